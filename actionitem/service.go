@@ -0,0 +1,193 @@
+package actionitem
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// MaxTitleLength caps action item titles, which are meant to be a short reminder rather than notes.
+const MaxTitleLength = 200
+
+// ErrRaceNotFound is returned when creating an item for a race the driver doesn't have.
+var ErrRaceNotFound = errors.New("race not found")
+
+// ValidateTitle checks an action item title. Returns validation errors for a blank or overly long title.
+func ValidateTitle(title string) []journal.FieldValidation {
+	if strings.TrimSpace(title) == "" {
+		return []journal.FieldValidation{{Field: "title", Code: "required"}}
+	}
+	if len(title) > MaxTitleLength {
+		return []journal.FieldValidation{{
+			Field:  "title",
+			Code:   "too_long",
+			Params: map[string]string{"max": strconv.Itoa(MaxTitleLength)},
+		}}
+	}
+	return nil
+}
+
+// Store defines the data access methods needed by the action item service.
+type Store interface {
+	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)
+	SaveActionItem(ctx context.Context, item store.ActionItem) error
+	GetActionItem(ctx context.Context, driverID int64, itemID string) (*store.ActionItem, error)
+	GetActionItems(ctx context.Context, driverID int64) ([]store.ActionItem, error)
+	DeleteActionItem(ctx context.Context, driverID int64, itemID string) error
+}
+
+// Service manages the things drivers want to work on.
+type Service struct {
+	store Store
+	newID func() string
+	now   func() time.Time
+}
+
+func NewService(store Store, newID func() string) *Service {
+	return &Service{
+		store: store,
+		newID: newID,
+		now:   time.Now,
+	}
+}
+
+// CreateInput describes a new action item. RaceID is zero for a free-standing item.
+type CreateInput struct {
+	DriverID int64
+	Title    string
+	RaceID   int64
+	TrackID  int64
+	DueDate  *time.Time
+}
+
+// Create adds an open action item. Items taken from a race are tied to the race's track, so they come back up the next
+// time the driver races there. Returns ErrRaceNotFound if RaceID doesn't identify one of the driver's races.
+func (s *Service) Create(ctx context.Context, input CreateInput) (*store.ActionItem, error) {
+	trackID := input.TrackID
+	if input.RaceID != 0 {
+		session, err := s.store.GetDriverSession(ctx, input.DriverID, store.TimeFromDriverRaceID(input.RaceID))
+		if err != nil {
+			return nil, err
+		}
+		if session == nil {
+			return nil, ErrRaceNotFound
+		}
+		trackID = session.TrackID
+	}
+
+	now := s.now()
+	item := store.ActionItem{
+		DriverID:  input.DriverID,
+		ItemID:    s.newID(),
+		Title:     input.Title,
+		Status:    store.ActionItemOpen,
+		RaceID:    input.RaceID,
+		TrackID:   trackID,
+		DueDate:   input.DueDate,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.store.SaveActionItem(ctx, item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// UpdateInput describes changes to an action item. Nil fields are left unchanged, ClearDueDate removes the due date.
+type UpdateInput struct {
+	DriverID     int64
+	ItemID       string
+	Title        *string
+	Status       *store.ActionItemStatus
+	DueDate      *time.Time
+	ClearDueDate bool
+}
+
+// Update applies changes to an action item, recording when it was completed. Returns nil if the item doesn't exist.
+func (s *Service) Update(ctx context.Context, input UpdateInput) (*store.ActionItem, error) {
+	item, err := s.store.GetActionItem(ctx, input.DriverID, input.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, nil
+	}
+
+	now := s.now()
+	if input.Title != nil {
+		item.Title = *input.Title
+	}
+	if input.DueDate != nil {
+		item.DueDate = input.DueDate
+	}
+	if input.ClearDueDate {
+		item.DueDate = nil
+	}
+	if input.Status != nil && *input.Status != item.Status {
+		item.Status = *input.Status
+		item.CompletedAt = nil
+		if item.Status == store.ActionItemDone {
+			item.CompletedAt = &now
+		}
+	}
+	item.UpdatedAt = now
+
+	if err := s.store.SaveActionItem(ctx, *item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// Delete removes an action item. Deleting an item that doesn't exist is not an error.
+func (s *Service) Delete(ctx context.Context, driverID int64, itemID string) error {
+	return s.store.DeleteActionItem(ctx, driverID, itemID)
+}
+
+// List returns a driver's action items with the given status, or all of them if status is empty, soonest due first.
+func (s *Service) List(ctx context.Context, driverID int64, status store.ActionItemStatus) ([]store.ActionItem, error) {
+	items, err := s.store.GetActionItems(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if status != "" {
+		items = slices.DeleteFunc(items, func(item store.ActionItem) bool {
+			return item.Status != status
+		})
+	}
+	sortItems(items)
+	return items, nil
+}
+
+// OpenForTrack returns the driver's open action items tied to a track, soonest due first.
+func (s *Service) OpenForTrack(ctx context.Context, driverID, trackID int64) ([]store.ActionItem, error) {
+	items, err := s.List(ctx, driverID, store.ActionItemOpen)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(items, func(item store.ActionItem) bool {
+		return item.TrackID != trackID
+	}), nil
+}
+
+// sortItems orders items by due date with undated items last, then by when they were created.
+func sortItems(items []store.ActionItem) {
+	slices.SortStableFunc(items, func(a, b store.ActionItem) int {
+		switch {
+		case a.DueDate != nil && b.DueDate != nil:
+			if c := a.DueDate.Compare(*b.DueDate); c != 0 {
+				return c
+			}
+		case a.DueDate != nil:
+			return -1
+		case b.DueDate != nil:
+			return 1
+		}
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+}
@@ -0,0 +1,259 @@
+package actionitem
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	now := time.Unix(1700100000, 0)
+	dueDate := time.Unix(1700500000, 0)
+
+	testCases := []struct {
+		name        string
+		input       CreateInput
+		setupMock   func(*MockStore)
+		expected    *store.ActionItem
+		expectedErr error
+		expectErr   bool
+	}{
+		{
+			name:  "from a race takes the race's track",
+			input: CreateInput{DriverID: driverID, Title: "Brake earlier into turn one", RaceID: raceID, TrackID: 7, DueDate: &dueDate},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).
+					Return(&store.DriverSession{DriverID: driverID, TrackID: 42}, nil)
+				m.EXPECT().SaveActionItem(mock.Anything, store.ActionItem{
+					DriverID: driverID, ItemID: "item-1", Title: "Brake earlier into turn one", Status: store.ActionItemOpen,
+					RaceID: raceID, TrackID: 42, DueDate: &dueDate, CreatedAt: now, UpdatedAt: now,
+				}).Return(nil)
+			},
+			expected: &store.ActionItem{
+				DriverID: driverID, ItemID: "item-1", Title: "Brake earlier into turn one", Status: store.ActionItemOpen,
+				RaceID: raceID, TrackID: 42, DueDate: &dueDate, CreatedAt: now, UpdatedAt: now,
+			},
+		},
+		{
+			name:  "free-standing",
+			input: CreateInput{DriverID: driverID, Title: "Work on consistency", TrackID: 7},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveActionItem(mock.Anything, store.ActionItem{
+					DriverID: driverID, ItemID: "item-1", Title: "Work on consistency", Status: store.ActionItemOpen,
+					TrackID: 7, CreatedAt: now, UpdatedAt: now,
+				}).Return(nil)
+			},
+			expected: &store.ActionItem{
+				DriverID: driverID, ItemID: "item-1", Title: "Work on consistency", Status: store.ActionItemOpen,
+				TrackID: 7, CreatedAt: now, UpdatedAt: now,
+			},
+		},
+		{
+			name:  "race not found",
+			input: CreateInput{DriverID: driverID, Title: "Brake earlier", RaceID: raceID},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).Return(nil, nil)
+			},
+			expectedErr: ErrRaceNotFound,
+		},
+		{
+			name:  "save error",
+			input: CreateInput{DriverID: driverID, Title: "Work on consistency"},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveActionItem(mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			svc := NewService(mockStore, func() string { return "item-1" })
+			svc.now = func() time.Time { return now }
+
+			result, err := svc.Create(ctx, tc.input)
+			switch {
+			case tc.expectedErr != nil:
+				assert.ErrorIs(t, err, tc.expectedErr)
+			case tc.expectErr:
+				assert.Error(t, err)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	createdAt := time.Unix(1700000000, 0)
+	now := time.Unix(1700100000, 0)
+	dueDate := time.Unix(1700500000, 0)
+	done := store.ActionItemDone
+	open := store.ActionItemOpen
+	title := "Brake much earlier"
+
+	existing := func() *store.ActionItem {
+		return &store.ActionItem{
+			DriverID: driverID, ItemID: "item-1", Title: "Brake earlier", Status: store.ActionItemOpen,
+			TrackID: 42, DueDate: &dueDate, CreatedAt: createdAt, UpdatedAt: createdAt,
+		}
+	}
+	completed := func() *store.ActionItem {
+		item := existing()
+		item.Status = store.ActionItemDone
+		item.CompletedAt = &createdAt
+		return item
+	}
+
+	testCases := []struct {
+		name      string
+		input     UpdateInput
+		current   *store.ActionItem
+		expected  *store.ActionItem
+		expectErr bool
+	}{
+		{
+			name:    "completing records when",
+			input:   UpdateInput{DriverID: driverID, ItemID: "item-1", Status: &done},
+			current: existing(),
+			expected: &store.ActionItem{
+				DriverID: driverID, ItemID: "item-1", Title: "Brake earlier", Status: store.ActionItemDone,
+				TrackID: 42, DueDate: &dueDate, CreatedAt: createdAt, UpdatedAt: now, CompletedAt: &now,
+			},
+		},
+		{
+			name:    "reopening clears completion",
+			input:   UpdateInput{DriverID: driverID, ItemID: "item-1", Status: &open},
+			current: completed(),
+			expected: &store.ActionItem{
+				DriverID: driverID, ItemID: "item-1", Title: "Brake earlier", Status: store.ActionItemOpen,
+				TrackID: 42, DueDate: &dueDate, CreatedAt: createdAt, UpdatedAt: now,
+			},
+		},
+		{
+			name:    "completing again keeps the original completion",
+			input:   UpdateInput{DriverID: driverID, ItemID: "item-1", Status: &done},
+			current: completed(),
+			expected: &store.ActionItem{
+				DriverID: driverID, ItemID: "item-1", Title: "Brake earlier", Status: store.ActionItemDone,
+				TrackID: 42, DueDate: &dueDate, CreatedAt: createdAt, UpdatedAt: now, CompletedAt: &createdAt,
+			},
+		},
+		{
+			name:    "title changed and due date cleared",
+			input:   UpdateInput{DriverID: driverID, ItemID: "item-1", Title: &title, ClearDueDate: true},
+			current: existing(),
+			expected: &store.ActionItem{
+				DriverID: driverID, ItemID: "item-1", Title: "Brake much earlier", Status: store.ActionItemOpen,
+				TrackID: 42, CreatedAt: createdAt, UpdatedAt: now,
+			},
+		},
+		{
+			name:  "not found",
+			input: UpdateInput{DriverID: driverID, ItemID: "item-1", Status: &done},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetActionItem(mock.Anything, driverID, "item-1").Return(tc.current, nil)
+			if tc.expected != nil {
+				mockStore.EXPECT().SaveActionItem(mock.Anything, *tc.expected).Return(nil)
+			}
+
+			svc := NewService(mockStore, nil)
+			svc.now = func() time.Time { return now }
+
+			result, err := svc.Update(ctx, tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	early := time.Unix(1700000000, 0)
+	late := time.Unix(1700500000, 0)
+
+	items := []store.ActionItem{
+		{ItemID: "undated-new", Status: store.ActionItemOpen, TrackID: 42, CreatedAt: late},
+		{ItemID: "due-late", Status: store.ActionItemOpen, TrackID: 7, DueDate: &late, CreatedAt: early},
+		{ItemID: "done", Status: store.ActionItemDone, TrackID: 42, CreatedAt: early},
+		{ItemID: "undated-old", Status: store.ActionItemOpen, CreatedAt: early},
+		{ItemID: "due-early", Status: store.ActionItemOpen, TrackID: 42, DueDate: &early, CreatedAt: late},
+	}
+	itemIDs := func(items []store.ActionItem) []string {
+		ids := make([]string, len(items))
+		for i, item := range items {
+			ids[i] = item.ItemID
+		}
+		return ids
+	}
+
+	testCases := []struct {
+		name     string
+		list     func(*Service) ([]store.ActionItem, error)
+		expected []string
+	}{
+		{
+			name: "all",
+			list: func(s *Service) ([]store.ActionItem, error) {
+				return s.List(ctx, driverID, "")
+			},
+			expected: []string{"due-early", "due-late", "done", "undated-old", "undated-new"},
+		},
+		{
+			name: "open",
+			list: func(s *Service) ([]store.ActionItem, error) {
+				return s.List(ctx, driverID, store.ActionItemOpen)
+			},
+			expected: []string{"due-early", "due-late", "undated-old", "undated-new"},
+		},
+		{
+			name: "open for track",
+			list: func(s *Service) ([]store.ActionItem, error) {
+				return s.OpenForTrack(ctx, driverID, 42)
+			},
+			expected: []string{"due-early", "undated-new"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetActionItems(mock.Anything, driverID).Return(append([]store.ActionItem(nil), items...), nil)
+
+			result, err := tc.list(NewService(mockStore, nil))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, itemIDs(result))
+		})
+	}
+}
+
+func TestValidateTitle(t *testing.T) {
+	assert.Empty(t, ValidateTitle("Trail brake into the hairpin"))
+	assert.Equal(t, "required", ValidateTitle("   ")[0].Code)
+	tooLong := ValidateTitle(strings.Repeat("a", MaxTitleLength+1))
+	require.Len(t, tooLong, 1)
+	assert.Equal(t, "too_long", tooLong[0].Code)
+	assert.Equal(t, map[string]string{"max": "200"}, tooLong[0].Params)
+}
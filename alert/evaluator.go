@@ -0,0 +1,168 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/i18n"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const actionTrendAlert = "trendAlert"
+
+// TrendAlertMsg tells the driver's connected clients a rule's condition has started being met.
+type TrendAlertMsg struct {
+	RuleID     string                `json:"ruleId"`
+	Name       string                `json:"name"`
+	Metric     store.AlertMetric     `json:"metric"`
+	Aggregate  store.AlertAggregate  `json:"aggregate"`
+	Value      float64               `json:"value"`
+	Threshold  float64               `json:"threshold"`
+	Comparison store.AlertComparison `json:"comparison"`
+	// Message describes the alert in the driver's locale, ready to be shown as is
+	Message string `json:"message"`
+}
+
+// EvaluatorStore defines the data access methods needed to evaluate a driver's rules.
+type EvaluatorStore interface {
+	GetAlertRules(ctx context.Context, driverID int64) ([]store.AlertRule, error)
+	SaveAlertRule(ctx context.Context, rule store.AlertRule) error
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error)
+}
+
+// Pusher delivers alerts to the driver's connected clients.
+type Pusher interface {
+	Broadcast(ctx context.Context, driverID int64, actionType string, payload any) error
+}
+
+// Evaluator checks a driver's rules against their latest races.
+type Evaluator struct {
+	store  EvaluatorStore
+	pusher Pusher
+	now    func() time.Time
+}
+
+func NewEvaluator(store EvaluatorStore, pusher Pusher) *Evaluator {
+	return &Evaluator{
+		store:  store,
+		pusher: pusher,
+		now:    time.Now,
+	}
+}
+
+// EvaluateDriver checks each of the driver's enabled rules, alerting on those whose condition has started being met
+// since they were last evaluated and rearming those whose condition no longer is. Rules that are already triggered
+// stay quiet, so a driver in a slump hears about it once rather than after every race.
+func (e *Evaluator) EvaluateDriver(ctx context.Context, driverID int64) error {
+	logger := zerolog.Ctx(ctx).With().Int64("driverId", driverID).Logger()
+
+	rules, err := e.store.GetAlertRules(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("getting alert rules: %w", err)
+	}
+	if !hasEnabled(rules) {
+		return nil
+	}
+
+	now := e.now()
+	sessions, err := e.store.GetDriverSessionsByTimeRange(ctx, driverID, now.Add(-lookback), now)
+	if err != nil {
+		return fmt.Errorf("getting recent sessions: %w", err)
+	}
+
+	var localizer *i18n.Localizer
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+		value, ok := Evaluate(rule, sessions, now)
+		met := ok && Triggers(rule, value)
+		if met == rule.Triggered {
+			continue
+		}
+
+		rule.Triggered = met
+		if met {
+			rule.LastTriggeredAt = &now
+			rule.LastValue = value
+		}
+		if err := e.store.SaveAlertRule(ctx, rule); err != nil {
+			return fmt.Errorf("saving alert rule %s: %w", rule.RuleID, err)
+		}
+		if !met {
+			continue
+		}
+
+		logger.Info().Str("ruleId", rule.RuleID).Float64("value", value).Msg("trend alert triggered")
+		if localizer == nil {
+			localizer = e.localizer(ctx, driverID)
+		}
+		// the rule keeps when it last triggered, so a driver who wasn't connected still sees it next time they look
+		if err := e.pusher.Broadcast(ctx, driverID, actionTrendAlert, TrendAlertMsg{
+			RuleID:     rule.RuleID,
+			Name:       rule.Name,
+			Metric:     rule.Metric,
+			Aggregate:  rule.Aggregate,
+			Value:      value,
+			Threshold:  rule.Threshold,
+			Comparison: rule.Comparison,
+			Message:    alertMessage(*localizer, rule, value),
+		}); err != nil {
+			logger.Warn().Err(err).Str("ruleId", rule.RuleID).Msg("failed to broadcast trend alert")
+		}
+	}
+	return nil
+}
+
+// localizer is only looked up once a rule triggers, most evaluations don't alert on anything. Not being able to read the
+// driver's settings isn't worth losing the alert over, it goes out in the default locale instead.
+func (e *Evaluator) localizer(ctx context.Context, driverID int64) *i18n.Localizer {
+	locale := i18n.DefaultLocale
+	settings, err := e.store.GetDriverSettings(ctx, driverID)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Int64("driverId", driverID).Msg("failed to get driver settings, alerting in the default locale")
+	} else {
+		locale = settings.Locale
+	}
+	localizer := i18n.For(locale)
+	return &localizer
+}
+
+var (
+	metricMessageKeys = map[store.AlertMetric]string{
+		store.AlertMetricIncidents:      i18n.KeyMetricIncidents,
+		store.AlertMetricIRating:        i18n.KeyMetricIRating,
+		store.AlertMetricFinishPosition: i18n.KeyMetricFinishPosition,
+	}
+	aggregateMessageKeys = map[store.AlertAggregate]string{
+		store.AlertAggregateAverage: i18n.KeyAggregateAverage,
+		store.AlertAggregateChange:  i18n.KeyAggregateChange,
+	}
+	comparisonMessageKeys = map[store.AlertComparison]string{
+		store.AlertComparisonAbove: i18n.KeyComparisonAbove,
+		store.AlertComparisonBelow: i18n.KeyComparisonBelow,
+	}
+)
+
+func alertMessage(localizer i18n.Localizer, rule store.AlertRule, value float64) string {
+	return localizer.Text(i18n.KeyTrendAlert, map[string]string{
+		"name":       rule.Name,
+		"metric":     localizer.Text(metricMessageKeys[rule.Metric], nil),
+		"aggregate":  localizer.Text(aggregateMessageKeys[rule.Aggregate], nil),
+		"value":      localizer.Number(value, 2),
+		"comparison": localizer.Text(comparisonMessageKeys[rule.Comparison], nil),
+		"threshold":  localizer.Number(rule.Threshold, 2),
+	})
+}
+
+func hasEnabled(rules []store.AlertRule) bool {
+	for _, rule := range rules {
+		if rule.Enabled {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,186 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEvaluator_EvaluateDriver(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	now := time.Unix(1700000000, 0)
+	earlier := time.Unix(1699000000, 0)
+
+	incidentRule := store.AlertRule{
+		DriverID:    driverID,
+		RuleID:      "incidents",
+		Name:        "Incident creep",
+		Metric:      store.AlertMetricIncidents,
+		Aggregate:   store.AlertAggregateAverage,
+		WindowRaces: 2,
+		Comparison:  store.AlertComparisonAbove,
+		Threshold:   5,
+		Enabled:     true,
+	}
+	triggered := func(rule store.AlertRule) store.AlertRule {
+		rule.Triggered = true
+		rule.LastTriggeredAt = &earlier
+		rule.LastValue = 9
+		return rule
+	}
+	disabled := func(rule store.AlertRule) store.AlertRule {
+		rule.Enabled = false
+		return rule
+	}
+
+	messy := []store.DriverSession{
+		{StartTime: now.Add(-time.Hour), Incidents: 8},
+		{StartTime: now.Add(-48 * time.Hour), Incidents: 4},
+	}
+	clean := []store.DriverSession{
+		{StartTime: now.Add(-time.Hour), Incidents: 0},
+		{StartTime: now.Add(-48 * time.Hour), Incidents: 4},
+	}
+
+	testCases := []struct {
+		name      string
+		setupMock func(*MockEvaluatorStore, *MockPusher)
+		expectErr bool
+	}{
+		{
+			name: "condition met triggers and alerts",
+			setupMock: func(m *MockEvaluatorStore, p *MockPusher) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{incidentRule}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return(messy, nil)
+				expected := incidentRule
+				expected.Triggered = true
+				expected.LastTriggeredAt = &now
+				expected.LastValue = 6
+				m.EXPECT().SaveAlertRule(mock.Anything, expected).Return(nil)
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID}, nil)
+				p.EXPECT().Broadcast(mock.Anything, driverID, "trendAlert", TrendAlertMsg{
+					RuleID:     "incidents",
+					Name:       "Incident creep",
+					Metric:     store.AlertMetricIncidents,
+					Aggregate:  store.AlertAggregateAverage,
+					Value:      6,
+					Threshold:  5,
+					Comparison: store.AlertComparisonAbove,
+					Message:    "Incident creep: average incidents is 6, above your threshold of 5",
+				}).Return(nil)
+			},
+		},
+		{
+			name: "already triggered stays quiet",
+			setupMock: func(m *MockEvaluatorStore, p *MockPusher) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{triggered(incidentRule)}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return(messy, nil)
+			},
+		},
+		{
+			name: "condition cleared rearms",
+			setupMock: func(m *MockEvaluatorStore, p *MockPusher) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{triggered(incidentRule)}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return(clean, nil)
+				expected := triggered(incidentRule)
+				expected.Triggered = false
+				m.EXPECT().SaveAlertRule(mock.Anything, expected).Return(nil)
+			},
+		},
+		{
+			name: "not enough races leaves the rule alone",
+			setupMock: func(m *MockEvaluatorStore, p *MockPusher) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{incidentRule}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return(messy[:1], nil)
+			},
+		},
+		{
+			name: "disabled rules are skipped without reading races",
+			setupMock: func(m *MockEvaluatorStore, p *MockPusher) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{disabled(incidentRule)}, nil)
+			},
+		},
+		{
+			name: "broadcast error is logged",
+			setupMock: func(m *MockEvaluatorStore, p *MockPusher) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{incidentRule}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return(messy, nil)
+				m.EXPECT().SaveAlertRule(mock.Anything, mock.Anything).Return(nil)
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID}, nil)
+				p.EXPECT().Broadcast(mock.Anything, driverID, "trendAlert", mock.Anything).Return(errors.New("connection lookup failed"))
+			},
+		},
+		{
+			name: "alert is written in the driver's locale",
+			setupMock: func(m *MockEvaluatorStore, p *MockPusher) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{incidentRule}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return(messy, nil)
+				m.EXPECT().SaveAlertRule(mock.Anything, mock.Anything).Return(nil)
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID, Locale: "de"}, nil)
+				p.EXPECT().Broadcast(mock.Anything, driverID, "trendAlert", mock.MatchedBy(func(msg TrendAlertMsg) bool {
+					return msg.Message == "Incident creep: Durchschnitt Incidents liegt bei 6 und damit über deinem Schwellenwert von 5"
+				})).Return(nil)
+			},
+		},
+		{
+			name: "settings error falls back on the default locale",
+			setupMock: func(m *MockEvaluatorStore, p *MockPusher) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{incidentRule}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return(messy, nil)
+				m.EXPECT().SaveAlertRule(mock.Anything, mock.Anything).Return(nil)
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(nil, errors.New("database error"))
+				p.EXPECT().Broadcast(mock.Anything, driverID, "trendAlert", mock.MatchedBy(func(msg TrendAlertMsg) bool {
+					return msg.Message == "Incident creep: average incidents is 6, above your threshold of 5"
+				})).Return(nil)
+			},
+		},
+		{
+			name: "rules error",
+			setupMock: func(m *MockEvaluatorStore, p *MockPusher) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return(nil, errors.New("database error"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "sessions error",
+			setupMock: func(m *MockEvaluatorStore, p *MockPusher) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{incidentRule}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return(nil, errors.New("database error"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "save error",
+			setupMock: func(m *MockEvaluatorStore, p *MockPusher) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{incidentRule}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return(messy, nil)
+				m.EXPECT().SaveAlertRule(mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockEvaluatorStore(t)
+			mockPusher := NewMockPusher(t)
+			tc.setupMock(mockStore, mockPusher)
+
+			evaluator := NewEvaluator(mockStore, mockPusher)
+			evaluator.now = func() time.Time { return now }
+
+			err := evaluator.EvaluateDriver(ctx, driverID)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
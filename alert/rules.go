@@ -0,0 +1,158 @@
+package alert
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// MaxNameLength caps rule names, which only need to say what the alert is about.
+const MaxNameLength = 100
+
+// MaxWindowRaces and MaxWindowDays bound how far back a rule can look. Rules are evaluated against the races run in
+// the last lookback, which has to be long enough to hold MaxWindowRaces races for drivers who race most weeks.
+const (
+	MaxWindowRaces = 50
+	MaxWindowDays  = 90
+)
+
+const lookback = 180 * 24 * time.Hour
+
+// aggregatesByMetric lists the aggregates each metric can be watched with. Only iRating carries a per race change.
+var aggregatesByMetric = map[store.AlertMetric][]store.AlertAggregate{
+	store.AlertMetricIncidents:      {store.AlertAggregateAverage},
+	store.AlertMetricIRating:        {store.AlertAggregateAverage, store.AlertAggregateChange},
+	store.AlertMetricFinishPosition: {store.AlertAggregateAverage},
+}
+
+// Definition is the part of a rule the driver controls.
+type Definition struct {
+	Name        string
+	Metric      store.AlertMetric
+	Aggregate   store.AlertAggregate
+	WindowRaces int
+	WindowDays  int
+	Comparison  store.AlertComparison
+	Threshold   float64
+	Enabled     bool
+}
+
+// Validate checks a rule definition, returning validation errors for anything the rules engine can't evaluate.
+func Validate(def Definition) []journal.FieldValidation {
+	var errs []journal.FieldValidation
+	if strings.TrimSpace(def.Name) == "" {
+		errs = append(errs, journal.FieldValidation{Field: "name", Code: "required"})
+	} else if len(def.Name) > MaxNameLength {
+		errs = append(errs, journal.FieldValidation{
+			Field:  "name",
+			Code:   "too_long",
+			Params: map[string]string{"max": strconv.Itoa(MaxNameLength)},
+		})
+	}
+
+	aggregates, ok := aggregatesByMetric[def.Metric]
+	if !ok {
+		errs = append(errs, invalidValue("metric", string(def.Metric), "incidents, irating, finishPosition"))
+	} else if !slices.Contains(aggregates, def.Aggregate) {
+		allowed := make([]string, len(aggregates))
+		for i, aggregate := range aggregates {
+			allowed[i] = string(aggregate)
+		}
+		errs = append(errs, invalidValue("aggregate", string(def.Aggregate), strings.Join(allowed, ", ")))
+	}
+
+	switch {
+	case def.WindowRaces != 0 && def.WindowDays != 0:
+		errs = append(errs, journal.FieldValidation{Field: "windowDays", Code: "mutual_exclusive", Params: map[string]string{"with": "windowRaces"}})
+	case def.WindowRaces == 0 && def.WindowDays == 0:
+		errs = append(errs, journal.FieldValidation{Field: "windowRaces", Code: "required"})
+	case def.WindowRaces != 0 && (def.WindowRaces < 1 || def.WindowRaces > MaxWindowRaces):
+		errs = append(errs, outOfRange("windowRaces", MaxWindowRaces))
+	case def.WindowDays != 0 && (def.WindowDays < 1 || def.WindowDays > MaxWindowDays):
+		errs = append(errs, outOfRange("windowDays", MaxWindowDays))
+	}
+
+	if def.Comparison != store.AlertComparisonAbove && def.Comparison != store.AlertComparisonBelow {
+		errs = append(errs, invalidValue("comparison", string(def.Comparison), "above, below"))
+	}
+	return errs
+}
+
+func invalidValue(field, value, allowed string) journal.FieldValidation {
+	return journal.FieldValidation{
+		Field:  field,
+		Code:   "invalid_value",
+		Params: map[string]string{"value": value, "allowed": allowed},
+	}
+}
+
+func outOfRange(field string, max int) journal.FieldValidation {
+	return journal.FieldValidation{
+		Field:  field,
+		Code:   "out_of_range",
+		Params: map[string]string{"min": "1", "max": strconv.Itoa(max)},
+	}
+}
+
+// Evaluate works out the rule's value from the driver's races, newest first, as of now. ok is false when there isn't
+// enough to judge the trend on: fewer than WindowRaces races, or no races in the last WindowDays days.
+func Evaluate(rule store.AlertRule, sessions []store.DriverSession, now time.Time) (value float64, ok bool) {
+	window := windowSessions(rule, sessions, now)
+	if len(window) == 0 {
+		return 0, false
+	}
+
+	if rule.Aggregate == store.AlertAggregateChange {
+		// summing each race's change rather than differencing the ends keeps road and oval iRatings apart
+		change := 0
+		for _, session := range window {
+			change += session.NewIRating - session.OldIRating
+		}
+		return float64(change), true
+	}
+
+	total := 0.0
+	for _, session := range window {
+		total += metricValue(rule.Metric, session)
+	}
+	return total / float64(len(window)), true
+}
+
+// Triggers reports whether value is on the alerting side of the rule's threshold.
+func Triggers(rule store.AlertRule, value float64) bool {
+	if rule.Comparison == store.AlertComparisonBelow {
+		return value < rule.Threshold
+	}
+	return value > rule.Threshold
+}
+
+func windowSessions(rule store.AlertRule, sessions []store.DriverSession, now time.Time) []store.DriverSession {
+	if rule.WindowRaces > 0 {
+		if len(sessions) < rule.WindowRaces {
+			return nil
+		}
+		return sessions[:rule.WindowRaces]
+	}
+	cutoff := now.Add(-time.Hour * 24 * time.Duration(rule.WindowDays))
+	end := 0
+	for end < len(sessions) && sessions[end].StartTime.After(cutoff) {
+		end++
+	}
+	return sessions[:end]
+}
+
+func metricValue(metric store.AlertMetric, session store.DriverSession) float64 {
+	switch metric {
+	case store.AlertMetricIRating:
+		return float64(session.NewIRating)
+	case store.AlertMetricFinishPosition:
+		// finish positions are stored zero based, drivers think of a win as finishing first
+		return float64(session.FinishPosition + 1)
+	default:
+		return float64(session.Incidents)
+	}
+}
@@ -0,0 +1,178 @@
+package alert
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	valid := Definition{
+		Name:        "Incident creep",
+		Metric:      store.AlertMetricIncidents,
+		Aggregate:   store.AlertAggregateAverage,
+		WindowRaces: 10,
+		Comparison:  store.AlertComparisonAbove,
+		Threshold:   5,
+	}
+
+	testCases := []struct {
+		name     string
+		modify   func(*Definition)
+		expected []journal.FieldValidation
+	}{
+		{
+			name:   "valid race window",
+			modify: func(d *Definition) {},
+		},
+		{
+			name: "valid day window",
+			modify: func(d *Definition) {
+				d.Metric = store.AlertMetricIRating
+				d.Aggregate = store.AlertAggregateChange
+				d.WindowRaces = 0
+				d.WindowDays = 7
+				d.Comparison = store.AlertComparisonBelow
+				d.Threshold = -200
+			},
+		},
+		{
+			name:     "blank name",
+			modify:   func(d *Definition) { d.Name = "  " },
+			expected: []journal.FieldValidation{{Field: "name", Code: "required"}},
+		},
+		{
+			name:     "name too long",
+			modify:   func(d *Definition) { d.Name = strings.Repeat("a", MaxNameLength+1) },
+			expected: []journal.FieldValidation{{Field: "name", Code: "too_long", Params: map[string]string{"max": "100"}}},
+		},
+		{
+			name:   "unknown metric",
+			modify: func(d *Definition) { d.Metric = "laps" },
+			expected: []journal.FieldValidation{{Field: "metric", Code: "invalid_value", Params: map[string]string{
+				"value":   "laps",
+				"allowed": "incidents, irating, finishPosition",
+			}}},
+		},
+		{
+			name:   "aggregate the metric doesn't support",
+			modify: func(d *Definition) { d.Aggregate = store.AlertAggregateChange },
+			expected: []journal.FieldValidation{{Field: "aggregate", Code: "invalid_value", Params: map[string]string{
+				"value":   "change",
+				"allowed": "average",
+			}}},
+		},
+		{
+			name:     "both windows",
+			modify:   func(d *Definition) { d.WindowDays = 7 },
+			expected: []journal.FieldValidation{{Field: "windowDays", Code: "mutual_exclusive", Params: map[string]string{"with": "windowRaces"}}},
+		},
+		{
+			name:     "no window",
+			modify:   func(d *Definition) { d.WindowRaces = 0 },
+			expected: []journal.FieldValidation{{Field: "windowRaces", Code: "required"}},
+		},
+		{
+			name:     "too many races",
+			modify:   func(d *Definition) { d.WindowRaces = MaxWindowRaces + 1 },
+			expected: []journal.FieldValidation{{Field: "windowRaces", Code: "out_of_range", Params: map[string]string{"min": "1", "max": "50"}}},
+		},
+		{
+			name: "negative days",
+			modify: func(d *Definition) {
+				d.WindowRaces = 0
+				d.WindowDays = -1
+			},
+			expected: []journal.FieldValidation{{Field: "windowDays", Code: "out_of_range", Params: map[string]string{"min": "1", "max": "90"}}},
+		},
+		{
+			name:   "unknown comparison",
+			modify: func(d *Definition) { d.Comparison = "equals" },
+			expected: []journal.FieldValidation{{Field: "comparison", Code: "invalid_value", Params: map[string]string{
+				"value":   "equals",
+				"allowed": "above, below",
+			}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			def := valid
+			tc.modify(&def)
+			assert.Equal(t, tc.expected, Validate(def))
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	day := 24 * time.Hour
+	// newest first, as the store returns them
+	sessions := []store.DriverSession{
+		{StartTime: now.Add(-1 * day), Incidents: 8, FinishPosition: 0, OldIRating: 1900, NewIRating: 1850},
+		{StartTime: now.Add(-3 * day), Incidents: 6, FinishPosition: 4, OldIRating: 2000, NewIRating: 1900},
+		{StartTime: now.Add(-9 * day), Incidents: 1, FinishPosition: 9, OldIRating: 1950, NewIRating: 2000},
+	}
+
+	testCases := []struct {
+		name       string
+		rule       store.AlertRule
+		expected   float64
+		expectedOK bool
+	}{
+		{
+			name:       "incident average over races",
+			rule:       store.AlertRule{Metric: store.AlertMetricIncidents, Aggregate: store.AlertAggregateAverage, WindowRaces: 2},
+			expected:   7,
+			expectedOK: true,
+		},
+		{
+			name:       "finish positions count a win as first",
+			rule:       store.AlertRule{Metric: store.AlertMetricFinishPosition, Aggregate: store.AlertAggregateAverage, WindowRaces: 3},
+			expected:   5.333333333333333,
+			expectedOK: true,
+		},
+		{
+			name:       "iRating change over days",
+			rule:       store.AlertRule{Metric: store.AlertMetricIRating, Aggregate: store.AlertAggregateChange, WindowDays: 7},
+			expected:   -150,
+			expectedOK: true,
+		},
+		{
+			name:       "iRating average over days",
+			rule:       store.AlertRule{Metric: store.AlertMetricIRating, Aggregate: store.AlertAggregateAverage, WindowDays: 30},
+			expected:   1916.6666666666667,
+			expectedOK: true,
+		},
+		{
+			name: "not enough races",
+			rule: store.AlertRule{Metric: store.AlertMetricIncidents, Aggregate: store.AlertAggregateAverage, WindowRaces: 4},
+		},
+		{
+			name: "no races in the window",
+			rule: store.AlertRule{Metric: store.AlertMetricIRating, Aggregate: store.AlertAggregateChange, WindowDays: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, ok := Evaluate(tc.rule, sessions, now)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.InDelta(t, tc.expected, value, 0.0001)
+		})
+	}
+}
+
+func TestTriggers(t *testing.T) {
+	above := store.AlertRule{Comparison: store.AlertComparisonAbove, Threshold: 5}
+	assert.True(t, Triggers(above, 5.1))
+	assert.False(t, Triggers(above, 5))
+
+	below := store.AlertRule{Comparison: store.AlertComparisonBelow, Threshold: -200}
+	assert.True(t, Triggers(below, -201))
+	assert.False(t, Triggers(below, -200))
+}
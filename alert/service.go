@@ -0,0 +1,111 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// MaxRulesPerDriver caps how many rules a driver can have, every one of them is evaluated after each ingestion.
+const MaxRulesPerDriver = 20
+
+// ErrTooManyRules is returned when creating a rule would take the driver past MaxRulesPerDriver.
+var ErrTooManyRules = errors.New("too many alert rules")
+
+// Store defines the data access methods needed by the alert service.
+type Store interface {
+	SaveAlertRule(ctx context.Context, rule store.AlertRule) error
+	GetAlertRule(ctx context.Context, driverID int64, ruleID string) (*store.AlertRule, error)
+	GetAlertRules(ctx context.Context, driverID int64) ([]store.AlertRule, error)
+	DeleteAlertRule(ctx context.Context, driverID int64, ruleID string) error
+}
+
+// Service manages the trends drivers want to be alerted about.
+type Service struct {
+	store Store
+	newID func() string
+	now   func() time.Time
+}
+
+func NewService(store Store, newID func() string) *Service {
+	return &Service{
+		store: store,
+		newID: newID,
+		now:   time.Now,
+	}
+}
+
+// Create adds a rule for the driver. Callers should validate the definition with Validate before calling Create.
+// Returns ErrTooManyRules if the driver already has MaxRulesPerDriver rules.
+func (s *Service) Create(ctx context.Context, driverID int64, def Definition) (*store.AlertRule, error) {
+	existing, err := s.store.GetAlertRules(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= MaxRulesPerDriver {
+		return nil, ErrTooManyRules
+	}
+
+	now := s.now()
+	rule := store.AlertRule{
+		DriverID:  driverID,
+		RuleID:    s.newID(),
+		CreatedAt: now,
+	}
+	applyDefinition(&rule, def, now)
+	if err := s.store.SaveAlertRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// Update replaces a rule's definition. The rule is rearmed, so it alerts the next time its new condition is met even
+// if the old one was already. Returns nil if the rule doesn't exist.
+func (s *Service) Update(ctx context.Context, driverID int64, ruleID string, def Definition) (*store.AlertRule, error) {
+	rule, err := s.store.GetAlertRule(ctx, driverID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, nil
+	}
+
+	applyDefinition(rule, def, s.now())
+	rule.Triggered = false
+	if err := s.store.SaveAlertRule(ctx, *rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// Delete removes a rule. Deleting a rule that doesn't exist is not an error.
+func (s *Service) Delete(ctx context.Context, driverID int64, ruleID string) error {
+	return s.store.DeleteAlertRule(ctx, driverID, ruleID)
+}
+
+// List returns the driver's rules, oldest first.
+func (s *Service) List(ctx context.Context, driverID int64) ([]store.AlertRule, error) {
+	rules, err := s.store.GetAlertRules(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(rules, func(a, b store.AlertRule) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return rules, nil
+}
+
+func applyDefinition(rule *store.AlertRule, def Definition, now time.Time) {
+	rule.Name = def.Name
+	rule.Metric = def.Metric
+	rule.Aggregate = def.Aggregate
+	rule.WindowRaces = def.WindowRaces
+	rule.WindowDays = def.WindowDays
+	rule.Comparison = def.Comparison
+	rule.Threshold = def.Threshold
+	rule.Enabled = def.Enabled
+	rule.UpdatedAt = now
+}
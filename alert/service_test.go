@@ -0,0 +1,186 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	now := time.Unix(1700100000, 0)
+	def := Definition{
+		Name:        "Incident creep",
+		Metric:      store.AlertMetricIncidents,
+		Aggregate:   store.AlertAggregateAverage,
+		WindowRaces: 10,
+		Comparison:  store.AlertComparisonAbove,
+		Threshold:   5,
+		Enabled:     true,
+	}
+	created := store.AlertRule{
+		DriverID:    driverID,
+		RuleID:      "rule-1",
+		Name:        "Incident creep",
+		Metric:      store.AlertMetricIncidents,
+		Aggregate:   store.AlertAggregateAverage,
+		WindowRaces: 10,
+		Comparison:  store.AlertComparisonAbove,
+		Threshold:   5,
+		Enabled:     true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	testCases := []struct {
+		name        string
+		setupMock   func(*MockStore)
+		expected    *store.AlertRule
+		expectedErr error
+		expectErr   bool
+	}{
+		{
+			name: "success",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{{RuleID: "other"}}, nil)
+				m.EXPECT().SaveAlertRule(mock.Anything, created).Return(nil)
+			},
+			expected: &created,
+		},
+		{
+			name: "too many rules",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return(make([]store.AlertRule, MaxRulesPerDriver), nil)
+			},
+			expectedErr: ErrTooManyRules,
+		},
+		{
+			name: "get error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return(nil, errors.New("database error"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "save error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetAlertRules(mock.Anything, driverID).Return(nil, nil)
+				m.EXPECT().SaveAlertRule(mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			svc := NewService(mockStore, func() string { return "rule-1" })
+			svc.now = func() time.Time { return now }
+
+			result, err := svc.Create(ctx, driverID, def)
+			switch {
+			case tc.expectedErr != nil:
+				assert.ErrorIs(t, err, tc.expectedErr)
+			case tc.expectErr:
+				assert.Error(t, err)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	createdAt := time.Unix(1700000000, 0)
+	now := time.Unix(1700100000, 0)
+	def := Definition{
+		Name:       "iRating slide",
+		Metric:     store.AlertMetricIRating,
+		Aggregate:  store.AlertAggregateChange,
+		WindowDays: 7,
+		Comparison: store.AlertComparisonBelow,
+		Threshold:  -200,
+	}
+
+	testCases := []struct {
+		name     string
+		current  *store.AlertRule
+		expected *store.AlertRule
+	}{
+		{
+			name: "replaces the definition and rearms the rule",
+			current: &store.AlertRule{
+				DriverID: driverID, RuleID: "rule-1", Name: "Incident creep", Metric: store.AlertMetricIncidents,
+				Aggregate: store.AlertAggregateAverage, WindowRaces: 10, Comparison: store.AlertComparisonAbove, Threshold: 5,
+				Enabled: true, Triggered: true, LastTriggeredAt: &createdAt, LastValue: 6, CreatedAt: createdAt, UpdatedAt: createdAt,
+			},
+			expected: &store.AlertRule{
+				DriverID: driverID, RuleID: "rule-1", Name: "iRating slide", Metric: store.AlertMetricIRating,
+				Aggregate: store.AlertAggregateChange, WindowDays: 7, Comparison: store.AlertComparisonBelow, Threshold: -200,
+				LastTriggeredAt: &createdAt, LastValue: 6, CreatedAt: createdAt, UpdatedAt: now,
+			},
+		},
+		{
+			name: "not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetAlertRule(mock.Anything, driverID, "rule-1").Return(tc.current, nil)
+			if tc.expected != nil {
+				mockStore.EXPECT().SaveAlertRule(mock.Anything, *tc.expected).Return(nil)
+			}
+
+			svc := NewService(mockStore, nil)
+			svc.now = func() time.Time { return now }
+
+			result, err := svc.Update(ctx, driverID, "rule-1", def)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	early := time.Unix(1700000000, 0)
+	late := time.Unix(1700500000, 0)
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetAlertRules(mock.Anything, driverID).Return([]store.AlertRule{
+		{RuleID: "a", CreatedAt: late},
+		{RuleID: "b", CreatedAt: early},
+	}, nil)
+
+	svc := NewService(mockStore, nil)
+	rules, err := svc.List(ctx, driverID)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, "b", rules[0].RuleID)
+	assert.Equal(t, "a", rules[1].RuleID)
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().DeleteAlertRule(mock.Anything, int64(12345), "rule-1").Return(nil)
+
+	svc := NewService(mockStore, nil)
+	assert.NoError(t, svc.Delete(ctx, 12345, "rule-1"))
+}
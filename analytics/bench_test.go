@@ -0,0 +1,127 @@
+package analytics
+
+import (
+	"context"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// benchSessionCount is the size of the driver history the analytics benchmarks run against, a heavy user's several
+// years of racing
+const benchSessionCount = 10_000
+
+var benchStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// generateSessions builds a repeatable driver history of races three hours apart from benchStart, drawn from a
+// realistic number of series, cars and tracks so grouping has work to do.
+func generateSessions(n int) []store.DriverSession {
+	rng := rand.New(rand.NewPCG(1, 2))
+	reasons := []store.ReasonOutCode{store.ReasonOutFinished, store.ReasonOutFinished, store.ReasonOutFinished, store.ReasonOutDNF, store.ReasonOutDisconnected}
+	sessions := make([]store.DriverSession, n)
+	irating := 1500
+	for i := range sessions {
+		newIRating := irating + rng.IntN(121) - 60
+		sessions[i] = store.DriverSession{
+			DriverID:              1100750,
+			SubsessionID:          int64(50_000_000 + i),
+			TrackID:               int64(rng.IntN(120) + 1),
+			CarID:                 int64(rng.IntN(40) + 1),
+			SeriesID:              int64(rng.IntN(60) + 1),
+			SeriesName:            "Benchmark Series",
+			StartTime:             benchStart.Add(time.Duration(i) * 3 * time.Hour),
+			StartPosition:         rng.IntN(24),
+			StartPositionInClass:  rng.IntN(24),
+			FinishPosition:        rng.IntN(24),
+			FinishPositionInClass: rng.IntN(24),
+			Incidents:             rng.IntN(17),
+			OldCPI:                rng.Float64() * 100,
+			NewCPI:                rng.Float64() * 100,
+			OldIRating:            irating,
+			NewIRating:            newIRating,
+			OldLicenseLevel:       16,
+			NewLicenseLevel:       16,
+			OldSubLevel:           300 + rng.IntN(100),
+			NewSubLevel:           300 + rng.IntN(100),
+			ReasonOutCode:         reasons[rng.IntN(len(reasons))],
+			StrengthOfField:       1000 + rng.IntN(3000),
+			CornersPerLap:         10 + rng.IntN(10),
+			LicenseCategoryID:     5,
+			LapsComplete:          10 + rng.IntN(40),
+			LapsLead:              rng.IntN(5),
+		}
+		irating = newIRating
+	}
+	return sessions
+}
+
+func BenchmarkGetAnalytics(b *testing.B) {
+	ctx := context.Background()
+	sessions := generateSessions(benchSessionCount)
+	memStore := store.NewMemoryStore()
+	if err := memStore.SaveDriverSessions(ctx, sessions); err != nil {
+		b.Fatal(err)
+	}
+	svc := NewService(memStore)
+	req := AnalyticsRequest{
+		DriverID:    1100750,
+		From:        benchStart,
+		To:          sessions[len(sessions)-1].StartTime.Add(time.Hour),
+		GroupBy:     []GroupByDimension{GroupBySeries, GroupByCar, GroupByTrack},
+		Granularity: GranularityWeek,
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := svc.GetAnalytics(ctx, req); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkComputeSummary(b *testing.B) {
+	sessions := generateSessions(benchSessionCount)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		computeSummary(sessions)
+	}
+}
+
+func BenchmarkComputeGroupedStats(b *testing.B) {
+	sessions := generateSessions(benchSessionCount)
+	groupBy := []GroupByDimension{GroupBySeries, GroupByCar, GroupByTrack}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		computeGroupedStats(sessions, groupBy)
+	}
+}
+
+func BenchmarkComputeTimeSeries(b *testing.B) {
+	sessions := generateSessions(benchSessionCount)
+
+	for _, granularity := range []Granularity{GranularityDay, GranularityWeek, GranularityMonth} {
+		b.Run(string(granularity), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				computeTimeSeries(sessions, granularity)
+			}
+		})
+	}
+}
+
+func BenchmarkComputeCPIBreakdown(b *testing.B) {
+	sessions := generateSessions(benchSessionCount)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		computeCPIBreakdown(sessions)
+	}
+}
@@ -0,0 +1,127 @@
+package analytics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/series"
+)
+
+// Category families, iRacing's license categories grouped the way drivers think of them
+const (
+	CategoryOval = "oval"
+	CategoryRoad = "road"
+	CategoryDirt = "dirt"
+)
+
+var categoryFamilies = []string{CategoryOval, CategoryRoad, CategoryDirt}
+
+const (
+	// underusedShare is the fraction of a driver's races below which a category is considered underused.
+	underusedShare = 0.15
+	// entryLevelLicenseGroup is the highest minimum license group (D) a series can require and still be suggested as
+	// a way into a category.
+	entryLevelLicenseGroup = 2
+	// maxSuggestionsPerCategory caps the series suggested for each underused category.
+	maxSuggestionsPerCategory = 3
+)
+
+// CategoryFamily maps an iRacing license or series category ID to its family. Road covers the sports car and formula
+// car categories road was split into, and dirt covers both dirt oval and dirt road. Returns an empty string for
+// unknown IDs.
+func CategoryFamily(categoryID int) string {
+	switch categoryID {
+	case 1:
+		return CategoryOval
+	case 2, 5, 6:
+		return CategoryRoad
+	case 3, 4:
+		return CategoryDirt
+	default:
+		return ""
+	}
+}
+
+// CategoryProfileRequest contains the parameters for profiling a driver's category mix. Catalog is the series catalog
+// suggestions are drawn from.
+type CategoryProfileRequest struct {
+	DriverID int64
+	From     time.Time
+	To       time.Time
+	Catalog  []series.Series
+}
+
+// CategoryUsage is how much a driver raced in a category family.
+type CategoryUsage struct {
+	Category string
+	Races    int
+	Share    float64
+	// Underused categories are ones the driver has raced in less than 15% of the time, along with suggested series
+	// open to rookie or D class licenses to get into them
+	Underused   bool
+	Suggestions []series.Series
+}
+
+// CategoryProfile is a driver's mix of oval, road and dirt racing.
+type CategoryProfile struct {
+	Categories []CategoryUsage
+	// Unclassified counts races ingested before their category was recorded, which are left out of the shares
+	Unclassified int
+}
+
+// GetCategoryProfile breaks the driver's races down by category family and suggests entry level series for the
+// families they rarely or never race in.
+func (s *Service) GetCategoryProfile(ctx context.Context, req CategoryProfileRequest) (*CategoryProfile, error) {
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, req.DriverID, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &CategoryProfile{}
+	races := make(map[string]int)
+	classified := 0
+	for _, session := range sessions {
+		family := CategoryFamily(session.LicenseCategoryID)
+		if family == "" {
+			profile.Unclassified++
+			continue
+		}
+		races[family]++
+		classified++
+	}
+
+	for _, family := range categoryFamilies {
+		usage := CategoryUsage{Category: family, Races: races[family]}
+		if classified > 0 {
+			usage.Share = float64(usage.Races) / float64(classified)
+		}
+		if usage.Share < underusedShare {
+			usage.Underused = true
+			usage.Suggestions = entryLevelSeries(req.Catalog, family)
+		}
+		profile.Categories = append(profile.Categories, usage)
+	}
+	return profile, nil
+}
+
+// entryLevelSeries picks active official series in the family that rookie or D class drivers can enter, alphabetically.
+func entryLevelSeries(catalog []series.Series, family string) []series.Series {
+	var suggestions []series.Series
+	for _, s := range catalog {
+		if !s.Active || !s.Official || CategoryFamily(s.CategoryID) != family {
+			continue
+		}
+		if s.MinLicenseGroup == 0 || s.MinLicenseGroup > entryLevelLicenseGroup {
+			continue
+		}
+		suggestions = append(suggestions, s)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		return suggestions[i].Name < suggestions[j].Name
+	})
+	if len(suggestions) > maxSuggestionsPerCategory {
+		suggestions = suggestions[:maxSuggestionsPerCategory]
+	}
+	return suggestions
+}
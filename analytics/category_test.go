@@ -0,0 +1,100 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/series"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetCategoryProfile(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	mx5 := series.Series{ID: 1, Name: "Global Mazda MX-5 Cup", CategoryID: 5, Active: true, Official: true, MinLicenseGroup: 1}
+	street := series.Series{ID: 2, Name: "Street Stock Series", CategoryID: 1, Active: true, Official: true, MinLicenseGroup: 1}
+	legends := series.Series{ID: 3, Name: "Legends Cup", CategoryID: 1, Active: true, Official: true, MinLicenseGroup: 2}
+	arca := series.Series{ID: 4, Name: "ARCA Menards Series", CategoryID: 1, Active: true, Official: true, MinLicenseGroup: 2}
+	lateModel := series.Series{ID: 5, Name: "Late Model Stock Tour", CategoryID: 1, Active: true, Official: true, MinLicenseGroup: 2}
+	nascar := series.Series{ID: 6, Name: "NASCAR Cup Series", CategoryID: 1, Active: true, Official: true, MinLicenseGroup: 4}
+	retired := series.Series{ID: 7, Name: "Dirt Street Stock", CategoryID: 3, Active: false, Official: true, MinLicenseGroup: 1}
+	unofficial := series.Series{ID: 8, Name: "Dirt Micro Sprint Hosted", CategoryID: 3, Active: true, Official: false, MinLicenseGroup: 1}
+	microSprint := series.Series{ID: 9, Name: "Dirt Micro Sprint Car Cup", CategoryID: 3, Active: true, Official: true, MinLicenseGroup: 1}
+	catalog := []series.Series{mx5, street, legends, arca, lateModel, nascar, retired, unofficial, microSprint}
+
+	testCases := []struct {
+		name string
+
+		sessions []store.DriverSession
+		err      error
+
+		expected    *CategoryProfile
+		expectedErr error
+	}{
+		{
+			name: "road racer",
+			sessions: []store.DriverSession{
+				{LicenseCategoryID: 5},
+				{LicenseCategoryID: 5},
+				{LicenseCategoryID: 6},
+				{LicenseCategoryID: 2},
+				{LicenseCategoryID: 5},
+				{LicenseCategoryID: 6},
+				{LicenseCategoryID: 5},
+				{LicenseCategoryID: 1},
+				{LicenseCategoryID: 0}, // ingested before categories were recorded
+			},
+			expected: &CategoryProfile{
+				Categories: []CategoryUsage{
+					{Category: CategoryOval, Races: 1, Share: 1.0 / 8, Underused: true, Suggestions: []series.Series{arca, lateModel, legends}},
+					{Category: CategoryRoad, Races: 7, Share: 7.0 / 8},
+					{Category: CategoryDirt, Races: 0, Share: 0, Underused: true, Suggestions: []series.Series{microSprint}},
+				},
+				Unclassified: 1,
+			},
+		},
+		{
+			name: "no races",
+			expected: &CategoryProfile{
+				Categories: []CategoryUsage{
+					{Category: CategoryOval, Underused: true, Suggestions: []series.Series{arca, lateModel, legends}},
+					{Category: CategoryRoad, Underused: true, Suggestions: []series.Series{mx5}},
+					{Category: CategoryDirt, Underused: true, Suggestions: []series.Series{microSprint}},
+				},
+			},
+		},
+		{
+			name:        "store error",
+			err:         errors.New("database error"),
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, int64(12345), from, to).Return(tc.sessions, tc.err)
+
+			service := NewService(mockStore)
+			result, err := service.GetCategoryProfile(context.Background(), CategoryProfileRequest{
+				DriverID: 12345,
+				From:     from,
+				To:       to,
+				Catalog:  catalog,
+			})
+
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
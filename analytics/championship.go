@@ -0,0 +1,162 @@
+package analytics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// DefaultCountedWeeks is the number of race weeks iRacing counts towards a season championship, the rest are dropped.
+const DefaultCountedWeeks = 8
+
+// ChampionshipRequest contains the parameters for a championship standings query.
+type ChampionshipRequest struct {
+	DriverID     int64
+	From         time.Time
+	To           time.Time
+	SeriesIDs    []int64
+	CountedWeeks int // zero means DefaultCountedWeeks
+}
+
+// ChampionshipWeek is the driver's championship score for a single race week.
+type ChampionshipWeek struct {
+	RaceWeekNum int
+	RaceCount   int
+	Points      int
+	// Dropped indicates the week falls outside the driver's best weeks and doesn't count towards the season total.
+	Dropped bool
+}
+
+// ChampionshipStanding is the driver's championship tally for a single series season.
+type ChampionshipStanding struct {
+	SeasonID      int64
+	SeasonYear    int
+	SeasonQuarter int
+	SeriesID      int64
+	SeriesName    string
+
+	TotalPoints  int
+	WeeksRaced   int
+	WeeksCounted int
+	Weeks        []ChampionshipWeek // ordered by race week
+}
+
+// ChampionshipResult contains the driver's championship standings, most recent season first.
+type ChampionshipResult struct {
+	Standings []ChampionshipStanding
+}
+
+type championshipKey struct {
+	seasonID int64
+	seriesID int64
+}
+
+// GetChampionship aggregates championship points by series season. Week scores follow iRacing's rules, averaging the
+// driver's best quarter of races in the week (rounded up), and only the best CountedWeeks weeks count towards the
+// season total. Races iRacing flagged as drop races, and races ingested before season data was recorded, are ignored.
+func (s *Service) GetChampionship(ctx context.Context, req ChampionshipRequest) (*ChampionshipResult, error) {
+	var filters []store.SessionFilter
+	if len(req.SeriesIDs) > 0 {
+		filters = append(filters, store.FilterBySeriesIDs(req.SeriesIDs))
+	}
+
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, req.DriverID, req.From, req.To, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	countedWeeks := req.CountedWeeks
+	if countedWeeks <= 0 {
+		countedWeeks = DefaultCountedWeeks
+	}
+
+	seasons := make(map[championshipKey][]store.DriverSession)
+	for _, session := range sessions {
+		if session.SeasonID == 0 || session.DropRace {
+			continue
+		}
+		key := championshipKey{seasonID: session.SeasonID, seriesID: session.SeriesID}
+		seasons[key] = append(seasons[key], session)
+	}
+
+	result := &ChampionshipResult{
+		Standings: make([]ChampionshipStanding, 0, len(seasons)),
+	}
+	for _, seasonSessions := range seasons {
+		result.Standings = append(result.Standings, computeStanding(seasonSessions, countedWeeks))
+	}
+
+	sort.Slice(result.Standings, func(i, j int) bool {
+		a, b := result.Standings[i], result.Standings[j]
+		if a.SeasonYear != b.SeasonYear {
+			return a.SeasonYear > b.SeasonYear
+		}
+		if a.SeasonQuarter != b.SeasonQuarter {
+			return a.SeasonQuarter > b.SeasonQuarter
+		}
+		return a.SeriesID < b.SeriesID
+	})
+
+	return result, nil
+}
+
+func computeStanding(sessions []store.DriverSession, countedWeeks int) ChampionshipStanding {
+	first := sessions[0]
+	standing := ChampionshipStanding{
+		SeasonID:      first.SeasonID,
+		SeasonYear:    first.SeasonYear,
+		SeasonQuarter: first.SeasonQuarter,
+		SeriesID:      first.SeriesID,
+		SeriesName:    first.SeriesName,
+	}
+
+	pointsByWeek := make(map[int][]int)
+	for _, session := range sessions {
+		pointsByWeek[session.RaceWeekNum] = append(pointsByWeek[session.RaceWeekNum], session.ChampPoints)
+	}
+
+	for week, points := range pointsByWeek {
+		standing.Weeks = append(standing.Weeks, ChampionshipWeek{
+			RaceWeekNum: week,
+			RaceCount:   len(points),
+			Points:      weekPoints(points),
+		})
+	}
+
+	// rank weeks by points to work out which ones get dropped, then put them back in calendar order
+	sort.Slice(standing.Weeks, func(i, j int) bool {
+		if standing.Weeks[i].Points != standing.Weeks[j].Points {
+			return standing.Weeks[i].Points > standing.Weeks[j].Points
+		}
+		return standing.Weeks[i].RaceWeekNum < standing.Weeks[j].RaceWeekNum
+	})
+	for i := range standing.Weeks {
+		if i < countedWeeks {
+			standing.TotalPoints += standing.Weeks[i].Points
+			standing.WeeksCounted++
+		} else {
+			standing.Weeks[i].Dropped = true
+		}
+	}
+	sort.Slice(standing.Weeks, func(i, j int) bool {
+		return standing.Weeks[i].RaceWeekNum < standing.Weeks[j].RaceWeekNum
+	})
+
+	standing.WeeksRaced = len(standing.Weeks)
+	return standing
+}
+
+// weekPoints averages the best quarter (rounded up) of the week's race points.
+func weekPoints(points []int) int {
+	sorted := append([]int(nil), points...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+
+	best := (len(sorted) + 3) / 4
+	total := 0
+	for _, p := range sorted[:best] {
+		total += p
+	}
+	return total / best
+}
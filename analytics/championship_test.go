@@ -0,0 +1,177 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetChampionship(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	season := func(week, points int) store.DriverSession {
+		return store.DriverSession{SeasonID: 4500, SeasonYear: 2024, SeasonQuarter: 2, SeriesID: 42, SeriesName: "Mazda", RaceWeekNum: week, ChampPoints: points}
+	}
+	dropped := season(0, 500)
+	dropped.DropRace = true
+
+	testSessions := []store.DriverSession{
+		// week 0: five races, best quarter rounded up is the top two
+		season(0, 100), season(0, 80), season(0, 60), season(0, 40), season(0, 20), dropped,
+		season(1, 90),
+		season(2, 30),
+		season(3, 70),
+		{SeasonID: 4400, SeasonYear: 2024, SeasonQuarter: 1, SeriesID: 43, SeriesName: "GT3", RaceWeekNum: 11, ChampPoints: 50},
+		{SeriesID: 42, ChampPoints: 999}, // ingested before season data was recorded
+	}
+
+	type storeCall struct {
+		sessions []store.DriverSession
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		request   ChampionshipRequest
+		storeCall storeCall
+
+		expected    *ChampionshipResult
+		expectedErr error
+	}{
+		{
+			name: "drops lowest weeks",
+			request: ChampionshipRequest{
+				DriverID:     12345,
+				From:         from,
+				To:           to,
+				CountedWeeks: 3,
+			},
+			storeCall: storeCall{sessions: testSessions},
+			expected: &ChampionshipResult{
+				Standings: []ChampionshipStanding{
+					{
+						SeasonID:      4500,
+						SeasonYear:    2024,
+						SeasonQuarter: 2,
+						SeriesID:      42,
+						SeriesName:    "Mazda",
+						TotalPoints:   250,
+						WeeksRaced:    4,
+						WeeksCounted:  3,
+						Weeks: []ChampionshipWeek{
+							{RaceWeekNum: 0, RaceCount: 5, Points: 90},
+							{RaceWeekNum: 1, RaceCount: 1, Points: 90},
+							{RaceWeekNum: 2, RaceCount: 1, Points: 30, Dropped: true},
+							{RaceWeekNum: 3, RaceCount: 1, Points: 70},
+						},
+					},
+					{
+						SeasonID:      4400,
+						SeasonYear:    2024,
+						SeasonQuarter: 1,
+						SeriesID:      43,
+						SeriesName:    "GT3",
+						TotalPoints:   50,
+						WeeksRaced:    1,
+						WeeksCounted:  1,
+						Weeks: []ChampionshipWeek{
+							{RaceWeekNum: 11, RaceCount: 1, Points: 50},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "series filter and default counted weeks",
+			request: ChampionshipRequest{
+				DriverID:  12345,
+				From:      from,
+				To:        to,
+				SeriesIDs: []int64{43},
+			},
+			storeCall: storeCall{sessions: testSessions},
+			expected: &ChampionshipResult{
+				Standings: []ChampionshipStanding{
+					{
+						SeasonID:      4400,
+						SeasonYear:    2024,
+						SeasonQuarter: 1,
+						SeriesID:      43,
+						SeriesName:    "GT3",
+						TotalPoints:   50,
+						WeeksRaced:    1,
+						WeeksCounted:  1,
+						Weeks: []ChampionshipWeek{
+							{RaceWeekNum: 11, RaceCount: 1, Points: 50},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no races",
+			request: ChampionshipRequest{
+				DriverID: 12345,
+				From:     from,
+				To:       to,
+			},
+			storeCall: storeCall{sessions: []store.DriverSession{}},
+			expected:  &ChampionshipResult{Standings: []ChampionshipStanding{}},
+		},
+		{
+			name: "store error",
+			request: ChampionshipRequest{
+				DriverID: 12345,
+				From:     from,
+				To:       to,
+			},
+			storeCall:   storeCall{err: errors.New("database error")},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+
+			storeCall := tc.storeCall
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, tc.request.DriverID, tc.request.From, tc.request.To, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ int64, _, _ time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error) {
+					if storeCall.err != nil {
+						return nil, storeCall.err
+					}
+					sessions := storeCall.sessions
+					for _, f := range filters {
+						sessions = f(sessions)
+					}
+					return sessions, nil
+				})
+
+			svc := NewService(mockStore)
+			result, err := svc.GetChampionship(context.Background(), tc.request)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestWeekPoints(t *testing.T) {
+	assert.Equal(t, 50, weekPoints([]int{50}))
+	assert.Equal(t, 90, weekPoints([]int{20, 100, 40, 80, 60}))
+	assert.Equal(t, 75, weekPoints([]int{10, 75, 20, 30}))
+}
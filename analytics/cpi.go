@@ -0,0 +1,111 @@
+package analytics
+
+import (
+	"sort"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// CPIBreakdown decomposes CPI movement across the series and tracks it happened in.
+type CPIBreakdown struct {
+	// BySeries is ordered by CPIDelta ascending, so the series costing the most SR come first.
+	BySeries []CPIContribution
+	// ByTrack is ordered by CPILossPerCorner descending, so the tracks where the driver bleeds the most SR per
+	// corner come first. Tracks without corner data sort last.
+	ByTrack []CPIContribution
+}
+
+// CPIContribution is the CPI movement attributed to a single series or track.
+type CPIContribution struct {
+	SeriesID *int64
+	TrackID  *int64
+
+	RaceCount int
+	CPIGain   float64
+	CPILoss   float64
+	CPIDelta  float64
+
+	Corners   int
+	Incidents int
+	// CPILossPerCorner is zero when none of the races have corner data.
+	CPILossPerCorner float64
+}
+
+func computeCPIBreakdown(sessions []store.DriverSession) *CPIBreakdown {
+	bySeries := make(map[int64]*CPIContribution)
+	byTrack := make(map[int64]*CPIContribution)
+
+	for _, session := range sessions {
+		series, ok := bySeries[session.SeriesID]
+		if !ok {
+			id := session.SeriesID
+			series = &CPIContribution{SeriesID: &id}
+			bySeries[id] = series
+		}
+		addCPIContribution(series, session)
+
+		track, ok := byTrack[session.TrackID]
+		if !ok {
+			id := session.TrackID
+			track = &CPIContribution{TrackID: &id}
+			byTrack[id] = track
+		}
+		addCPIContribution(track, session)
+	}
+
+	breakdown := &CPIBreakdown{
+		BySeries: make([]CPIContribution, 0, len(bySeries)),
+		ByTrack:  make([]CPIContribution, 0, len(byTrack)),
+	}
+	for _, c := range bySeries {
+		breakdown.BySeries = append(breakdown.BySeries, finishCPIContribution(c))
+	}
+	for _, c := range byTrack {
+		breakdown.ByTrack = append(breakdown.ByTrack, finishCPIContribution(c))
+	}
+
+	// ties are broken by ID so the ordering is stable across requests
+	sort.Slice(breakdown.BySeries, func(i, j int) bool {
+		a, b := breakdown.BySeries[i], breakdown.BySeries[j]
+		if a.CPIDelta != b.CPIDelta {
+			return a.CPIDelta < b.CPIDelta
+		}
+		return *a.SeriesID < *b.SeriesID
+	})
+	sort.Slice(breakdown.ByTrack, func(i, j int) bool {
+		a, b := breakdown.ByTrack[i], breakdown.ByTrack[j]
+		if a.CPILossPerCorner != b.CPILossPerCorner {
+			return a.CPILossPerCorner > b.CPILossPerCorner
+		}
+		return *a.TrackID < *b.TrackID
+	})
+
+	return breakdown
+}
+
+func addCPIContribution(c *CPIContribution, session store.DriverSession) {
+	c.RaceCount++
+	c.Incidents += session.Incidents
+	c.Corners += cornersDriven(session)
+
+	delta := session.NewCPI - session.OldCPI
+	if delta > 0 {
+		c.CPIGain += delta
+	} else {
+		c.CPILoss += -delta // store as positive
+	}
+}
+
+func finishCPIContribution(c *CPIContribution) CPIContribution {
+	c.CPIDelta = c.CPIGain - c.CPILoss
+	if c.Corners > 0 {
+		c.CPILossPerCorner = c.CPILoss / float64(c.Corners)
+	}
+	return *c
+}
+
+// cornersDriven is the number of corners the driver completed in a session, zero if the session predates corner data
+// being recorded.
+func cornersDriven(session store.DriverSession) int {
+	return session.CornersPerLap * session.LapsComplete
+}
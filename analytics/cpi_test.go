@@ -0,0 +1,45 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeCPIBreakdown(t *testing.T) {
+	sessions := []store.DriverSession{
+		{SeriesID: 42, TrackID: 100, OldCPI: 3.0, NewCPI: 2.8, Incidents: 4, CornersPerLap: 10, LapsComplete: 20},
+		{SeriesID: 42, TrackID: 200, OldCPI: 2.8, NewCPI: 3.0, Incidents: 0, CornersPerLap: 15, LapsComplete: 20},
+		{SeriesID: 43, TrackID: 200, OldCPI: 3.0, NewCPI: 2.7, Incidents: 6, CornersPerLap: 15, LapsComplete: 10},
+		{SeriesID: 43, TrackID: 300, OldCPI: 2.7, NewCPI: 2.6, Incidents: 2}, // ingested before corners were recorded
+	}
+
+	result := computeCPIBreakdown(sessions)
+
+	require.Len(t, result.BySeries, 2)
+	assert.Equal(t, int64(43), *result.BySeries[0].SeriesID)
+	assert.Nil(t, result.BySeries[0].TrackID)
+	assert.Equal(t, 2, result.BySeries[0].RaceCount)
+	assert.InDelta(t, 0, result.BySeries[0].CPIGain, 0.001)
+	assert.InDelta(t, 0.4, result.BySeries[0].CPILoss, 0.001)
+	assert.InDelta(t, -0.4, result.BySeries[0].CPIDelta, 0.001)
+	assert.Equal(t, 150, result.BySeries[0].Corners)
+	assert.Equal(t, 8, result.BySeries[0].Incidents)
+	assert.Equal(t, int64(42), *result.BySeries[1].SeriesID)
+	assert.InDelta(t, 0.2, result.BySeries[1].CPIGain, 0.001)
+	assert.InDelta(t, 0.2, result.BySeries[1].CPILoss, 0.001)
+	assert.InDelta(t, 0, result.BySeries[1].CPIDelta, 0.001)
+
+	require.Len(t, result.ByTrack, 3)
+	// track 100: 0.2 lost over 200 corners, track 200: 0.3 lost over 450 corners, track 300 has no corner data
+	assert.Equal(t, int64(100), *result.ByTrack[0].TrackID)
+	assert.Nil(t, result.ByTrack[0].SeriesID)
+	assert.InDelta(t, 0.001, result.ByTrack[0].CPILossPerCorner, 0.00001)
+	assert.Equal(t, int64(200), *result.ByTrack[1].TrackID)
+	assert.Equal(t, 450, result.ByTrack[1].Corners)
+	assert.InDelta(t, 0.3/450, result.ByTrack[1].CPILossPerCorner, 0.00001)
+	assert.Equal(t, int64(300), *result.ByTrack[2].TrackID)
+	assert.InDelta(t, 0, result.ByTrack[2].CPILossPerCorner, 0)
+}
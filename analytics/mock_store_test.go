@@ -39,6 +39,89 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
+// GetDriver provides a mock function for the type MockStore
+func (_mock *MockStore) GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDriver")
+	}
+
+	var r0 *store.Driver
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) (*store.Driver, error)); ok {
+		return returnFunc(ctx, driverID, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) *store.Driver); ok {
+		r0 = returnFunc(ctx, driverID, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.Driver)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetDriver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDriver'
+type MockStore_GetDriver_Call struct {
+	*mock.Call
+}
+
+// GetDriver is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - opts ...store.ReadOption
+func (_e *MockStore_Expecter) GetDriver(ctx interface{}, driverID interface{}, opts ...interface{}) *MockStore_GetDriver_Call {
+	return &MockStore_GetDriver_Call{Call: _e.mock.On("GetDriver",
+		append([]interface{}{ctx, driverID}, opts...)...)}
+}
+
+func (_c *MockStore_GetDriver_Call) Run(run func(ctx context.Context, driverID int64, opts ...store.ReadOption)) *MockStore_GetDriver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]store.ReadOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDriver_Call) Return(driver *store.Driver, err error) *MockStore_GetDriver_Call {
+	_c.Call.Return(driver, err)
+	return _c
+}
+
+func (_c *MockStore_GetDriver_Call) RunAndReturn(run func(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)) *MockStore_GetDriver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDriverSessionsByTimeRange provides a mock function for the type MockStore
 func (_mock *MockStore) GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from time.Time, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error) {
 	var tmpRet mock.Arguments
@@ -133,3 +216,151 @@ func (_c *MockStore_GetDriverSessionsByTimeRange_Call) RunAndReturn(run func(ctx
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetDriverSettings provides a mock function for the type MockStore
+func (_mock *MockStore) GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error) {
+	ret := _mock.Called(ctx, driverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDriverSettings")
+	}
+
+	var r0 *store.DriverSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*store.DriverSettings, error)); ok {
+		return returnFunc(ctx, driverID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *store.DriverSettings); ok {
+		r0 = returnFunc(ctx, driverID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.DriverSettings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, driverID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetDriverSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDriverSettings'
+type MockStore_GetDriverSettings_Call struct {
+	*mock.Call
+}
+
+// GetDriverSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+func (_e *MockStore_Expecter) GetDriverSettings(ctx interface{}, driverID interface{}) *MockStore_GetDriverSettings_Call {
+	return &MockStore_GetDriverSettings_Call{Call: _e.mock.On("GetDriverSettings", ctx, driverID)}
+}
+
+func (_c *MockStore_GetDriverSettings_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_GetDriverSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDriverSettings_Call) Return(driverSettings *store.DriverSettings, err error) *MockStore_GetDriverSettings_Call {
+	_c.Call.Return(driverSettings, err)
+	return _c
+}
+
+func (_c *MockStore_GetDriverSettings_Call) RunAndReturn(run func(ctx context.Context, driverID int64) (*store.DriverSettings, error)) *MockStore_GetDriverSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRegionWeeklyAggregates provides a mock function for the type MockStore
+func (_mock *MockStore) GetRegionWeeklyAggregates(ctx context.Context, clubID int, from time.Time, to time.Time) ([]store.RegionWeeklyAggregate, error) {
+	ret := _mock.Called(ctx, clubID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRegionWeeklyAggregates")
+	}
+
+	var r0 []store.RegionWeeklyAggregate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) ([]store.RegionWeeklyAggregate, error)); ok {
+		return returnFunc(ctx, clubID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) []store.RegionWeeklyAggregate); ok {
+		r0 = returnFunc(ctx, clubID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.RegionWeeklyAggregate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, clubID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetRegionWeeklyAggregates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRegionWeeklyAggregates'
+type MockStore_GetRegionWeeklyAggregates_Call struct {
+	*mock.Call
+}
+
+// GetRegionWeeklyAggregates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clubID int
+//   - from time.Time
+//   - to time.Time
+func (_e *MockStore_Expecter) GetRegionWeeklyAggregates(ctx interface{}, clubID interface{}, from interface{}, to interface{}) *MockStore_GetRegionWeeklyAggregates_Call {
+	return &MockStore_GetRegionWeeklyAggregates_Call{Call: _e.mock.On("GetRegionWeeklyAggregates", ctx, clubID, from, to)}
+}
+
+func (_c *MockStore_GetRegionWeeklyAggregates_Call) Run(run func(ctx context.Context, clubID int, from time.Time, to time.Time)) *MockStore_GetRegionWeeklyAggregates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRegionWeeklyAggregates_Call) Return(regionWeeklyAggregates []store.RegionWeeklyAggregate, err error) *MockStore_GetRegionWeeklyAggregates_Call {
+	_c.Call.Return(regionWeeklyAggregates, err)
+	return _c
+}
+
+func (_c *MockStore_GetRegionWeeklyAggregates_Call) RunAndReturn(run func(ctx context.Context, clubID int, from time.Time, to time.Time) ([]store.RegionWeeklyAggregate, error)) *MockStore_GetRegionWeeklyAggregates_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,130 @@
+package analytics
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+const (
+	// sofTolerance is how far (as a fraction of the requested SOF) a historical race's SOF may be from the
+	// requested SOF and still be considered similar conditions.
+	sofTolerance = 0.2
+	// minPredictionSamples is the number of SOF-matched races needed before we trust them on their own. With
+	// fewer than this we fall back to every race matching the series/car/track filters.
+	minPredictionSamples = 3
+)
+
+// PredictionRequest contains the parameters for a race prediction.
+type PredictionRequest struct {
+	DriverID        int64
+	From            time.Time
+	To              time.Time
+	SeriesIDs       []int64
+	CarIDs          []int64
+	TrackIDs        []int64
+	StrengthOfField int // zero means don't match on SOF
+}
+
+// Prediction is the expected outcome of a race based on the driver's history in similar conditions.
+// Positions are 0-based, matching the rest of the analytics data.
+type Prediction struct {
+	SampleSize int
+	// SOFMatched indicates the prediction was computed only from races with a similar SOF. When false either no SOF
+	// was requested, or there were too few similar races and all races matching the filters were used instead.
+	SOFMatched bool
+
+	ExpectedFinish  float64
+	FinishRangeLow  int // 25th percentile finish position
+	FinishRangeHigh int // 75th percentile finish position
+
+	// IncidentProbability is the fraction of races where the driver picked up at least one incident.
+	IncidentProbability float64
+	AvgIncidents        float64
+}
+
+// PredictRace predicts the driver's finish range and incident probability from historical races in similar conditions.
+func (s *Service) PredictRace(ctx context.Context, req PredictionRequest) (*Prediction, error) {
+	var filters []store.SessionFilter
+	if len(req.SeriesIDs) > 0 {
+		filters = append(filters, store.FilterBySeriesIDs(req.SeriesIDs))
+	}
+	if len(req.CarIDs) > 0 {
+		filters = append(filters, store.FilterByCarIDs(req.CarIDs))
+	}
+	if len(req.TrackIDs) > 0 {
+		filters = append(filters, store.FilterByTrackIDs(req.TrackIDs))
+	}
+
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, req.DriverID, req.From, req.To, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StrengthOfField > 0 {
+		similar := filterBySimilarSOF(sessions, req.StrengthOfField)
+		if len(similar) >= minPredictionSamples {
+			prediction := computePrediction(similar)
+			prediction.SOFMatched = true
+			return &prediction, nil
+		}
+	}
+
+	prediction := computePrediction(sessions)
+	return &prediction, nil
+}
+
+func filterBySimilarSOF(sessions []store.DriverSession, sof int) []store.DriverSession {
+	tolerance := float64(sof) * sofTolerance
+	var result []store.DriverSession
+	for _, session := range sessions {
+		// sessions ingested before SOF was recorded can't be compared
+		if session.StrengthOfField == 0 {
+			continue
+		}
+		if math.Abs(float64(session.StrengthOfField-sof)) <= tolerance {
+			result = append(result, session)
+		}
+	}
+	return result
+}
+
+func computePrediction(sessions []store.DriverSession) Prediction {
+	if len(sessions) == 0 {
+		return Prediction{}
+	}
+
+	positions := make([]int, len(sessions))
+	var totalFinishPos, totalIncidents, racesWithIncidents int
+	for i, session := range sessions {
+		positions[i] = session.FinishPosition
+		totalFinishPos += session.FinishPosition
+		totalIncidents += session.Incidents
+		if session.Incidents > 0 {
+			racesWithIncidents++
+		}
+	}
+	sort.Ints(positions)
+
+	count := float64(len(sessions))
+	return Prediction{
+		SampleSize:          len(sessions),
+		ExpectedFinish:      float64(totalFinishPos) / count,
+		FinishRangeLow:      percentile(positions, 0.25),
+		FinishRangeHigh:     percentile(positions, 0.75),
+		IncidentProbability: float64(racesWithIncidents) / count,
+		AvgIncidents:        float64(totalIncidents) / count,
+	}
+}
+
+// percentile returns the nearest-rank percentile of an already sorted slice.
+func percentile(sorted []int, p float64) int {
+	idx := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
@@ -0,0 +1,152 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_PredictRace(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	testSessions := []store.DriverSession{
+		{SeriesID: 42, TrackID: 100, FinishPosition: 2, Incidents: 0, StrengthOfField: 1500},
+		{SeriesID: 42, TrackID: 100, FinishPosition: 4, Incidents: 2, StrengthOfField: 1600},
+		{SeriesID: 42, TrackID: 100, FinishPosition: 6, Incidents: 4, StrengthOfField: 1550},
+		{SeriesID: 42, TrackID: 100, FinishPosition: 10, Incidents: 0, StrengthOfField: 3000},
+		{SeriesID: 42, TrackID: 100, FinishPosition: 1, Incidents: 1}, // ingested before SOF was recorded
+		{SeriesID: 43, TrackID: 100, FinishPosition: 0, Incidents: 0, StrengthOfField: 1500},
+	}
+
+	type storeCall struct {
+		sessions []store.DriverSession
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		request   PredictionRequest
+		storeCall storeCall
+
+		expected    *Prediction
+		expectedErr error
+	}{
+		{
+			name: "sof matched",
+			request: PredictionRequest{
+				DriverID:        12345,
+				From:            from,
+				To:              to,
+				SeriesIDs:       []int64{42},
+				StrengthOfField: 1500,
+			},
+			storeCall: storeCall{sessions: testSessions},
+			expected: &Prediction{
+				SampleSize:          3,
+				SOFMatched:          true,
+				ExpectedFinish:      4,
+				FinishRangeLow:      2,
+				FinishRangeHigh:     6,
+				IncidentProbability: 2.0 / 3,
+				AvgIncidents:        2,
+			},
+		},
+		{
+			name: "too few sof matches falls back to all filtered races",
+			request: PredictionRequest{
+				DriverID:        12345,
+				From:            from,
+				To:              to,
+				SeriesIDs:       []int64{42},
+				StrengthOfField: 3000,
+			},
+			storeCall: storeCall{sessions: testSessions},
+			expected: &Prediction{
+				SampleSize:          5,
+				SOFMatched:          false,
+				ExpectedFinish:      23.0 / 5,
+				FinishRangeLow:      2,
+				FinishRangeHigh:     6,
+				IncidentProbability: 3.0 / 5,
+				AvgIncidents:        7.0 / 5,
+			},
+		},
+		{
+			name: "no sof or filters",
+			request: PredictionRequest{
+				DriverID: 12345,
+				From:     from,
+				To:       to,
+			},
+			storeCall: storeCall{sessions: testSessions},
+			expected: &Prediction{
+				SampleSize:          6,
+				ExpectedFinish:      23.0 / 6,
+				FinishRangeLow:      1,
+				FinishRangeHigh:     6,
+				IncidentProbability: 3.0 / 6,
+				AvgIncidents:        7.0 / 6,
+			},
+		},
+		{
+			name: "no history",
+			request: PredictionRequest{
+				DriverID:        12345,
+				From:            from,
+				To:              to,
+				StrengthOfField: 1500,
+			},
+			storeCall: storeCall{sessions: []store.DriverSession{}},
+			expected:  &Prediction{},
+		},
+		{
+			name: "store error",
+			request: PredictionRequest{
+				DriverID: 12345,
+				From:     from,
+				To:       to,
+			},
+			storeCall:   storeCall{err: errors.New("database error")},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+
+			storeCall := tc.storeCall
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, tc.request.DriverID, tc.request.From, tc.request.To, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ int64, _, _ time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error) {
+					if storeCall.err != nil {
+						return nil, storeCall.err
+					}
+					sessions := storeCall.sessions
+					for _, f := range filters {
+						sessions = f(sessions)
+					}
+					return sessions, nil
+				})
+
+			svc := NewService(mockStore)
+			result, err := svc.PredictRace(context.Background(), tc.request)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
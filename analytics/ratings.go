@@ -0,0 +1,59 @@
+package analytics
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// LicenseCategories are iRacing's license categories, keyed by license category ID.
+var LicenseCategories = map[int]string{
+	1: "oval",
+	2: "road",
+	3: "dirt_oval",
+	4: "dirt_road",
+	5: "sports_car",
+	6: "formula_car",
+}
+
+// Rating is where a driver stands in a license category as of their last race in it.
+type Rating struct {
+	CategoryID   int
+	Category     string
+	IRating      int
+	LicenseLevel int
+	SubLevel     int
+	AsOf         time.Time
+}
+
+// LatestRatings takes each license category's ratings from the driver's latest race in it, ordered by category ID.
+// Races ingested before their category was recorded are skipped.
+func LatestRatings(sessions []store.DriverSession) []Rating {
+	latest := make(map[int]store.DriverSession)
+	for _, session := range sessions {
+		if _, known := LicenseCategories[session.LicenseCategoryID]; !known {
+			continue
+		}
+		if current, ok := latest[session.LicenseCategoryID]; !ok || session.StartTime.After(current.StartTime) {
+			latest[session.LicenseCategoryID] = session
+		}
+	}
+
+	ret := make([]Rating, 0, len(latest))
+	for categoryID, session := range latest {
+		ret = append(ret, Rating{
+			CategoryID:   categoryID,
+			Category:     LicenseCategories[categoryID],
+			IRating:      session.NewIRating,
+			LicenseLevel: session.NewLicenseLevel,
+			SubLevel:     session.NewSubLevel,
+			AsOf:         session.StartTime,
+		})
+	}
+	slices.SortFunc(ret, func(a, b Rating) int {
+		return cmp.Compare(a.CategoryID, b.CategoryID)
+	})
+	return ret
+}
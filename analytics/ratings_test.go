@@ -0,0 +1,25 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatestRatings(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	oldRoad := store.DriverSession{StartTime: now.Add(-72 * time.Hour), LicenseCategoryID: 5, NewIRating: 1500, NewLicenseLevel: 14, NewSubLevel: 250}
+	newRoad := store.DriverSession{StartTime: now.Add(-24 * time.Hour), LicenseCategoryID: 5, NewIRating: 1550, NewLicenseLevel: 15, NewSubLevel: 301}
+	oval := store.DriverSession{StartTime: now.Add(-48 * time.Hour), LicenseCategoryID: 1, NewIRating: 1200, NewLicenseLevel: 9, NewSubLevel: 420}
+	// ingested before categories were recorded, so it can't be placed
+	uncategorized := store.DriverSession{StartTime: now.Add(-2 * time.Hour), NewIRating: 9999}
+
+	assert.Equal(t, []Rating{
+		{CategoryID: 1, Category: "oval", IRating: 1200, LicenseLevel: 9, SubLevel: 420, AsOf: oval.StartTime},
+		{CategoryID: 5, Category: "sports_car", IRating: 1550, LicenseLevel: 15, SubLevel: 301, AsOf: newRoad.StartTime},
+	}, LatestRatings([]store.DriverSession{oldRoad, oval, uncategorized, newRoad}))
+
+	assert.Equal(t, []Rating{}, LatestRatings(nil))
+}
@@ -0,0 +1,104 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/rollup"
+	"github.com/jonsabados/saturdaysspinout/standings"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+const raceWeek = 7 * 24 * time.Hour
+
+// RegionComparisonRequest contains the parameters for comparing a driver against their region.
+type RegionComparisonRequest struct {
+	DriverID int64
+	From     time.Time
+	To       time.Time
+}
+
+// RegionStats are per race averages over a set of races. Finishing positions are overall, matching the rollups.
+type RegionStats struct {
+	Races             int
+	WinRate           float64
+	Top5Rate          float64
+	AvgIncidents      float64
+	AvgIRatingChange  float64
+	AvgSubLevelChange float64
+}
+
+// RegionComparison puts a driver's races alongside those of the other platform drivers in their iRacing club. Region
+// is nil when the driver has no club on record, or no week in the range had enough drivers in the club to be totaled.
+type RegionComparison struct {
+	ClubID   int
+	ClubName string
+	// Weeks is how many race weeks the region figures cover
+	Weeks  int
+	Driver RegionStats
+	Region *RegionStats
+}
+
+// GetRegionComparison compares the driver against the anonymized weekly aggregates of their club. Both sides cover the
+// whole race weeks touched by the range, and the driver's own races are taken back out of the aggregates so they
+// aren't compared against themselves.
+func (s *Service) GetRegionComparison(ctx context.Context, req RegionComparisonRequest) (*RegionComparison, error) {
+	driver, err := s.store.GetDriver(ctx, req.DriverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting driver: %w", err)
+	}
+
+	from := standings.WeekStart(req.From)
+	to := standings.WeekStart(req.To).Add(raceWeek - time.Second)
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, req.DriverID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("getting sessions: %w", err)
+	}
+
+	driverWeeks := make(map[int64]store.SessionTotals)
+	var driverTotals store.SessionTotals
+	for _, session := range sessions {
+		totals := rollup.TotalsForSession(session)
+		week := standings.WeekStart(session.StartTime).Unix()
+		driverWeeks[week] = driverWeeks[week].Add(totals)
+		driverTotals = driverTotals.Add(totals)
+	}
+
+	comparison := &RegionComparison{Driver: regionStats(driverTotals)}
+	if driver == nil || driver.ClubID == 0 {
+		return comparison, nil
+	}
+	comparison.ClubID = driver.ClubID
+	comparison.ClubName = driver.ClubName
+
+	aggregates, err := s.store.GetRegionWeeklyAggregates(ctx, driver.ClubID, from, standings.WeekStart(req.To))
+	if err != nil {
+		return nil, fmt.Errorf("getting region aggregates: %w", err)
+	}
+	var others store.SessionTotals
+	for _, aggregate := range aggregates {
+		others = others.Add(aggregate.SessionTotals.Sub(driverWeeks[aggregate.WeekStart.Unix()]))
+	}
+	comparison.Weeks = len(aggregates)
+	if others.Races > 0 {
+		region := regionStats(others)
+		comparison.Region = &region
+	}
+	return comparison, nil
+}
+
+func regionStats(totals store.SessionTotals) RegionStats {
+	if totals.Races == 0 {
+		return RegionStats{}
+	}
+	races := float64(totals.Races)
+	return RegionStats{
+		Races:             totals.Races,
+		WinRate:           float64(totals.Wins) / races,
+		Top5Rate:          float64(totals.Top5s) / races,
+		AvgIncidents:      float64(totals.Incidents) / races,
+		AvgIRatingChange:  float64(totals.IRatingChange) / races,
+		AvgSubLevelChange: float64(totals.SubLevelChange) / races,
+	}
+}
@@ -0,0 +1,135 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetRegionComparison(t *testing.T) {
+	// Thursday through the following Wednesday, touching the race weeks starting Tuesday 2026-01-06 and 2026-01-13
+	from := time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 14, 12, 0, 0, 0, time.UTC)
+	firstWeek := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+	lastWeek := time.Date(2026, 1, 13, 0, 0, 0, 0, time.UTC)
+	sessionsTo := time.Date(2026, 1, 19, 23, 59, 59, 0, time.UTC)
+
+	sessions := []store.DriverSession{
+		{StartTime: time.Date(2026, 1, 7, 20, 0, 0, 0, time.UTC), FinishPosition: 0, Incidents: 2, OldIRating: 2000, NewIRating: 2040},
+		{StartTime: time.Date(2026, 1, 14, 20, 0, 0, 0, time.UTC), FinishPosition: 6, Incidents: 4, OldIRating: 2040, NewIRating: 2020},
+	}
+	driverStats := RegionStats{Races: 2, WinRate: 0.5, Top5Rate: 0.5, AvgIncidents: 3, AvgIRatingChange: 10}
+
+	type driverCall struct {
+		result *store.Driver
+		err    error
+	}
+
+	type sessionsCall struct {
+		result []store.DriverSession
+		err    error
+	}
+
+	type aggregatesCall struct {
+		result []store.RegionWeeklyAggregate
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverCall     driverCall
+		sessionsCall   *sessionsCall
+		aggregatesCall *aggregatesCall
+
+		expected    *RegionComparison
+		expectedErr error
+	}{
+		{
+			name:         "driver's own races taken out of the region",
+			driverCall:   driverCall{result: &store.Driver{DriverID: 12345, ClubID: 7, ClubName: "Great Plains"}},
+			sessionsCall: &sessionsCall{result: sessions},
+			aggregatesCall: &aggregatesCall{result: []store.RegionWeeklyAggregate{
+				// the second week had too few drivers to be totaled
+				{ClubID: 7, WeekStart: firstWeek, Drivers: 5, SessionTotals: store.SessionTotals{Races: 11, Wins: 2, Podiums: 3, Top5s: 5, Incidents: 30, IRatingChange: 100, SubLevelChange: 50}},
+			}},
+			expected: &RegionComparison{
+				ClubID:   7,
+				ClubName: "Great Plains",
+				Weeks:    1,
+				Driver:   driverStats,
+				Region:   &RegionStats{Races: 10, WinRate: 1.0 / 10, Top5Rate: 4.0 / 10, AvgIncidents: 28.0 / 10, AvgIRatingChange: 60.0 / 10, AvgSubLevelChange: 50.0 / 10},
+			},
+		},
+		{
+			name:           "no region aggregates",
+			driverCall:     driverCall{result: &store.Driver{DriverID: 12345, ClubID: 7, ClubName: "Great Plains"}},
+			sessionsCall:   &sessionsCall{result: sessions},
+			aggregatesCall: &aggregatesCall{},
+			expected: &RegionComparison{
+				ClubID:   7,
+				ClubName: "Great Plains",
+				Driver:   driverStats,
+			},
+		},
+		{
+			name:         "driver without a club",
+			driverCall:   driverCall{result: &store.Driver{DriverID: 12345}},
+			sessionsCall: &sessionsCall{result: sessions},
+			expected:     &RegionComparison{Driver: driverStats},
+		},
+		{
+			name:        "driver lookup error",
+			driverCall:  driverCall{err: errors.New("database error")},
+			expectedErr: errors.New("getting driver: database error"),
+		},
+		{
+			name:         "sessions error",
+			driverCall:   driverCall{result: &store.Driver{DriverID: 12345, ClubID: 7}},
+			sessionsCall: &sessionsCall{err: errors.New("database error")},
+			expectedErr:  errors.New("getting sessions: database error"),
+		},
+		{
+			name:           "aggregates error",
+			driverCall:     driverCall{result: &store.Driver{DriverID: 12345, ClubID: 7}},
+			sessionsCall:   &sessionsCall{result: sessions},
+			aggregatesCall: &aggregatesCall{err: errors.New("database error")},
+			expectedErr:    errors.New("getting region aggregates: database error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetDriver(mock.Anything, int64(12345)).Return(tc.driverCall.result, tc.driverCall.err)
+			if tc.sessionsCall != nil {
+				mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, int64(12345), firstWeek, sessionsTo).
+					Return(tc.sessionsCall.result, tc.sessionsCall.err)
+			}
+			if tc.aggregatesCall != nil {
+				mockStore.EXPECT().GetRegionWeeklyAggregates(mock.Anything, 7, firstWeek, lastWeek).
+					Return(tc.aggregatesCall.result, tc.aggregatesCall.err)
+			}
+
+			service := NewService(mockStore)
+			result, err := service.GetRegionComparison(context.Background(), RegionComparisonRequest{
+				DriverID: 12345,
+				From:     from,
+				To:       to,
+			})
+
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -6,12 +6,16 @@ import (
 	"sort"
 	"time"
 
+	"github.com/jonsabados/saturdaysspinout/i18n"
 	"github.com/jonsabados/saturdaysspinout/store"
 )
 
 // Store defines the data access interface needed by the analytics service.
 type Store interface {
 	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	GetRegionWeeklyAggregates(ctx context.Context, clubID int, from, to time.Time) ([]store.RegionWeeklyAggregate, error)
+	GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error)
 }
 
 // Dimensions contains the unique series, cars, and tracks a driver has raced.
@@ -102,6 +106,24 @@ type Summary struct {
 	// Incidents
 	TotalIncidents int
 	AvgIncidents   float64
+
+	// Corners, only races with corner data contribute. CornersPerIncident treats incident free races as a single
+	// incident so the value stays finite.
+	TotalCorners       int
+	CornersPerIncident float64
+
+	// Traffic, only multiclass races with a traffic estimate contribute (10ths of milliseconds)
+	TrafficRaceCount int
+	TotalTrafficCost int
+	AvgTrafficCost   float64
+
+	// Outcomes, rates are fractions of RaceCount. Races without a reason out count towards none of them.
+	DNFs           int
+	DNFRate        float64
+	DQs            int
+	DQRate         float64
+	Disconnects    int
+	DisconnectRate float64
 }
 
 // GroupedSummary contains stats for a specific dimension grouping.
@@ -165,13 +187,20 @@ type AnalyticsRequest struct {
 	SeriesIDs   []int64
 	CarIDs      []int64
 	TrackIDs    []int64
+	// FinishedOnly leaves out races the driver didn't finish (DNFs, DQs and disconnects).
+	FinishedOnly bool
+	// TextSummary also describes the overall summary in words, in the driver's locale.
+	TextSummary bool
 }
 
 // AnalyticsResult contains the computed analytics.
 type AnalyticsResult struct {
-	Summary    Summary
-	GroupedBy  []GroupedSummary
-	TimeSeries []PeriodSummary
+	Summary      Summary
+	GroupedBy    []GroupedSummary
+	TimeSeries   []PeriodSummary
+	CPIBreakdown *CPIBreakdown
+	// TextSummary is only filled in when the request asked for it
+	TextSummary string
 }
 
 // GetAnalytics computes analytics for the given request.
@@ -186,6 +215,9 @@ func (s *Service) GetAnalytics(ctx context.Context, req AnalyticsRequest) (*Anal
 	if len(req.TrackIDs) > 0 {
 		filters = append(filters, store.FilterByTrackIDs(req.TrackIDs))
 	}
+	if req.FinishedOnly {
+		filters = append(filters, store.FilterFinished())
+	}
 
 	filtered, err := s.store.GetDriverSessionsByTimeRange(ctx, req.DriverID, req.From, req.To, filters...)
 	if err != nil {
@@ -200,6 +232,9 @@ func (s *Service) GetAnalytics(ctx context.Context, req AnalyticsRequest) (*Anal
 	result := &AnalyticsResult{
 		Summary: computeSummary(filtered),
 	}
+	if len(filtered) > 0 {
+		result.CPIBreakdown = computeCPIBreakdown(filtered)
+	}
 
 	// Compute grouped stats if groupBy specified
 	if len(req.GroupBy) > 0 {
@@ -211,6 +246,14 @@ func (s *Service) GetAnalytics(ctx context.Context, req AnalyticsRequest) (*Anal
 		result.TimeSeries = computeTimeSeries(filtered, req.Granularity)
 	}
 
+	if req.TextSummary {
+		settings, err := s.store.GetDriverSettings(ctx, req.DriverID)
+		if err != nil {
+			return nil, fmt.Errorf("getting settings: %w", err)
+		}
+		result.TextSummary = DescribeSummary(i18n.For(settings.Locale), result.Summary)
+	}
+
 	return result, nil
 }
 
@@ -229,6 +272,7 @@ func computeSummary(sessions []store.DriverSession) Summary {
 
 	var totalFinishPos, totalStartPos int
 	var positionsGainedSum int
+	var cornerRaceIncidents int
 
 	for _, session := range sessions {
 		// iRating changes
@@ -264,6 +308,28 @@ func computeSummary(sessions []store.DriverSession) Summary {
 
 		// Incidents
 		summary.TotalIncidents += session.Incidents
+
+		// Corners
+		if corners := cornersDriven(session); corners > 0 {
+			summary.TotalCorners += corners
+			cornerRaceIncidents += session.Incidents
+		}
+
+		// Traffic
+		if session.TrafficCost != nil {
+			summary.TrafficRaceCount++
+			summary.TotalTrafficCost += *session.TrafficCost
+		}
+
+		// Outcome
+		switch session.Outcome() {
+		case store.ReasonOutDNF:
+			summary.DNFs++
+		case store.ReasonOutDisqualified:
+			summary.DQs++
+		case store.ReasonOutDisconnected:
+			summary.Disconnects++
+		}
 	}
 
 	summary.IRatingDelta = summary.IRatingEnd - summary.IRatingStart
@@ -272,6 +338,15 @@ func computeSummary(sessions []store.DriverSession) Summary {
 	summary.AvgStartPosition = float64(totalStartPos) / float64(len(sessions))
 	summary.PositionsGained = float64(positionsGainedSum) / float64(len(sessions))
 	summary.AvgIncidents = float64(summary.TotalIncidents) / float64(len(sessions))
+	if summary.TotalCorners > 0 {
+		summary.CornersPerIncident = float64(summary.TotalCorners) / float64(max(cornerRaceIncidents, 1))
+	}
+	if summary.TrafficRaceCount > 0 {
+		summary.AvgTrafficCost = float64(summary.TotalTrafficCost) / float64(summary.TrafficRaceCount)
+	}
+	summary.DNFRate = float64(summary.DNFs) / float64(len(sessions))
+	summary.DQRate = float64(summary.DQs) / float64(len(sessions))
+	summary.DisconnectRate = float64(summary.Disconnects) / float64(len(sessions))
 
 	return summary
 }
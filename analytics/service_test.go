@@ -106,6 +106,7 @@ func TestService_GetAnalytics(t *testing.T) {
 			StartPosition:  5,
 			FinishPosition: 2,
 			Incidents:      2,
+			ReasonOutCode:  store.ReasonOutFinished,
 		},
 		{
 			SeriesID:       42,
@@ -119,6 +120,7 @@ func TestService_GetAnalytics(t *testing.T) {
 			StartPosition:  3,
 			FinishPosition: 8,
 			Incidents:      4,
+			ReasonOutCode:  store.ReasonOutDNF,
 		},
 		{
 			SeriesID:       43,
@@ -132,6 +134,7 @@ func TestService_GetAnalytics(t *testing.T) {
 			StartPosition:  10,
 			FinishPosition: 0, // 0-based: 0 = 1st place (win)
 			Incidents:      0,
+			ReasonOut:      "Running", // ingested before reason out codes were recorded
 		},
 	}
 
@@ -140,21 +143,28 @@ func TestService_GetAnalytics(t *testing.T) {
 		err      error
 	}
 
+	type settingsCall struct {
+		settings *store.DriverSettings
+		err      error
+	}
+
 	testCases := []struct {
 		name string
 
-		request   AnalyticsRequest
-		storeCall *storeCall
-
-		expectedRaceCount    int
-		expectedIRatingStart int
-		expectedIRatingEnd   int
-		expectedIRatingDelta int
-		expectedWins         int
-		expectedPodiums      int
-		expectedGroupCount   int
+		request      AnalyticsRequest
+		storeCall    *storeCall
+		settingsCall *settingsCall
+
+		expectedRaceCount       int
+		expectedIRatingStart    int
+		expectedIRatingEnd      int
+		expectedIRatingDelta    int
+		expectedWins            int
+		expectedPodiums         int
+		expectedGroupCount      int
 		expectedTimeSeriesCount int
-		expectedErr          error
+		expectedTextSummary     string
+		expectedErr             error
 	}{
 		{
 			name: "basic summary",
@@ -216,6 +226,22 @@ func TestService_GetAnalytics(t *testing.T) {
 			expectedIRatingStart: 1500,
 			expectedIRatingEnd:   1520,
 		},
+		{
+			name: "finished races only",
+			request: AnalyticsRequest{
+				DriverID:     12345,
+				From:         time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:           time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+				FinishedOnly: true,
+			},
+			storeCall: &storeCall{
+				sessions: testSessions,
+			},
+			expectedRaceCount:    2, // the DNF is left out
+			expectedIRatingStart: 1500,
+			expectedIRatingEnd:   1600,
+			expectedWins:         1,
+		},
 		{
 			name: "empty sessions",
 			request: AnalyticsRequest{
@@ -228,6 +254,56 @@ func TestService_GetAnalytics(t *testing.T) {
 			},
 			expectedRaceCount: 0,
 		},
+		{
+			name: "text summary",
+			request: AnalyticsRequest{
+				DriverID:    12345,
+				From:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:          time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+				TextSummary: true,
+			},
+			storeCall: &storeCall{
+				sessions: testSessions,
+			},
+			settingsCall: &settingsCall{
+				settings: &store.DriverSettings{DriverID: 12345},
+			},
+			expectedRaceCount:   3,
+			expectedTextSummary: "You raced 3 times. You gained 100 iRating, ending at 1600. You won once. You finished on the podium 2 times. On average you started P7 and finished P4.3. You averaged 2 incidents per race.",
+		},
+		{
+			name: "text summary in the driver's locale",
+			request: AnalyticsRequest{
+				DriverID:    12345,
+				From:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:          time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+				TextSummary: true,
+			},
+			storeCall: &storeCall{
+				sessions: []store.DriverSession{},
+			},
+			settingsCall: &settingsCall{
+				settings: &store.DriverSettings{DriverID: 12345, Locale: "es"},
+			},
+			expectedRaceCount:   0,
+			expectedTextSummary: "No corriste en este periodo.",
+		},
+		{
+			name: "text summary settings error",
+			request: AnalyticsRequest{
+				DriverID:    12345,
+				From:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:          time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+				TextSummary: true,
+			},
+			storeCall: &storeCall{
+				sessions: testSessions,
+			},
+			settingsCall: &settingsCall{
+				err: errors.New("settings error"),
+			},
+			expectedErr: errors.New("getting settings: settings error"),
+		},
 		{
 			name: "store error",
 			request: AnalyticsRequest{
@@ -260,6 +336,9 @@ func TestService_GetAnalytics(t *testing.T) {
 						return sessions, nil
 					})
 			}
+			if tc.settingsCall != nil {
+				mockStore.EXPECT().GetDriverSettings(mock.Anything, tc.request.DriverID).Return(tc.settingsCall.settings, tc.settingsCall.err)
+			}
 
 			svc := NewService(mockStore)
 			result, err := svc.GetAnalytics(context.Background(), tc.request)
@@ -272,6 +351,7 @@ func TestService_GetAnalytics(t *testing.T) {
 
 			require.NoError(t, err)
 			assert.Equal(t, tc.expectedRaceCount, result.Summary.RaceCount)
+			assert.Equal(t, tc.expectedTextSummary, result.TextSummary)
 
 			if tc.expectedIRatingStart != 0 {
 				assert.Equal(t, tc.expectedIRatingStart, result.Summary.IRatingStart)
@@ -299,6 +379,8 @@ func TestService_GetAnalytics(t *testing.T) {
 }
 
 func TestComputeSummary(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
 	testCases := []struct {
 		name     string
 		sessions []store.DriverSession
@@ -397,6 +479,60 @@ func TestComputeSummary(t *testing.T) {
 				PositionsGained:   -5.0,
 			},
 		},
+		{
+			name: "corners per incident ignores races without corner data",
+			sessions: []store.DriverSession{
+				{StartPosition: 5, FinishPosition: 5, Incidents: 4, CornersPerLap: 10, LapsComplete: 20},
+				{StartPosition: 5, FinishPosition: 5, Incidents: 0, CornersPerLap: 10, LapsComplete: 10},
+				{StartPosition: 5, FinishPosition: 5, Incidents: 8},
+			},
+			expected: Summary{
+				RaceCount:          3,
+				AvgFinishPosition:  5.0,
+				AvgStartPosition:   5.0,
+				TotalIncidents:     12,
+				AvgIncidents:       4.0,
+				TotalCorners:       300,
+				CornersPerIncident: 75,
+			},
+		},
+		{
+			name: "traffic only averaged over races with an estimate",
+			sessions: []store.DriverSession{
+				{StartPosition: 5, FinishPosition: 5, TrafficCost: intPtr(40000)},
+				{StartPosition: 5, FinishPosition: 5},
+				{StartPosition: 5, FinishPosition: 5, TrafficCost: intPtr(20000)},
+			},
+			expected: Summary{
+				RaceCount:         3,
+				AvgFinishPosition: 5.0,
+				AvgStartPosition:  5.0,
+				TrafficRaceCount:  2,
+				TotalTrafficCost:  60000,
+				AvgTrafficCost:    30000,
+			},
+		},
+		{
+			name: "outcome rates",
+			sessions: []store.DriverSession{
+				{StartPosition: 5, FinishPosition: 5, ReasonOutCode: store.ReasonOutFinished},
+				{StartPosition: 5, FinishPosition: 5, ReasonOutCode: store.ReasonOutDNF},
+				{StartPosition: 5, FinishPosition: 5, ReasonOut: "Retired"},
+				{StartPosition: 5, FinishPosition: 5, ReasonOutCode: store.ReasonOutDisqualified},
+				{StartPosition: 5, FinishPosition: 5, ReasonOutCode: store.ReasonOutDisconnected},
+			},
+			expected: Summary{
+				RaceCount:         5,
+				AvgFinishPosition: 5.0,
+				AvgStartPosition:  5.0,
+				DNFs:              2,
+				DNFRate:           0.4,
+				DQs:               1,
+				DQRate:            0.2,
+				Disconnects:       1,
+				DisconnectRate:    0.2,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -421,6 +557,17 @@ func TestComputeSummary(t *testing.T) {
 			assert.InDelta(t, tc.expected.PositionsGained, result.PositionsGained, 0.001)
 			assert.InDelta(t, tc.expected.TotalIncidents, result.TotalIncidents, 0)
 			assert.InDelta(t, tc.expected.AvgIncidents, result.AvgIncidents, 0.001)
+			assert.InDelta(t, tc.expected.TotalCorners, result.TotalCorners, 0)
+			assert.InDelta(t, tc.expected.CornersPerIncident, result.CornersPerIncident, 0.001)
+			assert.InDelta(t, tc.expected.TrafficRaceCount, result.TrafficRaceCount, 0)
+			assert.InDelta(t, tc.expected.TotalTrafficCost, result.TotalTrafficCost, 0)
+			assert.InDelta(t, tc.expected.AvgTrafficCost, result.AvgTrafficCost, 0.001)
+			assert.InDelta(t, tc.expected.DNFs, result.DNFs, 0)
+			assert.InDelta(t, tc.expected.DNFRate, result.DNFRate, 0.001)
+			assert.InDelta(t, tc.expected.DQs, result.DQs, 0)
+			assert.InDelta(t, tc.expected.DQRate, result.DQRate, 0.001)
+			assert.InDelta(t, tc.expected.Disconnects, result.Disconnects, 0)
+			assert.InDelta(t, tc.expected.DisconnectRate, result.DisconnectRate, 0.001)
 		})
 	}
 }
@@ -0,0 +1,219 @@
+package analytics
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+const (
+	// minSRSamples is the number of races with corner data needed before the driver's SR rates are trusted.
+	minSRSamples = 5
+	// maxSRScenarios caps the series offered as ways back to the target SR.
+	maxSRScenarios = 5
+	// PromotionSubLevel is the safety rating, as a sub level, at which iRacing promotes a driver to the next license
+	// class mid-season. Promotion resets the sub level, so the plan can't count races past it.
+	PromotionSubLevel = 400
+	// lastPromotableLicenseLevel is the top of B class. A class drivers aren't promoted on safety rating, Pro is by
+	// invitation.
+	lastPromotableLicenseLevel = 16
+)
+
+// ErrSRTargetCrossesLicense is returned when reaching the target safety rating would promote the driver to the next
+// license class on the way.
+var ErrSRTargetCrossesLicense = errors.New("target safety rating crosses a license promotion")
+
+// SRRecoveryRequest contains the parameters for planning a driver's way back to a safety rating. Ratings are kept per
+// license category, so the plan is for a single one. Sub levels are safety ratings times 100.
+type SRRecoveryRequest struct {
+	DriverID   int64
+	From       time.Time
+	To         time.Time
+	CategoryID int
+	// TargetSubLevel is within the driver's current license level. Targets at or past PromotionSubLevel are rejected
+	// with ErrSRTargetCrossesLicense for licenses that would be promoted on the way, targets below the driver's
+	// current rating take no races so never plan through a demotion.
+	TargetSubLevel int
+}
+
+// SRRecoveryPlan estimates how many races it would take the driver to get from their current safety rating to a
+// target. The estimate comes from a model fitted to the driver's own races in the category: SR gained per clean corner
+// and lost per incident. It is a linear approximation of iRacing's CPI based rating, good near the driver's current
+// CPI and rougher the further away the target is.
+type SRRecoveryPlan struct {
+	CategoryID      int
+	LicenseLevel    int
+	CurrentSubLevel int
+	CurrentCPI      float64
+	TargetSubLevel  int
+
+	// SampleSize is the number of races the model was fitted to. With fewer than minSRSamples, or when the fit doesn't
+	// have clean corners gaining SR, there are no scenarios.
+	SampleSize             int
+	SubLevelPerCleanCorner float64
+	SubLevelPerIncident    float64 // sub levels lost per incident, positive
+
+	// Scenarios are the series the driver raced in the category, quickest way back first.
+	Scenarios []SRRecoveryScenario
+}
+
+// SRRecoveryScenario is getting back to the target SR by racing a single series.
+type SRRecoveryScenario struct {
+	SeriesID int64
+	Races    int
+
+	CornersPerRace float64
+	// CornersPerIncident is the driver's historical rate in the series, incident free races count as a single incident
+	CornersPerIncident float64
+
+	// SubLevelPerCleanRace is the SR gained by an incident free race of the series' usual length, CleanRacesNeeded how
+	// many of them in a row it would take to reach the target
+	SubLevelPerCleanRace float64
+	CleanRacesNeeded     int
+	// SubLevelPerTypicalRace is the SR change of a race at the driver's usual incident rate in the series. Typical
+	// races that don't gain SR never reach the target, and TypicalRacesNeeded is nil.
+	SubLevelPerTypicalRace float64
+	TypicalRacesNeeded     *int
+}
+
+// GetSRRecoveryPlan estimates how many races in each series the driver has raced in the category it would take to
+// get from their current safety rating to the target, both racing clean and racing as they usually do. The driver's
+// current rating is taken from their latest race in the category, and the returned plan is nil if they haven't raced
+// in it over the requested range. Plans are within the driver's current license, see SRRecoveryRequest.TargetSubLevel.
+func (s *Service) GetSRRecoveryPlan(ctx context.Context, req SRRecoveryRequest) (*SRRecoveryPlan, error) {
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, req.DriverID, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	sessions = slices.DeleteFunc(sessions, func(session store.DriverSession) bool {
+		return session.LicenseCategoryID != req.CategoryID
+	})
+	if len(sessions) == 0 {
+		return nil, nil
+	}
+
+	latest := slices.MaxFunc(sessions, func(a, b store.DriverSession) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+	if req.TargetSubLevel >= PromotionSubLevel && latest.NewLicenseLevel <= lastPromotableLicenseLevel {
+		return nil, ErrSRTargetCrossesLicense
+	}
+	plan := &SRRecoveryPlan{
+		CategoryID:      req.CategoryID,
+		LicenseLevel:    latest.NewLicenseLevel,
+		CurrentSubLevel: latest.NewSubLevel,
+		CurrentCPI:      latest.NewCPI,
+		TargetSubLevel:  req.TargetSubLevel,
+		Scenarios:       []SRRecoveryScenario{},
+	}
+
+	// promotions and demotions reset the sub level, so races that changed license are no guide to SR per corner
+	samples := slices.DeleteFunc(slices.Clone(sessions), func(session store.DriverSession) bool {
+		return cornersDriven(session) == 0 || session.OldLicenseLevel != session.NewLicenseLevel
+	})
+	plan.SampleSize = len(samples)
+	if len(samples) < minSRSamples {
+		return plan, nil
+	}
+	perCorner, perIncident := fitSRRates(samples)
+	if perCorner <= 0 {
+		return plan, nil
+	}
+	plan.SubLevelPerCleanCorner = perCorner
+	plan.SubLevelPerIncident = perIncident
+
+	gap := float64(req.TargetSubLevel - latest.NewSubLevel)
+	for _, scenario := range seriesSRScenarios(samples, perCorner, perIncident) {
+		scenario.CleanRacesNeeded = racesNeeded(gap, scenario.SubLevelPerCleanRace)
+		if scenario.SubLevelPerTypicalRace > 0 || gap <= 0 {
+			needed := racesNeeded(gap, scenario.SubLevelPerTypicalRace)
+			scenario.TypicalRacesNeeded = &needed
+		}
+		plan.Scenarios = append(plan.Scenarios, scenario)
+	}
+
+	// ties are broken by series ID so the ordering is stable across requests
+	slices.SortFunc(plan.Scenarios, func(a, b SRRecoveryScenario) int {
+		if c := cmp.Compare(a.CleanRacesNeeded, b.CleanRacesNeeded); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.SeriesID, b.SeriesID)
+	})
+	if len(plan.Scenarios) > maxSRScenarios {
+		plan.Scenarios = plan.Scenarios[:maxSRScenarios]
+	}
+	return plan, nil
+}
+
+// fitSRRates fits each race's sub level change to perCorner*corners - perIncident*incidents by least squares. When
+// the races can't tell the two apart, typically because they were all clean, every race is put down to corners alone.
+func fitSRRates(sessions []store.DriverSession) (perCorner, perIncident float64) {
+	var cc, ci, ii, cd, id float64
+	for _, session := range sessions {
+		corners := float64(cornersDriven(session))
+		incidents := float64(session.Incidents)
+		delta := float64(session.NewSubLevel - session.OldSubLevel)
+		cc += corners * corners
+		ci += corners * incidents
+		ii += incidents * incidents
+		cd += corners * delta
+		id += incidents * delta
+	}
+
+	det := cc*ii - ci*ci
+	if math.Abs(det) < 1e-9*cc*max(ii, 1) {
+		return cd / cc, 0
+	}
+	perCorner = (cd*ii - ci*id) / det
+	// the fit is for delta = perCorner*corners + incidentCoefficient*incidents, which is negative when incidents cost SR
+	incidentCoefficient := (cc*id - ci*cd) / det
+	return perCorner, -incidentCoefficient
+}
+
+// seriesSRScenarios works out the SR change of clean and typical races in each series the driver raced.
+func seriesSRScenarios(sessions []store.DriverSession, perCorner, perIncident float64) []SRRecoveryScenario {
+	type seriesTotals struct {
+		races     int
+		corners   int
+		incidents int
+	}
+	bySeries := make(map[int64]*seriesTotals)
+	for _, session := range sessions {
+		totals, ok := bySeries[session.SeriesID]
+		if !ok {
+			totals = &seriesTotals{}
+			bySeries[session.SeriesID] = totals
+		}
+		totals.races++
+		totals.corners += cornersDriven(session)
+		totals.incidents += session.Incidents
+	}
+
+	ret := make([]SRRecoveryScenario, 0, len(bySeries))
+	for seriesID, totals := range bySeries {
+		cornersPerRace := float64(totals.corners) / float64(totals.races)
+		incidentsPerRace := float64(totals.incidents) / float64(totals.races)
+		ret = append(ret, SRRecoveryScenario{
+			SeriesID:               seriesID,
+			Races:                  totals.races,
+			CornersPerRace:         cornersPerRace,
+			CornersPerIncident:     float64(totals.corners) / float64(max(totals.incidents, 1)),
+			SubLevelPerCleanRace:   perCorner * cornersPerRace,
+			SubLevelPerTypicalRace: perCorner*cornersPerRace - perIncident*incidentsPerRace,
+		})
+	}
+	return ret
+}
+
+// racesNeeded is how many races gaining perRace sub levels each it takes to close gap, none when there is no gap.
+func racesNeeded(gap, perRace float64) int {
+	if gap <= 0 {
+		return 0
+	}
+	return int(math.Ceil(gap / perRace))
+}
@@ -0,0 +1,260 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetSRRecoveryPlan(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	// road races where every clean corner is worth 0.1 sub levels and every incident costs 2
+	race := func(hour int, seriesID int64, cornersPerLap, incidents, oldSubLevel int) store.DriverSession {
+		corners := cornersPerLap * 20
+		if seriesID == 43 {
+			corners = cornersPerLap * 10
+		}
+		return store.DriverSession{
+			SeriesID:          seriesID,
+			StartTime:         from.Add(time.Duration(hour) * time.Hour),
+			LicenseCategoryID: 2,
+			CornersPerLap:     cornersPerLap,
+			LapsComplete:      corners / cornersPerLap,
+			Incidents:         incidents,
+			OldLicenseLevel:   14,
+			NewLicenseLevel:   14,
+			OldSubLevel:       oldSubLevel,
+			NewSubLevel:       oldSubLevel + corners/10 - 2*incidents,
+			NewCPI:            40,
+		}
+	}
+	promoted := race(3, 42, 10, 0, 300)
+	promoted.NewLicenseLevel = 15
+	promoted.NewSubLevel = 200
+	noCorners := race(4, 42, 10, 0, 200)
+	noCorners.CornersPerLap = 0
+	oval := race(8, 42, 10, 0, 250)
+	oval.LicenseCategoryID = 1
+
+	testSessions := []store.DriverSession{
+		// series 42, 200 corners a race
+		race(0, 42, 10, 0, 220),
+		race(1, 42, 10, 2, 240),
+		race(2, 42, 10, 4, 256),
+		promoted,
+		noCorners,
+		// series 43, 120 corners a race
+		race(5, 43, 12, 0, 220),
+		race(6, 43, 12, 3, 232),
+		race(7, 43, 12, 9, 256), // leaves the driver at 2.50
+		oval,
+	}
+
+	aClassSessions := slices.Clone(testSessions[5:8])
+	for i := range aClassSessions {
+		aClassSessions[i].OldLicenseLevel = 17
+		aClassSessions[i].NewLicenseLevel = 17
+	}
+
+	intPtr := func(i int) *int { return &i }
+
+	testCases := []struct {
+		name string
+
+		request     SRRecoveryRequest
+		sessions    []store.DriverSession
+		storeErr    error
+		expected    *SRRecoveryPlan
+		expectedErr error
+	}{
+		{
+			name:     "scenarios",
+			request:  SRRecoveryRequest{DriverID: 12345, From: from, To: to, CategoryID: 2, TargetSubLevel: 300},
+			sessions: testSessions,
+			expected: &SRRecoveryPlan{
+				CategoryID:             2,
+				LicenseLevel:           14,
+				CurrentSubLevel:        250,
+				CurrentCPI:             40,
+				TargetSubLevel:         300,
+				SampleSize:             6,
+				SubLevelPerCleanCorner: 0.1,
+				SubLevelPerIncident:    2,
+				Scenarios: []SRRecoveryScenario{
+					{
+						SeriesID:               42,
+						Races:                  3,
+						CornersPerRace:         200,
+						CornersPerIncident:     100,
+						SubLevelPerCleanRace:   20,
+						CleanRacesNeeded:       3,
+						SubLevelPerTypicalRace: 16,
+						TypicalRacesNeeded:     intPtr(4),
+					},
+					{
+						SeriesID:               43,
+						Races:                  3,
+						CornersPerRace:         120,
+						CornersPerIncident:     30,
+						SubLevelPerCleanRace:   12,
+						CleanRacesNeeded:       5,
+						SubLevelPerTypicalRace: 4,
+						TypicalRacesNeeded:     intPtr(13),
+					},
+				},
+			},
+		},
+		{
+			name:     "already at target",
+			request:  SRRecoveryRequest{DriverID: 12345, From: from, To: to, CategoryID: 2, TargetSubLevel: 240},
+			sessions: testSessions,
+			expected: &SRRecoveryPlan{
+				CategoryID:             2,
+				LicenseLevel:           14,
+				CurrentSubLevel:        250,
+				CurrentCPI:             40,
+				TargetSubLevel:         240,
+				SampleSize:             6,
+				SubLevelPerCleanCorner: 0.1,
+				SubLevelPerIncident:    2,
+				Scenarios: []SRRecoveryScenario{
+					{SeriesID: 42, Races: 3, CornersPerRace: 200, CornersPerIncident: 100, SubLevelPerCleanRace: 20, SubLevelPerTypicalRace: 16, TypicalRacesNeeded: intPtr(0)},
+					{SeriesID: 43, Races: 3, CornersPerRace: 120, CornersPerIncident: 30, SubLevelPerCleanRace: 12, SubLevelPerTypicalRace: 4, TypicalRacesNeeded: intPtr(0)},
+				},
+			},
+		},
+		{
+			name:     "too few races to fit",
+			request:  SRRecoveryRequest{DriverID: 12345, From: from, To: to, CategoryID: 2, TargetSubLevel: 300},
+			sessions: testSessions[5:8],
+			expected: &SRRecoveryPlan{
+				CategoryID:      2,
+				LicenseLevel:    14,
+				CurrentSubLevel: 250,
+				CurrentCPI:      40,
+				TargetSubLevel:  300,
+				SampleSize:      3,
+				Scenarios:       []SRRecoveryScenario{},
+			},
+		},
+		{
+			name:    "all clean races are put down to corners",
+			request: SRRecoveryRequest{DriverID: 12345, From: from, To: to, CategoryID: 2, TargetSubLevel: 300},
+			sessions: []store.DriverSession{
+				race(0, 42, 10, 0, 200),
+				race(1, 42, 10, 0, 220),
+				race(2, 42, 10, 0, 240),
+				race(3, 43, 12, 0, 260),
+				race(4, 43, 12, 0, 272),
+			},
+			expected: &SRRecoveryPlan{
+				CategoryID:             2,
+				LicenseLevel:           14,
+				CurrentSubLevel:        284,
+				CurrentCPI:             40,
+				TargetSubLevel:         300,
+				SampleSize:             5,
+				SubLevelPerCleanCorner: 0.1,
+				Scenarios: []SRRecoveryScenario{
+					{
+						SeriesID:               42,
+						Races:                  3,
+						CornersPerRace:         200,
+						CornersPerIncident:     600,
+						SubLevelPerCleanRace:   20,
+						CleanRacesNeeded:       1,
+						SubLevelPerTypicalRace: 20,
+						TypicalRacesNeeded:     intPtr(1),
+					},
+					{
+						SeriesID:               43,
+						Races:                  2,
+						CornersPerRace:         120,
+						CornersPerIncident:     240,
+						SubLevelPerCleanRace:   12,
+						CleanRacesNeeded:       2,
+						SubLevelPerTypicalRace: 12,
+						TypicalRacesNeeded:     intPtr(2),
+					},
+				},
+			},
+		},
+		{
+			name:        "target past a promotion",
+			request:     SRRecoveryRequest{DriverID: 12345, From: from, To: to, CategoryID: 2, TargetSubLevel: 400},
+			sessions:    testSessions,
+			expectedErr: ErrSRTargetCrossesLicense,
+		},
+		{
+			name:     "A class isn't promoted on safety rating",
+			request:  SRRecoveryRequest{DriverID: 12345, From: from, To: to, CategoryID: 2, TargetSubLevel: 400},
+			sessions: aClassSessions,
+			expected: &SRRecoveryPlan{
+				CategoryID:      2,
+				LicenseLevel:    17,
+				CurrentSubLevel: 250,
+				CurrentCPI:      40,
+				TargetSubLevel:  400,
+				SampleSize:      3,
+				Scenarios:       []SRRecoveryScenario{},
+			},
+		},
+		{
+			name:     "not raced in category",
+			request:  SRRecoveryRequest{DriverID: 12345, From: from, To: to, CategoryID: 5, TargetSubLevel: 300},
+			sessions: testSessions,
+		},
+		{
+			name:        "store error",
+			request:     SRRecoveryRequest{DriverID: 12345, From: from, To: to, CategoryID: 2, TargetSubLevel: 300},
+			storeErr:    errors.New("database error"),
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, tc.request.DriverID, tc.request.From, tc.request.To).
+				Return(tc.sessions, tc.storeErr)
+
+			svc := NewService(mockStore)
+			result, err := svc.GetSRRecoveryPlan(context.Background(), tc.request)
+
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			if tc.expected == nil {
+				assert.Nil(t, result)
+				return
+			}
+			require.NotNil(t, result)
+			// the rates come out of a least squares fit, so only close to what the races were built from
+			assert.InDelta(t, tc.expected.SubLevelPerCleanCorner, result.SubLevelPerCleanCorner, 1e-9)
+			assert.InDelta(t, tc.expected.SubLevelPerIncident, result.SubLevelPerIncident, 1e-9)
+			require.Len(t, result.Scenarios, len(tc.expected.Scenarios))
+			for i, scenario := range tc.expected.Scenarios {
+				assert.InDelta(t, scenario.SubLevelPerCleanRace, result.Scenarios[i].SubLevelPerCleanRace, 1e-6)
+				assert.InDelta(t, scenario.SubLevelPerTypicalRace, result.Scenarios[i].SubLevelPerTypicalRace, 1e-6)
+				result.Scenarios[i].SubLevelPerCleanRace = scenario.SubLevelPerCleanRace
+				result.Scenarios[i].SubLevelPerTypicalRace = scenario.SubLevelPerTypicalRace
+			}
+			result.SubLevelPerCleanCorner = tc.expected.SubLevelPerCleanCorner
+			result.SubLevelPerIncident = tc.expected.SubLevelPerIncident
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
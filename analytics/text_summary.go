@@ -0,0 +1,55 @@
+package analytics
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jonsabados/saturdaysspinout/i18n"
+)
+
+// DescribeSummary writes a summary out as a few plain sentences, for screen readers and anywhere else the numbers
+// need to be read rather than charted. Generating it here keeps the phrasing the same wherever it shows up.
+func DescribeSummary(localizer i18n.Localizer, summary Summary) string {
+	if summary.RaceCount == 0 {
+		return localizer.Text(i18n.KeyAnalyticsNoRaces, nil)
+	}
+
+	sentences := []string{localizer.Plural(i18n.KeyAnalyticsRaces, summary.RaceCount, nil)}
+
+	iRating := map[string]string{
+		"delta": strconv.Itoa(absInt(summary.IRatingDelta)),
+		"end":   strconv.Itoa(summary.IRatingEnd),
+	}
+	if summary.IRatingDelta > 0 {
+		sentences = append(sentences, localizer.Text(i18n.KeyAnalyticsIRatingGained, iRating))
+	} else if summary.IRatingDelta < 0 {
+		sentences = append(sentences, localizer.Text(i18n.KeyAnalyticsIRatingLost, iRating))
+	} else {
+		sentences = append(sentences, localizer.Text(i18n.KeyAnalyticsIRatingHeld, iRating))
+	}
+
+	if summary.Wins > 0 {
+		sentences = append(sentences, localizer.Plural(i18n.KeyAnalyticsWins, summary.Wins, nil))
+	}
+	if summary.Podiums > 0 {
+		sentences = append(sentences, localizer.Plural(i18n.KeyAnalyticsPodiums, summary.Podiums, nil))
+	}
+
+	// positions are 0 based in the summary, but P1 is what anyone would say
+	sentences = append(sentences, localizer.Text(i18n.KeyAnalyticsPositions, map[string]string{
+		"start":  localizer.Number(summary.AvgStartPosition+1, 1),
+		"finish": localizer.Number(summary.AvgFinishPosition+1, 1),
+	}))
+	sentences = append(sentences, localizer.Text(i18n.KeyAnalyticsIncidents, map[string]string{
+		"incidents": localizer.Number(summary.AvgIncidents, 1),
+	}))
+
+	return strings.Join(sentences, " ")
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,143 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// Bands of the day races are grouped into, in the driver's local time
+const (
+	TimeOfDayMorning   = "morning"    // 06:00 to noon
+	TimeOfDayAfternoon = "afternoon"  // noon to 18:00
+	TimeOfDayEvening   = "evening"    // 18:00 to 22:00
+	TimeOfDayLateNight = "late_night" // 22:00 to 06:00
+)
+
+var timeOfDayBands = []string{TimeOfDayMorning, TimeOfDayAfternoon, TimeOfDayEvening, TimeOfDayLateNight}
+
+// daysOfWeek starts the week on Monday, the way most drivers' weeks go
+var daysOfWeek = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday}
+
+// TimeOfDayRequest contains the parameters for breaking a driver's races down by when they were run.
+type TimeOfDayRequest struct {
+	DriverID int64
+	From     time.Time
+	To       time.Time
+}
+
+// TimeStats are per race averages for the races run in a slice of the day or week.
+type TimeStats struct {
+	Races             int
+	AvgFinishPosition float64
+	AvgIncidents      float64
+	AvgIRatingChange  float64
+}
+
+type TimeOfDayStats struct {
+	Band  string
+	Stats TimeStats
+}
+
+type DayOfWeekStats struct {
+	Day   time.Weekday
+	Stats TimeStats
+}
+
+// TimeOfDayProfile is how a driver does depending on when they race. Every band and day is present, with zero races
+// when the driver didn't race then, so Overall is there to compare against.
+type TimeOfDayProfile struct {
+	// Timezone is the IANA name of the timezone races were placed in
+	Timezone   string
+	Overall    TimeStats
+	TimesOfDay []TimeOfDayStats
+	DaysOfWeek []DayOfWeekStats
+}
+
+// GetTimeOfDayProfile groups the driver's races by local time of day and day of week using the timezone from their
+// settings, falling back on UTC when they haven't set one.
+func (s *Service) GetTimeOfDayProfile(ctx context.Context, req TimeOfDayRequest) (*TimeOfDayProfile, error) {
+	settings, err := s.store.GetDriverSettings(ctx, req.DriverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting settings: %w", err)
+	}
+	location := time.UTC
+	if settings.Timezone != "" {
+		// timezones are checked when settings are saved, but the tz database does retire names now and then
+		if loaded, err := time.LoadLocation(settings.Timezone); err == nil {
+			location = loaded
+		}
+	}
+
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, req.DriverID, req.From, req.To)
+	if err != nil {
+		return nil, fmt.Errorf("getting sessions: %w", err)
+	}
+
+	var overall timeTotals
+	bands := make(map[string]timeTotals)
+	days := make(map[time.Weekday]timeTotals)
+	for _, session := range sessions {
+		local := session.StartTime.In(location)
+		band := timeOfDayBand(local.Hour())
+		overall = overall.add(session)
+		bands[band] = bands[band].add(session)
+		days[local.Weekday()] = days[local.Weekday()].add(session)
+	}
+
+	profile := &TimeOfDayProfile{
+		Timezone: location.String(),
+		Overall:  overall.stats(),
+	}
+	for _, band := range timeOfDayBands {
+		profile.TimesOfDay = append(profile.TimesOfDay, TimeOfDayStats{Band: band, Stats: bands[band].stats()})
+	}
+	for _, day := range daysOfWeek {
+		profile.DaysOfWeek = append(profile.DaysOfWeek, DayOfWeekStats{Day: day, Stats: days[day].stats()})
+	}
+	return profile, nil
+}
+
+func timeOfDayBand(hour int) string {
+	switch {
+	case hour >= 6 && hour < 12:
+		return TimeOfDayMorning
+	case hour >= 12 && hour < 18:
+		return TimeOfDayAfternoon
+	case hour >= 18 && hour < 22:
+		return TimeOfDayEvening
+	default:
+		return TimeOfDayLateNight
+	}
+}
+
+type timeTotals struct {
+	races          int
+	finishPosition int
+	incidents      int
+	iRatingChange  int
+}
+
+func (t timeTotals) add(session store.DriverSession) timeTotals {
+	return timeTotals{
+		races:          t.races + 1,
+		finishPosition: t.finishPosition + session.FinishPosition,
+		incidents:      t.incidents + session.Incidents,
+		iRatingChange:  t.iRatingChange + session.NewIRating - session.OldIRating,
+	}
+}
+
+func (t timeTotals) stats() TimeStats {
+	if t.races == 0 {
+		return TimeStats{}
+	}
+	races := float64(t.races)
+	return TimeStats{
+		Races:             t.races,
+		AvgFinishPosition: float64(t.finishPosition) / races,
+		AvgIncidents:      float64(t.incidents) / races,
+		AvgIRatingChange:  float64(t.iRatingChange) / races,
+	}
+}
@@ -0,0 +1,137 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetTimeOfDayProfile(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	race := func(start time.Time, finish, incidents, iRatingChange int) store.DriverSession {
+		return store.DriverSession{StartTime: start, FinishPosition: finish, Incidents: incidents, OldIRating: 2000, NewIRating: 2000 + iRatingChange}
+	}
+	sessions := []store.DriverSession{
+		race(time.Date(2026, 1, 10, 4, 30, 0, 0, time.UTC), 10, 8, -40), // Friday 22:30 in Chicago
+		race(time.Date(2026, 1, 10, 5, 0, 0, 0, time.UTC), 8, 6, -20),   // Friday 23:00 in Chicago
+		race(time.Date(2026, 1, 10, 20, 0, 0, 0, time.UTC), 2, 0, 30),   // Saturday 14:00 in Chicago
+		race(time.Date(2026, 1, 11, 1, 0, 0, 0, time.UTC), 4, 2, 10),    // Saturday 19:00 in Chicago
+	}
+	overall := TimeStats{Races: 4, AvgFinishPosition: 6, AvgIncidents: 4, AvgIRatingChange: -5}
+	inUTC := &TimeOfDayProfile{
+		Timezone: "UTC",
+		Overall:  overall,
+		TimesOfDay: []TimeOfDayStats{
+			{Band: TimeOfDayMorning},
+			{Band: TimeOfDayAfternoon},
+			{Band: TimeOfDayEvening, Stats: TimeStats{Races: 1, AvgFinishPosition: 2, AvgIncidents: 0, AvgIRatingChange: 30}},
+			{Band: TimeOfDayLateNight, Stats: TimeStats{Races: 3, AvgFinishPosition: 22.0 / 3, AvgIncidents: 16.0 / 3, AvgIRatingChange: -50.0 / 3}},
+		},
+		DaysOfWeek: []DayOfWeekStats{
+			{Day: time.Monday},
+			{Day: time.Tuesday},
+			{Day: time.Wednesday},
+			{Day: time.Thursday},
+			{Day: time.Friday},
+			{Day: time.Saturday, Stats: TimeStats{Races: 3, AvgFinishPosition: 20.0 / 3, AvgIncidents: 14.0 / 3, AvgIRatingChange: -10}},
+			{Day: time.Sunday, Stats: TimeStats{Races: 1, AvgFinishPosition: 4, AvgIncidents: 2, AvgIRatingChange: 10}},
+		},
+	}
+
+	type sessionsCall struct {
+		result []store.DriverSession
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		settings     *store.DriverSettings
+		settingsErr  error
+		sessionsCall *sessionsCall
+
+		expected    *TimeOfDayProfile
+		expectedErr error
+	}{
+		{
+			name:         "driver's timezone",
+			settings:     &store.DriverSettings{DriverID: 12345, Timezone: "America/Chicago"},
+			sessionsCall: &sessionsCall{result: sessions},
+			expected: &TimeOfDayProfile{
+				Timezone: "America/Chicago",
+				Overall:  overall,
+				TimesOfDay: []TimeOfDayStats{
+					{Band: TimeOfDayMorning},
+					{Band: TimeOfDayAfternoon, Stats: TimeStats{Races: 1, AvgFinishPosition: 2, AvgIncidents: 0, AvgIRatingChange: 30}},
+					{Band: TimeOfDayEvening, Stats: TimeStats{Races: 1, AvgFinishPosition: 4, AvgIncidents: 2, AvgIRatingChange: 10}},
+					{Band: TimeOfDayLateNight, Stats: TimeStats{Races: 2, AvgFinishPosition: 9, AvgIncidents: 7, AvgIRatingChange: -30}},
+				},
+				DaysOfWeek: []DayOfWeekStats{
+					{Day: time.Monday},
+					{Day: time.Tuesday},
+					{Day: time.Wednesday},
+					{Day: time.Thursday},
+					{Day: time.Friday, Stats: TimeStats{Races: 2, AvgFinishPosition: 9, AvgIncidents: 7, AvgIRatingChange: -30}},
+					{Day: time.Saturday, Stats: TimeStats{Races: 2, AvgFinishPosition: 3, AvgIncidents: 1, AvgIRatingChange: 20}},
+					{Day: time.Sunday},
+				},
+			},
+		},
+		{
+			name:         "no timezone set",
+			settings:     &store.DriverSettings{DriverID: 12345},
+			sessionsCall: &sessionsCall{result: sessions},
+			expected:     inUTC,
+		},
+		{
+			name:         "timezone no longer known",
+			settings:     &store.DriverSettings{DriverID: 12345, Timezone: "Mars/Olympus_Mons"},
+			sessionsCall: &sessionsCall{result: sessions},
+			expected:     inUTC,
+		},
+		{
+			name:        "settings error",
+			settingsErr: errors.New("database error"),
+			expectedErr: errors.New("getting settings: database error"),
+		},
+		{
+			name:         "sessions error",
+			settings:     &store.DriverSettings{DriverID: 12345},
+			sessionsCall: &sessionsCall{err: errors.New("database error")},
+			expectedErr:  errors.New("getting sessions: database error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetDriverSettings(mock.Anything, int64(12345)).Return(tc.settings, tc.settingsErr)
+			if tc.sessionsCall != nil {
+				mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, int64(12345), from, to).
+					Return(tc.sessionsCall.result, tc.sessionsCall.err)
+			}
+
+			service := NewService(mockStore)
+			result, err := service.GetTimeOfDayProfile(context.Background(), TimeOfDayRequest{
+				DriverID: 12345,
+				From:     from,
+				To:       to,
+			})
+
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package analytics
+
+import (
+	"sort"
+
+	"github.com/jonsabados/saturdaysspinout/lapflags"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+const (
+	// trafficSpikeThreshold is how far (as a fraction of the baseline lap) a lap must be off pace before we consider
+	// it to have been held up. Anything under this is normal lap to lap variation.
+	trafficSpikeThreshold = 0.01
+	// trafficSpikeCap is the point past which a slow lap is assumed to be something other than traffic (a spin that
+	// didn't register an incident, a tow, etc.) and is left out of the estimate.
+	trafficSpikeCap = 0.1
+	// minTrafficBaselineLaps is the number of clean laps needed to establish a baseline pace.
+	minTrafficBaselineLaps = 3
+)
+
+// TrafficCost estimates the time a driver lost to faster-class traffic in a multiclass race, in iRacing 10ths of
+// milliseconds. Clean green flag laps establish a median baseline pace, and a clean lap that is moderately off that pace
+// is put down to traffic when a faster-class car went by during it. fasterClassCrossings holds, for each car in a faster
+// class, the session times at which it crossed the line. Slow laps nobody went by on are down to tyre wear, fuel, a
+// mistake and so on rather than traffic, so aren't counted. Laps with incidents, incident lap flags (off tracks,
+// contact, etc.), pit stops or untrustworthy timing are excluded since the lost time is explained by something else.
+// Returns nil when there aren't enough clean laps to establish a baseline.
+func TrafficCost(laps []store.SessionDriverLap, fasterClassCrossings [][]int) *int {
+	var cleanLaps []store.SessionDriverLap
+	var clean []int
+	for _, l := range laps {
+		if isCleanLap(l) {
+			cleanLaps = append(cleanLaps, l)
+			clean = append(clean, l.LapTime)
+		}
+	}
+	if len(clean) < minTrafficBaselineLaps {
+		return nil
+	}
+
+	sort.Ints(clean)
+	baseline := clean[len(clean)/2]
+	if len(clean)%2 == 0 {
+		baseline = (clean[len(clean)/2-1] + clean[len(clean)/2]) / 2
+	}
+
+	threshold := baseline + int(float64(baseline)*trafficSpikeThreshold)
+	limit := baseline + int(float64(baseline)*trafficSpikeCap)
+
+	cost := 0
+	for _, l := range cleanLaps {
+		if l.LapTime > threshold && l.LapTime <= limit && passedDuring(l.SessionTime-l.LapTime, l.SessionTime, fasterClassCrossings) {
+			cost += l.LapTime - baseline
+		}
+	}
+	return &cost
+}
+
+// passedDuring reports whether any of the cars crossed the line twice between from and to, the session times a lap was
+// started and finished at. A car doing so was behind when the lap started and ahead by the time it finished, so went by
+// somewhere on it.
+func passedDuring(from, to int, crossings [][]int) bool {
+	for _, car := range crossings {
+		count := 0
+		for _, sessionTime := range car {
+			if sessionTime > from && sessionTime <= to {
+				count++
+			}
+		}
+		if count >= 2 {
+			return true
+		}
+	}
+	return false
+}
+
+// isCleanLap reports whether a lap is representative of racing pace. Lap 0 is the pace/grid lap and lap 1 includes
+// the start, so neither is representative.
+func isCleanLap(l store.SessionDriverLap) bool {
+	return l.LapNumber > 1 && l.LapTime > 0 && !l.Incident &&
+		!lapflags.Flags(l.Flags).Has(lapflags.Incidents|lapflags.Pitted|lapflags.TimingAnomalies)
+}
@@ -0,0 +1,106 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/jonsabados/saturdaysspinout/lapflags"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrafficCost(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
+	// laps is a stint of laps with the given times, starting with lap 2 at session time 0
+	laps := func(lapTimes ...int) []store.SessionDriverLap {
+		result := make([]store.SessionDriverLap, len(lapTimes))
+		sessionTime := 0
+		for i, lapTime := range lapTimes {
+			sessionTime += lapTime
+			result[i] = store.SessionDriverLap{LapNumber: i + 2, LapTime: lapTime, SessionTime: sessionTime}
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name                 string
+		laps                 []store.SessionDriverLap
+		fasterClassCrossings [][]int
+		expected             *int
+	}{
+		{
+			name: "slow laps a faster car went by on counted against median",
+			laps: append([]store.SessionDriverLap{
+				{LapNumber: 0, LapTime: -1},
+				{LapNumber: 1, LapTime: 1_000_000},
+			}, laps(900_000, 902_000, 930_000, 901_000, 950_000, 903_000)...),
+			fasterClassCrossings: [][]int{
+				// behind at the start of lap 4, ahead at the end of it
+				{1_810_000, 2_700_000},
+				// the same on lap 6
+				{3_650_000, 4_550_000},
+			},
+			// median of clean laps is 902_500 (902_000 and 903_000 averaged)
+			expected: intPtr(27_500 + 47_500),
+		},
+		{
+			name: "slow laps nobody went by on not counted",
+			laps: laps(900_000, 902_000, 930_000, 901_000, 950_000, 903_000),
+			fasterClassCrossings: [][]int{
+				// laps the driver on lap 4, but is nowhere near on lap 6
+				{1_810_000, 2_700_000, 3_500_000},
+				// crosses once during each lap, never going by
+				{850_000, 1_750_000, 2_650_000, 3_550_000, 4_450_000, 5_350_000},
+			},
+			expected: intPtr(27_500),
+		},
+		{
+			name:     "no faster classes",
+			laps:     laps(900_000, 902_000, 930_000, 901_000, 950_000, 903_000),
+			expected: intPtr(0),
+		},
+		{
+			name: "incident and event laps excluded",
+			laps: []store.SessionDriverLap{
+				{LapNumber: 2, LapTime: 900_000, SessionTime: 900_000},
+				{LapNumber: 3, LapTime: 900_000, SessionTime: 1_800_000},
+				{LapNumber: 4, LapTime: 950_000, SessionTime: 2_750_000, Incident: true},
+				{LapNumber: 5, LapTime: 940_000, SessionTime: 3_690_000, Flags: int(lapflags.Pitted)},
+				{LapNumber: 6, LapTime: 930_000, SessionTime: 4_620_000, Flags: int(lapflags.OffTrack)},
+				{LapNumber: 7, LapTime: 925_000, SessionTime: 5_545_000, Flags: int(lapflags.ClockSmash)},
+				{LapNumber: 8, LapTime: 900_000, SessionTime: 6_445_000},
+			},
+			fasterClassCrossings: [][]int{
+				{1_810_000, 2_000_000, 2_700_000, 3_000_000, 3_600_000, 4_000_000, 4_600_000, 5_000_000, 5_500_000},
+			},
+			expected: intPtr(0),
+		},
+		{
+			name: "laps beyond cap ignored",
+			laps: laps(900_000, 900_000, 1_200_000, 900_000, 920_000),
+			fasterClassCrossings: [][]int{
+				{1_850_000, 2_950_000},
+				{3_910_000, 4_800_000},
+			},
+			expected: intPtr(20_000),
+		},
+		{
+			name: "too few clean laps",
+			laps: []store.SessionDriverLap{
+				{LapNumber: 0, LapTime: -1},
+				{LapNumber: 1, LapTime: 1_000_000, SessionTime: 1_000_000},
+				{LapNumber: 2, LapTime: 900_000, SessionTime: 1_900_000},
+				{LapNumber: 3, LapTime: 930_000, SessionTime: 2_830_000, Incident: true},
+				{LapNumber: 4, LapTime: 901_000, SessionTime: 3_731_000},
+			},
+			fasterClassCrossings: [][]int{{1_950_000, 2_800_000}},
+			expected:             nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, TrafficCost(tc.laps, tc.fasterClassCrossings))
+		})
+	}
+}
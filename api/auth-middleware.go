@@ -8,6 +8,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/tenant"
 )
 
 type TokenValidator interface {
@@ -49,7 +50,14 @@ func AuthMiddleware(validator TokenValidator) func(next http.Handler) http.Handl
 				DoUnauthorizedResponse(ctx, "invalid token", w)
 				return
 			}
+			// tokens are only good for the tenant they were issued for
+			if sessionClaims.TenantID != tenant.FromContext(ctx) {
+				zerolog.Ctx(ctx).Warn().Str("tokenTenant", sessionClaims.TenantID).Str("requestTenant", tenant.FromContext(ctx)).Msg("token issued for another tenant")
+				DoUnauthorizedResponse(ctx, "invalid token", w)
+				return
+			}
 
+			ctx = enrichLogContext(ctx, sessionClaims)
 			ctx = context.WithValue(ctx, sessionClaimsKey, sessionClaims)
 			ctx = context.WithValue(ctx, sensitiveClaimsKey, sensitiveClaims)
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -92,6 +92,26 @@ func TestAuthMiddleware(t *testing.T) {
 			expectedResponseStatus:      http.StatusUnauthorized,
 			expectedResponseBodyFixture: "fixtures/auth_middleware_invalid_token_response.json",
 		},
+		{
+			name:       "token issued for another tenant",
+			httpMethod: http.MethodGet,
+			authHeader: "Bearer other-tenant-token",
+			validatorCalls: []validatorCall{
+				{
+					inputToken: "other-tenant-token",
+					sessionClaims: &auth.SessionClaims{
+						SessionID:     "test-session-id",
+						IRacingUserID: 1100750,
+						TenantID:      "league-one",
+					},
+					sensitiveClaims: testSensitiveClaims,
+					err:             nil,
+				},
+			},
+			expectNextCalled:            false,
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/auth_middleware_invalid_token_response.json",
+		},
 		{
 			name:       "valid token sets claims in context",
 			httpMethod: http.MethodGet,
@@ -3,12 +3,17 @@ package auth
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"math"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/jonsabados/saturdaysspinout/api"
 	"github.com/rs/zerolog"
 
 	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/store"
 )
 
 type CallbackRequest struct {
@@ -22,11 +27,39 @@ type CallbackResponse struct {
 	ExpiresAt int64  `json:"expires_at"`
 	UserID    int64  `json:"user_id"`
 	UserName  string `json:"user_name"`
+	// Welcome is only sent on the first sign in of a driver let in off the waitlist
+	Welcome bool `json:"welcome,omitempty"`
 }
 
 type Service interface {
-	HandleCallback(ctx context.Context, code, codeVerifier, redirectURI string) (*auth.Result, error)
-	HandleRefresh(ctx context.Context, userID int64, userName string, entitlements []string, refreshToken string) (*auth.Result, error)
+	HandleCallback(ctx context.Context, code, codeVerifier, redirectURI string, client auth.Client) (*auth.Result, error)
+	HandleRefresh(ctx context.Context, sessionID string, userID int64, userName string, entitlements []string, refreshToken string, client auth.Client) (*auth.Result, error)
+	ListSessions(ctx context.Context, driverID int64) ([]store.AuthSession, error)
+	RevokeSession(ctx context.Context, driverID int64, sessionID string) (bool, error)
+	RevokeOtherSessions(ctx context.Context, driverID int64, keepSessionID string) (int, error)
+}
+
+// clientFromRequest describes the device making the request. RemoteAddr carries the source IP both behind API
+// Gateway and when serving directly.
+func clientFromRequest(request *http.Request) auth.Client {
+	ip, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		ip = request.RemoteAddr
+	}
+	return auth.Client{UserAgent: request.UserAgent(), IP: ip}
+}
+
+// doTooManyAttemptsResponse answers with a 429 when err is the client being held off after too many failed attempts,
+// returning whether it did.
+func doTooManyAttemptsResponse(ctx context.Context, err error, writer http.ResponseWriter) bool {
+	var tooMany *auth.TooManyAttemptsError
+	if !errors.As(err, &tooMany) {
+		return false
+	}
+	zerolog.Ctx(ctx).Warn().Time("retryAt", tooMany.RetryAt).Msg("sign in attempt held off")
+	retryAfter := max(int(math.Ceil(time.Until(tooMany.RetryAt).Seconds())), 1)
+	api.DoTooManyRequestsResponse(ctx, "too many failed attempts", retryAfter, writer)
+	return true
 }
 
 func NewAuthCallbackEndpoint(authService Service) http.Handler {
@@ -61,7 +94,15 @@ func NewAuthCallbackEndpoint(authService Service) http.Handler {
 			return
 		}
 
-		result, err := authService.HandleCallback(ctx, req.Code, req.CodeVerifier, req.RedirectURI)
+		result, err := authService.HandleCallback(ctx, req.Code, req.CodeVerifier, req.RedirectURI, clientFromRequest(request))
+		if doTooManyAttemptsResponse(ctx, err, writer) {
+			return
+		}
+		if errors.Is(err, auth.ErrWaitlisted) {
+			logger.Info().Msg("sign in held on the waitlist")
+			api.DoForbiddenResponse(ctx, "waitlisted", writer)
+			return
+		}
 		if err != nil {
 			logger.Error().Err(err).Msg("authentication failed")
 			api.DoErrorResponse(ctx, writer)
@@ -75,6 +116,7 @@ func NewAuthCallbackEndpoint(authService Service) http.Handler {
 			ExpiresAt: result.ExpiresAt.Unix(),
 			UserID:    result.UserID,
 			UserName:  result.UserName,
+			Welcome:   result.Welcome,
 		}, writer)
 	})
 }
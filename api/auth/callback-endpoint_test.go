@@ -58,6 +58,40 @@ func TestNewAuthCallbackEndpoint(t *testing.T) {
 			expectedResponseStatus:      http.StatusOK,
 			expectedResponseBodyFixture: "fixtures/auth_callback_success_response.json",
 		},
+		{
+			name:         "success off the waitlist",
+			inputFixture: "fixtures/auth_callback_valid_request.json",
+			expectedAuthServiceCalls: []authServiceCall{
+				{
+					inputCode:         "test-auth-code",
+					inputCodeVerifier: "test-code-verifier",
+					redirectURI:       "http://localhost:5173/auth/ir/callback",
+					result: &auth.Result{
+						Token:     "test-jwt-token",
+						ExpiresAt: time.Unix(1735689600, 0),
+						UserID:    1100750,
+						UserName:  "Jon Sabados",
+						Welcome:   true,
+					},
+				},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/auth_callback_welcome_response.json",
+		},
+		{
+			name:         "waitlisted",
+			inputFixture: "fixtures/auth_callback_valid_request.json",
+			expectedAuthServiceCalls: []authServiceCall{
+				{
+					inputCode:         "test-auth-code",
+					inputCodeVerifier: "test-code-verifier",
+					redirectURI:       "http://localhost:5173/auth/ir/callback",
+					resultErr:         auth.ErrWaitlisted,
+				},
+			},
+			expectedResponseStatus:      http.StatusForbidden,
+			expectedResponseBodyFixture: "fixtures/auth_callback_waitlisted_response.json",
+		},
 		{
 			name:                        "missing code",
 			inputFixture:                "fixtures/auth_callback_missing_code_request.json",
@@ -86,6 +120,20 @@ func TestNewAuthCallbackEndpoint(t *testing.T) {
 			expectedResponseStatus:      http.StatusBadRequest,
 			expectedResponseBodyFixture: "fixtures/auth_callback_invalid_json_response.json",
 		},
+		{
+			name:         "too many failed attempts",
+			inputFixture: "fixtures/auth_callback_valid_request.json",
+			expectedAuthServiceCalls: []authServiceCall{
+				{
+					inputCode:         "test-auth-code",
+					inputCodeVerifier: "test-code-verifier",
+					redirectURI:       "http://localhost:5173/auth/ir/callback",
+					resultErr:         &auth.TooManyAttemptsError{RetryAt: time.Now().Add(time.Minute)},
+				},
+			},
+			expectedResponseStatus:      http.StatusTooManyRequests,
+			expectedResponseBodyFixture: "fixtures/auth_too_many_attempts_response.json",
+		},
 		{
 			name:         "auth service error",
 			inputFixture: "fixtures/auth_callback_valid_request.json",
@@ -109,7 +157,7 @@ func TestNewAuthCallbackEndpoint(t *testing.T) {
 
 			authService := NewMockService(t)
 			for _, call := range tc.expectedAuthServiceCalls {
-				authService.EXPECT().HandleCallback(mock.Anything, call.inputCode, call.inputCodeVerifier, call.redirectURI).Return(call.result, call.resultErr)
+				authService.EXPECT().HandleCallback(mock.Anything, call.inputCode, call.inputCodeVerifier, call.redirectURI, auth.Client{UserAgent: "test-agent", IP: "127.0.0.1"}).Return(call.result, call.resultErr)
 			}
 
 			endpoint := NewAuthCallbackEndpoint(authService)
@@ -123,6 +171,7 @@ func TestNewAuthCallbackEndpoint(t *testing.T) {
 
 			req, err := http.NewRequestWithContext(ctx, http.MethodPost, ts.URL, bytes.NewReader(requestBody))
 			require.NoError(t, err)
+			req.Header.Set("User-Agent", "test-agent")
 
 			res, err := http.DefaultClient.Do(req)
 			require.NoError(t, err)
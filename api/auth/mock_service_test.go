@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/store"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -39,8 +40,8 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 }
 
 // HandleCallback provides a mock function for the type MockService
-func (_mock *MockService) HandleCallback(ctx context.Context, code string, codeVerifier string, redirectURI string) (*auth.Result, error) {
-	ret := _mock.Called(ctx, code, codeVerifier, redirectURI)
+func (_mock *MockService) HandleCallback(ctx context.Context, code string, codeVerifier string, redirectURI string, client auth.Client) (*auth.Result, error) {
+	ret := _mock.Called(ctx, code, codeVerifier, redirectURI, client)
 
 	if len(ret) == 0 {
 		panic("no return value specified for HandleCallback")
@@ -48,18 +49,18 @@ func (_mock *MockService) HandleCallback(ctx context.Context, code string, codeV
 
 	var r0 *auth.Result
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*auth.Result, error)); ok {
-		return returnFunc(ctx, code, codeVerifier, redirectURI)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, auth.Client) (*auth.Result, error)); ok {
+		return returnFunc(ctx, code, codeVerifier, redirectURI, client)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *auth.Result); ok {
-		r0 = returnFunc(ctx, code, codeVerifier, redirectURI)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, auth.Client) *auth.Result); ok {
+		r0 = returnFunc(ctx, code, codeVerifier, redirectURI, client)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*auth.Result)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = returnFunc(ctx, code, codeVerifier, redirectURI)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, auth.Client) error); ok {
+		r1 = returnFunc(ctx, code, codeVerifier, redirectURI, client)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -76,11 +77,12 @@ type MockService_HandleCallback_Call struct {
 //   - code string
 //   - codeVerifier string
 //   - redirectURI string
-func (_e *MockService_Expecter) HandleCallback(ctx interface{}, code interface{}, codeVerifier interface{}, redirectURI interface{}) *MockService_HandleCallback_Call {
-	return &MockService_HandleCallback_Call{Call: _e.mock.On("HandleCallback", ctx, code, codeVerifier, redirectURI)}
+//   - client auth.Client
+func (_e *MockService_Expecter) HandleCallback(ctx interface{}, code interface{}, codeVerifier interface{}, redirectURI interface{}, client interface{}) *MockService_HandleCallback_Call {
+	return &MockService_HandleCallback_Call{Call: _e.mock.On("HandleCallback", ctx, code, codeVerifier, redirectURI, client)}
 }
 
-func (_c *MockService_HandleCallback_Call) Run(run func(ctx context.Context, code string, codeVerifier string, redirectURI string)) *MockService_HandleCallback_Call {
+func (_c *MockService_HandleCallback_Call) Run(run func(ctx context.Context, code string, codeVerifier string, redirectURI string, client auth.Client)) *MockService_HandleCallback_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -98,11 +100,16 @@ func (_c *MockService_HandleCallback_Call) Run(run func(ctx context.Context, cod
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 auth.Client
+		if args[4] != nil {
+			arg4 = args[4].(auth.Client)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -113,14 +120,14 @@ func (_c *MockService_HandleCallback_Call) Return(result *auth.Result, err error
 	return _c
 }
 
-func (_c *MockService_HandleCallback_Call) RunAndReturn(run func(ctx context.Context, code string, codeVerifier string, redirectURI string) (*auth.Result, error)) *MockService_HandleCallback_Call {
+func (_c *MockService_HandleCallback_Call) RunAndReturn(run func(ctx context.Context, code string, codeVerifier string, redirectURI string, client auth.Client) (*auth.Result, error)) *MockService_HandleCallback_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // HandleRefresh provides a mock function for the type MockService
-func (_mock *MockService) HandleRefresh(ctx context.Context, userID int64, userName string, entitlements []string, refreshToken string) (*auth.Result, error) {
-	ret := _mock.Called(ctx, userID, userName, entitlements, refreshToken)
+func (_mock *MockService) HandleRefresh(ctx context.Context, sessionID string, userID int64, userName string, entitlements []string, refreshToken string, client auth.Client) (*auth.Result, error) {
+	ret := _mock.Called(ctx, sessionID, userID, userName, entitlements, refreshToken, client)
 
 	if len(ret) == 0 {
 		panic("no return value specified for HandleRefresh")
@@ -128,18 +135,18 @@ func (_mock *MockService) HandleRefresh(ctx context.Context, userID int64, userN
 
 	var r0 *auth.Result
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, []string, string) (*auth.Result, error)); ok {
-		return returnFunc(ctx, userID, userName, entitlements, refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, string, []string, string, auth.Client) (*auth.Result, error)); ok {
+		return returnFunc(ctx, sessionID, userID, userName, entitlements, refreshToken, client)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, []string, string) *auth.Result); ok {
-		r0 = returnFunc(ctx, userID, userName, entitlements, refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, string, []string, string, auth.Client) *auth.Result); ok {
+		r0 = returnFunc(ctx, sessionID, userID, userName, entitlements, refreshToken, client)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*auth.Result)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, []string, string) error); ok {
-		r1 = returnFunc(ctx, userID, userName, entitlements, refreshToken)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int64, string, []string, string, auth.Client) error); ok {
+		r1 = returnFunc(ctx, sessionID, userID, userName, entitlements, refreshToken, client)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -153,35 +160,45 @@ type MockService_HandleRefresh_Call struct {
 
 // HandleRefresh is a helper method to define mock.On call
 //   - ctx context.Context
+//   - sessionID string
 //   - userID int64
 //   - userName string
 //   - entitlements []string
 //   - refreshToken string
-func (_e *MockService_Expecter) HandleRefresh(ctx interface{}, userID interface{}, userName interface{}, entitlements interface{}, refreshToken interface{}) *MockService_HandleRefresh_Call {
-	return &MockService_HandleRefresh_Call{Call: _e.mock.On("HandleRefresh", ctx, userID, userName, entitlements, refreshToken)}
+//   - client auth.Client
+func (_e *MockService_Expecter) HandleRefresh(ctx interface{}, sessionID interface{}, userID interface{}, userName interface{}, entitlements interface{}, refreshToken interface{}, client interface{}) *MockService_HandleRefresh_Call {
+	return &MockService_HandleRefresh_Call{Call: _e.mock.On("HandleRefresh", ctx, sessionID, userID, userName, entitlements, refreshToken, client)}
 }
 
-func (_c *MockService_HandleRefresh_Call) Run(run func(ctx context.Context, userID int64, userName string, entitlements []string, refreshToken string)) *MockService_HandleRefresh_Call {
+func (_c *MockService_HandleRefresh_Call) Run(run func(ctx context.Context, sessionID string, userID int64, userName string, entitlements []string, refreshToken string, client auth.Client)) *MockService_HandleRefresh_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 int64
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(int64)
 		}
-		var arg3 []string
+		var arg3 string
 		if args[3] != nil {
-			arg3 = args[3].([]string)
+			arg3 = args[3].(string)
 		}
-		var arg4 string
+		var arg4 []string
 		if args[4] != nil {
-			arg4 = args[4].(string)
+			arg4 = args[4].([]string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		var arg6 auth.Client
+		if args[6] != nil {
+			arg6 = args[6].(auth.Client)
 		}
 		run(
 			arg0,
@@ -189,6 +206,8 @@ func (_c *MockService_HandleRefresh_Call) Run(run func(ctx context.Context, user
 			arg2,
 			arg3,
 			arg4,
+			arg5,
+			arg6,
 		)
 	})
 	return _c
@@ -199,7 +218,219 @@ func (_c *MockService_HandleRefresh_Call) Return(result *auth.Result, err error)
 	return _c
 }
 
-func (_c *MockService_HandleRefresh_Call) RunAndReturn(run func(ctx context.Context, userID int64, userName string, entitlements []string, refreshToken string) (*auth.Result, error)) *MockService_HandleRefresh_Call {
+func (_c *MockService_HandleRefresh_Call) RunAndReturn(run func(ctx context.Context, sessionID string, userID int64, userName string, entitlements []string, refreshToken string, client auth.Client) (*auth.Result, error)) *MockService_HandleRefresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type MockService
+func (_mock *MockService) ListSessions(ctx context.Context, driverID int64) ([]store.AuthSession, error) {
+	ret := _mock.Called(ctx, driverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []store.AuthSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]store.AuthSession, error)); ok {
+		return returnFunc(ctx, driverID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []store.AuthSession); ok {
+		r0 = returnFunc(ctx, driverID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.AuthSession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, driverID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockService_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+func (_e *MockService_Expecter) ListSessions(ctx interface{}, driverID interface{}) *MockService_ListSessions_Call {
+	return &MockService_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, driverID)}
+}
+
+func (_c *MockService_ListSessions_Call) Run(run func(ctx context.Context, driverID int64)) *MockService_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ListSessions_Call) Return(authSessions []store.AuthSession, err error) *MockService_ListSessions_Call {
+	_c.Call.Return(authSessions, err)
+	return _c
+}
+
+func (_c *MockService_ListSessions_Call) RunAndReturn(run func(ctx context.Context, driverID int64) ([]store.AuthSession, error)) *MockService_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeOtherSessions provides a mock function for the type MockService
+func (_mock *MockService) RevokeOtherSessions(ctx context.Context, driverID int64, keepSessionID string) (int, error) {
+	ret := _mock.Called(ctx, driverID, keepSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeOtherSessions")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) (int, error)); ok {
+		return returnFunc(ctx, driverID, keepSessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) int); ok {
+		r0 = returnFunc(ctx, driverID, keepSessionID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = returnFunc(ctx, driverID, keepSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_RevokeOtherSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOtherSessions'
+type MockService_RevokeOtherSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeOtherSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - keepSessionID string
+func (_e *MockService_Expecter) RevokeOtherSessions(ctx interface{}, driverID interface{}, keepSessionID interface{}) *MockService_RevokeOtherSessions_Call {
+	return &MockService_RevokeOtherSessions_Call{Call: _e.mock.On("RevokeOtherSessions", ctx, driverID, keepSessionID)}
+}
+
+func (_c *MockService_RevokeOtherSessions_Call) Run(run func(ctx context.Context, driverID int64, keepSessionID string)) *MockService_RevokeOtherSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RevokeOtherSessions_Call) Return(n int, err error) *MockService_RevokeOtherSessions_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockService_RevokeOtherSessions_Call) RunAndReturn(run func(ctx context.Context, driverID int64, keepSessionID string) (int, error)) *MockService_RevokeOtherSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type MockService
+func (_mock *MockService) RevokeSession(ctx context.Context, driverID int64, sessionID string) (bool, error) {
+	ret := _mock.Called(ctx, driverID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) (bool, error)); ok {
+		return returnFunc(ctx, driverID, sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) bool); ok {
+		r0 = returnFunc(ctx, driverID, sessionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = returnFunc(ctx, driverID, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockService_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - sessionID string
+func (_e *MockService_Expecter) RevokeSession(ctx interface{}, driverID interface{}, sessionID interface{}) *MockService_RevokeSession_Call {
+	return &MockService_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, driverID, sessionID)}
+}
+
+func (_c *MockService_RevokeSession_Call) Run(run func(ctx context.Context, driverID int64, sessionID string)) *MockService_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RevokeSession_Call) Return(b bool, err error) *MockService_RevokeSession_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockService_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, driverID int64, sessionID string) (bool, error)) *MockService_RevokeSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,10 +1,13 @@
 package auth
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/jonsabados/saturdaysspinout/api"
 	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/auth"
 )
 
 func NewAuthRefreshEndpoint(authService Service) http.Handler {
@@ -25,7 +28,15 @@ func NewAuthRefreshEndpoint(authService Service) http.Handler {
 			return
 		}
 
-		result, err := authService.HandleRefresh(ctx, sessionClaims.IRacingUserID, sessionClaims.IRacingUserName, sessionClaims.Entitlements, sensitiveClaims.IRacingRefreshToken)
+		result, err := authService.HandleRefresh(ctx, sessionClaims.SessionID, sessionClaims.IRacingUserID, sessionClaims.IRacingUserName, sessionClaims.Entitlements, sensitiveClaims.IRacingRefreshToken, clientFromRequest(request))
+		if doTooManyAttemptsResponse(ctx, err, writer) {
+			return
+		}
+		if errors.Is(err, auth.ErrSessionRevoked) {
+			logger.Warn().Str("sessionId", sessionClaims.SessionID).Msg("refresh attempted on revoked session")
+			api.DoUnauthorizedResponse(ctx, "session revoked", writer)
+			return
+		}
 		if err != nil {
 			logger.Error().Err(err).Msg("token refresh failed")
 			api.DoErrorResponse(ctx, writer)
@@ -61,6 +61,7 @@ func TestNewAuthRefreshEndpoint(t *testing.T) {
 				{
 					inputToken: "valid-token",
 					sessionClaims: &auth.SessionClaims{
+						SessionID:       "session-id",
 						IRacingUserID:   1100750,
 						IRacingUserName: "Jon Sabados",
 					},
@@ -106,6 +107,60 @@ func TestNewAuthRefreshEndpoint(t *testing.T) {
 			expectedResponseStatus:      http.StatusUnauthorized,
 			expectedResponseBodyFixture: "fixtures/auth_refresh_invalid_token_response.json",
 		},
+		{
+			name:       "session revoked",
+			authHeader: "Bearer valid-token",
+			expectedValidatorCalls: []validatorCall{
+				{
+					inputToken: "valid-token",
+					sessionClaims: &auth.SessionClaims{
+						SessionID:       "session-id",
+						IRacingUserID:   1100750,
+						IRacingUserName: "Jon Sabados",
+					},
+					sensitiveClaims: &auth.SensitiveClaims{
+						IRacingRefreshToken: "iracing-refresh-token",
+					},
+				},
+			},
+			expectedAuthServiceCalls: []authServiceCall{
+				{
+					inputUserID:       1100750,
+					inputUserName:     "Jon Sabados",
+					inputRefreshToken: "iracing-refresh-token",
+					resultErr:         fmt.Errorf("saving session: %w", auth.ErrSessionRevoked),
+				},
+			},
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/auth_refresh_session_revoked_response.json",
+		},
+		{
+			name:       "too many failed attempts",
+			authHeader: "Bearer valid-token",
+			expectedValidatorCalls: []validatorCall{
+				{
+					inputToken: "valid-token",
+					sessionClaims: &auth.SessionClaims{
+						SessionID:       "session-id",
+						IRacingUserID:   1100750,
+						IRacingUserName: "Jon Sabados",
+					},
+					sensitiveClaims: &auth.SensitiveClaims{
+						IRacingRefreshToken: "iracing-refresh-token",
+					},
+				},
+			},
+			expectedAuthServiceCalls: []authServiceCall{
+				{
+					inputUserID:       1100750,
+					inputUserName:     "Jon Sabados",
+					inputRefreshToken: "iracing-refresh-token",
+					resultErr:         &auth.TooManyAttemptsError{RetryAt: time.Now().Add(time.Minute)},
+				},
+			},
+			expectedResponseStatus:      http.StatusTooManyRequests,
+			expectedResponseBodyFixture: "fixtures/auth_too_many_attempts_response.json",
+		},
 		{
 			name:       "auth service error",
 			authHeader: "Bearer valid-token",
@@ -113,6 +168,7 @@ func TestNewAuthRefreshEndpoint(t *testing.T) {
 				{
 					inputToken: "valid-token",
 					sessionClaims: &auth.SessionClaims{
+						SessionID:       "session-id",
 						IRacingUserID:   1100750,
 						IRacingUserName: "Jon Sabados",
 					},
@@ -153,7 +209,7 @@ func TestNewAuthRefreshEndpoint(t *testing.T) {
 
 			authService := NewMockService(t)
 			for _, call := range tc.expectedAuthServiceCalls {
-				authService.EXPECT().HandleRefresh(mock.Anything, call.inputUserID, call.inputUserName, call.inputEntitlements, call.inputRefreshToken).Return(call.result, call.resultErr)
+				authService.EXPECT().HandleRefresh(mock.Anything, "session-id", call.inputUserID, call.inputUserName, call.inputEntitlements, call.inputRefreshToken, auth.Client{UserAgent: "test-agent", IP: "127.0.0.1"}).Return(call.result, call.resultErr)
 			}
 
 			endpoint := NewAuthRefreshEndpoint(authService)
@@ -165,6 +221,7 @@ func TestNewAuthRefreshEndpoint(t *testing.T) {
 			req, err := http.NewRequestWithContext(ctx, http.MethodPost, ts.URL, nil)
 			require.NoError(t, err)
 
+			req.Header.Set("User-Agent", "test-agent")
 			if tc.authHeader != "" {
 				req.Header.Set("Authorization", tc.authHeader)
 			}
@@ -7,7 +7,7 @@ import (
 	"github.com/jonsabados/saturdaysspinout/api"
 )
 
-func NewRouter(authService Service, authMiddleware func(http.Handler) http.Handler) http.Handler {
+func NewRouter(authService Service, termsService TermsService, authMiddleware func(http.Handler) http.Handler) http.Handler {
 	r := chi.NewRouter()
 
 	r.Post("/ir/callback", api.WrapWithSegment("authCallbackEndpoint", NewAuthCallbackEndpoint(authService)).ServeHTTP)
@@ -15,6 +15,11 @@ func NewRouter(authService Service, authMiddleware func(http.Handler) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Use(authMiddleware)
 		r.Post("/refresh", api.WrapWithSegment("authRefreshEndpoint", NewAuthRefreshEndpoint(authService)).ServeHTTP)
+		r.Get("/sessions", api.WrapWithSegment("authListSessionsEndpoint", NewListSessionsEndpoint(authService)).ServeHTTP)
+		r.Delete("/sessions", api.WrapWithSegment("authRevokeOtherSessionsEndpoint", NewRevokeOtherSessionsEndpoint(authService)).ServeHTTP)
+		r.Delete("/sessions/{"+sessionIDPathParam+"}", api.WrapWithSegment("authRevokeSessionEndpoint", NewRevokeSessionEndpoint(authService)).ServeHTTP)
+		r.Get("/terms", api.WrapWithSegment("authTermsStatusEndpoint", NewTermsStatusEndpoint(termsService)).ServeHTTP)
+		r.Post("/terms", api.WrapWithSegment("authAcceptTermsEndpoint", NewAcceptTermsEndpoint(termsService)).ServeHTTP)
 	})
 
 	return r
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+const sessionIDPathParam = "session_id"
+
+type Session struct {
+	SessionID string    `json:"session_id"`
+	UserAgent string    `json:"user_agent"`
+	IPPrefix  string    `json:"ip_prefix"`
+	CreatedAt time.Time `json:"created_at"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Current   bool      `json:"current"`
+}
+
+type SessionsResponse struct {
+	Sessions []Session `json:"sessions"`
+}
+
+// NewListSessionsEndpoint creates the handler for GET /auth/sessions, listing the caller's active sessions
+func NewListSessionsEndpoint(authService Service) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ctx := request.Context()
+		logger := zerolog.Ctx(ctx)
+
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		if sessionClaims == nil {
+			logger.Error().Msg("claims not found in context")
+			api.DoErrorResponse(ctx, writer)
+			return
+		}
+
+		sessions, err := authService.ListSessions(ctx, sessionClaims.IRacingUserID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to list sessions")
+			api.DoErrorResponse(ctx, writer)
+			return
+		}
+
+		response := SessionsResponse{Sessions: make([]Session, 0, len(sessions))}
+		for _, s := range sessions {
+			response.Sessions = append(response.Sessions, Session{
+				SessionID: s.SessionID,
+				UserAgent: s.UserAgent,
+				IPPrefix:  s.IPPrefix,
+				CreatedAt: s.CreatedAt,
+				IssuedAt:  s.IssuedAt,
+				ExpiresAt: s.ExpiresAt,
+				Current:   s.SessionID == sessionClaims.SessionID,
+			})
+		}
+
+		api.DoOKResponse(ctx, response, writer)
+	})
+}
+
+// NewRevokeSessionEndpoint creates the handler for DELETE /auth/sessions/{session_id}. Revoking the current session
+// is allowed, and amounts to signing out.
+func NewRevokeSessionEndpoint(authService Service) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ctx := request.Context()
+		logger := zerolog.Ctx(ctx)
+
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		if sessionClaims == nil {
+			logger.Error().Msg("claims not found in context")
+			api.DoErrorResponse(ctx, writer)
+			return
+		}
+
+		sessionID := chi.URLParam(request, sessionIDPathParam)
+		found, err := authService.RevokeSession(ctx, sessionClaims.IRacingUserID, sessionID)
+		if err != nil {
+			logger.Error().Err(err).Str("sessionId", sessionID).Msg("failed to revoke session")
+			api.DoErrorResponse(ctx, writer)
+			return
+		}
+		if !found {
+			api.DoNotFoundResponse(ctx, "session not found", writer)
+			return
+		}
+
+		logger.Info().Str("sessionId", sessionID).Msg("session revoked")
+		writer.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// NewRevokeOtherSessionsEndpoint creates the handler for DELETE /auth/sessions, revoking every session except the one
+// making the request
+func NewRevokeOtherSessionsEndpoint(authService Service) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ctx := request.Context()
+		logger := zerolog.Ctx(ctx)
+
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		if sessionClaims == nil {
+			logger.Error().Msg("claims not found in context")
+			api.DoErrorResponse(ctx, writer)
+			return
+		}
+
+		revoked, err := authService.RevokeOtherSessions(ctx, sessionClaims.IRacingUserID, sessionClaims.SessionID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to revoke sessions")
+			api.DoErrorResponse(ctx, writer)
+			return
+		}
+
+		logger.Info().Int("revoked", revoked).Msg("other sessions revoked")
+		writer.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionEndpoints(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		method     string
+		path       string
+		setupMocks func(authService *MockService)
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:   "list sessions",
+			method: http.MethodGet,
+			path:   "/sessions",
+			setupMocks: func(authService *MockService) {
+				authService.EXPECT().ListSessions(mock.Anything, int64(1100750)).Return([]store.AuthSession{
+					{
+						DriverID:  1100750,
+						SessionID: "session-id",
+						UserAgent: "Mozilla/5.0 (X11; Linux x86_64) Firefox/140.0",
+						IPPrefix:  "203.0.113.0/24",
+						CreatedAt: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+						IssuedAt:  time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC),
+						ExpiresAt: time.Date(2026, 10, 17, 9, 30, 0, 0, time.UTC),
+					},
+					{
+						DriverID:  1100750,
+						SessionID: "other-session-id",
+						UserAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 18_0 like Mac OS X) Safari/604.1",
+						IPPrefix:  "2001:db8:1::/48",
+						CreatedAt: time.Date(2026, 9, 20, 18, 0, 0, 0, time.UTC),
+						IssuedAt:  time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC),
+						ExpiresAt: time.Date(2026, 10, 16, 20, 0, 0, 0, time.UTC),
+					},
+				}, nil)
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/auth_sessions_list_response.json",
+		},
+		{
+			name:   "list sessions error",
+			method: http.MethodGet,
+			path:   "/sessions",
+			setupMocks: func(authService *MockService) {
+				authService.EXPECT().ListSessions(mock.Anything, int64(1100750)).Return(nil, errors.New("db error"))
+			},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/auth_sessions_error_response.json",
+		},
+		{
+			name:   "revoke session",
+			method: http.MethodDelete,
+			path:   "/sessions/other-session-id",
+			setupMocks: func(authService *MockService) {
+				authService.EXPECT().RevokeSession(mock.Anything, int64(1100750), "other-session-id").Return(true, nil)
+			},
+			expectedResponseStatus: http.StatusNoContent,
+		},
+		{
+			name:   "revoke unknown session",
+			method: http.MethodDelete,
+			path:   "/sessions/nope",
+			setupMocks: func(authService *MockService) {
+				authService.EXPECT().RevokeSession(mock.Anything, int64(1100750), "nope").Return(false, nil)
+			},
+			expectedResponseStatus:      http.StatusNotFound,
+			expectedResponseBodyFixture: "fixtures/auth_sessions_not_found_response.json",
+		},
+		{
+			name:   "revoke session error",
+			method: http.MethodDelete,
+			path:   "/sessions/other-session-id",
+			setupMocks: func(authService *MockService) {
+				authService.EXPECT().RevokeSession(mock.Anything, int64(1100750), "other-session-id").Return(false, errors.New("db error"))
+			},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/auth_sessions_error_response.json",
+		},
+		{
+			name:   "revoke other sessions",
+			method: http.MethodDelete,
+			path:   "/sessions",
+			setupMocks: func(authService *MockService) {
+				authService.EXPECT().RevokeOtherSessions(mock.Anything, int64(1100750), "session-id").Return(2, nil)
+			},
+			expectedResponseStatus: http.StatusNoContent,
+		},
+		{
+			name:   "revoke other sessions error",
+			method: http.MethodDelete,
+			path:   "/sessions",
+			setupMocks: func(authService *MockService) {
+				authService.EXPECT().RevokeOtherSessions(mock.Anything, int64(1100750), "session-id").Return(0, errors.New("db error"))
+			},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/auth_sessions_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				validateFunc: func(ctx context.Context, token string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+					return &auth.SessionClaims{SessionID: "session-id", IRacingUserID: 1100750}, &auth.SensitiveClaims{}, nil
+				},
+			}
+
+			authService := NewMockService(t)
+			tc.setupMocks(authService)
+
+			router := NewRouter(authService, NewMockTermsService(t), api.AuthMiddleware(validator))
+			handler := correlation.Middleware(func() string { return testCorrelationID })(router)
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, tc.method, ts.URL+tc.path, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer valid-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			if tc.expectedResponseBodyFixture == "" {
+				assert.Empty(t, bodyBytes)
+				return
+			}
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+			assert.Equal(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/terms"
+	"github.com/rs/zerolog"
+)
+
+type TermsService interface {
+	Status(ctx context.Context, driverID int64) ([]terms.Status, error)
+	Accept(ctx context.Context, driverID int64, document, version string) error
+}
+
+type TermsDocument struct {
+	Document       string `json:"document"`
+	CurrentVersion string `json:"current_version"`
+	// AcceptedAt is null until the driver accepts the current version
+	AcceptedAt *time.Time `json:"accepted_at"`
+}
+
+type TermsResponse struct {
+	Documents []TermsDocument `json:"documents"`
+}
+
+type AcceptTermsRequest struct {
+	Document string `json:"document"`
+	Version  string `json:"version"`
+}
+
+// NewTermsStatusEndpoint creates the handler for GET /auth/terms, listing the current version of each document the
+// caller is asked to accept and when they accepted it.
+func NewTermsStatusEndpoint(termsService TermsService) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ctx := request.Context()
+
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		if sessionClaims == nil {
+			zerolog.Ctx(ctx).Error().Msg("claims not found in context")
+			api.DoErrorResponse(ctx, writer)
+			return
+		}
+
+		doTermsStatusResponse(ctx, termsService, sessionClaims.IRacingUserID, writer)
+	})
+}
+
+// NewAcceptTermsEndpoint creates the handler for POST /auth/terms, recording the caller accepting the current version
+// of a document. It sits outside the terms acceptance middleware, since it is how drivers get past it.
+func NewAcceptTermsEndpoint(termsService TermsService) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ctx := request.Context()
+		logger := zerolog.Ctx(ctx)
+
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		if sessionClaims == nil {
+			logger.Error().Msg("claims not found in context")
+			api.DoErrorResponse(ctx, writer)
+			return
+		}
+
+		var req AcceptTermsRequest
+		if err := json.NewDecoder(request.Body).Decode(&req); err != nil {
+			logger.Warn().Err(err).Msg("failed to decode request body")
+			api.DoBadRequestResponse(ctx, api.NewRequestErrors().WithError("invalid request body"), writer)
+			return
+		}
+
+		errs := api.NewRequestErrors()
+		if req.Document == "" {
+			errs = errs.WithFieldError("document", "required")
+		}
+		if req.Version == "" {
+			errs = errs.WithFieldError("version", "required")
+		}
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, writer)
+			return
+		}
+
+		err := termsService.Accept(ctx, sessionClaims.IRacingUserID, req.Document, req.Version)
+		if errors.Is(err, terms.ErrUnknownDocument) {
+			api.DoBadRequestResponse(ctx, errs.WithFieldError("document", err.Error()), writer)
+			return
+		}
+		if errors.Is(err, terms.ErrNotCurrentVersion) {
+			api.DoBadRequestResponse(ctx, errs.WithFieldError("version", err.Error()), writer)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to accept terms")
+			api.DoErrorResponse(ctx, writer)
+			return
+		}
+
+		doTermsStatusResponse(ctx, termsService, sessionClaims.IRacingUserID, writer)
+	})
+}
+
+func doTermsStatusResponse(ctx context.Context, termsService TermsService, driverID int64, writer http.ResponseWriter) {
+	statuses, err := termsService.Status(ctx, driverID)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to get terms status")
+		api.DoErrorResponse(ctx, writer)
+		return
+	}
+
+	response := TermsResponse{Documents: make([]TermsDocument, 0, len(statuses))}
+	for _, status := range statuses {
+		document := TermsDocument{
+			Document:       status.Document,
+			CurrentVersion: status.CurrentVersion,
+		}
+		if status.AcceptedAt != nil {
+			acceptedAt := status.AcceptedAt.UTC()
+			document.AcceptedAt = &acceptedAt
+		}
+		response.Documents = append(response.Documents, document)
+	}
+	api.DoOKResponse(ctx, response, writer)
+}
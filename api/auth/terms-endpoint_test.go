@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/terms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTermsEndpoints(t *testing.T) {
+	acceptedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	statuses := []terms.Status{
+		{Document: terms.DocumentPrivacyPolicy, CurrentVersion: "2026-09-01", AcceptedAt: &acceptedAt},
+		{Document: terms.DocumentTermsOfService, CurrentVersion: "2026-10-01"},
+	}
+
+	testCases := []struct {
+		name string
+
+		method      string
+		requestBody string
+		setupMocks  func(termsService *MockTermsService)
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:   "status",
+			method: http.MethodGet,
+			setupMocks: func(termsService *MockTermsService) {
+				termsService.EXPECT().Status(mock.Anything, int64(1100750)).Return(statuses, nil)
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/auth_terms_status_response.json",
+		},
+		{
+			name:   "status error",
+			method: http.MethodGet,
+			setupMocks: func(termsService *MockTermsService) {
+				termsService.EXPECT().Status(mock.Anything, int64(1100750)).Return(nil, errors.New("db error"))
+			},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/auth_sessions_error_response.json",
+		},
+		{
+			name:        "accept",
+			method:      http.MethodPost,
+			requestBody: `{"document":"tos","version":"2026-10-01"}`,
+			setupMocks: func(termsService *MockTermsService) {
+				termsService.EXPECT().Accept(mock.Anything, int64(1100750), "tos", "2026-10-01").Return(nil)
+				termsService.EXPECT().Status(mock.Anything, int64(1100750)).Return(statuses, nil)
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/auth_terms_status_response.json",
+		},
+		{
+			name:                        "accept invalid body",
+			method:                      http.MethodPost,
+			requestBody:                 `not json`,
+			setupMocks:                  func(termsService *MockTermsService) {},
+			expectedResponseStatus:      http.StatusBadRequest,
+			expectedResponseBodyFixture: "fixtures/auth_terms_invalid_body_response.json",
+		},
+		{
+			name:                        "accept missing fields",
+			method:                      http.MethodPost,
+			requestBody:                 `{}`,
+			setupMocks:                  func(termsService *MockTermsService) {},
+			expectedResponseStatus:      http.StatusBadRequest,
+			expectedResponseBodyFixture: "fixtures/auth_terms_missing_fields_response.json",
+		},
+		{
+			name:        "accept unknown document",
+			method:      http.MethodPost,
+			requestBody: `{"document":"eula","version":"2026-10-01"}`,
+			setupMocks: func(termsService *MockTermsService) {
+				termsService.EXPECT().Accept(mock.Anything, int64(1100750), "eula", "2026-10-01").Return(terms.ErrUnknownDocument)
+			},
+			expectedResponseStatus:      http.StatusBadRequest,
+			expectedResponseBodyFixture: "fixtures/auth_terms_unknown_document_response.json",
+		},
+		{
+			name:        "accept outdated version",
+			method:      http.MethodPost,
+			requestBody: `{"document":"tos","version":"2026-01-01"}`,
+			setupMocks: func(termsService *MockTermsService) {
+				termsService.EXPECT().Accept(mock.Anything, int64(1100750), "tos", "2026-01-01").Return(terms.ErrNotCurrentVersion)
+			},
+			expectedResponseStatus:      http.StatusBadRequest,
+			expectedResponseBodyFixture: "fixtures/auth_terms_not_current_response.json",
+		},
+		{
+			name:        "accept error",
+			method:      http.MethodPost,
+			requestBody: `{"document":"tos","version":"2026-10-01"}`,
+			setupMocks: func(termsService *MockTermsService) {
+				termsService.EXPECT().Accept(mock.Anything, int64(1100750), "tos", "2026-10-01").Return(errors.New("db error"))
+			},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/auth_sessions_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				validateFunc: func(ctx context.Context, token string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+					return &auth.SessionClaims{SessionID: "session-id", IRacingUserID: 1100750}, &auth.SensitiveClaims{}, nil
+				},
+			}
+
+			termsService := NewMockTermsService(t)
+			tc.setupMocks(termsService)
+
+			router := NewRouter(NewMockService(t), termsService, api.AuthMiddleware(validator))
+			handler := correlation.Middleware(func() string { return testCorrelationID })(router)
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, tc.method, ts.URL+"/terms", bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer valid-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/benchmark"
+	"github.com/rs/zerolog"
+)
+
+type ComparisonService interface {
+	GetComparisons(ctx context.Context, driverID int64) ([]benchmark.Comparison, error)
+}
+
+// NewGetBenchmarksEndpoint compares the logged-in driver against the benchmark tables. Only drivers that have opted in
+// to benchmarking, and so contribute to the tables, get a comparison; everyone else gets a 403.
+func NewGetBenchmarksEndpoint(svc ComparisonService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		comparisons, err := svc.GetComparisons(ctx, claims.IRacingUserID)
+		if err != nil {
+			if errors.Is(err, benchmark.ErrNotOptedIn) {
+				api.DoForbiddenResponse(ctx, "benchmarking opt in required", w)
+				return
+			}
+			logger.Error().Err(err).Msg("failed to get benchmark comparisons")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, benchmarksFromComparisons(comparisons), w)
+	})
+}
@@ -0,0 +1,171 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/benchmark"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+type stubTokenValidator struct {
+	sessionClaims   *auth.SessionClaims
+	sensitiveClaims *auth.SensitiveClaims
+	err             error
+}
+
+func (s *stubTokenValidator) ValidateToken(_ context.Context, _ string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+	return s.sessionClaims, s.sensitiveClaims, s.err
+}
+
+func TestGetBenchmarksEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+	computedAt := time.Unix(1700000000, 0)
+	offBest := 1.25
+
+	type getComparisonsCall struct {
+		result []benchmark.Comparison
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionClaims *auth.SessionClaims
+		tokenErr      error
+
+		getComparisonsCall *getComparisonsCall
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "invalid token returns 401",
+			tokenErr:                    errors.New("missing token"),
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/get_benchmarks_unauthorized_response.json",
+		},
+		{
+			name:                        "not opted in returns 403",
+			sessionClaims:               testSessionClaims,
+			getComparisonsCall:          &getComparisonsCall{err: benchmark.ErrNotOptedIn},
+			expectedResponseStatus:      http.StatusForbidden,
+			expectedResponseBodyFixture: "fixtures/get_benchmarks_not_opted_in_response.json",
+		},
+		{
+			name:                        "service error returns 500",
+			sessionClaims:               testSessionClaims,
+			getComparisonsCall:          &getComparisonsCall{err: errors.New("database error")},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/get_benchmarks_error_response.json",
+		},
+		{
+			name:                        "nothing to compare",
+			sessionClaims:               testSessionClaims,
+			getComparisonsCall:          &getComparisonsCall{result: []benchmark.Comparison{}},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_benchmarks_empty_response.json",
+		},
+		{
+			name:          "success",
+			sessionClaims: testSessionClaims,
+			getComparisonsCall: &getComparisonsCall{
+				result: []benchmark.Comparison{
+					{
+						SeriesID:         139,
+						SeriesName:       "Global Mazda MX-5 Cup",
+						TrackID:          219,
+						Races:            6,
+						IncidentsPerRace: 3.5,
+						OffBestPercent:   &offBest,
+						Table: store.BenchmarkTable{
+							SeriesID:           139,
+							TrackID:            219,
+							IRatingBand:        1500,
+							ComputedAt:         computedAt,
+							Drivers:            14,
+							IncidentsPerRace:   store.BenchmarkPercentiles{P10: 0.5, P25: 1, P50: 2.25, P75: 4, P90: 6.5},
+							OffBestPercent:     &store.BenchmarkPercentiles{P10: 0.4, P25: 0.7, P50: 1.1, P75: 1.6, P90: 2.3},
+							ConsistencyDrivers: 11,
+						},
+					},
+					{
+						SeriesID:         200,
+						SeriesName:       "GT3 Challenge",
+						TrackID:          47,
+						Races:            2,
+						IncidentsPerRace: 6,
+						Table: store.BenchmarkTable{
+							SeriesID:           200,
+							TrackID:            47,
+							IRatingBand:        2000,
+							ComputedAt:         computedAt,
+							Drivers:            10,
+							IncidentsPerRace:   store.BenchmarkPercentiles{P10: 1, P25: 2, P50: 4, P75: 6, P90: 9},
+							ConsistencyDrivers: 4,
+						},
+					},
+				},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_benchmarks_success_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				sessionClaims:   tc.sessionClaims,
+				sensitiveClaims: &auth.SensitiveClaims{},
+				err:             tc.tokenErr,
+			}
+
+			mockService := NewMockComparisonService(t)
+			if tc.getComparisonsCall != nil {
+				mockService.EXPECT().GetComparisons(mock.Anything, testSessionClaims.IRacingUserID).Return(tc.getComparisonsCall.result, tc.getComparisonsCall.err)
+			}
+
+			endpoint := NewGetBenchmarksEndpoint(mockService)
+			handler := correlation.Middleware(func() string { return testCorrelationID })(api.AuthMiddleware(validator)(endpoint))
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package benchmark
+
+import (
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/benchmark"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+type Percentiles struct {
+	P10 float64 `json:"p10"`
+	P25 float64 `json:"p25"`
+	P50 float64 `json:"p50"`
+	P75 float64 `json:"p75"`
+	P90 float64 `json:"p90"`
+}
+
+// Measure is the driver's own value for a measure next to the distribution across platform drivers.
+type Measure struct {
+	// Driver is nil when the driver's races don't have the data to measure
+	Driver *float64 `json:"driver"`
+	// Platform is nil when too few platform drivers had the data for a distribution
+	Platform *Percentiles `json:"platform"`
+}
+
+type Comparison struct {
+	SeriesID         int64     `json:"seriesId"`
+	SeriesName       string    `json:"seriesName"`
+	TrackID          int64     `json:"trackId"`
+	IRatingBand      int       `json:"iRatingBand"`
+	Races            int       `json:"races"`
+	PlatformDrivers  int       `json:"platformDrivers"`
+	ComputedAt       time.Time `json:"computedAt"`
+	IncidentsPerRace Measure   `json:"incidentsPerRace"`
+	OffBestPercent   Measure   `json:"offBestPercent"`
+}
+
+type Benchmarks struct {
+	Comparisons []Comparison `json:"comparisons"`
+}
+
+func percentilesFromStore(percentiles store.BenchmarkPercentiles) *Percentiles {
+	return &Percentiles{
+		P10: percentiles.P10,
+		P25: percentiles.P25,
+		P50: percentiles.P50,
+		P75: percentiles.P75,
+		P90: percentiles.P90,
+	}
+}
+
+func benchmarksFromComparisons(comparisons []benchmark.Comparison) Benchmarks {
+	ret := Benchmarks{Comparisons: make([]Comparison, len(comparisons))}
+	for i, c := range comparisons {
+		incidents := c.IncidentsPerRace
+		comparison := Comparison{
+			SeriesID:         c.SeriesID,
+			SeriesName:       c.SeriesName,
+			TrackID:          c.TrackID,
+			IRatingBand:      c.Table.IRatingBand,
+			Races:            c.Races,
+			PlatformDrivers:  c.Table.Drivers,
+			ComputedAt:       c.Table.ComputedAt.UTC(),
+			IncidentsPerRace: Measure{Driver: &incidents, Platform: percentilesFromStore(c.Table.IncidentsPerRace)},
+			OffBestPercent:   Measure{Driver: c.OffBestPercent},
+		}
+		if c.Table.OffBestPercent != nil {
+			comparison.OffBestPercent.Platform = percentilesFromStore(*c.Table.OffBestPercent)
+		}
+		ret.Comparisons[i] = comparison
+	}
+	return ret
+}
@@ -0,0 +1,17 @@
+package benchmark
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+func NewRouter(svc ComparisonService, authMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(authMiddleware)
+
+	r.Get("/", api.WrapWithSegment("getBenchmarks", NewGetBenchmarksEndpoint(svc)).ServeHTTP)
+
+	return r
+}
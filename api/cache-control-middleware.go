@@ -0,0 +1,44 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// CacheControlMiddleware marks successful responses as publicly cacheable, browsers keeping them for maxAge and shared
+// caches such as a CDN for sharedMaxAge. Anything else is marked no-store so an error isn't served from a cache after
+// whatever caused it has cleared. Only suitable for routes whose responses don't depend on who is asking.
+func CacheControlMiddleware(maxAge, sharedMaxAge time.Duration) func(next http.Handler) http.Handler {
+	cacheable := fmt.Sprintf("public, max-age=%d, s-maxage=%d", int(maxAge.Seconds()), int(sharedMaxAge.Seconds()))
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(&cacheControlWriter{ResponseWriter: w, cacheable: cacheable}, r)
+		})
+	}
+}
+
+type cacheControlWriter struct {
+	http.ResponseWriter
+	cacheable   string
+	wroteHeader bool
+}
+
+func (w *cacheControlWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if status >= 200 && status < 300 {
+			w.Header().Set("Cache-Control", w.cacheable)
+		} else {
+			w.Header().Set("Cache-Control", "no-store")
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *cacheControlWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
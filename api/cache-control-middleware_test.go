@@ -0,0 +1,70 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheControlMiddleware(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		handler http.HandlerFunc
+
+		expectedStatus       int
+		expectedCacheControl string
+	}{
+		{
+			name: "success is cacheable",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			expectedStatus:       http.StatusOK,
+			expectedCacheControl: "public, max-age=60, s-maxage=900",
+		},
+		{
+			name: "implicit success is cacheable",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("{}"))
+			},
+			expectedStatus:       http.StatusOK,
+			expectedCacheControl: "public, max-age=60, s-maxage=900",
+		},
+		{
+			name: "not found is not cached",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+			expectedStatus:       http.StatusNotFound,
+			expectedCacheControl: "no-store",
+		},
+		{
+			name: "error is not cached",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Cache-Control", "public, max-age=3600")
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expectedStatus:       http.StatusInternalServerError,
+			expectedCacheControl: "no-store",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(CacheControlMiddleware(time.Minute, 15*time.Minute)(tc.handler))
+			defer ts.Close()
+
+			res, err := http.Get(ts.URL)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+			assert.Equal(t, tc.expectedCacheControl, res.Header.Get("Cache-Control"))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package coaching
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type PracticePlanService interface {
+	GetPracticePlan(ctx context.Context, driverID int64) (*store.PracticePlan, error)
+}
+
+// NewGetPracticePlanEndpoint returns the logged-in driver's practice plan.
+func NewGetPracticePlanEndpoint(svc PracticePlanService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		plan, err := svc.GetPracticePlan(ctx, claims.IRacingUserID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get practice plan")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, practicePlanFromStore(*plan), w)
+	})
+}
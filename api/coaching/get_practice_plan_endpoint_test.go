@@ -0,0 +1,143 @@
+package coaching
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+type stubTokenValidator struct {
+	sessionClaims   *auth.SessionClaims
+	sensitiveClaims *auth.SensitiveClaims
+	err             error
+}
+
+func (s *stubTokenValidator) ValidateToken(_ context.Context, _ string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+	return s.sessionClaims, s.sensitiveClaims, s.err
+}
+
+func TestGetPracticePlanEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+
+	type getPracticePlanCall struct {
+		plan *store.PracticePlan
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionClaims *auth.SessionClaims
+		tokenErr      error
+
+		getPracticePlanCall *getPracticePlanCall
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "invalid token returns 401",
+			tokenErr:                    errors.New("missing token"),
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/get_practice_plan_unauthorized_response.json",
+		},
+		{
+			name:          "service error returns 500",
+			sessionClaims: testSessionClaims,
+			getPracticePlanCall: &getPracticePlanCall{
+				err: errors.New("database error"),
+			},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/get_practice_plan_error_response.json",
+		},
+		{
+			name:          "empty plan",
+			sessionClaims: testSessionClaims,
+			getPracticePlanCall: &getPracticePlanCall{
+				plan: &store.PracticePlan{
+					DriverID:    1100750,
+					GeneratedAt: time.Unix(1700000000, 0),
+					WindowStart: time.Unix(1697580800, 0),
+					Items:       []store.PracticePlanItem{},
+				},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_practice_plan_empty_response.json",
+		},
+		{
+			name:          "success",
+			sessionClaims: testSessionClaims,
+			getPracticePlanCall: &getPracticePlanCall{
+				plan: &store.PracticePlan{
+					DriverID:    1100750,
+					GeneratedAt: time.Unix(1700000000, 0),
+					WindowStart: time.Unix(1697580800, 0),
+					Items: []store.PracticePlanItem{
+						{TrackID: 20, CarID: 1, Focus: store.PracticeFocusRacecraft, RaceCount: 3, Value: 4, Baseline: 1},
+						{TrackID: 30, CarID: 2, Focus: store.PracticeFocusIncidents, RaceCount: 2, Value: 8.5, Baseline: 2.75},
+					},
+				},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_practice_plan_success_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				sessionClaims:   tc.sessionClaims,
+				sensitiveClaims: &auth.SensitiveClaims{},
+				err:             tc.tokenErr,
+			}
+
+			mockService := NewMockPracticePlanService(t)
+			if tc.getPracticePlanCall != nil {
+				mockService.EXPECT().GetPracticePlan(mock.Anything, testSessionClaims.IRacingUserID).Return(tc.getPracticePlanCall.plan, tc.getPracticePlanCall.err)
+			}
+
+			endpoint := NewGetPracticePlanEndpoint(mockService)
+			handler := correlation.Middleware(func() string { return testCorrelationID })(api.AuthMiddleware(validator)(endpoint))
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package coaching
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type WeeklyRecapStore interface {
+	GetWeeklyRecap(ctx context.Context, driverID int64) (*store.WeeklyRecap, error)
+}
+
+// NewGetWeeklyRecapEndpoint returns the logged-in driver's most recent weekly recap. Recaps are only written by the
+// weekly recap job, so a driver who hasn't been through it yet gets a 404.
+func NewGetWeeklyRecapEndpoint(recapStore WeeklyRecapStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		recap, err := recapStore.GetWeeklyRecap(ctx, claims.IRacingUserID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get weekly recap")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+		if recap == nil {
+			api.DoNotFoundResponse(ctx, "no weekly recap yet", w)
+			return
+		}
+
+		api.DoOKResponse(ctx, weeklyRecapFromStore(*recap), w)
+	})
+}
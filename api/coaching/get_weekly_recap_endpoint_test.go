@@ -0,0 +1,136 @@
+package coaching
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWeeklyRecapEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+	weekStart := time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC)
+
+	type getWeeklyRecapCall struct {
+		recap *store.WeeklyRecap
+		err   error
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionClaims *auth.SessionClaims
+		tokenErr      error
+
+		getWeeklyRecapCall *getWeeklyRecapCall
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "invalid token returns 401",
+			tokenErr:                    errors.New("missing token"),
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/get_weekly_recap_unauthorized_response.json",
+		},
+		{
+			name:                        "store error returns 500",
+			sessionClaims:               testSessionClaims,
+			getWeeklyRecapCall:          &getWeeklyRecapCall{err: errors.New("database error")},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/get_weekly_recap_error_response.json",
+		},
+		{
+			name:                        "no recap yet returns 404",
+			sessionClaims:               testSessionClaims,
+			getWeeklyRecapCall:          &getWeeklyRecapCall{},
+			expectedResponseStatus:      http.StatusNotFound,
+			expectedResponseBodyFixture: "fixtures/get_weekly_recap_not_found_response.json",
+		},
+		{
+			name:          "no fatigue",
+			sessionClaims: testSessionClaims,
+			getWeeklyRecapCall: &getWeeklyRecapCall{
+				recap: &store.WeeklyRecap{DriverID: 1100750, WeekStart: weekStart, GeneratedAt: time.Unix(1700000000, 0)},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_weekly_recap_no_fatigue_response.json",
+		},
+		{
+			name:          "fatigue flagged",
+			sessionClaims: testSessionClaims,
+			getWeeklyRecapCall: &getWeeklyRecapCall{
+				recap: &store.WeeklyRecap{
+					DriverID:    1100750,
+					WeekStart:   weekStart,
+					GeneratedAt: time.Unix(1700000000, 0),
+					Fatigue: &store.FatigueFlag{
+						MultiRaceDays: 4,
+						Measures:      []store.FatigueMeasure{store.FatigueMeasureFinishPosition, store.FatigueMeasureConsistency},
+					},
+					Highlights: []string{
+						"Dein Trainingsplan für die Woche ist fertig.",
+						"An deinen letzten 4 Tagen mit mehreren Rennen wurden deine Zielpositionen und Rundenkonstanz mit jedem Rennen eher schlechter.",
+					},
+				},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_weekly_recap_success_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				sessionClaims:   tc.sessionClaims,
+				sensitiveClaims: &auth.SensitiveClaims{},
+				err:             tc.tokenErr,
+			}
+
+			mockStore := NewMockWeeklyRecapStore(t)
+			if tc.getWeeklyRecapCall != nil {
+				mockStore.EXPECT().GetWeeklyRecap(mock.Anything, testSessionClaims.IRacingUserID).Return(tc.getWeeklyRecapCall.recap, tc.getWeeklyRecapCall.err)
+			}
+
+			endpoint := NewGetWeeklyRecapEndpoint(mockStore)
+			handler := correlation.Middleware(func() string { return testCorrelationID })(api.AuthMiddleware(validator)(endpoint))
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package coaching
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/report"
+	"github.com/jonsabados/saturdaysspinout/standings"
+	"github.com/rs/zerolog"
+)
+
+// RecapIDPathParam identifies a weekly recap by the date its race week started, e.g. 2024-03-12.
+const RecapIDPathParam = "recap_id"
+
+type RecapReportService interface {
+	WeeklyRecapPDF(ctx context.Context, driverID int64, weekStart time.Time) (*report.Download, error)
+}
+
+// NewGetWeeklyRecapPDFEndpoint returns a link to download one of the logged-in driver's weekly recaps as a PDF. A
+// recap's PDF is kept once it has been asked for, so drivers can come back for past weeks that were.
+func NewGetWeeklyRecapPDFEndpoint(reportService RecapReportService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		weekStart, err := time.Parse(time.DateOnly, chi.URLParam(r, RecapIDPathParam))
+		if err != nil || !standings.WeekStart(weekStart).Equal(weekStart) {
+			api.DoBadRequestResponse(ctx, api.NewRequestErrors().WithFieldError(RecapIDPathParam, "must be the date a race week started, as YYYY-MM-DD"), w)
+			return
+		}
+
+		download, err := reportService.WeeklyRecapPDF(ctx, claims.IRacingUserID, weekStart)
+		if errors.Is(err, report.ErrRecapNotFound) {
+			api.DoNotFoundResponse(ctx, "no weekly recap for that week", w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get weekly recap pdf")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, ReportDownload{URL: download.URL, ExpiresAt: download.ExpiresAt.UTC()}, w)
+	})
+}
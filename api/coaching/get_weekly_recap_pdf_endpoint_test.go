@@ -0,0 +1,140 @@
+package coaching
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/report"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWeeklyRecapPDFEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+	weekStart := time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC)
+
+	type weeklyRecapPDFCall struct {
+		download *report.Download
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		recapID       string
+		sessionClaims *auth.SessionClaims
+		tokenErr      error
+
+		weeklyRecapPDFCall *weeklyRecapPDFCall
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "invalid token returns 401",
+			recapID:                     "2023-11-14",
+			tokenErr:                    errors.New("missing token"),
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/get_weekly_recap_pdf_unauthorized_response.json",
+		},
+		{
+			name:                        "not a date returns 400",
+			recapID:                     "latest",
+			sessionClaims:               testSessionClaims,
+			expectedResponseStatus:      http.StatusBadRequest,
+			expectedResponseBodyFixture: "fixtures/get_weekly_recap_pdf_invalid_id_response.json",
+		},
+		{
+			name:                        "not the start of a race week returns 400",
+			recapID:                     "2023-11-15",
+			sessionClaims:               testSessionClaims,
+			expectedResponseStatus:      http.StatusBadRequest,
+			expectedResponseBodyFixture: "fixtures/get_weekly_recap_pdf_invalid_id_response.json",
+		},
+		{
+			name:                        "no recap for the week returns 404",
+			recapID:                     "2023-11-14",
+			sessionClaims:               testSessionClaims,
+			weeklyRecapPDFCall:          &weeklyRecapPDFCall{err: report.ErrRecapNotFound},
+			expectedResponseStatus:      http.StatusNotFound,
+			expectedResponseBodyFixture: "fixtures/get_weekly_recap_pdf_not_found_response.json",
+		},
+		{
+			name:                        "service error returns 500",
+			recapID:                     "2023-11-14",
+			sessionClaims:               testSessionClaims,
+			weeklyRecapPDFCall:          &weeklyRecapPDFCall{err: errors.New("s3 error")},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/get_weekly_recap_pdf_error_response.json",
+		},
+		{
+			name:          "success",
+			recapID:       "2023-11-14",
+			sessionClaims: testSessionClaims,
+			weeklyRecapPDFCall: &weeklyRecapPDFCall{
+				download: &report.Download{
+					URL:       "https://report-bucket.s3.amazonaws.com/recaps/1100750/2023-11-14.pdf?X-Amz-Signature=abc",
+					ExpiresAt: time.Date(2023, 11, 16, 9, 15, 0, 0, time.UTC),
+				},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_weekly_recap_pdf_success_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				sessionClaims:   tc.sessionClaims,
+				sensitiveClaims: &auth.SensitiveClaims{},
+				err:             tc.tokenErr,
+			}
+
+			mockService := NewMockRecapReportService(t)
+			if tc.weeklyRecapPDFCall != nil {
+				mockService.EXPECT().WeeklyRecapPDF(mock.Anything, testSessionClaims.IRacingUserID, weekStart).Return(tc.weeklyRecapPDFCall.download, tc.weeklyRecapPDFCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(validator))
+			r.Get("/recaps/{recap_id}/pdf", NewGetWeeklyRecapPDFEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/recaps/"+tc.recapID+"/pdf", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
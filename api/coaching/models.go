@@ -0,0 +1,79 @@
+package coaching
+
+import (
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+type PracticePlanItem struct {
+	TrackID   int64   `json:"trackId"`
+	CarID     int64   `json:"carId"`
+	Focus     string  `json:"focus"`
+	RaceCount int     `json:"raceCount"`
+	Value     float64 `json:"value"`
+	Baseline  float64 `json:"baseline"`
+}
+
+type PracticePlan struct {
+	GeneratedAt time.Time          `json:"generatedAt"`
+	WindowStart time.Time          `json:"windowStart"`
+	Items       []PracticePlanItem `json:"items"`
+}
+
+func practicePlanFromStore(plan store.PracticePlan) PracticePlan {
+	items := make([]PracticePlanItem, len(plan.Items))
+	for i, item := range plan.Items {
+		items[i] = PracticePlanItem{
+			TrackID:   item.TrackID,
+			CarID:     item.CarID,
+			Focus:     string(item.Focus),
+			RaceCount: item.RaceCount,
+			Value:     item.Value,
+			Baseline:  item.Baseline,
+		}
+	}
+	return PracticePlan{
+		GeneratedAt: plan.GeneratedAt.UTC(),
+		WindowStart: plan.WindowStart.UTC(),
+		Items:       items,
+	}
+}
+
+// FatigueFlag is a heads up that the driver's results tend to tail off over a day of several races.
+type FatigueFlag struct {
+	MultiRaceDays int      `json:"multiRaceDays"`
+	Measures      []string `json:"measures"`
+}
+
+type WeeklyRecap struct {
+	WeekStart   time.Time    `json:"weekStart"`
+	GeneratedAt time.Time    `json:"generatedAt"`
+	Fatigue     *FatigueFlag `json:"fatigue"`
+	// Highlights are the recap's lines of text, in the driver's locale as of when the recap was prepared
+	Highlights []string `json:"highlights"`
+}
+
+func weeklyRecapFromStore(recap store.WeeklyRecap) WeeklyRecap {
+	ret := WeeklyRecap{
+		WeekStart:   recap.WeekStart.UTC(),
+		GeneratedAt: recap.GeneratedAt.UTC(),
+		// recaps prepared before highlights were added have none
+		Highlights: make([]string, 0, len(recap.Highlights)),
+	}
+	ret.Highlights = append(ret.Highlights, recap.Highlights...)
+	if recap.Fatigue != nil {
+		measures := make([]string, len(recap.Fatigue.Measures))
+		for i, measure := range recap.Fatigue.Measures {
+			measures[i] = string(measure)
+		}
+		ret.Fatigue = &FatigueFlag{MultiRaceDays: recap.Fatigue.MultiRaceDays, Measures: measures}
+	}
+	return ret
+}
+
+// ReportDownload is a presigned link to download a report, good until ExpiresAt.
+type ReportDownload struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
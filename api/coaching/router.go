@@ -0,0 +1,25 @@
+package coaching
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/supporter"
+)
+
+// NewRouter builds the coaching routes. reportService may be nil when recap reports aren't configured, in which case
+// the PDF route isn't registered.
+func NewRouter(svc PracticePlanService, recapStore WeeklyRecapStore, reportService RecapReportService, authMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(authMiddleware)
+
+	r.Get("/practice-plan", api.WrapWithSegment("getPracticePlan", NewGetPracticePlanEndpoint(svc)).ServeHTTP)
+	r.Get("/weekly-recap", api.WrapWithSegment("getWeeklyRecap", NewGetWeeklyRecapEndpoint(recapStore)).ServeHTTP)
+	if reportService != nil {
+		r.With(api.EntitlementMiddleware(supporter.Entitlement)).Get(fmt.Sprintf("/recaps/{%s}/pdf", RecapIDPathParam), api.WrapWithSegment("getWeeklyRecapPdf", NewGetWeeklyRecapPDFEndpoint(reportService)).ServeHTTP)
+	}
+
+	return r
+}
@@ -7,9 +7,13 @@ import (
 	"net/http"
 	"slices"
 
+	"github.com/jonsabados/saturdaysspinout/buildinfo"
 	"github.com/jonsabados/saturdaysspinout/correlation"
 )
 
+// BuildSHAHeader is set on error responses so bug reports can be matched to the deployment that produced them
+const BuildSHAHeader = "X-Build-SHA"
+
 type ErrorResponse struct {
 	Message       string `json:"message"`
 	CorrelationID string `json:"correlationId"`
@@ -17,6 +21,7 @@ type ErrorResponse struct {
 
 func DoErrorResponse(ctx context.Context, writer http.ResponseWriter) {
 	writer.Header().Add("content-type", "application/json")
+	writer.Header().Set(BuildSHAHeader, buildinfo.GitSHA)
 	writer.WriteHeader(http.StatusInternalServerError)
 	bytes, err := json.Marshal(ErrorResponse{
 		Message:       "An unexpected error has been encountered. Please reference the included correlation id in any support inquires.",
@@ -112,6 +117,45 @@ func DoAcceptedResponse(ctx context.Context, Response interface{}, writer http.R
 	_, _ = writer.Write(bytes)
 }
 
+type ServiceUnavailableResponse struct {
+	Response      interface{} `json:"response"`
+	CorrelationID string      `json:"correlationId"`
+}
+
+func DoServiceUnavailableResponse(ctx context.Context, Response interface{}, writer http.ResponseWriter) {
+	writer.Header().Add("content-type", "application/json")
+	writer.WriteHeader(http.StatusServiceUnavailable)
+	bytes, err := json.Marshal(ServiceUnavailableResponse{
+		Response:      Response,
+		CorrelationID: correlation.FromContext(ctx),
+	})
+	if err != nil {
+		panic(fmt.Errorf("error marshalling ServiceUnavailableResponse, this should not happen: %w", err))
+	}
+	_, _ = writer.Write(bytes)
+}
+
+type UpstreamUnavailableResponse struct {
+	Message       string `json:"message"`
+	RetryAfter    int    `json:"retryAfter"`
+	CorrelationID string `json:"correlationId"`
+}
+
+func DoUpstreamUnavailableResponse(ctx context.Context, message string, retryAfterSeconds int, writer http.ResponseWriter) {
+	writer.Header().Add("content-type", "application/json")
+	writer.Header().Add("Retry-After", fmt.Sprintf("%d", retryAfterSeconds))
+	writer.WriteHeader(http.StatusServiceUnavailable)
+	bytes, err := json.Marshal(UpstreamUnavailableResponse{
+		Message:       message,
+		RetryAfter:    retryAfterSeconds,
+		CorrelationID: correlation.FromContext(ctx),
+	})
+	if err != nil {
+		panic(fmt.Errorf("error marshalling UpstreamUnavailableResponse, this should not happen: %w", err))
+	}
+	_, _ = writer.Write(bytes)
+}
+
 type UnauthorizedResponse struct {
 	Message       string `json:"message"`
 	CorrelationID string `json:"correlationId"`
@@ -187,6 +231,32 @@ func DoTooManyRequestsResponse(ctx context.Context, message string, retryAfterSe
 	_, _ = writer.Write(bytes)
 }
 
+// RequiredTerms is a document version the driver must accept before continuing
+type RequiredTerms struct {
+	Document string `json:"document"`
+	Version  string `json:"version"`
+}
+
+type PreconditionRequiredResponse struct {
+	Message       string          `json:"message"`
+	Required      []RequiredTerms `json:"required"`
+	CorrelationID string          `json:"correlationId"`
+}
+
+func DoPreconditionRequiredResponse(ctx context.Context, message string, required []RequiredTerms, writer http.ResponseWriter) {
+	writer.Header().Add("content-type", "application/json")
+	writer.WriteHeader(http.StatusPreconditionRequired)
+	bytes, err := json.Marshal(PreconditionRequiredResponse{
+		Message:       message,
+		Required:      required,
+		CorrelationID: correlation.FromContext(ctx),
+	})
+	if err != nil {
+		panic(fmt.Errorf("error marshalling PreconditionRequiredResponse, this should not happen: %w", err))
+	}
+	_, _ = writer.Write(bytes)
+}
+
 type OKResponse struct {
 	Response      interface{} `json:"response"`
 	CorrelationID string      `json:"correlationId"`
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-chi/cors"
+
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+// CORSConfig is the cross origin policy for the authenticated routes.
+type CORSConfig struct {
+	// AllowedOrigins are the origins browsers may call from. Each may hold a single * wildcard, such as
+	// https://*.example.com, and a bare * allows any origin.
+	AllowedOrigins []string
+	// AllowCredentials lets browsers send cookies and other credentials cross origin. Bearer tokens don't need it.
+	AllowCredentials bool
+	// PreflightMaxAge is how long browsers may cache a preflight response.
+	PreflightMaxAge time.Duration
+}
+
+// Validate checks the policy makes sense. An empty origin list would allow every origin, and allowing every origin
+// along with credentials would let any site make authenticated calls on a visitor's behalf, so both are rejected.
+func (c CORSConfig) Validate() error {
+	if len(c.AllowedOrigins) == 0 {
+		return errors.New("at least one allowed origin is required")
+	}
+	for _, origin := range c.AllowedOrigins {
+		if strings.Count(origin, "*") > 1 {
+			return errors.New("allowed origins may only hold one wildcard: " + origin)
+		}
+	}
+	if c.AllowCredentials && slices.Contains(c.AllowedOrigins, "*") {
+		return errors.New("credentials can't be allowed for any origin")
+	}
+	if c.PreflightMaxAge < 0 {
+		return errors.New("preflight max age can't be negative")
+	}
+	return nil
+}
+
+// CORSMiddleware answers preflight requests and sets the CORS headers on responses to origins the policy allows.
+func CORSMiddleware(cfg CORSConfig) func(next http.Handler) http.Handler {
+	return cors.Handler(cors.Options{
+		AllowedOrigins:   cfg.AllowedOrigins,
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-Correlation-ID", tenant.HeaderName},
+		ExposedHeaders:   []string{"X-Correlation-ID", BuildSHAHeader},
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           int(cfg.PreflightMaxAge.Seconds()),
+	})
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		cfg CORSConfig
+
+		expectErr bool
+	}{
+		{
+			name: "valid",
+			cfg: CORSConfig{
+				AllowedOrigins:   []string{"https://app.example.com", "https://*.example.com"},
+				AllowCredentials: true,
+				PreflightMaxAge:  5 * time.Minute,
+			},
+		},
+		{
+			name: "any origin without credentials",
+			cfg:  CORSConfig{AllowedOrigins: []string{"*"}},
+		},
+		{
+			name:      "no origins",
+			cfg:       CORSConfig{},
+			expectErr: true,
+		},
+		{
+			name: "any origin with credentials",
+			cfg: CORSConfig{
+				AllowedOrigins:   []string{"https://app.example.com", "*"},
+				AllowCredentials: true,
+			},
+			expectErr: true,
+		},
+		{
+			name:      "more than one wildcard",
+			cfg:       CORSConfig{AllowedOrigins: []string{"https://*.*.example.com"}},
+			expectErr: true,
+		},
+		{
+			name: "negative max age",
+			cfg: CORSConfig{
+				AllowedOrigins:  []string{"https://app.example.com"},
+				PreflightMaxAge: -time.Second,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		cfg       CORSConfig
+		method    string
+		origin    string
+		preflight bool
+
+		expectNextCalled    bool
+		expectedAllowOrigin string
+		expectedCredentials string
+		expectedMaxAge      string
+	}{
+		{
+			name: "preflight from allowed origin",
+			cfg: CORSConfig{
+				AllowedOrigins:   []string{"https://app.example.com"},
+				AllowCredentials: true,
+				PreflightMaxAge:  10 * time.Minute,
+			},
+			method:              http.MethodOptions,
+			origin:              "https://app.example.com",
+			preflight:           true,
+			expectedAllowOrigin: "https://app.example.com",
+			expectedCredentials: "true",
+			expectedMaxAge:      "600",
+		},
+		{
+			name: "preflight from wildcard origin",
+			cfg: CORSConfig{
+				AllowedOrigins:  []string{"https://*.example.com"},
+				PreflightMaxAge: time.Minute,
+			},
+			method:              http.MethodOptions,
+			origin:              "https://racing.example.com",
+			preflight:           true,
+			expectedAllowOrigin: "https://racing.example.com",
+			expectedMaxAge:      "60",
+		},
+		{
+			name: "preflight from other origin",
+			cfg: CORSConfig{
+				AllowedOrigins:   []string{"https://app.example.com"},
+				AllowCredentials: true,
+			},
+			method:    http.MethodOptions,
+			origin:    "https://elsewhere.example.org",
+			preflight: true,
+		},
+		{
+			name: "request from allowed origin",
+			cfg: CORSConfig{
+				AllowedOrigins:   []string{"https://app.example.com"},
+				AllowCredentials: true,
+			},
+			method:              http.MethodGet,
+			origin:              "https://app.example.com",
+			expectNextCalled:    true,
+			expectedAllowOrigin: "https://app.example.com",
+			expectedCredentials: "true",
+		},
+		{
+			name: "request from allowed origin without credentials",
+			cfg: CORSConfig{
+				AllowedOrigins: []string{"https://app.example.com"},
+			},
+			method:              http.MethodGet,
+			origin:              "https://app.example.com",
+			expectNextCalled:    true,
+			expectedAllowOrigin: "https://app.example.com",
+		},
+		{
+			name: "request from other origin",
+			cfg: CORSConfig{
+				AllowedOrigins: []string{"https://app.example.com"},
+			},
+			method:           http.MethodGet,
+			origin:           "https://elsewhere.example.org",
+			expectNextCalled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nextCalled := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nextCalled = true
+			})
+
+			req := httptest.NewRequest(tc.method, "/driver/12345", nil)
+			req.Header.Set("Origin", tc.origin)
+			if tc.preflight {
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				req.Header.Set("Access-Control-Request-Headers", "Authorization, Content-Type")
+			}
+			rec := httptest.NewRecorder()
+
+			CORSMiddleware(tc.cfg)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectNextCalled, nextCalled)
+			assert.Equal(t, tc.expectedAllowOrigin, rec.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tc.expectedCredentials, rec.Header().Get("Access-Control-Allow-Credentials"))
+			assert.Equal(t, tc.expectedMaxAge, rec.Header().Get("Access-Control-Max-Age"))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package dashboard
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type LayoutServiceForGet interface {
+	Get(ctx context.Context, driverID int64) (*store.DashboardLayout, error)
+}
+
+// NewGetLayoutEndpoint returns the logged-in driver's dashboard layout in the current schema version. A driver who has
+// never saved one gets an empty layout with a null updatedAt, for the frontend to fill in with its defaults.
+func NewGetLayoutEndpoint(svc LayoutServiceForGet) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		layout, err := svc.Get(ctx, claims.IRacingUserID)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to get dashboard layout")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, layoutFromStore(*layout), w)
+	})
+}
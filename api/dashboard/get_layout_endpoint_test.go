@@ -0,0 +1,136 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+type stubTokenValidator struct {
+	sessionClaims   *auth.SessionClaims
+	sensitiveClaims *auth.SensitiveClaims
+	err             error
+}
+
+func (s *stubTokenValidator) ValidateToken(_ context.Context, _ string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+	return s.sessionClaims, s.sensitiveClaims, s.err
+}
+
+func TestGetLayoutEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+
+	type getCall struct {
+		layout *store.DashboardLayout
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionClaims *auth.SessionClaims
+		tokenErr      error
+
+		getCall *getCall
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "invalid token returns 401",
+			tokenErr:                    errors.New("missing token"),
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/get_layout_unauthorized_response.json",
+		},
+		{
+			name:                        "service error returns 500",
+			sessionClaims:               testSessionClaims,
+			getCall:                     &getCall{err: errors.New("database error")},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/get_layout_error_response.json",
+		},
+		{
+			name:          "never saved",
+			sessionClaims: testSessionClaims,
+			getCall: &getCall{
+				layout: &store.DashboardLayout{DriverID: 1100750, SchemaVersion: 1, Widgets: []store.DashboardWidget{}},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_layout_empty_response.json",
+		},
+		{
+			name:          "saved layout",
+			sessionClaims: testSessionClaims,
+			getCall: &getCall{
+				layout: &store.DashboardLayout{
+					DriverID:      1100750,
+					SchemaVersion: 1,
+					Widgets: []store.DashboardWidget{
+						{WidgetID: "w1", Card: "irating", Column: 0, Row: 0, Width: 8, Height: 4, Days: 90, Granularity: "week", SeriesIDs: []int64{285}},
+						{WidgetID: "w2", Card: "incidents", Column: 8, Row: 0, Width: 4, Height: 4, GroupBy: []string{"track"}, CarIDs: []int64{7}, TrackIDs: []int64{42, 9}},
+					},
+					UpdatedAt: time.Unix(1700000000, 0),
+				},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_layout_success_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				sessionClaims:   tc.sessionClaims,
+				sensitiveClaims: &auth.SensitiveClaims{},
+				err:             tc.tokenErr,
+			}
+
+			mockService := NewMockLayoutServiceForGet(t)
+			if tc.getCall != nil {
+				mockService.EXPECT().Get(mock.Anything, testSessionClaims.IRacingUserID).Return(tc.getCall.layout, tc.getCall.err)
+			}
+
+			endpoint := NewGetLayoutEndpoint(mockService)
+			handler := correlation.Middleware(func() string { return testCorrelationID })(api.AuthMiddleware(validator)(endpoint))
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
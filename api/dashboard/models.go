@@ -0,0 +1,97 @@
+package dashboard
+
+import (
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// Widget is a card on the dashboard. Column, Row, Width and Height place it on the dashboard's 12 column grid, the rest
+// is what the card shows. Empty filters cover every series, car or track.
+type Widget struct {
+	ID          string   `json:"id"`
+	Card        string   `json:"card"`
+	Column      int      `json:"column"`
+	Row         int      `json:"row"`
+	Width       int      `json:"width"`
+	Height      int      `json:"height"`
+	Days        int      `json:"days"`
+	Granularity string   `json:"granularity,omitempty"`
+	GroupBy     []string `json:"groupBy"`
+	SeriesIDs   []int64  `json:"seriesIds"`
+	CarIDs      []int64  `json:"carIds"`
+	TrackIDs    []int64  `json:"trackIds"`
+}
+
+type Layout struct {
+	SchemaVersion int      `json:"schemaVersion"`
+	Widgets       []Widget `json:"widgets"`
+	// UpdatedAt is null until the driver saves a layout
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+type SaveLayoutRequest struct {
+	SchemaVersion int      `json:"schemaVersion"`
+	Widgets       []Widget `json:"widgets"`
+}
+
+func layoutFromStore(layout store.DashboardLayout) Layout {
+	widgets := make([]Widget, len(layout.Widgets))
+	for i, widget := range layout.Widgets {
+		widgets[i] = Widget{
+			ID:          widget.WidgetID,
+			Card:        widget.Card,
+			Column:      widget.Column,
+			Row:         widget.Row,
+			Width:       widget.Width,
+			Height:      widget.Height,
+			Days:        widget.Days,
+			Granularity: widget.Granularity,
+			GroupBy:     nonNil(widget.GroupBy),
+			SeriesIDs:   nonNil(widget.SeriesIDs),
+			CarIDs:      nonNil(widget.CarIDs),
+			TrackIDs:    nonNil(widget.TrackIDs),
+		}
+	}
+	ret := Layout{
+		SchemaVersion: layout.SchemaVersion,
+		Widgets:       widgets,
+	}
+	if !layout.UpdatedAt.IsZero() {
+		updatedAt := layout.UpdatedAt.UTC()
+		ret.UpdatedAt = &updatedAt
+	}
+	return ret
+}
+
+func layoutFromRequest(driverID int64, req SaveLayoutRequest) store.DashboardLayout {
+	widgets := make([]store.DashboardWidget, len(req.Widgets))
+	for i, widget := range req.Widgets {
+		widgets[i] = store.DashboardWidget{
+			WidgetID:    widget.ID,
+			Card:        widget.Card,
+			Column:      widget.Column,
+			Row:         widget.Row,
+			Width:       widget.Width,
+			Height:      widget.Height,
+			Days:        widget.Days,
+			Granularity: widget.Granularity,
+			GroupBy:     widget.GroupBy,
+			SeriesIDs:   widget.SeriesIDs,
+			CarIDs:      widget.CarIDs,
+			TrackIDs:    widget.TrackIDs,
+		}
+	}
+	return store.DashboardLayout{
+		DriverID:      driverID,
+		SchemaVersion: req.SchemaVersion,
+		Widgets:       widgets,
+	}
+}
+
+func nonNil[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
@@ -0,0 +1,24 @@
+package dashboard
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+type LayoutService interface {
+	LayoutServiceForGet
+	LayoutServiceForSave
+}
+
+// NewRouter builds the dashboard routes, which all act on the logged-in driver's own dashboard.
+func NewRouter(svc LayoutService, authMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(authMiddleware)
+
+	r.Get("/layout", api.WrapWithSegment("getDashboardLayout", NewGetLayoutEndpoint(svc)).ServeHTTP)
+	r.Put("/layout", api.WrapWithSegment("saveDashboardLayout", NewSaveLayoutEndpoint(svc)).ServeHTTP)
+
+	return r
+}
@@ -0,0 +1,54 @@
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type LayoutServiceForSave interface {
+	Save(ctx context.Context, layout store.DashboardLayout) (*store.DashboardLayout, []journal.FieldValidation, error)
+}
+
+// NewSaveLayoutEndpoint replaces the logged-in driver's dashboard layout. Layouts from an older schema version are
+// migrated before saving, and the layout as saved is returned so the frontend picks up any migration.
+func NewSaveLayoutEndpoint(svc LayoutServiceForSave) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		var req SaveLayoutRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			api.DoBadRequestResponse(ctx, api.NewRequestErrors().WithError("invalid JSON body"), w)
+			return
+		}
+
+		layout, validations, err := svc.Save(ctx, layoutFromRequest(claims.IRacingUserID, req))
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to save dashboard layout")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+		if len(validations) > 0 {
+			errs := api.NewRequestErrors()
+			for _, v := range validations {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, layoutFromStore(*layout), w)
+	})
+}
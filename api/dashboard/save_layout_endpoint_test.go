@@ -0,0 +1,142 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveLayoutEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+	requestBody := `{"schemaVersion": 1, "widgets": [{"id": "w1", "card": "irating", "column": 0, "row": 0, "width": 8, "height": 4, "days": 90, "granularity": "week", "seriesIds": [285]}]}`
+	requestLayout := store.DashboardLayout{
+		DriverID:      1100750,
+		SchemaVersion: 1,
+		Widgets: []store.DashboardWidget{
+			{WidgetID: "w1", Card: "irating", Column: 0, Row: 0, Width: 8, Height: 4, Days: 90, Granularity: "week", SeriesIDs: []int64{285}},
+		},
+	}
+	savedLayout := requestLayout
+	savedLayout.UpdatedAt = time.Unix(1700000000, 0)
+
+	type saveCall struct {
+		layout      *store.DashboardLayout
+		validations []journal.FieldValidation
+		err         error
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionClaims *auth.SessionClaims
+		tokenErr      error
+		requestBody   string
+
+		saveCall *saveCall
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "invalid token returns 401",
+			tokenErr:                    errors.New("missing token"),
+			requestBody:                 requestBody,
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/save_layout_unauthorized_response.json",
+		},
+		{
+			name:                        "invalid JSON returns 400",
+			sessionClaims:               testSessionClaims,
+			requestBody:                 `{"widgets": `,
+			expectedResponseStatus:      http.StatusBadRequest,
+			expectedResponseBodyFixture: "fixtures/save_layout_invalid_json_response.json",
+		},
+		{
+			name:          "invalid layout returns 400",
+			sessionClaims: testSessionClaims,
+			requestBody:   requestBody,
+			saveCall: &saveCall{
+				validations: []journal.FieldValidation{
+					{Field: "schemaVersion", Code: "out_of_range", Params: map[string]string{"min": "1", "max": "1"}},
+					{Field: "widgets[0].card", Code: "required"},
+				},
+			},
+			expectedResponseStatus:      http.StatusBadRequest,
+			expectedResponseBodyFixture: "fixtures/save_layout_invalid_layout_response.json",
+		},
+		{
+			name:                        "service error returns 500",
+			sessionClaims:               testSessionClaims,
+			requestBody:                 requestBody,
+			saveCall:                    &saveCall{err: errors.New("database error")},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/save_layout_error_response.json",
+		},
+		{
+			name:                        "success",
+			sessionClaims:               testSessionClaims,
+			requestBody:                 requestBody,
+			saveCall:                    &saveCall{layout: &savedLayout},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/save_layout_success_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				sessionClaims:   tc.sessionClaims,
+				sensitiveClaims: &auth.SensitiveClaims{},
+				err:             tc.tokenErr,
+			}
+
+			mockService := NewMockLayoutServiceForSave(t)
+			if tc.saveCall != nil {
+				mockService.EXPECT().Save(mock.Anything, requestLayout).Return(tc.saveCall.layout, tc.saveCall.validations, tc.saveCall.err)
+			}
+
+			endpoint := NewSaveLayoutEndpoint(mockService)
+			handler := correlation.Middleware(func() string { return testCorrelationID })(api.AuthMiddleware(validator)(endpoint))
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPut, ts.URL, strings.NewReader(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package developer
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/ingestion"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type CoverageStore interface {
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	GetIngestionCoverage(ctx context.Context, driverID int64, opts ...store.ReadOption) (store.Coverage, error)
+}
+
+type CoverageResponse struct {
+	DriverID        int64       `json:"driverId"`
+	MemberSince     time.Time   `json:"memberSince"`
+	RacesIngestedTo *time.Time  `json:"racesIngestedTo"`
+	CoveredPercent  float64     `json:"coveredPercent"`
+	Covered         []TimeRange `json:"covered"`
+	Gaps            []TimeRange `json:"gaps"`
+}
+
+type TimeRange struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// NewCoverageEndpoint creates the handler for GET /developer/coverage, laying out which stretches of a driver's history
+// have had their races ingested and which are still missing between when they joined and how far ingestion has got.
+func NewCoverageEndpoint(coverageStore CoverageStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		var driverID int64
+		driverIDStr := r.URL.Query().Get(api.DriverIDQueryParam)
+		if driverIDStr == "" {
+			errs = errs.WithFieldErrorCode(api.DriverIDQueryParam, ErrCodeRequired, nil)
+		} else {
+			var err error
+			driverID, err = strconv.ParseInt(driverIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.DriverIDQueryParam, ErrCodeInvalidInteger, map[string]string{"value": driverIDStr})
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		driver, err := coverageStore.GetDriver(ctx, driverID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get driver")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+		if driver == nil {
+			api.DoNotFoundResponse(ctx, "driver not found", w)
+			return
+		}
+
+		stored, err := coverageStore.GetIngestionCoverage(ctx, driverID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get ingestion coverage")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, coverageFromDriver(*driver, ingestion.DriverCoverage(*driver, stored)), w)
+	})
+}
+
+func coverageFromDriver(driver store.Driver, coverage store.Coverage) CoverageResponse {
+	response := CoverageResponse{
+		DriverID:    driver.DriverID,
+		MemberSince: driver.MemberSince.UTC(),
+		Covered:     timeRangesFromStore(coverage),
+		Gaps:        []TimeRange{},
+	}
+	if driver.RacesIngestedTo == nil {
+		return response
+	}
+
+	ingestedTo := driver.RacesIngestedTo.UTC()
+	response.RacesIngestedTo = &ingestedTo
+	gaps := coverage.Gaps(driver.MemberSince, ingestedTo)
+	response.Gaps = timeRangesFromStore(gaps)
+
+	if span := ingestedTo.Sub(driver.MemberSince); span > 0 {
+		var missing time.Duration
+		for _, gap := range gaps {
+			missing += gap.To.Sub(gap.From)
+		}
+		response.CoveredPercent = float64(span-missing) / float64(span) * 100
+	}
+	return response
+}
+
+func timeRangesFromStore(ranges []store.TimeRange) []TimeRange {
+	result := make([]TimeRange, len(ranges))
+	for i, r := range ranges {
+		result[i] = TimeRange{From: r.From.UTC(), To: r.To.UTC()}
+	}
+	return result
+}
@@ -0,0 +1,172 @@
+package developer
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCoverageEndpoint(t *testing.T) {
+	memberSince := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ingestedFrom := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	ingestedTo := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	type getDriverCall struct {
+		driverID int64
+		result   *store.Driver
+		err      error
+	}
+
+	type getCoverageCall struct {
+		driverID int64
+		result   store.Coverage
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		query string
+
+		getDriverCall   *getDriverCall
+		getCoverageCall *getCoverageCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:  "success",
+			query: "?driverId=12345",
+			getDriverCall: &getDriverCall{
+				driverID: 12345,
+				result: &store.Driver{
+					DriverID:          12345,
+					MemberSince:       memberSince,
+					RacesIngestedFrom: &ingestedFrom,
+					RacesIngestedTo:   &ingestedTo,
+				},
+			},
+			getCoverageCall: &getCoverageCall{
+				driverID: 12345,
+				result: store.Coverage{
+					{From: memberSince, To: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+					{From: ingestedFrom, To: ingestedTo},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/coverage_success_response.json",
+		},
+		{
+			name:  "ingested before coverage was recorded",
+			query: "?driverId=12345",
+			getDriverCall: &getDriverCall{
+				driverID: 12345,
+				result: &store.Driver{
+					DriverID:        12345,
+					MemberSince:     memberSince,
+					RacesIngestedTo: &ingestedTo,
+				},
+			},
+			getCoverageCall:     &getCoverageCall{driverID: 12345},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/coverage_legacy_response.json",
+		},
+		{
+			name:  "never ingested",
+			query: "?driverId=12345",
+			getDriverCall: &getDriverCall{
+				driverID: 12345,
+				result:   &store.Driver{DriverID: 12345, MemberSince: memberSince},
+			},
+			getCoverageCall:     &getCoverageCall{driverID: 12345},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/coverage_never_ingested_response.json",
+		},
+		{
+			name:                "missing driver id",
+			query:               "",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/coverage_missing_driver_id_response.json",
+		},
+		{
+			name:                "invalid driver id",
+			query:               "?driverId=abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/coverage_invalid_driver_id_response.json",
+		},
+		{
+			name:                "driver not found",
+			query:               "?driverId=12345",
+			getDriverCall:       &getDriverCall{driverID: 12345},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/coverage_not_found_response.json",
+		},
+		{
+			name:                "get driver error",
+			query:               "?driverId=12345",
+			getDriverCall:       &getDriverCall{driverID: 12345, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/coverage_store_error_response.json",
+		},
+		{
+			name:  "get coverage error",
+			query: "?driverId=12345",
+			getDriverCall: &getDriverCall{
+				driverID: 12345,
+				result:   &store.Driver{DriverID: 12345, MemberSince: memberSince},
+			},
+			getCoverageCall:     &getCoverageCall{driverID: 12345, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/coverage_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockCoverageStore(t)
+			if tc.getDriverCall != nil {
+				mockStore.EXPECT().GetDriver(mock.Anything, tc.getDriverCall.driverID).
+					Return(tc.getDriverCall.result, tc.getDriverCall.err)
+			}
+			if tc.getCoverageCall != nil {
+				mockStore.EXPECT().GetIngestionCoverage(mock.Anything, tc.getCoverageCall.driverID).
+					Return(tc.getCoverageCall.result, tc.getCoverageCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/coverage", NewCoverageEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/coverage"+tc.query, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
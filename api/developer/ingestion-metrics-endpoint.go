@@ -0,0 +1,137 @@
+package developer
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const defaultIngestionRunLimit = 50
+
+// Error codes for i18n support
+const (
+	ErrCodeRequired        = "required"
+	ErrCodeInvalidInteger  = "invalid_integer"
+	ErrCodePositiveInteger = "positive_integer"
+)
+
+type IngestionRunStore interface {
+	GetRecentIngestionRuns(ctx context.Context, limit int) ([]store.IngestionRun, error)
+}
+
+type IngestionMetricsResponse struct {
+	Summary IngestionMetricsSummary `json:"summary"`
+	Runs    []IngestionRun          `json:"runs"`
+}
+
+type IngestionMetricsSummary struct {
+	RunCount            int              `json:"runCount"`
+	FailedCount         int              `json:"failedCount"`
+	AvgDurationMs       int64            `json:"avgDurationMs"`
+	MaxDurationMs       int64            `json:"maxDurationMs"`
+	AvgPhaseDurationsMs map[string]int64 `json:"avgPhaseDurationsMs"`
+}
+
+type IngestionRun struct {
+	DriverID         int64            `json:"driverId"`
+	StartedAt        time.Time        `json:"startedAt"`
+	DurationMs       int64            `json:"durationMs"`
+	RaceCount        int              `json:"raceCount"`
+	NewRaceCount     int              `json:"newRaceCount"`
+	LapCount         int              `json:"lapCount"`
+	UpToDate         bool             `json:"upToDate"`
+	Error            string           `json:"error,omitempty"`
+	CaptureID        string           `json:"captureId,omitempty"`
+	PhaseDurationsMs map[string]int64 `json:"phaseDurationsMs"`
+}
+
+// NewIngestionMetricsEndpoint creates the handler for GET /developer/ingestion-metrics, summarizing the most recent
+// race ingestion runs across all drivers.
+func NewIngestionMetricsEndpoint(runStore IngestionRunStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		limit := defaultIngestionRunLimit
+		if limitStr := r.URL.Query().Get(api.LimitQueryParam); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.LimitQueryParam, ErrCodeInvalidInteger, map[string]string{"value": limitStr})
+			} else if limit < 1 {
+				errs = errs.WithFieldErrorCode(api.LimitQueryParam, ErrCodePositiveInteger, map[string]string{"value": limitStr})
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		runs, err := runStore.GetRecentIngestionRuns(ctx, limit)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get ingestion runs")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, ingestionMetricsFromStore(runs), w)
+	})
+}
+
+func ingestionMetricsFromStore(runs []store.IngestionRun) IngestionMetricsResponse {
+	response := IngestionMetricsResponse{
+		Summary: IngestionMetricsSummary{
+			RunCount:            len(runs),
+			AvgPhaseDurationsMs: make(map[string]int64),
+		},
+		Runs: make([]IngestionRun, len(runs)),
+	}
+
+	var totalDuration time.Duration
+	phaseTotals := make(map[string]time.Duration)
+	for i, run := range runs {
+		phases := make(map[string]int64, len(run.PhaseDurations))
+		for phase, d := range run.PhaseDurations {
+			phases[phase] = d.Milliseconds()
+			phaseTotals[phase] += d
+		}
+		response.Runs[i] = IngestionRun{
+			DriverID:         run.DriverID,
+			StartedAt:        run.StartedAt,
+			DurationMs:       run.Duration.Milliseconds(),
+			RaceCount:        run.RaceCount,
+			NewRaceCount:     run.NewRaceCount,
+			LapCount:         run.LapCount,
+			UpToDate:         run.UpToDate,
+			Error:            run.Error,
+			CaptureID:        run.CaptureID,
+			PhaseDurationsMs: phases,
+		}
+
+		totalDuration += run.Duration
+		if run.Duration.Milliseconds() > response.Summary.MaxDurationMs {
+			response.Summary.MaxDurationMs = run.Duration.Milliseconds()
+		}
+		if run.Error != "" {
+			response.Summary.FailedCount++
+		}
+	}
+
+	if len(runs) > 0 {
+		response.Summary.AvgDurationMs = (totalDuration / time.Duration(len(runs))).Milliseconds()
+		// averaged over every run, a run that never reached a phase counts as zero time spent in it
+		for phase, total := range phaseTotals {
+			response.Summary.AvgPhaseDurationsMs[phase] = (total / time.Duration(len(runs))).Milliseconds()
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,140 @@
+package developer
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIngestionMetricsEndpoint(t *testing.T) {
+	type storeCall struct {
+		limit  int
+		result []store.IngestionRun
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		query string
+
+		storeCall *storeCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:  "success",
+			query: "",
+			storeCall: &storeCall{
+				limit: 50,
+				result: []store.IngestionRun{
+					{
+						DriverID:     12345,
+						StartedAt:    time.Date(2024, 6, 15, 12, 5, 0, 0, time.UTC),
+						Duration:     3 * time.Second,
+						RaceCount:    4,
+						NewRaceCount: 2,
+						LapCount:     40,
+						PhaseDurations: map[string]time.Duration{
+							"search":        400 * time.Millisecond,
+							"session_fetch": 1200 * time.Millisecond,
+							"lap_fetch":     800 * time.Millisecond,
+							"persist":       300 * time.Millisecond,
+							"notify":        100 * time.Millisecond,
+						},
+					},
+					{
+						DriverID:  67890,
+						StartedAt: time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC),
+						Duration:  time.Second,
+						UpToDate:  true,
+						Error:     "searching series results: boom",
+						PhaseDurations: map[string]time.Duration{
+							"search": 600 * time.Millisecond,
+						},
+					},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/ingestion_metrics_success_response.json",
+		},
+		{
+			name:  "no runs",
+			query: "?limit=5",
+			storeCall: &storeCall{
+				limit:  5,
+				result: []store.IngestionRun{},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/ingestion_metrics_empty_response.json",
+		},
+		{
+			name:                "invalid limit",
+			query:               "?limit=abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/ingestion_metrics_invalid_limit_response.json",
+		},
+		{
+			name:                "zero limit",
+			query:               "?limit=0",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/ingestion_metrics_zero_limit_response.json",
+		},
+		{
+			name:  "store error",
+			query: "",
+			storeCall: &storeCall{
+				limit: 50,
+				err:   errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/ingestion_metrics_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockIngestionRunStore(t)
+			if tc.storeCall != nil {
+				mockStore.EXPECT().GetRecentIngestionRuns(mock.Anything, tc.storeCall.limit).
+					Return(tc.storeCall.result, tc.storeCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/ingestion-metrics", NewIngestionMetricsEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/ingestion-metrics"+tc.query, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package developer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const ErrCodeNonNegativeInteger = "non_negative_integer"
+
+const tierNamePathParam = "tier_name"
+
+type IngestionTierStore interface {
+	GetIngestionTiers(ctx context.Context) ([]store.IngestionTier, error)
+	SaveIngestionTier(ctx context.Context, tier store.IngestionTier) error
+}
+
+type IngestionTier struct {
+	Name                       string    `json:"name"`
+	Priority                   int       `json:"priority"`
+	SearchWindowDays           int       `json:"searchWindowDays"`
+	RaceConsumptionConcurrency int       `json:"raceConsumptionConcurrency"`
+	LapConsumptionConcurrency  int       `json:"lapConsumptionConcurrency"`
+	LapsDisabled               bool      `json:"lapsDisabled"`
+	UpdatedAt                  time.Time `json:"updatedAt"`
+}
+
+type SaveIngestionTierRequest struct {
+	Priority                   int  `json:"priority"`
+	SearchWindowDays           int  `json:"searchWindowDays"`
+	RaceConsumptionConcurrency int  `json:"raceConsumptionConcurrency"`
+	LapConsumptionConcurrency  int  `json:"lapConsumptionConcurrency"`
+	LapsDisabled               bool `json:"lapsDisabled"`
+}
+
+// NewListIngestionTiersEndpoint creates the handler for GET /developer/ingestion-tiers
+func NewListIngestionTiersEndpoint(tierStore IngestionTierStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		tiers, err := tierStore.GetIngestionTiers(ctx)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to get ingestion tiers")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		response := make([]IngestionTier, len(tiers))
+		for i, tier := range tiers {
+			response[i] = ingestionTierFromStore(tier)
+		}
+		api.DoOKResponse(ctx, response, w)
+	})
+}
+
+// NewSaveIngestionTierEndpoint creates the handler for PUT /developer/ingestion-tiers/{tier_name}. Tiers are named after
+// the entitlement that puts drivers in them, or "default" for everyone else, and take effect from the next ingestion
+// round a driver runs.
+func NewSaveIngestionTierEndpoint(tierStore IngestionTierStore, now func() time.Time) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		name := chi.URLParam(r, tierNamePathParam)
+
+		errs := api.NewRequestErrors()
+		var req SaveIngestionTierRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			// zero leaves the processor's own setting in place, so only negatives are invalid
+			for _, field := range []struct {
+				name  string
+				value int
+			}{
+				{name: "searchWindowDays", value: req.SearchWindowDays},
+				{name: "raceConsumptionConcurrency", value: req.RaceConsumptionConcurrency},
+				{name: "lapConsumptionConcurrency", value: req.LapConsumptionConcurrency},
+			} {
+				if field.value < 0 {
+					errs = errs.WithFieldErrorCode(field.name, ErrCodeNonNegativeInteger, map[string]string{"value": strconv.Itoa(field.value)})
+				}
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		tier := store.IngestionTier{
+			Name:                       name,
+			Priority:                   req.Priority,
+			SearchWindowDays:           req.SearchWindowDays,
+			RaceConsumptionConcurrency: req.RaceConsumptionConcurrency,
+			LapConsumptionConcurrency:  req.LapConsumptionConcurrency,
+			LapsDisabled:               req.LapsDisabled,
+			UpdatedAt:                  now(),
+		}
+		if err := tierStore.SaveIngestionTier(ctx, tier); err != nil {
+			logger.Error().Err(err).Str("tier", name).Msg("failed to save ingestion tier")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		logger.Info().Str("tier", name).Msg("ingestion tier saved")
+		api.DoOKResponse(ctx, ingestionTierFromStore(tier), w)
+	})
+}
+
+func ingestionTierFromStore(tier store.IngestionTier) IngestionTier {
+	return IngestionTier{
+		Name:                       tier.Name,
+		Priority:                   tier.Priority,
+		SearchWindowDays:           tier.SearchWindowDays,
+		RaceConsumptionConcurrency: tier.RaceConsumptionConcurrency,
+		LapConsumptionConcurrency:  tier.LapConsumptionConcurrency,
+		LapsDisabled:               tier.LapsDisabled,
+		UpdatedAt:                  tier.UpdatedAt.UTC(),
+	}
+}
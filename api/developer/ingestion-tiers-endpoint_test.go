@@ -0,0 +1,198 @@
+package developer
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListIngestionTiersEndpoint(t *testing.T) {
+	type storeCall struct {
+		result []store.IngestionTier
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		storeCall storeCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name: "success",
+			storeCall: storeCall{
+				result: []store.IngestionTier{
+					{
+						Name:         "default",
+						LapsDisabled: true,
+						UpdatedAt:    time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC),
+					},
+					{
+						Name:                       "supporter",
+						Priority:                   10,
+						SearchWindowDays:           30,
+						RaceConsumptionConcurrency: 4,
+						LapConsumptionConcurrency:  8,
+						UpdatedAt:                  time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_ingestion_tiers_success_response.json",
+		},
+		{
+			name:                "no tiers",
+			storeCall:           storeCall{result: []store.IngestionTier{}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_ingestion_tiers_empty_response.json",
+		},
+		{
+			name:                "store error",
+			storeCall:           storeCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/ingestion_tiers_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockIngestionTierStore(t)
+			mockStore.EXPECT().GetIngestionTiers(mock.Anything).Return(tc.storeCall.result, tc.storeCall.err)
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/ingestion-tiers", NewListIngestionTiersEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/ingestion-tiers", nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
+
+func TestNewSaveIngestionTierEndpoint(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	type storeCall struct {
+		tier store.IngestionTier
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		tierName    string
+		requestBody string
+
+		storeCall *storeCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			tierName:    "supporter",
+			requestBody: `{"priority": 10, "searchWindowDays": 30, "raceConsumptionConcurrency": 4, "lapConsumptionConcurrency": 8}`,
+			storeCall: &storeCall{
+				tier: store.IngestionTier{
+					Name:                       "supporter",
+					Priority:                   10,
+					SearchWindowDays:           30,
+					RaceConsumptionConcurrency: 4,
+					LapConsumptionConcurrency:  8,
+					UpdatedAt:                  now,
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_ingestion_tier_success_response.json",
+		},
+		{
+			name:                "invalid body",
+			tierName:            "supporter",
+			requestBody:         `not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/save_ingestion_tier_invalid_body_response.json",
+		},
+		{
+			name:                "negative values",
+			tierName:            "supporter",
+			requestBody:         `{"searchWindowDays": -1, "lapConsumptionConcurrency": -2}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/save_ingestion_tier_negative_values_response.json",
+		},
+		{
+			name:        "store error",
+			tierName:    "default",
+			requestBody: `{"lapsDisabled": true}`,
+			storeCall: &storeCall{
+				tier: store.IngestionTier{Name: "default", LapsDisabled: true, UpdatedAt: now},
+				err:  errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/ingestion_tiers_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockIngestionTierStore(t)
+			if tc.storeCall != nil {
+				mockStore.EXPECT().SaveIngestionTier(mock.Anything, tc.storeCall.tier).Return(tc.storeCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Put("/ingestion-tiers/{tier_name}", NewSaveIngestionTierEndpoint(mockStore, func() time.Time { return now }).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPut, ts.URL+"/ingestion-tiers/"+tc.tierName, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package developer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const (
+	ErrCodeTooLarge = "too_large"
+	ErrCodeBlank    = "blank"
+
+	// maxInvitationBatch keeps a batch of invitations to a few transactions
+	maxInvitationBatch = 100
+)
+
+type InvitationService interface {
+	GetWaitlist(ctx context.Context) ([]store.WaitlistEntry, error)
+	Invite(ctx context.Context, invitedBy int64, driverIDs []int64, entitlements []string) ([]store.Invitation, error)
+}
+
+type WaitlistEntry struct {
+	DriverID    int64     `json:"driverId"`
+	DriverName  string    `json:"driverName"`
+	RequestedAt time.Time `json:"requestedAt"`
+}
+
+type InviteRequest struct {
+	DriverIDs    []int64  `json:"driverIds"`
+	Entitlements []string `json:"entitlements"`
+}
+
+type Invitation struct {
+	DriverID     int64     `json:"driverId"`
+	Entitlements []string  `json:"entitlements"`
+	InvitedAt    time.Time `json:"invitedAt"`
+	InvitedBy    int64     `json:"invitedBy"`
+}
+
+// NewWaitlistEndpoint creates the handler for GET /developer/waitlist, listing the drivers waiting to be let in longest
+// waiting first.
+func NewWaitlistEndpoint(invitations InvitationService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		entries, err := invitations.GetWaitlist(ctx)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to get waitlist")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		response := make([]WaitlistEntry, len(entries))
+		for i, entry := range entries {
+			response[i] = WaitlistEntry{
+				DriverID:    entry.DriverID,
+				DriverName:  entry.DriverName,
+				RequestedAt: entry.RequestedAt.UTC(),
+			}
+		}
+		api.DoOKResponse(ctx, response, w)
+	})
+}
+
+// NewInviteEndpoint creates the handler for POST /developer/invitations, letting a batch of drivers in off the waitlist.
+// Each driver's record starts out with the requested entitlements when they next sign in, which is when they are
+// welcomed in.
+func NewInviteEndpoint(invitations InvitationService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+		var req InviteRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			if len(req.DriverIDs) == 0 {
+				errs = errs.WithFieldErrorCode("driverIds", ErrCodeRequired, nil)
+			}
+			if len(req.DriverIDs) > maxInvitationBatch {
+				errs = errs.WithFieldErrorCode("driverIds", ErrCodeTooLarge, map[string]string{"max": strconv.Itoa(maxInvitationBatch)})
+			}
+			for _, driverID := range req.DriverIDs {
+				if driverID <= 0 {
+					errs = errs.WithFieldErrorCode("driverIds", ErrCodePositiveInteger, map[string]string{"value": strconv.FormatInt(driverID, 10)})
+				}
+			}
+			for _, entitlement := range req.Entitlements {
+				if entitlement == "" {
+					errs = errs.WithFieldErrorCode("entitlements", ErrCodeBlank, nil)
+				}
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		invitedBy := api.SessionClaimsFromContext(ctx).IRacingUserID
+		invited, err := invitations.Invite(ctx, invitedBy, req.DriverIDs, req.Entitlements)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to invite drivers")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		response := make([]Invitation, len(invited))
+		for i, invitation := range invited {
+			response[i] = Invitation{
+				DriverID:     invitation.DriverID,
+				Entitlements: invitation.Entitlements,
+				InvitedAt:    invitation.InvitedAt.UTC(),
+				InvitedBy:    invitation.InvitedBy,
+			}
+		}
+		api.DoOKResponse(ctx, response, w)
+	})
+}
@@ -0,0 +1,236 @@
+package developer
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newInvitationsTestRouter(t *testing.T, invitations InvitationService) *httptest.Server {
+	validator := &stubTokenValidator{
+		sessionClaims: &auth.SessionClaims{
+			IRacingUserID: 12345,
+			Entitlements:  []string{"developer"},
+		},
+		sensitiveClaims: &auth.SensitiveClaims{},
+	}
+
+	r := chi.NewRouter()
+	r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+	r.Route("/developer", func(r chi.Router) {
+		r.Use(api.AuthMiddleware(validator))
+		r.Get("/waitlist", NewWaitlistEndpoint(invitations).ServeHTTP)
+		r.Post("/invitations", NewInviteEndpoint(invitations).ServeHTTP)
+	})
+
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestNewWaitlistEndpoint(t *testing.T) {
+	type serviceCall struct {
+		result []store.WaitlistEntry
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		serviceCall serviceCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name: "success",
+			serviceCall: serviceCall{
+				result: []store.WaitlistEntry{
+					{DriverID: 2, DriverName: "Jane Smith", RequestedAt: time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)},
+					{DriverID: 1, DriverName: "Jon Sabados", RequestedAt: time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/waitlist_success_response.json",
+		},
+		{
+			name:                "nobody waiting",
+			serviceCall:         serviceCall{result: []store.WaitlistEntry{}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/waitlist_empty_response.json",
+		},
+		{
+			name:                "service error",
+			serviceCall:         serviceCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/invitations_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockInvitationService(t)
+			mockService.EXPECT().GetWaitlist(mock.Anything).Return(tc.serviceCall.result, tc.serviceCall.err)
+
+			ts := newInvitationsTestRouter(t, mockService)
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/developer/waitlist", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
+
+func TestNewInviteEndpoint(t *testing.T) {
+	invitedAt := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	type serviceCall struct {
+		driverIDs    []int64
+		entitlements []string
+		result       []store.Invitation
+		err          error
+	}
+
+	testCases := []struct {
+		name string
+
+		requestBody string
+
+		serviceCall *serviceCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			requestBody: `{"driverIds": [1, 2], "entitlements": ["beta"]}`,
+			serviceCall: &serviceCall{
+				driverIDs:    []int64{1, 2},
+				entitlements: []string{"beta"},
+				result: []store.Invitation{
+					{DriverID: 1, Entitlements: []string{"beta"}, InvitedAt: invitedAt, InvitedBy: 12345},
+					{DriverID: 2, Entitlements: []string{"beta"}, InvitedAt: invitedAt, InvitedBy: 12345},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/invite_success_response.json",
+		},
+		{
+			name:                "invalid body",
+			requestBody:         `not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/invite_invalid_body_response.json",
+		},
+		{
+			name:                "no drivers",
+			requestBody:         `{"driverIds": [], "entitlements": ["beta"]}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/invite_no_drivers_response.json",
+		},
+		{
+			name:                "invalid values",
+			requestBody:         `{"driverIds": [1, -2], "entitlements": ["beta", ""]}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/invite_invalid_values_response.json",
+		},
+		{
+			name:        "service error",
+			requestBody: `{"driverIds": [1]}`,
+			serviceCall: &serviceCall{
+				driverIDs: []int64{1},
+				err:       errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/invitations_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockInvitationService(t)
+			if tc.serviceCall != nil {
+				mockService.EXPECT().Invite(mock.Anything, int64(12345), tc.serviceCall.driverIDs, tc.serviceCall.entitlements).Return(tc.serviceCall.result, tc.serviceCall.err)
+			}
+
+			ts := newInvitationsTestRouter(t, mockService)
+
+			req, err := http.NewRequest(http.MethodPost, ts.URL+"/developer/invitations", bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
+
+func TestNewInviteEndpoint_BatchTooLarge(t *testing.T) {
+	mockService := NewMockInvitationService(t)
+	ts := newInvitationsTestRouter(t, mockService)
+
+	body := bytes.NewBufferString(`{"driverIds": [`)
+	for i := range maxInvitationBatch + 1 {
+		if i > 0 {
+			body.WriteString(",")
+		}
+		body.WriteString("1")
+	}
+	body.WriteString(`]}`)
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL+"/developer/invitations", body)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer test-token")
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	expectedBody, err := os.ReadFile("fixtures/invite_too_large_response.json")
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+}
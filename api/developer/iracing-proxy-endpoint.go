@@ -0,0 +1,283 @@
+package developer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const (
+	ErrCodeNotAllowed      = "not_allowed"
+	ErrCodeUnknownTemplate = "unknown_template"
+)
+
+const defaultProxyHistoryLimit = 50
+
+// defaultMaintenanceRetryAfter is what callers are told to wait when iRacing is down for maintenance and nothing has
+// said when it is expected back
+const defaultMaintenanceRetryAfter = 15 * 60
+
+// proxyCategories are the data API categories the proxy will call. Everything under them is a read of iRacing data the
+// caller's own token can already see.
+var proxyCategories = []string{
+	"car", "carclass", "constants", "driver_stats_by_category", "hosted", "league", "lookup", "member", "results",
+	"season", "series", "stats", "team", "time_attack", "track",
+}
+
+var proxyPathPattern = regexp.MustCompile(`^/data/([a-z_]+)/[a-z_]+$`)
+
+var proxyTemplatePattern = regexp.MustCompile(`\{([a-zA-Z]+)\}`)
+
+// iRacingTimeFormat matches what the data API takes for its time range parameters
+const iRacingTimeFormat = "2006-01-02T15:04Z"
+
+type DataFetcher interface {
+	FetchData(ctx context.Context, accessToken, path string, params url.Values) (*iracing.DataResponse, error)
+}
+
+type ProxyRequestStore interface {
+	SaveIRacingProxyRequest(ctx context.Context, request store.IRacingProxyRequest) error
+	GetIRacingProxyRequests(ctx context.Context, driverID int64, limit int) ([]store.IRacingProxyRequest, error)
+}
+
+// IRacingProxyRequest is a data API request to make. Param values may use {custId} for the caller's customer ID and
+// {now} for the current time in the data API's format.
+type IRacingProxyRequest struct {
+	Path   string            `json:"path"`
+	Params map[string]string `json:"params"`
+	// Pretty asks for the body indented as well, for displaying as is
+	Pretty bool `json:"pretty"`
+}
+
+type IRacingProxyResponse struct {
+	Path       string            `json:"path"`
+	Params     map[string]string `json:"params"` // templates expanded
+	Status     int               `json:"status"`
+	Linked     bool              `json:"linked"`
+	DurationMs int64             `json:"durationMs"`
+	// Body is set for JSON responses, Text for anything else
+	Body   json.RawMessage `json:"body,omitempty"`
+	Text   string          `json:"text,omitempty"`
+	Pretty string          `json:"pretty,omitempty"`
+}
+
+type IRacingProxyHistoryEntry struct {
+	RequestedAt time.Time         `json:"requestedAt"`
+	Path        string            `json:"path"`
+	Params      map[string]string `json:"params"` // as entered, templates left in place
+	Status      int               `json:"status"`
+	DurationMs  int64             `json:"durationMs"`
+}
+
+type IRacingProxyHistoryResponse struct {
+	Requests []IRacingProxyHistoryEntry `json:"requests"`
+}
+
+// NewIRacingProxyEndpoint creates the handler for POST /developer/iracing-proxy, making a request to an allowed data API
+// path with the caller's iRacing token. Links are followed, and the response comes back whatever its status so
+// failures can be inspected too. Every request is kept in the caller's proxy history.
+func NewIRacingProxyEndpoint(fetcher DataFetcher, requestStore ProxyRequestStore, now func() time.Time) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		sensitiveClaims := api.SensitiveClaimsFromContext(ctx)
+		if sessionClaims == nil || sensitiveClaims == nil {
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		errs := api.NewRequestErrors()
+		var req IRacingProxyRequest
+		params := url.Values{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			errs = validateProxyPath(errs, req.Path)
+			requestedAt := now()
+			for name, value := range req.Params {
+				expanded, unknown := expandProxyTemplates(value, sessionClaims.IRacingUserID, requestedAt)
+				if unknown != "" {
+					errs = errs.WithFieldErrorCode("params."+name, ErrCodeUnknownTemplate, map[string]string{"template": unknown})
+					continue
+				}
+				params.Set(name, expanded)
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		start := now()
+		upstream, err := fetcher.FetchData(ctx, sensitiveClaims.IRacingAccessToken, req.Path, params)
+		duration := now().Sub(start)
+
+		history := store.IRacingProxyRequest{
+			DriverID:    sessionClaims.IRacingUserID,
+			RequestedAt: start,
+			Path:        req.Path,
+			Params:      req.Params,
+			Duration:    duration,
+		}
+		if history.Params == nil {
+			history.Params = map[string]string{}
+		}
+		if upstream != nil {
+			history.Status = upstream.Status
+		}
+		// history is a convenience, a failure to keep it shouldn't cost the developer the response
+		if saveErr := requestStore.SaveIRacingProxyRequest(ctx, history); saveErr != nil {
+			logger.Error().Err(saveErr).Msg("failed to save iracing proxy request")
+		}
+
+		if err != nil {
+			if errors.Is(err, iracing.ErrUpstreamUnauthorized) {
+				logger.Warn().Err(err).Str("path", req.Path).Msg("iracing token expired")
+				api.DoUnauthorizedResponse(ctx, "iRacing access token expired", w)
+				return
+			}
+			if errors.Is(err, iracing.ErrMaintenance) {
+				logger.Warn().Err(err).Str("path", req.Path).Msg("iracing down for maintenance")
+				api.DoUpstreamUnavailableResponse(ctx, "iRacing is down for maintenance", maintenanceRetryAfter(err, now()), w)
+				return
+			}
+			logger.Error().Err(err).Str("path", req.Path).Msg("failed to proxy iracing request")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		logger.Info().Str("path", req.Path).Int("status", upstream.Status).Bool("linked", upstream.Linked).Msg("proxied iracing request")
+
+		expandedParams := make(map[string]string, len(params))
+		for name := range params {
+			expandedParams[name] = params.Get(name)
+		}
+		response := IRacingProxyResponse{
+			Path:       req.Path,
+			Params:     expandedParams,
+			Status:     upstream.Status,
+			Linked:     upstream.Linked,
+			DurationMs: duration.Milliseconds(),
+		}
+		if json.Valid(upstream.Body) {
+			response.Body = upstream.Body
+			if req.Pretty {
+				var indented bytes.Buffer
+				if err := json.Indent(&indented, upstream.Body, "", "  "); err == nil {
+					response.Pretty = indented.String()
+				}
+			}
+		} else {
+			response.Text = string(upstream.Body)
+		}
+		api.DoOKResponse(ctx, response, w)
+	})
+}
+
+// NewIRacingProxyHistoryEndpoint creates the handler for GET /developer/iracing-proxy/history, listing the caller's most
+// recent proxy requests newest first.
+func NewIRacingProxyHistoryEndpoint(requestStore ProxyRequestStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		if sessionClaims == nil {
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		errs := api.NewRequestErrors()
+
+		limit := defaultProxyHistoryLimit
+		if limitStr := r.URL.Query().Get(api.LimitQueryParam); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.LimitQueryParam, ErrCodeInvalidInteger, map[string]string{"value": limitStr})
+			} else if limit < 1 {
+				errs = errs.WithFieldErrorCode(api.LimitQueryParam, ErrCodePositiveInteger, map[string]string{"value": limitStr})
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		requests, err := requestStore.GetIRacingProxyRequests(ctx, sessionClaims.IRacingUserID, limit)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get iracing proxy history")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		response := IRacingProxyHistoryResponse{Requests: make([]IRacingProxyHistoryEntry, len(requests))}
+		for i, request := range requests {
+			response.Requests[i] = IRacingProxyHistoryEntry{
+				RequestedAt: request.RequestedAt.UTC(),
+				Path:        request.Path,
+				Params:      request.Params,
+				Status:      request.Status,
+				DurationMs:  request.Duration.Milliseconds(),
+			}
+		}
+		api.DoOKResponse(ctx, response, w)
+	})
+}
+
+// maintenanceRetryAfter returns the whole seconds until the iRacing maintenance behind err is expected to be over.
+func maintenanceRetryAfter(err error, now time.Time) int {
+	until, ok := iracing.MaintenanceUntil(err)
+	if !ok {
+		return defaultMaintenanceRetryAfter
+	}
+	return max(int(math.Ceil(until.Sub(now).Seconds())), 1)
+}
+
+func validateProxyPath(errs api.RequestErrors, path string) api.RequestErrors {
+	if path == "" {
+		return errs.WithFieldErrorCode("path", ErrCodeRequired, nil)
+	}
+	match := proxyPathPattern.FindStringSubmatch(path)
+	if match == nil || !slices.Contains(proxyCategories, match[1]) {
+		return errs.WithFieldErrorCode("path", ErrCodeNotAllowed, map[string]string{"value": path})
+	}
+	return errs
+}
+
+// expandProxyTemplates fills in the templates in a param value, returning the first template it doesn't know when
+// there is one.
+func expandProxyTemplates(value string, custID int64, now time.Time) (string, string) {
+	var unknown string
+	expanded := proxyTemplatePattern.ReplaceAllStringFunc(value, func(template string) string {
+		switch strings.Trim(template, "{}") {
+		case "custId":
+			return strconv.FormatInt(custID, 10)
+		case "now":
+			return now.UTC().Format(iRacingTimeFormat)
+		}
+		if unknown == "" {
+			unknown = template
+		}
+		return template
+	})
+	return expanded, unknown
+}
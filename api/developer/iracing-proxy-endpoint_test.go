@@ -0,0 +1,365 @@
+package developer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIRacingProxyEndpoint(t *testing.T) {
+	requestedAt := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	type fetchCall struct {
+		path   string
+		params url.Values
+		result *iracing.DataResponse
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		body string
+
+		fetchCall  *fetchCall
+		expectSave *store.IRacingProxyRequest
+		saveErr    error
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name: "templates are expanded for iRacing but kept in the history",
+			body: `{"path": "/data/results/search_series", "params": {"cust_id": "{custId}", "finish_range_end": "{now}", "season_year": "2024"}}`,
+			fetchCall: &fetchCall{
+				path: "/data/results/search_series",
+				params: url.Values{
+					"cust_id":          []string{"12345"},
+					"finish_range_end": []string{"2024-06-15T12:00Z"},
+					"season_year":      []string{"2024"},
+				},
+				result: &iracing.DataResponse{Status: http.StatusOK, ContentType: "application/json", Body: []byte(`{"data":{"success":true}}`), Linked: true},
+			},
+			expectSave: &store.IRacingProxyRequest{
+				DriverID:    12345,
+				RequestedAt: requestedAt,
+				Path:        "/data/results/search_series",
+				Params:      map[string]string{"cust_id": "{custId}", "finish_range_end": "{now}", "season_year": "2024"},
+				Status:      http.StatusOK,
+				Duration:    250 * time.Millisecond,
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/iracing_proxy_success_response.json",
+		},
+		{
+			name: "pretty printed",
+			body: `{"path": "/data/member/info", "pretty": true}`,
+			fetchCall: &fetchCall{
+				path:   "/data/member/info",
+				params: url.Values{},
+				result: &iracing.DataResponse{Status: http.StatusOK, ContentType: "application/json", Body: []byte(`{"cust_id":12345}`)},
+			},
+			expectSave: &store.IRacingProxyRequest{
+				DriverID:    12345,
+				RequestedAt: requestedAt,
+				Path:        "/data/member/info",
+				Params:      map[string]string{},
+				Status:      http.StatusOK,
+				Duration:    250 * time.Millisecond,
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/iracing_proxy_pretty_response.json",
+		},
+		{
+			name: "upstream failures are passed through and history errors don't fail the request",
+			body: `{"path": "/data/member/info"}`,
+			fetchCall: &fetchCall{
+				path:   "/data/member/info",
+				params: url.Values{},
+				result: &iracing.DataResponse{Status: http.StatusServiceUnavailable, ContentType: "text/plain", Body: []byte("Service Unavailable")},
+			},
+			expectSave: &store.IRacingProxyRequest{
+				DriverID:    12345,
+				RequestedAt: requestedAt,
+				Path:        "/data/member/info",
+				Params:      map[string]string{},
+				Status:      http.StatusServiceUnavailable,
+				Duration:    250 * time.Millisecond,
+			},
+			saveErr:             errors.New("database error"),
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/iracing_proxy_upstream_error_response.json",
+		},
+		{
+			name: "expired iRacing token",
+			body: `{"path": "/data/member/info"}`,
+			fetchCall: &fetchCall{
+				path:   "/data/member/info",
+				params: url.Values{},
+				err:    iracing.ErrUpstreamUnauthorized,
+			},
+			expectSave: &store.IRacingProxyRequest{
+				DriverID:    12345,
+				RequestedAt: requestedAt,
+				Path:        "/data/member/info",
+				Params:      map[string]string{},
+				Duration:    250 * time.Millisecond,
+			},
+			expectedStatus:      http.StatusUnauthorized,
+			expectedBodyFixture: "fixtures/iracing_proxy_iracing_expired_response.json",
+		},
+		{
+			name: "iRacing down for maintenance",
+			body: `{"path": "/data/member/info"}`,
+			fetchCall: &fetchCall{
+				path:   "/data/member/info",
+				params: url.Values{},
+				err:    &iracing.MaintenanceError{Reason: "Back soon", Until: requestedAt.Add(15 * time.Minute)},
+			},
+			expectSave: &store.IRacingProxyRequest{
+				DriverID:    12345,
+				RequestedAt: requestedAt,
+				Path:        "/data/member/info",
+				Params:      map[string]string{},
+				Duration:    250 * time.Millisecond,
+			},
+			expectedStatus:      http.StatusServiceUnavailable,
+			expectedBodyFixture: "fixtures/iracing_proxy_maintenance_response.json",
+		},
+		{
+			name: "fetch error",
+			body: `{"path": "/data/member/info"}`,
+			fetchCall: &fetchCall{
+				path:   "/data/member/info",
+				params: url.Values{},
+				err:    errors.New("connection reset"),
+			},
+			expectSave: &store.IRacingProxyRequest{
+				DriverID:    12345,
+				RequestedAt: requestedAt,
+				Path:        "/data/member/info",
+				Params:      map[string]string{},
+				Duration:    250 * time.Millisecond,
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/iracing_proxy_error_response.json",
+		},
+		{
+			name:                "path outside the allowed categories and unknown template",
+			body:                `{"path": "/data/../auth", "params": {"cust_id": "{userId}"}}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/iracing_proxy_invalid_request_response.json",
+		},
+		{
+			name:                "missing path",
+			body:                `{"params": {}}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/iracing_proxy_missing_path_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			body:                `{`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/iracing_proxy_invalid_json_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			fetcher := NewMockDataFetcher(t)
+			if tc.fetchCall != nil {
+				fetcher.EXPECT().FetchData(mock.Anything, "test-iracing-access-token", tc.fetchCall.path, tc.fetchCall.params).
+					Return(tc.fetchCall.result, tc.fetchCall.err)
+			}
+			requestStore := NewMockProxyRequestStore(t)
+			if tc.expectSave != nil {
+				requestStore.EXPECT().SaveIRacingProxyRequest(mock.Anything, *tc.expectSave).Return(tc.saveErr)
+			}
+
+			// templates and the request start see requestedAt, the request finishing sees 250ms later
+			calls := 0
+			now := func() time.Time {
+				calls++
+				if calls > 2 {
+					return requestedAt.Add(250 * time.Millisecond)
+				}
+				return requestedAt
+			}
+
+			validator := &stubTokenValidator{
+				sessionClaims: &auth.SessionClaims{
+					IRacingUserID: 12345,
+					Entitlements:  []string{"developer"},
+				},
+				sensitiveClaims: &auth.SensitiveClaims{
+					IRacingAccessToken: "test-iracing-access-token",
+				},
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Route("/developer", func(r chi.Router) {
+				r.Use(api.AuthMiddleware(validator))
+				r.Post("/iracing-proxy", NewIRacingProxyEndpoint(fetcher, requestStore, now).ServeHTTP)
+			})
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, ts.URL+"/developer/iracing-proxy", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer valid-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
+
+func TestNewIRacingProxyHistoryEndpoint(t *testing.T) {
+	type storeCall struct {
+		limit  int
+		result []store.IRacingProxyRequest
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		query string
+
+		storeCall *storeCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:  "success",
+			query: "",
+			storeCall: &storeCall{
+				limit: 50,
+				result: []store.IRacingProxyRequest{
+					{
+						DriverID:    12345,
+						RequestedAt: time.Date(2024, 6, 15, 12, 5, 0, 0, time.UTC),
+						Path:        "/data/results/search_series",
+						Params:      map[string]string{"cust_id": "{custId}", "finish_range_end": "{now}"},
+						Status:      http.StatusOK,
+						Duration:    250 * time.Millisecond,
+					},
+					{
+						DriverID:    12345,
+						RequestedAt: time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC),
+						Path:        "/data/member/info",
+						Params:      map[string]string{},
+						Duration:    1500 * time.Millisecond,
+					},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/iracing_proxy_history_success_response.json",
+		},
+		{
+			name:  "no requests",
+			query: "?limit=5",
+			storeCall: &storeCall{
+				limit:  5,
+				result: []store.IRacingProxyRequest{},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/iracing_proxy_history_empty_response.json",
+		},
+		{
+			name:                "negative limit",
+			query:               "?limit=-1",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/iracing_proxy_history_invalid_limit_response.json",
+		},
+		{
+			name:  "store error",
+			query: "",
+			storeCall: &storeCall{
+				limit: 50,
+				err:   errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/iracing_proxy_history_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			requestStore := NewMockProxyRequestStore(t)
+			if tc.storeCall != nil {
+				requestStore.EXPECT().GetIRacingProxyRequests(mock.Anything, int64(12345), tc.storeCall.limit).
+					Return(tc.storeCall.result, tc.storeCall.err)
+			}
+
+			validator := &stubTokenValidator{
+				sessionClaims: &auth.SessionClaims{
+					IRacingUserID: 12345,
+					Entitlements:  []string{"developer"},
+				},
+				sensitiveClaims: &auth.SensitiveClaims{},
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Route("/developer", func(r chi.Router) {
+				r.Use(api.AuthMiddleware(validator))
+				r.Get("/iracing-proxy/history", NewIRacingProxyHistoryEndpoint(requestStore).ServeHTTP)
+			})
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/developer/iracing-proxy/history"+tc.query, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer valid-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
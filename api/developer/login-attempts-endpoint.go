@@ -0,0 +1,67 @@
+package developer
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/rs/zerolog"
+)
+
+const (
+	ipQueryParam = "ip"
+
+	ErrCodeInvalidIP = "invalid_ip"
+)
+
+type LoginAttemptTracker interface {
+	Clear(ctx context.Context, key string) error
+}
+
+// NewUnlockLoginAttemptsEndpoint creates the handler for DELETE /developer/login-attempts, forgetting the failed sign in
+// attempts held against a client address, a driver, or both, which lifts any delay or lockout they were under.
+func NewUnlockLoginAttemptsEndpoint(tracker LoginAttemptTracker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+		var keys []string
+
+		if ip := r.URL.Query().Get(ipQueryParam); ip != "" {
+			key := auth.IPAttemptKey(ip)
+			if key == "" {
+				errs = errs.WithFieldErrorCode(ipQueryParam, ErrCodeInvalidIP, map[string]string{"value": ip})
+			}
+			keys = append(keys, key)
+		}
+		if driverIDStr := r.URL.Query().Get(api.DriverIDQueryParam); driverIDStr != "" {
+			driverID, err := strconv.ParseInt(driverIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.DriverIDQueryParam, ErrCodeInvalidInteger, map[string]string{"value": driverIDStr})
+			}
+			keys = append(keys, auth.CustomerAttemptKey(driverID))
+		}
+		if len(keys) == 0 {
+			errs = errs.WithError("ip or driverId is required")
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		for _, key := range keys {
+			if err := tracker.Clear(ctx, key); err != nil {
+				logger.Error().Err(err).Str("attemptKey", key).Msg("failed to clear login attempts")
+				api.DoErrorResponse(ctx, w)
+				return
+			}
+			logger.Info().Str("attemptKey", key).Msg("login attempts unlocked")
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,107 @@
+package developer
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUnlockLoginAttemptsEndpoint(t *testing.T) {
+	type clearCall struct {
+		key string
+		err error
+	}
+
+	testCases := []struct {
+		name string
+
+		query      string
+		clearCalls []clearCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:           "unlock address",
+			query:          "?ip=203.0.113.7",
+			clearCalls:     []clearCall{{key: "ip#203.0.113.7"}},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "unlock IPv6 address",
+			query:          "?ip=2001:db8:1:2::7",
+			clearCalls:     []clearCall{{key: "ip#2001:db8:1:2::/64"}},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "unlock address and driver",
+			query:          "?ip=203.0.113.7&driverId=12345",
+			clearCalls:     []clearCall{{key: "ip#203.0.113.7"}, {key: "customer#12345"}},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                "nothing to unlock",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/login_attempts_missing_key_response.json",
+		},
+		{
+			name:                "invalid address and driver id",
+			query:               "?ip=nope&driverId=abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/login_attempts_invalid_params_response.json",
+		},
+		{
+			name:                "store error",
+			query:               "?driverId=12345",
+			clearCalls:          []clearCall{{key: "customer#12345", err: errors.New("db error")}},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/login_attempts_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tracker := NewMockLoginAttemptTracker(t)
+			for _, call := range tc.clearCalls {
+				tracker.EXPECT().Clear(mock.Anything, call.key).Return(call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Delete("/login-attempts", NewUnlockLoginAttemptsEndpoint(tracker).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodDelete, ts.URL+"/login-attempts"+tc.query, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			if tc.expectedBodyFixture == "" {
+				assert.Empty(t, bodyBytes)
+				return
+			}
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
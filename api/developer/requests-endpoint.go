@@ -0,0 +1,179 @@
+package developer
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const defaultCapturedRequestsLimit = 20
+
+// maxCapturedRequestsLimit keeps a page of captured requests, whose bodies run to 16KB apiece, a manageable size
+const maxCapturedRequestsLimit = 50
+
+type RequestCaptureService interface {
+	Enable(ctx context.Context, driverID int64) (*store.RequestCaptureMode, error)
+	Disable(ctx context.Context, driverID int64) error
+	Mode(ctx context.Context, driverID int64) (*store.RequestCaptureMode, error)
+	List(ctx context.Context, driverID int64, limit int) ([]store.CapturedRequest, error)
+}
+
+type RequestCaptureModeResponse struct {
+	EnabledAt time.Time `json:"enabledAt"`
+	Until     time.Time `json:"until"`
+}
+
+type CapturedRequestEntry struct {
+	ID                    string    `json:"id"`
+	RequestedAt           time.Time `json:"requestedAt"`
+	Method                string    `json:"method"`
+	Path                  string    `json:"path"`
+	Route                 string    `json:"route"`
+	Query                 string    `json:"query"`
+	Status                int       `json:"status"`
+	DurationMs            int64     `json:"durationMs"`
+	RequestContentType    string    `json:"requestContentType"`
+	RequestBody           string    `json:"requestBody"`
+	RequestBodyTruncated  bool      `json:"requestBodyTruncated"`
+	ResponseContentType   string    `json:"responseContentType"`
+	ResponseBody          string    `json:"responseBody"`
+	ResponseBodyTruncated bool      `json:"responseBodyTruncated"`
+}
+
+type CapturedRequestsResponse struct {
+	// Capture is nil when request capture is off
+	Capture  *RequestCaptureModeResponse `json:"capture"`
+	Requests []CapturedRequestEntry      `json:"requests"`
+}
+
+// NewCapturedRequestsEndpoint creates the handler for GET /developer/requests, listing the caller's most recently
+// captured requests newest first, along with whether capture is still on.
+func NewCapturedRequestsEndpoint(captureService RequestCaptureService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		if sessionClaims == nil {
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		errs := api.NewRequestErrors()
+
+		limit := defaultCapturedRequestsLimit
+		if limitStr := r.URL.Query().Get(api.LimitQueryParam); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.LimitQueryParam, ErrCodeInvalidInteger, map[string]string{"value": limitStr})
+			} else if limit < 1 {
+				errs = errs.WithFieldErrorCode(api.LimitQueryParam, ErrCodePositiveInteger, map[string]string{"value": limitStr})
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		mode, err := captureService.Mode(ctx, sessionClaims.IRacingUserID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get request capture mode")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		requests, err := captureService.List(ctx, sessionClaims.IRacingUserID, min(limit, maxCapturedRequestsLimit))
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get captured requests")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		response := CapturedRequestsResponse{Requests: make([]CapturedRequestEntry, len(requests))}
+		if mode != nil {
+			response.Capture = captureModeResponse(*mode)
+		}
+		for i, request := range requests {
+			response.Requests[i] = CapturedRequestEntry{
+				ID:                    request.ID,
+				RequestedAt:           request.RequestedAt.UTC(),
+				Method:                request.Method,
+				Path:                  request.Path,
+				Route:                 request.Route,
+				Query:                 request.Query,
+				Status:                request.Status,
+				DurationMs:            request.Duration.Milliseconds(),
+				RequestContentType:    request.RequestContentType,
+				RequestBody:           request.RequestBody,
+				RequestBodyTruncated:  request.RequestBodyTruncated,
+				ResponseContentType:   request.ResponseContentType,
+				ResponseBody:          request.ResponseBody,
+				ResponseBodyTruncated: request.ResponseBodyTruncated,
+			}
+		}
+		api.DoOKResponse(ctx, response, w)
+	})
+}
+
+// NewEnableRequestCaptureEndpoint creates the handler for PUT /developer/requests/capture, turning on capture of the
+// caller's own requests for the next hour, or starting the hour over if it was already on.
+func NewEnableRequestCaptureEndpoint(captureService RequestCaptureService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		if sessionClaims == nil {
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		mode, err := captureService.Enable(ctx, sessionClaims.IRacingUserID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to enable request capture")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		logger.Info().Time("until", mode.Until).Msg("request capture enabled")
+		api.DoOKResponse(ctx, captureModeResponse(*mode), w)
+	})
+}
+
+// NewDisableRequestCaptureEndpoint creates the handler for DELETE /developer/requests/capture, turning off capture of
+// the caller's requests. Requests already captured stay listed until they expire.
+func NewDisableRequestCaptureEndpoint(captureService RequestCaptureService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		if sessionClaims == nil {
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		if err := captureService.Disable(ctx, sessionClaims.IRacingUserID); err != nil {
+			logger.Error().Err(err).Msg("failed to disable request capture")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		logger.Info().Msg("request capture disabled")
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func captureModeResponse(mode store.RequestCaptureMode) *RequestCaptureModeResponse {
+	return &RequestCaptureModeResponse{
+		EnabledAt: mode.EnabledAt.UTC(),
+		Until:     mode.Until.UTC(),
+	}
+}
@@ -0,0 +1,292 @@
+package developer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequestCaptureTestRouter(t *testing.T, captureService RequestCaptureService) *httptest.Server {
+	validator := &stubTokenValidator{
+		sessionClaims: &auth.SessionClaims{
+			IRacingUserID: 12345,
+			Entitlements:  []string{"developer"},
+		},
+		sensitiveClaims: &auth.SensitiveClaims{},
+	}
+
+	r := chi.NewRouter()
+	r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+	r.Route("/developer", func(r chi.Router) {
+		r.Use(api.AuthMiddleware(validator))
+		r.Get("/requests", NewCapturedRequestsEndpoint(captureService).ServeHTTP)
+		r.Put("/requests/capture", NewEnableRequestCaptureEndpoint(captureService).ServeHTTP)
+		r.Delete("/requests/capture", NewDisableRequestCaptureEndpoint(captureService).ServeHTTP)
+	})
+
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestNewCapturedRequestsEndpoint(t *testing.T) {
+	type modeCall struct {
+		result *store.RequestCaptureMode
+		err    error
+	}
+	type listCall struct {
+		limit  int
+		result []store.CapturedRequest
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		query string
+
+		modeCall *modeCall
+		listCall *listCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:  "success",
+			query: "",
+			modeCall: &modeCall{
+				result: &store.RequestCaptureMode{
+					DriverID:  12345,
+					EnabledAt: time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC),
+					Until:     time.Date(2024, 6, 15, 13, 0, 0, 0, time.UTC),
+				},
+			},
+			listCall: &listCall{
+				limit: 20,
+				result: []store.CapturedRequest{
+					{
+						ID:                  "correlation-2",
+						DriverID:            12345,
+						RequestedAt:         time.Date(2024, 6, 15, 12, 5, 0, 0, time.UTC),
+						Method:              http.MethodPost,
+						Path:                "/driver/12345/journal/1718452800",
+						Route:               "/driver/{driver_id}/journal/{driver_race_id}",
+						Status:              http.StatusBadRequest,
+						Duration:            35 * time.Millisecond,
+						RequestContentType:  "application/json",
+						RequestBody:         `{"notes":""}`,
+						ResponseContentType: "application/json",
+						ResponseBody:        `{"errors":[]}`,
+					},
+					{
+						ID:                    "correlation-1",
+						DriverID:              12345,
+						RequestedAt:           time.Date(2024, 6, 15, 12, 1, 0, 0, time.UTC),
+						Method:                http.MethodGet,
+						Path:                  "/driver/12345/races",
+						Route:                 "/driver/{driver_id}/races",
+						Query:                 "startTime=2024-06-01T00%3A00%3A00Z",
+						Status:                http.StatusOK,
+						Duration:              120 * time.Millisecond,
+						ResponseContentType:   "application/json",
+						ResponseBody:          `{"items":[`,
+						ResponseBodyTruncated: true,
+					},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/captured_requests_success_response.json",
+		},
+		{
+			name:     "capture off and nothing captured",
+			query:    "?limit=5",
+			modeCall: &modeCall{},
+			listCall: &listCall{
+				limit:  5,
+				result: []store.CapturedRequest{},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/captured_requests_empty_response.json",
+		},
+		{
+			name:     "limit capped",
+			query:    "?limit=500",
+			modeCall: &modeCall{},
+			listCall: &listCall{
+				limit:  50,
+				result: []store.CapturedRequest{},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/captured_requests_empty_response.json",
+		},
+		{
+			name:                "zero limit",
+			query:               "?limit=0",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/captured_requests_invalid_limit_response.json",
+		},
+		{
+			name:                "mode error",
+			query:               "",
+			modeCall:            &modeCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/captured_requests_error_response.json",
+		},
+		{
+			name:     "list error",
+			query:    "",
+			modeCall: &modeCall{},
+			listCall: &listCall{
+				limit: 20,
+				err:   errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/captured_requests_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			captureService := NewMockRequestCaptureService(t)
+			if tc.modeCall != nil {
+				captureService.EXPECT().Mode(mock.Anything, int64(12345)).Return(tc.modeCall.result, tc.modeCall.err)
+			}
+			if tc.listCall != nil {
+				captureService.EXPECT().List(mock.Anything, int64(12345), tc.listCall.limit).Return(tc.listCall.result, tc.listCall.err)
+			}
+
+			ts := newRequestCaptureTestRouter(t, captureService)
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/developer/requests"+tc.query, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer valid-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
+
+func TestNewEnableRequestCaptureEndpoint(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		result *store.RequestCaptureMode
+		err    error
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name: "success",
+			result: &store.RequestCaptureMode{
+				DriverID:  12345,
+				EnabledAt: time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC),
+				Until:     time.Date(2024, 6, 15, 13, 0, 0, 0, time.UTC),
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/enable_request_capture_success_response.json",
+		},
+		{
+			name:                "service error",
+			err:                 errors.New("database error"),
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/captured_requests_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			captureService := NewMockRequestCaptureService(t)
+			captureService.EXPECT().Enable(mock.Anything, int64(12345)).Return(tc.result, tc.err)
+
+			ts := newRequestCaptureTestRouter(t, captureService)
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPut, ts.URL+"/developer/requests/capture", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer valid-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
+
+func TestNewDisableRequestCaptureEndpoint(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		err error
+
+		expectedStatus int
+	}{
+		{
+			name:           "success",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "service error",
+			err:            errors.New("database error"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			captureService := NewMockRequestCaptureService(t)
+			captureService.EXPECT().Disable(mock.Anything, int64(12345)).Return(tc.err)
+
+			ts := newRequestCaptureTestRouter(t, captureService)
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ts.URL+"/developer/requests/capture", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer valid-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+		})
+	}
+}
@@ -2,18 +2,40 @@ package developer
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/jonsabados/saturdaysspinout/api"
 )
 
-func NewRouter(docFetcher Fetcher, authMiddleware, developerMiddleware func(http.Handler) http.Handler) http.Handler {
+func NewRouter(docFetcher Fetcher, runStore IngestionRunStore, coverageStore CoverageStore, tierStore IngestionTierStore, dataFetcher DataFetcher, proxyRequestStore ProxyRequestStore, loginAttempts LoginAttemptTracker, requestCapture RequestCaptureService, invitations InvitationService, availability api.UpstreamAvailability, authMiddleware, developerMiddleware func(http.Handler) http.Handler) http.Handler {
 	r := chi.NewRouter()
 	r.Use(authMiddleware)
 	r.Use(developerMiddleware)
 
-	r.Get("/iracing-api/*", api.WrapWithSegment("iracingDocProxyEndpoint", NewIRacingDocProxyEndpoint(docFetcher)).ServeHTTP)
+	// the doc and data proxies call iRacing, so they are turned away while it is down for maintenance
+	upstreamUp := api.UpstreamAvailabilityMiddleware(availability, time.Now)
+
+	r.With(upstreamUp).Get("/iracing-api/*", api.WrapWithSegment("iracingDocProxyEndpoint", NewIRacingDocProxyEndpoint(docFetcher)).ServeHTTP)
+	r.With(upstreamUp).Post("/iracing-proxy", api.WrapWithSegment("iracingProxyEndpoint", NewIRacingProxyEndpoint(dataFetcher, proxyRequestStore, time.Now)).ServeHTTP)
+	r.Get("/iracing-proxy/history", api.WrapWithSegment("iracingProxyHistoryEndpoint", NewIRacingProxyHistoryEndpoint(proxyRequestStore)).ServeHTTP)
 	r.Get("/iracing-token", api.WrapWithSegment("iracingTokenEndpoint", NewIRacingTokenEndpoint()).ServeHTTP)
+	r.Get("/ingestion-metrics", api.WrapWithSegment("ingestionMetricsEndpoint", NewIngestionMetricsEndpoint(runStore)).ServeHTTP)
+	r.Get("/coverage", api.WrapWithSegment("coverageEndpoint", NewCoverageEndpoint(coverageStore)).ServeHTTP)
+	r.Get("/ingestion-tiers", api.WrapWithSegment("listIngestionTiersEndpoint", NewListIngestionTiersEndpoint(tierStore)).ServeHTTP)
+	r.Put("/ingestion-tiers/{"+tierNamePathParam+"}", api.WrapWithSegment("saveIngestionTierEndpoint", NewSaveIngestionTierEndpoint(tierStore, time.Now)).ServeHTTP)
+	r.Delete("/login-attempts", api.WrapWithSegment("unlockLoginAttemptsEndpoint", NewUnlockLoginAttemptsEndpoint(loginAttempts)).ServeHTTP)
+
+	// only there while the soft launch waitlist is on
+	if invitations != nil {
+		r.Get("/waitlist", api.WrapWithSegment("waitlistEndpoint", NewWaitlistEndpoint(invitations)).ServeHTTP)
+		r.Post("/invitations", api.WrapWithSegment("inviteEndpoint", NewInviteEndpoint(invitations)).ServeHTTP)
+	}
+
+	// kept out of request capture themselves, or listing captures would capture what was listed
+	r.Get("/requests", api.WithoutRequestCapture(api.WrapWithSegment("capturedRequestsEndpoint", NewCapturedRequestsEndpoint(requestCapture))).ServeHTTP)
+	r.Put("/requests/capture", api.WithoutRequestCapture(api.WrapWithSegment("enableRequestCaptureEndpoint", NewEnableRequestCaptureEndpoint(requestCapture))).ServeHTTP)
+	r.Delete("/requests/capture", api.WithoutRequestCapture(api.WrapWithSegment("disableRequestCaptureEndpoint", NewDisableRequestCaptureEndpoint(requestCapture))).ServeHTTP)
 
 	return r
 }
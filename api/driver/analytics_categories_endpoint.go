@@ -0,0 +1,102 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/series"
+	"github.com/rs/zerolog"
+)
+
+// SeriesCatalog supplies the iRacing series catalog that category suggestions are drawn from.
+type SeriesCatalog interface {
+	GetAll(ctx context.Context, accessToken string) ([]series.Series, error)
+}
+
+// NewAnalyticsCategoriesEndpoint creates the handler for GET /driver/{driver_id}/analytics/categories
+func NewAnalyticsCategoriesEndpoint(svc AnalyticsService, catalog SeriesCatalog) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SensitiveClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		errs := api.NewRequestErrors()
+
+		// Parse driver ID from path
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		// Parse time range from query params
+		var startTime, endTime time.Time
+
+		startTimeStr := r.URL.Query().Get(api.StartTimeQueryParam)
+		if startTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			startTime, err = time.Parse(time.RFC3339, startTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		endTimeStr := r.URL.Query().Get(api.EndTimeQueryParam)
+		if endTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			endTime, err = time.Parse(time.RFC3339, endTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		// Cross-field validation: endTime must be after startTime
+		if !startTime.IsZero() && !endTime.IsZero() && endTime.Before(startTime) {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeEndBeforeStart, nil)
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		seriesList, err := catalog.GetAll(ctx, claims.IRacingAccessToken)
+		if err != nil {
+			if errors.Is(err, iracing.ErrUpstreamUnauthorized) {
+				logger.Warn().Err(err).Msg("iRacing token expired while fetching series")
+				api.DoUnauthorizedResponse(ctx, "iRacing access token expired", w)
+				return
+			}
+			logger.Error().Err(err).Msg("failed to fetch series")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		profile, err := svc.GetCategoryProfile(ctx, analytics.CategoryProfileRequest{
+			DriverID: driverID,
+			From:     startTime,
+			To:       endTime,
+			Catalog:  seriesList,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to profile categories")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, categoryProfileResponseFromProfile(*profile), w)
+	})
+}
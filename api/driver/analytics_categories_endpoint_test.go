@@ -0,0 +1,138 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/series"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAnalyticsCategoriesEndpoint(t *testing.T) {
+	catalog := []series.Series{
+		{ID: 139, Name: "Street Stock Series", CategoryID: 1, LogoURL: "https://images-static.iracing.com/img/logos/series/street-stock.png", Active: true, Official: true, MinLicenseGroup: 1},
+	}
+	request := analytics.CategoryProfileRequest{
+		DriverID: 12345,
+		From:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+		Catalog:  catalog,
+	}
+
+	type catalogCall struct {
+		result []series.Series
+		err    error
+	}
+
+	type serviceCall struct {
+		result *analytics.CategoryProfile
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		queryString string
+
+		catalogCall *catalogCall
+		serviceCall *serviceCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			queryString: "startTime=2024-01-01T00:00:00Z&endTime=2024-06-30T00:00:00Z",
+			catalogCall: &catalogCall{result: catalog},
+			serviceCall: &serviceCall{
+				result: &analytics.CategoryProfile{
+					Categories: []analytics.CategoryUsage{
+						{Category: analytics.CategoryOval, Races: 2, Share: 0.1, Underused: true, Suggestions: catalog},
+						{Category: analytics.CategoryRoad, Races: 18, Share: 0.9},
+						{Category: analytics.CategoryDirt, Underused: true},
+					},
+					Unclassified: 4,
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_categories_success_response.json",
+		},
+		{
+			name:                "missing required params",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_categories_missing_params_response.json",
+		},
+		{
+			name:                "iracing token expired",
+			queryString:         "startTime=2024-01-01T00:00:00Z&endTime=2024-06-30T00:00:00Z",
+			catalogCall:         &catalogCall{err: iracing.ErrUpstreamUnauthorized},
+			expectedStatus:      http.StatusUnauthorized,
+			expectedBodyFixture: "fixtures/get_analytics_categories_iracing_expired_response.json",
+		},
+		{
+			name:                "service error",
+			queryString:         "startTime=2024-01-01T00:00:00Z&endTime=2024-06-30T00:00:00Z",
+			catalogCall:         &catalogCall{result: catalog},
+			serviceCall:         &serviceCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_analytics_categories_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCatalog := NewMockSeriesCatalog(t)
+			if tc.catalogCall != nil {
+				mockCatalog.EXPECT().GetAll(mock.Anything, "test-access-token").Return(tc.catalogCall.result, tc.catalogCall.err)
+			}
+			mockService := NewMockAnalyticsService(t)
+			if tc.serviceCall != nil {
+				mockService.EXPECT().GetCategoryProfile(mock.Anything, request).Return(tc.serviceCall.result, tc.serviceCall.err)
+			}
+
+			validator := &stubTokenValidator{
+				sessionClaims: &auth.SessionClaims{IRacingUserID: 12345, IRacingUserName: "Test Driver"},
+				accessToken:   "test-access-token",
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(validator))
+			r.Get("/{driver_id}/analytics/categories", NewAnalyticsCategoriesEndpoint(mockService, mockCatalog).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/12345/analytics/categories?"+tc.queryString, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
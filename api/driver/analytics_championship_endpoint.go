@@ -0,0 +1,118 @@
+package driver
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+// NewAnalyticsChampionshipEndpoint creates the handler for GET /driver/{driver_id}/analytics/championship
+func NewAnalyticsChampionshipEndpoint(svc AnalyticsService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		// Parse driver ID from path
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		// Parse time range from query params
+		var startTime, endTime time.Time
+
+		startTimeStr := r.URL.Query().Get(api.StartTimeQueryParam)
+		if startTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			startTime, err = time.Parse(time.RFC3339, startTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		endTimeStr := r.URL.Query().Get(api.EndTimeQueryParam)
+		if endTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			endTime, err = time.Parse(time.RFC3339, endTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		// Cross-field validation: endTime must be after startTime
+		if !startTime.IsZero() && !endTime.IsZero() && endTime.Before(startTime) {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeEndBeforeStart, nil)
+		}
+
+		// Parse counted weeks (optional, service applies iRacing's default)
+		var countedWeeks int
+		if countedWeeksStr := r.URL.Query().Get(api.CountedWeeksQueryParam); countedWeeksStr != "" {
+			countedWeeks, err = strconv.Atoi(countedWeeksStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.CountedWeeksQueryParam, ErrCodeInvalidInteger, map[string]string{"value": countedWeeksStr})
+			} else if countedWeeks < 1 {
+				errs = errs.WithFieldErrorCode(api.CountedWeeksQueryParam, ErrCodePositiveInteger, map[string]string{"value": countedWeeksStr})
+			}
+		}
+
+		seriesIDs, seriesErrs := parseInt64Slice(r.URL.Query()[api.SeriesIDQueryParam])
+		for _, e := range seriesErrs {
+			errs = errs.WithFieldErrorCode(api.SeriesIDQueryParam, ErrCodeInvalidInteger, map[string]string{"value": e})
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		result, err := svc.GetChampionship(ctx, analytics.ChampionshipRequest{
+			DriverID:     driverID,
+			From:         startTime,
+			To:           endTime,
+			SeriesIDs:    seriesIDs,
+			CountedWeeks: countedWeeks,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get championship standings")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		response := ChampionshipResponse{
+			Standings: make([]ChampionshipStanding, len(result.Standings)),
+		}
+		for i, s := range result.Standings {
+			weeks := make([]ChampionshipWeek, len(s.Weeks))
+			for j, week := range s.Weeks {
+				weeks[j] = ChampionshipWeek{
+					RaceWeekNum: week.RaceWeekNum,
+					RaceCount:   week.RaceCount,
+					Points:      week.Points,
+					Dropped:     week.Dropped,
+				}
+			}
+			response.Standings[i] = ChampionshipStanding{
+				SeasonID:      s.SeasonID,
+				SeasonYear:    s.SeasonYear,
+				SeasonQuarter: s.SeasonQuarter,
+				SeriesID:      s.SeriesID,
+				SeriesName:    s.SeriesName,
+				TotalPoints:   s.TotalPoints,
+				WeeksRaced:    s.WeeksRaced,
+				WeeksCounted:  s.WeeksCounted,
+				Weeks:         weeks,
+			}
+		}
+
+		api.DoOKResponse(ctx, response, w)
+	})
+}
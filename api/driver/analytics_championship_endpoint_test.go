@@ -0,0 +1,151 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAnalyticsChampionshipEndpoint(t *testing.T) {
+	type serviceCall struct {
+		req    analytics.ChampionshipRequest
+		result *analytics.ChampionshipResult
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		queryString string
+
+		serviceCall *serviceCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			driverID:    "12345",
+			queryString: "startTime=2024-01-01T00:00:00Z&endTime=2024-06-30T00:00:00Z&seriesId=42&countedWeeks=2",
+			serviceCall: &serviceCall{
+				req: analytics.ChampionshipRequest{
+					DriverID:     12345,
+					From:         time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					To:           time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+					SeriesIDs:    []int64{42},
+					CountedWeeks: 2,
+				},
+				result: &analytics.ChampionshipResult{
+					Standings: []analytics.ChampionshipStanding{
+						{
+							SeasonID:      4500,
+							SeasonYear:    2024,
+							SeasonQuarter: 2,
+							SeriesID:      42,
+							SeriesName:    "Advanced Mazda MX-5 Cup Series",
+							TotalPoints:   160,
+							WeeksRaced:    3,
+							WeeksCounted:  2,
+							Weeks: []analytics.ChampionshipWeek{
+								{RaceWeekNum: 0, RaceCount: 3, Points: 90},
+								{RaceWeekNum: 1, RaceCount: 1, Points: 30, Dropped: true},
+								{RaceWeekNum: 2, RaceCount: 2, Points: 70},
+							},
+						},
+					},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_championship_success_response.json",
+		},
+		{
+			name:        "no standings",
+			driverID:    "12345",
+			queryString: "startTime=2024-01-01T00:00:00Z&endTime=2024-06-30T00:00:00Z",
+			serviceCall: &serviceCall{
+				req: analytics.ChampionshipRequest{
+					DriverID: 12345,
+					From:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					To:       time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+				},
+				result: &analytics.ChampionshipResult{Standings: []analytics.ChampionshipStanding{}},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_championship_empty_response.json",
+		},
+		{
+			name:                "missing required params",
+			driverID:            "12345",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_championship_missing_params_response.json",
+		},
+		{
+			name:                "invalid counted weeks and filter",
+			driverID:            "12345",
+			queryString:         "startTime=2024-01-01T00:00:00Z&endTime=2024-06-30T00:00:00Z&countedWeeks=0&seriesId=xyz",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_championship_invalid_params_response.json",
+		},
+		{
+			name:        "service error",
+			driverID:    "12345",
+			queryString: "startTime=2024-01-01T00:00:00Z&endTime=2024-06-30T00:00:00Z",
+			serviceCall: &serviceCall{
+				req: analytics.ChampionshipRequest{
+					DriverID: 12345,
+					From:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					To:       time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+				},
+				err: errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_analytics_championship_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockAnalyticsService(t)
+			if tc.serviceCall != nil {
+				mockService.EXPECT().GetChampionship(mock.Anything, tc.serviceCall.req).
+					Return(tc.serviceCall.result, tc.serviceCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/analytics/championship", NewAnalyticsChampionshipEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/analytics/championship?"+tc.queryString, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
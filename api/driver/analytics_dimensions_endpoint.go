@@ -16,6 +16,12 @@ import (
 type AnalyticsService interface {
 	GetDimensions(ctx context.Context, driverID int64, from, to time.Time) (*analytics.Dimensions, error)
 	GetAnalytics(ctx context.Context, req analytics.AnalyticsRequest) (*analytics.AnalyticsResult, error)
+	PredictRace(ctx context.Context, req analytics.PredictionRequest) (*analytics.Prediction, error)
+	GetChampionship(ctx context.Context, req analytics.ChampionshipRequest) (*analytics.ChampionshipResult, error)
+	GetRegionComparison(ctx context.Context, req analytics.RegionComparisonRequest) (*analytics.RegionComparison, error)
+	GetCategoryProfile(ctx context.Context, req analytics.CategoryProfileRequest) (*analytics.CategoryProfile, error)
+	GetTimeOfDayProfile(ctx context.Context, req analytics.TimeOfDayRequest) (*analytics.TimeOfDayProfile, error)
+	GetSRRecoveryPlan(ctx context.Context, req analytics.SRRecoveryRequest) (*analytics.SRRecoveryPlan, error)
 }
 
 // Error codes for i18n support
@@ -27,6 +33,7 @@ const (
 	ErrCodeEndBeforeStart   = "end_before_start"
 	ErrCodeInvalidValue     = "invalid_value"
 	ErrCodeMutualExclusive  = "mutual_exclusive"
+	ErrCodeUnknownField     = "unknown_field"
 )
 
 // NewAnalyticsDimensionsEndpoint creates the handler for GET /driver/{driver_id}/analytics/dimensions
@@ -79,7 +86,7 @@ func NewAnalyticsDimensionsEndpoint(svc AnalyticsService) http.Handler {
 		// Get dimensions from service
 		dims, err := svc.GetDimensions(ctx, driverID, startTime, endTime)
 		if err != nil {
-			logger.Error().Err(err).Int64("driverId", driverID).Msg("failed to get dimensions")
+			logger.Error().Err(err).Msg("failed to get dimensions")
 			api.DoErrorResponse(ctx, w)
 			return
 		}
@@ -108,6 +108,31 @@ func NewAnalyticsEndpoint(svc AnalyticsService) http.Handler {
 			}
 		}
 
+		var finishedOnly bool
+		if f := r.URL.Query().Get(api.FinishedOnlyQueryParam); f != "" {
+			finishedOnly, err = strconv.ParseBool(f)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.FinishedOnlyQueryParam, ErrCodeInvalidValue, map[string]string{
+					"value":   f,
+					"allowed": "true, false",
+				})
+			}
+		}
+
+		var textSummary bool
+		if t := r.URL.Query().Get(api.TextSummaryQueryParam); t != "" {
+			textSummary, err = strconv.ParseBool(t)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.TextSummaryQueryParam, ErrCodeInvalidValue, map[string]string{
+					"value":   t,
+					"allowed": "true, false",
+				})
+			}
+		}
+
+		var fields api.FieldSelection
+		fields, errs = parseFieldSelection[AnalyticsResponse](r, errs)
+
 		if errs.HasAnyError() {
 			api.DoBadRequestResponse(ctx, errs, w)
 			return
@@ -115,26 +140,29 @@ func NewAnalyticsEndpoint(svc AnalyticsService) http.Handler {
 
 		// Build request and call service
 		req := analytics.AnalyticsRequest{
-			DriverID:    driverID,
-			From:        startTime,
-			To:          endTime,
-			GroupBy:     groupBy,
-			Granularity: granularity,
-			SeriesIDs:   seriesIDs,
-			CarIDs:      carIDs,
-			TrackIDs:    trackIDs,
+			DriverID:     driverID,
+			From:         startTime,
+			To:           endTime,
+			GroupBy:      groupBy,
+			Granularity:  granularity,
+			SeriesIDs:    seriesIDs,
+			CarIDs:       carIDs,
+			TrackIDs:     trackIDs,
+			FinishedOnly: finishedOnly,
+			TextSummary:  textSummary,
 		}
 
 		result, err := svc.GetAnalytics(ctx, req)
 		if err != nil {
-			logger.Error().Err(err).Int64("driverId", driverID).Msg("failed to get analytics")
+			logger.Error().Err(err).Msg("failed to get analytics")
 			api.DoErrorResponse(ctx, w)
 			return
 		}
 
 		// Convert domain result to API response
 		response := AnalyticsResponse{
-			Summary: summaryFromDomain(result.Summary),
+			Summary:     summaryFromDomain(result.Summary),
+			TextSummary: result.TextSummary,
 		}
 
 		if len(result.GroupedBy) > 0 {
@@ -159,30 +187,66 @@ func NewAnalyticsEndpoint(svc AnalyticsService) http.Handler {
 			}
 		}
 
-		api.DoOKResponse(ctx, response, w)
+		if result.CPIBreakdown != nil {
+			response.CPIBreakdown = &AnalyticsCPIBreakdown{
+				BySeries: cpiContributionsFromDomain(result.CPIBreakdown.BySeries),
+				ByTrack:  cpiContributionsFromDomain(result.CPIBreakdown.ByTrack),
+			}
+		}
+
+		api.DoOKResponse(ctx, fields.Select(response), w)
 	})
 }
 
+func cpiContributionsFromDomain(contributions []analytics.CPIContribution) []AnalyticsCPIContribution {
+	ret := make([]AnalyticsCPIContribution, len(contributions))
+	for i, c := range contributions {
+		ret[i] = AnalyticsCPIContribution{
+			SeriesID:         c.SeriesID,
+			TrackID:          c.TrackID,
+			RaceCount:        c.RaceCount,
+			CPIGain:          c.CPIGain,
+			CPILoss:          c.CPILoss,
+			CPIDelta:         c.CPIDelta,
+			Corners:          c.Corners,
+			Incidents:        c.Incidents,
+			CPILossPerCorner: c.CPILossPerCorner,
+		}
+	}
+	return ret
+}
+
 func summaryFromDomain(s analytics.Summary) AnalyticsSummary {
 	return AnalyticsSummary{
-		RaceCount:         s.RaceCount,
-		IRatingStart:      s.IRatingStart,
-		IRatingEnd:        s.IRatingEnd,
-		IRatingDelta:      s.IRatingDelta,
-		IRatingGain:       s.IRatingGain,
-		IRatingLoss:       s.IRatingLoss,
-		CPIStart:          s.CPIStart,
-		CPIEnd:            s.CPIEnd,
-		CPIDelta:          s.CPIDelta,
-		CPIGain:           s.CPIGain,
-		CPILoss:           s.CPILoss,
-		Podiums:           s.Podiums,
-		Top5Finishes:      s.Top5Finishes,
-		Wins:              s.Wins,
-		AvgFinishPosition: s.AvgFinishPosition,
-		AvgStartPosition:  s.AvgStartPosition,
-		PositionsGained:   s.PositionsGained,
-		TotalIncidents:    s.TotalIncidents,
-		AvgIncidents:      s.AvgIncidents,
+		RaceCount:          s.RaceCount,
+		IRatingStart:       s.IRatingStart,
+		IRatingEnd:         s.IRatingEnd,
+		IRatingDelta:       s.IRatingDelta,
+		IRatingGain:        s.IRatingGain,
+		IRatingLoss:        s.IRatingLoss,
+		CPIStart:           s.CPIStart,
+		CPIEnd:             s.CPIEnd,
+		CPIDelta:           s.CPIDelta,
+		CPIGain:            s.CPIGain,
+		CPILoss:            s.CPILoss,
+		Podiums:            s.Podiums,
+		Top5Finishes:       s.Top5Finishes,
+		Wins:               s.Wins,
+		AvgFinishPosition:  s.AvgFinishPosition,
+		AvgStartPosition:   s.AvgStartPosition,
+		PositionsGained:    s.PositionsGained,
+		TotalIncidents:     s.TotalIncidents,
+		AvgIncidents:       s.AvgIncidents,
+		TotalCorners:       s.TotalCorners,
+		CornersPerIncident: s.CornersPerIncident,
+		TrafficRaceCount:   s.TrafficRaceCount,
+		TotalTrafficCost:   s.TotalTrafficCost,
+		AvgTrafficCost:     s.AvgTrafficCost,
+		DNFs:               s.DNFs,
+		DNFRate:            s.DNFRate,
+		DQs:                s.DQs,
+		DQRate:             s.DQRate,
+		Disconnects:        s.Disconnects,
+		DisconnectRate:     s.DisconnectRate,
 	}
 }
\ No newline at end of file
@@ -19,29 +19,38 @@ import (
 
 func TestNewAnalyticsEndpoint(t *testing.T) {
 	baseSummary := analytics.Summary{
-		RaceCount:         3,
-		IRatingStart:      1500,
-		IRatingEnd:        1600,
-		IRatingDelta:      100,
-		IRatingGain:       130,
-		IRatingLoss:       30,
-		CPIStart:          3.0,
-		CPIEnd:            3.2,
-		CPIDelta:          0.2,
-		CPIGain:           0.4,
-		CPILoss:           0.2,
-		Podiums:           2,
-		Top5Finishes:      2,
-		Wins:              1,
-		AvgFinishPosition: 3.6666666666666665,
-		AvgStartPosition:  6,
-		PositionsGained:   2.3333333333333335,
-		TotalIncidents:    6,
-		AvgIncidents:      2,
+		RaceCount:          3,
+		IRatingStart:       1500,
+		IRatingEnd:         1600,
+		IRatingDelta:       100,
+		IRatingGain:        130,
+		IRatingLoss:        30,
+		CPIStart:           3.0,
+		CPIEnd:             3.2,
+		CPIDelta:           0.2,
+		CPIGain:            0.4,
+		CPILoss:            0.2,
+		Podiums:            2,
+		Top5Finishes:       2,
+		Wins:               1,
+		AvgFinishPosition:  3.6666666666666665,
+		AvgStartPosition:   6,
+		PositionsGained:    2.3333333333333335,
+		TotalIncidents:     6,
+		AvgIncidents:       2,
+		TotalCorners:       540,
+		CornersPerIncident: 90,
+		TrafficRaceCount:   2,
+		TotalTrafficCost:   51000,
+		AvgTrafficCost:     25500,
+		DNFs:               1,
+		DNFRate:            0.3333333333333333,
 	}
 
 	seriesID42 := int64(42)
 	seriesID43 := int64(43)
+	trackID100 := int64(100)
+	trackID101 := int64(101)
 
 	groupedResult := &analytics.AnalyticsResult{
 		Summary: baseSummary,
@@ -106,12 +115,15 @@ func TestNewAnalyticsEndpoint(t *testing.T) {
 	testCases := []struct {
 		name string
 
-		driverID    string
-		startTime   string
-		endTime     string
-		groupBy     []string
-		granularity string
-		seriesID    []string
+		driverID     string
+		startTime    string
+		endTime      string
+		groupBy      []string
+		granularity  string
+		seriesID     []string
+		finishedOnly string
+		textSummary  string
+		fields       string
 
 		serviceCalls []serviceCall
 
@@ -132,6 +144,15 @@ func TestNewAnalyticsEndpoint(t *testing.T) {
 					},
 					result: &analytics.AnalyticsResult{
 						Summary: baseSummary,
+						CPIBreakdown: &analytics.CPIBreakdown{
+							BySeries: []analytics.CPIContribution{
+								{SeriesID: &seriesID42, RaceCount: 3, CPIGain: 0.4, CPILoss: 0.2, CPIDelta: 0.2, Corners: 540, Incidents: 6, CPILossPerCorner: 0.2 / 540},
+							},
+							ByTrack: []analytics.CPIContribution{
+								{TrackID: &trackID100, RaceCount: 2, CPILoss: 0.2, CPIDelta: -0.2, Corners: 400, Incidents: 6, CPILossPerCorner: 0.0005},
+								{TrackID: &trackID101, RaceCount: 1, CPIGain: 0.4, CPIDelta: 0.4, Corners: 140},
+							},
+						},
 					},
 				},
 			},
@@ -158,6 +179,100 @@ func TestNewAnalyticsEndpoint(t *testing.T) {
 			expectedStatus:      http.StatusOK,
 			expectedBodyFixture: "fixtures/get_analytics_with_groupby_response.json",
 		},
+		{
+			name:      "success with selected fields",
+			driverID:  "12345",
+			startTime: "2024-01-01T00:00:00Z",
+			endTime:   "2024-01-31T00:00:00Z",
+			groupBy:   []string{"series"},
+			fields:    "summary.raceCount,groupedBy.seriesId,groupedBy.summary.iRatingDelta",
+			serviceCalls: []serviceCall{
+				{
+					req: analytics.AnalyticsRequest{
+						DriverID: 12345,
+						From:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						To:       time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+						GroupBy:  []analytics.GroupByDimension{analytics.GroupBySeries},
+					},
+					result: groupedResult,
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_selected_fields_response.json",
+		},
+		{
+			name:                "unknown field",
+			driverID:            "12345",
+			startTime:           "2024-01-01T00:00:00Z",
+			endTime:             "2024-01-31T00:00:00Z",
+			fields:              "summary.nope",
+			serviceCalls:        []serviceCall{},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_unknown_field_response.json",
+		},
+		{
+			name:         "success finished only",
+			driverID:     "12345",
+			startTime:    "2024-01-01T00:00:00Z",
+			endTime:      "2024-01-31T00:00:00Z",
+			finishedOnly: "true",
+			serviceCalls: []serviceCall{
+				{
+					req: analytics.AnalyticsRequest{
+						DriverID:     12345,
+						From:         time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						To:           time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+						FinishedOnly: true,
+					},
+					result: groupedResult,
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_with_groupby_response.json",
+		},
+		{
+			name:                "invalid finishedOnly",
+			driverID:            "12345",
+			startTime:           "2024-01-01T00:00:00Z",
+			endTime:             "2024-01-31T00:00:00Z",
+			finishedOnly:        "maybe",
+			serviceCalls:        []serviceCall{},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_invalid_finished_only_response.json",
+		},
+		{
+			name:        "success with text summary",
+			driverID:    "12345",
+			startTime:   "2024-01-01T00:00:00Z",
+			endTime:     "2024-01-31T00:00:00Z",
+			textSummary: "true",
+			serviceCalls: []serviceCall{
+				{
+					req: analytics.AnalyticsRequest{
+						DriverID:    12345,
+						From:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						To:          time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+						TextSummary: true,
+					},
+					result: &analytics.AnalyticsResult{
+						Summary:     baseSummary,
+						TextSummary: "You raced 3 times. You gained 100 iRating, ending at 1600.",
+					},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_text_summary_response.json",
+		},
+		{
+			name:                "invalid textSummary",
+			driverID:            "12345",
+			startTime:           "2024-01-01T00:00:00Z",
+			endTime:             "2024-01-31T00:00:00Z",
+			textSummary:         "please",
+			serviceCalls:        []serviceCall{},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_invalid_text_summary_response.json",
+		},
 		{
 			name:                "missing required params",
 			driverID:            "12345",
@@ -227,6 +342,15 @@ func TestNewAnalyticsEndpoint(t *testing.T) {
 			for _, s := range tc.seriesID {
 				url += "seriesId=" + s + "&"
 			}
+			if tc.finishedOnly != "" {
+				url += "finishedOnly=" + tc.finishedOnly + "&"
+			}
+			if tc.textSummary != "" {
+				url += "textSummary=" + tc.textSummary + "&"
+			}
+			if tc.fields != "" {
+				url += "fields=" + tc.fields + "&"
+			}
 
 			req, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
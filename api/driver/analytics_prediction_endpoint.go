@@ -0,0 +1,113 @@
+package driver
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+// NewAnalyticsPredictionEndpoint creates the handler for GET /driver/{driver_id}/analytics/prediction
+func NewAnalyticsPredictionEndpoint(svc AnalyticsService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		// Parse driver ID from path
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		// Parse time range from query params, this is the window of history the prediction draws from
+		var startTime, endTime time.Time
+
+		startTimeStr := r.URL.Query().Get(api.StartTimeQueryParam)
+		if startTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			startTime, err = time.Parse(time.RFC3339, startTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		endTimeStr := r.URL.Query().Get(api.EndTimeQueryParam)
+		if endTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			endTime, err = time.Parse(time.RFC3339, endTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		// Cross-field validation: endTime must be after startTime
+		if !startTime.IsZero() && !endTime.IsZero() && endTime.Before(startTime) {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeEndBeforeStart, nil)
+		}
+
+		// Parse SOF estimate (optional)
+		var sof int
+		if sofStr := r.URL.Query().Get(api.SOFQueryParam); sofStr != "" {
+			sof, err = strconv.Atoi(sofStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.SOFQueryParam, ErrCodeInvalidInteger, map[string]string{"value": sofStr})
+			} else if sof < 1 {
+				errs = errs.WithFieldErrorCode(api.SOFQueryParam, ErrCodePositiveInteger, map[string]string{"value": sofStr})
+			}
+		}
+
+		// Parse filter params (repeated params for OR within dimension)
+		seriesIDs, seriesErrs := parseInt64Slice(r.URL.Query()[api.SeriesIDQueryParam])
+		for _, e := range seriesErrs {
+			errs = errs.WithFieldErrorCode(api.SeriesIDQueryParam, ErrCodeInvalidInteger, map[string]string{"value": e})
+		}
+
+		carIDs, carErrs := parseInt64Slice(r.URL.Query()[api.CarIDQueryParam])
+		for _, e := range carErrs {
+			errs = errs.WithFieldErrorCode(api.CarIDQueryParam, ErrCodeInvalidInteger, map[string]string{"value": e})
+		}
+
+		trackIDs, trackErrs := parseInt64Slice(r.URL.Query()[api.TrackIDQueryParam])
+		for _, e := range trackErrs {
+			errs = errs.WithFieldErrorCode(api.TrackIDQueryParam, ErrCodeInvalidInteger, map[string]string{"value": e})
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		prediction, err := svc.PredictRace(ctx, analytics.PredictionRequest{
+			DriverID:        driverID,
+			From:            startTime,
+			To:              endTime,
+			SeriesIDs:       seriesIDs,
+			CarIDs:          carIDs,
+			TrackIDs:        trackIDs,
+			StrengthOfField: sof,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to predict race")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, PredictionResponse{
+			SampleSize:          prediction.SampleSize,
+			SOFMatched:          prediction.SOFMatched,
+			ExpectedFinish:      prediction.ExpectedFinish,
+			FinishRangeLow:      prediction.FinishRangeLow,
+			FinishRangeHigh:     prediction.FinishRangeHigh,
+			IncidentProbability: prediction.IncidentProbability,
+			AvgIncidents:        prediction.AvgIncidents,
+		}, w)
+	})
+}
@@ -0,0 +1,135 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAnalyticsPredictionEndpoint(t *testing.T) {
+	type serviceCall struct {
+		req    analytics.PredictionRequest
+		result *analytics.Prediction
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		queryString string
+
+		serviceCall *serviceCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			driverID:    "12345",
+			queryString: "startTime=2024-01-01T00:00:00Z&endTime=2024-06-30T00:00:00Z&seriesId=42&trackId=100&carId=10&sof=1500",
+			serviceCall: &serviceCall{
+				req: analytics.PredictionRequest{
+					DriverID:        12345,
+					From:            time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					To:              time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+					SeriesIDs:       []int64{42},
+					CarIDs:          []int64{10},
+					TrackIDs:        []int64{100},
+					StrengthOfField: 1500,
+				},
+				result: &analytics.Prediction{
+					SampleSize:          3,
+					SOFMatched:          true,
+					ExpectedFinish:      4,
+					FinishRangeLow:      2,
+					FinishRangeHigh:     6,
+					IncidentProbability: 2.0 / 3,
+					AvgIncidents:        2,
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_prediction_success_response.json",
+		},
+		{
+			name:                "missing required params",
+			driverID:            "12345",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_prediction_missing_params_response.json",
+		},
+		{
+			name:                "non positive sof",
+			driverID:            "12345",
+			queryString:         "startTime=2024-01-01T00:00:00Z&endTime=2024-06-30T00:00:00Z&sof=0",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_prediction_invalid_sof_response.json",
+		},
+		{
+			name:                "invalid sof and filter",
+			driverID:            "12345",
+			queryString:         "startTime=2024-01-01T00:00:00Z&endTime=2024-06-30T00:00:00Z&sof=abc&trackId=xyz",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_prediction_invalid_filter_response.json",
+		},
+		{
+			name:        "service error",
+			driverID:    "12345",
+			queryString: "startTime=2024-01-01T00:00:00Z&endTime=2024-06-30T00:00:00Z",
+			serviceCall: &serviceCall{
+				req: analytics.PredictionRequest{
+					DriverID: 12345,
+					From:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					To:       time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+				},
+				err: errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_analytics_prediction_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockAnalyticsService(t)
+			if tc.serviceCall != nil {
+				mockService.EXPECT().PredictRace(mock.Anything, tc.serviceCall.req).
+					Return(tc.serviceCall.result, tc.serviceCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/analytics/prediction", NewAnalyticsPredictionEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/analytics/prediction?"+tc.queryString, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package driver
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+// NewAnalyticsRegionEndpoint creates the handler for GET /driver/{driver_id}/analytics/region
+func NewAnalyticsRegionEndpoint(svc AnalyticsService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		// Parse driver ID from path
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		// Parse time range from query params, the comparison widens it out to whole race weeks
+		var startTime, endTime time.Time
+
+		startTimeStr := r.URL.Query().Get(api.StartTimeQueryParam)
+		if startTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			startTime, err = time.Parse(time.RFC3339, startTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		endTimeStr := r.URL.Query().Get(api.EndTimeQueryParam)
+		if endTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			endTime, err = time.Parse(time.RFC3339, endTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		// Cross-field validation: endTime must be after startTime
+		if !startTime.IsZero() && !endTime.IsZero() && endTime.Before(startTime) {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeEndBeforeStart, nil)
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		comparison, err := svc.GetRegionComparison(ctx, analytics.RegionComparisonRequest{
+			DriverID: driverID,
+			From:     startTime,
+			To:       endTime,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to compare driver to region")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		resp := RegionComparisonResponse{
+			ClubID:   comparison.ClubID,
+			ClubName: comparison.ClubName,
+			Weeks:    comparison.Weeks,
+			Driver:   regionStatsResponseFromStats(comparison.Driver),
+		}
+		if comparison.Region != nil {
+			region := regionStatsResponseFromStats(*comparison.Region)
+			resp.Region = &region
+		}
+		api.DoOKResponse(ctx, resp, w)
+	})
+}
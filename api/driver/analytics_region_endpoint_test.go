@@ -0,0 +1,132 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAnalyticsRegionEndpoint(t *testing.T) {
+	type serviceCall struct {
+		req    analytics.RegionComparisonRequest
+		result *analytics.RegionComparison
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		queryString string
+
+		serviceCall *serviceCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			driverID:    "12345",
+			queryString: "startTime=2026-01-08T00:00:00Z&endTime=2026-01-14T12:00:00Z",
+			serviceCall: &serviceCall{
+				req: analytics.RegionComparisonRequest{
+					DriverID: 12345,
+					From:     time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC),
+					To:       time.Date(2026, 1, 14, 12, 0, 0, 0, time.UTC),
+				},
+				result: &analytics.RegionComparison{
+					ClubID:   7,
+					ClubName: "Great Plains",
+					Weeks:    1,
+					Driver:   analytics.RegionStats{Races: 2, WinRate: 0.5, Top5Rate: 0.5, AvgIncidents: 3, AvgIRatingChange: 10},
+					Region:   &analytics.RegionStats{Races: 10, WinRate: 0.1, Top5Rate: 0.4, AvgIncidents: 2.8, AvgIRatingChange: 6, AvgSubLevelChange: 5},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_region_success_response.json",
+		},
+		{
+			name:        "no region to compare against",
+			driverID:    "12345",
+			queryString: "startTime=2026-01-08T00:00:00Z&endTime=2026-01-14T12:00:00Z",
+			serviceCall: &serviceCall{
+				req: analytics.RegionComparisonRequest{
+					DriverID: 12345,
+					From:     time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC),
+					To:       time.Date(2026, 1, 14, 12, 0, 0, 0, time.UTC),
+				},
+				result: &analytics.RegionComparison{
+					Driver: analytics.RegionStats{Races: 2, WinRate: 0.5, Top5Rate: 0.5, AvgIncidents: 3, AvgIRatingChange: 10},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_region_no_region_response.json",
+		},
+		{
+			name:                "missing required params",
+			driverID:            "12345",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_region_missing_params_response.json",
+		},
+		{
+			name:        "service error",
+			driverID:    "12345",
+			queryString: "startTime=2026-01-08T00:00:00Z&endTime=2026-01-14T12:00:00Z",
+			serviceCall: &serviceCall{
+				req: analytics.RegionComparisonRequest{
+					DriverID: 12345,
+					From:     time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC),
+					To:       time.Date(2026, 1, 14, 12, 0, 0, 0, time.UTC),
+				},
+				err: errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_analytics_region_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockAnalyticsService(t)
+			if tc.serviceCall != nil {
+				mockService.EXPECT().GetRegionComparison(mock.Anything, tc.serviceCall.req).
+					Return(tc.serviceCall.result, tc.serviceCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/analytics/region", NewAnalyticsRegionEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/analytics/region?"+tc.queryString, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
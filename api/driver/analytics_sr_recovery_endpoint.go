@@ -0,0 +1,127 @@
+package driver
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+// maxSubLevel is the highest safety rating iRacing hands out, 4.99
+const maxSubLevel = 499
+
+// NewAnalyticsSRRecoveryEndpoint creates the handler for GET /driver/{driver_id}/analytics/sr-recovery
+func NewAnalyticsSRRecoveryEndpoint(svc AnalyticsService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		// Parse driver ID from path
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		// Parse time range from query params, this is the window of history the driver's SR rates are fitted to
+		var startTime, endTime time.Time
+
+		startTimeStr := r.URL.Query().Get(api.StartTimeQueryParam)
+		if startTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			startTime, err = time.Parse(time.RFC3339, startTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		endTimeStr := r.URL.Query().Get(api.EndTimeQueryParam)
+		if endTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			endTime, err = time.Parse(time.RFC3339, endTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		// Cross-field validation: endTime must be after startTime
+		if !startTime.IsZero() && !endTime.IsZero() && endTime.Before(startTime) {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeEndBeforeStart, nil)
+		}
+
+		// Parse license category, ratings are kept per category
+		var categoryID int
+		category := r.URL.Query().Get(api.CategoryQueryParam)
+		if category == "" {
+			errs = errs.WithFieldErrorCode(api.CategoryQueryParam, ErrCodeRequired, nil)
+		} else {
+			for id, name := range analytics.LicenseCategories {
+				if name == category {
+					categoryID = id
+				}
+			}
+			if categoryID == 0 {
+				errs = errs.WithFieldErrorCode(api.CategoryQueryParam, ErrCodeInvalidValue, map[string]string{
+					"value":   category,
+					"allowed": "oval, road, dirt_oval, dirt_road, sports_car, formula_car",
+				})
+			}
+		}
+
+		// Parse target SR, as a sub level like the ratings on races
+		var targetSubLevel int
+		targetStr := r.URL.Query().Get(api.TargetSubLevelQueryParam)
+		if targetStr == "" {
+			errs = errs.WithFieldErrorCode(api.TargetSubLevelQueryParam, ErrCodeRequired, nil)
+		} else {
+			targetSubLevel, err = strconv.Atoi(targetStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.TargetSubLevelQueryParam, ErrCodeInvalidInteger, map[string]string{"value": targetStr})
+			} else if targetSubLevel < 1 || targetSubLevel > maxSubLevel {
+				errs = errs.WithFieldErrorCode(api.TargetSubLevelQueryParam, ErrCodeInvalidValue, map[string]string{
+					"value":   targetStr,
+					"allowed": "1 - 499",
+				})
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		plan, err := svc.GetSRRecoveryPlan(ctx, analytics.SRRecoveryRequest{
+			DriverID:       driverID,
+			From:           startTime,
+			To:             endTime,
+			CategoryID:     categoryID,
+			TargetSubLevel: targetSubLevel,
+		})
+		if errors.Is(err, analytics.ErrSRTargetCrossesLicense) {
+			api.DoBadRequestResponse(ctx, errs.WithFieldErrorCode(api.TargetSubLevelQueryParam, ErrCodeInvalidValue, map[string]string{
+				"value":   targetStr,
+				"allowed": "1 - " + strconv.Itoa(analytics.PromotionSubLevel-1),
+			}), w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to plan sr recovery")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+		if plan == nil {
+			api.DoNotFoundResponse(ctx, "no races in this category over the requested range", w)
+			return
+		}
+
+		api.DoOKResponse(ctx, srRecoveryResponseFromPlan(*plan), w)
+	})
+}
@@ -0,0 +1,157 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAnalyticsSRRecoveryEndpoint(t *testing.T) {
+	type serviceCall struct {
+		req    analytics.SRRecoveryRequest
+		result *analytics.SRRecoveryPlan
+		err    error
+	}
+
+	testRequest := analytics.SRRecoveryRequest{
+		DriverID:       12345,
+		From:           time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:             time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+		CategoryID:     2,
+		TargetSubLevel: 300,
+	}
+	typicalRacesNeeded := 4
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		queryString string
+
+		serviceCall *serviceCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			driverID:    "12345",
+			queryString: "startTime=2026-01-01T00:00:00Z&endTime=2026-03-31T00:00:00Z&category=road&targetSubLevel=300",
+			serviceCall: &serviceCall{
+				req: testRequest,
+				result: &analytics.SRRecoveryPlan{
+					CategoryID:             2,
+					LicenseLevel:           14,
+					CurrentSubLevel:        250,
+					CurrentCPI:             40,
+					TargetSubLevel:         300,
+					SampleSize:             6,
+					SubLevelPerCleanCorner: 0.1,
+					SubLevelPerIncident:    2,
+					Scenarios: []analytics.SRRecoveryScenario{
+						{SeriesID: 42, Races: 3, CornersPerRace: 200, CornersPerIncident: 100, SubLevelPerCleanRace: 20, CleanRacesNeeded: 3, SubLevelPerTypicalRace: 16, TypicalRacesNeeded: &typicalRacesNeeded},
+						{SeriesID: 43, Races: 3, CornersPerRace: 120, CornersPerIncident: 10, SubLevelPerCleanRace: 12, CleanRacesNeeded: 5, SubLevelPerTypicalRace: -12},
+					},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_sr_recovery_success_response.json",
+		},
+		{
+			name:        "no races in category",
+			driverID:    "12345",
+			queryString: "startTime=2026-01-01T00:00:00Z&endTime=2026-03-31T00:00:00Z&category=road&targetSubLevel=300",
+			serviceCall: &serviceCall{
+				req: testRequest,
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_analytics_sr_recovery_not_found_response.json",
+		},
+		{
+			name:                "missing required params",
+			driverID:            "12345",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_sr_recovery_missing_params_response.json",
+		},
+		{
+			name:                "invalid params",
+			driverID:            "12345",
+			queryString:         "startTime=2026-01-01T00:00:00Z&endTime=2026-03-31T00:00:00Z&category=karting&targetSubLevel=500",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_sr_recovery_invalid_params_response.json",
+		},
+		{
+			name:        "target crosses a promotion",
+			driverID:    "12345",
+			queryString: "startTime=2026-01-01T00:00:00Z&endTime=2026-03-31T00:00:00Z&category=road&targetSubLevel=400",
+			serviceCall: &serviceCall{
+				req: analytics.SRRecoveryRequest{
+					DriverID:       12345,
+					From:           time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					To:             time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+					CategoryID:     2,
+					TargetSubLevel: 400,
+				},
+				err: analytics.ErrSRTargetCrossesLicense,
+			},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_sr_recovery_crosses_license_response.json",
+		},
+		{
+			name:        "service error",
+			driverID:    "12345",
+			queryString: "startTime=2026-01-01T00:00:00Z&endTime=2026-03-31T00:00:00Z&category=road&targetSubLevel=300",
+			serviceCall: &serviceCall{
+				req: testRequest,
+				err: errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_analytics_sr_recovery_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockAnalyticsService(t)
+			if tc.serviceCall != nil {
+				mockService.EXPECT().GetSRRecoveryPlan(mock.Anything, tc.serviceCall.req).
+					Return(tc.serviceCall.result, tc.serviceCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/analytics/sr-recovery", NewAnalyticsSRRecoveryEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/analytics/sr-recovery?"+tc.queryString, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAnalyticsTimeOfDayEndpoint(t *testing.T) {
+	type serviceCall struct {
+		req    analytics.TimeOfDayRequest
+		result *analytics.TimeOfDayProfile
+		err    error
+	}
+
+	req := analytics.TimeOfDayRequest{
+		DriverID: 12345,
+		From:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	noRaces := analytics.TimeStats{}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		queryString string
+
+		serviceCall *serviceCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			driverID:    "12345",
+			queryString: "startTime=2026-01-01T00:00:00Z&endTime=2026-01-31T00:00:00Z",
+			serviceCall: &serviceCall{
+				req: req,
+				result: &analytics.TimeOfDayProfile{
+					Timezone: "America/Chicago",
+					Overall:  analytics.TimeStats{Races: 4, AvgFinishPosition: 6, AvgIncidents: 4, AvgIRatingChange: -5},
+					TimesOfDay: []analytics.TimeOfDayStats{
+						{Band: analytics.TimeOfDayMorning, Stats: noRaces},
+						{Band: analytics.TimeOfDayAfternoon, Stats: analytics.TimeStats{Races: 1, AvgFinishPosition: 2, AvgIRatingChange: 30}},
+						{Band: analytics.TimeOfDayEvening, Stats: analytics.TimeStats{Races: 1, AvgFinishPosition: 4, AvgIncidents: 2, AvgIRatingChange: 10}},
+						{Band: analytics.TimeOfDayLateNight, Stats: analytics.TimeStats{Races: 2, AvgFinishPosition: 9, AvgIncidents: 7, AvgIRatingChange: -30}},
+					},
+					DaysOfWeek: []analytics.DayOfWeekStats{
+						{Day: time.Monday, Stats: noRaces},
+						{Day: time.Tuesday, Stats: noRaces},
+						{Day: time.Wednesday, Stats: noRaces},
+						{Day: time.Thursday, Stats: noRaces},
+						{Day: time.Friday, Stats: analytics.TimeStats{Races: 2, AvgFinishPosition: 9, AvgIncidents: 7, AvgIRatingChange: -30}},
+						{Day: time.Saturday, Stats: analytics.TimeStats{Races: 2, AvgFinishPosition: 3, AvgIncidents: 1, AvgIRatingChange: 20}},
+						{Day: time.Sunday, Stats: noRaces},
+					},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_time_of_day_success_response.json",
+		},
+		{
+			name:                "missing required params",
+			driverID:            "12345",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_analytics_time_of_day_missing_params_response.json",
+		},
+		{
+			name:        "service error",
+			driverID:    "12345",
+			queryString: "startTime=2026-01-01T00:00:00Z&endTime=2026-01-31T00:00:00Z",
+			serviceCall: &serviceCall{
+				req: req,
+				err: errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_analytics_time_of_day_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockAnalyticsService(t)
+			if tc.serviceCall != nil {
+				mockService.EXPECT().GetTimeOfDayProfile(mock.Anything, tc.serviceCall.req).
+					Return(tc.serviceCall.result, tc.serviceCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/analytics/time-of-day", NewAnalyticsTimeOfDayEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/analytics/time-of-day?"+tc.queryString, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+type CancelIngestionStore interface {
+	RequestIngestionCancel(ctx context.Context, driverID int64) error
+}
+
+// NewCancelIngestionEndpoint asks for the driver's ingestion to stop. Ingestion picks the request up between races, so
+// it is accepted rather than done by the time this returns, and connected clients hear ingestionCancelled once it has
+// stopped.
+func NewCancelIngestionEndpoint(store CancelIngestionStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		if err := store.RequestIngestionCancel(ctx, driverID); err != nil {
+			logger.Error().Err(err).Msg("failed to request ingestion cancel")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		logger.Info().Msg("ingestion cancel requested")
+
+		api.DoAcceptedResponse(ctx, map[string]string{"status": "cancelRequested"}, w)
+	})
+}
@@ -0,0 +1,89 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCancelIngestionEndpoint(t *testing.T) {
+	type storeCall struct {
+		driverID int64
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+
+		storeCall *storeCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			driverID:            "12345",
+			storeCall:           &storeCall{driverID: 12345},
+			expectedStatus:      http.StatusAccepted,
+			expectedBodyFixture: "fixtures/cancel_ingestion_accepted_response.json",
+		},
+		{
+			name:                "invalid driver id",
+			driverID:            "abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/cancel_ingestion_invalid_driver_id_response.json",
+		},
+		{
+			name:                "store error",
+			driverID:            "12345",
+			storeCall:           &storeCall{driverID: 12345, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/cancel_ingestion_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockCancelIngestionStore(t)
+			if tc.storeCall != nil {
+				mockStore.EXPECT().RequestIngestionCancel(mock.Anything, tc.storeCall.driverID).
+					Return(tc.storeCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Post("/{driver_id}/ingestion/cancel", NewCancelIngestionEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPost, ts.URL+"/"+tc.driverID+"/ingestion/cancel", nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/actionitem"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type ActionItemServiceForCreate interface {
+	Create(ctx context.Context, input actionitem.CreateInput) (*store.ActionItem, error)
+}
+
+func NewCreateActionItemEndpoint(actionItemService ActionItemServiceForCreate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var req CreateActionItemRequest
+		var dueDate *time.Time
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			for _, v := range actionitem.ValidateTitle(req.Title) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+			if req.DueDate != "" {
+				parsed, err := time.Parse(time.RFC3339, req.DueDate)
+				if err != nil {
+					errs = errs.WithFieldErrorCode("dueDate", ErrCodeInvalidISO8601, nil)
+				} else {
+					dueDate = &parsed
+				}
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		item, err := actionItemService.Create(ctx, actionitem.CreateInput{
+			DriverID: driverID,
+			Title:    req.Title,
+			RaceID:   req.RaceID,
+			TrackID:  req.TrackID,
+			DueDate:  dueDate,
+		})
+		if errors.Is(err, actionitem.ErrRaceNotFound) {
+			api.DoBadRequestResponse(ctx, errs.WithFieldErrorCode("raceId", "race_not_found", nil), w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to create action item")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, actionItemFromStore(*item), w)
+	})
+}
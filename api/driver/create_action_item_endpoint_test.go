@@ -0,0 +1,142 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/actionitem"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCreateActionItemEndpoint(t *testing.T) {
+	now := time.Unix(1700100000, 0)
+	dueDate := time.Unix(1700500000, 0).UTC()
+	testItem := store.ActionItem{
+		DriverID:  12345,
+		ItemID:    "item-1",
+		Title:     "Brake earlier into turn one",
+		Status:    store.ActionItemOpen,
+		RaceID:    1700000000,
+		TrackID:   42,
+		DueDate:   &dueDate,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	validInput := actionitem.CreateInput{
+		DriverID: 12345,
+		Title:    "Brake earlier into turn one",
+		RaceID:   1700000000,
+		DueDate:  &dueDate,
+	}
+	validBody := `{"title": "Brake earlier into turn one", "raceId": 1700000000, "dueDate": "2023-11-20T17:06:40Z"}`
+
+	type createCall struct {
+		input actionitem.CreateInput
+		item  *store.ActionItem
+		err   error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		requestBody string
+
+		createCalls []createCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			driverID:    "12345",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{input: validInput, item: &testItem},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/create_action_item_success_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			driverID:            "12345",
+			requestBody:         `{not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_action_item_invalid_json_response.json",
+		},
+		{
+			name:                "invalid request",
+			driverID:            "12345",
+			requestBody:         `{"title": " ", "dueDate": "next tuesday"}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_action_item_invalid_request_response.json",
+		},
+		{
+			name:        "race not found",
+			driverID:    "12345",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{input: validInput, err: actionitem.ErrRaceNotFound},
+			},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_action_item_race_not_found_response.json",
+		},
+		{
+			name:        "service error",
+			driverID:    "12345",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{input: validInput, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/create_action_item_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockActionItemServiceForCreate(t)
+			for _, call := range tc.createCalls {
+				mockService.EXPECT().Create(mock.Anything, call.input).
+					Return(call.item, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Post("/{driver_id}/action-items", NewCreateActionItemEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/" + tc.driverID + "/action-items"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
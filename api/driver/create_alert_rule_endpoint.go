@@ -0,0 +1,64 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/alert"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type AlertServiceForCreate interface {
+	Create(ctx context.Context, driverID int64, def alert.Definition) (*store.AlertRule, error)
+}
+
+func NewCreateAlertRuleEndpoint(alertService AlertServiceForCreate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var req AlertRuleRequest
+		var def alert.Definition
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			def = alertDefinitionFromRequest(req)
+			for _, v := range alert.Validate(def) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		rule, err := alertService.Create(ctx, driverID, def)
+		if errors.Is(err, alert.ErrTooManyRules) {
+			api.DoBadRequestResponse(ctx, errs.WithFieldErrorCode("rules", "too_many", map[string]string{
+				"max": strconv.Itoa(alert.MaxRulesPerDriver),
+			}), w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to create alert rule")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, alertRuleFromStore(*rule), w)
+	})
+}
@@ -0,0 +1,146 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/alert"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCreateAlertRuleEndpoint(t *testing.T) {
+	now := time.Unix(1700100000, 0)
+	testRule := store.AlertRule{
+		DriverID:    12345,
+		RuleID:      "rule-1",
+		Name:        "Incident creep",
+		Metric:      store.AlertMetricIncidents,
+		Aggregate:   store.AlertAggregateAverage,
+		WindowRaces: 10,
+		Comparison:  store.AlertComparisonAbove,
+		Threshold:   5,
+		Enabled:     true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	validDef := alert.Definition{
+		Name:        "Incident creep",
+		Metric:      store.AlertMetricIncidents,
+		Aggregate:   store.AlertAggregateAverage,
+		WindowRaces: 10,
+		Comparison:  store.AlertComparisonAbove,
+		Threshold:   5,
+		Enabled:     true,
+	}
+	validBody := `{"name": "Incident creep", "metric": "incidents", "aggregate": "average", "windowRaces": 10, "comparison": "above", "threshold": 5}`
+
+	type createCall struct {
+		def  alert.Definition
+		rule *store.AlertRule
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		requestBody string
+
+		createCalls []createCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success, enabled by default",
+			driverID:    "12345",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{def: validDef, rule: &testRule},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/create_alert_rule_success_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			driverID:            "12345",
+			requestBody:         `{not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_alert_rule_invalid_json_response.json",
+		},
+		{
+			name:                "invalid request",
+			driverID:            "12345",
+			requestBody:         `{"name": "", "metric": "incidents", "aggregate": "change", "comparison": "equals"}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_alert_rule_invalid_request_response.json",
+		},
+		{
+			name:        "too many rules",
+			driverID:    "12345",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{def: validDef, err: alert.ErrTooManyRules},
+			},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_alert_rule_too_many_response.json",
+		},
+		{
+			name:        "service error",
+			driverID:    "12345",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{def: validDef, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/create_alert_rule_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockAlertServiceForCreate(t)
+			for _, call := range tc.createCalls {
+				mockService.EXPECT().Create(mock.Anything, int64(12345), call.def).
+					Return(call.rule, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Post("/{driver_id}/alert-rules", NewCreateAlertRuleEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/" + tc.driverID + "/alert-rules"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/bookmark"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type BookmarkServiceForCreate interface {
+	Create(ctx context.Context, input bookmark.CreateInput) (*store.RaceBookmark, error)
+}
+
+func NewCreateBookmarkEndpoint(bookmarkService BookmarkServiceForCreate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var raceID int64
+		raceIDStr := chi.URLParam(r, "driver_race_id")
+		if raceIDStr == "" {
+			errs = errs.WithFieldError("driver_race_id", "required")
+		} else {
+			raceID, err = strconv.ParseInt(raceIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError("driver_race_id", "must be a valid integer")
+			}
+		}
+
+		var req CreateBookmarkRequest
+		replayTime := time.Duration(0)
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			replayTime = time.Duration(req.ReplayTimeMs) * time.Millisecond
+			for _, v := range bookmark.ValidateLap(req.Lap) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+			for _, v := range bookmark.ValidateNote(req.Note) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+			for _, v := range bookmark.ValidateReplayTime(replayTime) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		created, err := bookmarkService.Create(ctx, bookmark.CreateInput{
+			DriverID:   driverID,
+			RaceID:     raceID,
+			Lap:        req.Lap,
+			Note:       req.Note,
+			ReplayTime: replayTime,
+		})
+		if errors.Is(err, bookmark.ErrRaceNotFound) {
+			api.DoNotFoundResponse(ctx, "race not found", w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to create bookmark")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, bookmarkFromStore(*created), w)
+	})
+}
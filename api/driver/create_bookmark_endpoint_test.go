@@ -0,0 +1,135 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/bookmark"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCreateBookmarkEndpoint(t *testing.T) {
+	now := time.Unix(1700100000, 0)
+	testBookmark := store.RaceBookmark{
+		DriverID:   12345,
+		RaceID:     1700000000,
+		BookmarkID: "bookmark-1",
+		Lap:        3,
+		Note:       "Lost the rear on exit of turn four",
+		ReplayTime: 252500 * time.Millisecond,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	validInput := bookmark.CreateInput{
+		DriverID:   12345,
+		RaceID:     1700000000,
+		Lap:        3,
+		Note:       "Lost the rear on exit of turn four",
+		ReplayTime: 252500 * time.Millisecond,
+	}
+	validBody := `{"lap": 3, "note": "Lost the rear on exit of turn four", "replayTimeMs": 252500}`
+
+	type createCall struct {
+		input    bookmark.CreateInput
+		bookmark *store.RaceBookmark
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		requestBody string
+
+		createCalls []createCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{input: validInput, bookmark: &testBookmark},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/create_bookmark_success_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			requestBody:         `{not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_bookmark_invalid_json_response.json",
+		},
+		{
+			name:                "invalid request",
+			requestBody:         `{"lap": -1, "note": "", "replayTimeMs": -500}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_bookmark_invalid_request_response.json",
+		},
+		{
+			name:        "race not found",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{input: validInput, err: bookmark.ErrRaceNotFound},
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/create_bookmark_race_not_found_response.json",
+		},
+		{
+			name:        "service error",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{input: validInput, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/create_bookmark_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockBookmarkServiceForCreate(t)
+			for _, call := range tc.createCalls {
+				mockService.EXPECT().Create(mock.Anything, call.input).
+					Return(call.bookmark, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Post("/{driver_id}/races/{driver_race_id}/bookmarks", NewCreateBookmarkEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/12345/races/1700000000/bookmarks"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
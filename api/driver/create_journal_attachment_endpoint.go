@@ -0,0 +1,78 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/voicememo"
+	"github.com/rs/zerolog"
+)
+
+type VoiceMemoServiceForCreate interface {
+	CreateUpload(ctx context.Context, input voicememo.CreateUploadInput) (*voicememo.Upload, error)
+}
+
+// NewCreateJournalAttachmentEndpoint starts a voice memo upload. The audio itself never passes through the API, the
+// response carries a presigned URL the client PUTs it to.
+func NewCreateJournalAttachmentEndpoint(voiceMemoService VoiceMemoServiceForCreate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var raceID int64
+		raceIDStr := chi.URLParam(r, "driver_race_id")
+		if raceIDStr == "" {
+			errs = errs.WithFieldError("driver_race_id", "required")
+		} else {
+			raceID, err = strconv.ParseInt(raceIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError("driver_race_id", "must be a valid integer")
+			}
+		}
+
+		var req CreateJournalAttachmentRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			for _, v := range voicememo.ValidateUpload(req.ContentType, req.SizeBytes) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		upload, err := voiceMemoService.CreateUpload(ctx, voicememo.CreateUploadInput{
+			DriverID:    driverID,
+			RaceID:      raceID,
+			ContentType: req.ContentType,
+			SizeBytes:   req.SizeBytes,
+			Transcribe:  req.Transcribe,
+		})
+		if errors.Is(err, voicememo.ErrJournalEntryNotFound) {
+			api.DoBadRequestResponse(ctx, errs.WithFieldErrorCode("driver_race_id", "journal_entry_not_found", nil), w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to create journal attachment upload")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, journalAttachmentUploadFromService(*upload), w)
+	})
+}
@@ -0,0 +1,147 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/voicememo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCreateJournalAttachmentEndpoint(t *testing.T) {
+	testUpload := voicememo.Upload{
+		Attachment: voicememo.Attachment{
+			AttachmentID: "3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a",
+			ContentType:  "audio/webm",
+			CreatedAt:    time.Unix(1000, 0),
+			Status:       store.JournalAttachmentPendingUpload,
+			Transcribe:   true,
+		},
+		URL:       "https://memos.s3.amazonaws.com/memos/12345/1700000000/3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a?X-Amz-Signature=abc",
+		Headers:   map[string]string{"Content-Type": "audio/webm", "Content-Length": "4096"},
+		ExpiresAt: time.Unix(1000, 0).Add(15 * time.Minute),
+	}
+	validInput := voicememo.CreateUploadInput{
+		DriverID:    12345,
+		RaceID:      1700000000,
+		ContentType: "audio/webm",
+		SizeBytes:   4096,
+		Transcribe:  true,
+	}
+
+	type createCall struct {
+		input  voicememo.CreateUploadInput
+		upload *voicememo.Upload
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		raceID      string
+		requestBody string
+
+		createCalls []createCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			driverID:    "12345",
+			raceID:      "1700000000",
+			requestBody: `{"contentType": "audio/webm", "sizeBytes": 4096, "transcribe": true}`,
+			createCalls: []createCall{
+				{input: validInput, upload: &testUpload},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/create_journal_attachment_success_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			driverID:            "12345",
+			raceID:              "1700000000",
+			requestBody:         `{not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_journal_attachment_invalid_json_response.json",
+		},
+		{
+			name:                "invalid upload",
+			driverID:            "12345",
+			raceID:              "1700000000",
+			requestBody:         `{"contentType": "video/mp4", "sizeBytes": 104857600}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_journal_attachment_invalid_upload_response.json",
+		},
+		{
+			name:        "journal entry not found",
+			driverID:    "12345",
+			raceID:      "1700000000",
+			requestBody: `{"contentType": "audio/webm", "sizeBytes": 4096, "transcribe": true}`,
+			createCalls: []createCall{
+				{input: validInput, err: voicememo.ErrJournalEntryNotFound},
+			},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_journal_attachment_entry_not_found_response.json",
+		},
+		{
+			name:        "service error",
+			driverID:    "12345",
+			raceID:      "1700000000",
+			requestBody: `{"contentType": "audio/webm", "sizeBytes": 4096, "transcribe": true}`,
+			createCalls: []createCall{
+				{input: validInput, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/create_journal_attachment_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockVoiceMemoServiceForCreate(t)
+			for _, call := range tc.createCalls {
+				mockService.EXPECT().CreateUpload(mock.Anything, call.input).
+					Return(call.upload, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Post("/{driver_id}/races/{driver_race_id}/journal/attachments", NewCreateJournalAttachmentEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/" + tc.driverID + "/races/" + tc.raceID + "/journal/attachments"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/videolink"
+	"github.com/rs/zerolog"
+)
+
+type VideoLinkServiceForCreate interface {
+	Create(ctx context.Context, input videolink.CreateInput) (*store.VideoLink, error)
+}
+
+// NewCreateVideoLinkEndpoint attaches an external video to a race's journal entry, or to one of its laps when a lap
+// is given.
+func NewCreateVideoLinkEndpoint(videoLinkService VideoLinkServiceForCreate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var raceID int64
+		raceIDStr := chi.URLParam(r, "driver_race_id")
+		if raceIDStr == "" {
+			errs = errs.WithFieldError("driver_race_id", "required")
+		} else {
+			raceID, err = strconv.ParseInt(raceIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError("driver_race_id", "must be a valid integer")
+			}
+		}
+
+		var req CreateVideoLinkRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			for _, v := range videolink.ValidateURL(req.URL) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+			for _, v := range videolink.ValidateLap(req.Lap) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		link, err := videoLinkService.Create(ctx, videolink.CreateInput{
+			DriverID: driverID,
+			RaceID:   raceID,
+			Lap:      req.Lap,
+			URL:      req.URL,
+		})
+		if errors.Is(err, videolink.ErrRaceNotFound) {
+			api.DoNotFoundResponse(ctx, "race not found", w)
+			return
+		}
+		if errors.Is(err, videolink.ErrJournalEntryNotFound) {
+			api.DoBadRequestResponse(ctx, errs.WithFieldErrorCode("driver_race_id", "journal_entry_not_found", nil), w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to create video link")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, videoLinkFromStore(*link), w)
+	})
+}
@@ -0,0 +1,145 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/videolink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCreateVideoLinkEndpoint(t *testing.T) {
+	lap := 3
+	testLink := store.VideoLink{
+		DriverID:  12345,
+		RaceID:    1700000000,
+		LinkID:    "link-2",
+		Lap:       &lap,
+		URL:       "https://clips.twitch.tv/FunnyClipSlug",
+		Provider:  "twitch",
+		CreatedAt: time.Unix(1700100000, 0),
+	}
+	validInput := videolink.CreateInput{
+		DriverID: 12345,
+		RaceID:   1700000000,
+		Lap:      &lap,
+		URL:      "https://www.twitch.tv/somestreamer/clip/FunnyClipSlug",
+	}
+	validBody := `{"lap": 3, "url": "https://www.twitch.tv/somestreamer/clip/FunnyClipSlug"}`
+
+	type createCall struct {
+		input videolink.CreateInput
+		link  *store.VideoLink
+		err   error
+	}
+
+	testCases := []struct {
+		name string
+
+		requestBody string
+
+		createCalls []createCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{input: validInput, link: &testLink},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/create_video_link_success_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			requestBody:         `{not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_video_link_invalid_json_response.json",
+		},
+		{
+			name:                "invalid request",
+			requestBody:         `{"lap": -1, "url": "ftp://example.com/video.mp4"}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_video_link_invalid_request_response.json",
+		},
+		{
+			name:        "race not found",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{input: validInput, err: videolink.ErrRaceNotFound},
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/create_video_link_race_not_found_response.json",
+		},
+		{
+			name:        "journal entry not found",
+			requestBody: `{"url": "https://www.twitch.tv/somestreamer/clip/FunnyClipSlug"}`,
+			createCalls: []createCall{
+				{
+					input: videolink.CreateInput{DriverID: 12345, RaceID: 1700000000, URL: "https://www.twitch.tv/somestreamer/clip/FunnyClipSlug"},
+					err:   videolink.ErrJournalEntryNotFound,
+				},
+			},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_video_link_journal_entry_not_found_response.json",
+		},
+		{
+			name:        "service error",
+			requestBody: validBody,
+			createCalls: []createCall{
+				{input: validInput, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/create_video_link_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockVideoLinkServiceForCreate(t)
+			for _, call := range tc.createCalls {
+				mockService.EXPECT().Create(mock.Anything, call.input).
+					Return(call.link, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Post("/{driver_id}/races/{driver_race_id}/video-links", NewCreateVideoLinkEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/12345/races/1700000000/video-links"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
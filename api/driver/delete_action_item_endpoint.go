@@ -0,0 +1,48 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+type ActionItemServiceForDelete interface {
+	Delete(ctx context.Context, driverID int64, itemID string) error
+}
+
+func NewDeleteActionItemEndpoint(actionItemService ActionItemServiceForDelete) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		itemID := chi.URLParam(r, "action_item_id")
+		if itemID == "" {
+			errs = errs.WithFieldError("action_item_id", "required")
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		err = actionItemService.Delete(ctx, driverID, itemID)
+		if err != nil {
+			logger.Error().Err(err).Str("itemId", itemID).Msg("failed to delete action item")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,94 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeleteActionItemEndpoint(t *testing.T) {
+	type deleteCall struct {
+		driverID int64
+		itemID   string
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+		itemID   string
+
+		deleteCalls []deleteCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:     "success",
+			driverID: "12345",
+			itemID:   "item-1",
+			deleteCalls: []deleteCall{
+				{driverID: 12345, itemID: "item-1"},
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:     "service error",
+			driverID: "12345",
+			itemID:   "item-1",
+			deleteCalls: []deleteCall{
+				{driverID: 12345, itemID: "item-1", err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/delete_action_item_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockActionItemServiceForDelete(t)
+			for _, call := range tc.deleteCalls {
+				mockService.EXPECT().Delete(mock.Anything, call.driverID, call.itemID).
+					Return(call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Delete("/{driver_id}/action-items/{action_item_id}", NewDeleteActionItemEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/" + tc.driverID + "/action-items/" + tc.itemID
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			if tc.expectedBodyFixture != "" {
+				expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+			} else {
+				assert.Empty(t, bodyBytes)
+			}
+		})
+	}
+}
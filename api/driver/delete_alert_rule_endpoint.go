@@ -0,0 +1,48 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+type AlertServiceForDelete interface {
+	Delete(ctx context.Context, driverID int64, ruleID string) error
+}
+
+func NewDeleteAlertRuleEndpoint(alertService AlertServiceForDelete) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		ruleID := chi.URLParam(r, "alert_rule_id")
+		if ruleID == "" {
+			errs = errs.WithFieldError("alert_rule_id", "required")
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		err = alertService.Delete(ctx, driverID, ruleID)
+		if err != nil {
+			logger.Error().Err(err).Str("ruleId", ruleID).Msg("failed to delete alert rule")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
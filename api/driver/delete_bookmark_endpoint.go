@@ -0,0 +1,59 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+type BookmarkServiceForDelete interface {
+	Delete(ctx context.Context, driverID, raceID int64, bookmarkID string) error
+}
+
+func NewDeleteBookmarkEndpoint(bookmarkService BookmarkServiceForDelete) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var raceID int64
+		raceIDStr := chi.URLParam(r, "driver_race_id")
+		if raceIDStr == "" {
+			errs = errs.WithFieldError("driver_race_id", "required")
+		} else {
+			raceID, err = strconv.ParseInt(raceIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError("driver_race_id", "must be a valid integer")
+			}
+		}
+
+		bookmarkID := chi.URLParam(r, "bookmark_id")
+		if bookmarkID == "" {
+			errs = errs.WithFieldError("bookmark_id", "required")
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		err = bookmarkService.Delete(ctx, driverID, raceID, bookmarkID)
+		if err != nil {
+			logger.Error().Err(err).Int64("raceId", raceID).Str("bookmarkId", bookmarkID).Msg("failed to delete bookmark")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -44,7 +44,7 @@ func NewDeleteJournalEntryEndpoint(journalService DeleteJournalEntryStore) http.
 
 		err = journalService.Delete(ctx, driverID, raceID)
 		if err != nil {
-			logger.Error().Err(err).Int64("driverId", driverID).Int64("raceId", raceID).Msg("failed to delete journal entry")
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to delete journal entry")
 			api.DoErrorResponse(ctx, w)
 			return
 		}
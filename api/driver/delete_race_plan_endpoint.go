@@ -0,0 +1,47 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/raceplan"
+	"github.com/rs/zerolog"
+)
+
+type RacePlanServiceForDelete interface {
+	Delete(ctx context.Context, driverID int64, key raceplan.Key) error
+}
+
+func NewDeleteRacePlanEndpoint(racePlanService RacePlanServiceForDelete) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var key raceplan.Key
+		key, errs = parseRacePlanKey(r, errs)
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		err = racePlanService.Delete(ctx, driverID, key)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to delete race plan")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
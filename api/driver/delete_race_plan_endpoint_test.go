@@ -0,0 +1,96 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/raceplan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeleteRacePlanEndpoint(t *testing.T) {
+	key := raceplan.Key{WeekStart: time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC), SeriesID: 260, TrackID: 47}
+
+	type deleteCall struct {
+		err error
+	}
+
+	testCases := []struct {
+		name string
+
+		path string
+
+		deleteCalls []deleteCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:           "success",
+			path:           "/12345/race-plans/2025-06-10/260/47",
+			deleteCalls:    []deleteCall{{}},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                "invalid driver ID",
+			path:                "/not-a-number/race-plans/2025-06-10/260/47",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_journal_invalid_driver_id_response.json",
+		},
+		{
+			name: "service error",
+			path: "/12345/race-plans/2025-06-10/260/47",
+			deleteCalls: []deleteCall{
+				{err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/delete_race_plan_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockRacePlanServiceForDelete(t)
+			for _, call := range tc.deleteCalls {
+				mockService.EXPECT().Delete(mock.Anything, int64(12345), key).
+					Return(call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Delete("/{driver_id}/race-plans/{race_week}/{series_id}/{track_id}", NewDeleteRacePlanEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodDelete, ts.URL+tc.path, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			if tc.expectedBodyFixture != "" {
+				expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+			} else {
+				assert.Empty(t, bodyBytes)
+			}
+		})
+	}
+}
@@ -33,7 +33,7 @@ func NewDeleteRacesEndpoint(store DeleteRacesStore) http.Handler {
 
 		err = store.DeleteDriverRaces(ctx, driverID)
 		if err != nil {
-			logger.Error().Err(err).Int64("driverId", driverID).Msg("failed to delete driver races")
+			logger.Error().Err(err).Msg("failed to delete driver races")
 			api.DoErrorResponse(ctx, w)
 			return
 		}
@@ -0,0 +1,91 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDismissJournalPromptEndpoint(t *testing.T) {
+	type dismissCall struct {
+		raceID int64
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		raceID string
+
+		dismissCall *dismissCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:           "success",
+			raceID:         "1700000000",
+			dismissCall:    &dismissCall{raceID: 1700000000},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                "invalid race id",
+			raceID:              "abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/dismiss_journal_prompt_invalid_race_id_response.json",
+		},
+		{
+			name:                "service error",
+			raceID:              "1700000000",
+			dismissCall:         &dismissCall{raceID: 1700000000, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/dismiss_journal_prompt_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockJournalServiceForDismissPrompt(t)
+			if tc.dismissCall != nil {
+				mockService.EXPECT().DismissPrompt(mock.Anything, int64(12345), tc.dismissCall.raceID).
+					Return(tc.dismissCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Delete("/{driver_id}/journal/prompts/{driver_race_id}", NewDismissJournalPromptEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodDelete, ts.URL+"/12345/journal/prompts/"+tc.raceID, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			if tc.expectedBodyFixture != "" {
+				expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+			} else {
+				assert.Empty(t, bodyBytes)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/export"
+	"github.com/jonsabados/saturdaysspinout/supporter"
+	"github.com/rs/zerolog"
+)
+
+type ExportService interface {
+	Build(ctx context.Context, driverID int64, from, to time.Time, maxRaces int, includeJournal bool) (*export.Pack, error)
+}
+
+// NewExportEndpoint creates the handler for GET /driver/{driver_id}/export, bundling the driver's most recent races in a
+// time range with their laps into a pack they can hand to a coach. How many races fit follows the session's
+// entitlements, and journal notes are only included when asked for.
+func NewExportEndpoint(exportService ExportService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		var startTime, endTime time.Time
+
+		startTimeStr := r.URL.Query().Get(api.StartTimeQueryParam)
+		if startTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			startTime, err = time.Parse(time.RFC3339, startTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		endTimeStr := r.URL.Query().Get(api.EndTimeQueryParam)
+		if endTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			endTime, err = time.Parse(time.RFC3339, endTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		if !startTime.IsZero() && !endTime.IsZero() && endTime.Before(startTime) {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeEndBeforeStart, nil)
+		}
+
+		var includeJournal bool
+		if j := r.URL.Query().Get(api.IncludeJournalQueryParam); j != "" {
+			includeJournal, err = strconv.ParseBool(j)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.IncludeJournalQueryParam, ErrCodeInvalidValue, map[string]string{
+					"value":   j,
+					"allowed": "true, false",
+				})
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		pack, err := exportService.Build(ctx, driverID, startTime, endTime, supporter.LimitsFor(claims.Entitlements).ExportMaxRaces, includeJournal)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to build export")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, exportResponseFromPack(pack), w)
+	})
+}
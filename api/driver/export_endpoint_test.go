@@ -0,0 +1,187 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/export"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExportEndpoint(t *testing.T) {
+	sessionClaims := &auth.SessionClaims{
+		IRacingUserID:   12345,
+		IRacingUserName: "Test Driver",
+		Entitlements:    []string{"supporter"},
+	}
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	pack := &export.Pack{
+		DriverID:    12345,
+		From:        from,
+		To:          to,
+		GeneratedAt: time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC),
+		Races: []export.Race{
+			{
+				Session: store.DriverSession{
+					DriverID:       12345,
+					SubsessionID:   100002,
+					TrackID:        2,
+					CarID:          11,
+					SeriesID:       43,
+					SeriesName:     "Ferrari GT3 Challenge",
+					StartTime:      time.Unix(1704700000, 0),
+					FinishPosition: 5,
+					ReasonOut:      "Running",
+				},
+				Laps: []store.SessionDriverLap{
+					{SubsessionID: 100002, DriverID: 12345, LapNumber: 1, LapTime: 905000, PersonalBestLap: true},
+					{SubsessionID: 100002, DriverID: 12345, LapNumber: 2, LapTime: 912000, Incident: true, LapEvents: []string{"off track"}},
+				},
+				Journal: &store.RaceJournalEntry{
+					DriverID: 12345,
+					RaceID:   1704700000,
+					Notes:    "Lost the rear in T3",
+					Tags:     []string{"sentiment:bad"},
+				},
+			},
+			{
+				Session: store.DriverSession{
+					DriverID:       12345,
+					SubsessionID:   100001,
+					TrackID:        1,
+					CarID:          10,
+					SeriesID:       42,
+					SeriesName:     "Advanced Mazda MX-5 Cup Series",
+					StartTime:      time.Unix(1704200000, 0),
+					FinishPosition: 2,
+					ReasonOut:      "Running",
+				},
+				LapSummary: &store.SessionDriverLapSummary{
+					SubsessionID:     100001,
+					DriverID:         12345,
+					LapCount:         12,
+					ValidLapCount:    11,
+					IncidentLapCount: 1,
+					BestLapTime:      1001000,
+					AvgLapTime:       1012000,
+				},
+			},
+		},
+	}
+
+	type buildCall struct {
+		includeJournal bool
+		pack           *export.Pack
+		err            error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+		query    string
+		tokenErr error
+
+		buildCall *buildCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			driverID:            "12345",
+			query:               "startTime=2024-01-01T00:00:00Z&endTime=2024-01-31T00:00:00Z&includeJournal=true",
+			buildCall:           &buildCall{includeJournal: true, pack: pack},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_export_success_response.json",
+		},
+		{
+			name:                "invalid token",
+			driverID:            "12345",
+			query:               "startTime=2024-01-01T00:00:00Z&endTime=2024-01-31T00:00:00Z",
+			tokenErr:            errors.New("missing token"),
+			expectedStatus:      http.StatusUnauthorized,
+			expectedBodyFixture: "fixtures/get_export_unauthorized_response.json",
+		},
+		{
+			name:                "missing times",
+			driverID:            "12345",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_export_missing_times_response.json",
+		},
+		{
+			name:                "end before start",
+			driverID:            "12345",
+			query:               "startTime=2024-01-31T00:00:00Z&endTime=2024-01-01T00:00:00Z",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_export_end_before_start_response.json",
+		},
+		{
+			name:                "invalid include journal",
+			driverID:            "12345",
+			query:               "startTime=2024-01-01T00:00:00Z&endTime=2024-01-31T00:00:00Z&includeJournal=maybe",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_export_invalid_include_journal_response.json",
+		},
+		{
+			name:                "service error",
+			driverID:            "12345",
+			query:               "startTime=2024-01-01T00:00:00Z&endTime=2024-01-31T00:00:00Z",
+			buildCall:           &buildCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_export_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockExportService(t)
+			if tc.buildCall != nil {
+				mockService.EXPECT().Build(mock.Anything, int64(12345), from, to, 10000, tc.buildCall.includeJournal).
+					Return(tc.buildCall.pack, tc.buildCall.err)
+			}
+
+			validator := &stubTokenValidator{sessionClaims: sessionClaims, err: tc.tokenErr}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(validator))
+			r.Get("/{driver_id}/export", NewExportEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/export?"+tc.query, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -12,7 +12,7 @@ import (
 )
 
 type GetDriverStore interface {
-	GetDriver(ctx context.Context, driverID int64) (*store.Driver, error)
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
 }
 
 func NewGetDriverEndpoint(driverStore GetDriverStore) http.Handler {
@@ -34,7 +34,7 @@ func NewGetDriverEndpoint(driverStore GetDriverStore) http.Handler {
 
 		driver, err := driverStore.GetDriver(ctx, driverID)
 		if err != nil {
-			logger.Error().Err(err).Int64("driverId", driverID).Msg("failed to fetch driver")
+			logger.Error().Err(err).Msg("failed to fetch driver")
 			api.DoErrorResponse(ctx, w)
 			return
 		}
@@ -18,20 +18,26 @@ import (
 )
 
 func TestNewGetDriverEndpoint(t *testing.T) {
+	racesIngestedFrom := time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)
 	racesIngestedTo := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
 	ingestionBlockedUntil := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
 
 	testDriver := &store.Driver{
-		DriverID:        12345,
-		DriverName:      "Jon Sabados",
-		MemberSince:     time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC),
-		RacesIngestedTo: &racesIngestedTo,
-		FirstLogin:      time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC),
-		LastLogin:       time.Date(2023, 11, 14, 22, 0, 0, 0, time.UTC),
-		LoginCount:      42,
-		SessionCount:    150,
+		DriverID:          12345,
+		DriverName:        "Jon Sabados",
+		MemberSince:       time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC),
+		ClubID:            7,
+		ClubName:          "Great Plains",
+		RacesIngestedFrom: &racesIngestedFrom,
+		RacesIngestedTo:   &racesIngestedTo,
+		FirstLogin:        time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC),
+		LastLogin:         time.Date(2023, 11, 14, 22, 0, 0, 0, time.UTC),
+		LoginCount:        42,
+		SessionCount:      150,
 	}
 
+	// ingested before history was walked backwards, so no RacesIngestedFrom
+
 	testDriverWithBlocked := &store.Driver{
 		DriverID:              12345,
 		DriverName:            "Jon Sabados",
@@ -0,0 +1,122 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetJournalDraftEndpoint(t *testing.T) {
+	testDraft := journal.Draft{
+		DriverID:  12345,
+		RaceID:    1700000000,
+		SavedAt:   time.Unix(1000, 0),
+		ExpiresAt: time.Unix(1000, 0).Add(30 * 24 * time.Hour),
+		Notes:     "Still thinking about turn 3",
+		Tags:      []string{"podium"},
+	}
+
+	type getCall struct {
+		driverID int64
+		raceID   int64
+		draft    *journal.Draft
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+		raceID   string
+
+		getCalls []getCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:     "success",
+			driverID: "12345",
+			raceID:   "1700000000",
+			getCalls: []getCall{
+				{driverID: 12345, raceID: 1700000000, draft: &testDraft},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_journal_draft_success_response.json",
+		},
+		{
+			name:                "invalid driver_race_id",
+			driverID:            "12345",
+			raceID:              "not-a-number",
+			getCalls:            []getCall{},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_journal_draft_invalid_race_id_response.json",
+		},
+		{
+			name:     "not found",
+			driverID: "12345",
+			raceID:   "1700000000",
+			getCalls: []getCall{
+				{driverID: 12345, raceID: 1700000000, draft: nil},
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_journal_draft_not_found_response.json",
+		},
+		{
+			name:     "store error",
+			driverID: "12345",
+			raceID:   "1700000000",
+			getCalls: []getCall{
+				{driverID: 12345, raceID: 1700000000, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_journal_draft_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockGetJournalDraftStore(t)
+			for _, call := range tc.getCalls {
+				mockService.EXPECT().GetDraft(mock.Anything, call.driverID, call.raceID).
+					Return(call.draft, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/races/{driver_race_id}/journal/draft", NewGetJournalDraftEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/" + tc.driverID + "/races/" + tc.raceID + "/journal/draft"
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/jonsabados/saturdaysspinout/api"
 	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
 	"github.com/rs/zerolog"
 )
 
@@ -15,7 +16,12 @@ type GetJournalEntryStore interface {
 	Get(ctx context.Context, driverID, raceID int64) (*journal.Entry, error)
 }
 
-func NewGetJournalEntryEndpoint(journalService GetJournalEntryStore) http.Handler {
+type JournalVideoLinkFinder interface {
+	ForJournal(ctx context.Context, driverID, raceID int64) ([]store.VideoLink, error)
+}
+
+// NewGetJournalEntryEndpoint returns a race's journal entry along with the videos attached to it.
+func NewGetJournalEntryEndpoint(journalService GetJournalEntryStore, videoLinks JournalVideoLinkFinder) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		logger := zerolog.Ctx(ctx)
@@ -45,7 +51,7 @@ func NewGetJournalEntryEndpoint(journalService GetJournalEntryStore) http.Handle
 
 		entry, err := journalService.Get(ctx, driverID, raceID)
 		if err != nil {
-			logger.Error().Err(err).Int64("driverId", driverID).Int64("raceId", raceID).Msg("failed to get journal entry")
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to get journal entry")
 			api.DoErrorResponse(ctx, w)
 			return
 		}
@@ -55,6 +61,15 @@ func NewGetJournalEntryEndpoint(journalService GetJournalEntryStore) http.Handle
 			return
 		}
 
-		api.DoOKResponse(ctx, journalEntryFromServiceEntry(*entry), w)
+		links, err := videoLinks.ForJournal(ctx, driverID, raceID)
+		if err != nil {
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to get journal video links")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		result := journalEntryFromServiceEntry(*entry)
+		result.VideoLinks = videoLinksFromStore(links)
+		api.DoOKResponse(ctx, result, w)
 	})
 }
\ No newline at end of file
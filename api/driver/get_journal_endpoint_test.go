@@ -37,6 +37,25 @@ func TestNewGetJournalEntryEndpoint(t *testing.T) {
 		},
 	}
 
+	journalLinks := []store.VideoLink{
+		{
+			DriverID:     12345,
+			RaceID:       1700000000,
+			LinkID:       "link-1",
+			URL:          "https://www.youtube.com/watch?v=abc123&t=95s",
+			Provider:     "youtube",
+			Title:        "Onboard, race 2",
+			AuthorName:   "Some Streamer",
+			ThumbnailURL: "https://i.ytimg.com/vi/abc123/hqdefault.jpg",
+			CreatedAt:    time.Unix(3000, 0),
+		},
+	}
+
+	type videoLinksCall struct {
+		links []store.VideoLink
+		err   error
+	}
+
 	type getCall struct {
 		driverID int64
 		raceID   int64
@@ -50,7 +69,8 @@ func TestNewGetJournalEntryEndpoint(t *testing.T) {
 		driverID string
 		raceID   string
 
-		getCalls []getCall
+		getCalls      []getCall
+		videoLinkCall *videoLinksCall
 
 		expectedStatus      int
 		expectedBodyFixture string
@@ -62,9 +82,32 @@ func TestNewGetJournalEntryEndpoint(t *testing.T) {
 			getCalls: []getCall{
 				{driverID: 12345, raceID: 1700000000, entry: &testEntry},
 			},
+			videoLinkCall:       &videoLinksCall{},
 			expectedStatus:      http.StatusOK,
 			expectedBodyFixture: "fixtures/get_journal_success_response.json",
 		},
+		{
+			name:     "with video links",
+			driverID: "12345",
+			raceID:   "1700000000",
+			getCalls: []getCall{
+				{driverID: 12345, raceID: 1700000000, entry: &testEntry},
+			},
+			videoLinkCall:       &videoLinksCall{links: journalLinks},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_journal_video_links_response.json",
+		},
+		{
+			name:     "video links error",
+			driverID: "12345",
+			raceID:   "1700000000",
+			getCalls: []getCall{
+				{driverID: 12345, raceID: 1700000000, entry: &testEntry},
+			},
+			videoLinkCall:       &videoLinksCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_journal_store_error_response.json",
+		},
 		{
 			name:                "invalid driver_id",
 			driverID:            "not-a-number",
@@ -111,9 +154,15 @@ func TestNewGetJournalEntryEndpoint(t *testing.T) {
 					Return(call.entry, call.err)
 			}
 
+			mockVideoLinks := NewMockJournalVideoLinkFinder(t)
+			if tc.videoLinkCall != nil {
+				mockVideoLinks.EXPECT().ForJournal(mock.Anything, int64(12345), int64(1700000000)).
+					Return(tc.videoLinkCall.links, tc.videoLinkCall.err)
+			}
+
 			r := chi.NewRouter()
 			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
-			r.Get("/{driver_id}/races/{driver_race_id}/journal", NewGetJournalEntryEndpoint(mockService).ServeHTTP)
+			r.Get("/{driver_id}/races/{driver_race_id}/journal", NewGetJournalEntryEndpoint(mockService, mockVideoLinks).ServeHTTP)
 
 			ts := httptest.NewServer(r)
 			defer ts.Close()
@@ -137,4 +186,4 @@ func TestNewGetJournalEntryEndpoint(t *testing.T) {
 			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
 		})
 	}
-}
\ No newline at end of file
+}
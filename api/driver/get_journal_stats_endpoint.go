@@ -0,0 +1,45 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/rs/zerolog"
+)
+
+type JournalServiceForStats interface {
+	Stats(ctx context.Context, driverID int64) (*journal.Stats, error)
+}
+
+// NewGetJournalStatsEndpoint creates the handler for GET /driver/{driver_id}/journal-stats
+func NewGetJournalStatsEndpoint(journalService JournalServiceForStats) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		stats, err := journalService.Stats(ctx, driverID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get journal stats")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, journalStatsFromService(*stats), w)
+	})
+}
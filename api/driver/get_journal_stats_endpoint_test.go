@@ -0,0 +1,96 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetJournalStatsEndpoint(t *testing.T) {
+	type statsCall struct {
+		result *journal.Stats
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+
+		statsCall *statsCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			driverID:            "12345",
+			statsCall:           &statsCall{result: &journal.Stats{CurrentStreakWeeks: 3, LongestStreakWeeks: 6, JournaledThisWeek: true}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_journal_stats_success_response.json",
+		},
+		{
+			name:                "never journaled",
+			driverID:            "12345",
+			statsCall:           &statsCall{result: &journal.Stats{}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_journal_stats_never_journaled_response.json",
+		},
+		{
+			name:                "invalid driver id",
+			driverID:            "abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_journal_stats_invalid_driver_id_response.json",
+		},
+		{
+			name:                "service error",
+			driverID:            "12345",
+			statsCall:           &statsCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_journal_stats_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockJournalServiceForStats(t)
+			if tc.statsCall != nil {
+				mockService.EXPECT().Stats(mock.Anything, int64(12345)).Return(tc.statsCall.result, tc.statsCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/journal-stats", NewGetJournalStatsEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/journal-stats", nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
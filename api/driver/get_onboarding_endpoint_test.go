@@ -0,0 +1,129 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetOnboardingEndpoint(t *testing.T) {
+	racesIngestedTo := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	type storeCall struct {
+		driverID int64
+		driver   *store.Driver
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+
+		storeCall *storeCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:     "new driver",
+			driverID: "12345",
+			storeCall: &storeCall{
+				driverID: 12345,
+				driver:   &store.Driver{DriverID: 12345, OnboardingStep: store.OnboardingStepProfileCreated},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_onboarding_new_driver_response.json",
+		},
+		{
+			name:     "driver from before onboarding was tracked",
+			driverID: "12345",
+			storeCall: &storeCall{
+				driverID: 12345,
+				driver:   &store.Driver{DriverID: 12345, RacesIngestedTo: &racesIngestedTo, SessionCount: 150},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_onboarding_race_ingested_response.json",
+		},
+		{
+			name:     "complete",
+			driverID: "12345",
+			storeCall: &storeCall{
+				driverID: 12345,
+				driver:   &store.Driver{DriverID: 12345, RacesIngestedTo: &racesIngestedTo, SessionCount: 150, OnboardingStep: store.OnboardingStepJournalEntryCreated},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_onboarding_complete_response.json",
+		},
+		{
+			name:                "invalid driver id",
+			driverID:            "abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_onboarding_invalid_driver_id_response.json",
+		},
+		{
+			name:     "driver not found",
+			driverID: "99999",
+			storeCall: &storeCall{
+				driverID: 99999,
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_driver_not_found_response.json",
+		},
+		{
+			name:     "store error",
+			driverID: "12345",
+			storeCall: &storeCall{
+				driverID: 12345,
+				err:      errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_driver_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockGetDriverStore(t)
+			if tc.storeCall != nil {
+				mockStore.EXPECT().GetDriver(mock.Anything, tc.storeCall.driverID).
+					Return(tc.storeCall.driver, tc.storeCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/onboarding", NewGetOnboardingEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/onboarding", nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/quota"
+	"github.com/rs/zerolog"
+)
+
+// QuotaService reports a driver's quota usage and is consumed against by quota limited routes.
+type QuotaService interface {
+	Consume(ctx context.Context, driverID int64, entitlements []string, operation quota.Operation) (*quota.Decision, error)
+	Usage(ctx context.Context, driverID int64, entitlements []string) ([]quota.Usage, time.Time, error)
+}
+
+// NewGetQuotaEndpoint creates the handler for GET /driver/{driver_id}/quota. Limits follow the session's entitlements,
+// the driver ownership middleware having already made sure the session is the driver's own.
+func NewGetQuotaEndpoint(quotaService QuotaService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		usage, resetsAt, err := quotaService.Usage(ctx, driverID, claims.Entitlements)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get quota usage")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, quotaResponseFromService(usage, resetsAt), w)
+	})
+}
@@ -0,0 +1,132 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/quota"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type stubTokenValidator struct {
+	sessionClaims *auth.SessionClaims
+	accessToken   string
+	err           error
+}
+
+func (s *stubTokenValidator) ValidateToken(_ context.Context, _ string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+	return s.sessionClaims, &auth.SensitiveClaims{IRacingAccessToken: s.accessToken}, s.err
+}
+
+func TestNewGetQuotaEndpoint(t *testing.T) {
+	sessionClaims := &auth.SessionClaims{
+		IRacingUserID:   12345,
+		IRacingUserName: "Test Driver",
+		Entitlements:    []string{"supporter"},
+	}
+
+	type usageCall struct {
+		driverID int64
+		usage    []quota.Usage
+		resetsAt time.Time
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+		tokenErr error
+
+		usageCall *usageCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:     "success",
+			driverID: "12345",
+			usageCall: &usageCall{
+				driverID: 12345,
+				usage: []quota.Usage{
+					{Operation: quota.OperationIRacingProxy, Used: 7, Limit: 1000},
+					{Operation: quota.OperationExport, Used: 1, Unlimited: true},
+					{Operation: quota.OperationLapIngest, Used: 0, Limit: 200},
+				},
+				resetsAt: time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_quota_success_response.json",
+		},
+		{
+			name:                "invalid token",
+			driverID:            "12345",
+			tokenErr:            errors.New("missing token"),
+			expectedStatus:      http.StatusUnauthorized,
+			expectedBodyFixture: "fixtures/get_quota_unauthorized_response.json",
+		},
+		{
+			name:                "invalid driver id",
+			driverID:            "abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_quota_invalid_driver_id_response.json",
+		},
+		{
+			name:                "service error",
+			driverID:            "12345",
+			usageCall:           &usageCall{driverID: 12345, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_quota_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockQuotaService(t)
+			if tc.usageCall != nil {
+				mockService.EXPECT().Usage(mock.Anything, tc.usageCall.driverID, []string{"supporter"}).
+					Return(tc.usageCall.usage, tc.usageCall.resetsAt, tc.usageCall.err)
+			}
+
+			validator := &stubTokenValidator{sessionClaims: sessionClaims, err: tc.tokenErr}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(validator))
+			r.Get("/{driver_id}/quota", NewGetQuotaEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/quota", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -13,10 +13,16 @@ import (
 )
 
 type GetRaceStore interface {
-	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time) (*store.DriverSession, error)
+	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)
 }
 
-func NewGetRaceEndpoint(raceStore GetRaceStore) http.Handler {
+type RelatedActionItemsFinder interface {
+	OpenForTrack(ctx context.Context, driverID, trackID int64) ([]store.ActionItem, error)
+}
+
+// NewGetRaceEndpoint returns a single race along with the driver's open action items for the track it was run at and
+// their replay bookmarks.
+func NewGetRaceEndpoint(raceStore GetRaceStore, actionItems RelatedActionItemsFinder, bookmarks BookmarkServiceForList) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		logger := zerolog.Ctx(ctx)
@@ -40,6 +46,9 @@ func NewGetRaceEndpoint(raceStore GetRaceStore) http.Handler {
 			}
 		}
 
+		var fields api.FieldSelection
+		fields, errs = parseFieldSelection[RaceDetail](r, errs)
+
 		if errs.HasAnyError() {
 			api.DoBadRequestResponse(ctx, errs, w)
 			return
@@ -47,7 +56,7 @@ func NewGetRaceEndpoint(raceStore GetRaceStore) http.Handler {
 
 		session, err := raceStore.GetDriverSession(ctx, driverID, store.TimeFromDriverRaceID(driverRaceID))
 		if err != nil {
-			logger.Error().Err(err).Int64("driverId", driverID).Int64("driverRaceId", driverRaceID).Msg("failed to fetch driver session")
+			logger.Error().Err(err).Int64("driverRaceId", driverRaceID).Msg("failed to fetch driver session")
 			api.DoErrorResponse(ctx, w)
 			return
 		}
@@ -57,6 +66,26 @@ func NewGetRaceEndpoint(raceStore GetRaceStore) http.Handler {
 			return
 		}
 
-		api.DoOKResponse(ctx, raceFromDriverSession(*session), w)
+		related, err := actionItems.OpenForTrack(ctx, driverID, session.TrackID)
+		if err != nil {
+			logger.Error().Err(err).Int64("trackId", session.TrackID).Msg("failed to fetch related action items")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		raceBookmarks, err := bookmarks.List(ctx, driverID, driverRaceID)
+		if err != nil {
+			logger.Error().Err(err).Int64("driverRaceId", driverRaceID).Msg("failed to fetch bookmarks")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, fields.Select(RaceDetail{
+			Race:               raceFromDriverSession(*session),
+			Positions:          session.Positions,
+			LapGaps:            session.LapGaps,
+			RelatedActionItems: actionItemsFromStore(related),
+			Bookmarks:          bookmarksFromStore(raceBookmarks),
+		}), w)
 	})
 }
\ No newline at end of file
@@ -18,6 +18,7 @@ import (
 )
 
 func TestNewGetRaceEndpoint(t *testing.T) {
+	trafficCost := 42000
 	testSession := &store.DriverSession{
 		DriverID:              12345,
 		SubsessionID:          100001,
@@ -40,6 +41,39 @@ func TestNewGetRaceEndpoint(t *testing.T) {
 		OldSubLevel:           381,
 		NewSubLevel:           399,
 		ReasonOut:             "Running",
+		CornersPerLap:         12,
+		LapsComplete:          15,
+		LapsLead:              3,
+		TrafficCost:           &trafficCost,
+		Positions:             []int{4, 3, 3, 2, 1},
+		LapGaps:               2,
+	}
+	dueDate := time.Unix(1700500000, 0)
+	relatedItems := []store.ActionItem{
+		{
+			DriverID:  12345,
+			ItemID:    "item-1",
+			Title:     "Brake earlier into turn one",
+			Status:    store.ActionItemOpen,
+			RaceID:    1690000000,
+			TrackID:   1,
+			DueDate:   &dueDate,
+			CreatedAt: time.Unix(1690001000, 0),
+			UpdatedAt: time.Unix(1690002000, 0),
+		},
+	}
+
+	raceBookmarks := []store.RaceBookmark{
+		{
+			DriverID:   12345,
+			RaceID:     1700000000,
+			BookmarkID: "bookmark-1",
+			Lap:        3,
+			Note:       "Lost the rear on exit of turn four",
+			ReplayTime: 4*time.Minute + 12500*time.Millisecond,
+			CreatedAt:  time.Unix(1700001000, 0),
+			UpdatedAt:  time.Unix(1700002000, 0),
+		},
 	}
 
 	type storeCall struct {
@@ -49,13 +83,25 @@ func TestNewGetRaceEndpoint(t *testing.T) {
 		err       error
 	}
 
+	type relatedCall struct {
+		items []store.ActionItem
+		err   error
+	}
+
+	type bookmarksCall struct {
+		bookmarks []store.RaceBookmark
+		err       error
+	}
+
 	testCases := []struct {
 		name string
 
 		driverID     string
 		driverRaceID string
 
-		storeCalls []storeCall
+		storeCalls    []storeCall
+		relatedCalls  []relatedCall
+		bookmarkCalls []bookmarksCall
 
 		expectedStatus      int
 		expectedBodyFixture string
@@ -71,9 +117,74 @@ func TestNewGetRaceEndpoint(t *testing.T) {
 					session:   testSession,
 				},
 			},
+			relatedCalls:        []relatedCall{{}},
+			bookmarkCalls:       []bookmarksCall{{}},
 			expectedStatus:      http.StatusOK,
 			expectedBodyFixture: "fixtures/get_race_success_response.json",
 		},
+		{
+			name:         "with related action items",
+			driverID:     "12345",
+			driverRaceID: "1700000000",
+			storeCalls: []storeCall{
+				{
+					driverID:  12345,
+					startTime: time.Unix(1700000000, 0),
+					session:   testSession,
+				},
+			},
+			relatedCalls:        []relatedCall{{items: relatedItems}},
+			bookmarkCalls:       []bookmarksCall{{}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_race_related_action_items_response.json",
+		},
+		{
+			name:         "with bookmarks",
+			driverID:     "12345",
+			driverRaceID: "1700000000",
+			storeCalls: []storeCall{
+				{
+					driverID:  12345,
+					startTime: time.Unix(1700000000, 0),
+					session:   testSession,
+				},
+			},
+			relatedCalls:        []relatedCall{{}},
+			bookmarkCalls:       []bookmarksCall{{bookmarks: raceBookmarks}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_race_bookmarks_response.json",
+		},
+		{
+			name:         "bookmarks error",
+			driverID:     "12345",
+			driverRaceID: "1700000000",
+			storeCalls: []storeCall{
+				{
+					driverID:  12345,
+					startTime: time.Unix(1700000000, 0),
+					session:   testSession,
+				},
+			},
+			relatedCalls:        []relatedCall{{}},
+			bookmarkCalls:       []bookmarksCall{{err: errors.New("database error")}},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_race_store_error_response.json",
+		},
+		{
+			name:         "related action items error",
+			driverID:     "12345",
+			driverRaceID: "1700000000",
+			storeCalls: []storeCall{
+				{
+					driverID:  12345,
+					startTime: time.Unix(1700000000, 0),
+					session:   testSession,
+				},
+			},
+			relatedCalls:        []relatedCall{{err: errors.New("database error")}},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_race_store_error_response.json",
+		},
 		{
 			name:         "not found",
 			driverID:     "12345",
@@ -119,10 +230,21 @@ func TestNewGetRaceEndpoint(t *testing.T) {
 				mockStore.EXPECT().GetDriverSession(mock.Anything, call.driverID, call.startTime).
 					Return(call.session, call.err)
 			}
+			mockActionItems := NewMockRelatedActionItemsFinder(t)
+			for _, call := range tc.relatedCalls {
+				mockActionItems.EXPECT().OpenForTrack(mock.Anything, int64(12345), int64(1)).
+					Return(call.items, call.err)
+			}
+
+			mockBookmarks := NewMockBookmarkServiceForList(t)
+			for _, call := range tc.bookmarkCalls {
+				mockBookmarks.EXPECT().List(mock.Anything, int64(12345), int64(1700000000)).
+					Return(call.bookmarks, call.err)
+			}
 
 			r := chi.NewRouter()
 			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
-			r.Get("/{driver_id}/races/{driver_race_id}", NewGetRaceEndpoint(mockStore).ServeHTTP)
+			r.Get("/{driver_id}/races/{driver_race_id}", NewGetRaceEndpoint(mockStore, mockActionItems, mockBookmarks).ServeHTTP)
 
 			ts := httptest.NewServer(r)
 			defer ts.Close()
@@ -0,0 +1,68 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/raceplan"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type RacePlanServiceForRace interface {
+	ForRace(ctx context.Context, driverID, raceID int64) (*store.RacePlan, error)
+}
+
+// NewGetRacePlanEndpoint returns the plan the driver wrote ahead of one of their races, so it can be read alongside the
+// result and the race's journal entry. Responds not found when the race wasn't planned.
+func NewGetRacePlanEndpoint(racePlanService RacePlanServiceForRace) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var raceID int64
+		raceIDStr := chi.URLParam(r, "driver_race_id")
+		if raceIDStr == "" {
+			errs = errs.WithFieldError("driver_race_id", "required")
+		} else {
+			raceID, err = strconv.ParseInt(raceIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError("driver_race_id", "must be a valid integer")
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		plan, err := racePlanService.ForRace(ctx, driverID, raceID)
+		if errors.Is(err, raceplan.ErrRaceNotFound) {
+			api.DoNotFoundResponse(ctx, "race not found", w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to get race plan")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		if plan == nil {
+			api.DoNotFoundResponse(ctx, "race plan not found", w)
+			return
+		}
+
+		api.DoOKResponse(ctx, racePlanFromStore(*plan), w)
+	})
+}
@@ -0,0 +1,110 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/raceplan"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetRacePlanEndpoint(t *testing.T) {
+	linked := linkedTestRacePlan()
+
+	type forRaceCall struct {
+		plan *store.RacePlan
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+		raceID   string
+
+		forRaceCalls []forRaceCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			driverID:            "12345",
+			raceID:              "1749645000",
+			forRaceCalls:        []forRaceCall{{plan: &linked}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_race_plan_success_response.json",
+		},
+		{
+			name:                "not planned",
+			driverID:            "12345",
+			raceID:              "1749645000",
+			forRaceCalls:        []forRaceCall{{}},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_race_plan_not_found_response.json",
+		},
+		{
+			name:                "race not found",
+			driverID:            "12345",
+			raceID:              "1749645000",
+			forRaceCalls:        []forRaceCall{{err: raceplan.ErrRaceNotFound}},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_race_plan_race_not_found_response.json",
+		},
+		{
+			name:                "invalid driver ID",
+			driverID:            "not-a-number",
+			raceID:              "1749645000",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_journal_invalid_driver_id_response.json",
+		},
+		{
+			name:                "service error",
+			driverID:            "12345",
+			raceID:              "1749645000",
+			forRaceCalls:        []forRaceCall{{err: errors.New("database error")}},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_race_plan_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockRacePlanServiceForRace(t)
+			for _, call := range tc.forRaceCalls {
+				mockService.EXPECT().ForRace(mock.Anything, int64(12345), int64(1749645000)).
+					Return(call.plan, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/races/{driver_race_id}/plan", NewGetRacePlanEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			res, err := http.Get(ts.URL + "/" + tc.driverID + "/races/" + tc.raceID + "/plan")
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -85,6 +85,9 @@ func NewGetRacesEndpoint(raceStore GetRacesStore) http.Handler {
 			errs = errs.WithFieldErrorCode(api.TrackIDQueryParam, ErrCodeInvalidInteger, map[string]string{"value": e})
 		}
 
+		var fields api.FieldSelection
+		fields, errs = parseFieldSelection[Race](r, errs)
+
 		if errs.HasAnyError() {
 			api.DoBadRequestResponse(ctx, errs, w)
 			return
@@ -103,7 +106,7 @@ func NewGetRacesEndpoint(raceStore GetRacesStore) http.Handler {
 
 		sessions, err := raceStore.GetDriverSessionsByTimeRange(ctx, driverID, startTime, endTime, filters...)
 		if err != nil {
-			logger.Error().Err(err).Int64("driverId", driverID).Msg("failed to fetch driver sessions")
+			logger.Error().Err(err).Msg("failed to fetch driver sessions")
 			api.DoErrorResponse(ctx, w)
 			return
 		}
@@ -124,6 +127,6 @@ func NewGetRacesEndpoint(raceStore GetRacesStore) http.Handler {
 			items[i] = raceFromDriverSession(session)
 		}
 
-		api.DoOKListResponse(ctx, items, page, resultsPerPage, totalResults, w)
+		api.DoOKListResponse(ctx, api.SelectEach(fields, items), page, resultsPerPage, totalResults, w)
 	})
 }
\ No newline at end of file
@@ -89,6 +89,7 @@ func TestNewGetRacesEndpoint(t *testing.T) {
 		seriesIDs      []string
 		carIDs         []string
 		trackIDs       []string
+		fields         string
 
 		storeCalls []storeCall
 
@@ -217,6 +218,33 @@ func TestNewGetRacesEndpoint(t *testing.T) {
 			expectedStatus:      http.StatusBadRequest,
 			expectedBodyFixture: "fixtures/get_races_end_before_start_response.json",
 		},
+		{
+			name:      "success with selected fields",
+			driverID:  "12345",
+			startTime: "2023-11-01T00:00:00Z",
+			endTime:   "2023-11-30T00:00:00Z",
+			fields:    "subsessionId,finishPosition",
+			storeCalls: []storeCall{
+				{
+					driverID: 12345,
+					from:     time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC),
+					to:       time.Date(2023, 11, 30, 0, 0, 0, 0, time.UTC),
+					sessions: testSessions,
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_races_selected_fields_response.json",
+		},
+		{
+			name:                "unknown field",
+			driverID:            "12345",
+			startTime:           "2023-11-01T00:00:00Z",
+			endTime:             "2023-11-30T00:00:00Z",
+			fields:              "subsessionId,nope",
+			storeCalls:          []storeCall{},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_races_unknown_field_response.json",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -266,6 +294,9 @@ func TestNewGetRacesEndpoint(t *testing.T) {
 			for _, id := range tc.trackIDs {
 				url += "trackId=" + id + "&"
 			}
+			if tc.fields != "" {
+				url += "fields=" + tc.fields + "&"
+			}
 
 			req, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
@@ -0,0 +1,74 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type GetRacingNowStore interface {
+	GetPresenceGrantsForViewer(ctx context.Context, viewerID int64) ([]store.PresenceGrant, error)
+	GetDriverPresences(ctx context.Context, driverIDs []int64) ([]store.DriverPresence, error)
+}
+
+// NewGetRacingNowEndpoint creates the handler for GET /driver/{driver_id}/racing-now, listing the drivers sharing
+// their presence with this driver who are in a sim session right now.
+func NewGetRacingNowEndpoint(presenceStore GetRacingNowStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		grants, err := presenceStore.GetPresenceGrantsForViewer(ctx, driverID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get presence grants")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		drivers := make([]RacingNowDriver, 0)
+		if len(grants) > 0 {
+			names := make(map[int64]string, len(grants))
+			driverIDs := make([]int64, len(grants))
+			for i, g := range grants {
+				names[g.DriverID] = g.DriverName
+				driverIDs[i] = g.DriverID
+			}
+
+			presences, err := presenceStore.GetDriverPresences(ctx, driverIDs)
+			if err != nil {
+				logger.Error().Err(err).Msg("failed to get driver presences")
+				api.DoErrorResponse(ctx, w)
+				return
+			}
+			for _, p := range presences {
+				drivers = append(drivers, RacingNowDriver{
+					DriverID:   p.DriverID,
+					DriverName: names[p.DriverID],
+					TrackID:    p.TrackID,
+					CarID:      p.CarID,
+					SeriesName: p.SeriesName,
+					UpdatedAt:  p.UpdatedAt,
+				})
+			}
+		}
+
+		api.DoOKResponse(ctx, RacingNowResponse{Drivers: drivers}, w)
+	})
+}
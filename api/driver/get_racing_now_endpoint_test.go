@@ -0,0 +1,131 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetRacingNowEndpoint(t *testing.T) {
+	type grantsCall struct {
+		viewerID int64
+		result   []store.PresenceGrant
+		err      error
+	}
+
+	type presencesCall struct {
+		driverIDs []int64
+		result    []store.DriverPresence
+		err       error
+	}
+
+	grants := []store.PresenceGrant{
+		{DriverID: 111, DriverName: "Fast Friend", ViewerID: 12345, ViewerName: "Test Driver"},
+		{DriverID: 222, DriverName: "Team Mate", ViewerID: 12345, ViewerName: "Test Driver"},
+		{DriverID: 333, DriverName: "Idle Friend", ViewerID: 12345, ViewerName: "Test Driver"},
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+
+		grantsCall    *grantsCall
+		presencesCall *presencesCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:       "success",
+			driverID:   "12345",
+			grantsCall: &grantsCall{viewerID: 12345, result: grants},
+			presencesCall: &presencesCall{
+				driverIDs: []int64{111, 222, 333},
+				result: []store.DriverPresence{
+					{DriverID: 111, TrackID: 1, CarID: 2, SeriesName: "Formula Vee", UpdatedAt: time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)},
+					{DriverID: 222, UpdatedAt: time.Date(2024, 1, 15, 10, 29, 30, 0, time.UTC)},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_racing_now_success_response.json",
+		},
+		{
+			name:                "nobody sharing",
+			driverID:            "12345",
+			grantsCall:          &grantsCall{viewerID: 12345, result: []store.PresenceGrant{}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_racing_now_empty_response.json",
+		},
+		{
+			name:                "invalid driver id",
+			driverID:            "abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_racing_now_invalid_driver_id_response.json",
+		},
+		{
+			name:                "grants error",
+			driverID:            "12345",
+			grantsCall:          &grantsCall{viewerID: 12345, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_racing_now_store_error_response.json",
+		},
+		{
+			name:                "presences error",
+			driverID:            "12345",
+			grantsCall:          &grantsCall{viewerID: 12345, result: grants},
+			presencesCall:       &presencesCall{driverIDs: []int64{111, 222, 333}, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_racing_now_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockGetRacingNowStore(t)
+			if tc.grantsCall != nil {
+				mockStore.EXPECT().GetPresenceGrantsForViewer(mock.Anything, tc.grantsCall.viewerID).
+					Return(tc.grantsCall.result, tc.grantsCall.err)
+			}
+			if tc.presencesCall != nil {
+				mockStore.EXPECT().GetDriverPresences(mock.Anything, tc.presencesCall.driverIDs).
+					Return(tc.presencesCall.result, tc.presencesCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/racing-now", NewGetRacingNowEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/racing-now", nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type GetSettingsStore interface {
+	GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error)
+}
+
+// NewGetSettingsEndpoint creates the handler for GET /driver/{driver_id}/settings
+func NewGetSettingsEndpoint(settingsStore GetSettingsStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		settings, err := settingsStore.GetDriverSettings(ctx, driverID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get driver settings")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, driverSettingsFromStore(*settings), w)
+	})
+}
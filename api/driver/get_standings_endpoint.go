@@ -0,0 +1,94 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type GetStandingsStore interface {
+	GetDriverStandings(ctx context.Context, driverID int64, from, to time.Time) ([]store.DriverStanding, error)
+}
+
+// NewGetStandingsEndpoint creates the handler for GET /driver/{driver_id}/standings
+func NewGetStandingsEndpoint(standingsStore GetStandingsStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		var startTime, endTime time.Time
+
+		startTimeStr := r.URL.Query().Get(api.StartTimeQueryParam)
+		if startTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			startTime, err = time.Parse(time.RFC3339, startTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.StartTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		endTimeStr := r.URL.Query().Get(api.EndTimeQueryParam)
+		if endTimeStr == "" {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeRequired, nil)
+		} else {
+			endTime, err = time.Parse(time.RFC3339, endTimeStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		if !startTime.IsZero() && !endTime.IsZero() && endTime.Before(startTime) {
+			errs = errs.WithFieldErrorCode(api.EndTimeQueryParam, ErrCodeEndBeforeStart, nil)
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		standings, err := standingsStore.GetDriverStandings(ctx, driverID, startTime, endTime)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get driver standings")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		response := StandingsResponse{
+			Standings: make([]DriverStanding, len(standings)),
+		}
+		for i, s := range standings {
+			response.Standings[i] = DriverStanding{
+				WeekStart:    s.WeekStart,
+				SnapshotAt:   s.SnapshotAt,
+				SeasonID:     s.SeasonID,
+				SeriesID:     s.SeriesID,
+				SeriesName:   s.SeriesName,
+				CarClassID:   s.CarClassID,
+				RaceWeekNum:  s.RaceWeekNum,
+				Division:     s.Division,
+				DivisionRank: s.DivisionRank,
+				DivisionSize: s.DivisionSize,
+				Points:       s.Points,
+				WeeksCounted: s.WeeksCounted,
+				Starts:       s.Starts,
+				Wins:         s.Wins,
+			}
+		}
+
+		api.DoOKResponse(ctx, response, w)
+	})
+}
@@ -0,0 +1,165 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetStandingsEndpoint(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	type storeCall struct {
+		driverID int64
+		from     time.Time
+		to       time.Time
+		result   []store.DriverStanding
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		queryString string
+
+		storeCall *storeCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			driverID:    "12345",
+			queryString: "startTime=2024-03-01T00:00:00Z&endTime=2024-06-01T00:00:00Z",
+			storeCall: &storeCall{
+				driverID: 12345,
+				from:     from,
+				to:       to,
+				result: []store.DriverStanding{
+					{
+						DriverID:     12345,
+						WeekStart:    time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC),
+						SnapshotAt:   time.Date(2024, 5, 11, 14, 30, 0, 0, time.UTC),
+						SeasonID:     4500,
+						SeriesID:     42,
+						SeriesName:   "Advanced Mazda MX-5 Cup Series",
+						CarClassID:   74,
+						RaceWeekNum:  7,
+						Division:     3,
+						DivisionRank: 2,
+						DivisionSize: 180,
+						Points:       640,
+						WeeksCounted: 5,
+						Starts:       9,
+						Wins:         1,
+					},
+					{
+						DriverID:     12345,
+						WeekStart:    time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+						SnapshotAt:   time.Date(2024, 5, 1, 2, 0, 0, 0, time.UTC),
+						SeasonID:     4500,
+						SeriesID:     42,
+						SeriesName:   "Advanced Mazda MX-5 Cup Series",
+						CarClassID:   74,
+						RaceWeekNum:  6,
+						Division:     3,
+						DivisionRank: 5,
+						DivisionSize: 176,
+						Points:       560,
+						WeeksCounted: 4,
+						Starts:       7,
+						Wins:         0,
+					},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_standings_success_response.json",
+		},
+		{
+			name:        "no standings",
+			driverID:    "12345",
+			queryString: "startTime=2024-03-01T00:00:00Z&endTime=2024-06-01T00:00:00Z",
+			storeCall: &storeCall{
+				driverID: 12345,
+				from:     from,
+				to:       to,
+				result:   []store.DriverStanding{},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_standings_empty_response.json",
+		},
+		{
+			name:                "missing required params",
+			driverID:            "12345",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_standings_missing_params_response.json",
+		},
+		{
+			name:                "end before start",
+			driverID:            "12345",
+			queryString:         "startTime=2024-06-01T00:00:00Z&endTime=2024-03-01T00:00:00Z",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_standings_end_before_start_response.json",
+		},
+		{
+			name:        "store error",
+			driverID:    "12345",
+			queryString: "startTime=2024-03-01T00:00:00Z&endTime=2024-06-01T00:00:00Z",
+			storeCall: &storeCall{
+				driverID: 12345,
+				from:     from,
+				to:       to,
+				err:      errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_standings_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockGetStandingsStore(t)
+			if tc.storeCall != nil {
+				mockStore.EXPECT().GetDriverStandings(mock.Anything, tc.storeCall.driverID, tc.storeCall.from, tc.storeCall.to).
+					Return(tc.storeCall.result, tc.storeCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/standings", NewGetStandingsEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/standings?"+tc.queryString, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testRaceTelemetry() store.RaceTelemetry {
+	return store.RaceTelemetry{
+		DriverID: 12345,
+		RaceID:   1700000000,
+		Stints: []store.TelemetryStint{
+			{Stint: 1, StartLap: 1, EndLap: 14, Laps: 14, FuelPerLap: 2.41, Tires: []store.TireSummary{
+				{Corner: store.TireCornerLF, AvgTemp: 84.5, StartPressure: 162.1, EndPressure: 176.4},
+				{Corner: store.TireCornerRF, AvgTemp: 88.25},
+			}},
+			{Stint: 2, StartLap: 15, EndLap: 22, Laps: 8, Tires: []store.TireSummary{}},
+		},
+		ImportedAt: time.Unix(1700100000, 0),
+	}
+}
+
+func TestNewGetTelemetryEndpoint(t *testing.T) {
+	telemetry := testRaceTelemetry()
+
+	type getCall struct {
+		telemetry *store.RaceTelemetry
+		err       error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverRaceID string
+
+		getCalls []getCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			driverRaceID:        "1700000000",
+			getCalls:            []getCall{{telemetry: &telemetry}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_telemetry_success_response.json",
+		},
+		{
+			name:                "not imported",
+			driverRaceID:        "1700000000",
+			getCalls:            []getCall{{}},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_telemetry_not_found_response.json",
+		},
+		{
+			name:                "invalid race id",
+			driverRaceID:        "not-an-integer",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_telemetry_invalid_race_id_response.json",
+		},
+		{
+			name:                "service error",
+			driverRaceID:        "1700000000",
+			getCalls:            []getCall{{err: errors.New("database error")}},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_telemetry_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockTelemetryServiceForGet(t)
+			for _, call := range tc.getCalls {
+				mockService.EXPECT().Get(mock.Anything, int64(12345), int64(1700000000)).
+					Return(call.telemetry, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/races/{driver_race_id}/telemetry", NewGetTelemetryEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			res, err := http.Get(ts.URL + "/12345/races/" + tc.driverRaceID + "/telemetry")
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const viewerIDPathParam = "viewer_id"
+
+type GrantPresenceViewerStore interface {
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	SavePresenceGrant(ctx context.Context, grant store.PresenceGrant) error
+}
+
+// NewGrantPresenceViewerEndpoint creates the handler for PUT /driver/{driver_id}/presence/viewers/{viewer_id}, letting
+// the viewer see when the driver is racing. The viewer has to be a driver who has logged in, and granting again is a
+// no-op beyond refreshing the names kept on the grant.
+func NewGrantPresenceViewerEndpoint(presenceStore GrantPresenceViewerStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		viewerIDStr := chi.URLParam(r, viewerIDPathParam)
+		viewerID, err := strconv.ParseInt(viewerIDStr, 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(viewerIDPathParam, ErrCodeInvalidInteger, nil)
+		} else if viewerID == driverID {
+			errs = errs.WithFieldErrorCode(viewerIDPathParam, ErrCodeInvalidValue, map[string]string{"value": viewerIDStr})
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		driver, err := presenceStore.GetDriver(ctx, driverID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to fetch driver")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+		if driver == nil {
+			api.DoNotFoundResponse(ctx, "driver not found", w)
+			return
+		}
+
+		viewer, err := presenceStore.GetDriver(ctx, viewerID)
+		if err != nil {
+			logger.Error().Err(err).Int64("viewerId", viewerID).Msg("failed to fetch viewer")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+		if viewer == nil {
+			api.DoNotFoundResponse(ctx, "viewer not found", w)
+			return
+		}
+
+		err = presenceStore.SavePresenceGrant(ctx, store.PresenceGrant{
+			DriverID:   driverID,
+			DriverName: driver.DriverName,
+			ViewerID:   viewerID,
+			ViewerName: viewer.DriverName,
+		})
+		if err != nil {
+			logger.Error().Err(err).Int64("viewerId", viewerID).Msg("failed to save presence grant")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,156 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGrantPresenceViewerEndpoint(t *testing.T) {
+	type getDriverCall struct {
+		driverID int64
+		result   *store.Driver
+		err      error
+	}
+
+	type saveCall struct {
+		grant store.PresenceGrant
+		err   error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+		viewerID string
+
+		getDriverCalls []getDriverCall
+		saveCall       *saveCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:     "success",
+			driverID: "12345",
+			viewerID: "222",
+			getDriverCalls: []getDriverCall{
+				{driverID: 12345, result: &store.Driver{DriverID: 12345, DriverName: "Test Driver"}},
+				{driverID: 222, result: &store.Driver{DriverID: 222, DriverName: "Team Mate"}},
+			},
+			saveCall: &saveCall{
+				grant: store.PresenceGrant{DriverID: 12345, DriverName: "Test Driver", ViewerID: 222, ViewerName: "Team Mate"},
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                "invalid ids",
+			driverID:            "abc",
+			viewerID:            "xyz",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/grant_presence_viewer_invalid_ids_response.json",
+		},
+		{
+			name:                "granting to self",
+			driverID:            "12345",
+			viewerID:            "12345",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/grant_presence_viewer_self_response.json",
+		},
+		{
+			name:     "driver not found",
+			driverID: "12345",
+			viewerID: "222",
+			getDriverCalls: []getDriverCall{
+				{driverID: 12345},
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/grant_presence_viewer_driver_not_found_response.json",
+		},
+		{
+			name:     "viewer not found",
+			driverID: "12345",
+			viewerID: "222",
+			getDriverCalls: []getDriverCall{
+				{driverID: 12345, result: &store.Driver{DriverID: 12345, DriverName: "Test Driver"}},
+				{driverID: 222},
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/grant_presence_viewer_viewer_not_found_response.json",
+		},
+		{
+			name:     "get driver error",
+			driverID: "12345",
+			viewerID: "222",
+			getDriverCalls: []getDriverCall{
+				{driverID: 12345, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/grant_presence_viewer_store_error_response.json",
+		},
+		{
+			name:     "save error",
+			driverID: "12345",
+			viewerID: "222",
+			getDriverCalls: []getDriverCall{
+				{driverID: 12345, result: &store.Driver{DriverID: 12345, DriverName: "Test Driver"}},
+				{driverID: 222, result: &store.Driver{DriverID: 222, DriverName: "Team Mate"}},
+			},
+			saveCall: &saveCall{
+				grant: store.PresenceGrant{DriverID: 12345, DriverName: "Test Driver", ViewerID: 222, ViewerName: "Team Mate"},
+				err:   errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/grant_presence_viewer_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockGrantPresenceViewerStore(t)
+			for _, call := range tc.getDriverCalls {
+				mockStore.EXPECT().GetDriver(mock.Anything, call.driverID).Return(call.result, call.err)
+			}
+			if tc.saveCall != nil {
+				mockStore.EXPECT().SavePresenceGrant(mock.Anything, tc.saveCall.grant).Return(tc.saveCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Put("/{driver_id}/presence/viewers/{viewer_id}", NewGrantPresenceViewerEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPut, ts.URL+"/"+tc.driverID+"/presence/viewers/"+tc.viewerID, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			if tc.expectedBodyFixture != "" {
+				expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+			} else {
+				assert.Empty(t, bodyBytes)
+			}
+		})
+	}
+}
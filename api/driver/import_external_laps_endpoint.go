@@ -0,0 +1,78 @@
+package driver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/lapimport"
+	"github.com/rs/zerolog"
+)
+
+// maxExternalLapExportBytes is generous for a season of practice exported from a lap time service.
+const maxExternalLapExportBytes = 2 * 1024 * 1024
+
+type LapImportService interface {
+	Import(ctx context.Context, driverID int64, source string, export io.Reader) (*lapimport.ImportResult, error)
+}
+
+// NewImportExternalLapsEndpoint takes an export from a third party lap time service, identified by the source path
+// parameter, as the raw request body and records its laps as practice for the driver. Re-importing an export replaces
+// the laps it brought in before.
+func NewImportExternalLapsEndpoint(lapImportService LapImportService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		source := chi.URLParam(r, "source")
+
+		export, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxExternalLapExportBytes))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				errs = errs.WithFieldErrorCode("body", "too_large", map[string]string{"maxBytes": strconv.Itoa(maxExternalLapExportBytes)})
+			} else {
+				errs = errs.WithError("unreadable body")
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		result, err := lapImportService.Import(ctx, driverID, source, bytes.NewReader(export))
+		if errors.Is(err, lapimport.ErrUnknownSource) {
+			api.DoNotFoundResponse(ctx, "unknown lap import source", w)
+			return
+		}
+		var parseErr *lapimport.ParseError
+		if errors.As(err, &parseErr) {
+			api.DoBadRequestResponse(ctx, errs.WithFieldErrorCode("body", parseErr.Code, parseErr.Params), w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("source", source).Msg("failed to import external laps")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, ExternalLapImportResponse{
+			Source:   source,
+			Sessions: result.Sessions,
+			Laps:     result.Laps,
+		}, w)
+	})
+}
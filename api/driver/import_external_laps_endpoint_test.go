@@ -0,0 +1,118 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/lapimport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewImportExternalLapsEndpoint(t *testing.T) {
+	export := "Session ID,Lap,Lap Time,Track ID,Car ID,Driven At\nabc,1,95.1234,10,20,2024-01-15T14:00:00Z\n"
+
+	type importCall struct {
+		result *lapimport.ImportResult
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		source      string
+		requestBody string
+
+		importCalls []importCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			source:              "garage61",
+			requestBody:         export,
+			importCalls:         []importCall{{result: &lapimport.ImportResult{Sessions: 2, Laps: 17}}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/import_external_laps_success_response.json",
+		},
+		{
+			name:                "too large",
+			source:              "garage61",
+			requestBody:         strings.Repeat("x", maxExternalLapExportBytes+1),
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/import_external_laps_too_large_response.json",
+		},
+		{
+			name:        "invalid export",
+			source:      "garage61",
+			requestBody: export,
+			importCalls: []importCall{
+				{err: &lapimport.ParseError{Code: "invalid_value", Params: map[string]string{"line": "3", "column": "Lap Time"}}},
+			},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/import_external_laps_invalid_export_response.json",
+		},
+		{
+			name:                "unknown source",
+			source:              "stopwatch",
+			requestBody:         export,
+			importCalls:         []importCall{{err: lapimport.ErrUnknownSource}},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/import_external_laps_unknown_source_response.json",
+		},
+		{
+			name:                "service error",
+			source:              "garage61",
+			requestBody:         export,
+			importCalls:         []importCall{{err: errors.New("database error")}},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/import_external_laps_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockLapImportService(t)
+			for _, call := range tc.importCalls {
+				mockService.EXPECT().Import(mock.Anything, int64(12345), tc.source, mock.MatchedBy(func(r io.Reader) bool {
+					body, err := io.ReadAll(r)
+					return err == nil && string(body) == tc.requestBody
+				})).Return(call.result, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Post("/{driver_id}/external-laps/{source}", NewImportExternalLapsEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPost, ts.URL+"/12345/external-laps/"+tc.source, strings.NewReader(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "text/csv")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package driver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/telemetry"
+	"github.com/rs/zerolog"
+)
+
+// maxTelemetryExportBytes leaves plenty of room for a per lap summary of an endurance race while staying well inside
+// what API Gateway will pass through.
+const maxTelemetryExportBytes = 1024 * 1024
+
+type TelemetryServiceForImport interface {
+	Import(ctx context.Context, driverID, raceID int64, export io.Reader) (*store.RaceTelemetry, error)
+}
+
+// NewImportTelemetryEndpoint takes a telemetry summary export as the raw CSV request body and attaches its stint
+// summary to the race, replacing any earlier import. Problems reading the export come back as field errors against
+// the body.
+func NewImportTelemetryEndpoint(telemetryService TelemetryServiceForImport) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var raceID int64
+		raceIDStr := chi.URLParam(r, "driver_race_id")
+		if raceIDStr == "" {
+			errs = errs.WithFieldError("driver_race_id", "required")
+		} else {
+			raceID, err = strconv.ParseInt(raceIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError("driver_race_id", "must be a valid integer")
+			}
+		}
+
+		export, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxTelemetryExportBytes))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				errs = errs.WithFieldErrorCode("body", "too_large", map[string]string{"maxBytes": strconv.Itoa(maxTelemetryExportBytes)})
+			} else {
+				errs = errs.WithError("unreadable body")
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		imported, err := telemetryService.Import(ctx, driverID, raceID, bytes.NewReader(export))
+		if errors.Is(err, telemetry.ErrRaceNotFound) {
+			api.DoNotFoundResponse(ctx, "race not found", w)
+			return
+		}
+		var parseErr *telemetry.ParseError
+		if errors.As(err, &parseErr) {
+			api.DoBadRequestResponse(ctx, errs.WithFieldErrorCode("body", parseErr.Code, parseErr.Params), w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to import race telemetry")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, raceTelemetryFromStore(*imported), w)
+	})
+}
@@ -0,0 +1,114 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewImportTelemetryEndpoint(t *testing.T) {
+	imported := testRaceTelemetry()
+	export := "Lap,Fuel Used,LF Temp\n1,2.4,84\n"
+
+	type importCall struct {
+		telemetry *store.RaceTelemetry
+		err       error
+	}
+
+	testCases := []struct {
+		name string
+
+		requestBody string
+
+		importCalls []importCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			requestBody:         export,
+			importCalls:         []importCall{{telemetry: &imported}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/import_telemetry_success_response.json",
+		},
+		{
+			name:                "too large",
+			requestBody:         strings.Repeat("x", maxTelemetryExportBytes+1),
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/import_telemetry_too_large_response.json",
+		},
+		{
+			name:        "invalid export",
+			requestBody: export,
+			importCalls: []importCall{
+				{err: &telemetry.ParseError{Code: "missing_column", Params: map[string]string{"column": "lap"}}},
+			},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/import_telemetry_invalid_export_response.json",
+		},
+		{
+			name:                "race not found",
+			requestBody:         export,
+			importCalls:         []importCall{{err: telemetry.ErrRaceNotFound}},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/import_telemetry_race_not_found_response.json",
+		},
+		{
+			name:                "service error",
+			requestBody:         export,
+			importCalls:         []importCall{{err: errors.New("database error")}},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/import_telemetry_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockTelemetryServiceForImport(t)
+			for _, call := range tc.importCalls {
+				mockService.EXPECT().Import(mock.Anything, int64(12345), int64(1700000000), mock.MatchedBy(func(r io.Reader) bool {
+					body, err := io.ReadAll(r)
+					return err == nil && string(body) == tc.requestBody
+				})).Return(call.telemetry, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Put("/{driver_id}/races/{driver_race_id}/telemetry", NewImportTelemetryEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPut, ts.URL+"/12345/races/1700000000/telemetry", strings.NewReader(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "text/csv")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
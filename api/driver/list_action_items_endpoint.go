@@ -0,0 +1,60 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type ActionItemServiceForList interface {
+	List(ctx context.Context, driverID int64, status store.ActionItemStatus) ([]store.ActionItem, error)
+}
+
+// NewListActionItemsEndpoint lists a driver's action items. Only open items are returned unless the status query
+// parameter asks for done or all items.
+func NewListActionItemsEndpoint(actionItemService ActionItemServiceForList) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		status := store.ActionItemOpen
+		switch s := r.URL.Query().Get(api.StatusQueryParam); s {
+		case "", string(store.ActionItemOpen):
+		case string(store.ActionItemDone):
+			status = store.ActionItemDone
+		case "all":
+			status = ""
+		default:
+			errs = errs.WithFieldErrorCode(api.StatusQueryParam, ErrCodeInvalidValue, map[string]string{
+				"value":   s,
+				"allowed": "open, done, all",
+			})
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		items, err := actionItemService.List(ctx, driverID, status)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to list action items")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, ActionItemsResponse{Items: actionItemsFromStore(items)}, w)
+	})
+}
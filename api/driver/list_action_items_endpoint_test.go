@@ -0,0 +1,144 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListActionItemsEndpoint(t *testing.T) {
+	now := time.Unix(1700100000, 0)
+	dueDate := time.Unix(1700500000, 0)
+	testItems := []store.ActionItem{
+		{
+			DriverID:  12345,
+			ItemID:    "item-1",
+			Title:     "Brake earlier into turn one",
+			Status:    store.ActionItemOpen,
+			RaceID:    1700000000,
+			TrackID:   42,
+			DueDate:   &dueDate,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		{
+			DriverID:    12345,
+			ItemID:      "item-2",
+			Title:       "Work on consistency",
+			Status:      store.ActionItemDone,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			CompletedAt: &now,
+		},
+	}
+
+	type listCall struct {
+		status store.ActionItemStatus
+		items  []store.ActionItem
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+		query    string
+
+		listCalls []listCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:     "defaults to open items",
+			driverID: "12345",
+			listCalls: []listCall{
+				{status: store.ActionItemOpen, items: []store.ActionItem{}},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_action_items_empty_response.json",
+		},
+		{
+			name:     "done items",
+			driverID: "12345",
+			query:    "?status=done",
+			listCalls: []listCall{
+				{status: store.ActionItemDone, items: nil},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_action_items_empty_response.json",
+		},
+		{
+			name:     "all items",
+			driverID: "12345",
+			query:    "?status=all",
+			listCalls: []listCall{
+				{status: "", items: testItems},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_action_items_success_response.json",
+		},
+		{
+			name:                "invalid status",
+			driverID:            "12345",
+			query:               "?status=pending",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/list_action_items_invalid_status_response.json",
+		},
+		{
+			name:     "service error",
+			driverID: "12345",
+			listCalls: []listCall{
+				{status: store.ActionItemOpen, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/list_action_items_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockActionItemServiceForList(t)
+			for _, call := range tc.listCalls {
+				mockService.EXPECT().List(mock.Anything, int64(12345), call.status).
+					Return(call.items, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/action-items", NewListActionItemsEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/" + tc.driverID + "/action-items" + tc.query
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type AlertServiceForList interface {
+	List(ctx context.Context, driverID int64) ([]store.AlertRule, error)
+}
+
+func NewListAlertRulesEndpoint(alertService AlertServiceForList) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		rules, err := alertService.List(ctx, driverID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to list alert rules")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		resp := AlertRulesResponse{Rules: make([]AlertRule, len(rules))}
+		for i, rule := range rules {
+			resp.Rules[i] = alertRuleFromStore(rule)
+		}
+		api.DoOKResponse(ctx, resp, w)
+	})
+}
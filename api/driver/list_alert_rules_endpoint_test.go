@@ -0,0 +1,135 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListAlertRulesEndpoint(t *testing.T) {
+	createdAt := time.Unix(1700000000, 0)
+	now := time.Unix(1700100000, 0)
+	testRules := []store.AlertRule{
+		{
+			DriverID:        12345,
+			RuleID:          "rule-1",
+			Name:            "Incident creep",
+			Metric:          store.AlertMetricIncidents,
+			Aggregate:       store.AlertAggregateAverage,
+			WindowRaces:     10,
+			Comparison:      store.AlertComparisonAbove,
+			Threshold:       5,
+			Enabled:         true,
+			Triggered:       true,
+			LastTriggeredAt: &now,
+			LastValue:       6.2,
+			CreatedAt:       createdAt,
+			UpdatedAt:       createdAt,
+		},
+		{
+			DriverID:   12345,
+			RuleID:     "rule-2",
+			Name:       "iRating slide",
+			Metric:     store.AlertMetricIRating,
+			Aggregate:  store.AlertAggregateChange,
+			WindowDays: 7,
+			Comparison: store.AlertComparisonBelow,
+			Threshold:  -200,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		},
+	}
+
+	type listCall struct {
+		driverID int64
+		rules    []store.AlertRule
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+
+		listCalls []listCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:     "success",
+			driverID: "12345",
+			listCalls: []listCall{
+				{driverID: 12345, rules: testRules},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_alert_rules_success_response.json",
+		},
+		{
+			name:     "no rules",
+			driverID: "12345",
+			listCalls: []listCall{
+				{driverID: 12345, rules: nil},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_alert_rules_empty_response.json",
+		},
+		{
+			name:                "invalid driver ID",
+			driverID:            "not-a-number",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_journal_invalid_driver_id_response.json",
+		},
+		{
+			name:     "service error",
+			driverID: "12345",
+			listCalls: []listCall{
+				{driverID: 12345, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/list_alert_rules_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockAlertServiceForList(t)
+			for _, call := range tc.listCalls {
+				mockService.EXPECT().List(mock.Anything, call.driverID).
+					Return(call.rules, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/alert-rules", NewListAlertRulesEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			res, err := http.Get(ts.URL + "/" + tc.driverID + "/alert-rules")
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
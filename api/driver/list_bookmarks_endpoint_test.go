@@ -0,0 +1,101 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListBookmarksEndpoint(t *testing.T) {
+	now := time.Unix(1700100000, 0)
+	bookmarks := []store.RaceBookmark{
+		{DriverID: 12345, RaceID: 1700000000, BookmarkID: "bookmark-1", Lap: 1, Note: "Got squeezed into turn one", ReplayTime: 45 * time.Second, CreatedAt: now, UpdatedAt: now},
+		{DriverID: 12345, RaceID: 1700000000, BookmarkID: "bookmark-2", Lap: 3, Note: "Lost the rear on exit of turn four", ReplayTime: 252500 * time.Millisecond, CreatedAt: now, UpdatedAt: now},
+	}
+
+	type listCall struct {
+		bookmarks []store.RaceBookmark
+		err       error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverRaceID string
+
+		listCalls []listCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			driverRaceID:        "1700000000",
+			listCalls:           []listCall{{bookmarks: bookmarks}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_bookmarks_success_response.json",
+		},
+		{
+			name:                "empty",
+			driverRaceID:        "1700000000",
+			listCalls:           []listCall{{}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_bookmarks_empty_response.json",
+		},
+		{
+			name:                "invalid race id",
+			driverRaceID:        "not-an-integer",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/list_bookmarks_invalid_race_id_response.json",
+		},
+		{
+			name:                "service error",
+			driverRaceID:        "1700000000",
+			listCalls:           []listCall{{err: errors.New("database error")}},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/list_bookmarks_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockBookmarkServiceForList(t)
+			for _, call := range tc.listCalls {
+				mockService.EXPECT().List(mock.Anything, int64(12345), int64(1700000000)).
+					Return(call.bookmarks, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/races/{driver_race_id}/bookmarks", NewListBookmarksEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			res, err := http.Get(ts.URL + "/12345/races/" + tc.driverRaceID + "/bookmarks")
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
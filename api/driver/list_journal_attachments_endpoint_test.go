@@ -0,0 +1,120 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/voicememo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListJournalAttachmentsEndpoint(t *testing.T) {
+	testAttachments := []voicememo.Attachment{
+		{
+			AttachmentID: "3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a",
+			ContentType:  "audio/webm",
+			CreatedAt:    time.Unix(1000, 0),
+			Status:       store.JournalAttachmentTranscribed,
+			Transcribe:   true,
+			DownloadURL:  "https://memos.s3.amazonaws.com/memos/12345/1700000000/3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a?X-Amz-Signature=abc",
+		},
+		{
+			AttachmentID: "7a9d0b3c-2e4f-4a1b-8c6d-5e3f2a1b0c9d",
+			ContentType:  "audio/mpeg",
+			CreatedAt:    time.Unix(2000, 0),
+			Status:       store.JournalAttachmentPendingUpload,
+		},
+	}
+
+	type listCall struct {
+		driverID    int64
+		raceID      int64
+		attachments []voicememo.Attachment
+		err         error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+		raceID   string
+
+		listCalls []listCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:     "success",
+			driverID: "12345",
+			raceID:   "1700000000",
+			listCalls: []listCall{
+				{driverID: 12345, raceID: 1700000000, attachments: testAttachments},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_journal_attachments_success_response.json",
+		},
+		{
+			name:                "invalid driver_race_id",
+			driverID:            "12345",
+			raceID:              "not-a-number",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/list_journal_attachments_invalid_race_id_response.json",
+		},
+		{
+			name:     "service error",
+			driverID: "12345",
+			raceID:   "1700000000",
+			listCalls: []listCall{
+				{driverID: 12345, raceID: 1700000000, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/list_journal_attachments_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockVoiceMemoServiceForList(t)
+			for _, call := range tc.listCalls {
+				mockService.EXPECT().List(mock.Anything, call.driverID, call.raceID).
+					Return(call.attachments, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/races/{driver_race_id}/journal/attachments", NewListJournalAttachmentsEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/" + tc.driverID + "/races/" + tc.raceID + "/journal/attachments"
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
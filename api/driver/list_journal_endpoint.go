@@ -75,9 +75,10 @@ func NewListJournalEntriesEndpoint(journalService ListJournalEntriesStore) http.
 			DriverID: driverID,
 			From:     startTime,
 			To:       endTime,
+			Query:    r.URL.Query().Get(api.SearchQueryParam),
 		})
 		if err != nil {
-			logger.Error().Err(err).Int64("driverId", driverID).Msg("failed to list journal entries")
+			logger.Error().Err(err).Msg("failed to list journal entries")
 			api.DoErrorResponse(ctx, w)
 			return
 		}
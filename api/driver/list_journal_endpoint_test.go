@@ -70,6 +70,7 @@ func TestNewListJournalEntriesEndpoint(t *testing.T) {
 		endTime        string
 		page           string
 		resultsPerPage string
+		query          string
 
 		listCalls []listCall
 
@@ -114,6 +115,26 @@ func TestNewListJournalEntriesEndpoint(t *testing.T) {
 			expectedStatus:      http.StatusOK,
 			expectedBodyFixture: "fixtures/list_journal_paginated_response.json",
 		},
+		{
+			name:      "success with search query",
+			driverID:  "12345",
+			startTime: "2023-11-01T00:00:00Z",
+			endTime:   "2023-11-30T00:00:00Z",
+			query:     "podium",
+			listCalls: []listCall{
+				{
+					input: journal.ListInput{
+						DriverID: 12345,
+						From:     time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC),
+						To:       time.Date(2023, 11, 30, 0, 0, 0, 0, time.UTC),
+						Query:    "podium",
+					},
+					entries: testEntries,
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_journal_success_response.json",
+		},
 		{
 			name:                "missing startTime",
 			driverID:            "12345",
@@ -187,6 +208,9 @@ func TestNewListJournalEntriesEndpoint(t *testing.T) {
 			if tc.resultsPerPage != "" {
 				url += "resultsPerPage=" + tc.resultsPerPage + "&"
 			}
+			if tc.query != "" {
+				url += "q=" + tc.query + "&"
+			}
 
 			req, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
@@ -0,0 +1,57 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/rs/zerolog"
+)
+
+const defaultJournalPromptDays = 7
+
+type JournalServiceForPrompts interface {
+	Prompts(ctx context.Context, driverID int64, days int) ([]journal.Prompt, error)
+}
+
+// NewListJournalPromptsEndpoint creates the handler for GET /driver/{driver_id}/journal/prompts, listing the races run
+// in the last days days (a week by default) that are still waiting on a journal entry.
+func NewListJournalPromptsEndpoint(journalService JournalServiceForPrompts) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		days := defaultJournalPromptDays
+		if daysStr := r.URL.Query().Get(api.DaysQueryParam); daysStr != "" {
+			days, err = strconv.Atoi(daysStr)
+			if err != nil || days < 1 || days > journal.MaxPromptDays {
+				errs = errs.WithFieldError(api.DaysQueryParam, fmt.Sprintf("must be an integer between 1 and %d", journal.MaxPromptDays))
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		prompts, err := journalService.Prompts(ctx, driverID, days)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to list journal prompts")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, journalPromptsResponseFromService(prompts), w)
+	})
+}
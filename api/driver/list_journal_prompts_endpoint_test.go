@@ -0,0 +1,138 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListJournalPromptsEndpoint(t *testing.T) {
+	type promptsCall struct {
+		days   int
+		result []journal.Prompt
+		err    error
+	}
+
+	prompts := []journal.Prompt{
+		{
+			RaceID:    1700000000,
+			CreatedAt: time.Unix(1700005000, 0),
+			Race: &store.DriverSession{
+				DriverID:       12345,
+				SubsessionID:   100001,
+				TrackID:        1,
+				CarID:          10,
+				SeriesID:       42,
+				SeriesName:     "Advanced Mazda MX-5 Cup Series",
+				StartTime:      time.Unix(1700000000, 0),
+				FinishPosition: 2,
+			},
+			PlanEvaluation: &store.PlanEvaluation{
+				Targets: []store.PlanTarget{
+					{Kind: store.PlanTargetFinishPosition, Target: 5, Actual: 1, Met: true},
+					{Kind: store.PlanTargetIncidents, Target: 0, Actual: 0, Met: true},
+				},
+				EvaluatedAt: time.Unix(1700004000, 0),
+			},
+		},
+		{
+			RaceID:    1699900000,
+			CreatedAt: time.Unix(1699905000, 0),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+		query    string
+
+		promptsCall *promptsCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			driverID:            "12345",
+			promptsCall:         &promptsCall{days: 7, result: prompts},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_journal_prompts_success_response.json",
+		},
+		{
+			name:                "custom days",
+			driverID:            "12345",
+			query:               "?days=30",
+			promptsCall:         &promptsCall{days: 30, result: []journal.Prompt{}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_journal_prompts_empty_response.json",
+		},
+		{
+			name:                "days out of range",
+			driverID:            "12345",
+			query:               "?days=31",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/list_journal_prompts_invalid_days_response.json",
+		},
+		{
+			name:                "days not a number",
+			driverID:            "12345",
+			query:               "?days=week",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/list_journal_prompts_invalid_days_response.json",
+		},
+		{
+			name:                "service error",
+			driverID:            "12345",
+			promptsCall:         &promptsCall{days: 7, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/list_journal_prompts_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockJournalServiceForPrompts(t)
+			if tc.promptsCall != nil {
+				mockService.EXPECT().Prompts(mock.Anything, int64(12345), tc.promptsCall.days).
+					Return(tc.promptsCall.result, tc.promptsCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/journal/prompts", NewListJournalPromptsEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/journal/prompts"+tc.query, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListPresenceViewersEndpoint(t *testing.T) {
+	type storeCall struct {
+		driverID int64
+		result   []store.PresenceGrant
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+
+		storeCall *storeCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:     "success",
+			driverID: "12345",
+			storeCall: &storeCall{
+				driverID: 12345,
+				result: []store.PresenceGrant{
+					{DriverID: 12345, DriverName: "Test Driver", ViewerID: 222, ViewerName: "Team Mate", GrantedAt: time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)},
+					{DriverID: 12345, DriverName: "Test Driver", ViewerID: 333, ViewerName: "Driving Coach", GrantedAt: time.Date(2024, 2, 1, 8, 0, 0, 0, time.UTC)},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_presence_viewers_success_response.json",
+		},
+		{
+			name:     "no viewers",
+			driverID: "12345",
+			storeCall: &storeCall{
+				driverID: 12345,
+				result:   []store.PresenceGrant{},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_presence_viewers_empty_response.json",
+		},
+		{
+			name:                "invalid driver id",
+			driverID:            "abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/list_presence_viewers_invalid_driver_id_response.json",
+		},
+		{
+			name:     "store error",
+			driverID: "12345",
+			storeCall: &storeCall{
+				driverID: 12345,
+				err:      errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/list_presence_viewers_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockListPresenceViewersStore(t)
+			if tc.storeCall != nil {
+				mockStore.EXPECT().GetPresenceViewers(mock.Anything, tc.storeCall.driverID).
+					Return(tc.storeCall.result, tc.storeCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/presence/viewers", NewListPresenceViewersEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.driverID+"/presence/viewers", nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type RacePlanServiceForList interface {
+	List(ctx context.Context, driverID int64) ([]store.RacePlan, error)
+}
+
+// NewListRacePlansEndpoint lists the plans a driver wrote ahead of their races, most recent race week first.
+func NewListRacePlansEndpoint(racePlanService RacePlanServiceForList) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		plans, err := racePlanService.List(ctx, driverID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to list race plans")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		resp := RacePlansResponse{Plans: make([]RacePlan, len(plans))}
+		for i, plan := range plans {
+			resp.Plans[i] = racePlanFromStore(plan)
+		}
+		api.DoOKResponse(ctx, resp, w)
+	})
+}
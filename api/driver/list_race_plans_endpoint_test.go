@@ -0,0 +1,138 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testRacePlan = store.RacePlan{
+	DriverID:        12345,
+	WeekStart:       time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+	SeriesID:        260,
+	TrackID:         47,
+	Goals:           "Clean race, top 5",
+	SetupIntentions: "Less rear wing",
+	CreatedAt:       time.Date(2025, 6, 8, 9, 0, 0, 0, time.UTC),
+	UpdatedAt:       time.Date(2025, 6, 9, 18, 30, 0, 0, time.UTC),
+}
+
+func linkedTestRacePlan() store.RacePlan {
+	plan := testRacePlan
+	plan.RaceID = 1749645000
+	plan.SubsessionID = 98765
+	plan.LinkedAt = time.Date(2025, 6, 11, 13, 0, 0, 0, time.UTC)
+	plan.Evaluation = &store.PlanEvaluation{
+		Targets: []store.PlanTarget{
+			{Kind: store.PlanTargetFinishPosition, Target: 5, Actual: 3, Met: true},
+			{Kind: store.PlanTargetIncidents, Target: 0, Actual: 4, Met: false},
+		},
+		EvaluatedAt: time.Date(2025, 6, 11, 13, 0, 0, 0, time.UTC),
+	}
+	return plan
+}
+
+func TestNewListRacePlansEndpoint(t *testing.T) {
+	nextWeek := store.RacePlan{
+		DriverID:  12345,
+		WeekStart: time.Date(2025, 6, 17, 0, 0, 0, 0, time.UTC),
+		SeriesID:  260,
+		TrackID:   12,
+		Goals:     "Qualify top 10",
+		CreatedAt: time.Date(2025, 6, 15, 9, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 6, 15, 9, 0, 0, 0, time.UTC),
+	}
+
+	type listCall struct {
+		driverID int64
+		plans    []store.RacePlan
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+
+		listCalls []listCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:     "success",
+			driverID: "12345",
+			listCalls: []listCall{
+				{driverID: 12345, plans: []store.RacePlan{nextWeek, linkedTestRacePlan()}},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_race_plans_success_response.json",
+		},
+		{
+			name:     "no plans",
+			driverID: "12345",
+			listCalls: []listCall{
+				{driverID: 12345, plans: nil},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_race_plans_empty_response.json",
+		},
+		{
+			name:                "invalid driver ID",
+			driverID:            "not-a-number",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_journal_invalid_driver_id_response.json",
+		},
+		{
+			name:     "service error",
+			driverID: "12345",
+			listCalls: []listCall{
+				{driverID: 12345, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/list_race_plans_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockRacePlanServiceForList(t)
+			for _, call := range tc.listCalls {
+				mockService.EXPECT().List(mock.Anything, call.driverID).
+					Return(call.plans, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/race-plans", NewListRacePlansEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			res, err := http.Get(ts.URL + "/" + tc.driverID + "/race-plans")
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
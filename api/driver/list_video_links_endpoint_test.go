@@ -0,0 +1,102 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListVideoLinksEndpoint(t *testing.T) {
+	now := time.Unix(1700100000, 0)
+	lap := 3
+	links := []store.VideoLink{
+		{DriverID: 12345, RaceID: 1700000000, LinkID: "link-1", URL: "https://www.youtube.com/watch?v=abc123&t=95s", Provider: "youtube", Title: "Onboard, race 2", AuthorName: "Some Streamer", ThumbnailURL: "https://i.ytimg.com/vi/abc123/hqdefault.jpg", CreatedAt: now},
+		{DriverID: 12345, RaceID: 1700000000, LinkID: "link-2", Lap: &lap, URL: "https://clips.twitch.tv/FunnyClipSlug", Provider: "twitch", CreatedAt: now},
+	}
+
+	type listCall struct {
+		links []store.VideoLink
+		err   error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverRaceID string
+
+		listCalls []listCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			driverRaceID:        "1700000000",
+			listCalls:           []listCall{{links: links}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_video_links_success_response.json",
+		},
+		{
+			name:                "empty",
+			driverRaceID:        "1700000000",
+			listCalls:           []listCall{{}},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_video_links_empty_response.json",
+		},
+		{
+			name:                "invalid race id",
+			driverRaceID:        "not-an-integer",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/list_video_links_invalid_race_id_response.json",
+		},
+		{
+			name:                "service error",
+			driverRaceID:        "1700000000",
+			listCalls:           []listCall{{err: errors.New("database error")}},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/list_video_links_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockVideoLinkServiceForList(t)
+			for _, call := range tc.listCalls {
+				mockService.EXPECT().List(mock.Anything, int64(12345), int64(1700000000)).
+					Return(call.links, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/races/{driver_race_id}/video-links", NewListVideoLinksEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			res, err := http.Get(ts.URL + "/12345/races/" + tc.driverRaceID + "/video-links")
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
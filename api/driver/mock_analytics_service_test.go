@@ -107,6 +107,142 @@ func (_c *MockAnalyticsService_GetAnalytics_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetCategoryProfile provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) GetCategoryProfile(ctx context.Context, req analytics.CategoryProfileRequest) (*analytics.CategoryProfile, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryProfile")
+	}
+
+	var r0 *analytics.CategoryProfile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.CategoryProfileRequest) (*analytics.CategoryProfile, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.CategoryProfileRequest) *analytics.CategoryProfile); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*analytics.CategoryProfile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, analytics.CategoryProfileRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsService_GetCategoryProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryProfile'
+type MockAnalyticsService_GetCategoryProfile_Call struct {
+	*mock.Call
+}
+
+// GetCategoryProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req analytics.CategoryProfileRequest
+func (_e *MockAnalyticsService_Expecter) GetCategoryProfile(ctx interface{}, req interface{}) *MockAnalyticsService_GetCategoryProfile_Call {
+	return &MockAnalyticsService_GetCategoryProfile_Call{Call: _e.mock.On("GetCategoryProfile", ctx, req)}
+}
+
+func (_c *MockAnalyticsService_GetCategoryProfile_Call) Run(run func(ctx context.Context, req analytics.CategoryProfileRequest)) *MockAnalyticsService_GetCategoryProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 analytics.CategoryProfileRequest
+		if args[1] != nil {
+			arg1 = args[1].(analytics.CategoryProfileRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsService_GetCategoryProfile_Call) Return(categoryProfile *analytics.CategoryProfile, err error) *MockAnalyticsService_GetCategoryProfile_Call {
+	_c.Call.Return(categoryProfile, err)
+	return _c
+}
+
+func (_c *MockAnalyticsService_GetCategoryProfile_Call) RunAndReturn(run func(ctx context.Context, req analytics.CategoryProfileRequest) (*analytics.CategoryProfile, error)) *MockAnalyticsService_GetCategoryProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChampionship provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) GetChampionship(ctx context.Context, req analytics.ChampionshipRequest) (*analytics.ChampionshipResult, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChampionship")
+	}
+
+	var r0 *analytics.ChampionshipResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.ChampionshipRequest) (*analytics.ChampionshipResult, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.ChampionshipRequest) *analytics.ChampionshipResult); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*analytics.ChampionshipResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, analytics.ChampionshipRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsService_GetChampionship_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChampionship'
+type MockAnalyticsService_GetChampionship_Call struct {
+	*mock.Call
+}
+
+// GetChampionship is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req analytics.ChampionshipRequest
+func (_e *MockAnalyticsService_Expecter) GetChampionship(ctx interface{}, req interface{}) *MockAnalyticsService_GetChampionship_Call {
+	return &MockAnalyticsService_GetChampionship_Call{Call: _e.mock.On("GetChampionship", ctx, req)}
+}
+
+func (_c *MockAnalyticsService_GetChampionship_Call) Run(run func(ctx context.Context, req analytics.ChampionshipRequest)) *MockAnalyticsService_GetChampionship_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 analytics.ChampionshipRequest
+		if args[1] != nil {
+			arg1 = args[1].(analytics.ChampionshipRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsService_GetChampionship_Call) Return(championshipResult *analytics.ChampionshipResult, err error) *MockAnalyticsService_GetChampionship_Call {
+	_c.Call.Return(championshipResult, err)
+	return _c
+}
+
+func (_c *MockAnalyticsService_GetChampionship_Call) RunAndReturn(run func(ctx context.Context, req analytics.ChampionshipRequest) (*analytics.ChampionshipResult, error)) *MockAnalyticsService_GetChampionship_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDimensions provides a mock function for the type MockAnalyticsService
 func (_mock *MockAnalyticsService) GetDimensions(ctx context.Context, driverID int64, from time.Time, to time.Time) (*analytics.Dimensions, error) {
 	ret := _mock.Called(ctx, driverID, from, to)
@@ -186,3 +322,275 @@ func (_c *MockAnalyticsService_GetDimensions_Call) RunAndReturn(run func(ctx con
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetRegionComparison provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) GetRegionComparison(ctx context.Context, req analytics.RegionComparisonRequest) (*analytics.RegionComparison, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRegionComparison")
+	}
+
+	var r0 *analytics.RegionComparison
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.RegionComparisonRequest) (*analytics.RegionComparison, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.RegionComparisonRequest) *analytics.RegionComparison); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*analytics.RegionComparison)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, analytics.RegionComparisonRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsService_GetRegionComparison_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRegionComparison'
+type MockAnalyticsService_GetRegionComparison_Call struct {
+	*mock.Call
+}
+
+// GetRegionComparison is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req analytics.RegionComparisonRequest
+func (_e *MockAnalyticsService_Expecter) GetRegionComparison(ctx interface{}, req interface{}) *MockAnalyticsService_GetRegionComparison_Call {
+	return &MockAnalyticsService_GetRegionComparison_Call{Call: _e.mock.On("GetRegionComparison", ctx, req)}
+}
+
+func (_c *MockAnalyticsService_GetRegionComparison_Call) Run(run func(ctx context.Context, req analytics.RegionComparisonRequest)) *MockAnalyticsService_GetRegionComparison_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 analytics.RegionComparisonRequest
+		if args[1] != nil {
+			arg1 = args[1].(analytics.RegionComparisonRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsService_GetRegionComparison_Call) Return(regionComparison *analytics.RegionComparison, err error) *MockAnalyticsService_GetRegionComparison_Call {
+	_c.Call.Return(regionComparison, err)
+	return _c
+}
+
+func (_c *MockAnalyticsService_GetRegionComparison_Call) RunAndReturn(run func(ctx context.Context, req analytics.RegionComparisonRequest) (*analytics.RegionComparison, error)) *MockAnalyticsService_GetRegionComparison_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSRRecoveryPlan provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) GetSRRecoveryPlan(ctx context.Context, req analytics.SRRecoveryRequest) (*analytics.SRRecoveryPlan, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSRRecoveryPlan")
+	}
+
+	var r0 *analytics.SRRecoveryPlan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.SRRecoveryRequest) (*analytics.SRRecoveryPlan, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.SRRecoveryRequest) *analytics.SRRecoveryPlan); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*analytics.SRRecoveryPlan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, analytics.SRRecoveryRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsService_GetSRRecoveryPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSRRecoveryPlan'
+type MockAnalyticsService_GetSRRecoveryPlan_Call struct {
+	*mock.Call
+}
+
+// GetSRRecoveryPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req analytics.SRRecoveryRequest
+func (_e *MockAnalyticsService_Expecter) GetSRRecoveryPlan(ctx interface{}, req interface{}) *MockAnalyticsService_GetSRRecoveryPlan_Call {
+	return &MockAnalyticsService_GetSRRecoveryPlan_Call{Call: _e.mock.On("GetSRRecoveryPlan", ctx, req)}
+}
+
+func (_c *MockAnalyticsService_GetSRRecoveryPlan_Call) Run(run func(ctx context.Context, req analytics.SRRecoveryRequest)) *MockAnalyticsService_GetSRRecoveryPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 analytics.SRRecoveryRequest
+		if args[1] != nil {
+			arg1 = args[1].(analytics.SRRecoveryRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsService_GetSRRecoveryPlan_Call) Return(sRRecoveryPlan *analytics.SRRecoveryPlan, err error) *MockAnalyticsService_GetSRRecoveryPlan_Call {
+	_c.Call.Return(sRRecoveryPlan, err)
+	return _c
+}
+
+func (_c *MockAnalyticsService_GetSRRecoveryPlan_Call) RunAndReturn(run func(ctx context.Context, req analytics.SRRecoveryRequest) (*analytics.SRRecoveryPlan, error)) *MockAnalyticsService_GetSRRecoveryPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTimeOfDayProfile provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) GetTimeOfDayProfile(ctx context.Context, req analytics.TimeOfDayRequest) (*analytics.TimeOfDayProfile, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimeOfDayProfile")
+	}
+
+	var r0 *analytics.TimeOfDayProfile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.TimeOfDayRequest) (*analytics.TimeOfDayProfile, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.TimeOfDayRequest) *analytics.TimeOfDayProfile); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*analytics.TimeOfDayProfile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, analytics.TimeOfDayRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsService_GetTimeOfDayProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeOfDayProfile'
+type MockAnalyticsService_GetTimeOfDayProfile_Call struct {
+	*mock.Call
+}
+
+// GetTimeOfDayProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req analytics.TimeOfDayRequest
+func (_e *MockAnalyticsService_Expecter) GetTimeOfDayProfile(ctx interface{}, req interface{}) *MockAnalyticsService_GetTimeOfDayProfile_Call {
+	return &MockAnalyticsService_GetTimeOfDayProfile_Call{Call: _e.mock.On("GetTimeOfDayProfile", ctx, req)}
+}
+
+func (_c *MockAnalyticsService_GetTimeOfDayProfile_Call) Run(run func(ctx context.Context, req analytics.TimeOfDayRequest)) *MockAnalyticsService_GetTimeOfDayProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 analytics.TimeOfDayRequest
+		if args[1] != nil {
+			arg1 = args[1].(analytics.TimeOfDayRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsService_GetTimeOfDayProfile_Call) Return(timeOfDayProfile *analytics.TimeOfDayProfile, err error) *MockAnalyticsService_GetTimeOfDayProfile_Call {
+	_c.Call.Return(timeOfDayProfile, err)
+	return _c
+}
+
+func (_c *MockAnalyticsService_GetTimeOfDayProfile_Call) RunAndReturn(run func(ctx context.Context, req analytics.TimeOfDayRequest) (*analytics.TimeOfDayProfile, error)) *MockAnalyticsService_GetTimeOfDayProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PredictRace provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) PredictRace(ctx context.Context, req analytics.PredictionRequest) (*analytics.Prediction, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PredictRace")
+	}
+
+	var r0 *analytics.Prediction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.PredictionRequest) (*analytics.Prediction, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, analytics.PredictionRequest) *analytics.Prediction); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*analytics.Prediction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, analytics.PredictionRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsService_PredictRace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PredictRace'
+type MockAnalyticsService_PredictRace_Call struct {
+	*mock.Call
+}
+
+// PredictRace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req analytics.PredictionRequest
+func (_e *MockAnalyticsService_Expecter) PredictRace(ctx interface{}, req interface{}) *MockAnalyticsService_PredictRace_Call {
+	return &MockAnalyticsService_PredictRace_Call{Call: _e.mock.On("PredictRace", ctx, req)}
+}
+
+func (_c *MockAnalyticsService_PredictRace_Call) Run(run func(ctx context.Context, req analytics.PredictionRequest)) *MockAnalyticsService_PredictRace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 analytics.PredictionRequest
+		if args[1] != nil {
+			arg1 = args[1].(analytics.PredictionRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsService_PredictRace_Call) Return(prediction *analytics.Prediction, err error) *MockAnalyticsService_PredictRace_Call {
+	_c.Call.Return(prediction, err)
+	return _c
+}
+
+func (_c *MockAnalyticsService_PredictRace_Call) RunAndReturn(run func(ctx context.Context, req analytics.PredictionRequest) (*analytics.Prediction, error)) *MockAnalyticsService_PredictRace_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,8 +39,14 @@ func (_m *MockGetDriverStore) EXPECT() *MockGetDriverStore_Expecter {
 }
 
 // GetDriver provides a mock function for the type MockGetDriverStore
-func (_mock *MockGetDriverStore) GetDriver(ctx context.Context, driverID int64) (*store.Driver, error) {
-	ret := _mock.Called(ctx, driverID)
+func (_mock *MockGetDriverStore) GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDriver")
@@ -48,18 +54,18 @@ func (_mock *MockGetDriverStore) GetDriver(ctx context.Context, driverID int64)
 
 	var r0 *store.Driver
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*store.Driver, error)); ok {
-		return returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) (*store.Driver, error)); ok {
+		return returnFunc(ctx, driverID, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *store.Driver); ok {
-		r0 = returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) *store.Driver); ok {
+		r0 = returnFunc(ctx, driverID, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*store.Driver)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -74,11 +80,13 @@ type MockGetDriverStore_GetDriver_Call struct {
 // GetDriver is a helper method to define mock.On call
 //   - ctx context.Context
 //   - driverID int64
-func (_e *MockGetDriverStore_Expecter) GetDriver(ctx interface{}, driverID interface{}) *MockGetDriverStore_GetDriver_Call {
-	return &MockGetDriverStore_GetDriver_Call{Call: _e.mock.On("GetDriver", ctx, driverID)}
+//   - opts ...store.ReadOption
+func (_e *MockGetDriverStore_Expecter) GetDriver(ctx interface{}, driverID interface{}, opts ...interface{}) *MockGetDriverStore_GetDriver_Call {
+	return &MockGetDriverStore_GetDriver_Call{Call: _e.mock.On("GetDriver",
+		append([]interface{}{ctx, driverID}, opts...)...)}
 }
 
-func (_c *MockGetDriverStore_GetDriver_Call) Run(run func(ctx context.Context, driverID int64)) *MockGetDriverStore_GetDriver_Call {
+func (_c *MockGetDriverStore_GetDriver_Call) Run(run func(ctx context.Context, driverID int64, opts ...store.ReadOption)) *MockGetDriverStore_GetDriver_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -88,9 +96,16 @@ func (_c *MockGetDriverStore_GetDriver_Call) Run(run func(ctx context.Context, d
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]store.ReadOption)
+		}
+		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
+			arg2...,
 		)
 	})
 	return _c
@@ -101,7 +116,7 @@ func (_c *MockGetDriverStore_GetDriver_Call) Return(driver *store.Driver, err er
 	return _c
 }
 
-func (_c *MockGetDriverStore_GetDriver_Call) RunAndReturn(run func(ctx context.Context, driverID int64) (*store.Driver, error)) *MockGetDriverStore_GetDriver_Call {
+func (_c *MockGetDriverStore_GetDriver_Call) RunAndReturn(run func(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)) *MockGetDriverStore_GetDriver_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -40,8 +40,14 @@ func (_m *MockGetRaceStore) EXPECT() *MockGetRaceStore_Expecter {
 }
 
 // GetDriverSession provides a mock function for the type MockGetRaceStore
-func (_mock *MockGetRaceStore) GetDriverSession(ctx context.Context, driverID int64, startTime time.Time) (*store.DriverSession, error) {
-	ret := _mock.Called(ctx, driverID, startTime)
+func (_mock *MockGetRaceStore) GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, startTime, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID, startTime)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDriverSession")
@@ -49,18 +55,18 @@ func (_mock *MockGetRaceStore) GetDriverSession(ctx context.Context, driverID in
 
 	var r0 *store.DriverSession
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) (*store.DriverSession, error)); ok {
-		return returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, ...store.ReadOption) (*store.DriverSession, error)); ok {
+		return returnFunc(ctx, driverID, startTime, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) *store.DriverSession); ok {
-		r0 = returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, ...store.ReadOption) *store.DriverSession); ok {
+		r0 = returnFunc(ctx, driverID, startTime, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*store.DriverSession)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
-		r1 = returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, startTime, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -76,11 +82,13 @@ type MockGetRaceStore_GetDriverSession_Call struct {
 //   - ctx context.Context
 //   - driverID int64
 //   - startTime time.Time
-func (_e *MockGetRaceStore_Expecter) GetDriverSession(ctx interface{}, driverID interface{}, startTime interface{}) *MockGetRaceStore_GetDriverSession_Call {
-	return &MockGetRaceStore_GetDriverSession_Call{Call: _e.mock.On("GetDriverSession", ctx, driverID, startTime)}
+//   - opts ...store.ReadOption
+func (_e *MockGetRaceStore_Expecter) GetDriverSession(ctx interface{}, driverID interface{}, startTime interface{}, opts ...interface{}) *MockGetRaceStore_GetDriverSession_Call {
+	return &MockGetRaceStore_GetDriverSession_Call{Call: _e.mock.On("GetDriverSession",
+		append([]interface{}{ctx, driverID, startTime}, opts...)...)}
 }
 
-func (_c *MockGetRaceStore_GetDriverSession_Call) Run(run func(ctx context.Context, driverID int64, startTime time.Time)) *MockGetRaceStore_GetDriverSession_Call {
+func (_c *MockGetRaceStore_GetDriverSession_Call) Run(run func(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption)) *MockGetRaceStore_GetDriverSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -94,10 +102,17 @@ func (_c *MockGetRaceStore_GetDriverSession_Call) Run(run func(ctx context.Conte
 		if args[2] != nil {
 			arg2 = args[2].(time.Time)
 		}
+		var arg3 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 3 {
+			variadicArgs = args[3].([]store.ReadOption)
+		}
+		arg3 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3...,
 		)
 	})
 	return _c
@@ -108,7 +123,7 @@ func (_c *MockGetRaceStore_GetDriverSession_Call) Return(driverSession *store.Dr
 	return _c
 }
 
-func (_c *MockGetRaceStore_GetDriverSession_Call) RunAndReturn(run func(ctx context.Context, driverID int64, startTime time.Time) (*store.DriverSession, error)) *MockGetRaceStore_GetDriverSession_Call {
+func (_c *MockGetRaceStore_GetDriverSession_Call) RunAndReturn(run func(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)) *MockGetRaceStore_GetDriverSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -175,6 +175,80 @@ func (_c *MockJournalService_Get_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// GetDraft provides a mock function for the type MockJournalService
+func (_mock *MockJournalService) GetDraft(ctx context.Context, driverID int64, raceID int64) (*journal.Draft, error) {
+	ret := _mock.Called(ctx, driverID, raceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDraft")
+	}
+
+	var r0 *journal.Draft
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*journal.Draft, error)); ok {
+		return returnFunc(ctx, driverID, raceID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *journal.Draft); ok {
+		r0 = returnFunc(ctx, driverID, raceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*journal.Draft)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, driverID, raceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJournalService_GetDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDraft'
+type MockJournalService_GetDraft_Call struct {
+	*mock.Call
+}
+
+// GetDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - raceID int64
+func (_e *MockJournalService_Expecter) GetDraft(ctx interface{}, driverID interface{}, raceID interface{}) *MockJournalService_GetDraft_Call {
+	return &MockJournalService_GetDraft_Call{Call: _e.mock.On("GetDraft", ctx, driverID, raceID)}
+}
+
+func (_c *MockJournalService_GetDraft_Call) Run(run func(ctx context.Context, driverID int64, raceID int64)) *MockJournalService_GetDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJournalService_GetDraft_Call) Return(draft *journal.Draft, err error) *MockJournalService_GetDraft_Call {
+	_c.Call.Return(draft, err)
+	return _c
+}
+
+func (_c *MockJournalService_GetDraft_Call) RunAndReturn(run func(ctx context.Context, driverID int64, raceID int64) (*journal.Draft, error)) *MockJournalService_GetDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function for the type MockJournalService
 func (_mock *MockJournalService) List(ctx context.Context, input journal.ListInput) ([]journal.Entry, error) {
 	ret := _mock.Called(ctx, input)
@@ -243,6 +317,74 @@ func (_c *MockJournalService_List_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// PublishDraft provides a mock function for the type MockJournalService
+func (_mock *MockJournalService) PublishDraft(ctx context.Context, draft journal.Draft) (*journal.Entry, error) {
+	ret := _mock.Called(ctx, draft)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishDraft")
+	}
+
+	var r0 *journal.Entry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, journal.Draft) (*journal.Entry, error)); ok {
+		return returnFunc(ctx, draft)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, journal.Draft) *journal.Entry); ok {
+		r0 = returnFunc(ctx, draft)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*journal.Entry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, journal.Draft) error); ok {
+		r1 = returnFunc(ctx, draft)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJournalService_PublishDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishDraft'
+type MockJournalService_PublishDraft_Call struct {
+	*mock.Call
+}
+
+// PublishDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - draft journal.Draft
+func (_e *MockJournalService_Expecter) PublishDraft(ctx interface{}, draft interface{}) *MockJournalService_PublishDraft_Call {
+	return &MockJournalService_PublishDraft_Call{Call: _e.mock.On("PublishDraft", ctx, draft)}
+}
+
+func (_c *MockJournalService_PublishDraft_Call) Run(run func(ctx context.Context, draft journal.Draft)) *MockJournalService_PublishDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 journal.Draft
+		if args[1] != nil {
+			arg1 = args[1].(journal.Draft)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJournalService_PublishDraft_Call) Return(entry *journal.Entry, err error) *MockJournalService_PublishDraft_Call {
+	_c.Call.Return(entry, err)
+	return _c
+}
+
+func (_c *MockJournalService_PublishDraft_Call) RunAndReturn(run func(ctx context.Context, draft journal.Draft) (*journal.Entry, error)) *MockJournalService_PublishDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function for the type MockJournalService
 func (_mock *MockJournalService) Save(ctx context.Context, input journal.SaveInput) (*journal.Entry, error) {
 	ret := _mock.Called(ctx, input)
@@ -311,6 +453,74 @@ func (_c *MockJournalService_Save_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// SaveDraft provides a mock function for the type MockJournalService
+func (_mock *MockJournalService) SaveDraft(ctx context.Context, input journal.SaveDraftInput) (*journal.Draft, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDraft")
+	}
+
+	var r0 *journal.Draft
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, journal.SaveDraftInput) (*journal.Draft, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, journal.SaveDraftInput) *journal.Draft); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*journal.Draft)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, journal.SaveDraftInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJournalService_SaveDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDraft'
+type MockJournalService_SaveDraft_Call struct {
+	*mock.Call
+}
+
+// SaveDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input journal.SaveDraftInput
+func (_e *MockJournalService_Expecter) SaveDraft(ctx interface{}, input interface{}) *MockJournalService_SaveDraft_Call {
+	return &MockJournalService_SaveDraft_Call{Call: _e.mock.On("SaveDraft", ctx, input)}
+}
+
+func (_c *MockJournalService_SaveDraft_Call) Run(run func(ctx context.Context, input journal.SaveDraftInput)) *MockJournalService_SaveDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 journal.SaveDraftInput
+		if args[1] != nil {
+			arg1 = args[1].(journal.SaveDraftInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJournalService_SaveDraft_Call) Return(draft *journal.Draft, err error) *MockJournalService_SaveDraft_Call {
+	_c.Call.Return(draft, err)
+	return _c
+}
+
+func (_c *MockJournalService_SaveDraft_Call) RunAndReturn(run func(ctx context.Context, input journal.SaveDraftInput) (*journal.Draft, error)) *MockJournalService_SaveDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateRaceExists provides a mock function for the type MockJournalService
 func (_mock *MockJournalService) ValidateRaceExists(ctx context.Context, driverID int64, raceID int64) (bool, error) {
 	ret := _mock.Called(ctx, driverID, raceID)
@@ -97,8 +97,14 @@ func (_c *MockStore_DeleteDriverRaces_Call) RunAndReturn(run func(ctx context.Co
 }
 
 // GetDriver provides a mock function for the type MockStore
-func (_mock *MockStore) GetDriver(ctx context.Context, driverID int64) (*store.Driver, error) {
-	ret := _mock.Called(ctx, driverID)
+func (_mock *MockStore) GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDriver")
@@ -106,18 +112,18 @@ func (_mock *MockStore) GetDriver(ctx context.Context, driverID int64) (*store.D
 
 	var r0 *store.Driver
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*store.Driver, error)); ok {
-		return returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) (*store.Driver, error)); ok {
+		return returnFunc(ctx, driverID, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *store.Driver); ok {
-		r0 = returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) *store.Driver); ok {
+		r0 = returnFunc(ctx, driverID, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*store.Driver)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -132,11 +138,13 @@ type MockStore_GetDriver_Call struct {
 // GetDriver is a helper method to define mock.On call
 //   - ctx context.Context
 //   - driverID int64
-func (_e *MockStore_Expecter) GetDriver(ctx interface{}, driverID interface{}) *MockStore_GetDriver_Call {
-	return &MockStore_GetDriver_Call{Call: _e.mock.On("GetDriver", ctx, driverID)}
+//   - opts ...store.ReadOption
+func (_e *MockStore_Expecter) GetDriver(ctx interface{}, driverID interface{}, opts ...interface{}) *MockStore_GetDriver_Call {
+	return &MockStore_GetDriver_Call{Call: _e.mock.On("GetDriver",
+		append([]interface{}{ctx, driverID}, opts...)...)}
 }
 
-func (_c *MockStore_GetDriver_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_GetDriver_Call {
+func (_c *MockStore_GetDriver_Call) Run(run func(ctx context.Context, driverID int64, opts ...store.ReadOption)) *MockStore_GetDriver_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -146,9 +154,16 @@ func (_c *MockStore_GetDriver_Call) Run(run func(ctx context.Context, driverID i
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]store.ReadOption)
+		}
+		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
+			arg2...,
 		)
 	})
 	return _c
@@ -159,14 +174,20 @@ func (_c *MockStore_GetDriver_Call) Return(driver *store.Driver, err error) *Moc
 	return _c
 }
 
-func (_c *MockStore_GetDriver_Call) RunAndReturn(run func(ctx context.Context, driverID int64) (*store.Driver, error)) *MockStore_GetDriver_Call {
+func (_c *MockStore_GetDriver_Call) RunAndReturn(run func(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)) *MockStore_GetDriver_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetDriverSession provides a mock function for the type MockStore
-func (_mock *MockStore) GetDriverSession(ctx context.Context, driverID int64, startTime time.Time) (*store.DriverSession, error) {
-	ret := _mock.Called(ctx, driverID, startTime)
+func (_mock *MockStore) GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, startTime, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID, startTime)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDriverSession")
@@ -174,18 +195,18 @@ func (_mock *MockStore) GetDriverSession(ctx context.Context, driverID int64, st
 
 	var r0 *store.DriverSession
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) (*store.DriverSession, error)); ok {
-		return returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, ...store.ReadOption) (*store.DriverSession, error)); ok {
+		return returnFunc(ctx, driverID, startTime, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) *store.DriverSession); ok {
-		r0 = returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, ...store.ReadOption) *store.DriverSession); ok {
+		r0 = returnFunc(ctx, driverID, startTime, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*store.DriverSession)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
-		r1 = returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, startTime, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -201,11 +222,13 @@ type MockStore_GetDriverSession_Call struct {
 //   - ctx context.Context
 //   - driverID int64
 //   - startTime time.Time
-func (_e *MockStore_Expecter) GetDriverSession(ctx interface{}, driverID interface{}, startTime interface{}) *MockStore_GetDriverSession_Call {
-	return &MockStore_GetDriverSession_Call{Call: _e.mock.On("GetDriverSession", ctx, driverID, startTime)}
+//   - opts ...store.ReadOption
+func (_e *MockStore_Expecter) GetDriverSession(ctx interface{}, driverID interface{}, startTime interface{}, opts ...interface{}) *MockStore_GetDriverSession_Call {
+	return &MockStore_GetDriverSession_Call{Call: _e.mock.On("GetDriverSession",
+		append([]interface{}{ctx, driverID, startTime}, opts...)...)}
 }
 
-func (_c *MockStore_GetDriverSession_Call) Run(run func(ctx context.Context, driverID int64, startTime time.Time)) *MockStore_GetDriverSession_Call {
+func (_c *MockStore_GetDriverSession_Call) Run(run func(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption)) *MockStore_GetDriverSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -219,10 +242,17 @@ func (_c *MockStore_GetDriverSession_Call) Run(run func(ctx context.Context, dri
 		if args[2] != nil {
 			arg2 = args[2].(time.Time)
 		}
+		var arg3 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 3 {
+			variadicArgs = args[3].([]store.ReadOption)
+		}
+		arg3 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3...,
 		)
 	})
 	return _c
@@ -233,7 +263,7 @@ func (_c *MockStore_GetDriverSession_Call) Return(driverSession *store.DriverSes
 	return _c
 }
 
-func (_c *MockStore_GetDriverSession_Call) RunAndReturn(run func(ctx context.Context, driverID int64, startTime time.Time) (*store.DriverSession, error)) *MockStore_GetDriverSession_Call {
+func (_c *MockStore_GetDriverSession_Call) RunAndReturn(run func(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)) *MockStore_GetDriverSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -332,3 +362,208 @@ func (_c *MockStore_GetDriverSessionsByTimeRange_Call) RunAndReturn(run func(ctx
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetDriverSettings provides a mock function for the type MockStore
+func (_mock *MockStore) GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error) {
+	ret := _mock.Called(ctx, driverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDriverSettings")
+	}
+
+	var r0 *store.DriverSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*store.DriverSettings, error)); ok {
+		return returnFunc(ctx, driverID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *store.DriverSettings); ok {
+		r0 = returnFunc(ctx, driverID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.DriverSettings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, driverID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetDriverSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDriverSettings'
+type MockStore_GetDriverSettings_Call struct {
+	*mock.Call
+}
+
+// GetDriverSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+func (_e *MockStore_Expecter) GetDriverSettings(ctx interface{}, driverID interface{}) *MockStore_GetDriverSettings_Call {
+	return &MockStore_GetDriverSettings_Call{Call: _e.mock.On("GetDriverSettings", ctx, driverID)}
+}
+
+func (_c *MockStore_GetDriverSettings_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_GetDriverSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDriverSettings_Call) Return(driverSettings *store.DriverSettings, err error) *MockStore_GetDriverSettings_Call {
+	_c.Call.Return(driverSettings, err)
+	return _c
+}
+
+func (_c *MockStore_GetDriverSettings_Call) RunAndReturn(run func(ctx context.Context, driverID int64) (*store.DriverSettings, error)) *MockStore_GetDriverSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDriverStandings provides a mock function for the type MockStore
+func (_mock *MockStore) GetDriverStandings(ctx context.Context, driverID int64, from time.Time, to time.Time) ([]store.DriverStanding, error) {
+	ret := _mock.Called(ctx, driverID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDriverStandings")
+	}
+
+	var r0 []store.DriverStanding
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) ([]store.DriverStanding, error)); ok {
+		return returnFunc(ctx, driverID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []store.DriverStanding); ok {
+		r0 = returnFunc(ctx, driverID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.DriverStanding)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, driverID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetDriverStandings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDriverStandings'
+type MockStore_GetDriverStandings_Call struct {
+	*mock.Call
+}
+
+// GetDriverStandings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - from time.Time
+//   - to time.Time
+func (_e *MockStore_Expecter) GetDriverStandings(ctx interface{}, driverID interface{}, from interface{}, to interface{}) *MockStore_GetDriverStandings_Call {
+	return &MockStore_GetDriverStandings_Call{Call: _e.mock.On("GetDriverStandings", ctx, driverID, from, to)}
+}
+
+func (_c *MockStore_GetDriverStandings_Call) Run(run func(ctx context.Context, driverID int64, from time.Time, to time.Time)) *MockStore_GetDriverStandings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDriverStandings_Call) Return(driverStandings []store.DriverStanding, err error) *MockStore_GetDriverStandings_Call {
+	_c.Call.Return(driverStandings, err)
+	return _c
+}
+
+func (_c *MockStore_GetDriverStandings_Call) RunAndReturn(run func(ctx context.Context, driverID int64, from time.Time, to time.Time) ([]store.DriverStanding, error)) *MockStore_GetDriverStandings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDriverSettings provides a mock function for the type MockStore
+func (_mock *MockStore) SaveDriverSettings(ctx context.Context, settings store.DriverSettings) error {
+	ret := _mock.Called(ctx, settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDriverSettings")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.DriverSettings) error); ok {
+		r0 = returnFunc(ctx, settings)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_SaveDriverSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDriverSettings'
+type MockStore_SaveDriverSettings_Call struct {
+	*mock.Call
+}
+
+// SaveDriverSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settings store.DriverSettings
+func (_e *MockStore_Expecter) SaveDriverSettings(ctx interface{}, settings interface{}) *MockStore_SaveDriverSettings_Call {
+	return &MockStore_SaveDriverSettings_Call{Call: _e.mock.On("SaveDriverSettings", ctx, settings)}
+}
+
+func (_c *MockStore_SaveDriverSettings_Call) Run(run func(ctx context.Context, settings store.DriverSettings)) *MockStore_SaveDriverSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.DriverSettings
+		if args[1] != nil {
+			arg1 = args[1].(store.DriverSettings)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_SaveDriverSettings_Call) Return(err error) *MockStore_SaveDriverSettings_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_SaveDriverSettings_Call) RunAndReturn(run func(ctx context.Context, settings store.DriverSettings) error) *MockStore_SaveDriverSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
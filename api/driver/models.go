@@ -1,16 +1,28 @@
 package driver
 
 import (
+	"strings"
 	"time"
 
+	"github.com/jonsabados/saturdaysspinout/alert"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/export"
+	"github.com/jonsabados/saturdaysspinout/iracing"
 	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/lapevents"
+	"github.com/jonsabados/saturdaysspinout/onboarding"
+	"github.com/jonsabados/saturdaysspinout/quota"
 	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/voicememo"
 )
 
 type DriverInfo struct {
 	DriverID              int64      `json:"driverId"`
 	DriverName            string     `json:"driverName"`
 	MemberSince           time.Time  `json:"memberSince"`
+	ClubID                int        `json:"clubId"` // zero until known, see clubName
+	ClubName              string     `json:"clubName"`
+	RacesIngestedFrom     *time.Time `json:"racesIngestedFrom"`
 	RacesIngestedTo       *time.Time `json:"racesIngestedTo"`
 	IngestionBlockedUntil *time.Time `json:"ingestionBlockedUntil"`
 	FirstLogin            time.Time  `json:"firstLogin"`
@@ -24,6 +36,8 @@ func driverInfoFromDriver(driver store.Driver) DriverInfo {
 		DriverID:     driver.DriverID,
 		DriverName:   driver.DriverName,
 		MemberSince:  driver.MemberSince.UTC(),
+		ClubID:       driver.ClubID,
+		ClubName:     driver.ClubName,
 		FirstLogin:   driver.FirstLogin.UTC(),
 		LastLogin:    driver.LastLogin.UTC(),
 		LoginCount:   driver.LoginCount,
@@ -32,6 +46,12 @@ func driverInfoFromDriver(driver store.Driver) DriverInfo {
 	if driver.RacesIngestedTo != nil {
 		t := driver.RacesIngestedTo.UTC()
 		info.RacesIngestedTo = &t
+		// drivers ingested before history was walked backwards have everything since they joined
+		from := driver.MemberSince.UTC()
+		if driver.RacesIngestedFrom != nil {
+			from = driver.RacesIngestedFrom.UTC()
+		}
+		info.RacesIngestedFrom = &from
 	}
 	if driver.IngestionBlockedUntil != nil {
 		t := driver.IngestionBlockedUntil.UTC()
@@ -40,6 +60,33 @@ func driverInfoFromDriver(driver store.Driver) DriverInfo {
 	return info
 }
 
+type OnboardingStep struct {
+	Step     string `json:"step"`
+	Complete bool   `json:"complete"`
+}
+
+type Onboarding struct {
+	CurrentStep string           `json:"currentStep"`
+	Complete    bool             `json:"complete"`
+	Steps       []OnboardingStep `json:"steps"`
+}
+
+func onboardingFromDriver(driver store.Driver) Onboarding {
+	current := onboarding.CurrentStep(driver)
+	steps := make([]OnboardingStep, len(onboarding.Steps))
+	for i, step := range onboarding.Steps {
+		steps[i] = OnboardingStep{
+			Step:     string(step),
+			Complete: onboarding.IsReached(current, step),
+		}
+	}
+	return Onboarding{
+		CurrentStep: string(current),
+		Complete:    onboarding.IsComplete(current),
+		Steps:       steps,
+	}
+}
+
 type Race struct {
 	ID                    int64     `json:"id"`
 	SubsessionID          int64     `json:"subsessionId"`
@@ -62,6 +109,13 @@ type Race struct {
 	OldSubLevel           int       `json:"oldSubLevel"`
 	NewSubLevel           int       `json:"newSubLevel"`
 	ReasonOut             string    `json:"reasonOut"`
+	ReasonOutCode         string    `json:"reasonOutCode"` // finished, dnf, disqualified, disconnected or unknown
+	CornersPerLap         int       `json:"cornersPerLap"` // zero for races ingested before this was recorded
+	LapsComplete          int       `json:"lapsComplete"`
+	LapsLead              int       `json:"lapsLead"`
+	TrafficCost           *int      `json:"trafficCost,omitempty"` // 10ths of milliseconds lost to faster-class traffic, multiclass races only
+	SquadEvents           []string  `json:"squadEvents,omitempty"` // IDs of the driver's squads that tagged the race as an event
+	OfficialResultsURL    string    `json:"officialResultsUrl"`    // the race's results on iRacing, for cross-checking
 }
 
 func raceFromDriverSession(session store.DriverSession) Race {
@@ -87,9 +141,30 @@ func raceFromDriverSession(session store.DriverSession) Race {
 		OldSubLevel:           session.OldSubLevel,
 		NewSubLevel:           session.NewSubLevel,
 		ReasonOut:             session.ReasonOut,
+		ReasonOutCode:         string(session.Outcome()),
+		CornersPerLap:         session.CornersPerLap,
+		LapsComplete:          session.LapsComplete,
+		LapsLead:              session.LapsLead,
+		TrafficCost:           session.TrafficCost,
+		SquadEvents:           session.SquadEvents,
+		OfficialResultsURL:    iracing.ResultsURL(session.SubsessionID),
 	}
 }
 
+// RaceDetail is the response for a single race, along with the driver's open action items for its track so that
+// things they meant to work on there come back up, and the moments they bookmarked in the race's replay.
+type RaceDetail struct {
+	Race
+	// Positions is the driver's 0-based overall position at the end of each lap, indexed by lap number with lap 0 being
+	// the starting grid. Only recorded for races lap data was pulled for.
+	Positions []int `json:"positions,omitempty"`
+	// LapGaps is the number of laps missing from the driver's lap data for the race, synthetic placeholders may stand
+	// in for them in lap responses
+	LapGaps            int          `json:"lapGaps"`
+	RelatedActionItems []ActionItem `json:"relatedActionItems"`
+	Bookmarks          []Bookmark   `json:"bookmarks"`
+}
+
 // SaveJournalEntryRequest is the request body for creating/updating a journal entry.
 type SaveJournalEntryRequest struct {
 	Notes       string   `json:"notes"`
@@ -105,7 +180,10 @@ type JournalEntry struct {
 	Notes       string    `json:"notes"`
 	Tags        []string  `json:"tags"`
 	ReplayVideo string    `json:"replayVideo,omitempty"`
+	Transcripts []string  `json:"transcripts,omitempty"`
 	Race        *Race     `json:"race,omitempty"`
+	// VideoLinks are the videos attached to the entry, only included when fetching a single entry
+	VideoLinks []VideoLink `json:"videoLinks,omitempty"`
 }
 
 func journalEntryFromStore(entry store.RaceJournalEntry, session *store.DriverSession) JournalEntry {
@@ -135,6 +213,7 @@ func journalEntryFromServiceEntry(entry journal.Entry) JournalEntry {
 		Notes:       entry.Notes,
 		Tags:        entry.Tags,
 		ReplayVideo: entry.ReplayVideo,
+		Transcripts: entry.Transcripts,
 	}
 	if result.Tags == nil {
 		result.Tags = []string{}
@@ -146,6 +225,512 @@ func journalEntryFromServiceEntry(entry journal.Entry) JournalEntry {
 	return result
 }
 
+// SaveJournalDraftRequest is the request body for autosaving a journal draft. Omitted fields keep their current value.
+type SaveJournalDraftRequest struct {
+	Notes       *string  `json:"notes"`
+	Tags        []string `json:"tags"`
+	ReplayVideo *string  `json:"replayVideo"`
+}
+
+// JournalDraft is the API response model for an unpublished journal entry.
+type JournalDraft struct {
+	RaceID      int64     `json:"raceId"`
+	SavedAt     time.Time `json:"savedAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	Notes       string    `json:"notes"`
+	Tags        []string  `json:"tags"`
+	ReplayVideo string    `json:"replayVideo,omitempty"`
+}
+
+func journalDraftFromServiceDraft(draft journal.Draft) JournalDraft {
+	result := JournalDraft{
+		RaceID:      draft.RaceID,
+		SavedAt:     draft.SavedAt.UTC(),
+		ExpiresAt:   draft.ExpiresAt.UTC(),
+		Notes:       draft.Notes,
+		Tags:        draft.Tags,
+		ReplayVideo: draft.ReplayVideo,
+	}
+	if result.Tags == nil {
+		result.Tags = []string{}
+	}
+	return result
+}
+
+// JournalPrompt is a recently run race waiting on a journal entry.
+type JournalPrompt struct {
+	RaceID         int64           `json:"raceId"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	Race           *Race           `json:"race,omitempty"`
+	PlanEvaluation *PlanEvaluation `json:"planEvaluation,omitempty"` // how the race went against the driver's plan for it
+}
+
+// JournalPromptsResponse is the response for the journal prompts endpoint, newest race first.
+type JournalPromptsResponse struct {
+	Prompts []JournalPrompt `json:"prompts"`
+}
+
+func journalPromptsResponseFromService(prompts []journal.Prompt) JournalPromptsResponse {
+	items := make([]JournalPrompt, len(prompts))
+	for i, prompt := range prompts {
+		items[i] = JournalPrompt{
+			RaceID:    prompt.RaceID,
+			CreatedAt: prompt.CreatedAt.UTC(),
+		}
+		if prompt.Race != nil {
+			race := raceFromDriverSession(*prompt.Race)
+			items[i].Race = &race
+		}
+		if prompt.PlanEvaluation != nil {
+			evaluation := planEvaluationFromStore(*prompt.PlanEvaluation)
+			items[i].PlanEvaluation = &evaluation
+		}
+	}
+	return JournalPromptsResponse{Prompts: items}
+}
+
+// JournalStats summarizes a driver's journaling. Streaks count consecutive race weeks with at least one journal entry.
+type JournalStats struct {
+	CurrentStreakWeeks int  `json:"currentStreakWeeks"`
+	LongestStreakWeeks int  `json:"longestStreakWeeks"`
+	JournaledThisWeek  bool `json:"journaledThisWeek"`
+}
+
+func journalStatsFromService(stats journal.Stats) JournalStats {
+	return JournalStats{
+		CurrentStreakWeeks: stats.CurrentStreakWeeks,
+		LongestStreakWeeks: stats.LongestStreakWeeks,
+		JournaledThisWeek:  stats.JournaledThisWeek,
+	}
+}
+
+// CreateJournalAttachmentRequest is the request body for starting a voice memo upload.
+type CreateJournalAttachmentRequest struct {
+	ContentType string `json:"contentType"`
+	SizeBytes   int64  `json:"sizeBytes"`
+	Transcribe  bool   `json:"transcribe"`
+}
+
+// JournalAttachment is the API response model for a voice memo attached to a journal entry.
+type JournalAttachment struct {
+	AttachmentID string    `json:"attachmentId"`
+	ContentType  string    `json:"contentType"`
+	CreatedAt    time.Time `json:"createdAt"`
+	Status       string    `json:"status"`
+	Transcribe   bool      `json:"transcribe"`
+	DownloadURL  string    `json:"downloadUrl,omitempty"`
+}
+
+// JournalAttachmentUpload is the response to starting a voice memo upload. The audio is sent to UploadURL with an
+// HTTP PUT including UploadHeaders.
+type JournalAttachmentUpload struct {
+	Attachment    JournalAttachment `json:"attachment"`
+	UploadURL     string            `json:"uploadUrl"`
+	UploadHeaders map[string]string `json:"uploadHeaders"`
+	ExpiresAt     time.Time         `json:"expiresAt"`
+}
+
+func journalAttachmentFromService(attachment voicememo.Attachment) JournalAttachment {
+	return JournalAttachment{
+		AttachmentID: attachment.AttachmentID,
+		ContentType:  attachment.ContentType,
+		CreatedAt:    attachment.CreatedAt.UTC(),
+		Status:       string(attachment.Status),
+		Transcribe:   attachment.Transcribe,
+		DownloadURL:  attachment.DownloadURL,
+	}
+}
+
+func journalAttachmentUploadFromService(upload voicememo.Upload) JournalAttachmentUpload {
+	return JournalAttachmentUpload{
+		Attachment:    journalAttachmentFromService(upload.Attachment),
+		UploadURL:     upload.URL,
+		UploadHeaders: upload.Headers,
+		ExpiresAt:     upload.ExpiresAt.UTC(),
+	}
+}
+
+// ActionItem is the API model for something the driver wants to work on.
+type ActionItem struct {
+	ItemID      string     `json:"itemId"`
+	Title       string     `json:"title"`
+	Status      string     `json:"status"`
+	RaceID      int64      `json:"raceId,omitempty"`  // omitted for free-standing items
+	TrackID     int64      `json:"trackId,omitempty"` // omitted when not tied to a track
+	DueDate     *time.Time `json:"dueDate,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+func actionItemFromStore(item store.ActionItem) ActionItem {
+	return ActionItem{
+		ItemID:      item.ItemID,
+		Title:       item.Title,
+		Status:      string(item.Status),
+		RaceID:      item.RaceID,
+		TrackID:     item.TrackID,
+		DueDate:     utcTimePtr(item.DueDate),
+		CreatedAt:   item.CreatedAt.UTC(),
+		UpdatedAt:   item.UpdatedAt.UTC(),
+		CompletedAt: utcTimePtr(item.CompletedAt),
+	}
+}
+
+func actionItemsFromStore(items []store.ActionItem) []ActionItem {
+	ret := make([]ActionItem, len(items))
+	for i, item := range items {
+		ret[i] = actionItemFromStore(item)
+	}
+	return ret
+}
+
+func utcTimePtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+// CreateActionItemRequest is the request body for creating an action item. DueDate is ISO8601 when set, and RaceID
+// ties the item to a race and its track.
+type CreateActionItemRequest struct {
+	Title   string `json:"title"`
+	RaceID  int64  `json:"raceId"`
+	TrackID int64  `json:"trackId"`
+	DueDate string `json:"dueDate"`
+}
+
+// UpdateActionItemRequest is the request body for updating an action item. Omitted fields keep their current value, an
+// empty DueDate removes the due date.
+type UpdateActionItemRequest struct {
+	Title   *string `json:"title"`
+	Status  *string `json:"status"`
+	DueDate *string `json:"dueDate"`
+}
+
+// ActionItemsResponse is the response for the action item listing, soonest due first.
+type ActionItemsResponse struct {
+	Items []ActionItem `json:"items"`
+}
+
+// AlertRule is the API model for a trend the driver wants to be alerted about. Exactly one of WindowRaces and
+// WindowDays is set. LastTriggeredAt and LastValue are omitted until the rule first triggers.
+type AlertRule struct {
+	RuleID          string     `json:"ruleId"`
+	Name            string     `json:"name"`
+	Metric          string     `json:"metric"`
+	Aggregate       string     `json:"aggregate"`
+	WindowRaces     int        `json:"windowRaces,omitempty"`
+	WindowDays      int        `json:"windowDays,omitempty"`
+	Comparison      string     `json:"comparison"`
+	Threshold       float64    `json:"threshold"`
+	Enabled         bool       `json:"enabled"`
+	Triggered       bool       `json:"triggered"`
+	LastTriggeredAt *time.Time `json:"lastTriggeredAt,omitempty"`
+	LastValue       *float64   `json:"lastValue,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+func alertRuleFromStore(rule store.AlertRule) AlertRule {
+	ret := AlertRule{
+		RuleID:          rule.RuleID,
+		Name:            rule.Name,
+		Metric:          string(rule.Metric),
+		Aggregate:       string(rule.Aggregate),
+		WindowRaces:     rule.WindowRaces,
+		WindowDays:      rule.WindowDays,
+		Comparison:      string(rule.Comparison),
+		Threshold:       rule.Threshold,
+		Enabled:         rule.Enabled,
+		Triggered:       rule.Triggered,
+		LastTriggeredAt: utcTimePtr(rule.LastTriggeredAt),
+		CreatedAt:       rule.CreatedAt.UTC(),
+		UpdatedAt:       rule.UpdatedAt.UTC(),
+	}
+	if rule.LastTriggeredAt != nil {
+		lastValue := rule.LastValue
+		ret.LastValue = &lastValue
+	}
+	return ret
+}
+
+// AlertRuleRequest is the request body for creating or replacing an alert rule. Enabled defaults to true when omitted.
+type AlertRuleRequest struct {
+	Name        string  `json:"name"`
+	Metric      string  `json:"metric"`
+	Aggregate   string  `json:"aggregate"`
+	WindowRaces int     `json:"windowRaces"`
+	WindowDays  int     `json:"windowDays"`
+	Comparison  string  `json:"comparison"`
+	Threshold   float64 `json:"threshold"`
+	Enabled     *bool   `json:"enabled"`
+}
+
+func alertDefinitionFromRequest(r AlertRuleRequest) alert.Definition {
+	enabled := true
+	if r.Enabled != nil {
+		enabled = *r.Enabled
+	}
+	return alert.Definition{
+		Name:        r.Name,
+		Metric:      store.AlertMetric(r.Metric),
+		Aggregate:   store.AlertAggregate(r.Aggregate),
+		WindowRaces: r.WindowRaces,
+		WindowDays:  r.WindowDays,
+		Comparison:  store.AlertComparison(r.Comparison),
+		Threshold:   r.Threshold,
+		Enabled:     enabled,
+	}
+}
+
+// AlertRulesResponse is the response for the alert rule listing, oldest first.
+type AlertRulesResponse struct {
+	Rules []AlertRule `json:"rules"`
+}
+
+// RacePlan is the API model for a driver's plan for racing a series at a track in a race week. RaceID, SubsessionID
+// and LinkedAt are omitted until a race run for the plan is ingested, after which RaceID leads to the race and its
+// journal entry.
+type RacePlan struct {
+	RaceWeek        string          `json:"raceWeek"`
+	SeriesID        int64           `json:"seriesId"`
+	TrackID         int64           `json:"trackId"`
+	Goals           string          `json:"goals"`
+	SetupIntentions string          `json:"setupIntentions"`
+	RaceID          int64           `json:"raceId,omitempty"`
+	SubsessionID    int64           `json:"subsessionId,omitempty"`
+	LinkedAt        *time.Time      `json:"linkedAt,omitempty"`
+	Evaluation      *PlanEvaluation `json:"evaluation,omitempty"` // absent until linked, and when the goals name nothing measurable
+	CreatedAt       time.Time       `json:"createdAt"`
+	UpdatedAt       time.Time       `json:"updatedAt"`
+}
+
+func racePlanFromStore(plan store.RacePlan) RacePlan {
+	ret := RacePlan{
+		RaceWeek:        plan.WeekStart.UTC().Format(time.DateOnly),
+		SeriesID:        plan.SeriesID,
+		TrackID:         plan.TrackID,
+		Goals:           plan.Goals,
+		SetupIntentions: plan.SetupIntentions,
+		RaceID:          plan.RaceID,
+		SubsessionID:    plan.SubsessionID,
+		CreatedAt:       plan.CreatedAt.UTC(),
+		UpdatedAt:       plan.UpdatedAt.UTC(),
+	}
+	if plan.RaceID != 0 {
+		linkedAt := plan.LinkedAt.UTC()
+		ret.LinkedAt = &linkedAt
+	}
+	if plan.Evaluation != nil {
+		evaluation := planEvaluationFromStore(*plan.Evaluation)
+		ret.Evaluation = &evaluation
+	}
+	return ret
+}
+
+// PlanEvaluation is how a race went against the targets picked out of the goals of the driver's plan for it.
+type PlanEvaluation struct {
+	Targets     []PlanTarget `json:"targets"`
+	Met         int          `json:"met"` // how many of the targets the race met
+	EvaluatedAt time.Time    `json:"evaluatedAt"`
+}
+
+// PlanTarget is a single target from a plan's goals. Finish position targets are the worst in-class finish, 1-based,
+// that meets them, incident targets the most incidents that do.
+type PlanTarget struct {
+	Kind   string `json:"kind"` // finish_position or incidents
+	Target int    `json:"target"`
+	Actual int    `json:"actual"`
+	Met    bool   `json:"met"`
+}
+
+func planEvaluationFromStore(evaluation store.PlanEvaluation) PlanEvaluation {
+	ret := PlanEvaluation{
+		Targets:     make([]PlanTarget, len(evaluation.Targets)),
+		EvaluatedAt: evaluation.EvaluatedAt.UTC(),
+	}
+	for i, target := range evaluation.Targets {
+		ret.Targets[i] = PlanTarget{
+			Kind:   string(target.Kind),
+			Target: target.Target,
+			Actual: target.Actual,
+			Met:    target.Met,
+		}
+		if target.Met {
+			ret.Met++
+		}
+	}
+	return ret
+}
+
+// RacePlanRequest is the request body for saving a race plan.
+type RacePlanRequest struct {
+	Goals           string `json:"goals"`
+	SetupIntentions string `json:"setupIntentions"`
+}
+
+// RacePlansResponse is the response for the race plan listing, most recent race week first.
+type RacePlansResponse struct {
+	Plans []RacePlan `json:"plans"`
+}
+
+// Bookmark is the API model for a moment the driver marked in a race's replay.
+type Bookmark struct {
+	BookmarkID   string    `json:"bookmarkId"`
+	Lap          int       `json:"lap"`
+	Note         string    `json:"note"`
+	ReplayTimeMs int64     `json:"replayTimeMs"` // offset into the iRacing replay
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+func bookmarkFromStore(bookmark store.RaceBookmark) Bookmark {
+	return Bookmark{
+		BookmarkID:   bookmark.BookmarkID,
+		Lap:          bookmark.Lap,
+		Note:         bookmark.Note,
+		ReplayTimeMs: bookmark.ReplayTime.Milliseconds(),
+		CreatedAt:    bookmark.CreatedAt.UTC(),
+		UpdatedAt:    bookmark.UpdatedAt.UTC(),
+	}
+}
+
+func bookmarksFromStore(bookmarks []store.RaceBookmark) []Bookmark {
+	ret := make([]Bookmark, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		ret[i] = bookmarkFromStore(bookmark)
+	}
+	return ret
+}
+
+// CreateBookmarkRequest is the request body for bookmarking a moment in a race's replay.
+type CreateBookmarkRequest struct {
+	Lap          int    `json:"lap"`
+	Note         string `json:"note"`
+	ReplayTimeMs int64  `json:"replayTimeMs"`
+}
+
+// UpdateBookmarkRequest is the request body for updating a bookmark. Omitted fields keep their current value.
+type UpdateBookmarkRequest struct {
+	Lap          *int    `json:"lap"`
+	Note         *string `json:"note"`
+	ReplayTimeMs *int64  `json:"replayTimeMs"`
+}
+
+// BookmarksResponse is the response for a race's bookmarks, in replay order.
+type BookmarksResponse struct {
+	Bookmarks []Bookmark `json:"bookmarks"`
+}
+
+// VideoLink is the API model for an external video attached to a race's journal entry or one of its laps.
+type VideoLink struct {
+	LinkID       string    `json:"linkId"`
+	Lap          *int      `json:"lap,omitempty"` // omitted when attached to the journal entry
+	URL          string    `json:"url"`
+	Provider     string    `json:"provider"`
+	Title        string    `json:"title,omitempty"`
+	AuthorName   string    `json:"authorName,omitempty"`
+	ThumbnailURL string    `json:"thumbnailUrl,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+func videoLinkFromStore(link store.VideoLink) VideoLink {
+	return VideoLink{
+		LinkID:       link.LinkID,
+		Lap:          link.Lap,
+		URL:          link.URL,
+		Provider:     link.Provider,
+		Title:        link.Title,
+		AuthorName:   link.AuthorName,
+		ThumbnailURL: link.ThumbnailURL,
+		CreatedAt:    link.CreatedAt.UTC(),
+	}
+}
+
+func videoLinksFromStore(links []store.VideoLink) []VideoLink {
+	ret := make([]VideoLink, len(links))
+	for i, link := range links {
+		ret[i] = videoLinkFromStore(link)
+	}
+	return ret
+}
+
+// CreateVideoLinkRequest is the request body for attaching a video to a race. Lap is omitted to attach the video to
+// the race's journal entry.
+type CreateVideoLinkRequest struct {
+	URL string `json:"url"`
+	Lap *int   `json:"lap"`
+}
+
+// VideoLinksResponse is the response for the videos attached to a race, journal entry videos first and then by lap.
+type VideoLinksResponse struct {
+	VideoLinks []VideoLink `json:"videoLinks"`
+}
+
+// ExternalLapImportResponse is the response for importing laps recorded with a third party lap time service.
+type ExternalLapImportResponse struct {
+	Source   string `json:"source"`
+	Sessions int    `json:"sessions"`
+	Laps     int    `json:"laps"`
+}
+
+// RaceTelemetry is the stint summary imported from a telemetry export for a race.
+type RaceTelemetry struct {
+	RaceID     int64            `json:"raceId"`
+	ImportedAt time.Time        `json:"importedAt"`
+	Stints     []TelemetryStint `json:"stints"`
+}
+
+// TelemetryStint summarizes the laps between two pit stops. FuelPerLap is in liters and omitted when the export had
+// no fuel data.
+type TelemetryStint struct {
+	Stint      int           `json:"stint"`
+	StartLap   int           `json:"startLap"`
+	EndLap     int           `json:"endLap"`
+	Laps       int           `json:"laps"`
+	FuelPerLap float64       `json:"fuelPerLap,omitempty"`
+	Tires      []TireSummary `json:"tires"`
+}
+
+// TireSummary is how one tire behaved over a stint, values the export didn't include are omitted.
+type TireSummary struct {
+	Corner           string  `json:"corner"`
+	AvgTempC         float64 `json:"avgTempC,omitempty"`
+	StartPressureKPa float64 `json:"startPressureKpa,omitempty"`
+	EndPressureKPa   float64 `json:"endPressureKpa,omitempty"`
+}
+
+func raceTelemetryFromStore(telemetry store.RaceTelemetry) RaceTelemetry {
+	stints := make([]TelemetryStint, len(telemetry.Stints))
+	for i, stint := range telemetry.Stints {
+		tires := make([]TireSummary, len(stint.Tires))
+		for j, tire := range stint.Tires {
+			tires[j] = TireSummary{
+				Corner:           string(tire.Corner),
+				AvgTempC:         tire.AvgTemp,
+				StartPressureKPa: tire.StartPressure,
+				EndPressureKPa:   tire.EndPressure,
+			}
+		}
+		stints[i] = TelemetryStint{
+			Stint:      stint.Stint,
+			StartLap:   stint.StartLap,
+			EndLap:     stint.EndLap,
+			Laps:       stint.Laps,
+			FuelPerLap: stint.FuelPerLap,
+			Tires:      tires,
+		}
+	}
+	return RaceTelemetry{
+		RaceID:     telemetry.RaceID,
+		ImportedAt: telemetry.ImportedAt,
+		Stints:     stints,
+	}
+}
+
 // AnalyticsSummary contains aggregated statistics for a set of races.
 type AnalyticsSummary struct {
 	RaceCount int `json:"raceCount"`
@@ -175,6 +760,23 @@ type AnalyticsSummary struct {
 	// Incidents
 	TotalIncidents int     `json:"totalIncidents"`
 	AvgIncidents   float64 `json:"avgIncidents"`
+
+	// Corners - races ingested before corner data was recorded don't contribute
+	TotalCorners       int     `json:"totalCorners"`
+	CornersPerIncident float64 `json:"cornersPerIncident"` // incident free races count as a single incident
+
+	// Traffic (10ths of milliseconds), only multiclass races with a traffic estimate count
+	TrafficRaceCount int     `json:"trafficRaceCount"`
+	TotalTrafficCost int     `json:"totalTrafficCost"`
+	AvgTrafficCost   float64 `json:"avgTrafficCost"`
+
+	// Outcomes, rates are fractions of raceCount
+	DNFs           int     `json:"dnfs"`
+	DNFRate        float64 `json:"dnfRate"`
+	DQs            int     `json:"dqs"`
+	DQRate         float64 `json:"dqRate"`
+	Disconnects    int     `json:"disconnects"`
+	DisconnectRate float64 `json:"disconnectRate"`
 }
 
 // AnalyticsGroup represents aggregated stats for a specific dimension combination.
@@ -194,11 +796,326 @@ type AnalyticsPeriod struct {
 	Summary AnalyticsSummary `json:"summary"`
 }
 
+// AnalyticsCPIContribution is the CPI movement attributed to a single series or track.
+type AnalyticsCPIContribution struct {
+	SeriesID *int64 `json:"seriesId,omitempty"`
+	TrackID  *int64 `json:"trackId,omitempty"`
+
+	RaceCount        int     `json:"raceCount"`
+	CPIGain          float64 `json:"cpiGain"`
+	CPILoss          float64 `json:"cpiLoss"`
+	CPIDelta         float64 `json:"cpiDelta"`
+	Corners          int     `json:"corners"`
+	Incidents        int     `json:"incidents"`
+	CPILossPerCorner float64 `json:"cpiLossPerCorner"` // zero when no races have corner data
+}
+
+// AnalyticsCPIBreakdown decomposes CPI movement by series and track.
+type AnalyticsCPIBreakdown struct {
+	BySeries []AnalyticsCPIContribution `json:"bySeries"` // biggest net CPI loss first
+	ByTrack  []AnalyticsCPIContribution `json:"byTrack"`  // most CPI lost per corner first
+}
+
 // AnalyticsResponse is the response for the analytics endpoint.
 type AnalyticsResponse struct {
-	Summary    AnalyticsSummary  `json:"summary"`
-	GroupedBy  []AnalyticsGroup  `json:"groupedBy,omitempty"`  // if groupBy specified
-	TimeSeries []AnalyticsPeriod `json:"timeSeries,omitempty"` // if granularity specified
+	Summary      AnalyticsSummary       `json:"summary"`
+	GroupedBy    []AnalyticsGroup       `json:"groupedBy,omitempty"`    // if groupBy specified
+	TimeSeries   []AnalyticsPeriod      `json:"timeSeries,omitempty"`   // if granularity specified
+	CPIBreakdown *AnalyticsCPIBreakdown `json:"cpiBreakdown,omitempty"` // if any races in range
+	TextSummary  string                 `json:"textSummary,omitempty"`  // if textSummary requested
+}
+
+// PredictionResponse is the response for the race prediction endpoint.
+// Positions are 0-based, consistent with AnalyticsSummary.
+type PredictionResponse struct {
+	SampleSize          int     `json:"sampleSize"`
+	SOFMatched          bool    `json:"sofMatched"`
+	ExpectedFinish      float64 `json:"expectedFinish"`
+	FinishRangeLow      int     `json:"finishRangeLow"`
+	FinishRangeHigh     int     `json:"finishRangeHigh"`
+	IncidentProbability float64 `json:"incidentProbability"`
+	AvgIncidents        float64 `json:"avgIncidents"`
+}
+
+// RegionStatsResponse are per race averages for one side of a region comparison.
+type RegionStatsResponse struct {
+	Races             int     `json:"races"`
+	WinRate           float64 `json:"winRate"`
+	Top5Rate          float64 `json:"top5Rate"`
+	AvgIncidents      float64 `json:"avgIncidents"`
+	AvgIRatingChange  float64 `json:"avgIRatingChange"`
+	AvgSubLevelChange float64 `json:"avgSubLevelChange"`
+}
+
+func regionStatsResponseFromStats(stats analytics.RegionStats) RegionStatsResponse {
+	return RegionStatsResponse{
+		Races:             stats.Races,
+		WinRate:           stats.WinRate,
+		Top5Rate:          stats.Top5Rate,
+		AvgIncidents:      stats.AvgIncidents,
+		AvgIRatingChange:  stats.AvgIRatingChange,
+		AvgSubLevelChange: stats.AvgSubLevelChange,
+	}
+}
+
+// RegionComparisonResponse is the response for the region comparison endpoint. Region is null when the driver's club
+// isn't known yet or too few drivers from it raced in the range.
+type RegionComparisonResponse struct {
+	ClubID   int                  `json:"clubId"`
+	ClubName string               `json:"clubName"`
+	Weeks    int                  `json:"weeks"`
+	Driver   RegionStatsResponse  `json:"driver"`
+	Region   *RegionStatsResponse `json:"region"`
+}
+
+// SuggestedSeries is an entry level series suggested for getting into an underused category.
+type SuggestedSeries struct {
+	SeriesID   int    `json:"seriesId"`
+	SeriesName string `json:"seriesName"`
+	LogoURL    string `json:"logoUrl"`
+}
+
+// CategoryUsageResponse is the driver's racing in one category family.
+type CategoryUsageResponse struct {
+	Category    string            `json:"category"`
+	Races       int               `json:"races"`
+	Share       float64           `json:"share"`
+	Underused   bool              `json:"underused"`
+	Suggestions []SuggestedSeries `json:"suggestions"`
+}
+
+// CategoryProfileResponse is the response for the category profile endpoint.
+type CategoryProfileResponse struct {
+	Categories   []CategoryUsageResponse `json:"categories"`
+	Unclassified int                     `json:"unclassified"`
+}
+
+func categoryProfileResponseFromProfile(profile analytics.CategoryProfile) CategoryProfileResponse {
+	resp := CategoryProfileResponse{
+		Categories:   make([]CategoryUsageResponse, len(profile.Categories)),
+		Unclassified: profile.Unclassified,
+	}
+	for i, usage := range profile.Categories {
+		suggestions := make([]SuggestedSeries, len(usage.Suggestions))
+		for j, s := range usage.Suggestions {
+			suggestions[j] = SuggestedSeries{SeriesID: s.ID, SeriesName: s.Name, LogoURL: s.LogoURL}
+		}
+		resp.Categories[i] = CategoryUsageResponse{
+			Category:    usage.Category,
+			Races:       usage.Races,
+			Share:       usage.Share,
+			Underused:   usage.Underused,
+			Suggestions: suggestions,
+		}
+	}
+	return resp
+}
+
+// TimeStatsResponse are per race averages for the races run in a slice of the day or week.
+type TimeStatsResponse struct {
+	Races             int     `json:"races"`
+	AvgFinishPosition float64 `json:"avgFinishPosition"`
+	AvgIncidents      float64 `json:"avgIncidents"`
+	AvgIRatingChange  float64 `json:"avgIRatingChange"`
+}
+
+type TimeOfDayStatsResponse struct {
+	Band string `json:"band"`
+	TimeStatsResponse
+}
+
+type DayOfWeekStatsResponse struct {
+	Day string `json:"day"` // lowercase english day name
+	TimeStatsResponse
+}
+
+// TimeOfDayProfileResponse is the response for the time of day endpoint.
+type TimeOfDayProfileResponse struct {
+	Timezone   string                   `json:"timezone"`
+	Overall    TimeStatsResponse        `json:"overall"`
+	TimesOfDay []TimeOfDayStatsResponse `json:"timesOfDay"`
+	DaysOfWeek []DayOfWeekStatsResponse `json:"daysOfWeek"`
+}
+
+func timeStatsResponseFromStats(stats analytics.TimeStats) TimeStatsResponse {
+	return TimeStatsResponse{
+		Races:             stats.Races,
+		AvgFinishPosition: stats.AvgFinishPosition,
+		AvgIncidents:      stats.AvgIncidents,
+		AvgIRatingChange:  stats.AvgIRatingChange,
+	}
+}
+
+func timeOfDayProfileResponseFromProfile(profile analytics.TimeOfDayProfile) TimeOfDayProfileResponse {
+	resp := TimeOfDayProfileResponse{
+		Timezone:   profile.Timezone,
+		Overall:    timeStatsResponseFromStats(profile.Overall),
+		TimesOfDay: make([]TimeOfDayStatsResponse, len(profile.TimesOfDay)),
+		DaysOfWeek: make([]DayOfWeekStatsResponse, len(profile.DaysOfWeek)),
+	}
+	for i, band := range profile.TimesOfDay {
+		resp.TimesOfDay[i] = TimeOfDayStatsResponse{Band: band.Band, TimeStatsResponse: timeStatsResponseFromStats(band.Stats)}
+	}
+	for i, day := range profile.DaysOfWeek {
+		resp.DaysOfWeek[i] = DayOfWeekStatsResponse{Day: strings.ToLower(day.Day.String()), TimeStatsResponse: timeStatsResponseFromStats(day.Stats)}
+	}
+	return resp
+}
+
+// SRRecoveryScenario is getting back to the target SR by racing a single series. Sub levels are SR times 100.
+type SRRecoveryScenario struct {
+	SeriesID               int64   `json:"seriesId"`
+	Races                  int     `json:"races"` // races the driver's rates in the series come from
+	CornersPerRace         float64 `json:"cornersPerRace"`
+	CornersPerIncident     float64 `json:"cornersPerIncident"`
+	SubLevelPerCleanRace   float64 `json:"subLevelPerCleanRace"`
+	CleanRacesNeeded       int     `json:"cleanRacesNeeded"`
+	SubLevelPerTypicalRace float64 `json:"subLevelPerTypicalRace"`
+	TypicalRacesNeeded     *int    `json:"typicalRacesNeeded"` // null when racing as usual doesn't gain SR
+}
+
+// SRRecoveryResponse is the response for the SR recovery endpoint. Scenarios is empty when there weren't enough races
+// in the category to estimate the driver's SR rates from.
+type SRRecoveryResponse struct {
+	Category               string               `json:"category"`
+	LicenseLevel           int                  `json:"licenseLevel"`
+	CurrentSubLevel        int                  `json:"currentSubLevel"`
+	CurrentCPI             float64              `json:"currentCpi"`
+	TargetSubLevel         int                  `json:"targetSubLevel"`
+	SampleSize             int                  `json:"sampleSize"`
+	SubLevelPerCleanCorner float64              `json:"subLevelPerCleanCorner"`
+	SubLevelPerIncident    float64              `json:"subLevelPerIncident"`
+	Scenarios              []SRRecoveryScenario `json:"scenarios"`
+}
+
+func srRecoveryResponseFromPlan(plan analytics.SRRecoveryPlan) SRRecoveryResponse {
+	resp := SRRecoveryResponse{
+		Category:               analytics.LicenseCategories[plan.CategoryID],
+		LicenseLevel:           plan.LicenseLevel,
+		CurrentSubLevel:        plan.CurrentSubLevel,
+		CurrentCPI:             plan.CurrentCPI,
+		TargetSubLevel:         plan.TargetSubLevel,
+		SampleSize:             plan.SampleSize,
+		SubLevelPerCleanCorner: plan.SubLevelPerCleanCorner,
+		SubLevelPerIncident:    plan.SubLevelPerIncident,
+		Scenarios:              make([]SRRecoveryScenario, len(plan.Scenarios)),
+	}
+	for i, scenario := range plan.Scenarios {
+		resp.Scenarios[i] = SRRecoveryScenario{
+			SeriesID:               scenario.SeriesID,
+			Races:                  scenario.Races,
+			CornersPerRace:         scenario.CornersPerRace,
+			CornersPerIncident:     scenario.CornersPerIncident,
+			SubLevelPerCleanRace:   scenario.SubLevelPerCleanRace,
+			CleanRacesNeeded:       scenario.CleanRacesNeeded,
+			SubLevelPerTypicalRace: scenario.SubLevelPerTypicalRace,
+			TypicalRacesNeeded:     scenario.TypicalRacesNeeded,
+		}
+	}
+	return resp
+}
+
+// ChampionshipWeek is the driver's championship score for a single race week.
+type ChampionshipWeek struct {
+	RaceWeekNum int  `json:"raceWeekNum"` // 0-based, as reported by iRacing
+	RaceCount   int  `json:"raceCount"`
+	Points      int  `json:"points"`
+	Dropped     bool `json:"dropped"` // outside the driver's best weeks, doesn't count towards the total
+}
+
+// ChampionshipStanding is the driver's championship tally for a single series season.
+type ChampionshipStanding struct {
+	SeasonID      int64              `json:"seasonId"`
+	SeasonYear    int                `json:"seasonYear"`
+	SeasonQuarter int                `json:"seasonQuarter"`
+	SeriesID      int64              `json:"seriesId"`
+	SeriesName    string             `json:"seriesName"`
+	TotalPoints   int                `json:"totalPoints"`
+	WeeksRaced    int                `json:"weeksRaced"`
+	WeeksCounted  int                `json:"weeksCounted"`
+	Weeks         []ChampionshipWeek `json:"weeks"`
+}
+
+// ChampionshipResponse is the response for the championship endpoint, most recent season first.
+type ChampionshipResponse struct {
+	Standings []ChampionshipStanding `json:"standings"`
+}
+
+// DriverStanding is a weekly snapshot of the driver's position in their division's season standings.
+type DriverStanding struct {
+	WeekStart    time.Time `json:"weekStart"`
+	SnapshotAt   time.Time `json:"snapshotAt"`
+	SeasonID     int64     `json:"seasonId"`
+	SeriesID     int64     `json:"seriesId"`
+	SeriesName   string    `json:"seriesName"`
+	CarClassID   int64     `json:"carClassId"`
+	RaceWeekNum  int       `json:"raceWeekNum"`  // 0-based, as reported by iRacing
+	Division     int       `json:"division"`     // 0-based, as reported by iRacing
+	DivisionRank int       `json:"divisionRank"` // 1-based
+	DivisionSize int       `json:"divisionSize"`
+	Points       int       `json:"points"`
+	WeeksCounted int       `json:"weeksCounted"`
+	Starts       int       `json:"starts"`
+	Wins         int       `json:"wins"`
+}
+
+// StandingsResponse is the response for the standings endpoint, most recent week first.
+type StandingsResponse struct {
+	Standings []DriverStanding `json:"standings"`
+}
+
+// DriverSettings is the API model for a driver's preferences, used for both requests and responses.
+type DriverSettings struct {
+	// LapRetentionMonths is how long individual laps are kept before being compacted into per-race summaries, zero keeps them forever.
+	LapRetentionMonths int `json:"lapRetentionMonths"`
+	// SummaryOnlyIngestion skips lap data when ingesting races. Turning it back off backfills the skipped laps.
+	SummaryOnlyIngestion bool `json:"summaryOnlyIngestion"`
+	// LeaderboardOptIn lists the driver, by name, on the weekly leaderboards.
+	LeaderboardOptIn bool `json:"leaderboardOptIn"`
+	// BenchmarkOptIn anonymously adds the driver's races to the platform benchmarks, and lets them compare against them.
+	BenchmarkOptIn bool `json:"benchmarkOptIn"`
+	// Timezone is an IANA timezone name such as America/Chicago, empty for UTC.
+	Timezone string `json:"timezone"`
+	// Locale is the language alerts and recaps are written in, one of en, de, es, fr or pt-BR. Empty for en.
+	Locale string `json:"locale"`
+}
+
+func driverSettingsFromStore(settings store.DriverSettings) DriverSettings {
+	return DriverSettings{
+		LapRetentionMonths:   settings.LapRetentionMonths,
+		SummaryOnlyIngestion: settings.SummaryOnlyIngestion,
+		LeaderboardOptIn:     settings.LeaderboardOptIn,
+		BenchmarkOptIn:       settings.BenchmarkOptIn,
+		Timezone:             settings.Timezone,
+		Locale:               settings.Locale,
+	}
+}
+
+// QuotaResponse is a driver's usage of their daily quotas.
+type QuotaResponse struct {
+	ResetsAt   time.Time        `json:"resetsAt"`
+	Operations []QuotaOperation `json:"operations"`
+}
+
+type QuotaOperation struct {
+	Operation string `json:"operation"`
+	Used      int    `json:"used"`
+	// Limit is nil for operations the driver can run without limit.
+	Limit *int `json:"limit"`
+}
+
+func quotaResponseFromService(usage []quota.Usage, resetsAt time.Time) QuotaResponse {
+	operations := make([]QuotaOperation, len(usage))
+	for i, u := range usage {
+		operations[i] = QuotaOperation{
+			Operation: string(u.Operation),
+			Used:      u.Used,
+		}
+		if !u.Unlimited {
+			operations[i].Limit = &u.Limit
+		}
+	}
+	return QuotaResponse{ResetsAt: resetsAt, Operations: operations}
 }
 
 // DimensionsResponse is the response for the dimensions endpoint.
@@ -208,3 +1125,141 @@ type DimensionsResponse struct {
 	Cars   []int64 `json:"cars"`
 	Tracks []int64 `json:"tracks"`
 }
+
+// PresenceViewer is a driver the current driver shares their racing presence with.
+type PresenceViewer struct {
+	ViewerID   int64     `json:"viewerId"`
+	ViewerName string    `json:"viewerName"`
+	GrantedAt  time.Time `json:"grantedAt"`
+}
+
+// PresenceViewersResponse is the response for the presence viewers endpoint, ordered by viewer ID.
+type PresenceViewersResponse struct {
+	Viewers []PresenceViewer `json:"viewers"`
+}
+
+func presenceViewersResponseFromStore(grants []store.PresenceGrant) PresenceViewersResponse {
+	viewers := make([]PresenceViewer, len(grants))
+	for i, g := range grants {
+		viewers[i] = PresenceViewer{
+			ViewerID:   g.ViewerID,
+			ViewerName: g.ViewerName,
+			GrantedAt:  g.GrantedAt,
+		}
+	}
+	return PresenceViewersResponse{Viewers: viewers}
+}
+
+// RacingNowDriver is a driver sharing their presence with the current driver who has sent a racing heartbeat recently.
+// TrackID, CarID and SeriesName are omitted when the driver's client didn't report them.
+type RacingNowDriver struct {
+	DriverID   int64     `json:"driverId"`
+	DriverName string    `json:"driverName"`
+	TrackID    int64     `json:"trackId,omitempty"`
+	CarID      int64     `json:"carId,omitempty"`
+	SeriesName string    `json:"seriesName,omitempty"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// RacingNowResponse is the response for the racing now endpoint, ordered by driver ID.
+type RacingNowResponse struct {
+	Drivers []RacingNowDriver `json:"drivers"`
+}
+
+// ExportLap is one of the driver's laps in an export pack.
+type ExportLap struct {
+	LapNumber       int  `json:"lapNumber"`
+	LapTime         int  `json:"lapTime"` // iRacing 10ths of milliseconds, -1 when the lap has no valid time
+	Incident        bool `json:"incident"`
+	PersonalBestLap bool `json:"personalBestLap"`
+	// EventCodes are the lap's events normalized into codes, see lapevents
+	EventCodes []string `json:"eventCodes"`
+	Synthetic  bool     `json:"synthetic,omitempty"`
+}
+
+// ExportLapSummary stands in for a race's laps once they have been compacted.
+type ExportLapSummary struct {
+	LapCount         int `json:"lapCount"`
+	ValidLapCount    int `json:"validLapCount"`
+	IncidentLapCount int `json:"incidentLapCount"`
+	BestLapTime      int `json:"bestLapTime"` // -1 when no lap had a valid time
+	AvgLapTime       int `json:"avgLapTime"`  // -1 when no lap had a valid time
+}
+
+// ExportJournal is the part of a journal entry shared in an export pack, voice memo transcripts are left out.
+type ExportJournal struct {
+	Notes       string   `json:"notes"`
+	Tags        []string `json:"tags"`
+	ReplayVideo string   `json:"replayVideo,omitempty"`
+}
+
+// ExportRace is one race in an export pack. Laps is empty and LapSummary set once the race's laps are compacted.
+type ExportRace struct {
+	Race       Race              `json:"race"`
+	Laps       []ExportLap       `json:"laps"`
+	LapSummary *ExportLapSummary `json:"lapSummary,omitempty"`
+	Journal    *ExportJournal    `json:"journal,omitempty"`
+}
+
+// ExportResponse is the response for the export endpoint, races most recent first.
+type ExportResponse struct {
+	DriverID    int64        `json:"driverId"`
+	From        time.Time    `json:"from"`
+	To          time.Time    `json:"to"`
+	GeneratedAt time.Time    `json:"generatedAt"`
+	Races       []ExportRace `json:"races"`
+	// Truncated is set when the range held more races than the driver's export limit
+	Truncated bool `json:"truncated"`
+}
+
+func exportResponseFromPack(pack *export.Pack) ExportResponse {
+	races := make([]ExportRace, len(pack.Races))
+	for i, r := range pack.Races {
+		race := ExportRace{
+			Race: raceFromDriverSession(r.Session),
+			Laps: make([]ExportLap, len(r.Laps)),
+		}
+		for j, l := range r.Laps {
+			eventCodes := []string{}
+			if len(l.LapEvents) > 0 {
+				eventCodes = lapevents.Normalize(l.LapEvents)
+			}
+			race.Laps[j] = ExportLap{
+				LapNumber:       l.LapNumber,
+				LapTime:         l.LapTime,
+				Incident:        l.Incident,
+				PersonalBestLap: l.PersonalBestLap,
+				EventCodes:      eventCodes,
+				Synthetic:       l.Synthetic,
+			}
+		}
+		if r.LapSummary != nil {
+			race.LapSummary = &ExportLapSummary{
+				LapCount:         r.LapSummary.LapCount,
+				ValidLapCount:    r.LapSummary.ValidLapCount,
+				IncidentLapCount: r.LapSummary.IncidentLapCount,
+				BestLapTime:      r.LapSummary.BestLapTime,
+				AvgLapTime:       r.LapSummary.AvgLapTime,
+			}
+		}
+		if r.Journal != nil {
+			race.Journal = &ExportJournal{
+				Notes:       r.Journal.Notes,
+				Tags:        r.Journal.Tags,
+				ReplayVideo: r.Journal.ReplayVideo,
+			}
+			if race.Journal.Tags == nil {
+				race.Journal.Tags = []string{}
+			}
+		}
+		races[i] = race
+	}
+	return ExportResponse{
+		DriverID:    pack.DriverID,
+		From:        pack.From.UTC(),
+		To:          pack.To.UTC(),
+		GeneratedAt: pack.GeneratedAt.UTC(),
+		Races:       races,
+		Truncated:   pack.Truncated,
+	}
+}
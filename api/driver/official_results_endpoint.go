@@ -0,0 +1,52 @@
+package driver
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// NewOfficialResultsEndpoint redirects to the race's official results on iRacing so the driver can cross-check what
+// was ingested. Ownership is checked by the router, the race has to have been ingested for the driver to be redirected.
+func NewOfficialResultsEndpoint(raceStore GetRaceStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		driverRaceID, err := strconv.ParseInt(chi.URLParam(r, "driver_race_id"), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError("driver_race_id", "must be a valid integer")
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		session, err := raceStore.GetDriverSession(ctx, driverID, store.TimeFromDriverRaceID(driverRaceID))
+		if err != nil {
+			logger.Error().Err(err).Int64("driverRaceId", driverRaceID).Msg("failed to fetch driver session")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		if session == nil {
+			api.DoNotFoundResponse(ctx, "race not found", w)
+			return
+		}
+
+		http.Redirect(w, r, iracing.ResultsURL(session.SubsessionID), http.StatusFound)
+	})
+}
@@ -0,0 +1,107 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOfficialResultsEndpoint(t *testing.T) {
+	type storeCall struct {
+		session *store.DriverSession
+		err     error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverRaceID string
+
+		storeCall *storeCall
+
+		expectedStatus      int
+		expectedLocation    string
+		expectedBodyFixture string
+	}{
+		{
+			name:             "redirects to iRacing",
+			driverRaceID:     "1700000000",
+			storeCall:        &storeCall{session: &store.DriverSession{DriverID: 12345, SubsessionID: 100001, StartTime: time.Unix(1700000000, 0)}},
+			expectedStatus:   http.StatusFound,
+			expectedLocation: "https://members-ng.iracing.com/web/racing/results-stats/results?subsessionid=100001",
+		},
+		{
+			name:                "not found",
+			driverRaceID:        "1700000000",
+			storeCall:           &storeCall{},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_race_not_found_response.json",
+		},
+		{
+			name:                "invalid driver_race_id",
+			driverRaceID:        "not-an-integer",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_race_invalid_driver_race_id_response.json",
+		},
+		{
+			name:                "store error",
+			driverRaceID:        "1700000000",
+			storeCall:           &storeCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_race_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockGetRaceStore(t)
+			if tc.storeCall != nil {
+				mockStore.EXPECT().GetDriverSession(mock.Anything, int64(12345), time.Unix(1700000000, 0)).
+					Return(tc.storeCall.session, tc.storeCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{driver_id}/races/{driver_race_id}/official", NewOfficialResultsEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/12345/races/"+tc.driverRaceID+"/official", nil)
+			require.NoError(t, err)
+
+			client := &http.Client{
+				CheckRedirect: func(*http.Request, []*http.Request) error {
+					return http.ErrUseLastResponse
+				},
+			}
+			res, err := client.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+			assert.Equal(t, tc.expectedLocation, res.Header.Get("Location"))
+
+			if tc.expectedBodyFixture != "" {
+				bodyBytes, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+
+				expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+				require.NoError(t, err)
+
+				assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+			}
+		})
+	}
+}
@@ -1,6 +1,16 @@
 package driver
 
-import "strconv"
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/raceplan"
+	"github.com/jonsabados/saturdaysspinout/standings"
+)
 
 // parseInt64Slice parses a slice of strings to int64s, returning invalid values separately.
 func parseInt64Slice(values []string) ([]int64, []string) {
@@ -17,4 +27,37 @@ func parseInt64Slice(values []string) ([]int64, []string) {
 	}
 
 	return ints, invalid
-}
\ No newline at end of file
+}
+
+// parseFieldSelection reads the fields query param for an endpoint responding with T, recording unknown fields
+// against errs.
+func parseFieldSelection[T any](r *http.Request, errs api.RequestErrors) (api.FieldSelection, api.RequestErrors) {
+	fields, err := api.ParseFieldSelection[T](r)
+	var unknown *api.UnknownFieldError
+	if errors.As(err, &unknown) {
+		errs = errs.WithFieldErrorCode(api.FieldsQueryParam, ErrCodeUnknownField, map[string]string{"value": unknown.Field})
+	}
+	return fields, errs
+}
+
+// parseRacePlanKey reads the race week, series and track a race plan is for from the path, recording anything invalid
+// against errs. The race week is the date it started, as YYYY-MM-DD.
+func parseRacePlanKey(r *http.Request, errs api.RequestErrors) (raceplan.Key, api.RequestErrors) {
+	var key raceplan.Key
+
+	weekStart, err := time.Parse(time.DateOnly, chi.URLParam(r, "race_week"))
+	if err != nil || !standings.WeekStart(weekStart).Equal(weekStart) {
+		errs = errs.WithFieldError("race_week", "must be the date a race week started, as YYYY-MM-DD")
+	}
+	key.WeekStart = weekStart
+
+	key.SeriesID, err = strconv.ParseInt(chi.URLParam(r, "series_id"), 10, 64)
+	if err != nil {
+		errs = errs.WithFieldError("series_id", "must be a valid integer")
+	}
+	key.TrackID, err = strconv.ParseInt(chi.URLParam(r, "track_id"), 10, 64)
+	if err != nil {
+		errs = errs.WithFieldError("track_id", "must be a valid integer")
+	}
+	return key, errs
+}
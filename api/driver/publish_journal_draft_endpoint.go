@@ -0,0 +1,81 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/rs/zerolog"
+)
+
+type JournalServiceForPublishDraft interface {
+	GetDraft(ctx context.Context, driverID, raceID int64) (*journal.Draft, error)
+	PublishDraft(ctx context.Context, draft journal.Draft) (*journal.Entry, error)
+}
+
+func NewPublishJournalDraftEndpoint(journalService JournalServiceForPublishDraft) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var raceID int64
+		raceIDStr := chi.URLParam(r, "driver_race_id")
+		if raceIDStr == "" {
+			errs = errs.WithFieldError("driver_race_id", "required")
+		} else {
+			raceID, err = strconv.ParseInt(raceIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError("driver_race_id", "must be a valid integer")
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		draft, err := journalService.GetDraft(ctx, driverID, raceID)
+		if err != nil {
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to get journal draft")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		if draft == nil {
+			api.DoNotFoundResponse(ctx, "no journal draft found for this race", w)
+			return
+		}
+
+		// Drafts are saved mid-edit without validation, so the content gets the same checks a direct save does
+		for _, v := range journal.ValidateTags(draft.Tags) {
+			errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+		}
+		if v := journal.ValidateReplayVideo(draft.ReplayVideo); v != nil {
+			errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		entry, err := journalService.PublishDraft(ctx, *draft)
+		if err != nil {
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to publish journal draft")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, journalEntryFromServiceEntry(*entry), w)
+	})
+}
@@ -0,0 +1,129 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPublishJournalDraftEndpoint(t *testing.T) {
+	testDraft := journal.Draft{
+		DriverID:  12345,
+		RaceID:    1700000000,
+		SavedAt:   time.Unix(1000, 0),
+		ExpiresAt: time.Unix(1000, 0).Add(30 * 24 * time.Hour),
+		Notes:     "Still thinking about turn 3",
+		Tags:      []string{"podium"},
+	}
+	invalidDraft := testDraft
+	invalidDraft.Tags = []string{"sentiment:invalid"}
+	testEntry := journal.Entry{
+		RaceID:    1700000000,
+		CreatedAt: time.Unix(1000, 0),
+		UpdatedAt: time.Unix(2000, 0),
+		Notes:     "Still thinking about turn 3",
+		Tags:      []string{"podium"},
+	}
+
+	type getCall struct {
+		draft *journal.Draft
+		err   error
+	}
+
+	type publishCall struct {
+		draft journal.Draft
+		entry *journal.Entry
+		err   error
+	}
+
+	testCases := []struct {
+		name string
+
+		getCalls     []getCall
+		publishCalls []publishCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:     "success",
+			getCalls: []getCall{{draft: &testDraft}},
+			publishCalls: []publishCall{
+				{draft: testDraft, entry: &testEntry},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/publish_journal_draft_success_response.json",
+		},
+		{
+			name:                "no draft",
+			getCalls:            []getCall{{draft: nil}},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/publish_journal_draft_not_found_response.json",
+		},
+		{
+			name:                "invalid draft content",
+			getCalls:            []getCall{{draft: &invalidDraft}},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/publish_journal_draft_invalid_tag_response.json",
+		},
+		{
+			name:     "publish error",
+			getCalls: []getCall{{draft: &testDraft}},
+			publishCalls: []publishCall{
+				{draft: testDraft, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/publish_journal_draft_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockJournalServiceForPublishDraft(t)
+			for _, call := range tc.getCalls {
+				mockService.EXPECT().GetDraft(mock.Anything, int64(12345), int64(1700000000)).
+					Return(call.draft, call.err)
+			}
+			for _, call := range tc.publishCalls {
+				mockService.EXPECT().PublishDraft(mock.Anything, call.draft).
+					Return(call.entry, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Post("/{driver_id}/races/{driver_race_id}/journal/draft/publish", NewPublishJournalDraftEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/12345/races/1700000000/journal/draft/publish"
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package driver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+type RevokePresenceViewerStore interface {
+	DeletePresenceGrant(ctx context.Context, driverID, viewerID int64) error
+}
+
+// NewRevokePresenceViewerEndpoint creates the handler for DELETE /driver/{driver_id}/presence/viewers/{viewer_id}
+func NewRevokePresenceViewerEndpoint(presenceStore RevokePresenceViewerStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		viewerID, err := strconv.ParseInt(chi.URLParam(r, viewerIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(viewerIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		if err := presenceStore.DeletePresenceGrant(ctx, driverID, viewerID); err != nil {
+			logger.Error().Err(err).Int64("viewerId", viewerID).Msg("failed to delete presence grant")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
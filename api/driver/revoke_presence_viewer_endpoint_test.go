@@ -0,0 +1,96 @@
+package driver
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRevokePresenceViewerEndpoint(t *testing.T) {
+	type deleteCall struct {
+		driverID int64
+		viewerID int64
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+		viewerID string
+
+		deleteCall *deleteCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:           "success",
+			driverID:       "12345",
+			viewerID:       "222",
+			deleteCall:     &deleteCall{driverID: 12345, viewerID: 222},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                "invalid viewer id",
+			driverID:            "12345",
+			viewerID:            "xyz",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/revoke_presence_viewer_invalid_viewer_id_response.json",
+		},
+		{
+			name:                "store error",
+			driverID:            "12345",
+			viewerID:            "222",
+			deleteCall:          &deleteCall{driverID: 12345, viewerID: 222, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/revoke_presence_viewer_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockRevokePresenceViewerStore(t)
+			if tc.deleteCall != nil {
+				mockStore.EXPECT().DeletePresenceGrant(mock.Anything, tc.deleteCall.driverID, tc.deleteCall.viewerID).
+					Return(tc.deleteCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Delete("/{driver_id}/presence/viewers/{viewer_id}", NewRevokePresenceViewerEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodDelete, ts.URL+"/"+tc.driverID+"/presence/viewers/"+tc.viewerID, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			if tc.expectedBodyFixture != "" {
+				expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+			} else {
+				assert.Empty(t, bodyBytes)
+			}
+		})
+	}
+}
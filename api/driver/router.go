@@ -3,9 +3,11 @@ package driver
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/quota"
 )
 
 type Store interface {
@@ -13,6 +15,14 @@ type Store interface {
 	GetRacesStore
 	GetRaceStore
 	DeleteRacesStore
+	GetStandingsStore
+	GetSettingsStore
+	SaveSettingsStore
+	CancelIngestionStore
+	ListPresenceViewersStore
+	GrantPresenceViewerStore
+	RevokePresenceViewerStore
+	GetRacingNowStore
 }
 
 type JournalService interface {
@@ -20,26 +30,131 @@ type JournalService interface {
 	GetJournalEntryStore
 	ListJournalEntriesStore
 	DeleteJournalEntryStore
+	GetJournalDraftStore
+	JournalServiceForSaveDraft
+	JournalServiceForPublishDraft
+	JournalServiceForPrompts
+	JournalServiceForDismissPrompt
+	JournalServiceForStats
 }
 
-func NewRouter(raceStore Store, journalService JournalService, analyticsService AnalyticsService, authMiddleware, developerMiddleware func(http.Handler) http.Handler) http.Handler {
+type VoiceMemoService interface {
+	VoiceMemoServiceForCreate
+	VoiceMemoServiceForList
+}
+
+type ActionItemService interface {
+	ActionItemServiceForCreate
+	ActionItemServiceForList
+	ActionItemServiceForUpdate
+	ActionItemServiceForDelete
+	RelatedActionItemsFinder
+}
+
+type AlertService interface {
+	AlertServiceForList
+	AlertServiceForCreate
+	AlertServiceForUpdate
+	AlertServiceForDelete
+}
+
+type RacePlanService interface {
+	RacePlanServiceForList
+	RacePlanServiceForSave
+	RacePlanServiceForDelete
+	RacePlanServiceForRace
+}
+
+type BookmarkService interface {
+	BookmarkServiceForList
+	BookmarkServiceForCreate
+	BookmarkServiceForUpdate
+	BookmarkServiceForDelete
+}
+
+type VideoLinkService interface {
+	VideoLinkServiceForList
+	VideoLinkServiceForCreate
+	VideoLinkServiceForDelete
+	JournalVideoLinkFinder
+}
+
+type TelemetryService interface {
+	TelemetryServiceForGet
+	TelemetryServiceForImport
+}
+
+// NewRouter builds the driver routes. voiceMemoService may be nil when voice memos aren't configured, in which case
+// the attachment routes aren't registered. limitsMiddleware holds every route to the driver's entitlement limits.
+func NewRouter(raceStore Store, journalService JournalService, voiceMemoService VoiceMemoService, actionItemService ActionItemService, alertService AlertService, bookmarkService BookmarkService, videoLinkService VideoLinkService, telemetryService TelemetryService, lapImportService LapImportService, analyticsService AnalyticsService, seriesCatalog SeriesCatalog, quotaService QuotaService, exportService ExportService, racePlanService RacePlanService, authMiddleware, developerMiddleware, limitsMiddleware func(http.Handler) http.Handler) http.Handler {
 	r := chi.NewRouter()
 	r.Use(authMiddleware)
+	r.Use(limitsMiddleware)
 
 	r.Route(fmt.Sprintf("/{%s}", api.DriverIDPathParam), func(r chi.Router) {
 		r.Use(api.DriverOwnershipMiddleware(api.DriverIDPathParam))
 
 		r.Get("/", api.WrapWithSegment("getDriver", NewGetDriverEndpoint(raceStore)).ServeHTTP)
+		r.Get("/onboarding", api.WrapWithSegment("getDriverOnboarding", NewGetOnboardingEndpoint(raceStore)).ServeHTTP)
 		r.Get("/races", api.WrapWithSegment("getDriverRaces", NewGetRacesEndpoint(raceStore)).ServeHTTP)
-		r.Get("/races/{driver_race_id}", api.WrapWithSegment("getDriverRace", NewGetRaceEndpoint(raceStore)).ServeHTTP)
-		r.Get("/races/{driver_race_id}/journal", api.WrapWithSegment("getJournalEntry", NewGetJournalEntryEndpoint(journalService)).ServeHTTP)
+		r.Get("/races/{driver_race_id}", api.WrapWithSegment("getDriverRace", NewGetRaceEndpoint(raceStore, actionItemService, bookmarkService)).ServeHTTP)
+		r.Get("/races/{driver_race_id}/official", api.WrapWithSegment("getOfficialRaceResults", NewOfficialResultsEndpoint(raceStore)).ServeHTTP)
+		r.Get("/races/{driver_race_id}/bookmarks", api.WrapWithSegment("listRaceBookmarks", NewListBookmarksEndpoint(bookmarkService)).ServeHTTP)
+		r.Post("/races/{driver_race_id}/bookmarks", api.WrapWithSegment("createRaceBookmark", NewCreateBookmarkEndpoint(bookmarkService)).ServeHTTP)
+		r.Patch("/races/{driver_race_id}/bookmarks/{bookmark_id}", api.WrapWithSegment("updateRaceBookmark", NewUpdateBookmarkEndpoint(bookmarkService)).ServeHTTP)
+		r.Delete("/races/{driver_race_id}/bookmarks/{bookmark_id}", api.WrapWithSegment("deleteRaceBookmark", NewDeleteBookmarkEndpoint(bookmarkService)).ServeHTTP)
+		r.Get("/races/{driver_race_id}/video-links", api.WrapWithSegment("listRaceVideoLinks", NewListVideoLinksEndpoint(videoLinkService)).ServeHTTP)
+		r.Post("/races/{driver_race_id}/video-links", api.WrapWithSegment("createRaceVideoLink", NewCreateVideoLinkEndpoint(videoLinkService)).ServeHTTP)
+		r.Delete("/races/{driver_race_id}/video-links/{video_link_id}", api.WrapWithSegment("deleteRaceVideoLink", NewDeleteVideoLinkEndpoint(videoLinkService)).ServeHTTP)
+		r.Get("/races/{driver_race_id}/plan", api.WrapWithSegment("getRacePlan", NewGetRacePlanEndpoint(racePlanService)).ServeHTTP)
+		r.Get("/races/{driver_race_id}/telemetry", api.WrapWithSegment("getRaceTelemetry", NewGetTelemetryEndpoint(telemetryService)).ServeHTTP)
+		r.Put("/races/{driver_race_id}/telemetry", api.WrapWithSegment("importRaceTelemetry", NewImportTelemetryEndpoint(telemetryService)).ServeHTTP)
+		r.Post("/external-laps/{source}", api.WrapWithSegment("importExternalLaps", NewImportExternalLapsEndpoint(lapImportService)).ServeHTTP)
+		r.Get("/races/{driver_race_id}/journal", api.WrapWithSegment("getJournalEntry", NewGetJournalEntryEndpoint(journalService, videoLinkService)).ServeHTTP)
 		r.Put("/races/{driver_race_id}/journal", api.WrapWithSegment("saveJournalEntry", NewSaveJournalEndpoint(journalService)).ServeHTTP)
 		r.Delete("/races/{driver_race_id}/journal", api.WrapWithSegment("deleteJournalEntry", NewDeleteJournalEntryEndpoint(journalService)).ServeHTTP)
+		r.Get("/races/{driver_race_id}/journal/draft", api.WrapWithSegment("getJournalDraft", NewGetJournalDraftEndpoint(journalService)).ServeHTTP)
+		r.Patch("/races/{driver_race_id}/journal/draft", api.WrapWithSegment("saveJournalDraft", NewSaveJournalDraftEndpoint(journalService)).ServeHTTP)
+		r.Post("/races/{driver_race_id}/journal/draft/publish", api.WrapWithSegment("publishJournalDraft", NewPublishJournalDraftEndpoint(journalService)).ServeHTTP)
+		if voiceMemoService != nil {
+			r.Get("/races/{driver_race_id}/journal/attachments", api.WrapWithSegment("listJournalAttachments", NewListJournalAttachmentsEndpoint(voiceMemoService)).ServeHTTP)
+			r.Post("/races/{driver_race_id}/journal/attachments", api.WrapWithSegment("createJournalAttachment", NewCreateJournalAttachmentEndpoint(voiceMemoService)).ServeHTTP)
+		}
 		r.Get("/journal", api.WrapWithSegment("listJournalEntries", NewListJournalEntriesEndpoint(journalService)).ServeHTTP)
+		r.Get("/journal/prompts", api.WrapWithSegment("listJournalPrompts", NewListJournalPromptsEndpoint(journalService)).ServeHTTP)
+		r.Delete("/journal/prompts/{driver_race_id}", api.WrapWithSegment("dismissJournalPrompt", NewDismissJournalPromptEndpoint(journalService)).ServeHTTP)
+		r.Get("/journal-stats", api.WrapWithSegment("getJournalStats", NewGetJournalStatsEndpoint(journalService)).ServeHTTP)
+		r.Get("/action-items", api.WrapWithSegment("listActionItems", NewListActionItemsEndpoint(actionItemService)).ServeHTTP)
+		r.Post("/action-items", api.WrapWithSegment("createActionItem", NewCreateActionItemEndpoint(actionItemService)).ServeHTTP)
+		r.Patch("/action-items/{action_item_id}", api.WrapWithSegment("updateActionItem", NewUpdateActionItemEndpoint(actionItemService)).ServeHTTP)
+		r.Delete("/action-items/{action_item_id}", api.WrapWithSegment("deleteActionItem", NewDeleteActionItemEndpoint(actionItemService)).ServeHTTP)
+		r.Get("/alert-rules", api.WrapWithSegment("listAlertRules", NewListAlertRulesEndpoint(alertService)).ServeHTTP)
+		r.Post("/alert-rules", api.WrapWithSegment("createAlertRule", NewCreateAlertRuleEndpoint(alertService)).ServeHTTP)
+		r.Put("/alert-rules/{alert_rule_id}", api.WrapWithSegment("updateAlertRule", NewUpdateAlertRuleEndpoint(alertService)).ServeHTTP)
+		r.Delete("/alert-rules/{alert_rule_id}", api.WrapWithSegment("deleteAlertRule", NewDeleteAlertRuleEndpoint(alertService)).ServeHTTP)
+		r.Get("/race-plans", api.WrapWithSegment("listRacePlans", NewListRacePlansEndpoint(racePlanService)).ServeHTTP)
+		r.Put("/race-plans/{race_week}/{series_id}/{track_id}", api.WrapWithSegment("saveRacePlan", NewSaveRacePlanEndpoint(racePlanService)).ServeHTTP)
+		r.Delete("/race-plans/{race_week}/{series_id}/{track_id}", api.WrapWithSegment("deleteRacePlan", NewDeleteRacePlanEndpoint(racePlanService)).ServeHTTP)
+		r.Get("/settings", api.WrapWithSegment("getDriverSettings", NewGetSettingsEndpoint(raceStore)).ServeHTTP)
+		r.Put("/settings", api.WrapWithSegment("saveDriverSettings", NewSaveSettingsEndpoint(raceStore)).ServeHTTP)
+		r.Get("/standings", api.WrapWithSegment("getDriverStandings", NewGetStandingsEndpoint(raceStore)).ServeHTTP)
+		r.Get("/quota", api.WrapWithSegment("getDriverQuota", NewGetQuotaEndpoint(quotaService)).ServeHTTP)
+		r.With(api.QuotaMiddleware(quotaService, quota.OperationExport, time.Now)).Get("/export", api.WrapWithSegment("getDriverExport", NewExportEndpoint(exportService)).ServeHTTP)
+		r.Post("/ingestion/cancel", api.WrapWithSegment("cancelDriverIngestion", NewCancelIngestionEndpoint(raceStore)).ServeHTTP)
+		r.Get("/presence/viewers", api.WrapWithSegment("listPresenceViewers", NewListPresenceViewersEndpoint(raceStore)).ServeHTTP)
+		r.Put("/presence/viewers/{viewer_id}", api.WrapWithSegment("grantPresenceViewer", NewGrantPresenceViewerEndpoint(raceStore)).ServeHTTP)
+		r.Delete("/presence/viewers/{viewer_id}", api.WrapWithSegment("revokePresenceViewer", NewRevokePresenceViewerEndpoint(raceStore)).ServeHTTP)
+		r.Get("/racing-now", api.WrapWithSegment("getRacingNow", NewGetRacingNowEndpoint(raceStore)).ServeHTTP)
 
 		// Analytics endpoints
 		r.Get("/analytics/dimensions", api.WrapWithSegment("getAnalyticsDimensions", NewAnalyticsDimensionsEndpoint(analyticsService)).ServeHTTP)
 		r.Get("/analytics", api.WrapWithSegment("getAnalytics", NewAnalyticsEndpoint(analyticsService)).ServeHTTP)
+		r.Get("/analytics/prediction", api.WrapWithSegment("getRacePrediction", NewAnalyticsPredictionEndpoint(analyticsService)).ServeHTTP)
+		r.Get("/analytics/championship", api.WrapWithSegment("getChampionship", NewAnalyticsChampionshipEndpoint(analyticsService)).ServeHTTP)
+		r.Get("/analytics/region", api.WrapWithSegment("getRegionComparison", NewAnalyticsRegionEndpoint(analyticsService)).ServeHTTP)
+		r.Get("/analytics/categories", api.WrapWithSegment("getCategoryProfile", NewAnalyticsCategoriesEndpoint(analyticsService, seriesCatalog)).ServeHTTP)
+		r.Get("/analytics/time-of-day", api.WrapWithSegment("getTimeOfDayProfile", NewAnalyticsTimeOfDayEndpoint(analyticsService)).ServeHTTP)
+		r.Get("/analytics/sr-recovery", api.WrapWithSegment("getSRRecoveryPlan", NewAnalyticsSRRecoveryEndpoint(analyticsService)).ServeHTTP)
 
 		// Developer-only endpoints
 		r.With(developerMiddleware).Delete("/races", api.WrapWithSegment("deleteDriverRaces", NewDeleteRacesEndpoint(raceStore)).ServeHTTP)
@@ -0,0 +1,83 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/rs/zerolog"
+)
+
+type JournalServiceForSaveDraft interface {
+	ValidateRaceExists(ctx context.Context, driverID, raceID int64) (bool, error)
+	SaveDraft(ctx context.Context, input journal.SaveDraftInput) (*journal.Draft, error)
+}
+
+// NewSaveJournalDraftEndpoint autosaves partial journal content. Tags and the replay video are not validated here since
+// drafts are saved mid-edit, they are validated when the draft is published.
+func NewSaveJournalDraftEndpoint(journalService JournalServiceForSaveDraft) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var raceID int64
+		raceIDStr := chi.URLParam(r, "driver_race_id")
+		if raceIDStr == "" {
+			errs = errs.WithFieldError("driver_race_id", "required")
+		} else {
+			raceID, err = strconv.ParseInt(raceIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError("driver_race_id", "must be a valid integer")
+			}
+		}
+
+		var req SaveJournalDraftRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		}
+
+		// Check if the race exists (only if we have valid IDs)
+		if !errs.HasAnyError() {
+			exists, err := journalService.ValidateRaceExists(ctx, driverID, raceID)
+			if err != nil {
+				logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to validate race exists")
+				api.DoErrorResponse(ctx, w)
+				return
+			}
+			if !exists {
+				errs = errs.WithFieldErrorCode("driver_race_id", "race_not_found", nil)
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		draft, err := journalService.SaveDraft(ctx, journal.SaveDraftInput{
+			DriverID:    driverID,
+			RaceID:      raceID,
+			Notes:       req.Notes,
+			Tags:        req.Tags,
+			ReplayVideo: req.ReplayVideo,
+		})
+		if err != nil {
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to save journal draft")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, journalDraftFromServiceDraft(*draft), w)
+	})
+}
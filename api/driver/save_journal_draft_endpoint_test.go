@@ -0,0 +1,169 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSaveJournalDraftEndpoint(t *testing.T) {
+	testDraft := journal.Draft{
+		DriverID:  12345,
+		RaceID:    1700000000,
+		SavedAt:   time.Unix(1000, 0),
+		ExpiresAt: time.Unix(1000, 0).Add(30 * 24 * time.Hour),
+		Notes:     "Still thinking about turn 3",
+		Tags:      []string{"podium"},
+	}
+	notes := "Still thinking about turn 3"
+
+	type validateCall struct {
+		driverID int64
+		raceID   int64
+		exists   bool
+		err      error
+	}
+
+	type saveCall struct {
+		input journal.SaveDraftInput
+		draft *journal.Draft
+		err   error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		raceID      string
+		requestBody string
+
+		validateCalls []validateCall
+		saveCalls     []saveCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success with partial content",
+			driverID:    "12345",
+			raceID:      "1700000000",
+			requestBody: `{"notes": "Still thinking about turn 3"}`,
+			validateCalls: []validateCall{
+				{driverID: 12345, raceID: 1700000000, exists: true},
+			},
+			saveCalls: []saveCall{
+				{
+					input: journal.SaveDraftInput{DriverID: 12345, RaceID: 1700000000, Notes: &notes},
+					draft: &testDraft,
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_journal_draft_success_response.json",
+		},
+		{
+			name:        "unvalidated tags are accepted",
+			driverID:    "12345",
+			raceID:      "1700000000",
+			requestBody: `{"tags": ["sentiment:incomplete"]}`,
+			validateCalls: []validateCall{
+				{driverID: 12345, raceID: 1700000000, exists: true},
+			},
+			saveCalls: []saveCall{
+				{
+					input: journal.SaveDraftInput{DriverID: 12345, RaceID: 1700000000, Tags: []string{"sentiment:incomplete"}},
+					draft: &testDraft,
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_journal_draft_success_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			driverID:            "12345",
+			raceID:              "1700000000",
+			requestBody:         `{not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/save_journal_draft_invalid_json_response.json",
+		},
+		{
+			name:        "race not found",
+			driverID:    "12345",
+			raceID:      "1700000000",
+			requestBody: `{"notes": "Still thinking about turn 3"}`,
+			validateCalls: []validateCall{
+				{driverID: 12345, raceID: 1700000000, exists: false},
+			},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/save_journal_draft_race_not_found_response.json",
+		},
+		{
+			name:        "store error",
+			driverID:    "12345",
+			raceID:      "1700000000",
+			requestBody: `{"notes": "Still thinking about turn 3"}`,
+			validateCalls: []validateCall{
+				{driverID: 12345, raceID: 1700000000, exists: true},
+			},
+			saveCalls: []saveCall{
+				{
+					input: journal.SaveDraftInput{DriverID: 12345, RaceID: 1700000000, Notes: &notes},
+					err:   errors.New("database error"),
+				},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/save_journal_draft_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockJournalServiceForSaveDraft(t)
+			for _, call := range tc.validateCalls {
+				mockService.EXPECT().ValidateRaceExists(mock.Anything, call.driverID, call.raceID).
+					Return(call.exists, call.err)
+			}
+			for _, call := range tc.saveCalls {
+				mockService.EXPECT().SaveDraft(mock.Anything, call.input).
+					Return(call.draft, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Patch("/{driver_id}/races/{driver_race_id}/journal/draft", NewSaveJournalDraftEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/" + tc.driverID + "/races/" + tc.raceID + "/journal/draft"
+			req, err := http.NewRequest(http.MethodPatch, url, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -59,7 +59,7 @@ func NewSaveJournalEndpoint(journalService JournalServiceForSave) http.Handler {
 		if !errs.HasAnyError() {
 			exists, err := journalService.ValidateRaceExists(ctx, driverID, raceID)
 			if err != nil {
-				logger.Error().Err(err).Int64("driverId", driverID).Int64("raceId", raceID).Msg("failed to validate race exists")
+				logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to validate race exists")
 				api.DoErrorResponse(ctx, w)
 				return
 			}
@@ -81,7 +81,7 @@ func NewSaveJournalEndpoint(journalService JournalServiceForSave) http.Handler {
 			ReplayVideo: req.ReplayVideo,
 		})
 		if err != nil {
-			logger.Error().Err(err).Int64("driverId", driverID).Int64("raceId", raceID).Msg("failed to save journal entry")
+			logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to save journal entry")
 			api.DoErrorResponse(ctx, w)
 			return
 		}
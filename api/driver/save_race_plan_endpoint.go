@@ -0,0 +1,60 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/raceplan"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type RacePlanServiceForSave interface {
+	Save(ctx context.Context, driverID int64, key raceplan.Key, goals, setupIntentions string) (*store.RacePlan, error)
+}
+
+// NewSaveRacePlanEndpoint writes the driver's plan for racing a series at a track in a race week, replacing any plan
+// they already had for it.
+func NewSaveRacePlanEndpoint(racePlanService RacePlanServiceForSave) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var key raceplan.Key
+		key, errs = parseRacePlanKey(r, errs)
+
+		var req RacePlanRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			for _, v := range raceplan.Validate(req.Goals, req.SetupIntentions) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		plan, err := racePlanService.Save(ctx, driverID, key, req.Goals, req.SetupIntentions)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to save race plan")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, racePlanFromStore(*plan), w)
+	})
+}
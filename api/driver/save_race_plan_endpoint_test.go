@@ -0,0 +1,119 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/raceplan"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSaveRacePlanEndpoint(t *testing.T) {
+	key := raceplan.Key{WeekStart: time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC), SeriesID: 260, TrackID: 47}
+	validBody := `{"goals": "Clean race, top 5", "setupIntentions": "Less rear wing"}`
+
+	type saveCall struct {
+		plan *store.RacePlan
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		path        string
+		requestBody string
+
+		saveCalls []saveCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			path:        "/12345/race-plans/2025-06-10/260/47",
+			requestBody: validBody,
+			saveCalls: []saveCall{
+				{plan: &testRacePlan},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_race_plan_success_response.json",
+		},
+		{
+			name:                "invalid request",
+			path:                "/12345/race-plans/2025-06-11/260/track",
+			requestBody:         `{"goals": " "}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/save_race_plan_invalid_request_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			path:                "/12345/race-plans/2025-06-10/260/47",
+			requestBody:         `{`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/save_race_plan_invalid_json_response.json",
+		},
+		{
+			name:                "invalid driver ID",
+			path:                "/not-a-number/race-plans/2025-06-10/260/47",
+			requestBody:         validBody,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_journal_invalid_driver_id_response.json",
+		},
+		{
+			name:        "service error",
+			path:        "/12345/race-plans/2025-06-10/260/47",
+			requestBody: validBody,
+			saveCalls: []saveCall{
+				{err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/save_race_plan_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockRacePlanServiceForSave(t)
+			for _, call := range tc.saveCalls {
+				mockService.EXPECT().Save(mock.Anything, int64(12345), key, "Clean race, top 5", "Less rear wing").
+					Return(call.plan, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Put("/{driver_id}/race-plans/{race_week}/{series_id}/{track_id}", NewSaveRacePlanEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPut, ts.URL+tc.path, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
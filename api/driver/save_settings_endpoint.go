@@ -0,0 +1,82 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/i18n"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type SaveSettingsStore interface {
+	GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error)
+	SaveDriverSettings(ctx context.Context, settings store.DriverSettings) error
+}
+
+// NewSaveSettingsEndpoint creates the handler for PUT /driver/{driver_id}/settings
+func NewSaveSettingsEndpoint(settingsStore SaveSettingsStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldErrorCode(api.DriverIDPathParam, ErrCodeInvalidInteger, nil)
+		}
+
+		var req DriverSettings
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			if req.LapRetentionMonths < 0 {
+				errs = errs.WithFieldErrorCode("lapRetentionMonths", ErrCodeInvalidValue, map[string]string{"value": strconv.Itoa(req.LapRetentionMonths)})
+			}
+			// LoadLocation also accepts "Local", which would be whatever the lambda runs in
+			if _, err := time.LoadLocation(req.Timezone); err != nil || req.Timezone == "Local" {
+				errs = errs.WithFieldErrorCode("timezone", ErrCodeInvalidValue, map[string]string{"value": req.Timezone})
+			}
+			if req.Locale != "" && !i18n.IsSupported(req.Locale) {
+				errs = errs.WithFieldErrorCode("locale", ErrCodeInvalidValue, map[string]string{"value": req.Locale})
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		current, err := settingsStore.GetDriverSettings(ctx, driverID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get driver settings")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		settings := store.DriverSettings{
+			DriverID:             driverID,
+			LapRetentionMonths:   req.LapRetentionMonths,
+			SummaryOnlyIngestion: req.SummaryOnlyIngestion,
+			// turning summary only ingestion off has the next ingestion run fetch the laps that were skipped
+			LapBackfillPending: current.LapBackfillPending || (current.SummaryOnlyIngestion && !req.SummaryOnlyIngestion),
+			LeaderboardOptIn:   req.LeaderboardOptIn,
+			BenchmarkOptIn:     req.BenchmarkOptIn,
+			Timezone:           req.Timezone,
+			Locale:             req.Locale,
+		}
+		if err := settingsStore.SaveDriverSettings(ctx, settings); err != nil {
+			logger.Error().Err(err).Msg("failed to save driver settings")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, driverSettingsFromStore(settings), w)
+	})
+}
@@ -0,0 +1,228 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSaveSettingsEndpoint(t *testing.T) {
+	type getCall struct {
+		driverID int64
+		result   *store.DriverSettings
+		err      error
+	}
+	type storeCall struct {
+		settings store.DriverSettings
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		requestBody string
+
+		getCall   *getCall
+		storeCall *storeCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			driverID:    "12345",
+			requestBody: `{"lapRetentionMonths": 12}`,
+			getCall:     &getCall{driverID: 12345, result: &store.DriverSettings{DriverID: 12345}},
+			storeCall: &storeCall{
+				settings: store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_settings_success_response.json",
+		},
+		{
+			name:        "leaderboard opt in",
+			driverID:    "12345",
+			requestBody: `{"lapRetentionMonths": 12, "leaderboardOptIn": true}`,
+			getCall:     &getCall{driverID: 12345, result: &store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12}},
+			storeCall: &storeCall{
+				settings: store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12, LeaderboardOptIn: true},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_settings_leaderboard_opt_in_response.json",
+		},
+		{
+			name:        "benchmark opt in",
+			driverID:    "12345",
+			requestBody: `{"lapRetentionMonths": 12, "benchmarkOptIn": true}`,
+			getCall:     &getCall{driverID: 12345, result: &store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12}},
+			storeCall: &storeCall{
+				settings: store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12, BenchmarkOptIn: true},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_settings_benchmark_opt_in_response.json",
+		},
+		{
+			name:        "keep laps forever",
+			driverID:    "12345",
+			requestBody: `{"lapRetentionMonths": 0}`,
+			getCall:     &getCall{driverID: 12345, result: &store.DriverSettings{DriverID: 12345, LapRetentionMonths: 6}},
+			storeCall: &storeCall{
+				settings: store.DriverSettings{DriverID: 12345},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_settings_keep_forever_response.json",
+		},
+		{
+			name:        "summary only ingestion turned on",
+			driverID:    "12345",
+			requestBody: `{"lapRetentionMonths": 0, "summaryOnlyIngestion": true}`,
+			getCall:     &getCall{driverID: 12345, result: &store.DriverSettings{DriverID: 12345}},
+			storeCall: &storeCall{
+				settings: store.DriverSettings{DriverID: 12345, SummaryOnlyIngestion: true},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_settings_summary_only_response.json",
+		},
+		{
+			name:        "summary only ingestion turned off - flags lap backfill",
+			driverID:    "12345",
+			requestBody: `{"lapRetentionMonths": 12, "summaryOnlyIngestion": false}`,
+			getCall:     &getCall{driverID: 12345, result: &store.DriverSettings{DriverID: 12345, SummaryOnlyIngestion: true}},
+			storeCall: &storeCall{
+				settings: store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12, LapBackfillPending: true},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_settings_success_response.json",
+		},
+		{
+			name:        "lap backfill still pending - flag kept",
+			driverID:    "12345",
+			requestBody: `{"lapRetentionMonths": 12}`,
+			getCall:     &getCall{driverID: 12345, result: &store.DriverSettings{DriverID: 12345, LapBackfillPending: true}},
+			storeCall: &storeCall{
+				settings: store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12, LapBackfillPending: true},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_settings_success_response.json",
+		},
+		{
+			name:        "timezone",
+			driverID:    "12345",
+			requestBody: `{"lapRetentionMonths": 12, "timezone": "America/Chicago"}`,
+			getCall:     &getCall{driverID: 12345, result: &store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12}},
+			storeCall: &storeCall{
+				settings: store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12, Timezone: "America/Chicago"},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_settings_timezone_response.json",
+		},
+		{
+			name:                "unknown timezone",
+			driverID:            "12345",
+			requestBody:         `{"lapRetentionMonths": 12, "timezone": "Mars/Olympus_Mons"}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/save_settings_invalid_timezone_response.json",
+		},
+		{
+			name:        "locale",
+			driverID:    "12345",
+			requestBody: `{"lapRetentionMonths": 12, "locale": "pt-BR"}`,
+			getCall:     &getCall{driverID: 12345, result: &store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12}},
+			storeCall: &storeCall{
+				settings: store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12, Locale: "pt-BR"},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/save_settings_locale_response.json",
+		},
+		{
+			name:                "unsupported locale",
+			driverID:            "12345",
+			requestBody:         `{"lapRetentionMonths": 12, "locale": "tlh"}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/save_settings_invalid_locale_response.json",
+		},
+		{
+			name:                "negative retention",
+			driverID:            "12345",
+			requestBody:         `{"lapRetentionMonths": -1}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/save_settings_invalid_retention_response.json",
+		},
+		{
+			name:                "invalid json",
+			driverID:            "12345",
+			requestBody:         `{"lapRetentionMonths": "forever"`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/save_settings_invalid_json_response.json",
+		},
+		{
+			name:        "store error",
+			driverID:    "12345",
+			requestBody: `{"lapRetentionMonths": 12}`,
+			getCall:     &getCall{driverID: 12345, result: &store.DriverSettings{DriverID: 12345}},
+			storeCall: &storeCall{
+				settings: store.DriverSettings{DriverID: 12345, LapRetentionMonths: 12},
+				err:      errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/save_settings_store_error_response.json",
+		},
+		{
+			name:                "get current settings error",
+			driverID:            "12345",
+			requestBody:         `{"lapRetentionMonths": 12}`,
+			getCall:             &getCall{driverID: 12345, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/save_settings_get_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockSaveSettingsStore(t)
+			if tc.getCall != nil {
+				mockStore.EXPECT().GetDriverSettings(mock.Anything, tc.getCall.driverID).Return(tc.getCall.result, tc.getCall.err)
+			}
+			if tc.storeCall != nil {
+				mockStore.EXPECT().SaveDriverSettings(mock.Anything, tc.storeCall.settings).Return(tc.storeCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Put("/{driver_id}/settings", NewSaveSettingsEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPut, ts.URL+"/"+tc.driverID+"/settings", bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/actionitem"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type ActionItemServiceForUpdate interface {
+	Update(ctx context.Context, input actionitem.UpdateInput) (*store.ActionItem, error)
+}
+
+func NewUpdateActionItemEndpoint(actionItemService ActionItemServiceForUpdate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		itemID := chi.URLParam(r, "action_item_id")
+		if itemID == "" {
+			errs = errs.WithFieldError("action_item_id", "required")
+		}
+
+		var req UpdateActionItemRequest
+		input := actionitem.UpdateInput{DriverID: driverID, ItemID: itemID}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			if req.Title != nil {
+				for _, v := range actionitem.ValidateTitle(*req.Title) {
+					errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+				}
+				input.Title = req.Title
+			}
+			if req.Status != nil {
+				status := store.ActionItemStatus(*req.Status)
+				if status != store.ActionItemOpen && status != store.ActionItemDone {
+					errs = errs.WithFieldErrorCode("status", ErrCodeInvalidValue, map[string]string{
+						"value":   *req.Status,
+						"allowed": "open, done",
+					})
+				}
+				input.Status = &status
+			}
+			if req.DueDate != nil {
+				if *req.DueDate == "" {
+					input.ClearDueDate = true
+				} else if parsed, err := time.Parse(time.RFC3339, *req.DueDate); err != nil {
+					errs = errs.WithFieldErrorCode("dueDate", ErrCodeInvalidISO8601, nil)
+				} else {
+					input.DueDate = &parsed
+				}
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		item, err := actionItemService.Update(ctx, input)
+		if err != nil {
+			logger.Error().Err(err).Str("itemId", itemID).Msg("failed to update action item")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		if item == nil {
+			api.DoNotFoundResponse(ctx, "action item not found", w)
+			return
+		}
+
+		api.DoOKResponse(ctx, actionItemFromStore(*item), w)
+	})
+}
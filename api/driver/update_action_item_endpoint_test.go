@@ -0,0 +1,149 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/actionitem"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpdateActionItemEndpoint(t *testing.T) {
+	now := time.Unix(1700100000, 0)
+	done := store.ActionItemDone
+	testItem := store.ActionItem{
+		DriverID:    12345,
+		ItemID:      "item-1",
+		Title:       "Brake earlier into turn one",
+		Status:      store.ActionItemDone,
+		RaceID:      1700000000,
+		TrackID:     42,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		CompletedAt: &now,
+	}
+	completeInput := actionitem.UpdateInput{
+		DriverID:     12345,
+		ItemID:       "item-1",
+		Status:       &done,
+		ClearDueDate: true,
+	}
+	completeBody := `{"status": "done", "dueDate": ""}`
+
+	type updateCall struct {
+		input actionitem.UpdateInput
+		item  *store.ActionItem
+		err   error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		itemID      string
+		requestBody string
+
+		updateCalls []updateCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			driverID:    "12345",
+			itemID:      "item-1",
+			requestBody: completeBody,
+			updateCalls: []updateCall{
+				{input: completeInput, item: &testItem},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/update_action_item_success_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			driverID:            "12345",
+			itemID:              "item-1",
+			requestBody:         `{not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/update_action_item_invalid_json_response.json",
+		},
+		{
+			name:                "invalid request",
+			driverID:            "12345",
+			itemID:              "item-1",
+			requestBody:         `{"title": "` + strings.Repeat("a", actionitem.MaxTitleLength+1) + `", "status": "pending", "dueDate": "next tuesday"}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/update_action_item_invalid_request_response.json",
+		},
+		{
+			name:        "not found",
+			driverID:    "12345",
+			itemID:      "item-1",
+			requestBody: completeBody,
+			updateCalls: []updateCall{
+				{input: completeInput},
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/update_action_item_not_found_response.json",
+		},
+		{
+			name:        "service error",
+			driverID:    "12345",
+			itemID:      "item-1",
+			requestBody: completeBody,
+			updateCalls: []updateCall{
+				{input: completeInput, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/update_action_item_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockActionItemServiceForUpdate(t)
+			for _, call := range tc.updateCalls {
+				mockService.EXPECT().Update(mock.Anything, call.input).
+					Return(call.item, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Patch("/{driver_id}/action-items/{action_item_id}", NewUpdateActionItemEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/" + tc.driverID + "/action-items/" + tc.itemID
+			req, err := http.NewRequest(http.MethodPatch, url, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/alert"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type AlertServiceForUpdate interface {
+	Update(ctx context.Context, driverID int64, ruleID string, def alert.Definition) (*store.AlertRule, error)
+}
+
+// NewUpdateAlertRuleEndpoint replaces an alert rule's definition. The rule is rearmed, so it alerts the next time its
+// condition is met.
+func NewUpdateAlertRuleEndpoint(alertService AlertServiceForUpdate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		ruleID := chi.URLParam(r, "alert_rule_id")
+		if ruleID == "" {
+			errs = errs.WithFieldError("alert_rule_id", "required")
+		}
+
+		var req AlertRuleRequest
+		var def alert.Definition
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			def = alertDefinitionFromRequest(req)
+			for _, v := range alert.Validate(def) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		rule, err := alertService.Update(ctx, driverID, ruleID, def)
+		if err != nil {
+			logger.Error().Err(err).Str("ruleId", ruleID).Msg("failed to update alert rule")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		if rule == nil {
+			api.DoNotFoundResponse(ctx, "alert rule not found", w)
+			return
+		}
+
+		api.DoOKResponse(ctx, alertRuleFromStore(*rule), w)
+	})
+}
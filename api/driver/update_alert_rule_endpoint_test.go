@@ -0,0 +1,140 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/alert"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpdateAlertRuleEndpoint(t *testing.T) {
+	createdAt := time.Unix(1700000000, 0)
+	now := time.Unix(1700100000, 0)
+	testRule := store.AlertRule{
+		DriverID:        12345,
+		RuleID:          "rule-1",
+		Name:            "iRating slide",
+		Metric:          store.AlertMetricIRating,
+		Aggregate:       store.AlertAggregateChange,
+		WindowDays:      7,
+		Comparison:      store.AlertComparisonBelow,
+		Threshold:       -200,
+		LastTriggeredAt: &createdAt,
+		LastValue:       -240,
+		CreatedAt:       createdAt,
+		UpdatedAt:       now,
+	}
+	disableDef := alert.Definition{
+		Name:       "iRating slide",
+		Metric:     store.AlertMetricIRating,
+		Aggregate:  store.AlertAggregateChange,
+		WindowDays: 7,
+		Comparison: store.AlertComparisonBelow,
+		Threshold:  -200,
+	}
+	disableBody := `{"name": "iRating slide", "metric": "irating", "aggregate": "change", "windowDays": 7, "comparison": "below", "threshold": -200, "enabled": false}`
+
+	type updateCall struct {
+		def  alert.Definition
+		rule *store.AlertRule
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID    string
+		requestBody string
+
+		updateCalls []updateCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			driverID:    "12345",
+			requestBody: disableBody,
+			updateCalls: []updateCall{
+				{def: disableDef, rule: &testRule},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/update_alert_rule_success_response.json",
+		},
+		{
+			name:                "invalid request",
+			driverID:            "12345",
+			requestBody:         `{"name": "", "metric": "incidents", "aggregate": "change", "comparison": "equals"}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_alert_rule_invalid_request_response.json",
+		},
+		{
+			name:        "not found",
+			driverID:    "12345",
+			requestBody: disableBody,
+			updateCalls: []updateCall{
+				{def: disableDef, rule: nil},
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/update_alert_rule_not_found_response.json",
+		},
+		{
+			name:        "service error",
+			driverID:    "12345",
+			requestBody: disableBody,
+			updateCalls: []updateCall{
+				{def: disableDef, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/update_alert_rule_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockAlertServiceForUpdate(t)
+			for _, call := range tc.updateCalls {
+				mockService.EXPECT().Update(mock.Anything, int64(12345), "rule-1", call.def).
+					Return(call.rule, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Put("/{driver_id}/alert-rules/{alert_rule_id}", NewUpdateAlertRuleEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/" + tc.driverID + "/alert-rules/rule-1"
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
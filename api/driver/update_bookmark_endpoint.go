@@ -0,0 +1,94 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/bookmark"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type BookmarkServiceForUpdate interface {
+	Update(ctx context.Context, input bookmark.UpdateInput) (*store.RaceBookmark, error)
+}
+
+func NewUpdateBookmarkEndpoint(bookmarkService BookmarkServiceForUpdate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, api.DriverIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(api.DriverIDPathParam, "must be a valid integer")
+		}
+
+		var raceID int64
+		raceIDStr := chi.URLParam(r, "driver_race_id")
+		if raceIDStr == "" {
+			errs = errs.WithFieldError("driver_race_id", "required")
+		} else {
+			raceID, err = strconv.ParseInt(raceIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError("driver_race_id", "must be a valid integer")
+			}
+		}
+
+		bookmarkID := chi.URLParam(r, "bookmark_id")
+		if bookmarkID == "" {
+			errs = errs.WithFieldError("bookmark_id", "required")
+		}
+
+		var req UpdateBookmarkRequest
+		input := bookmark.UpdateInput{DriverID: driverID, RaceID: raceID, BookmarkID: bookmarkID}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			if req.Lap != nil {
+				for _, v := range bookmark.ValidateLap(*req.Lap) {
+					errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+				}
+				input.Lap = req.Lap
+			}
+			if req.Note != nil {
+				for _, v := range bookmark.ValidateNote(*req.Note) {
+					errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+				}
+				input.Note = req.Note
+			}
+			if req.ReplayTimeMs != nil {
+				replayTime := time.Duration(*req.ReplayTimeMs) * time.Millisecond
+				for _, v := range bookmark.ValidateReplayTime(replayTime) {
+					errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+				}
+				input.ReplayTime = &replayTime
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		updated, err := bookmarkService.Update(ctx, input)
+		if err != nil {
+			logger.Error().Err(err).Int64("raceId", raceID).Str("bookmarkId", bookmarkID).Msg("failed to update bookmark")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		if updated == nil {
+			api.DoNotFoundResponse(ctx, "bookmark not found", w)
+			return
+		}
+
+		api.DoOKResponse(ctx, bookmarkFromStore(*updated), w)
+	})
+}
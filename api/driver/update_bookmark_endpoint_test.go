@@ -0,0 +1,137 @@
+package driver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/bookmark"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpdateBookmarkEndpoint(t *testing.T) {
+	now := time.Unix(1700100000, 0)
+	lap := 4
+	replayTime := 301 * time.Second
+	testBookmark := store.RaceBookmark{
+		DriverID:   12345,
+		RaceID:     1700000000,
+		BookmarkID: "bookmark-1",
+		Lap:        4,
+		Note:       "Lost the rear on exit of turn four",
+		ReplayTime: replayTime,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	moveInput := bookmark.UpdateInput{
+		DriverID:   12345,
+		RaceID:     1700000000,
+		BookmarkID: "bookmark-1",
+		Lap:        &lap,
+		ReplayTime: &replayTime,
+	}
+	moveBody := `{"lap": 4, "replayTimeMs": 301000}`
+
+	type updateCall struct {
+		input    bookmark.UpdateInput
+		bookmark *store.RaceBookmark
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		requestBody string
+
+		updateCalls []updateCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			requestBody: moveBody,
+			updateCalls: []updateCall{
+				{input: moveInput, bookmark: &testBookmark},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/update_bookmark_success_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			requestBody:         `{not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/update_bookmark_invalid_json_response.json",
+		},
+		{
+			name:                "invalid request",
+			requestBody:         `{"note": " ", "replayTimeMs": -1}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/update_bookmark_invalid_request_response.json",
+		},
+		{
+			name:        "not found",
+			requestBody: moveBody,
+			updateCalls: []updateCall{
+				{input: moveInput},
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/update_bookmark_not_found_response.json",
+		},
+		{
+			name:        "service error",
+			requestBody: moveBody,
+			updateCalls: []updateCall{
+				{input: moveInput, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/update_bookmark_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockBookmarkServiceForUpdate(t)
+			for _, call := range tc.updateCalls {
+				mockService.EXPECT().Update(mock.Anything, call.input).
+					Return(call.bookmark, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Patch("/{driver_id}/races/{driver_race_id}/bookmarks/{bookmark_id}", NewUpdateBookmarkEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/12345/races/1700000000/bookmarks/bookmark-1"
+			req, err := http.NewRequest(http.MethodPatch, url, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package drivers
+
+import "github.com/jonsabados/saturdaysspinout/store"
+
+type SearchResponse struct {
+	Drivers []DriverMatch `json:"drivers"`
+}
+
+// DriverMatch is a driver whose name starts with what was searched for
+type DriverMatch struct {
+	DriverID   int64  `json:"driverId"`
+	DriverName string `json:"driverName"`
+}
+
+func driverMatchFromStore(entry store.DriverSearchEntry) DriverMatch {
+	return DriverMatch{
+		DriverID:   entry.DriverID,
+		DriverName: entry.DriverName,
+	}
+}
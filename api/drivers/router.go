@@ -0,0 +1,17 @@
+package drivers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+func NewRouter(searchStore SearchStore, authMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(authMiddleware)
+
+	r.Get("/search", api.WrapWithSegment("searchDrivers", NewSearchEndpoint(searchStore)).ServeHTTP)
+
+	return r
+}
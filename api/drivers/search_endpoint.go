@@ -0,0 +1,135 @@
+package drivers
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const (
+	ErrCodeRequired        = "required"
+	ErrCodeTooShort        = "too_short"
+	ErrCodeInvalidInteger  = "invalid_integer"
+	ErrCodePositiveInteger = "positive_integer"
+	ErrCodeTooLarge        = "too_large"
+)
+
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+	// searchCandidates is how many drivers matching by name are read before the ones the caller may not see are
+	// dropped, bounding the work a short, common prefix can cause at the cost of listed drivers sorting after that
+	// many unlisted ones not being found.
+	searchCandidates = 200
+	// developerEntitlement sees every driver, for support and admin tooling
+	developerEntitlement = "developer"
+)
+
+type SearchStore interface {
+	SearchDrivers(ctx context.Context, prefix string, limit int) ([]store.DriverSearchEntry, error)
+	GetDriverSettingsForDrivers(ctx context.Context, driverIDs []int64) ([]store.DriverSettings, error)
+}
+
+// NewSearchEndpoint creates the handler for GET /drivers/search, finding drivers whose name starts with the q query
+// param, ordered by name. Drivers are only found by others when they've opted in to being listed by name on the
+// leaderboards, callers always find themselves, and developers find everyone.
+func NewSearchEndpoint(searchStore SearchStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		if sessionClaims == nil {
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		errs := api.NewRequestErrors()
+
+		query := r.URL.Query().Get(api.SearchQueryParam)
+		if query == "" {
+			errs = errs.WithFieldErrorCode(api.SearchQueryParam, ErrCodeRequired, nil)
+		} else if len([]rune(store.DriverSearchName(query))) < store.MinDriverSearchLength {
+			errs = errs.WithFieldErrorCode(api.SearchQueryParam, ErrCodeTooShort, map[string]string{
+				"value":     query,
+				"minLength": strconv.Itoa(store.MinDriverSearchLength),
+			})
+		}
+
+		limit := defaultSearchLimit
+		if limitStr := r.URL.Query().Get(api.LimitQueryParam); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.LimitQueryParam, ErrCodeInvalidInteger, map[string]string{"value": limitStr})
+			} else if limit < 1 {
+				errs = errs.WithFieldErrorCode(api.LimitQueryParam, ErrCodePositiveInteger, map[string]string{"value": limitStr})
+			} else if limit > maxSearchLimit {
+				errs = errs.WithFieldErrorCode(api.LimitQueryParam, ErrCodeTooLarge, map[string]string{
+					"value": limitStr,
+					"max":   strconv.Itoa(maxSearchLimit),
+				})
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		seesEveryone := slices.Contains(sessionClaims.Entitlements, developerEntitlement)
+		candidateLimit := searchCandidates
+		if seesEveryone {
+			candidateLimit = limit
+		}
+
+		entries, err := searchStore.SearchDrivers(ctx, query, candidateLimit)
+		if err != nil {
+			logger.Error().Err(err).Str("query", query).Msg("failed to search drivers")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		if !seesEveryone {
+			entries, err = listedEntries(ctx, searchStore, entries, sessionClaims.IRacingUserID)
+			if err != nil {
+				logger.Error().Err(err).Str("query", query).Msg("failed to get settings of matching drivers")
+				api.DoErrorResponse(ctx, w)
+				return
+			}
+		}
+
+		response := SearchResponse{Drivers: make([]DriverMatch, 0, min(limit, len(entries)))}
+		for _, entry := range entries[:min(limit, len(entries))] {
+			response.Drivers = append(response.Drivers, driverMatchFromStore(entry))
+		}
+		api.DoOKResponse(ctx, response, w)
+	})
+}
+
+// listedEntries keeps the drivers that have opted in to being listed by name, along with the caller
+func listedEntries(ctx context.Context, searchStore SearchStore, entries []store.DriverSearchEntry, callerID int64) ([]store.DriverSearchEntry, error) {
+	if len(entries) == 0 {
+		return entries, nil
+	}
+	driverIDs := make([]int64, len(entries))
+	for i, entry := range entries {
+		driverIDs[i] = entry.DriverID
+	}
+	settings, err := searchStore.GetDriverSettingsForDrivers(ctx, driverIDs)
+	if err != nil {
+		return nil, err
+	}
+	listed := make([]store.DriverSearchEntry, 0, len(entries))
+	for i, entry := range entries {
+		if entry.DriverID == callerID || settings[i].LeaderboardOptIn {
+			listed = append(listed, entry)
+		}
+	}
+	return listed, nil
+}
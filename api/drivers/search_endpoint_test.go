@@ -0,0 +1,206 @@
+package drivers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+type stubTokenValidator struct {
+	sessionClaims *auth.SessionClaims
+}
+
+func (s *stubTokenValidator) ValidateToken(_ context.Context, _ string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+	return s.sessionClaims, &auth.SensitiveClaims{}, nil
+}
+
+func TestNewSearchEndpoint(t *testing.T) {
+	type searchCall struct {
+		prefix  string
+		limit   int
+		entries []store.DriverSearchEntry
+		err     error
+	}
+
+	type settingsCall struct {
+		driverIDs []int64
+		settings  []store.DriverSettings
+		err       error
+	}
+
+	matches := []store.DriverSearchEntry{
+		{DriverID: 1100750, DriverName: "Jon Sabados"},
+		{DriverID: 67890, DriverName: "Jonathan Doe"},
+		{DriverID: 12345, DriverName: "Jonas Quiet"},
+	}
+
+	testCases := []struct {
+		name string
+
+		query        string
+		entitlements []string
+
+		searchCall   *searchCall
+		settingsCall *settingsCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:  "drivers that opted out are left out",
+			query: "q=jon&limit=5",
+			searchCall: &searchCall{
+				prefix:  "jon",
+				limit:   searchCandidates,
+				entries: matches,
+			},
+			settingsCall: &settingsCall{
+				driverIDs: []int64{1100750, 67890, 12345},
+				settings: []store.DriverSettings{
+					{DriverID: 1100750},
+					{DriverID: 67890, LeaderboardOptIn: true},
+					{DriverID: 12345},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/search_listed_response.json",
+		},
+		{
+			name:  "limit applies after filtering",
+			query: "q=jon&limit=1",
+			searchCall: &searchCall{
+				prefix:  "jon",
+				limit:   searchCandidates,
+				entries: matches,
+			},
+			settingsCall: &settingsCall{
+				driverIDs: []int64{1100750, 67890, 12345},
+				settings: []store.DriverSettings{
+					{DriverID: 1100750},
+					{DriverID: 67890, LeaderboardOptIn: true},
+					{DriverID: 12345, LeaderboardOptIn: true},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/search_limited_response.json",
+		},
+		{
+			name:         "developers see everyone",
+			query:        "q=Jon",
+			entitlements: []string{"developer"},
+			searchCall: &searchCall{
+				prefix:  "Jon",
+				limit:   defaultSearchLimit,
+				entries: matches,
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/search_everyone_response.json",
+		},
+		{
+			name:  "no matches",
+			query: "q=zz",
+			searchCall: &searchCall{
+				prefix:  "zz",
+				limit:   searchCandidates,
+				entries: []store.DriverSearchEntry{},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/search_empty_response.json",
+		},
+		{
+			name:                "missing query",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/search_missing_query_response.json",
+		},
+		{
+			name:                "query too short",
+			query:               "q=+j+&limit=0",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/search_invalid_response.json",
+		},
+		{
+			name:                "limit too large",
+			query:               "q=jon&limit=51",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/search_limit_too_large_response.json",
+		},
+		{
+			name:                "search error",
+			query:               "q=jon",
+			searchCall:          &searchCall{prefix: "jon", limit: searchCandidates, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/search_error_response.json",
+		},
+		{
+			name:  "settings error",
+			query: "q=jon",
+			searchCall: &searchCall{
+				prefix:  "jon",
+				limit:   searchCandidates,
+				entries: matches,
+			},
+			settingsCall:        &settingsCall{driverIDs: []int64{1100750, 67890, 12345}, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/search_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			searchStore := NewMockSearchStore(t)
+			if tc.searchCall != nil {
+				searchStore.EXPECT().SearchDrivers(mock.Anything, tc.searchCall.prefix, tc.searchCall.limit).Return(tc.searchCall.entries, tc.searchCall.err)
+			}
+			if tc.settingsCall != nil {
+				searchStore.EXPECT().GetDriverSettingsForDrivers(mock.Anything, tc.settingsCall.driverIDs).Return(tc.settingsCall.settings, tc.settingsCall.err)
+			}
+
+			validator := &stubTokenValidator{sessionClaims: &auth.SessionClaims{
+				IRacingUserID:   1100750,
+				IRacingUserName: "Jon Sabados",
+				Entitlements:    tc.entitlements,
+			}}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(validator))
+			r.Get("/search", NewSearchEndpoint(searchStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/search?"+tc.query, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// UnknownFieldError is returned when a requested field isn't part of the response.
+type UnknownFieldError struct {
+	Field string
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("unknown field %s", e.Field)
+}
+
+// FieldSelection is the sparse fieldset a caller asked for with the fields query param, trimming a response down to
+// the named fields. The zero value selects everything.
+type FieldSelection struct {
+	// fields maps selected json field names to what is selected beneath them, a nil map selects everything
+	fields map[string]FieldSelection
+}
+
+// ParseFieldSelection reads the fields query param for an endpoint responding with T. Fields are comma separated json
+// field names, the param may be repeated, and dots reach into nested objects, e.g. fields=track.trackName,results.
+// Arrays are stepped through, so a nested field applies to every element. Naming an object selects all of it.
+func ParseFieldSelection[T any](r *http.Request) (FieldSelection, error) {
+	var ret FieldSelection
+	for _, value := range r.URL.Query()[FieldsQueryParam] {
+		for _, field := range strings.Split(value, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+			if !fieldExists(reflect.TypeFor[T](), strings.Split(field, ".")) {
+				return FieldSelection{}, &UnknownFieldError{Field: field}
+			}
+			ret.add(strings.Split(field, "."))
+		}
+	}
+	return ret, nil
+}
+
+// IsEmpty is true when no fields were asked for, selecting everything.
+func (s FieldSelection) IsEmpty() bool {
+	return s.fields == nil
+}
+
+func (s *FieldSelection) add(path []string) {
+	if s.fields == nil {
+		s.fields = make(map[string]FieldSelection)
+	}
+	child, seen := s.fields[path[0]]
+	if seen && child.IsEmpty() {
+		// already selected in full
+		return
+	}
+	if len(path) == 1 {
+		s.fields[path[0]] = FieldSelection{}
+		return
+	}
+	child.add(path[1:])
+	s.fields[path[0]] = child
+}
+
+// Select returns v trimmed down to the selected fields, ready to be used as a response.
+func (s FieldSelection) Select(v any) json.RawMessage {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Errorf("error marshalling response for field selection, this should not happen: %w", err))
+	}
+	if s.IsEmpty() {
+		return raw
+	}
+
+	var doc any
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	// keep numbers exactly as they were rendered
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		panic(fmt.Errorf("error decoding response for field selection, this should not happen: %w", err))
+	}
+	ret, err := json.Marshal(s.apply(doc))
+	if err != nil {
+		panic(fmt.Errorf("error marshalling selected fields, this should not happen: %w", err))
+	}
+	return ret
+}
+
+// SelectEach applies the selection to every item, for use with list responses.
+func SelectEach[T any](s FieldSelection, items []T) []json.RawMessage {
+	ret := make([]json.RawMessage, len(items))
+	for i, item := range items {
+		ret[i] = s.Select(item)
+	}
+	return ret
+}
+
+func (s FieldSelection) apply(doc any) any {
+	if s.IsEmpty() {
+		return doc
+	}
+	switch d := doc.(type) {
+	case map[string]any:
+		ret := make(map[string]any, len(s.fields))
+		for name, child := range s.fields {
+			// fields left out with omitempty stay left out
+			if value, ok := d[name]; ok {
+				ret[name] = child.apply(value)
+			}
+		}
+		return ret
+	case []any:
+		for i, element := range d {
+			d[i] = s.apply(element)
+		}
+		return d
+	default:
+		return doc
+	}
+}
+
+var (
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// fieldExists walks path through the json rendering of t.
+func fieldExists(t reflect.Type, path []string) bool {
+	if len(path) == 0 {
+		return true
+	}
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	switch {
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType),
+		t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		// renders itself, so there is nothing to reach into
+		return false
+	case t.Kind() == reflect.Map || t.Kind() == reflect.Interface:
+		// keys aren't known until the response is built
+		return path[0] != ""
+	case t.Kind() == reflect.Struct:
+		fieldType, ok := jsonField(t, path[0])
+		return ok && fieldExists(fieldType, path[1:])
+	default:
+		return false
+	}
+}
+
+// jsonField finds the type of the field t renders under name, including fields promoted from embedded structs.
+func jsonField(t reflect.Type, name string) (reflect.Type, bool) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		tagName, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && tagName == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if ret, ok := jsonField(embedded, name); ok {
+					return ret, true
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if tagName == "" {
+			tagName = field.Name
+		}
+		if tagName == name {
+			return field.Type, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fieldsTestTrack struct {
+	TrackID   int64  `json:"trackId"`
+	TrackName string `json:"trackName"`
+}
+
+type fieldsTestResult struct {
+	Position int     `json:"position"`
+	Name     string  `json:"name"`
+	Interval *int    `json:"interval,omitempty"`
+	Ignored  string  `json:"-"`
+	Best     float64 `json:"best"`
+}
+
+type fieldsTestBase struct {
+	ID int64 `json:"id"`
+}
+
+type fieldsTestResponse struct {
+	fieldsTestBase
+	StartTime time.Time          `json:"startTime"`
+	Track     fieldsTestTrack    `json:"track"`
+	Results   []fieldsTestResult `json:"results"`
+	Extra     map[string]int     `json:"extra"`
+}
+
+func TestParseFieldSelection(t *testing.T) {
+	testCases := []struct {
+		name          string
+		query         string
+		expectedEmpty bool
+		expectedErr   string
+	}{
+		{
+			name:          "no fields",
+			query:         "",
+			expectedEmpty: true,
+		},
+		{
+			name:          "only blanks",
+			query:         "fields=,%20",
+			expectedEmpty: true,
+		},
+		{
+			name:  "top level, nested and promoted fields",
+			query: "fields=id,track.trackName,results.position&fields=startTime",
+		},
+		{
+			name:  "map keys",
+			query: "fields=extra.anything",
+		},
+		{
+			name:        "unknown field",
+			query:       "fields=id,nope",
+			expectedErr: "unknown field nope",
+		},
+		{
+			name:        "unknown nested field",
+			query:       "fields=track.nope",
+			expectedErr: "unknown field track.nope",
+		},
+		{
+			name:        "field left out of json",
+			query:       "fields=results.Ignored",
+			expectedErr: "unknown field results.Ignored",
+		},
+		{
+			name:        "reaching into a value",
+			query:       "fields=results.position.value",
+			expectedErr: "unknown field results.position.value",
+		},
+		{
+			name:        "reaching into a time",
+			query:       "fields=startTime.wall",
+			expectedErr: "unknown field startTime.wall",
+		},
+		{
+			name:        "empty segment",
+			query:       "fields=track..trackName",
+			expectedErr: "unknown field track..trackName",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/thing?"+tc.query, nil)
+
+			selection, err := ParseFieldSelection[fieldsTestResponse](r)
+			if tc.expectedErr != "" {
+				var unknown *UnknownFieldError
+				require.ErrorAs(t, err, &unknown)
+				assert.Equal(t, tc.expectedErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedEmpty, selection.IsEmpty())
+		})
+	}
+}
+
+func TestFieldSelection_Select(t *testing.T) {
+	interval := 1500
+	response := fieldsTestResponse{
+		fieldsTestBase: fieldsTestBase{ID: 12345678901234567},
+		StartTime:      time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+		Track:          fieldsTestTrack{TrackID: 7, TrackName: "Spa"},
+		Results: []fieldsTestResult{
+			{Position: 1, Name: "Alice", Best: 120.5},
+			{Position: 2, Name: "Bob", Interval: &interval, Best: 121.25},
+		},
+		Extra: map[string]int{"a": 1, "b": 2},
+	}
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "nothing selected",
+			query:    "",
+			expected: `{"id":12345678901234567,"startTime":"2025-03-01T12:00:00Z","track":{"trackId":7,"trackName":"Spa"},"results":[{"position":1,"name":"Alice","best":120.5},{"position":2,"name":"Bob","interval":1500,"best":121.25}],"extra":{"a":1,"b":2}}`,
+		},
+		{
+			name:     "top level fields",
+			query:    "fields=id,startTime",
+			expected: `{"id":12345678901234567,"startTime":"2025-03-01T12:00:00Z"}`,
+		},
+		{
+			name:     "nested fields through arrays",
+			query:    "fields=track.trackName,results.name,results.interval",
+			expected: `{"track":{"trackName":"Spa"},"results":[{"name":"Alice"},{"interval":1500,"name":"Bob"}]}`,
+		},
+		{
+			name:     "whole object wins over its fields",
+			query:    "fields=track.trackName,track",
+			expected: `{"track":{"trackId":7,"trackName":"Spa"}}`,
+		},
+		{
+			name:     "map keys",
+			query:    "fields=extra.b,extra.c",
+			expected: `{"extra":{"b":2}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/thing?"+tc.query, nil)
+			selection, err := ParseFieldSelection[fieldsTestResponse](r)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tc.expected, string(selection.Select(response)))
+		})
+	}
+}
+
+func TestSelectEach(t *testing.T) {
+	r := httptest.NewRequest("GET", "/thing?fields=name", nil)
+	selection, err := ParseFieldSelection[fieldsTestResult](r)
+	require.NoError(t, err)
+
+	selected := SelectEach(selection, []fieldsTestResult{{Position: 1, Name: "Alice"}, {Position: 2, Name: "Bob"}})
+	require.Len(t, selected, 2)
+	assert.JSONEq(t, `{"name":"Alice"}`, string(selected[0]))
+	assert.JSONEq(t, `{"name":"Bob"}`, string(selected[1]))
+}
@@ -0,0 +1,19 @@
+package health
+
+import (
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+type LivenessResponse struct {
+	Status string `json:"status"`
+}
+
+// NewLivenessEndpoint creates the handler for GET /healthz. It only confirms the process is up and serving requests,
+// dependencies are covered by /readyz.
+func NewLivenessEndpoint() http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		api.DoOKResponse(request.Context(), LivenessResponse{Status: StatusOK}, writer)
+	})
+}
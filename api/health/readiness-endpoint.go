@@ -0,0 +1,100 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+const (
+	StatusOK          = "ok"
+	StatusReady       = "ready"
+	StatusUnavailable = "unavailable"
+	StatusFailed      = "failed"
+)
+
+// Dependency is something the API needs in order to serve requests.
+type Dependency struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+type DependencyStatus struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latencyMs"`
+}
+
+type ReadinessResponse struct {
+	Status       string             `json:"status"`
+	Dependencies []DependencyStatus `json:"dependencies"`
+}
+
+// NewReadinessEndpoint creates the handler for GET /readyz. Dependencies are checked concurrently, each bounded by
+// checkTimeout, and a 503 is returned if any of them fail. Failure details are logged rather than returned since the
+// endpoint is unauthenticated.
+func NewReadinessEndpoint(checkTimeout time.Duration, dependencies ...Dependency) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ctx := request.Context()
+		logger := zerolog.Ctx(ctx)
+
+		statuses := make([]DependencyStatus, len(dependencies))
+		var wg sync.WaitGroup
+		for i, dependency := range dependencies {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+				defer cancel()
+
+				start := time.Now()
+				err := dependency.Check(checkCtx)
+				statuses[i] = DependencyStatus{
+					Name:      dependency.Name,
+					Status:    StatusOK,
+					LatencyMs: time.Since(start).Milliseconds(),
+				}
+				if err != nil {
+					logger.Warn().Err(err).Str("dependency", dependency.Name).Msg("readiness check failed")
+					statuses[i].Status = StatusFailed
+				}
+			}()
+		}
+		wg.Wait()
+
+		response := ReadinessResponse{
+			Status:       StatusReady,
+			Dependencies: statuses,
+		}
+		for _, status := range statuses {
+			if status.Status != StatusOK {
+				response.Status = StatusUnavailable
+				api.DoServiceUnavailableResponse(ctx, response, writer)
+				return
+			}
+		}
+		api.DoOKResponse(ctx, response, writer)
+	})
+}
+
+// HostResolvableCheck returns a check that passes when the host of rawURL resolves in DNS.
+func HostResolvableCheck(rawURL string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		parsed, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf("parsing url: %w", err)
+		}
+		if _, err := net.DefaultResolver.LookupHost(ctx, parsed.Hostname()); err != nil {
+			return fmt.Errorf("resolving %s: %w", parsed.Hostname(), err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+func TestNewReadinessEndpoint(t *testing.T) {
+	passing := func(ctx context.Context) error { return nil }
+
+	testCases := []struct {
+		name string
+
+		dependencies []Dependency
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name: "all dependencies ok",
+			dependencies: []Dependency{
+				{Name: "dynamodb", Check: passing},
+				{Name: "secrets", Check: passing},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/readiness_ready_response.json",
+		},
+		{
+			name: "dependency failing",
+			dependencies: []Dependency{
+				{Name: "dynamodb", Check: func(ctx context.Context) error { return errors.New("connection refused") }},
+				{Name: "secrets", Check: passing},
+			},
+			expectedStatus:      http.StatusServiceUnavailable,
+			expectedBodyFixture: "fixtures/readiness_unavailable_response.json",
+		},
+		{
+			name: "dependency timing out",
+			dependencies: []Dependency{
+				{Name: "dynamodb", Check: func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				}},
+				{Name: "secrets", Check: passing},
+			},
+			expectedStatus:      http.StatusServiceUnavailable,
+			expectedBodyFixture: "fixtures/readiness_unavailable_response.json",
+		},
+	}
+
+	// latency varies run to run, so it's zeroed out before comparing against fixtures
+	latencyPattern := regexp.MustCompile(`"latencyMs":\d+`)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/readyz", NewReadinessEndpoint(10*time.Millisecond, tc.dependencies...).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/readyz", nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), latencyPattern.ReplaceAllString(string(bodyBytes), `"latencyMs":0`))
+		})
+	}
+}
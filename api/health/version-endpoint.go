@@ -0,0 +1,15 @@
+package health
+
+import (
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/buildinfo"
+)
+
+// NewVersionEndpoint creates the handler for GET /version, reporting the build that is serving requests.
+func NewVersionEndpoint() http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		api.DoOKResponse(request.Context(), buildinfo.Get(), writer)
+	})
+}
@@ -0,0 +1,44 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/buildinfo"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVersionEndpoint(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+	r.Get("/version", NewVersionEndpoint().ServeHTTP)
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "/version")
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var body struct {
+		Response      buildinfo.Info `json:"response"`
+		CorrelationID string         `json:"correlationId"`
+	}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+
+	// ldflags aren't set for tests so the defaults come through
+	assert.Equal(t, buildinfo.Info{
+		GitSHA:    "dev",
+		BuildTime: "unknown",
+		GoVersion: runtime.Version(),
+	}, body.Response)
+	assert.Equal(t, testCorrelationID, body.CorrelationID)
+}
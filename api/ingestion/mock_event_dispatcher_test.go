@@ -7,6 +7,7 @@ package ingestion
 import (
 	"context"
 
+	"github.com/jonsabados/saturdaysspinout/event"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,16 +39,22 @@ func (_m *MockEventDispatcher) EXPECT() *MockEventDispatcher_Expecter {
 }
 
 // PublishEvent provides a mock function for the type MockEventDispatcher
-func (_mock *MockEventDispatcher) PublishEvent(ctx context.Context, event any) error {
-	ret := _mock.Called(ctx, event)
+func (_mock *MockEventDispatcher) PublishEvent(ctx context.Context, evt any, opts ...event.PublishOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, evt, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, evt)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for PublishEvent")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, any) error); ok {
-		r0 = returnFunc(ctx, event)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, any, ...event.PublishOption) error); ok {
+		r0 = returnFunc(ctx, evt, opts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -61,12 +68,14 @@ type MockEventDispatcher_PublishEvent_Call struct {
 
 // PublishEvent is a helper method to define mock.On call
 //   - ctx context.Context
-//   - event any
-func (_e *MockEventDispatcher_Expecter) PublishEvent(ctx interface{}, event interface{}) *MockEventDispatcher_PublishEvent_Call {
-	return &MockEventDispatcher_PublishEvent_Call{Call: _e.mock.On("PublishEvent", ctx, event)}
+//   - evt any
+//   - opts ...event.PublishOption
+func (_e *MockEventDispatcher_Expecter) PublishEvent(ctx interface{}, evt interface{}, opts ...interface{}) *MockEventDispatcher_PublishEvent_Call {
+	return &MockEventDispatcher_PublishEvent_Call{Call: _e.mock.On("PublishEvent",
+		append([]interface{}{ctx, evt}, opts...)...)}
 }
 
-func (_c *MockEventDispatcher_PublishEvent_Call) Run(run func(ctx context.Context, event any)) *MockEventDispatcher_PublishEvent_Call {
+func (_c *MockEventDispatcher_PublishEvent_Call) Run(run func(ctx context.Context, evt any, opts ...event.PublishOption)) *MockEventDispatcher_PublishEvent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -76,9 +85,16 @@ func (_c *MockEventDispatcher_PublishEvent_Call) Run(run func(ctx context.Contex
 		if args[1] != nil {
 			arg1 = args[1].(any)
 		}
+		var arg2 []event.PublishOption
+		var variadicArgs []event.PublishOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]event.PublishOption)
+		}
+		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
+			arg2...,
 		)
 	})
 	return _c
@@ -89,7 +105,7 @@ func (_c *MockEventDispatcher_PublishEvent_Call) Return(err error) *MockEventDis
 	return _c
 }
 
-func (_c *MockEventDispatcher_PublishEvent_Call) RunAndReturn(run func(ctx context.Context, event any) error) *MockEventDispatcher_PublishEvent_Call {
+func (_c *MockEventDispatcher_PublishEvent_Call) RunAndReturn(run func(ctx context.Context, evt any, opts ...event.PublishOption) error) *MockEventDispatcher_PublishEvent_Call {
 	_c.Call.Return(run)
 	return _c
 }
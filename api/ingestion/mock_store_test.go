@@ -38,28 +38,91 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
-// GetDriver provides a mock function for the type MockStore
-func (_mock *MockStore) GetDriver(ctx context.Context, driverID int64) (*store.Driver, error) {
+// ClearIngestionCancel provides a mock function for the type MockStore
+func (_mock *MockStore) ClearIngestionCancel(ctx context.Context, driverID int64) error {
 	ret := _mock.Called(ctx, driverID)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ClearIngestionCancel")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, driverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_ClearIngestionCancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearIngestionCancel'
+type MockStore_ClearIngestionCancel_Call struct {
+	*mock.Call
+}
+
+// ClearIngestionCancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+func (_e *MockStore_Expecter) ClearIngestionCancel(ctx interface{}, driverID interface{}) *MockStore_ClearIngestionCancel_Call {
+	return &MockStore_ClearIngestionCancel_Call{Call: _e.mock.On("ClearIngestionCancel", ctx, driverID)}
+}
+
+func (_c *MockStore_ClearIngestionCancel_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_ClearIngestionCancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ClearIngestionCancel_Call) Return(err error) *MockStore_ClearIngestionCancel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_ClearIngestionCancel_Call) RunAndReturn(run func(ctx context.Context, driverID int64) error) *MockStore_ClearIngestionCancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDriver provides a mock function for the type MockStore
+func (_mock *MockStore) GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID)
+	}
+	ret := tmpRet
+
 	if len(ret) == 0 {
 		panic("no return value specified for GetDriver")
 	}
 
 	var r0 *store.Driver
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*store.Driver, error)); ok {
-		return returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) (*store.Driver, error)); ok {
+		return returnFunc(ctx, driverID, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *store.Driver); ok {
-		r0 = returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) *store.Driver); ok {
+		r0 = returnFunc(ctx, driverID, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*store.Driver)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -74,11 +137,13 @@ type MockStore_GetDriver_Call struct {
 // GetDriver is a helper method to define mock.On call
 //   - ctx context.Context
 //   - driverID int64
-func (_e *MockStore_Expecter) GetDriver(ctx interface{}, driverID interface{}) *MockStore_GetDriver_Call {
-	return &MockStore_GetDriver_Call{Call: _e.mock.On("GetDriver", ctx, driverID)}
+//   - opts ...store.ReadOption
+func (_e *MockStore_Expecter) GetDriver(ctx interface{}, driverID interface{}, opts ...interface{}) *MockStore_GetDriver_Call {
+	return &MockStore_GetDriver_Call{Call: _e.mock.On("GetDriver",
+		append([]interface{}{ctx, driverID}, opts...)...)}
 }
 
-func (_c *MockStore_GetDriver_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_GetDriver_Call {
+func (_c *MockStore_GetDriver_Call) Run(run func(ctx context.Context, driverID int64, opts ...store.ReadOption)) *MockStore_GetDriver_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -88,9 +153,16 @@ func (_c *MockStore_GetDriver_Call) Run(run func(ctx context.Context, driverID i
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]store.ReadOption)
+		}
+		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
+			arg2...,
 		)
 	})
 	return _c
@@ -101,7 +173,7 @@ func (_c *MockStore_GetDriver_Call) Return(driver *store.Driver, err error) *Moc
 	return _c
 }
 
-func (_c *MockStore_GetDriver_Call) RunAndReturn(run func(ctx context.Context, driverID int64) (*store.Driver, error)) *MockStore_GetDriver_Call {
+func (_c *MockStore_GetDriver_Call) RunAndReturn(run func(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)) *MockStore_GetDriver_Call {
 	_c.Call.Return(run)
 	return _c
 }
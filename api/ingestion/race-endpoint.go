@@ -4,24 +4,34 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/event"
 	"github.com/jonsabados/saturdaysspinout/ingestion"
 	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
 	"github.com/rs/zerolog"
 )
 
+// developerEntitlement gates dry runs and captures, which are for checking ingestion changes against real accounts and
+// reproducing ingestion bugs.
+const developerEntitlement = "developer"
+
 type RaceIngestionRequest struct {
 	NotifyConnectionID string `json:"notifyConnectionId"`
+	DryRun             bool   `json:"dryRun"`
+	Capture            bool   `json:"capture"`
 }
 
 type EventDispatcher interface {
-	PublishEvent(ctx context.Context, event any) error
+	PublishEvent(ctx context.Context, evt any, opts ...event.PublishOption) error
 }
 
 type Store interface {
-	GetDriver(ctx context.Context, driverID int64) (*store.Driver, error)
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	ClearIngestionCancel(ctx context.Context, driverID int64) error
 }
 
 func NewRaceIngestionEndpoint(driverStore Store, dispatcher EventDispatcher) http.Handler {
@@ -76,18 +86,36 @@ func NewRaceIngestionEndpoint(driverStore Store, dispatcher EventDispatcher) htt
 			api.DoBadRequestResponse(ctx, errs, writer)
 			return
 		}
+		if req.DryRun && !slices.Contains(sessionClaims.Entitlements, developerEntitlement) {
+			api.DoForbiddenResponse(ctx, "dry runs require the developer entitlement", writer)
+			return
+		}
+		if req.Capture && !slices.Contains(sessionClaims.Entitlements, developerEntitlement) {
+			api.DoForbiddenResponse(ctx, "captures require the developer entitlement", writer)
+			return
+		}
+
+		// a cancel left over from an earlier sync would otherwise stop this one before it starts
+		if err := driverStore.ClearIngestionCancel(ctx, sessionClaims.IRacingUserID); err != nil {
+			logger.Error().Err(err).Msg("failed to clear ingestion cancel flag")
+			api.DoErrorResponse(ctx, writer)
+			return
+		}
 
 		if err := dispatcher.PublishEvent(ctx, ingestion.RaceIngestionRequest{
 			DriverID:           sessionClaims.IRacingUserID,
 			IRacingAccessToken: sensitiveClaims.IRacingAccessToken,
 			NotifyConnectionID: req.NotifyConnectionID,
+			TenantID:           tenant.FromContext(ctx),
+			DryRun:             req.DryRun,
+			Capture:            req.Capture,
 		}); err != nil {
 			logger.Error().Err(err).Msg("failed to publish race ingestion event")
 			api.DoErrorResponse(ctx, writer)
 			return
 		}
 
-		logger.Info().Int64("driverId", sessionClaims.IRacingUserID).Msg("race ingestion request queued")
+		logger.Info().Bool("dryRun", req.DryRun).Bool("capture", req.Capture).Msg("race ingestion request queued")
 
 		api.DoAcceptedResponse(ctx, map[string]string{"status": "queued"}, writer)
 	})
@@ -38,6 +38,11 @@ func TestNewRaceIngestionEndpoint(t *testing.T) {
 		IRacingUserID:   1100750,
 		IRacingUserName: "Jon Sabados",
 	}
+	testDeveloperClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+		Entitlements:    []string{"developer"},
+	}
 	testSensitiveClaims := &auth.SensitiveClaims{
 		IRacingAccessToken: "test-access-token",
 	}
@@ -48,6 +53,11 @@ func TestNewRaceIngestionEndpoint(t *testing.T) {
 		err      error
 	}
 
+	type clearIngestionCancelCall struct {
+		driverID int64
+		err      error
+	}
+
 	type publishEventCall struct {
 		event ingestion.RaceIngestionRequest
 		err   error
@@ -61,8 +71,9 @@ func TestNewRaceIngestionEndpoint(t *testing.T) {
 		tokenErr        error
 		requestBody     string
 
-		getDriverCall     *getDriverCall
-		publishEventCall  *publishEventCall
+		getDriverCall            *getDriverCall
+		clearIngestionCancelCall *clearIngestionCancelCall
+		publishEventCall         *publishEventCall
 
 		expectedResponseStatus      int
 		expectedResponseBodyFixture string
@@ -131,6 +142,10 @@ func TestNewRaceIngestionEndpoint(t *testing.T) {
 				},
 				err: nil,
 			},
+			clearIngestionCancelCall: &clearIngestionCancelCall{
+				driverID: 1100750,
+				err:      nil,
+			},
 			publishEventCall: &publishEventCall{
 				event: ingestion.RaceIngestionRequest{
 					DriverID:           1100750,
@@ -168,6 +183,23 @@ func TestNewRaceIngestionEndpoint(t *testing.T) {
 			expectedResponseStatus:      http.StatusBadRequest,
 			expectedResponseBodyFixture: "fixtures/race_endpoint_missing_connection_id_response.json",
 		},
+		{
+			name:            "clear cancel flag error returns 500",
+			sessionClaims:   testSessionClaims,
+			sensitiveClaims: testSensitiveClaims,
+			requestBody:     `{"notifyConnectionId": "conn-123"}`,
+			getDriverCall: &getDriverCall{
+				driverID: 1100750,
+				driver:   &store.Driver{DriverID: 1100750},
+				err:      nil,
+			},
+			clearIngestionCancelCall: &clearIngestionCancelCall{
+				driverID: 1100750,
+				err:      errors.New("database error"),
+			},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/race_endpoint_clear_cancel_error_response.json",
+		},
 		{
 			name:            "dispatcher error returns 500",
 			sessionClaims:   testSessionClaims,
@@ -178,6 +210,10 @@ func TestNewRaceIngestionEndpoint(t *testing.T) {
 				driver:   &store.Driver{DriverID: 1100750},
 				err:      nil,
 			},
+			clearIngestionCancelCall: &clearIngestionCancelCall{
+				driverID: 1100750,
+				err:      nil,
+			},
 			publishEventCall: &publishEventCall{
 				event: ingestion.RaceIngestionRequest{
 					DriverID:           1100750,
@@ -189,6 +225,84 @@ func TestNewRaceIngestionEndpoint(t *testing.T) {
 			expectedResponseStatus:      http.StatusInternalServerError,
 			expectedResponseBodyFixture: "fixtures/race_endpoint_dispatcher_error_response.json",
 		},
+		{
+			name:            "dry run without developer entitlement returns 403",
+			sessionClaims:   testSessionClaims,
+			sensitiveClaims: testSensitiveClaims,
+			requestBody:     `{"notifyConnectionId": "conn-123", "dryRun": true}`,
+			getDriverCall: &getDriverCall{
+				driverID: 1100750,
+				driver:   &store.Driver{DriverID: 1100750},
+				err:      nil,
+			},
+			expectedResponseStatus:      http.StatusForbidden,
+			expectedResponseBodyFixture: "fixtures/race_endpoint_dry_run_forbidden_response.json",
+		},
+		{
+			name:            "capture without developer entitlement returns 403",
+			sessionClaims:   testSessionClaims,
+			sensitiveClaims: testSensitiveClaims,
+			requestBody:     `{"notifyConnectionId": "conn-123", "capture": true}`,
+			getDriverCall: &getDriverCall{
+				driverID: 1100750,
+				driver:   &store.Driver{DriverID: 1100750},
+				err:      nil,
+			},
+			expectedResponseStatus:      http.StatusForbidden,
+			expectedResponseBodyFixture: "fixtures/race_endpoint_capture_forbidden_response.json",
+		},
+		{
+			name:            "capture for developer returns 202",
+			sessionClaims:   testDeveloperClaims,
+			sensitiveClaims: testSensitiveClaims,
+			requestBody:     `{"notifyConnectionId": "conn-123", "capture": true}`,
+			getDriverCall: &getDriverCall{
+				driverID: 1100750,
+				driver:   &store.Driver{DriverID: 1100750},
+				err:      nil,
+			},
+			clearIngestionCancelCall: &clearIngestionCancelCall{
+				driverID: 1100750,
+				err:      nil,
+			},
+			publishEventCall: &publishEventCall{
+				event: ingestion.RaceIngestionRequest{
+					DriverID:           1100750,
+					IRacingAccessToken: "test-access-token",
+					NotifyConnectionID: "conn-123",
+					Capture:            true,
+				},
+				err: nil,
+			},
+			expectedResponseStatus:      http.StatusAccepted,
+			expectedResponseBodyFixture: "fixtures/race_endpoint_accepted_response.json",
+		},
+		{
+			name:            "dry run for developer returns 202",
+			sessionClaims:   testDeveloperClaims,
+			sensitiveClaims: testSensitiveClaims,
+			requestBody:     `{"notifyConnectionId": "conn-123", "dryRun": true}`,
+			getDriverCall: &getDriverCall{
+				driverID: 1100750,
+				driver:   &store.Driver{DriverID: 1100750},
+				err:      nil,
+			},
+			clearIngestionCancelCall: &clearIngestionCancelCall{
+				driverID: 1100750,
+				err:      nil,
+			},
+			publishEventCall: &publishEventCall{
+				event: ingestion.RaceIngestionRequest{
+					DriverID:           1100750,
+					IRacingAccessToken: "test-access-token",
+					NotifyConnectionID: "conn-123",
+					DryRun:             true,
+				},
+				err: nil,
+			},
+			expectedResponseStatus:      http.StatusAccepted,
+			expectedResponseBodyFixture: "fixtures/race_endpoint_accepted_response.json",
+		},
 		{
 			name:            "success returns 202",
 			sessionClaims:   testSessionClaims,
@@ -199,6 +313,10 @@ func TestNewRaceIngestionEndpoint(t *testing.T) {
 				driver:   &store.Driver{DriverID: 1100750},
 				err:      nil,
 			},
+			clearIngestionCancelCall: &clearIngestionCancelCall{
+				driverID: 1100750,
+				err:      nil,
+			},
 			publishEventCall: &publishEventCall{
 				event: ingestion.RaceIngestionRequest{
 					DriverID:           1100750,
@@ -226,6 +344,9 @@ func TestNewRaceIngestionEndpoint(t *testing.T) {
 			if tc.getDriverCall != nil {
 				mockStore.EXPECT().GetDriver(mock.Anything, tc.getDriverCall.driverID).Return(tc.getDriverCall.driver, tc.getDriverCall.err)
 			}
+			if tc.clearIngestionCancelCall != nil {
+				mockStore.EXPECT().ClearIngestionCancel(mock.Anything, tc.clearIngestionCancelCall.driverID).Return(tc.clearIngestionCancelCall.err)
+			}
 
 			mockDispatcher := NewMockEventDispatcher(t)
 			if tc.publishEventCall != nil {
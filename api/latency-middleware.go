@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+)
+
+// unmatchedRoute stands in for the route of requests nothing was routed for, keeping arbitrary paths out of the
+// metric's dimensions
+const unmatchedRoute = "unmatched"
+
+type LatencyEmitter interface {
+	EmitDuration(ctx context.Context, name string, duration time.Duration, dimensions map[string]string) error
+}
+
+// LatencyMiddleware records how long each request took as api_latency, by route pattern and status class (2xx, 4xx
+// and so on), for latency SLOs to be tracked against p50, p90 and p99 of.
+func LatencyMiddleware(emitter LatencyEmitter) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			ww := middleware.NewWrapResponseWriter(writer, request.ProtoMajor)
+
+			t1 := time.Now()
+			defer func() {
+				latency := time.Since(t1)
+				ctx := request.Context()
+
+				route := routePattern(request)
+				if route == "" {
+					route = unmatchedRoute
+				}
+				err := emitter.EmitDuration(ctx, metrics.APILatency, latency, map[string]string{
+					metrics.DimensionRoute:       route,
+					metrics.DimensionStatusClass: statusClass(ww.Status()),
+				})
+				if err != nil {
+					zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to emit request latency")
+				}
+			}()
+
+			next.ServeHTTP(ww, request)
+		})
+	}
+}
+
+// statusClass buckets a status by its first digit, with handlers that never wrote a status having gone out as a 200.
+func statusClass(status int) string {
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return fmt.Sprintf("%dxx", status/100)
+}
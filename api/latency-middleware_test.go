@@ -0,0 +1,95 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+)
+
+func TestLatencyMiddleware(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		path      string
+		emitError error
+
+		expectedStatus     int
+		expectedDimensions map[string]string
+	}{
+		{
+			name:           "success",
+			path:           "/driver/1100750",
+			expectedStatus: http.StatusOK,
+			expectedDimensions: map[string]string{
+				metrics.DimensionRoute:       "/driver/{driver_id}",
+				metrics.DimensionStatusClass: "2xx",
+			},
+		},
+		{
+			name:           "handler never writing a status",
+			path:           "/empty",
+			expectedStatus: http.StatusOK,
+			expectedDimensions: map[string]string{
+				metrics.DimensionRoute:       "/empty",
+				metrics.DimensionStatusClass: "2xx",
+			},
+		},
+		{
+			name:           "error",
+			path:           "/driver/bogus/races",
+			expectedStatus: http.StatusBadRequest,
+			expectedDimensions: map[string]string{
+				metrics.DimensionRoute:       "/driver/{driver_id}/races",
+				metrics.DimensionStatusClass: "4xx",
+			},
+		},
+		{
+			name:           "nothing routed",
+			path:           "/wp-admin/install.php",
+			expectedStatus: http.StatusNotFound,
+			expectedDimensions: map[string]string{
+				metrics.DimensionRoute:       "unmatched",
+				metrics.DimensionStatusClass: "4xx",
+			},
+		},
+		{
+			name:           "emit failure doesn't fail the request",
+			path:           "/driver/1100750",
+			emitError:      errors.New("write failed"),
+			expectedStatus: http.StatusOK,
+			expectedDimensions: map[string]string{
+				metrics.DimensionRoute:       "/driver/{driver_id}",
+				metrics.DimensionStatusClass: "2xx",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			emitter := NewMockLatencyEmitter(t)
+			emitter.EXPECT().EmitDuration(mock.Anything, metrics.APILatency, mock.Anything, tc.expectedDimensions).Return(tc.emitError)
+
+			r := chi.NewRouter()
+			r.Use(LatencyMiddleware(emitter))
+			r.Get("/driver/{driver_id}", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			r.Get("/driver/{driver_id}/races", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			r.Get("/empty", func(w http.ResponseWriter, r *http.Request) {})
+
+			res := httptest.NewRecorder()
+			r.ServeHTTP(res, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package leaderboards
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/standings"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const (
+	ErrCodePositiveInteger = "positive_integer"
+	ErrCodeInvalidISO8601  = "invalid_iso8601"
+	ErrCodeInvalidValue    = "invalid_value"
+)
+
+var boards = map[string]bool{
+	store.LeaderboardIRatingGain:      true,
+	store.LeaderboardSafetyRatingGain: true,
+	store.LeaderboardCleanRaceStreak:  true,
+}
+
+type WeeklyLeaderboardStore interface {
+	GetRankedLeaderboard(ctx context.Context, board string, weekStart time.Time, offset, limit int) (*store.RankedLeaderboard, []store.LeaderboardRanking, error)
+	GetRankedLeaderboardByClub(ctx context.Context, board string, weekStart time.Time, clubID int) (*store.RankedLeaderboard, []store.LeaderboardRanking, error)
+}
+
+// NewGetWeeklyEndpoint serves a page of one of the weekly leaderboards, by default the iRating gain board for the
+// current race week. Any time within a race week selects that week. Leaderboards are computed periodically, so one
+// that hasn't been computed yet comes back empty. Filtering to a club (region) keeps each driver's overall rank.
+func NewGetWeeklyEndpoint(leaderboardStore WeeklyLeaderboardStore, now func() time.Time) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		board := store.LeaderboardIRatingGain
+		if b := r.URL.Query().Get(api.BoardQueryParam); b != "" {
+			board = b
+			if !boards[board] {
+				errs = errs.WithFieldErrorCode(api.BoardQueryParam, ErrCodeInvalidValue, map[string]string{
+					"value":   b,
+					"allowed": "irating_gain, sr_gain, clean_streak",
+				})
+			}
+		}
+
+		week := now()
+		if weekStr := r.URL.Query().Get(api.WeekQueryParam); weekStr != "" {
+			var err error
+			week, err = time.Parse(time.RFC3339, weekStr)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.WeekQueryParam, ErrCodeInvalidISO8601, nil)
+			}
+		}
+
+		clubID := 0
+		if clubStr := r.URL.Query().Get(api.ClubIDQueryParam); clubStr != "" {
+			var err error
+			clubID, err = strconv.Atoi(clubStr)
+			if err != nil || clubID < 1 {
+				errs = errs.WithFieldErrorCode(api.ClubIDQueryParam, ErrCodePositiveInteger, nil)
+			}
+		}
+
+		page := 1
+		if pageStr := r.URL.Query().Get(api.PageQueryParam); pageStr != "" {
+			var err error
+			page, err = strconv.Atoi(pageStr)
+			if err != nil || page < 1 {
+				errs = errs.WithFieldErrorCode(api.PageQueryParam, ErrCodePositiveInteger, nil)
+			}
+		}
+
+		resultsPerPage := api.DefaultResultsPerPage
+		if rppStr := r.URL.Query().Get(api.ResultsPerPageParam); rppStr != "" {
+			var err error
+			resultsPerPage, err = strconv.Atoi(rppStr)
+			if err != nil || resultsPerPage < 1 {
+				errs = errs.WithFieldErrorCode(api.ResultsPerPageParam, ErrCodePositiveInteger, nil)
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		weekStart := standings.WeekStart(week)
+		offset := (page - 1) * resultsPerPage
+		var rankings []store.LeaderboardRanking
+		var err error
+		totalResults := 0
+		if clubID != 0 {
+			// a club's share of a board is small enough to page through in memory
+			_, rankings, err = leaderboardStore.GetRankedLeaderboardByClub(ctx, board, weekStart, clubID)
+			totalResults = len(rankings)
+			rankings = rankings[min(offset, totalResults):min(offset+resultsPerPage, totalResults)]
+		} else {
+			var leaderboard *store.RankedLeaderboard
+			leaderboard, rankings, err = leaderboardStore.GetRankedLeaderboard(ctx, board, weekStart, offset, resultsPerPage)
+			if leaderboard != nil {
+				totalResults = leaderboard.TotalEntries
+			}
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("board", board).Time("weekStart", weekStart).Int("clubId", clubID).Msg("failed to fetch weekly leaderboard")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		items := make([]Ranking, len(rankings))
+		for i, ranking := range rankings {
+			items[i] = rankingFromStore(ranking)
+		}
+
+		api.DoOKListResponse(ctx, items, page, resultsPerPage, totalResults, w)
+	})
+}
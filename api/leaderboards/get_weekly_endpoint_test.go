@@ -0,0 +1,161 @@
+package leaderboards
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+func TestNewGetWeeklyEndpoint(t *testing.T) {
+	// Thursday, in the race week starting Tuesday 2026-01-06
+	now := time.Date(2026, 1, 8, 12, 0, 0, 0, time.UTC)
+	currentWeek := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+	previousWeek := time.Date(2025, 12, 30, 0, 0, 0, 0, time.UTC)
+
+	type storeCall struct {
+		board       string
+		weekStart   time.Time
+		offset      int
+		limit       int
+		leaderboard *store.RankedLeaderboard
+		rankings    []store.LeaderboardRanking
+		err         error
+	}
+
+	type clubStoreCall struct {
+		board     string
+		weekStart time.Time
+		clubID    int
+		rankings  []store.LeaderboardRanking
+		err       error
+	}
+
+	testCases := []struct {
+		name string
+
+		queryString string
+
+		storeCall     *storeCall
+		clubStoreCall *clubStoreCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			queryString: "board=sr_gain&week=2026-01-04T18:30:00Z&page=2&resultsPerPage=2",
+			storeCall: &storeCall{
+				board:     store.LeaderboardSafetyRatingGain,
+				weekStart: previousWeek,
+				offset:    2,
+				limit:     2,
+				leaderboard: &store.RankedLeaderboard{
+					Board:        store.LeaderboardSafetyRatingGain,
+					WeekStart:    previousWeek,
+					ComputedAt:   time.Date(2026, 1, 6, 6, 0, 0, 0, time.UTC),
+					TotalEntries: 5,
+				},
+				rankings: []store.LeaderboardRanking{
+					{Rank: 3, DriverID: 1100750, DriverName: "Jon Sabados", Races: 4, Value: 112},
+					{Rank: 4, DriverID: 67890, DriverName: "Another Driver", Races: 2, Value: 38},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_weekly_success_response.json",
+		},
+		{
+			name: "defaults to current week irating board, not yet computed",
+			storeCall: &storeCall{
+				board:     store.LeaderboardIRatingGain,
+				weekStart: currentWeek,
+				offset:    0,
+				limit:     10,
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_weekly_not_computed_response.json",
+		},
+		{
+			name:        "filtered to a club",
+			queryString: "clubId=7&page=2&resultsPerPage=2",
+			clubStoreCall: &clubStoreCall{
+				board:     store.LeaderboardIRatingGain,
+				weekStart: currentWeek,
+				clubID:    7,
+				rankings: []store.LeaderboardRanking{
+					{Rank: 2, DriverID: 11, DriverName: "Driver Eleven", ClubID: 7, Races: 6, Value: 140},
+					{Rank: 5, DriverID: 12, DriverName: "Driver Twelve", ClubID: 7, Races: 3, Value: 72},
+					{Rank: 9, DriverID: 13, DriverName: "Driver Thirteen", ClubID: 7, Races: 2, Value: 20},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_weekly_club_response.json",
+		},
+		{
+			name:                "invalid params",
+			queryString:         "board=fastest_lap&week=last-week&clubId=-3&page=0&resultsPerPage=abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_weekly_invalid_params_response.json",
+		},
+		{
+			name: "store error",
+			storeCall: &storeCall{
+				board:     store.LeaderboardIRatingGain,
+				weekStart: currentWeek,
+				offset:    0,
+				limit:     10,
+				err:       errors.New("database error"),
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_weekly_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockWeeklyLeaderboardStore(t)
+			if tc.storeCall != nil {
+				mockStore.EXPECT().GetRankedLeaderboard(mock.Anything, tc.storeCall.board, tc.storeCall.weekStart, tc.storeCall.offset, tc.storeCall.limit).
+					Return(tc.storeCall.leaderboard, tc.storeCall.rankings, tc.storeCall.err)
+			}
+			if tc.clubStoreCall != nil {
+				mockStore.EXPECT().GetRankedLeaderboardByClub(mock.Anything, tc.clubStoreCall.board, tc.clubStoreCall.weekStart, tc.clubStoreCall.clubID).
+					Return(&store.RankedLeaderboard{Board: tc.clubStoreCall.board, WeekStart: tc.clubStoreCall.weekStart, TotalEntries: 20}, tc.clubStoreCall.rankings, tc.clubStoreCall.err)
+			}
+
+			endpoint := NewGetWeeklyEndpoint(mockStore, func() time.Time { return now })
+			handler := correlation.Middleware(func() string { return testCorrelationID })(endpoint)
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"?"+tc.queryString, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package leaderboards
+
+import "github.com/jonsabados/saturdaysspinout/store"
+
+// Ranking is a driver's place on a weekly leaderboard. Only drivers that opted in to the leaderboards are ranked.
+type Ranking struct {
+	Rank       int    `json:"rank"`
+	DriverID   int64  `json:"driverId"`
+	DriverName string `json:"driverName"`
+	Races      int    `json:"races"`
+	Value      int    `json:"value"`
+}
+
+func rankingFromStore(ranking store.LeaderboardRanking) Ranking {
+	return Ranking{
+		Rank:       ranking.Rank,
+		DriverID:   ranking.DriverID,
+		DriverName: ranking.DriverName,
+		Races:      ranking.Races,
+		Value:      ranking.Value,
+	}
+}
@@ -0,0 +1,18 @@
+package leaderboards
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+func NewRouter(leaderboardStore WeeklyLeaderboardStore, authMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(authMiddleware)
+
+	r.Get("/weekly", api.WrapWithSegment("getWeeklyLeaderboard", NewGetWeeklyEndpoint(leaderboardStore, time.Now)).ServeHTTP)
+
+	return r
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/supporter"
+)
+
+// LimitsMiddleware holds requests to the limits of the driver's entitlements. Time ranges reaching further back than
+// the driver's history allows are pulled forward, and analytics granularities they aren't allowed are refused. It
+// works off the query string so endpoints don't each need to know about limits.
+func LimitsMiddleware(now func() time.Time) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			sessionClaims := SessionClaimsFromContext(ctx)
+			if sessionClaims == nil {
+				DoUnauthorizedResponse(ctx, "missing session claims", w)
+				return
+			}
+			limits := supporter.LimitsFor(sessionClaims.Entitlements)
+
+			query := r.URL.Query()
+			if g := query.Get(GranularityQueryParam); g != "" {
+				granularity := analytics.Granularity(g)
+				// invalid values are left for the endpoint to reject
+				if granularity.IsValid() && !limits.AllowsGranularity(granularity) {
+					DoForbiddenResponse(ctx, "granularity requires a supporter subscription", w)
+					return
+				}
+			}
+
+			if limits.HistoryDays > 0 {
+				earliest := now().AddDate(0, 0, -limits.HistoryDays).UTC().Truncate(time.Second)
+				changed := false
+				for _, param := range []string{StartTimeQueryParam, EndTimeQueryParam} {
+					t, err := time.Parse(time.RFC3339, query.Get(param))
+					if err == nil && t.Before(earliest) {
+						query.Set(param, earliest.Format(time.RFC3339))
+						changed = true
+					}
+				}
+				if changed {
+					r = r.Clone(ctx)
+					r.URL.RawQuery = query.Encode()
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
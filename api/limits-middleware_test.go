@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitsMiddleware(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	freeClaims := &auth.SessionClaims{
+		IRacingUserID:   12345,
+		IRacingUserName: "Test Driver",
+	}
+	supporterClaims := &auth.SessionClaims{
+		IRacingUserID:   12345,
+		IRacingUserName: "Test Driver",
+		Entitlements:    []string{"supporter"},
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionClaims *auth.SessionClaims
+		query         url.Values
+
+		expectNextCalled            bool
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "missing session claims returns 401",
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/limits_missing_claims_response.json",
+		},
+		{
+			name:          "free driver start time pulled forward",
+			sessionClaims: freeClaims,
+			query: url.Values{
+				StartTimeQueryParam:   {"2020-01-01T00:00:00Z"},
+				EndTimeQueryParam:     {"2023-06-01T00:00:00Z"},
+				GranularityQueryParam: {"week"},
+			},
+			expectNextCalled:            true,
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/limits_clamped_start_response.json",
+		},
+		{
+			name:          "free driver range entirely out of history",
+			sessionClaims: freeClaims,
+			query: url.Values{
+				StartTimeQueryParam: {"2020-01-01T00:00:00Z"},
+				EndTimeQueryParam:   {"2021-01-01T00:00:00Z"},
+			},
+			expectNextCalled:            true,
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/limits_clamped_response.json",
+		},
+		{
+			name:          "free driver range within history untouched, invalid granularity left for the endpoint",
+			sessionClaims: freeClaims,
+			query: url.Values{
+				StartTimeQueryParam:   {"2023-03-01T00:00:00Z"},
+				EndTimeQueryParam:     {"2023-06-01T00:00:00Z"},
+				GranularityQueryParam: {"bogus"},
+			},
+			expectNextCalled:            true,
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/limits_recent_response.json",
+		},
+		{
+			name:          "free driver daily granularity returns 403",
+			sessionClaims: freeClaims,
+			query: url.Values{
+				StartTimeQueryParam:   {"2023-03-01T00:00:00Z"},
+				EndTimeQueryParam:     {"2023-06-01T00:00:00Z"},
+				GranularityQueryParam: {"day"},
+			},
+			expectedResponseStatus:      http.StatusForbidden,
+			expectedResponseBodyFixture: "fixtures/limits_forbidden_granularity_response.json",
+		},
+		{
+			name:          "supporter unrestricted",
+			sessionClaims: supporterClaims,
+			query: url.Values{
+				StartTimeQueryParam:   {"2020-01-01T00:00:00Z"},
+				EndTimeQueryParam:     {"2023-06-01T00:00:00Z"},
+				GranularityQueryParam: {"day"},
+			},
+			expectNextCalled:            true,
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/limits_unchanged_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			nextCalled := false
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nextCalled = true
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]any{
+					"startTime":   r.URL.Query().Get(StartTimeQueryParam),
+					"endTime":     r.URL.Query().Get(EndTimeQueryParam),
+					"granularity": r.URL.Query().Get(GranularityQueryParam),
+				})
+			})
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Route("/limited", func(r chi.Router) {
+				r.Use(func(next http.Handler) http.Handler {
+					return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+						if tc.sessionClaims != nil {
+							reqCtx := context.WithValue(req.Context(), sessionClaimsKey, tc.sessionClaims)
+							req = req.WithContext(reqCtx)
+						}
+						next.ServeHTTP(w, req)
+					})
+				})
+				r.Use(LimitsMiddleware(func() time.Time { return now }))
+				r.Get("/", nextHandler)
+			})
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/limited?"+tc.query.Encode(), nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+			assert.Equal(t, tc.expectNextCalled, nextCalled)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/auth"
+)
+
+// DefaultPayloadLogMaxBytes is how much of each body is logged when PayloadLoggingConfig.MaxBytes is unset.
+const DefaultPayloadLogMaxBytes = 4096
+
+// PayloadLoggingConfig controls logging request and response bodies. Bodies are only ever logged at trace level, and
+// then only for a sample of requests, since they can be large and hold data about drivers.
+type PayloadLoggingConfig struct {
+	// SampleRate is the fraction of requests, from 0 to 1, whose bodies are logged when the logger is at trace level.
+	SampleRate float64
+	// MaxBytes is how much of each body is logged, zero for DefaultPayloadLogMaxBytes.
+	MaxBytes int
+}
+
+type requestInfoKeyType string
+
+const requestInfoKey = requestInfoKeyType("requestInfo")
+
+// requestInfo collects what middleware further down the chain learns about a request, such as who made it, for
+// middleware further up to act on once the request has been handled.
+type requestInfo struct {
+	claims *auth.SessionClaims
+	// skipCapture keeps the request out of request capture
+	skipCapture bool
+}
+
+// withRequestInfo returns the request's info, adding it to the context if nothing further up the chain has.
+func withRequestInfo(ctx context.Context) (context.Context, *requestInfo) {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		return ctx, info
+	}
+	info := &requestInfo{}
+	return context.WithValue(ctx, requestInfoKey, info), info
+}
+
+// RequestLoggingMiddleware writes one access log line per request, carrying the route pattern, status, latency and,
+// for authenticated requests, the driver making them. Sampled requests have their bodies logged at trace level.
+func RequestLoggingMiddleware(cfg PayloadLoggingConfig) func(next http.Handler) http.Handler {
+	maxBytes := cfg.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultPayloadLogMaxBytes
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			ctx := request.Context()
+			logger := zerolog.Ctx(ctx)
+			ctx, info := withRequestInfo(ctx)
+			ww := middleware.NewWrapResponseWriter(writer, request.ProtoMajor)
+
+			var requestBody, responseBody *cappedBuffer
+			if traceEnabled(logger) && sampled(cfg.SampleRate) {
+				requestBody = captureRequestBody(request, maxBytes)
+				responseBody = &cappedBuffer{max: maxBytes}
+				ww.Tee(responseBody)
+			}
+
+			t1 := time.Now()
+			defer func() {
+				latency := time.Since(t1)
+				route := routePattern(request)
+
+				evt := logger.Info().
+					Str("method", request.Method).
+					Str("route", route).
+					Int("status", ww.Status()).
+					Int("bytesWritten", ww.BytesWritten()).
+					Dur("duration", latency)
+				if info.claims != nil {
+					evt = evt.Int64("driverId", info.claims.IRacingUserID).Str("entitlements", entitlementsSummary(info.claims.Entitlements))
+				}
+				evt.Msg("request processed")
+
+				if requestBody != nil {
+					logger.Trace().
+						Str("method", request.Method).
+						Str("route", route).
+						Str("requestBody", requestBody.String()).
+						Bool("requestBodyTruncated", requestBody.truncated).
+						Str("responseBody", responseBody.String()).
+						Bool("responseBodyTruncated", responseBody.truncated).
+						Msg("request payloads")
+				}
+			}()
+
+			next.ServeHTTP(ww, request.WithContext(ctx))
+		})
+	}
+}
+
+// enrichLogContext adds who is making the request to the logger in the context, and to the request's info for the
+// access log line and request capture.
+func enrichLogContext(ctx context.Context, claims *auth.SessionClaims) context.Context {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		info.claims = claims
+	}
+	logger := zerolog.Ctx(ctx).With().
+		Int64("driverId", claims.IRacingUserID).
+		Str("entitlements", entitlementsSummary(claims.Entitlements)).
+		Logger()
+	return logger.WithContext(ctx)
+}
+
+func entitlementsSummary(entitlements []string) string {
+	if len(entitlements) == 0 {
+		return "none"
+	}
+	sorted := slices.Clone(entitlements)
+	slices.Sort(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// routePattern is the pattern of the route that served the request, which unlike the path groups requests for the
+// same endpoint together. It is only complete once routing is done, and empty when nothing matched.
+func routePattern(request *http.Request) string {
+	rctx := chi.RouteContext(request.Context())
+	if rctx == nil {
+		return ""
+	}
+	return rctx.RoutePattern()
+}
+
+func traceEnabled(logger *zerolog.Logger) bool {
+	return logger.GetLevel() <= zerolog.TraceLevel && zerolog.GlobalLevel() <= zerolog.TraceLevel
+}
+
+func sampled(rate float64) bool {
+	return rate > 0 && rand.Float64() < rate
+}
+
+// captureRequestBody reads up to maxBytes of the request body for logging, leaving the body intact for the handler.
+func captureRequestBody(request *http.Request, maxBytes int) *cappedBuffer {
+	captured := &cappedBuffer{max: maxBytes}
+	if request.Body == nil || request.Body == http.NoBody {
+		return captured
+	}
+	head, _ := io.ReadAll(io.LimitReader(request.Body, int64(maxBytes)+1))
+	_, _ = captured.Write(head)
+	request.Body = readCloser{
+		Reader: io.MultiReader(bytes.NewReader(head), request.Body),
+		Closer: request.Body,
+	}
+	return captured
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// cappedBuffer keeps the first max bytes written to it, discarding the rest without failing the write so it can sit
+// on a response's tee.
+type cappedBuffer struct {
+	buf       bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (c *cappedBuffer) Write(p []byte) (int, error) {
+	remaining := c.max - c.buf.Len()
+	if len(p) > remaining {
+		c.truncated = true
+		c.buf.Write(p[:max(remaining, 0)])
+		return len(p), nil
+	}
+	c.buf.Write(p)
+	return len(p), nil
+}
+
+func (c *cappedBuffer) String() string {
+	return c.buf.String()
+}
@@ -0,0 +1,279 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/auth"
+)
+
+func TestRequestLoggingMiddleware(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		SessionID:       "test-session-id",
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+		Entitlements:    []string{"supporter", "developer"},
+	}
+
+	testCases := []struct {
+		name string
+
+		logLevel      zerolog.Level
+		payloadConfig PayloadLoggingConfig
+		method        string
+		path          string
+		authHeader    string
+		body          string
+
+		expectedStatus int
+		// the access log line is expected without its duration, which varies
+		expectedLogs []map[string]any
+	}{
+		{
+			name:           "unauthenticated request",
+			logLevel:       zerolog.InfoLevel,
+			method:         http.MethodGet,
+			path:           "/public/42",
+			expectedStatus: http.StatusOK,
+			expectedLogs: []map[string]any{
+				{"level": "info", "message": "handled"},
+				{
+					"level":        "info",
+					"method":       "GET",
+					"route":        "/public/{id}",
+					"status":       float64(200),
+					"bytesWritten": float64(2),
+					"message":      "request processed",
+				},
+			},
+		},
+		{
+			name:           "authenticated request carries the driver",
+			logLevel:       zerolog.InfoLevel,
+			method:         http.MethodGet,
+			path:           "/driver/1100750/races",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusOK,
+			expectedLogs: []map[string]any{
+				{"level": "info", "driverId": float64(1100750), "entitlements": "developer,supporter", "message": "handled"},
+				{
+					"level":        "info",
+					"method":       "GET",
+					"route":        "/driver/{driver_id}/races",
+					"status":       float64(200),
+					"bytesWritten": float64(2),
+					"driverId":     float64(1100750),
+					"entitlements": "developer,supporter",
+					"message":      "request processed",
+				},
+			},
+		},
+		{
+			name:           "unmatched route",
+			logLevel:       zerolog.InfoLevel,
+			method:         http.MethodGet,
+			path:           "/nowhere",
+			expectedStatus: http.StatusNotFound,
+			expectedLogs: []map[string]any{
+				{
+					"level":        "info",
+					"method":       "GET",
+					"route":        "",
+					"status":       float64(404),
+					"bytesWritten": float64(19),
+					"message":      "request processed",
+				},
+			},
+		},
+		{
+			name:           "sampled payloads logged at trace",
+			logLevel:       zerolog.TraceLevel,
+			payloadConfig:  PayloadLoggingConfig{SampleRate: 1, MaxBytes: 8},
+			method:         http.MethodPost,
+			path:           "/public/42",
+			body:           `{"a":1}`,
+			expectedStatus: http.StatusOK,
+			expectedLogs: []map[string]any{
+				{"level": "info", "message": "handled"},
+				{
+					"level":        "info",
+					"method":       "POST",
+					"route":        "/public/{id}",
+					"status":       float64(200),
+					"bytesWritten": float64(7),
+					"message":      "request processed",
+				},
+				{
+					"level":                 "trace",
+					"method":                "POST",
+					"route":                 "/public/{id}",
+					"requestBody":           `{"a":1}`,
+					"requestBodyTruncated":  false,
+					"responseBody":          `{"a":1}`,
+					"responseBodyTruncated": false,
+					"message":               "request payloads",
+				},
+			},
+		},
+		{
+			name:           "sampled payloads truncated",
+			logLevel:       zerolog.TraceLevel,
+			payloadConfig:  PayloadLoggingConfig{SampleRate: 1, MaxBytes: 4},
+			method:         http.MethodPost,
+			path:           "/public/42",
+			body:           `{"a":1}`,
+			expectedStatus: http.StatusOK,
+			expectedLogs: []map[string]any{
+				{"level": "info", "message": "handled"},
+				{
+					"level":        "info",
+					"method":       "POST",
+					"route":        "/public/{id}",
+					"status":       float64(200),
+					"bytesWritten": float64(7),
+					"message":      "request processed",
+				},
+				{
+					"level":                 "trace",
+					"method":                "POST",
+					"route":                 "/public/{id}",
+					"requestBody":           `{"a"`,
+					"requestBodyTruncated":  true,
+					"responseBody":          `{"a"`,
+					"responseBodyTruncated": true,
+					"message":               "request payloads",
+				},
+			},
+		},
+		{
+			name:           "payloads not logged above trace",
+			logLevel:       zerolog.DebugLevel,
+			payloadConfig:  PayloadLoggingConfig{SampleRate: 1},
+			method:         http.MethodPost,
+			path:           "/public/42",
+			body:           `{"a":1}`,
+			expectedStatus: http.StatusOK,
+			expectedLogs: []map[string]any{
+				{"level": "info", "message": "handled"},
+				{
+					"level":        "info",
+					"method":       "POST",
+					"route":        "/public/{id}",
+					"status":       float64(200),
+					"bytesWritten": float64(7),
+					"message":      "request processed",
+				},
+			},
+		},
+		{
+			name:           "payloads not logged when not sampled",
+			logLevel:       zerolog.TraceLevel,
+			method:         http.MethodPost,
+			path:           "/public/42",
+			body:           `{"a":1}`,
+			expectedStatus: http.StatusOK,
+			expectedLogs: []map[string]any{
+				{"level": "info", "message": "handled"},
+				{
+					"level":        "info",
+					"method":       "POST",
+					"route":        "/public/{id}",
+					"status":       float64(200),
+					"bytesWritten": float64(7),
+					"message":      "request processed",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			validator := NewMockTokenValidator(t)
+			if tc.authHeader != "" {
+				validator.EXPECT().ValidateToken(mock.Anything, "valid-token").Return(testSessionClaims, &auth.SensitiveClaims{}, nil)
+			}
+
+			// echoes the request body, or "ok" when there is none
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				zerolog.Ctx(r.Context()).Info().Msg("handled")
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				if len(body) == 0 {
+					body = []byte("ok")
+				}
+				_, _ = w.Write(body)
+			}
+
+			logs := &bytes.Buffer{}
+			logger := zerolog.New(logs).Level(tc.logLevel)
+
+			r := chi.NewRouter()
+			r.Use(ZerologLogAttachMiddleware(logger))
+			r.Use(RequestLoggingMiddleware(tc.payloadConfig))
+			r.HandleFunc("/public/{id}", handler)
+			r.Route("/driver", func(r chi.Router) {
+				r.Use(AuthMiddleware(validator))
+				r.Get("/{driver_id}/races", handler)
+			})
+
+			var body io.Reader = http.NoBody
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+			req := httptest.NewRequest(tc.method, tc.path, body)
+			if tc.authHeader != "" {
+				req.Header.Set("Authorization", tc.authHeader)
+			}
+			res := httptest.NewRecorder()
+			r.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.expectedStatus, res.Code)
+			if tc.body != "" {
+				assert.Equal(t, tc.body, res.Body.String())
+			}
+
+			var entries []map[string]any
+			for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+				entry := map[string]any{}
+				require.NoError(t, json.Unmarshal([]byte(line), &entry))
+				if entry["message"] == "request processed" {
+					assert.Contains(t, entry, "duration")
+					delete(entry, "duration")
+				}
+				entries = append(entries, entry)
+			}
+			assert.Equal(t, tc.expectedLogs, entries)
+		})
+	}
+}
+
+func TestCappedBuffer(t *testing.T) {
+	buf := &cappedBuffer{max: 5}
+
+	n, err := buf.Write([]byte("abc"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.False(t, buf.truncated)
+
+	n, err = buf.Write([]byte("defg"))
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.True(t, buf.truncated)
+
+	n, err = buf.Write([]byte("h"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	assert.Equal(t, "abcde", buf.String())
+}
@@ -0,0 +1,42 @@
+package mobile
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/mobile"
+	"github.com/rs/zerolog"
+)
+
+type SummaryService interface {
+	Summary(ctx context.Context, driverID int64) (*mobile.Summary, error)
+}
+
+// NewGetSummaryEndpoint creates the handler for GET /mobile/v1/summary, returning the logged-in driver's last race,
+// current ratings, open journal prompts and sync status for the mobile home screen.
+func NewGetSummaryEndpoint(svc SummaryService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		summary, err := svc.Summary(ctx, claims.IRacingUserID)
+		if errors.Is(err, mobile.ErrDriverNotFound) {
+			api.DoNotFoundResponse(ctx, "driver not found", w)
+			return
+		}
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to build mobile summary")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, summaryResponseFromService(*summary), w)
+	})
+}
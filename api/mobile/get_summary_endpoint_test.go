@@ -0,0 +1,171 @@
+package mobile
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/mobile"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+type stubTokenValidator struct {
+	sessionClaims   *auth.SessionClaims
+	sensitiveClaims *auth.SensitiveClaims
+	err             error
+}
+
+func (s *stubTokenValidator) ValidateToken(_ context.Context, _ string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+	return s.sessionClaims, s.sensitiveClaims, s.err
+}
+
+func TestGetSummaryEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+
+	lastRace := store.DriverSession{
+		DriverID:              1100750,
+		SubsessionID:          100001,
+		StartTime:             time.Unix(1700000000, 0),
+		SeriesID:              42,
+		SeriesName:            "Advanced Mazda MX-5 Cup Series",
+		TrackID:               1,
+		CarID:                 10,
+		FinishPosition:        2,
+		FinishPositionInClass: 1,
+		Incidents:             4,
+		OldIRating:            1500,
+		NewIRating:            1550,
+	}
+	ingestedTo := time.Unix(1700003600, 0)
+	syncingUntil := time.Unix(1700007200, 0)
+
+	type summaryCall struct {
+		summary *mobile.Summary
+		err     error
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionClaims *auth.SessionClaims
+		tokenErr      error
+
+		summaryCall *summaryCall
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "invalid token returns 401",
+			tokenErr:                    errors.New("missing token"),
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/get_summary_unauthorized_response.json",
+		},
+		{
+			name:                        "missing driver returns 404",
+			sessionClaims:               testSessionClaims,
+			summaryCall:                 &summaryCall{err: mobile.ErrDriverNotFound},
+			expectedResponseStatus:      http.StatusNotFound,
+			expectedResponseBodyFixture: "fixtures/get_summary_not_found_response.json",
+		},
+		{
+			name:                        "service error returns 500",
+			sessionClaims:               testSessionClaims,
+			summaryCall:                 &summaryCall{err: errors.New("database error")},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/get_summary_error_response.json",
+		},
+		{
+			name:          "new driver",
+			sessionClaims: testSessionClaims,
+			summaryCall: &summaryCall{
+				summary: &mobile.Summary{
+					Ratings:     []analytics.Rating{},
+					OpenPrompts: []journal.Prompt{},
+					Sync:        mobile.SyncStatus{State: mobile.SyncStateNeverSynced},
+				},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_summary_empty_response.json",
+		},
+		{
+			name:          "active driver",
+			sessionClaims: testSessionClaims,
+			summaryCall: &summaryCall{
+				summary: &mobile.Summary{
+					LastRace: &lastRace,
+					Ratings: []analytics.Rating{
+						{CategoryID: 1, Category: "oval", IRating: 1200, LicenseLevel: 9, SubLevel: 420, AsOf: time.Unix(1699900000, 0)},
+						{CategoryID: 5, Category: "sports_car", IRating: 1550, LicenseLevel: 15, SubLevel: 301, AsOf: time.Unix(1700000000, 0)},
+					},
+					OpenPrompts: []journal.Prompt{
+						{RaceID: 1700000000, CreatedAt: time.Unix(1700003000, 0), Race: &lastRace},
+						{RaceID: 1699900000, CreatedAt: time.Unix(1699903000, 0)},
+					},
+					OpenPromptCount: 5,
+					Sync:            mobile.SyncStatus{State: mobile.SyncStateSyncing, RacesIngestedTo: &ingestedTo, SyncingUntil: &syncingUntil},
+				},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_summary_success_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				sessionClaims:   tc.sessionClaims,
+				sensitiveClaims: &auth.SensitiveClaims{},
+				err:             tc.tokenErr,
+			}
+
+			mockService := NewMockSummaryService(t)
+			if tc.summaryCall != nil {
+				mockService.EXPECT().Summary(mock.Anything, testSessionClaims.IRacingUserID).Return(tc.summaryCall.summary, tc.summaryCall.err)
+			}
+
+			endpoint := NewGetSummaryEndpoint(mockService)
+			handler := correlation.Middleware(func() string { return testCorrelationID })(api.AuthMiddleware(validator)(endpoint))
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
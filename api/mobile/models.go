@@ -0,0 +1,121 @@
+package mobile
+
+import (
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/mobile"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+type LastRace struct {
+	ID                    int64     `json:"id"`
+	SubsessionID          int64     `json:"subsessionId"`
+	StartTime             time.Time `json:"startTime"`
+	SeriesID              int64     `json:"seriesId"`
+	SeriesName            string    `json:"seriesName"`
+	TrackID               int64     `json:"trackId"`
+	CarID                 int64     `json:"carId"`
+	FinishPosition        int       `json:"finishPosition"`
+	FinishPositionInClass int       `json:"finishPositionInClass"`
+	Incidents             int       `json:"incidents"`
+	IRatingChange         int       `json:"iRatingChange"`
+}
+
+type Rating struct {
+	CategoryID   int       `json:"categoryId"`
+	Category     string    `json:"category"` // oval, road, dirt_oval, dirt_road, sports_car or formula_car
+	IRating      int       `json:"iRating"`
+	LicenseLevel int       `json:"licenseLevel"`
+	SubLevel     int       `json:"subLevel"` // safety rating times 100
+	AsOf         time.Time `json:"asOf"`     // the race the ratings were taken from
+}
+
+type OpenPrompt struct {
+	RaceID     int64     `json:"raceId"`
+	CreatedAt  time.Time `json:"createdAt"`
+	SeriesName string    `json:"seriesName,omitempty"`
+	TrackID    int64     `json:"trackId,omitempty"`
+}
+
+type SyncStatus struct {
+	State           string     `json:"state"` // never_synced, syncing or idle
+	RacesIngestedTo *time.Time `json:"racesIngestedTo"`
+	SyncingUntil    *time.Time `json:"syncingUntil"`
+}
+
+// SummaryResponse is everything the mobile home screen shows, in one call.
+type SummaryResponse struct {
+	LastRace        *LastRace    `json:"lastRace"`
+	Ratings         []Rating     `json:"ratings"`
+	OpenPrompts     []OpenPrompt `json:"openPrompts"`
+	OpenPromptCount int          `json:"openPromptCount"`
+	Sync            SyncStatus   `json:"sync"`
+}
+
+func summaryResponseFromService(summary mobile.Summary) SummaryResponse {
+	ret := SummaryResponse{
+		Ratings:         make([]Rating, len(summary.Ratings)),
+		OpenPrompts:     make([]OpenPrompt, len(summary.OpenPrompts)),
+		OpenPromptCount: summary.OpenPromptCount,
+		Sync: SyncStatus{
+			State:           summary.Sync.State,
+			RacesIngestedTo: utcPtr(summary.Sync.RacesIngestedTo),
+			SyncingUntil:    utcPtr(summary.Sync.SyncingUntil),
+		},
+	}
+	if summary.LastRace != nil {
+		ret.LastRace = lastRaceFromStore(*summary.LastRace)
+	}
+	for i, rating := range summary.Ratings {
+		ret.Ratings[i] = Rating{
+			CategoryID:   rating.CategoryID,
+			Category:     rating.Category,
+			IRating:      rating.IRating,
+			LicenseLevel: rating.LicenseLevel,
+			SubLevel:     rating.SubLevel,
+			AsOf:         rating.AsOf.UTC(),
+		}
+	}
+	for i, prompt := range summary.OpenPrompts {
+		ret.OpenPrompts[i] = openPromptFromService(prompt)
+	}
+	return ret
+}
+
+func lastRaceFromStore(session store.DriverSession) *LastRace {
+	return &LastRace{
+		ID:                    session.StartTime.Unix(),
+		SubsessionID:          session.SubsessionID,
+		StartTime:             session.StartTime.UTC(),
+		SeriesID:              session.SeriesID,
+		SeriesName:            session.SeriesName,
+		TrackID:               session.TrackID,
+		CarID:                 session.CarID,
+		FinishPosition:        session.FinishPosition,
+		FinishPositionInClass: session.FinishPositionInClass,
+		Incidents:             session.Incidents,
+		IRatingChange:         session.NewIRating - session.OldIRating,
+	}
+}
+
+func openPromptFromService(prompt journal.Prompt) OpenPrompt {
+	ret := OpenPrompt{
+		RaceID:    prompt.RaceID,
+		CreatedAt: prompt.CreatedAt.UTC(),
+	}
+	// the race can be missing if it was deleted after the prompt was raised
+	if prompt.Race != nil {
+		ret.SeriesName = prompt.Race.SeriesName
+		ret.TrackID = prompt.Race.TrackID
+	}
+	return ret
+}
+
+func utcPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	ret := t.UTC()
+	return &ret
+}
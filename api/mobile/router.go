@@ -0,0 +1,19 @@
+package mobile
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+// NewRouter builds the routes tailored to the mobile app, versioned separately from the rest of the API so the app
+// can keep working against an older shape while newer releases roll out.
+func NewRouter(svc SummaryService, authMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(authMiddleware)
+
+	r.Get("/v1/summary", api.WrapWithSegment("getMobileSummary", NewGetSummaryEndpoint(svc)).ServeHTTP)
+
+	return r
+}
@@ -0,0 +1,41 @@
+package overlay
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/overlay"
+	"github.com/rs/zerolog"
+)
+
+type ServiceForGet interface {
+	Get(ctx context.Context, driverID int64) (*overlay.Issued, error)
+}
+
+// NewGetEndpoint returns the logged-in driver's stream overlay and its token, 404 if they haven't set one up.
+func NewGetEndpoint(svc ServiceForGet) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		issued, err := svc.Get(ctx, claims.IRacingUserID)
+		if errors.Is(err, overlay.ErrNotSetUp) {
+			api.DoNotFoundResponse(ctx, "overlay not set up", w)
+			return
+		}
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to get overlay")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, issuedOverlayFromService(*issued), w)
+	})
+}
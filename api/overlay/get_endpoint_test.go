@@ -0,0 +1,153 @@
+package overlay
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/overlay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+type stubTokenValidator struct {
+	sessionClaims   *auth.SessionClaims
+	sensitiveClaims *auth.SensitiveClaims
+	err             error
+}
+
+func (s *stubTokenValidator) ValidateToken(_ context.Context, _ string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+	return s.sessionClaims, s.sensitiveClaims, s.err
+}
+
+func testIssued() *overlay.Issued {
+	updatedAt := time.Date(2026, 3, 14, 18, 30, 0, 0, time.UTC)
+	return &overlay.Issued{
+		Token: "q3Jx0bVd8pCzN5rWmK2yTfA7uHsLeG9oPiYjR4nX1wE",
+		Overlay: overlay.Overlay{
+			DriverID:     1100750,
+			DriverName:   "Jon Sabados",
+			Category:     "sports_car",
+			IRating:      2150,
+			LicenseClass: "A",
+			SafetyRating: 3.12,
+			LastRace: &overlay.LastRace{
+				SubsessionID:       81234567,
+				SeriesName:         "IMSA Endurance Series",
+				StartTime:          updatedAt.Add(-2 * time.Hour),
+				StartPosition:      9,
+				FinishPosition:     4,
+				Incidents:          2,
+				IRatingChange:      38,
+				SafetyRatingChange: 0.07,
+			},
+			Streak:    overlay.Streak{Direction: overlay.StreakUp, Races: 3},
+			UpdatedAt: updatedAt,
+		},
+		CreatedAt:   time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC),
+		RefreshedAt: updatedAt,
+	}
+}
+
+func TestGetEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+
+	type getCall struct {
+		issued *overlay.Issued
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionClaims *auth.SessionClaims
+		tokenErr      error
+
+		getCall *getCall
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "invalid token returns 401",
+			tokenErr:                    errors.New("missing token"),
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/unauthorized_response.json",
+		},
+		{
+			name:                        "service error returns 500",
+			sessionClaims:               testSessionClaims,
+			getCall:                     &getCall{err: errors.New("database error")},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/error_response.json",
+		},
+		{
+			name:                        "not set up returns 404",
+			sessionClaims:               testSessionClaims,
+			getCall:                     &getCall{err: overlay.ErrNotSetUp},
+			expectedResponseStatus:      http.StatusNotFound,
+			expectedResponseBodyFixture: "fixtures/get_not_set_up_response.json",
+		},
+		{
+			name:                        "set up",
+			sessionClaims:               testSessionClaims,
+			getCall:                     &getCall{issued: testIssued()},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/issued_overlay_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				sessionClaims:   tc.sessionClaims,
+				sensitiveClaims: &auth.SensitiveClaims{},
+				err:             tc.tokenErr,
+			}
+
+			mockService := NewMockServiceForGet(t)
+			if tc.getCall != nil {
+				mockService.EXPECT().Get(mock.Anything, testSessionClaims.IRacingUserID).Return(tc.getCall.issued, tc.getCall.err)
+			}
+
+			endpoint := NewGetEndpoint(mockService)
+			handler := correlation.Middleware(func() string { return testCorrelationID })(api.AuthMiddleware(validator)(endpoint))
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package overlay
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/overlay"
+	"github.com/rs/zerolog"
+)
+
+type ServiceForIssue interface {
+	Issue(ctx context.Context, driverID int64) (*overlay.Issued, error)
+}
+
+// NewIssueEndpoint sets up the logged-in driver's stream overlay under a new token. Issuing again replaces the token,
+// so the old one stops working, for drivers whose token ended up on stream.
+func NewIssueEndpoint(svc ServiceForIssue) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		issued, err := svc.Issue(ctx, claims.IRacingUserID)
+		if errors.Is(err, overlay.ErrDriverNotFound) {
+			api.DoNotFoundResponse(ctx, "driver not found", w)
+			return
+		}
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to issue overlay")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, issuedOverlayFromService(*issued), w)
+	})
+}
@@ -0,0 +1,25 @@
+package overlay
+
+import (
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/overlay"
+)
+
+// IssuedOverlay is the driver's overlay as they see it, along with the token it is served with. The overlay is served
+// publicly at /public/overlays/{token}, and over the WebSocket API to overlays subscribing with the token.
+type IssuedOverlay struct {
+	Token       string          `json:"token"`
+	Overlay     overlay.Overlay `json:"overlay"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	RefreshedAt time.Time       `json:"refreshedAt"`
+}
+
+func issuedOverlayFromService(issued overlay.Issued) IssuedOverlay {
+	return IssuedOverlay{
+		Token:       issued.Token,
+		Overlay:     issued.Overlay,
+		CreatedAt:   issued.CreatedAt.UTC(),
+		RefreshedAt: issued.RefreshedAt.UTC(),
+	}
+}
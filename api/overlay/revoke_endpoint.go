@@ -0,0 +1,34 @@
+package overlay
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+type ServiceForRevoke interface {
+	Revoke(ctx context.Context, driverID int64) error
+}
+
+// NewRevokeEndpoint takes down the logged-in driver's stream overlay. Revoking when there is no overlay succeeds.
+func NewRevokeEndpoint(svc ServiceForRevoke) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		if err := svc.Revoke(ctx, claims.IRacingUserID); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to revoke overlay")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,27 @@
+package overlay
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+type Service interface {
+	ServiceForGet
+	ServiceForIssue
+	ServiceForRevoke
+}
+
+// NewRouter builds the routes for the logged-in driver to manage their own stream overlay. The overlay itself is served
+// by token from the public routes, and pushed to overlays subscribed over the WebSocket API.
+func NewRouter(svc Service, authMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(authMiddleware)
+
+	r.Get("/", api.WrapWithSegment("getOverlay", NewGetEndpoint(svc)).ServeHTTP)
+	r.Post("/", api.WrapWithSegment("issueOverlay", NewIssueEndpoint(svc)).ServeHTTP)
+	r.Delete("/", api.WrapWithSegment("revokeOverlay", NewRevokeEndpoint(svc)).ServeHTTP)
+
+	return r
+}
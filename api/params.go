@@ -9,12 +9,39 @@ const (
 	EndTimeQueryParam         = "endTime"
 	PageQueryParam            = "page"
 	ResultsPerPageParam       = "resultsPerPage"
+	LimitQueryParam           = "limit"
+	CursorQueryParam          = "cursor"
+	DriverIDQueryParam        = "driverId"
+	SearchQueryParam          = "q"
+	StatusQueryParam          = "status"
+	DaysQueryParam            = "days"
+	FieldsQueryParam          = "fields"
 	DefaultResultsPerPage int = 10
 
 	// Analytics query params
-	GroupByQueryParam     = "groupBy"
-	GranularityQueryParam = "granularity"
-	SeriesIDQueryParam    = "seriesId"
-	CarIDQueryParam       = "carId"
-	TrackIDQueryParam     = "trackId"
+	GroupByQueryParam        = "groupBy"
+	GranularityQueryParam    = "granularity"
+	SeriesIDQueryParam       = "seriesId"
+	CarIDQueryParam          = "carId"
+	TrackIDQueryParam        = "trackId"
+	SOFQueryParam            = "sof"
+	CountedWeeksQueryParam   = "countedWeeks"
+	FinishedOnlyQueryParam   = "finishedOnly"
+	TextSummaryQueryParam    = "textSummary"
+	CategoryQueryParam       = "category"
+	TargetSubLevelQueryParam = "targetSubLevel"
+
+	// Leaderboard query params
+	BoardQueryParam  = "board"
+	WeekQueryParam   = "week"
+	ClubIDQueryParam = "clubId"
+
+	// Benchmark query params
+	IRatingQueryParam = "iRating"
+
+	// Lap query params
+	EventQueryParam = "event"
+
+	// Export query params
+	IncludeJournalQueryParam = "includeJournal"
 )
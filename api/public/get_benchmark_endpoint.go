@@ -0,0 +1,79 @@
+package public
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/benchmark"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// Error codes for i18n support
+const (
+	ErrCodeRequired           = "required"
+	ErrCodePositiveInteger    = "positive_integer"
+	ErrCodeNonNegativeInteger = "non_negative_integer"
+)
+
+type BenchmarkTableStore interface {
+	GetBenchmarkTable(ctx context.Context, seriesID, trackID int64, iRatingBand int) (*store.BenchmarkTable, error)
+}
+
+// NewGetBenchmarkEndpoint serves the benchmark table for a series and track at the band an iRating falls in. Tables are
+// only built where enough opted in drivers race, so most combinations don't have one.
+func NewGetBenchmarkEndpoint(tableStore BenchmarkTableStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		seriesID, errs := parseID(r, api.SeriesIDQueryParam, errs)
+		trackID, errs := parseID(r, api.TrackIDQueryParam, errs)
+
+		var iRating int
+		if iRatingStr := r.URL.Query().Get(api.IRatingQueryParam); iRatingStr == "" {
+			errs = errs.WithFieldErrorCode(api.IRatingQueryParam, ErrCodeRequired, nil)
+		} else {
+			var err error
+			iRating, err = strconv.Atoi(iRatingStr)
+			if err != nil || iRating < 0 {
+				errs = errs.WithFieldErrorCode(api.IRatingQueryParam, ErrCodeNonNegativeInteger, map[string]string{"value": iRatingStr})
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		band := benchmark.IRatingBand(iRating)
+		table, err := tableStore.GetBenchmarkTable(ctx, seriesID, trackID, band)
+		if err != nil {
+			logger.Error().Err(err).Int64("seriesId", seriesID).Int64("trackId", trackID).Int("iRatingBand", band).Msg("failed to get benchmark table")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+		if table == nil {
+			api.DoNotFoundResponse(ctx, "no benchmark for this series, track and iRating", w)
+			return
+		}
+
+		api.DoOKResponse(ctx, benchmarkTableFromStore(*table), w)
+	})
+}
+
+func parseID(r *http.Request, param string, errs api.RequestErrors) (int64, api.RequestErrors) {
+	str := r.URL.Query().Get(param)
+	if str == "" {
+		return 0, errs.WithFieldErrorCode(param, ErrCodeRequired, nil)
+	}
+	id, err := strconv.ParseInt(str, 10, 64)
+	if err != nil || id < 1 {
+		return 0, errs.WithFieldErrorCode(param, ErrCodePositiveInteger, map[string]string{"value": str})
+	}
+	return id, errs
+}
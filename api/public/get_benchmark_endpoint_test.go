@@ -0,0 +1,123 @@
+package public
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+func TestNewGetBenchmarkEndpoint(t *testing.T) {
+	computedAt := time.Date(2026, 1, 8, 6, 0, 0, 0, time.UTC)
+
+	type storeCall struct {
+		iRatingBand int
+		table       *store.BenchmarkTable
+		err         error
+	}
+
+	testCases := []struct {
+		name string
+
+		queryString string
+
+		storeCall *storeCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:        "success",
+			queryString: "seriesId=231&trackId=127&iRating=1850",
+			storeCall: &storeCall{
+				iRatingBand: 1500,
+				table: &store.BenchmarkTable{
+					SeriesID:         231,
+					TrackID:          127,
+					IRatingBand:      1500,
+					ComputedAt:       computedAt,
+					Drivers:          42,
+					IncidentsPerRace: store.BenchmarkPercentiles{P10: 0, P25: 1.5, P50: 3, P75: 5.25, P90: 8},
+					OffBestPercent:   &store.BenchmarkPercentiles{P10: 0.8, P25: 1.1, P50: 1.6, P75: 2.4, P90: 3.5},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_benchmark_success_response.json",
+		},
+		{
+			name:        "without consistency distribution",
+			queryString: "seriesId=231&trackId=127&iRating=0",
+			storeCall: &storeCall{
+				iRatingBand: 0,
+				table: &store.BenchmarkTable{
+					SeriesID:         231,
+					TrackID:          127,
+					ComputedAt:       computedAt,
+					Drivers:          12,
+					IncidentsPerRace: store.BenchmarkPercentiles{P10: 1, P25: 2, P50: 4, P75: 6, P90: 9},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_benchmark_no_consistency_response.json",
+		},
+		{
+			name:                "invalid params",
+			queryString:         "trackId=abc&iRating=-5",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_benchmark_invalid_params_response.json",
+		},
+		{
+			name:                "no table",
+			queryString:         "seriesId=231&trackId=127&iRating=1850",
+			storeCall:           &storeCall{iRatingBand: 1500},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_benchmark_not_found_response.json",
+		},
+		{
+			name:                "store error",
+			queryString:         "seriesId=231&trackId=127&iRating=1850",
+			storeCall:           &storeCall{iRatingBand: 1500, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_benchmark_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockBenchmarkTableStore(t)
+			if tc.storeCall != nil {
+				mockStore.EXPECT().GetBenchmarkTable(mock.Anything, int64(231), int64(127), tc.storeCall.iRatingBand).
+					Return(tc.storeCall.table, tc.storeCall.err)
+			}
+
+			handler := correlation.Middleware(func() string { return testCorrelationID })(NewGetBenchmarkEndpoint(mockStore))
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			res, err := http.Get(ts.URL + "/benchmarks?" + tc.queryString)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
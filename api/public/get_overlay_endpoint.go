@@ -0,0 +1,41 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/overlay"
+	"github.com/rs/zerolog"
+)
+
+const overlayTokenPathParam = "token"
+
+type OverlayService interface {
+	Fetch(ctx context.Context, token string) (*overlay.Overlay, error)
+}
+
+// NewGetOverlayEndpoint serves the stream overlay set up with a token, for browser sources that poll rather than
+// subscribing over the WebSocket API.
+func NewGetOverlayEndpoint(svc OverlayService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		token := chi.URLParam(r, overlayTokenPathParam)
+
+		ret, err := svc.Fetch(ctx, token)
+		if errors.Is(err, overlay.ErrNotSetUp) {
+			api.DoNotFoundResponse(ctx, "overlay not found", w)
+			return
+		}
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to fetch overlay")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, ret, w)
+	})
+}
@@ -0,0 +1,104 @@
+package public
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/overlay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetOverlayEndpoint(t *testing.T) {
+	token := "q3Jx0bVd8pCzN5rWmK2yTfA7uHsLeG9oPiYjR4nX1wE"
+	updatedAt := time.Date(2026, 3, 14, 18, 30, 0, 0, time.UTC)
+
+	type fetchCall struct {
+		overlay *overlay.Overlay
+		err     error
+	}
+
+	testCases := []struct {
+		name string
+
+		fetchCall fetchCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name: "success",
+			fetchCall: fetchCall{
+				overlay: &overlay.Overlay{
+					DriverID:     1100750,
+					DriverName:   "Jon Sabados",
+					Category:     "sports_car",
+					IRating:      2150,
+					LicenseClass: "A",
+					SafetyRating: 3.12,
+					LastRace: &overlay.LastRace{
+						SubsessionID:       81234567,
+						SeriesName:         "IMSA Endurance Series",
+						StartTime:          updatedAt.Add(-2 * time.Hour),
+						StartPosition:      9,
+						FinishPosition:     4,
+						Incidents:          2,
+						IRatingChange:      38,
+						SafetyRatingChange: 0.07,
+					},
+					Streak:    overlay.Streak{Direction: overlay.StreakUp, Races: 3},
+					UpdatedAt: updatedAt,
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_overlay_success_response.json",
+		},
+		{
+			name:                "not set up",
+			fetchCall:           fetchCall{err: overlay.ErrNotSetUp},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_overlay_not_found_response.json",
+		},
+		{
+			name:                "service error",
+			fetchCall:           fetchCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_overlay_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockOverlayService(t)
+			mockService.EXPECT().Fetch(mock.Anything, token).Return(tc.fetchCall.overlay, tc.fetchCall.err)
+
+			r := chi.NewRouter()
+			r.Handle("/overlays/{token}", NewGetOverlayEndpoint(mockService))
+			handler := correlation.Middleware(func() string { return testCorrelationID })(r)
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			res, err := http.Get(ts.URL + "/overlays/" + token)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/seasoncard"
+	"github.com/rs/zerolog"
+)
+
+const seasonCardTokenPathParam = "token"
+
+type SeasonCardService interface {
+	Fetch(ctx context.Context, token string) (*seasoncard.Signed, error)
+	PublicKeys(ctx context.Context) ([]seasoncard.PublicKey, error)
+}
+
+// NewGetSeasonCardEndpoint serves the season card shared with a token, along with the card signed as a JWS for
+// widgets that want to verify it against /public/season-cards/keys. Cards fetched too often get a 429.
+func NewGetSeasonCardEndpoint(svc SeasonCardService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		token := chi.URLParam(r, seasonCardTokenPathParam)
+
+		signed, err := svc.Fetch(ctx, token)
+		if errors.Is(err, seasoncard.ErrNotShared) {
+			api.DoNotFoundResponse(ctx, "season card not found", w)
+			return
+		}
+		var rateLimited *seasoncard.RateLimitedError
+		if errors.As(err, &rateLimited) {
+			logger.Warn().Time("retryAt", rateLimited.RetryAt).Msg("season card fetched too often")
+			retryAfter := max(int(math.Ceil(time.Until(rateLimited.RetryAt).Seconds())), 1)
+			api.DoTooManyRequestsResponse(ctx, "season card fetched too often", retryAfter, w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to fetch season card")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, SignedSeasonCard{Card: signed.Card, JWS: signed.JWS}, w)
+	})
+}
@@ -0,0 +1,45 @@
+package public
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+// NewGetSeasonCardKeysEndpoint serves the keys season cards are signed with as a JSON Web Key Set (RFC 7517). Unlike
+// the rest of the API the set isn't wrapped in a response envelope, so off the shelf JOSE libraries can read it.
+func NewGetSeasonCardKeysEndpoint(svc SeasonCardService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		keys, err := svc.PublicKeys(ctx)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to get season card keys")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		set := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(keys))}
+		for _, key := range keys {
+			jwk, err := jsonWebKeyFromPublicKey(key)
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Str("kid", key.KeyID).Msg("failed to render season card key")
+				api.DoErrorResponse(ctx, w)
+				return
+			}
+			set.Keys = append(set.Keys, jwk)
+		}
+
+		bytes, err := json.Marshal(set)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to marshal season card keys")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+		w.Header().Add("content-type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(bytes)
+	})
+}
@@ -0,0 +1,96 @@
+package public
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/seasoncard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetSeasonCardKeysEndpoint(t *testing.T) {
+	t.Run("serves the keys as a JWKS", func(t *testing.T) {
+		current, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		previous, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		mockService := NewMockSeasonCardService(t)
+		mockService.EXPECT().PublicKeys(mock.Anything).Return([]seasoncard.PublicKey{
+			{KeyID: "current-kid", Key: &current.PublicKey},
+			{KeyID: "previous-kid", Key: &previous.PublicKey},
+		}, nil)
+
+		handler := correlation.Middleware(func() string { return testCorrelationID })(NewGetSeasonCardKeysEndpoint(mockService))
+		ts := httptest.NewServer(handler)
+		defer ts.Close()
+
+		res, err := http.Get(ts.URL)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+
+		var set JSONWebKeySet
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&set))
+		require.Len(t, set.Keys, 2)
+		for i, expected := range []struct {
+			kid string
+			key *ecdsa.PrivateKey
+		}{{"current-kid", current}, {"previous-kid", previous}} {
+			jwk := set.Keys[i]
+			assert.Equal(t, expected.kid, jwk.KeyID)
+			assert.Equal(t, "EC", jwk.KeyType)
+			assert.Equal(t, "P-256", jwk.Curve)
+			assert.Equal(t, "ES256", jwk.Algorithm)
+			assert.Equal(t, "sig", jwk.Use)
+
+			x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+			require.NoError(t, err)
+			y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+			require.NoError(t, err)
+			rebuilt, err := ecdh.P256().NewPublicKey(append(append([]byte{4}, x...), y...))
+			require.NoError(t, err)
+			expectedKey, err := expected.key.PublicKey.ECDH()
+			require.NoError(t, err)
+			assert.True(t, expectedKey.Equal(rebuilt))
+		}
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		mockService := NewMockSeasonCardService(t)
+		mockService.EXPECT().PublicKeys(mock.Anything).Return(nil, errors.New("secrets error"))
+
+		handler := correlation.Middleware(func() string { return testCorrelationID })(NewGetSeasonCardKeysEndpoint(mockService))
+		ts := httptest.NewServer(handler)
+		defer ts.Close()
+
+		res, err := http.Get(ts.URL)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		bodyBytes, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+
+		expectedBody, err := os.ReadFile("fixtures/get_season_card_error_response.json")
+		require.NoError(t, err)
+
+		assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+	})
+}
@@ -0,0 +1,99 @@
+package public
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/seasoncard"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+type Percentiles struct {
+	P10 float64 `json:"p10"`
+	P25 float64 `json:"p25"`
+	P50 float64 `json:"p50"`
+	P75 float64 `json:"p75"`
+	P90 float64 `json:"p90"`
+}
+
+// BenchmarkTable is how platform drivers in an iRating band have gone in a series at a track. OffBestPercent is nil
+// when too few of the drivers had lap data for a distribution.
+type BenchmarkTable struct {
+	SeriesID         int64        `json:"seriesId"`
+	TrackID          int64        `json:"trackId"`
+	IRatingBand      int          `json:"iRatingBand"`
+	Drivers          int          `json:"drivers"`
+	ComputedAt       time.Time    `json:"computedAt"`
+	IncidentsPerRace Percentiles  `json:"incidentsPerRace"`
+	OffBestPercent   *Percentiles `json:"offBestPercent"`
+}
+
+func percentilesFromStore(percentiles store.BenchmarkPercentiles) Percentiles {
+	return Percentiles{
+		P10: percentiles.P10,
+		P25: percentiles.P25,
+		P50: percentiles.P50,
+		P75: percentiles.P75,
+		P90: percentiles.P90,
+	}
+}
+
+func benchmarkTableFromStore(table store.BenchmarkTable) BenchmarkTable {
+	ret := BenchmarkTable{
+		SeriesID:         table.SeriesID,
+		TrackID:          table.TrackID,
+		IRatingBand:      table.IRatingBand,
+		Drivers:          table.Drivers,
+		ComputedAt:       table.ComputedAt.UTC(),
+		IncidentsPerRace: percentilesFromStore(table.IncidentsPerRace),
+	}
+	if table.OffBestPercent != nil {
+		offBest := percentilesFromStore(*table.OffBestPercent)
+		ret.OffBestPercent = &offBest
+	}
+	return ret
+}
+
+// SignedSeasonCard is a shared season card. JWS is the card's JSON as a compact JWS signed with ES256, its kid header
+// naming the key in /public/season-cards/keys it was signed with.
+type SignedSeasonCard struct {
+	Card seasoncard.Card `json:"card"`
+	JWS  string          `json:"jws"`
+}
+
+// JSONWebKey is a P-256 public key as a JWK (RFC 7517).
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+func jsonWebKeyFromPublicKey(key seasoncard.PublicKey) (JSONWebKey, error) {
+	ecdhKey, err := key.Key.ECDH()
+	if err != nil {
+		return JSONWebKey{}, fmt.Errorf("converting key: %w", err)
+	}
+	// an uncompressed point, 0x04 followed by the 32 byte X and Y coordinates
+	point := ecdhKey.Bytes()
+	if len(point) != 65 {
+		return JSONWebKey{}, fmt.Errorf("unexpected P-256 point length %d", len(point))
+	}
+	return JSONWebKey{
+		KeyType:   "EC",
+		Curve:     "P-256",
+		X:         base64.RawURLEncoding.EncodeToString(point[1:33]),
+		Y:         base64.RawURLEncoding.EncodeToString(point[33:]),
+		KeyID:     key.KeyID,
+		Algorithm: "ES256",
+		Use:       "sig",
+	}, nil
+}
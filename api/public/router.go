@@ -0,0 +1,37 @@
+package public
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/api/leaderboards"
+)
+
+// NewRouter builds the public, read-only routes. Nothing here needs a token or varies by who asks, so each route sets
+// how long browsers and the CDN may cache it based on how often the data behind it changes.
+func NewRouter(leaderboardStore leaderboards.WeeklyLeaderboardStore, benchmarkStore BenchmarkTableStore, seasonCardService SeasonCardService, overlayService OverlayService) http.Handler {
+	r := chi.NewRouter()
+
+	// boards are recomputed every few hours, but the default week rolls over on Tuesdays so the CDN shouldn't hold on
+	// to one for too long
+	r.With(api.CacheControlMiddleware(5*time.Minute, 15*time.Minute)).
+		Get("/leaderboards/weekly", api.WrapWithSegment("getPublicWeeklyLeaderboard", leaderboards.NewGetWeeklyEndpoint(leaderboardStore, time.Now)).ServeHTTP)
+	// tables are rebuilt daily
+	r.With(api.CacheControlMiddleware(time.Hour, 6*time.Hour)).
+		Get("/benchmarks", api.WrapWithSegment("getPublicBenchmark", NewGetBenchmarkEndpoint(benchmarkStore)).ServeHTTP)
+	// cards only change when their driver finishes a race, and the short CDN hold keeps polling overlays well under
+	// the per card fetch limit
+	r.With(api.CacheControlMiddleware(time.Minute, time.Minute)).
+		Get("/season-cards/{"+seasonCardTokenPathParam+"}", api.WrapWithSegment("getPublicSeasonCard", NewGetSeasonCardEndpoint(seasonCardService)).ServeHTTP)
+	// kept short so a freshly rotated key is published soon after cards start being signed with it
+	r.With(api.CacheControlMiddleware(5*time.Minute, 5*time.Minute)).
+		Get("/season-cards/keys", api.WrapWithSegment("getPublicSeasonCardKeys", NewGetSeasonCardKeysEndpoint(seasonCardService)).ServeHTTP)
+	// overlays change when their driver finishes a race, which subscribed overlays hear about straight away, so polling
+	// ones are only held briefly
+	r.With(api.CacheControlMiddleware(15*time.Second, 15*time.Second)).
+		Get("/overlays/{"+overlayTokenPathParam+"}", api.WrapWithSegment("getPublicOverlay", NewGetOverlayEndpoint(overlayService)).ServeHTTP)
+
+	return r
+}
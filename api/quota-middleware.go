@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/quota"
+	"github.com/rs/zerolog"
+)
+
+// QuotaResetHeader carries when the driver's exhausted quota resets, as an RFC 3339 timestamp.
+const QuotaResetHeader = "X-Quota-Reset"
+
+type QuotaConsumer interface {
+	Consume(ctx context.Context, driverID int64, entitlements []string, operation quota.Operation) (*quota.Decision, error)
+}
+
+// QuotaMiddleware counts each request against the driver's daily quota for operation, answering with a 429 once the
+// quota is used up.
+func QuotaMiddleware(consumer QuotaConsumer, operation quota.Operation, now func() time.Time) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			sessionClaims := SessionClaimsFromContext(ctx)
+			if sessionClaims == nil {
+				DoUnauthorizedResponse(ctx, "missing session claims", w)
+				return
+			}
+
+			decision, err := consumer.Consume(ctx, sessionClaims.IRacingUserID, sessionClaims.Entitlements, operation)
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Str("operation", string(operation)).Msg("failed to consume quota")
+				DoErrorResponse(ctx, w)
+				return
+			}
+			if !decision.Allowed {
+				retryAfter := int(math.Ceil(decision.ResetsAt.Sub(now()).Seconds()))
+				w.Header().Set(QuotaResetHeader, decision.ResetsAt.UTC().Format(time.RFC3339))
+				DoTooManyRequestsResponse(ctx, fmt.Sprintf("daily %s quota of %d used up", operation, decision.Limit), retryAfter, w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
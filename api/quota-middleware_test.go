@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/quota"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaMiddleware(t *testing.T) {
+	now := time.Date(2024, 3, 10, 15, 30, 0, 0, time.UTC)
+	resetsAt := time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)
+	sessionClaims := &auth.SessionClaims{
+		IRacingUserID:   12345,
+		IRacingUserName: "Test Driver",
+		Entitlements:    []string{"supporter"},
+	}
+
+	type consumeCall struct {
+		decision *quota.Decision
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionClaims *auth.SessionClaims
+		consumeCall   *consumeCall
+
+		expectNextCalled            bool
+		expectedResponseStatus      int
+		expectedResetHeader         string
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "missing session claims returns 401",
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/quota_missing_claims_response.json",
+		},
+		{
+			name:                        "within quota passes through",
+			sessionClaims:               sessionClaims,
+			consumeCall:                 &consumeCall{decision: &quota.Decision{Allowed: true, Limit: 200, ResetsAt: resetsAt}},
+			expectNextCalled:            true,
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/quota_success_response.json",
+		},
+		{
+			name:                        "quota used up returns 429",
+			sessionClaims:               sessionClaims,
+			consumeCall:                 &consumeCall{decision: &quota.Decision{Allowed: false, Limit: 200, ResetsAt: resetsAt}},
+			expectedResponseStatus:      http.StatusTooManyRequests,
+			expectedResetHeader:         "2024-03-11T00:00:00Z",
+			expectedResponseBodyFixture: "fixtures/quota_exceeded_response.json",
+		},
+		{
+			name:                        "consume error returns 500",
+			sessionClaims:               sessionClaims,
+			consumeCall:                 &consumeCall{err: errors.New("database error")},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/quota_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			consumer := NewMockQuotaConsumer(t)
+			if tc.consumeCall != nil {
+				consumer.EXPECT().Consume(mock.Anything, int64(12345), []string{"supporter"}, quota.OperationIRacingProxy).
+					Return(tc.consumeCall.decision, tc.consumeCall.err)
+			}
+
+			nextCalled := false
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nextCalled = true
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]any{"next_called": true})
+			})
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Route("/limited", func(r chi.Router) {
+				r.Use(func(next http.Handler) http.Handler {
+					return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+						if tc.sessionClaims != nil {
+							reqCtx := context.WithValue(req.Context(), sessionClaimsKey, tc.sessionClaims)
+							req = req.WithContext(reqCtx)
+						}
+						next.ServeHTTP(w, req)
+					})
+				})
+				r.Use(QuotaMiddleware(consumer, quota.OperationIRacingProxy, func() time.Time { return now }))
+				r.Get("/", nextHandler)
+			})
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/limited", nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+			assert.Equal(t, tc.expectNextCalled, nextCalled)
+			assert.Equal(t, tc.expectedResetHeader, res.Header.Get(QuotaResetHeader))
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
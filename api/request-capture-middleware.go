@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/requestcapture"
+)
+
+// requestCaptureEntitlement is the entitlement a driver needs for their requests to be captured
+const requestCaptureEntitlement = "developer"
+
+type RequestCapturer interface {
+	Capturing(ctx context.Context, driverID int64) bool
+	Capture(ctx context.Context, request requestcapture.Request) error
+}
+
+// RequestCaptureMiddleware captures the requests of developers who have turned on request capture, along with the
+// responses to them. Bodies are copied as the handler reads and writes them, up to requestcapture.MaxBodyBytes, but
+// only requests that turn out to be from a capturing developer are kept. Requests without credentials can't be from
+// one and pass straight through. It must run after TenantMiddleware so captures are kept with the tenant's data.
+func RequestCaptureMiddleware(capturer RequestCapturer, now func() time.Time) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if request.Header.Get("Authorization") == "" || request.Method == http.MethodOptions {
+				next.ServeHTTP(writer, request)
+				return
+			}
+
+			ctx, info := withRequestInfo(request.Context())
+			requestBody := &cappedBuffer{max: requestcapture.MaxBodyBytes}
+			if request.Body != nil && request.Body != http.NoBody {
+				request.Body = readCloser{
+					Reader: io.TeeReader(request.Body, requestBody),
+					Closer: request.Body,
+				}
+			}
+			responseBody := &cappedBuffer{max: requestcapture.MaxBodyBytes}
+			ww := middleware.NewWrapResponseWriter(writer, request.ProtoMajor)
+			ww.Tee(responseBody)
+
+			requestedAt := now()
+			next.ServeHTTP(ww, request.WithContext(ctx))
+
+			claims := info.claims
+			if claims == nil || info.skipCapture || !slices.Contains(claims.Entitlements, requestCaptureEntitlement) {
+				return
+			}
+			if !capturer.Capturing(ctx, claims.IRacingUserID) {
+				return
+			}
+			err := capturer.Capture(ctx, requestcapture.Request{
+				ID:                    correlation.FromContext(ctx),
+				DriverID:              claims.IRacingUserID,
+				RequestedAt:           requestedAt,
+				Method:                request.Method,
+				Path:                  request.URL.Path,
+				Route:                 routePattern(request),
+				Query:                 request.URL.RawQuery,
+				Status:                ww.Status(),
+				Duration:              now().Sub(requestedAt),
+				RequestContentType:    request.Header.Get("Content-Type"),
+				RequestBody:           requestBody.buf.Bytes(),
+				RequestBodyTruncated:  requestBody.truncated,
+				ResponseContentType:   ww.Header().Get("Content-Type"),
+				ResponseBody:          responseBody.buf.Bytes(),
+				ResponseBodyTruncated: responseBody.truncated,
+			})
+			if err != nil {
+				zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to capture request")
+			}
+		})
+	}
+}
+
+// WithoutRequestCapture keeps requests to handler out of request capture, for routes such as those listing captured
+// requests, whose responses would otherwise end up holding earlier captures.
+func WithoutRequestCapture(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if info, ok := request.Context().Value(requestInfoKey).(*requestInfo); ok {
+			info.skipCapture = true
+		}
+		handler.ServeHTTP(writer, request)
+	})
+}
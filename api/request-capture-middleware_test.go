@@ -0,0 +1,149 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/requestcapture"
+)
+
+func TestRequestCaptureMiddleware(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	developerClaims := &auth.SessionClaims{IRacingUserID: 1100750, Entitlements: []string{"developer"}}
+	driverClaims := &auth.SessionClaims{IRacingUserID: 1100750}
+
+	expectedCapture := requestcapture.Request{
+		ID:                  testCorrelationID,
+		DriverID:            1100750,
+		RequestedAt:         now,
+		Method:              http.MethodPost,
+		Path:                "/driver/1100750/journal",
+		Route:               "/driver/{driver_id}/journal",
+		Query:               "dryRun=true",
+		Status:              http.StatusBadRequest,
+		RequestContentType:  "application/json",
+		RequestBody:         []byte(`{"notes":"x"}`),
+		ResponseContentType: "application/json",
+		ResponseBody:        []byte(`{"message":"bad"}`),
+	}
+
+	type captureCall struct {
+		request requestcapture.Request
+		err     error
+	}
+
+	testCases := []struct {
+		name string
+
+		authHeader  string
+		claims      *auth.SessionClaims
+		skipCapture bool
+
+		expectCapturingCheck bool
+		capturing            bool
+		captureCalls         []captureCall
+	}{
+		{
+			name: "no credentials",
+		},
+		{
+			name:       "credentials not accepted",
+			authHeader: "Bearer invalid",
+		},
+		{
+			name:       "driver without the developer entitlement",
+			authHeader: "Bearer valid",
+			claims:     driverClaims,
+		},
+		{
+			name:                 "developer not capturing",
+			authHeader:           "Bearer valid",
+			claims:               developerClaims,
+			expectCapturingCheck: true,
+		},
+		{
+			name:                 "developer capturing",
+			authHeader:           "Bearer valid",
+			claims:               developerClaims,
+			expectCapturingCheck: true,
+			capturing:            true,
+			captureCalls:         []captureCall{{request: expectedCapture}},
+		},
+		{
+			name:                 "capture failure doesn't fail the request",
+			authHeader:           "Bearer valid",
+			claims:               developerClaims,
+			expectCapturingCheck: true,
+			capturing:            true,
+			captureCalls:         []captureCall{{request: expectedCapture, err: errors.New("database error")}},
+		},
+		{
+			name:        "route kept out of capture",
+			authHeader:  "Bearer valid",
+			claims:      developerClaims,
+			skipCapture: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			capturer := NewMockRequestCapturer(t)
+			if tc.expectCapturingCheck {
+				capturer.EXPECT().Capturing(mock.Anything, int64(1100750)).Return(tc.capturing)
+			}
+			for _, call := range tc.captureCalls {
+				capturer.EXPECT().Capture(mock.Anything, call.request).Return(call.err)
+			}
+
+			// stands in for the auth middleware, which is what adds the claims to the request's info
+			authenticate := func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					ctx := r.Context()
+					if tc.claims != nil {
+						ctx = enrichLogContext(ctx, tc.claims)
+					}
+					next.ServeHTTP(w, r.WithContext(ctx))
+				})
+			}
+			var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, `{"notes":"x"}`, string(body))
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message":"bad"}`))
+			})
+			if tc.skipCapture {
+				handler = WithoutRequestCapture(handler)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(RequestCaptureMiddleware(capturer, func() time.Time { return now }))
+			r.With(authenticate).Post("/driver/{driver_id}/journal", handler.ServeHTTP)
+
+			req := httptest.NewRequest(http.MethodPost, "/driver/1100750/journal?dryRun=true", strings.NewReader(`{"notes":"x"}`))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.authHeader != "" {
+				req.Header.Set("Authorization", tc.authHeader)
+			}
+			res := httptest.NewRecorder()
+			r.ServeHTTP(res, req)
+
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+			assert.Equal(t, `{"message":"bad"}`, res.Body.String())
+		})
+	}
+}
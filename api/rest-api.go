@@ -12,24 +12,52 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/tenant"
 )
 
 type RootRouters struct {
-	HealthRouter    http.Handler
-	AuthRouter      http.Handler
-	DeveloperRouter http.Handler
-	IngestionRouter http.Handler
-	DriverRouter    http.Handler
-	TracksRouter    http.Handler
-	CarsRouter      http.Handler
-	SeriesRouter    http.Handler
-	SessionRouter   http.Handler
+	HealthRouter       http.Handler
+	AuthRouter         http.Handler
+	DeveloperRouter    http.Handler
+	IngestionRouter    http.Handler
+	DriverRouter       http.Handler
+	DriversRouter      http.Handler
+	TracksRouter       http.Handler
+	CarsRouter         http.Handler
+	SeriesRouter       http.Handler
+	SessionRouter      http.Handler
+	CoachingRouter     http.Handler
+	SupporterRouter    http.Handler
+	LeaderboardsRouter http.Handler
+	ScheduleRouter     http.Handler
+	BenchmarkRouter    http.Handler
+	SquadRouter        http.Handler
+	DashboardRouter    http.Handler
+	MobileRouter       http.Handler
+	SeasonCardRouter   http.Handler
+	OverlayRouter      http.Handler
+	// PublicRouter serves the unauthenticated, cacheable routes under /public
+	PublicRouter http.Handler
+
+	// LivenessHandler and ReadinessHandler are served at /healthz and /readyz for load balancers and uptime monitoring
+	LivenessHandler  http.Handler
+	ReadinessHandler http.Handler
+	VersionHandler   http.Handler
 }
 
 type RestAPIConfig struct {
-	CORSAllowedOrigins []string
+	// CORS is the cross origin policy for everything but the public routes, which allow any origin.
+	CORS CORSConfig
 	// DeadlineBuffer is subtracted from existing context deadlines to leave room for cleanup.
 	DeadlineBuffer time.Duration
+	// Tenants are the tenants requests may be for, nil for a deployment hosting only the default one.
+	Tenants *tenant.Registry
+	// PayloadLogging controls which requests have their bodies logged at trace level.
+	PayloadLogging PayloadLoggingConfig
+	// RequestCapture keeps the requests of developers who have turned on request capture, nil to capture nothing.
+	RequestCapture RequestCapturer
+	// Latency records how long requests take, nil to record nothing.
+	Latency LatencyEmitter
 }
 
 func NewRestAPI(logger zerolog.Logger, correlationIDGenerator correlation.IDGenerator, routers RootRouters, cfg RestAPIConfig) http.Handler {
@@ -37,28 +65,60 @@ func NewRestAPI(logger zerolog.Logger, correlationIDGenerator correlation.IDGene
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.Compress(5))
 	r.Use(middleware.RealIP)
-	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   cfg.CORSAllowedOrigins,
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-Correlation-ID"},
-		ExposedHeaders:   []string{"X-Correlation-ID"},
-		AllowCredentials: true,
-		MaxAge:           300,
-	}))
 	r.Use(ZerologLogAttachMiddleware(logger))
 	r.Use(correlation.Middleware(correlationIDGenerator))
 	r.Use(ReduceDeadlineMiddleware(cfg.DeadlineBuffer))
-	r.Use(RequestLoggingMiddleware())
-
-	r.Mount("/health", routers.HealthRouter)
-	r.Mount("/auth", routers.AuthRouter)
-	r.Mount("/ingestion", routers.IngestionRouter)
-	r.Mount("/developer", routers.DeveloperRouter)
-	r.Mount("/driver", routers.DriverRouter)
-	r.Mount("/tracks", routers.TracksRouter)
-	r.Mount("/cars", routers.CarsRouter)
-	r.Mount("/series", routers.SeriesRouter)
-	r.Mount("/session", routers.SessionRouter)
+	r.Use(RequestLoggingMiddleware(cfg.PayloadLogging))
+	tenants := cfg.Tenants
+	if tenants == nil {
+		tenants, _ = tenant.NewRegistry(nil)
+	}
+	r.Use(TenantMiddleware(tenants))
+	// after the tenant middleware so latency is recorded against the tenant
+	if cfg.Latency != nil {
+		r.Use(LatencyMiddleware(cfg.Latency))
+	}
+	if cfg.RequestCapture != nil {
+		r.Use(RequestCaptureMiddleware(cfg.RequestCapture, time.Now))
+	}
+
+	// the public routes can be read from any origin, but without credentials, so a CDN can cache both them and their
+	// preflights
+	r.With(cors.Handler(cors.Options{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"GET", "OPTIONS"},
+		AllowedHeaders: []string{"Accept", "Content-Type", "X-Correlation-ID", tenant.HeaderName},
+		ExposedHeaders: []string{"X-Correlation-ID", BuildSHAHeader},
+		MaxAge:         86400,
+	})).Mount("/public", routers.PublicRouter)
+
+	r.Group(func(r chi.Router) {
+		r.Use(CORSMiddleware(cfg.CORS))
+
+		r.Get("/healthz", routers.LivenessHandler.ServeHTTP)
+		r.Get("/readyz", routers.ReadinessHandler.ServeHTTP)
+		r.Get("/version", routers.VersionHandler.ServeHTTP)
+		r.Mount("/health", routers.HealthRouter)
+		r.Mount("/auth", routers.AuthRouter)
+		r.Mount("/ingestion", routers.IngestionRouter)
+		r.Mount("/developer", routers.DeveloperRouter)
+		r.Mount("/driver", routers.DriverRouter)
+		r.Mount("/drivers", routers.DriversRouter)
+		r.Mount("/tracks", routers.TracksRouter)
+		r.Mount("/cars", routers.CarsRouter)
+		r.Mount("/series", routers.SeriesRouter)
+		r.Mount("/session", routers.SessionRouter)
+		r.Mount("/coaching", routers.CoachingRouter)
+		r.Mount("/supporter", routers.SupporterRouter)
+		r.Mount("/leaderboards", routers.LeaderboardsRouter)
+		r.Mount("/schedule", routers.ScheduleRouter)
+		r.Mount("/benchmarks", routers.BenchmarkRouter)
+		r.Mount("/squads", routers.SquadRouter)
+		r.Mount("/dashboard", routers.DashboardRouter)
+		r.Mount("/mobile", routers.MobileRouter)
+		r.Mount("/season-card", routers.SeasonCardRouter)
+		r.Mount("/overlay", routers.OverlayRouter)
+	})
 
 	return xray.Handler(xray.NewFixedSegmentNamer("processHttpRequest"), r)
 }
@@ -73,25 +133,6 @@ func ZerologLogAttachMiddleware(logger zerolog.Logger) func(next http.Handler) h
 	}
 }
 
-func RequestLoggingMiddleware() func(next http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
-			ww := middleware.NewWrapResponseWriter(writer, request.ProtoMajor)
-
-			t1 := time.Now()
-			defer func() {
-				zerolog.Ctx(request.Context()).Info().
-					Int("status", ww.Status()).
-					Int("bytesWritten", ww.BytesWritten()).
-					Dur("duration", time.Since(t1)).
-					Msg("request processed")
-			}()
-
-			next.ServeHTTP(ww, request)
-		})
-	}
-}
-
 // ReduceDeadlineMiddleware reduces existing context deadlines by the specified buffer.
 // If no deadline exists, the context is passed through unchanged.
 func ReduceDeadlineMiddleware(buffer time.Duration) func(next http.Handler) http.Handler {
@@ -0,0 +1,45 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/schedule"
+	"github.com/rs/zerolog"
+)
+
+type RecommendationService interface {
+	GetRecommended(ctx context.Context, driverID int64, accessToken string) (*schedule.Recommendations, error)
+}
+
+// NewGetRecommendedEndpoint returns the upcoming race slots the logged-in driver has historically done well in.
+func NewGetRecommendedEndpoint(svc RecommendationService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		sensitiveClaims := api.SensitiveClaimsFromContext(ctx)
+		if claims == nil || sensitiveClaims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		recommendations, err := svc.GetRecommended(ctx, claims.IRacingUserID, sensitiveClaims.IRacingAccessToken)
+		if err != nil {
+			if errors.Is(err, iracing.ErrUpstreamUnauthorized) {
+				logger.Warn().Err(err).Msg("iRacing token expired while fetching seasons")
+				api.DoUnauthorizedResponse(ctx, "iRacing access token expired", w)
+				return
+			}
+			logger.Error().Err(err).Msg("failed to get recommended schedule")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, recommendationsFromSchedule(*recommendations), w)
+	})
+}
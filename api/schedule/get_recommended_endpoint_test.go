@@ -0,0 +1,178 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/schedule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+type stubTokenValidator struct {
+	sessionClaims   *auth.SessionClaims
+	sensitiveClaims *auth.SensitiveClaims
+	err             error
+}
+
+func (s *stubTokenValidator) ValidateToken(_ context.Context, _ string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+	return s.sessionClaims, s.sensitiveClaims, s.err
+}
+
+func TestGetRecommendedEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+	testSensitiveClaims := &auth.SensitiveClaims{
+		IRacingAccessToken: "test-access-token",
+	}
+	generatedAt := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	type getRecommendedCall struct {
+		recommendations *schedule.Recommendations
+		err             error
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionClaims *auth.SessionClaims
+		tokenErr      error
+
+		getRecommendedCall *getRecommendedCall
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "invalid token returns 401",
+			tokenErr:                    errors.New("missing token"),
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/get_recommended_unauthorized_response.json",
+		},
+		{
+			name:          "service error returns 500",
+			sessionClaims: testSessionClaims,
+			getRecommendedCall: &getRecommendedCall{
+				err: errors.New("database error"),
+			},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/get_recommended_error_response.json",
+		},
+		{
+			name:          "iRacing token expired returns 401",
+			sessionClaims: testSessionClaims,
+			getRecommendedCall: &getRecommendedCall{
+				err: fmt.Errorf("getting seasons: %w", iracing.ErrUpstreamUnauthorized),
+			},
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/get_recommended_iracing_expired_response.json",
+		},
+		{
+			name:          "no recommendations",
+			sessionClaims: testSessionClaims,
+			getRecommendedCall: &getRecommendedCall{
+				recommendations: &schedule.Recommendations{GeneratedAt: generatedAt, Items: []schedule.Recommendation{}},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_recommended_empty_response.json",
+		},
+		{
+			name:          "success",
+			sessionClaims: testSessionClaims,
+			getRecommendedCall: &getRecommendedCall{
+				recommendations: &schedule.Recommendations{
+					GeneratedAt: generatedAt,
+					Items: []schedule.Recommendation{
+						{
+							SeasonID:    5012,
+							SeriesID:    139,
+							SeasonName:  "Global Mazda MX-5 Cup - 2026 Season 2",
+							RaceWeekNum: 1,
+							TrackID:     47,
+							TrackName:   "WeatherTech Raceway at Laguna Seca",
+							ConfigName:  "Full Course",
+							CarClassID:  74,
+							Slots:       []time.Time{time.Date(2026, 3, 25, 18, 0, 0, 0, time.UTC)},
+							History:     schedule.History{Races: 2, AvgFinishPosition: 2, AvgIncidents: 1, AvgIRatingChange: 30},
+						},
+						{
+							SeasonID:    5012,
+							SeriesID:    139,
+							SeasonName:  "Global Mazda MX-5 Cup - 2026 Season 2",
+							RaceWeekNum: 0,
+							TrackID:     219,
+							TrackName:   "Okayama International Circuit",
+							ConfigName:  "Full Course",
+							CarClassID:  74,
+							Slots: []time.Time{
+								time.Date(2026, 3, 20, 12, 15, 0, 0, time.UTC),
+								time.Date(2026, 3, 20, 14, 15, 0, 0, time.UTC),
+							},
+							History:     schedule.History{Races: 3, AvgFinishPosition: 4, AvgIncidents: 2, AvgIRatingChange: 10},
+							ExpectedSOF: &schedule.SOFWindow{Low: 1500, High: 1800},
+						},
+					},
+				},
+			},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/get_recommended_success_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				sessionClaims:   tc.sessionClaims,
+				sensitiveClaims: testSensitiveClaims,
+				err:             tc.tokenErr,
+			}
+
+			mockService := NewMockRecommendationService(t)
+			if tc.getRecommendedCall != nil {
+				mockService.EXPECT().GetRecommended(mock.Anything, testSessionClaims.IRacingUserID, testSensitiveClaims.IRacingAccessToken).
+					Return(tc.getRecommendedCall.recommendations, tc.getRecommendedCall.err)
+			}
+
+			endpoint := NewGetRecommendedEndpoint(mockService)
+			handler := correlation.Middleware(func() string { return testCorrelationID })(api.AuthMiddleware(validator)(endpoint))
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
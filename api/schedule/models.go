@@ -0,0 +1,72 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/schedule"
+)
+
+type History struct {
+	Races             int     `json:"races"`
+	AvgFinishPosition float64 `json:"avgFinishPosition"`
+	AvgIncidents      float64 `json:"avgIncidents"`
+	AvgIRatingChange  float64 `json:"avgIRatingChange"`
+}
+
+type SOFWindow struct {
+	Low  int `json:"low"`
+	High int `json:"high"`
+}
+
+type Recommendation struct {
+	SeasonID    int64       `json:"seasonId"`
+	SeriesID    int64       `json:"seriesId"`
+	SeasonName  string      `json:"seasonName"`
+	RaceWeekNum int         `json:"raceWeekNum"`
+	TrackID     int64       `json:"trackId"`
+	TrackName   string      `json:"trackName"`
+	ConfigName  string      `json:"configName"`
+	CarClassID  int64       `json:"carClassId"`
+	Slots       []time.Time `json:"slots"`
+	History     History     `json:"history"`
+	ExpectedSOF *SOFWindow  `json:"expectedSof"`
+}
+
+type Recommendations struct {
+	GeneratedAt     time.Time        `json:"generatedAt"`
+	Recommendations []Recommendation `json:"recommendations"`
+}
+
+func recommendationsFromSchedule(recommendations schedule.Recommendations) Recommendations {
+	items := make([]Recommendation, len(recommendations.Items))
+	for i, item := range recommendations.Items {
+		slots := make([]time.Time, len(item.Slots))
+		for j, slot := range item.Slots {
+			slots[j] = slot.UTC()
+		}
+		items[i] = Recommendation{
+			SeasonID:    item.SeasonID,
+			SeriesID:    item.SeriesID,
+			SeasonName:  item.SeasonName,
+			RaceWeekNum: item.RaceWeekNum,
+			TrackID:     item.TrackID,
+			TrackName:   item.TrackName,
+			ConfigName:  item.ConfigName,
+			CarClassID:  item.CarClassID,
+			Slots:       slots,
+			History: History{
+				Races:             item.History.Races,
+				AvgFinishPosition: item.History.AvgFinishPosition,
+				AvgIncidents:      item.History.AvgIncidents,
+				AvgIRatingChange:  item.History.AvgIRatingChange,
+			},
+		}
+		if item.ExpectedSOF != nil {
+			items[i].ExpectedSOF = &SOFWindow{Low: item.ExpectedSOF.Low, High: item.ExpectedSOF.High}
+		}
+	}
+	return Recommendations{
+		GeneratedAt:     recommendations.GeneratedAt.UTC(),
+		Recommendations: items,
+	}
+}
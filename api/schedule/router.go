@@ -0,0 +1,17 @@
+package schedule
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+func NewRouter(svc RecommendationService, authMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(authMiddleware)
+
+	r.Get("/recommended", api.WrapWithSegment("getRecommendedSchedule", NewGetRecommendedEndpoint(svc)).ServeHTTP)
+
+	return r
+}
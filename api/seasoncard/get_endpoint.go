@@ -0,0 +1,41 @@
+package seasoncard
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/seasoncard"
+	"github.com/rs/zerolog"
+)
+
+type ServiceForGet interface {
+	Get(ctx context.Context, driverID int64) (*seasoncard.Shared, error)
+}
+
+// NewGetEndpoint returns the logged-in driver's shared season card and its token, 404 if they haven't shared one.
+func NewGetEndpoint(svc ServiceForGet) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		shared, err := svc.Get(ctx, claims.IRacingUserID)
+		if errors.Is(err, seasoncard.ErrNotShared) {
+			api.DoNotFoundResponse(ctx, "season card not shared", w)
+			return
+		}
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to get season card")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, sharedCardFromService(*shared), w)
+	})
+}
@@ -0,0 +1,152 @@
+package seasoncard
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/seasoncard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+type stubTokenValidator struct {
+	sessionClaims   *auth.SessionClaims
+	sensitiveClaims *auth.SensitiveClaims
+	err             error
+}
+
+func (s *stubTokenValidator) ValidateToken(_ context.Context, _ string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+	return s.sessionClaims, s.sensitiveClaims, s.err
+}
+
+func testShared() *seasoncard.Shared {
+	generatedAt := time.Date(2026, 3, 14, 18, 30, 0, 0, time.UTC)
+	return &seasoncard.Shared{
+		Token: "q3Jx0bVd8pCzN5rWmK2yTfA7uHsLeG9oPiYjR4nX1wE",
+		Card: seasoncard.Card{
+			DriverID:   1100750,
+			DriverName: "Jon Sabados",
+			Season: &seasoncard.Season{
+				Year:          2026,
+				Quarter:       1,
+				Races:         12,
+				Wins:          2,
+				Podiums:       5,
+				Top5s:         8,
+				AvgFinish:     4.25,
+				AvgIncidents:  3.5,
+				IRatingChange: 187,
+			},
+			Ratings: []seasoncard.Rating{
+				{CategoryID: 5, Category: "sports_car", IRating: 2150, LicenseLevel: 18, SubLevel: 312, AsOf: generatedAt.Add(-2 * time.Hour)},
+			},
+			GeneratedAt: generatedAt,
+		},
+		CreatedAt:   time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC),
+		RefreshedAt: generatedAt,
+	}
+}
+
+func TestGetEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+
+	type getCall struct {
+		shared *seasoncard.Shared
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionClaims *auth.SessionClaims
+		tokenErr      error
+
+		getCall *getCall
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "invalid token returns 401",
+			tokenErr:                    errors.New("missing token"),
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/unauthorized_response.json",
+		},
+		{
+			name:                        "service error returns 500",
+			sessionClaims:               testSessionClaims,
+			getCall:                     &getCall{err: errors.New("database error")},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/error_response.json",
+		},
+		{
+			name:                        "not shared returns 404",
+			sessionClaims:               testSessionClaims,
+			getCall:                     &getCall{err: seasoncard.ErrNotShared},
+			expectedResponseStatus:      http.StatusNotFound,
+			expectedResponseBodyFixture: "fixtures/get_not_shared_response.json",
+		},
+		{
+			name:                        "shared card",
+			sessionClaims:               testSessionClaims,
+			getCall:                     &getCall{shared: testShared()},
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/shared_card_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			validator := &stubTokenValidator{
+				sessionClaims:   tc.sessionClaims,
+				sensitiveClaims: &auth.SensitiveClaims{},
+				err:             tc.tokenErr,
+			}
+
+			mockService := NewMockServiceForGet(t)
+			if tc.getCall != nil {
+				mockService.EXPECT().Get(mock.Anything, testSessionClaims.IRacingUserID).Return(tc.getCall.shared, tc.getCall.err)
+			}
+
+			endpoint := NewGetEndpoint(mockService)
+			handler := correlation.Middleware(func() string { return testCorrelationID })(api.AuthMiddleware(validator)(endpoint))
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package seasoncard
+
+import (
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/seasoncard"
+)
+
+// SharedCard is the driver's card as they see it, along with the token it is shared with. The card is served publicly
+// at /public/season-cards/{token}.
+type SharedCard struct {
+	Token       string          `json:"token"`
+	Card        seasoncard.Card `json:"card"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	RefreshedAt time.Time       `json:"refreshedAt"`
+}
+
+func sharedCardFromService(shared seasoncard.Shared) SharedCard {
+	return SharedCard{
+		Token:       shared.Token,
+		Card:        shared.Card,
+		CreatedAt:   shared.CreatedAt.UTC(),
+		RefreshedAt: shared.RefreshedAt.UTC(),
+	}
+}
@@ -0,0 +1,34 @@
+package seasoncard
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/rs/zerolog"
+)
+
+type ServiceForRevoke interface {
+	Revoke(ctx context.Context, driverID int64) error
+}
+
+// NewRevokeEndpoint stops sharing the logged-in driver's season card. Revoking a card that isn't shared succeeds.
+func NewRevokeEndpoint(svc ServiceForRevoke) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		if err := svc.Revoke(ctx, claims.IRacingUserID); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to revoke season card")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
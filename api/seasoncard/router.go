@@ -0,0 +1,27 @@
+package seasoncard
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+type Service interface {
+	ServiceForGet
+	ServiceForShare
+	ServiceForRevoke
+}
+
+// NewRouter builds the routes for the logged-in driver to manage their own season card. The card itself is served
+// publicly by token from the public routes.
+func NewRouter(svc Service, authMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(authMiddleware)
+
+	r.Get("/", api.WrapWithSegment("getSeasonCard", NewGetEndpoint(svc)).ServeHTTP)
+	r.Post("/", api.WrapWithSegment("shareSeasonCard", NewShareEndpoint(svc)).ServeHTTP)
+	r.Delete("/", api.WrapWithSegment("revokeSeasonCard", NewRevokeEndpoint(svc)).ServeHTTP)
+
+	return r
+}
@@ -0,0 +1,42 @@
+package seasoncard
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/seasoncard"
+	"github.com/rs/zerolog"
+)
+
+type ServiceForShare interface {
+	Share(ctx context.Context, driverID int64) (*seasoncard.Shared, error)
+}
+
+// NewShareEndpoint shares a fresh snapshot of the logged-in driver's season under a new token. Sharing again replaces
+// the card, so the old token stops working.
+func NewShareEndpoint(svc ServiceForShare) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		shared, err := svc.Share(ctx, claims.IRacingUserID)
+		if errors.Is(err, seasoncard.ErrDriverNotFound) {
+			api.DoNotFoundResponse(ctx, "driver not found", w)
+			return
+		}
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to share season card")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, sharedCardFromService(*shared), w)
+	})
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/jonsabados/saturdaysspinout/api"
 	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
 )
 
 const (
@@ -22,7 +23,13 @@ type LapDataClient interface {
 	GetLapData(ctx context.Context, accessToken string, subsessionID int64, simsessionNumber int, opts ...iracing.GetLapDataOption) (*iracing.LapDataResponse, error)
 }
 
-func NewGetLapsEndpoint(client LapDataClient) http.Handler {
+type LapVideoLinkFinder interface {
+	ForLaps(ctx context.Context, driverID, raceID int64) (map[int][]store.VideoLink, error)
+}
+
+// NewGetLapsEndpoint proxies a driver's laps from iRacing. When the laps are the caller's own, the videos they attached
+// to each lap are included. Passing event codes narrows the laps to those with any of the events.
+func NewGetLapsEndpoint(client LapDataClient, videoLinks LapVideoLinkFinder) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		logger := zerolog.Ctx(ctx)
@@ -71,6 +78,8 @@ func NewGetLapsEndpoint(client LapDataClient) http.Handler {
 			}
 		}
 
+		events, errs := parseLapEventFilter(r, errs)
+
 		if errs.HasAnyError() {
 			api.DoBadRequestResponse(ctx, errs, w)
 			return
@@ -90,15 +99,41 @@ func NewGetLapsEndpoint(client LapDataClient) http.Handler {
 				api.DoUnauthorizedResponse(ctx, "iRacing access token expired", w)
 				return
 			}
+			if errors.Is(err, iracing.ErrNotFound) {
+				api.DoNotFoundResponse(ctx, "lap data not found", w)
+				return
+			}
+			if errors.Is(err, iracing.ErrRateLimited) {
+				logger.Warn().Err(err).Int64("subsessionId", subsessionID).Msg("rate limited by iRacing while fetching lap data")
+				api.DoTooManyRequestsResponse(ctx, "iRacing rate limit exceeded", rateLimitRetryAfter(err), w)
+				return
+			}
 			logger.Error().Err(err).
 				Int64("subsessionId", subsessionID).
 				Int("simsession", simsession).
-				Int64("driverId", driverID).
+				Int64("lapsDriverId", driverID).
 				Msg("failed to fetch lap data")
 			api.DoErrorResponse(ctx, w)
 			return
 		}
 
-		api.DoOKResponse(ctx, lapDataResponseFromIRacing(result), w)
+		response := lapDataResponseFromIRacing(result)
+		if sessionClaims := api.SessionClaimsFromContext(ctx); sessionClaims != nil && sessionClaims.IRacingUserID == driverID {
+			raceID := store.DriverRaceIDFromTime(result.SessionInfo.StartTime)
+			linksByLap, err := videoLinks.ForLaps(ctx, driverID, raceID)
+			if err != nil {
+				logger.Error().Err(err).Int64("raceId", raceID).Msg("failed to fetch lap video links")
+				api.DoErrorResponse(ctx, w)
+				return
+			}
+			for i, lap := range response.Laps {
+				if links := linksByLap[lap.LapNumber]; len(links) > 0 {
+					response.Laps[i].VideoLinks = videoLinksFromStore(links)
+				}
+			}
+		}
+		response.Laps = filterLaps(response.Laps, events)
+
+		api.DoOKResponse(ctx, response, w)
 	})
 }
\ No newline at end of file
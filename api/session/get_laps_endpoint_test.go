@@ -14,6 +14,7 @@ import (
 	"github.com/jonsabados/saturdaysspinout/auth"
 	"github.com/jonsabados/saturdaysspinout/correlation"
 	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -38,6 +39,7 @@ func TestNewGetLapsEndpoint(t *testing.T) {
 			SimsessionNumber: 0,
 			SimsessionType:   6,
 			SimsessionName:   "RACE",
+			StartTime:        time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC),
 		},
 		BestLapNum:      8,
 		BestLapTime:     95500,
@@ -71,7 +73,7 @@ func TestNewGetLapsEndpoint(t *testing.T) {
 			},
 			{
 				LapNumber:       3,
-				Flags:           0,
+				Flags:           4,
 				Incident:        true,
 				SessionTime:     254700,
 				LapTime:         97800,
@@ -90,6 +92,18 @@ func TestNewGetLapsEndpoint(t *testing.T) {
 		},
 	}
 
+	lapThree := 3
+	linksByLap := map[int][]store.VideoLink{
+		3: {
+			{DriverID: 1100750, RaceID: 1705327200, LinkID: "link-1", Lap: &lapThree, URL: "https://www.youtube.com/watch?v=abc123&t=254s", Provider: "youtube", Title: "Off at the bus stop", CreatedAt: time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	type videoLinksCall struct {
+		links map[int][]store.VideoLink
+		err   error
+	}
+
 	type clientCall struct {
 		subsessionID int64
 		simsession   int
@@ -104,12 +118,14 @@ func TestNewGetLapsEndpoint(t *testing.T) {
 		subsessionID string
 		simsession   string
 		driverID     string
+		queryString  string
 
 		sessionClaims   *auth.SessionClaims
 		sensitiveClaims *auth.SensitiveClaims
 		tokenErr        error
 
-		clientCall *clientCall
+		clientCall     *clientCall
+		videoLinksCall *videoLinksCall
 
 		expectedStatus      int
 		expectedBodyFixture string
@@ -127,9 +143,77 @@ func TestNewGetLapsEndpoint(t *testing.T) {
 				driverID:     1100750,
 				result:       testLapDataResponse,
 			},
+			videoLinksCall:      &videoLinksCall{},
 			expectedStatus:      http.StatusOK,
 			expectedBodyFixture: "fixtures/get_laps_success_response.json",
 		},
+		{
+			name:            "with video links",
+			subsessionID:    "12345678",
+			simsession:      "0",
+			driverID:        "1100750",
+			sessionClaims:   testSessionClaims,
+			sensitiveClaims: testSensitiveClaims,
+			clientCall: &clientCall{
+				subsessionID: 12345678,
+				simsession:   0,
+				driverID:     1100750,
+				result:       testLapDataResponse,
+			},
+			videoLinksCall:      &videoLinksCall{links: linksByLap},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_laps_video_links_response.json",
+		},
+		{
+			name:            "another driver's laps",
+			subsessionID:    "12345678",
+			simsession:      "0",
+			driverID:        "1100751",
+			sessionClaims:   testSessionClaims,
+			sensitiveClaims: testSensitiveClaims,
+			clientCall: &clientCall{
+				subsessionID: 12345678,
+				simsession:   0,
+				driverID:     1100751,
+				result:       testLapDataResponse,
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_laps_success_response.json",
+		},
+		{
+			name:            "filtered by event",
+			subsessionID:    "12345678",
+			simsession:      "0",
+			driverID:        "1100751",
+			queryString:     "event=off_track&event=tow",
+			sessionClaims:   testSessionClaims,
+			sensitiveClaims: testSensitiveClaims,
+			clientCall: &clientCall{
+				subsessionID: 12345678,
+				simsession:   0,
+				driverID:     1100751,
+				result:       testLapDataResponse,
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_laps_event_filter_response.json",
+		},
+		{
+			name:            "video links error",
+			subsessionID:    "12345678",
+			simsession:      "0",
+			driverID:        "1100750",
+			sessionClaims:   testSessionClaims,
+			sensitiveClaims: testSensitiveClaims,
+			clientCall: &clientCall{
+				subsessionID: 12345678,
+				simsession:   0,
+				driverID:     1100750,
+				result:       testLapDataResponse,
+			},
+			videoLinksCall:      &videoLinksCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_laps_error_response.json",
+		},
 		{
 			name:            "invalid subsession_id",
 			subsessionID:    "not-a-number",
@@ -187,6 +271,22 @@ func TestNewGetLapsEndpoint(t *testing.T) {
 			expectedStatus:      http.StatusUnauthorized,
 			expectedBodyFixture: "fixtures/get_laps_iracing_expired_response.json",
 		},
+		{
+			name:            "lap data not found on iracing",
+			subsessionID:    "12345678",
+			simsession:      "0",
+			driverID:        "1100750",
+			sessionClaims:   testSessionClaims,
+			sensitiveClaims: testSensitiveClaims,
+			clientCall: &clientCall{
+				subsessionID: 12345678,
+				simsession:   0,
+				driverID:     1100750,
+				err:          iracing.ErrNotFound,
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_laps_not_found_response.json",
+		},
 		{
 			name:            "client error",
 			subsessionID:    "12345678",
@@ -219,15 +319,21 @@ func TestNewGetLapsEndpoint(t *testing.T) {
 					Return(tc.clientCall.result, tc.clientCall.err)
 			}
 
+			mockVideoLinks := NewMockLapVideoLinkFinder(t)
+			if tc.videoLinksCall != nil {
+				mockVideoLinks.EXPECT().ForLaps(mock.Anything, int64(1100750), int64(1705327200)).
+					Return(tc.videoLinksCall.links, tc.videoLinksCall.err)
+			}
+
 			r := chi.NewRouter()
 			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
 			r.Use(api.AuthMiddleware(validator))
-			r.Get("/{"+SubsessionIDPathParam+"}/simsession/{"+SimsessionPathParam+"}/driver/{"+DriverIDPathParam+"}/laps", NewGetLapsEndpoint(mockClient).ServeHTTP)
+			r.Get("/{"+SubsessionIDPathParam+"}/simsession/{"+SimsessionPathParam+"}/driver/{"+DriverIDPathParam+"}/laps", NewGetLapsEndpoint(mockClient, mockVideoLinks).ServeHTTP)
 
 			ts := httptest.NewServer(r)
 			defer ts.Close()
 
-			url := ts.URL + "/" + tc.subsessionID + "/simsession/" + tc.simsession + "/driver/" + tc.driverID + "/laps"
+			url := ts.URL + "/" + tc.subsessionID + "/simsession/" + tc.simsession + "/driver/" + tc.driverID + "/laps?" + tc.queryString
 
 			req, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
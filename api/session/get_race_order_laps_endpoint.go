@@ -0,0 +1,111 @@
+package session
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/lapevents"
+	"github.com/jonsabados/saturdaysspinout/lapflags"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+const (
+	defaultRaceOrderLapLimit = 100
+	maxRaceOrderLapLimit     = 500
+)
+
+// Error codes for i18n support
+const (
+	ErrCodeOutOfRange    = "out_of_range"
+	ErrCodeInvalidCursor = "invalid_cursor"
+)
+
+type RaceOrderLapsStore interface {
+	GetSessionLapsInRaceOrder(ctx context.Context, subsessionID int64, after string, limit int) ([]store.SessionDriverLap, bool, error)
+}
+
+// NewGetRaceOrderLapsEndpoint pages through the laps stored for a session, across every driver, in the order they were
+// completed. The cursor marks a place in that order rather than an offset, so laps ingested between pages don't cause
+// any to be skipped or repeated. Only stored laps are returned, nothing is fetched from iRacing. Passing event codes
+// narrows each page to the laps with any of the events, so a page can hold fewer than limit laps, or none, while there
+// are still more to page through.
+func NewGetRaceOrderLapsEndpoint(lapStore RaceOrderLapsStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		subsessionID, err := strconv.ParseInt(chi.URLParam(r, SubsessionIDPathParam), 10, 64)
+		if err != nil {
+			errs = errs.WithFieldError(SubsessionIDPathParam, "must be a valid integer")
+		}
+
+		limit := defaultRaceOrderLapLimit
+		if limitStr := r.URL.Query().Get(api.LimitQueryParam); limitStr != "" {
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit < 1 || limit > maxRaceOrderLapLimit {
+				errs = errs.WithFieldErrorCode(api.LimitQueryParam, ErrCodeOutOfRange, map[string]string{
+					"min": "1",
+					"max": strconv.Itoa(maxRaceOrderLapLimit),
+				})
+			}
+		}
+
+		var after string
+		if cursor := r.URL.Query().Get(api.CursorQueryParam); cursor != "" {
+			decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+			if err != nil {
+				errs = errs.WithFieldErrorCode(api.CursorQueryParam, ErrCodeInvalidCursor, nil)
+			}
+			after = string(decoded)
+		}
+
+		events, errs := parseLapEventFilter(r, errs)
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		laps, more, err := lapStore.GetSessionLapsInRaceOrder(ctx, subsessionID, after, limit)
+		if err != nil {
+			logger.Error().Err(err).Int64("subsessionId", subsessionID).Msg("failed to get laps in race order")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		response := RaceOrderLapsResponse{
+			SubsessionID: subsessionID,
+			Laps:         make([]RaceOrderLap, 0, len(laps)),
+		}
+		for _, l := range filterStoredLaps(laps, events) {
+			response.Laps = append(response.Laps, RaceOrderLap{
+				DriverID: l.DriverID,
+				Lap: Lap{
+					LapNumber:       l.LapNumber,
+					Flags:           l.Flags,
+					Incident:        l.Incident,
+					SessionTime:     l.SessionTime,
+					LapTime:         l.LapTime,
+					PersonalBestLap: l.PersonalBestLap,
+					LapEvents:       lapevents.Text(l.LapEvents),
+					EventCodes:      eventCodes(l.LapEvents),
+					FlagNames:       lapflags.Decode(l.Flags),
+					Synthetic:       l.Synthetic,
+				},
+			})
+		}
+		if more {
+			response.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(store.RaceOrderCursor(laps[len(laps)-1])))
+		}
+
+		api.DoOKResponse(ctx, response, w)
+	})
+}
@@ -0,0 +1,153 @@
+package session
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetRaceOrderLapsEndpoint(t *testing.T) {
+	type storeCall struct {
+		after string
+		limit int
+		laps  []store.SessionDriverLap
+		more  bool
+		err   error
+	}
+
+	testCases := []struct {
+		name string
+
+		subsessionID string
+		queryString  string
+
+		storeCall *storeCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:         "first page",
+			subsessionID: "12345",
+			queryString:  "limit=2",
+			storeCall: &storeCall{
+				limit: 2,
+				laps: []store.SessionDriverLap{
+					{SubsessionID: 12345, DriverID: 1, LapNumber: 1, SessionTime: 1900000, LapTime: 950000, PersonalBestLap: true},
+					{SubsessionID: 12345, DriverID: 2, LapNumber: 1, Flags: 4, Incident: true, SessionTime: 1950000, LapTime: 1000000, LapEvents: []string{"off_track"}},
+				},
+				more: true,
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_race_order_laps_first_page_response.json",
+		},
+		{
+			name:         "last page",
+			subsessionID: "12345",
+			queryString:  "cursor=MDAwMTk1MDAwMCMyIzAwMDE",
+			storeCall: &storeCall{
+				after: "0001950000#2#0001",
+				limit: defaultRaceOrderLapLimit,
+				laps:  []store.SessionDriverLap{},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_race_order_laps_last_page_response.json",
+		},
+		{
+			name:         "synthetic lap",
+			subsessionID: "12345",
+			storeCall: &storeCall{
+				limit: defaultRaceOrderLapLimit,
+				laps: []store.SessionDriverLap{
+					{SubsessionID: 12345, DriverID: 1, LapNumber: 2, SessionTime: 2850000, LapTime: -1, Synthetic: true},
+				},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_race_order_laps_synthetic_response.json",
+		},
+		{
+			name:         "filtered by event",
+			subsessionID: "12345",
+			queryString:  "limit=2&event=off_track&event=contact",
+			storeCall: &storeCall{
+				limit: 2,
+				laps: []store.SessionDriverLap{
+					{SubsessionID: 12345, DriverID: 1, LapNumber: 1, SessionTime: 1900000, LapTime: 950000, PersonalBestLap: true},
+					// stored before events were normalized
+					{SubsessionID: 12345, DriverID: 2, LapNumber: 1, Flags: 4, Incident: true, SessionTime: 1950000, LapTime: 1000000, LapEvents: []string{"off track"}},
+				},
+				more: true,
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_race_order_laps_event_filter_response.json",
+		},
+		{
+			name:                "invalid event",
+			subsessionID:        "12345",
+			queryString:         "event=off+track",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_race_order_laps_invalid_event_response.json",
+		},
+		{
+			name:                "invalid subsession id",
+			subsessionID:        "abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_race_order_laps_invalid_subsession_id_response.json",
+		},
+		{
+			name:                "invalid params",
+			subsessionID:        "12345",
+			queryString:         "limit=501&cursor=not*base64",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_race_order_laps_invalid_params_response.json",
+		},
+		{
+			name:                "store error",
+			subsessionID:        "12345",
+			storeCall:           &storeCall{limit: defaultRaceOrderLapLimit, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_race_order_laps_store_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockRaceOrderLapsStore(t)
+			if tc.storeCall != nil {
+				mockStore.EXPECT().GetSessionLapsInRaceOrder(mock.Anything, int64(12345), tc.storeCall.after, tc.storeCall.limit).
+					Return(tc.storeCall.laps, tc.storeCall.more, tc.storeCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Get("/{"+SubsessionIDPathParam+"}/laps", NewGetRaceOrderLapsEndpoint(mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			res, err := http.Get(ts.URL + "/" + tc.subsessionID + "/laps?" + tc.queryString)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
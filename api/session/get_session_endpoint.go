@@ -3,8 +3,10 @@ package session
 import (
 	"context"
 	"errors"
+	"math"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog"
@@ -40,6 +42,11 @@ func NewGetSessionEndpoint(client IRacingClient) http.Handler {
 			}
 		}
 
+		fields, err := api.ParseFieldSelection[SessionResponse](r)
+		if err != nil {
+			errs = errs.WithFieldError(api.FieldsQueryParam, err.Error())
+		}
+
 		if errs.HasAnyError() {
 			api.DoBadRequestResponse(ctx, errs, w)
 			return
@@ -59,6 +66,15 @@ func NewGetSessionEndpoint(client IRacingClient) http.Handler {
 				api.DoUnauthorizedResponse(ctx, "iRacing access token expired", w)
 				return
 			}
+			if errors.Is(err, iracing.ErrNotFound) {
+				api.DoNotFoundResponse(ctx, "session not found", w)
+				return
+			}
+			if errors.Is(err, iracing.ErrRateLimited) {
+				logger.Warn().Err(err).Int64("subsessionId", subsessionID).Msg("rate limited by iRacing while fetching session results")
+				api.DoTooManyRequestsResponse(ctx, "iRacing rate limit exceeded", rateLimitRetryAfter(err), w)
+				return
+			}
 			logger.Error().Err(err).Int64("subsessionId", subsessionID).Msg("failed to fetch session results")
 			api.DoErrorResponse(ctx, w)
 			return
@@ -71,6 +87,18 @@ func NewGetSessionEndpoint(client IRacingClient) http.Handler {
 			return
 		}
 
-		api.DoOKResponse(ctx, sessionResponseFromIRacing(result, sessionClaims.IRacingUserID), w)
+		api.DoOKResponse(ctx, fields.Select(sessionResponseFromIRacing(result, sessionClaims.IRacingUserID)), w)
 	})
 }
+
+// defaultRateLimitRetryAfter is what callers are told to wait when iRacing didn't say when its rate limit resets
+const defaultRateLimitRetryAfter = 60
+
+// rateLimitRetryAfter returns the whole seconds until the iRacing rate limit behind err resets.
+func rateLimitRetryAfter(err error) int {
+	resetAt, ok := iracing.RateLimitResetAt(err)
+	if !ok {
+		return defaultRateLimitRetryAfter
+	}
+	return max(int(math.Ceil(time.Until(resetAt).Seconds())), 1)
+}
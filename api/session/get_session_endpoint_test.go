@@ -3,6 +3,7 @@ package session
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -555,6 +556,7 @@ func TestNewGetSessionEndpoint(t *testing.T) {
 		name string
 
 		subsessionID string
+		fields       string
 
 		sessionClaims   *auth.SessionClaims
 		sensitiveClaims *auth.SensitiveClaims
@@ -577,6 +579,28 @@ func TestNewGetSessionEndpoint(t *testing.T) {
 			expectedStatus:      http.StatusOK,
 			expectedBodyFixture: "fixtures/get_session_success_response.json",
 		},
+		{
+			name:            "success with selected fields",
+			subsessionID:    "12345678",
+			fields:          "subsessionId,track.trackName,sessionResults.simsessionName,sessionResults.results.displayName",
+			sessionClaims:   testSessionClaims,
+			sensitiveClaims: testSensitiveClaims,
+			clientCall: &clientCall{
+				subsessionID: 12345678,
+				result:       testSessionResult,
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_session_selected_fields_response.json",
+		},
+		{
+			name:                "unknown field",
+			subsessionID:        "12345678",
+			fields:              "track.nope",
+			sessionClaims:       testSessionClaims,
+			sensitiveClaims:     testSensitiveClaims,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_session_unknown_field_response.json",
+		},
 		{
 			name:            "invalid subsession_id",
 			subsessionID:    "not-a-number",
@@ -606,6 +630,30 @@ func TestNewGetSessionEndpoint(t *testing.T) {
 			expectedStatus:      http.StatusUnauthorized,
 			expectedBodyFixture: "fixtures/get_session_iracing_expired_response.json",
 		},
+		{
+			name:            "session not found on iracing",
+			subsessionID:    "12345678",
+			sessionClaims:   testSessionClaims,
+			sensitiveClaims: testSensitiveClaims,
+			clientCall: &clientCall{
+				subsessionID: 12345678,
+				err:          fmt.Errorf("fetching session results: %w", iracing.ErrNotFound),
+			},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_session_not_found_response.json",
+		},
+		{
+			name:            "rate limited by iracing",
+			subsessionID:    "12345678",
+			sessionClaims:   testSessionClaims,
+			sensitiveClaims: testSensitiveClaims,
+			clientCall: &clientCall{
+				subsessionID: 12345678,
+				err:          &iracing.RateLimitError{},
+			},
+			expectedStatus:      http.StatusTooManyRequests,
+			expectedBodyFixture: "fixtures/get_session_rate_limited_response.json",
+		},
 		{
 			name:            "client error",
 			subsessionID:    "12345678",
@@ -655,6 +703,9 @@ func TestNewGetSessionEndpoint(t *testing.T) {
 			defer ts.Close()
 
 			url := ts.URL + "/" + tc.subsessionID
+			if tc.fields != "" {
+				url += "?fields=" + tc.fields
+			}
 
 			req, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
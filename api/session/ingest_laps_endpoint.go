@@ -0,0 +1,108 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+const DriverIDQueryParam = "driver_id"
+
+type IngestLapsStore interface {
+	SaveSessionDriverLaps(ctx context.Context, laps []store.SessionDriverLap) error
+}
+
+// NewIngestLapsEndpoint fetches a participant's race laps from iRacing using the caller's token and stores them, so
+// that lap based features work against any driver in the session, not just the caller.
+func NewIngestLapsEndpoint(client LapDataClient, lapStore IngestLapsStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		subsessionIDStr := chi.URLParam(r, SubsessionIDPathParam)
+		if subsessionIDStr == "" {
+			errs = errs.WithFieldError(SubsessionIDPathParam, "required")
+		}
+
+		driverIDStr := r.URL.Query().Get(DriverIDQueryParam)
+		if driverIDStr == "" {
+			errs = errs.WithFieldError(DriverIDQueryParam, "required")
+		}
+
+		var subsessionID int64
+		var driverID int64
+		var err error
+
+		if subsessionIDStr != "" {
+			subsessionID, err = strconv.ParseInt(subsessionIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError(SubsessionIDPathParam, "must be a valid integer")
+			}
+		}
+
+		if driverIDStr != "" {
+			driverID, err = strconv.ParseInt(driverIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError(DriverIDQueryParam, "must be a valid integer")
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		claims := api.SensitiveClaimsFromContext(ctx)
+		if claims == nil {
+			logger.Error().Msg("sensitive claims not found in context")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		result, err := client.GetLapData(ctx, claims.IRacingAccessToken, subsessionID, raceSimsessionNumber, iracing.WithCustomerIDLap(driverID))
+		if err != nil {
+			if errors.Is(err, iracing.ErrUpstreamUnauthorized) {
+				logger.Warn().Err(err).Msg("iRacing token expired while fetching lap data")
+				api.DoUnauthorizedResponse(ctx, "iRacing access token expired", w)
+				return
+			}
+			logger.Error().Err(err).
+				Int64("subsessionId", subsessionID).
+				Int64("lapsDriverId", driverID).
+				Msg("failed to fetch lap data")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		if len(result.Laps) == 0 {
+			api.DoNotFoundResponse(ctx, "no race laps found for driver", w)
+			return
+		}
+
+		laps := sessionDriverLapsFromIRacing(subsessionID, driverID, result.Laps)
+		if err := lapStore.SaveSessionDriverLaps(ctx, laps); err != nil {
+			logger.Error().Err(err).
+				Int64("subsessionId", subsessionID).
+				Int64("lapsDriverId", driverID).
+				Msg("failed to save laps")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, IngestLapsResponse{
+			SubsessionID: subsessionID,
+			DriverID:     driverID,
+			LapsIngested: len(laps),
+		}, w)
+	})
+}
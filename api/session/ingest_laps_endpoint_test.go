@@ -0,0 +1,173 @@
+package session
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIngestLapsEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+	testSensitiveClaims := &auth.SensitiveClaims{
+		IRacingAccessToken: "test-access-token",
+	}
+
+	testLapData := &iracing.LapDataResponse{
+		CustID: 2000,
+		Laps: []iracing.Lap{
+			{LapNumber: 0, SessionTime: 60000, LapTime: -1},
+			{LapNumber: 1, Flags: 4, Incident: true, SessionTime: 157000, LapTime: 97000, LapEvents: []string{"off track"}},
+		},
+	}
+
+	expectedLaps := []store.SessionDriverLap{
+		{SubsessionID: 12345678, DriverID: 2000, LapNumber: 0, SessionTime: 60000, LapTime: -1},
+		{SubsessionID: 12345678, DriverID: 2000, LapNumber: 1, Flags: 4, Incident: true, SessionTime: 157000, LapTime: 97000, LapEvents: []string{"off_track"}},
+	}
+
+	type lapDataCall struct {
+		result *iracing.LapDataResponse
+		err    error
+	}
+
+	type saveLapsCall struct {
+		laps []store.SessionDriverLap
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		subsessionID string
+		driverID     string
+
+		lapDataCall  *lapDataCall
+		saveLapsCall *saveLapsCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			subsessionID:        "12345678",
+			driverID:            "2000",
+			lapDataCall:         &lapDataCall{result: testLapData},
+			saveLapsCall:        &saveLapsCall{laps: expectedLaps},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/ingest_laps_success_response.json",
+		},
+		{
+			name:                "missing driver_id",
+			subsessionID:        "12345678",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/ingest_laps_missing_driver_id_response.json",
+		},
+		{
+			name:                "invalid params",
+			subsessionID:        "not-a-number",
+			driverID:            "also-not-a-number",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/ingest_laps_invalid_params_response.json",
+		},
+		{
+			name:                "no laps",
+			subsessionID:        "12345678",
+			driverID:            "2000",
+			lapDataCall:         &lapDataCall{result: &iracing.LapDataResponse{CustID: 2000}},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/ingest_laps_no_laps_response.json",
+		},
+		{
+			name:                "iracing token expired",
+			subsessionID:        "12345678",
+			driverID:            "2000",
+			lapDataCall:         &lapDataCall{err: iracing.ErrUpstreamUnauthorized},
+			expectedStatus:      http.StatusUnauthorized,
+			expectedBodyFixture: "fixtures/ingest_laps_iracing_expired_response.json",
+		},
+		{
+			name:                "client error",
+			subsessionID:        "12345678",
+			driverID:            "2000",
+			lapDataCall:         &lapDataCall{err: errors.New("iracing API error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/ingest_laps_error_response.json",
+		},
+		{
+			name:                "store error",
+			subsessionID:        "12345678",
+			driverID:            "2000",
+			lapDataCall:         &lapDataCall{result: testLapData},
+			saveLapsCall:        &saveLapsCall{laps: expectedLaps, err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/ingest_laps_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			validator := &stubTokenValidator{
+				sessionClaims:   testSessionClaims,
+				sensitiveClaims: testSensitiveClaims,
+			}
+
+			mockClient := NewMockLapDataClient(t)
+			if tc.lapDataCall != nil {
+				mockClient.EXPECT().GetLapData(mock.Anything, "test-access-token", int64(12345678), 0, []iracing.GetLapDataOption{iracing.WithCustomerIDLap(2000)}).
+					Return(tc.lapDataCall.result, tc.lapDataCall.err)
+			}
+
+			mockStore := NewMockIngestLapsStore(t)
+			if tc.saveLapsCall != nil {
+				mockStore.EXPECT().SaveSessionDriverLaps(mock.Anything, tc.saveLapsCall.laps).Return(tc.saveLapsCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(validator))
+			r.Post("/{"+SubsessionIDPathParam+"}/laps/ingest", NewIngestLapsEndpoint(mockClient, mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			url := ts.URL + "/" + tc.subsessionID + "/laps/ingest"
+			if tc.driverID != "" {
+				url += "?driver_id=" + tc.driverID
+			}
+
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package session
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/lapevents"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// ErrCodeInvalidLapEvent is returned for an event query param that isn't a known lap event code
+const ErrCodeInvalidLapEvent = "invalid_lap_event"
+
+// parseLapEventFilter reads the repeatable event query param, the lap event codes to narrow laps down to. Laps having
+// any of them are kept, nil means no filtering.
+func parseLapEventFilter(r *http.Request, errs api.RequestErrors) ([]lapevents.Code, api.RequestErrors) {
+	var codes []lapevents.Code
+	for _, value := range r.URL.Query()[api.EventQueryParam] {
+		code := lapevents.Code(value)
+		if !code.IsValid() {
+			allowed := make([]string, 0, len(lapevents.Codes()))
+			for _, c := range lapevents.Codes() {
+				allowed = append(allowed, string(c))
+			}
+			errs = errs.WithFieldErrorCode(api.EventQueryParam, ErrCodeInvalidLapEvent, map[string]string{
+				"value":   value,
+				"allowed": strings.Join(allowed, ", "),
+			})
+			continue
+		}
+		codes = append(codes, code)
+	}
+	return codes, errs
+}
+
+// eventCodes normalizes a lap's events for the API, never nil so clean laps serialize as an empty list
+func eventCodes(events []string) []string {
+	if len(events) == 0 {
+		return []string{}
+	}
+	return lapevents.Normalize(events)
+}
+
+func filterStoredLaps(laps []store.SessionDriverLap, events []lapevents.Code) []store.SessionDriverLap {
+	if events == nil {
+		return laps
+	}
+	result := make([]store.SessionDriverLap, 0, len(laps))
+	for _, l := range laps {
+		if lapevents.HasAny(l.LapEvents, events) {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+func filterLaps(laps []Lap, events []lapevents.Code) []Lap {
+	if events == nil {
+		return laps
+	}
+	result := make([]Lap, 0, len(laps))
+	for _, l := range laps {
+		if lapevents.HasAny(l.LapEvents, events) {
+			result = append(result, l)
+		}
+	}
+	return result
+}
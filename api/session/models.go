@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/lapevents"
+	"github.com/jonsabados/saturdaysspinout/lapflags"
 	"github.com/jonsabados/saturdaysspinout/store"
 )
 
@@ -66,6 +68,7 @@ type SessionResponse struct {
 	Track                   Track              `json:"track"`
 	TrackState              TrackState         `json:"trackState"`
 	Weather                 Weather            `json:"weather"`
+	OfficialResultsURL      string             `json:"officialResultsUrl"` // the session's results on iRacing, for cross-checking
 }
 
 type AllowedLicense struct {
@@ -452,6 +455,7 @@ func sessionResponseFromIRacing(sr *iracing.SessionResult, currentDriverID int64
 			WindUnits:                     sr.Weather.WindUnits,
 			WindValue:                     sr.Weather.WindValue,
 		},
+		OfficialResultsURL: iracing.ResultsURL(sr.SubsessionID),
 	}
 }
 
@@ -599,6 +603,101 @@ type Lap struct {
 	LapTime         int      `json:"lapTime"`
 	PersonalBestLap bool     `json:"personalBestLap"`
 	LapEvents       []string `json:"lapEvents"`
+	// EventCodes is LapEvents normalized into codes, see lapevents. Events without a code are included as reported.
+	EventCodes []string `json:"eventCodes"`
+	// FlagNames is Flags decoded into named events, see lapflags.
+	FlagNames []string `json:"flagNames"`
+	// VideoLinks are the videos the driver attached to the lap, only included on their own laps.
+	VideoLinks []VideoLink `json:"videoLinks,omitempty"`
+	// Synthetic marks a stored placeholder filling a gap in iRacing's lap data, it has no lap time.
+	Synthetic bool `json:"synthetic,omitempty"`
+}
+
+// VideoLink is an external video the driver attached to a lap.
+type VideoLink struct {
+	LinkID       string    `json:"linkId"`
+	URL          string    `json:"url"`
+	Provider     string    `json:"provider"`
+	Title        string    `json:"title,omitempty"`
+	AuthorName   string    `json:"authorName,omitempty"`
+	ThumbnailURL string    `json:"thumbnailUrl,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+func videoLinksFromStore(links []store.VideoLink) []VideoLink {
+	ret := make([]VideoLink, len(links))
+	for i, link := range links {
+		ret[i] = VideoLink{
+			LinkID:       link.LinkID,
+			URL:          link.URL,
+			Provider:     link.Provider,
+			Title:        link.Title,
+			AuthorName:   link.AuthorName,
+			ThumbnailURL: link.ThumbnailURL,
+			CreatedAt:    link.CreatedAt.UTC(),
+		}
+	}
+	return ret
+}
+
+// PaceComparisonResponse is the API response comparing the caller's race laps against the class winner.
+// Times are in iRacing's 10ths of milliseconds, consistent with Lap.
+type PaceComparisonResponse struct {
+	SubsessionID      int64               `json:"subsessionId"`
+	DriverID          int64               `json:"driverId"`
+	WinnerDriverID    int64               `json:"winnerDriverId"`
+	WinnerDisplayName string              `json:"winnerDisplayName"`
+	CarClassID        int                 `json:"carClassId"`
+	Laps              []PaceComparisonLap `json:"laps"`
+}
+
+// PaceComparisonLap is a single lap of a pace comparison. Positive deltas and gaps mean the driver was slower or
+// behind the winner.
+type PaceComparisonLap struct {
+	LapNumber     int  `json:"lapNumber"`
+	LapTime       *int `json:"lapTime"`
+	WinnerLapTime *int `json:"winnerLapTime"`
+	Delta         *int `json:"delta"`
+	CumulativeGap *int `json:"cumulativeGap"`
+}
+
+// RaceOrderLap is a stored lap along with the driver that drove it.
+type RaceOrderLap struct {
+	DriverID int64 `json:"driverId"`
+	Lap
+}
+
+// RaceOrderLapsResponse is a page of a session's stored laps in the order they were completed. NextCursor is omitted
+// on the last page.
+type RaceOrderLapsResponse struct {
+	SubsessionID int64          `json:"subsessionId"`
+	Laps         []RaceOrderLap `json:"laps"`
+	NextCursor   string         `json:"nextCursor,omitempty"`
+}
+
+// IngestLapsResponse is the API response after storing a driver's laps for a session.
+type IngestLapsResponse struct {
+	SubsessionID int64 `json:"subsessionId"`
+	DriverID     int64 `json:"driverId"`
+	LapsIngested int   `json:"lapsIngested"`
+}
+
+func sessionDriverLapsFromIRacing(subsessionID, driverID int64, laps []iracing.Lap) []store.SessionDriverLap {
+	result := make([]store.SessionDriverLap, len(laps))
+	for i, l := range laps {
+		result[i] = store.SessionDriverLap{
+			SubsessionID:    subsessionID,
+			DriverID:        driverID,
+			LapNumber:       l.LapNumber,
+			Flags:           l.Flags,
+			Incident:        l.Incident,
+			SessionTime:     l.SessionTime,
+			LapTime:         l.LapTime,
+			PersonalBestLap: l.PersonalBestLap,
+			LapEvents:       lapevents.Normalize(l.LapEvents),
+		}
+	}
+	return result
 }
 
 func lapDataResponseFromIRacing(ldr *iracing.LapDataResponse) LapDataResponse {
@@ -612,6 +711,8 @@ func lapDataResponseFromIRacing(ldr *iracing.LapDataResponse) LapDataResponse {
 			LapTime:         l.LapTime,
 			PersonalBestLap: l.PersonalBestLap,
 			LapEvents:       l.LapEvents,
+			EventCodes:      eventCodes(l.LapEvents),
+			FlagNames:       lapflags.Decode(l.Flags),
 		}
 	}
 
@@ -634,4 +735,4 @@ func lapDataResponseFromIRacing(ldr *iracing.LapDataResponse) LapDataResponse {
 		LicenseLevel:    ldr.LicenseLevel,
 		Laps:            laps,
 	}
-}
\ No newline at end of file
+}
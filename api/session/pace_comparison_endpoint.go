@@ -0,0 +1,195 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// raceSimsessionNumber is the sim session number iRacing uses for the main event
+const raceSimsessionNumber = 0
+
+type PaceComparisonStore interface {
+	GetSessionDriverLaps(ctx context.Context, subsessionID, driverID int64) ([]store.SessionDriverLap, error)
+}
+
+// NewPaceComparisonEndpoint compares the caller's race laps against their class winner. Stored laps are used when
+// available, otherwise they are fetched from iRacing. Passing event codes narrows the comparison to the caller's laps
+// with any of the events, e.g. only the laps they went off track on.
+func NewPaceComparisonEndpoint(client CombinedClient, lapStore PaceComparisonStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		errs := api.NewRequestErrors()
+
+		subsessionIDStr := chi.URLParam(r, SubsessionIDPathParam)
+		if subsessionIDStr == "" {
+			errs = errs.WithFieldError(SubsessionIDPathParam, "required")
+		}
+
+		var subsessionID int64
+		var err error
+		if subsessionIDStr != "" {
+			subsessionID, err = strconv.ParseInt(subsessionIDStr, 10, 64)
+			if err != nil {
+				errs = errs.WithFieldError(SubsessionIDPathParam, "must be a valid integer")
+			}
+		}
+
+		events, errs := parseLapEventFilter(r, errs)
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		claims := api.SensitiveClaimsFromContext(ctx)
+		sessionClaims := api.SessionClaimsFromContext(ctx)
+		if claims == nil || sessionClaims == nil {
+			logger.Error().Msg("claims not found in context")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+		driverID := sessionClaims.IRacingUserID
+
+		sessionResult, err := client.GetSessionResults(ctx, claims.IRacingAccessToken, subsessionID)
+		if err != nil {
+			if errors.Is(err, iracing.ErrUpstreamUnauthorized) {
+				logger.Warn().Err(err).Msg("iRacing token expired while fetching session results")
+				api.DoUnauthorizedResponse(ctx, "iRacing access token expired", w)
+				return
+			}
+			logger.Error().Err(err).Int64("subsessionId", subsessionID).Msg("failed to fetch session results")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		driverResult, winnerResult := findDriverAndClassWinner(sessionResult, driverID)
+		if driverResult == nil || winnerResult == nil {
+			api.DoNotFoundResponse(ctx, "driver did not participate in the race session", w)
+			return
+		}
+
+		driverLaps, err := getRaceLaps(ctx, client, lapStore, claims.IRacingAccessToken, subsessionID, driverID)
+		if err != nil {
+			doLapDataError(ctx, err, subsessionID, driverID, w)
+			return
+		}
+
+		winnerLaps, err := getRaceLaps(ctx, client, lapStore, claims.IRacingAccessToken, subsessionID, winnerResult.CustID)
+		if err != nil {
+			doLapDataError(ctx, err, subsessionID, winnerResult.CustID, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, PaceComparisonResponse{
+			SubsessionID:      subsessionID,
+			DriverID:          driverID,
+			WinnerDriverID:    winnerResult.CustID,
+			WinnerDisplayName: winnerResult.DisplayName,
+			CarClassID:        winnerResult.CarClassID,
+			Laps:              comparePace(filterStoredLaps(driverLaps, events), winnerLaps),
+		}, w)
+	})
+}
+
+func getRaceLaps(ctx context.Context, client LapDataClient, lapStore PaceComparisonStore, accessToken string, subsessionID, driverID int64) ([]store.SessionDriverLap, error) {
+	laps, err := lapStore.GetSessionDriverLaps(ctx, subsessionID, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if len(laps) > 0 {
+		return laps, nil
+	}
+
+	result, err := client.GetLapData(ctx, accessToken, subsessionID, raceSimsessionNumber, iracing.WithCustomerIDLap(driverID))
+	if err != nil {
+		return nil, err
+	}
+	return sessionDriverLapsFromIRacing(subsessionID, driverID, result.Laps), nil
+}
+
+func doLapDataError(ctx context.Context, err error, subsessionID, driverID int64, w http.ResponseWriter) {
+	logger := zerolog.Ctx(ctx)
+	if errors.Is(err, iracing.ErrUpstreamUnauthorized) {
+		logger.Warn().Err(err).Msg("iRacing token expired while fetching lap data")
+		api.DoUnauthorizedResponse(ctx, "iRacing access token expired", w)
+		return
+	}
+	logger.Error().Err(err).
+		Int64("subsessionId", subsessionID).
+		Msg("failed to fetch lap data")
+	api.DoErrorResponse(ctx, w)
+}
+
+// findDriverAndClassWinner locates the driver's result in the race sim session along with the winner of their class.
+// Either may be nil if the driver did not take part in the race.
+func findDriverAndClassWinner(sr *iracing.SessionResult, driverID int64) (*iracing.DriverResult, *iracing.DriverResult) {
+	for _, ssr := range sr.SessionResults {
+		if ssr.SimsessionNumber != raceSimsessionNumber {
+			continue
+		}
+		var driver *iracing.DriverResult
+		for i := range ssr.Results {
+			if ssr.Results[i].CustID == driverID {
+				driver = &ssr.Results[i]
+			}
+		}
+		if driver == nil {
+			return nil, nil
+		}
+		for i := range ssr.Results {
+			if ssr.Results[i].CarClassID == driver.CarClassID && ssr.Results[i].FinishPositionInClass == 0 {
+				return driver, &ssr.Results[i]
+			}
+		}
+		return driver, nil
+	}
+	return nil, nil
+}
+
+// comparePace lines up the driver's laps against the winner's by lap number. Delta compares individual lap times,
+// while the cumulative gap is how far behind the winner the driver was when crossing the line at the end of the lap.
+// Winner fields are nil for laps the winner has no data for, and lap time fields are nil for laps without a valid time
+// (iRacing reports -1 for those).
+func comparePace(driverLaps, winnerLaps []store.SessionDriverLap) []PaceComparisonLap {
+	winnerByLap := make(map[int]store.SessionDriverLap, len(winnerLaps))
+	for _, l := range winnerLaps {
+		winnerByLap[l.LapNumber] = l
+	}
+
+	result := make([]PaceComparisonLap, 0, len(driverLaps))
+	for _, l := range driverLaps {
+		lap := PaceComparisonLap{
+			LapNumber: l.LapNumber,
+			LapTime:   validLapTime(l.LapTime),
+		}
+		if winnerLap, ok := winnerByLap[l.LapNumber]; ok {
+			lap.WinnerLapTime = validLapTime(winnerLap.LapTime)
+			if lap.LapTime != nil && lap.WinnerLapTime != nil {
+				delta := *lap.LapTime - *lap.WinnerLapTime
+				lap.Delta = &delta
+			}
+			gap := l.SessionTime - winnerLap.SessionTime
+			lap.CumulativeGap = &gap
+		}
+		result = append(result, lap)
+	}
+	return result
+}
+
+func validLapTime(lapTime int) *int {
+	if lapTime <= 0 {
+		return nil
+	}
+	return &lapTime
+}
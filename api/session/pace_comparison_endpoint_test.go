@@ -0,0 +1,276 @@
+package session
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPaceComparisonEndpoint(t *testing.T) {
+	testSessionClaims := &auth.SessionClaims{
+		IRacingUserID:   1100750,
+		IRacingUserName: "Jon Sabados",
+	}
+	testSensitiveClaims := &auth.SensitiveClaims{
+		IRacingAccessToken: "test-access-token",
+	}
+
+	testSessionResult := &iracing.SessionResult{
+		SubsessionID: 12345678,
+		SessionResults: []iracing.SimSessionResult{
+			{
+				SimsessionNumber: -1,
+				Results: []iracing.DriverResult{
+					{CustID: 1100750, CarClassID: 74, FinishPositionInClass: 0},
+				},
+			},
+			{
+				SimsessionNumber: 0,
+				Results: []iracing.DriverResult{
+					{CustID: 3000, DisplayName: "Other Class", CarClassID: 4029, FinishPosition: 0, FinishPositionInClass: 0},
+					{CustID: 2000, DisplayName: "Fast Guy", CarClassID: 74, FinishPosition: 1, FinishPositionInClass: 0},
+					{CustID: 1100750, DisplayName: "Jon Sabados", CarClassID: 74, FinishPosition: 3, FinishPositionInClass: 2},
+				},
+			},
+		},
+	}
+
+	spectatorSessionResult := &iracing.SessionResult{
+		SubsessionID: 12345678,
+		SessionResults: []iracing.SimSessionResult{
+			{
+				SimsessionNumber: 0,
+				Results: []iracing.DriverResult{
+					{CustID: 2000, DisplayName: "Fast Guy", CarClassID: 74, FinishPositionInClass: 0},
+				},
+			},
+		},
+	}
+
+	driverLaps := &iracing.LapDataResponse{
+		CustID: 1100750,
+		Laps: []iracing.Lap{
+			{LapNumber: 0, SessionTime: 75000, LapTime: -1},
+			{LapNumber: 1, SessionTime: 173500, LapTime: 98500},
+			{LapNumber: 2, SessionTime: 269500, LapTime: 96000},
+			{LapNumber: 3, SessionTime: 366500, LapTime: 97000},
+		},
+	}
+
+	winnerLaps := &iracing.LapDataResponse{
+		CustID: 2000,
+		Laps: []iracing.Lap{
+			{LapNumber: 0, SessionTime: 60000, LapTime: -1},
+			{LapNumber: 1, SessionTime: 157000, LapTime: 97000},
+			{LapNumber: 2, SessionTime: 253500, LapTime: 96500},
+		},
+	}
+
+	type sessionResultsCall struct {
+		result *iracing.SessionResult
+		err    error
+	}
+
+	storedDriverLaps := []store.SessionDriverLap{
+		{SubsessionID: 12345678, DriverID: 1100750, LapNumber: 0, SessionTime: 75000, LapTime: -1},
+		{SubsessionID: 12345678, DriverID: 1100750, LapNumber: 1, SessionTime: 173500, LapTime: 98500},
+		{SubsessionID: 12345678, DriverID: 1100750, LapNumber: 2, SessionTime: 269500, LapTime: 96000},
+		{SubsessionID: 12345678, DriverID: 1100750, LapNumber: 3, SessionTime: 366500, LapTime: 97000},
+	}
+
+	storedWinnerLaps := []store.SessionDriverLap{
+		{SubsessionID: 12345678, DriverID: 2000, LapNumber: 0, SessionTime: 60000, LapTime: -1},
+		{SubsessionID: 12345678, DriverID: 2000, LapNumber: 1, SessionTime: 157000, LapTime: 97000},
+		{SubsessionID: 12345678, DriverID: 2000, LapNumber: 2, SessionTime: 253500, LapTime: 96500},
+	}
+
+	type getLapsCall struct {
+		driverID int64
+		result   []store.SessionDriverLap
+		err      error
+	}
+
+	type lapDataCall struct {
+		custID int64
+		result *iracing.LapDataResponse
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		subsessionID string
+		queryString  string
+
+		sessionResultsCall *sessionResultsCall
+		getLapsCalls       []getLapsCall
+		lapDataCalls       []lapDataCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:               "success from stored laps",
+			subsessionID:       "12345678",
+			sessionResultsCall: &sessionResultsCall{result: testSessionResult},
+			getLapsCalls: []getLapsCall{
+				{driverID: 1100750, result: storedDriverLaps},
+				{driverID: 2000, result: storedWinnerLaps},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_pace_comparison_success_response.json",
+		},
+		{
+			name:               "filtered by event",
+			subsessionID:       "12345678",
+			queryString:        "event=off_track",
+			sessionResultsCall: &sessionResultsCall{result: testSessionResult},
+			getLapsCalls: []getLapsCall{
+				{driverID: 1100750, result: []store.SessionDriverLap{
+					{SubsessionID: 12345678, DriverID: 1100750, LapNumber: 0, SessionTime: 75000, LapTime: -1},
+					{SubsessionID: 12345678, DriverID: 1100750, LapNumber: 1, SessionTime: 173500, LapTime: 98500, LapEvents: []string{"off_track"}},
+					{SubsessionID: 12345678, DriverID: 1100750, LapNumber: 2, SessionTime: 269500, LapTime: 96000, LapEvents: []string{"pitted"}},
+				}},
+				{driverID: 2000, result: storedWinnerLaps},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_pace_comparison_event_filter_response.json",
+		},
+		{
+			name:                "invalid event",
+			subsessionID:        "12345678",
+			queryString:         "event=spin",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_pace_comparison_invalid_event_response.json",
+		},
+		{
+			name:               "success fetching laps not yet stored",
+			subsessionID:       "12345678",
+			sessionResultsCall: &sessionResultsCall{result: testSessionResult},
+			getLapsCalls: []getLapsCall{
+				{driverID: 1100750, result: storedDriverLaps},
+				{driverID: 2000, result: []store.SessionDriverLap{}},
+			},
+			lapDataCalls: []lapDataCall{
+				{custID: 2000, result: winnerLaps},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_pace_comparison_success_response.json",
+		},
+		{
+			name:                "invalid subsession_id",
+			subsessionID:        "not-a-number",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_pace_comparison_invalid_id_response.json",
+		},
+		{
+			name:                "driver not in race",
+			subsessionID:        "12345678",
+			sessionResultsCall:  &sessionResultsCall{result: spectatorSessionResult},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_pace_comparison_not_in_session_response.json",
+		},
+		{
+			name:               "store error",
+			subsessionID:       "12345678",
+			sessionResultsCall: &sessionResultsCall{result: testSessionResult},
+			getLapsCalls: []getLapsCall{
+				{driverID: 1100750, err: errors.New("database error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_pace_comparison_error_response.json",
+		},
+		{
+			name:                "iracing token expired",
+			subsessionID:        "12345678",
+			sessionResultsCall:  &sessionResultsCall{err: iracing.ErrUpstreamUnauthorized},
+			expectedStatus:      http.StatusUnauthorized,
+			expectedBodyFixture: "fixtures/get_pace_comparison_iracing_expired_response.json",
+		},
+		{
+			name:                "session results error",
+			subsessionID:        "12345678",
+			sessionResultsCall:  &sessionResultsCall{err: errors.New("iracing API error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_pace_comparison_error_response.json",
+		},
+		{
+			name:               "winner lap data error",
+			subsessionID:       "12345678",
+			sessionResultsCall: &sessionResultsCall{result: testSessionResult},
+			getLapsCalls: []getLapsCall{
+				{driverID: 1100750, result: []store.SessionDriverLap{}},
+				{driverID: 2000, result: []store.SessionDriverLap{}},
+			},
+			lapDataCalls: []lapDataCall{
+				{custID: 1100750, result: driverLaps},
+				{custID: 2000, err: errors.New("iracing API error")},
+			},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/get_pace_comparison_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			validator := &stubTokenValidator{
+				sessionClaims:   testSessionClaims,
+				sensitiveClaims: testSensitiveClaims,
+			}
+
+			mockClient := NewMockCombinedClient(t)
+			if tc.sessionResultsCall != nil {
+				mockClient.EXPECT().GetSessionResults(mock.Anything, "test-access-token", int64(12345678)).
+					Return(tc.sessionResultsCall.result, tc.sessionResultsCall.err)
+			}
+			mockStore := NewMockPaceComparisonStore(t)
+			for _, call := range tc.getLapsCalls {
+				mockStore.EXPECT().GetSessionDriverLaps(mock.Anything, int64(12345678), call.driverID).
+					Return(call.result, call.err)
+			}
+			for _, call := range tc.lapDataCalls {
+				mockClient.EXPECT().GetLapData(mock.Anything, "test-access-token", int64(12345678), 0, []iracing.GetLapDataOption{iracing.WithCustomerIDLap(call.custID)}).
+					Return(call.result, call.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(validator))
+			r.Get("/{"+SubsessionIDPathParam+"}/pace-comparison", NewPaceComparisonEndpoint(mockClient, mockStore).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+tc.subsessionID+"/pace-comparison?"+tc.queryString, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -2,9 +2,11 @@ package session
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/quota"
 )
 
 // CombinedClient combines all iRacing client methods needed by session endpoints.
@@ -13,12 +15,29 @@ type CombinedClient interface {
 	LapDataClient
 }
 
-func NewRouter(client CombinedClient, authMiddleware func(http.Handler) http.Handler) http.Handler {
+// Store combines all store methods needed by session endpoints.
+type Store interface {
+	IngestLapsStore
+	PaceComparisonStore
+	RaceOrderLapsStore
+}
+
+// NewRouter builds the session routes. Routes that call iRacing on the driver's behalf count against one of the
+// driver's daily quotas and are turned away while iRacing is down for maintenance, those only reading what's stored
+// aren't.
+func NewRouter(client CombinedClient, lapStore Store, videoLinks LapVideoLinkFinder, quotas api.QuotaConsumer, availability api.UpstreamAvailability, authMiddleware func(http.Handler) http.Handler) http.Handler {
 	r := chi.NewRouter()
 	r.Use(authMiddleware)
 
-	r.Get("/{"+SubsessionIDPathParam+"}", api.WrapWithSegment("getSession", NewGetSessionEndpoint(client)).ServeHTTP)
-	r.Get("/{"+SubsessionIDPathParam+"}/simsession/{"+SimsessionPathParam+"}/driver/{"+DriverIDPathParam+"}/laps", api.WrapWithSegment("getLaps", NewGetLapsEndpoint(client)).ServeHTTP)
+	upstreamUp := api.UpstreamAvailabilityMiddleware(availability, time.Now)
+	proxyQuota := api.QuotaMiddleware(quotas, quota.OperationIRacingProxy, time.Now)
+	lapIngestQuota := api.QuotaMiddleware(quotas, quota.OperationLapIngest, time.Now)
+
+	r.With(upstreamUp, proxyQuota).Get("/{"+SubsessionIDPathParam+"}", api.WrapWithSegment("getSession", NewGetSessionEndpoint(client)).ServeHTTP)
+	r.With(upstreamUp, proxyQuota).Get("/{"+SubsessionIDPathParam+"}/pace-comparison", api.WrapWithSegment("getPaceComparison", NewPaceComparisonEndpoint(client, lapStore)).ServeHTTP)
+	r.With(upstreamUp, lapIngestQuota).Post("/{"+SubsessionIDPathParam+"}/laps/ingest", api.WrapWithSegment("ingestLaps", NewIngestLapsEndpoint(client, lapStore)).ServeHTTP)
+	r.Get("/{"+SubsessionIDPathParam+"}/laps", api.WrapWithSegment("getRaceOrderLaps", NewGetRaceOrderLapsEndpoint(lapStore)).ServeHTTP)
+	r.With(upstreamUp, proxyQuota).Get("/{"+SubsessionIDPathParam+"}/simsession/{"+SimsessionPathParam+"}/driver/{"+DriverIDPathParam+"}/laps", api.WrapWithSegment("getLaps", NewGetLapsEndpoint(client, videoLinks)).ServeHTTP)
 
 	return r
 }
\ No newline at end of file
@@ -0,0 +1,46 @@
+package squad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type SquadServiceForAccept interface {
+	Accept(ctx context.Context, driverID int64, squadID string) (*store.SquadMember, error)
+}
+
+// NewAcceptInviteEndpoint creates the handler for POST /squads/{squad_id}/accept, joining the logged-in driver to a
+// squad they've been invited to. Accepting is the driver's consent to their races counting towards squad analytics.
+func NewAcceptInviteEndpoint(svc SquadServiceForAccept) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		squadID := chi.URLParam(r, squadIDPathParam)
+		member, err := svc.Accept(ctx, claims.IRacingUserID, squadID)
+		if errors.Is(err, squad.ErrNotInvited) {
+			api.DoNotFoundResponse(ctx, "invite not found", w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("squadId", squadID).Msg("failed to accept squad invite")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, memberFromStore(*member), w)
+	})
+}
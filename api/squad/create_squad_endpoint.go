@@ -0,0 +1,55 @@
+package squad
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type SquadServiceForCreate interface {
+	Create(ctx context.Context, managerID int64, name string) (*store.Squad, error)
+}
+
+// NewCreateSquadEndpoint creates the handler for POST /squads, starting a squad managed by the logged-in driver.
+func NewCreateSquadEndpoint(svc SquadServiceForCreate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		errs := api.NewRequestErrors()
+
+		var req CreateSquadRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			for _, v := range squad.ValidateName(req.Name) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		created, err := svc.Create(ctx, claims.IRacingUserID, req.Name)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to create squad")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, squadFromStore(*created), w)
+	})
+}
@@ -0,0 +1,123 @@
+package squad
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationID = "test-correlation-id"
+
+type stubTokenValidator struct {
+	sessionClaims *auth.SessionClaims
+}
+
+func (s *stubTokenValidator) ValidateToken(_ context.Context, _ string) (*auth.SessionClaims, *auth.SensitiveClaims, error) {
+	return s.sessionClaims, &auth.SensitiveClaims{}, nil
+}
+
+var testClaims = &auth.SessionClaims{
+	IRacingUserID:   12345,
+	IRacingUserName: "Jon Sabados",
+}
+
+func TestNewCreateSquadEndpoint(t *testing.T) {
+	testSquad := store.Squad{
+		SquadID:     "squad-1",
+		Name:        "Saturday League",
+		ManagerID:   12345,
+		ManagerName: "Jon Sabados",
+		CreatedAt:   time.Unix(1700100000, 0),
+	}
+
+	type createCall struct {
+		squad *store.Squad
+		err   error
+	}
+
+	testCases := []struct {
+		name string
+
+		requestBody string
+
+		createCall *createCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			requestBody:         `{"name": "Saturday League"}`,
+			createCall:          &createCall{squad: &testSquad},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/create_squad_success_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			requestBody:         `{not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_squad_invalid_json_response.json",
+		},
+		{
+			name:                "blank name",
+			requestBody:         `{"name": "  "}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_squad_invalid_request_response.json",
+		},
+		{
+			name:                "service error",
+			requestBody:         `{"name": "Saturday League"}`,
+			createCall:          &createCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/squad_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSquadServiceForCreate(t)
+			if tc.createCall != nil {
+				mockService.EXPECT().Create(mock.Anything, int64(12345), "Saturday League").Return(tc.createCall.squad, tc.createCall.err)
+			}
+
+			validator := &stubTokenValidator{sessionClaims: testClaims}
+			handler := correlation.Middleware(func() string { return testCorrelationID })(api.AuthMiddleware(validator)(NewCreateSquadEndpoint(mockService)))
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPost, ts.URL, bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
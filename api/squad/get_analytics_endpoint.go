@@ -0,0 +1,49 @@
+package squad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/rs/zerolog"
+)
+
+type SquadServiceForAnalytics interface {
+	Analytics(ctx context.Context, managerID int64, squadID string) (*squad.Analytics, error)
+}
+
+// NewGetAnalyticsEndpoint creates the handler for GET /squads/{squad_id}/analytics, the squad's combined results for
+// its manager. Only members who have accepted their invite are counted.
+func NewGetAnalyticsEndpoint(svc SquadServiceForAnalytics) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		squadID := chi.URLParam(r, squadIDPathParam)
+		analytics, err := svc.Analytics(ctx, claims.IRacingUserID, squadID)
+		if errors.Is(err, squad.ErrSquadNotFound) {
+			api.DoNotFoundResponse(ctx, "squad not found", w)
+			return
+		}
+		if errors.Is(err, squad.ErrNotManager) {
+			api.DoForbiddenResponse(ctx, "only the squad manager can view analytics", w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("squadId", squadID).Msg("failed to compute squad analytics")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, analyticsFromService(*analytics), w)
+	})
+}
@@ -0,0 +1,100 @@
+package squad
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetAnalyticsEndpoint(t *testing.T) {
+	analytics := &squad.Analytics{
+		ActiveMembers:  4,
+		InvitedMembers: 1,
+		SessionTotals:  store.SessionTotals{Races: 20, Wins: 3, Podiums: 6, Incidents: 50},
+		IRatingDistribution: []squad.IRatingBand{
+			{Band: 1000, Drivers: 1},
+			{Band: 2000, Drivers: 2},
+		},
+		Unrated: 1,
+	}
+
+	testCases := []struct {
+		name string
+
+		analytics *squad.Analytics
+		err       error
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			analytics:           analytics,
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_analytics_success_response.json",
+		},
+		{
+			name:                "not the manager",
+			err:                 squad.ErrNotManager,
+			expectedStatus:      http.StatusForbidden,
+			expectedBodyFixture: "fixtures/get_analytics_not_manager_response.json",
+		},
+		{
+			name:                "not found",
+			err:                 squad.ErrSquadNotFound,
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/squad_not_found_response.json",
+		},
+		{
+			name:                "service error",
+			err:                 errors.New("database error"),
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/squad_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSquadServiceForAnalytics(t)
+			mockService.EXPECT().Analytics(mock.Anything, int64(12345), "squad-1").Return(tc.analytics, tc.err)
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(&stubTokenValidator{sessionClaims: testClaims}))
+			r.Get("/{squad_id}/analytics", NewGetAnalyticsEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/squad-1/analytics", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
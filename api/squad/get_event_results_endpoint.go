@@ -0,0 +1,56 @@
+package squad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/rs/zerolog"
+)
+
+type SquadServiceForEventResults interface {
+	EventResults(ctx context.Context, driverID int64, squadID string, subsessionID int64) (*squad.EventResults, error)
+}
+
+// NewGetEventResultsEndpoint creates the handler for GET /squads/{squad_id}/events/{subsession_id}, returning the
+// event with the finishes of every member who raced it.
+func NewGetEventResultsEndpoint(svc SquadServiceForEventResults) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		subsessionID, err := strconv.ParseInt(chi.URLParam(r, subsessionIDPathParam), 10, 64)
+		if err != nil {
+			api.DoBadRequestResponse(ctx, api.NewRequestErrors().WithFieldErrorCode(subsessionIDPathParam, ErrCodeInvalidInteger, nil), w)
+			return
+		}
+
+		squadID := chi.URLParam(r, squadIDPathParam)
+		results, err := svc.EventResults(ctx, claims.IRacingUserID, squadID, subsessionID)
+		if errors.Is(err, squad.ErrSquadNotFound) {
+			api.DoNotFoundResponse(ctx, "squad not found", w)
+			return
+		}
+		if errors.Is(err, squad.ErrEventNotFound) {
+			api.DoNotFoundResponse(ctx, "squad event not found", w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("squadId", squadID).Int64("subsessionId", subsessionID).Msg("failed to get squad event results")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, eventResultsFromService(*results), w)
+	})
+}
@@ -0,0 +1,126 @@
+package squad
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetEventResultsEndpoint(t *testing.T) {
+	startTime := time.Unix(1700000000, 0)
+	results := &squad.EventResults{
+		Event: store.SquadEvent{SquadID: "squad-1", SubsessionID: 555, Name: "Race Night", TaggedAt: time.Unix(1700100000, 0)},
+		Results: []store.SquadEventResult{
+			{
+				SquadID: "squad-1", SubsessionID: 555, DriverID: 67890, DriverName: "Other Driver", StartTime: startTime,
+				CarID: 10, CarClassID: 20, StartPosition: 4, FinishPosition: 2, FinishPositionInClass: 1,
+				Incidents: 3, LapsComplete: 25, OldIRating: 1800, NewIRating: 1850, ReasonOutCode: store.ReasonOutFinished,
+			},
+			{
+				SquadID: "squad-1", SubsessionID: 555, DriverID: 12345, DriverName: "Jon Sabados", StartTime: startTime,
+				CarID: 10, CarClassID: 20, StartPosition: 1, FinishPosition: 9, FinishPositionInClass: 7,
+				Incidents: 12, LapsComplete: 8, OldIRating: 2100, NewIRating: 2020, ReasonOutCode: store.ReasonOutDisconnected,
+			},
+		},
+	}
+
+	type eventResultsCall struct {
+		results *squad.EventResults
+		err     error
+	}
+
+	testCases := []struct {
+		name string
+
+		subsessionID string
+
+		eventResultsCall *eventResultsCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			subsessionID:        "555",
+			eventResultsCall:    &eventResultsCall{results: results},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_event_results_success_response.json",
+		},
+		{
+			name:                "invalid subsession id",
+			subsessionID:        "abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/get_event_results_invalid_subsession_id_response.json",
+		},
+		{
+			name:                "squad not found",
+			subsessionID:        "555",
+			eventResultsCall:    &eventResultsCall{err: squad.ErrSquadNotFound},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/squad_not_found_response.json",
+		},
+		{
+			name:                "event not found",
+			subsessionID:        "555",
+			eventResultsCall:    &eventResultsCall{err: squad.ErrEventNotFound},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/get_event_results_not_found_response.json",
+		},
+		{
+			name:                "service error",
+			subsessionID:        "555",
+			eventResultsCall:    &eventResultsCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/squad_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSquadServiceForEventResults(t)
+			if tc.eventResultsCall != nil {
+				mockService.EXPECT().EventResults(mock.Anything, int64(12345), "squad-1", int64(555)).
+					Return(tc.eventResultsCall.results, tc.eventResultsCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(&stubTokenValidator{sessionClaims: testClaims}))
+			r.Get("/{squad_id}/events/{subsession_id}", NewGetEventResultsEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/squad-1/events/"+tc.subsessionID, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package squad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/rs/zerolog"
+)
+
+type SquadServiceForGet interface {
+	Get(ctx context.Context, driverID int64, squadID string) (*squad.Details, error)
+}
+
+// NewGetSquadEndpoint creates the handler for GET /squads/{squad_id}, returning the squad and its members. Squads the
+// logged-in driver isn't in or invited to are reported as not found.
+func NewGetSquadEndpoint(svc SquadServiceForGet) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		squadID := chi.URLParam(r, squadIDPathParam)
+		details, err := svc.Get(ctx, claims.IRacingUserID, squadID)
+		if errors.Is(err, squad.ErrSquadNotFound) {
+			api.DoNotFoundResponse(ctx, "squad not found", w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("squadId", squadID).Msg("failed to get squad")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, detailsFromService(*details), w)
+	})
+}
@@ -0,0 +1,99 @@
+package squad
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetSquadEndpoint(t *testing.T) {
+	joinedAt := time.Unix(1700100000, 0)
+	details := &squad.Details{
+		Squad: store.Squad{
+			SquadID:     "squad-1",
+			Name:        "Saturday League",
+			ManagerID:   12345,
+			ManagerName: "Jon Sabados",
+			CreatedAt:   time.Unix(1700100000, 0),
+		},
+		Members: []store.SquadMember{
+			{SquadID: "squad-1", DriverID: 12345, DriverName: "Jon Sabados", Status: store.SquadMemberActive, InvitedAt: joinedAt, JoinedAt: &joinedAt},
+			{SquadID: "squad-1", DriverID: 67890, DriverName: "Other Driver", Status: store.SquadMemberInvited, InvitedAt: time.Unix(1700200000, 0)},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		details *squad.Details
+		err     error
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			details:             details,
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/get_squad_success_response.json",
+		},
+		{
+			name:                "not found",
+			err:                 squad.ErrSquadNotFound,
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/squad_not_found_response.json",
+		},
+		{
+			name:                "service error",
+			err:                 errors.New("database error"),
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/squad_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSquadServiceForGet(t)
+			mockService.EXPECT().Get(mock.Anything, int64(12345), "squad-1").Return(tc.details, tc.err)
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(&stubTokenValidator{sessionClaims: testClaims}))
+			r.Get("/{squad_id}", NewGetSquadEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/squad-1", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
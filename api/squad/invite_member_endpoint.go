@@ -0,0 +1,65 @@
+package squad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type SquadServiceForInvite interface {
+	Invite(ctx context.Context, managerID int64, squadID string, driverID int64) (*store.SquadMember, error)
+}
+
+// NewInviteMemberEndpoint creates the handler for PUT /squads/{squad_id}/members/{driver_id}, inviting the driver to
+// the squad. Only the squad's manager can invite, and the driver's data isn't shared until they accept.
+func NewInviteMemberEndpoint(svc SquadServiceForInvite) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, driverIDPathParam), 10, 64)
+		if err != nil {
+			api.DoBadRequestResponse(ctx, api.NewRequestErrors().WithFieldErrorCode(driverIDPathParam, ErrCodeInvalidInteger, nil), w)
+			return
+		}
+
+		squadID := chi.URLParam(r, squadIDPathParam)
+		member, err := svc.Invite(ctx, claims.IRacingUserID, squadID, driverID)
+		if errors.Is(err, squad.ErrSquadNotFound) {
+			api.DoNotFoundResponse(ctx, "squad not found", w)
+			return
+		}
+		if errors.Is(err, squad.ErrDriverNotFound) {
+			api.DoNotFoundResponse(ctx, "driver not found", w)
+			return
+		}
+		if errors.Is(err, squad.ErrNotManager) {
+			api.DoForbiddenResponse(ctx, "only the squad manager can invite drivers", w)
+			return
+		}
+		if errors.Is(err, squad.ErrAlreadyMember) {
+			api.DoBadRequestResponse(ctx, api.NewRequestErrors().WithFieldErrorCode(driverIDPathParam, "already_member", nil), w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("squadId", squadID).Int64("memberId", driverID).Msg("failed to invite squad member")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, memberFromStore(*member), w)
+	})
+}
@@ -0,0 +1,124 @@
+package squad
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInviteMemberEndpoint(t *testing.T) {
+	invite := &store.SquadMember{
+		SquadID:    "squad-1",
+		SquadName:  "Saturday League",
+		DriverID:   67890,
+		DriverName: "Other Driver",
+		Status:     store.SquadMemberInvited,
+		InvitedAt:  time.Unix(1700200000, 0),
+	}
+
+	type inviteCall struct {
+		member *store.SquadMember
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		driverID string
+
+		inviteCall *inviteCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			driverID:            "67890",
+			inviteCall:          &inviteCall{member: invite},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/invite_member_success_response.json",
+		},
+		{
+			name:                "invalid driver id",
+			driverID:            "abc",
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/invite_member_invalid_driver_id_response.json",
+		},
+		{
+			name:                "not the manager",
+			driverID:            "67890",
+			inviteCall:          &inviteCall{err: squad.ErrNotManager},
+			expectedStatus:      http.StatusForbidden,
+			expectedBodyFixture: "fixtures/invite_member_not_manager_response.json",
+		},
+		{
+			name:                "driver not found",
+			driverID:            "67890",
+			inviteCall:          &inviteCall{err: squad.ErrDriverNotFound},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/invite_member_driver_not_found_response.json",
+		},
+		{
+			name:                "already a member",
+			driverID:            "67890",
+			inviteCall:          &inviteCall{err: squad.ErrAlreadyMember},
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/invite_member_already_member_response.json",
+		},
+		{
+			name:                "service error",
+			driverID:            "67890",
+			inviteCall:          &inviteCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/squad_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSquadServiceForInvite(t)
+			if tc.inviteCall != nil {
+				mockService.EXPECT().Invite(mock.Anything, int64(12345), "squad-1", int64(67890)).Return(tc.inviteCall.member, tc.inviteCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(&stubTokenValidator{sessionClaims: testClaims}))
+			r.Put("/{squad_id}/members/{driver_id}", NewInviteMemberEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPut, ts.URL+"/squad-1/members/"+tc.driverID, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package squad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type SquadServiceForListEvents interface {
+	Events(ctx context.Context, driverID int64, squadID string) ([]store.SquadEvent, error)
+}
+
+// NewListEventsEndpoint creates the handler for GET /squads/{squad_id}/events, returning the squad's events with the
+// most recent session first.
+func NewListEventsEndpoint(svc SquadServiceForListEvents) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		squadID := chi.URLParam(r, squadIDPathParam)
+		events, err := svc.Events(ctx, claims.IRacingUserID, squadID)
+		if errors.Is(err, squad.ErrSquadNotFound) {
+			api.DoNotFoundResponse(ctx, "squad not found", w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("squadId", squadID).Msg("failed to list squad events")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, EventsResponse{Events: eventsFromStore(events)}, w)
+	})
+}
@@ -0,0 +1,93 @@
+package squad
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListEventsEndpoint(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		events []store.SquadEvent
+		err    error
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name: "success",
+			events: []store.SquadEvent{
+				{SquadID: "squad-1", SubsessionID: 600, Name: "Finale", TaggedAt: time.Unix(1700200000, 0)},
+				{SquadID: "squad-1", SubsessionID: 555, Name: "Race Night", TaggedAt: time.Unix(1700100000, 0)},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_events_success_response.json",
+		},
+		{
+			name:                "no events",
+			events:              []store.SquadEvent{},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_events_empty_response.json",
+		},
+		{
+			name:                "not found",
+			err:                 squad.ErrSquadNotFound,
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/squad_not_found_response.json",
+		},
+		{
+			name:                "service error",
+			err:                 errors.New("database error"),
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/squad_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSquadServiceForListEvents(t)
+			mockService.EXPECT().Events(mock.Anything, int64(12345), "squad-1").Return(tc.events, tc.err)
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(&stubTokenValidator{sessionClaims: testClaims}))
+			r.Get("/{squad_id}/events", NewListEventsEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/squad-1/events", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
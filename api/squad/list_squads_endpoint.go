@@ -0,0 +1,38 @@
+package squad
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type SquadServiceForList interface {
+	ListForDriver(ctx context.Context, driverID int64) ([]store.SquadMember, error)
+}
+
+// NewListSquadsEndpoint creates the handler for GET /squads, listing the squads the logged-in driver is in along with
+// any they've been invited to.
+func NewListSquadsEndpoint(svc SquadServiceForList) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		memberships, err := svc.ListForDriver(ctx, claims.IRacingUserID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to list squads")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, MembershipsResponse{Squads: membershipsFromStore(memberships)}, w)
+	})
+}
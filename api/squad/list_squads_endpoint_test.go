@@ -0,0 +1,85 @@
+package squad
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListSquadsEndpoint(t *testing.T) {
+	joinedAt := time.Unix(1700100000, 0)
+
+	testCases := []struct {
+		name string
+
+		memberships []store.SquadMember
+		err         error
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name: "success",
+			memberships: []store.SquadMember{
+				{SquadID: "squad-1", SquadName: "Saturday League", DriverID: 12345, Status: store.SquadMemberActive, InvitedAt: joinedAt, JoinedAt: &joinedAt},
+				{SquadID: "squad-2", SquadName: "Endurance Crew", DriverID: 12345, Status: store.SquadMemberInvited, InvitedAt: time.Unix(1700200000, 0)},
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_squads_success_response.json",
+		},
+		{
+			name:                "no squads",
+			memberships:         []store.SquadMember{},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/list_squads_empty_response.json",
+		},
+		{
+			name:                "service error",
+			err:                 errors.New("database error"),
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/squad_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSquadServiceForList(t)
+			mockService.EXPECT().ListForDriver(mock.Anything, int64(12345)).Return(tc.memberships, tc.err)
+
+			validator := &stubTokenValidator{sessionClaims: testClaims}
+			handler := correlation.Middleware(func() string { return testCorrelationID })(api.AuthMiddleware(validator)(NewListSquadsEndpoint(mockService)))
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
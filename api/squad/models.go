@@ -0,0 +1,209 @@
+package squad
+
+import (
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// CreateSquadRequest is the request body for starting a squad.
+type CreateSquadRequest struct {
+	Name string `json:"name"`
+}
+
+type Squad struct {
+	SquadID     string    `json:"squadId"`
+	Name        string    `json:"name"`
+	ManagerID   int64     `json:"managerId"`
+	ManagerName string    `json:"managerName"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// Member is a driver in a squad, or invited to it.
+type Member struct {
+	DriverID   int64      `json:"driverId"`
+	DriverName string     `json:"driverName"`
+	Status     string     `json:"status"`
+	InvitedAt  time.Time  `json:"invitedAt"`
+	JoinedAt   *time.Time `json:"joinedAt,omitempty"`
+}
+
+type SquadDetails struct {
+	Squad
+	Members []Member `json:"members"`
+}
+
+// Membership is one of the logged-in driver's squads, or an invite to one.
+type Membership struct {
+	SquadID   string     `json:"squadId"`
+	SquadName string     `json:"squadName"`
+	Status    string     `json:"status"`
+	InvitedAt time.Time  `json:"invitedAt"`
+	JoinedAt  *time.Time `json:"joinedAt,omitempty"`
+}
+
+type MembershipsResponse struct {
+	Squads []Membership `json:"squads"`
+}
+
+type IRatingBand struct {
+	Band    int `json:"band"`
+	Drivers int `json:"drivers"`
+}
+
+// Analytics are a squad's combined results across the members who have accepted their invite.
+type Analytics struct {
+	ActiveMembers       int           `json:"activeMembers"`
+	InvitedMembers      int           `json:"invitedMembers"`
+	Races               int           `json:"races"`
+	Wins                int           `json:"wins"`
+	Podiums             int           `json:"podiums"`
+	Incidents           int           `json:"incidents"`
+	AverageIncidents    float64       `json:"averageIncidents"`
+	IRatingDistribution []IRatingBand `json:"iRatingDistribution"`
+	Unrated             int           `json:"unrated"`
+}
+
+// TagEventRequest is the request body for tagging a session as a squad event.
+type TagEventRequest struct {
+	SubsessionID int64  `json:"subsessionId"`
+	Name         string `json:"name"`
+}
+
+type Event struct {
+	SubsessionID int64     `json:"subsessionId"`
+	Name         string    `json:"name"`
+	TaggedAt     time.Time `json:"taggedAt"`
+}
+
+type EventsResponse struct {
+	Events []Event `json:"events"`
+}
+
+// EventResult is a member's finish in a squad event.
+type EventResult struct {
+	DriverID              int64     `json:"driverId"`
+	DriverName            string    `json:"driverName"`
+	StartTime             time.Time `json:"startTime"`
+	CarID                 int64     `json:"carId"`
+	CarClassID            int64     `json:"carClassId"`
+	StartPosition         int       `json:"startPosition"`
+	FinishPosition        int       `json:"finishPosition"`
+	FinishPositionInClass int       `json:"finishPositionInClass"`
+	Incidents             int       `json:"incidents"`
+	LapsComplete          int       `json:"lapsComplete"`
+	OldIRating            int       `json:"oldIrating"`
+	NewIRating            int       `json:"newIrating"`
+	ReasonOutCode         string    `json:"reasonOutCode"`
+}
+
+// EventResults is a squad event along with its members' finishes, in finishing order.
+type EventResults struct {
+	Event
+	Results []EventResult `json:"results"`
+}
+
+func squadFromStore(s store.Squad) Squad {
+	return Squad{
+		SquadID:     s.SquadID,
+		Name:        s.Name,
+		ManagerID:   s.ManagerID,
+		ManagerName: s.ManagerName,
+		CreatedAt:   s.CreatedAt,
+	}
+}
+
+func memberFromStore(m store.SquadMember) Member {
+	return Member{
+		DriverID:   m.DriverID,
+		DriverName: m.DriverName,
+		Status:     string(m.Status),
+		InvitedAt:  m.InvitedAt,
+		JoinedAt:   m.JoinedAt,
+	}
+}
+
+func detailsFromService(d squad.Details) SquadDetails {
+	members := make([]Member, len(d.Members))
+	for i, m := range d.Members {
+		members[i] = memberFromStore(m)
+	}
+	return SquadDetails{
+		Squad:   squadFromStore(d.Squad),
+		Members: members,
+	}
+}
+
+func membershipsFromStore(memberships []store.SquadMember) []Membership {
+	ret := make([]Membership, len(memberships))
+	for i, m := range memberships {
+		ret[i] = Membership{
+			SquadID:   m.SquadID,
+			SquadName: m.SquadName,
+			Status:    string(m.Status),
+			InvitedAt: m.InvitedAt,
+			JoinedAt:  m.JoinedAt,
+		}
+	}
+	return ret
+}
+
+func analyticsFromService(a squad.Analytics) Analytics {
+	bands := make([]IRatingBand, len(a.IRatingDistribution))
+	for i, b := range a.IRatingDistribution {
+		bands[i] = IRatingBand{Band: b.Band, Drivers: b.Drivers}
+	}
+	return Analytics{
+		ActiveMembers:       a.ActiveMembers,
+		InvitedMembers:      a.InvitedMembers,
+		Races:               a.Races,
+		Wins:                a.Wins,
+		Podiums:             a.Podiums,
+		Incidents:           a.Incidents,
+		AverageIncidents:    a.AverageIncidents(),
+		IRatingDistribution: bands,
+		Unrated:             a.Unrated,
+	}
+}
+
+func eventFromStore(e store.SquadEvent) Event {
+	return Event{
+		SubsessionID: e.SubsessionID,
+		Name:         e.Name,
+		TaggedAt:     e.TaggedAt,
+	}
+}
+
+func eventsFromStore(events []store.SquadEvent) []Event {
+	ret := make([]Event, len(events))
+	for i, e := range events {
+		ret[i] = eventFromStore(e)
+	}
+	return ret
+}
+
+func eventResultsFromService(r squad.EventResults) EventResults {
+	results := make([]EventResult, len(r.Results))
+	for i, res := range r.Results {
+		results[i] = EventResult{
+			DriverID:              res.DriverID,
+			DriverName:            res.DriverName,
+			StartTime:             res.StartTime.UTC(),
+			CarID:                 res.CarID,
+			CarClassID:            res.CarClassID,
+			StartPosition:         res.StartPosition,
+			FinishPosition:        res.FinishPosition,
+			FinishPositionInClass: res.FinishPositionInClass,
+			Incidents:             res.Incidents,
+			LapsComplete:          res.LapsComplete,
+			OldIRating:            res.OldIRating,
+			NewIRating:            res.NewIRating,
+			ReasonOutCode:         string(res.ReasonOutCode),
+		}
+	}
+	return EventResults{
+		Event:   eventFromStore(r.Event),
+		Results: results,
+	}
+}
@@ -0,0 +1,64 @@
+package squad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/rs/zerolog"
+)
+
+type SquadServiceForRemove interface {
+	Remove(ctx context.Context, callerID int64, squadID string, driverID int64) error
+}
+
+// NewRemoveMemberEndpoint creates the handler for DELETE /squads/{squad_id}/members/{driver_id}. Drivers use it to
+// leave a squad or decline an invite, withdrawing consent, and managers use it to remove a member or withdraw an invite.
+func NewRemoveMemberEndpoint(svc SquadServiceForRemove) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		driverID, err := strconv.ParseInt(chi.URLParam(r, driverIDPathParam), 10, 64)
+		if err != nil {
+			api.DoBadRequestResponse(ctx, api.NewRequestErrors().WithFieldErrorCode(driverIDPathParam, ErrCodeInvalidInteger, nil), w)
+			return
+		}
+
+		squadID := chi.URLParam(r, squadIDPathParam)
+		err = svc.Remove(ctx, claims.IRacingUserID, squadID, driverID)
+		if errors.Is(err, squad.ErrSquadNotFound) {
+			api.DoNotFoundResponse(ctx, "squad not found", w)
+			return
+		}
+		if errors.Is(err, squad.ErrMemberNotFound) {
+			api.DoNotFoundResponse(ctx, "member not found", w)
+			return
+		}
+		if errors.Is(err, squad.ErrNotManager) {
+			api.DoForbiddenResponse(ctx, "only the squad manager can remove other drivers", w)
+			return
+		}
+		if errors.Is(err, squad.ErrManagerCannotLeave) {
+			api.DoBadRequestResponse(ctx, api.NewRequestErrors().WithFieldErrorCode(driverIDPathParam, "squad_manager", nil), w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("squadId", squadID).Int64("memberId", driverID).Msg("failed to remove squad member")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,95 @@
+package squad
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRemoveMemberEndpoint(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		removeErr error
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:           "success",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                "member not found",
+			removeErr:           squad.ErrMemberNotFound,
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/remove_member_not_found_response.json",
+		},
+		{
+			name:                "not the manager",
+			removeErr:           squad.ErrNotManager,
+			expectedStatus:      http.StatusForbidden,
+			expectedBodyFixture: "fixtures/remove_member_not_manager_response.json",
+		},
+		{
+			name:                "manager can't leave",
+			removeErr:           squad.ErrManagerCannotLeave,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/remove_member_manager_response.json",
+		},
+		{
+			name:                "service error",
+			removeErr:           errors.New("database error"),
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/squad_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSquadServiceForRemove(t)
+			mockService.EXPECT().Remove(mock.Anything, int64(12345), "squad-1", int64(67890)).Return(tc.removeErr)
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(&stubTokenValidator{sessionClaims: testClaims}))
+			r.Delete("/{squad_id}/members/{driver_id}", NewRemoveMemberEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodDelete, ts.URL+"/squad-1/members/67890", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			if tc.expectedBodyFixture == "" {
+				assert.Empty(t, bodyBytes)
+				return
+			}
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package squad
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+const (
+	squadIDPathParam      = "squad_id"
+	driverIDPathParam     = "driver_id"
+	subsessionIDPathParam = "subsession_id"
+
+	ErrCodeInvalidInteger = "invalid_integer"
+)
+
+type SquadService interface {
+	SquadServiceForCreate
+	SquadServiceForList
+	SquadServiceForGet
+	SquadServiceForInvite
+	SquadServiceForAccept
+	SquadServiceForRemove
+	SquadServiceForAnalytics
+	SquadServiceForTagEvent
+	SquadServiceForListEvents
+	SquadServiceForEventResults
+}
+
+// NewRouter builds the squad routes. Every route acts as the logged-in driver, so membership and management rights come
+// from the session rather than the path.
+func NewRouter(svc SquadService, authMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(authMiddleware)
+
+	r.Post("/", api.WrapWithSegment("createSquad", NewCreateSquadEndpoint(svc)).ServeHTTP)
+	r.Get("/", api.WrapWithSegment("listSquads", NewListSquadsEndpoint(svc)).ServeHTTP)
+	r.Get("/{squad_id}", api.WrapWithSegment("getSquad", NewGetSquadEndpoint(svc)).ServeHTTP)
+	r.Put("/{squad_id}/members/{driver_id}", api.WrapWithSegment("inviteSquadMember", NewInviteMemberEndpoint(svc)).ServeHTTP)
+	r.Delete("/{squad_id}/members/{driver_id}", api.WrapWithSegment("removeSquadMember", NewRemoveMemberEndpoint(svc)).ServeHTTP)
+	r.Post("/{squad_id}/accept", api.WrapWithSegment("acceptSquadInvite", NewAcceptInviteEndpoint(svc)).ServeHTTP)
+	r.Get("/{squad_id}/analytics", api.WrapWithSegment("getSquadAnalytics", NewGetAnalyticsEndpoint(svc)).ServeHTTP)
+	r.Post("/{squad_id}/events", api.WrapWithSegment("tagSquadEvent", NewTagEventEndpoint(svc)).ServeHTTP)
+	r.Get("/{squad_id}/events", api.WrapWithSegment("listSquadEvents", NewListEventsEndpoint(svc)).ServeHTTP)
+	r.Get("/{squad_id}/events/{subsession_id}", api.WrapWithSegment("getSquadEventResults", NewGetEventResultsEndpoint(svc)).ServeHTTP)
+
+	return r
+}
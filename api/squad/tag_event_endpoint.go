@@ -0,0 +1,70 @@
+package squad
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type SquadServiceForTagEvent interface {
+	TagEvent(ctx context.Context, managerID int64, squadID string, subsessionID int64, name string) (*store.SquadEvent, error)
+}
+
+// NewTagEventEndpoint creates the handler for POST /squads/{squad_id}/events, tagging a session as a squad event. Only
+// the squad's manager can tag events, members' finishes are gathered as their races are ingested.
+func NewTagEventEndpoint(svc SquadServiceForTagEvent) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		errs := api.NewRequestErrors()
+
+		var req TagEventRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errs = errs.WithError("invalid JSON body")
+		} else {
+			if req.SubsessionID <= 0 {
+				errs = errs.WithFieldErrorCode("subsessionId", "required", nil)
+			}
+			for _, v := range squad.ValidateName(req.Name) {
+				errs = errs.WithFieldErrorCode(v.Field, v.Code, v.Params)
+			}
+		}
+
+		if errs.HasAnyError() {
+			api.DoBadRequestResponse(ctx, errs, w)
+			return
+		}
+
+		squadID := chi.URLParam(r, squadIDPathParam)
+		event, err := svc.TagEvent(ctx, claims.IRacingUserID, squadID, req.SubsessionID, req.Name)
+		if errors.Is(err, squad.ErrSquadNotFound) {
+			api.DoNotFoundResponse(ctx, "squad not found", w)
+			return
+		}
+		if errors.Is(err, squad.ErrNotManager) {
+			api.DoForbiddenResponse(ctx, "only the squad manager can tag events", w)
+			return
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("squadId", squadID).Int64("subsessionId", req.SubsessionID).Msg("failed to tag squad event")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, eventFromStore(*event), w)
+	})
+}
@@ -0,0 +1,124 @@
+package squad
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTagEventEndpoint(t *testing.T) {
+	testEvent := store.SquadEvent{
+		SquadID:      "squad-1",
+		SubsessionID: 555,
+		Name:         "Race Night",
+		TaggedAt:     time.Unix(1700100000, 0),
+	}
+
+	type tagEventCall struct {
+		event *store.SquadEvent
+		err   error
+	}
+
+	testCases := []struct {
+		name string
+
+		requestBody string
+
+		tagEventCall *tagEventCall
+
+		expectedStatus      int
+		expectedBodyFixture string
+	}{
+		{
+			name:                "success",
+			requestBody:         `{"subsessionId": 555, "name": "Race Night"}`,
+			tagEventCall:        &tagEventCall{event: &testEvent},
+			expectedStatus:      http.StatusOK,
+			expectedBodyFixture: "fixtures/tag_event_success_response.json",
+		},
+		{
+			name:                "invalid JSON",
+			requestBody:         `{not json`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/create_squad_invalid_json_response.json",
+		},
+		{
+			name:                "missing subsession and name",
+			requestBody:         `{}`,
+			expectedStatus:      http.StatusBadRequest,
+			expectedBodyFixture: "fixtures/tag_event_invalid_request_response.json",
+		},
+		{
+			name:                "not the manager",
+			requestBody:         `{"subsessionId": 555, "name": "Race Night"}`,
+			tagEventCall:        &tagEventCall{err: squad.ErrNotManager},
+			expectedStatus:      http.StatusForbidden,
+			expectedBodyFixture: "fixtures/tag_event_not_manager_response.json",
+		},
+		{
+			name:                "squad not found",
+			requestBody:         `{"subsessionId": 555, "name": "Race Night"}`,
+			tagEventCall:        &tagEventCall{err: squad.ErrSquadNotFound},
+			expectedStatus:      http.StatusNotFound,
+			expectedBodyFixture: "fixtures/squad_not_found_response.json",
+		},
+		{
+			name:                "service error",
+			requestBody:         `{"subsessionId": 555, "name": "Race Night"}`,
+			tagEventCall:        &tagEventCall{err: errors.New("database error")},
+			expectedStatus:      http.StatusInternalServerError,
+			expectedBodyFixture: "fixtures/squad_service_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSquadServiceForTagEvent(t)
+			if tc.tagEventCall != nil {
+				mockService.EXPECT().TagEvent(mock.Anything, int64(12345), "squad-1", int64(555), "Race Night").
+					Return(tc.tagEventCall.event, tc.tagEventCall.err)
+			}
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Use(api.AuthMiddleware(&stubTokenValidator{sessionClaims: testClaims}))
+			r.Post("/{squad_id}/events", NewTagEventEndpoint(mockService).ServeHTTP)
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPost, ts.URL+"/squad-1/events", bytes.NewBufferString(tc.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
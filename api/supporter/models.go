@@ -0,0 +1,48 @@
+package supporter
+
+import (
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/supporter"
+)
+
+type Limits struct {
+	HistoryDays            int                     `json:"historyDays"` // 0 for unlimited
+	AnalyticsGranularities []analytics.Granularity `json:"analyticsGranularities"`
+	ExportMaxRaces         int                     `json:"exportMaxRaces"`
+}
+
+type Subscription struct {
+	Status           string    `json:"status"`
+	CurrentPeriodEnd time.Time `json:"currentPeriodEnd"`
+}
+
+type Status struct {
+	Supporter    bool          `json:"supporter"`
+	Subscription *Subscription `json:"subscription,omitempty"`
+	Limits       Limits        `json:"limits"`
+}
+
+type WebhookResponse struct {
+	Received bool `json:"received"`
+}
+
+func limitsFromSupporter(limits supporter.Limits) Limits {
+	return Limits{
+		HistoryDays:            limits.HistoryDays,
+		AnalyticsGranularities: limits.AnalyticsGranularities,
+		ExportMaxRaces:         limits.ExportMaxRaces,
+	}
+}
+
+func subscriptionFromStore(s *store.Supporter) *Subscription {
+	if s == nil {
+		return nil
+	}
+	return &Subscription{
+		Status:           s.Status,
+		CurrentPeriodEnd: s.CurrentPeriodEnd,
+	}
+}
@@ -0,0 +1,23 @@
+package supporter
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/api"
+)
+
+type Service interface {
+	StatusService
+	SubscriptionService
+}
+
+func NewRouter(svc Service, stripeSigningSecret string, authMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+
+	r.With(authMiddleware).Get("/status", api.WrapWithSegment("getSupporterStatus", NewStatusEndpoint(svc)).ServeHTTP)
+	r.Post("/webhook/stripe", api.WrapWithSegment("stripeWebhook", NewStripeWebhookEndpoint(svc, stripeSigningSecret, time.Now)).ServeHTTP)
+
+	return r
+}
@@ -0,0 +1,45 @@
+package supporter
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/supporter"
+	"github.com/rs/zerolog"
+)
+
+type StatusService interface {
+	GetSupporter(ctx context.Context, driverID int64) (*store.Supporter, error)
+}
+
+// NewStatusEndpoint returns the logged-in driver's subscription along with the limits they're held to. Limits come from
+// the session's entitlements since that's what gets enforced, so a fresh subscription shows up there after the next
+// token refresh.
+func NewStatusEndpoint(svc StatusService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		claims := api.SessionClaimsFromContext(ctx)
+		if claims == nil {
+			api.DoUnauthorizedResponse(ctx, "missing session claims", w)
+			return
+		}
+
+		record, err := svc.GetSupporter(ctx, claims.IRacingUserID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get supporter")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, Status{
+			Supporter:    slices.Contains(claims.Entitlements, supporter.Entitlement),
+			Subscription: subscriptionFromStore(record),
+			Limits:       limitsFromSupporter(supporter.LimitsFor(claims.Entitlements)),
+		}, w)
+	})
+}
@@ -0,0 +1,62 @@
+package supporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/supporter"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+	"github.com/rs/zerolog"
+)
+
+// maxWebhookBodyBytes is well past the size of any subscription event.
+const maxWebhookBodyBytes = 64 * 1024
+
+type SubscriptionService interface {
+	ApplySubscriptionUpdate(ctx context.Context, update supporter.SubscriptionUpdate) error
+}
+
+// NewStripeWebhookEndpoint receives Stripe webhook deliveries. It isn't behind auth, deliveries are trusted by their
+// signature instead. Anything but a 2xx makes Stripe retry, so only failures worth retrying return a 500.
+func NewStripeWebhookEndpoint(svc SubscriptionService, signingSecret string, now func() time.Time) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodyBytes))
+		if err != nil {
+			api.DoBadRequestResponse(ctx, api.NewRequestErrors().WithError("unreadable body"), w)
+			return
+		}
+
+		if err := supporter.VerifyStripeSignature(payload, r.Header.Get(supporter.StripeSignatureHeader), signingSecret, now()); err != nil {
+			logger.Warn().Err(err).Msg("rejecting stripe webhook")
+			api.DoBadRequestResponse(ctx, api.NewRequestErrors().WithError("invalid signature"), w)
+			return
+		}
+
+		event, update, err := supporter.ParseStripeEvent(payload)
+		if err != nil {
+			logger.Error().Err(err).Msg("unprocessable stripe event")
+			api.DoBadRequestResponse(ctx, api.NewRequestErrors().WithError("unprocessable event"), w)
+			return
+		}
+		if update == nil {
+			logger.Debug().Str("eventId", event.ID).Str("eventType", event.Type).Msg("ignoring stripe event")
+			api.DoOKResponse(ctx, WebhookResponse{Received: true}, w)
+			return
+		}
+
+		// Stripe calls without a tenant, the subscription itself says which tenant it belongs to
+		if err := svc.ApplySubscriptionUpdate(tenant.WithID(ctx, update.TenantID), *update); err != nil {
+			logger.Error().Err(err).Str("eventId", event.ID).Msg("failed to apply subscription update")
+			api.DoErrorResponse(ctx, w)
+			return
+		}
+
+		api.DoOKResponse(ctx, WebhookResponse{Received: true}, w)
+	})
+}
@@ -0,0 +1,127 @@
+package supporter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/supporter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStripeWebhookEndpoint(t *testing.T) {
+	secret := "whsec_test"
+	now := time.Unix(1700000060, 0)
+
+	subscriptionEvent := `{"id":"evt_123","type":"customer.subscription.updated","created":1700000000,"data":{"object":{"id":"sub_123","customer":"cus_123","status":"active","current_period_end":1702592000,"metadata":{"driver_id":"12345"}}}}`
+	expectedUpdate := supporter.SubscriptionUpdate{
+		DriverID:         12345,
+		CustomerID:       "cus_123",
+		SubscriptionID:   "sub_123",
+		Status:           "active",
+		CurrentPeriodEnd: time.Unix(1702592000, 0),
+		OccurredAt:       time.Unix(1700000000, 0),
+	}
+
+	signatureFor := func(payload, key string) string {
+		mac := hmac.New(sha256.New, []byte(key))
+		mac.Write([]byte(fmt.Sprintf("%d.%s", now.Unix(), payload)))
+		return fmt.Sprintf("t=%d,v1=%s", now.Unix(), hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	testCases := []struct {
+		name string
+
+		payload   string
+		signature string
+
+		applyErr    error
+		expectApply bool
+
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "subscription update applied",
+			payload:                     subscriptionEvent,
+			signature:                   signatureFor(subscriptionEvent, secret),
+			expectApply:                 true,
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/stripe_webhook_received_response.json",
+		},
+		{
+			name:                        "other events acknowledged",
+			payload:                     `{"id":"evt_456","type":"invoice.paid","created":1700000000,"data":{"object":{"id":"in_123"}}}`,
+			signature:                   signatureFor(`{"id":"evt_456","type":"invoice.paid","created":1700000000,"data":{"object":{"id":"in_123"}}}`, secret),
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/stripe_webhook_received_response.json",
+		},
+		{
+			name:                        "bad signature returns 400",
+			payload:                     subscriptionEvent,
+			signature:                   signatureFor(subscriptionEvent, "whsec_other"),
+			expectedResponseStatus:      http.StatusBadRequest,
+			expectedResponseBodyFixture: "fixtures/stripe_webhook_invalid_signature_response.json",
+		},
+		{
+			name:                        "subscription without a driver returns 400",
+			payload:                     `{"id":"evt_789","type":"customer.subscription.created","created":1700000000,"data":{"object":{"id":"sub_123","status":"active"}}}`,
+			signature:                   signatureFor(`{"id":"evt_789","type":"customer.subscription.created","created":1700000000,"data":{"object":{"id":"sub_123","status":"active"}}}`, secret),
+			expectedResponseStatus:      http.StatusBadRequest,
+			expectedResponseBodyFixture: "fixtures/stripe_webhook_unprocessable_event_response.json",
+		},
+		{
+			name:                        "service error returns 500 so stripe retries",
+			payload:                     subscriptionEvent,
+			signature:                   signatureFor(subscriptionEvent, secret),
+			expectApply:                 true,
+			applyErr:                    errors.New("database error"),
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/stripe_webhook_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSubscriptionService(t)
+			if tc.expectApply {
+				mockService.EXPECT().ApplySubscriptionUpdate(mock.Anything, expectedUpdate).Return(tc.applyErr)
+			}
+
+			endpoint := NewStripeWebhookEndpoint(mockService, secret, func() time.Time { return now })
+			handler := correlation.Middleware(func() string { return testCorrelationID })(endpoint)
+
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPost, ts.URL, strings.NewReader(tc.payload))
+			require.NoError(t, err)
+			req.Header.Set(supporter.StripeSignatureHeader, tc.signature)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+// TenantMiddleware puts the tenant named by the tenant.HeaderName header on the request context, answering with a 404
+// for tenants the deployment doesn't host. Requests without the header are for the default tenant.
+func TenantMiddleware(tenants *tenant.Registry) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			// what's served differs by tenant, so caches need to keep them apart
+			w.Header().Add("Vary", tenant.HeaderName)
+
+			id := r.Header.Get(tenant.HeaderName)
+			if !tenants.Hosts(id) {
+				zerolog.Ctx(ctx).Warn().Str("tenant", id).Msg("request for unknown tenant")
+				DoNotFoundResponse(ctx, "unknown tenant", w)
+				return
+			}
+			if id != tenant.Default {
+				logger := zerolog.Ctx(ctx).With().Str("tenant", id).Logger()
+				ctx = logger.WithContext(ctx)
+			}
+
+			next.ServeHTTP(w, r.WithContext(tenant.WithID(ctx, id)))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+func TestTenantMiddleware(t *testing.T) {
+	tenants, err := tenant.NewRegistry([]string{"league-one"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+
+		tenantHeader string
+
+		expectedStatus   int
+		expectNextCalled bool
+		expectedTenant   string
+	}{
+		{
+			name:             "no header is the default tenant",
+			expectedStatus:   http.StatusOK,
+			expectNextCalled: true,
+			expectedTenant:   tenant.Default,
+		},
+		{
+			name:             "hosted tenant",
+			tenantHeader:     "league-one",
+			expectedStatus:   http.StatusOK,
+			expectNextCalled: true,
+			expectedTenant:   "league-one",
+		},
+		{
+			name:           "unknown tenant",
+			tenantHeader:   "league-two",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nextCalled := false
+			var seenTenant string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nextCalled = true
+				seenTenant = tenant.FromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+
+			ts := httptest.NewServer(correlation.Middleware(func() string { return testCorrelationID })(TenantMiddleware(tenants)(next)))
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodGet, ts.URL, nil)
+			require.NoError(t, err)
+			if tc.tenantHeader != "" {
+				req.Header.Set(tenant.HeaderName, tc.tenantHeader)
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+			assert.Equal(t, tc.expectNextCalled, nextCalled)
+			assert.Equal(t, tc.expectedTenant, seenTenant)
+			assert.Equal(t, tenant.HeaderName, res.Header.Get("Vary"))
+		})
+	}
+}
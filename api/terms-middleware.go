@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jonsabados/saturdaysspinout/terms"
+	"github.com/rs/zerolog"
+)
+
+type TermsChecker interface {
+	Outstanding(ctx context.Context, driverID int64) ([]terms.Requirement, error)
+}
+
+// TermsAcceptanceMiddleware holds back changes from drivers who haven't accepted the current version of the terms of
+// service and privacy policy, answering them with a 428 listing what needs accepting. Reads are let through so drivers
+// can still see their data before accepting.
+func TermsAcceptanceMiddleware(checker TermsChecker) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			sessionClaims := SessionClaimsFromContext(ctx)
+			if sessionClaims == nil {
+				DoUnauthorizedResponse(ctx, "missing session claims", w)
+				return
+			}
+
+			outstanding, err := checker.Outstanding(ctx, sessionClaims.IRacingUserID)
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to check terms acceptance")
+				DoErrorResponse(ctx, w)
+				return
+			}
+			if len(outstanding) > 0 {
+				required := make([]RequiredTerms, len(outstanding))
+				for i, requirement := range outstanding {
+					required[i] = RequiredTerms{Document: requirement.Document, Version: requirement.Version}
+				}
+				DoPreconditionRequiredResponse(ctx, "terms acceptance required", required, w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/jonsabados/saturdaysspinout/terms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTermsAcceptanceMiddleware(t *testing.T) {
+	sessionClaims := &auth.SessionClaims{
+		IRacingUserID:   12345,
+		IRacingUserName: "Test Driver",
+	}
+
+	type outstandingCall struct {
+		result []terms.Requirement
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		method          string
+		sessionClaims   *auth.SessionClaims
+		outstandingCall *outstandingCall
+
+		expectNextCalled            bool
+		expectedResponseStatus      int
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "reads pass through unchecked",
+			method:                      http.MethodGet,
+			sessionClaims:               sessionClaims,
+			expectNextCalled:            true,
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/terms_success_response.json",
+		},
+		{
+			name:                        "missing session claims returns 401",
+			method:                      http.MethodPost,
+			expectedResponseStatus:      http.StatusUnauthorized,
+			expectedResponseBodyFixture: "fixtures/terms_missing_claims_response.json",
+		},
+		{
+			name:                        "accepted passes through",
+			method:                      http.MethodPost,
+			sessionClaims:               sessionClaims,
+			outstandingCall:             &outstandingCall{},
+			expectNextCalled:            true,
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/terms_success_response.json",
+		},
+		{
+			name:          "not accepted returns 428",
+			method:        http.MethodDelete,
+			sessionClaims: sessionClaims,
+			outstandingCall: &outstandingCall{result: []terms.Requirement{
+				{Document: terms.DocumentPrivacyPolicy, Version: "2025-01-01"},
+				{Document: terms.DocumentTermsOfService, Version: "2025-02-01"},
+			}},
+			expectedResponseStatus:      http.StatusPreconditionRequired,
+			expectedResponseBodyFixture: "fixtures/terms_required_response.json",
+		},
+		{
+			name:                        "check error returns 500",
+			method:                      http.MethodPut,
+			sessionClaims:               sessionClaims,
+			outstandingCall:             &outstandingCall{err: errors.New("database error")},
+			expectedResponseStatus:      http.StatusInternalServerError,
+			expectedResponseBodyFixture: "fixtures/terms_error_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			checker := NewMockTermsChecker(t)
+			if tc.outstandingCall != nil {
+				checker.EXPECT().Outstanding(mock.Anything, int64(12345)).Return(tc.outstandingCall.result, tc.outstandingCall.err)
+			}
+
+			nextCalled := false
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nextCalled = true
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]any{"next_called": true})
+			})
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Route("/protected", func(r chi.Router) {
+				r.Use(func(next http.Handler) http.Handler {
+					return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+						if tc.sessionClaims != nil {
+							reqCtx := context.WithValue(req.Context(), sessionClaimsKey, tc.sessionClaims)
+							req = req.WithContext(reqCtx)
+						}
+						next.ServeHTTP(w, req)
+					})
+				})
+				r.Use(TermsAcceptanceMiddleware(checker))
+				r.HandleFunc("/", nextHandler)
+			})
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, tc.method, ts.URL+"/protected", nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+			assert.Equal(t, tc.expectNextCalled, nextCalled)
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type UpstreamAvailability interface {
+	DownUntil(ctx context.Context) (time.Time, error)
+}
+
+// UpstreamAvailabilityMiddleware answers with a 503 while iRacing is down for maintenance, so requests that would call
+// it don't while it is known to be down.
+func UpstreamAvailabilityMiddleware(availability UpstreamAvailability, now func() time.Time) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			downUntil, err := availability.DownUntil(ctx)
+			if err != nil {
+				// the request finds out for itself if iRacing is down, so let it through rather than failing it
+				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to check iRacing availability")
+			} else if !downUntil.IsZero() {
+				retryAfter := max(int(math.Ceil(downUntil.Sub(now()).Seconds())), 1)
+				DoUpstreamUnavailableResponse(ctx, "iRacing is down for maintenance", retryAfter, w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jonsabados/saturdaysspinout/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpstreamAvailabilityMiddleware(t *testing.T) {
+	now := time.Date(2024, 3, 10, 15, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+
+		downUntil time.Time
+		downErr   error
+
+		expectNextCalled            bool
+		expectedResponseStatus      int
+		expectedRetryAfterHeader    string
+		expectedResponseBodyFixture string
+	}{
+		{
+			name:                        "iRacing up passes through",
+			expectNextCalled:            true,
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/upstream_available_response.json",
+		},
+		{
+			name:                        "iRacing down returns 503",
+			downUntil:                   now.Add(10 * time.Minute),
+			expectedResponseStatus:      http.StatusServiceUnavailable,
+			expectedRetryAfterHeader:    "600",
+			expectedResponseBodyFixture: "fixtures/upstream_unavailable_response.json",
+		},
+		{
+			name:                        "availability error passes through",
+			downErr:                     errors.New("database error"),
+			expectNextCalled:            true,
+			expectedResponseStatus:      http.StatusOK,
+			expectedResponseBodyFixture: "fixtures/upstream_available_response.json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			availability := NewMockUpstreamAvailability(t)
+			availability.EXPECT().DownUntil(mock.Anything).Return(tc.downUntil, tc.downErr)
+
+			nextCalled := false
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nextCalled = true
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]any{"next_called": true})
+			})
+
+			r := chi.NewRouter()
+			r.Use(correlation.Middleware(func() string { return testCorrelationID }))
+			r.Route("/upstream", func(r chi.Router) {
+				r.Use(UpstreamAvailabilityMiddleware(availability, func() time.Time { return now }))
+				r.Get("/", nextHandler)
+			})
+
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/upstream", nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			bodyBytes, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedResponseStatus, res.StatusCode)
+			assert.Equal(t, tc.expectNextCalled, nextCalled)
+			assert.Equal(t, tc.expectedRetryAfterHeader, res.Header.Get("Retry-After"))
+
+			expectedBody, err := os.ReadFile(tc.expectedResponseBodyFixture)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expectedBody), string(bodyBytes))
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// ErrTooManyAttempts is returned when sign in attempts are being held off after too many failures. The error is a
+// *TooManyAttemptsError.
+var ErrTooManyAttempts = errors.New("too many failed sign in attempts")
+
+// TooManyAttemptsError says when attempts will next be let through.
+type TooManyAttemptsError struct {
+	RetryAt time.Time
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return fmt.Sprintf("%s, retry at %s", ErrTooManyAttempts, e.RetryAt.UTC().Format(time.RFC3339))
+}
+
+func (e *TooManyAttemptsError) Is(target error) bool {
+	return target == ErrTooManyAttempts
+}
+
+// AttemptPolicy is how failed sign in attempts are held against their source. The first FreeFailures cost nothing,
+// each one after holds off further attempts for BaseDelay doubling with every failure up to MaxDelay, and reaching
+// LockoutFailures locks the source out for LockoutDuration. Failures are forgotten after Window without any.
+type AttemptPolicy struct {
+	FreeFailures    int
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	LockoutFailures int
+	LockoutDuration time.Duration
+	Window          time.Duration
+}
+
+// DefaultAttemptPolicy is loose enough that someone fumbling a sign in never notices, while anything hammering the
+// credential exchange is slowed to a crawl and then shut out.
+var DefaultAttemptPolicy = AttemptPolicy{
+	FreeFailures:    5,
+	BaseDelay:       2 * time.Second,
+	MaxDelay:        5 * time.Minute,
+	LockoutFailures: 20,
+	LockoutDuration: time.Hour,
+	Window:          time.Hour,
+}
+
+// heldUntil is when attempts from a source with the given failures are next let through, zero if they aren't held off.
+func (p AttemptPolicy) heldUntil(attempts store.LoginAttempts) time.Time {
+	if attempts.Failures >= p.LockoutFailures {
+		return attempts.LastFailure.Add(p.LockoutDuration)
+	}
+	if attempts.Failures <= p.FreeFailures {
+		return time.Time{}
+	}
+	delay := p.MaxDelay
+	// past 30 doublings the delay is well beyond any sane MaxDelay, and shifting further risks overflow
+	if doublings := attempts.Failures - p.FreeFailures - 1; doublings < 30 {
+		delay = min(p.BaseDelay<<doublings, p.MaxDelay)
+	}
+	return attempts.LastFailure.Add(delay)
+}
+
+type AttemptStore interface {
+	GetLoginAttempts(ctx context.Context, key string) (*store.LoginAttempts, error)
+	RecordLoginFailure(ctx context.Context, key string, at time.Time, window time.Duration) (*store.LoginAttempts, error)
+	ClearLoginAttempts(ctx context.Context, key string) error
+}
+
+type MetricsEmitter interface {
+	EmitCount(ctx context.Context, name string, count int) error
+}
+
+// AttemptTracker holds failed sign in attempts against the client address and iRacing customer they came from.
+type AttemptTracker struct {
+	store   AttemptStore
+	metrics MetricsEmitter
+	policy  AttemptPolicy
+	now     func() time.Time
+}
+
+func NewAttemptTracker(store AttemptStore, metrics MetricsEmitter, policy AttemptPolicy) *AttemptTracker {
+	return &AttemptTracker{
+		store:   store,
+		metrics: metrics,
+		policy:  policy,
+		now:     time.Now,
+	}
+}
+
+// Check returns a *TooManyAttemptsError if attempts from any of the keys are being held off. Empty keys are skipped.
+func (t *AttemptTracker) Check(ctx context.Context, keys ...string) error {
+	now := t.now()
+	var retryAt time.Time
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		attempts, err := t.store.GetLoginAttempts(ctx, key)
+		if err != nil {
+			return fmt.Errorf("getting login attempts: %w", err)
+		}
+		if attempts == nil {
+			continue
+		}
+		if until := t.policy.heldUntil(*attempts); until.After(now) && until.After(retryAt) {
+			retryAt = until
+		}
+	}
+	if retryAt.IsZero() {
+		return nil
+	}
+
+	if err := t.metrics.EmitCount(ctx, metrics.LoginAttemptsRefused, 1); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to emit login attempts refused metric")
+	}
+	return &TooManyAttemptsError{RetryAt: retryAt}
+}
+
+// RecordFailure counts a failed attempt against each of the keys. Empty keys are skipped.
+func (t *AttemptTracker) RecordFailure(ctx context.Context, keys ...string) error {
+	now := t.now()
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		attempts, err := t.store.RecordLoginFailure(ctx, key, now, t.policy.Window)
+		if err != nil {
+			return fmt.Errorf("recording login failure: %w", err)
+		}
+		if attempts.Failures == t.policy.LockoutFailures {
+			zerolog.Ctx(ctx).Warn().Str("attemptKey", key).Int("failures", attempts.Failures).Time("firstFailure", attempts.FirstFailure).Msg("locking out sign in attempts")
+			if err := t.metrics.EmitCount(ctx, metrics.LoginLockouts, 1); err != nil {
+				zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to emit login lockout metric")
+			}
+		}
+	}
+
+	if err := t.metrics.EmitCount(ctx, metrics.LoginFailures, 1); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to emit login failure metric")
+	}
+	return nil
+}
+
+// Clear forgets the failed attempts held against key, for a successful sign in or an operator unlocking it.
+func (t *AttemptTracker) Clear(ctx context.Context, key string) error {
+	if err := t.store.ClearLoginAttempts(ctx, key); err != nil {
+		return fmt.Errorf("clearing login attempts: %w", err)
+	}
+	return nil
+}
+
+// IPAttemptKey is the key attempts from a client address are held against. IPv6 clients are tracked by their /64,
+// since a single host typically has the whole of one to rotate through. Returns empty for anything that isn't an
+// address.
+func IPAttemptKey(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+	addr = addr.Unmap()
+	if addr.Is6() {
+		prefix, err := addr.Prefix(64)
+		if err != nil {
+			return ""
+		}
+		return "ip#" + prefix.String()
+	}
+	return "ip#" + addr.String()
+}
+
+// CustomerAttemptKey is the key attempts on behalf of an iRacing customer are held against.
+func CustomerAttemptKey(customerID int64) string {
+	return "customer#" + strconv.FormatInt(customerID, 10)
+}
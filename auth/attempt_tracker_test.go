@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+func TestAttemptPolicy_heldUntil(t *testing.T) {
+	lastFailure := time.Unix(10000, 0)
+
+	testCases := []struct {
+		failures int
+		expected time.Time
+	}{
+		{failures: 1, expected: time.Time{}},
+		{failures: 5, expected: time.Time{}},
+		{failures: 6, expected: lastFailure.Add(2 * time.Second)},
+		{failures: 7, expected: lastFailure.Add(4 * time.Second)},
+		{failures: 10, expected: lastFailure.Add(32 * time.Second)},
+		{failures: 13, expected: lastFailure.Add(256 * time.Second)},
+		{failures: 14, expected: lastFailure.Add(5 * time.Minute)},
+		{failures: 19, expected: lastFailure.Add(5 * time.Minute)},
+		{failures: 20, expected: lastFailure.Add(time.Hour)},
+		{failures: 500, expected: lastFailure.Add(time.Hour)},
+	}
+
+	for _, tc := range testCases {
+		got := DefaultAttemptPolicy.heldUntil(store.LoginAttempts{Failures: tc.failures, LastFailure: lastFailure})
+		assert.Equal(t, tc.expected, got, "failures: %d", tc.failures)
+	}
+
+	// a policy whose lockout is out of reach still can't overflow its delay
+	noLockout := DefaultAttemptPolicy
+	noLockout.LockoutFailures = 1000
+	assert.Equal(t, lastFailure.Add(5*time.Minute), noLockout.heldUntil(store.LoginAttempts{Failures: 200, LastFailure: lastFailure}))
+}
+
+func TestAttemptTracker_Check(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(10000, 0)
+
+	testCases := []struct {
+		name      string
+		attempts  map[string]*store.LoginAttempts
+		storeErr  error
+		expectErr error
+	}{
+		{
+			name: "no failures",
+			attempts: map[string]*store.LoginAttempts{
+				"ip#203.0.113.7": nil,
+				"customer#12345": nil,
+			},
+		},
+		{
+			name: "delay has passed",
+			attempts: map[string]*store.LoginAttempts{
+				"ip#203.0.113.7": {Key: "ip#203.0.113.7", Failures: 6, LastFailure: now.Add(-2 * time.Second)},
+				"customer#12345": {Key: "customer#12345", Failures: 3, LastFailure: now},
+			},
+		},
+		{
+			name: "held off by the later of the keys",
+			attempts: map[string]*store.LoginAttempts{
+				"ip#203.0.113.7": {Key: "ip#203.0.113.7", Failures: 8, LastFailure: now.Add(-time.Second)},
+				"customer#12345": {Key: "customer#12345", Failures: 20, LastFailure: now.Add(-time.Minute)},
+			},
+			expectErr: &TooManyAttemptsError{RetryAt: now.Add(59 * time.Minute)},
+		},
+		{
+			name:     "store error",
+			storeErr: errors.New("db error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			attemptStore := NewMockAttemptStore(t)
+			metricsEmitter := NewMockMetricsEmitter(t)
+			if tc.storeErr != nil {
+				attemptStore.EXPECT().GetLoginAttempts(mock.Anything, "ip#203.0.113.7").Return(nil, tc.storeErr)
+			}
+			for key, attempts := range tc.attempts {
+				attemptStore.EXPECT().GetLoginAttempts(mock.Anything, key).Return(attempts, nil)
+			}
+			if tc.expectErr != nil {
+				metricsEmitter.EXPECT().EmitCount(mock.Anything, metrics.LoginAttemptsRefused, 1).Return(nil)
+			}
+
+			tracker := NewAttemptTracker(attemptStore, metricsEmitter, DefaultAttemptPolicy)
+			tracker.now = func() time.Time { return now }
+
+			err := tracker.Check(ctx, "ip#203.0.113.7", "", "customer#12345")
+			switch {
+			case tc.storeErr != nil:
+				assert.ErrorContains(t, err, "getting login attempts: db error")
+			case tc.expectErr != nil:
+				assert.Equal(t, tc.expectErr, err)
+				assert.ErrorIs(t, err, ErrTooManyAttempts)
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAttemptTracker_RecordFailure(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(10000, 0)
+
+	attemptStore := NewMockAttemptStore(t)
+	attemptStore.EXPECT().RecordLoginFailure(mock.Anything, "ip#203.0.113.7", now, time.Hour).Return(&store.LoginAttempts{Key: "ip#203.0.113.7", Failures: 20}, nil)
+	attemptStore.EXPECT().RecordLoginFailure(mock.Anything, "customer#12345", now, time.Hour).Return(&store.LoginAttempts{Key: "customer#12345", Failures: 4}, nil)
+
+	metricsEmitter := NewMockMetricsEmitter(t)
+	metricsEmitter.EXPECT().EmitCount(mock.Anything, metrics.LoginLockouts, 1).Return(nil).Once()
+	metricsEmitter.EXPECT().EmitCount(mock.Anything, metrics.LoginFailures, 1).Return(errors.New("cloudwatch down")).Once()
+
+	tracker := NewAttemptTracker(attemptStore, metricsEmitter, DefaultAttemptPolicy)
+	tracker.now = func() time.Time { return now }
+
+	require.NoError(t, tracker.RecordFailure(ctx, "ip#203.0.113.7", "customer#12345"))
+}
+
+func TestAttemptTracker_RecordFailure_StoreError(t *testing.T) {
+	attemptStore := NewMockAttemptStore(t)
+	attemptStore.EXPECT().RecordLoginFailure(mock.Anything, "ip#203.0.113.7", mock.Anything, time.Hour).Return(nil, errors.New("db error"))
+
+	tracker := NewAttemptTracker(attemptStore, NewMockMetricsEmitter(t), DefaultAttemptPolicy)
+
+	err := tracker.RecordFailure(context.Background(), "ip#203.0.113.7")
+	assert.ErrorContains(t, err, "recording login failure: db error")
+}
+
+func TestAttemptKeys(t *testing.T) {
+	assert.Equal(t, "ip#203.0.113.7", IPAttemptKey("203.0.113.7"))
+	assert.Equal(t, "ip#203.0.113.7", IPAttemptKey("::ffff:203.0.113.7"))
+	assert.Equal(t, "ip#2001:db8:1:2::/64", IPAttemptKey("2001:db8:1:2:3:4:5:6"))
+	assert.Equal(t, "", IPAttemptKey("not an address"))
+	assert.Equal(t, "customer#12345", CustomerAttemptKey(12345))
+}
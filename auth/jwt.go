@@ -2,8 +2,6 @@ package auth
 
 import (
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"encoding/base64"
@@ -11,9 +9,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/jonsabados/saturdaysspinout/tenant"
 )
 
 type EncryptedClaims struct {
@@ -33,47 +34,68 @@ type SessionClaims struct {
 	IRacingUserID   int64           `json:"ir_uid"`
 	IRacingUserName string          `json:"ir_name"`
 	Entitlements    []string        `json:"ent,omitempty"`
+	TenantID        string          `json:"tid,omitempty"` // the only tenant the token is good for, empty for the default
 	Encrypted       EncryptedClaims `json:"encrypted"`
 }
 
-type IDGenerator func() string
+// KeyProvider supplies the keys tokens are issued and validated with.
+type KeyProvider interface {
+	KeyRing(ctx context.Context) (*KeyRing, error)
+	// RefreshKeyRing is called when a token doesn't validate under the current key ring, in case it was issued under
+	// keys rotated in since the ring was loaded.
+	RefreshKeyRing(ctx context.Context) (*KeyRing, error)
+}
 
-type JWTService struct {
-	signingKey    *ecdsa.PrivateKey
-	encryptionKey []byte
-	gcm           cipher.AEAD
-	idGenerator   IDGenerator
-	issuer        string
-	tokenExpiry   time.Duration
+type staticKeys struct {
+	ring *KeyRing
 }
 
-func NewJWTService(signingKey *ecdsa.PrivateKey, encryptionKey []byte, idGenerator IDGenerator, issuer string, tokenExpiry time.Duration) (*JWTService, error) {
-	if len(encryptionKey) != 32 {
-		return nil, fmt.Errorf("encryption key must be 32 bytes, got %d", len(encryptionKey))
-	}
+func (s staticKeys) KeyRing(_ context.Context) (*KeyRing, error) {
+	return s.ring, nil
+}
 
-	block, err := aes.NewCipher(encryptionKey)
-	if err != nil {
-		return nil, fmt.Errorf("creating AES cipher: %w", err)
-	}
+func (s staticKeys) RefreshKeyRing(_ context.Context) (*KeyRing, error) {
+	return s.ring, nil
+}
+
+type JWTService struct {
+	keys        KeyProvider
+	issuer      string
+	tokenExpiry time.Duration
+}
 
-	gcm, err := cipher.NewGCM(block)
+// NewJWTService creates a service issuing and validating tokens with fixed keys.
+func NewJWTService(signingKey *ecdsa.PrivateKey, encryptionKey []byte, issuer string, tokenExpiry time.Duration) (*JWTService, error) {
+	ring, err := NewKeyRing([]*ecdsa.PrivateKey{signingKey}, [][]byte{encryptionKey})
 	if err != nil {
-		return nil, fmt.Errorf("creating GCM: %w", err)
+		return nil, err
 	}
+	return NewRotatingJWTService(staticKeys{ring: ring}, issuer, tokenExpiry), nil
+}
 
+// NewRotatingJWTService creates a service reading its keys from the provider on every use, so rotated keys are picked
+// up without a restart and tokens issued under the keys they replaced stay valid.
+func NewRotatingJWTService(keys KeyProvider, issuer string, tokenExpiry time.Duration) *JWTService {
 	return &JWTService{
-		signingKey:    signingKey,
-		encryptionKey: encryptionKey,
-		gcm:           gcm,
-		idGenerator:   idGenerator,
-		issuer:        issuer,
-		tokenExpiry:   tokenExpiry,
-	}, nil
+		keys:        keys,
+		issuer:      issuer,
+		tokenExpiry: tokenExpiry,
+	}
+}
+
+// Lifetime is how long issued tokens are good for.
+func (s *JWTService) Lifetime() time.Duration {
+	return s.tokenExpiry
 }
 
-func (s *JWTService) CreateToken(_ context.Context, userID int64, userName string, entitlements []string, accessToken, refreshToken string, tokenExpiry time.Time) (string, error) {
-	encryptedClaims, err := s.encryptSensitiveClaims(&SensitiveClaims{
+// CreateToken issues a token for the tenant ctx is for, as part of the given auth session.
+func (s *JWTService) CreateToken(ctx context.Context, sessionID string, userID int64, userName string, entitlements []string, accessToken, refreshToken string, tokenExpiry time.Time) (string, error) {
+	ring, err := s.keys.KeyRing(ctx)
+	if err != nil {
+		return "", fmt.Errorf("loading keys: %w", err)
+	}
+
+	encryptedClaims, err := ring.encryptSensitiveClaims(&SensitiveClaims{
 		IRacingAccessToken:  accessToken,
 		IRacingRefreshToken: refreshToken,
 		IRacingTokenExpiry:  tokenExpiry.Unix(),
@@ -91,16 +113,18 @@ func (s *JWTService) CreateToken(_ context.Context, userID int64, userName strin
 			ExpiresAt: jwt.NewNumericDate(now.Add(s.tokenExpiry)),
 			NotBefore: jwt.NewNumericDate(now),
 		},
-		SessionID:       s.idGenerator(),
+		SessionID:       sessionID,
 		IRacingUserID:   userID,
 		IRacingUserName: userName,
 		Entitlements:    entitlements,
+		TenantID:        tenant.FromContext(ctx),
 		Encrypted:       *encryptedClaims,
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = ring.keyIDs[0]
 
-	signedString, err := token.SignedString(s.signingKey)
+	signedString, err := token.SignedString(ring.signingKeys[0])
 	if err != nil {
 		return "", fmt.Errorf("signing token: %w", err)
 	}
@@ -108,14 +132,53 @@ func (s *JWTService) CreateToken(_ context.Context, userID int64, userName strin
 	return signedString, nil
 }
 
-func (s *JWTService) ValidateToken(_ context.Context, tokenString string) (*SessionClaims, *SensitiveClaims, error) {
+// ValidateToken accepts tokens issued under the current keys or the ones they were rotated from. A token issued under
+// neither gets one retry with a refreshed key ring, covering tokens issued by a process that saw a rotation first.
+func (s *JWTService) ValidateToken(ctx context.Context, tokenString string) (*SessionClaims, *SensitiveClaims, error) {
+	ring, err := s.keys.KeyRing(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading keys: %w", err)
+	}
+
+	claims, sensitiveClaims, err := ring.validateToken(tokenString)
+	if errors.Is(err, errUnknownKey) {
+		refreshed, refreshErr := s.keys.RefreshKeyRing(ctx)
+		if refreshErr != nil {
+			return nil, nil, fmt.Errorf("refreshing keys: %w", refreshErr)
+		}
+		if refreshed != ring {
+			claims, sensitiveClaims, err = refreshed.validateToken(tokenString)
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return claims, sensitiveClaims, nil
+}
+
+// errUnknownKey marks validation failures a key rotation could explain.
+var errUnknownKey = errors.New("not issued under a known key")
+
+func (r *KeyRing) validateToken(tokenString string) (*SessionClaims, *SensitiveClaims, error) {
 	claims := &SessionClaims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return &s.signingKey.PublicKey, nil
+		// tokens from before kid headers were added are tried against every key
+		keyID, ok := token.Header["kid"].(string)
+		if !ok {
+			return r.verificationKeys(), nil
+		}
+		i := slices.Index(r.keyIDs, keyID)
+		if i < 0 {
+			return nil, fmt.Errorf("%w: kid %s", errUnknownKey, keyID)
+		}
+		return &r.signingKeys[i].PublicKey, nil
 	})
+	if errors.Is(err, jwt.ErrTokenSignatureInvalid) && !errors.Is(err, errUnknownKey) {
+		err = fmt.Errorf("%w: %w", errUnknownKey, err)
+	}
 	if err != nil {
 		return nil, nil, fmt.Errorf("parsing token: %w", err)
 	}
@@ -124,7 +187,7 @@ func (s *JWTService) ValidateToken(_ context.Context, tokenString string) (*Sess
 		return nil, nil, errors.New("invalid token")
 	}
 
-	sensitiveClaims, err := s.decryptSensitiveClaims(&claims.Encrypted)
+	sensitiveClaims, err := r.decryptSensitiveClaims(&claims.Encrypted)
 	if err != nil {
 		return nil, nil, fmt.Errorf("decrypting sensitive claims: %w", err)
 	}
@@ -132,18 +195,27 @@ func (s *JWTService) ValidateToken(_ context.Context, tokenString string) (*Sess
 	return claims, sensitiveClaims, nil
 }
 
-func (s *JWTService) encryptSensitiveClaims(claims *SensitiveClaims) (*EncryptedClaims, error) {
+func (r *KeyRing) verificationKeys() jwt.VerificationKeySet {
+	keys := make([]jwt.VerificationKey, len(r.signingKeys))
+	for i, key := range r.signingKeys {
+		keys[i] = &key.PublicKey
+	}
+	return jwt.VerificationKeySet{Keys: keys}
+}
+
+func (r *KeyRing) encryptSensitiveClaims(claims *SensitiveClaims) (*EncryptedClaims, error) {
 	plaintext, err := json.Marshal(claims)
 	if err != nil {
 		return nil, fmt.Errorf("marshaling claims: %w", err)
 	}
 
-	nonce := make([]byte, s.gcm.NonceSize())
+	gcm := r.ciphers[0]
+	nonce := make([]byte, gcm.NonceSize())
 	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
 		return nil, fmt.Errorf("generating nonce: %w", err)
 	}
 
-	ciphertext := s.gcm.Seal(nil, nonce, plaintext, nil)
+	ciphertext := gcm.Seal(nil, nonce, plaintext, nil)
 
 	return &EncryptedClaims{
 		EncryptedData: base64.RawURLEncoding.EncodeToString(ciphertext),
@@ -151,7 +223,7 @@ func (s *JWTService) encryptSensitiveClaims(claims *SensitiveClaims) (*Encrypted
 	}, nil
 }
 
-func (s *JWTService) decryptSensitiveClaims(encrypted *EncryptedClaims) (*SensitiveClaims, error) {
+func (r *KeyRing) decryptSensitiveClaims(encrypted *EncryptedClaims) (*SensitiveClaims, error) {
 	ciphertext, err := base64.RawURLEncoding.DecodeString(encrypted.EncryptedData)
 	if err != nil {
 		return nil, fmt.Errorf("decoding ciphertext: %w", err)
@@ -162,9 +234,16 @@ func (s *JWTService) decryptSensitiveClaims(encrypted *EncryptedClaims) (*Sensit
 		return nil, fmt.Errorf("decoding nonce: %w", err)
 	}
 
-	plaintextBytes, err := s.gcm.Open(nil, nonce, ciphertext, nil)
+	// GCM authenticates, so only the key the claims were encrypted with opens them
+	var plaintextBytes []byte
+	for _, gcm := range r.ciphers {
+		plaintextBytes, err = gcm.Open(nil, nonce, ciphertext, nil)
+		if err == nil {
+			break
+		}
+	}
 	if err != nil {
-		return nil, fmt.Errorf("decrypting: %w", err)
+		return nil, fmt.Errorf("decrypting: %w: %w", errUnknownKey, err)
 	}
 
 	var result SensitiveClaims
@@ -173,4 +252,4 @@ func (s *JWTService) decryptSensitiveClaims(encrypted *EncryptedClaims) (*Sensit
 	}
 
 	return &result, nil
-}
\ No newline at end of file
+}
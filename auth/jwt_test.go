@@ -6,12 +6,18 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/pem"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/secrets"
+	"github.com/jonsabados/saturdaysspinout/tenant"
 )
 
 func TestJWTService_CreateToken(t *testing.T) {
@@ -24,12 +30,11 @@ func TestJWTService_CreateToken(t *testing.T) {
 	_, err = rand.Read(encryptionKey)
 	require.NoError(t, err)
 
-	idGenerator := func() string { return "test-session-id" }
-	service, err := NewJWTService(privateKey, encryptionKey, idGenerator, "test-issuer", time.Hour)
+	service, err := NewJWTService(privateKey, encryptionKey, "test-issuer", time.Hour)
 	require.NoError(t, err)
 
 	tokenExpiry := time.Now().Add(time.Hour)
-	token, err := service.CreateToken(ctx, 12345, "TestDriver", []string{"developer"}, "access-token-123", "refresh-token-456", tokenExpiry)
+	token, err := service.CreateToken(ctx, "test-session-id", 12345, "TestDriver", []string{"developer"}, "access-token-123", "refresh-token-456", tokenExpiry)
 
 	require.NoError(t, err)
 	assert.NotEmpty(t, token)
@@ -47,13 +52,12 @@ func TestJWTService_CreateAndValidateToken(t *testing.T) {
 	_, err = rand.Read(encryptionKey)
 	require.NoError(t, err)
 
-	idGenerator := func() string { return "test-session-id" }
-	service, err := NewJWTService(privateKey, encryptionKey, idGenerator, "test-issuer", time.Hour)
+	service, err := NewJWTService(privateKey, encryptionKey, "test-issuer", time.Hour)
 	require.NoError(t, err)
 
 	// Create token
 	tokenExpiry := time.Now().Add(time.Hour)
-	token, err := service.CreateToken(ctx, 12345, "TestDriver", []string{"developer", "beta"}, "access-token-123", "refresh-token-456", tokenExpiry)
+	token, err := service.CreateToken(ctx, "test-session-id", 12345, "TestDriver", []string{"developer", "beta"}, "access-token-123", "refresh-token-456", tokenExpiry)
 	require.NoError(t, err)
 
 	// Validate token
@@ -67,6 +71,7 @@ func TestJWTService_CreateAndValidateToken(t *testing.T) {
 	assert.Equal(t, "test-issuer", sessionClaims.Issuer)
 	assert.Equal(t, "12345", sessionClaims.Subject)
 	assert.Equal(t, []string{"developer", "beta"}, sessionClaims.Entitlements)
+	assert.Empty(t, sessionClaims.TenantID)
 
 	// Verify sensitive claims were decrypted correctly
 	assert.Equal(t, "access-token-123", sensitiveClaims.IRacingAccessToken)
@@ -74,6 +79,27 @@ func TestJWTService_CreateAndValidateToken(t *testing.T) {
 	assert.Equal(t, tokenExpiry.Unix(), sensitiveClaims.IRacingTokenExpiry)
 }
 
+func TestJWTService_CreateToken_Tenant(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), "league-one")
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	encryptionKey := make([]byte, 32)
+	_, err = rand.Read(encryptionKey)
+	require.NoError(t, err)
+
+	service, err := NewJWTService(privateKey, encryptionKey, "test-issuer", time.Hour)
+	require.NoError(t, err)
+
+	token, err := service.CreateToken(ctx, "test-session-id", 12345, "TestDriver", nil, "access-token-123", "refresh-token-456", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	sessionClaims, _, err := service.ValidateToken(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, "league-one", sessionClaims.TenantID)
+}
+
 func TestJWTService_ValidateToken_InvalidSignature(t *testing.T) {
 	ctx := context.Background()
 
@@ -87,17 +113,15 @@ func TestJWTService_ValidateToken_InvalidSignature(t *testing.T) {
 	_, err = rand.Read(encryptionKey)
 	require.NoError(t, err)
 
-	idGenerator := func() string { return "test-session-id" }
-
 	// Create token with key1
-	service1, err := NewJWTService(privateKey1, encryptionKey, idGenerator, "test-issuer", time.Hour)
+	service1, err := NewJWTService(privateKey1, encryptionKey, "test-issuer", time.Hour)
 	require.NoError(t, err)
 
-	token, err := service1.CreateToken(ctx, 12345, "TestDriver", nil, "access-token", "refresh-token", time.Now().Add(time.Hour))
+	token, err := service1.CreateToken(ctx, "test-session-id", 12345, "TestDriver", nil, "access-token", "refresh-token", time.Now().Add(time.Hour))
 	require.NoError(t, err)
 
 	// Try to validate with key2 (should fail)
-	service2, err := NewJWTService(privateKey2, encryptionKey, idGenerator, "test-issuer", time.Hour)
+	service2, err := NewJWTService(privateKey2, encryptionKey, "test-issuer", time.Hour)
 	require.NoError(t, err)
 
 	_, _, err = service2.ValidateToken(ctx, token)
@@ -116,11 +140,10 @@ func TestJWTService_ValidateToken_Expired(t *testing.T) {
 	require.NoError(t, err)
 
 	// Create service with very short token expiry (negative = already expired)
-	idGenerator := func() string { return "test-session-id" }
-	service, err := NewJWTService(privateKey, encryptionKey, idGenerator, "test-issuer", -time.Hour)
+	service, err := NewJWTService(privateKey, encryptionKey, "test-issuer", -time.Hour)
 	require.NoError(t, err)
 
-	token, err := service.CreateToken(ctx, 12345, "TestDriver", nil, "access-token", "refresh-token", time.Now().Add(time.Hour))
+	token, err := service.CreateToken(ctx, "test-session-id", 12345, "TestDriver", nil, "access-token", "refresh-token", time.Now().Add(time.Hour))
 	require.NoError(t, err)
 
 	_, _, err = service.ValidateToken(ctx, token)
@@ -137,8 +160,7 @@ func TestJWTService_InvalidEncryptionKeyLength(t *testing.T) {
 	_, err = rand.Read(shortKey)
 	require.NoError(t, err)
 
-	idGenerator := func() string { return "test-session-id" }
-	_, err = NewJWTService(privateKey, shortKey, idGenerator, "test-issuer", time.Hour)
+	_, err = NewJWTService(privateKey, shortKey, "test-issuer", time.Hour)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "encryption key must be 32 bytes")
 }
@@ -190,4 +212,160 @@ func TestParseEncryptionKeyBase64(t *testing.T) {
 	_, err = ParseEncryptionKeyBase64(shortKey)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "must be 32 bytes")
-}
\ No newline at end of file
+}
+func TestJWTService_ValidateToken_RotatedKeys(t *testing.T) {
+	ctx := context.Background()
+
+	oldSigningKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	newSigningKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	oldEncryptionKey := make([]byte, 32)
+	_, err = rand.Read(oldEncryptionKey)
+	require.NoError(t, err)
+	newEncryptionKey := make([]byte, 32)
+	_, err = rand.Read(newEncryptionKey)
+	require.NoError(t, err)
+
+	oldService, err := NewJWTService(oldSigningKey, oldEncryptionKey, "test-issuer", time.Hour)
+	require.NoError(t, err)
+	token, err := oldService.CreateToken(ctx, "test-session-id", 12345, "TestDriver", nil, "access-token", "refresh-token", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	// still accepted through the overlap
+	ring, err := NewKeyRing([]*ecdsa.PrivateKey{newSigningKey, oldSigningKey}, [][]byte{newEncryptionKey, oldEncryptionKey})
+	require.NoError(t, err)
+	rotatedService := NewRotatingJWTService(staticKeys{ring: ring}, "test-issuer", time.Hour)
+
+	_, sensitiveClaims, err := rotatedService.ValidateToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", sensitiveClaims.IRacingAccessToken)
+
+	// new tokens are issued under the new keys
+	newToken, err := rotatedService.CreateToken(ctx, "test-session-id", 12345, "TestDriver", nil, "access-token", "refresh-token", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	newOnlyService, err := NewJWTService(newSigningKey, newEncryptionKey, "test-issuer", time.Hour)
+	require.NoError(t, err)
+	_, _, err = newOnlyService.ValidateToken(ctx, newToken)
+	require.NoError(t, err)
+
+	// and once the old keys are dropped, so are their tokens
+	_, _, err = newOnlyService.ValidateToken(ctx, token)
+	assert.ErrorContains(t, err, "parsing token")
+
+	// a rotated encryption key alone still needs the previous one to read the sensitive claims
+	encryptionOnly, err := NewKeyRing([]*ecdsa.PrivateKey{oldSigningKey}, [][]byte{newEncryptionKey})
+	require.NoError(t, err)
+	_, _, err = NewRotatingJWTService(staticKeys{ring: encryptionOnly}, "test-issuer", time.Hour).ValidateToken(ctx, token)
+	assert.ErrorContains(t, err, "decrypting sensitive claims")
+}
+
+func TestJWTService_ValidateToken_RefreshesKeysForUnknownKey(t *testing.T) {
+	ctx := context.Background()
+
+	oldSigningKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	newSigningKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	encryptionKey := make([]byte, 32)
+	_, err = rand.Read(encryptionKey)
+	require.NoError(t, err)
+
+	// another process has already picked up the rotation
+	newService, err := NewJWTService(newSigningKey, encryptionKey, "test-issuer", time.Hour)
+	require.NoError(t, err)
+	token, err := newService.CreateToken(ctx, "test-session-id", 12345, "TestDriver", nil, "access-token", "refresh-token", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	encryptionValue := secrets.Value{Current: base64.StdEncoding.EncodeToString(encryptionKey)}
+	source := NewMockSecretSource(t)
+	source.EXPECT().Get(mock.Anything, "signing").Return(secrets.Value{Current: signingKeyPEM(t, oldSigningKey)}, nil).Once()
+	source.EXPECT().Get(mock.Anything, "encryption").Return(encryptionValue, nil).Once()
+	source.EXPECT().Refresh(mock.Anything, "signing").Return(secrets.Value{Current: signingKeyPEM(t, newSigningKey), Previous: signingKeyPEM(t, oldSigningKey)}, nil).Once()
+	source.EXPECT().Refresh(mock.Anything, "encryption").Return(encryptionValue, nil).Once()
+
+	service := NewRotatingJWTService(NewSecretsKeyProvider(source, "signing", "encryption"), "test-issuer", time.Hour)
+
+	sessionClaims, _, err := service.ValidateToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12345), sessionClaims.IRacingUserID)
+}
+
+func signingKeyPEM(t *testing.T, key *ecdsa.PrivateKey) string {
+	derBytes, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: derBytes}))
+}
+
+func TestJWTService_KeyIDHeader(t *testing.T) {
+	ctx := context.Background()
+
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	encryptionKey := make([]byte, 32)
+	_, err = rand.Read(encryptionKey)
+	require.NoError(t, err)
+
+	service, err := NewJWTService(signingKey, encryptionKey, "test-issuer", time.Hour)
+	require.NoError(t, err)
+
+	token, err := service.CreateToken(ctx, "test-session-id", 12345, "TestDriver", nil, "access-token", "refresh-token", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &SessionClaims{})
+	require.NoError(t, err)
+	expectedKeyID, err := KeyID(&signingKey.PublicKey)
+	require.NoError(t, err)
+	assert.Equal(t, expectedKeyID, parsed.Header["kid"])
+
+	// a ring holding the key in any position picks it out by kid
+	ring, err := NewKeyRing([]*ecdsa.PrivateKey{otherKey, signingKey}, [][]byte{encryptionKey})
+	require.NoError(t, err)
+	_, _, err = NewRotatingJWTService(staticKeys{ring: ring}, "test-issuer", time.Hour).ValidateToken(ctx, token)
+	require.NoError(t, err)
+
+	ring, err = NewKeyRing([]*ecdsa.PrivateKey{otherKey}, [][]byte{encryptionKey})
+	require.NoError(t, err)
+	_, _, err = NewRotatingJWTService(staticKeys{ring: ring}, "test-issuer", time.Hour).ValidateToken(ctx, token)
+	assert.ErrorContains(t, err, "not issued under a known key")
+}
+
+func TestJWTService_ValidateToken_WithoutKeyID(t *testing.T) {
+	ctx := context.Background()
+
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	encryptionKey := make([]byte, 32)
+	_, err = rand.Read(encryptionKey)
+	require.NoError(t, err)
+
+	ring, err := NewKeyRing([]*ecdsa.PrivateKey{otherKey, signingKey}, [][]byte{encryptionKey})
+	require.NoError(t, err)
+	encrypted, err := ring.encryptSensitiveClaims(&SensitiveClaims{IRacingAccessToken: "access-token"})
+	require.NoError(t, err)
+
+	// issued before kid headers were added
+	now := time.Now()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, SessionClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "test-issuer",
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+		IRacingUserID: 12345,
+		Encrypted:     *encrypted,
+	}).SignedString(signingKey)
+	require.NoError(t, err)
+
+	service := NewRotatingJWTService(staticKeys{ring: ring}, "test-issuer", time.Hour)
+	sessionClaims, sensitiveClaims, err := service.ValidateToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12345), sessionClaims.IRacingUserID)
+	assert.Equal(t, "access-token", sensitiveClaims.IRacingAccessToken)
+}
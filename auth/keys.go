@@ -1,13 +1,65 @@
 package auth
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
 	"crypto/ecdsa"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
+	"errors"
 	"fmt"
+	"slices"
 )
 
+// KeyRing holds the keys tokens are issued under, along with any they were rotated from which tokens issued before the
+// rotation are still validated with.
+type KeyRing struct {
+	signingKeys []*ecdsa.PrivateKey // current first
+	keyIDs      []string            // matching signingKeys
+	ciphers     []cipher.AEAD       // current first
+}
+
+// NewKeyRing creates a key ring issuing tokens with the first of each of the keys and accepting any of them. Repeated
+// signing keys are dropped.
+func NewKeyRing(signingKeys []*ecdsa.PrivateKey, encryptionKeys [][]byte) (*KeyRing, error) {
+	if len(signingKeys) == 0 || len(encryptionKeys) == 0 {
+		return nil, errors.New("key ring needs at least one signing and one encryption key")
+	}
+
+	ring := &KeyRing{}
+	for _, signingKey := range signingKeys {
+		keyID, err := KeyID(&signingKey.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(ring.keyIDs, keyID) {
+			continue
+		}
+		ring.signingKeys = append(ring.signingKeys, signingKey)
+		ring.keyIDs = append(ring.keyIDs, keyID)
+	}
+
+	for _, encryptionKey := range encryptionKeys {
+		if len(encryptionKey) != 32 {
+			return nil, fmt.Errorf("encryption key must be 32 bytes, got %d", len(encryptionKey))
+		}
+
+		block, err := aes.NewCipher(encryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("creating AES cipher: %w", err)
+		}
+
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("creating GCM: %w", err)
+		}
+		ring.ciphers = append(ring.ciphers, gcm)
+	}
+
+	return ring, nil
+}
+
 func ParseSigningKeyPEM(pemData []byte) (*ecdsa.PrivateKey, error) {
 	block, _ := pem.Decode(pemData)
 	if block == nil {
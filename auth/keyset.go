@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+// KeySet is the signing key secret's form once keys are rotated with the rotate-jwt-keys command, holding the key new
+// tokens are signed with followed by those it replaced, which tokens are still verified with. A secret holding a
+// single PEM key, as terraform seeds it, reads as a set of just that key.
+type KeySet struct {
+	Keys []SetKey `json:"keys"` // current first
+}
+
+type SetKey struct {
+	PrivateKeyPEM string    `json:"privateKeyPem"`
+	CreatedAt     time.Time `json:"createdAt"` // zero for a key carried over from a single PEM key
+}
+
+// ParseKeySet reads a signing key secret, either a KeySet or a single PEM key.
+func ParseKeySet(value string) (KeySet, error) {
+	trimmed := bytes.TrimSpace([]byte(value))
+	if bytes.HasPrefix(trimmed, []byte("-----BEGIN")) {
+		return KeySet{Keys: []SetKey{{PrivateKeyPEM: value}}}, nil
+	}
+
+	var set KeySet
+	if err := json.Unmarshal(trimmed, &set); err != nil {
+		return KeySet{}, fmt.Errorf("unmarshaling key set: %w", err)
+	}
+	if len(set.Keys) == 0 {
+		return KeySet{}, errors.New("key set has no keys")
+	}
+	return set, nil
+}
+
+// SigningKeys parses the set's keys, current first.
+func (k KeySet) SigningKeys() ([]*ecdsa.PrivateKey, error) {
+	keys := make([]*ecdsa.PrivateKey, len(k.Keys))
+	for i, setKey := range k.Keys {
+		key, err := ParseSigningKeyPEM([]byte(setKey.PrivateKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("parsing key %d: %w", i, err)
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+// Rotate returns the set with the new key made current, keeping the keys that were current within retain of now so
+// tokens they signed keep validating until they expire. The key being replaced is always kept.
+func (k KeySet) Rotate(newKey *ecdsa.PrivateKey, now time.Time, retain time.Duration) (KeySet, error) {
+	derBytes, err := x509.MarshalECPrivateKey(newKey)
+	if err != nil {
+		return KeySet{}, fmt.Errorf("marshaling key: %w", err)
+	}
+
+	rotated := KeySet{Keys: []SetKey{{
+		PrivateKeyPEM: string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: derBytes})),
+		CreatedAt:     now,
+	}}}
+	for i, setKey := range k.Keys {
+		// a key stopped being current when the one ahead of it was created
+		if i > 0 && now.Sub(k.Keys[i-1].CreatedAt) > retain {
+			break
+		}
+		rotated.Keys = append(rotated.Keys, setKey)
+	}
+	return rotated, nil
+}
+
+// KeyID identifies a signing key in the kid header of the tokens it signs, derived from the public key so every process
+// agrees on it without it being stored anywhere.
+func KeyID(key *ecdsa.PublicKey) (string, error) {
+	derBytes, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", fmt.Errorf("marshaling public key: %w", err)
+	}
+	sum := sha256.Sum256(derBytes)
+	return base64.RawURLEncoding.EncodeToString(sum[:12]), nil
+}
+
+// KeySetSecretStore defines the Secrets Manager calls rotating the signing key secret needs.
+type KeySetSecretStore interface {
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+	PutSecretValue(ctx context.Context, params *secretsmanager.PutSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error)
+}
+
+type RotationResult struct {
+	CurrentKeyID   string   `json:"currentKeyId"`
+	RetainedKeyIDs []string `json:"retainedKeyIds"`
+	DroppedKeyIDs  []string `json:"droppedKeyIds"`
+}
+
+// RotateSigningKeySecret generates a new signing key and stores it as the current key of the secret's key set, keeping
+// the keys current within retain so no session is cut short. Processes pick the new key up as their cached copy of the
+// secret expires, validating tokens signed with it in the meantime by refreshing early.
+func RotateSigningKeySecret(ctx context.Context, client KeySetSecretStore, secretID string, retain time.Duration, now time.Time) (RotationResult, error) {
+	current, err := client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	})
+	if err != nil {
+		return RotationResult{}, fmt.Errorf("getting key set: %w", err)
+	}
+	set, err := ParseKeySet(aws.ToString(current.SecretString))
+	if err != nil {
+		return RotationResult{}, fmt.Errorf("parsing key set: %w", err)
+	}
+	// refusing to rotate away from a key that doesn't parse rather than carrying it along
+	oldKeys, err := set.SigningKeys()
+	if err != nil {
+		return RotationResult{}, fmt.Errorf("parsing key set: %w", err)
+	}
+
+	newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return RotationResult{}, fmt.Errorf("generating key: %w", err)
+	}
+	rotated, err := set.Rotate(newKey, now, retain)
+	if err != nil {
+		return RotationResult{}, err
+	}
+
+	result := RotationResult{
+		RetainedKeyIDs: []string{},
+		DroppedKeyIDs:  []string{},
+	}
+	if result.CurrentKeyID, err = KeyID(&newKey.PublicKey); err != nil {
+		return RotationResult{}, err
+	}
+	for i, key := range oldKeys {
+		keyID, err := KeyID(&key.PublicKey)
+		if err != nil {
+			return RotationResult{}, err
+		}
+		// Rotate keeps a leading run of the old keys
+		if i+1 < len(rotated.Keys) {
+			result.RetainedKeyIDs = append(result.RetainedKeyIDs, keyID)
+		} else {
+			result.DroppedKeyIDs = append(result.DroppedKeyIDs, keyID)
+		}
+	}
+
+	value, err := json.Marshal(rotated)
+	if err != nil {
+		return RotationResult{}, fmt.Errorf("marshaling key set: %w", err)
+	}
+	_, err = client.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(secretID),
+		SecretString: aws.String(string(value)),
+	})
+	if err != nil {
+		return RotationResult{}, fmt.Errorf("storing key set: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeySet(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keyPEM := signingKeyPEM(t, key)
+
+	// a single PEM key, as terraform seeds the secret
+	set, err := ParseKeySet(keyPEM)
+	require.NoError(t, err)
+	keys, err := set.SigningKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.True(t, key.Equal(keys[0]))
+
+	createdAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	value, err := json.Marshal(KeySet{Keys: []SetKey{{PrivateKeyPEM: keyPEM, CreatedAt: createdAt}}})
+	require.NoError(t, err)
+	set, err = ParseKeySet(string(value))
+	require.NoError(t, err)
+	assert.Equal(t, KeySet{Keys: []SetKey{{PrivateKeyPEM: keyPEM, CreatedAt: createdAt}}}, set)
+
+	_, err = ParseKeySet(`{"keys":[]}`)
+	assert.ErrorContains(t, err, "key set has no keys")
+
+	_, err = ParseKeySet("not a key")
+	assert.ErrorContains(t, err, "unmarshaling key set")
+
+	set, err = ParseKeySet(`{"keys":[{"privateKeyPem":"not a key"}]}`)
+	require.NoError(t, err)
+	_, err = set.SigningKeys()
+	assert.ErrorContains(t, err, "parsing key 0")
+}
+
+func TestKeySet_Rotate(t *testing.T) {
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	retain := 48 * time.Hour
+
+	set := KeySet{Keys: []SetKey{
+		{PrivateKeyPEM: "current", CreatedAt: now.Add(-24 * time.Hour)},
+		// replaced a day ago, still within retain
+		{PrivateKeyPEM: "previous", CreatedAt: now.Add(-10 * 24 * time.Hour)},
+		// replaced ten days ago
+		{PrivateKeyPEM: "expired", CreatedAt: now.Add(-20 * 24 * time.Hour)},
+	}}
+
+	newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	rotated, err := set.Rotate(newKey, now, retain)
+	require.NoError(t, err)
+	require.Len(t, rotated.Keys, 3)
+	assert.Equal(t, signingKeyPEM(t, newKey), rotated.Keys[0].PrivateKeyPEM)
+	assert.Equal(t, now, rotated.Keys[0].CreatedAt)
+	assert.Equal(t, set.Keys[:2], rotated.Keys[1:])
+
+	// the key being replaced is kept even with nothing to say when it was created
+	legacy := KeySet{Keys: []SetKey{{PrivateKeyPEM: "legacy"}}}
+	rotated, err = legacy.Rotate(newKey, now, retain)
+	require.NoError(t, err)
+	assert.Equal(t, legacy.Keys, rotated.Keys[1:])
+}
+
+func TestRotateSigningKeySecret(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	currentKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	expiredKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	currentKeyID, err := KeyID(&currentKey.PublicKey)
+	require.NoError(t, err)
+	expiredKeyID, err := KeyID(&expiredKey.PublicKey)
+	require.NoError(t, err)
+
+	existing, err := json.Marshal(KeySet{Keys: []SetKey{
+		{PrivateKeyPEM: signingKeyPEM(t, currentKey), CreatedAt: now.Add(-30 * 24 * time.Hour)},
+		{PrivateKeyPEM: signingKeyPEM(t, expiredKey), CreatedAt: now.Add(-60 * 24 * time.Hour)},
+	}})
+	require.NoError(t, err)
+
+	client := NewMockKeySetSecretStore(t)
+	client.EXPECT().GetSecretValue(mock.Anything, &secretsmanager.GetSecretValueInput{SecretId: aws.String("signing")}).
+		Return(&secretsmanager.GetSecretValueOutput{SecretString: aws.String(string(existing))}, nil)
+
+	var stored KeySet
+	client.EXPECT().PutSecretValue(mock.Anything, mock.MatchedBy(func(input *secretsmanager.PutSecretValueInput) bool {
+		return aws.ToString(input.SecretId) == "signing"
+	})).RunAndReturn(func(_ context.Context, input *secretsmanager.PutSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error) {
+		require.NoError(t, json.Unmarshal([]byte(aws.ToString(input.SecretString)), &stored))
+		return &secretsmanager.PutSecretValueOutput{}, nil
+	})
+
+	result, err := RotateSigningKeySecret(ctx, client, "signing", 48*time.Hour, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{currentKeyID}, result.RetainedKeyIDs)
+	assert.Equal(t, []string{expiredKeyID}, result.DroppedKeyIDs)
+
+	keys, err := stored.SigningKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	newKeyID, err := KeyID(&keys[0].PublicKey)
+	require.NoError(t, err)
+	assert.Equal(t, result.CurrentKeyID, newKeyID)
+	assert.True(t, currentKey.Equal(keys[1]))
+}
+
+func TestRotateSigningKeySecret_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	client := NewMockKeySetSecretStore(t)
+	client.EXPECT().GetSecretValue(mock.Anything, mock.Anything).Return(nil, errors.New("access denied")).Once()
+	_, err := RotateSigningKeySecret(ctx, client, "signing", 48*time.Hour, time.Now())
+	assert.ErrorContains(t, err, "getting key set: access denied")
+
+	// nothing is stored when the existing keys don't parse
+	client.EXPECT().GetSecretValue(mock.Anything, mock.Anything).
+		Return(&secretsmanager.GetSecretValueOutput{SecretString: aws.String(`{"keys":[{"privateKeyPem":"not a key"}]}`)}, nil).Once()
+	_, err = RotateSigningKeySecret(ctx, client, "signing", 48*time.Hour, time.Now())
+	assert.ErrorContains(t, err, "parsing key set")
+}
@@ -39,24 +39,98 @@ func (_m *MockDriverStore) EXPECT() *MockDriverStore_Expecter {
 	return &MockDriverStore_Expecter{mock: &_m.Mock}
 }
 
-// GetDriver provides a mock function for the type MockDriverStore
-func (_mock *MockDriverStore) GetDriver(ctx context.Context, driverID int64) (*store.Driver, error) {
+// GetAuthSession provides a mock function for the type MockDriverStore
+func (_mock *MockDriverStore) GetAuthSession(ctx context.Context, driverID int64, sessionID string) (*store.AuthSession, error) {
+	ret := _mock.Called(ctx, driverID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthSession")
+	}
+
+	var r0 *store.AuthSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) (*store.AuthSession, error)); ok {
+		return returnFunc(ctx, driverID, sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) *store.AuthSession); ok {
+		r0 = returnFunc(ctx, driverID, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.AuthSession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = returnFunc(ctx, driverID, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDriverStore_GetAuthSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthSession'
+type MockDriverStore_GetAuthSession_Call struct {
+	*mock.Call
+}
+
+// GetAuthSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - sessionID string
+func (_e *MockDriverStore_Expecter) GetAuthSession(ctx interface{}, driverID interface{}, sessionID interface{}) *MockDriverStore_GetAuthSession_Call {
+	return &MockDriverStore_GetAuthSession_Call{Call: _e.mock.On("GetAuthSession", ctx, driverID, sessionID)}
+}
+
+func (_c *MockDriverStore_GetAuthSession_Call) Run(run func(ctx context.Context, driverID int64, sessionID string)) *MockDriverStore_GetAuthSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDriverStore_GetAuthSession_Call) Return(authSession *store.AuthSession, err error) *MockDriverStore_GetAuthSession_Call {
+	_c.Call.Return(authSession, err)
+	return _c
+}
+
+func (_c *MockDriverStore_GetAuthSession_Call) RunAndReturn(run func(ctx context.Context, driverID int64, sessionID string) (*store.AuthSession, error)) *MockDriverStore_GetAuthSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthSessions provides a mock function for the type MockDriverStore
+func (_mock *MockDriverStore) GetAuthSessions(ctx context.Context, driverID int64) ([]store.AuthSession, error) {
 	ret := _mock.Called(ctx, driverID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDriver")
+		panic("no return value specified for GetAuthSessions")
 	}
 
-	var r0 *store.Driver
+	var r0 []store.AuthSession
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*store.Driver, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]store.AuthSession, error)); ok {
 		return returnFunc(ctx, driverID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *store.Driver); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []store.AuthSession); ok {
 		r0 = returnFunc(ctx, driverID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*store.Driver)
+			r0 = ret.Get(0).([]store.AuthSession)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
@@ -67,6 +141,80 @@ func (_mock *MockDriverStore) GetDriver(ctx context.Context, driverID int64) (*s
 	return r0, r1
 }
 
+// MockDriverStore_GetAuthSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthSessions'
+type MockDriverStore_GetAuthSessions_Call struct {
+	*mock.Call
+}
+
+// GetAuthSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+func (_e *MockDriverStore_Expecter) GetAuthSessions(ctx interface{}, driverID interface{}) *MockDriverStore_GetAuthSessions_Call {
+	return &MockDriverStore_GetAuthSessions_Call{Call: _e.mock.On("GetAuthSessions", ctx, driverID)}
+}
+
+func (_c *MockDriverStore_GetAuthSessions_Call) Run(run func(ctx context.Context, driverID int64)) *MockDriverStore_GetAuthSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDriverStore_GetAuthSessions_Call) Return(authSessions []store.AuthSession, err error) *MockDriverStore_GetAuthSessions_Call {
+	_c.Call.Return(authSessions, err)
+	return _c
+}
+
+func (_c *MockDriverStore_GetAuthSessions_Call) RunAndReturn(run func(ctx context.Context, driverID int64) ([]store.AuthSession, error)) *MockDriverStore_GetAuthSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDriver provides a mock function for the type MockDriverStore
+func (_mock *MockDriverStore) GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDriver")
+	}
+
+	var r0 *store.Driver
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) (*store.Driver, error)); ok {
+		return returnFunc(ctx, driverID, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) *store.Driver); ok {
+		r0 = returnFunc(ctx, driverID, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.Driver)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
 // MockDriverStore_GetDriver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDriver'
 type MockDriverStore_GetDriver_Call struct {
 	*mock.Call
@@ -75,11 +223,13 @@ type MockDriverStore_GetDriver_Call struct {
 // GetDriver is a helper method to define mock.On call
 //   - ctx context.Context
 //   - driverID int64
-func (_e *MockDriverStore_Expecter) GetDriver(ctx interface{}, driverID interface{}) *MockDriverStore_GetDriver_Call {
-	return &MockDriverStore_GetDriver_Call{Call: _e.mock.On("GetDriver", ctx, driverID)}
+//   - opts ...store.ReadOption
+func (_e *MockDriverStore_Expecter) GetDriver(ctx interface{}, driverID interface{}, opts ...interface{}) *MockDriverStore_GetDriver_Call {
+	return &MockDriverStore_GetDriver_Call{Call: _e.mock.On("GetDriver",
+		append([]interface{}{ctx, driverID}, opts...)...)}
 }
 
-func (_c *MockDriverStore_GetDriver_Call) Run(run func(ctx context.Context, driverID int64)) *MockDriverStore_GetDriver_Call {
+func (_c *MockDriverStore_GetDriver_Call) Run(run func(ctx context.Context, driverID int64, opts ...store.ReadOption)) *MockDriverStore_GetDriver_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -89,9 +239,16 @@ func (_c *MockDriverStore_GetDriver_Call) Run(run func(ctx context.Context, driv
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]store.ReadOption)
+		}
+		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
+			arg2...,
 		)
 	})
 	return _c
@@ -102,7 +259,7 @@ func (_c *MockDriverStore_GetDriver_Call) Return(driver *store.Driver, err error
 	return _c
 }
 
-func (_c *MockDriverStore_GetDriver_Call) RunAndReturn(run func(ctx context.Context, driverID int64) (*store.Driver, error)) *MockDriverStore_GetDriver_Call {
+func (_c *MockDriverStore_GetDriver_Call) RunAndReturn(run func(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)) *MockDriverStore_GetDriver_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -226,3 +383,285 @@ func (_c *MockDriverStore_RecordLogin_Call) RunAndReturn(run func(ctx context.Co
 	_c.Call.Return(run)
 	return _c
 }
+
+// RevokeAuthSession provides a mock function for the type MockDriverStore
+func (_mock *MockDriverStore) RevokeAuthSession(ctx context.Context, driverID int64, sessionID string, revokedAt time.Time) (bool, error) {
+	ret := _mock.Called(ctx, driverID, sessionID, revokedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAuthSession")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, driverID, sessionID, revokedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) bool); ok {
+		r0 = returnFunc(ctx, driverID, sessionID, revokedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, driverID, sessionID, revokedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDriverStore_RevokeAuthSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAuthSession'
+type MockDriverStore_RevokeAuthSession_Call struct {
+	*mock.Call
+}
+
+// RevokeAuthSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - sessionID string
+//   - revokedAt time.Time
+func (_e *MockDriverStore_Expecter) RevokeAuthSession(ctx interface{}, driverID interface{}, sessionID interface{}, revokedAt interface{}) *MockDriverStore_RevokeAuthSession_Call {
+	return &MockDriverStore_RevokeAuthSession_Call{Call: _e.mock.On("RevokeAuthSession", ctx, driverID, sessionID, revokedAt)}
+}
+
+func (_c *MockDriverStore_RevokeAuthSession_Call) Run(run func(ctx context.Context, driverID int64, sessionID string, revokedAt time.Time)) *MockDriverStore_RevokeAuthSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDriverStore_RevokeAuthSession_Call) Return(b bool, err error) *MockDriverStore_RevokeAuthSession_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockDriverStore_RevokeAuthSession_Call) RunAndReturn(run func(ctx context.Context, driverID int64, sessionID string, revokedAt time.Time) (bool, error)) *MockDriverStore_RevokeAuthSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveAuthSession provides a mock function for the type MockDriverStore
+func (_mock *MockDriverStore) SaveAuthSession(ctx context.Context, session store.AuthSession) (bool, error) {
+	ret := _mock.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAuthSession")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.AuthSession) (bool, error)); ok {
+		return returnFunc(ctx, session)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.AuthSession) bool); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.AuthSession) error); ok {
+		r1 = returnFunc(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDriverStore_SaveAuthSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAuthSession'
+type MockDriverStore_SaveAuthSession_Call struct {
+	*mock.Call
+}
+
+// SaveAuthSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session store.AuthSession
+func (_e *MockDriverStore_Expecter) SaveAuthSession(ctx interface{}, session interface{}) *MockDriverStore_SaveAuthSession_Call {
+	return &MockDriverStore_SaveAuthSession_Call{Call: _e.mock.On("SaveAuthSession", ctx, session)}
+}
+
+func (_c *MockDriverStore_SaveAuthSession_Call) Run(run func(ctx context.Context, session store.AuthSession)) *MockDriverStore_SaveAuthSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.AuthSession
+		if args[1] != nil {
+			arg1 = args[1].(store.AuthSession)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDriverStore_SaveAuthSession_Call) Return(b bool, err error) *MockDriverStore_SaveAuthSession_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockDriverStore_SaveAuthSession_Call) RunAndReturn(run func(ctx context.Context, session store.AuthSession) (bool, error)) *MockDriverStore_SaveAuthSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDriverClub provides a mock function for the type MockDriverStore
+func (_mock *MockDriverStore) UpdateDriverClub(ctx context.Context, driverID int64, clubID int, clubName string) error {
+	ret := _mock.Called(ctx, driverID, clubID, clubName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDriverClub")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, string) error); ok {
+		r0 = returnFunc(ctx, driverID, clubID, clubName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDriverStore_UpdateDriverClub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDriverClub'
+type MockDriverStore_UpdateDriverClub_Call struct {
+	*mock.Call
+}
+
+// UpdateDriverClub is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - clubID int
+//   - clubName string
+func (_e *MockDriverStore_Expecter) UpdateDriverClub(ctx interface{}, driverID interface{}, clubID interface{}, clubName interface{}) *MockDriverStore_UpdateDriverClub_Call {
+	return &MockDriverStore_UpdateDriverClub_Call{Call: _e.mock.On("UpdateDriverClub", ctx, driverID, clubID, clubName)}
+}
+
+func (_c *MockDriverStore_UpdateDriverClub_Call) Run(run func(ctx context.Context, driverID int64, clubID int, clubName string)) *MockDriverStore_UpdateDriverClub_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDriverStore_UpdateDriverClub_Call) Return(err error) *MockDriverStore_UpdateDriverClub_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDriverStore_UpdateDriverClub_Call) RunAndReturn(run func(ctx context.Context, driverID int64, clubID int, clubName string) error) *MockDriverStore_UpdateDriverClub_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDriverName provides a mock function for the type MockDriverStore
+func (_mock *MockDriverStore) UpdateDriverName(ctx context.Context, driverID int64, previousName string, name string) error {
+	ret := _mock.Called(ctx, driverID, previousName, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDriverName")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string) error); ok {
+		r0 = returnFunc(ctx, driverID, previousName, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDriverStore_UpdateDriverName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDriverName'
+type MockDriverStore_UpdateDriverName_Call struct {
+	*mock.Call
+}
+
+// UpdateDriverName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - previousName string
+//   - name string
+func (_e *MockDriverStore_Expecter) UpdateDriverName(ctx interface{}, driverID interface{}, previousName interface{}, name interface{}) *MockDriverStore_UpdateDriverName_Call {
+	return &MockDriverStore_UpdateDriverName_Call{Call: _e.mock.On("UpdateDriverName", ctx, driverID, previousName, name)}
+}
+
+func (_c *MockDriverStore_UpdateDriverName_Call) Run(run func(ctx context.Context, driverID int64, previousName string, name string)) *MockDriverStore_UpdateDriverName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDriverStore_UpdateDriverName_Call) Return(err error) *MockDriverStore_UpdateDriverName_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDriverStore_UpdateDriverName_Call) RunAndReturn(run func(ctx context.Context, driverID int64, previousName string, name string) error) *MockDriverStore_UpdateDriverName_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,8 +39,8 @@ func (_m *MockJWTCreator) EXPECT() *MockJWTCreator_Expecter {
 }
 
 // CreateToken provides a mock function for the type MockJWTCreator
-func (_mock *MockJWTCreator) CreateToken(ctx context.Context, userID int64, userName string, entitlements []string, accessToken string, refreshToken string, tokenExpiry time.Time) (string, error) {
-	ret := _mock.Called(ctx, userID, userName, entitlements, accessToken, refreshToken, tokenExpiry)
+func (_mock *MockJWTCreator) CreateToken(ctx context.Context, sessionID string, userID int64, userName string, entitlements []string, accessToken string, refreshToken string, tokenExpiry time.Time) (string, error) {
+	ret := _mock.Called(ctx, sessionID, userID, userName, entitlements, accessToken, refreshToken, tokenExpiry)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateToken")
@@ -48,16 +48,16 @@ func (_mock *MockJWTCreator) CreateToken(ctx context.Context, userID int64, user
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, []string, string, string, time.Time) (string, error)); ok {
-		return returnFunc(ctx, userID, userName, entitlements, accessToken, refreshToken, tokenExpiry)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, string, []string, string, string, time.Time) (string, error)); ok {
+		return returnFunc(ctx, sessionID, userID, userName, entitlements, accessToken, refreshToken, tokenExpiry)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, []string, string, string, time.Time) string); ok {
-		r0 = returnFunc(ctx, userID, userName, entitlements, accessToken, refreshToken, tokenExpiry)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, string, []string, string, string, time.Time) string); ok {
+		r0 = returnFunc(ctx, sessionID, userID, userName, entitlements, accessToken, refreshToken, tokenExpiry)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, []string, string, string, time.Time) error); ok {
-		r1 = returnFunc(ctx, userID, userName, entitlements, accessToken, refreshToken, tokenExpiry)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int64, string, []string, string, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, sessionID, userID, userName, entitlements, accessToken, refreshToken, tokenExpiry)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -71,45 +71,50 @@ type MockJWTCreator_CreateToken_Call struct {
 
 // CreateToken is a helper method to define mock.On call
 //   - ctx context.Context
+//   - sessionID string
 //   - userID int64
 //   - userName string
 //   - entitlements []string
 //   - accessToken string
 //   - refreshToken string
 //   - tokenExpiry time.Time
-func (_e *MockJWTCreator_Expecter) CreateToken(ctx interface{}, userID interface{}, userName interface{}, entitlements interface{}, accessToken interface{}, refreshToken interface{}, tokenExpiry interface{}) *MockJWTCreator_CreateToken_Call {
-	return &MockJWTCreator_CreateToken_Call{Call: _e.mock.On("CreateToken", ctx, userID, userName, entitlements, accessToken, refreshToken, tokenExpiry)}
+func (_e *MockJWTCreator_Expecter) CreateToken(ctx interface{}, sessionID interface{}, userID interface{}, userName interface{}, entitlements interface{}, accessToken interface{}, refreshToken interface{}, tokenExpiry interface{}) *MockJWTCreator_CreateToken_Call {
+	return &MockJWTCreator_CreateToken_Call{Call: _e.mock.On("CreateToken", ctx, sessionID, userID, userName, entitlements, accessToken, refreshToken, tokenExpiry)}
 }
 
-func (_c *MockJWTCreator_CreateToken_Call) Run(run func(ctx context.Context, userID int64, userName string, entitlements []string, accessToken string, refreshToken string, tokenExpiry time.Time)) *MockJWTCreator_CreateToken_Call {
+func (_c *MockJWTCreator_CreateToken_Call) Run(run func(ctx context.Context, sessionID string, userID int64, userName string, entitlements []string, accessToken string, refreshToken string, tokenExpiry time.Time)) *MockJWTCreator_CreateToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 int64
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(int64)
 		}
-		var arg3 []string
+		var arg3 string
 		if args[3] != nil {
-			arg3 = args[3].([]string)
+			arg3 = args[3].(string)
 		}
-		var arg4 string
+		var arg4 []string
 		if args[4] != nil {
-			arg4 = args[4].(string)
+			arg4 = args[4].([]string)
 		}
 		var arg5 string
 		if args[5] != nil {
 			arg5 = args[5].(string)
 		}
-		var arg6 time.Time
+		var arg6 string
 		if args[6] != nil {
-			arg6 = args[6].(time.Time)
+			arg6 = args[6].(string)
+		}
+		var arg7 time.Time
+		if args[7] != nil {
+			arg7 = args[7].(time.Time)
 		}
 		run(
 			arg0,
@@ -119,6 +124,7 @@ func (_c *MockJWTCreator_CreateToken_Call) Run(run func(ctx context.Context, use
 			arg4,
 			arg5,
 			arg6,
+			arg7,
 		)
 	})
 	return _c
@@ -129,7 +135,51 @@ func (_c *MockJWTCreator_CreateToken_Call) Return(s string, err error) *MockJWTC
 	return _c
 }
 
-func (_c *MockJWTCreator_CreateToken_Call) RunAndReturn(run func(ctx context.Context, userID int64, userName string, entitlements []string, accessToken string, refreshToken string, tokenExpiry time.Time) (string, error)) *MockJWTCreator_CreateToken_Call {
+func (_c *MockJWTCreator_CreateToken_Call) RunAndReturn(run func(ctx context.Context, sessionID string, userID int64, userName string, entitlements []string, accessToken string, refreshToken string, tokenExpiry time.Time) (string, error)) *MockJWTCreator_CreateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lifetime provides a mock function for the type MockJWTCreator
+func (_mock *MockJWTCreator) Lifetime() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lifetime")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockJWTCreator_Lifetime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lifetime'
+type MockJWTCreator_Lifetime_Call struct {
+	*mock.Call
+}
+
+// Lifetime is a helper method to define mock.On call
+func (_e *MockJWTCreator_Expecter) Lifetime() *MockJWTCreator_Lifetime_Call {
+	return &MockJWTCreator_Lifetime_Call{Call: _e.mock.On("Lifetime")}
+}
+
+func (_c *MockJWTCreator_Lifetime_Call) Run(run func()) *MockJWTCreator_Lifetime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockJWTCreator_Lifetime_Call) Return(duration time.Duration) *MockJWTCreator_Lifetime_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockJWTCreator_Lifetime_Call) RunAndReturn(run func() time.Duration) *MockJWTCreator_Lifetime_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/secrets"
+)
+
+// SecretSource defines the cached secret reads the key provider needs.
+type SecretSource interface {
+	Get(ctx context.Context, secretID string) (secrets.Value, error)
+	Refresh(ctx context.Context, secretID string) (secrets.Value, error)
+}
+
+// SecretsKeyProvider builds key rings from the Secrets Manager secrets holding the signing and encryption keys, taking
+// the current version of each to issue tokens and accepting the previous one too so a rotation doesn't sign everyone
+// out. Key rings are only rebuilt when a secret changes.
+type SecretsKeyProvider struct {
+	secrets             SecretSource
+	signingKeySecret    string
+	encryptionKeySecret string
+
+	mu              sync.Mutex
+	signingValue    secrets.Value
+	encryptionValue secrets.Value
+	ring            *KeyRing
+}
+
+func NewSecretsKeyProvider(secrets SecretSource, signingKeySecret, encryptionKeySecret string) *SecretsKeyProvider {
+	return &SecretsKeyProvider{
+		secrets:             secrets,
+		signingKeySecret:    signingKeySecret,
+		encryptionKeySecret: encryptionKeySecret,
+	}
+}
+
+func (p *SecretsKeyProvider) KeyRing(ctx context.Context) (*KeyRing, error) {
+	return p.keyRing(ctx, p.secrets.Get)
+}
+
+func (p *SecretsKeyProvider) RefreshKeyRing(ctx context.Context) (*KeyRing, error) {
+	return p.keyRing(ctx, p.secrets.Refresh)
+}
+
+func (p *SecretsKeyProvider) keyRing(ctx context.Context, get func(ctx context.Context, secretID string) (secrets.Value, error)) (*KeyRing, error) {
+	signingValue, err := get(ctx, p.signingKeySecret)
+	if err != nil {
+		return nil, fmt.Errorf("getting signing key: %w", err)
+	}
+	encryptionValue, err := get(ctx, p.encryptionKeySecret)
+	if err != nil {
+		return nil, fmt.Errorf("getting encryption key: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.ring != nil && signingValue == p.signingValue && encryptionValue == p.encryptionValue {
+		return p.ring, nil
+	}
+
+	ring, err := parseKeyRing(ctx, signingValue, encryptionValue)
+	if err != nil {
+		return nil, err
+	}
+	p.signingValue, p.encryptionValue, p.ring = signingValue, encryptionValue, ring
+	return ring, nil
+}
+
+// parseKeyRing fails on a current key that won't parse, but only warns about a previous one, since all that costs is
+// the sessions issued under it.
+func parseKeyRing(ctx context.Context, signingValue, encryptionValue secrets.Value) (*KeyRing, error) {
+	signingKeys, err := parseSigningKeys(signingValue.Current)
+	if err != nil {
+		return nil, fmt.Errorf("parsing signing key: %w", err)
+	}
+	if signingValue.Previous != "" {
+		previous, err := parseSigningKeys(signingValue.Previous)
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Msg("ignoring unparseable previous signing key")
+		} else {
+			signingKeys = append(signingKeys, previous...)
+		}
+	}
+
+	encryptionKey, err := ParseEncryptionKeyBase64(encryptionValue.Current)
+	if err != nil {
+		return nil, fmt.Errorf("parsing encryption key: %w", err)
+	}
+	encryptionKeys := [][]byte{encryptionKey}
+	if encryptionValue.Previous != "" {
+		previous, err := ParseEncryptionKeyBase64(encryptionValue.Previous)
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Msg("ignoring unparseable previous encryption key")
+		} else {
+			encryptionKeys = append(encryptionKeys, previous)
+		}
+	}
+
+	return NewKeyRing(signingKeys, encryptionKeys)
+}
+
+func parseSigningKeys(value string) ([]*ecdsa.PrivateKey, error) {
+	set, err := ParseKeySet(value)
+	if err != nil {
+		return nil, err
+	}
+	return set.SigningKeys()
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/secrets"
+)
+
+func TestSecretsKeyProvider_KeyRing(t *testing.T) {
+	ctx := context.Background()
+
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	previousSigningKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	encryptionKey := make([]byte, 32)
+	_, err = rand.Read(encryptionKey)
+	require.NoError(t, err)
+
+	signingValue := secrets.Value{Current: signingKeyPEM(t, signingKey), Previous: signingKeyPEM(t, previousSigningKey)}
+	encryptionValue := secrets.Value{Current: base64.StdEncoding.EncodeToString(encryptionKey), Previous: "not a key"}
+
+	source := NewMockSecretSource(t)
+	source.EXPECT().Get(mock.Anything, "signing").Return(signingValue, nil).Twice()
+	source.EXPECT().Get(mock.Anything, "encryption").Return(encryptionValue, nil).Twice()
+
+	provider := NewSecretsKeyProvider(source, "signing", "encryption")
+
+	ring, err := provider.KeyRing(ctx)
+	require.NoError(t, err)
+	assert.Len(t, ring.signingKeys, 2)
+	assert.True(t, signingKey.Equal(ring.signingKeys[0]))
+	assert.True(t, previousSigningKey.Equal(ring.signingKeys[1]))
+	// the unparseable previous encryption key is left out rather than failing everything
+	assert.Len(t, ring.ciphers, 1)
+
+	// unchanged secrets reuse the ring
+	again, err := provider.KeyRing(ctx)
+	require.NoError(t, err)
+	assert.Same(t, ring, again)
+}
+
+func TestSecretsKeyProvider_KeyRing_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	source := NewMockSecretSource(t)
+	source.EXPECT().Get(mock.Anything, "signing").Return(secrets.Value{}, errors.New("access denied")).Once()
+
+	provider := NewSecretsKeyProvider(source, "signing", "encryption")
+	_, err := provider.KeyRing(ctx)
+	assert.ErrorContains(t, err, "getting signing key: access denied")
+
+	source.EXPECT().Get(mock.Anything, "signing").Return(secrets.Value{Current: "not a key"}, nil).Once()
+	source.EXPECT().Get(mock.Anything, "encryption").Return(secrets.Value{Current: "MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTIzNDU2Nzg5MDE="}, nil).Once()
+	_, err = provider.KeyRing(ctx)
+	assert.ErrorContains(t, err, "parsing signing key")
+}
@@ -2,18 +2,38 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/netip"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
 	"github.com/jonsabados/saturdaysspinout/iracing"
 	"github.com/jonsabados/saturdaysspinout/store"
 )
 
+// ErrSessionRevoked is returned when refreshing a token for an auth session that has been revoked.
+var ErrSessionRevoked = errors.New("session revoked")
+
+// ErrWaitlisted is returned when a driver signing in for the first time has been put on the waitlist rather than let
+// in, see WithWaitlist.
+var ErrWaitlisted = errors.New("waitlisted")
+
+// Client is who a token is being issued to, recorded against its auth session so drivers can tell their sessions apart.
+type Client struct {
+	UserAgent string
+	IP        string
+}
+
 type Result struct {
 	Token     string
 	ExpiresAt time.Time
 	UserID    int64
 	UserName  string
+	// Welcome is set on the first sign in of a driver let in by an invitation, so they can be told they're in.
+	Welcome bool
 }
 
 type OAuthClient interface {
@@ -26,13 +46,42 @@ type UserInfoProvider interface {
 }
 
 type JWTCreator interface {
-	CreateToken(ctx context.Context, userID int64, userName string, entitlements []string, accessToken, refreshToken string, tokenExpiry time.Time) (string, error)
+	CreateToken(ctx context.Context, sessionID string, userID int64, userName string, entitlements []string, accessToken, refreshToken string, tokenExpiry time.Time) (string, error)
+	Lifetime() time.Duration
 }
 
 type DriverStore interface {
-	GetDriver(ctx context.Context, driverID int64) (*store.Driver, error)
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
 	InsertDriver(ctx context.Context, driver store.Driver) error
 	RecordLogin(ctx context.Context, driverID int64, loginTime time.Time) error
+	UpdateDriverClub(ctx context.Context, driverID int64, clubID int, clubName string) error
+	UpdateDriverName(ctx context.Context, driverID int64, previousName, name string) error
+	SaveAuthSession(ctx context.Context, session store.AuthSession) (bool, error)
+	GetAuthSession(ctx context.Context, driverID int64, sessionID string) (*store.AuthSession, error)
+	GetAuthSessions(ctx context.Context, driverID int64) ([]store.AuthSession, error)
+	RevokeAuthSession(ctx context.Context, driverID int64, sessionID string, revokedAt time.Time) (bool, error)
+}
+
+// LoginGuard holds failed sign in attempts against where they came from, see AttemptTracker.
+type LoginGuard interface {
+	Check(ctx context.Context, keys ...string) error
+	RecordFailure(ctx context.Context, keys ...string) error
+	Clear(ctx context.Context, key string) error
+}
+
+// Waitlist decides whether drivers signing in for the first time are let in, see invitations.Service.
+type Waitlist interface {
+	Admit(ctx context.Context, driverID int64, driverName string) ([]string, bool, error)
+}
+
+type ServiceOption func(*Service)
+
+// WithWaitlist only creates driver records for drivers the waitlist lets in, the rest fail to sign in with
+// ErrWaitlisted. Drivers that already have a record are unaffected.
+func WithWaitlist(waitlist Waitlist) ServiceOption {
+	return func(s *Service) {
+		s.waitlist = waitlist
+	}
 }
 
 type Service struct {
@@ -40,28 +89,71 @@ type Service struct {
 	jwtCreator       JWTCreator
 	userInfoProvider UserInfoProvider
 	driverStore      DriverStore
+	guard            LoginGuard
+	waitlist         Waitlist
 	now              func() time.Time
+	newSessionID     func() string
 }
 
-func NewService(oauthClient OAuthClient, jwtCreator JWTCreator, userInfoProvider UserInfoProvider, driverStore DriverStore) *Service {
-	return &Service{
+func NewService(oauthClient OAuthClient, jwtCreator JWTCreator, userInfoProvider UserInfoProvider, driverStore DriverStore, guard LoginGuard, opts ...ServiceOption) *Service {
+	s := &Service{
 		oauthClient:      oauthClient,
 		jwtCreator:       jwtCreator,
 		userInfoProvider: userInfoProvider,
 		driverStore:      driverStore,
+		guard:            guard,
 		now:              time.Now,
+		newSessionID:     uuid.NewString,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
-// HandleRefresh refreshes the iRacing tokens and issues a new JWT
-func (s *Service) HandleRefresh(ctx context.Context, userID int64, userName string, entitlements []string, refreshToken string) (*Result, error) {
+// HandleRefresh refreshes the iRacing tokens and issues a new JWT. Entitlements are reloaded from the driver record so
+// grants and revocations take hold at the next refresh, the ones passed in are only used if the record is gone.
+// The token continues the auth session it replaces, failing with ErrSessionRevoked if that has been revoked. Refresh
+// tokens iRacing rejects count against both the client and the customer, failing with a *TooManyAttemptsError once
+// either has too many.
+func (s *Service) HandleRefresh(ctx context.Context, sessionID string, userID int64, userName string, entitlements []string, refreshToken string, client Client) (*Result, error) {
+	attemptKeys := []string{IPAttemptKey(client.IP), CustomerAttemptKey(userID)}
+	if err := s.guard.Check(ctx, attemptKeys...); err != nil {
+		return nil, err
+	}
+
 	tokenResp, err := s.oauthClient.RefreshToken(ctx, refreshToken)
 	if err != nil {
+		s.recordRejection(ctx, err, attemptKeys...)
 		return nil, fmt.Errorf("refreshing iRacing token: %w", err)
 	}
 
+	driverRecord, err := s.driverStore.GetDriver(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting driver record: %w", err)
+	}
+	if driverRecord != nil {
+		entitlements = driverRecord.Entitlements
+	}
+
+	existing, err := s.driverStore.GetAuthSession(ctx, userID, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("getting session: %w", err)
+	}
+	// tokens from before sessions were recorded start theirs at their first refresh
+	createdAt := s.now()
+	if existing != nil {
+		if existing.RevokedAt != nil {
+			return nil, ErrSessionRevoked
+		}
+		createdAt = existing.CreatedAt
+	}
+	if err := s.recordSession(ctx, userID, sessionID, createdAt, client); err != nil {
+		return nil, err
+	}
+
 	tokenExpiry := s.now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
-	jwt, err := s.jwtCreator.CreateToken(ctx, userID, userName, entitlements, tokenResp.AccessToken, tokenResp.RefreshToken, tokenExpiry)
+	jwt, err := s.jwtCreator.CreateToken(ctx, sessionID, userID, userName, entitlements, tokenResp.AccessToken, tokenResp.RefreshToken, tokenExpiry)
 	if err != nil {
 		return nil, fmt.Errorf("creating JWT: %w", err)
 	}
@@ -74,10 +166,18 @@ func (s *Service) HandleRefresh(ctx context.Context, userID int64, userName stri
 	}, nil
 }
 
-// HandleCallback processes an OAuth callback from iRacing after a user has authenticated is returning to our site
-func (s *Service) HandleCallback(ctx context.Context, code, codeVerifier, redirectURI string) (*Result, error) {
+// HandleCallback processes an OAuth callback from iRacing after a user has authenticated is returning to our site.
+// Authorization codes iRacing rejects count against the client, failing with a *TooManyAttemptsError once it has too
+// many. With a waitlist, drivers signing in for the first time without an invitation fail with ErrWaitlisted.
+func (s *Service) HandleCallback(ctx context.Context, code, codeVerifier, redirectURI string, client Client) (*Result, error) {
+	ipKey := IPAttemptKey(client.IP)
+	if err := s.guard.Check(ctx, ipKey); err != nil {
+		return nil, err
+	}
+
 	tokenResp, err := s.oauthClient.ExchangeCode(ctx, code, codeVerifier, redirectURI)
 	if err != nil {
+		s.recordRejection(ctx, err, ipKey)
 		return nil, fmt.Errorf("exchanging authorization code: %w", err)
 	}
 
@@ -86,21 +186,42 @@ func (s *Service) HandleCallback(ctx context.Context, code, codeVerifier, redire
 		return nil, fmt.Errorf("getting user info: %w", err)
 	}
 
+	// signing in proves the customer holds their credentials, so refresh failures start over
+	if err := s.guard.Clear(ctx, CustomerAttemptKey(userInfo.UserID)); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Int64("driverId", userInfo.UserID).Msg("failed to clear login attempts")
+	}
+
 	driverRecord, err := s.driverStore.GetDriver(ctx, userInfo.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("getting driver record: %w", err)
 	}
 
 	var entitlements []string
+	welcome := false
 	if driverRecord == nil {
+		if s.waitlist != nil {
+			invitedEntitlements, admitted, err := s.waitlist.Admit(ctx, userInfo.UserID, userInfo.UserName)
+			if err != nil {
+				return nil, fmt.Errorf("checking waitlist: %w", err)
+			}
+			if !admitted {
+				return nil, ErrWaitlisted
+			}
+			entitlements = invitedEntitlements
+			welcome = true
+		}
 		now := s.now()
 		err := s.driverStore.InsertDriver(ctx, store.Driver{
-			DriverID:    userInfo.UserID,
-			DriverName:  userInfo.UserName,
-			MemberSince: userInfo.MemberSince,
-			FirstLogin:  now,
-			LastLogin:   now,
-			LoginCount:  1,
+			DriverID:       userInfo.UserID,
+			DriverName:     userInfo.UserName,
+			MemberSince:    userInfo.MemberSince,
+			ClubID:         userInfo.ClubID,
+			ClubName:       userInfo.ClubName,
+			FirstLogin:     now,
+			LastLogin:      now,
+			LoginCount:     1,
+			OnboardingStep: store.OnboardingStepProfileCreated,
+			Entitlements:   entitlements,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("creating driver: %w", err)
@@ -111,10 +232,29 @@ func (s *Service) HandleCallback(ctx context.Context, code, codeVerifier, redire
 		if err != nil {
 			return nil, fmt.Errorf("recording login: %w", err)
 		}
+		// drivers can move clubs, and ones created before clubs were tracked won't have one yet
+		if userInfo.ClubID != 0 && (userInfo.ClubID != driverRecord.ClubID || userInfo.ClubName != driverRecord.ClubName) {
+			err := s.driverStore.UpdateDriverClub(ctx, userInfo.UserID, userInfo.ClubID, userInfo.ClubName)
+			if err != nil {
+				return nil, fmt.Errorf("updating driver club: %w", err)
+			}
+		}
+		// names can change on iRacing's side, and drivers created before driver search need indexing
+		if userInfo.UserName != "" && (userInfo.UserName != driverRecord.DriverName || !driverRecord.SearchIndexed) {
+			err := s.driverStore.UpdateDriverName(ctx, userInfo.UserID, driverRecord.DriverName, userInfo.UserName)
+			if err != nil {
+				return nil, fmt.Errorf("updating driver name: %w", err)
+			}
+		}
+	}
+
+	sessionID := s.newSessionID()
+	if err := s.recordSession(ctx, userInfo.UserID, sessionID, s.now(), client); err != nil {
+		return nil, err
 	}
 
 	tokenExpiry := s.now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
-	jwt, err := s.jwtCreator.CreateToken(ctx, userInfo.UserID, userInfo.UserName, entitlements, tokenResp.AccessToken, tokenResp.RefreshToken, tokenExpiry)
+	jwt, err := s.jwtCreator.CreateToken(ctx, sessionID, userInfo.UserID, userInfo.UserName, entitlements, tokenResp.AccessToken, tokenResp.RefreshToken, tokenExpiry)
 	if err != nil {
 		return nil, fmt.Errorf("creating JWT: %w", err)
 	}
@@ -124,5 +264,105 @@ func (s *Service) HandleCallback(ctx context.Context, code, codeVerifier, redire
 		ExpiresAt: tokenExpiry,
 		UserID:    userInfo.UserID,
 		UserName:  userInfo.UserName,
+		Welcome:   welcome,
 	}, nil
 }
+
+// recordRejection counts a failed credential exchange against the keys when iRacing turned the credentials down. Other
+// failures, iRacing being down or slow, aren't the client's doing.
+func (s *Service) recordRejection(ctx context.Context, err error, keys ...string) {
+	if !errors.Is(err, iracing.ErrTokenRejected) {
+		return
+	}
+	if err := s.guard.RecordFailure(ctx, keys...); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to record login failure")
+	}
+}
+
+// recordSession saves the auth session a token is about to be issued for. It is saved first so no token goes out that
+// the driver can't see and revoke.
+func (s *Service) recordSession(ctx context.Context, driverID int64, sessionID string, createdAt time.Time, client Client) error {
+	now := s.now()
+	saved, err := s.driverStore.SaveAuthSession(ctx, store.AuthSession{
+		DriverID:  driverID,
+		SessionID: sessionID,
+		UserAgent: client.UserAgent,
+		IPPrefix:  coarseIP(client.IP),
+		CreatedAt: createdAt,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(s.jwtCreator.Lifetime()),
+	})
+	if err != nil {
+		return fmt.Errorf("saving session: %w", err)
+	}
+	if !saved {
+		return ErrSessionRevoked
+	}
+	return nil
+}
+
+// ListSessions returns the driver's active auth sessions, most recently refreshed first.
+func (s *Service) ListSessions(ctx context.Context, driverID int64) ([]store.AuthSession, error) {
+	sessions, err := s.driverStore.GetAuthSessions(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting sessions: %w", err)
+	}
+	active := make([]store.AuthSession, 0, len(sessions))
+	for _, session := range sessions {
+		if session.RevokedAt == nil {
+			active = append(active, session)
+		}
+	}
+	return active, nil
+}
+
+// RevokeSession revokes one of the driver's auth sessions, returning false if the driver has no such session.
+func (s *Service) RevokeSession(ctx context.Context, driverID int64, sessionID string) (bool, error) {
+	revoked, err := s.driverStore.RevokeAuthSession(ctx, driverID, sessionID, s.now())
+	if err != nil {
+		return false, fmt.Errorf("revoking session: %w", err)
+	}
+	return revoked, nil
+}
+
+// RevokeOtherSessions revokes every active auth session of the driver's but the one given, signing them out everywhere
+// else. Returns how many were revoked.
+func (s *Service) RevokeOtherSessions(ctx context.Context, driverID int64, keepSessionID string) (int, error) {
+	sessions, err := s.ListSessions(ctx, driverID)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, session := range sessions {
+		if session.SessionID == keepSessionID {
+			continue
+		}
+		revoked, err := s.RevokeSession(ctx, driverID, session.SessionID)
+		if err != nil {
+			return count, err
+		}
+		if revoked {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// coarseIP reduces an address to its /24 for IPv4 or /48 for IPv6, enough to tell home from work without pinpointing
+// anyone. Returns empty for anything that isn't an address.
+func coarseIP(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+	addr = addr.Unmap()
+	bits := 48
+	if addr.Is4() {
+		bits = 24
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return ""
+	}
+	return prefix.String()
+}
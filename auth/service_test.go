@@ -3,9 +3,11 @@ package auth
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/jonsabados/saturdaysspinout/iracing"
 	"github.com/jonsabados/saturdaysspinout/store"
 	"github.com/stretchr/testify/assert"
@@ -45,6 +47,33 @@ func TestService_HandleCallback(t *testing.T) {
 		err               error
 	}
 
+	type updateDriverClubCall struct {
+		expectedDriverID int64
+		expectedClubID   int
+		expectedClubName string
+		err              error
+	}
+
+	type updateDriverNameCall struct {
+		expectedDriverID     int64
+		expectedPreviousName string
+		expectedName         string
+		err                  error
+	}
+
+	type admitCall struct {
+		expectedDriverID   int64
+		expectedDriverName string
+		entitlements       []string
+		admitted           bool
+		err                error
+	}
+
+	type saveSessionCall struct {
+		saved bool
+		err   error
+	}
+
 	type jwtCreatorCall struct {
 		inputUserID       int64
 		inputUserName     string
@@ -66,11 +95,18 @@ func TestService_HandleCallback(t *testing.T) {
 		inputCodeVerifier string
 		inputRedirectURI  string
 
+		guardCheckErr         error
 		oauthClientCalls      []oauthClientCall
+		expectRecordFailure   bool
 		userInfoProviderCalls []userInfoProviderCall
 		getDriverCalls        []getDriverCall
 		insertDriverCalls     []insertDriverCall
 		recordLoginCalls      []recordLoginCall
+		updateDriverClubCalls []updateDriverClubCall
+		updateDriverNameCalls []updateDriverNameCall
+		waitlistEnabled       bool
+		admitCalls            []admitCall
+		saveSessionCalls      []saveSessionCall
 		jwtCreatorCalls       []jwtCreatorCall
 
 		expectedResult *Result
@@ -99,6 +135,8 @@ func TestService_HandleCallback(t *testing.T) {
 					result: &iracing.UserInfo{
 						UserID:   12345,
 						UserName: "Test Driver",
+						ClubID:   7,
+						ClubName: "Great Plains",
 					},
 				},
 			},
@@ -107,13 +145,17 @@ func TestService_HandleCallback(t *testing.T) {
 			},
 			insertDriverCalls: []insertDriverCall{
 				{expectedDriver: store.Driver{
-					DriverID:   12345,
-					DriverName: "Test Driver",
-					FirstLogin: fixedNow,
-					LastLogin:  fixedNow,
-					LoginCount: 1,
+					DriverID:       12345,
+					DriverName:     "Test Driver",
+					ClubID:         7,
+					ClubName:       "Great Plains",
+					FirstLogin:     fixedNow,
+					LastLogin:      fixedNow,
+					LoginCount:     1,
+					OnboardingStep: store.OnboardingStepProfileCreated,
 				}},
 			},
+			saveSessionCalls: []saveSessionCall{{saved: true}},
 			jwtCreatorCalls: []jwtCreatorCall{
 				{
 					inputUserID:       12345,
@@ -160,18 +202,20 @@ func TestService_HandleCallback(t *testing.T) {
 				{
 					inputDriverID: 12345,
 					result: &store.Driver{
-						DriverID:     12345,
-						DriverName:   "Test Driver",
-						FirstLogin:   time.Unix(1000, 0),
-						LastLogin:    time.Unix(2000, 0),
-						LoginCount:   5,
-						Entitlements: []string{"developer"},
+						DriverID:      12345,
+						DriverName:    "Test Driver",
+						SearchIndexed: true,
+						FirstLogin:    time.Unix(1000, 0),
+						LastLogin:     time.Unix(2000, 0),
+						LoginCount:    5,
+						Entitlements:  []string{"developer"},
 					},
 				},
 			},
 			recordLoginCalls: []recordLoginCall{
 				{expectedDriverID: 12345, expectedLoginTime: fixedNow},
 			},
+			saveSessionCalls: []saveSessionCall{{saved: true}},
 			jwtCreatorCalls: []jwtCreatorCall{
 				{
 					inputUserID:       12345,
@@ -189,6 +233,178 @@ func TestService_HandleCallback(t *testing.T) {
 				UserName: "Test Driver",
 			},
 		},
+		{
+			name:              "success - existing driver changed club",
+			inputCode:         "auth-code",
+			inputCodeVerifier: "code-verifier",
+			inputRedirectURI:  "http://localhost/callback",
+			oauthClientCalls: []oauthClientCall{
+				{
+					inputCode:         "auth-code",
+					inputCodeVerifier: "code-verifier",
+					inputRedirectURI:  "http://localhost/callback",
+					result: &iracing.TokenResponse{
+						AccessToken:  "access-token",
+						RefreshToken: "refresh-token",
+						ExpiresIn:    3600,
+					},
+				},
+			},
+			userInfoProviderCalls: []userInfoProviderCall{
+				{
+					inputAccessToken: "access-token",
+					result: &iracing.UserInfo{
+						UserID:   12345,
+						UserName: "Test Driver",
+						ClubID:   7,
+						ClubName: "Great Plains",
+					},
+				},
+			},
+			getDriverCalls: []getDriverCall{
+				{
+					inputDriverID: 12345,
+					result: &store.Driver{
+						DriverID:      12345,
+						DriverName:    "Test Driver",
+						SearchIndexed: true,
+						ClubID:        3,
+						ClubName:      "Midwest",
+						FirstLogin:    time.Unix(1000, 0),
+						LastLogin:     time.Unix(2000, 0),
+						LoginCount:    5,
+					},
+				},
+			},
+			recordLoginCalls: []recordLoginCall{
+				{expectedDriverID: 12345, expectedLoginTime: fixedNow},
+			},
+			updateDriverClubCalls: []updateDriverClubCall{
+				{expectedDriverID: 12345, expectedClubID: 7, expectedClubName: "Great Plains"},
+			},
+			saveSessionCalls: []saveSessionCall{{saved: true}},
+			jwtCreatorCalls: []jwtCreatorCall{
+				{
+					inputUserID:       12345,
+					inputUserName:     "Test Driver",
+					inputAccessToken:  "access-token",
+					inputRefreshToken: "refresh-token",
+					inputTokenExpiry:  expectedTokenExpiry,
+					result:            "jwt-token",
+				},
+			},
+			expectedResult: &Result{
+				Token:    "jwt-token",
+				UserID:   12345,
+				UserName: "Test Driver",
+			},
+		},
+		{
+			name:              "success - existing driver renamed",
+			inputCode:         "auth-code",
+			inputCodeVerifier: "code-verifier",
+			inputRedirectURI:  "http://localhost/callback",
+			oauthClientCalls: []oauthClientCall{
+				{
+					inputCode:         "auth-code",
+					inputCodeVerifier: "code-verifier",
+					inputRedirectURI:  "http://localhost/callback",
+					result: &iracing.TokenResponse{
+						AccessToken:  "access-token",
+						RefreshToken: "refresh-token",
+						ExpiresIn:    3600,
+					},
+				},
+			},
+			userInfoProviderCalls: []userInfoProviderCall{
+				{
+					inputAccessToken: "access-token",
+					result: &iracing.UserInfo{
+						UserID:   12345,
+						UserName: "Renamed Driver",
+					},
+				},
+			},
+			getDriverCalls: []getDriverCall{
+				{
+					inputDriverID: 12345,
+					result: &store.Driver{
+						DriverID:      12345,
+						DriverName:    "Test Driver",
+						FirstLogin:    time.Unix(1000, 0),
+						LastLogin:     time.Unix(2000, 0),
+						LoginCount:    5,
+						SearchIndexed: true,
+					},
+				},
+			},
+			recordLoginCalls: []recordLoginCall{
+				{expectedDriverID: 12345, expectedLoginTime: fixedNow},
+			},
+			updateDriverNameCalls: []updateDriverNameCall{
+				{expectedDriverID: 12345, expectedPreviousName: "Test Driver", expectedName: "Renamed Driver"},
+			},
+			saveSessionCalls: []saveSessionCall{{saved: true}},
+			jwtCreatorCalls: []jwtCreatorCall{
+				{
+					inputUserID:       12345,
+					inputUserName:     "Renamed Driver",
+					inputAccessToken:  "access-token",
+					inputRefreshToken: "refresh-token",
+					inputTokenExpiry:  expectedTokenExpiry,
+					result:            "jwt-token",
+				},
+			},
+			expectedResult: &Result{
+				Token:    "jwt-token",
+				UserID:   12345,
+				UserName: "Renamed Driver",
+			},
+		},
+		{
+			name:              "existing driver not yet indexed for search",
+			inputCode:         "auth-code",
+			inputCodeVerifier: "code-verifier",
+			inputRedirectURI:  "http://localhost/callback",
+			oauthClientCalls: []oauthClientCall{
+				{
+					inputCode:         "auth-code",
+					inputCodeVerifier: "code-verifier",
+					inputRedirectURI:  "http://localhost/callback",
+					result: &iracing.TokenResponse{
+						AccessToken:  "access-token",
+						RefreshToken: "refresh-token",
+						ExpiresIn:    3600,
+					},
+				},
+			},
+			userInfoProviderCalls: []userInfoProviderCall{
+				{
+					inputAccessToken: "access-token",
+					result: &iracing.UserInfo{
+						UserID:   12345,
+						UserName: "Test Driver",
+					},
+				},
+			},
+			getDriverCalls: []getDriverCall{
+				{
+					inputDriverID: 12345,
+					result: &store.Driver{
+						DriverID:   12345,
+						DriverName: "Test Driver",
+						LoginCount: 1,
+					},
+				},
+			},
+			recordLoginCalls: []recordLoginCall{
+				{expectedDriverID: 12345, expectedLoginTime: fixedNow},
+			},
+			updateDriverNameCalls: []updateDriverNameCall{
+				{expectedDriverID: 12345, expectedPreviousName: "Test Driver", expectedName: "Test Driver", err: errors.New("update error")},
+			},
+			expectedErr: "updating driver name: update error",
+		},
 		{
 			name:              "oauth exchange fails",
 			inputCode:         "auth-code",
@@ -204,6 +420,30 @@ func TestService_HandleCallback(t *testing.T) {
 			},
 			expectedErr: "exchanging authorization code: oauth error",
 		},
+		{
+			name:              "authorization code rejected",
+			inputCode:         "auth-code",
+			inputCodeVerifier: "code-verifier",
+			inputRedirectURI:  "http://localhost/callback",
+			oauthClientCalls: []oauthClientCall{
+				{
+					inputCode:         "auth-code",
+					inputCodeVerifier: "code-verifier",
+					inputRedirectURI:  "http://localhost/callback",
+					err:               fmt.Errorf("token exchange failed: %w, status 400", iracing.ErrTokenRejected),
+				},
+			},
+			expectRecordFailure: true,
+			expectedErr:         "exchanging authorization code: token exchange failed: token request rejected",
+		},
+		{
+			name:              "too many failed attempts",
+			inputCode:         "auth-code",
+			inputCodeVerifier: "code-verifier",
+			inputRedirectURI:  "http://localhost/callback",
+			guardCheckErr:     &TooManyAttemptsError{RetryAt: fixedNow.Add(time.Minute)},
+			expectedErr:       "too many failed sign in attempts",
+		},
 		{
 			name:              "get user info fails",
 			inputCode:         "auth-code",
@@ -291,11 +531,12 @@ func TestService_HandleCallback(t *testing.T) {
 			},
 			insertDriverCalls: []insertDriverCall{
 				{expectedDriver: store.Driver{
-					DriverID:   12345,
-					DriverName: "Test Driver",
-					FirstLogin: fixedNow,
-					LastLogin:  fixedNow,
-					LoginCount: 1,
+					DriverID:       12345,
+					DriverName:     "Test Driver",
+					FirstLogin:     fixedNow,
+					LastLogin:      fixedNow,
+					LoginCount:     1,
+					OnboardingStep: store.OnboardingStepProfileCreated,
 				}, err: errors.New("insert error")},
 			},
 			expectedErr: "creating driver: insert error",
@@ -330,9 +571,10 @@ func TestService_HandleCallback(t *testing.T) {
 				{
 					inputDriverID: 12345,
 					result: &store.Driver{
-						DriverID:   12345,
-						DriverName: "Test Driver",
-						LoginCount: 1,
+						DriverID:      12345,
+						DriverName:    "Test Driver",
+						SearchIndexed: true,
+						LoginCount:    1,
 					},
 				},
 			},
@@ -341,6 +583,48 @@ func TestService_HandleCallback(t *testing.T) {
 			},
 			expectedErr: "recording login: record login error",
 		},
+		{
+			name:              "save session fails",
+			inputCode:         "auth-code",
+			inputCodeVerifier: "code-verifier",
+			inputRedirectURI:  "http://localhost/callback",
+			oauthClientCalls: []oauthClientCall{
+				{
+					inputCode:         "auth-code",
+					inputCodeVerifier: "code-verifier",
+					inputRedirectURI:  "http://localhost/callback",
+					result: &iracing.TokenResponse{
+						AccessToken:  "access-token",
+						RefreshToken: "refresh-token",
+						ExpiresIn:    3600,
+					},
+				},
+			},
+			userInfoProviderCalls: []userInfoProviderCall{
+				{
+					inputAccessToken: "access-token",
+					result: &iracing.UserInfo{
+						UserID:   12345,
+						UserName: "Test Driver",
+					},
+				},
+			},
+			getDriverCalls: []getDriverCall{
+				{inputDriverID: 12345, result: nil},
+			},
+			insertDriverCalls: []insertDriverCall{
+				{expectedDriver: store.Driver{
+					DriverID:       12345,
+					DriverName:     "Test Driver",
+					FirstLogin:     fixedNow,
+					LastLogin:      fixedNow,
+					LoginCount:     1,
+					OnboardingStep: store.OnboardingStepProfileCreated,
+				}},
+			},
+			saveSessionCalls: []saveSessionCall{{err: errors.New("db error")}},
+			expectedErr:      "saving session: db error",
+		},
 		{
 			name:              "create token fails",
 			inputCode:         "auth-code",
@@ -372,13 +656,15 @@ func TestService_HandleCallback(t *testing.T) {
 			},
 			insertDriverCalls: []insertDriverCall{
 				{expectedDriver: store.Driver{
-					DriverID:   12345,
-					DriverName: "Test Driver",
-					FirstLogin: fixedNow,
-					LastLogin:  fixedNow,
-					LoginCount: 1,
+					DriverID:       12345,
+					DriverName:     "Test Driver",
+					FirstLogin:     fixedNow,
+					LastLogin:      fixedNow,
+					LoginCount:     1,
+					OnboardingStep: store.OnboardingStepProfileCreated,
 				}},
 			},
+			saveSessionCalls: []saveSessionCall{{saved: true}},
 			jwtCreatorCalls: []jwtCreatorCall{
 				{
 					inputUserID:       12345,
@@ -391,12 +677,214 @@ func TestService_HandleCallback(t *testing.T) {
 			},
 			expectedErr: "creating JWT: jwt error",
 		},
+		{
+			name:              "waitlist - invited new driver is welcomed",
+			inputCode:         "auth-code",
+			inputCodeVerifier: "code-verifier",
+			inputRedirectURI:  "http://localhost/callback",
+			oauthClientCalls: []oauthClientCall{
+				{
+					inputCode:         "auth-code",
+					inputCodeVerifier: "code-verifier",
+					inputRedirectURI:  "http://localhost/callback",
+					result: &iracing.TokenResponse{
+						AccessToken:  "access-token",
+						RefreshToken: "refresh-token",
+						ExpiresIn:    3600,
+					},
+				},
+			},
+			userInfoProviderCalls: []userInfoProviderCall{
+				{
+					inputAccessToken: "access-token",
+					result: &iracing.UserInfo{
+						UserID:   12345,
+						UserName: "Test Driver",
+					},
+				},
+			},
+			getDriverCalls: []getDriverCall{
+				{inputDriverID: 12345, result: nil},
+			},
+			waitlistEnabled: true,
+			admitCalls: []admitCall{
+				{expectedDriverID: 12345, expectedDriverName: "Test Driver", entitlements: []string{"beta"}, admitted: true},
+			},
+			insertDriverCalls: []insertDriverCall{
+				{expectedDriver: store.Driver{
+					DriverID:       12345,
+					DriverName:     "Test Driver",
+					FirstLogin:     fixedNow,
+					LastLogin:      fixedNow,
+					LoginCount:     1,
+					OnboardingStep: store.OnboardingStepProfileCreated,
+					Entitlements:   []string{"beta"},
+				}},
+			},
+			saveSessionCalls: []saveSessionCall{{saved: true}},
+			jwtCreatorCalls: []jwtCreatorCall{
+				{
+					inputUserID:       12345,
+					inputUserName:     "Test Driver",
+					inputEntitlements: []string{"beta"},
+					inputAccessToken:  "access-token",
+					inputRefreshToken: "refresh-token",
+					inputTokenExpiry:  expectedTokenExpiry,
+					result:            "jwt-token",
+				},
+			},
+			expectedResult: &Result{
+				Token:    "jwt-token",
+				UserID:   12345,
+				UserName: "Test Driver",
+				Welcome:  true,
+			},
+		},
+		{
+			name:              "waitlist - new driver without an invitation is waitlisted",
+			inputCode:         "auth-code",
+			inputCodeVerifier: "code-verifier",
+			inputRedirectURI:  "http://localhost/callback",
+			oauthClientCalls: []oauthClientCall{
+				{
+					inputCode:         "auth-code",
+					inputCodeVerifier: "code-verifier",
+					inputRedirectURI:  "http://localhost/callback",
+					result: &iracing.TokenResponse{
+						AccessToken:  "access-token",
+						RefreshToken: "refresh-token",
+						ExpiresIn:    3600,
+					},
+				},
+			},
+			userInfoProviderCalls: []userInfoProviderCall{
+				{
+					inputAccessToken: "access-token",
+					result: &iracing.UserInfo{
+						UserID:   12345,
+						UserName: "Test Driver",
+					},
+				},
+			},
+			getDriverCalls: []getDriverCall{
+				{inputDriverID: 12345, result: nil},
+			},
+			waitlistEnabled: true,
+			admitCalls: []admitCall{
+				{expectedDriverID: 12345, expectedDriverName: "Test Driver"},
+			},
+			expectedErr: ErrWaitlisted.Error(),
+		},
+		{
+			name:              "waitlist - admit error",
+			inputCode:         "auth-code",
+			inputCodeVerifier: "code-verifier",
+			inputRedirectURI:  "http://localhost/callback",
+			oauthClientCalls: []oauthClientCall{
+				{
+					inputCode:         "auth-code",
+					inputCodeVerifier: "code-verifier",
+					inputRedirectURI:  "http://localhost/callback",
+					result: &iracing.TokenResponse{
+						AccessToken:  "access-token",
+						RefreshToken: "refresh-token",
+						ExpiresIn:    3600,
+					},
+				},
+			},
+			userInfoProviderCalls: []userInfoProviderCall{
+				{
+					inputAccessToken: "access-token",
+					result: &iracing.UserInfo{
+						UserID:   12345,
+						UserName: "Test Driver",
+					},
+				},
+			},
+			getDriverCalls: []getDriverCall{
+				{inputDriverID: 12345, result: nil},
+			},
+			waitlistEnabled: true,
+			admitCalls: []admitCall{
+				{expectedDriverID: 12345, expectedDriverName: "Test Driver", err: errors.New("waitlist error")},
+			},
+			expectedErr: "checking waitlist: waitlist error",
+		},
+		{
+			name:              "waitlist - existing driver is let straight in",
+			inputCode:         "auth-code",
+			inputCodeVerifier: "code-verifier",
+			inputRedirectURI:  "http://localhost/callback",
+			oauthClientCalls: []oauthClientCall{
+				{
+					inputCode:         "auth-code",
+					inputCodeVerifier: "code-verifier",
+					inputRedirectURI:  "http://localhost/callback",
+					result: &iracing.TokenResponse{
+						AccessToken:  "access-token",
+						RefreshToken: "refresh-token",
+						ExpiresIn:    3600,
+					},
+				},
+			},
+			userInfoProviderCalls: []userInfoProviderCall{
+				{
+					inputAccessToken: "access-token",
+					result: &iracing.UserInfo{
+						UserID:   12345,
+						UserName: "Test Driver",
+					},
+				},
+			},
+			getDriverCalls: []getDriverCall{
+				{
+					inputDriverID: 12345,
+					result: &store.Driver{
+						DriverID:      12345,
+						DriverName:    "Test Driver",
+						SearchIndexed: true,
+						FirstLogin:    time.Unix(1000, 0),
+						LastLogin:     time.Unix(2000, 0),
+						LoginCount:    5,
+					},
+				},
+			},
+			waitlistEnabled: true,
+			recordLoginCalls: []recordLoginCall{
+				{expectedDriverID: 12345, expectedLoginTime: fixedNow},
+			},
+			saveSessionCalls: []saveSessionCall{{saved: true}},
+			jwtCreatorCalls: []jwtCreatorCall{
+				{
+					inputUserID:       12345,
+					inputUserName:     "Test Driver",
+					inputAccessToken:  "access-token",
+					inputRefreshToken: "refresh-token",
+					inputTokenExpiry:  expectedTokenExpiry,
+					result:            "jwt-token",
+				},
+			},
+			expectedResult: &Result{
+				Token:    "jwt-token",
+				UserID:   12345,
+				UserName: "Test Driver",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
 
+			guard := NewMockLoginGuard(t)
+			guard.EXPECT().Check(mock.Anything, []string{"ip#203.0.113.7"}).Return(tc.guardCheckErr)
+			if tc.expectRecordFailure {
+				guard.EXPECT().RecordFailure(mock.Anything, []string{"ip#203.0.113.7"}).Return(nil)
+			}
+			if len(tc.userInfoProviderCalls) > 0 && tc.userInfoProviderCalls[0].err == nil {
+				guard.EXPECT().Clear(mock.Anything, "customer#12345").Return(nil)
+			}
+
 			oauthClient := NewMockOAuthClient(t)
 			for _, call := range tc.oauthClientCalls {
 				oauthClient.EXPECT().ExchangeCode(mock.Anything, call.inputCode, call.inputCodeVerifier, call.inputRedirectURI).Return(call.result, call.err)
@@ -417,16 +905,44 @@ func TestService_HandleCallback(t *testing.T) {
 			for _, call := range tc.recordLoginCalls {
 				driverStore.EXPECT().RecordLogin(mock.Anything, call.expectedDriverID, call.expectedLoginTime).Return(call.err)
 			}
+			for _, call := range tc.updateDriverClubCalls {
+				driverStore.EXPECT().UpdateDriverClub(mock.Anything, call.expectedDriverID, call.expectedClubID, call.expectedClubName).Return(call.err)
+			}
+			for _, call := range tc.updateDriverNameCalls {
+				driverStore.EXPECT().UpdateDriverName(mock.Anything, call.expectedDriverID, call.expectedPreviousName, call.expectedName).Return(call.err)
+			}
 
 			jwtCreator := NewMockJWTCreator(t)
+			for _, call := range tc.saveSessionCalls {
+				jwtCreator.EXPECT().Lifetime().Return(24 * time.Hour)
+				driverStore.EXPECT().SaveAuthSession(mock.Anything, store.AuthSession{
+					DriverID:  12345,
+					SessionID: "session-id",
+					UserAgent: "Firefox",
+					IPPrefix:  "203.0.113.0/24",
+					CreatedAt: fixedNow,
+					IssuedAt:  fixedNow,
+					ExpiresAt: fixedNow.Add(24 * time.Hour),
+				}).Return(call.saved, call.err)
+			}
 			for _, call := range tc.jwtCreatorCalls {
-				jwtCreator.EXPECT().CreateToken(mock.Anything, call.inputUserID, call.inputUserName, call.inputEntitlements, call.inputAccessToken, call.inputRefreshToken, call.inputTokenExpiry).Return(call.result, call.err)
+				jwtCreator.EXPECT().CreateToken(mock.Anything, "session-id", call.inputUserID, call.inputUserName, call.inputEntitlements, call.inputAccessToken, call.inputRefreshToken, call.inputTokenExpiry).Return(call.result, call.err)
+			}
+
+			var opts []ServiceOption
+			if tc.waitlistEnabled {
+				waitlist := NewMockWaitlist(t)
+				for _, call := range tc.admitCalls {
+					waitlist.EXPECT().Admit(mock.Anything, call.expectedDriverID, call.expectedDriverName).Return(call.entitlements, call.admitted, call.err)
+				}
+				opts = append(opts, WithWaitlist(waitlist))
 			}
 
-			service := NewService(oauthClient, jwtCreator, userInfoProvider, driverStore)
+			service := NewService(oauthClient, jwtCreator, userInfoProvider, driverStore, guard, opts...)
 			service.now = func() time.Time { return fixedNow }
+			service.newSessionID = func() string { return "session-id" }
 
-			result, err := service.HandleCallback(ctx, tc.inputCode, tc.inputCodeVerifier, tc.inputRedirectURI)
+			result, err := service.HandleCallback(ctx, tc.inputCode, tc.inputCodeVerifier, tc.inputRedirectURI, Client{UserAgent: "Firefox", IP: "203.0.113.7"})
 
 			if tc.expectedErr != "" {
 				require.Error(t, err)
@@ -438,7 +954,233 @@ func TestService_HandleCallback(t *testing.T) {
 				assert.Equal(t, tc.expectedResult.Token, result.Token)
 				assert.Equal(t, tc.expectedResult.UserID, result.UserID)
 				assert.Equal(t, tc.expectedResult.UserName, result.UserName)
+				assert.Equal(t, tc.expectedResult.Welcome, result.Welcome)
 			}
 		})
 	}
 }
+
+func TestService_HandleRefresh(t *testing.T) {
+	type getDriverCall struct {
+		result *store.Driver
+		err    error
+	}
+
+	type getSessionCall struct {
+		result *store.AuthSession
+		err    error
+	}
+
+	fixedNow := time.Unix(5000, 0)
+	expectedTokenExpiry := fixedNow.Add(time.Hour)
+	revokedAt := time.Unix(4000, 0)
+
+	testCases := []struct {
+		name string
+
+		guardCheckErr        error
+		refreshErr           error
+		expectRecordFailure  bool
+		getDriverCall        *getDriverCall
+		getSessionCall       *getSessionCall
+		saveSessionCall      *bool
+		expectedCreatedAt    time.Time
+		expectedEntitlements []string
+
+		expectedErr string
+	}{
+		{
+			name:                 "entitlements reloaded from the driver record",
+			getDriverCall:        &getDriverCall{result: &store.Driver{DriverID: 12345, Entitlements: []string{"supporter"}}},
+			getSessionCall:       &getSessionCall{result: &store.AuthSession{DriverID: 12345, SessionID: "session-id", CreatedAt: time.Unix(1000, 0)}},
+			saveSessionCall:      aws.Bool(true),
+			expectedCreatedAt:    time.Unix(1000, 0),
+			expectedEntitlements: []string{"supporter"},
+		},
+		{
+			name:                 "revoked entitlements dropped",
+			getDriverCall:        &getDriverCall{result: &store.Driver{DriverID: 12345}},
+			getSessionCall:       &getSessionCall{result: &store.AuthSession{DriverID: 12345, SessionID: "session-id", CreatedAt: time.Unix(1000, 0)}},
+			saveSessionCall:      aws.Bool(true),
+			expectedCreatedAt:    time.Unix(1000, 0),
+			expectedEntitlements: nil,
+		},
+		{
+			name:                 "missing driver keeps the session entitlements",
+			getDriverCall:        &getDriverCall{},
+			getSessionCall:       &getSessionCall{result: &store.AuthSession{DriverID: 12345, SessionID: "session-id", CreatedAt: time.Unix(1000, 0)}},
+			saveSessionCall:      aws.Bool(true),
+			expectedCreatedAt:    time.Unix(1000, 0),
+			expectedEntitlements: []string{"developer"},
+		},
+		{
+			name:                 "session started for a token from before sessions were recorded",
+			getDriverCall:        &getDriverCall{result: &store.Driver{DriverID: 12345}},
+			getSessionCall:       &getSessionCall{},
+			saveSessionCall:      aws.Bool(true),
+			expectedCreatedAt:    fixedNow,
+			expectedEntitlements: nil,
+		},
+		{
+			name:           "revoked session",
+			getDriverCall:  &getDriverCall{result: &store.Driver{DriverID: 12345}},
+			getSessionCall: &getSessionCall{result: &store.AuthSession{DriverID: 12345, SessionID: "session-id", CreatedAt: time.Unix(1000, 0), RevokedAt: &revokedAt}},
+			expectedErr:    "session revoked",
+		},
+		{
+			name:              "session revoked while refreshing",
+			getDriverCall:     &getDriverCall{result: &store.Driver{DriverID: 12345}},
+			getSessionCall:    &getSessionCall{result: &store.AuthSession{DriverID: 12345, SessionID: "session-id", CreatedAt: time.Unix(1000, 0)}},
+			saveSessionCall:   aws.Bool(false),
+			expectedCreatedAt: time.Unix(1000, 0),
+			expectedErr:       "session revoked",
+		},
+		{
+			name:           "session lookup error",
+			getDriverCall:  &getDriverCall{result: &store.Driver{DriverID: 12345}},
+			getSessionCall: &getSessionCall{err: errors.New("database error")},
+			expectedErr:    "getting session",
+		},
+		{
+			name:          "driver lookup error",
+			getDriverCall: &getDriverCall{err: errors.New("database error")},
+			expectedErr:   "getting driver record",
+		},
+		{
+			name:        "refresh error",
+			refreshErr:  errors.New("connection reset"),
+			expectedErr: "refreshing iRacing token",
+		},
+		{
+			name:                "refresh token rejected",
+			refreshErr:          fmt.Errorf("token refresh failed: %w, status 401", iracing.ErrTokenRejected),
+			expectRecordFailure: true,
+			expectedErr:         "refreshing iRacing token: token refresh failed: token request rejected",
+		},
+		{
+			name:          "too many failed attempts",
+			guardCheckErr: &TooManyAttemptsError{RetryAt: fixedNow.Add(time.Hour)},
+			expectedErr:   "too many failed sign in attempts",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			guard := NewMockLoginGuard(t)
+			guard.EXPECT().Check(mock.Anything, []string{"ip#2001:db8:1:2::/64", "customer#12345"}).Return(tc.guardCheckErr)
+			if tc.expectRecordFailure {
+				guard.EXPECT().RecordFailure(mock.Anything, []string{"ip#2001:db8:1:2::/64", "customer#12345"}).Return(nil)
+			}
+
+			oauthClient := NewMockOAuthClient(t)
+			if tc.guardCheckErr == nil {
+				var tokenResp *iracing.TokenResponse
+				if tc.refreshErr == nil {
+					tokenResp = &iracing.TokenResponse{
+						AccessToken:  "new-access-token",
+						RefreshToken: "new-refresh-token",
+						ExpiresIn:    3600,
+					}
+				}
+				oauthClient.EXPECT().RefreshToken(mock.Anything, "refresh-token").Return(tokenResp, tc.refreshErr)
+			}
+
+			driverStore := NewMockDriverStore(t)
+			if tc.getDriverCall != nil {
+				driverStore.EXPECT().GetDriver(mock.Anything, int64(12345)).Return(tc.getDriverCall.result, tc.getDriverCall.err)
+			}
+			if tc.getSessionCall != nil {
+				driverStore.EXPECT().GetAuthSession(mock.Anything, int64(12345), "session-id").Return(tc.getSessionCall.result, tc.getSessionCall.err)
+			}
+
+			jwtCreator := NewMockJWTCreator(t)
+			if tc.saveSessionCall != nil {
+				jwtCreator.EXPECT().Lifetime().Return(24 * time.Hour)
+				driverStore.EXPECT().SaveAuthSession(mock.Anything, store.AuthSession{
+					DriverID:  12345,
+					SessionID: "session-id",
+					UserAgent: "Firefox",
+					IPPrefix:  "2001:db8:1::/48",
+					CreatedAt: tc.expectedCreatedAt,
+					IssuedAt:  fixedNow,
+					ExpiresAt: fixedNow.Add(24 * time.Hour),
+				}).Return(*tc.saveSessionCall, nil)
+			}
+			if tc.expectedErr == "" {
+				jwtCreator.EXPECT().CreateToken(mock.Anything, "session-id", int64(12345), "Test Driver", tc.expectedEntitlements, "new-access-token", "new-refresh-token", expectedTokenExpiry).Return("jwt-token", nil)
+			}
+
+			service := NewService(oauthClient, jwtCreator, NewMockUserInfoProvider(t), driverStore, guard)
+			service.now = func() time.Time { return fixedNow }
+
+			result, err := service.HandleRefresh(ctx, "session-id", 12345, "Test Driver", []string{"developer"}, "refresh-token", Client{UserAgent: "Firefox", IP: "2001:db8:1:2::7"})
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, &Result{
+					Token:     "jwt-token",
+					ExpiresAt: expectedTokenExpiry,
+					UserID:    12345,
+					UserName:  "Test Driver",
+				}, result)
+			}
+		})
+	}
+}
+
+func TestService_ListSessions(t *testing.T) {
+	ctx := context.Background()
+	revokedAt := time.Unix(4000, 0)
+
+	driverStore := NewMockDriverStore(t)
+	driverStore.EXPECT().GetAuthSessions(mock.Anything, int64(12345)).Return([]store.AuthSession{
+		{DriverID: 12345, SessionID: "session-b"},
+		{DriverID: 12345, SessionID: "session-a", RevokedAt: &revokedAt},
+		{DriverID: 12345, SessionID: "session-c"},
+	}, nil)
+
+	service := NewService(NewMockOAuthClient(t), NewMockJWTCreator(t), NewMockUserInfoProvider(t), driverStore, NewMockLoginGuard(t))
+
+	sessions, err := service.ListSessions(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, []store.AuthSession{
+		{DriverID: 12345, SessionID: "session-b"},
+		{DriverID: 12345, SessionID: "session-c"},
+	}, sessions)
+}
+
+func TestService_RevokeOtherSessions(t *testing.T) {
+	ctx := context.Background()
+	fixedNow := time.Unix(5000, 0)
+
+	driverStore := NewMockDriverStore(t)
+	driverStore.EXPECT().GetAuthSessions(mock.Anything, int64(12345)).Return([]store.AuthSession{
+		{DriverID: 12345, SessionID: "session-b"},
+		{DriverID: 12345, SessionID: "current"},
+		{DriverID: 12345, SessionID: "session-c"},
+	}, nil)
+	driverStore.EXPECT().RevokeAuthSession(mock.Anything, int64(12345), "session-b", fixedNow).Return(true, nil)
+	// gone by the time it was revoked
+	driverStore.EXPECT().RevokeAuthSession(mock.Anything, int64(12345), "session-c", fixedNow).Return(false, nil)
+
+	service := NewService(NewMockOAuthClient(t), NewMockJWTCreator(t), NewMockUserInfoProvider(t), driverStore, NewMockLoginGuard(t))
+	service.now = func() time.Time { return fixedNow }
+
+	count, err := service.RevokeOtherSessions(ctx, 12345, "current")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestCoarseIP(t *testing.T) {
+	assert.Equal(t, "203.0.113.0/24", coarseIP("203.0.113.77"))
+	assert.Equal(t, "203.0.113.0/24", coarseIP("::ffff:203.0.113.77"))
+	assert.Equal(t, "2001:db8:1::/48", coarseIP("2001:db8:1:2:3:4:5:6"))
+	assert.Equal(t, "", coarseIP("203.0.113.77:443"))
+	assert.Equal(t, "", coarseIP(""))
+}
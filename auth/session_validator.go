@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+// DefaultSessionCheckInterval is how long a session found unrevoked is trusted, keeping the revocation check from
+// costing a store read on every request. Revocations take hold within it.
+const DefaultSessionCheckInterval = 30 * time.Second
+
+// maxCheckedSessions bounds the sessions remembered as checked, stale ones are swept out once it is reached.
+const maxCheckedSessions = 10000
+
+type TokenValidator interface {
+	ValidateToken(ctx context.Context, tokenString string) (*SessionClaims, *SensitiveClaims, error)
+}
+
+type SessionStore interface {
+	GetAuthSession(ctx context.Context, driverID int64, sessionID string) (*store.AuthSession, error)
+}
+
+// SessionValidator rejects tokens whose auth session has been revoked, on top of the checks the token validator makes.
+// Tokens with no recorded session, issued before sessions were, are let through.
+type SessionValidator struct {
+	tokens        TokenValidator
+	store         SessionStore
+	checkInterval time.Duration
+	now           func() time.Time
+
+	mu      sync.Mutex
+	checked map[string]time.Time
+}
+
+func NewSessionValidator(tokens TokenValidator, store SessionStore, checkInterval time.Duration) *SessionValidator {
+	return &SessionValidator{
+		tokens:        tokens,
+		store:         store,
+		checkInterval: checkInterval,
+		now:           time.Now,
+		checked:       make(map[string]time.Time),
+	}
+}
+
+func (v *SessionValidator) ValidateToken(ctx context.Context, tokenString string) (*SessionClaims, *SensitiveClaims, error) {
+	sessionClaims, sensitiveClaims, err := v.tokens.ValidateToken(ctx, tokenString)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := v.checkSession(ctx, sessionClaims); err != nil {
+		return nil, nil, err
+	}
+	return sessionClaims, sensitiveClaims, nil
+}
+
+func (v *SessionValidator) checkSession(ctx context.Context, claims *SessionClaims) error {
+	now := v.now()
+	v.mu.Lock()
+	checkedAt, ok := v.checked[claims.SessionID]
+	v.mu.Unlock()
+	if ok && now.Sub(checkedAt) < v.checkInterval {
+		return nil
+	}
+
+	// the session lives in the tenant the token was issued for
+	session, err := v.store.GetAuthSession(tenant.WithID(ctx, claims.TenantID), claims.IRacingUserID, claims.SessionID)
+	if err != nil {
+		return fmt.Errorf("getting session: %w", err)
+	}
+	// A session that was never recorded is let through. Every token issued since sessions were tracked has its session
+	// saved before it goes out, and the record is kept until the session's latest token expires, so a revoked session
+	// can't go missing while a token for it still validates. What's left are tokens issued before sessions were tracked,
+	// which can't be revoked but stop validating within a token lifetime, and refreshing one records its session.
+	if session != nil && session.RevokedAt != nil {
+		return ErrSessionRevoked
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if len(v.checked) >= maxCheckedSessions {
+		for sessionID, at := range v.checked {
+			if now.Sub(at) >= v.checkInterval {
+				delete(v.checked, sessionID)
+			}
+		}
+	}
+	v.checked[claims.SessionID] = now
+	return nil
+}
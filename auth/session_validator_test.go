@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+func TestSessionValidator_ValidateToken(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(10000, 0)
+	claims := &SessionClaims{SessionID: "session-id", IRacingUserID: 12345, TenantID: "league-one"}
+	sensitive := &SensitiveClaims{IRacingAccessToken: "access-token"}
+
+	tokens := NewMockTokenValidator(t)
+	tokens.EXPECT().ValidateToken(mock.Anything, "token").Return(claims, sensitive, nil)
+
+	sessionStore := NewMockSessionStore(t)
+	sessionStore.EXPECT().GetAuthSession(mock.MatchedBy(func(ctx context.Context) bool {
+		return tenant.FromContext(ctx) == "league-one"
+	}), int64(12345), "session-id").Return(&store.AuthSession{SessionID: "session-id"}, nil).Once()
+
+	validator := NewSessionValidator(tokens, sessionStore, DefaultSessionCheckInterval)
+	validator.now = func() time.Time { return now }
+
+	gotClaims, gotSensitive, err := validator.ValidateToken(ctx, "token")
+	require.NoError(t, err)
+	assert.Equal(t, claims, gotClaims)
+	assert.Equal(t, sensitive, gotSensitive)
+
+	// trusted without another read until the check interval is up
+	now = now.Add(DefaultSessionCheckInterval - time.Second)
+	_, _, err = validator.ValidateToken(ctx, "token")
+	require.NoError(t, err)
+
+	now = now.Add(time.Second)
+	revokedAt := now
+	sessionStore.EXPECT().GetAuthSession(mock.Anything, int64(12345), "session-id").Return(&store.AuthSession{SessionID: "session-id", RevokedAt: &revokedAt}, nil).Once()
+	_, _, err = validator.ValidateToken(ctx, "token")
+	assert.ErrorIs(t, err, ErrSessionRevoked)
+}
+
+func TestSessionValidator_ValidateToken_Errors(t *testing.T) {
+	ctx := context.Background()
+	claims := &SessionClaims{SessionID: "session-id", IRacingUserID: 12345}
+
+	tokens := NewMockTokenValidator(t)
+	tokens.EXPECT().ValidateToken(mock.Anything, "bad-token").Return(nil, nil, errors.New("parsing token: expired"))
+	tokens.EXPECT().ValidateToken(mock.Anything, "token").Return(claims, &SensitiveClaims{}, nil)
+
+	sessionStore := NewMockSessionStore(t)
+	sessionStore.EXPECT().GetAuthSession(mock.Anything, int64(12345), "session-id").Return(nil, errors.New("db error"))
+
+	validator := NewSessionValidator(tokens, sessionStore, DefaultSessionCheckInterval)
+
+	_, _, err := validator.ValidateToken(ctx, "bad-token")
+	assert.ErrorContains(t, err, "parsing token: expired")
+
+	_, _, err = validator.ValidateToken(ctx, "token")
+	assert.ErrorContains(t, err, "getting session: db error")
+}
+
+func TestSessionValidator_ValidateToken_UnrecordedSession(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(10000, 0)
+	// issued before sessions were tracked, so there's no session to check
+	claims := &SessionClaims{SessionID: "legacy-session", IRacingUserID: 12345}
+
+	tokens := NewMockTokenValidator(t)
+	tokens.EXPECT().ValidateToken(mock.Anything, "legacy-token").Return(claims, &SensitiveClaims{}, nil)
+
+	sessionStore := NewMockSessionStore(t)
+	sessionStore.EXPECT().GetAuthSession(mock.Anything, int64(12345), "legacy-session").Return(nil, nil).Once()
+
+	validator := NewSessionValidator(tokens, sessionStore, DefaultSessionCheckInterval)
+	validator.now = func() time.Time { return now }
+
+	gotClaims, _, err := validator.ValidateToken(ctx, "legacy-token")
+	require.NoError(t, err)
+	assert.Equal(t, claims, gotClaims)
+
+	// once a refresh has recorded the session it can be revoked like any other
+	now = now.Add(DefaultSessionCheckInterval)
+	revokedAt := now
+	sessionStore.EXPECT().GetAuthSession(mock.Anything, int64(12345), "legacy-session").
+		Return(&store.AuthSession{SessionID: "legacy-session", RevokedAt: &revokedAt}, nil).Once()
+	_, _, err = validator.ValidateToken(ctx, "legacy-token")
+	assert.ErrorIs(t, err, ErrSessionRevoked)
+}
@@ -0,0 +1,110 @@
+// Package benchmark compares opted in drivers against the anonymized distributions of other opted in drivers at a
+// similar iRating, in the same series at the same track.
+package benchmark
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// LookbackDays is how far back races are sampled, both when building the tables and when comparing a driver to them.
+const LookbackDays = 90
+
+// IRatingBandWidth is the span of iRating drivers are grouped into, races go in the band of the driver's iRating going
+// into them.
+const IRatingBandWidth = 500
+
+// MinDrivers is the fewest drivers a distribution is built from, fewer and the percentiles start to give away how
+// individual drivers went.
+const MinDrivers = 10
+
+// ConsistencyMeasurer works out how consistent a driver's laps were in a race.
+type ConsistencyMeasurer interface {
+	RaceOffBestPercent(ctx context.Context, session store.DriverSession) (float64, bool, error)
+}
+
+// IRatingBand returns the bottom of the band an iRating falls in.
+func IRatingBand(iRating int) int {
+	return iRating / IRatingBandWidth * IRatingBandWidth
+}
+
+type tableKey struct {
+	seriesID int64
+	trackID  int64
+	band     int
+}
+
+// sample is one driver's races at a series, track and iRating band.
+type sample struct {
+	races            int
+	incidents        int
+	consistencyRaces int
+	offBestPercent   float64
+	// latest is the most recent race in the sample
+	latest store.DriverSession
+}
+
+func (s *sample) incidentsPerRace() float64 {
+	return float64(s.incidents) / float64(s.races)
+}
+
+// avgOffBestPercent returns false when none of the races had enough laps to measure.
+func (s *sample) avgOffBestPercent() (float64, bool) {
+	if s.consistencyRaces == 0 {
+		return 0, false
+	}
+	return s.offBestPercent / float64(s.consistencyRaces), true
+}
+
+// sampleSessions groups a driver's sessions into samples. Sessions ingested before the series was recorded can't be
+// placed and are left out.
+func sampleSessions(ctx context.Context, consistency ConsistencyMeasurer, sessions []store.DriverSession) (map[tableKey]*sample, error) {
+	samples := make(map[tableKey]*sample)
+	for _, session := range sessions {
+		if session.SeriesID == 0 {
+			continue
+		}
+		key := tableKey{seriesID: session.SeriesID, trackID: session.TrackID, band: IRatingBand(session.OldIRating)}
+		s, ok := samples[key]
+		if !ok {
+			s = &sample{}
+			samples[key] = s
+		}
+		s.races++
+		s.incidents += session.Incidents
+		if session.StartTime.After(s.latest.StartTime) {
+			s.latest = session
+		}
+
+		offBest, ok, err := consistency.RaceOffBestPercent(ctx, session)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			s.consistencyRaces++
+			s.offBestPercent += offBest
+		}
+	}
+	return samples, nil
+}
+
+// percentiles interpolates between the closest ranks of values, which must not be empty. values is sorted in place.
+func percentiles(values []float64) store.BenchmarkPercentiles {
+	sort.Float64s(values)
+	at := func(p float64) float64 {
+		pos := p * float64(len(values)-1)
+		lower := int(math.Floor(pos))
+		upper := int(math.Ceil(pos))
+		return values[lower] + (values[upper]-values[lower])*(pos-float64(lower))
+	}
+	return store.BenchmarkPercentiles{
+		P10: at(0.10),
+		P25: at(0.25),
+		P50: at(0.50),
+		P75: at(0.75),
+		P90: at(0.90),
+	}
+}
@@ -0,0 +1,105 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// JobStore defines the data access methods needed by the aggregation job.
+type JobStore interface {
+	GetDriverSettingsWithBenchmarkOptIn(ctx context.Context) ([]store.DriverSettings, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	SaveBenchmarkTable(ctx context.Context, table store.BenchmarkTable) error
+}
+
+// Job rebuilds the benchmark tables from the races of every opted in driver.
+type Job struct {
+	store       JobStore
+	consistency ConsistencyMeasurer
+	now         func() time.Time
+}
+
+func NewJob(store JobStore, consistency ConsistencyMeasurer) *Job {
+	return &Job{
+		store:       store,
+		consistency: consistency,
+		now:         time.Now,
+	}
+}
+
+// Run samples every opted in driver's races over the last LookbackDays and saves a table for each series, track and
+// iRating band with at least MinDrivers drivers. A driver that can't be sampled is left out rather than holding up
+// everyone else's tables; all failures are returned together.
+func (j *Job) Run(ctx context.Context) error {
+	logger := zerolog.Ctx(ctx)
+
+	settings, err := j.store.GetDriverSettingsWithBenchmarkOptIn(ctx)
+	if err != nil {
+		return fmt.Errorf("getting opted in drivers: %w", err)
+	}
+
+	now := j.now()
+	var errs []error
+	grouped := make(map[tableKey][]*sample)
+	for _, s := range settings {
+		sessions, err := j.store.GetDriverSessionsByTimeRange(ctx, s.DriverID, now.AddDate(0, 0, -LookbackDays), now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("getting sessions for driver %d: %w", s.DriverID, err))
+			continue
+		}
+		samples, err := sampleSessions(ctx, j.consistency, sessions)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("sampling driver %d: %w", s.DriverID, err))
+			continue
+		}
+		for key, sample := range samples {
+			grouped[key] = append(grouped[key], sample)
+		}
+	}
+
+	saved := 0
+	for key, samples := range grouped {
+		if len(samples) < MinDrivers {
+			continue
+		}
+		if err := j.store.SaveBenchmarkTable(ctx, buildTable(key, samples, now)); err != nil {
+			errs = append(errs, fmt.Errorf("saving table for series %d track %d band %d: %w", key.seriesID, key.trackID, key.band, err))
+			continue
+		}
+		saved++
+	}
+
+	logger.Info().Int("optedInCount", len(settings)).Int("tableCount", saved).Int("failureCount", len(errs)).Msg("benchmark tables computed")
+	return errors.Join(errs...)
+}
+
+func buildTable(key tableKey, samples []*sample, computedAt time.Time) store.BenchmarkTable {
+	incidents := make([]float64, 0, len(samples))
+	var offBest []float64
+	for _, s := range samples {
+		incidents = append(incidents, s.incidentsPerRace())
+		if value, ok := s.avgOffBestPercent(); ok {
+			offBest = append(offBest, value)
+		}
+	}
+
+	table := store.BenchmarkTable{
+		SeriesID:           key.seriesID,
+		TrackID:            key.trackID,
+		IRatingBand:        key.band,
+		ComputedAt:         computedAt,
+		Drivers:            len(samples),
+		IncidentsPerRace:   percentiles(incidents),
+		ConsistencyDrivers: len(offBest),
+	}
+	if len(offBest) >= MinDrivers {
+		distribution := percentiles(offBest)
+		table.OffBestPercent = &distribution
+	}
+	return table
+}
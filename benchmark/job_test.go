@@ -0,0 +1,136 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJob_Run(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	from := now.AddDate(0, 0, -LookbackDays)
+	ctx := zerolog.Nop().WithContext(context.Background())
+
+	var optedIn []store.DriverSettings
+	for driverID := int64(1); driverID <= MinDrivers; driverID++ {
+		optedIn = append(optedIn, store.DriverSettings{DriverID: driverID, BenchmarkOptIn: true})
+	}
+
+	// every driver races twice at Okayama averaging as many incidents as their driver ID, a few also race at Laguna Seca
+	// which isn't enough drivers for a table
+	sessionsFor := func(driverID int64) []store.DriverSession {
+		sessions := []store.DriverSession{
+			{DriverID: driverID, SubsessionID: driverID*10 + 1, SeriesID: 139, TrackID: 219, OldIRating: 1500 + int(driverID)*10, Incidents: int(driverID) - 1, StartTime: now.Add(-48 * time.Hour)},
+			{DriverID: driverID, SubsessionID: driverID*10 + 2, SeriesID: 139, TrackID: 219, OldIRating: 1500 + int(driverID)*20, Incidents: int(driverID) + 1, StartTime: now.Add(-24 * time.Hour)},
+			// ingested before the series was recorded
+			{DriverID: driverID, SubsessionID: driverID*10 + 3, TrackID: 219, OldIRating: 1500, Incidents: 20, StartTime: now.Add(-12 * time.Hour)},
+		}
+		if driverID <= 3 {
+			sessions = append(sessions, store.DriverSession{DriverID: driverID, SubsessionID: driverID*10 + 4, SeriesID: 200, TrackID: 47, OldIRating: 2100, StartTime: now.Add(-6 * time.Hour)})
+		}
+		return sessions
+	}
+
+	// lap consistency is twice the driver ID at Okayama, Laguna Seca never has enough laps
+	offBest := func(_ context.Context, session store.DriverSession) (float64, bool, error) {
+		if session.TrackID != 219 {
+			return 0, false, nil
+		}
+		return float64(session.DriverID * 2), true, nil
+	}
+
+	assertPercentiles := func(t *testing.T, expected, actual store.BenchmarkPercentiles) {
+		assert.InDelta(t, expected.P10, actual.P10, 0.0001)
+		assert.InDelta(t, expected.P25, actual.P25, 0.0001)
+		assert.InDelta(t, expected.P50, actual.P50, 0.0001)
+		assert.InDelta(t, expected.P75, actual.P75, 0.0001)
+		assert.InDelta(t, expected.P90, actual.P90, 0.0001)
+	}
+
+	t.Run("builds tables for bands with enough drivers", func(t *testing.T) {
+		mockStore := NewMockJobStore(t)
+		mockStore.EXPECT().GetDriverSettingsWithBenchmarkOptIn(mock.Anything).Return(optedIn, nil)
+		for _, s := range optedIn {
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, s.DriverID, from, now).Return(sessionsFor(s.DriverID), nil)
+		}
+		var saved []store.BenchmarkTable
+		mockStore.EXPECT().SaveBenchmarkTable(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, table store.BenchmarkTable) error {
+			saved = append(saved, table)
+			return nil
+		})
+		mockConsistency := NewMockConsistencyMeasurer(t)
+		mockConsistency.EXPECT().RaceOffBestPercent(mock.Anything, mock.Anything).RunAndReturn(offBest)
+
+		job := NewJob(mockStore, mockConsistency)
+		job.now = func() time.Time { return now }
+		require.NoError(t, job.Run(ctx))
+
+		require.Len(t, saved, 1)
+		table := saved[0]
+		assert.Equal(t, int64(139), table.SeriesID)
+		assert.Equal(t, int64(219), table.TrackID)
+		assert.Equal(t, 1500, table.IRatingBand)
+		assert.Equal(t, now, table.ComputedAt)
+		assert.Equal(t, MinDrivers, table.Drivers)
+		assert.Equal(t, MinDrivers, table.ConsistencyDrivers)
+		assertPercentiles(t, store.BenchmarkPercentiles{P10: 1.9, P25: 3.25, P50: 5.5, P75: 7.75, P90: 9.1}, table.IncidentsPerRace)
+		require.NotNil(t, table.OffBestPercent)
+		assertPercentiles(t, store.BenchmarkPercentiles{P10: 3.8, P25: 6.5, P50: 11, P75: 15.5, P90: 18.2}, *table.OffBestPercent)
+	})
+
+	t.Run("drivers that can't be sampled are left out", func(t *testing.T) {
+		mockStore := NewMockJobStore(t)
+		mockStore.EXPECT().GetDriverSettingsWithBenchmarkOptIn(mock.Anything).Return(append(optedIn, store.DriverSettings{DriverID: 99, BenchmarkOptIn: true}), nil)
+		for _, s := range optedIn {
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, s.DriverID, from, now).Return(sessionsFor(s.DriverID), nil)
+		}
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, int64(99), from, now).Return(nil, errors.New("boom"))
+		mockStore.EXPECT().SaveBenchmarkTable(mock.Anything, mock.MatchedBy(func(table store.BenchmarkTable) bool {
+			return table.Drivers == MinDrivers
+		})).Return(nil)
+		mockConsistency := NewMockConsistencyMeasurer(t)
+		mockConsistency.EXPECT().RaceOffBestPercent(mock.Anything, mock.Anything).RunAndReturn(offBest)
+
+		job := NewJob(mockStore, mockConsistency)
+		job.now = func() time.Time { return now }
+		assert.EqualError(t, job.Run(ctx), "getting sessions for driver 99: boom")
+	})
+
+	t.Run("not enough lap data for a consistency distribution", func(t *testing.T) {
+		mockStore := NewMockJobStore(t)
+		mockStore.EXPECT().GetDriverSettingsWithBenchmarkOptIn(mock.Anything).Return(optedIn, nil)
+		for _, s := range optedIn {
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, s.DriverID, from, now).Return(sessionsFor(s.DriverID), nil)
+		}
+		mockStore.EXPECT().SaveBenchmarkTable(mock.Anything, mock.MatchedBy(func(table store.BenchmarkTable) bool {
+			return table.OffBestPercent == nil && table.ConsistencyDrivers == MinDrivers-1
+		})).Return(nil)
+		mockConsistency := NewMockConsistencyMeasurer(t)
+		mockConsistency.EXPECT().RaceOffBestPercent(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, session store.DriverSession) (float64, bool, error) {
+			if session.DriverID == 1 {
+				return 0, false, nil
+			}
+			return offBest(ctx, session)
+		})
+
+		job := NewJob(mockStore, mockConsistency)
+		job.now = func() time.Time { return now }
+		assert.NoError(t, job.Run(ctx))
+	})
+
+	t.Run("settings error", func(t *testing.T) {
+		mockStore := NewMockJobStore(t)
+		mockStore.EXPECT().GetDriverSettingsWithBenchmarkOptIn(mock.Anything).Return(nil, errors.New("boom"))
+
+		job := NewJob(mockStore, NewMockConsistencyMeasurer(t))
+		job.now = func() time.Time { return now }
+		assert.EqualError(t, job.Run(ctx), "getting opted in drivers: boom")
+	})
+}
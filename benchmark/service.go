@@ -0,0 +1,122 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// ErrNotOptedIn is returned when a driver that hasn't opted in to benchmarking asks to be compared. Comparing is only
+// offered to drivers that contribute to the tables.
+var ErrNotOptedIn = errors.New("driver has not opted in to benchmarking")
+
+// ServiceStore defines the data access methods needed by the benchmark service.
+type ServiceStore interface {
+	GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	GetBenchmarkTable(ctx context.Context, seriesID, trackID int64, iRatingBand int) (*store.BenchmarkTable, error)
+}
+
+// Service compares a driver against the benchmark tables.
+type Service struct {
+	store       ServiceStore
+	consistency ConsistencyMeasurer
+	now         func() time.Time
+}
+
+func NewService(store ServiceStore, consistency ConsistencyMeasurer) *Service {
+	return &Service{
+		store:       store,
+		consistency: consistency,
+		now:         time.Now,
+	}
+}
+
+// Comparison is a driver's own figures for a series and track alongside the table for their iRating band.
+type Comparison struct {
+	SeriesID         int64
+	SeriesName       string
+	TrackID          int64
+	Races            int
+	IncidentsPerRace float64
+	// OffBestPercent is nil when none of the driver's races had enough laps to measure
+	OffBestPercent *float64
+	Table          store.BenchmarkTable
+}
+
+// GetComparisons compares the driver at each series and track they've raced over the last LookbackDays, using the
+// iRating band of their latest race there. Series and tracks without a table for that band, because too few opted in
+// drivers race there, are left out. Those with the most races come first.
+func (s *Service) GetComparisons(ctx context.Context, driverID int64) ([]Comparison, error) {
+	settings, err := s.store.GetDriverSettings(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting settings: %w", err)
+	}
+	if !settings.BenchmarkOptIn {
+		return nil, ErrNotOptedIn
+	}
+
+	now := s.now()
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, driverID, now.AddDate(0, 0, -LookbackDays), now)
+	if err != nil {
+		return nil, fmt.Errorf("getting sessions: %w", err)
+	}
+	samples, err := sampleSessions(ctx, s.consistency, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	// a driver who has moved bands is compared at the one they're in now
+	type seriesTrack struct {
+		seriesID int64
+		trackID  int64
+	}
+	current := make(map[seriesTrack]tableKey)
+	for key, sample := range samples {
+		st := seriesTrack{seriesID: key.seriesID, trackID: key.trackID}
+		existing, ok := current[st]
+		if !ok || sample.latest.StartTime.After(samples[existing].latest.StartTime) {
+			current[st] = key
+		}
+	}
+
+	comparisons := []Comparison{}
+	for _, key := range current {
+		table, err := s.store.GetBenchmarkTable(ctx, key.seriesID, key.trackID, key.band)
+		if err != nil {
+			return nil, fmt.Errorf("getting table for series %d track %d: %w", key.seriesID, key.trackID, err)
+		}
+		if table == nil {
+			continue
+		}
+		sample := samples[key]
+		comparison := Comparison{
+			SeriesID:         key.seriesID,
+			SeriesName:       sample.latest.SeriesName,
+			TrackID:          key.trackID,
+			Races:            sample.races,
+			IncidentsPerRace: sample.incidentsPerRace(),
+			Table:            *table,
+		}
+		if offBest, ok := sample.avgOffBestPercent(); ok {
+			comparison.OffBestPercent = &offBest
+		}
+		comparisons = append(comparisons, comparison)
+	}
+
+	sort.Slice(comparisons, func(i, j int) bool {
+		a, b := comparisons[i], comparisons[j]
+		if a.Races != b.Races {
+			return a.Races > b.Races
+		}
+		if a.SeriesID != b.SeriesID {
+			return a.SeriesID < b.SeriesID
+		}
+		return a.TrackID < b.TrackID
+	})
+	return comparisons, nil
+}
@@ -0,0 +1,135 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetComparisons(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Unix(1700000000, 0)
+	from := now.AddDate(0, 0, -LookbackDays)
+	ptr := func(v float64) *float64 { return &v }
+
+	sessions := []store.DriverSession{
+		// before the driver moved up a band, so not compared
+		{DriverID: driverID, SubsessionID: 1, SeriesID: 139, SeriesName: "Global Mazda MX-5 Cup", TrackID: 219, OldIRating: 1450, Incidents: 12, StartTime: now.Add(-72 * time.Hour)},
+		{DriverID: driverID, SubsessionID: 2, SeriesID: 139, SeriesName: "Global Mazda MX-5 Cup", TrackID: 219, OldIRating: 1520, Incidents: 2, StartTime: now.Add(-48 * time.Hour)},
+		{DriverID: driverID, SubsessionID: 3, SeriesID: 139, SeriesName: "Global Mazda MX-5 Cup", TrackID: 219, OldIRating: 1540, Incidents: 4, StartTime: now.Add(-24 * time.Hour)},
+		// too few opted in drivers for a table
+		{DriverID: driverID, SubsessionID: 4, SeriesID: 200, SeriesName: "GT3 Challenge", TrackID: 47, OldIRating: 2100, Incidents: 6, StartTime: now.Add(-12 * time.Hour)},
+		// ingested before the series was recorded
+		{DriverID: driverID, SubsessionID: 5, TrackID: 47, OldIRating: 2100, StartTime: now.Add(-6 * time.Hour)},
+	}
+	offBest := func(_ context.Context, session store.DriverSession) (float64, bool, error) {
+		if session.SubsessionID == 2 {
+			return 1.5, true, nil
+		}
+		return 0, false, nil
+	}
+	table := &store.BenchmarkTable{
+		SeriesID:           139,
+		TrackID:            219,
+		IRatingBand:        1500,
+		ComputedAt:         now.Add(-time.Hour),
+		Drivers:            14,
+		IncidentsPerRace:   store.BenchmarkPercentiles{P10: 0.5, P25: 1, P50: 2.25, P75: 4, P90: 6.5},
+		OffBestPercent:     &store.BenchmarkPercentiles{P10: 0.4, P25: 0.7, P50: 1.1, P75: 1.6, P90: 2.3},
+		ConsistencyDrivers: 11,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func(*MockServiceStore, *MockConsistencyMeasurer)
+		expected      []Comparison
+		expectedError string
+	}{
+		{
+			name: "compares series and tracks with a table",
+			setupMocks: func(m *MockServiceStore, c *MockConsistencyMeasurer) {
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID, BenchmarkOptIn: true}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, now).Return(sessions, nil)
+				m.EXPECT().GetBenchmarkTable(mock.Anything, int64(139), int64(219), 1500).Return(table, nil)
+				m.EXPECT().GetBenchmarkTable(mock.Anything, int64(200), int64(47), 2000).Return(nil, nil)
+				c.EXPECT().RaceOffBestPercent(mock.Anything, mock.Anything).RunAndReturn(offBest)
+			},
+			expected: []Comparison{
+				{
+					SeriesID:         139,
+					SeriesName:       "Global Mazda MX-5 Cup",
+					TrackID:          219,
+					Races:            2,
+					IncidentsPerRace: 3,
+					OffBestPercent:   ptr(1.5),
+					Table:            *table,
+				},
+			},
+		},
+		{
+			name: "nothing to compare",
+			setupMocks: func(m *MockServiceStore, c *MockConsistencyMeasurer) {
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID, BenchmarkOptIn: true}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, now).Return(nil, nil)
+			},
+			expected: []Comparison{},
+		},
+		{
+			name: "not opted in",
+			setupMocks: func(m *MockServiceStore, c *MockConsistencyMeasurer) {
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID}, nil)
+			},
+			expectedError: ErrNotOptedIn.Error(),
+		},
+		{
+			name: "settings error",
+			setupMocks: func(m *MockServiceStore, c *MockConsistencyMeasurer) {
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(nil, errors.New("boom"))
+			},
+			expectedError: "getting settings: boom",
+		},
+		{
+			name: "sessions error",
+			setupMocks: func(m *MockServiceStore, c *MockConsistencyMeasurer) {
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID, BenchmarkOptIn: true}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, now).Return(nil, errors.New("boom"))
+			},
+			expectedError: "getting sessions: boom",
+		},
+		{
+			name: "table error",
+			setupMocks: func(m *MockServiceStore, c *MockConsistencyMeasurer) {
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID, BenchmarkOptIn: true}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, now).Return(sessions[1:3], nil)
+				m.EXPECT().GetBenchmarkTable(mock.Anything, int64(139), int64(219), 1500).Return(nil, errors.New("boom"))
+				c.EXPECT().RaceOffBestPercent(mock.Anything, mock.Anything).RunAndReturn(offBest)
+			},
+			expectedError: "getting table for series 139 track 219: boom",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockServiceStore(t)
+			mockConsistency := NewMockConsistencyMeasurer(t)
+			tc.setupMocks(mockStore, mockConsistency)
+
+			svc := NewService(mockStore, mockConsistency)
+			svc.now = func() time.Time { return now }
+
+			comparisons, err := svc.GetComparisons(context.Background(), driverID)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, comparisons)
+		})
+	}
+}
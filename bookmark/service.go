@@ -0,0 +1,171 @@
+package bookmark
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// MaxNoteLength caps bookmark notes. They're meant to say what happened at the moment, longer reflection belongs in
+// the race's journal entry.
+const MaxNoteLength = 1000
+
+// ErrRaceNotFound is returned when bookmarking a race the driver doesn't have.
+var ErrRaceNotFound = errors.New("race not found")
+
+// ValidateNote checks a bookmark note. Returns validation errors for a blank or overly long note.
+func ValidateNote(note string) []journal.FieldValidation {
+	if strings.TrimSpace(note) == "" {
+		return []journal.FieldValidation{{Field: "note", Code: "required"}}
+	}
+	if len(note) > MaxNoteLength {
+		return []journal.FieldValidation{{
+			Field:  "note",
+			Code:   "too_long",
+			Params: map[string]string{"max": strconv.Itoa(MaxNoteLength)},
+		}}
+	}
+	return nil
+}
+
+// ValidateLap checks a bookmark's lap. Lap zero is the formation or pace lap, anything below that is an error.
+func ValidateLap(lap int) []journal.FieldValidation {
+	if lap < 0 {
+		return []journal.FieldValidation{{Field: "lap", Code: "out_of_range", Params: map[string]string{"min": "0"}}}
+	}
+	return nil
+}
+
+// ValidateReplayTime checks a bookmark's replay timecode, which can't be before the start of the replay.
+func ValidateReplayTime(replayTime time.Duration) []journal.FieldValidation {
+	if replayTime < 0 {
+		return []journal.FieldValidation{{Field: "replayTimeMs", Code: "out_of_range", Params: map[string]string{"min": "0"}}}
+	}
+	return nil
+}
+
+// Store defines the data access methods needed by the bookmark service.
+type Store interface {
+	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)
+	SaveRaceBookmark(ctx context.Context, bookmark store.RaceBookmark) error
+	GetRaceBookmark(ctx context.Context, driverID, raceID int64, bookmarkID string) (*store.RaceBookmark, error)
+	GetRaceBookmarks(ctx context.Context, driverID, raceID int64) ([]store.RaceBookmark, error)
+	DeleteRaceBookmark(ctx context.Context, driverID, raceID int64, bookmarkID string) error
+}
+
+// Service manages the moments drivers mark in their race replays.
+type Service struct {
+	store Store
+	newID func() string
+	now   func() time.Time
+}
+
+func NewService(store Store, newID func() string) *Service {
+	return &Service{
+		store: store,
+		newID: newID,
+		now:   time.Now,
+	}
+}
+
+// CreateInput describes a new bookmark.
+type CreateInput struct {
+	DriverID   int64
+	RaceID     int64
+	Lap        int
+	Note       string
+	ReplayTime time.Duration
+}
+
+// Create bookmarks a moment in one of the driver's races. Returns ErrRaceNotFound if RaceID doesn't identify one of
+// the driver's races.
+func (s *Service) Create(ctx context.Context, input CreateInput) (*store.RaceBookmark, error) {
+	session, err := s.store.GetDriverSession(ctx, input.DriverID, store.TimeFromDriverRaceID(input.RaceID))
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, ErrRaceNotFound
+	}
+
+	now := s.now()
+	bookmark := store.RaceBookmark{
+		DriverID:   input.DriverID,
+		RaceID:     input.RaceID,
+		BookmarkID: s.newID(),
+		Lap:        input.Lap,
+		Note:       input.Note,
+		ReplayTime: input.ReplayTime,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.store.SaveRaceBookmark(ctx, bookmark); err != nil {
+		return nil, err
+	}
+	return &bookmark, nil
+}
+
+// UpdateInput describes changes to a bookmark. Nil fields are left unchanged.
+type UpdateInput struct {
+	DriverID   int64
+	RaceID     int64
+	BookmarkID string
+	Lap        *int
+	Note       *string
+	ReplayTime *time.Duration
+}
+
+// Update applies changes to a bookmark. Returns nil if the bookmark doesn't exist.
+func (s *Service) Update(ctx context.Context, input UpdateInput) (*store.RaceBookmark, error) {
+	bookmark, err := s.store.GetRaceBookmark(ctx, input.DriverID, input.RaceID, input.BookmarkID)
+	if err != nil {
+		return nil, err
+	}
+	if bookmark == nil {
+		return nil, nil
+	}
+
+	if input.Lap != nil {
+		bookmark.Lap = *input.Lap
+	}
+	if input.Note != nil {
+		bookmark.Note = *input.Note
+	}
+	if input.ReplayTime != nil {
+		bookmark.ReplayTime = *input.ReplayTime
+	}
+	bookmark.UpdatedAt = s.now()
+
+	if err := s.store.SaveRaceBookmark(ctx, *bookmark); err != nil {
+		return nil, err
+	}
+	return bookmark, nil
+}
+
+// Delete removes a bookmark. Deleting a bookmark that doesn't exist is not an error.
+func (s *Service) Delete(ctx context.Context, driverID, raceID int64, bookmarkID string) error {
+	return s.store.DeleteRaceBookmark(ctx, driverID, raceID, bookmarkID)
+}
+
+// List returns a race's bookmarks in the order they appear in the replay.
+func (s *Service) List(ctx context.Context, driverID, raceID int64) ([]store.RaceBookmark, error) {
+	bookmarks, err := s.store.GetRaceBookmarks(ctx, driverID, raceID)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(bookmarks, func(a, b store.RaceBookmark) int {
+		return cmp.Or(
+			cmp.Compare(a.ReplayTime, b.ReplayTime),
+			cmp.Compare(a.Lap, b.Lap),
+			a.CreatedAt.Compare(b.CreatedAt),
+		)
+	})
+	return bookmarks, nil
+}
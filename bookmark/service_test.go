@@ -0,0 +1,191 @@
+package bookmark
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	now := time.Unix(1700100000, 0)
+	input := CreateInput{DriverID: driverID, RaceID: raceID, Lap: 4, Note: "Lost the rear on exit", ReplayTime: 6 * time.Minute}
+	expected := store.RaceBookmark{
+		DriverID: driverID, RaceID: raceID, BookmarkID: "bookmark-1", Lap: 4, Note: "Lost the rear on exit",
+		ReplayTime: 6 * time.Minute, CreatedAt: now, UpdatedAt: now,
+	}
+
+	testCases := []struct {
+		name        string
+		setupMock   func(*MockStore)
+		expected    *store.RaceBookmark
+		expectedErr error
+		expectErr   bool
+	}{
+		{
+			name: "success",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).
+					Return(&store.DriverSession{DriverID: driverID}, nil)
+				m.EXPECT().SaveRaceBookmark(mock.Anything, expected).Return(nil)
+			},
+			expected: &expected,
+		},
+		{
+			name: "race not found",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).Return(nil, nil)
+			},
+			expectedErr: ErrRaceNotFound,
+		},
+		{
+			name: "save error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).
+					Return(&store.DriverSession{DriverID: driverID}, nil)
+				m.EXPECT().SaveRaceBookmark(mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			svc := NewService(mockStore, func() string { return "bookmark-1" })
+			svc.now = func() time.Time { return now }
+
+			result, err := svc.Create(ctx, input)
+			switch {
+			case tc.expectedErr != nil:
+				assert.ErrorIs(t, err, tc.expectedErr)
+			case tc.expectErr:
+				assert.Error(t, err)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	createdAt := time.Unix(1700000000, 0)
+	now := time.Unix(1700100000, 0)
+	lap := 5
+	note := "Lost the rear on exit, too much throttle"
+	replayTime := 7 * time.Minute
+
+	existing := &store.RaceBookmark{
+		DriverID: driverID, RaceID: raceID, BookmarkID: "bookmark-1", Lap: 4, Note: "Lost the rear on exit",
+		ReplayTime: 6 * time.Minute, CreatedAt: createdAt, UpdatedAt: createdAt,
+	}
+
+	testCases := []struct {
+		name     string
+		input    UpdateInput
+		current  *store.RaceBookmark
+		expected *store.RaceBookmark
+	}{
+		{
+			name:    "note only",
+			input:   UpdateInput{DriverID: driverID, RaceID: raceID, BookmarkID: "bookmark-1", Note: &note},
+			current: existing,
+			expected: &store.RaceBookmark{
+				DriverID: driverID, RaceID: raceID, BookmarkID: "bookmark-1", Lap: 4, Note: note,
+				ReplayTime: 6 * time.Minute, CreatedAt: createdAt, UpdatedAt: now,
+			},
+		},
+		{
+			name:    "moved to another moment",
+			input:   UpdateInput{DriverID: driverID, RaceID: raceID, BookmarkID: "bookmark-1", Lap: &lap, ReplayTime: &replayTime},
+			current: existing,
+			expected: &store.RaceBookmark{
+				DriverID: driverID, RaceID: raceID, BookmarkID: "bookmark-1", Lap: 5, Note: "Lost the rear on exit",
+				ReplayTime: 7 * time.Minute, CreatedAt: createdAt, UpdatedAt: now,
+			},
+		},
+		{
+			name:  "not found",
+			input: UpdateInput{DriverID: driverID, RaceID: raceID, BookmarkID: "bookmark-1", Note: &note},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			var current *store.RaceBookmark
+			if tc.current != nil {
+				copied := *tc.current
+				current = &copied
+			}
+			mockStore.EXPECT().GetRaceBookmark(mock.Anything, driverID, raceID, "bookmark-1").Return(current, nil)
+			if tc.expected != nil {
+				mockStore.EXPECT().SaveRaceBookmark(mock.Anything, *tc.expected).Return(nil)
+			}
+
+			svc := NewService(mockStore, nil)
+			svc.now = func() time.Time { return now }
+
+			result, err := svc.Update(ctx, tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	early := time.Unix(1700000000, 0)
+	late := time.Unix(1700500000, 0)
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetRaceBookmarks(mock.Anything, driverID, raceID).Return([]store.RaceBookmark{
+		{BookmarkID: "late-in-replay", Lap: 9, ReplayTime: 20 * time.Minute, CreatedAt: early},
+		{BookmarkID: "same-moment-added-later", Lap: 2, ReplayTime: 3 * time.Minute, CreatedAt: late},
+		{BookmarkID: "no-timecode-lap-3", Lap: 3, CreatedAt: early},
+		{BookmarkID: "same-moment", Lap: 2, ReplayTime: 3 * time.Minute, CreatedAt: early},
+		{BookmarkID: "no-timecode-lap-1", Lap: 1, CreatedAt: late},
+	}, nil)
+
+	result, err := NewService(mockStore, nil).List(ctx, driverID, raceID)
+	require.NoError(t, err)
+	ids := make([]string, len(result))
+	for i, bookmark := range result {
+		ids[i] = bookmark.BookmarkID
+	}
+	assert.Equal(t, []string{"no-timecode-lap-1", "no-timecode-lap-3", "same-moment", "same-moment-added-later", "late-in-replay"}, ids)
+}
+
+func TestValidate(t *testing.T) {
+	assert.Empty(t, ValidateNote("Contact with the 44 car"))
+	assert.Equal(t, "required", ValidateNote("  ")[0].Code)
+	tooLong := ValidateNote(strings.Repeat("a", MaxNoteLength+1))
+	require.Len(t, tooLong, 1)
+	assert.Equal(t, "too_long", tooLong[0].Code)
+	assert.Equal(t, map[string]string{"max": "1000"}, tooLong[0].Params)
+
+	assert.Empty(t, ValidateLap(0))
+	assert.Equal(t, "out_of_range", ValidateLap(-1)[0].Code)
+
+	assert.Empty(t, ValidateReplayTime(0))
+	badTime := ValidateReplayTime(-time.Second)
+	require.Len(t, badTime, 1)
+	assert.Equal(t, "replayTimeMs", badTime[0].Field)
+}
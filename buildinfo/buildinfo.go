@@ -0,0 +1,26 @@
+// Package buildinfo exposes metadata about the running build so logs, error responses and GET /version can be tied
+// back to a deployment.
+package buildinfo
+
+import "runtime"
+
+// GitSHA and BuildTime are set at build time via ldflags, see scripts/build-lambda.sh. Binaries built any other way
+// (go run, tests) get the defaults.
+var (
+	GitSHA    = "dev"
+	BuildTime = "unknown"
+)
+
+type Info struct {
+	GitSHA    string `json:"gitSha"`
+	BuildTime string `json:"buildTime"`
+	GoVersion string `json:"goVersion"`
+}
+
+func Get() Info {
+	return Info{
+		GitSHA:    GitSHA,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+}
@@ -5,54 +5,105 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"os"
 	"time"
+	// driver timezones are resolved by the API, and the Lambda runtime has no zoneinfo of its own
+	_ "time/tzdata"
 
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
-	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
-	"github.com/aws/aws-sdk-go-v2/service/sqs"
-	"github.com/aws/aws-xray-sdk-go/v2/instrumentation/awsv2"
-	"github.com/aws/aws-xray-sdk-go/v2/xray"
 	"github.com/google/uuid"
+	"github.com/jonsabados/saturdaysspinout/actionitem"
+	"github.com/jonsabados/saturdaysspinout/alert"
 	"github.com/jonsabados/saturdaysspinout/analytics"
 	apiAuth "github.com/jonsabados/saturdaysspinout/api/auth"
+	apiBenchmark "github.com/jonsabados/saturdaysspinout/api/benchmark"
 	apiCars "github.com/jonsabados/saturdaysspinout/api/cars"
+	apiCoaching "github.com/jonsabados/saturdaysspinout/api/coaching"
+	apiDashboard "github.com/jonsabados/saturdaysspinout/api/dashboard"
 	"github.com/jonsabados/saturdaysspinout/api/developer"
 	"github.com/jonsabados/saturdaysspinout/api/driver"
+	apiDrivers "github.com/jonsabados/saturdaysspinout/api/drivers"
 	"github.com/jonsabados/saturdaysspinout/api/health"
 	"github.com/jonsabados/saturdaysspinout/api/ingestion"
+	apiLeaderboards "github.com/jonsabados/saturdaysspinout/api/leaderboards"
+	apiMobile "github.com/jonsabados/saturdaysspinout/api/mobile"
+	apiOverlay "github.com/jonsabados/saturdaysspinout/api/overlay"
+	apiPublic "github.com/jonsabados/saturdaysspinout/api/public"
+	apiSchedule "github.com/jonsabados/saturdaysspinout/api/schedule"
+	apiSeasonCard "github.com/jonsabados/saturdaysspinout/api/seasoncard"
 	apiSeries "github.com/jonsabados/saturdaysspinout/api/series"
 	apiSession "github.com/jonsabados/saturdaysspinout/api/session"
+	apiSquad "github.com/jonsabados/saturdaysspinout/api/squad"
+	apiSupporter "github.com/jonsabados/saturdaysspinout/api/supporter"
 	apiTracks "github.com/jonsabados/saturdaysspinout/api/tracks"
+	"github.com/jonsabados/saturdaysspinout/benchmark"
+	"github.com/jonsabados/saturdaysspinout/bookmark"
 	"github.com/jonsabados/saturdaysspinout/cars"
+	"github.com/jonsabados/saturdaysspinout/cmd/bootstrap"
+	"github.com/jonsabados/saturdaysspinout/coaching"
+	"github.com/jonsabados/saturdaysspinout/dashboard"
 	"github.com/jonsabados/saturdaysspinout/event"
+	"github.com/jonsabados/saturdaysspinout/export"
+	"github.com/jonsabados/saturdaysspinout/mobile"
 	"github.com/jonsabados/saturdaysspinout/series"
-	"github.com/kelseyhightower/envconfig"
 	"github.com/rs/zerolog"
 
 	"github.com/jonsabados/saturdaysspinout/api"
 	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/invitations"
 	"github.com/jonsabados/saturdaysspinout/iracing"
 	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/lapimport"
 	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/onboarding"
+	"github.com/jonsabados/saturdaysspinout/overlay"
+	"github.com/jonsabados/saturdaysspinout/quota"
+	"github.com/jonsabados/saturdaysspinout/raceplan"
+	"github.com/jonsabados/saturdaysspinout/report"
+	"github.com/jonsabados/saturdaysspinout/requestcapture"
+	"github.com/jonsabados/saturdaysspinout/schedule"
+	"github.com/jonsabados/saturdaysspinout/seasoncard"
+	"github.com/jonsabados/saturdaysspinout/squad"
 	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/supporter"
+	"github.com/jonsabados/saturdaysspinout/telemetry"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+	"github.com/jonsabados/saturdaysspinout/terms"
 	"github.com/jonsabados/saturdaysspinout/tracks"
+	"github.com/jonsabados/saturdaysspinout/upstream"
+	"github.com/jonsabados/saturdaysspinout/videolink"
+	"github.com/jonsabados/saturdaysspinout/voicememo"
 )
 
 type appCfg struct {
-	LogLevel                 string   `envconfig:"LOG_LEVEL" required:"true"`
-	CORSAllowedOrigins       []string `envconfig:"CORS_ALLOWED_ORIGINS" required:"true"`
-	IRacingCredentialsSecret string   `envconfig:"IRACING_CREDENTIALS_SECRET" required:"true"`
-	JWTSigningKeySecret      string   `envconfig:"JWT_SIGNING_KEY_SECRET" required:"true"`
-	JWTEncryptionKeySecret   string   `envconfig:"JWT_ENCRYPTION_KEY_SECRET" required:"true"`
-	DynamoDBTable            string   `envconfig:"DYNAMODB_TABLE" required:"true"`
-	RaceIngestionQueueURL    string   `envconfig:"RACE_INGESTION_QUEUE_URL" required:"true"`
-	IRacingCacheBucket       string   `envconfig:"IRACING_CACHE_BUCKET" required:"true"`
-	MetricsNamespace         string   `envconfig:"METRICS_NAMESPACE" required:"true"`
+	bootstrap.Config
+	CORSAllowedOrigins        []string `envconfig:"CORS_ALLOWED_ORIGINS" required:"true"`
+	CORSAllowCredentials      bool     `envconfig:"CORS_ALLOW_CREDENTIALS" default:"true"`
+	CORSMaxAgeSeconds         int      `envconfig:"CORS_MAX_AGE_SECONDS" default:"300"`
+	IRacingCredentialsSecret  string   `envconfig:"IRACING_CREDENTIALS_SECRET" required:"true"`
+	JWTSigningKeySecret       string   `envconfig:"JWT_SIGNING_KEY_SECRET" required:"true"`
+	JWTEncryptionKeySecret    string   `envconfig:"JWT_ENCRYPTION_KEY_SECRET" required:"true"`
+	SeasonCardKeySecret       string   `envconfig:"SEASON_CARD_KEY_SECRET" required:"true"`
+	DynamoDBTable             string   `envconfig:"DYNAMODB_TABLE" required:"true"`
+	RaceIngestionQueueURL     string   `envconfig:"RACE_INGESTION_QUEUE_URL" required:"true"`
+	RaceIngestionTopicARN     string   `envconfig:"RACE_INGESTION_TOPIC_ARN"`
+	EventBackend              string   `envconfig:"EVENT_BACKEND" default:"sqs"`
+	IRacingCacheBucket        string   `envconfig:"IRACING_CACHE_BUCKET" required:"true"`
+	MetricsNamespace          string   `envconfig:"METRICS_NAMESPACE" required:"true"`
+	JournalDraftRetentionDays int      `envconfig:"JOURNAL_DRAFT_RETENTION_DAYS" default:"30"`
+	VoiceMemoBucket           string   `envconfig:"VOICE_MEMO_BUCKET" required:"true"`
+	ReportBucket              string   `envconfig:"REPORT_BUCKET" required:"true"`
+	StripeWebhookSecret       string   `envconfig:"STRIPE_WEBHOOK_SECRET" required:"true"`
+	DailyQuotas               string   `envconfig:"DAILY_QUOTAS"`
+	IRacingMaxResponseMB      int64    `envconfig:"IRACING_MAX_RESPONSE_MB" default:"64"`
+	Tenants                   []string `envconfig:"TENANTS"`
+	FieldEncryptionKeyID      string   `envconfig:"FIELD_ENCRYPTION_KEY_ID"`
+	PayloadLogSampleRate      float64  `envconfig:"PAYLOAD_LOG_SAMPLE_RATE" default:"0"`
+	PayloadLogMaxBytes        int      `envconfig:"PAYLOAD_LOG_MAX_BYTES" default:"4096"`
+	WaitlistEnabled           bool     `envconfig:"WAITLIST_ENABLED" default:"false"`
+	TermsVersions             string   `envconfig:"TERMS_VERSIONS"`
 }
 
 type iRacingCredentials struct {
@@ -61,131 +112,385 @@ type iRacingCredentials struct {
 }
 
 func CreateAPI() http.Handler {
-	ctx := context.Background()
-	zerolog.TimeFieldFormat = time.RFC3339Nano
-	zerolog.LevelFieldName = "severity"
-	logger := zerolog.New(os.Stdout).With().Timestamp().Logger()
-
-	logger.Info().Msg("starting rest API")
+	logger, deps := CreateAPIDependencies()
+	return NewAPI(logger, deps)
+}
 
+// CreateAPIDependencies loads configuration from the environment and builds the AWS backed dependencies of the API,
+// exiting on failure.
+func CreateAPIDependencies() (zerolog.Logger, APIDependencies) {
+	ctx := context.Background()
 	var cfg appCfg
-	err := envconfig.Process("", &cfg)
-	if err != nil {
-		logger.Fatal().Err(err).Msg("error loading config")
-	}
+	app := bootstrap.Start("rest API", &cfg)
+	logger := app.Logger
+	httpClient := app.HTTPClient
 
-	logLevel, err := zerolog.ParseLevel(cfg.LogLevel)
-	if err != nil {
-		logger.Fatal().Str("input", cfg.LogLevel).Err(err).Msg("error parsing log level")
-	}
-	logger = logger.Level(logLevel)
+	tenants := app.Tenants(cfg.Tenants)
 
-	err = xray.Configure(xray.Config{
-		LogLevel: "warn",
-	})
-	if err != nil {
-		logger.Fatal().Err(err).Msg("error configuring x-ray")
+	corsCfg := api.CORSConfig{
+		AllowedOrigins:   cfg.CORSAllowedOrigins,
+		AllowCredentials: cfg.CORSAllowCredentials,
+		PreflightMaxAge:  time.Duration(cfg.CORSMaxAgeSeconds) * time.Second,
 	}
-
-	// get x-ray goodness going with the http client we will be using
-	httpClient := xray.Client(http.DefaultClient)
-
-	awsCfg, err := config.LoadDefaultConfig(ctx, config.WithHTTPClient(httpClient))
-	if err != nil {
-		logger.Fatal().Err(err).Msg("error loading default config")
+	raceIngestionCfg := event.DispatcherConfig{
+		Backend:  event.Backend(cfg.EventBackend),
+		QueueURL: cfg.RaceIngestionQueueURL,
+		TopicARN: cfg.RaceIngestionTopicARN,
 	}
 
-	// add x-ray instrumentation to all the AWS clients
-	awsv2.AWSV2Instrumentor(&awsCfg.APIOptions)
+	// the secrets are loaded as part of checking the configuration, all at once rather than one round trip after another
+	var (
+		iRacingCreds        iRacingCredentials
+		stripeWebhookSecret string
+		jwtService          *auth.JWTService
+		seasonCardKeys      *seasoncard.SecretKeySource
+		quotaTiers          = quota.DefaultTierLimits
+		termsVersions       terms.Versions
+	)
+	app.VerifyConfig(ctx,
+		bootstrap.TableCheck(app.DynamoDB(), cfg.DynamoDBTable),
+		bootstrap.DispatcherCheck(app.SQS(), app.SNS(), "race ingestion", raceIngestionCfg),
+		bootstrap.SecretCheck(app.SecretsManager(), "iRacing credentials", cfg.IRacingCredentialsSecret, func(secret string) error {
+			if err := json.Unmarshal([]byte(secret), &iRacingCreds); err != nil {
+				return err
+			}
+			if iRacingCreds.OauthClientID == "" || iRacingCreds.OauthClientSecret == "" {
+				return errors.New("oauth_client_id and oauth_client_secret are required")
+			}
+			secretHash := sha256.Sum256([]byte(iRacingCreds.OauthClientSecret))
+			logger.Info().Str("oauth_client_id", iRacingCreds.OauthClientID).Str("oauth_client_secret_sha256", hex.EncodeToString(secretHash[:])).Msg("loaded iRacing OAuth credentials")
+			return nil
+		}),
+		bootstrap.SecretCheck(app.SecretsManager(), "stripe webhook signing secret", cfg.StripeWebhookSecret, func(secret string) error {
+			stripeWebhookSecret = secret
+			return nil
+		}),
+		bootstrap.Check{
+			Name: "JWT keys",
+			Check: func(ctx context.Context) error {
+				var err error
+				jwtService, err = NewJWTService(logger.WithContext(ctx), app.SecretsManager(), cfg.JWTSigningKeySecret, cfg.JWTEncryptionKeySecret)
+				return err
+			},
+		},
+		bootstrap.Check{
+			Name: "season card signing keys",
+			Check: func(ctx context.Context) error {
+				var err error
+				seasonCardKeys, err = NewSeasonCardKeySource(logger.WithContext(ctx), app.SecretsManager(), cfg.SeasonCardKeySecret)
+				return err
+			},
+		},
+		bootstrap.Check{
+			Name: "CORS",
+			Check: func(ctx context.Context) error {
+				return corsCfg.Validate()
+			},
+		},
+		bootstrap.Check{
+			Name: "daily quotas",
+			Check: func(ctx context.Context) error {
+				if cfg.DailyQuotas == "" {
+					return nil
+				}
+				var err error
+				quotaTiers, err = quota.ParseTierLimits(cfg.DailyQuotas)
+				return err
+			},
+		},
+		bootstrap.Check{
+			Name: "terms versions",
+			Check: func(ctx context.Context) error {
+				if cfg.TermsVersions == "" {
+					return nil
+				}
+				var err error
+				termsVersions, err = terms.ParseVersions(cfg.TermsVersions)
+				return err
+			},
+		},
+	)
 
-	secretsClient := secretsmanager.NewFromConfig(awsCfg)
-	secretResult, err := secretsClient.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
-		SecretId: &cfg.IRacingCredentialsSecret,
-	})
-	if err != nil {
-		logger.Fatal().Err(err).Msg("error fetching iRacing credentials from secrets manager")
-	}
+	// request time metrics are written as log lines so requests don't wait on CloudWatch
+	emfEmitter := metrics.NewEMFEmitter(os.Stdout, cfg.MetricsNamespace)
 
-	var iRacingCreds iRacingCredentials
-	err = json.Unmarshal([]byte(*secretResult.SecretString), &iRacingCreds)
-	if err != nil {
-		logger.Fatal().Err(err).Msg("error parsing iRacing credentials")
-	}
+	storeOpts := append([]store.DynamoStoreOption{store.WithReadMetrics(emfEmitter)}, app.FieldEncryption(cfg.FieldEncryptionKeyID)...)
+	driverStore := app.Store(cfg.DynamoDBTable, storeOpts...)
 
-	secretHash := sha256.Sum256([]byte(iRacingCreds.OauthClientSecret))
-	logger.Info().Str("oauth_client_id", iRacingCreds.OauthClientID).Str("oauth_client_secret_sha256", hex.EncodeToString(secretHash[:])).Msg("loaded iRacing OAuth credentials")
+	metricsClient := app.CloudWatchMetrics(cfg.MetricsNamespace)
 
-	signingKeyResult, err := secretsClient.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
-		SecretId: &cfg.JWTSigningKeySecret,
-	})
-	if err != nil {
-		logger.Fatal().Err(err).Msg("error fetching JWT signing key from secrets manager")
-	}
+	iRacingOAuthClient := iracing.NewOAuthClient(httpClient, iRacingCreds.OauthClientID, iRacingCreds.OauthClientSecret)
+	upstreamMonitor := upstream.NewMonitor(driverStore, upstream.DefaultPause, upstream.DefaultRefreshInterval)
+	iRacingClient := iracing.NewClient(httpClient, metricsClient,
+		iracing.WithAvailability(upstreamMonitor),
+		iracing.WithMaxResponseBytes(cfg.IRacingMaxResponseMB<<20),
+	)
 
-	signingKey, err := auth.ParseSigningKeyPEM([]byte(*signingKeyResult.SecretString))
-	if err != nil {
-		logger.Fatal().Err(err).Msg("error parsing JWT signing key")
-	}
-	logger.Info().Msg("loaded JWT signing key")
+	s3Client := app.S3()
+	cachingClient := iracing.NewGlobalInfoCachingClient(iRacingClient, s3Client, cfg.IRacingCacheBucket, 24*time.Hour)
 
-	encryptionKeyResult, err := secretsClient.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
-		SecretId: &cfg.JWTEncryptionKeySecret,
-	})
-	if err != nil {
-		logger.Fatal().Err(err).Msg("error fetching JWT encryption key from secrets manager")
-	}
+	voiceMemoService := voicememo.NewService(driverStore, s3.NewPresignClient(s3Client), cfg.VoiceMemoBucket, uuid.NewString)
+	reportService := report.NewService(driverStore, s3Client, s3.NewPresignClient(s3Client), cfg.ReportBucket)
 
-	encryptionKey, err := auth.ParseEncryptionKeyBase64(*encryptionKeyResult.SecretString)
+	raceIngestionDispatcher, err := event.NewEventDispatcher(raceIngestionCfg, app.SQS(), app.SNS())
 	if err != nil {
-		logger.Fatal().Err(err).Msg("error parsing JWT encryption key")
+		logger.Fatal().Err(err).Msg("error creating event dispatcher")
 	}
-	logger.Info().Msg("loaded JWT encryption key")
 
-	jwtService, err := auth.NewJWTService(signingKey, encryptionKey, uuid.NewString, "saturdaysspinout", 24*time.Hour)
-	if err != nil {
-		logger.Fatal().Err(err).Msg("error creating JWT service")
+	readinessChecks := []health.Dependency{
+		{
+			Name: "dynamodb",
+			Check: func(ctx context.Context) error {
+				_, err := driverStore.GetGlobalCounters(ctx)
+				return err
+			},
+		},
+		{
+			Name: "secrets",
+			Check: func(ctx context.Context) error {
+				// secrets are loaded once at startup, this guards against them having come back empty
+				if iRacingCreds.OauthClientID == "" || iRacingCreds.OauthClientSecret == "" {
+					return errors.New("iRacing OAuth credentials not loaded")
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "iracing",
+			Check: health.HostResolvableCheck(iracing.DataAPIBaseURL),
+		},
 	}
 
-	dynamoClient := dynamodb.NewFromConfig(awsCfg)
-	driverStore := store.NewDynamoStore(dynamoClient, cfg.DynamoDBTable)
+	return logger, APIDependencies{
+		Store:                     driverStore,
+		JWTService:                jwtService,
+		IRacingOAuthClient:        iRacingOAuthClient,
+		IRacingClient:             iRacingClient,
+		Upstream:                  upstreamMonitor,
+		GlobalInfoClient:          cachingClient,
+		DocFetcher:                iracing.NewDocClient(httpClient),
+		IngestionDispatcher:       raceIngestionDispatcher,
+		Metrics:                   metricsClient,
+		ReadinessChecks:           readinessChecks,
+		CORS:                      corsCfg,
+		JournalDraftRetentionDays: cfg.JournalDraftRetentionDays,
+		VoiceMemos:                voiceMemoService,
+		Reports:                   reportService,
+		StripeWebhookSecret:       stripeWebhookSecret,
+		QuotaTiers:                quotaTiers,
+		VideoMetadata:             videolink.NewOEmbedClient(httpClient),
+		Tenants:                   tenants,
+		PayloadLogging: api.PayloadLoggingConfig{
+			SampleRate: cfg.PayloadLogSampleRate,
+			MaxBytes:   cfg.PayloadLogMaxBytes,
+		},
+		LatencyMetrics:  emfEmitter,
+		WaitlistEnabled: cfg.WaitlistEnabled,
+		TermsVersions:   termsVersions,
+		SeasonCardKeys:  seasonCardKeys,
+	}
+}
 
-	cwClient := cloudwatch.NewFromConfig(awsCfg)
-	metricsClient := metrics.NewCloudWatchEmitter(cwClient, cfg.MetricsNamespace)
+// APIStore is everything the API needs from persistence, along with the connection tracking needed to serve the
+// WebSocket API from the same process. It is satisfied by both store.DynamoStore and store.MemoryStore.
+type APIStore interface {
+	WebSocketStore
+	auth.DriverStore
+	auth.AttemptStore
+	developer.IngestionRunStore
+	developer.CoverageStore
+	developer.IngestionTierStore
+	developer.ProxyRequestStore
+	requestcapture.Store
+	ingestion.Store
+	driver.Store
+	apiSession.Store
+	journal.Store
+	journal.StreakStore
+	actionitem.Store
+	alert.Store
+	raceplan.Store
+	bookmark.Store
+	videolink.Store
+	telemetry.Store
+	lapimport.Store
+	analytics.Store
+	coaching.Store
+	export.Store
+	apiCoaching.WeeklyRecapStore
+	dashboard.Store
+	mobile.Store
+	seasoncard.Store
+	overlay.Store
+	schedule.Store
+	benchmark.ServiceStore
+	squad.Store
+	onboarding.Store
+	supporter.Store
+	quota.Store
+	apiLeaderboards.WeeklyLeaderboardStore
+	apiDrivers.SearchStore
+	invitations.Store
+	terms.Store
+	upstream.Store
+	GetGlobalCounters(ctx context.Context) (*store.GlobalCounters, error)
+}
 
-	iRacingOAuthClient := iracing.NewOAuthClient(httpClient, iRacingCreds.OauthClientID, iRacingCreds.OauthClientSecret)
-	iRacingClient := iracing.NewClient(httpClient, metricsClient)
+// GlobalInfoClient serves the rarely changing iRacing data (tracks, cars, series, season schedules), normally from an
+// S3 backed cache.
+type GlobalInfoClient interface {
+	tracks.IRacingClient
+	cars.IRacingClient
+	series.IRacingClient
+	schedule.IRacingClient
+}
 
-	s3Client := s3.NewFromConfig(awsCfg)
-	cachingClient := iracing.NewGlobalInfoCachingClient(iRacingClient, s3Client, cfg.IRacingCacheBucket, 24*time.Hour)
+// APIDependencies are the externally backed collaborators of the API, broken out so they can be swapped for local
+// stand-ins when running outside AWS.
+type APIDependencies struct {
+	Store               APIStore
+	JWTService          *auth.JWTService
+	IRacingOAuthClient  auth.OAuthClient
+	IRacingClient       *iracing.Client
+	GlobalInfoClient    GlobalInfoClient
+	DocFetcher          developer.Fetcher
+	IngestionDispatcher ingestion.EventDispatcher
+	Metrics             journal.MetricsEmitter
+	ReadinessChecks     []health.Dependency
+	CORS                api.CORSConfig
+	// JournalDraftRetentionDays is how long unpublished journal drafts are kept, zero keeps the journal default.
+	JournalDraftRetentionDays int
+	// VoiceMemos serves journal voice memo attachments, which are left out of the API when nil.
+	VoiceMemos *voicememo.Service
+	// Reports renders weekly recaps as PDFs, which are left out of the API when nil.
+	Reports *report.Service
+	// StripeWebhookSecret verifies supporter subscription webhooks, which are all rejected when empty.
+	StripeWebhookSecret string
+	// QuotaTiers are the daily quota limits by entitlement, nil keeps quota.DefaultTierLimits.
+	QuotaTiers map[string]quota.Limits
+	// VideoMetadata captures previews for video links, which are saved without one when nil.
+	VideoMetadata videolink.MetadataFetcher
+	// Upstream tracks iRacing maintenance windows, and should be the monitor IRacingClient reports maintenance to.
+	Upstream *upstream.Monitor
+	// Tenants are the tenants the deployment hosts, nil for only the default one.
+	Tenants *tenant.Registry
+	// PayloadLogging controls which requests have their bodies logged at trace level, none when zero.
+	PayloadLogging api.PayloadLoggingConfig
+	// LatencyMetrics records API request and WebSocket push latency, nil to record neither.
+	LatencyMetrics api.LatencyEmitter
+	// WaitlistEnabled holds drivers signing in for the first time on the waitlist until they are invited.
+	WaitlistEnabled bool
+	// TermsVersions are the current versions of the documents drivers must accept before changing anything, nil to ask
+	// for none.
+	TermsVersions terms.Versions
+	// SeasonCardKeys sign shared season cards as they are served.
+	SeasonCardKeys seasoncard.KeySource
+}
 
-	sqsClient := sqs.NewFromConfig(awsCfg)
-	raceIngestionDispatcher := event.NewSQSEventDispatcher(sqsClient, cfg.RaceIngestionQueueURL)
+// NewAPI assembles the REST API from already constructed dependencies.
+func NewAPI(logger zerolog.Logger, deps APIDependencies) http.Handler {
+	loginAttempts := auth.NewAttemptTracker(deps.Store, deps.Metrics, auth.DefaultAttemptPolicy)
+	var authOpts []auth.ServiceOption
+	// a nil *invitations.Service would make a non-nil interface, so only set it when the waitlist is on
+	var invitationService developer.InvitationService
+	if deps.WaitlistEnabled {
+		waitlist := invitations.NewService(deps.Store)
+		authOpts = append(authOpts, auth.WithWaitlist(waitlist))
+		invitationService = waitlist
+	}
+	authService := auth.NewService(deps.IRacingOAuthClient, deps.JWTService, deps.IRacingClient, deps.Store, loginAttempts, authOpts...)
+	tracksService := tracks.NewService(deps.GlobalInfoClient)
+	carsService := cars.NewService(deps.GlobalInfoClient)
+	seriesService := series.NewService(deps.GlobalInfoClient)
+	var journalOpts []journal.ServiceOption
+	if deps.JournalDraftRetentionDays > 0 {
+		journalOpts = append(journalOpts, journal.WithDraftRetentionInDays(deps.JournalDraftRetentionDays))
+	}
+	journalOpts = append(journalOpts, journal.WithOnboardingTracker(onboarding.NewTracker(deps.Store)))
+	journalOpts = append(journalOpts, journal.WithStreakTracker(journal.NewStreakTracker(deps.Store, deps.Metrics)))
+	journalService := journal.NewService(deps.Store, deps.Metrics, journalOpts...)
+	analyticsService := analytics.NewService(deps.Store)
+	actionItemService := actionitem.NewService(deps.Store, uuid.NewString)
+	alertService := alert.NewService(deps.Store, uuid.NewString)
+	racePlanService := raceplan.NewService(deps.Store)
+	bookmarkService := bookmark.NewService(deps.Store, uuid.NewString)
+	videoLinkService := videolink.NewService(deps.Store, deps.VideoMetadata, uuid.NewString)
+	telemetryService := telemetry.NewService(deps.Store)
+	lapImportService := lapimport.NewService(deps.Store, lapimport.NewGarage61Adapter())
+	coachingService := coaching.NewService(deps.Store)
+	exportService := export.NewService(deps.Store)
+	scheduleService := schedule.NewService(deps.Store, deps.GlobalInfoClient)
+	benchmarkService := benchmark.NewService(deps.Store, coachingService)
+	squadService := squad.NewService(deps.Store, uuid.NewString)
+	dashboardService := dashboard.NewService(deps.Store)
+	mobileService := mobile.NewService(deps.Store, journalService)
+	seasonCardService := seasoncard.NewService(deps.Store, deps.SeasonCardKeys)
+	overlayService := overlay.NewService(deps.Store)
+	supporterService := supporter.NewService(deps.Store)
+	var quotaOpts []quota.Option
+	if deps.QuotaTiers != nil {
+		quotaOpts = append(quotaOpts, quota.WithTierLimits(deps.QuotaTiers))
+	}
+	quotaService := quota.NewService(deps.Store, quotaOpts...)
+	// a nil *voicememo.Service would make a non-nil interface, so only set it when there is one
+	var voiceMemoService driver.VoiceMemoService
+	if deps.VoiceMemos != nil {
+		voiceMemoService = deps.VoiceMemos
+	}
+	var reportService apiCoaching.RecapReportService
+	if deps.Reports != nil {
+		reportService = deps.Reports
+	}
 
-	authService := auth.NewService(iRacingOAuthClient, jwtService, iRacingClient, driverStore)
-	tracksService := tracks.NewService(cachingClient)
-	carsService := cars.NewService(cachingClient)
-	seriesService := series.NewService(cachingClient)
-	journalService := journal.NewService(driverStore, metricsClient)
-	analyticsService := analytics.NewService(driverStore)
+	requestCapture := requestcapture.NewService(deps.Store)
 
-	authMiddleware := api.AuthMiddleware(jwtService)
+	termsService := terms.NewService(deps.Store, deps.TermsVersions)
+	sessionAuthMiddleware := api.AuthMiddleware(auth.NewSessionValidator(deps.JWTService, deps.Store, auth.DefaultSessionCheckInterval))
+	termsMiddleware := api.TermsAcceptanceMiddleware(termsService)
+	// everywhere but the auth routes, where the terms are accepted, changes are held back until the current terms are
+	authMiddleware := func(next http.Handler) http.Handler {
+		return sessionAuthMiddleware(termsMiddleware(next))
+	}
 	developerMiddleware := api.EntitlementMiddleware("developer")
+	limitsMiddleware := api.LimitsMiddleware(time.Now)
 
 	routers := api.RootRouters{
-		HealthRouter:    health.NewRouter(),
-		AuthRouter:      apiAuth.NewRouter(authService, authMiddleware),
-		DeveloperRouter: developer.NewRouter(iracing.NewDocClient(httpClient), authMiddleware, developerMiddleware),
-		IngestionRouter: ingestion.NewRouter(driverStore, raceIngestionDispatcher, authMiddleware),
-		DriverRouter:    driver.NewRouter(driverStore, journalService, analyticsService, authMiddleware, developerMiddleware),
-		TracksRouter:    apiTracks.NewRouter(tracksService, authMiddleware),
-		CarsRouter:      apiCars.NewRouter(carsService, authMiddleware),
-		SeriesRouter:    apiSeries.NewRouter(seriesService, authMiddleware),
-		SessionRouter:   apiSession.NewRouter(iRacingClient, authMiddleware),
+		HealthRouter:       health.NewRouter(),
+		AuthRouter:         apiAuth.NewRouter(authService, termsService, sessionAuthMiddleware),
+		DeveloperRouter:    developer.NewRouter(deps.DocFetcher, deps.Store, deps.Store, deps.Store, deps.IRacingClient, deps.Store, loginAttempts, requestCapture, invitationService, deps.Upstream, authMiddleware, developerMiddleware),
+		IngestionRouter:    ingestion.NewRouter(deps.Store, deps.IngestionDispatcher, authMiddleware),
+		DriverRouter:       driver.NewRouter(deps.Store, journalService, voiceMemoService, actionItemService, alertService, bookmarkService, videoLinkService, telemetryService, lapImportService, analyticsService, seriesService, quotaService, exportService, racePlanService, authMiddleware, developerMiddleware, limitsMiddleware),
+		DriversRouter:      apiDrivers.NewRouter(deps.Store, authMiddleware),
+		TracksRouter:       apiTracks.NewRouter(tracksService, authMiddleware),
+		CarsRouter:         apiCars.NewRouter(carsService, authMiddleware),
+		SeriesRouter:       apiSeries.NewRouter(seriesService, authMiddleware),
+		SessionRouter:      apiSession.NewRouter(deps.IRacingClient, deps.Store, videoLinkService, quotaService, deps.Upstream, authMiddleware),
+		CoachingRouter:     apiCoaching.NewRouter(coachingService, deps.Store, reportService, authMiddleware),
+		SupporterRouter:    apiSupporter.NewRouter(supporterService, deps.StripeWebhookSecret, authMiddleware),
+		LeaderboardsRouter: apiLeaderboards.NewRouter(deps.Store, authMiddleware),
+		ScheduleRouter:     apiSchedule.NewRouter(scheduleService, authMiddleware),
+		BenchmarkRouter:    apiBenchmark.NewRouter(benchmarkService, authMiddleware),
+		SquadRouter:        apiSquad.NewRouter(squadService, authMiddleware),
+		DashboardRouter:    apiDashboard.NewRouter(dashboardService, authMiddleware),
+		MobileRouter:       apiMobile.NewRouter(mobileService, authMiddleware),
+		SeasonCardRouter:   apiSeasonCard.NewRouter(seasonCardService, authMiddleware),
+		OverlayRouter:      apiOverlay.NewRouter(overlayService, authMiddleware),
+		// kept apart from the authenticated routers, nothing under it may depend on who is asking since it is cached
+		// by the CDN
+		PublicRouter: apiPublic.NewRouter(deps.Store, deps.Store, seasonCardService, overlayService),
+
+		LivenessHandler:  health.NewLivenessEndpoint(),
+		ReadinessHandler: health.NewReadinessEndpoint(2*time.Second, deps.ReadinessChecks...),
+		VersionHandler:   health.NewVersionEndpoint(),
 	}
 
 	apiCfg := api.RestAPIConfig{
-		CORSAllowedOrigins: cfg.CORSAllowedOrigins,
-		DeadlineBuffer:     250 * time.Millisecond,
+		CORS:           deps.CORS,
+		DeadlineBuffer: 250 * time.Millisecond,
+		Tenants:        deps.Tenants,
+		PayloadLogging: deps.PayloadLogging,
+		RequestCapture: requestCapture,
+		Latency:        deps.LatencyMetrics,
 	}
 
 	return api.NewRestAPI(logger, uuid.NewString, routers, apiCfg)
@@ -0,0 +1,90 @@
+// Command backfill-from-archive fills in fields added to stored driver sessions after they were ingested, parsing the
+// session archives rather than fetching the sessions from iRacing again. Progress is saved after every batch, so an
+// interrupted run picks up where it stopped when started again.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/ingestion"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+func main() {
+	bucket := flag.String("bucket", os.Getenv("SESSION_ARCHIVE_BUCKET"), "bucket sessions are archived to")
+	table := flag.String("table", os.Getenv("DYNAMODB_TABLE"), "DynamoDB table sessions are stored in")
+	tenantID := flag.String("tenant", "", "tenant whose sessions to patch, the default tenant when left off")
+	patchName := flag.String("patch", "", "patch to apply, one of "+strings.Join(patchNames(), ", "))
+	batchSize := flag.Int("batch-size", ingestion.DefaultBackfillBatchSize, "archives to work through between saving progress")
+	maxBatches := flag.Int("max-batches", 0, "stop after this many batches, 0 to run until every archive has been worked through")
+	restart := flag.Bool("restart", false, "discard saved progress and start from the first archive")
+	logLevel := flag.String("log-level", "info", "log level")
+	flag.Parse()
+
+	ctx := context.Background()
+	zerolog.TimeFieldFormat = time.RFC3339Nano
+	logger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).With().Timestamp().Logger()
+	level, err := zerolog.ParseLevel(*logLevel)
+	if err != nil {
+		logger.Fatal().Str("input", *logLevel).Err(err).Msg("error parsing log level")
+	}
+	logger = logger.Level(level)
+	ctx = logger.WithContext(ctx)
+
+	patch, ok := ingestion.SessionPatches[*patchName]
+	if !ok {
+		logger.Fatal().Str("patch", *patchName).Strs("patches", patchNames()).Msg("unknown patch")
+	}
+	if *bucket == "" || *table == "" {
+		logger.Fatal().Msg("-bucket and -table are required")
+	}
+	if *tenantID != tenant.Default && !tenant.Valid(*tenantID) {
+		logger.Fatal().Str("tenant", *tenantID).Msg("invalid tenant")
+	}
+	ctx = tenant.WithID(ctx, *tenantID)
+
+	awsCfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error loading AWS config")
+	}
+	driverStore := store.NewDynamoStore(dynamodb.NewFromConfig(awsCfg), *table)
+	archives := ingestion.NewS3SessionArchive(s3.NewFromConfig(awsCfg), *bucket, driverStore)
+
+	if *restart {
+		if err := driverStore.SaveBackfillProgress(ctx, store.BackfillProgress{Name: patch.Name, UpdatedAt: time.Now()}); err != nil {
+			logger.Fatal().Err(err).Msg("error resetting backfill progress")
+		}
+	}
+
+	progress, err := ingestion.NewArchiveBackfill(driverStore, archives, patch, *batchSize).Run(ctx, *maxBatches)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("backfill failed")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(progress); err != nil {
+		logger.Fatal().Err(err).Msg("error writing progress")
+	}
+}
+
+func patchNames() []string {
+	names := make([]string, 0, len(ingestion.SessionPatches))
+	for name := range ingestion.SessionPatches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
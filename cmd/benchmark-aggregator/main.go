@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+
+	"github.com/jonsabados/saturdaysspinout/benchmark"
+	"github.com/jonsabados/saturdaysspinout/cmd/bootstrap"
+	"github.com/jonsabados/saturdaysspinout/coaching"
+)
+
+type appCfg struct {
+	bootstrap.Config
+	DynamoDBTable string   `envconfig:"DYNAMODB_TABLE" required:"true"`
+	Tenants       []string `envconfig:"TENANTS"`
+}
+
+func main() {
+	var cfg appCfg
+	app := bootstrap.Start("benchmark aggregation", &cfg)
+	app.VerifyConfig(context.Background(), bootstrap.TableCheck(app.DynamoDB(), cfg.DynamoDBTable))
+	tenants := app.Tenants(cfg.Tenants)
+
+	driverStore := app.Store(cfg.DynamoDBTable)
+	job := benchmark.NewJob(driverStore, coaching.NewService(driverStore))
+
+	// invoked on a schedule, the event payload carries nothing we need
+	lambda.Start(func(ctx context.Context) error {
+		// every tenant has its own data to work through
+		return tenants.ForEach(app.Logger.WithContext(ctx), job.Run)
+	})
+}
@@ -0,0 +1,202 @@
+// Package bootstrap is the start up the Lambda mains share: logging, configuration, X-Ray and the AWS clients. Clients
+// are built on first use so each Lambda only pays for the ones it needs. VerifyConfig checks that what the configuration
+// points at, like tables, queues and secrets, is there and usable before anything is handled, running the checks (and
+// with them the fetching of secrets) concurrently so a cold start waits on the slowest rather than all of them in turn.
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewaymanagementapi"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	"github.com/aws/aws-xray-sdk-go/v2/instrumentation/awsv2"
+	"github.com/aws/aws-xray-sdk-go/v2/xray"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/buildinfo"
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+// Config is the configuration every Lambda has. Embed it in a Lambda's own configuration and pass that to Start.
+type Config struct {
+	LogLevel string `envconfig:"LOG_LEVEL" required:"true"`
+}
+
+func (c *Config) base() *Config {
+	return c
+}
+
+type configuration interface {
+	base() *Config
+}
+
+// Lambda holds what a Lambda starts with. Its clients are instrumented for X-Ray and built the first time they're asked
+// for, after which the same client is returned.
+type Lambda struct {
+	Logger zerolog.Logger
+	// HTTPClient traces requests to anything other than AWS
+	HTTPClient *http.Client
+	AWS        aws.Config
+
+	dynamoDB       func() *dynamodb.Client
+	secretsManager func() *secretsmanager.Client
+	cloudWatch     func() *cloudwatch.Client
+	s3             func() *s3.Client
+	sqs            func() *sqs.Client
+	sns            func() *sns.Client
+	kms            func() *kms.Client
+	transcribe     func() *transcribe.Client
+}
+
+// Start loads cfg from the environment and sets up logging, X-Ray and the AWS config, exiting on failure. name is what
+// the Lambda is, for the start up log line.
+func Start(name string, cfg configuration) *Lambda {
+	ctx := context.Background()
+	zerolog.TimeFieldFormat = time.RFC3339Nano
+	zerolog.LevelFieldName = "severity"
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("gitSha", buildinfo.GitSHA).Logger()
+
+	logger.Info().Msg("starting " + name)
+
+	err := envconfig.Process("", cfg)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error loading config")
+	}
+
+	logLevel, err := zerolog.ParseLevel(cfg.base().LogLevel)
+	if err != nil {
+		logger.Fatal().Str("input", cfg.base().LogLevel).Err(err).Msg("error parsing log level")
+	}
+	logger = logger.Level(logLevel)
+
+	err = xray.Configure(xray.Config{
+		LogLevel: "warn",
+	})
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error configuring x-ray")
+	}
+
+	// get x-ray goodness going with the http client we will be using
+	httpClient := xray.Client(http.DefaultClient)
+
+	awsCfg, err := config.LoadDefaultConfig(ctx, config.WithHTTPClient(httpClient))
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error loading AWS config")
+	}
+	// add x-ray instrumentation to all the AWS clients
+	awsv2.AWSV2Instrumentor(&awsCfg.APIOptions)
+
+	return &Lambda{
+		Logger:         logger,
+		HTTPClient:     httpClient,
+		AWS:            awsCfg,
+		dynamoDB:       sync.OnceValue(func() *dynamodb.Client { return dynamodb.NewFromConfig(awsCfg) }),
+		secretsManager: sync.OnceValue(func() *secretsmanager.Client { return secretsmanager.NewFromConfig(awsCfg) }),
+		cloudWatch:     sync.OnceValue(func() *cloudwatch.Client { return cloudwatch.NewFromConfig(awsCfg) }),
+		s3:             sync.OnceValue(func() *s3.Client { return s3.NewFromConfig(awsCfg) }),
+		sqs:            sync.OnceValue(func() *sqs.Client { return sqs.NewFromConfig(awsCfg) }),
+		sns:            sync.OnceValue(func() *sns.Client { return sns.NewFromConfig(awsCfg) }),
+		kms:            sync.OnceValue(func() *kms.Client { return kms.NewFromConfig(awsCfg) }),
+		transcribe:     sync.OnceValue(func() *transcribe.Client { return transcribe.NewFromConfig(awsCfg) }),
+	}
+}
+
+func (l *Lambda) DynamoDB() *dynamodb.Client {
+	return l.dynamoDB()
+}
+
+func (l *Lambda) SecretsManager() *secretsmanager.Client {
+	return l.secretsManager()
+}
+
+func (l *Lambda) CloudWatch() *cloudwatch.Client {
+	return l.cloudWatch()
+}
+
+func (l *Lambda) S3() *s3.Client {
+	return l.s3()
+}
+
+func (l *Lambda) SQS() *sqs.Client {
+	return l.sqs()
+}
+
+func (l *Lambda) SNS() *sns.Client {
+	return l.sns()
+}
+
+func (l *Lambda) KMS() *kms.Client {
+	return l.kms()
+}
+
+func (l *Lambda) Transcribe() *transcribe.Client {
+	return l.transcribe()
+}
+
+// APIGatewayManagement returns a client for pushing to the WebSocket API at endpoint
+func (l *Lambda) APIGatewayManagement(endpoint string) *apigatewaymanagementapi.Client {
+	return apigatewaymanagementapi.NewFromConfig(l.AWS, func(o *apigatewaymanagementapi.Options) {
+		o.BaseEndpoint = &endpoint
+	})
+}
+
+// Store returns the DynamoDB backed store for table
+func (l *Lambda) Store(table string, opts ...store.DynamoStoreOption) *store.DynamoStore {
+	return store.NewDynamoStore(l.DynamoDB(), table, opts...)
+}
+
+// FieldEncryption returns the store option encrypting fields under keyID, or nothing when keyID is empty and fields are
+// stored in plaintext.
+func (l *Lambda) FieldEncryption(keyID string) []store.DynamoStoreOption {
+	if keyID == "" {
+		return nil
+	}
+	encrypter := store.NewFieldEncrypter(l.KMS(), keyID, store.DefaultDataKeyMaxAge)
+	return []store.DynamoStoreOption{store.WithFieldEncryption(encrypter)}
+}
+
+// CloudWatchMetrics returns an emitter putting metrics straight to CloudWatch, for work no one is waiting on
+func (l *Lambda) CloudWatchMetrics(namespace string) *metrics.CloudWatchEmitter {
+	return metrics.NewCloudWatchEmitter(l.CloudWatch(), namespace)
+}
+
+// Tenants returns the registry of the configured tenants, exiting when the configuration is invalid
+func (l *Lambda) Tenants(names []string) *tenant.Registry {
+	tenants, err := tenant.NewRegistry(names)
+	if err != nil {
+		l.Logger.Fatal().Err(err).Strs("tenants", names).Msg("invalid tenant configuration")
+	}
+	return tenants
+}
+
+// Parallel runs each of steps concurrently, returning once they've all finished with the errors of any that failed.
+func Parallel(ctx context.Context, steps ...func(ctx context.Context) error) error {
+	errs := make([]error, len(steps))
+	var wg sync.WaitGroup
+	for i, step := range steps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = step(ctx)
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
@@ -0,0 +1,66 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallel(t *testing.T) {
+	errA := errors.New("a failed")
+	errB := errors.New("b failed")
+
+	testCases := []struct {
+		name    string
+		results []error
+
+		expectedErrs []error
+	}{
+		{
+			name:    "all succeed",
+			results: []error{nil, nil, nil},
+		},
+		{
+			name:         "one fails",
+			results:      []error{nil, errA, nil},
+			expectedErrs: []error{errA},
+		},
+		{
+			name:         "several fail",
+			results:      []error{errA, nil, errB},
+			expectedErrs: []error{errA, errB},
+		},
+		{
+			name: "nothing to do",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// every step waits on all the others having started, so this only finishes if they run concurrently
+			var started sync.WaitGroup
+			started.Add(len(tc.results))
+			steps := make([]func(ctx context.Context) error, len(tc.results))
+			for i, result := range tc.results {
+				steps[i] = func(ctx context.Context) error {
+					started.Done()
+					started.Wait()
+					return result
+				}
+			}
+
+			err := Parallel(context.Background(), steps...)
+
+			if len(tc.expectedErrs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, expected := range tc.expectedErrs {
+				assert.ErrorIs(t, err, expected)
+			}
+		})
+	}
+}
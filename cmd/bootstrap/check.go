@@ -0,0 +1,186 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/jonsabados/saturdaysspinout/event"
+)
+
+// CheckTimeout bounds each configuration check, so something unreachable fails its check rather than hanging start up
+const CheckTimeout = 5 * time.Second
+
+// Check is something a Lambda's configuration has to get right for it to work, verified before it handles anything.
+type Check struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// CheckConfig runs checks concurrently, each bounded by CheckTimeout, and returns an error for each that failed, prefixed
+// with its name.
+func CheckConfig(ctx context.Context, checks ...Check) []error {
+	failures := make([]error, len(checks))
+	steps := make([]func(ctx context.Context) error, len(checks))
+	for i, check := range checks {
+		steps[i] = func(ctx context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx, CheckTimeout)
+			defer cancel()
+			if err := check.Check(ctx); err != nil {
+				failures[i] = fmt.Errorf("%s: %w", check.Name, err)
+			}
+			return nil
+		}
+	}
+	_ = Parallel(ctx, steps...)
+	return slices.DeleteFunc(failures, func(err error) bool {
+		return err == nil
+	})
+}
+
+// VerifyConfig runs checks and exits with a report of every one that failed, so a bad deploy shows all of what's wrong
+// with it at once rather than only the first thing start up tripped over.
+func (l *Lambda) VerifyConfig(ctx context.Context, checks ...Check) {
+	failures := CheckConfig(ctx, checks...)
+	if len(failures) > 0 {
+		report := make([]string, len(failures))
+		for i, failure := range failures {
+			report[i] = failure.Error()
+		}
+		l.Logger.Fatal().Strs("misconfigurations", report).Int("checks", len(checks)).Msg("configuration check failed")
+	}
+	names := make([]string, len(checks))
+	for i, check := range checks {
+		names[i] = check.Name
+	}
+	l.Logger.Info().Strs("checked", names).Msg("configuration check passed")
+}
+
+type TableDescriber interface {
+	DescribeTable(ctx context.Context, params *dynamodb.DescribeTableInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error)
+}
+
+// TableCheck checks that table exists and can be used
+func TableCheck(client TableDescriber, table string) Check {
+	return Check{
+		Name: fmt.Sprintf("dynamodb table %q", table),
+		Check: func(ctx context.Context) error {
+			result, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: &table})
+			if err != nil {
+				return err
+			}
+			status := result.Table.TableStatus
+			if status != types.TableStatusActive && status != types.TableStatusUpdating {
+				return fmt.Errorf("table is %s", status)
+			}
+			return nil
+		},
+	}
+}
+
+type QueueAttributeGetter interface {
+	GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
+}
+
+type TopicAttributeGetter interface {
+	GetTopicAttributes(ctx context.Context, params *sns.GetTopicAttributesInput, optFns ...func(*sns.Options)) (*sns.GetTopicAttributesOutput, error)
+}
+
+// DispatcherCheck checks that the queue or topic cfg dispatches events to, depending on its backend, is reachable. name
+// says which events, for the report.
+func DispatcherCheck(sqsClient QueueAttributeGetter, snsClient TopicAttributeGetter, name string, cfg event.DispatcherConfig) Check {
+	return Check{
+		Name: name + " events",
+		Check: func(ctx context.Context) error {
+			switch cfg.Backend {
+			case event.BackendSQS:
+				if cfg.QueueURL == "" {
+					return errors.New("queue URL is required for the sqs backend")
+				}
+				_, err := sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+					QueueUrl:       &cfg.QueueURL,
+					AttributeNames: []sqsTypes.QueueAttributeName{sqsTypes.QueueAttributeNameQueueArn},
+				})
+				if err != nil {
+					return fmt.Errorf("queue %s: %w", cfg.QueueURL, err)
+				}
+				return nil
+			case event.BackendSNS:
+				if cfg.TopicARN == "" {
+					return errors.New("topic ARN is required for the sns backend")
+				}
+				_, err := snsClient.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: &cfg.TopicARN})
+				if err != nil {
+					return fmt.Errorf("topic %s: %w", cfg.TopicARN, err)
+				}
+				return nil
+			default:
+				return fmt.Errorf("unknown event backend %q", cfg.Backend)
+			}
+		},
+	}
+}
+
+// WebSocketEndpointCheck checks that endpoint looks like a WebSocket API's management endpoint, an https URL ending in
+// the stage, e.g. https://abc123.execute-api.us-east-1.amazonaws.com/ws
+func WebSocketEndpointCheck(endpoint string) Check {
+	return Check{
+		Name: "websocket management endpoint",
+		Check: func(ctx context.Context) error {
+			parsed, err := url.Parse(endpoint)
+			if err != nil {
+				return err
+			}
+			if parsed.Scheme != "https" {
+				return fmt.Errorf("%q is not an https URL", endpoint)
+			}
+			if parsed.Host == "" {
+				return fmt.Errorf("%q has no host", endpoint)
+			}
+			stage := strings.Trim(parsed.Path, "/")
+			if stage == "" || strings.Contains(stage, "/") {
+				return fmt.Errorf("%q should end in the stage and nothing else", endpoint)
+			}
+			if parsed.RawQuery != "" || parsed.Fragment != "" {
+				return fmt.Errorf("%q should not have a query or fragment", endpoint)
+			}
+			return nil
+		},
+	}
+}
+
+type SecretGetter interface {
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+// SecretCheck fetches secretID and hands its value to parse, which fails the check when the value isn't usable. Since
+// the value is fetched anyway, parse is also where it should be kept for use.
+func SecretCheck(client SecretGetter, name, secretID string, parse func(secret string) error) Check {
+	return Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			result, err := client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: &secretID})
+			if err != nil {
+				return fmt.Errorf("fetching secret %s: %w", secretID, err)
+			}
+			if result.SecretString == nil || *result.SecretString == "" {
+				return fmt.Errorf("secret %s is empty", secretID)
+			}
+			if err := parse(*result.SecretString); err != nil {
+				return fmt.Errorf("parsing secret %s: %w", secretID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,325 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/event"
+)
+
+func TestCheckConfig(t *testing.T) {
+	passing := Check{Name: "passing", Check: func(ctx context.Context) error { return nil }}
+	failing := Check{Name: "failing", Check: func(ctx context.Context) error { return errors.New("nope") }}
+	bounded := Check{Name: "bounded", Check: func(ctx context.Context) error {
+		_, hasDeadline := ctx.Deadline()
+		if !hasDeadline {
+			return errors.New("no deadline")
+		}
+		return nil
+	}}
+
+	testCases := []struct {
+		name   string
+		checks []Check
+
+		expectedFailures []string
+	}{
+		{
+			name:   "all pass",
+			checks: []Check{passing, bounded},
+		},
+		{
+			name:             "every failure reported",
+			checks:           []Check{failing, passing, failing},
+			expectedFailures: []string{"failing: nope", "failing: nope"},
+		},
+		{
+			name: "nothing to check",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			failures := CheckConfig(context.Background(), tc.checks...)
+
+			var actual []string
+			for _, failure := range failures {
+				actual = append(actual, failure.Error())
+			}
+			assert.Equal(t, tc.expectedFailures, actual)
+		})
+	}
+}
+
+func TestTableCheck(t *testing.T) {
+	type describeTableCall struct {
+		status types.TableStatus
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		describeTableCall describeTableCall
+
+		expectedErr string
+	}{
+		{
+			name:              "active",
+			describeTableCall: describeTableCall{status: types.TableStatusActive},
+		},
+		{
+			name:              "updating",
+			describeTableCall: describeTableCall{status: types.TableStatusUpdating},
+		},
+		{
+			name:              "being deleted",
+			describeTableCall: describeTableCall{status: types.TableStatusDeleting},
+			expectedErr:       "table is DELETING",
+		},
+		{
+			name:              "missing",
+			describeTableCall: describeTableCall{err: errors.New("ResourceNotFoundException")},
+			expectedErr:       "ResourceNotFoundException",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var result *dynamodb.DescribeTableOutput
+			if tc.describeTableCall.err == nil {
+				result = &dynamodb.DescribeTableOutput{Table: &types.TableDescription{TableName: aws.String("spinout"), TableStatus: tc.describeTableCall.status}}
+			}
+			client := NewMockTableDescriber(t)
+			client.EXPECT().DescribeTable(mock.Anything, &dynamodb.DescribeTableInput{TableName: aws.String("spinout")}).
+				Return(result, tc.describeTableCall.err)
+
+			check := TableCheck(client, "spinout")
+
+			err := check.Check(context.Background())
+
+			assert.Equal(t, `dynamodb table "spinout"`, check.Name)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestDispatcherCheck(t *testing.T) {
+	queueURL := "https://sqs.us-east-1.amazonaws.com/123/ingestion"
+	topicARN := "arn:aws:sns:us-east-1:123:ingestion"
+
+	type getQueueAttributesCall struct {
+		queueURL string
+		err      error
+	}
+
+	type getTopicAttributesCall struct {
+		topicARN string
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+		cfg  event.DispatcherConfig
+
+		getQueueAttributesCall *getQueueAttributesCall
+		getTopicAttributesCall *getTopicAttributesCall
+
+		expectedErr string
+	}{
+		{
+			name:                   "sqs",
+			cfg:                    event.DispatcherConfig{Backend: event.BackendSQS, QueueURL: queueURL, TopicARN: topicARN},
+			getQueueAttributesCall: &getQueueAttributesCall{queueURL: queueURL},
+		},
+		{
+			name:                   "unreachable queue",
+			cfg:                    event.DispatcherConfig{Backend: event.BackendSQS, QueueURL: queueURL},
+			getQueueAttributesCall: &getQueueAttributesCall{queueURL: queueURL, err: errors.New("AccessDenied")},
+			expectedErr:            "queue " + queueURL + ": AccessDenied",
+		},
+		{
+			name:        "sqs without a queue",
+			cfg:         event.DispatcherConfig{Backend: event.BackendSQS, TopicARN: topicARN},
+			expectedErr: "queue URL is required for the sqs backend",
+		},
+		{
+			name:                   "sns",
+			cfg:                    event.DispatcherConfig{Backend: event.BackendSNS, QueueURL: queueURL, TopicARN: topicARN},
+			getTopicAttributesCall: &getTopicAttributesCall{topicARN: topicARN},
+		},
+		{
+			name:                   "missing topic",
+			cfg:                    event.DispatcherConfig{Backend: event.BackendSNS, TopicARN: topicARN},
+			getTopicAttributesCall: &getTopicAttributesCall{topicARN: topicARN, err: errors.New("NotFound")},
+			expectedErr:            "topic " + topicARN + ": NotFound",
+		},
+		{
+			name:        "sns without a topic",
+			cfg:         event.DispatcherConfig{Backend: event.BackendSNS, QueueURL: queueURL},
+			expectedErr: "topic ARN is required for the sns backend",
+		},
+		{
+			name:        "unknown backend",
+			cfg:         event.DispatcherConfig{Backend: "kafka", QueueURL: queueURL, TopicARN: topicARN},
+			expectedErr: `unknown event backend "kafka"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			queues := NewMockQueueAttributeGetter(t)
+			if tc.getQueueAttributesCall != nil {
+				queues.EXPECT().GetQueueAttributes(mock.Anything, &sqs.GetQueueAttributesInput{
+					QueueUrl:       aws.String(tc.getQueueAttributesCall.queueURL),
+					AttributeNames: []sqsTypes.QueueAttributeName{sqsTypes.QueueAttributeNameQueueArn},
+				}).Return(&sqs.GetQueueAttributesOutput{}, tc.getQueueAttributesCall.err)
+			}
+			topics := NewMockTopicAttributeGetter(t)
+			if tc.getTopicAttributesCall != nil {
+				topics.EXPECT().GetTopicAttributes(mock.Anything, &sns.GetTopicAttributesInput{TopicArn: aws.String(tc.getTopicAttributesCall.topicARN)}).
+					Return(&sns.GetTopicAttributesOutput{}, tc.getTopicAttributesCall.err)
+			}
+
+			check := DispatcherCheck(queues, topics, "ingestion", tc.cfg)
+			err := check.Check(context.Background())
+
+			assert.Equal(t, "ingestion events", check.Name)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestWebSocketEndpointCheck(t *testing.T) {
+	testCases := []struct {
+		name     string
+		endpoint string
+
+		expectErr bool
+	}{
+		{name: "management endpoint", endpoint: "https://abc123.execute-api.us-east-1.amazonaws.com/ws"},
+		{name: "trailing slash", endpoint: "https://abc123.execute-api.us-east-1.amazonaws.com/ws/"},
+		{name: "wss", endpoint: "wss://abc123.execute-api.us-east-1.amazonaws.com/ws", expectErr: true},
+		{name: "no stage", endpoint: "https://abc123.execute-api.us-east-1.amazonaws.com", expectErr: true},
+		{name: "connections path", endpoint: "https://abc123.execute-api.us-east-1.amazonaws.com/ws/@connections", expectErr: true},
+		{name: "no host", endpoint: "https:///ws", expectErr: true},
+		{name: "query", endpoint: "https://abc123.execute-api.us-east-1.amazonaws.com/ws?x=1", expectErr: true},
+		{name: "not a URL", endpoint: "://nope", expectErr: true},
+		{name: "empty", endpoint: "", expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := WebSocketEndpointCheck(tc.endpoint).Check(context.Background())
+
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSecretCheck(t *testing.T) {
+	type getSecretValueCall struct {
+		value *string
+		err   error
+	}
+
+	type parseCall struct {
+		secret string
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		getSecretValueCall getSecretValueCall
+		parseCall          *parseCall
+
+		expectedErr string
+	}{
+		{
+			name:               "parses",
+			getSecretValueCall: getSecretValueCall{value: aws.String("value")},
+			parseCall:          &parseCall{secret: "value"},
+		},
+		{
+			name:               "doesn't parse",
+			getSecretValueCall: getSecretValueCall{value: aws.String("garbage")},
+			parseCall:          &parseCall{secret: "garbage", err: errors.New("not parseable")},
+			expectedErr:        "parsing secret the-secret: not parseable",
+		},
+		{
+			name:               "empty",
+			getSecretValueCall: getSecretValueCall{value: aws.String("")},
+			expectedErr:        "secret the-secret is empty",
+		},
+		{
+			name:               "binary",
+			getSecretValueCall: getSecretValueCall{},
+			expectedErr:        "secret the-secret is empty",
+		},
+		{
+			name:               "missing",
+			getSecretValueCall: getSecretValueCall{err: errors.New("ResourceNotFoundException")},
+			expectedErr:        "fetching secret the-secret: ResourceNotFoundException",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var result *secretsmanager.GetSecretValueOutput
+			if tc.getSecretValueCall.err == nil {
+				result = &secretsmanager.GetSecretValueOutput{SecretString: tc.getSecretValueCall.value}
+			}
+			client := NewMockSecretGetter(t)
+			client.EXPECT().GetSecretValue(mock.Anything, &secretsmanager.GetSecretValueInput{SecretId: aws.String("the-secret")}).
+				Return(result, tc.getSecretValueCall.err)
+
+			var parsed []string
+			check := SecretCheck(client, "the secret", "the-secret", func(secret string) error {
+				parsed = append(parsed, secret)
+				if tc.parseCall == nil {
+					return nil
+				}
+				return tc.parseCall.err
+			})
+
+			err := check.Check(context.Background())
+
+			assert.Equal(t, "the secret", check.Name)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.parseCall == nil {
+				assert.Empty(t, parsed)
+			} else {
+				assert.Equal(t, []string{tc.parseCall.secret}, parsed)
+			}
+		})
+	}
+}
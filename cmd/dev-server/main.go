@@ -0,0 +1,219 @@
+// Command dev-server runs the whole product in a single process for local development: the REST API, a natively served
+// WebSocket API, and race ingestion, backed by an in-memory store and event dispatcher in place of DynamoDB, SQS and
+// API Gateway. Only iRacing itself is real, so OAuth client credentials are still required.
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/alert"
+	"github.com/jonsabados/saturdaysspinout/api"
+	"github.com/jonsabados/saturdaysspinout/api/health"
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/buildinfo"
+	"github.com/jonsabados/saturdaysspinout/cmd"
+	"github.com/jonsabados/saturdaysspinout/event"
+	"github.com/jonsabados/saturdaysspinout/ingestion"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/onboarding"
+	"github.com/jonsabados/saturdaysspinout/overlay"
+	"github.com/jonsabados/saturdaysspinout/raceplan"
+	"github.com/jonsabados/saturdaysspinout/seasoncard"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/standings"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/terms"
+	"github.com/jonsabados/saturdaysspinout/upstream"
+	"github.com/jonsabados/saturdaysspinout/videolink"
+	"github.com/jonsabados/saturdaysspinout/ws"
+	"github.com/jonsabados/saturdaysspinout/ws/native"
+)
+
+type appCfg struct {
+	LogLevel                     string   `envconfig:"LOG_LEVEL" default:"debug"`
+	CORSAllowedOrigins           []string `envconfig:"CORS_ALLOWED_ORIGINS" default:"http://localhost:5173"`
+	CORSAllowCredentials         bool     `envconfig:"CORS_ALLOW_CREDENTIALS" default:"true"`
+	CORSMaxAgeSeconds            int      `envconfig:"CORS_MAX_AGE_SECONDS" default:"300"`
+	IRacingOAuthClientID         string   `envconfig:"IRACING_OAUTH_CLIENT_ID" required:"true"`
+	IRacingOAuthClientSecret     string   `envconfig:"IRACING_OAUTH_CLIENT_SECRET" required:"true"`
+	SearchWindowInDays           int      `envconfig:"SEARCH_WINDOW_IN_DAYS" default:"10"`
+	BootstrapWindowInDays        int      `envconfig:"BOOTSTRAP_WINDOW_IN_DAYS" default:"30"`
+	RaceConsumptionConcurrency   int      `envconfig:"RACE_CONSUMPTION_CONCURRENCY" default:"5"`
+	LapConsumptionConcurrency    int      `envconfig:"LAP_CONSUMPTION_CONCURRENCY" default:"5"`
+	IngestionLockDurationSeconds int      `envconfig:"INGESTION_LOCK_DURATION_SECONDS" default:"60"`
+	InterpolateMissingLaps       bool     `envconfig:"INTERPOLATE_MISSING_LAPS" default:"false"`
+	StripeWebhookSecret          string   `envconfig:"STRIPE_WEBHOOK_SECRET"`
+	PayloadLogSampleRate         float64  `envconfig:"PAYLOAD_LOG_SAMPLE_RATE" default:"0"`
+	WaitlistEnabled              bool     `envconfig:"WAITLIST_ENABLED" default:"false"`
+	TermsVersions                string   `envconfig:"TERMS_VERSIONS"`
+}
+
+func main() {
+	apiAddress := flag.String("api-address", ":8080", "address to serve the REST API on")
+	wsAddress := flag.String("ws-address", ":8081", "address to serve the WebSocket API on")
+	flag.Parse()
+
+	zerolog.TimeFieldFormat = time.RFC3339Nano
+	zerolog.LevelFieldName = "severity"
+	logger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stdout}).With().Timestamp().Str("gitSha", buildinfo.GitSHA).Logger()
+
+	logger.Info().Msg("starting dev server")
+
+	var cfg appCfg
+	err := envconfig.Process("", &cfg)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error loading config")
+	}
+
+	logLevel, err := zerolog.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		logger.Fatal().Str("input", cfg.LogLevel).Err(err).Msg("error parsing log level")
+	}
+	logger = logger.Level(logLevel)
+
+	corsCfg := api.CORSConfig{
+		AllowedOrigins:   cfg.CORSAllowedOrigins,
+		AllowCredentials: cfg.CORSAllowCredentials,
+		PreflightMaxAge:  time.Duration(cfg.CORSMaxAgeSeconds) * time.Second,
+	}
+	if err := corsCfg.Validate(); err != nil {
+		logger.Fatal().Err(err).Strs("allowedOrigins", cfg.CORSAllowedOrigins).Msg("invalid CORS configuration")
+	}
+
+	var termsVersions terms.Versions
+	if cfg.TermsVersions != "" {
+		termsVersions, err = terms.ParseVersions(cfg.TermsVersions)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("invalid terms versions")
+		}
+	}
+
+	// keys are generated per run, so tokens (and therefore logins) don't survive a restart, but then neither does
+	// anything else
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error generating JWT signing key")
+	}
+	encryptionKey := make([]byte, 32)
+	if _, err := rand.Read(encryptionKey); err != nil {
+		logger.Fatal().Err(err).Msg("error generating JWT encryption key")
+	}
+	jwtService, err := auth.NewJWTService(signingKey, encryptionKey, "saturdaysspinout", 24*time.Hour)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error creating JWT service")
+	}
+	seasonCardKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error generating season card signing key")
+	}
+
+	memStore := store.NewMemoryStore()
+	metricsClient := metrics.NewLoggingEmitter()
+	dispatcher := event.NewMemoryEventDispatcher()
+
+	wsServer := native.NewServer(logger, uuid.NewString, cmd.WebSocketRoutes...)
+	wsHandler, pusher := cmd.NewWebSocketHandler(jwtService, wsServer, memStore, ws.WithPushMetrics(metricsClient))
+
+	upstreamMonitor := upstream.NewMonitor(memStore, upstream.DefaultPause, upstream.DefaultRefreshInterval)
+	iRacingClient := iracing.NewClient(http.DefaultClient, metricsClient, iracing.WithAvailability(upstreamMonitor))
+	iRacingOAuthClient := iracing.NewOAuthClient(http.DefaultClient, cfg.IRacingOAuthClientID, cfg.IRacingOAuthClientSecret)
+
+	processor := ingestion.NewRaceProcessor(memStore, iRacingClient, pusher, dispatcher, metricsClient,
+		time.Duration(cfg.IngestionLockDurationSeconds)*time.Second,
+		ingestion.WithSearchWindowInDays(cfg.SearchWindowInDays),
+		ingestion.WithBootstrapWindowInDays(cfg.BootstrapWindowInDays),
+		ingestion.WithRaceConsumptionConcurrency(cfg.RaceConsumptionConcurrency),
+		ingestion.WithLapConsumptionConcurrency(cfg.LapConsumptionConcurrency),
+		ingestion.WithStandingsSnapshotter(standings.NewSnapshotter(memStore, iRacingClient)),
+		ingestion.WithOnboardingTracker(onboarding.NewTracker(memStore)),
+		ingestion.WithAlertEvaluator(alert.NewEvaluator(memStore, pusher)),
+		ingestion.WithSeasonCardRefresher(seasoncard.NewRefresher(memStore)),
+		ingestion.WithOverlayRefresher(overlay.NewRefresher(memStore, pusher)),
+		ingestion.WithSquadEventTagger(squad.NewEventTagger(memStore)),
+		ingestion.WithRacePlanLinker(raceplan.NewLinker(memStore, pusher)),
+		ingestion.WithIngestionTiers(memStore),
+		ingestion.WithLapInterpolation(cfg.InterpolateMissingLaps),
+		ingestion.WithUpstreamAvailability(upstreamMonitor),
+	)
+	dispatcher.Subscribe(func(ctx context.Context, body []byte) error {
+		var request ingestion.RaceIngestionRequest
+		if err := json.Unmarshal(body, &request); err != nil {
+			return err
+		}
+		zerolog.Ctx(ctx).Info().Int64("driverId", request.DriverID).Msg("processing race ingestion")
+		return processor.IngestRaces(ctx, request)
+	})
+
+	restAPI := cmd.NewAPI(logger, cmd.APIDependencies{
+		Store:               memStore,
+		JWTService:          jwtService,
+		IRacingOAuthClient:  iRacingOAuthClient,
+		IRacingClient:       iRacingClient,
+		Upstream:            upstreamMonitor,
+		GlobalInfoClient:    iRacingClient,
+		DocFetcher:          iracing.NewDocClient(http.DefaultClient),
+		IngestionDispatcher: dispatcher,
+		Metrics:             metricsClient,
+		ReadinessChecks: []health.Dependency{
+			{
+				Name:  "iracing",
+				Check: health.HostResolvableCheck(iracing.DataAPIBaseURL),
+			},
+		},
+		CORS:                corsCfg,
+		StripeWebhookSecret: cfg.StripeWebhookSecret,
+		VideoMetadata:       videolink.NewOEmbedClient(http.DefaultClient),
+		PayloadLogging:      api.PayloadLoggingConfig{SampleRate: cfg.PayloadLogSampleRate},
+		LatencyMetrics:      metricsClient,
+		WaitlistEnabled:     cfg.WaitlistEnabled,
+		TermsVersions:       termsVersions,
+		SeasonCardKeys:      seasoncard.StaticKeySource{seasonCardKey},
+	})
+
+	apiServer := &http.Server{Addr: *apiAddress, Handler: restAPI}
+	websocketServer := &http.Server{Addr: *wsAddress, Handler: wsServer.Handler(wsHandler)}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	servers := []*http.Server{apiServer, websocketServer}
+	serveErrs := make(chan error, len(servers))
+	for _, srv := range servers {
+		go func() {
+			logger.Info().Str("address", srv.Addr).Msg("listening")
+			serveErrs <- srv.ListenAndServe()
+		}()
+	}
+
+	select {
+	case <-ctx.Done():
+		logger.Info().Msg("shutting down")
+	case err := <-serveErrs:
+		logger.Error().Err(err).Msg("server error")
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for _, srv := range servers {
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			logger.Error().Err(err).Str("address", srv.Addr).Msg("error shutting down server")
+		}
+	}
+	logger.Info().Msg("waiting for in flight ingestion")
+	dispatcher.Wait()
+}
@@ -0,0 +1,501 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// tagName is the struct tag the generator reads attribute mappings from
+const tagName = "dynamo"
+
+// keysMethod and computedAttributesMethod are the handwritten methods generated code calls into, the former is
+// required and returns the partition and sort key, the latter is optional and adds attributes derived from more than
+// one field (GSI keys and the like)
+const (
+	keysMethod               = "keys"
+	computedAttributesMethod = "addComputedAttributes"
+)
+
+// reservedNames are identifiers the generated code uses, fields named after them would shadow them once they become
+// locals in the FromAttributeMap function
+var reservedNames = map[string]bool{
+	"item":    true,
+	"err":     true,
+	"v":       true,
+	"ok":      true,
+	"p":       true,
+	"types":   true,
+	"strconv": true,
+}
+
+type fieldKind int
+
+const (
+	kindString fieldKind = iota
+	kindInt
+	kindInt64
+	kindFloat64
+	kindBool
+	kindIntList
+	kindStringList
+	kindStringSet
+)
+
+var basicKinds = map[string]fieldKind{
+	"string":  kindString,
+	"int":     kindInt,
+	"int64":   kindInt64,
+	"float64": kindFloat64,
+	"bool":    kindBool,
+}
+
+type modelField struct {
+	name      string
+	attr      string
+	typeName  string
+	kind      fieldKind
+	pointer   bool
+	optional  bool
+	omitEmpty bool
+}
+
+type model struct {
+	name     string
+	fields   []modelField
+	computed bool
+}
+
+// parsedPackage is what the generator needs from the package it generates for: its name, its structs in declaration
+// order, the types declared over a basic type (type ReasonOutCode string) and which methods each type has.
+type parsedPackage struct {
+	name       string
+	structs    []*ast.TypeSpec
+	basicTypes map[string]fieldKind
+	methods    map[string]map[string]bool
+}
+
+// generate reads the non-test Go files in dir, other than output, and returns the source of toAttributeMap and
+// <model>FromAttributeMap for every struct with dynamo tagged fields.
+func generate(dir, output string) ([]byte, error) {
+	pkg, err := parsePackage(dir, output)
+	if err != nil {
+		return nil, err
+	}
+
+	var models []model
+	for _, spec := range pkg.structs {
+		m, ok, err := pkg.model(spec)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			models = append(models, m)
+		}
+	}
+	if len(models) == 0 {
+		return nil, fmt.Errorf("no structs with %s tags found in %s", tagName, dir)
+	}
+
+	src, err := format.Source(render(pkg.name, models))
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w", err)
+	}
+	return src, nil
+}
+
+func parsePackage(dir, output string) (*parsedPackage, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", dir, err)
+	}
+	pkg := &parsedPackage{
+		basicTypes: map[string]fieldKind{},
+		methods:    map[string]map[string]bool{},
+	}
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == output {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", name, err)
+		}
+		pkg.name = file.Name.Name
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				pkg.addTypes(d)
+			case *ast.FuncDecl:
+				pkg.addMethod(d)
+			}
+		}
+	}
+	return pkg, nil
+}
+
+func (p *parsedPackage) addTypes(decl *ast.GenDecl) {
+	if decl.Tok != token.TYPE {
+		return
+	}
+	for _, spec := range decl.Specs {
+		typeSpec := spec.(*ast.TypeSpec)
+		switch t := typeSpec.Type.(type) {
+		case *ast.StructType:
+			p.structs = append(p.structs, typeSpec)
+		case *ast.Ident:
+			if kind, ok := basicKinds[t.Name]; ok && !typeSpec.Assign.IsValid() {
+				p.basicTypes[typeSpec.Name.Name] = kind
+			}
+		}
+	}
+}
+
+func (p *parsedPackage) addMethod(decl *ast.FuncDecl) {
+	if decl.Recv == nil || len(decl.Recv.List) != 1 {
+		return
+	}
+	recv := decl.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	ident, ok := recv.(*ast.Ident)
+	if !ok {
+		return
+	}
+	if p.methods[ident.Name] == nil {
+		p.methods[ident.Name] = map[string]bool{}
+	}
+	p.methods[ident.Name][decl.Name.Name] = true
+}
+
+// model builds the model for a struct, returning false if none of its fields are tagged.
+func (p *parsedPackage) model(spec *ast.TypeSpec) (model, bool, error) {
+	m := model{name: spec.Name.Name}
+	seen := map[string]string{}
+	for _, f := range spec.Type.(*ast.StructType).Fields.List {
+		if f.Tag == nil {
+			continue
+		}
+		tag, ok := reflect.StructTag(strings.Trim(f.Tag.Value, "`")).Lookup(tagName)
+		if !ok {
+			continue
+		}
+		if len(f.Names) != 1 {
+			return model{}, false, fmt.Errorf("%s: tagged fields must be declared one per line", m.name)
+		}
+		field, err := p.field(f.Names[0].Name, tag, f.Type)
+		if err != nil {
+			return model{}, false, fmt.Errorf("%s.%s: %w", m.name, f.Names[0].Name, err)
+		}
+		if other, ok := seen[field.attr]; ok {
+			return model{}, false, fmt.Errorf("%s.%s: attribute %q is already mapped by %s", m.name, field.name, field.attr, other)
+		}
+		seen[field.attr] = field.name
+		m.fields = append(m.fields, field)
+	}
+	if len(m.fields) == 0 {
+		return model{}, false, nil
+	}
+	if !p.methods[m.name][keysMethod] {
+		return model{}, false, fmt.Errorf("%s: missing %s() method returning its partition and sort key", m.name, keysMethod)
+	}
+	m.computed = p.methods[m.name][computedAttributesMethod]
+	return m, true, nil
+}
+
+func (p *parsedPackage) field(name, tag string, expr ast.Expr) (modelField, error) {
+	if reservedNames[name] {
+		return modelField{}, fmt.Errorf("field name collides with an identifier the generated code uses")
+	}
+	parts := strings.Split(tag, ",")
+	f := modelField{name: name, attr: parts[0]}
+	if f.attr == "" {
+		return modelField{}, fmt.Errorf("missing attribute name")
+	}
+	set := false
+	for _, opt := range parts[1:] {
+		switch opt {
+		case "optional":
+			f.optional = true
+		case "omitempty":
+			f.omitEmpty = true
+		case "set":
+			set = true
+		default:
+			return modelField{}, fmt.Errorf("unknown option %q", opt)
+		}
+	}
+
+	switch t := expr.(type) {
+	case *ast.Ident:
+		kind, typeName, err := p.scalar(t)
+		if err != nil {
+			return modelField{}, err
+		}
+		f.kind, f.typeName = kind, typeName
+	case *ast.StarExpr:
+		ident, ok := t.X.(*ast.Ident)
+		if !ok {
+			return modelField{}, fmt.Errorf("only pointers to basic types are supported")
+		}
+		kind, typeName, err := p.scalar(ident)
+		if err != nil {
+			return modelField{}, err
+		}
+		f.kind, f.typeName, f.pointer = kind, typeName, true
+	case *ast.ArrayType:
+		elem, ok := t.Elt.(*ast.Ident)
+		if t.Len != nil || !ok {
+			return modelField{}, fmt.Errorf("only []int and []string slices are supported")
+		}
+		switch {
+		case elem.Name == "int" && !set:
+			f.kind = kindIntList
+		case elem.Name == "string" && set:
+			f.kind = kindStringSet
+		case elem.Name == "string":
+			f.kind = kindStringList
+		default:
+			return modelField{}, fmt.Errorf("only []int and []string slices are supported, and only []string as a set")
+		}
+		f.typeName = "[]" + elem.Name
+	default:
+		return modelField{}, fmt.Errorf("unsupported type")
+	}
+	if set && f.kind != kindStringSet {
+		return modelField{}, fmt.Errorf("set is only supported on []string")
+	}
+	return f, nil
+}
+
+func (p *parsedPackage) scalar(ident *ast.Ident) (fieldKind, string, error) {
+	if kind, ok := basicKinds[ident.Name]; ok {
+		return kind, ident.Name, nil
+	}
+	if kind, ok := p.basicTypes[ident.Name]; ok {
+		return kind, ident.Name, nil
+	}
+	return 0, "", fmt.Errorf("unsupported type %s", ident.Name)
+}
+
+func needsStrconv(models []model) bool {
+	for _, m := range models {
+		for _, f := range m.fields {
+			switch f.kind {
+			case kindInt, kindInt64, kindFloat64:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readLocal is the type the getter used to read a field returns, which differs from the field's when it's a named
+// type or an int read with getOptionalInt64Attr.
+func (f modelField) readLocal() string {
+	switch f.kind {
+	case kindString:
+		return "string"
+	case kindInt:
+		if f.lenient() {
+			return "int64"
+		}
+		return "int"
+	case kindInt64:
+		return "int64"
+	case kindFloat64:
+		return "float64"
+	case kindBool:
+		return "bool"
+	}
+	return f.typeName
+}
+
+// lenient is whether a missing or unreadable attribute reads as the zero value rather than failing the read
+func (f modelField) lenient() bool {
+	return f.optional || f.omitEmpty || f.pointer
+}
+
+// convert wraps a value read for the field in the conversion to the field's type, if one is needed
+func (f modelField) convert(expr string) string {
+	if f.readLocal() == f.typeName {
+		return expr
+	}
+	return f.typeName + "(" + expr + ")"
+}
+
+// attributeValue is the expression marshaling the field's value, expr being the field (or what it points to).
+func (f modelField) attributeValue(expr string) string {
+	switch f.kind {
+	case kindString:
+		if f.typeName != "string" {
+			expr = "string(" + expr + ")"
+		}
+		return "&types.AttributeValueMemberS{Value: " + expr + "}"
+	case kindInt:
+		if f.typeName != "int" {
+			expr = "int(" + expr + ")"
+		}
+		return "&types.AttributeValueMemberN{Value: strconv.Itoa(" + expr + ")}"
+	case kindInt64:
+		if f.typeName != "int64" {
+			expr = "int64(" + expr + ")"
+		}
+		return "&types.AttributeValueMemberN{Value: strconv.FormatInt(" + expr + ", 10)}"
+	case kindFloat64:
+		if f.typeName != "float64" {
+			expr = "float64(" + expr + ")"
+		}
+		return "&types.AttributeValueMemberN{Value: strconv.FormatFloat(" + expr + ", 'f', -1, 64)}"
+	case kindBool:
+		return "&types.AttributeValueMemberBOOL{Value: " + expr + "}"
+	case kindIntList:
+		return "intListAttr(" + expr + ")"
+	case kindStringList:
+		return "stringListAttr(" + expr + ")"
+	case kindStringSet:
+		return "&types.AttributeValueMemberSS{Value: " + expr + "}"
+	}
+	panic(fmt.Sprintf("unhandled kind %d", f.kind))
+}
+
+// conditional is whether the attribute is only written sometimes, string sets always are as DynamoDB rejects empty
+// ones
+func (f modelField) conditional() bool {
+	return f.omitEmpty || f.pointer || f.kind == kindStringSet
+}
+
+// presentCheck is the condition the attribute is written under when it's conditional
+func (f modelField) presentCheck() string {
+	expr := "m." + f.name
+	switch {
+	case f.pointer:
+		return expr + " != nil"
+	case f.kind == kindIntList || f.kind == kindStringList || f.kind == kindStringSet:
+		return "len(" + expr + ") > 0"
+	case f.kind == kindString:
+		return expr + ` != ""`
+	case f.kind == kindBool:
+		return expr
+	}
+	return expr + " != 0"
+}
+
+// read is the statements reading the field into a local of the same name.
+func (f modelField) read() string {
+	attr := fmt.Sprintf("%q", f.attr)
+	switch {
+	case f.kind == kindIntList:
+		return f.name + ", err := getOptionalIntSliceAttr(item, " + attr + ")\nif err != nil {\nreturn nil, err\n}"
+	case f.kind == kindStringList:
+		return f.name + ", err := getOptionalStringSliceAttr(item, " + attr + ")\nif err != nil {\nreturn nil, err\n}"
+	case f.kind == kindStringSet:
+		return f.name + ", err := getOptionalStringSetAttr(item, " + attr + ")\nif err != nil {\nreturn nil, err\n}"
+	case f.pointer:
+		var read string
+		if f.kind == kindInt || f.kind == kindInt64 {
+			read = "if v, ok := getOptionalInt64Attr(item, " + attr + "); ok {\n"
+		} else {
+			read = "if v, err := " + f.getter() + "(item, " + attr + "); err == nil {\n"
+		}
+		return "var " + f.name + " *" + f.typeName + "\n" + read +
+			"p := " + f.convert("v") + "\n" + f.name + " = &p\n}"
+	case f.lenient():
+		if f.kind == kindInt || f.kind == kindInt64 {
+			return f.name + ", _ := getOptionalInt64Attr(item, " + attr + ")"
+		}
+		return f.name + ", _ := " + f.getter() + "(item, " + attr + ")"
+	}
+	return f.name + ", err := " + f.getter() + "(item, " + attr + ")\nif err != nil {\nreturn nil, err\n}"
+}
+
+func (f modelField) getter() string {
+	switch f.kind {
+	case kindString:
+		return "getStringAttr"
+	case kindInt:
+		return "getIntAttr"
+	case kindInt64:
+		return "getInt64Attr"
+	case kindFloat64:
+		return "getFloatAttr"
+	case kindBool:
+		return "getBoolAttr"
+	}
+	panic(fmt.Sprintf("unhandled kind %d", f.kind))
+}
+
+// assign is the value the field is set to from its local in the model literal
+func (f modelField) assign() string {
+	if f.pointer || f.kind == kindIntList || f.kind == kindStringList || f.kind == kindStringSet {
+		return f.name
+	}
+	return f.convert(f.name)
+}
+
+// render writes the generated file for models in package pkg
+func render(pkg string, models []model) []byte {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by dynamo-modelgen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\nimport (\n", pkg)
+	if needsStrconv(models) {
+		b.WriteString("\"strconv\"\n\n")
+	}
+	b.WriteString("\"github.com/aws/aws-sdk-go-v2/service/dynamodb/types\"\n)\n")
+	for _, m := range models {
+		m.renderToAttributeMap(&b)
+		m.renderFromAttributeMap(&b)
+	}
+	return b.Bytes()
+}
+
+func (m model) renderToAttributeMap(b *bytes.Buffer) {
+	fmt.Fprintf(b, "\nfunc (m %s) toAttributeMap() map[string]types.AttributeValue {\n", m.name)
+	b.WriteString("pk, sk := m.keys()\n")
+	b.WriteString("ret := map[string]types.AttributeValue{\n")
+	b.WriteString("partitionKeyName: &types.AttributeValueMemberS{Value: pk},\n")
+	b.WriteString("sortKeyName: &types.AttributeValueMemberS{Value: sk},\n")
+	for _, f := range m.fields {
+		if !f.conditional() {
+			fmt.Fprintf(b, "%q: %s,\n", f.attr, f.attributeValue("m."+f.name))
+		}
+	}
+	b.WriteString("}\n")
+	for _, f := range m.fields {
+		if !f.conditional() {
+			continue
+		}
+		expr := "m." + f.name
+		if f.pointer {
+			expr = "*" + expr
+		}
+		fmt.Fprintf(b, "if %s {\nret[%q] = %s\n}\n", f.presentCheck(), f.attr, f.attributeValue(expr))
+	}
+	if m.computed {
+		fmt.Fprintf(b, "m.%s(ret)\n", computedAttributesMethod)
+	}
+	b.WriteString("return ret\n}\n")
+}
+
+func (m model) renderFromAttributeMap(b *bytes.Buffer) {
+	fmt.Fprintf(b, "\nfunc %sFromAttributeMap(item map[string]types.AttributeValue) (*%s, error) {\n", m.name, m.name)
+	for _, f := range m.fields {
+		b.WriteString(f.read())
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(b, "return &%s{\n", m.name)
+	for _, f := range m.fields {
+		fmt.Fprintf(b, "%s: %s,\n", f.name, f.assign())
+	}
+	b.WriteString("}, nil\n}\n")
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate_StoreIsUpToDate(t *testing.T) {
+	expected, err := os.ReadFile("../../store/dynamo_models_gen.go")
+	require.NoError(t, err)
+
+	actual, err := generate("../../store", "dynamo_models_gen.go")
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expected), string(actual), "store/dynamo_models_gen.go is stale, run go generate ./store")
+}
+
+func TestGenerate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		source string
+
+		expectedContains []string
+		expectedErr      string
+	}{
+		{
+			name: "named, pointer and computed fields",
+			source: `package models
+
+type Color string
+
+type widgetModel struct {
+	id     int64  ` + "`dynamo:\"id\"`" + `
+	color  Color  ` + "`dynamo:\"color,omitempty\"`" + `
+	weight *float64 ` + "`dynamo:\"weight\"`" + `
+	tags   []string ` + "`dynamo:\"tags,set\"`" + `
+	cache  string
+}
+
+func (m widgetModel) keys() (string, string) { return "widget", "info" }
+
+func (m widgetModel) addComputedAttributes(map[string]any) {}
+`,
+			expectedContains: []string{
+				`ret["color"] = &types.AttributeValueMemberS{Value: string(m.color)}`,
+				`ret["weight"] = &types.AttributeValueMemberN{Value: strconv.FormatFloat(*m.weight, 'f', -1, 64)}`,
+				`ret["tags"] = &types.AttributeValueMemberSS{Value: m.tags}`,
+				`m.addComputedAttributes(ret)`,
+				`if v, err := getFloatAttr(item, "weight"); err == nil {`,
+				`color:  Color(color),`,
+			},
+		},
+		{
+			name: "unsupported type",
+			source: `package models
+
+type widgetModel struct {
+	sizes map[string]int ` + "`dynamo:\"sizes\"`" + `
+}
+
+func (m widgetModel) keys() (string, string) { return "widget", "info" }
+`,
+			expectedErr: "widgetModel.sizes: unsupported type",
+		},
+		{
+			name: "missing keys",
+			source: `package models
+
+type widgetModel struct {
+	id int64 ` + "`dynamo:\"id\"`" + `
+}
+`,
+			expectedErr: "widgetModel: missing keys() method returning its partition and sort key",
+		},
+		{
+			name: "set on a non string slice",
+			source: `package models
+
+type widgetModel struct {
+	id int64 ` + "`dynamo:\"id,set\"`" + `
+}
+
+func (m widgetModel) keys() (string, string) { return "widget", "info" }
+`,
+			expectedErr: "widgetModel.id: set is only supported on []string",
+		},
+		{
+			name: "attribute mapped twice",
+			source: `package models
+
+type widgetModel struct {
+	id    int64 ` + "`dynamo:\"id\"`" + `
+	altID int64 ` + "`dynamo:\"id\"`" + `
+}
+
+func (m widgetModel) keys() (string, string) { return "widget", "info" }
+`,
+			expectedErr: `widgetModel.altID: attribute "id" is already mapped by id`,
+		},
+		{
+			name: "field shadowing generated code",
+			source: `package models
+
+type widgetModel struct {
+	item string ` + "`dynamo:\"item\"`" + `
+}
+
+func (m widgetModel) keys() (string, string) { return "widget", "info" }
+`,
+			expectedErr: "widgetModel.item: field name collides with an identifier the generated code uses",
+		},
+		{
+			name:        "nothing tagged",
+			source:      "package models\n\ntype widgetModel struct {\n\tid int64\n}\n",
+			expectedErr: "no structs with dynamo tags found in",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "models.go"), []byte(tc.source), 0o644))
+
+			src, err := generate(dir, "models_gen.go")
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, expected := range tc.expectedContains {
+				assert.Contains(t, string(src), expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Command dynamo-modelgen generates the DynamoDB marshaling code for the store's models from their struct tags, so the
+// attribute names and types of each model are declared once rather than kept in sync across a handwritten
+// toAttributeMap and FromAttributeMap pair. It's run by go generate in the store package.
+//
+// Any struct with a field tagged dynamo:"<attribute>" gets a toAttributeMap method and a <model>FromAttributeMap
+// function, with untagged fields left out of both. Options follow the attribute name:
+//
+//   - optional: always written, but records written before the attribute existed read as the zero value
+//   - omitempty: only written when not the zero value, reading as the zero value when absent
+//   - set: writes a []string as a string set rather than a list, these are never written empty as DynamoDB rejects
+//     empty sets
+//
+// Pointer fields are written when non-nil and read as nil when absent. Keys are left to a handwritten keys() method
+// returning the partition and sort key, and attributes computed from more than one field (GSI keys and the like) to an
+// optional addComputedAttributes(map[string]types.AttributeValue) method.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "package directory to generate models for")
+	output := flag.String("output", "dynamo_models_gen.go", "file, within dir, to write the generated code to")
+	flag.Parse()
+
+	src, err := generate(*dir, *output)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dynamo-modelgen: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *output), src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "dynamo-modelgen: writing %s: %v\n", *output, err)
+		os.Exit(1)
+	}
+}
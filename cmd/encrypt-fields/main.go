@@ -0,0 +1,65 @@
+// Command encrypt-fields encrypts the journal notes, transcripts and bookmark notes that were stored before field
+// encryption was turned on, and re-encrypts any encrypted under a KMS key other than the one given, so a retired key
+// can be disabled once it's done. Items are only rewritten if unchanged since they were read, so it's safe to run while
+// the site is up, and running it again picks up anything that was skipped.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+func main() {
+	table := flag.String("table", os.Getenv("DYNAMODB_TABLE"), "DynamoDB table to encrypt fields in")
+	tenantID := flag.String("tenant", "", "tenant whose table to encrypt fields in, the default tenant when left off")
+	keyID := flag.String("key-id", os.Getenv("FIELD_ENCRYPTION_KEY_ID"), "KMS key ID, ARN or alias to encrypt fields under")
+	logLevel := flag.String("log-level", "info", "log level")
+	flag.Parse()
+
+	ctx := context.Background()
+	zerolog.TimeFieldFormat = time.RFC3339Nano
+	logger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).With().Timestamp().Logger()
+	level, err := zerolog.ParseLevel(*logLevel)
+	if err != nil {
+		logger.Fatal().Str("input", *logLevel).Err(err).Msg("error parsing log level")
+	}
+	logger = logger.Level(level)
+	ctx = logger.WithContext(ctx)
+
+	if *table == "" || *keyID == "" {
+		logger.Fatal().Msg("-table and -key-id are required")
+	}
+	if *tenantID != tenant.Default && !tenant.Valid(*tenantID) {
+		logger.Fatal().Str("tenant", *tenantID).Msg("invalid tenant")
+	}
+	ctx = tenant.WithID(ctx, *tenantID)
+
+	awsCfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error loading AWS config")
+	}
+	encrypter := store.NewFieldEncrypter(kms.NewFromConfig(awsCfg), *keyID, store.DefaultDataKeyMaxAge)
+	driverStore := store.NewDynamoStore(dynamodb.NewFromConfig(awsCfg), *table, store.WithFieldEncryption(encrypter))
+
+	result, err := driverStore.EncryptFields(ctx)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("encrypting fields failed")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		logger.Fatal().Err(err).Msg("error writing result")
+	}
+}
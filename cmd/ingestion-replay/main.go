@@ -0,0 +1,79 @@
+// Command ingestion-replay runs a captured ingestion round again locally, answering iRacing from the captured
+// responses and seeding an in-memory store with what the round read, then prints what the round would have written.
+// Captures are loaded from the capture bucket by ID, or from a file downloaded from it.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/ingestion"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+)
+
+func main() {
+	bucket := flag.String("bucket", os.Getenv("INGESTION_CAPTURE_BUCKET"), "bucket captures are saved to")
+	captureID := flag.String("capture-id", "", "ID of the capture to replay, as logged by the processor and shown in the developer ingestion metrics")
+	file := flag.String("file", "", "replay a capture from a local file instead of the bucket")
+	logLevel := flag.String("log-level", "debug", "log level")
+	flag.Parse()
+
+	ctx := context.Background()
+	zerolog.TimeFieldFormat = time.RFC3339Nano
+	logger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).With().Timestamp().Logger()
+	level, err := zerolog.ParseLevel(*logLevel)
+	if err != nil {
+		logger.Fatal().Str("input", *logLevel).Err(err).Msg("error parsing log level")
+	}
+	logger = logger.Level(level)
+	ctx = logger.WithContext(ctx)
+
+	var capture *ingestion.Capture
+	switch {
+	case *file != "":
+		raw, err := os.ReadFile(*file)
+		if err != nil {
+			logger.Fatal().Err(err).Str("file", *file).Msg("error reading capture file")
+		}
+		capture = &ingestion.Capture{}
+		if err := json.Unmarshal(raw, capture); err != nil {
+			logger.Fatal().Err(err).Str("file", *file).Msg("error parsing capture file")
+		}
+	case *captureID != "" && *bucket != "":
+		awsCfg, err := config.LoadDefaultConfig(ctx)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("error loading AWS config")
+		}
+		capture, err = ingestion.NewS3CaptureStore(s3.NewFromConfig(awsCfg), *bucket).GetCapture(ctx, *captureID)
+		if err != nil {
+			logger.Fatal().Err(err).Str("captureID", *captureID).Msg("error loading capture")
+		}
+	default:
+		logger.Fatal().Msg("either -file, or -capture-id and -bucket, are required")
+	}
+
+	logger.Info().
+		Str("captureID", capture.CaptureID).
+		Int64("driverID", capture.DriverID).
+		Time("startedAt", capture.StartedAt).
+		Int("exchanges", len(capture.Exchanges)).
+		Msg("replaying capture")
+
+	summary, err := ingestion.ReplayCapture(ctx, *capture, iracing.DataAPIBaseURL)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("replay failed")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(summary); err != nil {
+		logger.Fatal().Err(err).Msg("error writing summary")
+	}
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/seasoncard"
+	"github.com/jonsabados/saturdaysspinout/secrets"
+)
+
+// JWTExpiry is how long issued tokens are good for.
+const JWTExpiry = 24 * time.Hour
+
+// NewJWTService creates the JWT service shared by the API and WebSocket lambdas, reading its keys from Secrets Manager
+// through a cache so warm lambdas pick up rotated keys. The keys are loaded before returning, so a missing or malformed
+// secret fails the cold start rather than the first request.
+func NewJWTService(ctx context.Context, secretsClient secrets.Client, signingKeySecret, encryptionKeySecret string) (*auth.JWTService, error) {
+	provider := secrets.NewProvider(secretsClient, secrets.DefaultTTL, secrets.DefaultMinRefreshInterval)
+	keys := auth.NewSecretsKeyProvider(provider, signingKeySecret, encryptionKeySecret)
+	if _, err := keys.KeyRing(ctx); err != nil {
+		return nil, fmt.Errorf("loading JWT keys: %w", err)
+	}
+	return auth.NewRotatingJWTService(keys, "saturdaysspinout", JWTExpiry), nil
+}
+
+// NewSeasonCardKeySource reads the keys season cards are signed with from Secrets Manager, cached the same way as the
+// JWT keys. Like them they are loaded before returning.
+func NewSeasonCardKeySource(ctx context.Context, secretsClient secrets.Client, signingKeySecret string) (*seasoncard.SecretKeySource, error) {
+	provider := secrets.NewProvider(secretsClient, secrets.DefaultTTL, secrets.DefaultMinRefreshInterval)
+	keys := seasoncard.NewSecretKeySource(provider, signingKeySecret)
+	if _, err := keys.SigningKeys(ctx); err != nil {
+		return nil, fmt.Errorf("loading season card signing keys: %w", err)
+	}
+	return keys, nil
+}
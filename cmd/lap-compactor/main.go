@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+
+	"github.com/jonsabados/saturdaysspinout/cmd/bootstrap"
+	"github.com/jonsabados/saturdaysspinout/retention"
+)
+
+type appCfg struct {
+	bootstrap.Config
+	DynamoDBTable    string   `envconfig:"DYNAMODB_TABLE" required:"true"`
+	MetricsNamespace string   `envconfig:"METRICS_NAMESPACE" required:"true"`
+	Tenants          []string `envconfig:"TENANTS"`
+}
+
+func main() {
+	var cfg appCfg
+	app := bootstrap.Start("lap compactor", &cfg)
+	app.VerifyConfig(context.Background(), bootstrap.TableCheck(app.DynamoDB(), cfg.DynamoDBTable))
+	tenants := app.Tenants(cfg.Tenants)
+
+	compactor := retention.NewCompactor(app.Store(cfg.DynamoDBTable), app.CloudWatchMetrics(cfg.MetricsNamespace))
+
+	// invoked on a schedule, the event payload carries nothing we need
+	lambda.Start(func(ctx context.Context) error {
+		// every tenant has its own data to work through
+		return tenants.ForEach(app.Logger.WithContext(ctx), compactor.CompactAll)
+	})
+}
@@ -14,7 +14,9 @@ type Processor interface {
 	IngestRaces(ctx context.Context, request ingestion.RaceIngestionRequest) error
 }
 
-func NewHandler(processor Processor) sqs.HandlerFunc {
+// NewHandler processes ingestion requests from either queue. Each priority has its own processor so background rounds
+// can be held to less iRacing concurrency than rounds a driver is waiting on.
+func NewHandler(interactive, background Processor) sqs.HandlerFunc {
 	return func(ctx context.Context, event events.SQSEvent) error {
 		log := zerolog.Ctx(ctx)
 
@@ -25,8 +27,13 @@ func NewHandler(processor Processor) sqs.HandlerFunc {
 				continue
 			}
 
-			log.Info().Int64("driverId", msg.DriverID).Str("messageId", record.MessageId).Msg("processing race ingestion")
+			priority := msg.Priority()
+			log.Info().Int64("driverId", msg.DriverID).Str("messageId", record.MessageId).Str("priority", string(priority)).Msg("processing race ingestion")
 
+			processor := interactive
+			if priority == ingestion.PriorityBackground {
+				processor = background
+			}
 			if err := processor.IngestRaces(ctx, msg); err != nil {
 				log.Error().Err(err).Int64("driverId", msg.DriverID).Msg("failed to ingest races")
 				return err
@@ -15,8 +15,9 @@ import (
 
 func TestNewHandler(t *testing.T) {
 	type ingestRacesCall struct {
-		request ingestion.RaceIngestionRequest
-		err     error
+		request    ingestion.RaceIngestionRequest
+		background bool
+		err        error
 	}
 
 	testCases := []struct {
@@ -84,6 +85,23 @@ func TestNewHandler(t *testing.T) {
 				{request: ingestion.RaceIngestionRequest{DriverID: 1001, IRacingAccessToken: "token-1", NotifyConnectionID: "conn-1"}},
 			},
 		},
+		{
+			name: "background requests go to the background processor",
+			messages: []events.SQSMessage{
+				{
+					MessageId: "msg-1",
+					Body:      mustJSON(ingestion.RaceIngestionRequest{DriverID: 1001, IRacingAccessToken: "token-1"}),
+				},
+				{
+					MessageId: "msg-2",
+					Body:      mustJSON(ingestion.RaceIngestionRequest{DriverID: 1002, IRacingAccessToken: "token-2", NotifyConnectionID: "conn-2", Backwards: true}),
+				},
+			},
+			ingestRacesCalls: []ingestRacesCall{
+				{request: ingestion.RaceIngestionRequest{DriverID: 1001, IRacingAccessToken: "token-1"}, background: true},
+				{request: ingestion.RaceIngestionRequest{DriverID: 1002, IRacingAccessToken: "token-2", NotifyConnectionID: "conn-2", Backwards: true}, background: true},
+			},
+		},
 		{
 			name: "processor error returns immediately",
 			messages: []events.SQSMessage{
@@ -132,15 +150,20 @@ func TestNewHandler(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			mockProcessor := NewMockProcessor(t)
+			interactiveProcessor := NewMockProcessor(t)
+			backgroundProcessor := NewMockProcessor(t)
 
 			for _, call := range tc.ingestRacesCalls {
+				mockProcessor := interactiveProcessor
+				if call.background {
+					mockProcessor = backgroundProcessor
+				}
 				mockProcessor.EXPECT().
 					IngestRaces(mock.Anything, call.request).
 					Return(call.err)
 			}
 
-			handler := NewHandler(mockProcessor)
+			handler := NewHandler(interactiveProcessor, backgroundProcessor)
 			err := handler(context.Background(), events.SQSEvent{Records: tc.messages})
 
 			if tc.expectErr {
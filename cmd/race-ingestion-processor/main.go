@@ -2,103 +2,151 @@ package main
 
 import (
 	"context"
-	"net/http"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-lambda-go/lambda"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/service/apigatewaymanagementapi"
-	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
-	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/aws/aws-sdk-go-v2/service/sqs"
-	"github.com/aws/aws-xray-sdk-go/v2/instrumentation/awsv2"
-	"github.com/aws/aws-xray-sdk-go/v2/xray"
+	"github.com/google/uuid"
+	"github.com/jonsabados/saturdaysspinout/alert"
+	"github.com/jonsabados/saturdaysspinout/cmd/bootstrap"
 	"github.com/jonsabados/saturdaysspinout/event"
 	"github.com/jonsabados/saturdaysspinout/ingestion"
 	"github.com/jonsabados/saturdaysspinout/iracing"
 	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/onboarding"
+	"github.com/jonsabados/saturdaysspinout/overlay"
+	"github.com/jonsabados/saturdaysspinout/raceplan"
+	"github.com/jonsabados/saturdaysspinout/ratebudget"
+	"github.com/jonsabados/saturdaysspinout/seasoncard"
 	sqsutil "github.com/jonsabados/saturdaysspinout/sqs"
+	"github.com/jonsabados/saturdaysspinout/squad"
+	"github.com/jonsabados/saturdaysspinout/standings"
 	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/upstream"
 	"github.com/jonsabados/saturdaysspinout/ws"
-	"github.com/kelseyhightower/envconfig"
-	"github.com/rs/zerolog"
 )
 
 type appCfg struct {
-	LogLevel                     string `envconfig:"LOG_LEVEL" required:"true"`
+	bootstrap.Config
 	DynamoDBTable                string `envconfig:"DYNAMODB_TABLE" required:"true"`
 	SearchWindowInDays           int    `envconfig:"SEARCH_WINDOW_IN_DAYS" default:"10"`
+	BootstrapWindowInDays        int    `envconfig:"BOOTSTRAP_WINDOW_IN_DAYS" default:"30"`
 	WSManagementEndpoint         string `envconfig:"WS_MANAGEMENT_ENDPOINT" required:"true"`
 	RaceConsumptionConcurrency   int    `envconfig:"RACE_CONSUMPTION_CONCURRENCY" required:"true"`
+	LapConsumptionConcurrency    int    `envconfig:"LAP_CONSUMPTION_CONCURRENCY" required:"true"`
+	BackgroundRaceConcurrency    int    `envconfig:"BACKGROUND_RACE_CONSUMPTION_CONCURRENCY" required:"true"`
+	BackgroundLapConcurrency     int    `envconfig:"BACKGROUND_LAP_CONSUMPTION_CONCURRENCY" required:"true"`
 	IngestionQueueURL            string `envconfig:"INGESTION_QUEUE_URL" required:"true"`
+	IngestionTopicARN            string `envconfig:"INGESTION_TOPIC_ARN"`
+	BackgroundQueueURL           string `envconfig:"BACKGROUND_INGESTION_QUEUE_URL" required:"true"`
+	BackgroundTopicARN           string `envconfig:"BACKGROUND_INGESTION_TOPIC_ARN"`
+	EventBackend                 string `envconfig:"EVENT_BACKEND" default:"sqs"`
 	IngestionLockDurationSeconds int    `envconfig:"INGESTION_LOCK_DURATION_SECONDS" required:"true"`
 	IRacingCacheBucket           string `envconfig:"IRACING_CACHE_BUCKET" required:"true"`
 	MetricsNamespace             string `envconfig:"METRICS_NAMESPACE" required:"true"`
+	IngestionRateBudget          int    `envconfig:"INGESTION_RATE_BUDGET" default:"0"`
+	IngestionRoundRateCost       int    `envconfig:"INGESTION_ROUND_RATE_COST" default:"20"`
+	InterpolateMissingLaps       bool   `envconfig:"INTERPOLATE_MISSING_LAPS" default:"false"`
+	IngestionCaptureBucket       string `envconfig:"INGESTION_CAPTURE_BUCKET"`
+	SessionArchiveBucket         string `envconfig:"SESSION_ARCHIVE_BUCKET"`
+	IRacingMaxResponseMB         int64  `envconfig:"IRACING_MAX_RESPONSE_MB" default:"64"`
 }
 
 func main() {
-	ctx := context.Background()
-	zerolog.TimeFieldFormat = time.RFC3339Nano
-	zerolog.LevelFieldName = "severity"
-	logger := zerolog.New(os.Stdout).With().Timestamp().Logger()
+	var cfg appCfg
+	app := bootstrap.Start("race ingestion processor", &cfg)
+	logger := app.Logger
 
-	logger.Info().Msg("starting race ingestion processor")
+	// store reads and push latency are written as log lines so neither waits on CloudWatch
+	emfEmitter := metrics.NewEMFEmitter(os.Stdout, cfg.MetricsNamespace)
 
-	var cfg appCfg
-	err := envconfig.Process("", &cfg)
-	if err != nil {
-		logger.Fatal().Err(err).Msg("error loading config")
-	}
+	driverStore := app.Store(cfg.DynamoDBTable, store.WithReadMetrics(emfEmitter))
 
-	logLevel, err := zerolog.ParseLevel(cfg.LogLevel)
-	if err != nil {
-		logger.Fatal().Str("input", cfg.LogLevel).Err(err).Msg("error parsing log level")
+	transport := ws.NewAPIGatewayTransport(app.APIGatewayManagement(cfg.WSManagementEndpoint))
+	pusher := ws.NewPusher(transport, driverStore, ws.WithPushMetrics(emfEmitter))
+
+	metricsClient := app.CloudWatchMetrics(cfg.MetricsNamespace)
+
+	sqsClient := app.SQS()
+	interactiveCfg := event.DispatcherConfig{
+		Backend:  event.Backend(cfg.EventBackend),
+		QueueURL: cfg.IngestionQueueURL,
+		TopicARN: cfg.IngestionTopicARN,
+	}
+	backgroundCfg := event.DispatcherConfig{
+		Backend:  event.Backend(cfg.EventBackend),
+		QueueURL: cfg.BackgroundQueueURL,
+		TopicARN: cfg.BackgroundTopicARN,
 	}
-	logger = logger.Level(logLevel)
+	app.VerifyConfig(context.Background(),
+		bootstrap.TableCheck(app.DynamoDB(), cfg.DynamoDBTable),
+		bootstrap.WebSocketEndpointCheck(cfg.WSManagementEndpoint),
+		bootstrap.DispatcherCheck(sqsClient, app.SNS(), "ingestion", interactiveCfg),
+		bootstrap.DispatcherCheck(sqsClient, app.SNS(), "background ingestion", backgroundCfg),
+	)
 
-	err = xray.Configure(xray.Config{
-		LogLevel: "warn",
-	})
+	interactiveDispatcher, err := event.NewEventDispatcher(interactiveCfg, sqsClient, app.SNS())
 	if err != nil {
-		logger.Fatal().Err(err).Msg("error configuring x-ray")
+		logger.Fatal().Err(err).Msg("error creating event dispatcher")
 	}
-
-	httpClient := xray.Client(http.DefaultClient)
-
-	awsCfg, err := config.LoadDefaultConfig(ctx, config.WithHTTPClient(httpClient))
+	backgroundDispatcher, err := event.NewEventDispatcher(backgroundCfg, sqsClient, app.SNS())
 	if err != nil {
-		logger.Fatal().Err(err).Msg("error loading AWS config")
+		logger.Fatal().Err(err).Msg("error creating background event dispatcher")
 	}
-	awsv2.AWSV2Instrumentor(&awsCfg.APIOptions)
-
-	dynamoClient := dynamodb.NewFromConfig(awsCfg)
-	driverStore := store.NewDynamoStore(dynamoClient, cfg.DynamoDBTable)
+	eventDispatcher := ingestion.NewPriorityDispatcher(interactiveDispatcher, backgroundDispatcher)
 
-	apiGWClient := apigatewaymanagementapi.NewFromConfig(awsCfg, func(o *apigatewaymanagementapi.Options) {
-		o.BaseEndpoint = &cfg.WSManagementEndpoint
-	})
-	pusher := ws.NewPusher(apiGWClient, driverStore)
+	s3Client := app.S3()
 
-	cwClient := cloudwatch.NewFromConfig(awsCfg)
-	metricsClient := metrics.NewCloudWatchEmitter(cwClient, cfg.MetricsNamespace)
-
-	sqsClient := sqs.NewFromConfig(awsCfg)
-	eventDispatcher := event.NewSQSEventDispatcher(sqsClient, cfg.IngestionQueueURL)
+	upstreamMonitor := upstream.NewMonitor(driverStore, upstream.DefaultPause, upstream.DefaultRefreshInterval)
+	// responses only get recorded for captured rounds and archived sessions, everything else passes straight through
+	iracingClient := iracing.NewClient(iracing.NewRecordingHTTPClient(app.HTTPClient), metricsClient,
+		iracing.WithAvailability(upstreamMonitor),
+		iracing.WithMaxResponseBytes(cfg.IRacingMaxResponseMB<<20),
+	)
+	cachingClient := iracing.NewGlobalInfoCachingClient(iracingClient, s3Client, cfg.IRacingCacheBucket, 24*time.Hour)
 
-	s3Client := s3.NewFromConfig(awsCfg)
+	standingsSnapshotter := standings.NewSnapshotter(driverStore, cachingClient)
 
-	iracingClient := iracing.NewClient(httpClient, metricsClient)
-	cachingClient := iracing.NewGlobalInfoCachingClient(iracingClient, s3Client, cfg.IRacingCacheBucket, 24*time.Hour)
+	processorOpts := []ingestion.RaceProcessorOption{
+		ingestion.WithSearchWindowInDays(cfg.SearchWindowInDays),
+		ingestion.WithBootstrapWindowInDays(cfg.BootstrapWindowInDays),
+		ingestion.WithStandingsSnapshotter(standingsSnapshotter),
+		ingestion.WithOnboardingTracker(onboarding.NewTracker(driverStore)),
+		ingestion.WithAlertEvaluator(alert.NewEvaluator(driverStore, pusher)),
+		ingestion.WithSeasonCardRefresher(seasoncard.NewRefresher(driverStore)),
+		ingestion.WithOverlayRefresher(overlay.NewRefresher(driverStore, pusher)),
+		ingestion.WithSquadEventTagger(squad.NewEventTagger(driverStore)),
+		ingestion.WithRacePlanLinker(raceplan.NewLinker(driverStore, pusher)),
+		ingestion.WithIngestionTiers(driverStore),
+		ingestion.WithLapInterpolation(cfg.InterpolateMissingLaps),
+		ingestion.WithUpstreamAvailability(upstreamMonitor),
+	}
+	if cfg.IngestionRateBudget > 0 {
+		rateBudget := ratebudget.NewCoordinator(driverStore, cfg.IngestionRateBudget, ratebudget.DefaultWindow)
+		processorOpts = append(processorOpts, ingestion.WithRateBudget(rateBudget, cfg.IngestionRoundRateCost))
+	}
+	if cfg.IngestionCaptureBucket != "" {
+		captureStore := ingestion.NewS3CaptureStore(s3Client, cfg.IngestionCaptureBucket)
+		processorOpts = append(processorOpts, ingestion.WithRunCapture(captureStore, uuid.NewString))
+	}
+	if cfg.SessionArchiveBucket != "" {
+		sessionArchive := ingestion.NewS3SessionArchive(s3Client, cfg.SessionArchiveBucket, driverStore)
+		processorOpts = append(processorOpts, ingestion.WithSessionArchive(sessionArchive))
+	}
 
 	lockDuration := time.Duration(cfg.IngestionLockDurationSeconds) * time.Second
-	processor := ingestion.NewRaceProcessor(driverStore, cachingClient, pusher, eventDispatcher, metricsClient, lockDuration,
-		ingestion.WithSearchWindowInDays(cfg.SearchWindowInDays),
-		ingestion.WithRaceConsumptionConcurrency(cfg.RaceConsumptionConcurrency),
-	)
+	newProcessor := func(raceConcurrency, lapConcurrency int) *ingestion.RaceProcessor {
+		opts := slices.Concat(processorOpts, []ingestion.RaceProcessorOption{
+			ingestion.WithRaceConsumptionConcurrency(raceConcurrency),
+			ingestion.WithLapConsumptionConcurrency(lapConcurrency),
+		})
+		return ingestion.NewRaceProcessor(driverStore, cachingClient, pusher, eventDispatcher, metricsClient, lockDuration, opts...)
+	}
+	interactiveProcessor := newProcessor(cfg.RaceConsumptionConcurrency, cfg.LapConsumptionConcurrency)
+	backgroundProcessor := newProcessor(cfg.BackgroundRaceConcurrency, cfg.BackgroundLapConcurrency)
 
-	handler := NewHandler(processor)
+	handler := NewHandler(interactiveProcessor, backgroundProcessor)
 	handler = sqsutil.WithReducedContextDeadline(handler, time.Second*5)
 	handler = sqsutil.WithVisibilityResetOnError(handler, sqsClient, sqsutil.LinearVisibilityTimeoutComputer(time.Second*2))
 	handler = sqsutil.WithXRayCapture(handler, "ProcessIngestion")
@@ -0,0 +1,60 @@
+// Command rotate-jwt-keys rotates the JWT signing key, storing a newly generated key as the current one in the signing
+// key secret's key set. Keys it replaced within the retention window stay in the set so tokens they signed keep
+// validating until they expire, and lambdas move over to the new key as their cached copy of the secret expires, so
+// nobody is signed out.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/cmd"
+)
+
+func main() {
+	secretID := flag.String("secret", os.Getenv("JWT_SIGNING_KEY_SECRET"), "name or ARN of the JWT signing key secret")
+	retain := flag.Duration("retain", 2*cmd.JWTExpiry, "how long replaced keys keep validating tokens, at least the token lifetime")
+	logLevel := flag.String("log-level", "info", "log level")
+	flag.Parse()
+
+	ctx := context.Background()
+	zerolog.TimeFieldFormat = time.RFC3339Nano
+	logger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).With().Timestamp().Logger()
+	level, err := zerolog.ParseLevel(*logLevel)
+	if err != nil {
+		logger.Fatal().Str("input", *logLevel).Err(err).Msg("error parsing log level")
+	}
+	logger = logger.Level(level)
+	ctx = logger.WithContext(ctx)
+
+	if *secretID == "" {
+		logger.Fatal().Msg("-secret is required")
+	}
+	if *retain < cmd.JWTExpiry {
+		logger.Fatal().Dur("retain", *retain).Dur("tokenLifetime", cmd.JWTExpiry).Msg("-retain is shorter than the token lifetime, sessions would be cut short")
+	}
+
+	awsCfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("error loading AWS config")
+	}
+
+	result, err := auth.RotateSigningKeySecret(ctx, secretsmanager.NewFromConfig(awsCfg), *secretID, *retain, time.Now())
+	if err != nil {
+		logger.Fatal().Err(err).Msg("rotating signing key failed")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		logger.Fatal().Err(err).Msg("error writing result")
+	}
+}
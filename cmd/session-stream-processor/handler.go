@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+	"github.com/rs/zerolog"
+)
+
+type Processor interface {
+	ProcessSession(ctx context.Context, session store.DriverSession) error
+}
+
+type HandlerFunc func(ctx context.Context, event events.DynamoDBEvent) error
+
+// NewHandler processes driver sessions as they are first written to the table. Modifications and removals are ignored,
+// as is anything other than a driver session, though the event source mapping should filter those out before they
+// get here. Returning an error has Lambda retry the batch, which is safe since processing is idempotent. Each tenant's
+// table streams to the same function, so sessions are processed for the tenant whose copy of table they came from.
+func NewHandler(processor Processor, table string) HandlerFunc {
+	return func(ctx context.Context, event events.DynamoDBEvent) error {
+		log := zerolog.Ctx(ctx)
+
+		for _, record := range event.Records {
+			if events.DynamoDBOperationType(record.EventName) != events.DynamoDBOperationTypeInsert {
+				continue
+			}
+
+			session, ok, err := store.DriverSessionFromItem(toAttributeValueMap(record.Change.NewImage))
+			if err != nil {
+				// a malformed item will never parse, retrying would just block the shard
+				log.Error().Err(err).Str("eventId", record.EventID).Msg("failed to parse driver session")
+				continue
+			}
+			if !ok {
+				continue
+			}
+
+			tenantID, ok := tenant.FromTableName(table, tableFromStreamARN(record.EventSourceArn))
+			if !ok {
+				// only a misconfigured event source mapping gets us here, retrying won't help
+				log.Error().Str("eventSourceArn", record.EventSourceArn).Str("eventId", record.EventID).Msg("driver session from an unknown table")
+				continue
+			}
+
+			log.Debug().Int64("driverId", session.DriverID).Int64("subsessionId", session.SubsessionID).Str("tenant", tenantID).Msg("processing driver session")
+			if err := processor.ProcessSession(tenant.WithID(ctx, tenantID), *session); err != nil {
+				return fmt.Errorf("processing session %d for driver %d: %w", session.SubsessionID, session.DriverID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// tableFromStreamARN returns the name of the table a stream ARN (arn:aws:dynamodb:<region>:<account>:table/<name>/stream/<label>)
+// belongs to, empty when it isn't one.
+func tableFromStreamARN(arn string) string {
+	parts := strings.Split(arn, "/")
+	if len(parts) != 4 || !strings.HasSuffix(parts[0], ":table") || parts[2] != "stream" {
+		return ""
+	}
+	return parts[1]
+}
+
+// toAttributeValueMap converts a stream record image into the form the SDK uses, so items can be decoded by the store
+func toAttributeValueMap(image map[string]events.DynamoDBAttributeValue) map[string]types.AttributeValue {
+	item := make(map[string]types.AttributeValue, len(image))
+	for name, value := range image {
+		item[name] = toAttributeValue(value)
+	}
+	return item
+}
+
+func toAttributeValue(value events.DynamoDBAttributeValue) types.AttributeValue {
+	switch value.DataType() {
+	case events.DataTypeString:
+		return &types.AttributeValueMemberS{Value: value.String()}
+	case events.DataTypeNumber:
+		return &types.AttributeValueMemberN{Value: value.Number()}
+	case events.DataTypeBoolean:
+		return &types.AttributeValueMemberBOOL{Value: value.Boolean()}
+	case events.DataTypeBinary:
+		return &types.AttributeValueMemberB{Value: value.Binary()}
+	case events.DataTypeStringSet:
+		return &types.AttributeValueMemberSS{Value: value.StringSet()}
+	case events.DataTypeNumberSet:
+		return &types.AttributeValueMemberNS{Value: value.NumberSet()}
+	case events.DataTypeBinarySet:
+		return &types.AttributeValueMemberBS{Value: value.BinarySet()}
+	case events.DataTypeList:
+		list := make([]types.AttributeValue, len(value.List()))
+		for i, v := range value.List() {
+			list[i] = toAttributeValue(v)
+		}
+		return &types.AttributeValueMemberL{Value: list}
+	case events.DataTypeMap:
+		return &types.AttributeValueMemberM{Value: toAttributeValueMap(value.Map())}
+	default:
+		return &types.AttributeValueMemberNULL{Value: true}
+	}
+}
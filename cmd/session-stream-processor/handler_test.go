@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHandler(t *testing.T) {
+	type processCall struct {
+		session  store.DriverSession
+		tenantID string
+		err      error
+	}
+
+	testCases := []struct {
+		name              string
+		records           []events.DynamoDBEventRecord
+		processCalls      []processCall
+		expectErrContains string
+	}{
+		{
+			name:    "empty event returns nil",
+			records: []events.DynamoDBEventRecord{},
+		},
+		{
+			name: "inserted sessions are processed",
+			records: []events.DynamoDBEventRecord{
+				sessionRecord("INSERT", 1001, 5000, 1715100000),
+				sessionRecord("INSERT", 1002, 5000, 1715100000),
+			},
+			processCalls: []processCall{
+				{session: expectedSession(1001, 5000, 1715100000)},
+				{session: expectedSession(1002, 5000, 1715100000)},
+			},
+		},
+		{
+			name: "modifications and removals ignored",
+			records: []events.DynamoDBEventRecord{
+				sessionRecord("MODIFY", 1001, 5000, 1715100000),
+				sessionRecord("REMOVE", 1001, 5001, 1715200000),
+			},
+		},
+		{
+			name: "other items ignored",
+			records: []events.DynamoDBEventRecord{
+				{
+					EventName: "INSERT",
+					Change: events.DynamoDBStreamRecord{
+						NewImage: map[string]events.DynamoDBAttributeValue{
+							"partition_key": events.NewStringAttribute("driver#1001"),
+							"sort_key":      events.NewStringAttribute("journal#1715100000"),
+						},
+					},
+				},
+				sessionRecord("INSERT", 1001, 5000, 1715100000),
+			},
+			processCalls: []processCall{
+				{session: expectedSession(1001, 5000, 1715100000)},
+			},
+		},
+		{
+			name: "malformed session skipped",
+			records: []events.DynamoDBEventRecord{
+				{
+					EventName: "INSERT",
+					Change: events.DynamoDBStreamRecord{
+						NewImage: map[string]events.DynamoDBAttributeValue{
+							"partition_key": events.NewStringAttribute("driver#1001"),
+							"sort_key":      events.NewStringAttribute("session#1715100000"),
+						},
+					},
+				},
+				sessionRecord("INSERT", 1001, 5001, 1715200000),
+			},
+			processCalls: []processCall{
+				{session: expectedSession(1001, 5001, 1715200000)},
+			},
+		},
+		{
+			name: "sessions processed for the tenant whose table they came from",
+			records: []events.DynamoDBEventRecord{
+				fromTable(sessionRecord("INSERT", 1001, 5000, 1715100000), "AppStore-league-one"),
+				sessionRecord("INSERT", 1002, 5000, 1715100000),
+			},
+			processCalls: []processCall{
+				{session: expectedSession(1001, 5000, 1715100000), tenantID: "league-one"},
+				{session: expectedSession(1002, 5000, 1715100000)},
+			},
+		},
+		{
+			name: "sessions from an unknown table skipped",
+			records: []events.DynamoDBEventRecord{
+				fromTable(sessionRecord("INSERT", 1001, 5000, 1715100000), "OtherStore"),
+				sessionRecord("INSERT", 1002, 5000, 1715100000),
+			},
+			processCalls: []processCall{
+				{session: expectedSession(1002, 5000, 1715100000)},
+			},
+		},
+		{
+			name: "processing error stops the batch",
+			records: []events.DynamoDBEventRecord{
+				sessionRecord("INSERT", 1001, 5000, 1715100000),
+				sessionRecord("INSERT", 1002, 5000, 1715100000),
+			},
+			processCalls: []processCall{
+				{session: expectedSession(1001, 5000, 1715100000), err: errors.New("throttled")},
+			},
+			expectErrContains: "processing session 5000 for driver 1001: throttled",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			mockProcessor := NewMockProcessor(t)
+			for _, call := range tc.processCalls {
+				tenantMatcher := mock.MatchedBy(func(ctx context.Context) bool {
+					return tenant.FromContext(ctx) == call.tenantID
+				})
+				mockProcessor.EXPECT().ProcessSession(tenantMatcher, call.session).Return(call.err)
+			}
+
+			err := NewHandler(mockProcessor, "AppStore")(ctx, events.DynamoDBEvent{Records: tc.records})
+			if tc.expectErrContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectErrContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func sessionRecord(eventName string, driverID, subsessionID, startTime int64) events.DynamoDBEventRecord {
+	number := func(n int64) events.DynamoDBAttributeValue {
+		return events.NewNumberAttribute(strconv.FormatInt(n, 10))
+	}
+	return events.DynamoDBEventRecord{
+		EventID:        "event-" + strconv.FormatInt(subsessionID, 10),
+		EventName:      eventName,
+		EventSourceArn: streamARN("AppStore"),
+		Change: events.DynamoDBStreamRecord{
+			NewImage: map[string]events.DynamoDBAttributeValue{
+				"partition_key":            events.NewStringAttribute("driver#" + strconv.FormatInt(driverID, 10)),
+				"sort_key":                 events.NewStringAttribute("session#" + strconv.FormatInt(startTime, 10)),
+				"subsession_id":            number(subsessionID),
+				"track_id":                 number(50),
+				"car_id":                   number(67),
+				"series_id":                number(42),
+				"series_name":              events.NewStringAttribute("Advanced Mazda MX-5 Cup Series"),
+				"start_time":               number(startTime),
+				"start_position":           number(5),
+				"start_position_in_class":  number(5),
+				"finish_position":          number(2),
+				"finish_position_in_class": number(2),
+				"incidents":                number(4),
+				"old_cpi":                  events.NewNumberAttribute("12.5"),
+				"new_cpi":                  events.NewNumberAttribute("13.25"),
+				"old_irating":              number(1480),
+				"new_irating":              number(1510),
+				"old_license_level":        number(14),
+				"new_license_level":        number(14),
+				"old_sub_level":            number(301),
+				"new_sub_level":            number(310),
+				"reason_out":               events.NewStringAttribute("Running"),
+				"laps_complete":            number(20),
+				"laps_lead":                number(3),
+				"season_id":                number(4500),
+				"drop_race":                events.NewBooleanAttribute(false),
+			},
+		},
+	}
+}
+
+func streamARN(table string) string {
+	return "arn:aws:dynamodb:us-east-1:123456789012:table/" + table + "/stream/2024-05-07T00:00:00.000"
+}
+
+func fromTable(record events.DynamoDBEventRecord, table string) events.DynamoDBEventRecord {
+	record.EventSourceArn = streamARN(table)
+	return record
+}
+
+func expectedSession(driverID, subsessionID, startTime int64) store.DriverSession {
+	return store.DriverSession{
+		DriverID:              driverID,
+		SubsessionID:          subsessionID,
+		TrackID:               50,
+		CarID:                 67,
+		SeriesID:              42,
+		SeriesName:            "Advanced Mazda MX-5 Cup Series",
+		StartTime:             time.Unix(startTime, 0),
+		StartPosition:         5,
+		StartPositionInClass:  5,
+		FinishPosition:        2,
+		FinishPositionInClass: 2,
+		Incidents:             4,
+		OldCPI:                12.5,
+		NewCPI:                13.25,
+		OldIRating:            1480,
+		NewIRating:            1510,
+		OldLicenseLevel:       14,
+		NewLicenseLevel:       14,
+		OldSubLevel:           301,
+		NewSubLevel:           310,
+		ReasonOut:             "Running",
+		LapsComplete:          20,
+		LapsLead:              3,
+		SeasonID:              4500,
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+
+	"github.com/jonsabados/saturdaysspinout/cmd/bootstrap"
+	"github.com/jonsabados/saturdaysspinout/datachange"
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/onboarding"
+	"github.com/jonsabados/saturdaysspinout/rollup"
+	"github.com/jonsabados/saturdaysspinout/ws"
+)
+
+type appCfg struct {
+	bootstrap.Config
+	DynamoDBTable        string `envconfig:"DYNAMODB_TABLE" required:"true"`
+	MetricsNamespace     string `envconfig:"METRICS_NAMESPACE" required:"true"`
+	WSManagementEndpoint string `envconfig:"WS_MANAGEMENT_ENDPOINT" required:"true"`
+}
+
+func main() {
+	var cfg appCfg
+	app := bootstrap.Start("session stream processor", &cfg)
+	app.VerifyConfig(context.Background(), bootstrap.TableCheck(app.DynamoDB(), cfg.DynamoDBTable))
+
+	driverStore := app.Store(cfg.DynamoDBTable)
+	transport := ws.NewAPIGatewayTransport(app.APIGatewayManagement(cfg.WSManagementEndpoint))
+	pusher := ws.NewPusher(transport, driverStore, ws.WithPushMetrics(metrics.NewEMFEmitter(os.Stdout, cfg.MetricsNamespace)))
+	processor := rollup.NewProcessor(driverStore, app.CloudWatchMetrics(cfg.MetricsNamespace),
+		rollup.WithOnboardingTracker(onboarding.NewTracker(driverStore)),
+		rollup.WithChangeNotifier(datachange.NewNotifier(pusher)),
+	)
+	handler := NewHandler(processor, cfg.DynamoDBTable)
+
+	lambda.Start(func(ctx context.Context, event events.DynamoDBEvent) error {
+		return handler(app.Logger.WithContext(ctx), event)
+	})
+}
@@ -6,7 +6,11 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/jonsabados/saturdaysspinout/cmd"
+	"github.com/jonsabados/saturdaysspinout/ws"
+	"github.com/jonsabados/saturdaysspinout/ws/native"
 )
 
 // requestTimeout matches the Lambda timeout configured in terraform/api.tf
@@ -14,9 +18,33 @@ const requestTimeout = 15 * time.Second
 
 func main() {
 	listenAddress := flag.String("listen-address", ":8080", "address to listen to for inbound requests")
+	wsListenAddress := flag.String("ws-listen-address", "", "address to serve the WebSocket API on, disabled when empty")
+	checkConfig := flag.Bool("check-config", false, "check the configuration, report anything wrong with it and exit without serving")
 	flag.Parse()
 
-	handler := withRequestTimeout(cmd.CreateAPI(), requestTimeout)
+	// the configuration is checked while the dependencies are created, exiting with a report of what's wrong
+	logger, deps := cmd.CreateAPIDependencies()
+	if *checkConfig {
+		return
+	}
+	handler := withRequestTimeout(cmd.NewAPI(logger, deps), requestTimeout)
+
+	if *wsListenAddress != "" {
+		// connections are tracked in the real store, but messages pushed from lambdas still go via API Gateway so
+		// only reach clients connected there
+		wsServer := native.NewServer(logger, uuid.NewString, cmd.WebSocketRoutes...)
+		var pushOpts []ws.PusherOption
+		if deps.LatencyMetrics != nil {
+			pushOpts = append(pushOpts, ws.WithPushMetrics(deps.LatencyMetrics))
+		}
+		wsHandler, _ := cmd.NewWebSocketHandler(deps.JWTService, wsServer, deps.Store, pushOpts...)
+		go func() {
+			err := http.ListenAndServe(*wsListenAddress, wsServer.Handler(wsHandler))
+			if err != nil {
+				panic(err)
+			}
+		}()
+	}
 
 	err := http.ListenAndServe(*listenAddress, handler)
 	if err != nil {
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/rs/zerolog"
+)
+
+type Processor interface {
+	HandleObjectCreated(ctx context.Context, key string) error
+	HandleTranscriptionFailed(ctx context.Context, jobName, reason string) error
+}
+
+type HandlerFunc func(ctx context.Context, payload json.RawMessage) error
+
+const transcribeJobStateChange = "Transcribe Job State Change"
+
+type transcribeJobDetail struct {
+	TranscriptionJobName   string `json:"TranscriptionJobName"`
+	TranscriptionJobStatus string `json:"TranscriptionJobStatus"`
+	FailureReason          string `json:"FailureReason"`
+}
+
+// NewHandler takes both S3 object created notifications from the memo bucket and EventBridge transcription job state
+// changes, which arrive as differently shaped payloads on the same function. Completed jobs need no handling of their
+// own, their transcript landing in the bucket is what drives things forward.
+func NewHandler(processor Processor) HandlerFunc {
+	return func(ctx context.Context, payload json.RawMessage) error {
+		var probe struct {
+			Records    json.RawMessage `json:"Records"`
+			DetailType string          `json:"detail-type"`
+		}
+		if err := json.Unmarshal(payload, &probe); err != nil {
+			return fmt.Errorf("decoding event: %w", err)
+		}
+
+		switch {
+		case probe.Records != nil:
+			var event events.S3Event
+			if err := json.Unmarshal(payload, &event); err != nil {
+				return fmt.Errorf("decoding s3 event: %w", err)
+			}
+			return handleS3Event(ctx, processor, event)
+		case probe.DetailType == transcribeJobStateChange:
+			var event events.CloudWatchEvent
+			if err := json.Unmarshal(payload, &event); err != nil {
+				return fmt.Errorf("decoding transcription event: %w", err)
+			}
+			return handleJobStateChange(ctx, processor, event)
+		default:
+			zerolog.Ctx(ctx).Warn().RawJSON("payload", payload).Msg("ignoring unrecognized event")
+			return nil
+		}
+	}
+}
+
+func handleS3Event(ctx context.Context, processor Processor, event events.S3Event) error {
+	for _, record := range event.Records {
+		// keys arrive URL encoded
+		key, err := url.QueryUnescape(record.S3.Object.Key)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Str("key", record.S3.Object.Key).Msg("failed to decode object key")
+			continue
+		}
+		if err := processor.HandleObjectCreated(ctx, key); err != nil {
+			return fmt.Errorf("processing object %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func handleJobStateChange(ctx context.Context, processor Processor, event events.CloudWatchEvent) error {
+	var detail transcribeJobDetail
+	if err := json.Unmarshal(event.Detail, &detail); err != nil {
+		return fmt.Errorf("decoding transcription job detail: %w", err)
+	}
+	if detail.TranscriptionJobStatus != "FAILED" {
+		return nil
+	}
+	return processor.HandleTranscriptionFailed(ctx, detail.TranscriptionJobName, detail.FailureReason)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewHandler(t *testing.T) {
+	testCases := []struct {
+		name              string
+		payload           string
+		setupProcessor    func(*MockProcessor)
+		expectErrContains string
+	}{
+		{
+			name: "object created records processed with decoded keys",
+			payload: `{"Records": [
+				{"eventName": "ObjectCreated:Put", "s3": {"bucket": {"name": "memo-bucket"}, "object": {"key": "memos/12345/1700000000/abc"}}},
+				{"eventName": "ObjectCreated:Put", "s3": {"bucket": {"name": "memo-bucket"}, "object": {"key": "transcripts/12345/1700000000/abc%2Bdef.json"}}}
+			]}`,
+			setupProcessor: func(m *MockProcessor) {
+				m.EXPECT().HandleObjectCreated(mock.Anything, "memos/12345/1700000000/abc").Return(nil)
+				m.EXPECT().HandleObjectCreated(mock.Anything, "transcripts/12345/1700000000/abc+def.json").Return(nil)
+			},
+		},
+		{
+			name: "object processing error returned",
+			payload: `{"Records": [
+				{"eventName": "ObjectCreated:Put", "s3": {"bucket": {"name": "memo-bucket"}, "object": {"key": "memos/12345/1700000000/abc"}}}
+			]}`,
+			setupProcessor: func(m *MockProcessor) {
+				m.EXPECT().HandleObjectCreated(mock.Anything, "memos/12345/1700000000/abc").Return(errors.New("database error"))
+			},
+			expectErrContains: "database error",
+		},
+		{
+			name: "failed transcription job",
+			payload: `{
+				"source": "aws.transcribe",
+				"detail-type": "Transcribe Job State Change",
+				"detail": {"TranscriptionJobName": "memo.12345.1700000000.abc", "TranscriptionJobStatus": "FAILED", "FailureReason": "bad audio"}
+			}`,
+			setupProcessor: func(m *MockProcessor) {
+				m.EXPECT().HandleTranscriptionFailed(mock.Anything, "memo.12345.1700000000.abc", "bad audio").Return(nil)
+			},
+		},
+		{
+			name: "completed transcription job ignored",
+			payload: `{
+				"source": "aws.transcribe",
+				"detail-type": "Transcribe Job State Change",
+				"detail": {"TranscriptionJobName": "memo.12345.1700000000.abc", "TranscriptionJobStatus": "COMPLETED"}
+			}`,
+		},
+		{
+			name:    "unrecognized event ignored",
+			payload: `{"detail-type": "Something Else"}`,
+		},
+		{
+			name:              "malformed payload",
+			payload:           `[`,
+			expectErrContains: "decoding event",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			processor := NewMockProcessor(t)
+			if tc.setupProcessor != nil {
+				tc.setupProcessor(processor)
+			}
+
+			ctx := zerolog.Nop().WithContext(context.Background())
+			err := NewHandler(processor)(ctx, json.RawMessage(tc.payload))
+			if tc.expectErrContains != "" {
+				assert.ErrorContains(t, err, tc.expectErrContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-lambda-go/lambda"
+
+	"github.com/jonsabados/saturdaysspinout/cmd/bootstrap"
+	"github.com/jonsabados/saturdaysspinout/voicememo"
+)
+
+type appCfg struct {
+	bootstrap.Config
+	DynamoDBTable        string `envconfig:"DYNAMODB_TABLE" required:"true"`
+	VoiceMemoBucket      string `envconfig:"VOICE_MEMO_BUCKET" required:"true"`
+	FieldEncryptionKeyID string `envconfig:"FIELD_ENCRYPTION_KEY_ID"`
+}
+
+func main() {
+	var cfg appCfg
+	app := bootstrap.Start("voice memo processor", &cfg)
+	app.VerifyConfig(context.Background(), bootstrap.TableCheck(app.DynamoDB(), cfg.DynamoDBTable))
+
+	driverStore := app.Store(cfg.DynamoDBTable, app.FieldEncryption(cfg.FieldEncryptionKeyID)...)
+
+	transcriber := voicememo.NewAWSTranscriber(app.Transcribe())
+	processor := voicememo.NewProcessor(driverStore, app.S3(), transcriber, cfg.VoiceMemoBucket)
+
+	handler := NewHandler(processor)
+
+	lambda.Start(func(ctx context.Context, payload json.RawMessage) error {
+		return handler(app.Logger.WithContext(ctx), payload)
+	})
+}
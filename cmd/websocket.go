@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/ws"
+	wsauth "github.com/jonsabados/saturdaysspinout/ws/auth"
+	"github.com/jonsabados/saturdaysspinout/ws/cancel"
+	"github.com/jonsabados/saturdaysspinout/ws/disconnect"
+	wsoverlay "github.com/jonsabados/saturdaysspinout/ws/overlay"
+	"github.com/jonsabados/saturdaysspinout/ws/ping"
+	"github.com/jonsabados/saturdaysspinout/ws/presence"
+)
+
+// WebSocketRoutes are the non-special routes of the WebSocket API, matching those in terraform/websockets.tf
+var WebSocketRoutes = []string{"auth", "pingRequest", "cancelIngestion", "racingHeartbeat", "overlaySubscribe"}
+
+type WebSocketStore interface {
+	wsauth.ConnectionStore
+	ping.ConnectionStore
+	cancel.ConnectionStore
+	presence.ConnectionStore
+	wsoverlay.ConnectionStore
+	disconnect.ConnectionStore
+	ws.ConnectionLookup
+	auth.SessionStore
+}
+
+// NewWebSocketHandler assembles the WebSocket API on top of the given transport, returning the pusher bound to it as
+// well so other parts of the same process can message connected clients. Tokens from revoked sessions are refused.
+func NewWebSocketHandler(validator wsauth.JWTValidator, transport ws.Transport, connStore WebSocketStore, pushOpts ...ws.PusherOption) (*ws.Handler, *ws.Pusher) {
+	pusher := ws.NewPusher(transport, connStore, pushOpts...)
+	handler := ws.NewHandler(
+		disconnect.NewHandler(connStore),
+		wsauth.NewHandler(auth.NewSessionValidator(validator, connStore, auth.DefaultSessionCheckInterval), pusher, connStore),
+		ping.NewHandler(pusher, connStore),
+		cancel.NewHandler(pusher, connStore),
+		presence.NewHandler(pusher, connStore),
+		wsoverlay.NewHandler(pusher, connStore),
+	)
+	return handler, pusher
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+
+	"github.com/jonsabados/saturdaysspinout/cmd/bootstrap"
+	"github.com/jonsabados/saturdaysspinout/coaching"
+	"github.com/jonsabados/saturdaysspinout/datachange"
+	"github.com/jonsabados/saturdaysspinout/recap"
+	"github.com/jonsabados/saturdaysspinout/ws"
+)
+
+type appCfg struct {
+	bootstrap.Config
+	DynamoDBTable        string   `envconfig:"DYNAMODB_TABLE" required:"true"`
+	Tenants              []string `envconfig:"TENANTS"`
+	WSManagementEndpoint string   `envconfig:"WS_MANAGEMENT_ENDPOINT" required:"true"`
+}
+
+func main() {
+	var cfg appCfg
+	app := bootstrap.Start("weekly recap", &cfg)
+	app.VerifyConfig(context.Background(), bootstrap.TableCheck(app.DynamoDB(), cfg.DynamoDBTable))
+	tenants := app.Tenants(cfg.Tenants)
+
+	driverStore := app.Store(cfg.DynamoDBTable)
+	coachingService := coaching.NewService(driverStore)
+	transport := ws.NewAPIGatewayTransport(app.APIGatewayManagement(cfg.WSManagementEndpoint))
+	pusher := ws.NewPusher(transport, driverStore)
+	job := recap.NewJob(driverStore, coachingService, coachingService, recap.WithChangeNotifier(datachange.NewNotifier(pusher)))
+
+	// invoked on a schedule, the event payload carries nothing we need
+	lambda.Start(func(ctx context.Context) error {
+		// every tenant has its own data to work through
+		return tenants.ForEach(app.Logger.WithContext(ctx), job.Run)
+	})
+}
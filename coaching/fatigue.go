@@ -0,0 +1,194 @@
+package coaching
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// FatigueLookbackDays is how far back multi-race days are looked at when checking a driver for fatigue.
+const FatigueLookbackDays = 28
+
+const (
+	// minFatigueDays is how many days with more than one race it takes before calling a pattern
+	minFatigueDays = 3
+	// maxRaceOfDay lumps a day's races from this one on together, few drivers run more
+	maxRaceOfDay = 4
+	// minFatigueMeasures is how many measures have to tail off before the driver is flagged, one on its own is too
+	// easily down to the tracks and fields they happened to run
+	minFatigueMeasures = 2
+)
+
+// How much worse, on average, each measure has to get from one race to the next on the same day to count as tailing off
+const (
+	finishFatigueThreshold      = 0.5 // positions
+	incidentFatigueThreshold    = 0.5 // incidents
+	consistencyFatigueThreshold = 0.1 // percentage points off the best lap
+)
+
+// RaceOfDayStats is how the driver did in the nth race of their multi-race days.
+type RaceOfDayStats struct {
+	// RaceOfDay is 1 based, the last entry also covers any races after it
+	RaceOfDay         int
+	Races             int
+	AvgFinishPosition float64
+	AvgIncidents      float64
+	// AvgOffBestPercent is nil when none of the races had enough laps to measure consistency from
+	AvgOffBestPercent *float64
+}
+
+// FatigueInsight looks at whether the driver's races get worse the more of them they run in a day. Changes are the
+// average difference between consecutive races on the same day, positive meaning the later race was worse.
+type FatigueInsight struct {
+	WindowStart          time.Time
+	MultiRaceDays        int
+	RaceOfDay            []RaceOfDayStats
+	FinishPositionChange float64
+	IncidentChange       float64
+	// ConsistencyChange is nil when no consecutive pair of races both had enough laps to measure
+	ConsistencyChange *float64
+	// Degrading lists the measures that tailed off, it's only filled in once there are enough multi-race days to go on
+	Degrading []store.FatigueMeasure
+	Detected  bool
+}
+
+// Flag is the recap flag for the insight, nil unless fatigue was detected.
+func (f FatigueInsight) Flag() *store.FatigueFlag {
+	if !f.Detected {
+		return nil
+	}
+	return &store.FatigueFlag{MultiRaceDays: f.MultiRaceDays, Measures: f.Degrading}
+}
+
+type raceOfDayTotals struct {
+	races            int
+	finishPosition   int
+	incidents        int
+	consistencyRaces int
+	offBestPercent   float64
+}
+
+type changeTotals struct {
+	pairs      int
+	finish     int
+	incidents  int
+	lapPairs   int
+	offBestSum float64
+}
+
+// DetectFatigue compares the driver's races over the last FatigueLookbackDays by where they fell in their day, with
+// days split in the driver's own timezone so a late session doesn't count towards the next day.
+func (s *Service) DetectFatigue(ctx context.Context, driverID int64) (*FatigueInsight, error) {
+	now := s.now()
+	windowStart := now.AddDate(0, 0, -FatigueLookbackDays)
+
+	settings, err := s.store.GetDriverSettings(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting settings: %w", err)
+	}
+	location := time.UTC
+	if settings.Timezone != "" {
+		if loaded, err := time.LoadLocation(settings.Timezone); err == nil {
+			location = loaded
+		}
+	}
+
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, driverID, windowStart, now)
+	if err != nil {
+		return nil, fmt.Errorf("getting sessions: %w", err)
+	}
+
+	days := make(map[string][]store.DriverSession)
+	for _, session := range sessions {
+		day := session.StartTime.In(location).Format(time.DateOnly)
+		days[day] = append(days[day], session)
+	}
+
+	insight := &FatigueInsight{WindowStart: windowStart}
+	ordinals := make([]raceOfDayTotals, maxRaceOfDay)
+	var changes changeTotals
+	for _, races := range days {
+		if len(races) < 2 {
+			continue
+		}
+		insight.MultiRaceDays++
+		sort.Slice(races, func(i, j int) bool {
+			return races[i].StartTime.Before(races[j].StartTime)
+		})
+
+		var prevOffBest float64
+		var prevHasLaps bool
+		for i, race := range races {
+			offBest, hasLaps, err := s.RaceOffBestPercent(ctx, race)
+			if err != nil {
+				return nil, err
+			}
+
+			totals := &ordinals[min(i, maxRaceOfDay-1)]
+			totals.races++
+			totals.finishPosition += race.FinishPosition
+			totals.incidents += race.Incidents
+			if hasLaps {
+				totals.consistencyRaces++
+				totals.offBestPercent += offBest
+			}
+
+			if i > 0 {
+				prev := races[i-1]
+				changes.pairs++
+				changes.finish += race.FinishPosition - prev.FinishPosition
+				changes.incidents += race.Incidents - prev.Incidents
+				if hasLaps && prevHasLaps {
+					changes.lapPairs++
+					changes.offBestSum += offBest - prevOffBest
+				}
+			}
+			prevOffBest, prevHasLaps = offBest, hasLaps
+		}
+	}
+
+	for i, totals := range ordinals {
+		if totals.races == 0 {
+			continue
+		}
+		stats := RaceOfDayStats{
+			RaceOfDay:         i + 1,
+			Races:             totals.races,
+			AvgFinishPosition: float64(totals.finishPosition) / float64(totals.races),
+			AvgIncidents:      float64(totals.incidents) / float64(totals.races),
+		}
+		if totals.consistencyRaces > 0 {
+			avg := totals.offBestPercent / float64(totals.consistencyRaces)
+			stats.AvgOffBestPercent = &avg
+		}
+		insight.RaceOfDay = append(insight.RaceOfDay, stats)
+	}
+	if changes.pairs == 0 {
+		return insight, nil
+	}
+
+	insight.FinishPositionChange = float64(changes.finish) / float64(changes.pairs)
+	insight.IncidentChange = float64(changes.incidents) / float64(changes.pairs)
+	if changes.lapPairs > 0 {
+		change := changes.offBestSum / float64(changes.lapPairs)
+		insight.ConsistencyChange = &change
+	}
+
+	if insight.MultiRaceDays < minFatigueDays {
+		return insight, nil
+	}
+	if insight.FinishPositionChange >= finishFatigueThreshold {
+		insight.Degrading = append(insight.Degrading, store.FatigueMeasureFinishPosition)
+	}
+	if insight.IncidentChange >= incidentFatigueThreshold {
+		insight.Degrading = append(insight.Degrading, store.FatigueMeasureIncidents)
+	}
+	if insight.ConsistencyChange != nil && *insight.ConsistencyChange >= consistencyFatigueThreshold {
+		insight.Degrading = append(insight.Degrading, store.FatigueMeasureConsistency)
+	}
+	insight.Detected = len(insight.Degrading) >= minFatigueMeasures
+	return insight, nil
+}
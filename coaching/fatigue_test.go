@@ -0,0 +1,155 @@
+package coaching
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_DetectFatigue(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	windowStart := now.AddDate(0, 0, -FatigueLookbackDays)
+	chicago, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+
+	race := func(subsessionID int64, start time.Time, finish, incidents int, lapsSkipped bool) store.DriverSession {
+		return store.DriverSession{
+			DriverID:       driverID,
+			SubsessionID:   subsessionID,
+			StartTime:      start,
+			FinishPosition: finish,
+			Incidents:      incidents,
+			LapsSkipped:    lapsSkipped,
+		}
+	}
+	sessions := []store.DriverSession{
+		// an evening of three races, each further off the best lap
+		race(1, time.Date(2026, 3, 10, 19, 0, 0, 0, chicago), 2, 0, false),
+		race(2, time.Date(2026, 3, 10, 20, 0, 0, 0, chicago), 4, 2, false),
+		race(3, time.Date(2026, 3, 10, 21, 0, 0, 0, chicago), 7, 4, false),
+		// straddles midnight UTC
+		race(4, time.Date(2026, 3, 12, 18, 30, 0, 0, chicago), 3, 1, true),
+		race(5, time.Date(2026, 3, 12, 19, 30, 0, 0, chicago), 5, 2, true),
+		race(6, time.Date(2026, 3, 15, 14, 0, 0, 0, chicago), 1, 0, true),
+		race(7, time.Date(2026, 3, 15, 15, 0, 0, 0, chicago), 2, 1, true),
+		// single race days don't count
+		race(8, time.Date(2026, 3, 17, 14, 0, 0, 0, chicago), 12, 8, true),
+	}
+	// best laps of 1024000 with averages 1/64th, 2/64ths and 3/64ths slower keep the percentages exact
+	lapSummaries := map[int64]int{1: 1040000, 2: 1056000, 3: 1072000}
+	ptr := func(v float64) *float64 { return &v }
+
+	expectLaps := func(m *MockStore) {
+		for subsessionID, avgLapTime := range lapSummaries {
+			m.EXPECT().GetSessionDriverLaps(mock.Anything, subsessionID, driverID).Return(nil, nil)
+			m.EXPECT().GetSessionDriverLapSummary(mock.Anything, subsessionID, driverID).Return(&store.SessionDriverLapSummary{
+				ValidLapCount: 10, BestLapTime: 1024000, AvgLapTime: avgLapTime,
+			}, nil)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*MockStore)
+		expected      *FatigueInsight
+		expectedFlag  *store.FatigueFlag
+		expectedError string
+	}{
+		{
+			name: "tailing off in the driver's timezone",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID, Timezone: "America/Chicago"}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, windowStart, now).Return(sessions, nil)
+				expectLaps(m)
+			},
+			expected: &FatigueInsight{
+				WindowStart:   windowStart,
+				MultiRaceDays: 3,
+				RaceOfDay: []RaceOfDayStats{
+					{RaceOfDay: 1, Races: 3, AvgFinishPosition: 2, AvgIncidents: 1.0 / 3, AvgOffBestPercent: ptr(1.5625)},
+					{RaceOfDay: 2, Races: 3, AvgFinishPosition: 11.0 / 3, AvgIncidents: 5.0 / 3, AvgOffBestPercent: ptr(3.125)},
+					{RaceOfDay: 3, Races: 1, AvgFinishPosition: 7, AvgIncidents: 4, AvgOffBestPercent: ptr(4.6875)},
+				},
+				FinishPositionChange: 2,
+				IncidentChange:       1.5,
+				ConsistencyChange:    ptr(1.5625),
+				Degrading:            []store.FatigueMeasure{store.FatigueMeasureFinishPosition, store.FatigueMeasureIncidents, store.FatigueMeasureConsistency},
+				Detected:             true,
+			},
+			expectedFlag: &store.FatigueFlag{
+				MultiRaceDays: 3,
+				Measures:      []store.FatigueMeasure{store.FatigueMeasureFinishPosition, store.FatigueMeasureIncidents, store.FatigueMeasureConsistency},
+			},
+		},
+		{
+			name: "too few multi-race days in UTC",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, windowStart, now).Return(sessions, nil)
+				expectLaps(m)
+			},
+			expected: &FatigueInsight{
+				WindowStart:   windowStart,
+				MultiRaceDays: 2,
+				RaceOfDay: []RaceOfDayStats{
+					{RaceOfDay: 1, Races: 2, AvgFinishPosition: 1.5, AvgIncidents: 0, AvgOffBestPercent: ptr(1.5625)},
+					{RaceOfDay: 2, Races: 2, AvgFinishPosition: 3, AvgIncidents: 1.5, AvgOffBestPercent: ptr(3.125)},
+					{RaceOfDay: 3, Races: 1, AvgFinishPosition: 7, AvgIncidents: 4, AvgOffBestPercent: ptr(4.6875)},
+				},
+				FinishPositionChange: 2,
+				IncidentChange:       5.0 / 3,
+				ConsistencyChange:    ptr(1.5625),
+			},
+		},
+		{
+			name: "no races",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, windowStart, now).Return(nil, nil)
+			},
+			expected: &FatigueInsight{WindowStart: windowStart},
+		},
+		{
+			name: "settings error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(nil, errors.New("boom"))
+			},
+			expectedError: "getting settings: boom",
+		},
+		{
+			name: "session error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID}, nil)
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, windowStart, now).Return(nil, errors.New("boom"))
+			},
+			expectedError: "getting sessions: boom",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			svc := NewService(mockStore)
+			svc.now = func() time.Time { return now }
+
+			insight, err := svc.DetectFatigue(ctx, driverID)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, insight)
+			assert.Equal(t, tc.expectedFlag, insight.Flag())
+		})
+	}
+}
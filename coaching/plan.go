@@ -0,0 +1,195 @@
+package coaching
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// MinRaces is how many races a driver needs at a track in a car before it's compared against their norm, fewer than
+// that is more likely to be bad luck than something to practice.
+const MinRaces = 2
+
+// MaxPlanItems caps the plan, a short list is more likely to actually get practiced.
+const MaxPlanItems = 5
+
+// minExcess is how far worse than the driver's norm a combination has to be, relative to the norm, to make the plan.
+const minExcess = 0.25
+
+// minConsistencyLaps is the fewest timed laps a race needs before its lap time spread says anything.
+const minConsistencyLaps = 3
+
+// LapStats is the per-race lap data the consistency focus is measured from. Times are iRacing 10ths of milliseconds.
+type LapStats struct {
+	ValidLapCount int
+	BestLapTime   int
+	AvgLapTime    int
+}
+
+// PracticeLapStats is the lap data from a practice session driven outside of any race, such as laps imported from a
+// third party lap time service.
+type PracticeLapStats struct {
+	TrackID int64
+	CarID   int64
+	LapStats
+}
+
+type comboKey struct {
+	trackID int64
+	carID   int64
+}
+
+// measures accumulates the figures behind every focus area for a set of races.
+type measures struct {
+	raceCount int
+
+	corners   int
+	incidents int
+
+	positionsLost int
+
+	// consistency takes in practice sessions as well as races
+	consistencySessions int
+	offBestPercent      float64
+}
+
+func (m *measures) add(session store.DriverSession, lapStats map[int64]LapStats) {
+	m.raceCount++
+	m.positionsLost += session.FinishPosition - session.StartPosition
+	if corners := session.CornersPerLap * session.LapsComplete; corners > 0 {
+		m.corners += corners
+		m.incidents += session.Incidents
+	}
+	m.addConsistency(lapStats[session.SubsessionID])
+}
+
+func (m *measures) addConsistency(stats LapStats) {
+	if offBest, ok := offBestPercent(stats); ok {
+		m.consistencySessions++
+		m.offBestPercent += offBest
+	}
+}
+
+// offBestPercent is how far a race's average lap was off its best lap, as a percentage of the best lap. Returns false
+// when the race has too few timed laps to say.
+func offBestPercent(stats LapStats) (float64, bool) {
+	if stats.ValidLapCount < minConsistencyLaps || stats.BestLapTime <= 0 {
+		return 0, false
+	}
+	return float64(stats.AvgLapTime-stats.BestLapTime) / float64(stats.BestLapTime) * 100, true
+}
+
+// value returns the measure for a focus area, false if none of the races had the data needed.
+func (m *measures) value(focus store.PracticeFocus) (float64, bool) {
+	switch focus {
+	case store.PracticeFocusIncidents:
+		if m.corners == 0 {
+			return 0, false
+		}
+		return float64(m.incidents) / float64(m.corners) * 100, true
+	case store.PracticeFocusConsistency:
+		if m.consistencySessions == 0 {
+			return 0, false
+		}
+		return m.offBestPercent / float64(m.consistencySessions), true
+	case store.PracticeFocusRacecraft:
+		if m.raceCount == 0 {
+			return 0, false
+		}
+		return float64(m.positionsLost) / float64(m.raceCount), true
+	}
+	return 0, false
+}
+
+// focusAreas are the areas considered, each measured so that higher is worse:
+//   - incidents: incidents per 100 corners
+//   - consistency: how far the average lap is off the best lap, as a percentage of the best lap
+//   - racecraft: average positions lost between the start and the finish
+var focusAreas = []store.PracticeFocus{
+	store.PracticeFocusIncidents,
+	store.PracticeFocusConsistency,
+	store.PracticeFocusRacecraft,
+}
+
+type scoredItem struct {
+	item  store.PracticePlanItem
+	score float64
+}
+
+// BuildPlan picks the track and car combinations the driver does worst at relative to their own norm across the given
+// sessions, along with what to focus on at each. lapStats is keyed by subsession ID; races without an entry don't
+// count towards consistency. practice only counts towards consistency, and only at combinations with enough races to
+// be considered. Every combination appears at most once, under the focus it's furthest off the norm in, and the most
+// pressing come first.
+func BuildPlan(sessions []store.DriverSession, lapStats map[int64]LapStats, practice []PracticeLapStats) []store.PracticePlanItem {
+	var baseline measures
+	combos := make(map[comboKey]*measures)
+	comboFor := func(trackID, carID int64) *measures {
+		key := comboKey{trackID: trackID, carID: carID}
+		combo, ok := combos[key]
+		if !ok {
+			combo = &measures{}
+			combos[key] = combo
+		}
+		return combo
+	}
+	for _, session := range sessions {
+		baseline.add(session, lapStats)
+		comboFor(session.TrackID, session.CarID).add(session, lapStats)
+	}
+	for _, stats := range practice {
+		baseline.addConsistency(stats.LapStats)
+		comboFor(stats.TrackID, stats.CarID).addConsistency(stats.LapStats)
+	}
+
+	var candidates []scoredItem
+	for key, combo := range combos {
+		if combo.raceCount < MinRaces {
+			continue
+		}
+		best, found := scoredItem{}, false
+		for _, focus := range focusAreas {
+			value, ok := combo.value(focus)
+			if !ok {
+				continue
+			}
+			norm, _ := baseline.value(focus)
+			// the floor keeps a near spotless norm from turning a single incident into a huge excess
+			score := (value - norm) / math.Max(math.Abs(norm), 1)
+			if score < minExcess || (found && score <= best.score) {
+				continue
+			}
+			best, found = scoredItem{
+				item: store.PracticePlanItem{
+					TrackID:   key.trackID,
+					CarID:     key.carID,
+					Focus:     focus,
+					RaceCount: combo.raceCount,
+					Value:     value,
+					Baseline:  norm,
+				},
+				score: score,
+			}, true
+		}
+		if found {
+			candidates = append(candidates, best)
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b scoredItem) int {
+		// ties broken by ID so plans don't reshuffle between generations
+		return cmp.Or(
+			cmp.Compare(b.score, a.score),
+			cmp.Compare(a.item.TrackID, b.item.TrackID),
+			cmp.Compare(a.item.CarID, b.item.CarID),
+		)
+	})
+
+	items := make([]store.PracticePlanItem, 0, min(len(candidates), MaxPlanItems))
+	for _, c := range candidates[:min(len(candidates), MaxPlanItems)] {
+		items = append(items, c.item)
+	}
+	return items
+}
@@ -0,0 +1,170 @@
+package coaching
+
+import (
+	"testing"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildPlan(t *testing.T) {
+	// race at a track and car with 12 corners over 10 laps, so incidents are per 120 corners
+	race := func(subsessionID, trackID, carID int64, start, finish, incidents int) store.DriverSession {
+		return store.DriverSession{
+			SubsessionID:   subsessionID,
+			TrackID:        trackID,
+			CarID:          carID,
+			StartPosition:  start,
+			FinishPosition: finish,
+			Incidents:      incidents,
+			CornersPerLap:  12,
+			LapsComplete:   10,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		sessions []store.DriverSession
+		lapStats map[int64]LapStats
+		practice []PracticeLapStats
+		expected []store.PracticePlanItem
+	}{
+		{
+			name:     "no races",
+			expected: []store.PracticePlanItem{},
+		},
+		{
+			name: "incident heavy track",
+			sessions: []store.DriverSession{
+				race(1, 10, 1, 5, 5, 0),
+				race(2, 10, 1, 5, 5, 0),
+				race(3, 20, 1, 5, 5, 6),
+				race(4, 20, 1, 5, 5, 6),
+			},
+			expected: []store.PracticePlanItem{
+				{TrackID: 20, CarID: 1, Focus: store.PracticeFocusIncidents, RaceCount: 2, Value: 5, Baseline: 2.5},
+			},
+		},
+		{
+			name: "single races aren't enough to judge",
+			sessions: []store.DriverSession{
+				race(1, 10, 1, 5, 5, 0),
+				race(2, 10, 1, 5, 5, 0),
+				race(3, 20, 1, 5, 5, 12),
+			},
+			expected: []store.PracticePlanItem{},
+		},
+		{
+			name: "losing positions",
+			sessions: []store.DriverSession{
+				race(1, 10, 1, 5, 3, 0),
+				race(2, 10, 1, 5, 3, 0),
+				race(3, 20, 1, 2, 8, 0),
+				race(4, 20, 1, 2, 8, 0),
+			},
+			expected: []store.PracticePlanItem{
+				{TrackID: 20, CarID: 1, Focus: store.PracticeFocusRacecraft, RaceCount: 2, Value: 6, Baseline: 2},
+			},
+		},
+		{
+			name: "inconsistent laps, races without enough laps ignored",
+			sessions: []store.DriverSession{
+				race(1, 10, 1, 5, 5, 0),
+				race(2, 10, 1, 5, 5, 0),
+				race(3, 20, 2, 5, 5, 0),
+				race(4, 20, 2, 5, 5, 0),
+			},
+			lapStats: map[int64]LapStats{
+				1: {ValidLapCount: 10, BestLapTime: 1000000, AvgLapTime: 1010000},
+				2: {ValidLapCount: 10, BestLapTime: 1000000, AvgLapTime: 1010000},
+				3: {ValidLapCount: 10, BestLapTime: 1000000, AvgLapTime: 1040000},
+				4: {ValidLapCount: 2, BestLapTime: 1000000, AvgLapTime: 1500000},
+			},
+			expected: []store.PracticePlanItem{
+				{TrackID: 20, CarID: 2, Focus: store.PracticeFocusConsistency, RaceCount: 2, Value: 4, Baseline: 2},
+			},
+		},
+		{
+			name: "worst focus per combination, most pressing first",
+			sessions: []store.DriverSession{
+				race(1, 10, 1, 5, 5, 0),
+				race(2, 10, 1, 5, 5, 0),
+				race(3, 10, 1, 5, 5, 0),
+				race(4, 10, 1, 5, 5, 0),
+				// a little worse on incidents, a lot worse on positions
+				race(5, 20, 1, 5, 9, 4),
+				race(6, 20, 1, 5, 9, 4),
+				// much worse on incidents
+				race(7, 30, 1, 5, 5, 10),
+				race(8, 30, 1, 5, 5, 10),
+			},
+			expected: []store.PracticePlanItem{
+				{TrackID: 20, CarID: 1, Focus: store.PracticeFocusRacecraft, RaceCount: 2, Value: 4, Baseline: 1},
+				{TrackID: 30, CarID: 1, Focus: store.PracticeFocusIncidents, RaceCount: 2, Value: 8.333, Baseline: 2.917},
+			},
+		},
+		{
+			name: "practice counts towards consistency",
+			sessions: []store.DriverSession{
+				race(1, 10, 1, 5, 5, 0),
+				race(2, 10, 1, 5, 5, 0),
+				race(3, 20, 1, 5, 5, 0),
+				race(4, 20, 1, 5, 5, 0),
+			},
+			lapStats: map[int64]LapStats{
+				1: {ValidLapCount: 10, BestLapTime: 1000000, AvgLapTime: 1010000},
+				2: {ValidLapCount: 10, BestLapTime: 1000000, AvgLapTime: 1010000},
+				3: {ValidLapCount: 10, BestLapTime: 1000000, AvgLapTime: 1010000},
+			},
+			practice: []PracticeLapStats{
+				{TrackID: 20, CarID: 1, LapStats: LapStats{ValidLapCount: 20, BestLapTime: 1000000, AvgLapTime: 1060000}},
+				{TrackID: 20, CarID: 1, LapStats: LapStats{ValidLapCount: 20, BestLapTime: 1000000, AvgLapTime: 1050000}},
+				// too few laps to say anything
+				{TrackID: 20, CarID: 1, LapStats: LapStats{ValidLapCount: 2, BestLapTime: 1000000, AvgLapTime: 1500000}},
+				// never raced here, so it only moves the norm
+				{TrackID: 30, CarID: 1, LapStats: LapStats{ValidLapCount: 20, BestLapTime: 1000000, AvgLapTime: 1010000}},
+			},
+			expected: []store.PracticePlanItem{
+				{TrackID: 20, CarID: 1, Focus: store.PracticeFocusConsistency, RaceCount: 2, Value: 4, Baseline: 2.5},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			items := BuildPlan(tc.sessions, tc.lapStats, tc.practice)
+			assert.Len(t, items, len(tc.expected))
+			for i := range min(len(items), len(tc.expected)) {
+				assert.Equal(t, tc.expected[i].TrackID, items[i].TrackID)
+				assert.Equal(t, tc.expected[i].CarID, items[i].CarID)
+				assert.Equal(t, tc.expected[i].Focus, items[i].Focus)
+				assert.Equal(t, tc.expected[i].RaceCount, items[i].RaceCount)
+				assert.InDelta(t, tc.expected[i].Value, items[i].Value, 0.001)
+				assert.InDelta(t, tc.expected[i].Baseline, items[i].Baseline, 0.001)
+			}
+		})
+	}
+}
+
+func TestBuildPlan_Capped(t *testing.T) {
+	// plenty of clean races elsewhere so most of the tracks below stand out
+	var sessions []store.DriverSession
+	for i := range int64(10) {
+		sessions = append(sessions, store.DriverSession{SubsessionID: 1000 + i, TrackID: 100, CarID: 1})
+	}
+	for track := range int64(10) {
+		for i := range int64(2) {
+			sessions = append(sessions, store.DriverSession{
+				SubsessionID:   track*10 + i,
+				TrackID:        track,
+				CarID:          1,
+				StartPosition:  0,
+				FinishPosition: int(track),
+			})
+		}
+	}
+
+	items := BuildPlan(sessions, nil, nil)
+	assert.Len(t, items, MaxPlanItems)
+	assert.Equal(t, int64(9), items[0].TrackID)
+}
@@ -0,0 +1,168 @@
+package coaching
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/retention"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// LookbackDays is how far back races are considered when generating a practice plan.
+const LookbackDays = 28
+
+// Store defines the data access methods needed by the coaching service.
+type Store interface {
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	GetSessionDriverLaps(ctx context.Context, subsessionID, driverID int64) ([]store.SessionDriverLap, error)
+	GetSessionDriverLapSummary(ctx context.Context, subsessionID, driverID int64) (*store.SessionDriverLapSummary, error)
+	GetExternalLapsByTimeRange(ctx context.Context, driverID int64, from, to time.Time) ([]store.ExternalLap, error)
+	SavePracticePlan(ctx context.Context, plan store.PracticePlan) error
+	GetPracticePlan(ctx context.Context, driverID int64) (*store.PracticePlan, error)
+	GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error)
+}
+
+// Service turns a driver's recent races into practice suggestions.
+type Service struct {
+	store Store
+	now   func() time.Time
+}
+
+func NewService(store Store) *Service {
+	return &Service{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// GetPracticePlan returns the driver's current practice plan, generating one if they've never had one. Plans are
+// otherwise only refreshed by GeneratePracticePlan.
+func (s *Service) GetPracticePlan(ctx context.Context, driverID int64) (*store.PracticePlan, error) {
+	plan, err := s.store.GetPracticePlan(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if plan != nil {
+		return plan, nil
+	}
+	return s.GeneratePracticePlan(ctx, driverID)
+}
+
+// GeneratePracticePlan builds a practice plan from the driver's races over the last LookbackDays and saves it over
+// any previous plan.
+func (s *Service) GeneratePracticePlan(ctx context.Context, driverID int64) (*store.PracticePlan, error) {
+	now := s.now()
+	windowStart := now.AddDate(0, 0, -LookbackDays)
+
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, driverID, windowStart, now)
+	if err != nil {
+		return nil, fmt.Errorf("getting sessions: %w", err)
+	}
+
+	lapStats := make(map[int64]LapStats, len(sessions))
+	for _, session := range sessions {
+		stats, ok, err := s.lapStats(ctx, session)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			lapStats[session.SubsessionID] = stats
+		}
+	}
+
+	externalLaps, err := s.store.GetExternalLapsByTimeRange(ctx, driverID, windowStart, now)
+	if err != nil {
+		return nil, fmt.Errorf("getting external laps: %w", err)
+	}
+
+	plan := store.PracticePlan{
+		DriverID:    driverID,
+		GeneratedAt: now,
+		WindowStart: windowStart,
+		Items:       BuildPlan(sessions, lapStats, practiceLapStats(externalLaps)),
+	}
+	if err := s.store.SavePracticePlan(ctx, plan); err != nil {
+		return nil, fmt.Errorf("saving practice plan: %w", err)
+	}
+	return &plan, nil
+}
+
+// lapStats gathers a race's lap stats from its laps, falling back to the summary left behind once laps have been
+// compacted. Returns false if the race has neither.
+func (s *Service) lapStats(ctx context.Context, session store.DriverSession) (LapStats, bool, error) {
+	if session.LapsSkipped {
+		return LapStats{}, false, nil
+	}
+
+	laps, err := s.store.GetSessionDriverLaps(ctx, session.SubsessionID, session.DriverID)
+	if err != nil {
+		return LapStats{}, false, fmt.Errorf("getting laps for session %d: %w", session.SubsessionID, err)
+	}
+	if len(laps) > 0 {
+		summary := retention.SummarizeLaps(session.SubsessionID, session.DriverID, laps)
+		return lapStatsFromSummary(summary), true, nil
+	}
+
+	summary, err := s.store.GetSessionDriverLapSummary(ctx, session.SubsessionID, session.DriverID)
+	if err != nil {
+		return LapStats{}, false, fmt.Errorf("getting lap summary for session %d: %w", session.SubsessionID, err)
+	}
+	if summary == nil {
+		return LapStats{}, false, nil
+	}
+	return lapStatsFromSummary(*summary), true, nil
+}
+
+// practiceLapStats summarizes imported laps session by session, in the order the sessions first appear.
+func practiceLapStats(laps []store.ExternalLap) []PracticeLapStats {
+	type sessionKey struct {
+		source    string
+		sessionID string
+	}
+	var order []sessionKey
+	bySession := make(map[sessionKey][]store.ExternalLap)
+	for _, lap := range laps {
+		key := sessionKey{source: lap.Source, sessionID: lap.SessionID}
+		if _, ok := bySession[key]; !ok {
+			order = append(order, key)
+		}
+		bySession[key] = append(bySession[key], lap)
+	}
+
+	stats := make([]PracticeLapStats, 0, len(order))
+	for _, key := range order {
+		sessionLaps := bySession[key]
+		// summarized the same way as race laps so practice and races measure alike
+		asRaceLaps := make([]store.SessionDriverLap, len(sessionLaps))
+		for i, lap := range sessionLaps {
+			asRaceLaps[i] = store.SessionDriverLap{LapNumber: lap.LapNumber, Incident: lap.Incident, LapTime: lap.LapTime}
+		}
+		summary := retention.SummarizeLaps(0, sessionLaps[0].DriverID, asRaceLaps)
+		stats = append(stats, PracticeLapStats{
+			TrackID:  sessionLaps[0].TrackID,
+			CarID:    sessionLaps[0].CarID,
+			LapStats: lapStatsFromSummary(summary),
+		})
+	}
+	return stats
+}
+
+// RaceOffBestPercent is how far the driver's average lap in a race was off their best lap, as a percentage of the best
+// lap. Returns false when the race doesn't have enough timed laps to say.
+func (s *Service) RaceOffBestPercent(ctx context.Context, session store.DriverSession) (float64, bool, error) {
+	stats, ok, err := s.lapStats(ctx, session)
+	if err != nil || !ok {
+		return 0, false, err
+	}
+	offBest, ok := offBestPercent(stats)
+	return offBest, ok, nil
+}
+
+func lapStatsFromSummary(summary store.SessionDriverLapSummary) LapStats {
+	return LapStats{
+		ValidLapCount: summary.ValidLapCount,
+		BestLapTime:   summary.BestLapTime,
+		AvgLapTime:    summary.AvgLapTime,
+	}
+}
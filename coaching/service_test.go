@@ -0,0 +1,189 @@
+package coaching
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GeneratePracticePlan(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	now := time.Unix(1700000000, 0)
+	windowStart := now.AddDate(0, 0, -LookbackDays)
+
+	race := func(subsessionID, trackID int64, lapsSkipped bool) store.DriverSession {
+		return store.DriverSession{DriverID: driverID, SubsessionID: subsessionID, TrackID: trackID, CarID: 1, LapsSkipped: lapsSkipped}
+	}
+	laps := func(times ...int) []store.SessionDriverLap {
+		var ret []store.SessionDriverLap
+		for i, lapTime := range times {
+			ret = append(ret, store.SessionDriverLap{LapNumber: i + 1, LapTime: lapTime})
+		}
+		return ret
+	}
+
+	testCases := []struct {
+		name      string
+		setupMock func(*MockStore)
+		expected  *store.PracticePlan
+		expectErr bool
+	}{
+		{
+			name: "laps, compacted summaries and skipped laps",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, windowStart, now).Return([]store.DriverSession{
+					race(1, 10, false),
+					race(2, 10, false),
+					race(3, 20, false),
+					race(4, 20, true),
+				}, nil)
+				m.EXPECT().GetSessionDriverLaps(mock.Anything, int64(1), driverID).Return(laps(1000000, 1010000, 1020000), nil)
+				m.EXPECT().GetSessionDriverLaps(mock.Anything, int64(2), driverID).Return(nil, nil)
+				m.EXPECT().GetSessionDriverLapSummary(mock.Anything, int64(2), driverID).Return(&store.SessionDriverLapSummary{
+					ValidLapCount: 10, BestLapTime: 1000000, AvgLapTime: 1010000,
+				}, nil)
+				m.EXPECT().GetSessionDriverLaps(mock.Anything, int64(3), driverID).Return(nil, nil)
+				m.EXPECT().GetSessionDriverLapSummary(mock.Anything, int64(3), driverID).Return(nil, nil)
+				m.EXPECT().GetExternalLapsByTimeRange(mock.Anything, driverID, windowStart, now).Return(nil, nil)
+				m.EXPECT().SavePracticePlan(mock.Anything, store.PracticePlan{
+					DriverID:    driverID,
+					GeneratedAt: now,
+					WindowStart: windowStart,
+					Items:       []store.PracticePlanItem{},
+				}).Return(nil)
+			},
+			expected: &store.PracticePlan{
+				DriverID:    driverID,
+				GeneratedAt: now,
+				WindowStart: windowStart,
+				Items:       []store.PracticePlanItem{},
+			},
+		},
+		{
+			name: "practice laps count towards consistency",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, windowStart, now).Return([]store.DriverSession{
+					race(1, 10, false),
+					race(2, 10, false),
+					race(3, 20, true),
+					race(4, 20, true),
+				}, nil)
+				m.EXPECT().GetSessionDriverLaps(mock.Anything, int64(1), driverID).Return(laps(1000000, 1010000, 1020000), nil)
+				m.EXPECT().GetSessionDriverLaps(mock.Anything, int64(2), driverID).Return(laps(1000000, 1010000, 1020000), nil)
+				practiceLap := func(lapNumber, lapTime int) store.ExternalLap {
+					return store.ExternalLap{DriverID: driverID, Source: "garage61", SessionID: "abc", TrackID: 20, CarID: 1, LapNumber: lapNumber, LapTime: lapTime}
+				}
+				m.EXPECT().GetExternalLapsByTimeRange(mock.Anything, driverID, windowStart, now).Return([]store.ExternalLap{
+					practiceLap(1, 1000000),
+					practiceLap(2, 1100000),
+					practiceLap(3, 1200000),
+				}, nil)
+				m.EXPECT().SavePracticePlan(mock.Anything, mock.Anything).Return(nil)
+			},
+			expected: &store.PracticePlan{
+				DriverID:    driverID,
+				GeneratedAt: now,
+				WindowStart: windowStart,
+				Items: []store.PracticePlanItem{
+					{TrackID: 20, CarID: 1, Focus: store.PracticeFocusConsistency, RaceCount: 2, Value: 10, Baseline: 4},
+				},
+			},
+		},
+		{
+			name: "external lap error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, windowStart, now).Return(nil, nil)
+				m.EXPECT().GetExternalLapsByTimeRange(mock.Anything, driverID, windowStart, now).Return(nil, errors.New("boom"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "session error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, windowStart, now).Return(nil, errors.New("boom"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "lap error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, windowStart, now).Return([]store.DriverSession{race(1, 10, false)}, nil)
+				m.EXPECT().GetSessionDriverLaps(mock.Anything, int64(1), driverID).Return(nil, errors.New("boom"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "save error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, windowStart, now).Return(nil, nil)
+				m.EXPECT().GetExternalLapsByTimeRange(mock.Anything, driverID, windowStart, now).Return(nil, nil)
+				m.EXPECT().SavePracticePlan(mock.Anything, mock.Anything).Return(errors.New("boom"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			svc := NewService(mockStore)
+			svc.now = func() time.Time { return now }
+
+			plan, err := svc.GeneratePracticePlan(ctx, driverID)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, plan)
+		})
+	}
+}
+
+func TestService_GetPracticePlan(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	now := time.Unix(1700000000, 0)
+
+	t.Run("existing plan", func(t *testing.T) {
+		existing := &store.PracticePlan{DriverID: driverID, GeneratedAt: now.Add(-time.Hour)}
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetPracticePlan(mock.Anything, driverID).Return(existing, nil)
+
+		plan, err := NewService(mockStore).GetPracticePlan(ctx, driverID)
+		require.NoError(t, err)
+		assert.Equal(t, existing, plan)
+	})
+
+	t.Run("generated on first request", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetPracticePlan(mock.Anything, driverID).Return(nil, nil)
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.AddDate(0, 0, -LookbackDays), now).Return(nil, nil)
+		mockStore.EXPECT().GetExternalLapsByTimeRange(mock.Anything, driverID, now.AddDate(0, 0, -LookbackDays), now).Return(nil, nil)
+		mockStore.EXPECT().SavePracticePlan(mock.Anything, mock.Anything).Return(nil)
+
+		svc := NewService(mockStore)
+		svc.now = func() time.Time { return now }
+
+		plan, err := svc.GetPracticePlan(ctx, driverID)
+		require.NoError(t, err)
+		assert.Equal(t, now, plan.GeneratedAt)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetPracticePlan(mock.Anything, driverID).Return(nil, errors.New("boom"))
+
+		_, err := NewService(mockStore).GetPracticePlan(ctx, driverID)
+		assert.Error(t, err)
+	})
+}
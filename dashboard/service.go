@@ -0,0 +1,189 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// SchemaVersion is the version of the frontend's layout schema the server understands. Bump it alongside a migration
+// whenever the frontend changes the shape of a layout.
+const SchemaVersion = 1
+
+const (
+	// GridColumns is how many columns wide the dashboard's grid is
+	GridColumns = 12
+	MaxWidgets  = 24
+	// MaxDays is the furthest back a card may look, other than all of the driver's races
+	MaxDays = 3650
+)
+
+// Migration brings a layout written in the schema version before the one it is keyed by up to that version. The
+// layout's SchemaVersion is set by the caller, and the layout's widgets must be copied rather than changed in place.
+type Migration func(layout store.DashboardLayout) store.DashboardLayout
+
+// migrations are keyed by the schema version they migrate to
+var migrations = map[int]Migration{}
+
+type Store interface {
+	GetDashboardLayout(ctx context.Context, driverID int64) (*store.DashboardLayout, error)
+	SaveDashboardLayout(ctx context.Context, layout store.DashboardLayout) error
+}
+
+// Service keeps drivers' dashboard layouts. Layouts written in an older schema version are migrated when read or saved,
+// so the frontend only ever deals with the current one.
+type Service struct {
+	store      Store
+	version    int
+	migrations map[int]Migration
+	now        func() time.Time
+}
+
+func NewService(store Store) *Service {
+	return &Service{
+		store:      store,
+		version:    SchemaVersion,
+		migrations: migrations,
+		now:        time.Now,
+	}
+}
+
+// Get returns a driver's dashboard layout, migrated to the current schema version. A driver who has never saved one
+// gets an empty layout, leaving the frontend to fill in its defaults.
+func (s *Service) Get(ctx context.Context, driverID int64) (*store.DashboardLayout, error) {
+	layout, err := s.store.GetDashboardLayout(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting dashboard layout: %w", err)
+	}
+	if layout == nil {
+		return &store.DashboardLayout{DriverID: driverID, SchemaVersion: s.version, Widgets: []store.DashboardWidget{}}, nil
+	}
+	// a layout from a newer schema than this server knows is left alone, it was written by a newer deployment
+	if layout.SchemaVersion >= s.version {
+		return layout, nil
+	}
+
+	from := layout.SchemaVersion
+	migrated, err := s.migrate(*layout)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.SaveDashboardLayout(ctx, migrated); err != nil {
+		return nil, fmt.Errorf("saving migrated dashboard layout: %w", err)
+	}
+	zerolog.Ctx(ctx).Info().Int64("driverId", driverID).Int("from", from).Int("to", migrated.SchemaVersion).Msg("migrated dashboard layout")
+	return &migrated, nil
+}
+
+// Save replaces a driver's dashboard layout. A layout in an older schema version is migrated before it is checked, one
+// in a newer version than the server knows is refused. Validation failures are returned without saving anything.
+func (s *Service) Save(ctx context.Context, layout store.DashboardLayout) (*store.DashboardLayout, []journal.FieldValidation, error) {
+	if layout.SchemaVersion < 1 || layout.SchemaVersion > s.version {
+		return nil, []journal.FieldValidation{{
+			Field:  "schemaVersion",
+			Code:   "out_of_range",
+			Params: map[string]string{"min": "1", "max": strconv.Itoa(s.version)},
+		}}, nil
+	}
+	migrated, err := s.migrate(layout)
+	if err != nil {
+		return nil, nil, err
+	}
+	if errs := Validate(migrated); len(errs) > 0 {
+		return nil, errs, nil
+	}
+
+	migrated.UpdatedAt = s.now()
+	if err := s.store.SaveDashboardLayout(ctx, migrated); err != nil {
+		return nil, nil, fmt.Errorf("saving dashboard layout: %w", err)
+	}
+	return &migrated, nil, nil
+}
+
+func (s *Service) migrate(layout store.DashboardLayout) (store.DashboardLayout, error) {
+	for version := layout.SchemaVersion + 1; version <= s.version; version++ {
+		migration, ok := s.migrations[version]
+		if !ok {
+			return store.DashboardLayout{}, fmt.Errorf("no dashboard layout migration to schema version %d", version)
+		}
+		layout = migration(layout)
+		layout.SchemaVersion = version
+	}
+	return layout, nil
+}
+
+// Validate checks a layout against the current schema version.
+func Validate(layout store.DashboardLayout) []journal.FieldValidation {
+	var errs []journal.FieldValidation
+	if len(layout.Widgets) > MaxWidgets {
+		errs = append(errs, journal.FieldValidation{
+			Field:  "widgets",
+			Code:   "too_many",
+			Params: map[string]string{"max": strconv.Itoa(MaxWidgets)},
+		})
+	}
+
+	seen := make(map[string]bool, len(layout.Widgets))
+	for i, widget := range layout.Widgets {
+		field := func(name string) string {
+			return fmt.Sprintf("widgets[%d].%s", i, name)
+		}
+		if widget.WidgetID == "" {
+			errs = append(errs, journal.FieldValidation{Field: field("id"), Code: "required"})
+		} else if seen[widget.WidgetID] {
+			errs = append(errs, journal.FieldValidation{Field: field("id"), Code: "duplicate", Params: map[string]string{"value": widget.WidgetID}})
+		}
+		seen[widget.WidgetID] = true
+		if widget.Card == "" {
+			errs = append(errs, journal.FieldValidation{Field: field("card"), Code: "required"})
+		}
+
+		if widget.Column < 0 || widget.Column >= GridColumns {
+			errs = append(errs, outOfRange(field("column"), 0, GridColumns-1))
+		} else if widget.Width < 1 || widget.Column+widget.Width > GridColumns {
+			// the widget has to fit in the columns to the right of where it starts
+			errs = append(errs, outOfRange(field("width"), 1, GridColumns-widget.Column))
+		}
+		if widget.Row < 0 {
+			errs = append(errs, journal.FieldValidation{Field: field("row"), Code: "out_of_range", Params: map[string]string{"min": "0"}})
+		}
+		if widget.Height < 1 {
+			errs = append(errs, journal.FieldValidation{Field: field("height"), Code: "out_of_range", Params: map[string]string{"min": "1"}})
+		}
+
+		if widget.Days < 0 || widget.Days > MaxDays {
+			errs = append(errs, outOfRange(field("days"), 0, MaxDays))
+		}
+		if widget.Granularity != "" && !analytics.Granularity(widget.Granularity).IsValid() {
+			errs = append(errs, invalidValue(field("granularity"), widget.Granularity, "day, week, month, year"))
+		}
+		for _, groupBy := range widget.GroupBy {
+			if !analytics.GroupByDimension(groupBy).IsValid() {
+				errs = append(errs, invalidValue(field("groupBy"), groupBy, "series, car, track"))
+			}
+		}
+	}
+	return errs
+}
+
+func invalidValue(field, value, allowed string) journal.FieldValidation {
+	return journal.FieldValidation{
+		Field:  field,
+		Code:   "invalid_value",
+		Params: map[string]string{"value": value, "allowed": allowed},
+	}
+}
+
+func outOfRange(field string, min, max int) journal.FieldValidation {
+	return journal.FieldValidation{
+		Field:  field,
+		Code:   "out_of_range",
+		Params: map[string]string{"min": strconv.Itoa(min), "max": strconv.Itoa(max)},
+	}
+}
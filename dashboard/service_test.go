@@ -0,0 +1,304 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// renameCards is a version 2 migration for the tests, renaming the irating card
+func renameCards(layout store.DashboardLayout) store.DashboardLayout {
+	widgets := make([]store.DashboardWidget, len(layout.Widgets))
+	for i, widget := range layout.Widgets {
+		if widget.Card == "irating" {
+			widget.Card = "iratingTrend"
+		}
+		widgets[i] = widget
+	}
+	layout.Widgets = widgets
+	return layout
+}
+
+func newTestService(mockStore *MockStore, now time.Time) *Service {
+	service := NewService(mockStore)
+	service.version = 2
+	service.migrations = map[int]Migration{2: renameCards}
+	service.now = func() time.Time { return now }
+	return service
+}
+
+func TestService_Get(t *testing.T) {
+	driverID := int64(12345)
+	updatedAt := time.Unix(1000, 0)
+	widget := store.DashboardWidget{WidgetID: "w1", Card: "irating", Column: 0, Row: 0, Width: 6, Height: 4, Days: 90}
+	migratedWidget := widget
+	migratedWidget.Card = "iratingTrend"
+
+	testCases := []struct {
+		name        string
+		setupMock   func(*MockStore)
+		expected    *store.DashboardLayout
+		expectedErr bool
+	}{
+		{
+			name: "never saved",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDashboardLayout(mock.Anything, driverID).Return(nil, nil)
+			},
+			expected: &store.DashboardLayout{DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{}},
+		},
+		{
+			name: "current version",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDashboardLayout(mock.Anything, driverID).Return(&store.DashboardLayout{
+					DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{migratedWidget}, UpdatedAt: updatedAt,
+				}, nil)
+			},
+			expected: &store.DashboardLayout{DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{migratedWidget}, UpdatedAt: updatedAt},
+		},
+		{
+			name: "older version is migrated and saved",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDashboardLayout(mock.Anything, driverID).Return(&store.DashboardLayout{
+					DriverID: driverID, SchemaVersion: 1, Widgets: []store.DashboardWidget{widget}, UpdatedAt: updatedAt,
+				}, nil)
+				m.EXPECT().SaveDashboardLayout(mock.Anything, store.DashboardLayout{
+					DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{migratedWidget}, UpdatedAt: updatedAt,
+				}).Return(nil)
+			},
+			expected: &store.DashboardLayout{DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{migratedWidget}, UpdatedAt: updatedAt},
+		},
+		{
+			name: "newer version is left alone",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDashboardLayout(mock.Anything, driverID).Return(&store.DashboardLayout{
+					DriverID: driverID, SchemaVersion: 3, Widgets: []store.DashboardWidget{widget}, UpdatedAt: updatedAt,
+				}, nil)
+			},
+			expected: &store.DashboardLayout{DriverID: driverID, SchemaVersion: 3, Widgets: []store.DashboardWidget{widget}, UpdatedAt: updatedAt},
+		},
+		{
+			name: "store error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDashboardLayout(mock.Anything, driverID).Return(nil, errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+		{
+			name: "saving migrated layout fails",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDashboardLayout(mock.Anything, driverID).Return(&store.DashboardLayout{
+					DriverID: driverID, SchemaVersion: 1, Widgets: []store.DashboardWidget{widget}, UpdatedAt: updatedAt,
+				}, nil)
+				m.EXPECT().SaveDashboardLayout(mock.Anything, mock.Anything).Return(errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			layout, err := newTestService(mockStore, time.Unix(5000, 0)).Get(context.Background(), driverID)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, layout)
+		})
+	}
+}
+
+func TestService_Get_MissingMigration(t *testing.T) {
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetDashboardLayout(mock.Anything, int64(12345)).Return(&store.DashboardLayout{
+		DriverID: 12345, SchemaVersion: 1, Widgets: []store.DashboardWidget{},
+	}, nil)
+
+	service := newTestService(mockStore, time.Unix(5000, 0))
+	service.migrations = map[int]Migration{}
+
+	_, err := service.Get(context.Background(), 12345)
+	assert.Error(t, err)
+}
+
+func TestService_Save(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Unix(5000, 0)
+	widget := store.DashboardWidget{WidgetID: "w1", Card: "irating", Column: 6, Row: 2, Width: 6, Height: 4, Days: 30, Granularity: "week"}
+	migratedWidget := widget
+	migratedWidget.Card = "iratingTrend"
+
+	testCases := []struct {
+		name                string
+		layout              store.DashboardLayout
+		setupMock           func(*MockStore)
+		expected            *store.DashboardLayout
+		expectedValidations []journal.FieldValidation
+		expectedErr         bool
+	}{
+		{
+			name:   "current version",
+			layout: store.DashboardLayout{DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{migratedWidget}},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveDashboardLayout(mock.Anything, store.DashboardLayout{
+					DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{migratedWidget}, UpdatedAt: now,
+				}).Return(nil)
+			},
+			expected: &store.DashboardLayout{DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{migratedWidget}, UpdatedAt: now},
+		},
+		{
+			name:   "older version is migrated",
+			layout: store.DashboardLayout{DriverID: driverID, SchemaVersion: 1, Widgets: []store.DashboardWidget{widget}},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveDashboardLayout(mock.Anything, store.DashboardLayout{
+					DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{migratedWidget}, UpdatedAt: now,
+				}).Return(nil)
+			},
+			expected: &store.DashboardLayout{DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{migratedWidget}, UpdatedAt: now},
+		},
+		{
+			name:      "newer version",
+			layout:    store.DashboardLayout{DriverID: driverID, SchemaVersion: 3, Widgets: []store.DashboardWidget{widget}},
+			setupMock: func(m *MockStore) {},
+			expectedValidations: []journal.FieldValidation{
+				{Field: "schemaVersion", Code: "out_of_range", Params: map[string]string{"min": "1", "max": "2"}},
+			},
+		},
+		{
+			name:      "missing version",
+			layout:    store.DashboardLayout{DriverID: driverID, Widgets: []store.DashboardWidget{widget}},
+			setupMock: func(m *MockStore) {},
+			expectedValidations: []journal.FieldValidation{
+				{Field: "schemaVersion", Code: "out_of_range", Params: map[string]string{"min": "1", "max": "2"}},
+			},
+		},
+		{
+			name:      "invalid widget",
+			layout:    store.DashboardLayout{DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{{WidgetID: "w1", Card: "irating", Width: 4, Height: 2, Granularity: "hour"}}},
+			setupMock: func(m *MockStore) {},
+			expectedValidations: []journal.FieldValidation{
+				{Field: "widgets[0].granularity", Code: "invalid_value", Params: map[string]string{"value": "hour", "allowed": "day, week, month, year"}},
+			},
+		},
+		{
+			name:   "store error",
+			layout: store.DashboardLayout{DriverID: driverID, SchemaVersion: 2, Widgets: []store.DashboardWidget{migratedWidget}},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveDashboardLayout(mock.Anything, mock.Anything).Return(errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			layout, validations, err := newTestService(mockStore, now).Save(context.Background(), tc.layout)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedValidations, validations)
+			assert.Equal(t, tc.expected, layout)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := store.DashboardWidget{WidgetID: "w1", Card: "irating", Column: 0, Row: 0, Width: 12, Height: 4, Days: 90, Granularity: "month", GroupBy: []string{"car"}}
+
+	tooMany := make([]store.DashboardWidget, MaxWidgets+1)
+	for i := range tooMany {
+		tooMany[i] = valid
+		tooMany[i].WidgetID = string(rune('a' + i))
+	}
+
+	testCases := []struct {
+		name     string
+		widgets  []store.DashboardWidget
+		expected []journal.FieldValidation
+	}{
+		{
+			name:    "valid",
+			widgets: []store.DashboardWidget{valid},
+		},
+		{
+			name: "empty",
+		},
+		{
+			name:    "too many widgets",
+			widgets: tooMany,
+			expected: []journal.FieldValidation{
+				{Field: "widgets", Code: "too_many", Params: map[string]string{"max": "24"}},
+			},
+		},
+		{
+			name: "missing id and card",
+			widgets: []store.DashboardWidget{
+				{Width: 4, Height: 2},
+			},
+			expected: []journal.FieldValidation{
+				{Field: "widgets[0].id", Code: "required"},
+				{Field: "widgets[0].card", Code: "required"},
+			},
+		},
+		{
+			name:    "duplicate id",
+			widgets: []store.DashboardWidget{valid, valid},
+			expected: []journal.FieldValidation{
+				{Field: "widgets[1].id", Code: "duplicate", Params: map[string]string{"value": "w1"}},
+			},
+		},
+		{
+			name: "off the grid",
+			widgets: []store.DashboardWidget{
+				{WidgetID: "w1", Card: "irating", Column: 8, Row: -1, Width: 6, Height: 0},
+			},
+			expected: []journal.FieldValidation{
+				{Field: "widgets[0].width", Code: "out_of_range", Params: map[string]string{"min": "1", "max": "4"}},
+				{Field: "widgets[0].row", Code: "out_of_range", Params: map[string]string{"min": "0"}},
+				{Field: "widgets[0].height", Code: "out_of_range", Params: map[string]string{"min": "1"}},
+			},
+		},
+		{
+			name: "column past the grid",
+			widgets: []store.DashboardWidget{
+				{WidgetID: "w1", Card: "irating", Column: 12, Width: 1, Height: 1},
+			},
+			expected: []journal.FieldValidation{
+				{Field: "widgets[0].column", Code: "out_of_range", Params: map[string]string{"min": "0", "max": "11"}},
+			},
+		},
+		{
+			name: "bad filters",
+			widgets: []store.DashboardWidget{
+				{WidgetID: "w1", Card: "irating", Width: 4, Height: 2, Days: -1, Granularity: "fortnight", GroupBy: []string{"track", "weather"}},
+			},
+			expected: []journal.FieldValidation{
+				{Field: "widgets[0].days", Code: "out_of_range", Params: map[string]string{"min": "0", "max": "3650"}},
+				{Field: "widgets[0].granularity", Code: "invalid_value", Params: map[string]string{"value": "fortnight", "allowed": "day, week, month, year"}},
+				{Field: "widgets[0].groupBy", Code: "invalid_value", Params: map[string]string{"value": "weather", "allowed": "series, car, track"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Validate(store.DashboardLayout{SchemaVersion: SchemaVersion, Widgets: tc.widgets}))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package datachange
+
+import (
+	"context"
+	"slices"
+
+	"github.com/rs/zerolog"
+)
+
+// Action is the WebSocket action hints are pushed under
+const Action = "dataChanged"
+
+// Resource names a kind of derived data a dashboard shows, so the frontend can refresh only the widgets built on it.
+// New resources must be added to the frontend's list of what each widget depends on, or it will never refresh for them.
+type Resource string
+
+const (
+	ResourceRollups      Resource = "rollups"      // career and season totals
+	ResourceMilestones   Resource = "milestones"   // milestones the driver has achieved
+	ResourceLeaderboards Resource = "leaderboards" // the ranked weekly leaderboards
+	ResourceWeeklyRecap  Resource = "weeklyRecap"  // the driver's latest weekly recap
+	ResourcePracticePlan Resource = "practicePlan" // the driver's practice plan
+)
+
+// Msg is the payload of a dataChanged message. It only says what changed, the frontend fetches the data itself.
+type Msg struct {
+	Resources []Resource `json:"resources"`
+}
+
+type Broadcaster interface {
+	Broadcast(ctx context.Context, driverID int64, actionType string, payload any) error
+}
+
+// Notifier pushes dataChanged hints to a driver's connections. Hints are a nicety, so a failure to send one is logged
+// rather than failing the work that changed the data.
+type Notifier struct {
+	broadcaster Broadcaster
+}
+
+func NewNotifier(broadcaster Broadcaster) *Notifier {
+	return &Notifier{broadcaster: broadcaster}
+}
+
+// Notify tells the driver's connections that the resources changed. Resources are sent sorted with duplicates dropped,
+// and nothing is sent when there are none.
+func (n *Notifier) Notify(ctx context.Context, driverID int64, resources ...Resource) {
+	if len(resources) == 0 {
+		return
+	}
+	resources = slices.Clone(resources)
+	slices.Sort(resources)
+	resources = slices.Compact(resources)
+
+	if err := n.broadcaster.Broadcast(ctx, driverID, Action, Msg{Resources: resources}); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Int64("driverId", driverID).Msg("failed to push data changed hint")
+	}
+}
@@ -0,0 +1,49 @@
+package datachange
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotifier_Notify(t *testing.T) {
+	testCases := []struct {
+		name         string
+		resources    []Resource
+		expectedMsg  *Msg
+		broadcastErr error
+	}{
+		{
+			name:        "single resource",
+			resources:   []Resource{ResourceWeeklyRecap},
+			expectedMsg: &Msg{Resources: []Resource{ResourceWeeklyRecap}},
+		},
+		{
+			name:        "sorted and deduplicated",
+			resources:   []Resource{ResourceRollups, ResourceMilestones, ResourceRollups},
+			expectedMsg: &Msg{Resources: []Resource{ResourceMilestones, ResourceRollups}},
+		},
+		{
+			name: "nothing changed",
+		},
+		{
+			name:         "broadcast failure is swallowed",
+			resources:    []Resource{ResourceLeaderboards},
+			expectedMsg:  &Msg{Resources: []Resource{ResourceLeaderboards}},
+			broadcastErr: errors.New("boom"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			broadcaster := NewMockBroadcaster(t)
+			if tc.expectedMsg != nil {
+				broadcaster.EXPECT().Broadcast(mock.Anything, int64(12345), Action, *tc.expectedMsg).Return(tc.broadcastErr)
+			}
+
+			NewNotifier(broadcaster).Notify(context.Background(), 12345, tc.resources...)
+		})
+	}
+}
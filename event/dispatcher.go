@@ -0,0 +1,46 @@
+package event
+
+import (
+	"context"
+	"fmt"
+)
+
+// EventDispatcher publishes events for asynchronous consumption. Events are JSON marshalled, and consumers receive
+// that JSON as-is regardless of the backend.
+type EventDispatcher interface {
+	PublishEvent(ctx context.Context, event any, opts ...PublishOption) error
+}
+
+type Backend string
+
+const (
+	// BackendSQS sends events straight to a single queue
+	BackendSQS Backend = "sqs"
+	// BackendSNS publishes events to a topic, fanning them out to any number of subscribed consumers
+	BackendSNS Backend = "sns"
+)
+
+type DispatcherConfig struct {
+	Backend  Backend
+	QueueURL string // required for BackendSQS
+	TopicARN string // required for BackendSNS
+}
+
+// NewEventDispatcher creates the dispatcher selected by cfg. MemoryEventDispatcher isn't selectable here since it is
+// only useful alongside a consumer subscribed in the same process, and so is wired up directly where that is the case.
+func NewEventDispatcher(cfg DispatcherConfig, sqsClient SQSClient, snsClient SNSClient) (EventDispatcher, error) {
+	switch cfg.Backend {
+	case BackendSQS:
+		if cfg.QueueURL == "" {
+			return nil, fmt.Errorf("queue URL is required for the %s backend", cfg.Backend)
+		}
+		return NewSQSEventDispatcher(sqsClient, cfg.QueueURL), nil
+	case BackendSNS:
+		if cfg.TopicARN == "" {
+			return nil, fmt.Errorf("topic ARN is required for the %s backend", cfg.Backend)
+		}
+		return NewSNSEventDispatcher(snsClient, cfg.TopicARN), nil
+	default:
+		return nil, fmt.Errorf("unknown event backend %q", cfg.Backend)
+	}
+}
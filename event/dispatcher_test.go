@@ -0,0 +1,58 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventDispatcher(t *testing.T) {
+	sqsClient := NewMockSQSClient(t)
+	snsClient := NewMockSNSClient(t)
+
+	testCases := []struct {
+		name           string
+		cfg            DispatcherConfig
+		expected       EventDispatcher
+		expectedErrMsg string
+	}{
+		{
+			name:     "sqs",
+			cfg:      DispatcherConfig{Backend: BackendSQS, QueueURL: "https://sqs/queue"},
+			expected: NewSQSEventDispatcher(sqsClient, "https://sqs/queue"),
+		},
+		{
+			name:     "sns",
+			cfg:      DispatcherConfig{Backend: BackendSNS, TopicARN: "arn:topic"},
+			expected: NewSNSEventDispatcher(snsClient, "arn:topic"),
+		},
+		{
+			name:           "sqs without queue",
+			cfg:            DispatcherConfig{Backend: BackendSQS, TopicARN: "arn:topic"},
+			expectedErrMsg: "queue URL is required for the sqs backend",
+		},
+		{
+			name:           "sns without topic",
+			cfg:            DispatcherConfig{Backend: BackendSNS, QueueURL: "https://sqs/queue"},
+			expectedErrMsg: "topic ARN is required for the sns backend",
+		},
+		{
+			name:           "unknown backend",
+			cfg:            DispatcherConfig{Backend: "carrier-pigeon"},
+			expectedErrMsg: `unknown event backend "carrier-pigeon"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dispatcher, err := NewEventDispatcher(tc.cfg, sqsClient, snsClient)
+			if tc.expectedErrMsg != "" {
+				require.EqualError(t, err, tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, dispatcher)
+		})
+	}
+}
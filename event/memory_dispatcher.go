@@ -0,0 +1,69 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// MessageHandler consumes a published event in the same JSON form it would have been sent to SQS in.
+type MessageHandler func(ctx context.Context, body []byte) error
+
+// MemoryEventDispatcher stands in for SQS when everything runs in a single process. Events are marshalled just as
+// SQSEventDispatcher would marshal them and handed to the subscribed handler on their own goroutine, so publishers
+// never block on consumers. Failed deliveries are logged and dropped rather than retried.
+type MemoryEventDispatcher struct {
+	mu       sync.RWMutex
+	handler  MessageHandler
+	inFlight sync.WaitGroup
+}
+
+func NewMemoryEventDispatcher() *MemoryEventDispatcher {
+	return &MemoryEventDispatcher{}
+}
+
+// Subscribe sets the handler events are delivered to. It is separate from construction since consumers usually need
+// the dispatcher themselves in order to publish follow-up events.
+func (d *MemoryEventDispatcher) Subscribe(handler MessageHandler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.handler = handler
+}
+
+func (d *MemoryEventDispatcher) PublishEvent(ctx context.Context, event any, opts ...PublishOption) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	d.mu.RLock()
+	handler := d.handler
+	d.mu.RUnlock()
+	if handler == nil {
+		return errors.New("no subscriber registered")
+	}
+
+	// the publisher's context is typically a request that ends long before the consumer does, keep its values but
+	// not its deadline
+	deliveryCtx := context.WithoutCancel(ctx)
+	delay := applyPublishOptions(opts).delay
+	d.inFlight.Add(1)
+	go func() {
+		defer d.inFlight.Done()
+		time.Sleep(delay)
+		if err := handler(deliveryCtx, body); err != nil {
+			zerolog.Ctx(deliveryCtx).Error().Err(err).Msg("in-memory event delivery failed")
+		}
+	}()
+	return nil
+}
+
+// Wait blocks until all events published so far, and any they publish in turn, have been handled. Delayed events are
+// waited out too.
+func (d *MemoryEventDispatcher) Wait() {
+	d.inFlight.Wait()
+}
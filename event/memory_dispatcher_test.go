@@ -0,0 +1,95 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryEventDispatcher_PublishEvent(t *testing.T) {
+	type testEvent struct {
+		DriverID int64 `json:"driverId"`
+	}
+
+	t.Run("delivers marshalled event to subscriber", func(t *testing.T) {
+		dispatcher := NewMemoryEventDispatcher()
+
+		var mu sync.Mutex
+		var received []string
+		dispatcher.Subscribe(func(ctx context.Context, body []byte) error {
+			mu.Lock()
+			defer mu.Unlock()
+			received = append(received, string(body))
+			return nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		require.NoError(t, dispatcher.PublishEvent(ctx, testEvent{DriverID: 42}))
+		// delivery must not be tied to the publisher's context
+		cancel()
+		dispatcher.Wait()
+
+		assert.Equal(t, []string{`{"driverId":42}`}, received)
+	})
+
+	t.Run("waits for events published by the handler", func(t *testing.T) {
+		dispatcher := NewMemoryEventDispatcher()
+
+		var mu sync.Mutex
+		deliveries := 0
+		dispatcher.Subscribe(func(ctx context.Context, body []byte) error {
+			mu.Lock()
+			deliveries++
+			again := deliveries < 3
+			mu.Unlock()
+			if again {
+				return dispatcher.PublishEvent(ctx, testEvent{})
+			}
+			return errors.New("handler errors are only logged")
+		})
+
+		require.NoError(t, dispatcher.PublishEvent(context.Background(), testEvent{}))
+		dispatcher.Wait()
+
+		assert.Equal(t, 3, deliveries)
+	})
+
+	t.Run("holds delayed events back", func(t *testing.T) {
+		dispatcher := NewMemoryEventDispatcher()
+
+		var deliveredAt time.Time
+		dispatcher.Subscribe(func(ctx context.Context, body []byte) error {
+			deliveredAt = time.Now()
+			return nil
+		})
+
+		publishedAt := time.Now()
+		require.NoError(t, dispatcher.PublishEvent(context.Background(), testEvent{}, WithDelay(50*time.Millisecond)))
+		dispatcher.Wait()
+
+		assert.GreaterOrEqual(t, deliveredAt.Sub(publishedAt), 50*time.Millisecond)
+	})
+
+	t.Run("errors without a subscriber", func(t *testing.T) {
+		dispatcher := NewMemoryEventDispatcher()
+
+		err := dispatcher.PublishEvent(context.Background(), testEvent{})
+		assert.Error(t, err)
+	})
+
+	t.Run("marshal error", func(t *testing.T) {
+		dispatcher := NewMemoryEventDispatcher()
+		dispatcher.Subscribe(func(ctx context.Context, body []byte) error {
+			t.Fatal("should not be delivered")
+			return nil
+		})
+
+		err := dispatcher.PublishEvent(context.Background(), make(chan int))
+		assert.Error(t, err)
+	})
+}
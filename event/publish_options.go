@@ -0,0 +1,35 @@
+package event
+
+import "time"
+
+// maxSQSDelay is the longest SQS will hold a message back before making it visible.
+const maxSQSDelay = 15 * time.Minute
+
+// PublishOption adjusts how a single event is published.
+type PublishOption interface {
+	applyPublish(options *publishOptions)
+}
+
+type publishOptions struct {
+	delay time.Duration
+}
+
+type delayOption time.Duration
+
+func (d delayOption) applyPublish(options *publishOptions) {
+	options.delay = time.Duration(d)
+}
+
+// WithDelay holds an event back from consumers for at least d. SQS rounds the delay up to whole seconds and caps it at
+// 15 minutes. SNS has no way to delay a message, so events published through it are delivered straight away.
+func WithDelay(d time.Duration) PublishOption {
+	return delayOption(d)
+}
+
+func applyPublishOptions(opts []PublishOption) publishOptions {
+	var options publishOptions
+	for _, opt := range opts {
+		opt.applyPublish(&options)
+	}
+	return options
+}
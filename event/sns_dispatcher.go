@@ -0,0 +1,53 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+// EventTypeAttribute is the message attribute SNS subscribers can filter on, holding the Go type of the event without
+// any pointer indirection (for example "ingestion.RaceIngestionRequest").
+const EventTypeAttribute = "event_type"
+
+type SNSClient interface {
+	Publish(ctx context.Context, params *sns.PublishInput, optFns ...func(*sns.Options)) (*sns.PublishOutput, error)
+}
+
+type SNSEventDispatcher struct {
+	client   SNSClient
+	topicARN string
+}
+
+func NewSNSEventDispatcher(client SNSClient, topicARN string) *SNSEventDispatcher {
+	return &SNSEventDispatcher{
+		client:   client,
+		topicARN: topicARN,
+	}
+}
+
+// PublishEvent publishes event to the topic. Options are accepted for compatibility, but SNS can't delay delivery so
+// WithDelay has no effect.
+func (d *SNSEventDispatcher) PublishEvent(ctx context.Context, event any, _ ...PublishOption) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.client.Publish(ctx, &sns.PublishInput{
+		TopicArn: aws.String(d.topicARN),
+		Message:  aws.String(string(body)),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			EventTypeAttribute: {
+				DataType:    aws.String("String"),
+				StringValue: aws.String(strings.TrimLeft(fmt.Sprintf("%T", event), "*")),
+			},
+		},
+	})
+	return err
+}
@@ -0,0 +1,71 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type snsTestEvent struct {
+	DriverID int64 `json:"driverId"`
+}
+
+func TestSNSEventDispatcher_PublishEvent(t *testing.T) {
+	testCases := []struct {
+		name        string
+		event       any
+		publishErr  error
+		expectedErr error
+	}{
+		{
+			name:  "publishes marshalled event with type attribute",
+			event: snsTestEvent{DriverID: 42},
+		},
+		{
+			name:  "pointer events have the same type attribute",
+			event: &snsTestEvent{DriverID: 42},
+		},
+		{
+			name:        "publish error",
+			event:       snsTestEvent{DriverID: 42},
+			publishErr:  errors.New("boom"),
+			expectedErr: errors.New("boom"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := NewMockSNSClient(t)
+			client.EXPECT().Publish(ctx, &sns.PublishInput{
+				TopicArn: aws.String("arn:aws:sns:us-east-1:123456789012:events"),
+				Message:  aws.String(`{"driverId":42}`),
+				MessageAttributes: map[string]types.MessageAttributeValue{
+					EventTypeAttribute: {
+						DataType:    aws.String("String"),
+						StringValue: aws.String("event.snsTestEvent"),
+					},
+				},
+			}).Return(&sns.PublishOutput{}, tc.publishErr)
+
+			dispatcher := NewSNSEventDispatcher(client, "arn:aws:sns:us-east-1:123456789012:events")
+			err := dispatcher.PublishEvent(ctx, tc.event)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestSNSEventDispatcher_PublishEvent_MarshalError(t *testing.T) {
+	client := NewMockSNSClient(t)
+	dispatcher := NewSNSEventDispatcher(client, "arn:aws:sns:us-east-1:123456789012:events")
+
+	err := dispatcher.PublishEvent(context.Background(), make(chan int))
+	assert.Error(t, err)
+	client.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+}
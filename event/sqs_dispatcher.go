@@ -3,6 +3,7 @@ package event
 import (
 	"context"
 	"encoding/json"
+	"math"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -24,15 +25,19 @@ func NewSQSEventDispatcher(client SQSClient, queueURL string) *SQSEventDispatche
 	}
 }
 
-func (d *SQSEventDispatcher) PublishEvent(ctx context.Context, event any) error {
+func (d *SQSEventDispatcher) PublishEvent(ctx context.Context, event any, opts ...PublishOption) error {
 	body, err := json.Marshal(event)
 	if err != nil {
 		return err
 	}
 
-	_, err = d.client.SendMessage(ctx, &sqs.SendMessageInput{
+	input := &sqs.SendMessageInput{
 		QueueUrl:    aws.String(d.queueURL),
 		MessageBody: aws.String(string(body)),
-	})
+	}
+	if delay := min(applyPublishOptions(opts).delay, maxSQSDelay); delay > 0 {
+		input.DelaySeconds = int32(math.Ceil(delay.Seconds()))
+	}
+	_, err = d.client.SendMessage(ctx, input)
 	return err
 }
@@ -0,0 +1,61 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQSEventDispatcher_PublishEvent(t *testing.T) {
+	type testEvent struct {
+		DriverID int64 `json:"driverId"`
+	}
+
+	testCases := []struct {
+		name                 string
+		opts                 []PublishOption
+		sendErr              error
+		expectedDelaySeconds int32
+		expectedErr          error
+	}{
+		{
+			name: "sends marshalled event",
+		},
+		{
+			name:                 "delay rounds up to whole seconds",
+			opts:                 []PublishOption{WithDelay(1500 * time.Millisecond)},
+			expectedDelaySeconds: 2,
+		},
+		{
+			name:                 "delay is capped at the SQS maximum",
+			opts:                 []PublishOption{WithDelay(time.Hour)},
+			expectedDelaySeconds: 900,
+		},
+		{
+			name:        "send error",
+			sendErr:     errors.New("boom"),
+			expectedErr: errors.New("boom"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := NewMockSQSClient(t)
+			client.EXPECT().SendMessage(ctx, &sqs.SendMessageInput{
+				QueueUrl:     aws.String("https://sqs.us-east-1.amazonaws.com/123456789012/events"),
+				MessageBody:  aws.String(`{"driverId":42}`),
+				DelaySeconds: tc.expectedDelaySeconds,
+			}).Return(&sqs.SendMessageOutput{}, tc.sendErr)
+
+			dispatcher := NewSQSEventDispatcher(client, "https://sqs.us-east-1.amazonaws.com/123456789012/events")
+			err := dispatcher.PublishEvent(ctx, testEvent{DriverID: 42}, tc.opts...)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
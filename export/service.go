@@ -0,0 +1,121 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// Store defines the data access methods needed by the export service.
+type Store interface {
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	GetSessionDriverLaps(ctx context.Context, subsessionID, driverID int64) ([]store.SessionDriverLap, error)
+	GetSessionDriverLapSummary(ctx context.Context, subsessionID, driverID int64) (*store.SessionDriverLapSummary, error)
+	GetJournalEntries(ctx context.Context, driverID int64, from, to time.Time) ([]store.RaceJournalEntry, error)
+}
+
+// Race is one race in a pack along with the driver's laps and journal entry for it.
+type Race struct {
+	Session store.DriverSession
+	// Laps are empty once the race's laps have been compacted, LapSummary is set in their place
+	Laps       []store.SessionDriverLap
+	LapSummary *store.SessionDriverLapSummary
+	// Journal is nil unless journal entries were asked for and the driver wrote one for the race
+	Journal *store.RaceJournalEntry
+}
+
+// Pack bundles a driver's races over a time range into something that can be handed to a coach.
+type Pack struct {
+	DriverID    int64
+	From        time.Time
+	To          time.Time
+	GeneratedAt time.Time
+	// Races are most recent first
+	Races []Race
+	// Truncated is set when the range held more races than the pack was allowed
+	Truncated bool
+}
+
+// Service builds export packs of a driver's races.
+type Service struct {
+	store Store
+	now   func() time.Time
+}
+
+func NewService(store Store) *Service {
+	return &Service{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Build bundles the driver's most recent races between from and to, at most maxRaces of them. Journal entries are only
+// included when asked for, and only their notes, tags and replay video; voice memo transcripts stay private.
+func (s *Service) Build(ctx context.Context, driverID int64, from, to time.Time, maxRaces int, includeJournal bool) (*Pack, error) {
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, driverID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("getting sessions: %w", err)
+	}
+	pack := &Pack{
+		DriverID:    driverID,
+		From:        from,
+		To:          to,
+		GeneratedAt: s.now(),
+		Races:       make([]Race, 0, min(len(sessions), maxRaces)),
+	}
+	if len(sessions) > maxRaces {
+		sessions = sessions[:maxRaces]
+		pack.Truncated = true
+	}
+
+	journal := make(map[int64]store.RaceJournalEntry)
+	if includeJournal && len(sessions) > 0 {
+		entries, err := s.store.GetJournalEntries(ctx, driverID, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("getting journal entries: %w", err)
+		}
+		for _, entry := range entries {
+			journal[entry.RaceID] = entry
+		}
+	}
+
+	for _, session := range sessions {
+		race := Race{Session: session}
+		race.Laps, race.LapSummary, err = s.laps(ctx, session)
+		if err != nil {
+			return nil, err
+		}
+		if entry, ok := journal[session.StartTime.Unix()]; ok {
+			race.Journal = &store.RaceJournalEntry{
+				DriverID:    entry.DriverID,
+				RaceID:      entry.RaceID,
+				CreatedAt:   entry.CreatedAt,
+				UpdatedAt:   entry.UpdatedAt,
+				Notes:       entry.Notes,
+				Tags:        entry.Tags,
+				ReplayVideo: entry.ReplayVideo,
+			}
+		}
+		pack.Races = append(pack.Races, race)
+	}
+	return pack, nil
+}
+
+// laps returns the driver's laps in a session when they are still stored, or just the compacted summary when they
+// aren't. Both are empty when laps were never pulled for the session.
+func (s *Service) laps(ctx context.Context, session store.DriverSession) ([]store.SessionDriverLap, *store.SessionDriverLapSummary, error) {
+	laps, err := s.store.GetSessionDriverLaps(ctx, session.SubsessionID, session.DriverID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting laps for session %d: %w", session.SubsessionID, err)
+	}
+	if len(laps) > 0 {
+		return laps, nil, nil
+	}
+	summary, err := s.store.GetSessionDriverLapSummary(ctx, session.SubsessionID, session.DriverID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting lap summary for session %d: %w", session.SubsessionID, err)
+	}
+	return nil, summary, nil
+}
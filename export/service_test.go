@@ -0,0 +1,169 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Build(t *testing.T) {
+	driverID := int64(12345)
+	from := time.Unix(1000, 0)
+	to := time.Unix(9000, 0)
+	now := time.Unix(10000, 0)
+
+	recent := store.DriverSession{DriverID: driverID, SubsessionID: 2, StartTime: time.Unix(5000, 0)}
+	older := store.DriverSession{DriverID: driverID, SubsessionID: 1, StartTime: time.Unix(2000, 0)}
+	laps := []store.SessionDriverLap{{SubsessionID: 2, DriverID: driverID, LapNumber: 1, LapTime: 900000}}
+	summary := &store.SessionDriverLapSummary{SubsessionID: 1, DriverID: driverID, LapCount: 10, BestLapTime: 890000}
+	entry := store.RaceJournalEntry{
+		DriverID:        driverID,
+		RaceID:          5000,
+		Notes:           "braked too late into T1",
+		Tags:            []string{"sentiment:bad"},
+		Transcripts:     []string{"note to self, not for sharing"},
+		SearchTerms:     []string{"braked"},
+		TranscriptTerms: []string{"sharing"},
+	}
+
+	testCases := []struct {
+		name           string
+		includeJournal bool
+		setupMock      func(*MockStore)
+		expected       *Pack
+		expectedErr    bool
+	}{
+		{
+			name: "laps and summaries",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, to).Return([]store.DriverSession{recent, older}, nil)
+				m.EXPECT().GetSessionDriverLaps(mock.Anything, int64(2), driverID).Return(laps, nil)
+				m.EXPECT().GetSessionDriverLaps(mock.Anything, int64(1), driverID).Return([]store.SessionDriverLap{}, nil)
+				m.EXPECT().GetSessionDriverLapSummary(mock.Anything, int64(1), driverID).Return(summary, nil)
+			},
+			expected: &Pack{
+				DriverID:    driverID,
+				From:        from,
+				To:          to,
+				GeneratedAt: now,
+				Races: []Race{
+					{Session: recent, Laps: laps},
+					{Session: older, LapSummary: summary},
+				},
+			},
+		},
+		{
+			name:           "journal without transcripts",
+			includeJournal: true,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, to).Return([]store.DriverSession{recent}, nil)
+				m.EXPECT().GetJournalEntries(mock.Anything, driverID, from, to).Return([]store.RaceJournalEntry{entry}, nil)
+				m.EXPECT().GetSessionDriverLaps(mock.Anything, int64(2), driverID).Return(laps, nil)
+			},
+			expected: &Pack{
+				DriverID:    driverID,
+				From:        from,
+				To:          to,
+				GeneratedAt: now,
+				Races: []Race{
+					{
+						Session: recent,
+						Laps:    laps,
+						Journal: &store.RaceJournalEntry{
+							DriverID: driverID,
+							RaceID:   5000,
+							Notes:    "braked too late into T1",
+							Tags:     []string{"sentiment:bad"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:           "no races",
+			includeJournal: true,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, to).Return([]store.DriverSession{}, nil)
+			},
+			expected: &Pack{
+				DriverID:    driverID,
+				From:        from,
+				To:          to,
+				GeneratedAt: now,
+				Races:       []Race{},
+			},
+		},
+		{
+			name: "session error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, to).Return(nil, errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+		{
+			name:           "journal error",
+			includeJournal: true,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, to).Return([]store.DriverSession{recent}, nil)
+				m.EXPECT().GetJournalEntries(mock.Anything, driverID, from, to).Return(nil, errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+		{
+			name: "lap error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, to).Return([]store.DriverSession{recent}, nil)
+				m.EXPECT().GetSessionDriverLaps(mock.Anything, int64(2), driverID).Return(nil, errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			service := NewService(mockStore)
+			service.now = func() time.Time { return now }
+
+			pack, err := service.Build(context.Background(), driverID, from, to, 10, tc.includeJournal)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, pack)
+		})
+	}
+}
+
+func TestService_Build_Truncates(t *testing.T) {
+	driverID := int64(12345)
+	from := time.Unix(0, 0)
+	to := time.Unix(100000, 0)
+	maxRaces := 3
+
+	sessions := make([]store.DriverSession, maxRaces+5)
+	for i := range sessions {
+		sessions[i] = store.DriverSession{DriverID: driverID, SubsessionID: int64(i), StartTime: time.Unix(int64(100000-i), 0)}
+	}
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, to).Return(sessions, nil)
+	mockStore.EXPECT().GetSessionDriverLaps(mock.Anything, mock.Anything, driverID).Return([]store.SessionDriverLap{}, nil).Times(maxRaces)
+	mockStore.EXPECT().GetSessionDriverLapSummary(mock.Anything, mock.Anything, driverID).Return(nil, nil).Times(maxRaces)
+
+	pack, err := NewService(mockStore).Build(context.Background(), driverID, from, to, maxRaces, false)
+	require.NoError(t, err)
+	assert.True(t, pack.Truncated)
+	require.Len(t, pack.Races, maxRaces)
+	assert.Equal(t, sessions[0], pack.Races[0].Session)
+	assert.Equal(t, sessions[maxRaces-1], pack.Races[maxRaces-1].Session)
+}
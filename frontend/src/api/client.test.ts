@@ -1,5 +1,5 @@
 import { describe, it, expect, beforeEach, vi } from 'vitest'
-import { ApiClient, ValidationError } from './client'
+import { ApiClient, TermsRequiredError, ValidationError } from './client'
 
 // Mock fetch globally
 const mockFetch = vi.fn()
@@ -117,6 +117,24 @@ describe('ApiClient', () => {
       expect((err as ValidationError).correlationId).toBe('test-id')
     })
 
+    it('throws TermsRequiredError on 428', async () => {
+      mockFetch.mockResolvedValue(
+        createJsonResponse(
+          {
+            message: 'terms acceptance required',
+            required: [{ document: 'tos', version: '2025-01-01' }],
+            correlationId: 'test-id',
+          },
+          428
+        )
+      )
+
+      const err = await client.fetch('/test').catch((e) => e)
+      expect(err).toBeInstanceOf(TermsRequiredError)
+      expect((err as TermsRequiredError).required).toEqual([{ document: 'tos', version: '2025-01-01' }])
+      expect((err as TermsRequiredError).correlationId).toBe('test-id')
+    })
+
     it('refreshes token on 401 and retries', async () => {
       mockFetch
         .mockResolvedValueOnce(createErrorResponse(401, 'Unauthorized'))
@@ -25,6 +25,35 @@ export class ValidationError extends Error {
   }
 }
 
+export interface RequiredTerms {
+  document: string
+  version: string
+}
+
+/**
+ * Thrown when a write is held back until the current terms of service and privacy policy are accepted.
+ */
+export class TermsRequiredError extends Error {
+  required: RequiredTerms[]
+  correlationId?: string
+
+  constructor(required: RequiredTerms[], correlationId?: string) {
+    super('Terms acceptance required')
+    this.required = required
+    this.correlationId = correlationId
+  }
+}
+
+export interface TermsDocument {
+  document: string
+  current_version: string
+  accepted_at: string | null
+}
+
+export interface TermsStatus {
+  documents: TermsDocument[]
+}
+
 export interface Race {
   id: number
   subsessionId: number
@@ -71,6 +100,7 @@ export interface Driver {
   driverId: number
   driverName: string
   memberSince: string
+  racesIngestedFrom: string | null
   racesIngestedTo: string | null
   ingestionBlockedUntil: string | null
   firstLogin: string
@@ -447,6 +477,8 @@ export interface Lap {
   lapTime: number
   personalBestLap: boolean
   lapEvents: string[]
+  eventCodes: string[]
+  flagNames: string[]
 }
 
 export interface LapData {
@@ -582,6 +614,35 @@ export interface AnalyticsDimensionsResponse {
 
 export type AnalyticsGroupBy = 'series' | 'car' | 'track'
 
+export interface IRacingProxyRequest {
+  path: string
+  params: Record<string, string>
+  pretty?: boolean
+}
+
+export interface IRacingProxyResponse {
+  path: string
+  params: Record<string, string>
+  status: number
+  linked: boolean
+  durationMs: number
+  body?: unknown
+  text?: string
+  pretty?: string
+}
+
+export interface IRacingProxyHistoryEntry {
+  requestedAt: string
+  path: string
+  params: Record<string, string>
+  status: number
+  durationMs: number
+}
+
+export interface IRacingProxyHistoryResponse {
+  requests: IRacingProxyHistoryEntry[]
+}
+
 export class ApiClient {
   private authStore: ReturnType<typeof useAuthStore>
   private sessionStore: ReturnType<typeof useSessionStore>
@@ -663,10 +724,17 @@ export class ApiClient {
 
   private async parseError(response: Response): Promise<Error> {
     try {
-      const data = (await response.json()) as ApiError & { errors?: string[]; fieldErrors?: FieldError[] }
+      const data = (await response.json()) as ApiError & {
+        errors?: string[]
+        fieldErrors?: FieldError[]
+        required?: RequiredTerms[]
+      }
       if (response.status === 400 && data.fieldErrors && data.fieldErrors.length > 0) {
         return new ValidationError(data.fieldErrors, data.correlationId)
       }
+      if (response.status === 428 && data.required) {
+        return new TermsRequiredError(data.required, data.correlationId)
+      }
       let message = data.message || `Request failed: ${response.status}`
       if (data.correlationId) {
         message += ` (Correlation ID: ${data.correlationId})`
@@ -702,6 +770,10 @@ export class ApiClient {
     return { throttled: false }
   }
 
+  async cancelRaceIngestion(driverId: number): Promise<void> {
+    return this.fetchVoid(`/driver/${driverId}/ingestion/cancel`, { method: 'POST' })
+  }
+
   async getRaces(
     driverId: number,
     startTime: Date,
@@ -907,6 +979,42 @@ export class ApiClient {
     const data = await this.fetch<AnalyticsResponse>(`/driver/${driverId}/analytics?${params}`)
     return data.response
   }
+
+  async getTermsStatus(): Promise<TermsStatus> {
+    const data = await this.fetch<{ response: TermsStatus }>('/auth/terms')
+    return data.response
+  }
+
+  async acceptTerms(document: string, version: string): Promise<TermsStatus> {
+    const data = await this.fetch<{ response: TermsStatus }>('/auth/terms', {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ document, version }),
+    })
+    return data.response
+  }
+
+  // Developer methods
+
+  /**
+   * Make an iRacing Data API request with the current session's iRacing token.
+   * Param values may use the {custId} and {now} templates.
+   */
+  async sendIRacingProxyRequest(request: IRacingProxyRequest): Promise<IRacingProxyResponse> {
+    const data = await this.fetch<{ response: IRacingProxyResponse }>('/developer/iracing-proxy', {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify(request),
+    })
+    return data.response
+  }
+
+  async getIRacingProxyHistory(limit = 20): Promise<IRacingProxyHistoryEntry[]> {
+    const data = await this.fetch<{ response: IRacingProxyHistoryResponse }>(
+      `/developer/iracing-proxy/history?limit=${limit}`
+    )
+    return data.response.requests
+  }
 }
 
 export function useApiClient() {
@@ -20,6 +20,8 @@ function createLap(overrides: Partial<Lap> = {}): Lap {
     lapTime: 90000, // 1:30.000
     personalBestLap: false,
     lapEvents: [],
+    eventCodes: [],
+    flagNames: [],
     ...overrides,
   }
 }
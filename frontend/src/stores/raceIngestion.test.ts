@@ -4,9 +4,11 @@ import { useRaceIngestionStore, setupRaceIngestionListener } from './raceIngesti
 
 // Mock dependencies
 const mockTriggerRaceIngestion = vi.fn()
+const mockCancelRaceIngestion = vi.fn()
 vi.mock('@/api/client', () => ({
   useApiClient: () => ({
     triggerRaceIngestion: mockTriggerRaceIngestion,
+    cancelRaceIngestion: mockCancelRaceIngestion,
   }),
 }))
 
@@ -23,15 +25,20 @@ vi.mock('./session', () => ({
     get isReady() {
       return mockSessionIsReady
     },
+    userId: 12345,
   }),
 }))
 
 // Capture the stale credentials callback when registered
 let staleCredentialsCallback: (() => void) | null = null
+let cancelledCallback: (() => void) | null = null
 const mockWsOn = vi.fn((event: string, callback: () => void) => {
   if (event === 'ingestionFailedStaleCredentials') {
     staleCredentialsCallback = callback
   }
+  if (event === 'ingestionCancelled') {
+    cancelledCallback = callback
+  }
 })
 
 vi.mock('./websocket', () => ({
@@ -47,6 +54,7 @@ describe('raceIngestion store', () => {
     vi.clearAllMocks()
     mockSessionIsReady = true
     staleCredentialsCallback = null
+    cancelledCallback = null
   })
 
   describe('triggerIngestion', () => {
@@ -103,6 +111,27 @@ describe('raceIngestion store', () => {
     })
   })
 
+  describe('cancelIngestion', () => {
+    it('requests cancellation for the current driver', async () => {
+      mockCancelRaceIngestion.mockResolvedValue(undefined)
+      const store = useRaceIngestionStore()
+
+      await store.cancelIngestion()
+
+      expect(mockCancelRaceIngestion).toHaveBeenCalledWith(12345)
+      expect(store.error).toBeNull()
+    })
+
+    it('sets error when the request fails', async () => {
+      mockCancelRaceIngestion.mockRejectedValue(new Error('Network error'))
+      const store = useRaceIngestionStore()
+
+      await store.cancelIngestion()
+
+      expect(store.error).toBe('Network error')
+    })
+  })
+
   describe('setupListener', () => {
     it('registers listener for stale credentials event', () => {
       const store = useRaceIngestionStore()
@@ -110,6 +139,18 @@ describe('raceIngestion store', () => {
 
       expect(mockWsOn).toHaveBeenCalledWith('ingestionFailedStaleCredentials', expect.any(Function))
     })
+
+    it('returns to idle when ingestion is cancelled', async () => {
+      mockTriggerRaceIngestion.mockResolvedValue({ throttled: false })
+      const store = useRaceIngestionStore()
+      store.setupListener()
+      await store.triggerIngestion()
+      expect(store.status).toBe('success')
+
+      cancelledCallback!()
+
+      expect(store.status).toBe('idle')
+    })
   })
 
   describe('stale credentials handling (via listener)', () => {
@@ -70,9 +70,27 @@ export const useRaceIngestionStore = defineStore('raceIngestion', () => {
     }
   }
 
+  async function cancelIngestion() {
+    const sessionStore = useSessionStore()
+    if (!sessionStore.userId) {
+      return
+    }
+
+    try {
+      const apiClient = useApiClient()
+      await apiClient.cancelRaceIngestion(sessionStore.userId)
+    } catch (err) {
+      error.value = err instanceof Error ? err.message : 'Failed to cancel ingestion'
+    }
+  }
+
   function setupListener() {
     const wsStore = useWebSocketStore()
     wsStore.on('ingestionFailedStaleCredentials', () => handleStaleCredentials())
+    wsStore.on('ingestionCancelled', () => {
+      console.log('[RaceIngestion] Ingestion cancelled')
+      status.value = 'idle'
+    })
   }
 
   return {
@@ -81,6 +99,7 @@ export const useRaceIngestionStore = defineStore('raceIngestion', () => {
     error,
     // Actions
     triggerIngestion,
+    cancelIngestion,
     setupListener,
   }
 })
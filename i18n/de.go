@@ -0,0 +1,43 @@
+package i18n
+
+var german = catalog{
+	KeyTrendAlert: "{name}: {aggregate} {metric} liegt bei {value} und damit {comparison} deinem Schwellenwert von {threshold}",
+
+	KeyMetricIncidents:      "Incidents",
+	KeyMetricIRating:        "iRating",
+	KeyMetricFinishPosition: "Zielposition",
+
+	KeyAggregateAverage: "Durchschnitt",
+	KeyAggregateChange:  "Veränderung",
+
+	KeyComparisonAbove: "über",
+	KeyComparisonBelow: "unter",
+
+	KeyPlanEvaluated: "Du hast {met} von {total} Zielen erreicht, die du dir für dieses Rennen vorgenommen hattest.",
+
+	KeyRecapPracticePlan: "Dein Trainingsplan für die Woche ist fertig.",
+	KeyRecapFatigue:      "An deinen letzten {days} Tagen mit mehreren Rennen wurden deine {measures} mit jedem Rennen eher schlechter.",
+	KeyRecapTitle:        "Wochenrückblick",
+	KeyRecapWeekOf:       "Rennwoche ab {week}",
+
+	KeyFatigueFinishPosition: "Zielpositionen",
+	KeyFatigueIncidents:      "Incidents",
+	KeyFatigueConsistency:    "Rundenkonstanz",
+
+	KeyAnalyticsNoRaces:               "In diesem Zeitraum bist du keine Rennen gefahren.",
+	KeyAnalyticsRaces + pluralOne:     "Du bist ein Rennen gefahren.",
+	KeyAnalyticsRaces + pluralOther:   "Du bist {count} Rennen gefahren.",
+	KeyAnalyticsIRatingGained:         "Du hast {delta} iRating gewonnen und lagst am Ende bei {end}.",
+	KeyAnalyticsIRatingLost:           "Du hast {delta} iRating verloren und lagst am Ende bei {end}.",
+	KeyAnalyticsIRatingHeld:           "Dein iRating blieb stabil bei {end}.",
+	KeyAnalyticsWins + pluralOne:      "Du hast einmal gewonnen.",
+	KeyAnalyticsWins + pluralOther:    "Du hast {count} Mal gewonnen.",
+	KeyAnalyticsPodiums + pluralOne:   "Du bist einmal aufs Podium gefahren.",
+	KeyAnalyticsPodiums + pluralOther: "Du bist {count} Mal aufs Podium gefahren.",
+	KeyAnalyticsPositions:             "Im Schnitt bist du von P{start} gestartet und auf P{finish} ins Ziel gekommen.",
+	KeyAnalyticsIncidents:             "Im Schnitt hattest du {incidents} Incidents pro Rennen.",
+
+	KeyListSeparator:     ", ",
+	KeyListLastSeparator: " und ",
+	KeyDecimalSeparator:  ",",
+}
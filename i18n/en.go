@@ -0,0 +1,43 @@
+package i18n
+
+var english = catalog{
+	KeyTrendAlert: "{name}: {aggregate} {metric} is {value}, {comparison} your threshold of {threshold}",
+
+	KeyMetricIncidents:      "incidents",
+	KeyMetricIRating:        "iRating",
+	KeyMetricFinishPosition: "finish position",
+
+	KeyAggregateAverage: "average",
+	KeyAggregateChange:  "change in",
+
+	KeyComparisonAbove: "above",
+	KeyComparisonBelow: "below",
+
+	KeyPlanEvaluated: "You met {met} of the {total} goals you planned for this race.",
+
+	KeyRecapPracticePlan: "Your practice plan for the week is ready.",
+	KeyRecapFatigue:      "Over your last {days} days of several races, your {measures} tended to get worse the more you raced.",
+	KeyRecapTitle:        "Weekly recap",
+	KeyRecapWeekOf:       "Race week of {week}",
+
+	KeyFatigueFinishPosition: "finishing position",
+	KeyFatigueIncidents:      "incident count",
+	KeyFatigueConsistency:    "lap consistency",
+
+	KeyAnalyticsNoRaces:               "You didn't race in this period.",
+	KeyAnalyticsRaces + pluralOne:     "You raced once.",
+	KeyAnalyticsRaces + pluralOther:   "You raced {count} times.",
+	KeyAnalyticsIRatingGained:         "You gained {delta} iRating, ending at {end}.",
+	KeyAnalyticsIRatingLost:           "You lost {delta} iRating, ending at {end}.",
+	KeyAnalyticsIRatingHeld:           "Your iRating held steady at {end}.",
+	KeyAnalyticsWins + pluralOne:      "You won once.",
+	KeyAnalyticsWins + pluralOther:    "You won {count} times.",
+	KeyAnalyticsPodiums + pluralOne:   "You finished on the podium once.",
+	KeyAnalyticsPodiums + pluralOther: "You finished on the podium {count} times.",
+	KeyAnalyticsPositions:             "On average you started P{start} and finished P{finish}.",
+	KeyAnalyticsIncidents:             "You averaged {incidents} incidents per race.",
+
+	KeyListSeparator:     ", ",
+	KeyListLastSeparator: " and ",
+	KeyDecimalSeparator:  ".",
+}
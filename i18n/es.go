@@ -0,0 +1,43 @@
+package i18n
+
+var spanish = catalog{
+	KeyTrendAlert: "{name}: {aggregate} {metric} es {value}, {comparison} tu umbral de {threshold}",
+
+	KeyMetricIncidents:      "incidentes",
+	KeyMetricIRating:        "iRating",
+	KeyMetricFinishPosition: "posición final",
+
+	KeyAggregateAverage: "promedio de",
+	KeyAggregateChange:  "cambio de",
+
+	KeyComparisonAbove: "por encima de",
+	KeyComparisonBelow: "por debajo de",
+
+	KeyPlanEvaluated: "Cumpliste {met} de los {total} objetivos que te propusiste para esta carrera.",
+
+	KeyRecapPracticePlan: "Tu plan de práctica para la semana está listo.",
+	KeyRecapFatigue:      "En tus últimos {days} días con varias carreras, tu {measures} tendió a empeorar cuanto más corrías.",
+	KeyRecapTitle:        "Resumen semanal",
+	KeyRecapWeekOf:       "Semana de carreras del {week}",
+
+	KeyFatigueFinishPosition: "posición final",
+	KeyFatigueIncidents:      "número de incidentes",
+	KeyFatigueConsistency:    "consistencia por vuelta",
+
+	KeyAnalyticsNoRaces:               "No corriste en este periodo.",
+	KeyAnalyticsRaces + pluralOne:     "Corriste una vez.",
+	KeyAnalyticsRaces + pluralOther:   "Corriste {count} veces.",
+	KeyAnalyticsIRatingGained:         "Ganaste {delta} de iRating y terminaste en {end}.",
+	KeyAnalyticsIRatingLost:           "Perdiste {delta} de iRating y terminaste en {end}.",
+	KeyAnalyticsIRatingHeld:           "Tu iRating se mantuvo en {end}.",
+	KeyAnalyticsWins + pluralOne:      "Ganaste una vez.",
+	KeyAnalyticsWins + pluralOther:    "Ganaste {count} veces.",
+	KeyAnalyticsPodiums + pluralOne:   "Subiste al podio una vez.",
+	KeyAnalyticsPodiums + pluralOther: "Subiste al podio {count} veces.",
+	KeyAnalyticsPositions:             "De media saliste P{start} y terminaste P{finish}.",
+	KeyAnalyticsIncidents:             "Promediaste {incidents} incidentes por carrera.",
+
+	KeyListSeparator:     ", ",
+	KeyListLastSeparator: " y ",
+	KeyDecimalSeparator:  ",",
+}
@@ -0,0 +1,43 @@
+package i18n
+
+var french = catalog{
+	KeyTrendAlert: "{name} : {aggregate} {metric} est de {value}, {comparison} ton seuil de {threshold}",
+
+	KeyMetricIncidents:      "incidents",
+	KeyMetricIRating:        "iRating",
+	KeyMetricFinishPosition: "position d'arrivée",
+
+	KeyAggregateAverage: "moyenne des",
+	KeyAggregateChange:  "évolution de",
+
+	KeyComparisonAbove: "au-dessus de",
+	KeyComparisonBelow: "en dessous de",
+
+	KeyPlanEvaluated: "Tu as atteint {met} des {total} objectifs que tu t'étais fixés pour cette course.",
+
+	KeyRecapPracticePlan: "Ton plan d'entraînement de la semaine est prêt.",
+	KeyRecapFatigue:      "Sur tes {days} derniers jours à plusieurs courses, ta {measures} a eu tendance à se dégrader au fil des courses.",
+	KeyRecapTitle:        "Récap de la semaine",
+	KeyRecapWeekOf:       "Semaine de course du {week}",
+
+	KeyFatigueFinishPosition: "position d'arrivée",
+	KeyFatigueIncidents:      "nombre d'incidents",
+	KeyFatigueConsistency:    "régularité au tour",
+
+	KeyAnalyticsNoRaces:               "Tu n'as pas couru sur cette période.",
+	KeyAnalyticsRaces + pluralOne:     "Tu as couru une fois.",
+	KeyAnalyticsRaces + pluralOther:   "Tu as couru {count} fois.",
+	KeyAnalyticsIRatingGained:         "Tu as gagné {delta} d'iRating pour finir à {end}.",
+	KeyAnalyticsIRatingLost:           "Tu as perdu {delta} d'iRating pour finir à {end}.",
+	KeyAnalyticsIRatingHeld:           "Ton iRating est resté stable à {end}.",
+	KeyAnalyticsWins + pluralOne:      "Tu as gagné une fois.",
+	KeyAnalyticsWins + pluralOther:    "Tu as gagné {count} fois.",
+	KeyAnalyticsPodiums + pluralOne:   "Tu es monté sur le podium une fois.",
+	KeyAnalyticsPodiums + pluralOther: "Tu es monté sur le podium {count} fois.",
+	KeyAnalyticsPositions:             "En moyenne, tu es parti P{start} et as terminé P{finish}.",
+	KeyAnalyticsIncidents:             "Tu as eu en moyenne {incidents} incidents par course.",
+
+	KeyListSeparator:     ", ",
+	KeyListLastSeparator: " et ",
+	KeyDecimalSeparator:  ",",
+}
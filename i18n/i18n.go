@@ -0,0 +1,163 @@
+// Package i18n holds the message catalogs for text generated on the server, such as trend alerts and weekly recaps,
+// so drivers get it in the language they picked in their settings.
+package i18n
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale is used for drivers that haven't picked a locale, and for any message a catalog is missing.
+const DefaultLocale = "en"
+
+// Message keys. Parameters are substituted into messages as {name}, see each key for the parameters it takes.
+const (
+	// KeyTrendAlert takes name, metric, aggregate, value, comparison and threshold
+	KeyTrendAlert = "alert.trend"
+
+	KeyMetricIncidents      = "metric.incidents"
+	KeyMetricIRating        = "metric.irating"
+	KeyMetricFinishPosition = "metric.finishPosition"
+
+	KeyAggregateAverage = "aggregate.average"
+	KeyAggregateChange  = "aggregate.change"
+
+	KeyComparisonAbove = "comparison.above"
+	KeyComparisonBelow = "comparison.below"
+
+	// KeyPlanEvaluated takes met and total, how many of the targets in a race plan's goals the race met
+	KeyPlanEvaluated = "plan.evaluated"
+
+	// KeyRecapPracticePlan takes no parameters
+	KeyRecapPracticePlan = "recap.practicePlan"
+	// KeyRecapFatigue takes days and measures
+	KeyRecapFatigue = "recap.fatigue"
+	// KeyRecapTitle takes no parameters
+	KeyRecapTitle = "recap.title"
+	// KeyRecapWeekOf takes week, the date the race week started
+	KeyRecapWeekOf = "recap.weekOf"
+
+	KeyFatigueFinishPosition = "fatigue.finish_position"
+	KeyFatigueIncidents      = "fatigue.incidents"
+	KeyFatigueConsistency    = "fatigue.consistency"
+
+	// KeyAnalyticsNoRaces takes no parameters
+	KeyAnalyticsNoRaces = "analytics.noRaces"
+	// KeyAnalyticsRaces is plural, see Localizer.Plural
+	KeyAnalyticsRaces = "analytics.races"
+	// KeyAnalyticsIRatingGained and KeyAnalyticsIRatingLost take delta and end
+	KeyAnalyticsIRatingGained = "analytics.iratingGained"
+	KeyAnalyticsIRatingLost   = "analytics.iratingLost"
+	// KeyAnalyticsIRatingHeld takes end
+	KeyAnalyticsIRatingHeld = "analytics.iratingHeld"
+	// KeyAnalyticsWins and KeyAnalyticsPodiums are plural, see Localizer.Plural
+	KeyAnalyticsWins    = "analytics.wins"
+	KeyAnalyticsPodiums = "analytics.podiums"
+	// KeyAnalyticsPositions takes start and finish
+	KeyAnalyticsPositions = "analytics.positions"
+	// KeyAnalyticsIncidents takes incidents
+	KeyAnalyticsIncidents = "analytics.incidents"
+
+	// KeyListSeparator joins items in a list, and KeyListLastSeparator joins the last item on
+	KeyListSeparator     = "list.separator"
+	KeyListLastSeparator = "list.lastSeparator"
+	// KeyDecimalSeparator is the character numbers use between the whole and fractional parts
+	KeyDecimalSeparator = "format.decimalSeparator"
+)
+
+// Plural messages are kept in the catalogs under their key with one of these suffixes.
+const (
+	pluralOne   = ".one"
+	pluralOther = ".other"
+)
+
+type catalog map[string]string
+
+var catalogs = map[string]catalog{
+	"en":    english,
+	"de":    german,
+	"es":    spanish,
+	"fr":    french,
+	"pt-BR": brazilianPortuguese,
+}
+
+// Supported lists the locales there are catalogs for.
+func Supported() []string {
+	return []string{"en", "de", "es", "fr", "pt-BR"}
+}
+
+// IsSupported checks if there is a catalog for the locale.
+func IsSupported(locale string) bool {
+	_, ok := catalogs[locale]
+	return ok
+}
+
+// Localizer renders messages in a single locale.
+type Localizer struct {
+	locale  string
+	catalog catalog
+}
+
+// For returns a Localizer for the locale, falling back on DefaultLocale when there's no catalog for it.
+func For(locale string) Localizer {
+	if c, ok := catalogs[locale]; ok {
+		return Localizer{locale: locale, catalog: c}
+	}
+	return Localizer{locale: DefaultLocale, catalog: english}
+}
+
+// Locale is the locale messages are rendered in.
+func (l Localizer) Locale() string {
+	return l.locale
+}
+
+// Text renders the message for key, substituting in params. Messages missing from the locale's catalog come from the
+// default catalog, and a key that isn't in either is returned as is so a gap shows up rather than blank text.
+func (l Localizer) Text(key string, params map[string]string) string {
+	message, ok := l.catalog[key]
+	if !ok {
+		message, ok = english[key]
+	}
+	if !ok {
+		return key
+	}
+	if len(params) == 0 {
+		return message
+	}
+	replacements := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		replacements = append(replacements, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(message)
+}
+
+// Plural renders the singular or plural form of the message for key depending on count, which is substituted in as
+// {count} along with params. Every supported locale uses the singular for exactly one.
+func (l Localizer) Plural(key string, count int, params map[string]string) string {
+	withCount := map[string]string{"count": strconv.Itoa(count)}
+	for name, value := range params {
+		withCount[name] = value
+	}
+	if count == 1 {
+		return l.Text(key+pluralOne, withCount)
+	}
+	return l.Text(key+pluralOther, withCount)
+}
+
+// Number formats a value with at most the given number of decimal places, using the locale's decimal separator.
+func (l Localizer) Number(value float64, decimals int) string {
+	formatted := strconv.FormatFloat(value, 'f', decimals, 64)
+	if strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+	}
+	return strings.Replace(formatted, ".", l.Text(KeyDecimalSeparator, nil), 1)
+}
+
+// List joins items into a list such as "a, b and c".
+func (l Localizer) List(items []string) string {
+	if len(items) < 2 {
+		return strings.Join(items, "")
+	}
+	last := len(items) - 1
+	return strings.Join(items[:last], l.Text(KeyListSeparator, nil)) + l.Text(KeyListLastSeparator, nil) + items[last]
+}
@@ -0,0 +1,96 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogsAreComplete(t *testing.T) {
+	for _, locale := range Supported() {
+		t.Run(locale, func(t *testing.T) {
+			c, ok := catalogs[locale]
+			if !assert.True(t, ok, "no catalog for supported locale") {
+				return
+			}
+			for key := range english {
+				assert.Contains(t, c, key)
+			}
+		})
+	}
+}
+
+func TestLocalizer_Text(t *testing.T) {
+	testCases := []struct {
+		name     string
+		locale   string
+		key      string
+		params   map[string]string
+		expected string
+	}{
+		{
+			name:     "substitutes params",
+			locale:   "en",
+			key:      KeyRecapFatigue,
+			params:   map[string]string{"days": "4", "measures": "incident count"},
+			expected: "Over your last 4 days of several races, your incident count tended to get worse the more you raced.",
+		},
+		{
+			name:     "uses the locale catalog",
+			locale:   "de",
+			key:      KeyRecapPracticePlan,
+			expected: "Dein Trainingsplan für die Woche ist fertig.",
+		},
+		{
+			name:     "unknown locale falls back on english",
+			locale:   "tlh",
+			key:      KeyRecapPracticePlan,
+			expected: "Your practice plan for the week is ready.",
+		},
+		{
+			name:     "empty locale falls back on english",
+			locale:   "",
+			key:      KeyComparisonAbove,
+			expected: "above",
+		},
+		{
+			name:     "unknown key is returned as is",
+			locale:   "fr",
+			key:      "no.such.key",
+			expected: "no.such.key",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, For(tc.locale).Text(tc.key, tc.params))
+		})
+	}
+}
+
+func TestLocalizer_Number(t *testing.T) {
+	assert.Equal(t, "6.5", For("en").Number(6.5, 2))
+	assert.Equal(t, "6,5", For("de").Number(6.5, 2))
+	assert.Equal(t, "0.33", For("en").Number(1.0/3, 2))
+	assert.Equal(t, "-12", For("fr").Number(-12, 2))
+	assert.Equal(t, "120", For("en").Number(120, 0))
+}
+
+func TestLocalizer_List(t *testing.T) {
+	assert.Equal(t, "", For("en").List(nil))
+	assert.Equal(t, "a", For("en").List([]string{"a"}))
+	assert.Equal(t, "a and b", For("en").List([]string{"a", "b"}))
+	assert.Equal(t, "a, b et c", For("fr").List([]string{"a", "b", "c"}))
+}
+
+func TestIsSupported(t *testing.T) {
+	assert.True(t, IsSupported("pt-BR"))
+	assert.False(t, IsSupported("pt"))
+	assert.False(t, IsSupported(""))
+}
+
+func TestLocalizer_Plural(t *testing.T) {
+	assert.Equal(t, "You raced once.", For("en").Plural(KeyAnalyticsRaces, 1, nil))
+	assert.Equal(t, "You raced 12 times.", For("en").Plural(KeyAnalyticsRaces, 12, nil))
+	assert.Equal(t, "Du bist 0 Rennen gefahren.", For("de").Plural(KeyAnalyticsRaces, 0, nil))
+}
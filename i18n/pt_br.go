@@ -0,0 +1,43 @@
+package i18n
+
+var brazilianPortuguese = catalog{
+	KeyTrendAlert: "{name}: {aggregate} {metric} está em {value}, {comparison} do seu limite de {threshold}",
+
+	KeyMetricIncidents:      "incidentes",
+	KeyMetricIRating:        "iRating",
+	KeyMetricFinishPosition: "posição de chegada",
+
+	KeyAggregateAverage: "média de",
+	KeyAggregateChange:  "variação de",
+
+	KeyComparisonAbove: "acima",
+	KeyComparisonBelow: "abaixo",
+
+	KeyPlanEvaluated: "Você atingiu {met} das {total} metas que planejou para esta corrida.",
+
+	KeyRecapPracticePlan: "Seu plano de treino da semana está pronto.",
+	KeyRecapFatigue:      "Nos seus últimos {days} dias com várias corridas, seu {measures} tendeu a piorar quanto mais você corria.",
+	KeyRecapTitle:        "Resumo semanal",
+	KeyRecapWeekOf:       "Semana de corridas de {week}",
+
+	KeyFatigueFinishPosition: "posição de chegada",
+	KeyFatigueIncidents:      "número de incidentes",
+	KeyFatigueConsistency:    "consistência de volta",
+
+	KeyAnalyticsNoRaces:               "Você não correu neste período.",
+	KeyAnalyticsRaces + pluralOne:     "Você correu uma vez.",
+	KeyAnalyticsRaces + pluralOther:   "Você correu {count} vezes.",
+	KeyAnalyticsIRatingGained:         "Você ganhou {delta} de iRating e terminou em {end}.",
+	KeyAnalyticsIRatingLost:           "Você perdeu {delta} de iRating e terminou em {end}.",
+	KeyAnalyticsIRatingHeld:           "Seu iRating ficou estável em {end}.",
+	KeyAnalyticsWins + pluralOne:      "Você venceu uma vez.",
+	KeyAnalyticsWins + pluralOther:    "Você venceu {count} vezes.",
+	KeyAnalyticsPodiums + pluralOne:   "Você subiu ao pódio uma vez.",
+	KeyAnalyticsPodiums + pluralOther: "Você subiu ao pódio {count} vezes.",
+	KeyAnalyticsPositions:             "Em média você largou em P{start} e terminou em P{finish}.",
+	KeyAnalyticsIncidents:             "Você teve em média {incidents} incidentes por corrida.",
+
+	KeyListSeparator:     ", ",
+	KeyListLastSeparator: " e ",
+	KeyDecimalSeparator:  ",",
+}
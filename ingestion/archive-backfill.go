@@ -0,0 +1,186 @@
+package ingestion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// DefaultBackfillBatchSize is how many archives a backfill works through between saving its progress.
+const DefaultBackfillBatchSize = 25
+
+// ArchiveBackfillStore defines the data access methods needed to backfill driver sessions from the session archives.
+type ArchiveBackfillStore interface {
+	GetBackfillProgress(ctx context.Context, name string) (*store.BackfillProgress, error)
+	SaveBackfillProgress(ctx context.Context, progress store.BackfillProgress) error
+	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)
+	SaveDriverSessionsWithMode(ctx context.Context, sessions []store.DriverSession, mode store.WriteMode) (store.WriteResult, error)
+}
+
+// ArchiveSource reads the session archives a backfill works through.
+type ArchiveSource interface {
+	ListResultsArchives(ctx context.Context, after string, limit int) ([]string, error)
+	GetSessionArchive(ctx context.Context, key string) (*RawSessionArchive, error)
+}
+
+// SessionPatch fills in fields added to store.DriverSession after sessions were already ingested. Apply sets them on
+// session from the driver's result in the archived session results, reporting whether anything changed.
+type SessionPatch struct {
+	Name  string
+	Apply func(session *store.DriverSession, result *iracing.SessionResult, driverResult *iracing.DriverResult) bool
+}
+
+// SessionPatches are the patches a backfill can run, by name. Each one works through the archives on its own, so a
+// field added later gets a patch of its own rather than a change to one that has already run.
+var SessionPatches = map[string]SessionPatch{
+	"championship": {
+		Name: "championship",
+		Apply: func(session *store.DriverSession, result *iracing.SessionResult, driverResult *iracing.DriverResult) bool {
+			// sessions ingested since championship data was recorded already have it
+			if session.SeasonID != 0 || result.SeasonID == 0 {
+				return false
+			}
+			session.CarClassID = int64(driverResult.CarClassID)
+			session.SeasonID = int64(result.SeasonID)
+			session.SeasonYear = result.SeasonYear
+			session.SeasonQuarter = result.SeasonQuarter
+			session.RaceWeekNum = result.RaceWeekNum
+			session.ChampPoints = driverResult.ChampPoints
+			session.DropRace = driverResult.DropRace
+			return true
+		},
+	},
+}
+
+// ArchiveBackfill applies a SessionPatch to the stored sessions of every driver in each archived session, parsing the
+// archived results just as ingestion would have had it fetched them rather than spending iRacing quota on them again.
+type ArchiveBackfill struct {
+	store     ArchiveBackfillStore
+	archives  ArchiveSource
+	patch     SessionPatch
+	batchSize int
+	baseURL   string
+	now       func() time.Time
+}
+
+func NewArchiveBackfill(store ArchiveBackfillStore, archives ArchiveSource, patch SessionPatch, batchSize int) *ArchiveBackfill {
+	return &ArchiveBackfill{
+		store:     store,
+		archives:  archives,
+		patch:     patch,
+		batchSize: batchSize,
+		baseURL:   iracing.DataAPIBaseURL,
+		now:       time.Now,
+	}
+}
+
+// Run works through the archives a batch at a time, patching each batch's sessions together and saving progress after
+// it, until there are none left or maxBatches have been run (zero for no limit). A run picks up after the last batch
+// saved, and one that already completed does nothing. Archives that can't be read or parsed are logged and skipped.
+func (b *ArchiveBackfill) Run(ctx context.Context, maxBatches int) (store.BackfillProgress, error) {
+	logger := zerolog.Ctx(ctx).With().Str("backfill", b.patch.Name).Logger()
+
+	saved, err := b.store.GetBackfillProgress(ctx, b.patch.Name)
+	if err != nil {
+		return store.BackfillProgress{}, fmt.Errorf("getting backfill progress: %w", err)
+	}
+	progress := store.BackfillProgress{Name: b.patch.Name}
+	if saved != nil {
+		progress = *saved
+	}
+	if progress.Complete {
+		logger.Info().Msg("backfill already complete")
+		return progress, nil
+	}
+
+	for batch := 0; maxBatches == 0 || batch < maxBatches; batch++ {
+		keys, err := b.archives.ListResultsArchives(ctx, progress.After, b.batchSize)
+		if err != nil {
+			return progress, err
+		}
+		if len(keys) == 0 {
+			progress.Complete = true
+		}
+
+		var patched []store.DriverSession
+		for _, key := range keys {
+			result, err := b.readArchive(ctx, key)
+			if err != nil {
+				logger.Warn().Err(err).Str("key", key).Msg("skipping archive")
+				progress.Failures++
+				continue
+			}
+			sessions, err := b.patchSessions(ctx, result)
+			if err != nil {
+				return progress, fmt.Errorf("patching sessions from %s: %w", key, err)
+			}
+			patched = append(patched, sessions...)
+		}
+		if _, err := b.store.SaveDriverSessionsWithMode(ctx, patched, store.WriteModeUpsert); err != nil {
+			return progress, fmt.Errorf("saving patched sessions: %w", err)
+		}
+
+		if len(keys) > 0 {
+			progress.After = keys[len(keys)-1]
+		}
+		progress.Archives += len(keys)
+		progress.Sessions += len(patched)
+		progress.UpdatedAt = b.now()
+		if err := b.store.SaveBackfillProgress(ctx, progress); err != nil {
+			return progress, fmt.Errorf("saving backfill progress: %w", err)
+		}
+		logger.Info().
+			Str("after", progress.After).
+			Int("archives", progress.Archives).
+			Int("sessions", progress.Sessions).
+			Int("failures", progress.Failures).
+			Bool("complete", progress.Complete).
+			Msg("backfill batch complete")
+
+		if progress.Complete {
+			break
+		}
+	}
+	return progress, nil
+}
+
+// readArchive parses the session results archived under key.
+func (b *ArchiveBackfill) readArchive(ctx context.Context, key string) (*iracing.SessionResult, error) {
+	archive, err := b.archives.GetSessionArchive(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	// the access token isn't part of what was archived, so any will do
+	result, err := archive.ReplayClient(b.baseURL).GetSessionResults(ctx, "", archive.SubsessionID, iracing.WithIncludeLicenses(true))
+	if err != nil {
+		return nil, fmt.Errorf("parsing archived results: %w", err)
+	}
+	return result, nil
+}
+
+// patchSessions returns the stored sessions of drivers in the race that the patch changed.
+func (b *ArchiveBackfill) patchSessions(ctx context.Context, result *iracing.SessionResult) ([]store.DriverSession, error) {
+	raceSession := findRaceSession(result.SessionResults)
+	if raceSession == nil {
+		return nil, nil
+	}
+
+	var patched []store.DriverSession
+	for i := range raceSession.Results {
+		driverResult := &raceSession.Results[i]
+		// most participants aren't drivers on the site, they just have nothing stored
+		session, err := b.store.GetDriverSession(ctx, driverResult.CustID, result.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("getting session for driver %d: %w", driverResult.CustID, err)
+		}
+		if session == nil || !b.patch.Apply(session, result, driverResult) {
+			continue
+		}
+		patched = append(patched, *session)
+	}
+	return patched, nil
+}
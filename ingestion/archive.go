@@ -0,0 +1,74 @@
+package ingestion
+
+import (
+	"context"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// SessionArchiver keeps the raw iRacing responses behind ingested sessions.
+type SessionArchiver interface {
+	ArchiveSession(ctx context.Context, archive RawSessionArchive) error
+}
+
+// RawSessionArchive is what iRacing sent back when part of a session was fetched. Responses are kept as they came over
+// the wire, so replaying them parses exactly what a fresh fetch would, fields added to the models since included.
+type RawSessionArchive struct {
+	SubsessionID int64                    `json:"subsessionId"`
+	Kind         store.SessionArchiveKind `json:"kind"`
+	// DriverID is who lap data was fetched for, zero for results
+	DriverID   int64                      `json:"driverId,omitempty"`
+	ArchivedAt time.Time                  `json:"archivedAt"`
+	Exchanges  []iracing.CapturedExchange `json:"exchanges"`
+}
+
+// ReplayClient returns an iRacing client answering from the archive rather than going to iRacing. Calls have to be
+// made just as ingestion made them, results with licenses included and lap data for the archived driver.
+func (a RawSessionArchive) ReplayClient(baseURL string) *iracing.Client {
+	return iracing.NewClient(iracing.NewReplayHTTPClient(a.Exchanges), dryRunMetrics{}, iracing.WithBaseURL(baseURL))
+}
+
+// WithSessionArchive archives the iRacing responses behind the results and lap data of each newly ingested session.
+// Responses are only recorded when the processor's iRacing client goes through an iracing.RecordingHTTPClient.
+func WithSessionArchive(archiver SessionArchiver) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.sessionArchiver = archiver
+	}
+}
+
+// recordForArchive attaches a recorder for fetches that are to be archived, the recorder is nil when archiving is off.
+func (r *RaceProcessor) recordForArchive(ctx context.Context) (context.Context, *iracing.Recorder) {
+	if r.sessionArchiver == nil {
+		return ctx, nil
+	}
+	recorder := iracing.NewRecorder()
+	return iracing.WithRecorder(ctx, recorder), recorder
+}
+
+// archiveSession archives what recorder picked up. The session has been ingested regardless, so a failure is logged
+// rather than failing the round.
+func (r *RaceProcessor) archiveSession(ctx context.Context, recorder *iracing.Recorder, subsessionID int64, kind store.SessionArchiveKind, driverID int64) {
+	if recorder == nil {
+		return
+	}
+	logger := zerolog.Ctx(ctx)
+
+	exchanges := recorder.Exchanges()
+	if len(exchanges) == 0 {
+		logger.Debug().Int64("subsessionID", subsessionID).Msg("nothing recorded to archive, iRacing client isn't recording")
+		return
+	}
+	err := r.sessionArchiver.ArchiveSession(ctx, RawSessionArchive{
+		SubsessionID: subsessionID,
+		Kind:         kind,
+		DriverID:     driverID,
+		ArchivedAt:   r.now(),
+		Exchanges:    exchanges,
+	})
+	if err != nil {
+		logger.Err(err).Int64("subsessionID", subsessionID).Str("kind", string(kind)).Msg("failed to archive session")
+	}
+}
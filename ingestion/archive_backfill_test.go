@@ -0,0 +1,321 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// archiveResults archives what iRacing answers for the session's results. The server has to have them under
+// /results/<subsession id>.
+func archiveResults(t *testing.T, ctx context.Context, iRacing *httptest.Server, subsessionID int64) RawSessionArchive {
+	client := iracing.NewClient(iracing.NewRecordingHTTPClient(iRacing.Client()), dryRunMetrics{}, iracing.WithBaseURL(iRacing.URL))
+	recorder := iracing.NewRecorder()
+	_, err := client.GetSessionResults(iracing.WithRecorder(ctx, recorder), "test-token", subsessionID, iracing.WithIncludeLicenses(true))
+	require.NoError(t, err)
+	return RawSessionArchive{SubsessionID: subsessionID, Kind: store.SessionArchiveResults, Exchanges: recorder.Exchanges()}
+}
+
+func TestArchiveBackfill_Championship(t *testing.T) {
+	ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	firstStart := time.Date(2024, 6, 14, 18, 0, 0, 0, time.UTC)
+	secondStart := time.Date(2024, 6, 14, 20, 0, 0, 0, time.UTC)
+
+	iRacing := newIRacingServer(t, map[string]string{
+		"/data/results/get?include_licenses=true&subsession_id=111": `{"link":"{server}/results/111"}`,
+		"/data/results/get?include_licenses=true&subsession_id=222": `{"link":"{server}/results/222"}`,
+		"/results/111": `{"subsession_id":111,"start_time":"2024-06-14T18:00:00Z",` +
+			`"season_id":4567,"season_year":2024,"season_quarter":2,"race_week_num":5,"session_results":[{"simsession_number":0,"results":[` +
+			`{"cust_id":1001,"car_class_id":74,"champ_points":52,"drop_race":true},` +
+			`{"cust_id":1002,"car_class_id":74,"champ_points":80},` +
+			`{"cust_id":9999,"car_class_id":74,"champ_points":60}]}]}`,
+		"/results/222": `{"subsession_id":222,"start_time":"2024-06-14T20:00:00Z",` +
+			`"season_id":4567,"season_year":2024,"season_quarter":2,"race_week_num":5,"session_results":[{"simsession_number":0,"results":[` +
+			`{"cust_id":1001,"car_class_id":74,"champ_points":40}]}]}`,
+	})
+	firstArchive := archiveResults(t, ctx, iRacing, 111)
+	secondArchive := archiveResults(t, ctx, iRacing, 222)
+	// nothing iRacing answered was kept, so there's nothing to parse
+	emptyArchive := RawSessionArchive{SubsessionID: 333, Kind: store.SessionArchiveResults}
+
+	storedFirst := func() *store.DriverSession {
+		return &store.DriverSession{DriverID: 1001, SubsessionID: 111, StartTime: firstStart, FinishPosition: 3}
+	}
+	storedOther := func() *store.DriverSession {
+		return &store.DriverSession{DriverID: 1002, SubsessionID: 111, StartTime: firstStart, FinishPosition: 1}
+	}
+	// ingested with championship data already, left alone
+	storedSecond := func() *store.DriverSession {
+		return &store.DriverSession{DriverID: 1001, SubsessionID: 222, StartTime: secondStart, SeasonID: 7, SeasonYear: 2023, RaceWeekNum: 1}
+	}
+	patchedFirst := store.DriverSession{
+		DriverID:       1001,
+		SubsessionID:   111,
+		StartTime:      firstStart,
+		FinishPosition: 3,
+		CarClassID:     74,
+		SeasonID:       4567,
+		SeasonYear:     2024,
+		SeasonQuarter:  2,
+		RaceWeekNum:    5,
+		ChampPoints:    52,
+		DropRace:       true,
+	}
+	patchedOther := store.DriverSession{
+		DriverID:       1002,
+		SubsessionID:   111,
+		StartTime:      firstStart,
+		FinishPosition: 1,
+		CarClassID:     74,
+		SeasonID:       4567,
+		SeasonYear:     2024,
+		SeasonQuarter:  2,
+		RaceWeekNum:    5,
+		ChampPoints:    80,
+	}
+	firstBatch := store.BackfillProgress{
+		Name:      "championship",
+		After:     "sessions/222/results.json",
+		Archives:  2,
+		Sessions:  2,
+		UpdatedAt: now,
+	}
+
+	type getBackfillProgressCall struct {
+		progress *store.BackfillProgress
+		err      error
+	}
+
+	type listResultsArchivesCall struct {
+		after string
+		keys  []string
+		err   error
+	}
+
+	type getSessionArchiveCall struct {
+		key     string
+		archive *RawSessionArchive
+		err     error
+	}
+
+	type getDriverSessionCall struct {
+		driverID  int64
+		startTime time.Time
+		session   *store.DriverSession
+		err       error
+	}
+
+	type saveDriverSessionsCall struct {
+		sessions []store.DriverSession
+		err      error
+	}
+
+	type saveBackfillProgressCall struct {
+		progress store.BackfillProgress
+		err      error
+	}
+
+	testCases := []struct {
+		name string
+
+		maxBatches int
+
+		getBackfillProgressCall   getBackfillProgressCall
+		listResultsArchivesCalls  []listResultsArchivesCall
+		getSessionArchiveCalls    []getSessionArchiveCall
+		getDriverSessionCalls     []getDriverSessionCall
+		saveDriverSessionsCalls   []saveDriverSessionsCall
+		saveBackfillProgressCalls []saveBackfillProgressCall
+
+		expected    store.BackfillProgress
+		expectedErr string
+	}{
+		{
+			name:       "a single batch",
+			maxBatches: 1,
+			listResultsArchivesCalls: []listResultsArchivesCall{
+				{after: "", keys: []string{"sessions/111/results.json", "sessions/222/results.json"}},
+			},
+			getSessionArchiveCalls: []getSessionArchiveCall{
+				{key: "sessions/111/results.json", archive: &firstArchive},
+				{key: "sessions/222/results.json", archive: &secondArchive},
+			},
+			getDriverSessionCalls: []getDriverSessionCall{
+				{driverID: 1001, startTime: firstStart, session: storedFirst()},
+				{driverID: 1002, startTime: firstStart, session: storedOther()},
+				// participants that aren't on the site don't get sessions made for them
+				{driverID: 9999, startTime: firstStart},
+				{driverID: 1001, startTime: secondStart, session: storedSecond()},
+			},
+			saveDriverSessionsCalls: []saveDriverSessionsCall{
+				{sessions: []store.DriverSession{patchedFirst, patchedOther}},
+			},
+			saveBackfillProgressCalls: []saveBackfillProgressCall{
+				{progress: firstBatch},
+			},
+			expected: firstBatch,
+		},
+		{
+			name:                    "picks up where it stopped, through to the end",
+			getBackfillProgressCall: getBackfillProgressCall{progress: &firstBatch},
+			listResultsArchivesCalls: []listResultsArchivesCall{
+				{after: "sessions/222/results.json", keys: []string{"sessions/333/results.json", "sessions/444/results.json"}},
+				{after: "sessions/444/results.json"},
+			},
+			getSessionArchiveCalls: []getSessionArchiveCall{
+				{key: "sessions/333/results.json", archive: &emptyArchive},
+				{key: "sessions/444/results.json", err: errors.New("no such key")},
+			},
+			saveDriverSessionsCalls: []saveDriverSessionsCall{
+				{},
+				{},
+			},
+			saveBackfillProgressCalls: []saveBackfillProgressCall{
+				{progress: store.BackfillProgress{
+					Name:      "championship",
+					After:     "sessions/444/results.json",
+					Archives:  4,
+					Sessions:  2,
+					Failures:  2,
+					UpdatedAt: now,
+				}},
+				{progress: store.BackfillProgress{
+					Name:      "championship",
+					After:     "sessions/444/results.json",
+					Archives:  4,
+					Sessions:  2,
+					Failures:  2,
+					Complete:  true,
+					UpdatedAt: now,
+				}},
+			},
+			expected: store.BackfillProgress{
+				Name:      "championship",
+				After:     "sessions/444/results.json",
+				Archives:  4,
+				Sessions:  2,
+				Failures:  2,
+				Complete:  true,
+				UpdatedAt: now,
+			},
+		},
+		{
+			name: "already complete",
+			getBackfillProgressCall: getBackfillProgressCall{progress: &store.BackfillProgress{
+				Name:     "championship",
+				After:    "sessions/444/results.json",
+				Archives: 4,
+				Complete: true,
+			}},
+			expected: store.BackfillProgress{
+				Name:     "championship",
+				After:    "sessions/444/results.json",
+				Archives: 4,
+				Complete: true,
+			},
+		},
+		{
+			name:                    "progress error",
+			getBackfillProgressCall: getBackfillProgressCall{err: errors.New("database error")},
+			expectedErr:             "getting backfill progress: database error",
+		},
+		{
+			name: "list error",
+			listResultsArchivesCalls: []listResultsArchivesCall{
+				{after: "", err: errors.New("access denied")},
+			},
+			expectedErr: "access denied",
+		},
+		{
+			name: "session error",
+			listResultsArchivesCalls: []listResultsArchivesCall{
+				{after: "", keys: []string{"sessions/111/results.json"}},
+			},
+			getSessionArchiveCalls: []getSessionArchiveCall{
+				{key: "sessions/111/results.json", archive: &firstArchive},
+			},
+			getDriverSessionCalls: []getDriverSessionCall{
+				{driverID: 1001, startTime: firstStart, err: errors.New("database error")},
+			},
+			expectedErr: "patching sessions from sessions/111/results.json: getting session for driver 1001: database error",
+		},
+		{
+			name: "save sessions error",
+			listResultsArchivesCalls: []listResultsArchivesCall{
+				{after: "", keys: []string{"sessions/333/results.json"}},
+			},
+			getSessionArchiveCalls: []getSessionArchiveCall{
+				{key: "sessions/333/results.json", archive: &emptyArchive},
+			},
+			saveDriverSessionsCalls: []saveDriverSessionsCall{
+				{err: errors.New("database error")},
+			},
+			expectedErr: "saving patched sessions: database error",
+		},
+		{
+			name: "save progress error",
+			listResultsArchivesCalls: []listResultsArchivesCall{
+				{after: ""},
+			},
+			saveDriverSessionsCalls: []saveDriverSessionsCall{
+				{},
+			},
+			saveBackfillProgressCalls: []saveBackfillProgressCall{
+				{
+					progress: store.BackfillProgress{Name: "championship", Complete: true, UpdatedAt: now},
+					err:      errors.New("database error"),
+				},
+			},
+			expectedErr: "saving backfill progress: database error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backfillStore := NewMockArchiveBackfillStore(t)
+			backfillStore.EXPECT().GetBackfillProgress(mock.Anything, "championship").
+				Return(tc.getBackfillProgressCall.progress, tc.getBackfillProgressCall.err)
+			for _, call := range tc.getDriverSessionCalls {
+				backfillStore.EXPECT().GetDriverSession(mock.Anything, call.driverID, call.startTime).Return(call.session, call.err).Once()
+			}
+			for _, call := range tc.saveDriverSessionsCalls {
+				backfillStore.EXPECT().SaveDriverSessionsWithMode(mock.Anything, call.sessions, store.WriteModeUpsert).
+					Return(store.WriteResult{}, call.err).Once()
+			}
+			for _, call := range tc.saveBackfillProgressCalls {
+				backfillStore.EXPECT().SaveBackfillProgress(mock.Anything, call.progress).Return(call.err).Once()
+			}
+
+			archives := NewMockArchiveSource(t)
+			for _, call := range tc.listResultsArchivesCalls {
+				archives.EXPECT().ListResultsArchives(mock.Anything, call.after, 2).Return(call.keys, call.err).Once()
+			}
+			for _, call := range tc.getSessionArchiveCalls {
+				archives.EXPECT().GetSessionArchive(mock.Anything, call.key).Return(call.archive, call.err).Once()
+			}
+
+			backfill := NewArchiveBackfill(backfillStore, archives, SessionPatches["championship"], 2)
+			backfill.baseURL = iRacing.URL
+			backfill.now = func() time.Time { return now }
+
+			progress, err := backfill.Run(ctx, tc.maxBatches)
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, progress)
+		})
+	}
+}
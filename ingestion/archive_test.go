@@ -0,0 +1,78 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaceProcessor_SessionArchive(t *testing.T) {
+	driverID := int64(12345)
+	memberSince := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	racesIngestedTo := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+	driverStore := store.NewMemoryStore()
+	require.NoError(t, driverStore.InsertDriver(ctx, store.Driver{DriverID: driverID, MemberSince: memberSince, RacesIngestedTo: &racesIngestedTo}))
+	require.NoError(t, driverStore.SaveDriverSettings(ctx, store.DriverSettings{DriverID: driverID}))
+
+	iRacing := newIRacingServer(t, map[string]string{
+		"/data/results/search_series": `{"data":{"success":true,"chunk_info":{"rows":1,"base_download_url":"{server}/chunks/","chunk_file_names":["c0.json"]}}}`,
+		"/chunks/c0.json":             `[{"subsession_id":99999,"start_time":"2024-06-14T18:00:00Z"}]`,
+		"/data/results/get":           `{"link":"{server}/results/99999"}`,
+		"/results/99999": `{"subsession_id":99999,"series_name":"Test Series","start_time":"2024-06-14T18:00:00Z","track":{"track_id":123},` +
+			`"session_results":[{"simsession_number":0,"results":[{"cust_id":12345,"car_id":10,"finish_position":3,"incidents":2}]}]}`,
+	})
+	client := iracing.NewClient(iracing.NewRecordingHTTPClient(iRacing.Client()), dryRunMetrics{}, iracing.WithBaseURL(iRacing.URL))
+
+	var archived []RawSessionArchive
+	archiver := NewMockSessionArchiver(t)
+	archiver.EXPECT().ArchiveSession(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, archive RawSessionArchive) error {
+		archived = append(archived, archive)
+		return nil
+	})
+
+	processor := NewRaceProcessor(driverStore, client, dryRunPusher{}, NewMockEventDispatcher(t), dryRunMetrics{}, 15*time.Minute,
+		WithSessionArchive(archiver))
+	processor.now = func() time.Time { return now }
+
+	require.NoError(t, processor.IngestRaces(ctx, RaceIngestionRequest{DriverID: driverID, IRacingAccessToken: "test-token"}))
+
+	require.Len(t, archived, 1)
+	assert.Equal(t, int64(99999), archived[0].SubsessionID)
+	assert.Equal(t, store.SessionArchiveResults, archived[0].Kind)
+	assert.Zero(t, archived[0].DriverID)
+	assert.Equal(t, now, archived[0].ArchivedAt)
+	// only the results fetch, the search the session was found by isn't part of it
+	assert.Len(t, archived[0].Exchanges, 2)
+	for _, exchange := range archived[0].Exchanges {
+		assert.NotContains(t, exchange.URL, "test-token")
+	}
+
+	// reprocessing works from the archive as stored
+	marshalled, err := json.Marshal(archived[0])
+	require.NoError(t, err)
+	var stored RawSessionArchive
+	require.NoError(t, json.Unmarshal(marshalled, &stored))
+
+	result, err := stored.ReplayClient(iRacing.URL).GetSessionResults(ctx, "other-token", 99999, iracing.WithIncludeLicenses(true))
+	require.NoError(t, err)
+	assert.Equal(t, int64(99999), result.SubsessionID)
+	assert.Equal(t, "Test Series", result.SeriesName)
+
+	// a session that's already stored isn't archived again
+	archived = nil
+	require.NoError(t, driverStore.UpdateDriverRacesIngestedTo(ctx, driverID, racesIngestedTo))
+	require.NoError(t, processor.IngestRaces(ctx, RaceIngestionRequest{DriverID: driverID, IRacingAccessToken: "test-token"}))
+	assert.Empty(t, archived)
+}
@@ -0,0 +1,215 @@
+package ingestion
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// CaptureStore keeps captured rounds around so they can be replayed later.
+type CaptureStore interface {
+	SaveCapture(ctx context.Context, capture Capture) error
+}
+
+// Capture is everything an ingestion round read, from iRacing and from the store, which is what's needed to run the
+// round again against the same inputs.
+type Capture struct {
+	CaptureID string        `json:"captureId"`
+	DriverID  int64         `json:"driverId"`
+	Backwards bool          `json:"backwards"`
+	StartedAt time.Time     `json:"startedAt"`
+	Config    CaptureConfig `json:"config"`
+
+	Driver   *store.Driver         `json:"driver"`
+	Settings *store.DriverSettings `json:"settings"`
+	Coverage store.Coverage        `json:"coverage"`
+	Tiers    []store.IngestionTier `json:"tiers"`
+	// Sessions are the driver's already stored sessions the round looked at
+	Sessions []store.DriverSession `json:"sessions"`
+
+	Exchanges []iracing.CapturedExchange `json:"exchanges"`
+}
+
+// CaptureConfig is how the processor that ran the round was set up.
+type CaptureConfig struct {
+	SearchWindow               time.Duration `json:"searchWindow"`
+	BootstrapWindow            time.Duration `json:"bootstrapWindow"`
+	RaceConsumptionConcurrency int           `json:"raceConsumptionConcurrency"`
+	LapConsumptionConcurrency  int           `json:"lapConsumptionConcurrency"`
+	LapBackfillBatchSize       int           `json:"lapBackfillBatchSize"`
+	InterpolateLaps            bool          `json:"interpolateLaps"`
+}
+
+// WithRunCapture lets requests ask for their rounds to be captured. Everything iRacing sends back is only recorded
+// when the processor's iRacing client goes through an iracing.RecordingHTTPClient.
+func WithRunCapture(captures CaptureStore, newID func() string) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.captureStore = captures
+		r.newCaptureID = newID
+	}
+}
+
+// runRound runs an ingestion round, capturing it when the request asks for that.
+func (r *RaceProcessor) runRound(ctx context.Context, request RaceIngestionRequest, stats *runStats) (*RaceIngestionRequest, error) {
+	if !request.Capture {
+		return r.doIngestRaces(ctx, request, stats)
+	}
+	if r.captureStore == nil {
+		zerolog.Ctx(ctx).Warn().Int64("driverID", request.DriverID).Msg("capture requested but not configured, running uncaptured")
+		return r.doIngestRaces(ctx, request, stats)
+	}
+
+	recorder := &captureRecorder{capture: Capture{
+		CaptureID: r.newCaptureID(),
+		DriverID:  request.DriverID,
+		Backwards: request.Backwards,
+		StartedAt: stats.startedAt,
+		Config: CaptureConfig{
+			SearchWindow:               r.searchWindowDuration,
+			BootstrapWindow:            r.bootstrapWindowDuration,
+			RaceConsumptionConcurrency: r.raceConsumptionConcurrency,
+			LapConsumptionConcurrency:  r.lapConsumptionConcurrency,
+			LapBackfillBatchSize:       r.lapBackfillBatchSize,
+			InterpolateLaps:            r.interpolateLaps,
+		},
+		Sessions: []store.DriverSession{},
+	}}
+	captured := *r
+	captured.store = &captureStore{Store: r.store, recorder: recorder}
+	if r.tierSource != nil {
+		captured.tierSource = &captureTierSource{IngestionTierSource: r.tierSource, recorder: recorder}
+	}
+
+	httpRecorder := iracing.NewRecorder()
+	next, err := captured.doIngestRaces(iracing.WithRecorder(ctx, httpRecorder), request, stats)
+
+	capture := recorder.result(httpRecorder.Exchanges())
+	if saveErr := r.captureStore.SaveCapture(ctx, capture); saveErr != nil {
+		// the round itself went fine, losing the capture shouldn't make it retry
+		zerolog.Ctx(ctx).Err(saveErr).Int64("driverID", request.DriverID).Msg("failed to save ingestion capture")
+	} else {
+		stats.captureID = capture.CaptureID
+		zerolog.Ctx(ctx).Info().Int64("driverID", request.DriverID).Str("captureID", capture.CaptureID).Msg("ingestion round captured")
+	}
+	return next, err
+}
+
+// captureRecorder collects reads from the concurrent session workers.
+type captureRecorder struct {
+	mu      sync.Mutex
+	capture Capture
+}
+
+func (c *captureRecorder) record(f func(capture *Capture)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f(&c.capture)
+}
+
+func (c *captureRecorder) result(exchanges []iracing.CapturedExchange) Capture {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ret := c.capture
+	ret.Exchanges = exchanges
+	ret.Sessions = slices.Clone(ret.Sessions)
+	slices.SortFunc(ret.Sessions, func(a, b store.DriverSession) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+	return ret
+}
+
+// addSession keeps the first copy of a session seen, later reads of it come after the round has updated it.
+func (c *Capture) addSession(session store.DriverSession) {
+	for _, existing := range c.Sessions {
+		if existing.StartTime.Equal(session.StartTime) {
+			return
+		}
+	}
+	c.Sessions = append(c.Sessions, session)
+}
+
+// captureStore records what the round reads from the store, writes go through untouched.
+type captureStore struct {
+	Store
+	recorder *captureRecorder
+}
+
+func (c *captureStore) GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error) {
+	driver, err := c.Store.GetDriver(ctx, driverID, opts...)
+	if err == nil && driver != nil {
+		c.recorder.record(func(capture *Capture) {
+			if capture.Driver == nil {
+				copied := *driver
+				capture.Driver = &copied
+			}
+		})
+	}
+	return driver, err
+}
+
+func (c *captureStore) GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error) {
+	settings, err := c.Store.GetDriverSettings(ctx, driverID)
+	if err == nil && settings != nil {
+		c.recorder.record(func(capture *Capture) {
+			if capture.Settings == nil {
+				copied := *settings
+				capture.Settings = &copied
+			}
+		})
+	}
+	return settings, err
+}
+
+func (c *captureStore) GetIngestionCoverage(ctx context.Context, driverID int64, opts ...store.ReadOption) (store.Coverage, error) {
+	coverage, err := c.Store.GetIngestionCoverage(ctx, driverID, opts...)
+	if err == nil {
+		c.recorder.record(func(capture *Capture) {
+			if capture.Coverage == nil {
+				capture.Coverage = slices.Clone(coverage)
+			}
+		})
+	}
+	return coverage, err
+}
+
+func (c *captureStore) GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error) {
+	session, err := c.Store.GetDriverSession(ctx, driverID, startTime, opts...)
+	if err == nil && session != nil {
+		c.recorder.record(func(capture *Capture) {
+			capture.addSession(*session)
+		})
+	}
+	return session, err
+}
+
+func (c *captureStore) GetDriverSessionsWithSkippedLaps(ctx context.Context, driverID int64) ([]store.DriverSession, error) {
+	sessions, err := c.Store.GetDriverSessionsWithSkippedLaps(ctx, driverID)
+	if err == nil {
+		c.recorder.record(func(capture *Capture) {
+			for _, session := range sessions {
+				capture.addSession(session)
+			}
+		})
+	}
+	return sessions, err
+}
+
+type captureTierSource struct {
+	IngestionTierSource
+	recorder *captureRecorder
+}
+
+func (c *captureTierSource) GetIngestionTiers(ctx context.Context) ([]store.IngestionTier, error) {
+	tiers, err := c.IngestionTierSource.GetIngestionTiers(ctx)
+	if err == nil {
+		c.recorder.record(func(capture *Capture) {
+			capture.Tiers = slices.Clone(tiers)
+		})
+	}
+	return tiers, err
+}
@@ -0,0 +1,118 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newIRacingServer answers by path, or by path and query where the path alone isn't enough, standing in for both the
+// data API and the S3 links it hands out. Links in the responses are written against {server}, which is swapped for
+// the server's URL.
+func newIRacingServer(t *testing.T, responses map[string]string) *httptest.Server {
+	t.Helper()
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.RequestURI()]
+		if !ok {
+			body, ok = responses[r.URL.Path]
+		}
+		if !ok {
+			t.Errorf("unexpected request to %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, strings.ReplaceAll(body, "{server}", server.URL))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRaceProcessor_CaptureAndReplay(t *testing.T) {
+	driverID := int64(12345)
+	memberSince := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	racesIngestedTo := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	sessionStartTime := time.Date(2024, 6, 14, 18, 0, 0, 0, time.UTC)
+
+	ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+	driverStore := store.NewMemoryStore()
+	require.NoError(t, driverStore.InsertDriver(ctx, store.Driver{DriverID: driverID, MemberSince: memberSince, RacesIngestedTo: &racesIngestedTo}))
+	require.NoError(t, driverStore.SaveDriverSettings(ctx, store.DriverSettings{DriverID: driverID}))
+
+	iRacing := newIRacingServer(t, map[string]string{
+		"/data/results/search_series": `{"data":{"success":true,"chunk_info":{"rows":1,"base_download_url":"{server}/chunks/","chunk_file_names":["c0.json"]}}}`,
+		"/chunks/c0.json":             `[{"subsession_id":99999,"start_time":"2024-06-14T18:00:00Z"}]`,
+		"/data/results/get":           `{"link":"{server}/results/99999"}`,
+		"/results/99999": `{"subsession_id":99999,"series_name":"Test Series","start_time":"2024-06-14T18:00:00Z","track":{"track_id":123},` +
+			`"session_results":[{"simsession_number":0,"results":[{"cust_id":12345,"car_id":10,"finish_position":3,"incidents":2}]}]}`,
+	})
+	client := iracing.NewClient(iracing.NewRecordingHTTPClient(iRacing.Client()), dryRunMetrics{}, iracing.WithBaseURL(iRacing.URL))
+
+	var saved Capture
+	captures := NewMockCaptureStore(t)
+	captures.EXPECT().SaveCapture(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, capture Capture) error {
+		saved = capture
+		return nil
+	})
+
+	processor := NewRaceProcessor(driverStore, client, dryRunPusher{}, NewMockEventDispatcher(t), dryRunMetrics{}, 15*time.Minute,
+		WithRunCapture(captures, func() string { return "capture-1" }))
+	processor.now = func() time.Time { return now }
+
+	require.NoError(t, processor.IngestRaces(ctx, RaceIngestionRequest{DriverID: driverID, IRacingAccessToken: "test-token", Capture: true}))
+
+	runs, err := driverStore.GetRecentIngestionRuns(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "capture-1", runs[0].CaptureID)
+
+	assert.Equal(t, "capture-1", saved.CaptureID)
+	assert.Equal(t, driverID, saved.DriverID)
+	assert.Equal(t, now, saved.StartedAt)
+	require.NotNil(t, saved.Driver)
+	assert.True(t, racesIngestedTo.Equal(*saved.Driver.RacesIngestedTo))
+	assert.Len(t, saved.Exchanges, 4)
+	for _, exchange := range saved.Exchanges {
+		assert.NotContains(t, exchange.URL, "test-token")
+	}
+
+	// replays work from the capture as stored, not the processor's copy
+	marshalled, err := json.Marshal(saved)
+	require.NoError(t, err)
+	var stored Capture
+	require.NoError(t, json.Unmarshal(marshalled, &stored))
+
+	summary, err := ReplayCapture(ctx, stored, iRacing.URL)
+	require.NoError(t, err)
+	assert.Equal(t, []DryRunSession{{
+		RaceID:         store.DriverRaceIDFromTime(sessionStartTime),
+		SubsessionID:   99999,
+		StartTime:      sessionStartTime,
+		SeriesName:     "Test Series",
+		TrackID:        123,
+		CarID:          10,
+		FinishPosition: 3,
+		Incidents:      2,
+	}}, summary.Sessions)
+	assert.Equal(t, &now, summary.RacesIngestedTo)
+
+	// a capture taken once the race was stored replays it as already ingested
+	stored.Sessions = []store.DriverSession{{DriverID: driverID, SubsessionID: 99999, StartTime: sessionStartTime}}
+	summary, err = ReplayCapture(ctx, stored, iRacing.URL)
+	require.NoError(t, err)
+	assert.Empty(t, summary.Sessions)
+}
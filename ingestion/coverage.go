@@ -0,0 +1,16 @@
+package ingestion
+
+import "github.com/jonsabados/saturdaysspinout/store"
+
+// DriverCoverage is the time ranges a driver's races have been ingested for. Drivers ingested before coverage was
+// recorded only have their cursors, which were only ever moved over contiguous history, so those stand in for it.
+func DriverCoverage(driver store.Driver, stored store.Coverage) store.Coverage {
+	if stored != nil || driver.RacesIngestedTo == nil {
+		return stored
+	}
+	from := driver.MemberSince
+	if driver.RacesIngestedFrom != nil {
+		from = *driver.RacesIngestedFrom
+	}
+	return store.Coverage{}.Add(store.TimeRange{From: from, To: *driver.RacesIngestedTo})
+}
@@ -0,0 +1,50 @@
+package ingestion
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriverCoverage(t *testing.T) {
+	memberSince := time.Unix(1000, 0)
+	ingestedFrom := time.Unix(3000, 0)
+	ingestedTo := time.Unix(5000, 0)
+	stored := store.Coverage{{From: time.Unix(2000, 0), To: time.Unix(4000, 0)}}
+
+	testCases := []struct {
+		name     string
+		driver   store.Driver
+		stored   store.Coverage
+		expected store.Coverage
+	}{
+		{
+			name:   "never ingested",
+			driver: store.Driver{MemberSince: memberSince},
+		},
+		{
+			name:     "recorded coverage",
+			driver:   store.Driver{MemberSince: memberSince, RacesIngestedTo: &ingestedTo},
+			stored:   stored,
+			expected: stored,
+		},
+		{
+			name:     "ingested forwards from member since",
+			driver:   store.Driver{MemberSince: memberSince, RacesIngestedTo: &ingestedTo},
+			expected: store.Coverage{{From: memberSince, To: ingestedTo}},
+		},
+		{
+			name:     "ingested back to a from cursor",
+			driver:   store.Driver{MemberSince: memberSince, RacesIngestedFrom: &ingestedFrom, RacesIngestedTo: &ingestedTo},
+			expected: store.Coverage{{From: ingestedFrom, To: ingestedTo}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, DriverCoverage(tc.driver, tc.stored))
+		})
+	}
+}
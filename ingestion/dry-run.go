@@ -0,0 +1,262 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const actionIngestionDryRunComplete = "ingestionDryRunComplete"
+
+// DryRunSummary is what a dry run round would have written had it been a real one.
+type DryRunSummary struct {
+	DriverID          int64           `json:"driverId"`
+	Backwards         bool            `json:"backwards"`
+	Sessions          []DryRunSession `json:"sessions"`
+	BackfilledRaceIDs []int64         `json:"backfilledRaceIds"`
+	LapCount          int             `json:"lapCount"`
+	CoverageAdded     []DryRunRange   `json:"coverageAdded"`
+	// the driver's cursors, omitted when the round would have left them alone
+	RacesIngestedFrom  *time.Time `json:"racesIngestedFrom,omitempty"`
+	RacesIngestedTo    *time.Time `json:"racesIngestedTo,omitempty"`
+	LapBackfillPending *bool      `json:"lapBackfillPending,omitempty"`
+	JournalPromptIDs   []int64    `json:"journalPromptRaceIds"`
+	// whether another round would have been dispatched, dry runs only ever run one
+	MoreToIngest bool `json:"moreToIngest"`
+}
+
+// DryRunSession is a session a dry run would have persisted.
+type DryRunSession struct {
+	RaceID         int64     `json:"raceId"`
+	SubsessionID   int64     `json:"subsessionId"`
+	StartTime      time.Time `json:"startTime"`
+	SeriesName     string    `json:"seriesName"`
+	TrackID        int64     `json:"trackId"`
+	CarID          int64     `json:"carId"`
+	FinishPosition int       `json:"finishPosition"`
+	Incidents      int       `json:"incidents"`
+	Laps           int       `json:"laps"`
+	LapGaps        int       `json:"lapGaps"`
+	LapsSkipped    bool      `json:"lapsSkipped"`
+}
+
+type DryRunRange struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// dryRun runs a single round with every write captured into a summary instead of being made, then logs the summary
+// and pushes it to the requesting connection. Nothing is written for the driver, so the lock and rate budget are
+// skipped as well, leaving a dry run free to go alongside a real ingestion.
+func (r *RaceProcessor) dryRun(ctx context.Context, request RaceIngestionRequest) error {
+	logger := zerolog.Ctx(ctx)
+
+	summary, err := r.dryRunRound(ctx, request)
+	if err != nil {
+		if errors.Is(err, iracing.ErrUpstreamUnauthorized) {
+			r.notifyStaleCredentials(ctx, request.NotifyConnectionID)
+			return nil
+		}
+		if errors.Is(err, errIngestionCancelled) {
+			logger.Info().Int64("driverID", request.DriverID).Msg("dry run stopped by cancel request")
+			return nil
+		}
+		return err
+	}
+
+	logger.Info().Int64("driverID", request.DriverID).Interface("summary", summary).Msg("ingestion dry run complete")
+
+	if request.NotifyConnectionID != "" {
+		if _, err := r.pusher.Push(ctx, request.NotifyConnectionID, actionIngestionDryRunComplete, summary); err != nil {
+			logger.Err(err).Int64("driverID", request.DriverID).Msg("failed to push dry run summary")
+		}
+	}
+	return nil
+}
+
+func (r *RaceProcessor) dryRunRound(ctx context.Context, request RaceIngestionRequest) (DryRunSummary, error) {
+	recorder := &dryRunRecorder{summary: DryRunSummary{DriverID: request.DriverID, Backwards: request.Backwards}}
+	dry := *r
+	dry.store = &dryRunStore{Store: r.store, recorder: recorder}
+	dry.pusher = dryRunPusher{}
+	dry.metricsClient = dryRunMetrics{}
+	dry.sessionArchiver = nil
+
+	next, err := dry.doIngestRaces(ctx, request, newRunStats(r.now()))
+	if err != nil {
+		return DryRunSummary{}, err
+	}
+	return recorder.result(next != nil), nil
+}
+
+// dryRunRecorder collects writes from the concurrent session workers.
+type dryRunRecorder struct {
+	mu      sync.Mutex
+	summary DryRunSummary
+}
+
+func (d *dryRunRecorder) record(f func(summary *DryRunSummary)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	f(&d.summary)
+}
+
+func (d *dryRunRecorder) result(moreToIngest bool) DryRunSummary {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	ret := d.summary
+	ret.MoreToIngest = moreToIngest
+	// sessions are persisted by concurrent workers, put them back in race order
+	ret.Sessions = slices.Clone(ret.Sessions)
+	slices.SortFunc(ret.Sessions, func(a, b DryRunSession) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+	// lists are always present so the summary reads the same whether or not anything was found
+	if ret.Sessions == nil {
+		ret.Sessions = []DryRunSession{}
+	}
+	if ret.BackfilledRaceIDs == nil {
+		ret.BackfilledRaceIDs = []int64{}
+	}
+	if ret.CoverageAdded == nil {
+		ret.CoverageAdded = []DryRunRange{}
+	}
+	if ret.JournalPromptIDs == nil {
+		ret.JournalPromptIDs = []int64{}
+	}
+	return ret
+}
+
+// dryRunStore passes reads through to the real store and records writes instead of making them.
+type dryRunStore struct {
+	Store
+	recorder *dryRunRecorder
+}
+
+func (d *dryRunStore) AddIngestionCoverage(_ context.Context, _ int64, ranges ...store.TimeRange) error {
+	d.recorder.record(func(summary *DryRunSummary) {
+		for _, tr := range ranges {
+			summary.CoverageAdded = append(summary.CoverageAdded, DryRunRange{From: tr.From, To: tr.To})
+		}
+	})
+	return nil
+}
+
+func (d *dryRunStore) UpdateDriverRacesIngestedFrom(_ context.Context, _ int64, racesIngestedFrom time.Time) error {
+	d.recorder.record(func(summary *DryRunSummary) {
+		summary.RacesIngestedFrom = &racesIngestedFrom
+	})
+	return nil
+}
+
+func (d *dryRunStore) UpdateDriverRacesIngestedTo(_ context.Context, _ int64, racesIngestedTo time.Time) error {
+	d.recorder.record(func(summary *DryRunSummary) {
+		summary.RacesIngestedTo = &racesIngestedTo
+	})
+	return nil
+}
+
+func (d *dryRunStore) PersistSessionData(_ context.Context, session store.DriverSession, laps []store.SessionDriverLap) error {
+	d.recorder.record(func(summary *DryRunSummary) {
+		summary.Sessions = append(summary.Sessions, DryRunSession{
+			RaceID:         store.DriverRaceIDFromTime(session.StartTime),
+			SubsessionID:   session.SubsessionID,
+			StartTime:      session.StartTime,
+			SeriesName:     session.SeriesName,
+			TrackID:        session.TrackID,
+			CarID:          session.CarID,
+			FinishPosition: session.FinishPosition,
+			Incidents:      session.Incidents,
+			Laps:           len(laps),
+			LapGaps:        session.LapGaps,
+			LapsSkipped:    session.LapsSkipped,
+		})
+		summary.LapCount += len(laps)
+	})
+	return nil
+}
+
+func (d *dryRunStore) SaveSessionDriverLaps(_ context.Context, laps []store.SessionDriverLap) error {
+	d.recorder.record(func(summary *DryRunSummary) {
+		summary.LapCount += len(laps)
+	})
+	return nil
+}
+
+func (d *dryRunStore) CompleteDriverSessionLaps(_ context.Context, _ int64, startTime time.Time, _ *int, _ int) error {
+	d.recorder.record(func(summary *DryRunSummary) {
+		summary.BackfilledRaceIDs = append(summary.BackfilledRaceIDs, store.DriverRaceIDFromTime(startTime))
+	})
+	return nil
+}
+
+func (d *dryRunStore) ClearLapBackfillPending(_ context.Context, _ int64) error {
+	d.recorder.record(func(summary *DryRunSummary) {
+		pending := false
+		summary.LapBackfillPending = &pending
+	})
+	return nil
+}
+
+func (d *dryRunStore) SaveDriverSettings(_ context.Context, settings store.DriverSettings) error {
+	d.recorder.record(func(summary *DryRunSummary) {
+		pending := settings.LapBackfillPending
+		summary.LapBackfillPending = &pending
+	})
+	return nil
+}
+
+func (d *dryRunStore) SaveJournalPrompt(_ context.Context, prompt store.JournalPrompt) error {
+	d.recorder.record(func(summary *DryRunSummary) {
+		summary.JournalPromptIDs = append(summary.JournalPromptIDs, prompt.RaceID)
+	})
+	return nil
+}
+
+func (d *dryRunStore) AcquireIngestionLock(context.Context, int64, time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (d *dryRunStore) ReleaseIngestionLock(context.Context, int64) error {
+	return nil
+}
+
+func (d *dryRunStore) SaveIngestionRun(context.Context, store.IngestionRun) error {
+	return nil
+}
+
+func (d *dryRunStore) ClearIngestionCancel(context.Context, int64) error {
+	return nil
+}
+
+// dryRunPusher swallows the notifications a round sends along the way, the races they announce were never stored.
+type dryRunPusher struct{}
+
+func (dryRunPusher) Push(context.Context, string, string, any) (bool, error) {
+	return true, nil
+}
+
+func (dryRunPusher) Broadcast(context.Context, int64, string, any) error {
+	return nil
+}
+
+// dryRunMetrics keeps dry runs out of the ingestion metrics.
+type dryRunMetrics struct{}
+
+func (dryRunMetrics) EmitCount(context.Context, string, int) error {
+	return nil
+}
+
+func (dryRunMetrics) EmitDuration(context.Context, string, time.Duration, map[string]string) error {
+	return nil
+}
+
+func (dryRunMetrics) EmitGauge(context.Context, string, float64) error {
+	return nil
+}
@@ -0,0 +1,122 @@
+package ingestion
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRaceProcessor_DryRun(t *testing.T) {
+	driverID := int64(12345)
+	subsessionID := int64(99999)
+	memberSince := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	racesIngestedTo := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	sessionStartTime := time.Date(2024, 6, 14, 18, 0, 0, 0, time.UTC)
+	raceID := store.DriverRaceIDFromTime(sessionStartTime)
+
+	request := RaceIngestionRequest{
+		DriverID:           driverID,
+		IRacingAccessToken: "test-token",
+		NotifyConnectionID: "conn-123",
+		DryRun:             true,
+	}
+	sessionResult := &iracing.SessionResult{
+		SubsessionID: subsessionID,
+		SeriesName:   "Test Series",
+		Track:        iracing.Track{TrackID: 123},
+		StartTime:    sessionStartTime,
+		SessionResults: []iracing.SimSessionResult{
+			{
+				SimsessionNumber: 0,
+				Results: []iracing.DriverResult{
+					{CustID: driverID, CarID: 10, FinishPosition: 3, Incidents: 2},
+					{CustID: 54321, CarID: 10, FinishPosition: 0},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		searchErr error
+		setupPush func(p *MockPusher)
+	}{
+		{
+			name: "reports what would have been written",
+			setupPush: func(p *MockPusher) {
+				p.EXPECT().Push(mock.Anything, "conn-123", "ingestionDryRunComplete", DryRunSummary{
+					DriverID: driverID,
+					Sessions: []DryRunSession{{
+						RaceID:         raceID,
+						SubsessionID:   subsessionID,
+						StartTime:      sessionStartTime,
+						SeriesName:     "Test Series",
+						TrackID:        123,
+						CarID:          10,
+						FinishPosition: 3,
+						Incidents:      2,
+					}},
+					BackfilledRaceIDs: []int64{},
+					CoverageAdded: []DryRunRange{
+						{From: memberSince, To: racesIngestedTo}, // carried over from the cursors
+						{From: racesIngestedTo.Add(-4 * time.Hour), To: now},
+					},
+					RacesIngestedTo:  &now,
+					JournalPromptIDs: []int64{raceID},
+				}).Return(true, nil)
+			},
+		},
+		{
+			name:      "stale credentials",
+			searchErr: fmt.Errorf("searching: %w", iracing.ErrUpstreamUnauthorized),
+			setupPush: func(p *MockPusher) {
+				p.EXPECT().Push(mock.Anything, "conn-123", "ingestionFailedStaleCredentials", nil).Return(true, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			// only reads are expected, the mock fails the test on any write
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().IngestionCancelRequested(mock.Anything, driverID).Return(false, nil)
+			mockStore.EXPECT().GetDriver(mock.Anything, driverID, []store.ReadOption{store.ConsistentRead()}).
+				Return(&store.Driver{DriverID: driverID, MemberSince: memberSince, RacesIngestedTo: &racesIngestedTo}, nil)
+			mockStore.EXPECT().GetDriverSettings(mock.Anything, driverID).
+				Return(&store.DriverSettings{DriverID: driverID}, nil)
+			mockStore.EXPECT().GetIngestionCoverage(mock.Anything, driverID, []store.ReadOption{store.ConsistentRead()}).
+				Return(nil, nil)
+
+			mockIRacing := NewMockIRacingClient(t)
+			if tc.searchErr != nil {
+				mockIRacing.EXPECT().SearchSeriesResults(mock.Anything, "test-token", racesIngestedTo.Add(-4*time.Hour), now, mock.Anything, mock.Anything).
+					Return(nil, tc.searchErr)
+			} else {
+				mockIRacing.EXPECT().SearchSeriesResults(mock.Anything, "test-token", racesIngestedTo.Add(-4*time.Hour), now, mock.Anything, mock.Anything).
+					Return([]iracing.SeriesResult{{SubsessionID: subsessionID}}, nil)
+				mockIRacing.EXPECT().GetSessionResults(mock.Anything, "test-token", subsessionID, mock.Anything).
+					Return(sessionResult, nil)
+				mockStore.EXPECT().GetDriverSession(mock.Anything, driverID, sessionStartTime).Return(nil, nil)
+			}
+
+			mockPusher := NewMockPusher(t)
+			tc.setupPush(mockPusher)
+
+			// nothing is dispatched or measured
+			processor := NewRaceProcessor(mockStore, mockIRacing, mockPusher, NewMockEventDispatcher(t), NewMockMetricsClient(t), 15*time.Minute)
+			processor.now = func() time.Time { return now }
+
+			assert.NoError(t, processor.IngestRaces(ctx, request))
+		})
+	}
+}
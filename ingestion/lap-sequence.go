@@ -0,0 +1,70 @@
+package ingestion
+
+import (
+	"sort"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// validateLapSequence orders a driver's laps by lap number and counts the lap numbers missing from it, starting from
+// lap 0 (the grid). iRacing occasionally drops laps from its lap data, which throws off anything that walks the laps
+// in order. Duplicate lap numbers keep the first lap seen and negative lap numbers are dropped.
+//
+// When interpolate is set each missing lap is filled in with a placeholder flagged as synthetic. Placeholders have no
+// valid lap time so pace and consistency stats skip them, and their session time is spread evenly between the laps
+// either side of the gap so race ordering still holds. A placeholder without a timed lap on both sides gets no session
+// time.
+func validateLapSequence(laps []store.SessionDriverLap, interpolate bool) ([]store.SessionDriverLap, int) {
+	if len(laps) == 0 {
+		return laps, 0
+	}
+
+	sorted := make([]store.SessionDriverLap, 0, len(laps))
+	seen := make(map[int]bool, len(laps))
+	for _, l := range laps {
+		if l.LapNumber < 0 || seen[l.LapNumber] {
+			continue
+		}
+		seen[l.LapNumber] = true
+		sorted = append(sorted, l)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LapNumber < sorted[j].LapNumber
+	})
+
+	gaps := 0
+	result := make([]store.SessionDriverLap, 0, len(sorted))
+	var prev *store.SessionDriverLap
+	for _, l := range sorted {
+		nextExpected := 0
+		if prev != nil {
+			nextExpected = prev.LapNumber + 1
+		}
+		missing := l.LapNumber - nextExpected
+		gaps += missing
+		if interpolate {
+			for i := range missing {
+				result = append(result, placeholderLap(prev, l, nextExpected+i, i+1, missing+1))
+			}
+		}
+		result = append(result, l)
+		prev = &l
+	}
+	return result, gaps
+}
+
+// placeholderLap builds the synthetic lap standing in for lapNumber, the step'th of steps between before and after.
+func placeholderLap(before *store.SessionDriverLap, after store.SessionDriverLap, lapNumber, step, steps int) store.SessionDriverLap {
+	sessionTime := -1
+	if before != nil && before.SessionTime >= 0 && after.SessionTime >= 0 {
+		sessionTime = before.SessionTime + (after.SessionTime-before.SessionTime)*step/steps
+	}
+	return store.SessionDriverLap{
+		SubsessionID: after.SubsessionID,
+		DriverID:     after.DriverID,
+		LapNumber:    lapNumber,
+		SessionTime:  sessionTime,
+		LapTime:      -1,
+		Synthetic:    true,
+	}
+}
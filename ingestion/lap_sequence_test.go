@@ -0,0 +1,81 @@
+package ingestion
+
+import (
+	"testing"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateLapSequence(t *testing.T) {
+	lap := func(number, sessionTime, lapTime int) store.SessionDriverLap {
+		return store.SessionDriverLap{SubsessionID: 1, DriverID: 2, LapNumber: number, SessionTime: sessionTime, LapTime: lapTime}
+	}
+	placeholder := func(number, sessionTime int) store.SessionDriverLap {
+		return store.SessionDriverLap{SubsessionID: 1, DriverID: 2, LapNumber: number, SessionTime: sessionTime, LapTime: -1, Synthetic: true}
+	}
+
+	testCases := []struct {
+		name         string
+		laps         []store.SessionDriverLap
+		interpolate  bool
+		expectedLaps []store.SessionDriverLap
+		expectedGaps int
+	}{
+		{
+			name: "no laps",
+		},
+		{
+			name:         "complete sequence is sorted",
+			laps:         []store.SessionDriverLap{lap(2, 3000, 1000), lap(0, 1000, -1), lap(1, 2000, 1000)},
+			expectedLaps: []store.SessionDriverLap{lap(0, 1000, -1), lap(1, 2000, 1000), lap(2, 3000, 1000)},
+		},
+		{
+			name:         "gaps counted without interpolating",
+			laps:         []store.SessionDriverLap{lap(0, 1000, -1), lap(3, 4000, 1000), lap(5, 6000, 1000)},
+			expectedLaps: []store.SessionDriverLap{lap(0, 1000, -1), lap(3, 4000, 1000), lap(5, 6000, 1000)},
+			expectedGaps: 3,
+		},
+		{
+			name:        "gaps interpolated",
+			laps:        []store.SessionDriverLap{lap(0, 1000, -1), lap(3, 4000, 1000), lap(5, 6000, 1000)},
+			interpolate: true,
+			expectedLaps: []store.SessionDriverLap{
+				lap(0, 1000, -1),
+				placeholder(1, 2000),
+				placeholder(2, 3000),
+				lap(3, 4000, 1000),
+				placeholder(4, 5000),
+				lap(5, 6000, 1000),
+			},
+			expectedGaps: 3,
+		},
+		{
+			name:         "missing grid lap has no session time to interpolate",
+			laps:         []store.SessionDriverLap{lap(1, 2000, 1000), lap(2, 3000, 1000)},
+			interpolate:  true,
+			expectedLaps: []store.SessionDriverLap{placeholder(0, -1), lap(1, 2000, 1000), lap(2, 3000, 1000)},
+			expectedGaps: 1,
+		},
+		{
+			name:         "untimed neighbour leaves placeholder untimed",
+			laps:         []store.SessionDriverLap{lap(0, -1, -1), lap(2, 3000, 1000)},
+			interpolate:  true,
+			expectedLaps: []store.SessionDriverLap{lap(0, -1, -1), placeholder(1, -1), lap(2, 3000, 1000)},
+			expectedGaps: 1,
+		},
+		{
+			name:         "duplicate and negative lap numbers dropped",
+			laps:         []store.SessionDriverLap{lap(-1, 500, -1), lap(0, 1000, -1), lap(1, 2000, 1000), lap(1, 2500, 1500)},
+			expectedLaps: []store.SessionDriverLap{lap(0, 1000, -1), lap(1, 2000, 1000)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			laps, gaps := validateLapSequence(tc.laps, tc.interpolate)
+			assert.Equal(t, tc.expectedLaps, laps)
+			assert.Equal(t, tc.expectedGaps, gaps)
+		})
+	}
+}
@@ -39,6 +39,181 @@ func (_m *MockIRacingClient) EXPECT() *MockIRacingClient_Expecter {
 	return &MockIRacingClient_Expecter{mock: &_m.Mock}
 }
 
+// GetLapChartData provides a mock function for the type MockIRacingClient
+func (_mock *MockIRacingClient) GetLapChartData(ctx context.Context, accessToken string, subsessionID int64, simsessionNumber int) (*iracing.LapChartResponse, error) {
+	ret := _mock.Called(ctx, accessToken, subsessionID, simsessionNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLapChartData")
+	}
+
+	var r0 *iracing.LapChartResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, int) (*iracing.LapChartResponse, error)); ok {
+		return returnFunc(ctx, accessToken, subsessionID, simsessionNumber)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, int) *iracing.LapChartResponse); ok {
+		r0 = returnFunc(ctx, accessToken, subsessionID, simsessionNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iracing.LapChartResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int64, int) error); ok {
+		r1 = returnFunc(ctx, accessToken, subsessionID, simsessionNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRacingClient_GetLapChartData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLapChartData'
+type MockIRacingClient_GetLapChartData_Call struct {
+	*mock.Call
+}
+
+// GetLapChartData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+//   - subsessionID int64
+//   - simsessionNumber int
+func (_e *MockIRacingClient_Expecter) GetLapChartData(ctx interface{}, accessToken interface{}, subsessionID interface{}, simsessionNumber interface{}) *MockIRacingClient_GetLapChartData_Call {
+	return &MockIRacingClient_GetLapChartData_Call{Call: _e.mock.On("GetLapChartData", ctx, accessToken, subsessionID, simsessionNumber)}
+}
+
+func (_c *MockIRacingClient_GetLapChartData_Call) Run(run func(ctx context.Context, accessToken string, subsessionID int64, simsessionNumber int)) *MockIRacingClient_GetLapChartData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRacingClient_GetLapChartData_Call) Return(lapChartResponse *iracing.LapChartResponse, err error) *MockIRacingClient_GetLapChartData_Call {
+	_c.Call.Return(lapChartResponse, err)
+	return _c
+}
+
+func (_c *MockIRacingClient_GetLapChartData_Call) RunAndReturn(run func(ctx context.Context, accessToken string, subsessionID int64, simsessionNumber int) (*iracing.LapChartResponse, error)) *MockIRacingClient_GetLapChartData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLapData provides a mock function for the type MockIRacingClient
+func (_mock *MockIRacingClient) GetLapData(ctx context.Context, accessToken string, subsessionID int64, simsessionNumber int, opts ...iracing.GetLapDataOption) (*iracing.LapDataResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, accessToken, subsessionID, simsessionNumber, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, accessToken, subsessionID, simsessionNumber)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLapData")
+	}
+
+	var r0 *iracing.LapDataResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, int, ...iracing.GetLapDataOption) (*iracing.LapDataResponse, error)); ok {
+		return returnFunc(ctx, accessToken, subsessionID, simsessionNumber, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, int, ...iracing.GetLapDataOption) *iracing.LapDataResponse); ok {
+		r0 = returnFunc(ctx, accessToken, subsessionID, simsessionNumber, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iracing.LapDataResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int64, int, ...iracing.GetLapDataOption) error); ok {
+		r1 = returnFunc(ctx, accessToken, subsessionID, simsessionNumber, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRacingClient_GetLapData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLapData'
+type MockIRacingClient_GetLapData_Call struct {
+	*mock.Call
+}
+
+// GetLapData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+//   - subsessionID int64
+//   - simsessionNumber int
+//   - opts ...iracing.GetLapDataOption
+func (_e *MockIRacingClient_Expecter) GetLapData(ctx interface{}, accessToken interface{}, subsessionID interface{}, simsessionNumber interface{}, opts ...interface{}) *MockIRacingClient_GetLapData_Call {
+	return &MockIRacingClient_GetLapData_Call{Call: _e.mock.On("GetLapData",
+		append([]interface{}{ctx, accessToken, subsessionID, simsessionNumber}, opts...)...)}
+}
+
+func (_c *MockIRacingClient_GetLapData_Call) Run(run func(ctx context.Context, accessToken string, subsessionID int64, simsessionNumber int, opts ...iracing.GetLapDataOption)) *MockIRacingClient_GetLapData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 []iracing.GetLapDataOption
+		var variadicArgs []iracing.GetLapDataOption
+		if len(args) > 4 {
+			variadicArgs = args[4].([]iracing.GetLapDataOption)
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRacingClient_GetLapData_Call) Return(lapDataResponse *iracing.LapDataResponse, err error) *MockIRacingClient_GetLapData_Call {
+	_c.Call.Return(lapDataResponse, err)
+	return _c
+}
+
+func (_c *MockIRacingClient_GetLapData_Call) RunAndReturn(run func(ctx context.Context, accessToken string, subsessionID int64, simsessionNumber int, opts ...iracing.GetLapDataOption) (*iracing.LapDataResponse, error)) *MockIRacingClient_GetLapData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSessionResults provides a mock function for the type MockIRacingClient
 func (_mock *MockIRacingClient) GetSessionResults(ctx context.Context, accessToken string, subsessionID int64, opts ...iracing.GetSessionResultsOption) (*iracing.SessionResult, error) {
 	var tmpRet mock.Arguments
@@ -6,6 +6,7 @@ package ingestion
 
 import (
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -99,3 +100,72 @@ func (_c *MockMetricsClient_EmitCount_Call) RunAndReturn(run func(ctx context.Co
 	_c.Call.Return(run)
 	return _c
 }
+
+// EmitDuration provides a mock function for the type MockMetricsClient
+func (_mock *MockMetricsClient) EmitDuration(ctx context.Context, name string, duration time.Duration, dimensions map[string]string) error {
+	ret := _mock.Called(ctx, name, duration, dimensions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmitDuration")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration, map[string]string) error); ok {
+		r0 = returnFunc(ctx, name, duration, dimensions)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMetricsClient_EmitDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmitDuration'
+type MockMetricsClient_EmitDuration_Call struct {
+	*mock.Call
+}
+
+// EmitDuration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - duration time.Duration
+//   - dimensions map[string]string
+func (_e *MockMetricsClient_Expecter) EmitDuration(ctx interface{}, name interface{}, duration interface{}, dimensions interface{}) *MockMetricsClient_EmitDuration_Call {
+	return &MockMetricsClient_EmitDuration_Call{Call: _e.mock.On("EmitDuration", ctx, name, duration, dimensions)}
+}
+
+func (_c *MockMetricsClient_EmitDuration_Call) Run(run func(ctx context.Context, name string, duration time.Duration, dimensions map[string]string)) *MockMetricsClient_EmitDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		var arg3 map[string]string
+		if args[3] != nil {
+			arg3 = args[3].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMetricsClient_EmitDuration_Call) Return(err error) *MockMetricsClient_EmitDuration_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMetricsClient_EmitDuration_Call) RunAndReturn(run func(ctx context.Context, name string, duration time.Duration, dimensions map[string]string) error) *MockMetricsClient_EmitDuration_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -111,28 +111,295 @@ func (_c *MockStore_AcquireIngestionLock_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
-// GetDriver provides a mock function for the type MockStore
-func (_mock *MockStore) GetDriver(ctx context.Context, driverID int64) (*store.Driver, error) {
+// AddIngestionCoverage provides a mock function for the type MockStore
+func (_mock *MockStore) AddIngestionCoverage(ctx context.Context, driverID int64, ranges ...store.TimeRange) error {
+	var tmpRet mock.Arguments
+	if len(ranges) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, ranges)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddIngestionCoverage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.TimeRange) error); ok {
+		r0 = returnFunc(ctx, driverID, ranges...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_AddIngestionCoverage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddIngestionCoverage'
+type MockStore_AddIngestionCoverage_Call struct {
+	*mock.Call
+}
+
+// AddIngestionCoverage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - ranges ...store.TimeRange
+func (_e *MockStore_Expecter) AddIngestionCoverage(ctx interface{}, driverID interface{}, ranges ...interface{}) *MockStore_AddIngestionCoverage_Call {
+	return &MockStore_AddIngestionCoverage_Call{Call: _e.mock.On("AddIngestionCoverage",
+		append([]interface{}{ctx, driverID}, ranges...)...)}
+}
+
+func (_c *MockStore_AddIngestionCoverage_Call) Run(run func(ctx context.Context, driverID int64, ranges ...store.TimeRange)) *MockStore_AddIngestionCoverage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 []store.TimeRange
+		var variadicArgs []store.TimeRange
+		if len(args) > 2 {
+			variadicArgs = args[2].([]store.TimeRange)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_AddIngestionCoverage_Call) Return(err error) *MockStore_AddIngestionCoverage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_AddIngestionCoverage_Call) RunAndReturn(run func(ctx context.Context, driverID int64, ranges ...store.TimeRange) error) *MockStore_AddIngestionCoverage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClearIngestionCancel provides a mock function for the type MockStore
+func (_mock *MockStore) ClearIngestionCancel(ctx context.Context, driverID int64) error {
+	ret := _mock.Called(ctx, driverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearIngestionCancel")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, driverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_ClearIngestionCancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearIngestionCancel'
+type MockStore_ClearIngestionCancel_Call struct {
+	*mock.Call
+}
+
+// ClearIngestionCancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+func (_e *MockStore_Expecter) ClearIngestionCancel(ctx interface{}, driverID interface{}) *MockStore_ClearIngestionCancel_Call {
+	return &MockStore_ClearIngestionCancel_Call{Call: _e.mock.On("ClearIngestionCancel", ctx, driverID)}
+}
+
+func (_c *MockStore_ClearIngestionCancel_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_ClearIngestionCancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ClearIngestionCancel_Call) Return(err error) *MockStore_ClearIngestionCancel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_ClearIngestionCancel_Call) RunAndReturn(run func(ctx context.Context, driverID int64) error) *MockStore_ClearIngestionCancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClearLapBackfillPending provides a mock function for the type MockStore
+func (_mock *MockStore) ClearLapBackfillPending(ctx context.Context, driverID int64) error {
 	ret := _mock.Called(ctx, driverID)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ClearLapBackfillPending")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, driverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_ClearLapBackfillPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearLapBackfillPending'
+type MockStore_ClearLapBackfillPending_Call struct {
+	*mock.Call
+}
+
+// ClearLapBackfillPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+func (_e *MockStore_Expecter) ClearLapBackfillPending(ctx interface{}, driverID interface{}) *MockStore_ClearLapBackfillPending_Call {
+	return &MockStore_ClearLapBackfillPending_Call{Call: _e.mock.On("ClearLapBackfillPending", ctx, driverID)}
+}
+
+func (_c *MockStore_ClearLapBackfillPending_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_ClearLapBackfillPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ClearLapBackfillPending_Call) Return(err error) *MockStore_ClearLapBackfillPending_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_ClearLapBackfillPending_Call) RunAndReturn(run func(ctx context.Context, driverID int64) error) *MockStore_ClearLapBackfillPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteDriverSessionLaps provides a mock function for the type MockStore
+func (_mock *MockStore) CompleteDriverSessionLaps(ctx context.Context, driverID int64, startTime time.Time, trafficCost *int, lapGaps int) error {
+	ret := _mock.Called(ctx, driverID, startTime, trafficCost, lapGaps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteDriverSessionLaps")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, *int, int) error); ok {
+		r0 = returnFunc(ctx, driverID, startTime, trafficCost, lapGaps)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_CompleteDriverSessionLaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteDriverSessionLaps'
+type MockStore_CompleteDriverSessionLaps_Call struct {
+	*mock.Call
+}
+
+// CompleteDriverSessionLaps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - startTime time.Time
+//   - trafficCost *int
+//   - lapGaps int
+func (_e *MockStore_Expecter) CompleteDriverSessionLaps(ctx interface{}, driverID interface{}, startTime interface{}, trafficCost interface{}, lapGaps interface{}) *MockStore_CompleteDriverSessionLaps_Call {
+	return &MockStore_CompleteDriverSessionLaps_Call{Call: _e.mock.On("CompleteDriverSessionLaps", ctx, driverID, startTime, trafficCost, lapGaps)}
+}
+
+func (_c *MockStore_CompleteDriverSessionLaps_Call) Run(run func(ctx context.Context, driverID int64, startTime time.Time, trafficCost *int, lapGaps int)) *MockStore_CompleteDriverSessionLaps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 *int
+		if args[3] != nil {
+			arg3 = args[3].(*int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_CompleteDriverSessionLaps_Call) Return(err error) *MockStore_CompleteDriverSessionLaps_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_CompleteDriverSessionLaps_Call) RunAndReturn(run func(ctx context.Context, driverID int64, startTime time.Time, trafficCost *int, lapGaps int) error) *MockStore_CompleteDriverSessionLaps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDriver provides a mock function for the type MockStore
+func (_mock *MockStore) GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID)
+	}
+	ret := tmpRet
+
 	if len(ret) == 0 {
 		panic("no return value specified for GetDriver")
 	}
 
 	var r0 *store.Driver
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*store.Driver, error)); ok {
-		return returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) (*store.Driver, error)); ok {
+		return returnFunc(ctx, driverID, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *store.Driver); ok {
-		r0 = returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) *store.Driver); ok {
+		r0 = returnFunc(ctx, driverID, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*store.Driver)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, driverID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -147,11 +414,13 @@ type MockStore_GetDriver_Call struct {
 // GetDriver is a helper method to define mock.On call
 //   - ctx context.Context
 //   - driverID int64
-func (_e *MockStore_Expecter) GetDriver(ctx interface{}, driverID interface{}) *MockStore_GetDriver_Call {
-	return &MockStore_GetDriver_Call{Call: _e.mock.On("GetDriver", ctx, driverID)}
+//   - opts ...store.ReadOption
+func (_e *MockStore_Expecter) GetDriver(ctx interface{}, driverID interface{}, opts ...interface{}) *MockStore_GetDriver_Call {
+	return &MockStore_GetDriver_Call{Call: _e.mock.On("GetDriver",
+		append([]interface{}{ctx, driverID}, opts...)...)}
 }
 
-func (_c *MockStore_GetDriver_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_GetDriver_Call {
+func (_c *MockStore_GetDriver_Call) Run(run func(ctx context.Context, driverID int64, opts ...store.ReadOption)) *MockStore_GetDriver_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -161,9 +430,16 @@ func (_c *MockStore_GetDriver_Call) Run(run func(ctx context.Context, driverID i
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]store.ReadOption)
+		}
+		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
+			arg2...,
 		)
 	})
 	return _c
@@ -174,14 +450,20 @@ func (_c *MockStore_GetDriver_Call) Return(driver *store.Driver, err error) *Moc
 	return _c
 }
 
-func (_c *MockStore_GetDriver_Call) RunAndReturn(run func(ctx context.Context, driverID int64) (*store.Driver, error)) *MockStore_GetDriver_Call {
+func (_c *MockStore_GetDriver_Call) RunAndReturn(run func(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)) *MockStore_GetDriver_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetDriverSession provides a mock function for the type MockStore
-func (_mock *MockStore) GetDriverSession(ctx context.Context, driverID int64, startTime time.Time) (*store.DriverSession, error) {
-	ret := _mock.Called(ctx, driverID, startTime)
+func (_mock *MockStore) GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, startTime, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID, startTime)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDriverSession")
@@ -189,18 +471,18 @@ func (_mock *MockStore) GetDriverSession(ctx context.Context, driverID int64, st
 
 	var r0 *store.DriverSession
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) (*store.DriverSession, error)); ok {
-		return returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, ...store.ReadOption) (*store.DriverSession, error)); ok {
+		return returnFunc(ctx, driverID, startTime, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) *store.DriverSession); ok {
-		r0 = returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, ...store.ReadOption) *store.DriverSession); ok {
+		r0 = returnFunc(ctx, driverID, startTime, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*store.DriverSession)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
-		r1 = returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, startTime, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,11 +498,13 @@ type MockStore_GetDriverSession_Call struct {
 //   - ctx context.Context
 //   - driverID int64
 //   - startTime time.Time
-func (_e *MockStore_Expecter) GetDriverSession(ctx interface{}, driverID interface{}, startTime interface{}) *MockStore_GetDriverSession_Call {
-	return &MockStore_GetDriverSession_Call{Call: _e.mock.On("GetDriverSession", ctx, driverID, startTime)}
+//   - opts ...store.ReadOption
+func (_e *MockStore_Expecter) GetDriverSession(ctx interface{}, driverID interface{}, startTime interface{}, opts ...interface{}) *MockStore_GetDriverSession_Call {
+	return &MockStore_GetDriverSession_Call{Call: _e.mock.On("GetDriverSession",
+		append([]interface{}{ctx, driverID, startTime}, opts...)...)}
 }
 
-func (_c *MockStore_GetDriverSession_Call) Run(run func(ctx context.Context, driverID int64, startTime time.Time)) *MockStore_GetDriverSession_Call {
+func (_c *MockStore_GetDriverSession_Call) Run(run func(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption)) *MockStore_GetDriverSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -234,10 +518,17 @@ func (_c *MockStore_GetDriverSession_Call) Run(run func(ctx context.Context, dri
 		if args[2] != nil {
 			arg2 = args[2].(time.Time)
 		}
+		var arg3 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 3 {
+			variadicArgs = args[3].([]store.ReadOption)
+		}
+		arg3 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3...,
 		)
 	})
 	return _c
@@ -248,41 +539,52 @@ func (_c *MockStore_GetDriverSession_Call) Return(driverSession *store.DriverSes
 	return _c
 }
 
-func (_c *MockStore_GetDriverSession_Call) RunAndReturn(run func(ctx context.Context, driverID int64, startTime time.Time) (*store.DriverSession, error)) *MockStore_GetDriverSession_Call {
+func (_c *MockStore_GetDriverSession_Call) RunAndReturn(run func(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)) *MockStore_GetDriverSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReleaseIngestionLock provides a mock function for the type MockStore
-func (_mock *MockStore) ReleaseIngestionLock(ctx context.Context, driverID int64) error {
+// GetDriverSessionsWithSkippedLaps provides a mock function for the type MockStore
+func (_mock *MockStore) GetDriverSessionsWithSkippedLaps(ctx context.Context, driverID int64) ([]store.DriverSession, error) {
 	ret := _mock.Called(ctx, driverID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReleaseIngestionLock")
+		panic("no return value specified for GetDriverSessionsWithSkippedLaps")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+	var r0 []store.DriverSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]store.DriverSession, error)); ok {
+		return returnFunc(ctx, driverID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []store.DriverSession); ok {
 		r0 = returnFunc(ctx, driverID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.DriverSession)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, driverID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_ReleaseIngestionLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseIngestionLock'
-type MockStore_ReleaseIngestionLock_Call struct {
+// MockStore_GetDriverSessionsWithSkippedLaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDriverSessionsWithSkippedLaps'
+type MockStore_GetDriverSessionsWithSkippedLaps_Call struct {
 	*mock.Call
 }
 
-// ReleaseIngestionLock is a helper method to define mock.On call
+// GetDriverSessionsWithSkippedLaps is a helper method to define mock.On call
 //   - ctx context.Context
 //   - driverID int64
-func (_e *MockStore_Expecter) ReleaseIngestionLock(ctx interface{}, driverID interface{}) *MockStore_ReleaseIngestionLock_Call {
-	return &MockStore_ReleaseIngestionLock_Call{Call: _e.mock.On("ReleaseIngestionLock", ctx, driverID)}
+func (_e *MockStore_Expecter) GetDriverSessionsWithSkippedLaps(ctx interface{}, driverID interface{}) *MockStore_GetDriverSessionsWithSkippedLaps_Call {
+	return &MockStore_GetDriverSessionsWithSkippedLaps_Call{Call: _e.mock.On("GetDriverSessionsWithSkippedLaps", ctx, driverID)}
 }
 
-func (_c *MockStore_ReleaseIngestionLock_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_ReleaseIngestionLock_Call {
+func (_c *MockStore_GetDriverSessionsWithSkippedLaps_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_GetDriverSessionsWithSkippedLaps_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -300,54 +602,65 @@ func (_c *MockStore_ReleaseIngestionLock_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockStore_ReleaseIngestionLock_Call) Return(err error) *MockStore_ReleaseIngestionLock_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_GetDriverSessionsWithSkippedLaps_Call) Return(driverSessions []store.DriverSession, err error) *MockStore_GetDriverSessionsWithSkippedLaps_Call {
+	_c.Call.Return(driverSessions, err)
 	return _c
 }
 
-func (_c *MockStore_ReleaseIngestionLock_Call) RunAndReturn(run func(ctx context.Context, driverID int64) error) *MockStore_ReleaseIngestionLock_Call {
+func (_c *MockStore_GetDriverSessionsWithSkippedLaps_Call) RunAndReturn(run func(ctx context.Context, driverID int64) ([]store.DriverSession, error)) *MockStore_GetDriverSessionsWithSkippedLaps_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveDriverSessions provides a mock function for the type MockStore
-func (_mock *MockStore) SaveDriverSessions(ctx context.Context, sessions []store.DriverSession) error {
-	ret := _mock.Called(ctx, sessions)
+// GetDriverSettings provides a mock function for the type MockStore
+func (_mock *MockStore) GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error) {
+	ret := _mock.Called(ctx, driverID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveDriverSessions")
+		panic("no return value specified for GetDriverSettings")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []store.DriverSession) error); ok {
-		r0 = returnFunc(ctx, sessions)
+	var r0 *store.DriverSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*store.DriverSettings, error)); ok {
+		return returnFunc(ctx, driverID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *store.DriverSettings); ok {
+		r0 = returnFunc(ctx, driverID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.DriverSettings)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, driverID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_SaveDriverSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDriverSessions'
-type MockStore_SaveDriverSessions_Call struct {
+// MockStore_GetDriverSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDriverSettings'
+type MockStore_GetDriverSettings_Call struct {
 	*mock.Call
 }
 
-// SaveDriverSessions is a helper method to define mock.On call
+// GetDriverSettings is a helper method to define mock.On call
 //   - ctx context.Context
-//   - sessions []store.DriverSession
-func (_e *MockStore_Expecter) SaveDriverSessions(ctx interface{}, sessions interface{}) *MockStore_SaveDriverSessions_Call {
-	return &MockStore_SaveDriverSessions_Call{Call: _e.mock.On("SaveDriverSessions", ctx, sessions)}
+//   - driverID int64
+func (_e *MockStore_Expecter) GetDriverSettings(ctx interface{}, driverID interface{}) *MockStore_GetDriverSettings_Call {
+	return &MockStore_GetDriverSettings_Call{Call: _e.mock.On("GetDriverSettings", ctx, driverID)}
 }
 
-func (_c *MockStore_SaveDriverSessions_Call) Run(run func(ctx context.Context, sessions []store.DriverSession)) *MockStore_SaveDriverSessions_Call {
+func (_c *MockStore_GetDriverSettings_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_GetDriverSettings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []store.DriverSession
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].([]store.DriverSession)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -357,12 +670,570 @@ func (_c *MockStore_SaveDriverSessions_Call) Run(run func(ctx context.Context, s
 	return _c
 }
 
-func (_c *MockStore_SaveDriverSessions_Call) Return(err error) *MockStore_SaveDriverSessions_Call {
+func (_c *MockStore_GetDriverSettings_Call) Return(driverSettings *store.DriverSettings, err error) *MockStore_GetDriverSettings_Call {
+	_c.Call.Return(driverSettings, err)
+	return _c
+}
+
+func (_c *MockStore_GetDriverSettings_Call) RunAndReturn(run func(ctx context.Context, driverID int64) (*store.DriverSettings, error)) *MockStore_GetDriverSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIngestionCoverage provides a mock function for the type MockStore
+func (_mock *MockStore) GetIngestionCoverage(ctx context.Context, driverID int64, opts ...store.ReadOption) (store.Coverage, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIngestionCoverage")
+	}
+
+	var r0 store.Coverage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) (store.Coverage, error)); ok {
+		return returnFunc(ctx, driverID, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, ...store.ReadOption) store.Coverage); ok {
+		r0 = returnFunc(ctx, driverID, opts...)
+	} else {
+		r0 = ret.Get(0).(store.Coverage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetIngestionCoverage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIngestionCoverage'
+type MockStore_GetIngestionCoverage_Call struct {
+	*mock.Call
+}
+
+// GetIngestionCoverage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - opts ...store.ReadOption
+func (_e *MockStore_Expecter) GetIngestionCoverage(ctx interface{}, driverID interface{}, opts ...interface{}) *MockStore_GetIngestionCoverage_Call {
+	return &MockStore_GetIngestionCoverage_Call{Call: _e.mock.On("GetIngestionCoverage",
+		append([]interface{}{ctx, driverID}, opts...)...)}
+}
+
+func (_c *MockStore_GetIngestionCoverage_Call) Run(run func(ctx context.Context, driverID int64, opts ...store.ReadOption)) *MockStore_GetIngestionCoverage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]store.ReadOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetIngestionCoverage_Call) Return(coverage store.Coverage, err error) *MockStore_GetIngestionCoverage_Call {
+	_c.Call.Return(coverage, err)
+	return _c
+}
+
+func (_c *MockStore_GetIngestionCoverage_Call) RunAndReturn(run func(ctx context.Context, driverID int64, opts ...store.ReadOption) (store.Coverage, error)) *MockStore_GetIngestionCoverage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestionCancelRequested provides a mock function for the type MockStore
+func (_mock *MockStore) IngestionCancelRequested(ctx context.Context, driverID int64) (bool, error) {
+	ret := _mock.Called(ctx, driverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IngestionCancelRequested")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (bool, error)); ok {
+		return returnFunc(ctx, driverID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) bool); ok {
+		r0 = returnFunc(ctx, driverID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, driverID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_IngestionCancelRequested_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestionCancelRequested'
+type MockStore_IngestionCancelRequested_Call struct {
+	*mock.Call
+}
+
+// IngestionCancelRequested is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+func (_e *MockStore_Expecter) IngestionCancelRequested(ctx interface{}, driverID interface{}) *MockStore_IngestionCancelRequested_Call {
+	return &MockStore_IngestionCancelRequested_Call{Call: _e.mock.On("IngestionCancelRequested", ctx, driverID)}
+}
+
+func (_c *MockStore_IngestionCancelRequested_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_IngestionCancelRequested_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_IngestionCancelRequested_Call) Return(b bool, err error) *MockStore_IngestionCancelRequested_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockStore_IngestionCancelRequested_Call) RunAndReturn(run func(ctx context.Context, driverID int64) (bool, error)) *MockStore_IngestionCancelRequested_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PersistSessionData provides a mock function for the type MockStore
+func (_mock *MockStore) PersistSessionData(ctx context.Context, session store.DriverSession, laps []store.SessionDriverLap) error {
+	ret := _mock.Called(ctx, session, laps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PersistSessionData")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.DriverSession, []store.SessionDriverLap) error); ok {
+		r0 = returnFunc(ctx, session, laps)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_PersistSessionData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersistSessionData'
+type MockStore_PersistSessionData_Call struct {
+	*mock.Call
+}
+
+// PersistSessionData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session store.DriverSession
+//   - laps []store.SessionDriverLap
+func (_e *MockStore_Expecter) PersistSessionData(ctx interface{}, session interface{}, laps interface{}) *MockStore_PersistSessionData_Call {
+	return &MockStore_PersistSessionData_Call{Call: _e.mock.On("PersistSessionData", ctx, session, laps)}
+}
+
+func (_c *MockStore_PersistSessionData_Call) Run(run func(ctx context.Context, session store.DriverSession, laps []store.SessionDriverLap)) *MockStore_PersistSessionData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.DriverSession
+		if args[1] != nil {
+			arg1 = args[1].(store.DriverSession)
+		}
+		var arg2 []store.SessionDriverLap
+		if args[2] != nil {
+			arg2 = args[2].([]store.SessionDriverLap)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_PersistSessionData_Call) Return(err error) *MockStore_PersistSessionData_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_PersistSessionData_Call) RunAndReturn(run func(ctx context.Context, session store.DriverSession, laps []store.SessionDriverLap) error) *MockStore_PersistSessionData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseIngestionLock provides a mock function for the type MockStore
+func (_mock *MockStore) ReleaseIngestionLock(ctx context.Context, driverID int64) error {
+	ret := _mock.Called(ctx, driverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseIngestionLock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, driverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_ReleaseIngestionLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseIngestionLock'
+type MockStore_ReleaseIngestionLock_Call struct {
+	*mock.Call
+}
+
+// ReleaseIngestionLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+func (_e *MockStore_Expecter) ReleaseIngestionLock(ctx interface{}, driverID interface{}) *MockStore_ReleaseIngestionLock_Call {
+	return &MockStore_ReleaseIngestionLock_Call{Call: _e.mock.On("ReleaseIngestionLock", ctx, driverID)}
+}
+
+func (_c *MockStore_ReleaseIngestionLock_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_ReleaseIngestionLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ReleaseIngestionLock_Call) Return(err error) *MockStore_ReleaseIngestionLock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_ReleaseIngestionLock_Call) RunAndReturn(run func(ctx context.Context, driverID int64) error) *MockStore_ReleaseIngestionLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDriverSettings provides a mock function for the type MockStore
+func (_mock *MockStore) SaveDriverSettings(ctx context.Context, settings store.DriverSettings) error {
+	ret := _mock.Called(ctx, settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDriverSettings")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.DriverSettings) error); ok {
+		r0 = returnFunc(ctx, settings)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_SaveDriverSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDriverSettings'
+type MockStore_SaveDriverSettings_Call struct {
+	*mock.Call
+}
+
+// SaveDriverSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settings store.DriverSettings
+func (_e *MockStore_Expecter) SaveDriverSettings(ctx interface{}, settings interface{}) *MockStore_SaveDriverSettings_Call {
+	return &MockStore_SaveDriverSettings_Call{Call: _e.mock.On("SaveDriverSettings", ctx, settings)}
+}
+
+func (_c *MockStore_SaveDriverSettings_Call) Run(run func(ctx context.Context, settings store.DriverSettings)) *MockStore_SaveDriverSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.DriverSettings
+		if args[1] != nil {
+			arg1 = args[1].(store.DriverSettings)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_SaveDriverSettings_Call) Return(err error) *MockStore_SaveDriverSettings_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_SaveDriverSettings_Call) RunAndReturn(run func(ctx context.Context, settings store.DriverSettings) error) *MockStore_SaveDriverSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveIngestionRun provides a mock function for the type MockStore
+func (_mock *MockStore) SaveIngestionRun(ctx context.Context, run store.IngestionRun) error {
+	ret := _mock.Called(ctx, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIngestionRun")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.IngestionRun) error); ok {
+		r0 = returnFunc(ctx, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_SaveIngestionRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIngestionRun'
+type MockStore_SaveIngestionRun_Call struct {
+	*mock.Call
+}
+
+// SaveIngestionRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - run store.IngestionRun
+func (_e *MockStore_Expecter) SaveIngestionRun(ctx interface{}, run interface{}) *MockStore_SaveIngestionRun_Call {
+	return &MockStore_SaveIngestionRun_Call{Call: _e.mock.On("SaveIngestionRun", ctx, run)}
+}
+
+func (_c *MockStore_SaveIngestionRun_Call) Run(run func(ctx context.Context, run store.IngestionRun)) *MockStore_SaveIngestionRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.IngestionRun
+		if args[1] != nil {
+			arg1 = args[1].(store.IngestionRun)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_SaveIngestionRun_Call) Return(err error) *MockStore_SaveIngestionRun_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_SaveIngestionRun_Call) RunAndReturn(run func(ctx context.Context, run store.IngestionRun) error) *MockStore_SaveIngestionRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveJournalPrompt provides a mock function for the type MockStore
+func (_mock *MockStore) SaveJournalPrompt(ctx context.Context, prompt store.JournalPrompt) error {
+	ret := _mock.Called(ctx, prompt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveJournalPrompt")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.JournalPrompt) error); ok {
+		r0 = returnFunc(ctx, prompt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_SaveJournalPrompt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveJournalPrompt'
+type MockStore_SaveJournalPrompt_Call struct {
+	*mock.Call
+}
+
+// SaveJournalPrompt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prompt store.JournalPrompt
+func (_e *MockStore_Expecter) SaveJournalPrompt(ctx interface{}, prompt interface{}) *MockStore_SaveJournalPrompt_Call {
+	return &MockStore_SaveJournalPrompt_Call{Call: _e.mock.On("SaveJournalPrompt", ctx, prompt)}
+}
+
+func (_c *MockStore_SaveJournalPrompt_Call) Run(run func(ctx context.Context, prompt store.JournalPrompt)) *MockStore_SaveJournalPrompt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.JournalPrompt
+		if args[1] != nil {
+			arg1 = args[1].(store.JournalPrompt)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_SaveJournalPrompt_Call) Return(err error) *MockStore_SaveJournalPrompt_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_SaveJournalPrompt_Call) RunAndReturn(run func(ctx context.Context, prompt store.JournalPrompt) error) *MockStore_SaveJournalPrompt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSessionDriverLaps provides a mock function for the type MockStore
+func (_mock *MockStore) SaveSessionDriverLaps(ctx context.Context, laps []store.SessionDriverLap) error {
+	ret := _mock.Called(ctx, laps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSessionDriverLaps")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []store.SessionDriverLap) error); ok {
+		r0 = returnFunc(ctx, laps)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_SaveSessionDriverLaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSessionDriverLaps'
+type MockStore_SaveSessionDriverLaps_Call struct {
+	*mock.Call
+}
+
+// SaveSessionDriverLaps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - laps []store.SessionDriverLap
+func (_e *MockStore_Expecter) SaveSessionDriverLaps(ctx interface{}, laps interface{}) *MockStore_SaveSessionDriverLaps_Call {
+	return &MockStore_SaveSessionDriverLaps_Call{Call: _e.mock.On("SaveSessionDriverLaps", ctx, laps)}
+}
+
+func (_c *MockStore_SaveSessionDriverLaps_Call) Run(run func(ctx context.Context, laps []store.SessionDriverLap)) *MockStore_SaveSessionDriverLaps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []store.SessionDriverLap
+		if args[1] != nil {
+			arg1 = args[1].([]store.SessionDriverLap)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_SaveSessionDriverLaps_Call) Return(err error) *MockStore_SaveSessionDriverLaps_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_SaveSessionDriverLaps_Call) RunAndReturn(run func(ctx context.Context, laps []store.SessionDriverLap) error) *MockStore_SaveSessionDriverLaps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDriverRacesIngestedFrom provides a mock function for the type MockStore
+func (_mock *MockStore) UpdateDriverRacesIngestedFrom(ctx context.Context, driverID int64, racesIngestedFrom time.Time) error {
+	ret := _mock.Called(ctx, driverID, racesIngestedFrom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDriverRacesIngestedFrom")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = returnFunc(ctx, driverID, racesIngestedFrom)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_UpdateDriverRacesIngestedFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDriverRacesIngestedFrom'
+type MockStore_UpdateDriverRacesIngestedFrom_Call struct {
+	*mock.Call
+}
+
+// UpdateDriverRacesIngestedFrom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - racesIngestedFrom time.Time
+func (_e *MockStore_Expecter) UpdateDriverRacesIngestedFrom(ctx interface{}, driverID interface{}, racesIngestedFrom interface{}) *MockStore_UpdateDriverRacesIngestedFrom_Call {
+	return &MockStore_UpdateDriverRacesIngestedFrom_Call{Call: _e.mock.On("UpdateDriverRacesIngestedFrom", ctx, driverID, racesIngestedFrom)}
+}
+
+func (_c *MockStore_UpdateDriverRacesIngestedFrom_Call) Run(run func(ctx context.Context, driverID int64, racesIngestedFrom time.Time)) *MockStore_UpdateDriverRacesIngestedFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdateDriverRacesIngestedFrom_Call) Return(err error) *MockStore_UpdateDriverRacesIngestedFrom_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_SaveDriverSessions_Call) RunAndReturn(run func(ctx context.Context, sessions []store.DriverSession) error) *MockStore_SaveDriverSessions_Call {
+func (_c *MockStore_UpdateDriverRacesIngestedFrom_Call) RunAndReturn(run func(ctx context.Context, driverID int64, racesIngestedFrom time.Time) error) *MockStore_UpdateDriverRacesIngestedFrom_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,7 +1,36 @@
 package ingestion
 
+// Priority decides which queue an ingestion request waits in, so background work never holds up a driver watching
+// their races come in.
+type Priority string
+
+const (
+	PriorityInteractive Priority = "interactive"
+	PriorityBackground  Priority = "background"
+)
+
 type RaceIngestionRequest struct {
 	DriverID           int64  `json:"driverID"`
 	IRacingAccessToken string `json:"iRacingAccessToken"`
 	NotifyConnectionID string `json:"notifyConnectionID"`
+	// TenantID is the tenant the driver is ingesting for, carried over to the rounds that follow.
+	TenantID string `json:"tenantID,omitempty"`
+	// Backwards rounds walk history from the driver's ingested from cursor towards when they joined.
+	Backwards bool `json:"backwards,omitempty"`
+	// DryRun rounds read everything a real round would but write nothing, reporting what they would have written to
+	// the notify connection instead.
+	DryRun bool `json:"dryRun,omitempty"`
+	// Capture has each round save everything it read so the round can be replayed in development, carried over to
+	// the rounds that follow.
+	Capture bool `json:"capture,omitempty"`
+}
+
+// Priority is interactive when a driver is waiting on the request, which means they have a connection to be notified
+// on and the request is bringing in recent races. Filling in older history is background work even with someone
+// watching, they already have their latest races by then.
+func (r RaceIngestionRequest) Priority() Priority {
+	if r.NotifyConnectionID == "" || r.Backwards {
+		return PriorityBackground
+	}
+	return PriorityInteractive
 }
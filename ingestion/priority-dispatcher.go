@@ -0,0 +1,28 @@
+package ingestion
+
+import (
+	"context"
+
+	"github.com/jonsabados/saturdaysspinout/event"
+)
+
+// PriorityDispatcher sends each RaceIngestionRequest to the dispatcher for its Priority. Anything else goes to the
+// interactive dispatcher.
+type PriorityDispatcher struct {
+	interactive EventDispatcher
+	background  EventDispatcher
+}
+
+func NewPriorityDispatcher(interactive, background EventDispatcher) *PriorityDispatcher {
+	return &PriorityDispatcher{
+		interactive: interactive,
+		background:  background,
+	}
+}
+
+func (d *PriorityDispatcher) PublishEvent(ctx context.Context, evt any, opts ...event.PublishOption) error {
+	if request, ok := evt.(RaceIngestionRequest); ok && request.Priority() == PriorityBackground {
+		return d.background.PublishEvent(ctx, evt, opts...)
+	}
+	return d.interactive.PublishEvent(ctx, evt, opts...)
+}
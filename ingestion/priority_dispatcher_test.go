@@ -0,0 +1,65 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPriorityDispatcher_PublishEvent(t *testing.T) {
+	testCases := []struct {
+		name             string
+		event            any
+		expectBackground bool
+		publishErr       error
+		expectedErr      error
+	}{
+		{
+			name:  "driver waiting on recent races",
+			event: RaceIngestionRequest{DriverID: 12345, NotifyConnectionID: "conn-123"},
+		},
+		{
+			name:             "nobody waiting",
+			event:            RaceIngestionRequest{DriverID: 12345},
+			expectBackground: true,
+		},
+		{
+			name:             "filling in history",
+			event:            RaceIngestionRequest{DriverID: 12345, NotifyConnectionID: "conn-123", Backwards: true},
+			expectBackground: true,
+		},
+		{
+			name:  "other events",
+			event: RaceReadyMsg{RaceID: 99999},
+		},
+		{
+			name:        "publish error",
+			event:       RaceIngestionRequest{DriverID: 12345, NotifyConnectionID: "conn-123"},
+			publishErr:  errors.New("boom"),
+			expectedErr: errors.New("boom"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			opts := []event.PublishOption{event.WithDelay(time.Minute)}
+			interactive := NewMockEventDispatcher(t)
+			background := NewMockEventDispatcher(t)
+			expected := interactive
+			if tc.expectBackground {
+				expected = background
+			}
+			expected.EXPECT().PublishEvent(mock.Anything, tc.event, opts).Return(tc.publishErr)
+
+			dispatcher := NewPriorityDispatcher(interactive, background)
+			err := dispatcher.PublishEvent(ctx, tc.event, opts...)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
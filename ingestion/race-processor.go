@@ -4,42 +4,78 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-xray-sdk-go/v2/xray"
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/event"
 	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/lapevents"
 	"github.com/jonsabados/saturdaysspinout/metrics"
 	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
 	"github.com/rs/zerolog"
 )
 
 const DefaultRaceConsumptionConcurrency = 2
+const DefaultLapConsumptionConcurrency = 4
+const DefaultLapBackfillBatchSize = 25
+const DefaultBootstrapWindowInDays = 30
+const DefaultRoundRateCost = 20
 
 const mainEventSessionNumber = 0
 const actionIngestionFailedStaleCredentials = "ingestionFailedStaleCredentials"
+const actionIngestionCancelled = "ingestionCancelled"
 const broadcastThreshold = time.Hour * 24 * 30
 
+// maintenanceRetryDelay is how long to hold a round back when iRacing is down for maintenance and nothing has said when
+// it is expected back. Maintenance usually runs well past this, so a round may be deferred several times.
+const maintenanceRetryDelay = 15 * time.Minute
+
+// rateLimitRetryDelay is how long to hold a round back when iRacing rate limited it without saying when the limit resets
+const rateLimitRetryDelay = time.Minute
+
+// errIngestionCancelled stops a round when the driver has asked for their ingestion to stop.
+var errIngestionCancelled = errors.New("ingestion cancelled")
+
 type RaceReadyMsg struct {
 	RaceID int64 `json:"raceId"`
 }
 
 type ChunkCompleteMsg struct {
-	IngestedTo time.Time `json:"ingestedTo"`
+	IngestedFrom time.Time `json:"ingestedFrom"`
+	IngestedTo   time.Time `json:"ingestedTo"`
 }
 
 type Store interface {
-	GetDriver(ctx context.Context, driverID int64) (*store.Driver, error)
-	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time) (*store.DriverSession, error)
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)
+	GetIngestionCoverage(ctx context.Context, driverID int64, opts ...store.ReadOption) (store.Coverage, error)
+	AddIngestionCoverage(ctx context.Context, driverID int64, ranges ...store.TimeRange) error
+	UpdateDriverRacesIngestedFrom(ctx context.Context, driverID int64, racesIngestedFrom time.Time) error
 	UpdateDriverRacesIngestedTo(ctx context.Context, driverID int64, racesIngestedTo time.Time) error
-	SaveDriverSessions(ctx context.Context, sessions []store.DriverSession) error
+	PersistSessionData(ctx context.Context, session store.DriverSession, laps []store.SessionDriverLap) error
 	AcquireIngestionLock(ctx context.Context, driverID int64, lockDuration time.Duration) (bool, error)
 	ReleaseIngestionLock(ctx context.Context, driverID int64) error
+	SaveSessionDriverLaps(ctx context.Context, laps []store.SessionDriverLap) error
+	GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error)
+	GetDriverSessionsWithSkippedLaps(ctx context.Context, driverID int64) ([]store.DriverSession, error)
+	CompleteDriverSessionLaps(ctx context.Context, driverID int64, startTime time.Time, trafficCost *int, lapGaps int) error
+	ClearLapBackfillPending(ctx context.Context, driverID int64) error
+	SaveDriverSettings(ctx context.Context, settings store.DriverSettings) error
+	SaveIngestionRun(ctx context.Context, run store.IngestionRun) error
+	IngestionCancelRequested(ctx context.Context, driverID int64) (bool, error)
+	ClearIngestionCancel(ctx context.Context, driverID int64) error
+	SaveJournalPrompt(ctx context.Context, prompt store.JournalPrompt) error
 }
 
 type IRacingClient interface {
 	SearchSeriesResults(ctx context.Context, accessToken string, finishRangeBegin, finishRangeEnd time.Time, opts ...iracing.SearchOption) ([]iracing.SeriesResult, error)
 	GetSessionResults(ctx context.Context, accessToken string, subsessionID int64, opts ...iracing.GetSessionResultsOption) (*iracing.SessionResult, error)
+	GetLapData(ctx context.Context, accessToken string, subsessionID int64, simsessionNumber int, opts ...iracing.GetLapDataOption) (*iracing.LapDataResponse, error)
+	GetLapChartData(ctx context.Context, accessToken string, subsessionID int64, simsessionNumber int) (*iracing.LapChartResponse, error)
 }
 
 type Pusher interface {
@@ -48,11 +84,49 @@ type Pusher interface {
 }
 
 type EventDispatcher interface {
-	PublishEvent(ctx context.Context, event any) error
+	PublishEvent(ctx context.Context, evt any, opts ...event.PublishOption) error
 }
 
 type MetricsClient interface {
 	EmitCount(ctx context.Context, name string, count int) error
+	EmitDuration(ctx context.Context, name string, duration time.Duration, dimensions map[string]string) error
+}
+
+type StandingsSnapshotter interface {
+	SnapshotDriverStanding(ctx context.Context, accessToken string, driverID int64) error
+}
+
+type AlertEvaluator interface {
+	EvaluateDriver(ctx context.Context, driverID int64) error
+}
+
+type SeasonCardRefresher interface {
+	RefreshDriver(ctx context.Context, driverID int64) error
+}
+
+type OverlayRefresher interface {
+	RefreshDriver(ctx context.Context, driverID int64) error
+}
+
+type SquadEventTagger interface {
+	TagSession(ctx context.Context, session store.DriverSession) error
+}
+
+type RacePlanLinker interface {
+	LinkSession(ctx context.Context, session store.DriverSession) (*store.PlanEvaluation, error)
+}
+
+type OnboardingTracker interface {
+	Advance(ctx context.Context, driverID int64, step store.OnboardingStep) error
+}
+
+type RateBudget interface {
+	Reserve(ctx context.Context, driverID int64, cost int) (time.Duration, error)
+}
+
+// UpstreamAvailability says when iRacing is expected back from maintenance, the zero time when it isn't known to be down
+type UpstreamAvailability interface {
+	DownUntil(ctx context.Context) (time.Time, error)
 }
 
 type RaceProcessorOption func(*RaceProcessor)
@@ -63,21 +137,152 @@ func WithSearchWindowInDays(days int) RaceProcessorOption {
 	}
 }
 
+// WithBootstrapWindowInDays sets how far back a driver's first ingestion reaches. Recent races are ingested first so
+// there is something to show right away, older history is filled in by backwards rounds once caught up.
+func WithBootstrapWindowInDays(days int) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.bootstrapWindowDuration = time.Hour * 24 * time.Duration(days)
+	}
+}
+
 func WithRaceConsumptionConcurrency(n int) RaceProcessorOption {
 	return func(r *RaceProcessor) {
 		r.raceConsumptionConcurrency = n
 	}
 }
 
+// WithLapConsumptionConcurrency bounds how many sessions have their lap data pulled and persisted in parallel.
+func WithLapConsumptionConcurrency(n int) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.lapConsumptionConcurrency = n
+	}
+}
+
+// WithStandingsSnapshotter snapshots the driver's division standing once ingestion has caught up. Ingestion runs are
+// the only time we hold the driver's iRacing token outside a request, so this is where the weekly snapshot is taken,
+// and weeks the driver didn't sync are filled in by the snapshotter when they next do.
+func WithStandingsSnapshotter(snapshotter StandingsSnapshotter) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.standingsSnapshotter = snapshotter
+	}
+}
+
+// WithAlertEvaluator checks the driver's trend alert rules once ingestion has caught up, so rules see every race
+// from the run rather than being checked part way through a backfill.
+func WithAlertEvaluator(evaluator AlertEvaluator) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.alertEvaluator = evaluator
+	}
+}
+
+// WithSeasonCardRefresher retakes the snapshot of the driver's shared season card once ingestion has caught up, so the
+// card reflects every race from the run.
+func WithSeasonCardRefresher(refresher SeasonCardRefresher) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.seasonCardRefresher = refresher
+	}
+}
+
+// WithOverlayRefresher retakes the snapshot of the driver's stream overlay once ingestion has caught up, pushing it to
+// any overlay subscribed over the WebSocket API.
+func WithOverlayRefresher(refresher OverlayRefresher) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.overlayRefresher = refresher
+	}
+}
+
+// WithSquadEventTagger tags each persisted session as an event of any squad the driver is in that marked it as one,
+// recording their finish in the squad's event results.
+func WithSquadEventTagger(tagger SquadEventTagger) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.squadEventTagger = tagger
+	}
+}
+
+// WithRacePlanLinker links each persisted session to the driver's plan for its series, track and race week, if they
+// wrote one.
+func WithRacePlanLinker(linker RacePlanLinker) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.racePlanLinker = linker
+	}
+}
+
+// WithOnboardingTracker marks the driver's ingestion as started in their onboarding whenever a run gets going.
+func WithOnboardingTracker(tracker OnboardingTracker) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.onboardingTracker = tracker
+	}
+}
+
+// WithRateBudget has each round reserve roundCost iRacing requests from a budget shared with every other ingestion
+// before it starts. Rounds that can't get their cost are handed back to the queue to run once budget frees up, so a
+// crowd of drivers syncing at once slows down rather than running into the rate limit.
+func WithRateBudget(budget RateBudget, roundCost int) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.rateBudget = budget
+		r.roundRateCost = roundCost
+	}
+}
+
+// WithUpstreamAvailability has each round check iRacing isn't down for maintenance before it starts. Rounds are handed
+// back to the queue to run once it is expected back, rather than each spending a request to find out.
+func WithUpstreamAvailability(availability UpstreamAvailability) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.upstreamAvailability = availability
+	}
+}
+
+// WithIngestionTiers has each round look up the driver's ingestion tier when it starts, letting the tier adjust the
+// search window, concurrency and whether laps are pulled.
+func WithIngestionTiers(source IngestionTierSource) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.tierSource = source
+	}
+}
+
+// WithLapBackfillBatchSize caps how many sessions have their laps backfilled per ingestion round, remaining sessions
+// are picked up by another round.
+func WithLapBackfillBatchSize(n int) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.lapBackfillBatchSize = n
+	}
+}
+
+// WithLapInterpolation fills gaps in a driver's lap data with synthetic placeholder laps when enabled. Gaps are counted
+// on the session either way.
+func WithLapInterpolation(enabled bool) RaceProcessorOption {
+	return func(r *RaceProcessor) {
+		r.interpolateLaps = enabled
+	}
+}
+
 type RaceProcessor struct {
 	store                      Store
 	iracingClient              IRacingClient
 	searchWindowDuration       time.Duration
+	bootstrapWindowDuration    time.Duration
 	pusher                     Pusher
 	eventDispatcher            EventDispatcher
 	metricsClient              MetricsClient
 	raceConsumptionConcurrency int
+	lapConsumptionConcurrency  int
+	lapBackfillBatchSize       int
+	interpolateLaps            bool
 	lockDuration               time.Duration
+	standingsSnapshotter       StandingsSnapshotter
+	alertEvaluator             AlertEvaluator
+	seasonCardRefresher        SeasonCardRefresher
+	overlayRefresher           OverlayRefresher
+	onboardingTracker          OnboardingTracker
+	squadEventTagger           SquadEventTagger
+	racePlanLinker             RacePlanLinker
+	rateBudget                 RateBudget
+	upstreamAvailability       UpstreamAvailability
+	roundRateCost              int
+	tierSource                 IngestionTierSource
+	captureStore               CaptureStore
+	sessionArchiver            SessionArchiver
+	newCaptureID               func() string
 	now                        func() time.Time
 }
 
@@ -89,7 +294,11 @@ func NewRaceProcessor(store Store, iracingClient IRacingClient, pusher Pusher, e
 		eventDispatcher:            eventDispatcher,
 		metricsClient:              metricsClient,
 		searchWindowDuration:       time.Hour * 24 * 10,
+		bootstrapWindowDuration:    time.Hour * 24 * DefaultBootstrapWindowInDays,
 		raceConsumptionConcurrency: DefaultRaceConsumptionConcurrency,
+		lapConsumptionConcurrency:  DefaultLapConsumptionConcurrency,
+		lapBackfillBatchSize:       DefaultLapBackfillBatchSize,
+		roundRateCost:              DefaultRoundRateCost,
 		lockDuration:               lockDuration,
 		now:                        time.Now,
 	}
@@ -100,8 +309,13 @@ func NewRaceProcessor(store Store, iracingClient IRacingClient, pusher Pusher, e
 }
 
 func (r *RaceProcessor) IngestRaces(ctx context.Context, request RaceIngestionRequest) error {
+	ctx = tenant.WithID(ctx, request.TenantID)
 	logger := zerolog.Ctx(ctx)
 
+	if request.DryRun {
+		return r.dryRun(ctx, request)
+	}
+
 	acquired, err := r.store.AcquireIngestionLock(ctx, request.DriverID, r.lockDuration)
 	if err != nil {
 		return fmt.Errorf("acquiring ingestion lock: %w", err)
@@ -111,7 +325,36 @@ func (r *RaceProcessor) IngestRaces(ctx context.Context, request RaceIngestionRe
 		return nil
 	}
 
-	needsRecursion, err := r.doIngestRaces(ctx, request)
+	if r.upstreamAvailability != nil {
+		downUntil, err := r.upstreamAvailability.DownUntil(ctx)
+		if err != nil {
+			// the round finds out for itself when iRacing is down, so carry on without knowing
+			logger.Err(err).Int64("driverID", request.DriverID).Msg("failed to check iRacing availability")
+		} else if !downUntil.IsZero() {
+			return r.deferRound(ctx, request, max(downUntil.Sub(r.now()), time.Second), "iRacing down for maintenance")
+		}
+	}
+
+	if r.rateBudget != nil {
+		retryAfter, err := r.rateBudget.Reserve(ctx, request.DriverID, r.roundRateCost)
+		if err != nil {
+			// the budget only keeps us clear of the rate limit, iRacing still enforces it, so carry on without it
+			logger.Err(err).Int64("driverID", request.DriverID).Msg("failed to reserve rate budget")
+		} else if retryAfter > 0 {
+			return r.deferRound(ctx, request, retryAfter, "rate budget exhausted")
+		}
+	}
+
+	if r.onboardingTracker != nil {
+		// onboarding only drives the frontend checklist, don't fail ingestion over it
+		if err := r.onboardingTracker.Advance(ctx, request.DriverID, store.OnboardingStepIngestionStarted); err != nil {
+			logger.Err(err).Int64("driverID", request.DriverID).Msg("failed to advance onboarding")
+		}
+	}
+
+	stats := newRunStats(r.now())
+	next, err := r.runRound(ctx, request, stats)
+	r.recordRun(ctx, request.DriverID, stats, err)
 	if err != nil {
 		// Release lock so SQS backoff can handle retry (or client can retry immediately for stale credentials)
 		if releaseErr := r.store.ReleaseIngestionLock(ctx, request.DriverID); releaseErr != nil {
@@ -121,69 +364,206 @@ func (r *RaceProcessor) IngestRaces(ctx context.Context, request RaceIngestionRe
 			r.notifyStaleCredentials(ctx, request.NotifyConnectionID)
 			return nil
 		}
+		if errors.Is(err, errIngestionCancelled) {
+			r.acknowledgeCancel(ctx, request.DriverID)
+			return nil
+		}
+		if delay, ok := r.upstreamRetryDelay(err); ok {
+			// retrying through SQS backoff would only spend the message's receives while iRacing is unavailable
+			logger.Warn().Err(err).Int64("driverID", request.DriverID).Dur("retryAfter", delay).Msg("iRacing unavailable, deferring ingestion round")
+			if err := r.eventDispatcher.PublishEvent(ctx, request, event.WithDelay(delay)); err != nil {
+				return fmt.Errorf("deferring ingestion round: %w", err)
+			}
+			return nil
+		}
 		return err
 	}
 
-	if needsRecursion {
+	if next != nil {
 		if err := r.store.ReleaseIngestionLock(ctx, request.DriverID); err != nil {
 			return fmt.Errorf("releasing ingestion lock: %w", err)
 		}
-		logger.Info().Msg("more races to ingest, dispatching another round")
-		if err := r.eventDispatcher.PublishEvent(ctx, request); err != nil {
+		logger.Info().Bool("backwards", next.Backwards).Msg("more races to ingest, dispatching another round")
+		if err := r.eventDispatcher.PublishEvent(ctx, *next); err != nil {
 			return fmt.Errorf("dispatching next ingestion round: %w", err)
 		}
+		return nil
 	}
 	// If up to date, let the lock expire naturally (cooldown period)
 
+	if r.standingsSnapshotter != nil {
+		// standings are a nice to have, don't fail ingestion over them
+		if err := r.standingsSnapshotter.SnapshotDriverStanding(ctx, request.IRacingAccessToken, request.DriverID); err != nil {
+			logger.Err(err).Int64("driverID", request.DriverID).Msg("failed to snapshot driver standing")
+		}
+	}
+
+	if r.alertEvaluator != nil {
+		if err := r.alertEvaluator.EvaluateDriver(ctx, request.DriverID); err != nil {
+			logger.Err(err).Int64("driverID", request.DriverID).Msg("failed to evaluate trend alerts")
+		}
+	}
+
+	if r.seasonCardRefresher != nil {
+		if err := r.seasonCardRefresher.RefreshDriver(ctx, request.DriverID); err != nil {
+			logger.Err(err).Int64("driverID", request.DriverID).Msg("failed to refresh season card")
+		}
+	}
+
+	if r.overlayRefresher != nil {
+		if err := r.overlayRefresher.RefreshDriver(ctx, request.DriverID); err != nil {
+			logger.Err(err).Int64("driverID", request.DriverID).Msg("failed to refresh overlay")
+		}
+	}
+
+	return nil
+}
+
+// upstreamRetryDelay returns how long to hold a round back when err means iRacing won't serve it for a while, false
+// for errors a retry might get past straight away.
+func (r *RaceProcessor) upstreamRetryDelay(err error) (time.Duration, bool) {
+	switch {
+	case errors.Is(err, iracing.ErrRateLimited):
+		if resetAt, ok := iracing.RateLimitResetAt(err); ok {
+			return max(resetAt.Sub(r.now()), time.Second), true
+		}
+		return rateLimitRetryDelay, true
+	case errors.Is(err, iracing.ErrMaintenance):
+		if until, ok := iracing.MaintenanceUntil(err); ok {
+			return max(until.Sub(r.now()), time.Second), true
+		}
+		return maintenanceRetryDelay, true
+	}
+	return 0, false
+}
+
+// deferRound hands the round back to the queue to be picked up again after delay. Nothing was ingested, so it isn't
+// recorded as a run.
+func (r *RaceProcessor) deferRound(ctx context.Context, request RaceIngestionRequest, delay time.Duration, reason string) error {
+	if err := r.store.ReleaseIngestionLock(ctx, request.DriverID); err != nil {
+		return fmt.Errorf("releasing ingestion lock: %w", err)
+	}
+	zerolog.Ctx(ctx).Info().Int64("driverID", request.DriverID).Dur("retryAfter", delay).Str("reason", reason).Msg("deferring ingestion round")
+	if err := r.eventDispatcher.PublishEvent(ctx, request, event.WithDelay(delay)); err != nil {
+		return fmt.Errorf("deferring ingestion round: %w", err)
+	}
 	return nil
 }
 
-func (r *RaceProcessor) doIngestRaces(ctx context.Context, request RaceIngestionRequest) (needsRecursion bool, err error) {
+func (r *RaceProcessor) doIngestRaces(ctx context.Context, request RaceIngestionRequest, stats *runStats) (next *RaceIngestionRequest, err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	logger := zerolog.Ctx(ctx)
 
-	driver, err := r.store.GetDriver(ctx, request.DriverID)
+	// rounds already queued when the driver cancelled go no further
+	if r.cancelRequested(ctx, request.DriverID) {
+		return nil, errIngestionCancelled
+	}
+
+	// follow-up rounds are dispatched right after the previous round records how far it got
+	driver, err := r.store.GetDriver(ctx, request.DriverID, store.ConsistentRead())
 	if err != nil {
-		return false, fmt.Errorf("getting driver: %w", err)
+		return nil, fmt.Errorf("getting driver: %w", err)
 	}
 	if driver == nil {
-		return false, fmt.Errorf("driver %d not found", request.DriverID)
+		return nil, fmt.Errorf("driver %d not found", request.DriverID)
+	}
+
+	settings, err := r.store.GetDriverSettings(ctx, request.DriverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting driver settings: %w", err)
 	}
 
-	rangeBegin := driver.MemberSince
-	if driver.RacesIngestedTo != nil {
-		rangeBegin = *driver.RacesIngestedTo
-		// give bit of a buffer, if ingestion is triggered after exiting a session its possible results
-		// will not be ready & then the user is stuck with missing races
-		rangeBegin = rangeBegin.Add(-time.Hour * 4)
+	stored, err := r.store.GetIngestionCoverage(ctx, request.DriverID, store.ConsistentRead())
+	if err != nil {
+		return nil, fmt.Errorf("getting ingestion coverage: %w", err)
+	}
+	coverage := DriverCoverage(*driver, stored)
+
+	cfg := r.runConfigFor(ctx, driver)
+	if cfg.lapsDisabled && !settings.SummaryOnlyIngestion {
+		// a tier without laps is ingested as if the driver chose summary only, with a backfill left pending so the laps
+		// are pulled if the driver's tier comes to allow them
+		if !settings.LapBackfillPending {
+			pending := *settings
+			pending.LapBackfillPending = true
+			if err := r.store.SaveDriverSettings(ctx, pending); err != nil {
+				return nil, fmt.Errorf("marking lap backfill pending: %w", err)
+			}
+		}
+		summaryOnly := *settings
+		summaryOnly.SummaryOnlyIngestion = true
+		settings = &summaryOnly
 	}
 
+	// drivers ingested before history was walked backwards were ingested forwards from when they joined
+	ingestedFrom := driver.MemberSince
+	if driver.RacesIngestedFrom != nil {
+		ingestedFrom = *driver.RacesIngestedFrom
+	}
+
+	var rangeBegin, rangeEnd time.Time
 	willBeUpToDate := false
 	now := r.now()
-	rangeEnd := rangeBegin.Add(r.searchWindowDuration)
-	if rangeEnd.After(now) {
-		rangeEnd = now
-		willBeUpToDate = true
+	if request.Backwards {
+		var gaps []store.TimeRange
+		if driver.RacesIngestedTo != nil {
+			gaps = coverage.Gaps(driver.MemberSince, *driver.RacesIngestedTo)
+		}
+		if len(gaps) == 0 {
+			// races were deleted since this round was dispatched, or there is no history left, either way nothing to do
+			logger.Info().Int64("driverID", request.DriverID).Msg("no history left to ingest")
+			return nil, nil
+		}
+		// history is filled in newest to oldest, so the most recent gap goes first
+		gap := gaps[len(gaps)-1]
+		rangeEnd = gap.To
+		rangeBegin = rangeEnd.Add(-cfg.searchWindowDuration)
+		if rangeBegin.Before(gap.From) {
+			rangeBegin = gap.From
+		}
+	} else {
+		if driver.RacesIngestedTo != nil {
+			rangeBegin = *driver.RacesIngestedTo
+			// give bit of a buffer, if ingestion is triggered after exiting a session its possible results
+			// will not be ready & then the user is stuck with missing races
+			rangeBegin = rangeBegin.Add(-time.Hour * 4)
+		} else {
+			// first ingestion, start with recent races so the driver has something to look at right away
+			rangeBegin = now.Add(-r.bootstrapWindowDuration)
+			if rangeBegin.Before(driver.MemberSince) {
+				rangeBegin = driver.MemberSince
+			}
+		}
+
+		rangeEnd = rangeBegin.Add(cfg.searchWindowDuration)
+		if rangeEnd.After(now) {
+			rangeEnd = now
+			willBeUpToDate = true
+		}
 	}
 
 	logger.Info().
 		Int64("driverID", request.DriverID).
+		Bool("backwards", request.Backwards).
 		Time("rangeBegin", rangeBegin).
 		Time("rangeEnd", rangeEnd).
 		Msg("searching for race results")
 
+	searchStart := r.now()
 	results, err := r.iracingClient.SearchSeriesResults(ctx, request.IRacingAccessToken, rangeBegin, rangeEnd,
 		iracing.WithCustomerID(request.DriverID),
 		iracing.WithEventTypes(iracing.EventTypeRace),
 	)
+	stats.record(phaseSearch, 0, r.now().Sub(searchStart))
 	if err != nil {
-		return false, fmt.Errorf("searching series results: %w", err)
+		return nil, fmt.Errorf("searching series results: %w", err)
 	}
 
 	raceCount := 0
 	newRaceCount := 0
+	lapCount := 0
 	var errs []error
 
 	collectionChan := make(chan collectionResult)
@@ -195,6 +575,7 @@ func (r *RaceProcessor) doIngestRaces(ctx context.Context, request RaceIngestion
 		for result := range collectionChan {
 			raceCount += result.race
 			newRaceCount += result.newRace
+			lapCount += result.laps
 			if result.err != nil {
 				logger.Err(result.err).Msg("error during ingestion, bailing out")
 				errs = append(errs, result.err)
@@ -204,12 +585,36 @@ func (r *RaceProcessor) doIngestRaces(ctx context.Context, request RaceIngestion
 		}
 	}()
 
+	// Races are pulled into driver sessions by one pool of workers, then handed off to a second pool that pulls lap
+	// data and persists each session along with its laps in one go. Lap data is the slow part, so it gets its own
+	// bound on parallelism.
 	racesChan := make(chan iracing.SeriesResult)
 	racesDone := sync.WaitGroup{}
+	sessionsChan := make(chan pendingSession)
+	sessionsDone := sync.WaitGroup{}
 
 	insertionMutex := sync.Mutex{}
 
-	for i := 0; i < r.raceConsumptionConcurrency; i++ {
+	for i := 0; i < cfg.lapConsumptionConcurrency; i++ {
+		sessionsDone.Add(1)
+		go func() {
+			defer sessionsDone.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case pending, ok := <-sessionsChan:
+					if !ok {
+						return
+					}
+					r.persistSession(ctx, &insertionMutex, request, pending, stats, collectionChan)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < cfg.raceConsumptionConcurrency; i++ {
 		racesDone.Add(1)
 		go func() {
 			defer racesDone.Done()
@@ -222,17 +627,24 @@ func (r *RaceProcessor) doIngestRaces(ctx context.Context, request RaceIngestion
 					if !ok {
 						return
 					}
-					r.ingestRace(ctx, &insertionMutex, driver, request, race, collectionChan)
+					r.ingestRace(ctx, driver, settings, request, race, stats, sessionsChan, collectionChan)
 				}
 			}
 		}()
 	}
 
 	running := true
-	for _, race := range results {
+	cancelled := false
+	for i, race := range results {
 		if !running {
 			break
 		}
+		// races are handed over as workers free up, so checking before each one stops a round within a race or so of
+		// the driver cancelling
+		if i > 0 && r.cancelRequested(ctx, request.DriverID) {
+			cancelled = true
+			break
+		}
 		select {
 		case racesChan <- race:
 		case <-ctx.Done():
@@ -243,26 +655,196 @@ func (r *RaceProcessor) doIngestRaces(ctx context.Context, request RaceIngestion
 	close(racesChan)
 	racesDone.Wait()
 
+	close(sessionsChan)
+	sessionsDone.Wait()
+
 	close(collectionChan)
 	collectorDone.Wait()
 
+	stats.raceCount = raceCount
+	stats.newRaceCount = newRaceCount
+	stats.lapCount = lapCount
+	stats.upToDate = willBeUpToDate
+
 	if len(errs) > 0 {
-		return false, errors.Join(errs...)
+		return nil, errors.Join(errs...)
+	}
+	if cancelled {
+		// the range wasn't searched all the way through so it isn't recorded as covered, the races that did make it in
+		// are found already ingested whenever it is searched again
+		return nil, errIngestionCancelled
 	}
 
-	if err := r.store.UpdateDriverRacesIngestedTo(ctx, driver.DriverID, rangeEnd); err != nil {
-		return false, fmt.Errorf("updating driver ingested to: %w", err)
+	// coverage is recorded first as it is what later rounds go by to find what is still missing, drivers without any
+	// recorded yet have what their cursors stood for carried over
+	searched := store.TimeRange{From: rangeBegin, To: rangeEnd}
+	added := []store.TimeRange{searched}
+	if stored == nil {
+		added = slices.Concat(coverage, added)
 	}
-	if err := r.pusher.Broadcast(ctx, driver.DriverID, "ingestionChunkComplete", ChunkCompleteMsg{IngestedTo: rangeEnd}); err != nil {
-		return false, fmt.Errorf("pushing chunk complete notification: %w", err)
+	if err := r.store.AddIngestionCoverage(ctx, driver.DriverID, added...); err != nil {
+		return nil, fmt.Errorf("updating ingestion coverage: %w", err)
 	}
+	coverage = coverage.Add(searched)
 
-	logger.Info().Int("raceCount", raceCount).Int("newRaceCount", newRaceCount).Bool("willBeUpToDate", willBeUpToDate).Msg("ingested races")
+	ingestedTo := rangeEnd
+	if request.Backwards {
+		ingestedTo = *driver.RacesIngestedTo
+	}
+	historyRemaining := len(coverage.Gaps(driver.MemberSince, ingestedTo)) > 0
+	if request.Backwards {
+		stats.upToDate = !historyRemaining
+	}
+
+	// the from cursor goes first, a first round failing between the two writes must not leave the driver looking like
+	// one that was ingested forwards from MemberSince
+	coveredFrom := coverage.CoveredFrom(ingestedTo)
+	if driver.RacesIngestedTo == nil || !coveredFrom.Equal(ingestedFrom) {
+		if err := r.store.UpdateDriverRacesIngestedFrom(ctx, driver.DriverID, coveredFrom); err != nil {
+			return nil, fmt.Errorf("updating driver ingested from: %w", err)
+		}
+	}
+	if !request.Backwards {
+		if err := r.store.UpdateDriverRacesIngestedTo(ctx, driver.DriverID, rangeEnd); err != nil {
+			return nil, fmt.Errorf("updating driver ingested to: %w", err)
+		}
+	}
+	notifyStart := r.now()
+	chunkComplete := ChunkCompleteMsg{IngestedFrom: coveredFrom, IngestedTo: ingestedTo}
+	if err := r.pusher.Broadcast(ctx, driver.DriverID, "ingestionChunkComplete", chunkComplete); err != nil {
+		return nil, fmt.Errorf("pushing chunk complete notification: %w", err)
+	}
+	stats.record(phaseNotify, 0, r.now().Sub(notifyStart))
+
+	logger.Info().Int("raceCount", raceCount).Int("newRaceCount", newRaceCount).Int("lapCount", lapCount).Bool("willBeUpToDate", willBeUpToDate).Msg("ingested races")
+
+	if request.Backwards {
+		if historyRemaining {
+			return &request, nil
+		}
+		return nil, nil
+	}
+	if !willBeUpToDate {
+		return &request, nil
+	}
+
+	// Only backfill once caught up, the sessions ingested along the way may still be missing laps
+	if settings.LapBackfillPending && !settings.SummaryOnlyIngestion {
+		moreToBackfill, err := r.backfillLaps(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("backfilling laps: %w", err)
+		}
+		if moreToBackfill {
+			return &request, nil
+		}
+	}
 
-	return !willBeUpToDate, nil
+	// caught up with recent races, carry on into older history
+	if historyRemaining {
+		backwards := request
+		backwards.Backwards = true
+		return &backwards, nil
+	}
+	return nil, nil
 }
 
-func (r *RaceProcessor) ingestRace(ctx context.Context, insertionMutex *sync.Mutex, driver *store.Driver, request RaceIngestionRequest, race iracing.SeriesResult, collectorChan chan collectionResult) {
+// backfillLaps pulls lap data for sessions that were ingested while the driver had summary only ingestion turned on.
+// Returns true if there are sessions left for another round.
+func (r *RaceProcessor) backfillLaps(ctx context.Context, request RaceIngestionRequest) (bool, error) {
+	ctx, segment := xray.BeginSubsegment(ctx, "BackfillLaps")
+	var segmentErr error
+	defer func() { segment.Close(segmentErr) }()
+
+	logger := zerolog.Ctx(ctx)
+
+	sessions, err := r.store.GetDriverSessionsWithSkippedLaps(ctx, request.DriverID)
+	if err != nil {
+		segmentErr = err
+		return false, fmt.Errorf("getting sessions with skipped laps: %w", err)
+	}
+
+	moreToBackfill := len(sessions) > r.lapBackfillBatchSize
+	if moreToBackfill {
+		sessions = sessions[:r.lapBackfillBatchSize]
+	}
+
+	for _, session := range sessions {
+		fetchCtx, archiveRecorder := r.recordForArchive(ctx)
+		lapData, err := r.iracingClient.GetLapData(fetchCtx, request.IRacingAccessToken, session.SubsessionID, mainEventSessionNumber, iracing.WithCustomerIDLap(request.DriverID))
+		if err != nil {
+			segmentErr = err
+			return false, fmt.Errorf("pulling lap data: %w", err)
+		}
+		laps, lapGaps := validateLapSequence(sessionDriverLapsFromIRacing(session.SubsessionID, request.DriverID, lapData.Laps), r.interpolateLaps)
+		var trafficCost *int
+		if len(laps) > 0 {
+			if err := r.store.SaveSessionDriverLaps(ctx, laps); err != nil {
+				segmentErr = err
+				return false, fmt.Errorf("saving session driver laps: %w", err)
+			}
+			trafficCost = r.backfillTrafficCost(ctx, fetchCtx, request, session.SubsessionID, laps)
+		}
+		if err := r.store.CompleteDriverSessionLaps(ctx, request.DriverID, session.StartTime, trafficCost, lapGaps); err != nil {
+			segmentErr = err
+			return false, fmt.Errorf("completing driver session laps: %w", err)
+		}
+		r.archiveSession(ctx, archiveRecorder, session.SubsessionID, store.SessionArchiveLaps, request.DriverID)
+	}
+
+	if len(sessions) > 0 {
+		if err := r.metricsClient.EmitCount(ctx, metrics.LapsBackfilled, len(sessions)); err != nil {
+			logger.Warn().Err(err).Msg("failed to emit laps backfilled metric")
+		}
+	}
+
+	if !moreToBackfill {
+		if err := r.store.ClearLapBackfillPending(ctx, request.DriverID); err != nil {
+			segmentErr = err
+			return false, fmt.Errorf("clearing lap backfill pending: %w", err)
+		}
+	}
+
+	logger.Info().Int("sessionCount", len(sessions)).Bool("moreToBackfill", moreToBackfill).Msg("backfilled laps")
+
+	return moreToBackfill, nil
+}
+
+// backfillTrafficCost estimates the traffic cost of a session whose laps are being backfilled. That takes the results,
+// for who was in a faster class, and the lap chart, for when they went by, so it's left unknown when either can't be
+// had.
+func (r *RaceProcessor) backfillTrafficCost(ctx, fetchCtx context.Context, request RaceIngestionRequest, subsessionID int64, laps []store.SessionDriverLap) *int {
+	logger := zerolog.Ctx(ctx).With().Int64("subsessionID", subsessionID).Logger()
+
+	// the results were archived when the session was first ingested
+	sessionResult, err := r.iracingClient.GetSessionResults(ctx, request.IRacingAccessToken, subsessionID, iracing.WithIncludeLicenses(true))
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to pull session results for traffic cost")
+		return nil
+	}
+	raceSession := findRaceSession(sessionResult.SessionResults)
+	if raceSession == nil {
+		return nil
+	}
+	var driverResult *iracing.DriverResult
+	for i := range raceSession.Results {
+		if raceSession.Results[i].CustID == request.DriverID {
+			driverResult = &raceSession.Results[i]
+			break
+		}
+	}
+	if driverResult == nil {
+		return nil
+	}
+
+	lapChart, err := r.iracingClient.GetLapChartData(fetchCtx, request.IRacingAccessToken, subsessionID, mainEventSessionNumber)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to pull lap chart data for traffic cost")
+		return nil
+	}
+	return analytics.TrafficCost(laps, lineCrossings(lapChart.Laps, fasterClassDrivers(raceSession, driverResult)))
+}
+
+func (r *RaceProcessor) ingestRace(ctx context.Context, driver *store.Driver, settings *store.DriverSettings, request RaceIngestionRequest, race iracing.SeriesResult, stats *runStats, sessionsChan chan<- pendingSession, collectorChan chan collectionResult) {
 	ctx, segment := xray.BeginSubsegment(ctx, "IngestRace")
 	var segmentErr error
 	defer func() { segment.Close(segmentErr) }()
@@ -279,12 +861,15 @@ func (r *RaceProcessor) ingestRace(ctx context.Context, insertionMutex *sync.Mut
 	collectorChan <- collectionResult{race: 1}
 
 	// Fetch session results from iRacing to get this driver's detailed stats
-	sessionResult, err := r.iracingClient.GetSessionResults(ctx, request.IRacingAccessToken, race.SubsessionID, iracing.WithIncludeLicenses(true))
+	fetchStart := r.now()
+	fetchCtx, archiveRecorder := r.recordForArchive(ctx)
+	sessionResult, err := r.iracingClient.GetSessionResults(fetchCtx, request.IRacingAccessToken, race.SubsessionID, iracing.WithIncludeLicenses(true))
 	if err != nil {
 		segmentErr = err
 		collectorChan <- collectionResult{err: fmt.Errorf("pulling session results: %w", err)}
 		return
 	}
+	stats.record(phaseSessionFetch, raceDriverCount(sessionResult), r.now().Sub(fetchStart))
 
 	// Check if we already have this driver's session record
 	existingDriverSession, err := r.store.GetDriverSession(ctx, driver.DriverID, sessionResult.StartTime)
@@ -299,6 +884,7 @@ func (r *RaceProcessor) ingestRace(ctx context.Context, insertionMutex *sync.Mut
 	}
 
 	collectorChan <- collectionResult{newRace: 1}
+	r.archiveSession(ctx, archiveRecorder, race.SubsessionID, store.SessionArchiveResults, 0)
 
 	raceSession := findRaceSession(sessionResult.SessionResults)
 	if raceSession == nil {
@@ -341,28 +927,166 @@ func (r *RaceProcessor) ingestRace(ctx context.Context, insertionMutex *sync.Mut
 		OldSubLevel:           driverResult.OldSubLevel,
 		NewSubLevel:           driverResult.NewSubLevel,
 		ReasonOut:             driverResult.ReasonOut,
+		ReasonOutCode:         store.NormalizeReasonOut(driverResult.ReasonOut),
+		StrengthOfField:       sessionResult.EventStrengthOfField,
+		CornersPerLap:         sessionResult.CornersPerLap,
+		LicenseCategoryID:     sessionResult.LicenseCategoryID,
+		LapsComplete:          driverResult.LapsComplete,
+		LapsLead:              driverResult.LapsLead,
+		CarClassID:            int64(driverResult.CarClassID),
+		SeasonID:              int64(sessionResult.SeasonID),
+		SeasonYear:            sessionResult.SeasonYear,
+		SeasonQuarter:         sessionResult.SeasonQuarter,
+		RaceWeekNum:           sessionResult.RaceWeekNum,
+		ChampPoints:           driverResult.ChampPoints,
+		DropRace:              driverResult.DropRace,
 	}
 
+	// Traffic from other classes is only a factor in multiclass races, so lap data is only pulled for those
+	multiclass := isMulticlass(raceSession)
+	if multiclass && settings.SummaryOnlyIngestion {
+		// flagged so the laps can be backfilled if summary only ingestion gets turned off
+		driverSession.LapsSkipped = true
+	}
+
+	pending := pendingSession{driverSession: driverSession, driverCount: len(raceSession.Results), fetchLaps: multiclass && !settings.SummaryOnlyIngestion}
+	if pending.fetchLaps {
+		pending.fasterClassDrivers = fasterClassDrivers(raceSession, driverResult)
+	}
+
+	select {
+	case sessionsChan <- pending:
+	case <-ctx.Done():
+	}
+}
+
+// persistSession pulls lap data for the session when needed and saves the session and its laps in a single
+// PersistSessionData call, so laps for a session are never split across concurrent writes.
+func (r *RaceProcessor) persistSession(ctx context.Context, insertionMutex *sync.Mutex, request RaceIngestionRequest, pending pendingSession, stats *runStats, collectorChan chan collectionResult) {
+	ctx, segment := xray.BeginSubsegment(ctx, "PersistSession")
+	var segmentErr error
+	defer func() { segment.Close(segmentErr) }()
+	_ = xray.AddAnnotation(ctx, "subsessionID", pending.driverSession.SubsessionID)
+
+	logger := zerolog.Ctx(ctx)
+	driverSession := pending.driverSession
+
+	var laps []store.SessionDriverLap
+	var archiveRecorder *iracing.Recorder
+	// nil until the lap chart says when faster cars crossed the line, without it there's no telling what was traffic
+	var fasterClassCrossings [][]int
+	if pending.fetchLaps {
+		lapFetchStart := r.now()
+		var fetchCtx context.Context
+		fetchCtx, archiveRecorder = r.recordForArchive(ctx)
+		lapData, err := r.iracingClient.GetLapData(fetchCtx, request.IRacingAccessToken, driverSession.SubsessionID, mainEventSessionNumber, iracing.WithCustomerIDLap(driverSession.DriverID))
+		if err != nil {
+			segmentErr = err
+			collectorChan <- collectionResult{err: fmt.Errorf("pulling lap data: %w", err)}
+			return
+		}
+		// the position timeline is a nice to have, the race is still worth saving without it
+		lapChart, err := r.iracingClient.GetLapChartData(fetchCtx, request.IRacingAccessToken, driverSession.SubsessionID, mainEventSessionNumber)
+		if err != nil {
+			logger.Warn().Err(err).Int64("subsessionID", driverSession.SubsessionID).Msg("failed to pull lap chart data")
+		} else {
+			driverSession.Positions = runningPositions(driverSession.DriverID, lapChart.Laps)
+			fasterClassCrossings = lineCrossings(lapChart.Laps, pending.fasterClassDrivers)
+		}
+		stats.record(phaseLapFetch, pending.driverCount, r.now().Sub(lapFetchStart))
+		laps, driverSession.LapGaps = validateLapSequence(sessionDriverLapsFromIRacing(driverSession.SubsessionID, driverSession.DriverID, lapData.Laps), r.interpolateLaps)
+		if driverSession.LapGaps > 0 {
+			logger.Warn().Int64("subsessionID", driverSession.SubsessionID).Int("lapGaps", driverSession.LapGaps).Msg("lap data has gaps")
+		}
+		if fasterClassCrossings != nil {
+			driverSession.TrafficCost = analytics.TrafficCost(laps, fasterClassCrossings)
+		}
+	}
+
+	// the session count on the driver record is bumped with each session, concurrent transactions touching it would
+	// conflict
+	persistStart := r.now()
 	insertionMutex.Lock()
-	if err := r.store.SaveDriverSessions(ctx, []store.DriverSession{driverSession}); err != nil {
-		insertionMutex.Unlock()
+	err := r.store.PersistSessionData(ctx, driverSession, laps)
+	insertionMutex.Unlock()
+	stats.record(phasePersist, pending.driverCount, r.now().Sub(persistStart))
+	if err != nil {
 		segmentErr = err
-		collectorChan <- collectionResult{err: fmt.Errorf("saving driver session: %w", err)}
+		collectorChan <- collectionResult{err: fmt.Errorf("persisting session data: %w", err)}
 		return
 	}
-	insertionMutex.Unlock()
+
+	collectorChan <- collectionResult{laps: len(laps)}
+	r.archiveSession(ctx, archiveRecorder, driverSession.SubsessionID, store.SessionArchiveLaps, driverSession.DriverID)
 
 	if err := r.metricsClient.EmitCount(ctx, metrics.DriverSessionsIngested, 1); err != nil {
 		logger.Warn().Err(err).Msg("failed to emit driver sessions ingested metric")
 	}
+	if len(laps) > 0 {
+		if err := r.metricsClient.EmitCount(ctx, metrics.LapsIngested, len(laps)); err != nil {
+			logger.Warn().Err(err).Msg("failed to emit laps ingested metric")
+		}
+		if err := r.metricsClient.EmitCount(ctx, metrics.LapSessionsIngested, 1); err != nil {
+			logger.Warn().Err(err).Msg("failed to emit lap sessions ingested metric")
+		}
+	}
+
+	// squad event results are a side show, the race itself is already saved
+	if r.squadEventTagger != nil {
+		if err := r.squadEventTagger.TagSession(ctx, driverSession); err != nil {
+			logger.Warn().Err(err).Int64("subsessionID", driverSession.SubsessionID).Msg("failed to tag squad events")
+		}
+	}
+
+	// as are plan links
+	var planEvaluation *store.PlanEvaluation
+	if r.racePlanLinker != nil {
+		evaluation, err := r.racePlanLinker.LinkSession(ctx, driverSession)
+		if err != nil {
+			logger.Warn().Err(err).Int64("subsessionID", driverSession.SubsessionID).Msg("failed to link race plan")
+		}
+		planEvaluation = evaluation
+	}
 
 	if r.now().Sub(driverSession.StartTime) < broadcastThreshold {
 		raceID := store.DriverRaceIDFromTime(driverSession.StartTime)
-		if err := r.pusher.Broadcast(ctx, driver.DriverID, "raceIngested", RaceReadyMsg{raceID}); err != nil {
+		notifyStart := r.now()
+		if err := r.pusher.Broadcast(ctx, driverSession.DriverID, "raceIngested", RaceReadyMsg{raceID}); err != nil {
 			segmentErr = err
 			collectorChan <- collectionResult{err: fmt.Errorf("broadcasting race ingested: %w", err)}
 			return
 		}
+		stats.record(phaseNotify, pending.driverCount, r.now().Sub(notifyStart))
+
+		// the prompt only nudges the driver to journal the race, it isn't worth failing the race over. It carries the plan
+		// evaluation so how the race went against the plan is in front of the driver while they journal.
+		if err := r.store.SaveJournalPrompt(ctx, store.JournalPrompt{DriverID: driverSession.DriverID, RaceID: raceID, PlanEvaluation: planEvaluation}); err != nil {
+			logger.Warn().Err(err).Int64("subsessionID", driverSession.SubsessionID).Msg("failed to save journal prompt")
+		}
+	}
+}
+
+// cancelRequested reports whether the driver has asked for their ingestion to stop. A failed check just means carrying
+// on until the next one.
+func (r *RaceProcessor) cancelRequested(ctx context.Context, driverID int64) bool {
+	requested, err := r.store.IngestionCancelRequested(ctx, driverID)
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Int64("driverID", driverID).Msg("failed to check for ingestion cancel")
+		return false
+	}
+	return requested
+}
+
+// acknowledgeCancel clears the cancel once ingestion has stopped, so it doesn't also stop the driver's next sync, and
+// lets every connection the driver has open know.
+func (r *RaceProcessor) acknowledgeCancel(ctx context.Context, driverID int64) {
+	logger := zerolog.Ctx(ctx)
+	logger.Info().Int64("driverID", driverID).Msg("ingestion cancelled")
+	if err := r.store.ClearIngestionCancel(ctx, driverID); err != nil {
+		logger.Err(err).Int64("driverID", driverID).Msg("failed to clear ingestion cancel")
+	}
+	if err := r.pusher.Broadcast(ctx, driverID, actionIngestionCancelled, nil); err != nil {
+		logger.Err(err).Int64("driverID", driverID).Msg("failed to notify driver of cancelled ingestion")
 	}
 }
 
@@ -387,8 +1111,128 @@ func findRaceSession(sessions []iracing.SimSessionResult) *iracing.SimSessionRes
 	return nil
 }
 
+// raceDriverCount is the number of drivers in the main event, 0 if there isn't one.
+func raceDriverCount(sessionResult *iracing.SessionResult) int {
+	raceSession := findRaceSession(sessionResult.SessionResults)
+	if raceSession == nil {
+		return 0
+	}
+	return len(raceSession.Results)
+}
+
+func isMulticlass(raceSession *iracing.SimSessionResult) bool {
+	if len(raceSession.Results) == 0 {
+		return false
+	}
+	carClassID := raceSession.Results[0].CarClassID
+	for _, result := range raceSession.Results[1:] {
+		if result.CarClassID != carClassID {
+			return true
+		}
+	}
+	return false
+}
+
+// fasterClassDrivers returns the drivers in classes quicker than driverResult's, going by the best lap set in each
+// class.
+func fasterClassDrivers(raceSession *iracing.SimSessionResult, driverResult *iracing.DriverResult) map[int64]bool {
+	classBest := make(map[int]int)
+	for _, result := range raceSession.Results {
+		if result.BestLapTime <= 0 {
+			continue
+		}
+		if best, ok := classBest[result.CarClassID]; !ok || result.BestLapTime < best {
+			classBest[result.CarClassID] = result.BestLapTime
+		}
+	}
+
+	drivers := make(map[int64]bool)
+	driverClassBest, ok := classBest[driverResult.CarClassID]
+	if !ok {
+		return drivers
+	}
+	for _, result := range raceSession.Results {
+		if best, ok := classBest[result.CarClassID]; ok && best < driverClassBest {
+			drivers[result.CustID] = true
+		}
+	}
+	return drivers
+}
+
+// lineCrossings returns the session times at which each of drivers crossed the line, going by the lap chart. Never
+// nil, so a race nobody was quicker in can be told apart from one there's no lap chart for.
+func lineCrossings(laps []iracing.LapChartLap, drivers map[int64]bool) [][]int {
+	byDriver := make(map[int64][]int)
+	for _, l := range laps {
+		if drivers[l.CustID] && l.SessionTime > 0 {
+			byDriver[l.CustID] = append(byDriver[l.CustID], l.SessionTime)
+		}
+	}
+	crossings := make([][]int, 0, len(byDriver))
+	for _, sessionTimes := range byDriver {
+		crossings = append(crossings, sessionTimes)
+	}
+	return crossings
+}
+
+func sessionDriverLapsFromIRacing(subsessionID, driverID int64, laps []iracing.Lap) []store.SessionDriverLap {
+	result := make([]store.SessionDriverLap, len(laps))
+	for i, l := range laps {
+		result[i] = store.SessionDriverLap{
+			SubsessionID:    subsessionID,
+			DriverID:        driverID,
+			LapNumber:       l.LapNumber,
+			Flags:           l.Flags,
+			Incident:        l.Incident,
+			SessionTime:     l.SessionTime,
+			LapTime:         l.LapTime,
+			PersonalBestLap: l.PersonalBestLap,
+			LapEvents:       lapevents.Normalize(l.LapEvents),
+		}
+	}
+	return result
+}
+
+// runningPositions pulls a driver's position at the end of each lap out of a lap chart, indexed by lap number and
+// converted to the 0-based positions used everywhere else. A lap missing from the chart carries the previous lap's
+// position forward. Returns nil when the driver has no laps in the chart.
+func runningPositions(driverID int64, laps []iracing.LapChartLap) []int {
+	byLap := make(map[int]int)
+	lastLap := -1
+	for _, l := range laps {
+		if l.CustID != driverID || l.LapNumber < 0 {
+			continue
+		}
+		byLap[l.LapNumber] = l.LapPosition - 1
+		lastLap = max(lastLap, l.LapNumber)
+	}
+	if lastLap < 0 {
+		return nil
+	}
+
+	positions := make([]int, lastLap+1)
+	for lap := range positions {
+		position, ok := byLap[lap]
+		if !ok && lap > 0 {
+			position = positions[lap-1]
+		}
+		positions[lap] = position
+	}
+	return positions
+}
+
+// pendingSession is a driver session built from race results that is waiting on lap data (if any) before being persisted
+type pendingSession struct {
+	driverSession store.DriverSession
+	driverCount   int
+	fetchLaps     bool
+	// fasterClassDrivers are the drivers in quicker classes, for telling which slow laps were down to traffic
+	fasterClassDrivers map[int64]bool
+}
+
 type collectionResult struct {
 	newRace int
 	race    int
+	laps    int
 	err     error
 }
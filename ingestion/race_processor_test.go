@@ -2,9 +2,13 @@ package ingestion
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/jonsabados/saturdaysspinout/event"
 	"github.com/jonsabados/saturdaysspinout/iracing"
 	"github.com/jonsabados/saturdaysspinout/metrics"
 	"github.com/jonsabados/saturdaysspinout/store"
@@ -33,6 +37,23 @@ type getSessionResultsCall struct {
 	err          error
 }
 
+type getLapDataCall struct {
+	subsessionID int64
+	result       *iracing.LapDataResponse
+	err          error
+}
+
+type getLapChartDataCall struct {
+	subsessionID int64
+	result       *iracing.LapChartResponse
+	err          error
+}
+
+type saveSessionDriverLapsCall struct {
+	validate func(t *testing.T, laps []store.SessionDriverLap)
+	err      error
+}
+
 type getDriverSessionCall struct {
 	driverID  int64
 	startTime time.Time
@@ -40,8 +61,8 @@ type getDriverSessionCall struct {
 	err       error
 }
 
-type saveDriverSessionsCall struct {
-	validate func(t *testing.T, sessions []store.DriverSession)
+type persistSessionDataCall struct {
+	validate func(t *testing.T, session store.DriverSession, laps []store.SessionDriverLap)
 	err      error
 }
 
@@ -65,11 +86,38 @@ type updateDriverRacesIngestedToCall struct {
 	err             error
 }
 
+type getIngestionCoverageCall struct {
+	result store.Coverage
+	err    error
+}
+
+type addIngestionCoverageCall struct {
+	ranges []store.TimeRange
+	err    error
+}
+
+type updateDriverRacesIngestedFromCall struct {
+	driverID          int64
+	racesIngestedFrom time.Time
+	err               error
+}
+
 type publishEventCall struct {
 	event any
+	opts  []event.PublishOption
 	err   error
 }
 
+type downUntilCall struct {
+	result time.Time
+	err    error
+}
+
+type reserveRateBudgetCall struct {
+	retryAfter time.Duration
+	err        error
+}
+
 type emitCountCall struct {
 	name  string
 	count int
@@ -87,13 +135,99 @@ type releaseIngestionLockCall struct {
 	err      error
 }
 
+type getDriverSettingsCall struct {
+	driverID int64
+	result   *store.DriverSettings
+	err      error
+}
+
+type getDriverSessionsWithSkippedLapsCall struct {
+	driverID int64
+	result   []store.DriverSession
+	err      error
+}
+
+type completeDriverSessionLapsCall struct {
+	driverID    int64
+	startTime   time.Time
+	trafficCost *int
+	lapGaps     int
+	err         error
+}
+
+type clearLapBackfillPendingCall struct {
+	driverID int64
+	err      error
+}
+
+type ingestionCancelRequestedCall struct {
+	requested bool
+	err       error
+}
+
+type clearIngestionCancelCall struct {
+	err error
+}
+
+type getIngestionTiersCall struct {
+	result []store.IngestionTier
+	err    error
+}
+
+type saveDriverSettingsCall struct {
+	settings store.DriverSettings
+	err      error
+}
+
+type saveIngestionRunCall struct {
+	validate func(t *testing.T, run store.IngestionRun)
+	err      error
+}
+
+type snapshotDriverStandingCall struct {
+	driverID int64
+	err      error
+}
+
+type evaluateAlertsCall struct {
+	driverID int64
+	err      error
+}
+
+type refreshSeasonCardCall struct {
+	driverID int64
+	err      error
+}
+
+type refreshOverlayCall struct {
+	driverID int64
+	err      error
+}
+
+type tagSquadEventsCall struct {
+	subsessionID int64
+	err          error
+}
+
+type linkRacePlanCall struct {
+	subsessionID int64
+	evaluation   *store.PlanEvaluation
+	err          error
+}
+
+type advanceOnboardingCall struct {
+	driverID int64
+	err      error
+}
+
 func TestRaceProcessor_IngestRaces(t *testing.T) {
 	driverID := int64(12345)
 	subsessionID := int64(99999)
 	memberSince := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
 	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
 	sessionStartTime := time.Date(2024, 6, 10, 18, 0, 0, 0, time.UTC)
-	rangeEnd := memberSince.Add(time.Hour * 24 * 10) // default search window
+	bootstrapRangeBegin := now.Add(-time.Hour * 24 * DefaultBootstrapWindowInDays) // new drivers start with recent races
+	rangeEnd := bootstrapRangeBegin.Add(time.Hour * 24 * 10)                       // default search window
 	lockDuration := 15 * time.Minute
 
 	// For continuation scenario (driver with RacesIngestedTo set)
@@ -101,23 +235,63 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 	continuationRangeBegin := racesIngestedTo.Add(-time.Hour * 4) // 4-hour buffer
 	continuationRangeEnd := now                                   // capped by now since rangeBegin + 10 days > now
 
+	// For backwards rounds (driver whose history has been ingested back to racesIngestedFrom)
+	racesIngestedFrom := time.Date(2024, 5, 16, 12, 0, 0, 0, time.UTC)
+	backwardsRangeBegin := racesIngestedFrom.Add(-time.Hour * 24 * 10) // default search window
+	nearlyCompleteFrom := memberSince.Add(time.Hour * 24 * 3)
+
+	// For coverage with a hole in it that the cursors know nothing about
+	gapBegin := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	gapEnd := racesIngestedFrom.Add(-time.Hour * 24 * 5)
+
+	// For drivers whose tier searches further per round
+	tierRangeEnd := bootstrapRangeBegin.Add(time.Hour * 24 * 20)
+
 	testCases := []struct {
 		name string
 
 		request RaceIngestionRequest
 
-		acquireIngestionLockCall        acquireIngestionLockCall
-		releaseIngestionLockCall        *releaseIngestionLockCall
-		getDriverCall                   *getDriverCall
-		searchSeriesResultsCall         *searchSeriesResultsCall
-		getSessionResultsCalls          []getSessionResultsCall
-		getDriverSessionCalls           []getDriverSessionCall
-		saveDriverSessionsCalls         []saveDriverSessionsCall
-		emitCountCalls                  []emitCountCall
-		pushCalls                       []pushCall
-		broadcastCalls                  []broadcastCall
-		updateDriverRacesIngestedToCall *updateDriverRacesIngestedToCall
-		publishEventCall                *publishEventCall
+		acquireIngestionLockCall          acquireIngestionLockCall
+		releaseIngestionLockCall          *releaseIngestionLockCall
+		getDriverCall                     *getDriverCall
+		getDriverSettingsCall             *getDriverSettingsCall
+		searchSeriesResultsCall           *searchSeriesResultsCall
+		getSessionResultsCalls            []getSessionResultsCall
+		getDriverSessionCalls             []getDriverSessionCall
+		getLapDataCalls                   []getLapDataCall
+		getLapChartDataCalls              []getLapChartDataCall
+		saveSessionDriverLapsCalls        []saveSessionDriverLapsCall
+		persistSessionDataCalls           []persistSessionDataCall
+		emitCountCalls                    []emitCountCall
+		pushCalls                         []pushCall
+		broadcastCalls                    []broadcastCall
+		saveJournalPromptErr              error
+		getIngestionCoverageCall          *getIngestionCoverageCall
+		addIngestionCoverageCall          *addIngestionCoverageCall
+		updateDriverRacesIngestedFromCall *updateDriverRacesIngestedFromCall
+		updateDriverRacesIngestedToCall   *updateDriverRacesIngestedToCall
+		publishEventCall                  *publishEventCall
+		snapshotDriverStandingCall        *snapshotDriverStandingCall
+		evaluateAlertsCall                *evaluateAlertsCall
+		refreshSeasonCardCall             *refreshSeasonCardCall
+		refreshOverlayCall                *refreshOverlayCall
+		advanceOnboardingCall             *advanceOnboardingCall
+		tagSquadEventsCall                *tagSquadEventsCall
+		linkRacePlanCall                  *linkRacePlanCall
+		downUntilCall                     *downUntilCall
+		reserveRateBudgetCall             *reserveRateBudgetCall
+		ingestionCancelRequestedCalls     []ingestionCancelRequestedCall
+		clearIngestionCancelCall          *clearIngestionCancelCall
+		getIngestionTiersCall             *getIngestionTiersCall
+		saveDriverSettingsCall            *saveDriverSettingsCall
+		saveIngestionRunCall              *saveIngestionRunCall
+
+		getDriverSessionsWithSkippedLapsCall *getDriverSessionsWithSkippedLapsCall
+		completeDriverSessionLapsCalls       []completeDriverSessionLapsCall
+		clearLapBackfillPendingCall          *clearLapBackfillPendingCall
+		lapBackfillBatchSize                 int
+		lapConsumptionConcurrency            int
 
 		expectedErr string
 	}{
@@ -129,6 +303,8 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 				NotifyConnectionID: "conn-123",
 			},
 			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			advanceOnboardingCall:    &advanceOnboardingCall{driverID: driverID},
+			reserveRateBudgetCall:    &reserveRateBudgetCall{}, // budget to spare
 			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
 			getDriverCall: &getDriverCall{
 				driverID: driverID,
@@ -138,7 +314,7 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 				},
 			},
 			searchSeriesResultsCall: &searchSeriesResultsCall{
-				finishRangeBegin: memberSince,
+				finishRangeBegin: bootstrapRangeBegin,
 				finishRangeEnd:   rangeEnd,
 				result: []iracing.SeriesResult{
 					{SubsessionID: subsessionID},
@@ -148,12 +324,19 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 				{
 					subsessionID: subsessionID,
 					result: &iracing.SessionResult{
-						SubsessionID:    subsessionID,
-						SeriesID:        42,
-						SeriesName:      "Test Series",
-						LicenseCategory: "Road",
-						Track:           iracing.Track{TrackID: 123},
-						StartTime:       sessionStartTime,
+						SubsessionID:         subsessionID,
+						SeriesID:             42,
+						SeriesName:           "Test Series",
+						LicenseCategory:      "Road",
+						LicenseCategoryID:    2,
+						Track:                iracing.Track{TrackID: 123},
+						StartTime:            sessionStartTime,
+						EventStrengthOfField: 1850,
+						CornersPerLap:        12,
+						SeasonID:             4500,
+						SeasonYear:           2024,
+						SeasonQuarter:        2,
+						RaceWeekNum:          5,
 						SessionResults: []iracing.SimSessionResult{
 							{
 								SimsessionNumber: 0, // main event
@@ -174,6 +357,11 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 										OldSubLevel:             381,
 										NewSubLevel:             399,
 										ReasonOut:               "Running",
+										LapsComplete:            15,
+										LapsLead:                3,
+										ChampPoints:             87,
+										CarClassID:              74,
+										DropRace:                true,
 									},
 								},
 							},
@@ -188,11 +376,9 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 					result:    nil, // doesn't exist
 				},
 			},
-			saveDriverSessionsCalls: []saveDriverSessionsCall{
+			persistSessionDataCalls: []persistSessionDataCall{
 				{
-					validate: func(t *testing.T, sessions []store.DriverSession) {
-						require.Len(t, sessions, 1)
-						ds := sessions[0]
+					validate: func(t *testing.T, ds store.DriverSession, laps []store.SessionDriverLap) {
 						assert.Equal(t, driverID, ds.DriverID)
 						assert.Equal(t, subsessionID, ds.SubsessionID)
 						assert.Equal(t, int64(123), ds.TrackID)
@@ -209,6 +395,21 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 						assert.Equal(t, 381, ds.OldSubLevel)
 						assert.Equal(t, 399, ds.NewSubLevel)
 						assert.Equal(t, "Running", ds.ReasonOut)
+						assert.Equal(t, store.ReasonOutFinished, ds.ReasonOutCode)
+						assert.Equal(t, 1850, ds.StrengthOfField)
+						assert.Equal(t, 12, ds.CornersPerLap)
+						assert.Equal(t, 2, ds.LicenseCategoryID)
+						assert.Equal(t, 15, ds.LapsComplete)
+						assert.Equal(t, 3, ds.LapsLead)
+						assert.Equal(t, int64(74), ds.CarClassID)
+						assert.Equal(t, int64(4500), ds.SeasonID)
+						assert.Equal(t, 2024, ds.SeasonYear)
+						assert.Equal(t, 2, ds.SeasonQuarter)
+						assert.Equal(t, 5, ds.RaceWeekNum)
+						assert.Equal(t, 87, ds.ChampPoints)
+						assert.True(t, ds.DropRace)
+						assert.Nil(t, ds.TrafficCost, "single class races should not get a traffic estimate")
+						assert.Empty(t, laps, "single class races should not pull lap data")
 					},
 				},
 			},
@@ -224,9 +425,16 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 				{
 					driverID:   driverID,
 					actionType: "ingestionChunkComplete",
-					payload:    ChunkCompleteMsg{IngestedTo: rangeEnd},
+					payload:    ChunkCompleteMsg{IngestedFrom: bootstrapRangeBegin, IngestedTo: rangeEnd},
 				},
 			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: rangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: bootstrapRangeBegin,
+			},
 			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
 				driverID:        driverID,
 				racesIngestedTo: rangeEnd,
@@ -238,15 +446,33 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 					NotifyConnectionID: "conn-123",
 				},
 			},
+			saveIngestionRunCall: &saveIngestionRunCall{
+				validate: func(t *testing.T, run store.IngestionRun) {
+					assert.Equal(t, driverID, run.DriverID)
+					assert.Equal(t, now, run.StartedAt)
+					assert.Equal(t, 1, run.RaceCount)
+					assert.Equal(t, 1, run.NewRaceCount)
+					assert.Equal(t, 0, run.LapCount)
+					assert.False(t, run.UpToDate)
+					assert.Empty(t, run.Error)
+					assert.Contains(t, run.PhaseDurations, phaseSearch)
+					assert.Contains(t, run.PhaseDurations, phaseSessionFetch)
+					assert.Contains(t, run.PhaseDurations, phasePersist)
+					assert.Contains(t, run.PhaseDurations, phaseNotify)
+					assert.NotContains(t, run.PhaseDurations, phaseLapFetch)
+				},
+			},
 		},
 		{
-			name: "driver session already exists - skips save and notify",
+			name: "journal prompt failure does not fail the race",
 			request: RaceIngestionRequest{
 				DriverID:           driverID,
 				IRacingAccessToken: "test-token",
 				NotifyConnectionID: "conn-123",
 			},
 			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			advanceOnboardingCall:    &advanceOnboardingCall{driverID: driverID},
+			reserveRateBudgetCall:    &reserveRateBudgetCall{}, // budget to spare
 			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
 			getDriverCall: &getDriverCall{
 				driverID: driverID,
@@ -256,7 +482,7 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 				},
 			},
 			searchSeriesResultsCall: &searchSeriesResultsCall{
-				finishRangeBegin: memberSince,
+				finishRangeBegin: bootstrapRangeBegin,
 				finishRangeEnd:   rangeEnd,
 				result: []iracing.SeriesResult{
 					{SubsessionID: subsessionID},
@@ -266,10 +492,47 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 				{
 					subsessionID: subsessionID,
 					result: &iracing.SessionResult{
-						SubsessionID: subsessionID,
-						StartTime:    sessionStartTime,
+						SubsessionID:         subsessionID,
+						SeriesID:             42,
+						SeriesName:           "Test Series",
+						LicenseCategory:      "Road",
+						LicenseCategoryID:    2,
+						Track:                iracing.Track{TrackID: 123},
+						StartTime:            sessionStartTime,
+						EventStrengthOfField: 1850,
+						CornersPerLap:        12,
+						SeasonID:             4500,
+						SeasonYear:           2024,
+						SeasonQuarter:        2,
+						RaceWeekNum:          5,
 						SessionResults: []iracing.SimSessionResult{
-							{SimsessionNumber: 0, Results: []iracing.DriverResult{{CustID: driverID}}},
+							{
+								SimsessionNumber: 0, // main event
+								Results: []iracing.DriverResult{
+									{
+										CustID:                  driverID,
+										DisplayName:             "Test Driver",
+										CarID:                   10,
+										StartingPosition:        5,
+										StartingPositionInClass: 5,
+										FinishPosition:          3,
+										FinishPositionInClass:   3,
+										Incidents:               2,
+										OldIRating:              1400,
+										NewIRating:              1450,
+										OldLicenseLevel:         17,
+										NewLicenseLevel:         18,
+										OldSubLevel:             381,
+										NewSubLevel:             399,
+										ReasonOut:               "Running",
+										LapsComplete:            15,
+										LapsLead:                3,
+										ChampPoints:             87,
+										CarClassID:              74,
+										DropRace:                true,
+									},
+								},
+							},
 						},
 					},
 				},
@@ -278,23 +541,69 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 				{
 					driverID:  driverID,
 					startTime: sessionStartTime,
-					result: &store.DriverSession{
-						DriverID:     driverID,
-						SubsessionID: subsessionID,
-						StartTime:    sessionStartTime,
+					result:    nil, // doesn't exist
+				},
+			},
+			persistSessionDataCalls: []persistSessionDataCall{
+				{
+					validate: func(t *testing.T, ds store.DriverSession, laps []store.SessionDriverLap) {
+						assert.Equal(t, driverID, ds.DriverID)
+						assert.Equal(t, subsessionID, ds.SubsessionID)
+						assert.Equal(t, int64(123), ds.TrackID)
+						assert.Equal(t, int64(42), ds.SeriesID)
+						assert.Equal(t, "Test Series", ds.SeriesName)
+						assert.Equal(t, int64(10), ds.CarID)
+						assert.Equal(t, 5, ds.StartPosition)
+						assert.Equal(t, 3, ds.FinishPosition)
+						assert.Equal(t, 2, ds.Incidents)
+						assert.Equal(t, 1400, ds.OldIRating)
+						assert.Equal(t, 1450, ds.NewIRating)
+						assert.Equal(t, 17, ds.OldLicenseLevel)
+						assert.Equal(t, 18, ds.NewLicenseLevel)
+						assert.Equal(t, 381, ds.OldSubLevel)
+						assert.Equal(t, 399, ds.NewSubLevel)
+						assert.Equal(t, "Running", ds.ReasonOut)
+						assert.Equal(t, store.ReasonOutFinished, ds.ReasonOutCode)
+						assert.Equal(t, 1850, ds.StrengthOfField)
+						assert.Equal(t, 12, ds.CornersPerLap)
+						assert.Equal(t, 2, ds.LicenseCategoryID)
+						assert.Equal(t, 15, ds.LapsComplete)
+						assert.Equal(t, 3, ds.LapsLead)
+						assert.Equal(t, int64(74), ds.CarClassID)
+						assert.Equal(t, int64(4500), ds.SeasonID)
+						assert.Equal(t, 2024, ds.SeasonYear)
+						assert.Equal(t, 2, ds.SeasonQuarter)
+						assert.Equal(t, 5, ds.RaceWeekNum)
+						assert.Equal(t, 87, ds.ChampPoints)
+						assert.True(t, ds.DropRace)
+						assert.Nil(t, ds.TrafficCost, "single class races should not get a traffic estimate")
+						assert.Empty(t, laps, "single class races should not pull lap data")
 					},
 				},
 			},
-			// No save calls - already exists
-			saveDriverSessionsCalls: []saveDriverSessionsCall{},
-			// No raceIngested broadcast - already exists
+			emitCountCalls: []emitCountCall{
+				{name: metrics.DriverSessionsIngested, count: 1},
+			},
+			saveJournalPromptErr: errors.New("dynamo error"),
 			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "raceIngested",
+					payload:    RaceReadyMsg{RaceID: sessionStartTime.Unix()},
+				},
 				{
 					driverID:   driverID,
 					actionType: "ingestionChunkComplete",
-					payload:    ChunkCompleteMsg{IngestedTo: rangeEnd},
+					payload:    ChunkCompleteMsg{IngestedFrom: bootstrapRangeBegin, IngestedTo: rangeEnd},
 				},
 			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: rangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: bootstrapRangeBegin,
+			},
 			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
 				driverID:        driverID,
 				racesIngestedTo: rangeEnd,
@@ -306,15 +615,33 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 					NotifyConnectionID: "conn-123",
 				},
 			},
+			saveIngestionRunCall: &saveIngestionRunCall{
+				validate: func(t *testing.T, run store.IngestionRun) {
+					assert.Equal(t, driverID, run.DriverID)
+					assert.Equal(t, now, run.StartedAt)
+					assert.Equal(t, 1, run.RaceCount)
+					assert.Equal(t, 1, run.NewRaceCount)
+					assert.Equal(t, 0, run.LapCount)
+					assert.False(t, run.UpToDate)
+					assert.Empty(t, run.Error)
+					assert.Contains(t, run.PhaseDurations, phaseSearch)
+					assert.Contains(t, run.PhaseDurations, phaseSessionFetch)
+					assert.Contains(t, run.PhaseDurations, phasePersist)
+					assert.Contains(t, run.PhaseDurations, phaseNotify)
+					assert.NotContains(t, run.PhaseDurations, phaseLapFetch)
+				},
+			},
 		},
 		{
-			name: "stale credentials on SearchSeriesResults - notifies and returns without error",
+			name: "squad event tagging failure does not fail the race",
 			request: RaceIngestionRequest{
 				DriverID:           driverID,
-				IRacingAccessToken: "stale-token",
+				IRacingAccessToken: "test-token",
 				NotifyConnectionID: "conn-123",
 			},
 			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			advanceOnboardingCall:    &advanceOnboardingCall{driverID: driverID},
+			reserveRateBudgetCall:    &reserveRateBudgetCall{}, // budget to spare
 			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
 			getDriverCall: &getDriverCall{
 				driverID: driverID,
@@ -324,67 +651,99 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 				},
 			},
 			searchSeriesResultsCall: &searchSeriesResultsCall{
-				finishRangeBegin: memberSince,
+				finishRangeBegin: bootstrapRangeBegin,
 				finishRangeEnd:   rangeEnd,
-				result:           nil,
-				err:              iracing.ErrUpstreamUnauthorized,
+				result: []iracing.SeriesResult{
+					{SubsessionID: subsessionID},
+				},
 			},
-			pushCalls: []pushCall{
+			getSessionResultsCalls: []getSessionResultsCall{
 				{
-					connectionID: "conn-123",
-					actionType:   "ingestionFailedStaleCredentials",
-					result:       true,
+					subsessionID: subsessionID,
+					result: &iracing.SessionResult{
+						SubsessionID:         subsessionID,
+						SeriesID:             42,
+						SeriesName:           "Test Series",
+						LicenseCategory:      "Road",
+						LicenseCategoryID:    2,
+						Track:                iracing.Track{TrackID: 123},
+						StartTime:            sessionStartTime,
+						EventStrengthOfField: 1850,
+						CornersPerLap:        12,
+						SeasonID:             4500,
+						SeasonYear:           2024,
+						SeasonQuarter:        2,
+						RaceWeekNum:          5,
+						SessionResults: []iracing.SimSessionResult{
+							{
+								SimsessionNumber: 0, // main event
+								Results: []iracing.DriverResult{
+									{
+										CustID:                  driverID,
+										DisplayName:             "Test Driver",
+										CarID:                   10,
+										StartingPosition:        5,
+										StartingPositionInClass: 5,
+										FinishPosition:          3,
+										FinishPositionInClass:   3,
+										Incidents:               2,
+										OldIRating:              1400,
+										NewIRating:              1450,
+										OldLicenseLevel:         17,
+										NewLicenseLevel:         18,
+										OldSubLevel:             381,
+										NewSubLevel:             399,
+										ReasonOut:               "Running",
+										LapsComplete:            15,
+										LapsLead:                3,
+										ChampPoints:             87,
+										CarClassID:              74,
+										DropRace:                true,
+									},
+								},
+							},
+						},
+					},
 				},
 			},
-		},
-		{
-			name: "driver not found - returns error",
-			request: RaceIngestionRequest{
-				DriverID:           driverID,
-				IRacingAccessToken: "test-token",
-				NotifyConnectionID: "conn-123",
-			},
-			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
-			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
-			getDriverCall: &getDriverCall{
-				driverID: driverID,
-				result:   nil,
-			},
-			expectedErr: "driver 12345 not found",
-		},
-		{
-			name: "team event - skipped without processing",
-			request: RaceIngestionRequest{
-				DriverID:           driverID,
-				IRacingAccessToken: "test-token",
-				NotifyConnectionID: "conn-123",
-			},
-			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
-			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
-			getDriverCall: &getDriverCall{
-				driverID: driverID,
-				result: &store.Driver{
-					DriverID:    driverID,
-					MemberSince: memberSince,
+			getDriverSessionCalls: []getDriverSessionCall{
+				{
+					driverID:  driverID,
+					startTime: sessionStartTime,
+					result:    nil, // doesn't exist
 				},
 			},
-			searchSeriesResultsCall: &searchSeriesResultsCall{
-				finishRangeBegin: memberSince,
-				finishRangeEnd:   rangeEnd,
-				result: []iracing.SeriesResult{
-					{SubsessionID: subsessionID, DriverChanges: true}, // team event
+			persistSessionDataCalls: []persistSessionDataCall{{}},
+			tagSquadEventsCall:      &tagSquadEventsCall{subsessionID: subsessionID, err: errors.New("dynamo error")},
+			linkRacePlanCall: &linkRacePlanCall{
+				subsessionID: subsessionID,
+				evaluation: &store.PlanEvaluation{
+					Targets:     []store.PlanTarget{{Kind: store.PlanTargetFinishPosition, Target: 10, Actual: 7, Met: true}},
+					EvaluatedAt: sessionStartTime.Add(time.Hour),
 				},
 			},
-			// No API calls or saves - team event is skipped
-			getSessionResultsCalls:  []getSessionResultsCall{},
-			saveDriverSessionsCalls: []saveDriverSessionsCall{},
+			emitCountCalls: []emitCountCall{
+				{name: metrics.DriverSessionsIngested, count: 1},
+			},
 			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "raceIngested",
+					payload:    RaceReadyMsg{RaceID: sessionStartTime.Unix()},
+				},
 				{
 					driverID:   driverID,
 					actionType: "ingestionChunkComplete",
-					payload:    ChunkCompleteMsg{IngestedTo: rangeEnd},
+					payload:    ChunkCompleteMsg{IngestedFrom: bootstrapRangeBegin, IngestedTo: rangeEnd},
 				},
 			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: rangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: bootstrapRangeBegin,
+			},
 			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
 				driverID:        driverID,
 				racesIngestedTo: rangeEnd,
@@ -396,19 +755,15 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 					NotifyConnectionID: "conn-123",
 				},
 			},
-		},
-		{
-			name: "ingestion lock not acquired - skips processing",
-			request: RaceIngestionRequest{
-				DriverID:           driverID,
-				IRacingAccessToken: "test-token",
-				NotifyConnectionID: "conn-123",
+			saveIngestionRunCall: &saveIngestionRunCall{
+				validate: func(t *testing.T, run store.IngestionRun) {
+					assert.Equal(t, 1, run.NewRaceCount)
+					assert.Empty(t, run.Error)
+				},
 			},
-			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: false},
-			// No other calls - lock not acquired means skip
 		},
 		{
-			name: "driver not found in session results - logs warning and continues",
+			name: "multiclass race - laps saved and traffic cost estimated",
 			request: RaceIngestionRequest{
 				DriverID:           driverID,
 				IRacingAccessToken: "test-token",
@@ -424,7 +779,581 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 				},
 			},
 			searchSeriesResultsCall: &searchSeriesResultsCall{
-				finishRangeBegin: memberSince,
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				result: []iracing.SeriesResult{
+					{SubsessionID: subsessionID},
+				},
+			},
+			getSessionResultsCalls: []getSessionResultsCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.SessionResult{
+						SubsessionID: subsessionID,
+						SeriesID:     42,
+						SeriesName:   "Test Series",
+						Track:        iracing.Track{TrackID: 123},
+						StartTime:    sessionStartTime,
+						SessionResults: []iracing.SimSessionResult{
+							{
+								SimsessionNumber: 0,
+								Results: []iracing.DriverResult{
+									{CustID: 999, CarClassID: 1, CarID: 20, BestLapTime: 850_000},
+									{CustID: driverID, CarClassID: 2, CarID: 10, ReasonOut: "Running", BestLapTime: 900_000},
+								},
+							},
+						},
+					},
+				},
+			},
+			getDriverSessionCalls: []getDriverSessionCall{
+				{
+					driverID:  driverID,
+					startTime: sessionStartTime,
+					result:    nil,
+				},
+			},
+			getLapDataCalls: []getLapDataCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.LapDataResponse{
+						Laps: []iracing.Lap{
+							{LapNumber: 0, LapTime: -1, SessionTime: 100_000},
+							{LapNumber: 1, LapTime: 1_000_000, SessionTime: 1_100_000},
+							{LapNumber: 2, LapTime: 900_000, SessionTime: 2_000_000},
+							{LapNumber: 3, LapTime: 900_000, SessionTime: 2_900_000},
+							{LapNumber: 4, LapTime: 930_000, SessionTime: 3_830_000},
+							{LapNumber: 5, LapTime: 900_000, SessionTime: 4_730_000},
+						},
+					},
+				},
+			},
+			getLapChartDataCalls: []getLapChartDataCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.LapChartResponse{
+						Laps: []iracing.LapChartLap{
+							{CustID: driverID, LapNumber: 0, LapPosition: 2, SessionTime: 100_000},
+							{CustID: 999, LapNumber: 0, LapPosition: 1, SessionTime: 90_000},
+							{CustID: driverID, LapNumber: 1, LapPosition: 2, SessionTime: 1_100_000},
+							{CustID: 999, LapNumber: 1, LapPosition: 1, SessionTime: 1_050_000},
+							{CustID: driverID, LapNumber: 2, LapPosition: 1, SessionTime: 2_000_000},
+							{CustID: 999, LapNumber: 2, LapPosition: 2, SessionTime: 2_010_000},
+							{CustID: driverID, LapNumber: 3, LapPosition: 1, SessionTime: 2_900_000},
+							// behind as lap 4 started, ahead by the end of it
+							{CustID: 999, LapNumber: 3, LapPosition: 2, SessionTime: 2_920_000},
+							{CustID: 999, LapNumber: 4, LapPosition: 2, SessionTime: 3_770_000},
+							{CustID: driverID, LapNumber: 4, LapPosition: 1, SessionTime: 3_830_000},
+							{CustID: driverID, LapNumber: 5, LapPosition: 1, SessionTime: 4_730_000},
+						},
+					},
+				},
+			},
+			persistSessionDataCalls: []persistSessionDataCall{
+				{
+					validate: func(t *testing.T, session store.DriverSession, laps []store.SessionDriverLap) {
+						require.NotNil(t, session.TrafficCost)
+						assert.Equal(t, 30_000, *session.TrafficCost)
+						assert.Equal(t, []int{1, 1, 0, 0, 0, 0}, session.Positions)
+						require.Len(t, laps, 6)
+						assert.Equal(t, subsessionID, laps[0].SubsessionID)
+						assert.Equal(t, driverID, laps[0].DriverID)
+						assert.Equal(t, 4, laps[4].LapNumber)
+						assert.Equal(t, 930_000, laps[4].LapTime)
+					},
+				},
+			},
+			emitCountCalls: []emitCountCall{
+				{name: metrics.DriverSessionsIngested, count: 1},
+				{name: metrics.LapsIngested, count: 6},
+				{name: metrics.LapSessionsIngested, count: 1},
+			},
+			saveIngestionRunCall: &saveIngestionRunCall{
+				validate: func(t *testing.T, run store.IngestionRun) {
+					assert.Equal(t, 6, run.LapCount)
+					assert.Contains(t, run.PhaseDurations, phaseLapFetch)
+				},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "raceIngested",
+					payload:    RaceReadyMsg{RaceID: sessionStartTime.Unix()},
+				},
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: bootstrapRangeBegin, IngestedTo: rangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: rangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: bootstrapRangeBegin,
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: rangeEnd,
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+			},
+		},
+		{
+			name: "driver session already exists - skips save and notify",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				result: []iracing.SeriesResult{
+					{SubsessionID: subsessionID},
+				},
+			},
+			getSessionResultsCalls: []getSessionResultsCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.SessionResult{
+						SubsessionID: subsessionID,
+						StartTime:    sessionStartTime,
+						SessionResults: []iracing.SimSessionResult{
+							{SimsessionNumber: 0, Results: []iracing.DriverResult{{CustID: driverID}}},
+						},
+					},
+				},
+			},
+			getDriverSessionCalls: []getDriverSessionCall{
+				{
+					driverID:  driverID,
+					startTime: sessionStartTime,
+					result: &store.DriverSession{
+						DriverID:     driverID,
+						SubsessionID: subsessionID,
+						StartTime:    sessionStartTime,
+					},
+				},
+			},
+			// No save calls - already exists
+			persistSessionDataCalls: []persistSessionDataCall{},
+			// No raceIngested broadcast - already exists
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: bootstrapRangeBegin, IngestedTo: rangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: rangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: bootstrapRangeBegin,
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: rangeEnd,
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+			},
+		},
+		{
+			name: "stale credentials on SearchSeriesResults - notifies and returns without error",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "stale-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				result:           nil,
+				err:              iracing.ErrUpstreamUnauthorized,
+			},
+			pushCalls: []pushCall{
+				{
+					connectionID: "conn-123",
+					actionType:   "ingestionFailedStaleCredentials",
+					result:       true,
+				},
+			},
+		},
+		{
+			name: "rate limited by iRacing - defers round until the limit resets",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				err:              &iracing.RateLimitError{ResetAt: now.Add(90 * time.Second)},
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+				opts: []event.PublishOption{event.WithDelay(90 * time.Second)},
+			},
+		},
+		{
+			name: "iRacing down for maintenance - defers round",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				err:              fmt.Errorf("%w: site maintenance", iracing.ErrMaintenance),
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+				opts: []event.PublishOption{event.WithDelay(maintenanceRetryDelay)},
+			},
+		},
+		{
+			name: "iRacing down for maintenance with known end - defers round until then",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				err:              &iracing.MaintenanceError{Reason: "site maintenance", Until: now.Add(10 * time.Minute)},
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+				opts: []event.PublishOption{event.WithDelay(10 * time.Minute)},
+			},
+		},
+		{
+			name: "iRacing known to be down - defers round without calling it",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			downUntilCall:            &downUntilCall{result: now.Add(5 * time.Minute)},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+				opts: []event.PublishOption{event.WithDelay(5 * time.Minute)},
+			},
+		},
+		{
+			name: "driver not found - returns error",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result:   nil,
+			},
+			expectedErr: "driver 12345 not found",
+		},
+		{
+			name: "team event - skipped without processing",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				result: []iracing.SeriesResult{
+					{SubsessionID: subsessionID, DriverChanges: true}, // team event
+				},
+			},
+			// No API calls or saves - team event is skipped
+			getSessionResultsCalls:  []getSessionResultsCall{},
+			persistSessionDataCalls: []persistSessionDataCall{},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: bootstrapRangeBegin, IngestedTo: rangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: rangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: bootstrapRangeBegin,
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: rangeEnd,
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+			},
+		},
+		{
+			name: "ingestion lock not acquired - skips processing",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: false},
+			// No other calls - lock not acquired means skip
+		},
+		{
+			name: "rate budget exhausted - defers round",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			reserveRateBudgetCall:    &reserveRateBudgetCall{retryAfter: 45 * time.Second},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+				opts: []event.PublishOption{event.WithDelay(45 * time.Second)},
+			},
+		},
+		{
+			name: "rate budget exhausted - defer publish error returns error",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			reserveRateBudgetCall:    &reserveRateBudgetCall{retryAfter: 45 * time.Second},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+				opts: []event.PublishOption{event.WithDelay(45 * time.Second)},
+				err:  errors.New("sqs error"),
+			},
+			expectedErr: "deferring ingestion round",
+		},
+		{
+			name: "rate budget error - logged and ingestion goes ahead",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			reserveRateBudgetCall:    &reserveRateBudgetCall{err: errors.New("db error")},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result:   nil,
+			},
+			expectedErr: "driver 12345 not found",
+		},
+		{
+			name: "cancelled before the round starts - stops without searching",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall:      acquireIngestionLockCall{driverID: driverID, acquired: true},
+			ingestionCancelRequestedCalls: []ingestionCancelRequestedCall{{requested: true}},
+			releaseIngestionLockCall:      &releaseIngestionLockCall{driverID: driverID},
+			clearIngestionCancelCall:      &clearIngestionCancelCall{},
+			broadcastCalls: []broadcastCall{
+				{driverID: driverID, actionType: "ingestionCancelled"},
+			},
+			saveIngestionRunCall: &saveIngestionRunCall{
+				validate: func(t *testing.T, run store.IngestionRun) {
+					assert.Equal(t, "ingestion cancelled", run.Error)
+				},
+			},
+		},
+		{
+			name: "cancelled between races - stops without recording coverage or dispatching another round",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			ingestionCancelRequestedCalls: []ingestionCancelRequestedCall{
+				{requested: false}, // before the round
+				{requested: true},  // before the second race
+			},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				result: []iracing.SeriesResult{
+					{SubsessionID: subsessionID, DriverChanges: true}, // team events need no further calls
+					{SubsessionID: subsessionID + 1, DriverChanges: true},
+				},
+			},
+			clearIngestionCancelCall: &clearIngestionCancelCall{},
+			broadcastCalls: []broadcastCall{
+				{driverID: driverID, actionType: "ingestionCancelled"},
+			},
+		},
+		{
+			name: "cancel check error - logged and the round carries on",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall:      acquireIngestionLockCall{driverID: driverID, acquired: true},
+			ingestionCancelRequestedCalls: []ingestionCancelRequestedCall{{err: errors.New("db error")}},
+			releaseIngestionLockCall:      &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result:   nil,
+			},
+			expectedErr: "driver 12345 not found",
+		},
+		{
+			name: "clearing cancel and notifying fail - logged and still stops",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall:      acquireIngestionLockCall{driverID: driverID, acquired: true},
+			ingestionCancelRequestedCalls: []ingestionCancelRequestedCall{{requested: true}},
+			releaseIngestionLockCall:      &releaseIngestionLockCall{driverID: driverID},
+			clearIngestionCancelCall:      &clearIngestionCancelCall{err: errors.New("db error")},
+			broadcastCalls: []broadcastCall{
+				{driverID: driverID, actionType: "ingestionCancelled", err: errors.New("ws error")},
+			},
+		},
+		{
+			name: "driver not found in session results - logs warning and continues",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
 				finishRangeEnd:   rangeEnd,
 				result: []iracing.SeriesResult{
 					{SubsessionID: subsessionID},
@@ -447,36 +1376,1194 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 					},
 				},
 			},
-			getDriverSessionCalls: []getDriverSessionCall{
+			getDriverSessionCalls: []getDriverSessionCall{
+				{
+					driverID:  driverID,
+					startTime: sessionStartTime,
+					result:    nil,
+				},
+			},
+			// No save - driver not in results
+			persistSessionDataCalls: []persistSessionDataCall{},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: bootstrapRangeBegin, IngestedTo: rangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: rangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: bootstrapRangeBegin,
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: rangeEnd,
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+			},
+		},
+		{
+			name: "old race - no raceIngested broadcast for races older than 30 days",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				result: []iracing.SeriesResult{
+					{SubsessionID: subsessionID},
+				},
+			},
+			getSessionResultsCalls: []getSessionResultsCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.SessionResult{
+						SubsessionID:    subsessionID,
+						SeriesID:        42,
+						SeriesName:      "Test Series",
+						LicenseCategory: "Road",
+						Track:           iracing.Track{TrackID: 123},
+						StartTime:       time.Date(2020, 1, 5, 18, 0, 0, 0, time.UTC), // 4+ years old, well beyond 30 days
+						SessionResults: []iracing.SimSessionResult{
+							{
+								SimsessionNumber: 0,
+								Results: []iracing.DriverResult{
+									{
+										CustID:           driverID,
+										DisplayName:      "Test Driver",
+										CarID:            10,
+										StartingPosition: 5,
+										FinishPosition:   3,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			getDriverSessionCalls: []getDriverSessionCall{
+				{
+					driverID:  driverID,
+					startTime: time.Date(2020, 1, 5, 18, 0, 0, 0, time.UTC),
+					result:    nil, // doesn't exist
+				},
+			},
+			persistSessionDataCalls: []persistSessionDataCall{
+				{
+					validate: func(t *testing.T, session store.DriverSession, laps []store.SessionDriverLap) {
+						assert.Equal(t, driverID, session.DriverID)
+					},
+				},
+			},
+			emitCountCalls: []emitCountCall{
+				{name: metrics.DriverSessionsIngested, count: 1},
+			},
+			// Key assertion: NO raceIngested broadcast for old races, only ingestionChunkComplete
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: bootstrapRangeBegin, IngestedTo: rangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: rangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: bootstrapRangeBegin,
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: rangeEnd,
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+			},
+		},
+		{
+			name: "continuation ingestion - applies 4-hour buffer to search range",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			// No releaseIngestionLockCall - willBeUpToDate=true so lock expires naturally
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:        driverID,
+					MemberSince:     memberSince,
+					RacesIngestedTo: &racesIngestedTo, // continuing from previous ingestion
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: continuationRangeBegin, // RacesIngestedTo - 4 hours
+				finishRangeEnd:   continuationRangeEnd,   // capped by now
+				result:           []iracing.SeriesResult{},
+			},
+			// No races found, so no session calls
+			getSessionResultsCalls:  []getSessionResultsCall{},
+			persistSessionDataCalls: []persistSessionDataCall{},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: memberSince, IngestedTo: continuationRangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: memberSince, To: racesIngestedTo}, {From: continuationRangeBegin, To: continuationRangeEnd}},
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: continuationRangeEnd,
+			},
+			// No publishEventCall - willBeUpToDate=true since rangeEnd was capped by now
+		},
+		{
+			name: "up to date - snapshots driver standing",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			// No releaseIngestionLockCall - willBeUpToDate=true so lock expires naturally
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:        driverID,
+					MemberSince:     memberSince,
+					RacesIngestedTo: &racesIngestedTo, // continuing from previous ingestion
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: continuationRangeBegin, // RacesIngestedTo - 4 hours
+				finishRangeEnd:   continuationRangeEnd,   // capped by now
+				result:           []iracing.SeriesResult{},
+			},
+			// No races found, so no session calls
+			getSessionResultsCalls:  []getSessionResultsCall{},
+			persistSessionDataCalls: []persistSessionDataCall{},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: memberSince, IngestedTo: continuationRangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: memberSince, To: racesIngestedTo}, {From: continuationRangeBegin, To: continuationRangeEnd}},
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: continuationRangeEnd,
+			},
+			snapshotDriverStandingCall: &snapshotDriverStandingCall{driverID: driverID, err: nil}, // standing snapshotted once caught up
+			evaluateAlertsCall:         &evaluateAlertsCall{driverID: driverID},                   // as are trend alerts
+			refreshSeasonCardCall:      &refreshSeasonCardCall{driverID: driverID},                // and season cards refreshed
+			refreshOverlayCall:         &refreshOverlayCall{driverID: driverID},                   // and overlays
+		},
+		{
+			name: "standing snapshot error - logged and ingestion still succeeds",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			// No releaseIngestionLockCall - willBeUpToDate=true so lock expires naturally
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:        driverID,
+					MemberSince:     memberSince,
+					RacesIngestedTo: &racesIngestedTo, // continuing from previous ingestion
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: continuationRangeBegin, // RacesIngestedTo - 4 hours
+				finishRangeEnd:   continuationRangeEnd,   // capped by now
+				result:           []iracing.SeriesResult{},
+			},
+			// No races found, so no session calls
+			getSessionResultsCalls:  []getSessionResultsCall{},
+			persistSessionDataCalls: []persistSessionDataCall{},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: memberSince, IngestedTo: continuationRangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: memberSince, To: racesIngestedTo}, {From: continuationRangeBegin, To: continuationRangeEnd}},
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: continuationRangeEnd,
+			},
+			snapshotDriverStandingCall: &snapshotDriverStandingCall{driverID: driverID, err: errors.New("upstream error")}, // snapshot errors don't fail ingestion
+			evaluateAlertsCall:         &evaluateAlertsCall{driverID: driverID, err: errors.New("db error")},               // nor do alert errors
+			refreshSeasonCardCall:      &refreshSeasonCardCall{driverID: driverID, err: errors.New("db error")},            // nor do season card errors
+			refreshOverlayCall:         &refreshOverlayCall{driverID: driverID, err: errors.New("db error")},               // nor do overlay errors
+			advanceOnboardingCall:      &advanceOnboardingCall{driverID: driverID, err: errors.New("db error")},            // nor do onboarding errors
+		},
+		{
+			name: "caught up with history remaining - dispatches backwards round",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:          driverID,
+					MemberSince:       memberSince,
+					RacesIngestedFrom: &racesIngestedFrom,
+					RacesIngestedTo:   &racesIngestedTo,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: continuationRangeBegin,
+				finishRangeEnd:   continuationRangeEnd,
+				result:           []iracing.SeriesResult{},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: racesIngestedFrom, IngestedTo: continuationRangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: racesIngestedFrom, To: racesIngestedTo}, {From: continuationRangeBegin, To: continuationRangeEnd}},
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: continuationRangeEnd,
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+					Backwards:          true,
+				},
+			},
+			// No snapshotDriverStandingCall - that waits for the last round
+		},
+		{
+			name: "backwards round - walks history towards member since",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+				Backwards:          true,
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:          driverID,
+					MemberSince:       memberSince,
+					RacesIngestedFrom: &racesIngestedFrom,
+					RacesIngestedTo:   &racesIngestedTo,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: backwardsRangeBegin,
+				finishRangeEnd:   racesIngestedFrom,
+				result:           []iracing.SeriesResult{},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: backwardsRangeBegin, IngestedTo: racesIngestedTo},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: racesIngestedFrom, To: racesIngestedTo}, {From: backwardsRangeBegin, To: racesIngestedFrom}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: backwardsRangeBegin,
+			},
+			// No updateDriverRacesIngestedToCall - backwards rounds leave the forward cursor alone
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+					Backwards:          true,
+				},
+			},
+		},
+		{
+			name: "backwards round - reaches member since and snapshots driver standing",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+				Backwards:          true,
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			// No releaseIngestionLockCall - history is complete so lock expires naturally
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:          driverID,
+					MemberSince:       memberSince,
+					RacesIngestedFrom: &nearlyCompleteFrom,
+					RacesIngestedTo:   &racesIngestedTo,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: memberSince, // search window clamped to member since
+				finishRangeEnd:   nearlyCompleteFrom,
+				result:           []iracing.SeriesResult{},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: memberSince, IngestedTo: racesIngestedTo},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: nearlyCompleteFrom, To: racesIngestedTo}, {From: memberSince, To: nearlyCompleteFrom}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: memberSince,
+			},
+			snapshotDriverStandingCall: &snapshotDriverStandingCall{driverID: driverID},
+		},
+		{
+			name: "caught up with a gap in coverage - dispatches backwards round",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:        driverID,
+					MemberSince:     memberSince,
+					RacesIngestedTo: &racesIngestedTo, // cursors alone say history is complete
+				},
+			},
+			getIngestionCoverageCall: &getIngestionCoverageCall{
+				result: store.Coverage{{From: memberSince, To: gapBegin}, {From: racesIngestedFrom, To: racesIngestedTo}},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: continuationRangeBegin,
+				finishRangeEnd:   continuationRangeEnd,
+				result:           []iracing.SeriesResult{},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: racesIngestedFrom, IngestedTo: continuationRangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: continuationRangeBegin, To: continuationRangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: racesIngestedFrom,
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: continuationRangeEnd,
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+					Backwards:          true,
+				},
+			},
+		},
+		{
+			name: "backwards round - fills the most recent gap in coverage",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+				Backwards:          true,
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:          driverID,
+					MemberSince:       memberSince,
+					RacesIngestedFrom: &racesIngestedFrom,
+					RacesIngestedTo:   &racesIngestedTo,
+				},
+			},
+			getIngestionCoverageCall: &getIngestionCoverageCall{
+				result: store.Coverage{
+					{From: memberSince, To: memberSince.Add(time.Hour * 24)},
+					{From: gapBegin, To: gapEnd},
+					{From: racesIngestedFrom, To: racesIngestedTo},
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: gapEnd, // the gap between gapEnd and racesIngestedFrom is shorter than a search window
+				finishRangeEnd:   racesIngestedFrom,
+				result:           []iracing.SeriesResult{},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: gapBegin, IngestedTo: racesIngestedTo},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: gapEnd, To: racesIngestedFrom}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: gapBegin,
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+					Backwards:          true,
+				},
+			},
+		},
+		{
+			name: "add ingestion coverage error - releases lock and returns error",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				result:           []iracing.SeriesResult{},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: rangeEnd}},
+				err:    errors.New("conditional check failed"),
+			},
+			expectedErr: "updating ingestion coverage",
+		},
+		{
+			name: "get ingestion coverage error - releases lock and returns error",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			getIngestionCoverageCall: &getIngestionCoverageCall{err: errors.New("db error")},
+			expectedErr:              "getting ingestion coverage",
+		},
+		{
+			name: "backwards round after races were deleted - nothing to do",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+				Backwards:          true,
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+		},
+		{
+			name: "new driver who joined within the bootstrap window - ingests from member since",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: racesIngestedTo,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: racesIngestedTo,
+				finishRangeEnd:   now,
+				result:           []iracing.SeriesResult{},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: racesIngestedTo, IngestedTo: now},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: racesIngestedTo, To: now}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: racesIngestedTo,
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: now,
+			},
+			// No publishEventCall - caught up with no history before member since
+		},
+		{
+			name: "multiple multiclass races - each session persisted with only its own laps",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				result: []iracing.SeriesResult{
+					{SubsessionID: subsessionID},
+					{SubsessionID: 88888},
+				},
+			},
+			lapConsumptionConcurrency: 2,
+			getSessionResultsCalls: []getSessionResultsCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.SessionResult{
+						SubsessionID: subsessionID,
+						StartTime:    sessionStartTime,
+						SessionResults: []iracing.SimSessionResult{
+							{
+								SimsessionNumber: 0,
+								Results: []iracing.DriverResult{
+									{CustID: 999, CarClassID: 1, CarID: 20},
+									{CustID: driverID, CarClassID: 2, CarID: 10, ReasonOut: "Running"},
+								},
+							},
+						},
+					},
+				},
+				{
+					subsessionID: 88888,
+					result: &iracing.SessionResult{
+						SubsessionID: 88888,
+						StartTime:    sessionStartTime.Add(-time.Hour * 24),
+						SessionResults: []iracing.SimSessionResult{
+							{
+								SimsessionNumber: 0,
+								Results: []iracing.DriverResult{
+									{CustID: 999, CarClassID: 1, CarID: 20},
+									{CustID: driverID, CarClassID: 2, CarID: 10, ReasonOut: "Running"},
+								},
+							},
+						},
+					},
+				},
+			},
+			getDriverSessionCalls: []getDriverSessionCall{
+				{driverID: driverID, startTime: sessionStartTime},
+				{driverID: driverID, startTime: sessionStartTime.Add(-time.Hour * 24)},
+			},
+			getLapDataCalls: []getLapDataCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.LapDataResponse{
+						Laps: []iracing.Lap{
+							{LapNumber: 1, LapTime: 900_000},
+							{LapNumber: 2, LapTime: 900_000},
+						},
+					},
+				},
+				{
+					subsessionID: 88888,
+					result: &iracing.LapDataResponse{
+						Laps: []iracing.Lap{
+							{LapNumber: 1, LapTime: 910_000},
+							{LapNumber: 2, LapTime: 910_000},
+						},
+					},
+				},
+			},
+			getLapChartDataCalls: []getLapChartDataCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.LapChartResponse{
+						Laps: []iracing.LapChartLap{
+							{CustID: driverID, LapNumber: 1, LapPosition: 2},
+							{CustID: driverID, LapNumber: 2, LapPosition: 2},
+						},
+					},
+				},
+				{
+					// the race still goes in without a position timeline
+					subsessionID: 88888,
+					err:          errors.New("lap chart unavailable"),
+				},
+			},
+			persistSessionDataCalls: []persistSessionDataCall{
+				{
+					validate: func(t *testing.T, session store.DriverSession, laps []store.SessionDriverLap) {
+						require.Len(t, laps, 2)
+						for _, lap := range laps {
+							assert.Equal(t, session.SubsessionID, lap.SubsessionID)
+						}
+					},
+				},
+				{
+					validate: func(t *testing.T, session store.DriverSession, laps []store.SessionDriverLap) {
+						require.Len(t, laps, 2)
+						for _, lap := range laps {
+							assert.Equal(t, session.SubsessionID, lap.SubsessionID)
+						}
+					},
+				},
+			},
+			emitCountCalls: []emitCountCall{
+				{name: metrics.DriverSessionsIngested, count: 1},
+				{name: metrics.LapsIngested, count: 2},
+				{name: metrics.LapSessionsIngested, count: 1},
+				{name: metrics.DriverSessionsIngested, count: 1},
+				{name: metrics.LapsIngested, count: 2},
+				{name: metrics.LapSessionsIngested, count: 1},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "raceIngested",
+					payload:    RaceReadyMsg{RaceID: sessionStartTime.Unix()},
+				},
+				{
+					driverID:   driverID,
+					actionType: "raceIngested",
+					payload:    RaceReadyMsg{RaceID: sessionStartTime.Add(-time.Hour * 24).Unix()},
+				},
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: bootstrapRangeBegin, IngestedTo: rangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: rangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: bootstrapRangeBegin,
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: rangeEnd,
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+			},
+		},
+		{
+			name: "persist session data error - releases lock and returns error",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				result: []iracing.SeriesResult{
+					{SubsessionID: subsessionID},
+				},
+			},
+			getSessionResultsCalls: []getSessionResultsCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.SessionResult{
+						SubsessionID: subsessionID,
+						StartTime:    sessionStartTime,
+						SessionResults: []iracing.SimSessionResult{
+							{
+								SimsessionNumber: 0,
+								Results: []iracing.DriverResult{
+									{CustID: 999, CarClassID: 1, CarID: 20},
+									{CustID: driverID, CarClassID: 2, CarID: 10, ReasonOut: "Running"},
+								},
+							},
+						},
+					},
+				},
+			},
+			getDriverSessionCalls: []getDriverSessionCall{
+				{driverID: driverID, startTime: sessionStartTime},
+			},
+			getLapDataCalls: []getLapDataCall{
+				{
+					subsessionID: subsessionID,
+					result:       &iracing.LapDataResponse{Laps: []iracing.Lap{{LapNumber: 1, LapTime: 900_000}}},
+				},
+			},
+			getLapChartDataCalls: []getLapChartDataCall{
+				{
+					subsessionID: subsessionID,
+					result:       &iracing.LapChartResponse{},
+				},
+			},
+			persistSessionDataCalls: []persistSessionDataCall{
+				{err: errors.New("transaction cancelled")},
+			},
+			expectedErr: "persisting session data",
+		},
+		{
+			name: "summary only ingestion - multiclass race skips lap data",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			getDriverSettingsCall: &getDriverSettingsCall{
+				driverID: driverID,
+				result:   &store.DriverSettings{DriverID: driverID, SummaryOnlyIngestion: true},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   rangeEnd,
+				result: []iracing.SeriesResult{
+					{SubsessionID: subsessionID},
+				},
+			},
+			getSessionResultsCalls: []getSessionResultsCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.SessionResult{
+						SubsessionID: subsessionID,
+						StartTime:    sessionStartTime,
+						SessionResults: []iracing.SimSessionResult{
+							{
+								SimsessionNumber: 0,
+								Results: []iracing.DriverResult{
+									{CustID: 999, CarClassID: 1, CarID: 20},
+									{CustID: driverID, CarClassID: 2, CarID: 10, ReasonOut: "Running"},
+								},
+							},
+						},
+					},
+				},
+			},
+			getDriverSessionCalls: []getDriverSessionCall{
+				{driverID: driverID, startTime: sessionStartTime},
+			},
+			// No getLapDataCalls
+			persistSessionDataCalls: []persistSessionDataCall{
+				{
+					validate: func(t *testing.T, session store.DriverSession, laps []store.SessionDriverLap) {
+						assert.True(t, session.LapsSkipped)
+						assert.Nil(t, session.TrafficCost)
+						assert.Empty(t, laps)
+					},
+				},
+			},
+			emitCountCalls: []emitCountCall{
+				{name: metrics.DriverSessionsIngested, count: 1},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "raceIngested",
+					payload:    RaceReadyMsg{RaceID: sessionStartTime.Unix()},
+				},
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: bootstrapRangeBegin, IngestedTo: rangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: rangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: bootstrapRangeBegin,
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: rangeEnd,
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+			},
+		},
+		{
+			name: "driver tier - wider search window and laps skipped with a backfill left pending",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:     driverID,
+					MemberSince:  memberSince,
+					Entitlements: []string{"supporter"},
+				},
+			},
+			getIngestionTiersCall: &getIngestionTiersCall{
+				result: []store.IngestionTier{
+					{Name: DefaultTierName, SearchWindowDays: 5},
+					{Name: "supporter", Priority: 10, SearchWindowDays: 20, LapsDisabled: true},
+				},
+			},
+			saveDriverSettingsCall: &saveDriverSettingsCall{
+				settings: store.DriverSettings{DriverID: driverID, LapBackfillPending: true},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: bootstrapRangeBegin,
+				finishRangeEnd:   tierRangeEnd,
+				result: []iracing.SeriesResult{
+					{SubsessionID: subsessionID},
+				},
+			},
+			getSessionResultsCalls: []getSessionResultsCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.SessionResult{
+						SubsessionID: subsessionID,
+						StartTime:    sessionStartTime,
+						SessionResults: []iracing.SimSessionResult{
+							{
+								SimsessionNumber: 0,
+								Results: []iracing.DriverResult{
+									{CustID: 999, CarClassID: 1, CarID: 20},
+									{CustID: driverID, CarClassID: 2, CarID: 10, ReasonOut: "Running"},
+								},
+							},
+						},
+					},
+				},
+			},
+			getDriverSessionCalls: []getDriverSessionCall{
+				{driverID: driverID, startTime: sessionStartTime},
+			},
+			// No getLapDataCalls
+			persistSessionDataCalls: []persistSessionDataCall{
+				{
+					validate: func(t *testing.T, session store.DriverSession, laps []store.SessionDriverLap) {
+						assert.True(t, session.LapsSkipped)
+						assert.Empty(t, laps)
+					},
+				},
+			},
+			emitCountCalls: []emitCountCall{
+				{name: metrics.DriverSessionsIngested, count: 1},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "raceIngested",
+					payload:    RaceReadyMsg{RaceID: sessionStartTime.Unix()},
+				},
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: bootstrapRangeBegin, IngestedTo: tierRangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: bootstrapRangeBegin, To: tierRangeEnd}},
+			},
+			updateDriverRacesIngestedFromCall: &updateDriverRacesIngestedFromCall{
+				driverID:          driverID,
+				racesIngestedFrom: bootstrapRangeBegin,
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: tierRangeEnd,
+			},
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+			},
+		},
+		{
+			name: "marking lap backfill pending for a tier without laps fails - releases lock and returns error",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:    driverID,
+					MemberSince: memberSince,
+				},
+			},
+			getIngestionTiersCall: &getIngestionTiersCall{
+				result: []store.IngestionTier{{Name: DefaultTierName, LapsDisabled: true}},
+			},
+			saveDriverSettingsCall: &saveDriverSettingsCall{
+				settings: store.DriverSettings{DriverID: driverID, LapBackfillPending: true},
+				err:      errors.New("dynamo error"),
+			},
+			expectedErr: "marking lap backfill pending",
+		},
+		{
+			name: "ingestion tiers error - logged and the round goes by the processor's own settings",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:        driverID,
+					MemberSince:     memberSince,
+					RacesIngestedTo: &racesIngestedTo,
+					Entitlements:    []string{"supporter"},
+				},
+			},
+			getIngestionTiersCall: &getIngestionTiersCall{err: errors.New("dynamo error")},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: continuationRangeBegin,
+				finishRangeEnd:   continuationRangeEnd,
+				result:           []iracing.SeriesResult{},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: memberSince, IngestedTo: continuationRangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: memberSince, To: racesIngestedTo}, {From: continuationRangeBegin, To: continuationRangeEnd}},
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: continuationRangeEnd,
+			},
+		},
+		{
+			name: "lap backfill pending - skipped laps pulled once caught up",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			// No releaseIngestionLockCall - backfill finished so lock expires naturally
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:        driverID,
+					MemberSince:     memberSince,
+					RacesIngestedTo: &racesIngestedTo,
+				},
+			},
+			getDriverSettingsCall: &getDriverSettingsCall{
+				driverID: driverID,
+				result:   &store.DriverSettings{DriverID: driverID, LapBackfillPending: true},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: continuationRangeBegin,
+				finishRangeEnd:   continuationRangeEnd,
+				result:           []iracing.SeriesResult{},
+			},
+			broadcastCalls: []broadcastCall{
+				{
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: memberSince, IngestedTo: continuationRangeEnd},
+				},
+			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: memberSince, To: racesIngestedTo}, {From: continuationRangeBegin, To: continuationRangeEnd}},
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: continuationRangeEnd,
+			},
+			getDriverSessionsWithSkippedLapsCall: &getDriverSessionsWithSkippedLapsCall{
+				driverID: driverID,
+				result: []store.DriverSession{
+					{DriverID: driverID, SubsessionID: subsessionID, StartTime: sessionStartTime, LapsSkipped: true},
+				},
+			},
+			getLapDataCalls: []getLapDataCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.LapDataResponse{
+						Laps: []iracing.Lap{
+							{LapNumber: 0, LapTime: -1, SessionTime: 100_000},
+							{LapNumber: 1, LapTime: 1_000_000, SessionTime: 1_100_000},
+							{LapNumber: 2, LapTime: 900_000, SessionTime: 2_000_000},
+							{LapNumber: 3, LapTime: 900_000, SessionTime: 2_900_000},
+							{LapNumber: 4, LapTime: 930_000, SessionTime: 3_830_000},
+							{LapNumber: 5, LapTime: 900_000, SessionTime: 4_730_000},
+						},
+					},
+				},
+			},
+			// who was in a faster class and when they went by, for the traffic cost
+			getSessionResultsCalls: []getSessionResultsCall{
+				{
+					subsessionID: subsessionID,
+					result: &iracing.SessionResult{
+						SubsessionID: subsessionID,
+						StartTime:    sessionStartTime,
+						SessionResults: []iracing.SimSessionResult{
+							{
+								SimsessionNumber: 0,
+								Results: []iracing.DriverResult{
+									{CustID: 999, CarClassID: 1, CarID: 20, BestLapTime: 850_000},
+									{CustID: driverID, CarClassID: 2, CarID: 10, ReasonOut: "Running", BestLapTime: 900_000},
+								},
+							},
+						},
+					},
+				},
+			},
+			getLapChartDataCalls: []getLapChartDataCall{
 				{
-					driverID:  driverID,
-					startTime: sessionStartTime,
-					result:    nil,
+					subsessionID: subsessionID,
+					result: &iracing.LapChartResponse{
+						Laps: []iracing.LapChartLap{
+							{CustID: 999, LapNumber: 2, SessionTime: 2_010_000},
+							{CustID: 999, LapNumber: 3, SessionTime: 2_920_000},
+							{CustID: 999, LapNumber: 4, SessionTime: 3_770_000},
+						},
+					},
 				},
 			},
-			// No save - driver not in results
-			saveDriverSessionsCalls: []saveDriverSessionsCall{},
-			broadcastCalls: []broadcastCall{
+			saveSessionDriverLapsCalls: []saveSessionDriverLapsCall{
 				{
-					driverID:   driverID,
-					actionType: "ingestionChunkComplete",
-					payload:    ChunkCompleteMsg{IngestedTo: rangeEnd},
+					validate: func(t *testing.T, laps []store.SessionDriverLap) {
+						require.Len(t, laps, 6)
+						assert.Equal(t, subsessionID, laps[0].SubsessionID)
+						assert.Equal(t, driverID, laps[0].DriverID)
+					},
 				},
 			},
-			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
-				driverID:        driverID,
-				racesIngestedTo: rangeEnd,
+			completeDriverSessionLapsCalls: []completeDriverSessionLapsCall{
+				{driverID: driverID, startTime: sessionStartTime, trafficCost: aws.Int(30_000)},
 			},
-			publishEventCall: &publishEventCall{
-				event: RaceIngestionRequest{
-					DriverID:           driverID,
-					IRacingAccessToken: "test-token",
-					NotifyConnectionID: "conn-123",
-				},
+			emitCountCalls: []emitCountCall{
+				{name: metrics.LapsBackfilled, count: 1},
 			},
+			clearLapBackfillPendingCall: &clearLapBackfillPendingCall{driverID: driverID},
 		},
 		{
-			name: "old race - no raceIngested broadcast for races older than 30 days",
+			name: "lap backfill pending - more sessions than a batch dispatches another round",
 			request: RaceIngestionRequest{
 				DriverID:           driverID,
 				IRacingAccessToken: "test-token",
@@ -487,119 +2574,177 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 			getDriverCall: &getDriverCall{
 				driverID: driverID,
 				result: &store.Driver{
-					DriverID:    driverID,
-					MemberSince: memberSince,
+					DriverID:        driverID,
+					MemberSince:     memberSince,
+					RacesIngestedTo: &racesIngestedTo,
 				},
 			},
+			getDriverSettingsCall: &getDriverSettingsCall{
+				driverID: driverID,
+				result:   &store.DriverSettings{DriverID: driverID, LapBackfillPending: true},
+			},
 			searchSeriesResultsCall: &searchSeriesResultsCall{
-				finishRangeBegin: memberSince,
-				finishRangeEnd:   rangeEnd,
-				result: []iracing.SeriesResult{
-					{SubsessionID: subsessionID},
-				},
+				finishRangeBegin: continuationRangeBegin,
+				finishRangeEnd:   continuationRangeEnd,
+				result:           []iracing.SeriesResult{},
 			},
-			getSessionResultsCalls: []getSessionResultsCall{
+			broadcastCalls: []broadcastCall{
 				{
-					subsessionID: subsessionID,
-					result: &iracing.SessionResult{
-						SubsessionID:    subsessionID,
-						SeriesID:        42,
-						SeriesName:      "Test Series",
-						LicenseCategory: "Road",
-						Track:           iracing.Track{TrackID: 123},
-						StartTime:       time.Date(2020, 1, 5, 18, 0, 0, 0, time.UTC), // 4+ years old, well beyond 30 days
-						SessionResults: []iracing.SimSessionResult{
-							{
-								SimsessionNumber: 0,
-								Results: []iracing.DriverResult{
-									{
-										CustID:           driverID,
-										DisplayName:      "Test Driver",
-										CarID:            10,
-										StartingPosition: 5,
-										FinishPosition:   3,
-									},
-								},
-							},
-						},
-					},
+					driverID:   driverID,
+					actionType: "ingestionChunkComplete",
+					payload:    ChunkCompleteMsg{IngestedFrom: memberSince, IngestedTo: continuationRangeEnd},
 				},
 			},
-			getDriverSessionCalls: []getDriverSessionCall{
-				{
-					driverID:  driverID,
-					startTime: time.Date(2020, 1, 5, 18, 0, 0, 0, time.UTC),
-					result:    nil, // doesn't exist
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: memberSince, To: racesIngestedTo}, {From: continuationRangeBegin, To: continuationRangeEnd}},
+			},
+			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
+				driverID:        driverID,
+				racesIngestedTo: continuationRangeEnd,
+			},
+			lapBackfillBatchSize: 1,
+			getDriverSessionsWithSkippedLapsCall: &getDriverSessionsWithSkippedLapsCall{
+				driverID: driverID,
+				result: []store.DriverSession{
+					{DriverID: driverID, SubsessionID: subsessionID, StartTime: sessionStartTime, LapsSkipped: true},
+					{DriverID: driverID, SubsessionID: 88888, StartTime: sessionStartTime.Add(-time.Hour * 24), LapsSkipped: true},
 				},
 			},
-			saveDriverSessionsCalls: []saveDriverSessionsCall{
+			getLapDataCalls: []getLapDataCall{
 				{
-					validate: func(t *testing.T, sessions []store.DriverSession) {
-						require.Len(t, sessions, 1)
-						assert.Equal(t, driverID, sessions[0].DriverID)
-					},
+					subsessionID: subsessionID,
+					result:       &iracing.LapDataResponse{},
 				},
 			},
+			completeDriverSessionLapsCalls: []completeDriverSessionLapsCall{
+				{driverID: driverID, startTime: sessionStartTime},
+			},
 			emitCountCalls: []emitCountCall{
-				{name: metrics.DriverSessionsIngested, count: 1},
+				{name: metrics.LapsBackfilled, count: 1},
+			},
+			// No clearLapBackfillPendingCall - the next round picks up the rest
+			publishEventCall: &publishEventCall{
+				event: RaceIngestionRequest{
+					DriverID:           driverID,
+					IRacingAccessToken: "test-token",
+					NotifyConnectionID: "conn-123",
+				},
+			},
+		},
+		{
+			name: "lap backfill pending but summary only ingestion still on - no backfill",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:        driverID,
+					MemberSince:     memberSince,
+					RacesIngestedTo: &racesIngestedTo,
+				},
+			},
+			getDriverSettingsCall: &getDriverSettingsCall{
+				driverID: driverID,
+				result:   &store.DriverSettings{DriverID: driverID, SummaryOnlyIngestion: true, LapBackfillPending: true},
+			},
+			searchSeriesResultsCall: &searchSeriesResultsCall{
+				finishRangeBegin: continuationRangeBegin,
+				finishRangeEnd:   continuationRangeEnd,
+				result:           []iracing.SeriesResult{},
 			},
-			// Key assertion: NO raceIngested broadcast for old races, only ingestionChunkComplete
 			broadcastCalls: []broadcastCall{
 				{
 					driverID:   driverID,
 					actionType: "ingestionChunkComplete",
-					payload:    ChunkCompleteMsg{IngestedTo: rangeEnd},
+					payload:    ChunkCompleteMsg{IngestedFrom: memberSince, IngestedTo: continuationRangeEnd},
 				},
 			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: memberSince, To: racesIngestedTo}, {From: continuationRangeBegin, To: continuationRangeEnd}},
+			},
 			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
 				driverID:        driverID,
-				racesIngestedTo: rangeEnd,
-			},
-			publishEventCall: &publishEventCall{
-				event: RaceIngestionRequest{
-					DriverID:           driverID,
-					IRacingAccessToken: "test-token",
-					NotifyConnectionID: "conn-123",
-				},
+				racesIngestedTo: continuationRangeEnd,
 			},
 		},
 		{
-			name: "continuation ingestion - applies 4-hour buffer to search range",
+			name: "lap backfill error - releases lock and returns error",
 			request: RaceIngestionRequest{
 				DriverID:           driverID,
 				IRacingAccessToken: "test-token",
 				NotifyConnectionID: "conn-123",
 			},
 			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
-			// No releaseIngestionLockCall - willBeUpToDate=true so lock expires naturally
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
 			getDriverCall: &getDriverCall{
 				driverID: driverID,
 				result: &store.Driver{
 					DriverID:        driverID,
 					MemberSince:     memberSince,
-					RacesIngestedTo: &racesIngestedTo, // continuing from previous ingestion
+					RacesIngestedTo: &racesIngestedTo,
 				},
 			},
+			getDriverSettingsCall: &getDriverSettingsCall{
+				driverID: driverID,
+				result:   &store.DriverSettings{DriverID: driverID, LapBackfillPending: true},
+			},
 			searchSeriesResultsCall: &searchSeriesResultsCall{
-				finishRangeBegin: continuationRangeBegin, // RacesIngestedTo - 4 hours
-				finishRangeEnd:   continuationRangeEnd,   // capped by now
+				finishRangeBegin: continuationRangeBegin,
+				finishRangeEnd:   continuationRangeEnd,
 				result:           []iracing.SeriesResult{},
 			},
-			// No races found, so no session calls
-			getSessionResultsCalls:  []getSessionResultsCall{},
-			saveDriverSessionsCalls: []saveDriverSessionsCall{},
 			broadcastCalls: []broadcastCall{
 				{
 					driverID:   driverID,
 					actionType: "ingestionChunkComplete",
-					payload:    ChunkCompleteMsg{IngestedTo: continuationRangeEnd},
+					payload:    ChunkCompleteMsg{IngestedFrom: memberSince, IngestedTo: continuationRangeEnd},
 				},
 			},
+			addIngestionCoverageCall: &addIngestionCoverageCall{
+				ranges: []store.TimeRange{{From: memberSince, To: racesIngestedTo}, {From: continuationRangeBegin, To: continuationRangeEnd}},
+			},
 			updateDriverRacesIngestedToCall: &updateDriverRacesIngestedToCall{
 				driverID:        driverID,
 				racesIngestedTo: continuationRangeEnd,
 			},
-			// No publishEventCall - willBeUpToDate=true since rangeEnd was capped by now
+			getDriverSessionsWithSkippedLapsCall: &getDriverSessionsWithSkippedLapsCall{
+				driverID: driverID,
+				err:      errors.New("dynamo error"),
+			},
+			expectedErr: "backfilling laps",
+		},
+		{
+			name: "get driver settings error - releases lock and returns error",
+			request: RaceIngestionRequest{
+				DriverID:           driverID,
+				IRacingAccessToken: "test-token",
+				NotifyConnectionID: "conn-123",
+			},
+			acquireIngestionLockCall: acquireIngestionLockCall{driverID: driverID, acquired: true},
+			releaseIngestionLockCall: &releaseIngestionLockCall{driverID: driverID},
+			getDriverCall: &getDriverCall{
+				driverID: driverID,
+				result: &store.Driver{
+					DriverID:        driverID,
+					MemberSince:     memberSince,
+					RacesIngestedTo: &racesIngestedTo,
+				},
+			},
+			getDriverSettingsCall: &getDriverSettingsCall{
+				driverID: driverID,
+				err:      errors.New("dynamo error"),
+			},
+			saveIngestionRunCall: &saveIngestionRunCall{
+				validate: func(t *testing.T, run store.IngestionRun) {
+					assert.Equal(t, driverID, run.DriverID)
+					assert.Contains(t, run.Error, "getting driver settings")
+				},
+			},
+			expectedErr: "getting driver settings",
 		},
 	}
 
@@ -625,10 +2770,19 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 
 			// Setup GetDriver
 			if tc.getDriverCall != nil {
-				mockStore.EXPECT().GetDriver(mock.Anything, tc.getDriverCall.driverID).
+				mockStore.EXPECT().GetDriver(mock.Anything, tc.getDriverCall.driverID, []store.ReadOption{store.ConsistentRead()}).
 					Return(tc.getDriverCall.result, tc.getDriverCall.err)
 			}
 
+			// Setup GetDriverSettings, drivers that were found get default settings unless the case says otherwise
+			if tc.getDriverSettingsCall != nil {
+				mockStore.EXPECT().GetDriverSettings(mock.Anything, tc.getDriverSettingsCall.driverID).
+					Return(tc.getDriverSettingsCall.result, tc.getDriverSettingsCall.err)
+			} else if tc.getDriverCall != nil && tc.getDriverCall.result != nil && tc.getDriverCall.err == nil {
+				mockStore.EXPECT().GetDriverSettings(mock.Anything, tc.getDriverCall.driverID).
+					Return(&store.DriverSettings{DriverID: tc.getDriverCall.driverID}, nil)
+			}
+
 			// Setup SearchSeriesResults
 			if tc.searchSeriesResultsCall != nil {
 				mockIRacing.EXPECT().SearchSeriesResults(
@@ -656,26 +2810,92 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 					Return(call.result, call.err)
 			}
 
-			// Setup SaveDriverSessions calls
-			for _, call := range tc.saveDriverSessionsCalls {
-				mockStore.EXPECT().SaveDriverSessions(mock.Anything, mock.MatchedBy(func(sessions []store.DriverSession) bool {
+			// Setup GetLapData calls
+			for _, call := range tc.getLapDataCalls {
+				mockIRacing.EXPECT().GetLapData(
+					mock.Anything,
+					tc.request.IRacingAccessToken,
+					call.subsessionID,
+					mainEventSessionNumber,
+					[]iracing.GetLapDataOption{iracing.WithCustomerIDLap(tc.request.DriverID)},
+				).Return(call.result, call.err)
+			}
+
+			// Setup GetLapChartData calls
+			for _, call := range tc.getLapChartDataCalls {
+				mockIRacing.EXPECT().GetLapChartData(
+					mock.Anything,
+					tc.request.IRacingAccessToken,
+					call.subsessionID,
+					mainEventSessionNumber,
+				).Return(call.result, call.err)
+			}
+
+			// Setup SaveSessionDriverLaps calls
+			for _, call := range tc.saveSessionDriverLapsCalls {
+				mockStore.EXPECT().SaveSessionDriverLaps(mock.Anything, mock.MatchedBy(func(laps []store.SessionDriverLap) bool {
 					if call.validate != nil {
-						call.validate(t, sessions)
+						call.validate(t, laps)
 					}
 					return true
 				})).Return(call.err)
 			}
 
+			// Setup PersistSessionData calls
+			for _, call := range tc.persistSessionDataCalls {
+				mockStore.EXPECT().PersistSessionData(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, session store.DriverSession, laps []store.SessionDriverLap) error {
+						if call.validate != nil {
+							call.validate(t, session, laps)
+						}
+						return call.err
+					}).Once()
+			}
+
 			// Setup Push calls
 			for _, call := range tc.pushCalls {
 				mockPusher.EXPECT().Push(mock.Anything, call.connectionID, call.actionType, mock.Anything).
 					Return(call.result, call.err)
 			}
 
-			// Setup Broadcast calls
+			// Setup Broadcast calls, races announced as ingested are also prompted for a journal entry carrying any plan
+			// evaluation
+			var planEvaluation *store.PlanEvaluation
+			if tc.linkRacePlanCall != nil {
+				planEvaluation = tc.linkRacePlanCall.evaluation
+			}
 			for _, call := range tc.broadcastCalls {
 				mockPusher.EXPECT().Broadcast(mock.Anything, call.driverID, call.actionType, call.payload).
 					Return(call.err)
+				if msg, ok := call.payload.(RaceReadyMsg); ok && call.err == nil {
+					mockStore.EXPECT().SaveJournalPrompt(mock.Anything, store.JournalPrompt{DriverID: call.driverID, RaceID: msg.RaceID, PlanEvaluation: planEvaluation}).
+						Return(tc.saveJournalPromptErr)
+				}
+			}
+
+			// Setup GetIngestionCoverage, drivers whose settings loaded have none recorded unless the case says otherwise
+			if tc.getIngestionCoverageCall != nil {
+				mockStore.EXPECT().GetIngestionCoverage(mock.Anything, tc.request.DriverID, []store.ReadOption{store.ConsistentRead()}).
+					Return(tc.getIngestionCoverageCall.result, tc.getIngestionCoverageCall.err)
+			} else if tc.getDriverCall != nil && tc.getDriverCall.result != nil && tc.getDriverCall.err == nil &&
+				(tc.getDriverSettingsCall == nil || tc.getDriverSettingsCall.err == nil) {
+				mockStore.EXPECT().GetIngestionCoverage(mock.Anything, tc.request.DriverID, []store.ReadOption{store.ConsistentRead()}).
+					Return(nil, nil)
+			}
+
+			// Setup AddIngestionCoverage
+			if tc.addIngestionCoverageCall != nil {
+				mockStore.EXPECT().AddIngestionCoverage(mock.Anything, tc.request.DriverID, tc.addIngestionCoverageCall.ranges).
+					Return(tc.addIngestionCoverageCall.err)
+			}
+
+			// Setup UpdateDriverRacesIngestedFrom
+			if tc.updateDriverRacesIngestedFromCall != nil {
+				mockStore.EXPECT().UpdateDriverRacesIngestedFrom(
+					mock.Anything,
+					tc.updateDriverRacesIngestedFromCall.driverID,
+					tc.updateDriverRacesIngestedFromCall.racesIngestedFrom,
+				).Return(tc.updateDriverRacesIngestedFromCall.err)
 			}
 
 			// Setup UpdateDriverRacesIngestedTo
@@ -688,7 +2908,10 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 			}
 
 			// Setup PublishEvent
-			if tc.publishEventCall != nil {
+			if tc.publishEventCall != nil && tc.publishEventCall.opts != nil {
+				mockEventDispatcher.EXPECT().PublishEvent(mock.Anything, tc.publishEventCall.event, tc.publishEventCall.opts).
+					Return(tc.publishEventCall.err)
+			} else if tc.publishEventCall != nil {
 				mockEventDispatcher.EXPECT().PublishEvent(mock.Anything, tc.publishEventCall.event).
 					Return(tc.publishEventCall.err)
 			}
@@ -699,7 +2922,136 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 					Return(call.err)
 			}
 
-			processor := NewRaceProcessor(mockStore, mockIRacing, mockPusher, mockEventDispatcher, mockMetricsClient, lockDuration)
+			// Setup lap backfill calls
+			if tc.getDriverSessionsWithSkippedLapsCall != nil {
+				mockStore.EXPECT().GetDriverSessionsWithSkippedLaps(mock.Anything, tc.getDriverSessionsWithSkippedLapsCall.driverID).
+					Return(tc.getDriverSessionsWithSkippedLapsCall.result, tc.getDriverSessionsWithSkippedLapsCall.err)
+			}
+			for _, call := range tc.completeDriverSessionLapsCalls {
+				mockStore.EXPECT().CompleteDriverSessionLaps(mock.Anything, call.driverID, call.startTime, call.trafficCost, call.lapGaps).
+					Return(call.err)
+			}
+			if tc.clearLapBackfillPendingCall != nil {
+				mockStore.EXPECT().ClearLapBackfillPending(mock.Anything, tc.clearLapBackfillPendingCall.driverID).
+					Return(tc.clearLapBackfillPendingCall.err)
+			}
+
+			deferred := (tc.reserveRateBudgetCall != nil && tc.reserveRateBudgetCall.retryAfter > 0) ||
+				(tc.downUntilCall != nil && !tc.downUntilCall.result.IsZero())
+
+			// Setup IngestionCancelRequested, rounds that get going are never cancelled unless the case says otherwise
+			for _, call := range tc.ingestionCancelRequestedCalls {
+				mockStore.EXPECT().IngestionCancelRequested(mock.Anything, tc.request.DriverID).
+					Return(call.requested, call.err).Once()
+			}
+			if tc.ingestionCancelRequestedCalls == nil && tc.acquireIngestionLockCall.acquired && tc.acquireIngestionLockCall.err == nil && !deferred {
+				mockStore.EXPECT().IngestionCancelRequested(mock.Anything, tc.request.DriverID).
+					Return(false, nil).Maybe()
+			}
+
+			// Setup ClearIngestionCancel
+			if tc.clearIngestionCancelCall != nil {
+				mockStore.EXPECT().ClearIngestionCancel(mock.Anything, tc.request.DriverID).
+					Return(tc.clearIngestionCancelCall.err)
+			}
+
+			// Setup SaveDriverSettings
+			if tc.saveDriverSettingsCall != nil {
+				mockStore.EXPECT().SaveDriverSettings(mock.Anything, tc.saveDriverSettingsCall.settings).
+					Return(tc.saveDriverSettingsCall.err)
+			}
+
+			// Setup SaveIngestionRun, every run that gets the lock is recorded unless it was deferred
+			if tc.acquireIngestionLockCall.acquired && tc.acquireIngestionLockCall.err == nil && !deferred {
+				call := saveIngestionRunCall{}
+				if tc.saveIngestionRunCall != nil {
+					call = *tc.saveIngestionRunCall
+				}
+				mockStore.EXPECT().SaveIngestionRun(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, run store.IngestionRun) error {
+						if call.validate != nil {
+							call.validate(t, run)
+						}
+						return call.err
+					}).Once()
+			}
+
+			// Phase timings are covered by TestRaceProcessor_recordRun
+			mockMetricsClient.EXPECT().EmitDuration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(nil).Maybe()
+
+			var opts []RaceProcessorOption
+			if tc.lapConsumptionConcurrency > 0 {
+				opts = append(opts, WithLapConsumptionConcurrency(tc.lapConsumptionConcurrency))
+			}
+			if tc.lapBackfillBatchSize > 0 {
+				opts = append(opts, WithLapBackfillBatchSize(tc.lapBackfillBatchSize))
+			}
+			if tc.snapshotDriverStandingCall != nil {
+				mockSnapshotter := NewMockStandingsSnapshotter(t)
+				mockSnapshotter.EXPECT().SnapshotDriverStanding(mock.Anything, tc.request.IRacingAccessToken, tc.snapshotDriverStandingCall.driverID).
+					Return(tc.snapshotDriverStandingCall.err)
+				opts = append(opts, WithStandingsSnapshotter(mockSnapshotter))
+			}
+			if tc.evaluateAlertsCall != nil {
+				mockEvaluator := NewMockAlertEvaluator(t)
+				mockEvaluator.EXPECT().EvaluateDriver(mock.Anything, tc.evaluateAlertsCall.driverID).
+					Return(tc.evaluateAlertsCall.err)
+				opts = append(opts, WithAlertEvaluator(mockEvaluator))
+			}
+			if tc.refreshSeasonCardCall != nil {
+				mockRefresher := NewMockSeasonCardRefresher(t)
+				mockRefresher.EXPECT().RefreshDriver(mock.Anything, tc.refreshSeasonCardCall.driverID).
+					Return(tc.refreshSeasonCardCall.err)
+				opts = append(opts, WithSeasonCardRefresher(mockRefresher))
+			}
+			if tc.refreshOverlayCall != nil {
+				mockRefresher := NewMockOverlayRefresher(t)
+				mockRefresher.EXPECT().RefreshDriver(mock.Anything, tc.refreshOverlayCall.driverID).
+					Return(tc.refreshOverlayCall.err)
+				opts = append(opts, WithOverlayRefresher(mockRefresher))
+			}
+			if tc.advanceOnboardingCall != nil {
+				mockTracker := NewMockOnboardingTracker(t)
+				mockTracker.EXPECT().Advance(mock.Anything, tc.advanceOnboardingCall.driverID, store.OnboardingStepIngestionStarted).
+					Return(tc.advanceOnboardingCall.err)
+				opts = append(opts, WithOnboardingTracker(mockTracker))
+			}
+			if tc.tagSquadEventsCall != nil {
+				mockTagger := NewMockSquadEventTagger(t)
+				mockTagger.EXPECT().TagSession(mock.Anything, mock.MatchedBy(func(session store.DriverSession) bool {
+					return session.SubsessionID == tc.tagSquadEventsCall.subsessionID
+				})).Return(tc.tagSquadEventsCall.err)
+				opts = append(opts, WithSquadEventTagger(mockTagger))
+			}
+			if tc.linkRacePlanCall != nil {
+				mockLinker := NewMockRacePlanLinker(t)
+				mockLinker.EXPECT().LinkSession(mock.Anything, mock.MatchedBy(func(session store.DriverSession) bool {
+					return session.SubsessionID == tc.linkRacePlanCall.subsessionID
+				})).Return(tc.linkRacePlanCall.evaluation, tc.linkRacePlanCall.err)
+				opts = append(opts, WithRacePlanLinker(mockLinker))
+			}
+			if tc.downUntilCall != nil {
+				mockAvailability := NewMockUpstreamAvailability(t)
+				mockAvailability.EXPECT().DownUntil(mock.Anything).
+					Return(tc.downUntilCall.result, tc.downUntilCall.err)
+				opts = append(opts, WithUpstreamAvailability(mockAvailability))
+			}
+			if tc.reserveRateBudgetCall != nil {
+				mockBudget := NewMockRateBudget(t)
+				mockBudget.EXPECT().Reserve(mock.Anything, tc.request.DriverID, DefaultRoundRateCost).
+					Return(tc.reserveRateBudgetCall.retryAfter, tc.reserveRateBudgetCall.err)
+				opts = append(opts, WithRateBudget(mockBudget, DefaultRoundRateCost))
+			}
+
+			if tc.getIngestionTiersCall != nil {
+				mockTiers := NewMockIngestionTierSource(t)
+				mockTiers.EXPECT().GetIngestionTiers(mock.Anything).
+					Return(tc.getIngestionTiersCall.result, tc.getIngestionTiersCall.err)
+				opts = append(opts, WithIngestionTiers(mockTiers))
+			}
+
+			processor := NewRaceProcessor(mockStore, mockIRacing, mockPusher, mockEventDispatcher, mockMetricsClient, lockDuration, opts...)
 			processor.now = func() time.Time { return now }
 
 			err := processor.IngestRaces(ctx, tc.request)
@@ -712,4 +3064,112 @@ func TestRaceProcessor_IngestRaces(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestRunningPositions(t *testing.T) {
+	driverID := int64(12345)
+
+	testCases := []struct {
+		name     string
+		laps     []iracing.LapChartLap
+		expected []int
+	}{
+		{
+			name: "positions by lap",
+			laps: []iracing.LapChartLap{
+				{CustID: driverID, LapNumber: 1, LapPosition: 3},
+				{CustID: 999, LapNumber: 0, LapPosition: 1},
+				{CustID: driverID, LapNumber: 0, LapPosition: 4},
+				{CustID: driverID, LapNumber: 2, LapPosition: 1},
+			},
+			expected: []int{3, 2, 0},
+		},
+		{
+			name: "missing laps carry forward",
+			laps: []iracing.LapChartLap{
+				{CustID: driverID, LapNumber: 0, LapPosition: 2},
+				{CustID: driverID, LapNumber: 3, LapPosition: 5},
+			},
+			expected: []int{1, 1, 1, 4},
+		},
+		{
+			name: "driver not in chart",
+			laps: []iracing.LapChartLap{
+				{CustID: 999, LapNumber: 0, LapPosition: 1},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, runningPositions(driverID, tc.laps))
+		})
+	}
+}
+
+func TestFasterClassDrivers(t *testing.T) {
+	driverID := int64(12345)
+
+	testCases := []struct {
+		name     string
+		results  []iracing.DriverResult
+		expected map[int64]bool
+	}{
+		{
+			name: "quicker classes by best lap",
+			results: []iracing.DriverResult{
+				{CustID: 1, CarClassID: 1, BestLapTime: 850_000},
+				{CustID: 2, CarClassID: 1}, // no timed lap, still in the class
+				{CustID: 3, CarClassID: 2, BestLapTime: 880_000},
+				{CustID: driverID, CarClassID: 2, BestLapTime: 900_000},
+				{CustID: 4, CarClassID: 3, BestLapTime: 950_000},
+			},
+			expected: map[int64]bool{1: true, 2: true},
+		},
+		{
+			name: "in the quickest class",
+			results: []iracing.DriverResult{
+				{CustID: 1, CarClassID: 1, BestLapTime: 850_000},
+				{CustID: driverID, CarClassID: 2, BestLapTime: 900_000},
+				{CustID: 3, CarClassID: 2, BestLapTime: 840_000},
+			},
+			expected: map[int64]bool{},
+		},
+		{
+			name: "no timed laps in the driver's class",
+			results: []iracing.DriverResult{
+				{CustID: 1, CarClassID: 1, BestLapTime: 850_000},
+				{CustID: driverID, CarClassID: 2},
+			},
+			expected: map[int64]bool{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			raceSession := &iracing.SimSessionResult{Results: tc.results}
+			var driverResult *iracing.DriverResult
+			for i := range raceSession.Results {
+				if raceSession.Results[i].CustID == driverID {
+					driverResult = &raceSession.Results[i]
+				}
+			}
+			assert.Equal(t, tc.expected, fasterClassDrivers(raceSession, driverResult))
+		})
+	}
+}
+
+func TestLineCrossings(t *testing.T) {
+	laps := []iracing.LapChartLap{
+		{CustID: 1, LapNumber: 0, SessionTime: 100_000},
+		{CustID: 2, LapNumber: 0, SessionTime: 110_000},
+		{CustID: 1, LapNumber: 1, SessionTime: 950_000},
+		{CustID: 3, LapNumber: 1, SessionTime: 1_010_000},
+		{CustID: 1, LapNumber: 2, SessionTime: 1_800_000},
+	}
+
+	assert.Equal(t, [][]int{{100_000, 950_000, 1_800_000}}, lineCrossings(laps, map[int64]bool{1: true}))
+	// a race nobody was quicker in still has crossings, just none of them
+	assert.Equal(t, [][]int{}, lineCrossings(laps, map[int64]bool{}))
+}
@@ -0,0 +1,64 @@
+package ingestion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// ReplayCapture runs a captured round again as a dry run, with iRacing answered from the captured responses and the
+// store seeded with what the round read, and returns what the round would have written. baseURL is the iRacing data
+// API the capture was taken against.
+func ReplayCapture(ctx context.Context, capture Capture, baseURL string) (DryRunSummary, error) {
+	if capture.Driver == nil || capture.Settings == nil {
+		return DryRunSummary{}, fmt.Errorf("capture %s is missing the driver, the round failed before reading it", capture.CaptureID)
+	}
+
+	replayStore := store.NewMemoryStore()
+	if err := replayStore.InsertDriver(ctx, *capture.Driver); err != nil {
+		return DryRunSummary{}, fmt.Errorf("seeding driver: %w", err)
+	}
+	if err := replayStore.SaveDriverSettings(ctx, *capture.Settings); err != nil {
+		return DryRunSummary{}, fmt.Errorf("seeding driver settings: %w", err)
+	}
+	if len(capture.Coverage) > 0 {
+		if err := replayStore.AddIngestionCoverage(ctx, capture.DriverID, capture.Coverage...); err != nil {
+			return DryRunSummary{}, fmt.Errorf("seeding ingestion coverage: %w", err)
+		}
+	}
+	for _, session := range capture.Sessions {
+		if err := replayStore.PersistSessionData(ctx, session, nil); err != nil {
+			return DryRunSummary{}, fmt.Errorf("seeding session %d: %w", session.SubsessionID, err)
+		}
+	}
+
+	client := iracing.NewClient(iracing.NewReplayHTTPClient(capture.Exchanges), dryRunMetrics{}, iracing.WithBaseURL(baseURL))
+	processor := NewRaceProcessor(replayStore, client, dryRunPusher{}, nil, dryRunMetrics{}, 0,
+		WithRaceConsumptionConcurrency(capture.Config.RaceConsumptionConcurrency),
+		WithLapConsumptionConcurrency(capture.Config.LapConsumptionConcurrency),
+		WithLapBackfillBatchSize(capture.Config.LapBackfillBatchSize),
+		WithLapInterpolation(capture.Config.InterpolateLaps),
+		WithIngestionTiers(capturedTiers(capture.Tiers)),
+	)
+	processor.searchWindowDuration = capture.Config.SearchWindow
+	processor.bootstrapWindowDuration = capture.Config.BootstrapWindow
+	// search ranges are worked out from the current time, they have to match the captured requests
+	processor.now = func() time.Time { return capture.StartedAt }
+
+	return processor.dryRunRound(ctx, RaceIngestionRequest{
+		DriverID:           capture.DriverID,
+		IRacingAccessToken: "replay",
+		Backwards:          capture.Backwards,
+		DryRun:             true,
+	})
+}
+
+// capturedTiers serves the tiers a captured round saw, nil when it ran without tiers.
+type capturedTiers []store.IngestionTier
+
+func (c capturedTiers) GetIngestionTiers(context.Context) ([]store.IngestionTier, error) {
+	return c, nil
+}
@@ -0,0 +1,123 @@
+package ingestion
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// Phases of an ingestion round that get timed
+const (
+	phaseSearch       = "search"
+	phaseSessionFetch = "session_fetch"
+	phaseLapFetch     = "lap_fetch"
+	phasePersist      = "persist"
+	phaseNotify       = "notify"
+)
+
+// phaseKey identifies where time went, the phase plus the bucketed number of drivers in the race being worked on.
+// driverCount is empty for phases that aren't tied to a single race.
+type phaseKey struct {
+	phase       string
+	driverCount string
+}
+
+// runStats collects counts and phase timings over an ingestion round. Recording is safe from multiple goroutines.
+type runStats struct {
+	startedAt time.Time
+
+	mu     sync.Mutex
+	phases map[phaseKey]time.Duration
+
+	raceCount    int
+	newRaceCount int
+	lapCount     int
+	upToDate     bool
+	captureID    string
+}
+
+func newRunStats(startedAt time.Time) *runStats {
+	return &runStats{
+		startedAt: startedAt,
+		phases:    make(map[phaseKey]time.Duration),
+	}
+}
+
+// record adds time spent in a phase. driverCount is the number of drivers in the race, or 0 when the phase isn't
+// tied to one.
+func (s *runStats) record(phase string, driverCount int, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.phases[phaseKey{phase: phase, driverCount: driverCountBucket(driverCount)}] += d
+}
+
+// phaseTotals returns the time spent in each phase regardless of race size.
+func (s *runStats) phaseTotals() map[string]time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	totals := make(map[string]time.Duration)
+	for key, d := range s.phases {
+		totals[key.phase] += d
+	}
+	return totals
+}
+
+// driverCountBucket keeps the driver count dimension to a handful of values, CloudWatch charges per unique metric.
+func driverCountBucket(driverCount int) string {
+	switch {
+	case driverCount <= 0:
+		return ""
+	case driverCount <= 10:
+		return "1-10"
+	case driverCount <= 20:
+		return "11-20"
+	case driverCount <= 40:
+		return "21-40"
+	default:
+		return "41+"
+	}
+}
+
+// recordRun emits timing metrics for an ingestion round and saves a record of it for the developer ingestion metrics
+// endpoint. Failures here are logged, they shouldn't fail ingestion.
+func (r *RaceProcessor) recordRun(ctx context.Context, driverID int64, stats *runStats, runErr error) {
+	logger := zerolog.Ctx(ctx)
+	duration := r.now().Sub(stats.startedAt)
+
+	if err := r.metricsClient.EmitDuration(ctx, metrics.IngestionRunDuration, duration, nil); err != nil {
+		logger.Warn().Err(err).Msg("failed to emit ingestion run duration metric")
+	}
+	stats.mu.Lock()
+	for key, d := range stats.phases {
+		dimensions := map[string]string{metrics.DimensionPhase: key.phase}
+		if key.driverCount != "" {
+			dimensions[metrics.DimensionDriverCount] = key.driverCount
+		}
+		if err := r.metricsClient.EmitDuration(ctx, metrics.IngestionPhaseDuration, d, dimensions); err != nil {
+			logger.Warn().Err(err).Str("phase", key.phase).Msg("failed to emit ingestion phase duration metric")
+		}
+	}
+	stats.mu.Unlock()
+
+	run := store.IngestionRun{
+		DriverID:       driverID,
+		StartedAt:      stats.startedAt,
+		Duration:       duration,
+		RaceCount:      stats.raceCount,
+		NewRaceCount:   stats.newRaceCount,
+		LapCount:       stats.lapCount,
+		UpToDate:       stats.upToDate,
+		CaptureID:      stats.captureID,
+		PhaseDurations: stats.phaseTotals(),
+	}
+	if runErr != nil {
+		run.Error = runErr.Error()
+	}
+	if err := r.store.SaveIngestionRun(ctx, run); err != nil {
+		logger.Warn().Err(err).Msg("failed to save ingestion run")
+	}
+}
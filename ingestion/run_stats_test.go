@@ -0,0 +1,154 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDriverCountBucket(t *testing.T) {
+	testCases := []struct {
+		driverCount int
+		expected    string
+	}{
+		{driverCount: 0, expected: ""},
+		{driverCount: 1, expected: "1-10"},
+		{driverCount: 10, expected: "1-10"},
+		{driverCount: 11, expected: "11-20"},
+		{driverCount: 20, expected: "11-20"},
+		{driverCount: 21, expected: "21-40"},
+		{driverCount: 40, expected: "21-40"},
+		{driverCount: 41, expected: "41+"},
+		{driverCount: 60, expected: "41+"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, driverCountBucket(tc.driverCount), "driver count %d", tc.driverCount)
+	}
+}
+
+func TestRunStats_PhaseTotals(t *testing.T) {
+	stats := newRunStats(time.Now())
+	stats.record(phaseSearch, 0, 100*time.Millisecond)
+	stats.record(phaseSessionFetch, 5, 200*time.Millisecond)
+	stats.record(phaseSessionFetch, 25, 300*time.Millisecond)
+	stats.record(phaseSessionFetch, 8, 50*time.Millisecond)
+
+	assert.Equal(t, map[phaseKey]time.Duration{
+		{phase: phaseSearch}:                             100 * time.Millisecond,
+		{phase: phaseSessionFetch, driverCount: "1-10"}:  250 * time.Millisecond,
+		{phase: phaseSessionFetch, driverCount: "21-40"}: 300 * time.Millisecond,
+	}, stats.phases)
+	assert.Equal(t, map[string]time.Duration{
+		phaseSearch:       100 * time.Millisecond,
+		phaseSessionFetch: 550 * time.Millisecond,
+	}, stats.phaseTotals())
+}
+
+func TestRaceProcessor_recordRun(t *testing.T) {
+	driverID := int64(12345)
+	startedAt := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	now := startedAt.Add(5 * time.Second)
+
+	testCases := []struct {
+		name string
+
+		runErr            error
+		emitErr           error
+		saveErr           error
+		expectedRunRecord store.IngestionRun
+	}{
+		{
+			name: "successful run",
+			expectedRunRecord: store.IngestionRun{
+				DriverID:     driverID,
+				StartedAt:    startedAt,
+				Duration:     5 * time.Second,
+				RaceCount:    3,
+				NewRaceCount: 2,
+				LapCount:     30,
+				UpToDate:     true,
+				PhaseDurations: map[string]time.Duration{
+					phaseSearch:       time.Second,
+					phaseSessionFetch: 2 * time.Second,
+				},
+			},
+		},
+		{
+			name:   "failed run",
+			runErr: errors.New("searching series results: boom"),
+			expectedRunRecord: store.IngestionRun{
+				DriverID:     driverID,
+				StartedAt:    startedAt,
+				Duration:     5 * time.Second,
+				RaceCount:    3,
+				NewRaceCount: 2,
+				LapCount:     30,
+				UpToDate:     true,
+				Error:        "searching series results: boom",
+				PhaseDurations: map[string]time.Duration{
+					phaseSearch:       time.Second,
+					phaseSessionFetch: 2 * time.Second,
+				},
+			},
+		},
+		{
+			name:    "metric and save failures are swallowed",
+			emitErr: errors.New("cloudwatch down"),
+			saveErr: errors.New("dynamo down"),
+			expectedRunRecord: store.IngestionRun{
+				DriverID:     driverID,
+				StartedAt:    startedAt,
+				Duration:     5 * time.Second,
+				RaceCount:    3,
+				NewRaceCount: 2,
+				LapCount:     30,
+				UpToDate:     true,
+				PhaseDurations: map[string]time.Duration{
+					phaseSearch:       time.Second,
+					phaseSessionFetch: 2 * time.Second,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			mockStore := NewMockStore(t)
+			mockMetricsClient := NewMockMetricsClient(t)
+
+			mockMetricsClient.EXPECT().EmitDuration(mock.Anything, metrics.IngestionRunDuration, 5*time.Second, map[string]string(nil)).
+				Return(tc.emitErr)
+			mockMetricsClient.EXPECT().EmitDuration(mock.Anything, metrics.IngestionPhaseDuration, time.Second, map[string]string{
+				metrics.DimensionPhase: phaseSearch,
+			}).Return(tc.emitErr)
+			mockMetricsClient.EXPECT().EmitDuration(mock.Anything, metrics.IngestionPhaseDuration, 2*time.Second, map[string]string{
+				metrics.DimensionPhase:       phaseSessionFetch,
+				metrics.DimensionDriverCount: "11-20",
+			}).Return(tc.emitErr)
+			mockStore.EXPECT().SaveIngestionRun(mock.Anything, tc.expectedRunRecord).Return(tc.saveErr)
+
+			processor := NewRaceProcessor(mockStore, NewMockIRacingClient(t), NewMockPusher(t), NewMockEventDispatcher(t), mockMetricsClient, time.Minute)
+			processor.now = func() time.Time { return now }
+
+			stats := newRunStats(startedAt)
+			stats.record(phaseSearch, 0, time.Second)
+			stats.record(phaseSessionFetch, 15, 2*time.Second)
+			stats.raceCount = 3
+			stats.newRaceCount = 2
+			stats.lapCount = 30
+			stats.upToDate = true
+
+			processor.recordRun(ctx, driverID, stats, tc.runErr)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package ingestion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+type S3Client interface {
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	ListObjectsV2(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)
+}
+
+// S3CaptureStore keeps captured rounds as JSON objects keyed by capture ID. Captures hold driver data, the bucket is
+// expected to expire them.
+type S3CaptureStore struct {
+	client S3Client
+	bucket string
+}
+
+func NewS3CaptureStore(client S3Client, bucket string) *S3CaptureStore {
+	return &S3CaptureStore{client: client, bucket: bucket}
+}
+
+func captureKey(captureID string) string {
+	return fmt.Sprintf("captures/%s.json", captureID)
+}
+
+func (s *S3CaptureStore) SaveCapture(ctx context.Context, capture Capture) error {
+	marshalled, err := json.Marshal(capture)
+	if err != nil {
+		return fmt.Errorf("marshalling capture: %w", err)
+	}
+	_, err = s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(captureKey(capture.CaptureID)),
+		Body:        bytes.NewReader(marshalled),
+		ContentType: aws.String("application/json"),
+	})
+	if err != nil {
+		return fmt.Errorf("writing capture to S3: %w", err)
+	}
+	return nil
+}
+
+func (s *S3CaptureStore) GetCapture(ctx context.Context, captureID string) (*Capture, error) {
+	res, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(captureKey(captureID)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading capture from S3: %w", err)
+	}
+	defer res.Body.Close()
+
+	marshalled, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading capture body: %w", err)
+	}
+	var capture Capture
+	if err := json.Unmarshal(marshalled, &capture); err != nil {
+		return nil, fmt.Errorf("unmarshalling capture: %w", err)
+	}
+	return &capture, nil
+}
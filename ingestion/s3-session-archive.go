@@ -0,0 +1,125 @@
+package ingestion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// SessionArchiveStore records where a session's archives are.
+type SessionArchiveStore interface {
+	SaveSessionArchive(ctx context.Context, archive store.SessionArchive) error
+}
+
+// S3SessionArchive keeps raw session archives as JSON objects keyed by subsession ID, recording each in the store so
+// a session's archives can be found without listing the bucket.
+type S3SessionArchive struct {
+	client S3Client
+	bucket string
+	store  SessionArchiveStore
+}
+
+func NewS3SessionArchive(client S3Client, bucket string, store SessionArchiveStore) *S3SessionArchive {
+	return &S3SessionArchive{client: client, bucket: bucket, store: store}
+}
+
+const sessionArchivePrefix = "sessions/"
+const sessionResultsArchiveSuffix = "/results.json"
+
+func sessionArchiveKey(archive RawSessionArchive) string {
+	if archive.Kind == store.SessionArchiveLaps {
+		return fmt.Sprintf(sessionArchivePrefix+"%d/laps/%d.json", archive.SubsessionID, archive.DriverID)
+	}
+	return fmt.Sprintf(sessionArchivePrefix+"%d"+sessionResultsArchiveSuffix, archive.SubsessionID)
+}
+
+func (s *S3SessionArchive) ArchiveSession(ctx context.Context, archive RawSessionArchive) error {
+	marshalled, err := json.Marshal(archive)
+	if err != nil {
+		return fmt.Errorf("marshalling session archive: %w", err)
+	}
+	key := sessionArchiveKey(archive)
+	_, err = s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(marshalled),
+		ContentType: aws.String("application/json"),
+	})
+	if err != nil {
+		return fmt.Errorf("writing session archive to S3: %w", err)
+	}
+	err = s.store.SaveSessionArchive(ctx, store.SessionArchive{
+		SubsessionID: archive.SubsessionID,
+		Kind:         archive.Kind,
+		DriverID:     archive.DriverID,
+		Key:          key,
+		ArchivedAt:   archive.ArchivedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("saving session archive record: %w", err)
+	}
+	return nil
+}
+
+// GetSessionArchive reads the archive stored under key, as recorded in store.SessionArchive.Key.
+func (s *S3SessionArchive) GetSessionArchive(ctx context.Context, key string) (*RawSessionArchive, error) {
+	res, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading session archive from S3: %w", err)
+	}
+	defer res.Body.Close()
+
+	marshalled, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading session archive body: %w", err)
+	}
+	var archive RawSessionArchive
+	if err := json.Unmarshal(marshalled, &archive); err != nil {
+		return nil, fmt.Errorf("unmarshalling session archive: %w", err)
+	}
+	return &archive, nil
+}
+
+// ListResultsArchives returns the keys of up to limit archived session results, in key order starting after the key
+// given, empty to start from the first. An empty list means there are no more.
+func (s *S3SessionArchive) ListResultsArchives(ctx context.Context, after string, limit int) ([]string, error) {
+	var keys []string
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(sessionArchivePrefix),
+	}
+	if after != "" {
+		input.StartAfter = aws.String(after)
+	}
+	for {
+		res, err := s.client.ListObjectsV2(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("listing session archives: %w", err)
+		}
+		for _, object := range res.Contents {
+			key := aws.ToString(object.Key)
+			// lap data archives sit alongside the results, there's nothing on them a backfill needs
+			if !strings.HasSuffix(key, sessionResultsArchiveSuffix) {
+				continue
+			}
+			keys = append(keys, key)
+			if len(keys) == limit {
+				return keys, nil
+			}
+		}
+		if !aws.ToBool(res.IsTruncated) {
+			return keys, nil
+		}
+		input.ContinuationToken = res.NextContinuationToken
+	}
+}
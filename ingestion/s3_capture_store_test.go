@@ -0,0 +1,150 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3CaptureStore_SaveCapture(t *testing.T) {
+	capture := Capture{
+		CaptureID: "capture-1",
+		DriverID:  12345,
+		StartedAt: time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC),
+		Exchanges: []iracing.CapturedExchange{{URL: "https://test.iracing.com/data/results/get", Status: 200, Body: `{"link":"x"}`}},
+	}
+
+	type putObjectCall struct {
+		bucket      string
+		key         string
+		contentType string
+		body        Capture
+		err         error
+	}
+
+	testCases := []struct {
+		name string
+
+		putObjectCall putObjectCall
+
+		expectedErr string
+	}{
+		{
+			name: "saved",
+			putObjectCall: putObjectCall{
+				bucket:      "capture-bucket",
+				key:         "captures/capture-1.json",
+				contentType: "application/json",
+				body:        capture,
+			},
+		},
+		{
+			name: "S3 error",
+			putObjectCall: putObjectCall{
+				bucket:      "capture-bucket",
+				key:         "captures/capture-1.json",
+				contentType: "application/json",
+				body:        capture,
+				err:         errors.New("access denied"),
+			},
+			expectedErr: "writing capture to S3: access denied",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := NewMockS3Client(t)
+			client.EXPECT().PutObject(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, input *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+					assert.Equal(t, tc.putObjectCall.bucket, aws.ToString(input.Bucket))
+					assert.Equal(t, tc.putObjectCall.key, aws.ToString(input.Key))
+					assert.Equal(t, tc.putObjectCall.contentType, aws.ToString(input.ContentType))
+					body, err := io.ReadAll(input.Body)
+					require.NoError(t, err)
+					var written Capture
+					require.NoError(t, json.Unmarshal(body, &written))
+					assert.Equal(t, tc.putObjectCall.body, written)
+					return &s3.PutObjectOutput{}, tc.putObjectCall.err
+				})
+
+			err := NewS3CaptureStore(client, "capture-bucket").SaveCapture(context.Background(), capture)
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestS3CaptureStore_GetCapture(t *testing.T) {
+	type getObjectCall struct {
+		body string
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		getObjectCall getObjectCall
+
+		expected    *Capture
+		expectedErr string
+	}{
+		{
+			name:          "found",
+			getObjectCall: getObjectCall{body: `{"captureId":"capture-1","driverId":12345,"backwards":true,"startedAt":"2024-06-15T12:00:00Z"}`},
+			expected: &Capture{
+				CaptureID: "capture-1",
+				DriverID:  12345,
+				Backwards: true,
+				StartedAt: time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:          "S3 error",
+			getObjectCall: getObjectCall{err: errors.New("no such key")},
+			expectedErr:   "reading capture from S3: no such key",
+		},
+		{
+			name:          "not a capture",
+			getObjectCall: getObjectCall{body: `not json`},
+			expectedErr:   "unmarshalling capture: invalid character 'o' in literal null (expecting 'u')",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := NewMockS3Client(t)
+			var output *s3.GetObjectOutput
+			if tc.getObjectCall.err == nil {
+				output = &s3.GetObjectOutput{Body: io.NopCloser(strings.NewReader(tc.getObjectCall.body))}
+			}
+			client.EXPECT().GetObject(mock.Anything, &s3.GetObjectInput{
+				Bucket: aws.String("capture-bucket"),
+				Key:    aws.String("captures/capture-1.json"),
+			}).Return(output, tc.getObjectCall.err)
+
+			result, err := NewS3CaptureStore(client, "capture-bucket").GetCapture(context.Background(), "capture-1")
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
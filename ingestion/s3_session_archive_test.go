@@ -0,0 +1,225 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3SessionArchive_ArchiveSession(t *testing.T) {
+	archivedAt := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	results := RawSessionArchive{
+		SubsessionID: 99999,
+		Kind:         store.SessionArchiveResults,
+		ArchivedAt:   archivedAt,
+		Exchanges:    []iracing.CapturedExchange{{URL: "https://test.iracing.com/data/results/get", Status: 200, Body: `{"link":"x"}`}},
+	}
+	laps := RawSessionArchive{
+		SubsessionID: 99999,
+		Kind:         store.SessionArchiveLaps,
+		DriverID:     12345,
+		ArchivedAt:   archivedAt,
+		Exchanges:    []iracing.CapturedExchange{{URL: "https://test.iracing.com/data/results/lap_data", Status: 200, Body: `{"link":"y"}`}},
+	}
+
+	type putObjectCall struct {
+		key string
+		err error
+	}
+
+	type saveSessionArchiveCall struct {
+		archive store.SessionArchive
+		err     error
+	}
+
+	testCases := []struct {
+		name string
+
+		archive RawSessionArchive
+
+		putObjectCall          putObjectCall
+		saveSessionArchiveCall *saveSessionArchiveCall
+
+		expectedErr string
+	}{
+		{
+			name:          "results",
+			archive:       results,
+			putObjectCall: putObjectCall{key: "sessions/99999/results.json"},
+			saveSessionArchiveCall: &saveSessionArchiveCall{
+				archive: store.SessionArchive{SubsessionID: 99999, Kind: store.SessionArchiveResults, Key: "sessions/99999/results.json", ArchivedAt: archivedAt},
+			},
+		},
+		{
+			name:          "laps",
+			archive:       laps,
+			putObjectCall: putObjectCall{key: "sessions/99999/laps/12345.json"},
+			saveSessionArchiveCall: &saveSessionArchiveCall{
+				archive: store.SessionArchive{SubsessionID: 99999, Kind: store.SessionArchiveLaps, DriverID: 12345, Key: "sessions/99999/laps/12345.json", ArchivedAt: archivedAt},
+			},
+		},
+		{
+			name:          "S3 error",
+			archive:       results,
+			putObjectCall: putObjectCall{key: "sessions/99999/results.json", err: errors.New("access denied")},
+			expectedErr:   "writing session archive to S3: access denied",
+		},
+		{
+			name:          "store error",
+			archive:       results,
+			putObjectCall: putObjectCall{key: "sessions/99999/results.json"},
+			saveSessionArchiveCall: &saveSessionArchiveCall{
+				archive: store.SessionArchive{SubsessionID: 99999, Kind: store.SessionArchiveResults, Key: "sessions/99999/results.json", ArchivedAt: archivedAt},
+				err:     errors.New("database error"),
+			},
+			expectedErr: "saving session archive record: database error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := NewMockS3Client(t)
+			client.EXPECT().PutObject(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, input *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+					assert.Equal(t, "archive-bucket", aws.ToString(input.Bucket))
+					assert.Equal(t, tc.putObjectCall.key, aws.ToString(input.Key))
+					assert.Equal(t, "application/json", aws.ToString(input.ContentType))
+					body, err := io.ReadAll(input.Body)
+					require.NoError(t, err)
+					var written RawSessionArchive
+					require.NoError(t, json.Unmarshal(body, &written))
+					assert.Equal(t, tc.archive, written)
+					return &s3.PutObjectOutput{}, tc.putObjectCall.err
+				})
+			archiveStore := NewMockSessionArchiveStore(t)
+			if tc.saveSessionArchiveCall != nil {
+				archiveStore.EXPECT().SaveSessionArchive(mock.Anything, tc.saveSessionArchiveCall.archive).Return(tc.saveSessionArchiveCall.err)
+			}
+
+			err := NewS3SessionArchive(client, "archive-bucket", archiveStore).ArchiveSession(context.Background(), tc.archive)
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestS3SessionArchive_ListResultsArchives(t *testing.T) {
+	type listObjectsCall struct {
+		startAfter        *string
+		continuationToken *string
+		keys              []string
+		nextToken         *string
+		err               error
+	}
+
+	objects := func(keys ...string) []types.Object {
+		result := make([]types.Object, len(keys))
+		for i, key := range keys {
+			result[i] = types.Object{Key: aws.String(key)}
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name string
+
+		after string
+		limit int
+
+		listObjectsCalls []listObjectsCall
+
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name:  "lap data skipped",
+			limit: 10,
+			listObjectsCalls: []listObjectsCall{
+				{keys: []string{"sessions/111/laps/1001.json", "sessions/111/results.json", "sessions/222/results.json"}},
+			},
+			expected: []string{"sessions/111/results.json", "sessions/222/results.json"},
+		},
+		{
+			name:  "across pages, after a key",
+			after: "sessions/111/results.json",
+			limit: 10,
+			listObjectsCalls: []listObjectsCall{
+				{startAfter: aws.String("sessions/111/results.json"), keys: []string{"sessions/222/results.json"}, nextToken: aws.String("page-2")},
+				{startAfter: aws.String("sessions/111/results.json"), continuationToken: aws.String("page-2"), keys: []string{"sessions/333/results.json"}},
+			},
+			expected: []string{"sessions/222/results.json", "sessions/333/results.json"},
+		},
+		{
+			name:  "stops at the limit",
+			limit: 1,
+			listObjectsCalls: []listObjectsCall{
+				{keys: []string{"sessions/111/results.json", "sessions/222/results.json"}, nextToken: aws.String("page-2")},
+			},
+			expected: []string{"sessions/111/results.json"},
+		},
+		{
+			name:  "none left",
+			after: "sessions/333/results.json",
+			limit: 10,
+			listObjectsCalls: []listObjectsCall{
+				{startAfter: aws.String("sessions/333/results.json")},
+			},
+		},
+		{
+			name:  "S3 error",
+			limit: 10,
+			listObjectsCalls: []listObjectsCall{
+				{err: errors.New("access denied")},
+			},
+			expectedErr: "listing session archives: access denied",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := NewMockS3Client(t)
+			for _, call := range tc.listObjectsCalls {
+				var output *s3.ListObjectsV2Output
+				if call.err == nil {
+					output = &s3.ListObjectsV2Output{
+						Contents:              objects(call.keys...),
+						IsTruncated:           aws.Bool(call.nextToken != nil),
+						NextContinuationToken: call.nextToken,
+					}
+				}
+				client.EXPECT().ListObjectsV2(mock.Anything, &s3.ListObjectsV2Input{
+					Bucket:            aws.String("archive-bucket"),
+					Prefix:            aws.String("sessions/"),
+					StartAfter:        call.startAfter,
+					ContinuationToken: call.continuationToken,
+				}).Return(output, call.err).Once()
+			}
+
+			result, err := NewS3SessionArchive(client, "archive-bucket", NewMockSessionArchiveStore(t)).
+				ListResultsArchives(context.Background(), tc.after, tc.limit)
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
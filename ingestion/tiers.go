@@ -0,0 +1,82 @@
+package ingestion
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// DefaultTierName is the tier drivers fall back on when none of their entitlements has a tier of its own.
+const DefaultTierName = "default"
+
+type IngestionTierSource interface {
+	GetIngestionTiers(ctx context.Context) ([]store.IngestionTier, error)
+}
+
+// ResolveTier picks the tier a driver with the given entitlements is ingested under. Of the tiers named after one of
+// the entitlements the highest priority wins, ties going to whichever comes first. Returns nil when no tier applies.
+func ResolveTier(tiers []store.IngestionTier, entitlements []string) *store.IngestionTier {
+	var resolved *store.IngestionTier
+	for i := range tiers {
+		if !slices.Contains(entitlements, tiers[i].Name) {
+			continue
+		}
+		if resolved == nil || tiers[i].Priority > resolved.Priority {
+			resolved = &tiers[i]
+		}
+	}
+	if resolved != nil {
+		return resolved
+	}
+	for i := range tiers {
+		if tiers[i].Name == DefaultTierName {
+			return &tiers[i]
+		}
+	}
+	return nil
+}
+
+// runConfig is how a single round goes about ingesting, the processor's own settings adjusted by the driver's tier.
+type runConfig struct {
+	searchWindowDuration       time.Duration
+	raceConsumptionConcurrency int
+	lapConsumptionConcurrency  int
+	lapsDisabled               bool
+}
+
+func (r *RaceProcessor) runConfigFor(ctx context.Context, driver *store.Driver) runConfig {
+	cfg := runConfig{
+		searchWindowDuration:       r.searchWindowDuration,
+		raceConsumptionConcurrency: r.raceConsumptionConcurrency,
+		lapConsumptionConcurrency:  r.lapConsumptionConcurrency,
+	}
+	if r.tierSource == nil {
+		return cfg
+	}
+
+	tiers, err := r.tierSource.GetIngestionTiers(ctx)
+	if err != nil {
+		// tiers only tune how fast a sync goes, the processor's own settings work fine without them
+		zerolog.Ctx(ctx).Err(err).Int64("driverID", driver.DriverID).Msg("failed to get ingestion tiers")
+		return cfg
+	}
+	tier := ResolveTier(tiers, driver.Entitlements)
+	if tier == nil {
+		return cfg
+	}
+
+	if tier.SearchWindowDays > 0 {
+		cfg.searchWindowDuration = time.Hour * 24 * time.Duration(tier.SearchWindowDays)
+	}
+	if tier.RaceConsumptionConcurrency > 0 {
+		cfg.raceConsumptionConcurrency = tier.RaceConsumptionConcurrency
+	}
+	if tier.LapConsumptionConcurrency > 0 {
+		cfg.lapConsumptionConcurrency = tier.LapConsumptionConcurrency
+	}
+	cfg.lapsDisabled = tier.LapsDisabled
+	return cfg
+}
@@ -0,0 +1,62 @@
+package ingestion
+
+import (
+	"testing"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveTier(t *testing.T) {
+	standard := store.IngestionTier{Name: DefaultTierName, SearchWindowDays: 10}
+	supporter := store.IngestionTier{Name: "supporter", Priority: 10, SearchWindowDays: 30}
+	developer := store.IngestionTier{Name: "developer", Priority: 20, RaceConsumptionConcurrency: 8}
+	beta := store.IngestionTier{Name: "beta", Priority: 20, LapsDisabled: true}
+
+	testCases := []struct {
+		name         string
+		tiers        []store.IngestionTier
+		entitlements []string
+		expected     *store.IngestionTier
+	}{
+		{
+			name:     "no tiers",
+			expected: nil,
+		},
+		{
+			name:         "no matching entitlement or default tier",
+			tiers:        []store.IngestionTier{supporter},
+			entitlements: []string{"developer"},
+			expected:     nil,
+		},
+		{
+			name:     "no entitlements falls back on the default tier",
+			tiers:    []store.IngestionTier{standard, supporter},
+			expected: &standard,
+		},
+		{
+			name:         "matching entitlement wins over the default tier",
+			tiers:        []store.IngestionTier{standard, supporter},
+			entitlements: []string{"supporter"},
+			expected:     &supporter,
+		},
+		{
+			name:         "highest priority match wins",
+			tiers:        []store.IngestionTier{developer, standard, supporter},
+			entitlements: []string{"supporter", "developer"},
+			expected:     &developer,
+		},
+		{
+			name:         "priority ties go to the first tier",
+			tiers:        []store.IngestionTier{beta, developer},
+			entitlements: []string{"developer", "beta"},
+			expected:     &beta,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ResolveTier(tc.tiers, tc.entitlements))
+		})
+	}
+}
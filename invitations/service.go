@@ -0,0 +1,97 @@
+package invitations
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+type Store interface {
+	AddToWaitlist(ctx context.Context, entry store.WaitlistEntry) (bool, error)
+	GetWaitlist(ctx context.Context) ([]store.WaitlistEntry, error)
+	GetInvitation(ctx context.Context, driverID int64) (*store.Invitation, error)
+	SaveInvitations(ctx context.Context, invitations []store.Invitation) error
+}
+
+// Service runs the soft launch waitlist. Drivers signing in for the first time are only let in with an invitation,
+// everyone else waits on the waitlist until they are invited.
+type Service struct {
+	store Store
+	now   func() time.Time
+}
+
+func NewService(store Store) *Service {
+	return &Service{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Admit decides whether a driver signing in for the first time is let in, returning the entitlements their driver
+// record starts out with when they are. Drivers without an invitation are put on the waitlist, keeping their place if
+// they are already on it.
+func (s *Service) Admit(ctx context.Context, driverID int64, driverName string) ([]string, bool, error) {
+	invitation, err := s.store.GetInvitation(ctx, driverID)
+	if err != nil {
+		return nil, false, fmt.Errorf("getting invitation: %w", err)
+	}
+	if invitation != nil {
+		return invitation.Entitlements, true, nil
+	}
+
+	added, err := s.store.AddToWaitlist(ctx, store.WaitlistEntry{
+		DriverID:    driverID,
+		DriverName:  driverName,
+		RequestedAt: s.now(),
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("adding to waitlist: %w", err)
+	}
+	if added {
+		zerolog.Ctx(ctx).Info().Int64("driverId", driverID).Msg("driver added to waitlist")
+	}
+	return nil, false, nil
+}
+
+// GetWaitlist returns the drivers waiting to be let in, longest waiting first.
+func (s *Service) GetWaitlist(ctx context.Context) ([]store.WaitlistEntry, error) {
+	entries, err := s.store.GetWaitlist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(entries, func(a, b store.WaitlistEntry) int {
+		return a.RequestedAt.Compare(b.RequestedAt)
+	})
+	return entries, nil
+}
+
+// Invite lets drivers in, taking them off the waitlist. Their driver records start out with entitlements when they
+// next sign in, which is also when they are told they have been let in. Drivers already invited have their invitation
+// replaced, and drivers who have never signed in can be invited ahead of doing so.
+func (s *Service) Invite(ctx context.Context, invitedBy int64, driverIDs []int64, entitlements []string) ([]store.Invitation, error) {
+	now := s.now()
+	invitations := make([]store.Invitation, 0, len(driverIDs))
+	seen := make(map[int64]bool, len(driverIDs))
+	for _, driverID := range driverIDs {
+		if seen[driverID] {
+			continue
+		}
+		seen[driverID] = true
+		invitations = append(invitations, store.Invitation{
+			DriverID:     driverID,
+			Entitlements: entitlements,
+			InvitedAt:    now,
+			InvitedBy:    invitedBy,
+		})
+	}
+
+	if err := s.store.SaveInvitations(ctx, invitations); err != nil {
+		return nil, fmt.Errorf("saving invitations: %w", err)
+	}
+	zerolog.Ctx(ctx).Info().Int64("invitedBy", invitedBy).Int("invited", len(invitations)).Strs("entitlements", entitlements).Msg("drivers invited")
+	return invitations, nil
+}
@@ -0,0 +1,132 @@
+package invitations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Admit(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Unix(5000, 0)
+	waitlistEntry := store.WaitlistEntry{DriverID: driverID, DriverName: "Jon Sabados", RequestedAt: now}
+
+	testCases := []struct {
+		name                 string
+		setupMock            func(*MockStore)
+		expectedEntitlements []string
+		expectedAdmitted     bool
+		expectedErr          bool
+	}{
+		{
+			name: "invited",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetInvitation(mock.Anything, driverID).Return(&store.Invitation{
+					DriverID:     driverID,
+					Entitlements: []string{"beta"},
+					InvitedAt:    time.Unix(4000, 0),
+					InvitedBy:    99,
+				}, nil)
+			},
+			expectedEntitlements: []string{"beta"},
+			expectedAdmitted:     true,
+		},
+		{
+			name: "not invited joins the waitlist",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetInvitation(mock.Anything, driverID).Return(nil, nil)
+				m.EXPECT().AddToWaitlist(mock.Anything, waitlistEntry).Return(true, nil)
+			},
+		},
+		{
+			name: "already waiting",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetInvitation(mock.Anything, driverID).Return(nil, nil)
+				m.EXPECT().AddToWaitlist(mock.Anything, waitlistEntry).Return(false, nil)
+			},
+		},
+		{
+			name: "invitation lookup fails",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetInvitation(mock.Anything, driverID).Return(nil, errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+		{
+			name: "waitlist add fails",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetInvitation(mock.Anything, driverID).Return(nil, nil)
+				m.EXPECT().AddToWaitlist(mock.Anything, waitlistEntry).Return(false, errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			service := NewService(mockStore)
+			service.now = func() time.Time { return now }
+
+			entitlements, admitted, err := service.Admit(context.Background(), driverID, "Jon Sabados")
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAdmitted, admitted)
+			assert.Equal(t, tc.expectedEntitlements, entitlements)
+		})
+	}
+}
+
+func TestService_GetWaitlist_LongestWaitingFirst(t *testing.T) {
+	early := store.WaitlistEntry{DriverID: 2, DriverName: "Jane Smith", RequestedAt: time.Unix(1000, 0)}
+	late := store.WaitlistEntry{DriverID: 1, DriverName: "Jon Sabados", RequestedAt: time.Unix(2000, 0)}
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetWaitlist(mock.Anything).Return([]store.WaitlistEntry{late, early}, nil)
+
+	entries, err := NewService(mockStore).GetWaitlist(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []store.WaitlistEntry{early, late}, entries)
+}
+
+func TestService_Invite(t *testing.T) {
+	now := time.Unix(5000, 0)
+	invitationFor := func(driverID int64) store.Invitation {
+		return store.Invitation{DriverID: driverID, Entitlements: []string{"beta"}, InvitedAt: now, InvitedBy: 99}
+	}
+
+	t.Run("duplicates are invited once", func(t *testing.T) {
+		expected := []store.Invitation{invitationFor(1), invitationFor(2)}
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().SaveInvitations(mock.Anything, expected).Return(nil)
+
+		service := NewService(mockStore)
+		service.now = func() time.Time { return now }
+
+		invitations, err := service.Invite(context.Background(), 99, []int64{1, 2, 1}, []string{"beta"})
+		require.NoError(t, err)
+		assert.Equal(t, expected, invitations)
+	})
+
+	t.Run("save fails", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().SaveInvitations(mock.Anything, []store.Invitation{invitationFor(1)}).Return(errors.New("boom"))
+
+		service := NewService(mockStore)
+		service.now = func() time.Time { return now }
+
+		_, err := service.Invite(context.Background(), 99, []int64{1}, []string{"beta"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,121 @@
+package iracing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// CapturedExchange is a response the client received, stored as it came over the wire so replays parse exactly what
+// was parsed originally. Request headers aren't kept, they carry the driver's access token.
+type CapturedExchange struct {
+	URL    string `json:"url"`
+	Status int    `json:"status"`
+	Body   string `json:"body"`
+}
+
+// Recorder collects the exchanges made with a context it has been attached to. Safe for use from multiple goroutines.
+type Recorder struct {
+	mu        sync.Mutex
+	exchanges []CapturedExchange
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Exchanges returns everything recorded so far, in the order the responses came back.
+func (r *Recorder) Exchanges() []CapturedExchange {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]CapturedExchange{}, r.exchanges...)
+}
+
+func (r *Recorder) record(exchange CapturedExchange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.exchanges = append(r.exchanges, exchange)
+}
+
+type recorderKey struct{}
+
+// WithRecorder attaches a recorder to the context, requests made with it through a RecordingHTTPClient are recorded.
+// Recorders already attached to ctx keep recording as well.
+func WithRecorder(ctx context.Context, recorder *Recorder) context.Context {
+	recorders := recordersFromContext(ctx)
+	return context.WithValue(ctx, recorderKey{}, append(recorders[:len(recorders):len(recorders)], recorder))
+}
+
+func recordersFromContext(ctx context.Context) []*Recorder {
+	recorders, _ := ctx.Value(recorderKey{}).([]*Recorder)
+	return recorders
+}
+
+// RecordingHTTPClient records responses for requests whose context carries a Recorder and passes everything else
+// straight through, so a single client can serve both captured and regular calls.
+type RecordingHTTPClient struct {
+	wrapped HTTPClient
+}
+
+func NewRecordingHTTPClient(wrapped HTTPClient) *RecordingHTTPClient {
+	return &RecordingHTTPClient{wrapped: wrapped}
+}
+
+func (c *RecordingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	resp, err := c.wrapped.Do(req)
+	recorders := recordersFromContext(req.Context())
+	if err != nil || len(recorders) == 0 {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading response body for capture: %w", err)
+	}
+	exchange := CapturedExchange{URL: req.URL.String(), Status: resp.StatusCode, Body: string(body)}
+	for _, recorder := range recorders {
+		recorder.record(exchange)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// ReplayHTTPClient answers requests from captured exchanges rather than going to iRacing. A URL fetched more than
+// once is answered in the order it was captured, repeating the last response once they run out.
+type ReplayHTTPClient struct {
+	mu        sync.Mutex
+	exchanges map[string][]CapturedExchange
+}
+
+func NewReplayHTTPClient(exchanges []CapturedExchange) *ReplayHTTPClient {
+	byURL := make(map[string][]CapturedExchange)
+	for _, exchange := range exchanges {
+		byURL[exchange.URL] = append(byURL[exchange.URL], exchange)
+	}
+	return &ReplayHTTPClient{exchanges: byURL}
+}
+
+func (c *ReplayHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	url := req.URL.String()
+	captured := c.exchanges[url]
+	if len(captured) == 0 {
+		return nil, fmt.Errorf("no captured response for %s", url)
+	}
+	exchange := captured[0]
+	if len(captured) > 1 {
+		c.exchanges[url] = captured[1:]
+	}
+	return &http.Response{
+		StatusCode: exchange.Status,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader([]byte(exchange.Body))),
+		Request:    req,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package iracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapture_RecordAndReplay(t *testing.T) {
+	linkResponse := loadFixture(t, "fixtures/series/seasons_link_response.json")
+	seasonsResponse := loadFixture(t, "fixtures/series/seasons_response.json")
+
+	httpClient := NewMockHTTPClient(t)
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "https://test.iracing.com/data/series/seasons"
+	})).RunAndReturn(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(linkResponse))}, nil
+	}).Times(2)
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return strings.Contains(req.URL.String(), "scorpio-assets.s3")
+	})).RunAndReturn(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(seasonsResponse))}, nil
+	}).Times(2)
+
+	recording := NewClient(NewRecordingHTTPClient(httpClient), NewMockMetricsClient(t), WithBaseURL("https://test.iracing.com"))
+
+	// calls without a recorder aren't captured
+	_, err := recording.GetSeriesSeasons(context.Background(), "test-access-token")
+	require.NoError(t, err)
+
+	recorder := NewRecorder()
+	original, err := recording.GetSeriesSeasons(WithRecorder(context.Background(), recorder), "test-access-token")
+	require.NoError(t, err)
+
+	exchanges := recorder.Exchanges()
+	require.Len(t, exchanges, 2)
+	assert.Equal(t, CapturedExchange{URL: "https://test.iracing.com/data/series/seasons", Status: http.StatusOK, Body: linkResponse}, exchanges[0])
+	assert.Equal(t, http.StatusOK, exchanges[1].Status)
+	assert.Equal(t, seasonsResponse, exchanges[1].Body)
+
+	replaying := NewClient(NewReplayHTTPClient(exchanges), NewMockMetricsClient(t), WithBaseURL("https://test.iracing.com"))
+
+	replayed, err := replaying.GetSeriesSeasons(context.Background(), "test-access-token")
+	require.NoError(t, err)
+	assert.Equal(t, original, replayed)
+
+	_, err = replaying.GetCars(context.Background(), "test-access-token")
+	assert.ErrorContains(t, err, "no captured response for https://test.iracing.com/data/car/get")
+}
+
+func TestCapture_NestedRecorders(t *testing.T) {
+	httpClient := NewMockHTTPClient(t)
+	httpClient.EXPECT().Do(mock.Anything).RunAndReturn(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(req.URL.Path))}, nil
+	}).Times(2)
+	recording := NewRecordingHTTPClient(httpClient)
+
+	outer := NewRecorder()
+	inner := NewRecorder()
+	outerCtx := WithRecorder(context.Background(), outer)
+	innerCtx := WithRecorder(outerCtx, inner)
+
+	for _, call := range []struct {
+		ctx  context.Context
+		path string
+	}{
+		{innerCtx, "/inner"},
+		{outerCtx, "/outer"},
+	} {
+		req, err := http.NewRequestWithContext(call.ctx, http.MethodGet, "https://test.iracing.com"+call.path, nil)
+		require.NoError(t, err)
+		resp, err := recording.Do(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, call.path, string(body))
+	}
+
+	assert.Equal(t, []CapturedExchange{
+		{URL: "https://test.iracing.com/inner", Status: http.StatusOK, Body: "/inner"},
+		{URL: "https://test.iracing.com/outer", Status: http.StatusOK, Body: "/outer"},
+	}, outer.Exchanges())
+	assert.Equal(t, []CapturedExchange{
+		{URL: "https://test.iracing.com/inner", Status: http.StatusOK, Body: "/inner"},
+	}, inner.Exchanges())
+}
+
+func TestReplayHTTPClient_RepeatedURL(t *testing.T) {
+	client := NewReplayHTTPClient([]CapturedExchange{
+		{URL: "https://test.iracing.com/data/thing", Status: http.StatusTooManyRequests, Body: "slow down"},
+		{URL: "https://test.iracing.com/data/thing", Status: http.StatusOK, Body: "ok"},
+	})
+
+	expected := []struct {
+		status int
+		body   string
+	}{
+		{http.StatusTooManyRequests, "slow down"},
+		{http.StatusOK, "ok"},
+		{http.StatusOK, "ok"}, // the last response sticks once they run out
+	}
+	for _, want := range expected {
+		req, err := http.NewRequest(http.MethodGet, "https://test.iracing.com/data/thing", nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, want.status, resp.StatusCode)
+		assert.Equal(t, want.body, string(body))
+	}
+}
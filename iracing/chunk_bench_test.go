@@ -0,0 +1,133 @@
+package iracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"testing"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+)
+
+// benchLapCount is how many laps the chunk benchmarks parse, split into chunks of benchChunkSize as iRacing splits
+// them
+const (
+	benchLapCount  = 500_000
+	benchChunkSize = 10_000
+)
+
+const benchChunkBaseURL = "https://benchmark.example.com/chunks/"
+
+// benchHTTPClient serves chunks from memory, so the benchmarks measure parsing rather than the network
+type benchHTTPClient map[string][]byte
+
+func (c benchHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	body, ok := c[req.URL.String()]
+	if !ok {
+		return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+}
+
+// generateChunks splits n generated items into chunks of benchChunkSize, marshaled as iRacing serves them.
+func generateChunks[T any](b *testing.B, n int, generate func(rng *rand.Rand, i int) T) (benchHTTPClient, chunkInfo) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	client := benchHTTPClient{}
+	info := chunkInfo{BaseDownloadURL: benchChunkBaseURL, ChunkSize: benchChunkSize}
+	for start := 0; start < n; start += benchChunkSize {
+		chunk := make([]T, 0, benchChunkSize)
+		for i := start; i < min(start+benchChunkSize, n); i++ {
+			chunk = append(chunk, generate(rng, i))
+		}
+		data, err := json.Marshal(chunk)
+		if err != nil {
+			b.Fatal(err)
+		}
+		fileName := fmt.Sprintf("chunk_%d.json", len(info.ChunkFileNames))
+		client[benchChunkBaseURL+fileName] = data
+		info.ChunkFileNames = append(info.ChunkFileNames, fileName)
+	}
+	info.NumChunks = len(info.ChunkFileNames)
+	info.Rows = n
+	return client, info
+}
+
+func generateBenchLap(rng *rand.Rand, i int) Lap {
+	custID := int64(100_000 + i%500/20)
+	lap := Lap{
+		GroupID:         custID,
+		Name:            "Benchmark Driver",
+		CustID:          custID,
+		DisplayName:     "Benchmark Driver",
+		LapNumber:       i%20 + 1,
+		Flags:           rng.IntN(4),
+		Incident:        rng.IntN(10) == 0,
+		SessionTime:     (i%20 + 1) * 900_000,
+		LapTime:         850_000 + rng.IntN(100_000),
+		PersonalBestLap: rng.IntN(20) == 0,
+		Helmet:          Helmet{Pattern: 1, Color1: "ffffff", Color2: "000000", Color3: "ff0000"},
+		LicenseLevel:    16,
+		CarNumber:       fmt.Sprint(i % 500 / 20),
+	}
+	if lap.Incident {
+		lap.LapEvents = []string{"off track"}
+	}
+	return lap
+}
+
+func generateBenchLapChartLap(rng *rand.Rand, i int) LapChartLap {
+	custID := int64(100_000 + i%500/20)
+	return LapChartLap{
+		GroupID:      custID,
+		Name:         "Benchmark Driver",
+		CustID:       custID,
+		DisplayName:  "Benchmark Driver",
+		LapNumber:    i % 21,
+		Flags:        rng.IntN(4),
+		SessionTime:  (i%21 + 1) * 900_000,
+		LapTime:      850_000 + rng.IntN(100_000),
+		LicenseLevel: 16,
+		CarNumber:    fmt.Sprint(i % 500 / 20),
+		LapPosition:  rng.IntN(25) + 1,
+	}
+}
+
+func BenchmarkFetchChunks_Laps(b *testing.B) {
+	httpClient, info := generateChunks(b, benchLapCount, generateBenchLap)
+	client := NewClient(httpClient, metrics.NewLoggingEmitter())
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		laps, err := fetchChunks[Lap](ctx, client, info)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(laps) != benchLapCount {
+			b.Fatalf("expected %d laps, got %d", benchLapCount, len(laps))
+		}
+	}
+}
+
+func BenchmarkFetchChunks_LapChart(b *testing.B) {
+	httpClient, info := generateChunks(b, benchLapCount, generateBenchLapChartLap)
+	client := NewClient(httpClient, metrics.NewLoggingEmitter())
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		laps, err := fetchChunks[LapChartLap](ctx, client, info)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(laps) != benchLapCount {
+			b.Fatalf("expected %d laps, got %d", benchLapCount, len(laps))
+		}
+	}
+}
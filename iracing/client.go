@@ -3,6 +3,7 @@ package iracing
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -21,6 +22,23 @@ const DataAPIBaseURL = "https://members-ng.iracing.com"
 // ImageBaseURL is the base URL for iRacing static image assets
 const ImageBaseURL = "https://images-static.iracing.com"
 
+// ResultsPageBaseURL is the iRacing member site page showing a session's official results
+const ResultsPageBaseURL = "https://members-ng.iracing.com/web/racing/results-stats/results"
+
+// ResultsURL returns the official iRacing results page for a subsession, for cross-checking what we have stored
+// against the source.
+func ResultsURL(subsessionID int64) string {
+	return fmt.Sprintf("%s?subsessionid=%d", ResultsPageBaseURL, subsessionID)
+}
+
+// DefaultMaxResponseBytes caps how much of a single response body the client will read. Results for a full split run to
+// several MB, this leaves plenty of room while keeping a runaway body from taking the Lambda's memory with it.
+const DefaultMaxResponseBytes = 64 << 20
+
+// largePayloadBytes is the size from which payloads are reported with the IRacingLargePayloadBytes metric, so the
+// largest seen can be watched against the limit without a metric for every request.
+const largePayloadBytes = 1 << 20
+
 // iRacingTimeFormat is ISO-8601 with minute precision used by iRacing API
 const iRacingTimeFormat = "2006-01-02T15:04Z"
 
@@ -33,12 +51,23 @@ type UserInfo struct {
 	UserID      int64
 	UserName    string
 	MemberSince time.Time
+	// ClubID and ClubName are the member's iRacing club (region), zero values when iRacing doesn't include them
+	ClubID   int
+	ClubName string
+}
+
+// Availability shares iRacing being down for maintenance with everything else calling it
+type Availability interface {
+	DownUntil(ctx context.Context) (time.Time, error)
+	ReportMaintenance(ctx context.Context, reason string) (time.Time, error)
 }
 
 type Client struct {
-	httpClient    HTTPClient
-	metricsClient MetricsClient
-	baseURL       string
+	httpClient       HTTPClient
+	metricsClient    MetricsClient
+	baseURL          string
+	availability     Availability
+	maxResponseBytes int64
 }
 
 type ClientOption func(*Client)
@@ -49,11 +78,28 @@ func WithBaseURL(url string) ClientOption {
 	}
 }
 
+// WithAvailability has the client report maintenance responses to availability, and fail data API requests with a
+// *MaintenanceError without making them while availability has iRacing down.
+func WithAvailability(availability Availability) ClientOption {
+	return func(c *Client) {
+		c.availability = availability
+	}
+}
+
+// WithMaxResponseBytes caps how much of a single response body the client will read, requests with a bigger body fail
+// with a *ResponseTooLargeError. Defaults to DefaultMaxResponseBytes.
+func WithMaxResponseBytes(n int64) ClientOption {
+	return func(c *Client) {
+		c.maxResponseBytes = n
+	}
+}
+
 func NewClient(httpClient HTTPClient, metricsClient MetricsClient, opts ...ClientOption) *Client {
 	c := &Client{
-		httpClient:    httpClient,
-		metricsClient: metricsClient,
-		baseURL:       DataAPIBaseURL,
+		httpClient:       httpClient,
+		metricsClient:    metricsClient,
+		baseURL:          DataAPIBaseURL,
+		maxResponseBytes: DefaultMaxResponseBytes,
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -108,16 +154,57 @@ func (c *Client) logRateLimitHeaders(ctx context.Context, logger *zerolog.Logger
 	}
 }
 
+// readBody reads a response body, failing with a *ResponseTooLargeError once it runs past maxResponseBytes.
+func (c *Client) readBody(body io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(body, c.maxResponseBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > c.maxResponseBytes {
+		return nil, &ResponseTooLargeError{Limit: c.maxResponseBytes}
+	}
+	return data, nil
+}
+
+// decodeBody decodes a JSON response body into v as it is read, rather than holding the raw body alongside the decoded
+// value. It fails with a *ResponseTooLargeError once the body runs past maxResponseBytes, and returns how many bytes
+// were read.
+func (c *Client) decodeBody(body io.Reader, v any) (int64, error) {
+	limited := &io.LimitedReader{R: body, N: c.maxResponseBytes + 1}
+	err := json.NewDecoder(limited).Decode(v)
+	read := c.maxResponseBytes + 1 - limited.N
+	if read > c.maxResponseBytes {
+		return read, &ResponseTooLargeError{Limit: c.maxResponseBytes}
+	}
+	return read, err
+}
+
+// recordPayloadSize reports payloads of largePayloadBytes or more.
+func (c *Client) recordPayloadSize(ctx context.Context, size int64) {
+	if size < largePayloadBytes {
+		return
+	}
+	logger := zerolog.Ctx(ctx)
+	logger.Info().Int64("bytes", size).Int64("limit", c.maxResponseBytes).Msg("large iRacing payload")
+	if err := c.metricsClient.EmitGauge(ctx, metrics.IRacingLargePayloadBytes, float64(size)); err != nil {
+		logger.Warn().Err(err).Msg("failed to emit payload size metric")
+	}
+}
+
 // linkResponse represents the initial response from iRacing API endpoints that return a signed S3 URL to fetch the actual data
 type linkResponse struct {
 	Link string `json:"link"`
 }
 
 // doAPIRequest makes an authenticated request to an iRacing API endpoint.
-// Handles 401 responses by returning ErrUpstreamUnauthorized.
+// Non 200 responses are returned as the matching error from errors.go, e.g. ErrUpstreamUnauthorized for a 401.
 func (c *Client) doAPIRequest(ctx context.Context, accessToken, endpoint string) ([]byte, error) {
 	logger := zerolog.Ctx(ctx)
 
+	if err := c.checkAvailability(ctx); err != nil {
+		return nil, err
+	}
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating request: %w", err)
@@ -130,7 +217,7 @@ func (c *Client) doAPIRequest(ctx context.Context, accessToken, endpoint string)
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := c.readBody(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("reading response body: %w", err)
 	}
@@ -141,20 +228,95 @@ func (c *Client) doAPIRequest(ctx context.Context, accessToken, endpoint string)
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		zerolog.Ctx(ctx).Warn().Str("body", string(body)).Msg("401 received from iRacing API")
-		return nil, ErrUpstreamUnauthorized
 	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(body))
+		err := errorFromResponse(resp, body)
+		var maintenanceErr *MaintenanceError
+		if errors.As(err, &maintenanceErr) {
+			c.reportMaintenance(ctx, maintenanceErr)
+		}
+		return nil, err
 	}
 
 	return body, nil
 }
 
+// checkAvailability returns a *MaintenanceError when availability has iRacing down, so the request isn't made.
+func (c *Client) checkAvailability(ctx context.Context) error {
+	if c.availability == nil {
+		return nil
+	}
+	downUntil, err := c.availability.DownUntil(ctx)
+	if err != nil {
+		// not knowing only costs a request that may fail, don't fail it for certain
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to check iRacing availability")
+		return nil
+	}
+	if !downUntil.IsZero() {
+		return &MaintenanceError{Until: downUntil}
+	}
+	return nil
+}
+
+// reportMaintenance reports a maintenance response to availability, setting when iRacing is expected back on
+// maintenanceErr.
+func (c *Client) reportMaintenance(ctx context.Context, maintenanceErr *MaintenanceError) {
+	if c.availability == nil {
+		return
+	}
+	downUntil, err := c.availability.ReportMaintenance(ctx, maintenanceErr.Reason)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to report iRacing maintenance")
+		return
+	}
+	maintenanceErr.Until = downUntil
+}
+
 // fetchLinkedData fetches data from an iRacing API endpoint that returns a signed S3 URL.
 // It makes the initial API request, parses the link response, and fetches the actual data from S3.
 func (c *Client) fetchLinkedData(ctx context.Context, accessToken, endpoint string) ([]byte, error) {
 	logger := zerolog.Ctx(ctx)
 
+	dataBody, err := c.openLinkedData(ctx, accessToken, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer dataBody.Close()
+
+	data, err := c.readBody(dataBody)
+	if err != nil {
+		return nil, fmt.Errorf("reading data response body: %w", err)
+	}
+
+	logger.Trace().RawJSON("response", data).Msg("received linked data from S3")
+	c.recordPayloadSize(ctx, int64(len(data)))
+
+	return data, nil
+}
+
+// decodeLinkedData is fetchLinkedData for payloads big enough to be worth decoding as they're read, such as session
+// results, decoding the linked data into v.
+func (c *Client) decodeLinkedData(ctx context.Context, accessToken, endpoint string, v any) error {
+	dataBody, err := c.openLinkedData(ctx, accessToken, endpoint)
+	if err != nil {
+		return err
+	}
+	defer dataBody.Close()
+
+	size, err := c.decodeBody(dataBody, v)
+	if err != nil {
+		return err
+	}
+
+	zerolog.Ctx(ctx).Trace().Int64("bytes", size).Msg("decoded linked data from S3")
+	c.recordPayloadSize(ctx, size)
+
+	return nil
+}
+
+// openLinkedData makes the initial API request for an endpoint that returns a signed S3 URL, and requests what the link
+// points at. The caller closes the returned body.
+func (c *Client) openLinkedData(ctx context.Context, accessToken, endpoint string) (io.ReadCloser, error) {
 	body, err := c.doAPIRequest(ctx, accessToken, endpoint)
 	if err != nil {
 		return nil, err
@@ -178,20 +340,17 @@ func (c *Client) fetchLinkedData(ctx context.Context, accessToken, endpoint stri
 	if err != nil {
 		return nil, fmt.Errorf("executing data request: %w", err)
 	}
-	defer dataResp.Body.Close()
-
-	dataBody, err := io.ReadAll(dataResp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading data response body: %w", err)
-	}
-
-	logger.Trace().RawJSON("response", dataBody).Int("status", dataResp.StatusCode).Msg("received linked data from S3")
 
 	if dataResp.StatusCode != http.StatusOK {
+		defer dataResp.Body.Close()
+		dataBody, err := c.readBody(dataResp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading data response body: %w", err)
+		}
 		return nil, fmt.Errorf("fetching linked data failed with status %d: %s", dataResp.StatusCode, string(dataBody))
 	}
 
-	return dataBody, nil
+	return dataResp.Body, nil
 }
 
 // chunkInfo represents the chunked download info returned by search endpoints
@@ -203,8 +362,8 @@ type chunkInfo struct {
 	ChunkFileNames  []string `json:"chunk_file_names"`
 }
 
-// fetchChunks fetches and unmarshals chunked data from S3.
-func fetchChunks[T any](ctx context.Context, httpClient HTTPClient, info chunkInfo) ([]T, error) {
+// fetchChunks fetches and decodes chunked data from S3.
+func fetchChunks[T any](ctx context.Context, c *Client, info chunkInfo) ([]T, error) {
 	logger := zerolog.Ctx(ctx)
 
 	if info.Rows == 0 {
@@ -222,27 +381,13 @@ func fetchChunks[T any](ctx context.Context, httpClient HTTPClient, info chunkIn
 
 		chunkReq, err := http.NewRequestWithContext(ctx, http.MethodGet, chunkURL, nil)
 		if err != nil {
-			return nil, fmt.Errorf("creating chunk request: %w", err)
+			return nil, &ChunkFetchError{Chunk: i, Err: fmt.Errorf("creating chunk request: %w", err)}
 		}
 
-		chunkResp, err := httpClient.Do(chunkReq)
-		if err != nil {
-			return nil, fmt.Errorf("executing chunk request: %w", err)
-		}
-
-		chunkBody, err := io.ReadAll(chunkResp.Body)
-		chunkResp.Body.Close()
-		if err != nil {
-			return nil, fmt.Errorf("reading chunk response body: %w", err)
-		}
-
-		if chunkResp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("fetch chunk %d failed with status %d: %s", i, chunkResp.StatusCode, string(chunkBody))
-		}
-
-		var chunkItems []T
-		if err := json.Unmarshal(chunkBody, &chunkItems); err != nil {
-			return nil, fmt.Errorf("parsing chunk %d: %w", i, err)
+		chunkItems, chunkErr := fetchChunk[T](ctx, c, chunkReq)
+		if chunkErr != nil {
+			chunkErr.Chunk = i
+			return nil, chunkErr
 		}
 
 		results = append(results, chunkItems...)
@@ -253,6 +398,32 @@ func fetchChunks[T any](ctx context.Context, httpClient HTTPClient, info chunkIn
 	return results, nil
 }
 
+// fetchChunk fetches and decodes a single chunk, failing with a *ChunkFetchError for the caller to fill the chunk in on.
+func fetchChunk[T any](ctx context.Context, c *Client, chunkReq *http.Request) ([]T, *ChunkFetchError) {
+	chunkResp, err := c.httpClient.Do(chunkReq)
+	if err != nil {
+		return nil, &ChunkFetchError{Err: fmt.Errorf("executing chunk request: %w", err)}
+	}
+	defer chunkResp.Body.Close()
+
+	if chunkResp.StatusCode != http.StatusOK {
+		chunkBody, err := c.readBody(chunkResp.Body)
+		if err != nil {
+			return nil, &ChunkFetchError{Status: chunkResp.StatusCode, Err: fmt.Errorf("reading chunk response body: %w", err)}
+		}
+		return nil, &ChunkFetchError{Status: chunkResp.StatusCode, Err: fmt.Errorf("failed with status %d: %s", chunkResp.StatusCode, string(chunkBody))}
+	}
+
+	var chunkItems []T
+	size, err := c.decodeBody(chunkResp.Body, &chunkItems)
+	if err != nil {
+		return nil, &ChunkFetchError{Status: chunkResp.StatusCode, Err: fmt.Errorf("parsing chunk: %w", err)}
+	}
+	c.recordPayloadSize(ctx, size)
+
+	return chunkItems, nil
+}
+
 // searchResponse represents the response from search endpoints like /data/results/search_series
 type searchResponse struct {
 	Type string `json:"type"`
@@ -325,6 +496,8 @@ func (c *Client) GetUserInfo(ctx context.Context, accessToken string) (*UserInfo
 		CustID      int64    `json:"cust_id"`
 		DisplayName string   `json:"display_name"`
 		MemberSince dateOnly `json:"member_since"`
+		ClubID      int      `json:"club_id"`
+		ClubName    string   `json:"club_name"`
 	}
 	if err := json.Unmarshal(data, &apiResp); err != nil {
 		return nil, fmt.Errorf("parsing user info response: %w", err)
@@ -334,6 +507,8 @@ func (c *Client) GetUserInfo(ctx context.Context, accessToken string) (*UserInfo
 		UserID:      apiResp.CustID,
 		UserName:    apiResp.DisplayName,
 		MemberSince: apiResp.MemberSince.Time(),
+		ClubID:      apiResp.ClubID,
+		ClubName:    apiResp.ClubName,
 	}, nil
 }
 
@@ -361,7 +536,7 @@ func (c *Client) SearchSeriesResults(ctx context.Context, accessToken string, fi
 		return nil, fmt.Errorf("search was not successful")
 	}
 
-	return fetchChunks[SeriesResult](ctx, c.httpClient, searchResp.Data.ChunkInfo)
+	return fetchChunks[SeriesResult](ctx, c, searchResp.Data.ChunkInfo)
 }
 
 // GetSessionResultsOption configures optional parameters for GetSessionResults
@@ -390,14 +565,10 @@ func (c *Client) GetSessionResults(ctx context.Context, accessToken string, subs
 
 	endpoint := c.baseURL + "/data/results/get?" + params.Encode()
 
-	data, err := c.fetchLinkedData(ctx, accessToken, endpoint)
-	if err != nil {
-		return nil, err
-	}
-
+	// results for a full split run to several MB, so they're decoded as they're read
 	var result SessionResult
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("parsing session results: %w", err)
+	if err := c.decodeLinkedData(ctx, accessToken, endpoint, &result); err != nil {
+		return nil, fmt.Errorf("fetching session results: %w", err)
 	}
 
 	return &result, nil
@@ -474,7 +645,7 @@ func (c *Client) GetLapData(ctx context.Context, accessToken string, subsessionI
 		return nil, fmt.Errorf("parsing lap data response: %w", err)
 	}
 
-	laps, err := fetchChunks[Lap](ctx, c.httpClient, apiResp.ChunkInfo)
+	laps, err := fetchChunks[Lap](ctx, c, apiResp.ChunkInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -500,6 +671,50 @@ func (c *Client) GetLapData(ctx context.Context, accessToken string, subsessionI
 	}, nil
 }
 
+// lapChartAPIResponse is the raw response from the lap chart endpoint including chunk info.
+type lapChartAPIResponse struct {
+	Success     bool               `json:"success"`
+	SessionInfo LapDataSessionInfo `json:"session_info"`
+	BestLapNum  int                `json:"best_lap_num"`
+	BestLapTime int                `json:"best_lap_time"`
+	ChunkInfo   chunkInfo          `json:"chunk_info"`
+	LastUpdated time.Time          `json:"last_updated"`
+}
+
+// GetLapChartData fetches every driver's laps for a subsession along with their running position and interval at the
+// end of each lap.
+func (c *Client) GetLapChartData(ctx context.Context, accessToken string, subsessionID int64, simsessionNumber int) (*LapChartResponse, error) {
+	params := url.Values{}
+	params.Set("subsession_id", strconv.FormatInt(subsessionID, 10))
+	params.Set("simsession_number", strconv.Itoa(simsessionNumber))
+
+	endpoint := c.baseURL + "/data/results/lap_chart_data?" + params.Encode()
+
+	body, err := c.fetchLinkedData(ctx, accessToken, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResp lapChartAPIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("parsing lap chart response: %w", err)
+	}
+
+	laps, err := fetchChunks[LapChartLap](ctx, c, apiResp.ChunkInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LapChartResponse{
+		Success:     apiResp.Success,
+		SessionInfo: apiResp.SessionInfo,
+		BestLapNum:  apiResp.BestLapNum,
+		BestLapTime: apiResp.BestLapTime,
+		LastUpdated: apiResp.LastUpdated,
+		Laps:        laps,
+	}, nil
+}
+
 // GetTracks fetches all track information from iRacing.
 func (c *Client) GetTracks(ctx context.Context, accessToken string) ([]TrackInfo, error) {
 	endpoint := c.baseURL + "/data/track/get"
@@ -610,3 +825,193 @@ func (c *Client) GetSeries(ctx context.Context, accessToken string) ([]SeriesInf
 
 	return series, nil
 }
+
+// GetSeriesSeasons fetches the current seasons of every series along with their race week schedules.
+func (c *Client) GetSeriesSeasons(ctx context.Context, accessToken string) ([]SeriesSeason, error) {
+	endpoint := c.baseURL + "/data/series/seasons"
+
+	data, err := c.fetchLinkedData(ctx, accessToken, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var seasons []SeriesSeason
+	if err := json.Unmarshal(data, &seasons); err != nil {
+		return nil, fmt.Errorf("parsing series seasons response: %w", err)
+	}
+
+	return seasons, nil
+}
+
+// GetMemberDivision fetches the calling member's division for a season.
+func (c *Client) GetMemberDivision(ctx context.Context, accessToken string, seasonID int64, eventType EventType) (*MemberDivision, error) {
+	params := url.Values{}
+	params.Set("season_id", strconv.FormatInt(seasonID, 10))
+	params.Set("event_type", strconv.Itoa(int(eventType)))
+
+	endpoint := c.baseURL + "/data/stats/member_division?" + params.Encode()
+
+	data, err := c.fetchLinkedData(ctx, accessToken, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var result MemberDivision
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("parsing member division response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// SeasonStandingsOption configures optional parameters for GetSeasonDriverStandings
+type SeasonStandingsOption interface {
+	applySeasonStandings(params url.Values)
+}
+
+type divisionOption int
+
+func (d divisionOption) applySeasonStandings(params url.Values) {
+	params.Set("division", strconv.Itoa(int(d)))
+}
+
+// WithDivision limits standings to a single division (0-based, 0 is division 1).
+func WithDivision(division int) SeasonStandingsOption {
+	return divisionOption(division)
+}
+
+type raceWeekNumOption int
+
+func (r raceWeekNumOption) applySeasonStandings(params url.Values) {
+	params.Set("race_week_num", strconv.Itoa(int(r)))
+}
+
+// WithRaceWeekNum limits standings to a single race week (0-based).
+func WithRaceWeekNum(raceWeekNum int) SeasonStandingsOption {
+	return raceWeekNumOption(raceWeekNum)
+}
+
+// seasonStandingsAPIResponse is the raw response from the season standings endpoint including chunk info.
+type seasonStandingsAPIResponse struct {
+	SeasonDriverStandings
+	ChunkInfo chunkInfo `json:"chunk_info"`
+}
+
+// GetSeasonDriverStandings fetches the championship standings for a season and car class.
+func (c *Client) GetSeasonDriverStandings(ctx context.Context, accessToken string, seasonID, carClassID int64, opts ...SeasonStandingsOption) (*SeasonDriverStandings, error) {
+	params := url.Values{}
+	params.Set("season_id", strconv.FormatInt(seasonID, 10))
+	params.Set("car_class_id", strconv.FormatInt(carClassID, 10))
+	for _, opt := range opts {
+		opt.applySeasonStandings(params)
+	}
+
+	endpoint := c.baseURL + "/data/stats/season_driver_standings?" + params.Encode()
+
+	data, err := c.fetchLinkedData(ctx, accessToken, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResp seasonStandingsAPIResponse
+	if err := json.Unmarshal(data, &apiResp); err != nil {
+		return nil, fmt.Errorf("parsing season standings response: %w", err)
+	}
+
+	standings, err := fetchChunks[SeasonDriverStanding](ctx, c, apiResp.ChunkInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	result := apiResp.SeasonDriverStandings
+	result.Standings = standings
+	return &result, nil
+}
+
+// DataResponse is an unparsed data API response, for looking at what iRacing sends back rather than consuming it.
+type DataResponse struct {
+	Status      int
+	ContentType string
+	Body        []byte
+	// Linked is set when iRacing answered with a link and Body is what the link pointed at
+	Linked bool
+}
+
+// FetchData makes a request to any data API path and returns the response as is, following the link when iRacing
+// answers with one. Responses other than 401 and maintenance are returned whatever their status, chunked results are
+// left as the chunk info rather than being fetched. Like every other data API request it fails with a *MaintenanceError
+// while iRacing is down.
+func (c *Client) FetchData(ctx context.Context, accessToken, path string, params url.Values) (*DataResponse, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if err := c.checkAvailability(ctx); err != nil {
+		return nil, err
+	}
+
+	endpoint := c.baseURL + path
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	ret, err := c.doRawRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	c.logRateLimitHeaders(ctx, logger, ret.resp)
+	logger.Trace().Int("status", ret.Status).Str("endpoint", endpoint).Msg("received raw API response")
+
+	if ret.Status == http.StatusUnauthorized {
+		return nil, ErrUpstreamUnauthorized
+	}
+	if ret.Status != http.StatusOK {
+		if reason, ok := maintenanceReason(ret.Body); ok {
+			maintenanceErr := &MaintenanceError{Reason: reason}
+			c.reportMaintenance(ctx, maintenanceErr)
+			return nil, maintenanceErr
+		}
+		return &ret.DataResponse, nil
+	}
+
+	var linkResp linkResponse
+	if err := json.Unmarshal(ret.Body, &linkResp); err != nil || linkResp.Link == "" {
+		return &ret.DataResponse, nil
+	}
+
+	dataReq, err := http.NewRequestWithContext(ctx, http.MethodGet, linkResp.Link, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating data request: %w", err)
+	}
+	linked, err := c.doRawRequest(dataReq)
+	if err != nil {
+		return nil, err
+	}
+	linked.Linked = true
+	return &linked.DataResponse, nil
+}
+
+type rawResponse struct {
+	DataResponse
+	resp *http.Response
+}
+
+func (c *Client) doRawRequest(req *http.Request) (*rawResponse, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := c.readBody(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+	return &rawResponse{
+		DataResponse: DataResponse{Status: resp.StatusCode, ContentType: resp.Header.Get("Content-Type"), Body: body},
+		resp:         resp,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"testing"
@@ -20,6 +21,10 @@ func loadFixture(t *testing.T, path string) string {
 	return string(data)
 }
 
+func TestResultsURL(t *testing.T) {
+	assert.Equal(t, "https://members-ng.iracing.com/web/racing/results-stats/results?subsessionid=12345678", ResultsURL(12345678))
+}
+
 func TestClient_GetUserInfo(t *testing.T) {
 	testCases := []struct {
 		name               string
@@ -30,6 +35,8 @@ func TestClient_GetUserInfo(t *testing.T) {
 		expectedUserID     int64
 		expectedUserName   string
 		expectedMemberYear int
+		expectedClubID     int
+		expectedClubName   string
 		expectedErr        string
 	}{
 		{
@@ -42,6 +49,18 @@ func TestClient_GetUserInfo(t *testing.T) {
 			expectedUserName:   "Jon Sabados",
 			expectedMemberYear: 2024,
 		},
+		{
+			name:               "success with club",
+			linkResponseFile:   "fixtures/member/info_link_response.json",
+			linkStatusCode:     http.StatusOK,
+			memberResponseFile: "fixtures/member/info_with_club_response.json",
+			memberStatusCode:   http.StatusOK,
+			expectedUserID:     1100751,
+			expectedUserName:   "Test Driver",
+			expectedMemberYear: 2019,
+			expectedClubID:     7,
+			expectedClubName:   "Great Plains",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -81,7 +100,287 @@ func TestClient_GetUserInfo(t *testing.T) {
 				assert.Equal(t, tc.expectedUserID, userInfo.UserID)
 				assert.Equal(t, tc.expectedUserName, userInfo.UserName)
 				assert.Equal(t, tc.expectedMemberYear, userInfo.MemberSince.Year())
+				assert.Equal(t, tc.expectedClubID, userInfo.ClubID)
+				assert.Equal(t, tc.expectedClubName, userInfo.ClubName)
+			}
+		})
+	}
+}
+
+func TestClient_GetSeriesSeasons(t *testing.T) {
+	linkResponse := loadFixture(t, "fixtures/series/seasons_link_response.json")
+	seasonsResponse := loadFixture(t, "fixtures/series/seasons_response.json")
+
+	httpClient := NewMockHTTPClient(t)
+	metricsClient := NewMockMetricsClient(t)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "https://test.iracing.com/data/series/seasons"
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(linkResponse)),
+	}, nil)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return strings.Contains(req.URL.String(), "scorpio-assets.s3")
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(seasonsResponse)),
+	}, nil)
+
+	client := NewClient(httpClient, metricsClient, WithBaseURL("https://test.iracing.com"))
+
+	seasons, err := client.GetSeriesSeasons(context.Background(), "test-access-token")
+	require.NoError(t, err)
+	assert.Equal(t, []SeriesSeason{
+		{
+			SeasonID:      5012,
+			SeriesID:      139,
+			SeasonName:    "Global Mazda MX-5 Cup - 2026 Season 2",
+			SeasonYear:    2026,
+			SeasonQuarter: 2,
+			Active:        true,
+			Official:      true,
+			CarClassIDs:   []int64{74},
+			Schedules: []SeasonSchedule{
+				{
+					RaceWeekNum: 0,
+					StartDate:   "2026-03-17",
+					Track:       ScheduleTrack{TrackID: 219, TrackName: "Okayama International Circuit", ConfigName: "Full Course"},
+					RaceTimeDescriptors: []RaceTimeDescriptor{
+						{Repeating: true, FirstSessionTime: "00:15:00", RepeatMinutes: 120, DayOffset: []int{0, 1, 2, 3, 4, 5, 6}},
+					},
+				},
+				{
+					RaceWeekNum: 1,
+					StartDate:   "2026-03-24",
+					Track:       ScheduleTrack{TrackID: 47, TrackName: "WeatherTech Raceway at Laguna Seca", ConfigName: "Full Course"},
+					RaceTimeDescriptors: []RaceTimeDescriptor{
+						{SessionTimes: []string{"2026-03-28T18:00:00Z", "2026-03-29T18:00:00Z"}},
+					},
+				},
+			},
+		},
+	}, seasons)
+}
+
+func TestClient_GetMemberDivision(t *testing.T) {
+	linkResponse := loadFixture(t, "fixtures/stats/member_division_link_response.json")
+	divisionResponse := loadFixture(t, "fixtures/stats/member_division_response.json")
+
+	httpClient := NewMockHTTPClient(t)
+	metricsClient := NewMockMetricsClient(t)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "https://test.iracing.com/data/stats/member_division?event_type=5&season_id=4500"
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(linkResponse)),
+	}, nil)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return strings.Contains(req.URL.String(), "scorpio-assets.s3")
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(divisionResponse)),
+	}, nil)
+
+	client := NewClient(httpClient, metricsClient, WithBaseURL("https://test.iracing.com"))
+
+	division, err := client.GetMemberDivision(context.Background(), "test-access-token", 4500, EventTypeRace)
+	require.NoError(t, err)
+	assert.Equal(t, &MemberDivision{
+		Division:  3,
+		EventType: 5,
+		Success:   true,
+		SeasonID:  4500,
+	}, division)
+}
+
+func TestClient_GetSeasonDriverStandings(t *testing.T) {
+	linkResponse := loadFixture(t, "fixtures/stats/season_driver_standings_link_response.json")
+	standingsResponse := loadFixture(t, "fixtures/stats/season_driver_standings_response.json")
+	chunkResponse := loadFixture(t, "fixtures/stats/season_driver_standings_chunk_0.json")
+
+	httpClient := NewMockHTTPClient(t)
+	metricsClient := NewMockMetricsClient(t)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "https://test.iracing.com/data/stats/season_driver_standings?car_class_id=74&division=3&season_id=4500"
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(linkResponse)),
+	}, nil)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return strings.Contains(req.URL.String(), "scorpio-assets.s3.us-east-1")
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(standingsResponse)),
+	}, nil)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return strings.HasSuffix(req.URL.String(), "/standings_chunk_0.json")
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(chunkResponse)),
+	}, nil)
+
+	client := NewClient(httpClient, metricsClient, WithBaseURL("https://test.iracing.com"))
+
+	standings, err := client.GetSeasonDriverStandings(context.Background(), "test-access-token", 4500, 74, WithDivision(3))
+	require.NoError(t, err)
+
+	assert.True(t, standings.Success)
+	assert.Equal(t, int64(4500), standings.SeasonID)
+	assert.Equal(t, int64(42), standings.SeriesID)
+	assert.Equal(t, "Advanced Mazda MX-5 Cup Series", standings.SeriesName)
+	assert.Equal(t, int64(74), standings.CarClassID)
+	require.NotNil(t, standings.Division)
+	assert.Equal(t, 3, *standings.Division)
+
+	require.Len(t, standings.Standings, 2)
+	assert.Equal(t, int64(2000), standings.Standings[0].CustID)
+	assert.Equal(t, 812, standings.Standings[0].Points)
+	assert.Equal(t, int64(1100750), standings.Standings[1].CustID)
+	assert.Equal(t, 2, standings.Standings[1].Rank)
+	assert.Equal(t, 5, standings.Standings[1].WeeksCounted)
+	assert.Equal(t, 640, standings.Standings[1].Points)
+	assert.True(t, standings.Standings[1].WeekDropped)
+}
+
+func TestClient_GetLapChartData(t *testing.T) {
+	linkResponse := loadFixture(t, "fixtures/results/lap_chart_data_link_response.json")
+	lapChartResponse := loadFixture(t, "fixtures/results/lap_chart_data_response.json")
+	chunkResponse := loadFixture(t, "fixtures/results/lap_chart_data_chunk_0.json")
+
+	httpClient := NewMockHTTPClient(t)
+	metricsClient := NewMockMetricsClient(t)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "https://test.iracing.com/data/results/lap_chart_data?simsession_number=0&subsession_id=12345"
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(linkResponse)),
+	}, nil)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return strings.Contains(req.URL.String(), "scorpio-assets.s3.us-east-1")
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(lapChartResponse)),
+	}, nil)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return strings.HasSuffix(req.URL.String(), "/lap_chart_chunk_0.json")
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(chunkResponse)),
+	}, nil)
+
+	client := NewClient(httpClient, metricsClient, WithBaseURL("https://test.iracing.com"))
+
+	lapChart, err := client.GetLapChartData(context.Background(), "test-access-token", 12345, 0)
+	require.NoError(t, err)
+
+	assert.True(t, lapChart.Success)
+	assert.Equal(t, int64(12345), lapChart.SessionInfo.SubsessionID)
+	assert.Equal(t, 2, lapChart.BestLapNum)
+	assert.Equal(t, 951234, lapChart.BestLapTime)
+
+	require.Len(t, lapChart.Laps, 4)
+	assert.Equal(t, int64(1100750), lapChart.Laps[0].CustID)
+	assert.Equal(t, 0, lapChart.Laps[0].LapNumber)
+	assert.Equal(t, 2, lapChart.Laps[0].LapPosition)
+	assert.Equal(t, 2501, lapChart.Laps[0].Interval)
+	assert.Equal(t, "ms", lapChart.Laps[0].IntervalUnits)
+	assert.Equal(t, int64(2000), lapChart.Laps[3].CustID)
+	assert.Equal(t, 1, lapChart.Laps[3].LapNumber)
+	assert.Equal(t, 2, lapChart.Laps[3].LapPosition)
+	assert.Equal(t, []string{"off track"}, lapChart.Laps[3].LapEvents)
+}
+
+func TestClient_FetchData(t *testing.T) {
+	linkResponse := loadFixture(t, "fixtures/series/seasons_link_response.json")
+	seasonsResponse := loadFixture(t, "fixtures/series/seasons_response.json")
+
+	testCases := []struct {
+		name             string
+		params           url.Values
+		expectedURL      string
+		upstreamStatus   int
+		upstreamBody     string
+		followsLink      bool
+		expectedResponse *DataResponse
+		expectedErr      error
+	}{
+		{
+			name:             "follows links",
+			expectedURL:      "https://test.iracing.com/data/series/seasons",
+			upstreamStatus:   http.StatusOK,
+			upstreamBody:     linkResponse,
+			followsLink:      true,
+			expectedResponse: &DataResponse{Status: http.StatusOK, Body: []byte(seasonsResponse), Linked: true},
+		},
+		{
+			name:             "returns responses without a link as is",
+			params:           url.Values{"include_series": []string{"true"}},
+			expectedURL:      "https://test.iracing.com/data/series/seasons?include_series=true",
+			upstreamStatus:   http.StatusOK,
+			upstreamBody:     `{"success":true}`,
+			expectedResponse: &DataResponse{Status: http.StatusOK, Body: []byte(`{"success":true}`)},
+		},
+		{
+			name:             "returns failures as is",
+			expectedURL:      "https://test.iracing.com/data/series/seasons",
+			upstreamStatus:   http.StatusBadRequest,
+			upstreamBody:     `{"error":"bad request"}`,
+			expectedResponse: &DataResponse{Status: http.StatusBadRequest, Body: []byte(`{"error":"bad request"}`)},
+		},
+		{
+			name:           "unauthorized",
+			expectedURL:    "https://test.iracing.com/data/series/seasons",
+			upstreamStatus: http.StatusUnauthorized,
+			upstreamBody:   `{"error":"Unauthorized"}`,
+			expectedErr:    ErrUpstreamUnauthorized,
+		},
+		{
+			name:           "maintenance",
+			expectedURL:    "https://test.iracing.com/data/series/seasons",
+			upstreamStatus: http.StatusServiceUnavailable,
+			upstreamBody:   `{"error":"Site Maintenance","note":"Back soon"}`,
+			expectedErr:    ErrMaintenance,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := NewMockHTTPClient(t)
+
+			httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+				return req.URL.String() == tc.expectedURL && req.Header.Get("Authorization") == "Bearer test-access-token"
+			})).Return(&http.Response{
+				StatusCode: tc.upstreamStatus,
+				Body:       io.NopCloser(strings.NewReader(tc.upstreamBody)),
+			}, nil)
+			if tc.followsLink {
+				httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+					return strings.Contains(req.URL.String(), "scorpio-assets.s3")
+				})).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(seasonsResponse)),
+				}, nil)
+			}
+
+			client := NewClient(httpClient, NewMockMetricsClient(t), WithBaseURL("https://test.iracing.com"))
+
+			got, err := client.FetchData(context.Background(), "test-access-token", "/data/series/seasons", tc.params)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
 			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResponse, got)
 		})
 	}
 }
@@ -1,6 +1,168 @@
 package iracing
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // ErrUpstreamUnauthorized is returned when iRacing returns 401, indicating the access token is expired
 var ErrUpstreamUnauthorized = errors.New("upstream returned 401 unauthorized")
+
+// ErrTokenRejected is returned when iRacing's OAuth server refuses an authorization code or refresh token, as opposed to
+// failing to answer
+var ErrTokenRejected = errors.New("token request rejected")
+
+// ErrRateLimited is returned when iRacing returns 429. The error is a *RateLimitError carrying when the limit resets.
+var ErrRateLimited = errors.New("upstream rate limit exceeded")
+
+// ErrMaintenance is returned when iRacing answers with its maintenance payload, or is already known to be down for
+// maintenance. The error is a *MaintenanceError. Nothing will succeed until maintenance is over.
+var ErrMaintenance = errors.New("upstream is down for maintenance")
+
+// ErrNotFound is returned when iRacing returns 404, e.g. for a subsession that doesn't exist
+var ErrNotFound = errors.New("upstream returned 404 not found")
+
+// ErrChunkFetch is returned when a chunk of a chunked result can't be downloaded or parsed. The error is a
+// *ChunkFetchError naming the chunk.
+var ErrChunkFetch = errors.New("fetching result chunk failed")
+
+// ErrResponseTooLarge is returned when a response body runs past the client's size limit. The error is a
+// *ResponseTooLargeError.
+var ErrResponseTooLarge = errors.New("response body too large")
+
+// RateLimitError is returned when iRacing rejects a request for exceeding the rate limit. ResetAt is zero when iRacing
+// didn't say when the limit resets.
+type RateLimitError struct {
+	ResetAt time.Time
+}
+
+func (e *RateLimitError) Error() string {
+	if e.ResetAt.IsZero() {
+		return ErrRateLimited.Error()
+	}
+	return fmt.Sprintf("%s, resets at %s", ErrRateLimited, e.ResetAt.UTC().Format(time.RFC3339))
+}
+
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// RateLimitResetAt returns when the rate limit behind err resets, false when err isn't a rate limit error or iRacing
+// didn't say.
+func RateLimitResetAt(err error) (time.Time, bool) {
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.ResetAt.IsZero() {
+		return time.Time{}, false
+	}
+	return rateLimitErr.ResetAt, true
+}
+
+// MaintenanceError is returned when iRacing is down for maintenance. Until is when it is expected back, zero when
+// nothing has said.
+type MaintenanceError struct {
+	Reason string
+	Until  time.Time
+}
+
+func (e *MaintenanceError) Error() string {
+	msg := ErrMaintenance.Error()
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	if !e.Until.IsZero() {
+		msg += ", expected back at " + e.Until.UTC().Format(time.RFC3339)
+	}
+	return msg
+}
+
+func (e *MaintenanceError) Is(target error) bool {
+	return target == ErrMaintenance
+}
+
+// MaintenanceUntil returns when the maintenance behind err is expected to be over, false when err isn't a maintenance
+// error or nothing has said.
+func MaintenanceUntil(err error) (time.Time, bool) {
+	var maintenanceErr *MaintenanceError
+	if !errors.As(err, &maintenanceErr) || maintenanceErr.Until.IsZero() {
+		return time.Time{}, false
+	}
+	return maintenanceErr.Until, true
+}
+
+// ResponseTooLargeError is returned when a response body is bigger than Limit bytes. Reading stops at the limit, so
+// how big the body really was isn't known.
+type ResponseTooLargeError struct {
+	Limit int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("%s, exceeds limit of %d bytes", ErrResponseTooLarge, e.Limit)
+}
+
+func (e *ResponseTooLargeError) Is(target error) bool {
+	return target == ErrResponseTooLarge
+}
+
+// ChunkFetchError is returned when chunk Chunk of a chunked result couldn't be fetched. Status is the S3 response status,
+// zero when the failure came before or after a response.
+type ChunkFetchError struct {
+	Chunk  int
+	Status int
+	Err    error
+}
+
+func (e *ChunkFetchError) Error() string {
+	return fmt.Sprintf("fetching chunk %d: %v", e.Chunk, e.Err)
+}
+
+func (e *ChunkFetchError) Unwrap() []error {
+	return []error{ErrChunkFetch, e.Err}
+}
+
+// maintenancePayload is the body iRacing serves while the service is down for maintenance
+type maintenancePayload struct {
+	Error string `json:"error"`
+	Note  string `json:"note"`
+}
+
+// errorFromResponse works out the error for a non 200 data API response, falling back to a plain error carrying the
+// status and body for anything not worth branching on.
+func errorFromResponse(resp *http.Response, body []byte) error {
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrUpstreamUnauthorized
+	case http.StatusTooManyRequests:
+		rateLimitErr := &RateLimitError{}
+		if resetEpoch, err := strconv.ParseInt(resp.Header.Get("x-ratelimit-reset"), 10, 64); err == nil {
+			rateLimitErr.ResetAt = time.Unix(resetEpoch, 0)
+		}
+		return rateLimitErr
+	case http.StatusNotFound:
+		return ErrNotFound
+	}
+	if reason, ok := maintenanceReason(body); ok {
+		return &MaintenanceError{Reason: reason}
+	}
+	return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(body))
+}
+
+// maintenanceReason returns what iRacing said about the maintenance when body is its maintenance payload.
+func maintenanceReason(body []byte) (string, bool) {
+	var payload maintenancePayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", false
+	}
+	if !strings.Contains(strings.ToLower(payload.Error), "maintenance") &&
+		!strings.Contains(strings.ToLower(payload.Note), "maintenance") {
+		return "", false
+	}
+	if payload.Note != "" {
+		return payload.Note, true
+	}
+	return payload.Error, true
+}
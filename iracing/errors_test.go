@@ -0,0 +1,322 @@
+package iracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ErrorsFromResponses(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		status  int
+		headers http.Header
+		body    string
+
+		expectedErr     error
+		expectedResetAt time.Time
+	}{
+		{
+			name:        "unauthorized",
+			status:      http.StatusUnauthorized,
+			body:        `{"error":"Unauthorized"}`,
+			expectedErr: ErrUpstreamUnauthorized,
+		},
+		{
+			name:            "rate limited with reset",
+			status:          http.StatusTooManyRequests,
+			headers:         http.Header{"X-Ratelimit-Limit": {"240"}, "X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"1718452800"}},
+			body:            `{"error":"Rate limit exceeded"}`,
+			expectedErr:     ErrRateLimited,
+			expectedResetAt: time.Unix(1718452800, 0),
+		},
+		{
+			name:        "rate limited without reset",
+			status:      http.StatusTooManyRequests,
+			body:        `{"error":"Rate limit exceeded"}`,
+			expectedErr: ErrRateLimited,
+		},
+		{
+			name:        "maintenance",
+			status:      http.StatusServiceUnavailable,
+			body:        `{"error":"Site Maintenance","note":"iRacing is currently down for maintenance"}`,
+			expectedErr: ErrMaintenance,
+		},
+		{
+			name:        "not found",
+			status:      http.StatusNotFound,
+			body:        `{"error":"Not Found"}`,
+			expectedErr: ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := NewMockHTTPClient(t)
+			metricsClient := NewMockMetricsClient(t)
+			metricsClient.EXPECT().EmitGauge(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			headers := tc.headers
+			if headers == nil {
+				headers = http.Header{}
+			}
+			httpClient.EXPECT().Do(mock.Anything).Return(&http.Response{
+				StatusCode: tc.status,
+				Header:     headers,
+				Body:       io.NopCloser(strings.NewReader(tc.body)),
+			}, nil)
+
+			client := NewClient(httpClient, metricsClient, WithBaseURL("https://test.iracing.com"))
+
+			_, err := client.GetUserInfo(context.Background(), "test-access-token")
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tc.expectedErr)
+
+			resetAt, ok := RateLimitResetAt(err)
+			assert.Equal(t, !tc.expectedResetAt.IsZero(), ok)
+			assert.True(t, tc.expectedResetAt.Equal(resetAt))
+		})
+	}
+}
+
+func TestClient_ChunkFetchError(t *testing.T) {
+	linkResponse := loadFixture(t, "fixtures/stats/season_driver_standings_link_response.json")
+	standingsResponse := loadFixture(t, "fixtures/stats/season_driver_standings_response.json")
+
+	httpClient := NewMockHTTPClient(t)
+	metricsClient := NewMockMetricsClient(t)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return strings.HasPrefix(req.URL.String(), "https://test.iracing.com/data/stats/season_driver_standings")
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(linkResponse)),
+	}, nil)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return strings.Contains(req.URL.String(), "scorpio-assets.s3.us-east-1") && !strings.HasSuffix(req.URL.String(), "/standings_chunk_0.json")
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(standingsResponse)),
+	}, nil)
+
+	httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return strings.HasSuffix(req.URL.String(), "/standings_chunk_0.json")
+	})).Return(&http.Response{
+		StatusCode: http.StatusForbidden,
+		Body:       io.NopCloser(strings.NewReader("<Error><Code>AccessDenied</Code></Error>")),
+	}, nil)
+
+	client := NewClient(httpClient, metricsClient, WithBaseURL("https://test.iracing.com"))
+
+	_, err := client.GetSeasonDriverStandings(context.Background(), "test-access-token", 4500, 74)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrChunkFetch)
+
+	var chunkErr *ChunkFetchError
+	require.True(t, errors.As(err, &chunkErr))
+	assert.Equal(t, 0, chunkErr.Chunk)
+	assert.Equal(t, http.StatusForbidden, chunkErr.Status)
+}
+
+func TestClient_Availability(t *testing.T) {
+	downUntil := time.Unix(1718452800, 0)
+
+	t.Run("maintenance responses are reported", func(t *testing.T) {
+		httpClient := NewMockHTTPClient(t)
+		availability := NewMockAvailability(t)
+
+		availability.EXPECT().DownUntil(mock.Anything).Return(time.Time{}, nil)
+		httpClient.EXPECT().Do(mock.Anything).Return(&http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"error":"Site Maintenance","note":"Back soon"}`)),
+		}, nil)
+		availability.EXPECT().ReportMaintenance(mock.Anything, "Back soon").Return(downUntil, nil)
+
+		client := NewClient(httpClient, NewMockMetricsClient(t), WithBaseURL("https://test.iracing.com"), WithAvailability(availability))
+
+		_, err := client.GetUserInfo(context.Background(), "test-access-token")
+		assert.ErrorIs(t, err, ErrMaintenance)
+		until, ok := MaintenanceUntil(err)
+		assert.True(t, ok)
+		assert.Equal(t, downUntil, until)
+	})
+
+	t.Run("requests aren't made while down", func(t *testing.T) {
+		availability := NewMockAvailability(t)
+		availability.EXPECT().DownUntil(mock.Anything).Return(downUntil, nil)
+
+		client := NewClient(NewMockHTTPClient(t), NewMockMetricsClient(t), WithBaseURL("https://test.iracing.com"), WithAvailability(availability))
+
+		_, err := client.GetUserInfo(context.Background(), "test-access-token")
+		assert.ErrorIs(t, err, ErrMaintenance)
+		until, ok := MaintenanceUntil(err)
+		assert.True(t, ok)
+		assert.Equal(t, downUntil, until)
+	})
+
+	t.Run("raw maintenance responses are reported", func(t *testing.T) {
+		httpClient := NewMockHTTPClient(t)
+		availability := NewMockAvailability(t)
+
+		availability.EXPECT().DownUntil(mock.Anything).Return(time.Time{}, nil)
+		httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+			return req.URL.String() == "https://test.iracing.com/data/series/seasons"
+		})).Return(&http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"error":"Site Maintenance","note":"Back soon"}`)),
+		}, nil)
+		availability.EXPECT().ReportMaintenance(mock.Anything, "Back soon").Return(downUntil, nil)
+
+		client := NewClient(httpClient, NewMockMetricsClient(t), WithBaseURL("https://test.iracing.com"), WithAvailability(availability))
+
+		_, err := client.FetchData(context.Background(), "test-access-token", "/data/series/seasons", nil)
+		assert.ErrorIs(t, err, ErrMaintenance)
+		until, ok := MaintenanceUntil(err)
+		assert.True(t, ok)
+		assert.Equal(t, downUntil, until)
+	})
+
+	t.Run("raw requests aren't made while down", func(t *testing.T) {
+		availability := NewMockAvailability(t)
+		availability.EXPECT().DownUntil(mock.Anything).Return(downUntil, nil)
+
+		client := NewClient(NewMockHTTPClient(t), NewMockMetricsClient(t), WithBaseURL("https://test.iracing.com"), WithAvailability(availability))
+
+		_, err := client.FetchData(context.Background(), "test-access-token", "/data/series/seasons", nil)
+		assert.ErrorIs(t, err, ErrMaintenance)
+		until, ok := MaintenanceUntil(err)
+		assert.True(t, ok)
+		assert.Equal(t, downUntil, until)
+	})
+
+	t.Run("requests are still made when availability can't be checked", func(t *testing.T) {
+		linkResponse := loadFixture(t, "fixtures/member/info_link_response.json")
+		memberResponse := loadFixture(t, "fixtures/member/info_response.json")
+
+		httpClient := NewMockHTTPClient(t)
+		availability := NewMockAvailability(t)
+
+		availability.EXPECT().DownUntil(mock.Anything).Return(time.Time{}, errors.New("db error"))
+		httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+			return req.URL.String() == "https://test.iracing.com/data/member/info"
+		})).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(linkResponse)),
+		}, nil)
+		httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+			return strings.Contains(req.URL.String(), "scorpio-assets.s3")
+		})).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(memberResponse)),
+		}, nil)
+
+		client := NewClient(httpClient, NewMockMetricsClient(t), WithBaseURL("https://test.iracing.com"), WithAvailability(availability))
+
+		userInfo, err := client.GetUserInfo(context.Background(), "test-access-token")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1100750), userInfo.UserID)
+	})
+}
+
+func TestClient_ResponseSizeLimits(t *testing.T) {
+	sessionLink := `{"link":"https://scorpio-assets.s3.amazonaws.com/production/data-server/results/12345.json"}`
+
+	t.Run("linked data past the limit", func(t *testing.T) {
+		httpClient := NewMockHTTPClient(t)
+
+		httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+			return strings.HasPrefix(req.URL.String(), "https://test.iracing.com/data/results/get")
+		})).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(sessionLink)),
+		}, nil)
+		httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+			return strings.Contains(req.URL.String(), "scorpio-assets.s3")
+		})).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"subsession_id":12345,"series_name":"` + strings.Repeat("x", 512) + `"}`)),
+		}, nil)
+
+		client := NewClient(httpClient, NewMockMetricsClient(t), WithBaseURL("https://test.iracing.com"), WithMaxResponseBytes(256))
+
+		_, err := client.GetSessionResults(context.Background(), "test-access-token", 12345)
+		assert.ErrorIs(t, err, ErrResponseTooLarge)
+		var tooLargeErr *ResponseTooLargeError
+		require.True(t, errors.As(err, &tooLargeErr))
+		assert.Equal(t, int64(256), tooLargeErr.Limit)
+	})
+
+	t.Run("chunk past the limit", func(t *testing.T) {
+		linkResponse := loadFixture(t, "fixtures/stats/season_driver_standings_link_response.json")
+		standingsResponse := loadFixture(t, "fixtures/stats/season_driver_standings_response.json")
+		chunkResponse := loadFixture(t, "fixtures/stats/season_driver_standings_chunk_0.json")
+
+		httpClient := NewMockHTTPClient(t)
+
+		httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+			return strings.HasPrefix(req.URL.String(), "https://test.iracing.com/data/stats/season_driver_standings")
+		})).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(linkResponse)),
+		}, nil)
+		httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+			return strings.Contains(req.URL.String(), "scorpio-assets.s3.us-east-1")
+		})).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(standingsResponse)),
+		}, nil)
+		httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+			return strings.HasSuffix(req.URL.String(), "/standings_chunk_0.json")
+		})).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(chunkResponse)),
+		}, nil)
+
+		// room for the link and standings, but not the chunk
+		client := NewClient(httpClient, NewMockMetricsClient(t), WithBaseURL("https://test.iracing.com"), WithMaxResponseBytes(800))
+
+		_, err := client.GetSeasonDriverStandings(context.Background(), "test-access-token", 4500, 74)
+		assert.ErrorIs(t, err, ErrChunkFetch)
+		assert.ErrorIs(t, err, ErrResponseTooLarge)
+	})
+
+	t.Run("large payloads are reported", func(t *testing.T) {
+		sessionResponse := `{"subsession_id":12345,"series_name":"` + strings.Repeat("x", largePayloadBytes) + `"}`
+
+		httpClient := NewMockHTTPClient(t)
+		metricsClient := NewMockMetricsClient(t)
+
+		httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+			return strings.HasPrefix(req.URL.String(), "https://test.iracing.com/data/results/get")
+		})).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(sessionLink)),
+		}, nil)
+		httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+			return strings.Contains(req.URL.String(), "scorpio-assets.s3")
+		})).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(sessionResponse)),
+		}, nil)
+		metricsClient.EXPECT().EmitGauge(mock.Anything, metrics.IRacingLargePayloadBytes, float64(len(sessionResponse))).Return(nil)
+
+		client := NewClient(httpClient, metricsClient, WithBaseURL("https://test.iracing.com"))
+
+		result, err := client.GetSessionResults(context.Background(), "test-access-token", 12345)
+		require.NoError(t, err)
+		assert.Equal(t, int64(12345), result.SubsessionID)
+	})
+}
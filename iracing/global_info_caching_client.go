@@ -14,17 +14,19 @@ type GlobalInfoCachingClient struct {
 	carsCache      func(ctx context.Context, accessToken string) ([]CarInfo, error)
 	carAssetsCache func(ctx context.Context, accessToken string) (map[int64]CarAssets, error)
 
-	seriesCache func(ctx context.Context, accessToken string) ([]SeriesInfo, error)
+	seriesCache        func(ctx context.Context, accessToken string) ([]SeriesInfo, error)
+	seriesSeasonsCache func(ctx context.Context, accessToken string) ([]SeriesSeason, error)
 }
 
 func NewGlobalInfoCachingClient(toWrap *Client, s3Client S3Client, bucketName string, s3CacheDuration time.Duration) *GlobalInfoCachingClient {
 	return &GlobalInfoCachingClient{
-		Client:          toWrap,
-		trackCache:      WithMemoryCache(WithS3Cache(s3Client, bucketName, "tracks", s3CacheDuration, toWrap.GetTracks)),
-		trackAssetCache: WithMemoryCache(WithS3Cache(s3Client, bucketName, "trackAssets", s3CacheDuration, toWrap.GetTrackAssets)),
-		carsCache:       WithMemoryCache(WithS3Cache(s3Client, bucketName, "cars", s3CacheDuration, toWrap.GetCars)),
-		carAssetsCache:  WithMemoryCache(WithS3Cache(s3Client, bucketName, "carAssets", s3CacheDuration, toWrap.GetCarAssets)),
-		seriesCache:     WithMemoryCache(WithS3Cache(s3Client, bucketName, "series", s3CacheDuration, toWrap.GetSeries)),
+		Client:             toWrap,
+		trackCache:         WithMemoryCache(WithS3Cache(s3Client, bucketName, "tracks", s3CacheDuration, toWrap.GetTracks)),
+		trackAssetCache:    WithMemoryCache(WithS3Cache(s3Client, bucketName, "trackAssets", s3CacheDuration, toWrap.GetTrackAssets)),
+		carsCache:          WithMemoryCache(WithS3Cache(s3Client, bucketName, "cars", s3CacheDuration, toWrap.GetCars)),
+		carAssetsCache:     WithMemoryCache(WithS3Cache(s3Client, bucketName, "carAssets", s3CacheDuration, toWrap.GetCarAssets)),
+		seriesCache:        WithMemoryCache(WithS3Cache(s3Client, bucketName, "series", s3CacheDuration, toWrap.GetSeries)),
+		seriesSeasonsCache: WithMemoryCache(WithS3Cache(s3Client, bucketName, "seriesSeasons", s3CacheDuration, toWrap.GetSeriesSeasons)),
 	}
 }
 
@@ -47,3 +49,7 @@ func (g *GlobalInfoCachingClient) GetCarAssets(ctx context.Context, accessToken
 func (g *GlobalInfoCachingClient) GetSeries(ctx context.Context, accessToken string) ([]SeriesInfo, error) {
 	return g.seriesCache(ctx, accessToken)
 }
+
+func (g *GlobalInfoCachingClient) GetSeriesSeasons(ctx context.Context, accessToken string) ([]SeriesSeason, error) {
+	return g.seriesSeasonsCache(ctx, accessToken)
+}
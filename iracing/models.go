@@ -401,6 +401,39 @@ type Lap struct {
 	AI               bool     `json:"ai"`
 }
 
+// LapChartResponse is the response from GetLapChartData, laps for every driver in the session.
+type LapChartResponse struct {
+	Success     bool               `json:"success"`
+	SessionInfo LapDataSessionInfo `json:"session_info"`
+	BestLapNum  int                `json:"best_lap_num"`
+	BestLapTime int                `json:"best_lap_time"`
+	LastUpdated time.Time          `json:"last_updated"`
+	Laps        []LapChartLap      `json:"laps,omitempty"`
+}
+
+// LapChartLap is a single driver's lap in a lap chart. LapPosition is 1-based, and lap 0 is the starting grid.
+type LapChartLap struct {
+	GroupID          int64    `json:"group_id"`
+	Name             string   `json:"name"`
+	CustID           int64    `json:"cust_id"`
+	DisplayName      string   `json:"display_name"`
+	LapNumber        int      `json:"lap_number"`
+	Flags            int      `json:"flags"`
+	SessionTime      int      `json:"session_time"`
+	SessionStartTime *int     `json:"session_start_time"`
+	LapTime          int      `json:"lap_time"`
+	TeamFastestLap   bool     `json:"team_fastest_lap"`
+	PersonalBestLap  bool     `json:"personal_best_lap"`
+	LicenseLevel     int      `json:"license_level"`
+	CarNumber        string   `json:"car_number"`
+	LapEvents        []string `json:"lap_events"`
+	LapPosition      int      `json:"lap_position"`
+	Interval         int      `json:"interval"`
+	IntervalUnits    string   `json:"interval_units"`
+	FastestLap       bool     `json:"fastest_lap"`
+	AI               bool     `json:"ai"`
+}
+
 // LapDataSessionInfo contains session metadata returned with lap data.
 type LapDataSessionInfo struct {
 	SubsessionID          int64     `json:"subsession_id"`
@@ -603,4 +636,98 @@ type SeriesInfo struct {
 	Oval           bool   `json:"oval"`
 	Road           bool   `json:"road"`
 	Dirt           bool   `json:"dirt"`
+	AllowedLicenses []AllowedLicense `json:"allowed_licenses"`
+}
+
+// SeriesSeason is a season of a series from the /data/series/seasons endpoint, including its race week schedule.
+type SeriesSeason struct {
+	SeasonID      int64            `json:"season_id"`
+	SeriesID      int64            `json:"series_id"`
+	SeasonName    string           `json:"season_name"`
+	SeasonYear    int              `json:"season_year"`
+	SeasonQuarter int              `json:"season_quarter"`
+	Active        bool             `json:"active"`
+	Official      bool             `json:"official"`
+	RaceWeek      int              `json:"race_week"` // the current race week, 0-based
+	CarClassIDs   []int64          `json:"car_class_ids"`
+	Schedules     []SeasonSchedule `json:"schedules"`
+}
+
+// SeasonSchedule is one race week of a season.
+type SeasonSchedule struct {
+	RaceWeekNum         int                  `json:"race_week_num"`
+	StartDate           string               `json:"start_date"` // YYYY-MM-DD, race weeks start at 00:00 GMT
+	Track               ScheduleTrack        `json:"track"`
+	RaceTimeDescriptors []RaceTimeDescriptor `json:"race_time_descriptors"`
+}
+
+// ScheduleTrack is the track raced during a race week.
+type ScheduleTrack struct {
+	TrackID    int64  `json:"track_id"`
+	TrackName  string `json:"track_name"`
+	ConfigName string `json:"config_name"`
+}
+
+// RaceTimeDescriptor describes when sessions go off during a race week. Repeating descriptors start sessions every
+// RepeatMinutes from FirstSessionTime on each of the DayOffset days, otherwise SessionTimes lists every session.
+type RaceTimeDescriptor struct {
+	Repeating        bool     `json:"repeating"`
+	FirstSessionTime string   `json:"first_session_time"` // HH:MM:SS GMT
+	RepeatMinutes    int      `json:"repeat_minutes"`
+	DayOffset        []int    `json:"day_offset"` // days after the race week start, every day when empty
+	SessionTimes     []string `json:"session_times"`
+	SuperSession     bool     `json:"super_session"`
+}
+
+// MemberDivision is the response from the /data/stats/member_division endpoint.
+type MemberDivision struct {
+	Division  int   `json:"division"` // 0-based, 0 is division 1. 10 is the rookie division
+	Projected bool  `json:"projected"`
+	EventType int   `json:"event_type"`
+	Success   bool  `json:"success"`
+	SeasonID  int64 `json:"season_id"`
+}
+
+// SeasonDriverStandings is the response from the /data/stats/season_driver_standings endpoint.
+type SeasonDriverStandings struct {
+	Success         bool                   `json:"success"`
+	SeasonID        int64                  `json:"season_id"`
+	SeasonName      string                 `json:"season_name"`
+	SeasonShortName string                 `json:"season_short_name"`
+	SeriesID        int64                  `json:"series_id"`
+	SeriesName      string                 `json:"series_name"`
+	CarClassID      int64                  `json:"car_class_id"`
+	RaceWeekNum     int                    `json:"race_week_num"`
+	Division        *int                   `json:"division"`
+	ClubID          int                    `json:"club_id"`
+	CustomerRank    int                    `json:"customer_rank"`
+	LastUpdated     time.Time              `json:"last_updated"`
+	Standings       []SeasonDriverStanding `json:"-"`
 }
+
+// SeasonDriverStanding is a single driver's row in the season standings.
+type SeasonDriverStanding struct {
+	Rank              int     `json:"rank"`
+	CustID            int64   `json:"cust_id"`
+	DisplayName       string  `json:"display_name"`
+	Division          int     `json:"division"`
+	ClubID            int     `json:"club_id"`
+	ClubName          string  `json:"club_name"`
+	WeeksCounted      int     `json:"weeks_counted"`
+	Starts            int     `json:"starts"`
+	Wins              int     `json:"wins"`
+	Top5              int     `json:"top5"`
+	Top25             int     `json:"top25"`
+	Poles             int     `json:"poles"`
+	AvgStartPosition  int     `json:"avg_start_position"`
+	AvgFinishPosition int     `json:"avg_finish_position"`
+	AvgFieldSize      int     `json:"avg_field_size"`
+	Laps              int     `json:"laps"`
+	LapsLed           int     `json:"laps_led"`
+	Incidents         int     `json:"incidents"`
+	Points            int     `json:"points"`
+	RawPoints         float64 `json:"raw_points"`
+	WeekDropped       bool    `json:"week_dropped"`
+	CountryCode       string  `json:"country_code"`
+	Country           string  `json:"country"`
+}
\ No newline at end of file
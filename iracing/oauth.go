@@ -75,7 +75,7 @@ func (c *OAuthClient) ExchangeCode(ctx context.Context, code, codeVerifier, redi
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("token exchange failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("token exchange failed: %w", tokenErrorFromResponse(resp.StatusCode, body))
 	}
 
 	var tokenResp TokenResponse
@@ -115,7 +115,7 @@ func (c *OAuthClient) RefreshToken(ctx context.Context, refreshToken string) (*T
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("token refresh failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("token refresh failed: %w", tokenErrorFromResponse(resp.StatusCode, body))
 	}
 
 	var tokenResp TokenResponse
@@ -126,6 +126,15 @@ func (c *OAuthClient) RefreshToken(ctx context.Context, refreshToken string) (*T
 	return &tokenResp, nil
 }
 
+// tokenErrorFromResponse works out the error for a non 200 token endpoint response, marking the ones where iRacing
+// turned down the code or token itself rather than failing to answer.
+func tokenErrorFromResponse(status int, body []byte) error {
+	if status >= 400 && status < 500 && status != http.StatusTooManyRequests {
+		return fmt.Errorf("%w, status %d: %s", ErrTokenRejected, status, string(body))
+	}
+	return fmt.Errorf("status %d: %s", status, string(body))
+}
+
 // maskSecret creates the masked client secret per iRacing's requirements:
 // base64(sha256(secret + lowercase(trim(client_id))))
 func (c *OAuthClient) maskSecret() string {
@@ -0,0 +1,71 @@
+package iracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthClient_ExchangeCode(t *testing.T) {
+	testCases := []struct {
+		name         string
+		status       int
+		body         string
+		expected     *TokenResponse
+		expectedErr  string
+		wantRejected bool
+	}{
+		{
+			name:     "success",
+			status:   http.StatusOK,
+			body:     `{"access_token":"access","token_type":"Bearer","expires_in":600,"refresh_token":"refresh"}`,
+			expected: &TokenResponse{AccessToken: "access", TokenType: "Bearer", ExpiresIn: 600, RefreshToken: "refresh"},
+		},
+		{
+			name:         "code rejected",
+			status:       http.StatusBadRequest,
+			body:         `{"error":"invalid_grant"}`,
+			expectedErr:  `token exchange failed: token request rejected, status 400: {"error":"invalid_grant"}`,
+			wantRejected: true,
+		},
+		{
+			name:        "rate limited",
+			status:      http.StatusTooManyRequests,
+			body:        `slow down`,
+			expectedErr: "token exchange failed: status 429: slow down",
+		},
+		{
+			name:        "server error",
+			status:      http.StatusBadGateway,
+			body:        `bad gateway`,
+			expectedErr: "token exchange failed: status 502: bad gateway",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := NewMockHTTPClient(t)
+			httpClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+				return req.URL.String() == tokenURL
+			})).Return(&http.Response{StatusCode: tc.status, Body: io.NopCloser(strings.NewReader(tc.body))}, nil)
+
+			client := NewOAuthClient(httpClient, "client-id", "client-secret")
+			result, err := client.ExchangeCode(context.Background(), "code", "verifier", "http://localhost/callback")
+
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				assert.Equal(t, tc.wantRejected, errors.Is(err, ErrTokenRejected))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
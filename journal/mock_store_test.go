@@ -39,6 +39,69 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
+// DeleteJournalDraft provides a mock function for the type MockStore
+func (_mock *MockStore) DeleteJournalDraft(ctx context.Context, driverID int64, raceID int64) error {
+	ret := _mock.Called(ctx, driverID, raceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteJournalDraft")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, driverID, raceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_DeleteJournalDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteJournalDraft'
+type MockStore_DeleteJournalDraft_Call struct {
+	*mock.Call
+}
+
+// DeleteJournalDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - raceID int64
+func (_e *MockStore_Expecter) DeleteJournalDraft(ctx interface{}, driverID interface{}, raceID interface{}) *MockStore_DeleteJournalDraft_Call {
+	return &MockStore_DeleteJournalDraft_Call{Call: _e.mock.On("DeleteJournalDraft", ctx, driverID, raceID)}
+}
+
+func (_c *MockStore_DeleteJournalDraft_Call) Run(run func(ctx context.Context, driverID int64, raceID int64)) *MockStore_DeleteJournalDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteJournalDraft_Call) Return(err error) *MockStore_DeleteJournalDraft_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_DeleteJournalDraft_Call) RunAndReturn(run func(ctx context.Context, driverID int64, raceID int64) error) *MockStore_DeleteJournalDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteJournalEntry provides a mock function for the type MockStore
 func (_mock *MockStore) DeleteJournalEntry(ctx context.Context, driverID int64, raceID int64) error {
 	ret := _mock.Called(ctx, driverID, raceID)
@@ -102,9 +165,78 @@ func (_c *MockStore_DeleteJournalEntry_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// DeleteJournalPrompt provides a mock function for the type MockStore
+func (_mock *MockStore) DeleteJournalPrompt(ctx context.Context, driverID int64, raceID int64) error {
+	ret := _mock.Called(ctx, driverID, raceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteJournalPrompt")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, driverID, raceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_DeleteJournalPrompt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteJournalPrompt'
+type MockStore_DeleteJournalPrompt_Call struct {
+	*mock.Call
+}
+
+// DeleteJournalPrompt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - raceID int64
+func (_e *MockStore_Expecter) DeleteJournalPrompt(ctx interface{}, driverID interface{}, raceID interface{}) *MockStore_DeleteJournalPrompt_Call {
+	return &MockStore_DeleteJournalPrompt_Call{Call: _e.mock.On("DeleteJournalPrompt", ctx, driverID, raceID)}
+}
+
+func (_c *MockStore_DeleteJournalPrompt_Call) Run(run func(ctx context.Context, driverID int64, raceID int64)) *MockStore_DeleteJournalPrompt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteJournalPrompt_Call) Return(err error) *MockStore_DeleteJournalPrompt_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_DeleteJournalPrompt_Call) RunAndReturn(run func(ctx context.Context, driverID int64, raceID int64) error) *MockStore_DeleteJournalPrompt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDriverSession provides a mock function for the type MockStore
-func (_mock *MockStore) GetDriverSession(ctx context.Context, driverID int64, startTime time.Time) (*store.DriverSession, error) {
-	ret := _mock.Called(ctx, driverID, startTime)
+func (_mock *MockStore) GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, startTime, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID, startTime)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDriverSession")
@@ -112,18 +244,18 @@ func (_mock *MockStore) GetDriverSession(ctx context.Context, driverID int64, st
 
 	var r0 *store.DriverSession
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) (*store.DriverSession, error)); ok {
-		return returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, ...store.ReadOption) (*store.DriverSession, error)); ok {
+		return returnFunc(ctx, driverID, startTime, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) *store.DriverSession); ok {
-		r0 = returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, ...store.ReadOption) *store.DriverSession); ok {
+		r0 = returnFunc(ctx, driverID, startTime, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*store.DriverSession)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
-		r1 = returnFunc(ctx, driverID, startTime)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, startTime, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -139,11 +271,13 @@ type MockStore_GetDriverSession_Call struct {
 //   - ctx context.Context
 //   - driverID int64
 //   - startTime time.Time
-func (_e *MockStore_Expecter) GetDriverSession(ctx interface{}, driverID interface{}, startTime interface{}) *MockStore_GetDriverSession_Call {
-	return &MockStore_GetDriverSession_Call{Call: _e.mock.On("GetDriverSession", ctx, driverID, startTime)}
+//   - opts ...store.ReadOption
+func (_e *MockStore_Expecter) GetDriverSession(ctx interface{}, driverID interface{}, startTime interface{}, opts ...interface{}) *MockStore_GetDriverSession_Call {
+	return &MockStore_GetDriverSession_Call{Call: _e.mock.On("GetDriverSession",
+		append([]interface{}{ctx, driverID, startTime}, opts...)...)}
 }
 
-func (_c *MockStore_GetDriverSession_Call) Run(run func(ctx context.Context, driverID int64, startTime time.Time)) *MockStore_GetDriverSession_Call {
+func (_c *MockStore_GetDriverSession_Call) Run(run func(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption)) *MockStore_GetDriverSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -157,10 +291,17 @@ func (_c *MockStore_GetDriverSession_Call) Run(run func(ctx context.Context, dri
 		if args[2] != nil {
 			arg2 = args[2].(time.Time)
 		}
+		var arg3 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 3 {
+			variadicArgs = args[3].([]store.ReadOption)
+		}
+		arg3 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3...,
 		)
 	})
 	return _c
@@ -171,7 +312,7 @@ func (_c *MockStore_GetDriverSession_Call) Return(driverSession *store.DriverSes
 	return _c
 }
 
-func (_c *MockStore_GetDriverSession_Call) RunAndReturn(run func(ctx context.Context, driverID int64, startTime time.Time) (*store.DriverSession, error)) *MockStore_GetDriverSession_Call {
+func (_c *MockStore_GetDriverSession_Call) RunAndReturn(run func(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)) *MockStore_GetDriverSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -250,6 +391,95 @@ func (_c *MockStore_GetDriverSessions_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// GetJournalDraft provides a mock function for the type MockStore
+func (_mock *MockStore) GetJournalDraft(ctx context.Context, driverID int64, raceID int64, opts ...store.ReadOption) (*store.RaceJournalDraft, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, raceID, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID, raceID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJournalDraft")
+	}
+
+	var r0 *store.RaceJournalDraft
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, ...store.ReadOption) (*store.RaceJournalDraft, error)); ok {
+		return returnFunc(ctx, driverID, raceID, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, ...store.ReadOption) *store.RaceJournalDraft); ok {
+		r0 = returnFunc(ctx, driverID, raceID, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.RaceJournalDraft)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, raceID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetJournalDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJournalDraft'
+type MockStore_GetJournalDraft_Call struct {
+	*mock.Call
+}
+
+// GetJournalDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - raceID int64
+//   - opts ...store.ReadOption
+func (_e *MockStore_Expecter) GetJournalDraft(ctx interface{}, driverID interface{}, raceID interface{}, opts ...interface{}) *MockStore_GetJournalDraft_Call {
+	return &MockStore_GetJournalDraft_Call{Call: _e.mock.On("GetJournalDraft",
+		append([]interface{}{ctx, driverID, raceID}, opts...)...)}
+}
+
+func (_c *MockStore_GetJournalDraft_Call) Run(run func(ctx context.Context, driverID int64, raceID int64, opts ...store.ReadOption)) *MockStore_GetJournalDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 3 {
+			variadicArgs = args[3].([]store.ReadOption)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetJournalDraft_Call) Return(raceJournalDraft *store.RaceJournalDraft, err error) *MockStore_GetJournalDraft_Call {
+	_c.Call.Return(raceJournalDraft, err)
+	return _c
+}
+
+func (_c *MockStore_GetJournalDraft_Call) RunAndReturn(run func(ctx context.Context, driverID int64, raceID int64, opts ...store.ReadOption) (*store.RaceJournalDraft, error)) *MockStore_GetJournalDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetJournalEntries provides a mock function for the type MockStore
 func (_mock *MockStore) GetJournalEntries(ctx context.Context, driverID int64, from time.Time, to time.Time) ([]store.RaceJournalEntry, error) {
 	ret := _mock.Called(ctx, driverID, from, to)
@@ -331,8 +561,14 @@ func (_c *MockStore_GetJournalEntries_Call) RunAndReturn(run func(ctx context.Co
 }
 
 // GetJournalEntry provides a mock function for the type MockStore
-func (_mock *MockStore) GetJournalEntry(ctx context.Context, driverID int64, raceID int64) (*store.RaceJournalEntry, error) {
-	ret := _mock.Called(ctx, driverID, raceID)
+func (_mock *MockStore) GetJournalEntry(ctx context.Context, driverID int64, raceID int64, opts ...store.ReadOption) (*store.RaceJournalEntry, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, driverID, raceID, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, driverID, raceID)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetJournalEntry")
@@ -340,18 +576,18 @@ func (_mock *MockStore) GetJournalEntry(ctx context.Context, driverID int64, rac
 
 	var r0 *store.RaceJournalEntry
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*store.RaceJournalEntry, error)); ok {
-		return returnFunc(ctx, driverID, raceID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, ...store.ReadOption) (*store.RaceJournalEntry, error)); ok {
+		return returnFunc(ctx, driverID, raceID, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *store.RaceJournalEntry); ok {
-		r0 = returnFunc(ctx, driverID, raceID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, ...store.ReadOption) *store.RaceJournalEntry); ok {
+		r0 = returnFunc(ctx, driverID, raceID, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*store.RaceJournalEntry)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
-		r1 = returnFunc(ctx, driverID, raceID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, ...store.ReadOption) error); ok {
+		r1 = returnFunc(ctx, driverID, raceID, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -367,11 +603,13 @@ type MockStore_GetJournalEntry_Call struct {
 //   - ctx context.Context
 //   - driverID int64
 //   - raceID int64
-func (_e *MockStore_Expecter) GetJournalEntry(ctx interface{}, driverID interface{}, raceID interface{}) *MockStore_GetJournalEntry_Call {
-	return &MockStore_GetJournalEntry_Call{Call: _e.mock.On("GetJournalEntry", ctx, driverID, raceID)}
+//   - opts ...store.ReadOption
+func (_e *MockStore_Expecter) GetJournalEntry(ctx interface{}, driverID interface{}, raceID interface{}, opts ...interface{}) *MockStore_GetJournalEntry_Call {
+	return &MockStore_GetJournalEntry_Call{Call: _e.mock.On("GetJournalEntry",
+		append([]interface{}{ctx, driverID, raceID}, opts...)...)}
 }
 
-func (_c *MockStore_GetJournalEntry_Call) Run(run func(ctx context.Context, driverID int64, raceID int64)) *MockStore_GetJournalEntry_Call {
+func (_c *MockStore_GetJournalEntry_Call) Run(run func(ctx context.Context, driverID int64, raceID int64, opts ...store.ReadOption)) *MockStore_GetJournalEntry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -385,10 +623,17 @@ func (_c *MockStore_GetJournalEntry_Call) Run(run func(ctx context.Context, driv
 		if args[2] != nil {
 			arg2 = args[2].(int64)
 		}
+		var arg3 []store.ReadOption
+		var variadicArgs []store.ReadOption
+		if len(args) > 3 {
+			variadicArgs = args[3].([]store.ReadOption)
+		}
+		arg3 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3...,
 		)
 	})
 	return _c
@@ -399,7 +644,212 @@ func (_c *MockStore_GetJournalEntry_Call) Return(raceJournalEntry *store.RaceJou
 	return _c
 }
 
-func (_c *MockStore_GetJournalEntry_Call) RunAndReturn(run func(ctx context.Context, driverID int64, raceID int64) (*store.RaceJournalEntry, error)) *MockStore_GetJournalEntry_Call {
+func (_c *MockStore_GetJournalEntry_Call) RunAndReturn(run func(ctx context.Context, driverID int64, raceID int64, opts ...store.ReadOption) (*store.RaceJournalEntry, error)) *MockStore_GetJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJournalPrompts provides a mock function for the type MockStore
+func (_mock *MockStore) GetJournalPrompts(ctx context.Context, driverID int64, from time.Time, to time.Time) ([]store.JournalPrompt, error) {
+	ret := _mock.Called(ctx, driverID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJournalPrompts")
+	}
+
+	var r0 []store.JournalPrompt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) ([]store.JournalPrompt, error)); ok {
+		return returnFunc(ctx, driverID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []store.JournalPrompt); ok {
+		r0 = returnFunc(ctx, driverID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.JournalPrompt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, driverID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetJournalPrompts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJournalPrompts'
+type MockStore_GetJournalPrompts_Call struct {
+	*mock.Call
+}
+
+// GetJournalPrompts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+//   - from time.Time
+//   - to time.Time
+func (_e *MockStore_Expecter) GetJournalPrompts(ctx interface{}, driverID interface{}, from interface{}, to interface{}) *MockStore_GetJournalPrompts_Call {
+	return &MockStore_GetJournalPrompts_Call{Call: _e.mock.On("GetJournalPrompts", ctx, driverID, from, to)}
+}
+
+func (_c *MockStore_GetJournalPrompts_Call) Run(run func(ctx context.Context, driverID int64, from time.Time, to time.Time)) *MockStore_GetJournalPrompts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetJournalPrompts_Call) Return(journalPrompts []store.JournalPrompt, err error) *MockStore_GetJournalPrompts_Call {
+	_c.Call.Return(journalPrompts, err)
+	return _c
+}
+
+func (_c *MockStore_GetJournalPrompts_Call) RunAndReturn(run func(ctx context.Context, driverID int64, from time.Time, to time.Time) ([]store.JournalPrompt, error)) *MockStore_GetJournalPrompts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJournalStreak provides a mock function for the type MockStore
+func (_mock *MockStore) GetJournalStreak(ctx context.Context, driverID int64) (*store.JournalStreak, error) {
+	ret := _mock.Called(ctx, driverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJournalStreak")
+	}
+
+	var r0 *store.JournalStreak
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*store.JournalStreak, error)); ok {
+		return returnFunc(ctx, driverID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *store.JournalStreak); ok {
+		r0 = returnFunc(ctx, driverID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.JournalStreak)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, driverID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetJournalStreak_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJournalStreak'
+type MockStore_GetJournalStreak_Call struct {
+	*mock.Call
+}
+
+// GetJournalStreak is a helper method to define mock.On call
+//   - ctx context.Context
+//   - driverID int64
+func (_e *MockStore_Expecter) GetJournalStreak(ctx interface{}, driverID interface{}) *MockStore_GetJournalStreak_Call {
+	return &MockStore_GetJournalStreak_Call{Call: _e.mock.On("GetJournalStreak", ctx, driverID)}
+}
+
+func (_c *MockStore_GetJournalStreak_Call) Run(run func(ctx context.Context, driverID int64)) *MockStore_GetJournalStreak_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetJournalStreak_Call) Return(journalStreak *store.JournalStreak, err error) *MockStore_GetJournalStreak_Call {
+	_c.Call.Return(journalStreak, err)
+	return _c
+}
+
+func (_c *MockStore_GetJournalStreak_Call) RunAndReturn(run func(ctx context.Context, driverID int64) (*store.JournalStreak, error)) *MockStore_GetJournalStreak_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveJournalDraft provides a mock function for the type MockStore
+func (_mock *MockStore) SaveJournalDraft(ctx context.Context, draft store.RaceJournalDraft) error {
+	ret := _mock.Called(ctx, draft)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveJournalDraft")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.RaceJournalDraft) error); ok {
+		r0 = returnFunc(ctx, draft)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_SaveJournalDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveJournalDraft'
+type MockStore_SaveJournalDraft_Call struct {
+	*mock.Call
+}
+
+// SaveJournalDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - draft store.RaceJournalDraft
+func (_e *MockStore_Expecter) SaveJournalDraft(ctx interface{}, draft interface{}) *MockStore_SaveJournalDraft_Call {
+	return &MockStore_SaveJournalDraft_Call{Call: _e.mock.On("SaveJournalDraft", ctx, draft)}
+}
+
+func (_c *MockStore_SaveJournalDraft_Call) Run(run func(ctx context.Context, draft store.RaceJournalDraft)) *MockStore_SaveJournalDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.RaceJournalDraft
+		if args[1] != nil {
+			arg1 = args[1].(store.RaceJournalDraft)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_SaveJournalDraft_Call) Return(err error) *MockStore_SaveJournalDraft_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_SaveJournalDraft_Call) RunAndReturn(run func(ctx context.Context, draft store.RaceJournalDraft) error) *MockStore_SaveJournalDraft_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,54 @@
+package journal
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// minSearchTermLength drops single characters, which would match nearly everything by prefix.
+const minSearchTermLength = 2
+
+// SearchTerms breaks text into the lowercased, de-duplicated words journal entries are indexed by. Tags are split on
+// their separators as well, so "sentiment:good" is found by searching for "good".
+func SearchTerms(texts ...string) []string {
+	var terms []string
+	for _, text := range texts {
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, word := range words {
+			if len([]rune(word)) >= minSearchTermLength && !slices.Contains(terms, word) {
+				terms = append(terms, word)
+			}
+		}
+	}
+	slices.Sort(terms)
+	return terms
+}
+
+func entrySearchTerms(notes string, tags []string) []string {
+	return SearchTerms(append([]string{notes}, tags...)...)
+}
+
+// matchesQuery reports whether every word of the query is the start of one of the entry's terms.
+func matchesQuery(entry store.RaceJournalEntry, queryTerms []string) bool {
+	terms := entry.SearchTerms
+	if terms == nil {
+		// entries saved before search existed have no terms of their own
+		terms = entrySearchTerms(entry.Notes, entry.Tags)
+	}
+	terms = append(slices.Clone(terms), entry.TranscriptTerms...)
+
+	for _, queryTerm := range queryTerms {
+		found := slices.ContainsFunc(terms, func(term string) bool {
+			return strings.HasPrefix(term, queryTerm)
+		})
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+package journal
+
+import (
+	"testing"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTerms(t *testing.T) {
+	testCases := []struct {
+		name     string
+		texts    []string
+		expected []string
+	}{
+		{name: "nothing", texts: nil, expected: nil},
+		{name: "punctuation and case", texts: []string{"Spun at Turn 1, again!"}, expected: []string{"again", "at", "spun", "turn"}},
+		{name: "tags split on separators", texts: []string{"sentiment:good", "clean-race"}, expected: []string{"clean", "good", "race", "sentiment"}},
+		{name: "duplicates across texts", texts: []string{"late braking", "Braking late"}, expected: []string{"braking", "late"}},
+		{name: "unicode letters", texts: []string{"Nürburgring Südschleife"}, expected: []string{"nürburgring", "südschleife"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SearchTerms(tc.texts...))
+		})
+	}
+}
+
+func TestMatchesQuery(t *testing.T) {
+	entry := store.RaceJournalEntry{
+		Notes:           "Spun at turn one",
+		SearchTerms:     []string{"at", "one", "spun", "turn"},
+		TranscriptTerms: []string{"throttle"},
+	}
+
+	assert.True(t, matchesQuery(entry, SearchTerms("spun")))
+	assert.True(t, matchesQuery(entry, SearchTerms("tur thr")), "every query word should prefix match")
+	assert.False(t, matchesQuery(entry, SearchTerms("spun brakes")))
+	assert.False(t, matchesQuery(entry, SearchTerms("urn")), "matches are by prefix only")
+}
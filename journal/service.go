@@ -3,6 +3,7 @@ package journal
 import (
 	"context"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -84,28 +85,83 @@ type Entry struct {
 	Notes       string
 	Tags        []string
 	ReplayVideo string
+	Transcripts []string // transcripts of attached voice memos
 	Race        *store.DriverSession
 }
 
 // Store defines the data access methods needed by the journal service.
 type Store interface {
-	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time) (*store.DriverSession, error)
+	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)
 	GetDriverSessions(ctx context.Context, driverID int64, startTimes []time.Time) ([]store.DriverSession, error)
 	SaveJournalEntry(ctx context.Context, entry store.RaceJournalEntry) error
-	GetJournalEntry(ctx context.Context, driverID, raceID int64) (*store.RaceJournalEntry, error)
+	GetJournalEntry(ctx context.Context, driverID, raceID int64, opts ...store.ReadOption) (*store.RaceJournalEntry, error)
 	GetJournalEntries(ctx context.Context, driverID int64, from, to time.Time) ([]store.RaceJournalEntry, error)
 	DeleteJournalEntry(ctx context.Context, driverID, raceID int64) error
+	SaveJournalDraft(ctx context.Context, draft store.RaceJournalDraft) error
+	GetJournalDraft(ctx context.Context, driverID, raceID int64, opts ...store.ReadOption) (*store.RaceJournalDraft, error)
+	DeleteJournalDraft(ctx context.Context, driverID, raceID int64) error
+	GetJournalPrompts(ctx context.Context, driverID int64, from, to time.Time) ([]store.JournalPrompt, error)
+	DeleteJournalPrompt(ctx context.Context, driverID, raceID int64) error
+	GetJournalStreak(ctx context.Context, driverID int64) (*store.JournalStreak, error)
+}
+
+// OnboardingTracker records the driver's progress through onboarding.
+type OnboardingTracker interface {
+	Advance(ctx context.Context, driverID int64, step store.OnboardingStep) error
+}
+
+// StreakRecorder counts journal entries towards the driver's journaling streak.
+type StreakRecorder interface {
+	RecordEntry(ctx context.Context, driverID int64, createdAt time.Time) error
+}
+
+const defaultDraftRetention = 30 * 24 * time.Hour
+
+type ServiceOption func(*Service)
+
+// WithDraftRetentionInDays sets how long a draft is kept after it was last saved before it is discarded.
+func WithDraftRetentionInDays(days int) ServiceOption {
+	return func(s *Service) {
+		s.draftRetention = time.Hour * 24 * time.Duration(days)
+	}
+}
+
+// WithOnboardingTracker completes the journal step of the driver's onboarding whenever they save an entry.
+func WithOnboardingTracker(tracker OnboardingTracker) ServiceOption {
+	return func(s *Service) {
+		s.onboardingTracker = tracker
+	}
+}
+
+// WithStreakTracker counts every saved entry towards the driver's journaling streak.
+func WithStreakTracker(tracker StreakRecorder) ServiceOption {
+	return func(s *Service) {
+		s.streakTracker = tracker
+	}
 }
 
 // Service provides business logic for race journal operations.
 type Service struct {
-	store   Store
-	metrics MetricsEmitter
+	store             Store
+	metrics           MetricsEmitter
+	onboardingTracker OnboardingTracker
+	streakTracker     StreakRecorder
+	draftRetention    time.Duration
+	now               func() time.Time
 }
 
 // NewService creates a new journal service.
-func NewService(store Store, metrics MetricsEmitter) *Service {
-	return &Service{store: store, metrics: metrics}
+func NewService(store Store, metrics MetricsEmitter, opts ...ServiceOption) *Service {
+	s := &Service{
+		store:          store,
+		metrics:        metrics,
+		draftRetention: defaultDraftRetention,
+		now:            time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // ValidateRaceExists checks if a race exists for the given driver.
@@ -137,6 +193,7 @@ func (s *Service) Save(ctx context.Context, input SaveInput) (*Entry, error) {
 		Notes:       input.Notes,
 		Tags:        input.Tags,
 		ReplayVideo: input.ReplayVideo,
+		SearchTerms: entrySearchTerms(input.Notes, input.Tags),
 	}
 
 	if err := s.store.SaveJournalEntry(ctx, entry); err != nil {
@@ -148,14 +205,36 @@ func (s *Service) Save(ctx context.Context, input SaveInput) (*Entry, error) {
 		zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to emit journal entry metric")
 	}
 
-	// Fetch the saved entry to get timestamps and race context
-	return s.Get(ctx, input.DriverID, input.RaceID)
+	if s.onboardingTracker != nil {
+		if err := s.onboardingTracker.Advance(ctx, input.DriverID, store.OnboardingStepJournalEntryCreated); err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Int64("driverId", input.DriverID).Msg("failed to advance onboarding")
+		}
+	}
+
+	// Fetch the saved entry to get timestamps and race context. An eventually consistent read could miss the write
+	// that just happened.
+	saved, err := s.get(ctx, input.DriverID, input.RaceID, store.ConsistentRead())
+	if err != nil {
+		return nil, err
+	}
+
+	// The streak counts the week the entry was first written in, so edits to older entries leave it alone.
+	if s.streakTracker != nil && saved != nil {
+		if err := s.streakTracker.RecordEntry(ctx, input.DriverID, saved.CreatedAt); err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Int64("driverId", input.DriverID).Msg("failed to update journal streak")
+		}
+	}
+	return saved, nil
 }
 
 // Get retrieves a single journal entry with its race context.
 // Returns nil if the entry doesn't exist. Error is only for infrastructure failures.
 func (s *Service) Get(ctx context.Context, driverID, raceID int64) (*Entry, error) {
-	entry, err := s.store.GetJournalEntry(ctx, driverID, raceID)
+	return s.get(ctx, driverID, raceID)
+}
+
+func (s *Service) get(ctx context.Context, driverID, raceID int64, opts ...store.ReadOption) (*Entry, error) {
+	entry, err := s.store.GetJournalEntry(ctx, driverID, raceID, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +243,7 @@ func (s *Service) Get(ctx context.Context, driverID, raceID int64) (*Entry, erro
 	}
 
 	// Fetch the associated race session
-	session, err := s.store.GetDriverSession(ctx, driverID, store.TimeFromDriverRaceID(raceID))
+	session, err := s.store.GetDriverSession(ctx, driverID, store.TimeFromDriverRaceID(raceID), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +255,7 @@ func (s *Service) Get(ctx context.Context, driverID, raceID int64) (*Entry, erro
 		Notes:       entry.Notes,
 		Tags:        normalizeTags(entry.Tags),
 		ReplayVideo: entry.ReplayVideo,
+		Transcripts: entry.Transcripts,
 		Race:        session,
 	}, nil
 }
@@ -185,6 +265,9 @@ type ListInput struct {
 	DriverID int64
 	From     time.Time
 	To       time.Time
+	// Query optionally limits results to entries where every word of the query starts one of the words in the
+	// entry's notes, tags or voice memo transcripts.
+	Query string
 }
 
 // List retrieves journal entries within a time range, joined with race context.
@@ -194,6 +277,12 @@ func (s *Service) List(ctx context.Context, input ListInput) ([]Entry, error) {
 		return nil, err
 	}
 
+	if queryTerms := SearchTerms(input.Query); len(queryTerms) > 0 {
+		entries = slices.DeleteFunc(entries, func(entry store.RaceJournalEntry) bool {
+			return !matchesQuery(entry, queryTerms)
+		})
+	}
+
 	if len(entries) == 0 {
 		return []Entry{}, nil
 	}
@@ -226,6 +315,7 @@ func (s *Service) List(ctx context.Context, input ListInput) ([]Entry, error) {
 			Notes:       entry.Notes,
 			Tags:        normalizeTags(entry.Tags),
 			ReplayVideo: entry.ReplayVideo,
+			Transcripts: entry.Transcripts,
 			Race:        sessionMap[entry.RaceID],
 		}
 	}
@@ -238,6 +328,195 @@ func (s *Service) Delete(ctx context.Context, driverID, raceID int64) error {
 	return s.store.DeleteJournalEntry(ctx, driverID, raceID)
 }
 
+// Draft is unpublished journal content for a race.
+type Draft struct {
+	DriverID    int64
+	RaceID      int64
+	SavedAt     time.Time
+	ExpiresAt   time.Time
+	Notes       string
+	Tags        []string
+	ReplayVideo string
+}
+
+// SaveDraftInput contains the draft content being saved. Nil fields keep their current value, so autosaves can send
+// only what changed.
+type SaveDraftInput struct {
+	DriverID    int64
+	RaceID      int64
+	Notes       *string
+	Tags        []string
+	ReplayVideo *string
+}
+
+// SaveDraft merges the input into the race's draft, starting a new draft from the published entry if there is none.
+// Drafts are kept apart from the published entry, so saving one neither bumps the entry's UpdatedAt nor counts towards
+// the journal metrics. Callers should validate the race with ValidateRaceExists before calling SaveDraft.
+func (s *Service) SaveDraft(ctx context.Context, input SaveDraftInput) (*Draft, error) {
+	draft, err := s.store.GetJournalDraft(ctx, input.DriverID, input.RaceID, store.ConsistentRead())
+	if err != nil {
+		return nil, err
+	}
+	if draft == nil {
+		draft = &store.RaceJournalDraft{DriverID: input.DriverID, RaceID: input.RaceID}
+		entry, err := s.store.GetJournalEntry(ctx, input.DriverID, input.RaceID, store.ConsistentRead())
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			draft.Notes = entry.Notes
+			draft.Tags = entry.Tags
+			draft.ReplayVideo = entry.ReplayVideo
+		}
+	}
+
+	if input.Notes != nil {
+		draft.Notes = *input.Notes
+	}
+	if input.Tags != nil {
+		draft.Tags = input.Tags
+	}
+	if input.ReplayVideo != nil {
+		draft.ReplayVideo = *input.ReplayVideo
+	}
+	draft.ExpiresAt = s.now().Add(s.draftRetention)
+
+	if err := s.store.SaveJournalDraft(ctx, *draft); err != nil {
+		return nil, err
+	}
+
+	// Read back for the saved timestamp, consistently so the write that just happened is seen.
+	return s.getDraft(ctx, input.DriverID, input.RaceID, store.ConsistentRead())
+}
+
+// GetDraft retrieves the draft for a race.
+// Returns nil if there is no draft, or it has expired. Error is only for infrastructure failures.
+func (s *Service) GetDraft(ctx context.Context, driverID, raceID int64) (*Draft, error) {
+	return s.getDraft(ctx, driverID, raceID)
+}
+
+func (s *Service) getDraft(ctx context.Context, driverID, raceID int64, opts ...store.ReadOption) (*Draft, error) {
+	draft, err := s.store.GetJournalDraft(ctx, driverID, raceID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if draft == nil {
+		return nil, nil
+	}
+	return &Draft{
+		DriverID:    draft.DriverID,
+		RaceID:      draft.RaceID,
+		SavedAt:     draft.SavedAt,
+		ExpiresAt:   draft.ExpiresAt,
+		Notes:       draft.Notes,
+		Tags:        normalizeTags(draft.Tags),
+		ReplayVideo: draft.ReplayVideo,
+	}, nil
+}
+
+// PublishDraft saves the draft's content as the race's journal entry, exactly as Save would, then discards the draft.
+// Callers should fetch the draft with GetDraft and validate its content with ValidateTags and ValidateReplayVideo
+// before publishing it.
+func (s *Service) PublishDraft(ctx context.Context, draft Draft) (*Entry, error) {
+	entry, err := s.Save(ctx, SaveInput{
+		DriverID:    draft.DriverID,
+		RaceID:      draft.RaceID,
+		Notes:       draft.Notes,
+		Tags:        draft.Tags,
+		ReplayVideo: draft.ReplayVideo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The entry is already published at this point, a draft left behind will simply expire.
+	if err := s.store.DeleteJournalDraft(ctx, draft.DriverID, draft.RaceID); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Int64("driverId", draft.DriverID).Int64("raceId", draft.RaceID).Msg("failed to delete published journal draft")
+	}
+	return entry, nil
+}
+
+// MaxPromptDays is the furthest back prompts can be listed for. Prompts are only kept for about this long.
+const MaxPromptDays = 30
+
+// Prompt is a recently ingested race the driver hasn't journaled yet, with its race context.
+type Prompt struct {
+	RaceID         int64
+	CreatedAt      time.Time
+	Race           *store.DriverSession
+	PlanEvaluation *store.PlanEvaluation // how the race went against the driver's plan for it, nil if not planned
+}
+
+// Prompts lists the driver's undismissed prompts for races run in the last days days, newest first. Races journaled
+// since the prompt was raised are left out, so the list only holds races still waiting on an entry.
+func (s *Service) Prompts(ctx context.Context, driverID int64, days int) ([]Prompt, error) {
+	to := s.now()
+	from := to.Add(-time.Hour * 24 * time.Duration(days))
+
+	prompts, err := s.store.GetJournalPrompts(ctx, driverID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if len(prompts) == 0 {
+		return []Prompt{}, nil
+	}
+
+	entries, err := s.store.GetJournalEntries(ctx, driverID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	journaled := make(map[int64]bool, len(entries))
+	for _, entry := range entries {
+		journaled[entry.RaceID] = true
+	}
+	prompts = slices.DeleteFunc(prompts, func(prompt store.JournalPrompt) bool {
+		return journaled[prompt.RaceID]
+	})
+	if len(prompts) == 0 {
+		return []Prompt{}, nil
+	}
+
+	startTimes := make([]time.Time, len(prompts))
+	for i, prompt := range prompts {
+		startTimes[i] = store.TimeFromDriverRaceID(prompt.RaceID)
+	}
+	sessions, err := s.store.GetDriverSessions(ctx, driverID, startTimes)
+	if err != nil {
+		return nil, err
+	}
+	sessionMap := make(map[int64]*store.DriverSession, len(sessions))
+	for i := range sessions {
+		sessionMap[sessions[i].StartTime.Unix()] = &sessions[i]
+	}
+
+	results := make([]Prompt, len(prompts))
+	for i, prompt := range prompts {
+		results[i] = Prompt{
+			RaceID:         prompt.RaceID,
+			CreatedAt:      prompt.CreatedAt,
+			Race:           sessionMap[prompt.RaceID],
+			PlanEvaluation: prompt.PlanEvaluation,
+		}
+	}
+	return results, nil
+}
+
+// DismissPrompt stops the race being listed as waiting on a journal entry. Idempotent - succeeds even if there is no
+// prompt for the race.
+func (s *Service) DismissPrompt(ctx context.Context, driverID, raceID int64) error {
+	return s.store.DeleteJournalPrompt(ctx, driverID, raceID)
+}
+
+// Stats summarizes the driver's journaling as of now.
+func (s *Service) Stats(ctx context.Context, driverID int64) (*Stats, error) {
+	streak, err := s.store.GetJournalStreak(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	stats := statsFromStreak(streak, s.now())
+	return &stats, nil
+}
+
 // normalizeTags ensures tags is never nil (returns empty slice instead).
 func normalizeTags(tags []string) []string {
 	if tags == nil {
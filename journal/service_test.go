@@ -317,6 +317,8 @@ func TestService_Save(t *testing.T) {
 	startTime := store.TimeFromDriverRaceID(raceID)
 	createdAt := time.Unix(1000, 0)
 	updatedAt := time.Unix(2000, 0)
+	// reading back what was just saved must not be eventually consistent
+	consistentRead := []store.ReadOption{store.ConsistentRead()}
 
 	testCases := []struct {
 		name            string
@@ -342,9 +344,10 @@ func TestService_Save(t *testing.T) {
 					Notes:       "Great race!",
 					Tags:        []string{"sentiment:good"},
 					ReplayVideo: "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+					SearchTerms: []string{"good", "great", "race", "sentiment"},
 				}).Return(nil)
 				me.EXPECT().EmitCount(mock.Anything, metrics.JournalEntriesCreated, 1).Return(nil)
-				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID).
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID, consistentRead).
 					Return(&store.RaceJournalEntry{
 						DriverID:    driverID,
 						RaceID:      raceID,
@@ -354,7 +357,7 @@ func TestService_Save(t *testing.T) {
 						CreatedAt:   createdAt,
 						UpdatedAt:   updatedAt,
 					}, nil)
-				m.EXPECT().GetDriverSession(mock.Anything, driverID, startTime).
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, startTime, consistentRead).
 					Return(&store.DriverSession{
 						DriverID:       driverID,
 						StartTime:      startTime,
@@ -386,13 +389,14 @@ func TestService_Save(t *testing.T) {
 			},
 			setupMock: func(m *MockStore, me *MockMetricsEmitter) {
 				m.EXPECT().SaveJournalEntry(mock.Anything, store.RaceJournalEntry{
-					DriverID: driverID,
-					RaceID:   raceID,
-					Notes:    "Great race!",
-					Tags:     []string{"sentiment:good"},
+					DriverID:    driverID,
+					RaceID:      raceID,
+					Notes:       "Great race!",
+					Tags:        []string{"sentiment:good"},
+					SearchTerms: []string{"good", "great", "race", "sentiment"},
 				}).Return(nil)
 				me.EXPECT().EmitCount(mock.Anything, metrics.JournalEntriesCreated, 1).Return(errors.New("cloudwatch error"))
-				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID).
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID, consistentRead).
 					Return(&store.RaceJournalEntry{
 						DriverID:  driverID,
 						RaceID:    raceID,
@@ -401,7 +405,7 @@ func TestService_Save(t *testing.T) {
 						CreatedAt: createdAt,
 						UpdatedAt: updatedAt,
 					}, nil)
-				m.EXPECT().GetDriverSession(mock.Anything, driverID, startTime).
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, startTime, consistentRead).
 					Return(&store.DriverSession{
 						DriverID:       driverID,
 						StartTime:      startTime,
@@ -446,7 +450,7 @@ func TestService_Save(t *testing.T) {
 			setupMock: func(m *MockStore, me *MockMetricsEmitter) {
 				m.EXPECT().SaveJournalEntry(mock.Anything, mock.Anything).Return(nil)
 				me.EXPECT().EmitCount(mock.Anything, metrics.JournalEntriesCreated, 1).Return(nil)
-				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID).
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID, consistentRead).
 					Return(nil, errors.New("database error"))
 			},
 			expected:    nil,
@@ -473,6 +477,83 @@ func TestService_Save(t *testing.T) {
 	}
 }
 
+func TestService_Save_AdvancesOnboarding(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	startTime := store.TimeFromDriverRaceID(raceID)
+	consistentRead := []store.ReadOption{store.ConsistentRead()}
+
+	testCases := []struct {
+		name          string
+		onboardingErr error
+	}{
+		{name: "success"},
+		{name: "onboarding error is logged and the save still succeeds", onboardingErr: errors.New("database error")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockMetrics := NewMockMetricsEmitter(t)
+			mockTracker := NewMockOnboardingTracker(t)
+
+			mockStore.EXPECT().SaveJournalEntry(mock.Anything, mock.Anything).Return(nil)
+			mockMetrics.EXPECT().EmitCount(mock.Anything, metrics.JournalEntriesCreated, 1).Return(nil)
+			mockTracker.EXPECT().Advance(mock.Anything, driverID, store.OnboardingStepJournalEntryCreated).Return(tc.onboardingErr)
+			mockStore.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID, consistentRead).
+				Return(&store.RaceJournalEntry{DriverID: driverID, RaceID: raceID, Notes: "Great race!"}, nil)
+			mockStore.EXPECT().GetDriverSession(mock.Anything, driverID, startTime, consistentRead).
+				Return(&store.DriverSession{DriverID: driverID, StartTime: startTime}, nil)
+
+			svc := NewService(mockStore, mockMetrics, WithOnboardingTracker(mockTracker))
+			entry, err := svc.Save(ctx, SaveInput{DriverID: driverID, RaceID: raceID, Notes: "Great race!"})
+
+			require.NoError(t, err)
+			assert.Equal(t, "Great race!", entry.Notes)
+		})
+	}
+}
+
+func TestService_Save_RecordsStreak(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	startTime := store.TimeFromDriverRaceID(raceID)
+	createdAt := time.Unix(1000, 0)
+	consistentRead := []store.ReadOption{store.ConsistentRead()}
+
+	testCases := []struct {
+		name      string
+		streakErr error
+	}{
+		{name: "success"},
+		{name: "streak error is logged and the save still succeeds", streakErr: errors.New("database error")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockMetrics := NewMockMetricsEmitter(t)
+			mockRecorder := NewMockStreakRecorder(t)
+
+			mockStore.EXPECT().SaveJournalEntry(mock.Anything, mock.Anything).Return(nil)
+			mockMetrics.EXPECT().EmitCount(mock.Anything, metrics.JournalEntriesCreated, 1).Return(nil)
+			mockStore.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID, consistentRead).
+				Return(&store.RaceJournalEntry{DriverID: driverID, RaceID: raceID, Notes: "Great race!", CreatedAt: createdAt}, nil)
+			mockStore.EXPECT().GetDriverSession(mock.Anything, driverID, startTime, consistentRead).
+				Return(&store.DriverSession{DriverID: driverID, StartTime: startTime}, nil)
+			mockRecorder.EXPECT().RecordEntry(mock.Anything, driverID, createdAt).Return(tc.streakErr)
+
+			svc := NewService(mockStore, mockMetrics, WithStreakTracker(mockRecorder))
+			entry, err := svc.Save(ctx, SaveInput{DriverID: driverID, RaceID: raceID, Notes: "Great race!"})
+
+			require.NoError(t, err)
+			assert.Equal(t, "Great race!", entry.Notes)
+		})
+	}
+}
+
 func TestService_List(t *testing.T) {
 	ctx := context.Background()
 	driverID := int64(12345)
@@ -542,6 +623,38 @@ func TestService_List(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name:  "query matches notes, tags and transcripts",
+			input: ListInput{DriverID: driverID, From: from, To: to, Query: "Brak T1"},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalEntries(mock.Anything, driverID, from, to).
+					Return([]store.RaceJournalEntry{
+						{DriverID: driverID, RaceID: raceID1, Notes: "Braking into T1", SearchTerms: []string{"braking", "into", "t1"}, CreatedAt: from, UpdatedAt: from},
+						{DriverID: driverID, RaceID: raceID2, Notes: "Race 2", SearchTerms: []string{"race"}, Transcripts: []string{"braked late into t1"}, TranscriptTerms: []string{"braked", "into", "late", "t1"}, CreatedAt: to, UpdatedAt: to},
+						{DriverID: driverID, RaceID: 4000, Notes: "Braking everywhere", SearchTerms: []string{"braking", "everywhere"}, CreatedAt: to, UpdatedAt: to},
+					}, nil)
+				m.EXPECT().GetDriverSessions(mock.Anything, driverID, []time.Time{startTime1, startTime2}).
+					Return([]store.DriverSession{}, nil)
+			},
+			expected: []Entry{
+				{RaceID: raceID1, Notes: "Braking into T1", Tags: []string{}, CreatedAt: from, UpdatedAt: from},
+				{RaceID: raceID2, Notes: "Race 2", Tags: []string{}, Transcripts: []string{"braked late into t1"}, CreatedAt: to, UpdatedAt: to},
+			},
+			expectedErr: false,
+		},
+		{
+			name:  "query without matches",
+			input: ListInput{DriverID: driverID, From: from, To: to, Query: "wet"},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalEntries(mock.Anything, driverID, from, to).
+					Return([]store.RaceJournalEntry{
+						// no stored terms, as for entries saved before search was added
+						{DriverID: driverID, RaceID: raceID1, Notes: "Dry race", Tags: []string{"sentiment:good"}},
+					}, nil)
+			},
+			expected:    []Entry{},
+			expectedErr: false,
+		},
 		{
 			name:  "GetJournalEntries error",
 			input: ListInput{DriverID: driverID, From: from, To: to},
@@ -631,3 +744,394 @@ func TestService_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestService_SaveDraft(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	now := time.Unix(5000, 0)
+	expiresAt := now.Add(7 * 24 * time.Hour)
+	savedAt := time.Unix(5000, 0)
+	consistentRead := []store.ReadOption{store.ConsistentRead()}
+	notes := "Half way through writing this"
+	replayVideo := "https://youtu.be/dQw4w9WgXcQ"
+
+	testCases := []struct {
+		name        string
+		input       SaveDraftInput
+		setupMock   func(*MockStore)
+		expected    *Draft
+		expectedErr bool
+	}{
+		{
+			name:  "first draft starts from the published entry",
+			input: SaveDraftInput{DriverID: driverID, RaceID: raceID, Notes: &notes},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalDraft(mock.Anything, driverID, raceID, consistentRead).Return(nil, nil).Once()
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID, consistentRead).
+					Return(&store.RaceJournalEntry{
+						DriverID:    driverID,
+						RaceID:      raceID,
+						Notes:       "Published notes",
+						Tags:        []string{"podium"},
+						ReplayVideo: replayVideo,
+					}, nil)
+				m.EXPECT().SaveJournalDraft(mock.Anything, store.RaceJournalDraft{
+					DriverID:    driverID,
+					RaceID:      raceID,
+					ExpiresAt:   expiresAt,
+					Notes:       notes,
+					Tags:        []string{"podium"},
+					ReplayVideo: replayVideo,
+				}).Return(nil)
+				m.EXPECT().GetJournalDraft(mock.Anything, driverID, raceID, consistentRead).
+					Return(&store.RaceJournalDraft{
+						DriverID:    driverID,
+						RaceID:      raceID,
+						SavedAt:     savedAt,
+						ExpiresAt:   expiresAt,
+						Notes:       notes,
+						Tags:        []string{"podium"},
+						ReplayVideo: replayVideo,
+					}, nil).Once()
+			},
+			expected: &Draft{
+				DriverID:    driverID,
+				RaceID:      raceID,
+				SavedAt:     savedAt,
+				ExpiresAt:   expiresAt,
+				Notes:       notes,
+				Tags:        []string{"podium"},
+				ReplayVideo: replayVideo,
+			},
+		},
+		{
+			name:  "existing draft only has provided fields replaced",
+			input: SaveDraftInput{DriverID: driverID, RaceID: raceID, Tags: []string{}},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalDraft(mock.Anything, driverID, raceID, consistentRead).
+					Return(&store.RaceJournalDraft{
+						DriverID:  driverID,
+						RaceID:    raceID,
+						SavedAt:   time.Unix(1000, 0),
+						ExpiresAt: time.Unix(2000, 0),
+						Notes:     notes,
+						Tags:      []string{"podium"},
+					}, nil).Once()
+				m.EXPECT().SaveJournalDraft(mock.Anything, store.RaceJournalDraft{
+					DriverID:  driverID,
+					RaceID:    raceID,
+					SavedAt:   time.Unix(1000, 0),
+					ExpiresAt: expiresAt,
+					Notes:     notes,
+					Tags:      []string{},
+				}).Return(nil)
+				m.EXPECT().GetJournalDraft(mock.Anything, driverID, raceID, consistentRead).
+					Return(&store.RaceJournalDraft{
+						DriverID:  driverID,
+						RaceID:    raceID,
+						SavedAt:   savedAt,
+						ExpiresAt: expiresAt,
+						Notes:     notes,
+					}, nil).Once()
+			},
+			expected: &Draft{
+				DriverID:  driverID,
+				RaceID:    raceID,
+				SavedAt:   savedAt,
+				ExpiresAt: expiresAt,
+				Notes:     notes,
+				Tags:      []string{},
+			},
+		},
+		{
+			name:  "get draft error",
+			input: SaveDraftInput{DriverID: driverID, RaceID: raceID, Notes: &notes},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalDraft(mock.Anything, driverID, raceID, consistentRead).
+					Return(nil, errors.New("database error"))
+			},
+			expectedErr: true,
+		},
+		{
+			name:  "save error",
+			input: SaveDraftInput{DriverID: driverID, RaceID: raceID, Notes: &notes},
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalDraft(mock.Anything, driverID, raceID, consistentRead).Return(nil, nil)
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID, consistentRead).Return(nil, nil)
+				m.EXPECT().SaveJournalDraft(mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			// drafts never emit metrics, an unexpected call fails the test
+			mockMetrics := NewMockMetricsEmitter(t)
+			tc.setupMock(mockStore)
+
+			svc := NewService(mockStore, mockMetrics, WithDraftRetentionInDays(7))
+			svc.now = func() time.Time { return now }
+			draft, err := svc.SaveDraft(ctx, tc.input)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, draft)
+			}
+		})
+	}
+}
+
+func TestService_PublishDraft(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	startTime := store.TimeFromDriverRaceID(raceID)
+	consistentRead := []store.ReadOption{store.ConsistentRead()}
+	draft := Draft{
+		DriverID: driverID,
+		RaceID:   raceID,
+		Notes:    "Finished writing",
+		Tags:     []string{"sentiment:good"},
+	}
+	savedEntry := &store.RaceJournalEntry{
+		DriverID:  driverID,
+		RaceID:    raceID,
+		Notes:     "Finished writing",
+		Tags:      []string{"sentiment:good"},
+		CreatedAt: time.Unix(1000, 0),
+		UpdatedAt: time.Unix(2000, 0),
+	}
+	expectedEntry := &Entry{
+		RaceID:    raceID,
+		CreatedAt: time.Unix(1000, 0),
+		UpdatedAt: time.Unix(2000, 0),
+		Notes:     "Finished writing",
+		Tags:      []string{"sentiment:good"},
+	}
+
+	testCases := []struct {
+		name        string
+		setupMock   func(*MockStore, *MockMetricsEmitter)
+		expected    *Entry
+		expectedErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func(m *MockStore, me *MockMetricsEmitter) {
+				m.EXPECT().SaveJournalEntry(mock.Anything, store.RaceJournalEntry{
+					DriverID:    driverID,
+					RaceID:      raceID,
+					Notes:       "Finished writing",
+					Tags:        []string{"sentiment:good"},
+					SearchTerms: []string{"finished", "good", "sentiment", "writing"},
+				}).Return(nil)
+				me.EXPECT().EmitCount(mock.Anything, metrics.JournalEntriesCreated, 1).Return(nil)
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID, consistentRead).Return(savedEntry, nil)
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, startTime, consistentRead).Return(nil, nil)
+				m.EXPECT().DeleteJournalDraft(mock.Anything, driverID, raceID).Return(nil)
+			},
+			expected: expectedEntry,
+		},
+		{
+			name: "draft delete error (logs but continues)",
+			setupMock: func(m *MockStore, me *MockMetricsEmitter) {
+				m.EXPECT().SaveJournalEntry(mock.Anything, mock.Anything).Return(nil)
+				me.EXPECT().EmitCount(mock.Anything, metrics.JournalEntriesCreated, 1).Return(nil)
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID, consistentRead).Return(savedEntry, nil)
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, startTime, consistentRead).Return(nil, nil)
+				m.EXPECT().DeleteJournalDraft(mock.Anything, driverID, raceID).Return(errors.New("database error"))
+			},
+			expected: expectedEntry,
+		},
+		{
+			name: "save error keeps the draft",
+			setupMock: func(m *MockStore, me *MockMetricsEmitter) {
+				m.EXPECT().SaveJournalEntry(mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockMetrics := NewMockMetricsEmitter(t)
+			tc.setupMock(mockStore, mockMetrics)
+
+			svc := NewService(mockStore, mockMetrics)
+			entry, err := svc.PublishDraft(ctx, draft)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, entry)
+			}
+		})
+	}
+}
+
+func TestService_Prompts(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	now := time.Unix(1000000, 0)
+	from := now.Add(-7 * 24 * time.Hour)
+
+	raceID1 := int64(900000)
+	raceID2 := int64(950000)
+	raceID3 := int64(990000)
+	startTime1 := store.TimeFromDriverRaceID(raceID1)
+	startTime3 := store.TimeFromDriverRaceID(raceID3)
+	planEvaluation := &store.PlanEvaluation{
+		Targets:     []store.PlanTarget{{Kind: store.PlanTargetFinishPosition, Target: 5, Actual: 4, Met: true}},
+		EvaluatedAt: time.Unix(990100, 0),
+	}
+
+	testCases := []struct {
+		name        string
+		setupMock   func(*MockStore)
+		expected    []Prompt
+		expectedErr bool
+	}{
+		{
+			name: "no prompts",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalPrompts(mock.Anything, driverID, from, now).
+					Return([]store.JournalPrompt{}, nil)
+			},
+			expected: []Prompt{},
+		},
+		{
+			name: "journaled races left out",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalPrompts(mock.Anything, driverID, from, now).
+					Return([]store.JournalPrompt{
+						{DriverID: driverID, RaceID: raceID3, CreatedAt: time.Unix(990100, 0), PlanEvaluation: planEvaluation},
+						{DriverID: driverID, RaceID: raceID2, CreatedAt: time.Unix(950100, 0)},
+						{DriverID: driverID, RaceID: raceID1, CreatedAt: time.Unix(900100, 0)},
+					}, nil)
+				m.EXPECT().GetJournalEntries(mock.Anything, driverID, from, now).
+					Return([]store.RaceJournalEntry{
+						{DriverID: driverID, RaceID: raceID2, Notes: "already written"},
+					}, nil)
+				m.EXPECT().GetDriverSessions(mock.Anything, driverID, []time.Time{startTime3, startTime1}).
+					Return([]store.DriverSession{
+						{DriverID: driverID, StartTime: startTime3, FinishPosition: 3},
+					}, nil)
+			},
+			expected: []Prompt{
+				{RaceID: raceID3, CreatedAt: time.Unix(990100, 0), Race: &store.DriverSession{DriverID: driverID, StartTime: startTime3, FinishPosition: 3}, PlanEvaluation: planEvaluation},
+				{RaceID: raceID1, CreatedAt: time.Unix(900100, 0)},
+			},
+		},
+		{
+			name: "every race journaled",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalPrompts(mock.Anything, driverID, from, now).
+					Return([]store.JournalPrompt{{DriverID: driverID, RaceID: raceID1}}, nil)
+				m.EXPECT().GetJournalEntries(mock.Anything, driverID, from, now).
+					Return([]store.RaceJournalEntry{{DriverID: driverID, RaceID: raceID1}}, nil)
+			},
+			expected: []Prompt{},
+		},
+		{
+			name: "GetJournalPrompts error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalPrompts(mock.Anything, driverID, from, now).
+					Return(nil, errors.New("database error"))
+			},
+			expectedErr: true,
+		},
+		{
+			name: "GetJournalEntries error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalPrompts(mock.Anything, driverID, from, now).
+					Return([]store.JournalPrompt{{DriverID: driverID, RaceID: raceID1}}, nil)
+				m.EXPECT().GetJournalEntries(mock.Anything, driverID, from, now).
+					Return(nil, errors.New("database error"))
+			},
+			expectedErr: true,
+		},
+		{
+			name: "GetDriverSessions error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetJournalPrompts(mock.Anything, driverID, from, now).
+					Return([]store.JournalPrompt{{DriverID: driverID, RaceID: raceID1}}, nil)
+				m.EXPECT().GetJournalEntries(mock.Anything, driverID, from, now).
+					Return([]store.RaceJournalEntry{}, nil)
+				m.EXPECT().GetDriverSessions(mock.Anything, driverID, []time.Time{startTime1}).
+					Return(nil, errors.New("database error"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockMetrics := NewMockMetricsEmitter(t)
+			tc.setupMock(mockStore)
+
+			svc := NewService(mockStore, mockMetrics)
+			svc.now = func() time.Time { return now }
+			prompts, err := svc.Prompts(ctx, driverID, 7)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, prompts)
+			}
+		})
+	}
+}
+
+func TestService_DismissPrompt(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+
+	testCases := []struct {
+		name        string
+		setupMock   func(*MockStore)
+		expectedErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().DeleteJournalPrompt(mock.Anything, driverID, raceID).Return(nil)
+			},
+			expectedErr: false,
+		},
+		{
+			name: "store error",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().DeleteJournalPrompt(mock.Anything, driverID, raceID).
+					Return(errors.New("database error"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockMetrics := NewMockMetricsEmitter(t)
+			tc.setupMock(mockStore)
+
+			svc := NewService(mockStore, mockMetrics)
+			err := svc.DismissPrompt(ctx, driverID, raceID)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
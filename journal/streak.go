@@ -0,0 +1,118 @@
+package journal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/standings"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const raceWeek = 7 * 24 * time.Hour
+
+// streakMilestoneWeeks are the streak lengths a driver is congratulated for reaching
+var streakMilestoneWeeks = []int{4, 12, 26, 52}
+
+// StreakMilestone names the milestone for journaling in weeks consecutive race weeks
+func StreakMilestone(weeks int) string {
+	return fmt.Sprintf("journal_streak_%d", weeks)
+}
+
+// StreakStore defines the data access methods needed to track journaling streaks.
+type StreakStore interface {
+	GetJournalStreak(ctx context.Context, driverID int64) (*store.JournalStreak, error)
+	SaveJournalStreak(ctx context.Context, streak store.JournalStreak) error
+	RecordMilestone(ctx context.Context, milestone store.DriverMilestone) (bool, error)
+}
+
+// StreakTracker keeps count of the consecutive race weeks each driver has written at least one journal entry in.
+type StreakTracker struct {
+	store   StreakStore
+	metrics MetricsEmitter
+}
+
+func NewStreakTracker(store StreakStore, metrics MetricsEmitter) *StreakTracker {
+	return &StreakTracker{
+		store:   store,
+		metrics: metrics,
+	}
+}
+
+// RecordEntry counts an entry created at createdAt towards the driver's streak. Only the first entry of a race week
+// changes anything, so callers can report every save, including edits to entries written in earlier weeks. Reaching
+// one of the streak milestones records it against the driver.
+func (t *StreakTracker) RecordEntry(ctx context.Context, driverID int64, createdAt time.Time) error {
+	week := standings.WeekStart(createdAt)
+
+	streak, err := t.store.GetJournalStreak(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("getting journal streak: %w", err)
+	}
+	if streak == nil {
+		streak = &store.JournalStreak{DriverID: driverID}
+	} else if !week.After(streak.LastWeekStart) {
+		return nil
+	}
+
+	if streak.CurrentWeeks > 0 && week.Equal(streak.LastWeekStart.Add(raceWeek)) {
+		streak.CurrentWeeks++
+	} else {
+		streak.CurrentWeeks = 1
+	}
+	streak.LastWeekStart = week
+	streak.LongestWeeks = max(streak.LongestWeeks, streak.CurrentWeeks)
+
+	if err := t.store.SaveJournalStreak(ctx, *streak); err != nil {
+		return fmt.Errorf("saving journal streak: %w", err)
+	}
+
+	if !slices.Contains(streakMilestoneWeeks, streak.CurrentWeeks) {
+		return nil
+	}
+	milestone := StreakMilestone(streak.CurrentWeeks)
+	recorded, err := t.store.RecordMilestone(ctx, store.DriverMilestone{
+		DriverID:   driverID,
+		Milestone:  milestone,
+		AchievedAt: createdAt,
+	})
+	if err != nil {
+		return fmt.Errorf("recording milestone %s: %w", milestone, err)
+	}
+	if recorded {
+		zerolog.Ctx(ctx).Info().Int64("driverId", driverID).Str("milestone", milestone).Msg("milestone recorded")
+		if err := t.metrics.EmitCount(ctx, metrics.MilestonesRecorded, 1); err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to emit milestones recorded metric")
+		}
+	}
+	return nil
+}
+
+// Stats summarizes a driver's journaling habits.
+type Stats struct {
+	CurrentStreakWeeks int
+	LongestStreakWeeks int
+	JournaledThisWeek  bool
+}
+
+// statsFromStreak works out the driver's stats as of now. The stored streak is only updated when an entry is
+// written, so a streak whose last week ended before the previous race week has been broken even though the stored
+// count says otherwise. A streak last extended in the previous race week is still current, the driver has until the
+// end of this week to keep it going.
+func statsFromStreak(streak *store.JournalStreak, now time.Time) Stats {
+	if streak == nil {
+		return Stats{}
+	}
+	thisWeek := standings.WeekStart(now)
+	stats := Stats{
+		LongestStreakWeeks: streak.LongestWeeks,
+		JournaledThisWeek:  !streak.LastWeekStart.Before(thisWeek),
+	}
+	if !streak.LastWeekStart.Before(thisWeek.Add(-raceWeek)) {
+		stats.CurrentStreakWeeks = streak.CurrentWeeks
+	}
+	return stats
+}
@@ -0,0 +1,221 @@
+package journal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreakTracker_RecordEntry(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	// race weeks start Tuesday 00:00 UTC
+	week := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	createdAt := week.Add(3*24*time.Hour + 5*time.Hour)
+
+	testCases := []struct {
+		name      string
+		setupMock func(*MockStreakStore, *MockMetricsEmitter)
+		expectErr bool
+	}{
+		{
+			name: "first entry starts a streak",
+			setupMock: func(m *MockStreakStore, me *MockMetricsEmitter) {
+				m.EXPECT().GetJournalStreak(mock.Anything, driverID).Return(nil, nil)
+				m.EXPECT().SaveJournalStreak(mock.Anything, store.JournalStreak{
+					DriverID:      driverID,
+					CurrentWeeks:  1,
+					LongestWeeks:  1,
+					LastWeekStart: week,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "another entry in the same week changes nothing",
+			setupMock: func(m *MockStreakStore, me *MockMetricsEmitter) {
+				m.EXPECT().GetJournalStreak(mock.Anything, driverID).
+					Return(&store.JournalStreak{DriverID: driverID, CurrentWeeks: 2, LongestWeeks: 2, LastWeekStart: week}, nil)
+			},
+		},
+		{
+			name: "edit to an entry from an earlier week changes nothing",
+			setupMock: func(m *MockStreakStore, me *MockMetricsEmitter) {
+				m.EXPECT().GetJournalStreak(mock.Anything, driverID).
+					Return(&store.JournalStreak{DriverID: driverID, CurrentWeeks: 1, LongestWeeks: 1, LastWeekStart: week.Add(2 * raceWeek)}, nil)
+			},
+		},
+		{
+			name: "entry in the following week extends the streak",
+			setupMock: func(m *MockStreakStore, me *MockMetricsEmitter) {
+				m.EXPECT().GetJournalStreak(mock.Anything, driverID).
+					Return(&store.JournalStreak{DriverID: driverID, CurrentWeeks: 1, LongestWeeks: 5, LastWeekStart: week.Add(-raceWeek)}, nil)
+				m.EXPECT().SaveJournalStreak(mock.Anything, store.JournalStreak{
+					DriverID:      driverID,
+					CurrentWeeks:  2,
+					LongestWeeks:  5,
+					LastWeekStart: week,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "skipped week restarts the streak",
+			setupMock: func(m *MockStreakStore, me *MockMetricsEmitter) {
+				m.EXPECT().GetJournalStreak(mock.Anything, driverID).
+					Return(&store.JournalStreak{DriverID: driverID, CurrentWeeks: 7, LongestWeeks: 7, LastWeekStart: week.Add(-2 * raceWeek)}, nil)
+				m.EXPECT().SaveJournalStreak(mock.Anything, store.JournalStreak{
+					DriverID:      driverID,
+					CurrentWeeks:  1,
+					LongestWeeks:  7,
+					LastWeekStart: week,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "reaching a milestone records it",
+			setupMock: func(m *MockStreakStore, me *MockMetricsEmitter) {
+				m.EXPECT().GetJournalStreak(mock.Anything, driverID).
+					Return(&store.JournalStreak{DriverID: driverID, CurrentWeeks: 3, LongestWeeks: 3, LastWeekStart: week.Add(-raceWeek)}, nil)
+				m.EXPECT().SaveJournalStreak(mock.Anything, store.JournalStreak{
+					DriverID:      driverID,
+					CurrentWeeks:  4,
+					LongestWeeks:  4,
+					LastWeekStart: week,
+				}).Return(nil)
+				m.EXPECT().RecordMilestone(mock.Anything, store.DriverMilestone{
+					DriverID:   driverID,
+					Milestone:  "journal_streak_4",
+					AchievedAt: createdAt,
+				}).Return(true, nil)
+				me.EXPECT().EmitCount(mock.Anything, metrics.MilestonesRecorded, 1).Return(nil)
+			},
+		},
+		{
+			name: "milestone already achieved by an earlier streak",
+			setupMock: func(m *MockStreakStore, me *MockMetricsEmitter) {
+				m.EXPECT().GetJournalStreak(mock.Anything, driverID).
+					Return(&store.JournalStreak{DriverID: driverID, CurrentWeeks: 3, LongestWeeks: 9, LastWeekStart: week.Add(-raceWeek)}, nil)
+				m.EXPECT().SaveJournalStreak(mock.Anything, mock.Anything).Return(nil)
+				m.EXPECT().RecordMilestone(mock.Anything, mock.Anything).Return(false, nil)
+			},
+		},
+		{
+			name: "milestone metric error is logged",
+			setupMock: func(m *MockStreakStore, me *MockMetricsEmitter) {
+				m.EXPECT().GetJournalStreak(mock.Anything, driverID).
+					Return(&store.JournalStreak{DriverID: driverID, CurrentWeeks: 11, LongestWeeks: 11, LastWeekStart: week.Add(-raceWeek)}, nil)
+				m.EXPECT().SaveJournalStreak(mock.Anything, mock.Anything).Return(nil)
+				m.EXPECT().RecordMilestone(mock.Anything, mock.MatchedBy(func(milestone store.DriverMilestone) bool {
+					return milestone.Milestone == "journal_streak_12"
+				})).Return(true, nil)
+				me.EXPECT().EmitCount(mock.Anything, metrics.MilestonesRecorded, 1).Return(errors.New("cloudwatch error"))
+			},
+		},
+		{
+			name: "get error",
+			setupMock: func(m *MockStreakStore, me *MockMetricsEmitter) {
+				m.EXPECT().GetJournalStreak(mock.Anything, driverID).Return(nil, errors.New("database error"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "save error",
+			setupMock: func(m *MockStreakStore, me *MockMetricsEmitter) {
+				m.EXPECT().GetJournalStreak(mock.Anything, driverID).Return(nil, nil)
+				m.EXPECT().SaveJournalStreak(mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "milestone error",
+			setupMock: func(m *MockStreakStore, me *MockMetricsEmitter) {
+				m.EXPECT().GetJournalStreak(mock.Anything, driverID).
+					Return(&store.JournalStreak{DriverID: driverID, CurrentWeeks: 3, LongestWeeks: 3, LastWeekStart: week.Add(-raceWeek)}, nil)
+				m.EXPECT().SaveJournalStreak(mock.Anything, mock.Anything).Return(nil)
+				m.EXPECT().RecordMilestone(mock.Anything, mock.Anything).Return(false, errors.New("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStreakStore(t)
+			mockMetrics := NewMockMetricsEmitter(t)
+			tc.setupMock(mockStore, mockMetrics)
+
+			tracker := NewStreakTracker(mockStore, mockMetrics)
+			err := tracker.RecordEntry(ctx, driverID, createdAt)
+
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_Stats(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	week := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	now := week.Add(2 * 24 * time.Hour)
+
+	testCases := []struct {
+		name        string
+		streak      *store.JournalStreak
+		storeErr    error
+		expected    *Stats
+		expectedErr bool
+	}{
+		{
+			name:     "never journaled",
+			expected: &Stats{},
+		},
+		{
+			name:     "journaled this week",
+			streak:   &store.JournalStreak{DriverID: driverID, CurrentWeeks: 3, LongestWeeks: 6, LastWeekStart: week},
+			expected: &Stats{CurrentStreakWeeks: 3, LongestStreakWeeks: 6, JournaledThisWeek: true},
+		},
+		{
+			name:     "streak can still be extended this week",
+			streak:   &store.JournalStreak{DriverID: driverID, CurrentWeeks: 3, LongestWeeks: 6, LastWeekStart: week.Add(-raceWeek)},
+			expected: &Stats{CurrentStreakWeeks: 3, LongestStreakWeeks: 6},
+		},
+		{
+			name:     "streak broken by a skipped week",
+			streak:   &store.JournalStreak{DriverID: driverID, CurrentWeeks: 3, LongestWeeks: 6, LastWeekStart: week.Add(-2 * raceWeek)},
+			expected: &Stats{LongestStreakWeeks: 6},
+		},
+		{
+			name:        "store error",
+			storeErr:    errors.New("database error"),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetJournalStreak(mock.Anything, driverID).Return(tc.streak, tc.storeErr)
+
+			svc := NewService(mockStore, NewMockMetricsEmitter(t))
+			svc.now = func() time.Time { return now }
+			stats, err := svc.Stats(ctx, driverID)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, stats)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Package lapevents normalizes the lap_events iRacing reports for each lap. iRacing sends them as display text, so
+// they're turned into codes at ingestion and everything downstream matches against the codes.
+package lapevents
+
+import (
+	"slices"
+	"strings"
+)
+
+// Code is a normalized lap event. The codes match the names lapflags decodes the lap flags bitmask into.
+type Code string
+
+const (
+	Invalid              Code = "invalid"
+	Pitted               Code = "pitted"
+	OffTrack             Code = "off_track"
+	BlackFlag            Code = "black_flag"
+	CarReset             Code = "car_reset"
+	Contact              Code = "contact"
+	CarContact           Code = "car_contact"
+	LostControl          Code = "lost_control"
+	Discontinuity        Code = "discontinuity"
+	InterpolatedCrossing Code = "interpolated_crossing"
+	ClockSmash           Code = "clock_smash"
+	Tow                  Code = "tow"
+)
+
+// events pairs each code with the text iRacing reports it as
+var events = []struct {
+	code Code
+	text string
+}{
+	{Invalid, "invalid"},
+	{Pitted, "pitted"},
+	{OffTrack, "off track"},
+	{BlackFlag, "black flag"},
+	{CarReset, "car reset"},
+	{Contact, "contact"},
+	{CarContact, "car contact"},
+	{LostControl, "lost control"},
+	{Discontinuity, "discontinuity"},
+	{InterpolatedCrossing, "interpolated crossing"},
+	{ClockSmash, "clock smash"},
+	{Tow, "tow"},
+}
+
+// Codes returns every known code.
+func Codes() []Code {
+	result := make([]Code, len(events))
+	for i, e := range events {
+		result[i] = e.code
+	}
+	return result
+}
+
+// IsValid reports whether c is a known code.
+func (c Code) IsValid() bool {
+	return slices.Contains(Codes(), c)
+}
+
+// Parse returns the code for an event, given either iRacing's text for it or the code itself, ignoring case and
+// whether words are separated by spaces, underscores or hyphens. The second return is false for events that aren't
+// known.
+func Parse(event string) (Code, bool) {
+	key := strings.Join(strings.FieldsFunc(strings.ToLower(event), func(r rune) bool {
+		return r == ' ' || r == '_' || r == '-'
+	}), " ")
+	for _, e := range events {
+		if e.text == key {
+			return e.code, true
+		}
+	}
+	return "", false
+}
+
+// Normalize turns the events reported for a lap into codes. Events that aren't known are kept as they were, so nothing
+// iRacing starts reporting is lost before a code is added for it. Normalizing codes leaves them as they are, so laps
+// stored before their events were normalized can be run through it on the way out.
+func Normalize(events []string) []string {
+	if events == nil {
+		return nil
+	}
+	result := make([]string, len(events))
+	for i, event := range events {
+		result[i] = event
+		if code, ok := Parse(event); ok {
+			result[i] = string(code)
+		}
+	}
+	return result
+}
+
+// Text turns a lap's events back into iRacing's display text, the reverse of Normalize. Events that aren't known are
+// kept as they were.
+func Text(codes []string) []string {
+	if codes == nil {
+		return nil
+	}
+	result := make([]string, len(codes))
+	for i, code := range codes {
+		result[i] = code
+		for _, e := range events {
+			if string(e.code) == code {
+				result[i] = e.text
+			}
+		}
+	}
+	return result
+}
+
+// HasAny reports whether a lap's events, normalized or not, include any of codes.
+func HasAny(lapEvents []string, codes []Code) bool {
+	for _, event := range lapEvents {
+		if code, ok := Parse(event); ok && slices.Contains(codes, code) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package lapevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jonsabados/saturdaysspinout/lapflags"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		events   []string
+		expected []string
+	}{
+		{
+			name:     "no events",
+			events:   []string{},
+			expected: []string{},
+		},
+		{
+			name:     "nil stays nil",
+			events:   nil,
+			expected: nil,
+		},
+		{
+			name:     "iRacing text",
+			events:   []string{"off track", "car contact", "lost control"},
+			expected: []string{"off_track", "car_contact", "lost_control"},
+		},
+		{
+			name:     "case and spacing ignored",
+			events:   []string{"Off Track", " black  flag ", "Car-Reset"},
+			expected: []string{"off_track", "black_flag", "car_reset"},
+		},
+		{
+			name:     "already normalized",
+			events:   []string{"off_track", "tow"},
+			expected: []string{"off_track", "tow"},
+		},
+		{
+			name:     "unknown events kept",
+			events:   []string{"off track", "meatball flag"},
+			expected: []string{"off_track", "meatball flag"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Normalize(tc.events))
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	assert.Equal(t, []string{"off track", "contact", "meatball flag"}, Text([]string{"off_track", "contact", "meatball flag"}))
+	assert.Nil(t, Text(nil))
+}
+
+func TestCodes_MatchLapFlags(t *testing.T) {
+	var codes []string
+	for _, code := range Codes() {
+		codes = append(codes, string(code))
+	}
+	assert.Equal(t, lapflags.Decode(0xFFF), codes)
+}
+
+func TestCode_IsValid(t *testing.T) {
+	assert.True(t, OffTrack.IsValid())
+	assert.False(t, Code("off track").IsValid())
+	assert.False(t, Code("").IsValid())
+}
+
+func TestHasAny(t *testing.T) {
+	testCases := []struct {
+		name     string
+		events   []string
+		codes    []Code
+		expected bool
+	}{
+		{
+			name:     "normalized match",
+			events:   []string{"pitted", "off_track"},
+			codes:    []Code{OffTrack},
+			expected: true,
+		},
+		{
+			name:     "text match",
+			events:   []string{"car contact"},
+			codes:    []Code{Contact, CarContact},
+			expected: true,
+		},
+		{
+			name:   "no match",
+			events: []string{"pitted"},
+			codes:  []Code{OffTrack, Contact},
+		},
+		{
+			name:   "unknown events never match",
+			events: []string{"meatball flag"},
+			codes:  []Code{OffTrack},
+		},
+		{
+			name:  "no events",
+			codes: []Code{OffTrack},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, HasAny(tc.events, tc.codes))
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Package lapflags decodes the lap flags bitmask iRacing reports for each lap in its lap data.
+package lapflags
+
+// Flags is iRacing's per lap bitmask. The same information is reported in a lap's lap_events strings, but the strings
+// are display text and not something to match against.
+type Flags int
+
+const (
+	Invalid              Flags = 1 << 0
+	Pitted               Flags = 1 << 1
+	OffTrack             Flags = 1 << 2
+	BlackFlag            Flags = 1 << 3
+	CarReset             Flags = 1 << 4
+	Contact              Flags = 1 << 5
+	CarContact           Flags = 1 << 6
+	LostControl          Flags = 1 << 7
+	Discontinuity        Flags = 1 << 8
+	InterpolatedCrossing Flags = 1 << 9
+	ClockSmash           Flags = 1 << 10
+	Tow                  Flags = 1 << 11
+)
+
+const (
+	// Incidents are the flags for something going wrong on track.
+	Incidents = OffTrack | BlackFlag | CarReset | Contact | CarContact | LostControl | Tow
+	// TimingAnomalies are the flags marking a lap time that can't be trusted.
+	TimingAnomalies = Invalid | Discontinuity | InterpolatedCrossing | ClockSmash
+)
+
+var names = []struct {
+	flag Flags
+	name string
+}{
+	{Invalid, "invalid"},
+	{Pitted, "pitted"},
+	{OffTrack, "off_track"},
+	{BlackFlag, "black_flag"},
+	{CarReset, "car_reset"},
+	{Contact, "contact"},
+	{CarContact, "car_contact"},
+	{LostControl, "lost_control"},
+	{Discontinuity, "discontinuity"},
+	{InterpolatedCrossing, "interpolated_crossing"},
+	{ClockSmash, "clock_smash"},
+	{Tow, "tow"},
+}
+
+// Has reports whether any of the flags in mask are set.
+func (f Flags) Has(mask Flags) bool {
+	return f&mask != 0
+}
+
+// Names returns the names of the set flags in bit order. Bits iRacing hasn't documented are ignored. Never nil, so
+// it serializes as an empty list for clean laps.
+func (f Flags) Names() []string {
+	result := make([]string, 0)
+	for _, n := range names {
+		if f.Has(n.flag) {
+			result = append(result, n.name)
+		}
+	}
+	return result
+}
+
+// Decode returns the names of the flags set in a raw lap flags value.
+func Decode(flags int) []string {
+	return Flags(flags).Names()
+}
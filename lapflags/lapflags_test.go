@@ -0,0 +1,67 @@
+package lapflags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		flags    int
+		expected []string
+	}{
+		{
+			name:     "clean lap",
+			flags:    0,
+			expected: []string{},
+		},
+		{
+			name:     "single flag",
+			flags:    4,
+			expected: []string{"off_track"},
+		},
+		{
+			name:     "multiple flags in bit order",
+			flags:    int(Tow | OffTrack | Pitted | Contact),
+			expected: []string{"pitted", "off_track", "contact", "tow"},
+		},
+		{
+			name:     "every flag",
+			flags:    0xFFF,
+			expected: []string{"invalid", "pitted", "off_track", "black_flag", "car_reset", "contact", "car_contact", "lost_control", "discontinuity", "interpolated_crossing", "clock_smash", "tow"},
+		},
+		{
+			name:     "unknown bits ignored",
+			flags:    1<<12 | 1<<20 | int(BlackFlag),
+			expected: []string{"black_flag"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Decode(tc.flags))
+		})
+	}
+}
+
+func TestFlags_Has(t *testing.T) {
+	testCases := []struct {
+		name     string
+		flags    Flags
+		mask     Flags
+		expected bool
+	}{
+		{name: "incident flag", flags: LostControl, mask: Incidents, expected: true},
+		{name: "pitting is not an incident", flags: Pitted, mask: Incidents, expected: false},
+		{name: "timing anomaly", flags: Pitted | ClockSmash, mask: TimingAnomalies, expected: true},
+		{name: "clean lap", flags: 0, mask: Incidents | TimingAnomalies | Pitted, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.flags.Has(tc.mask))
+		})
+	}
+}
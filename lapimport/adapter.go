@@ -0,0 +1,41 @@
+package lapimport
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// Adapter maps a third party lap time service's export format onto external laps.
+type Adapter interface {
+	// Source names the service. It's how imports pick the adapter and is recorded on every lap the adapter produces.
+	Source() string
+	// Parse reads an export of the driver's laps. Problems with the export itself are returned as a *ParseError.
+	Parse(driverID int64, export io.Reader) ([]store.ExternalLap, error)
+}
+
+// ParseError describes why an export couldn't be read. Code and Params follow the conventions of field validation
+// errors so they can be reported back against the upload.
+type ParseError struct {
+	Code   string
+	Params map[string]string
+}
+
+func (e *ParseError) Error() string {
+	if len(e.Params) == 0 {
+		return e.Code
+	}
+	keys := make([]string, 0, len(e.Params))
+	for k := range e.Params {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + e.Params[k]
+	}
+	return fmt.Sprintf("%s (%s)", e.Code, strings.Join(parts, ", "))
+}
@@ -0,0 +1,170 @@
+package lapimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// Garage61Source is the source recorded on laps imported from Garage61.
+const Garage61Source = "garage61"
+
+// garage61Columns maps Garage61's lap export headers, lower cased, to the fields they fill.
+var garage61Columns = map[string]string{
+	"session id": "session",
+	"lap":        "lap",
+	"lap time":   "lap_time",
+	"track id":   "track",
+	"car id":     "car",
+	"driven at":  "driven_at",
+	"clean":      "clean",
+}
+
+// garage61Required are the columns a lap can't be placed without. Clean is optional, laps are taken as clean without it.
+var garage61Required = []string{"session id", "lap", "lap time", "track id", "car id", "driven at"}
+
+// Garage61Adapter reads Garage61's CSV lap export. Garage61 records laps with iRacing's own track and car IDs, so
+// imported laps line up with ingested races without any mapping.
+type Garage61Adapter struct{}
+
+func NewGarage61Adapter() *Garage61Adapter {
+	return &Garage61Adapter{}
+}
+
+func (a *Garage61Adapter) Source() string {
+	return Garage61Source
+}
+
+// Parse reads the export's header row to find its columns, then one lap per row. Lap times are either seconds or
+// minutes:seconds, a blank time or "-" marks a lap without a valid time. Each session starts when its earliest lap was
+// driven.
+func (a *Garage61Adapter) Parse(driverID int64, export io.Reader) ([]store.ExternalLap, error) {
+	reader := csv.NewReader(export)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, &ParseError{Code: "no_laps"}
+	}
+	if err != nil {
+		return nil, csvParseError(err)
+	}
+
+	columns := make(map[string]int)
+	for i, h := range header {
+		name := strings.ToLower(strings.TrimSpace(h))
+		if field, ok := garage61Columns[name]; ok {
+			columns[field] = i
+		}
+	}
+	for _, name := range garage61Required {
+		if _, ok := columns[garage61Columns[name]]; !ok {
+			return nil, &ParseError{Code: "missing_column", Params: map[string]string{"column": name}}
+		}
+	}
+
+	var laps []store.ExternalLap
+	sessionStarts := make(map[string]time.Time)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, csvParseError(err)
+		}
+		line, _ := reader.FieldPos(0)
+		invalid := func(column string) error {
+			return &ParseError{Code: "invalid_value", Params: map[string]string{"line": strconv.Itoa(line), "column": column}}
+		}
+
+		sessionID := strings.TrimSpace(record[columns["session"]])
+		if sessionID == "" {
+			return nil, invalid("session id")
+		}
+		lapNumber, err := strconv.Atoi(strings.TrimSpace(record[columns["lap"]]))
+		if err != nil || lapNumber < 0 {
+			return nil, invalid("lap")
+		}
+		lapTime, err := parseLapTime(record[columns["lap_time"]])
+		if err != nil {
+			return nil, invalid("lap time")
+		}
+		trackID, err := strconv.ParseInt(strings.TrimSpace(record[columns["track"]]), 10, 64)
+		if err != nil {
+			return nil, invalid("track id")
+		}
+		carID, err := strconv.ParseInt(strings.TrimSpace(record[columns["car"]]), 10, 64)
+		if err != nil {
+			return nil, invalid("car id")
+		}
+		drivenAt, err := time.Parse(time.RFC3339, strings.TrimSpace(record[columns["driven_at"]]))
+		if err != nil {
+			return nil, invalid("driven at")
+		}
+		clean := true
+		if i, ok := columns["clean"]; ok && strings.TrimSpace(record[i]) != "" {
+			if clean, err = strconv.ParseBool(strings.TrimSpace(record[i])); err != nil {
+				return nil, invalid("clean")
+			}
+		}
+
+		if start, ok := sessionStarts[sessionID]; !ok || drivenAt.Before(start) {
+			sessionStarts[sessionID] = drivenAt
+		}
+		laps = append(laps, store.ExternalLap{
+			DriverID:  driverID,
+			Source:    Garage61Source,
+			SessionID: sessionID,
+			TrackID:   trackID,
+			CarID:     carID,
+			LapNumber: lapNumber,
+			Incident:  !clean,
+			LapTime:   lapTime,
+		})
+	}
+	if len(laps) == 0 {
+		return nil, &ParseError{Code: "no_laps"}
+	}
+
+	for i := range laps {
+		laps[i].SessionStart = sessionStarts[laps[i].SessionID]
+	}
+	return laps, nil
+}
+
+// parseLapTime converts "95.1234" or "1:35.1234" into iRacing 10ths of milliseconds, -1 for a lap without a time.
+func parseLapTime(raw string) (int, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || raw == "-" {
+		return -1, nil
+	}
+	var minutes float64
+	if before, after, ok := strings.Cut(raw, ":"); ok {
+		m, err := strconv.Atoi(before)
+		if err != nil || m < 0 {
+			return 0, errors.New("invalid minutes")
+		}
+		minutes = float64(m)
+		raw = after
+	}
+	seconds, err := strconv.ParseFloat(raw, 64)
+	if err != nil || seconds < 0 || math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+		return 0, errors.New("invalid seconds")
+	}
+	return int(math.Round((minutes*60 + seconds) * 10000)), nil
+}
+
+func csvParseError(err error) error {
+	var csvErr *csv.ParseError
+	if errors.As(err, &csvErr) {
+		return &ParseError{Code: "invalid_csv", Params: map[string]string{"line": strconv.Itoa(csvErr.Line)}}
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+package lapimport
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGarage61Adapter_Parse(t *testing.T) {
+	sessionStart := time.Date(2024, 1, 20, 18, 0, 0, 0, time.UTC)
+	otherStart := time.Date(2024, 1, 21, 19, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		export        string
+		expected      []store.ExternalLap
+		expectedError *ParseError
+	}{
+		{
+			name: "success",
+			export: `Driven At,Session ID,Track ID,Car ID,Lap,Lap Time,Clean,Fuel Used
+2024-01-20T18:01:35Z,abc,42,7,2,1:35.1234,true,2.4
+2024-01-20T18:00:00Z,abc,42,7,1,95.5,false,2.5
+2024-01-20T18:03:10Z,abc,42,7,3,-,true,2.4
+2024-01-21T19:30:00Z,def,9,7,1,102,,2.6
+`,
+			expected: []store.ExternalLap{
+				{DriverID: 12345, Source: "garage61", SessionID: "abc", SessionStart: sessionStart, TrackID: 42, CarID: 7, LapNumber: 2, LapTime: 951234},
+				{DriverID: 12345, Source: "garage61", SessionID: "abc", SessionStart: sessionStart, TrackID: 42, CarID: 7, LapNumber: 1, Incident: true, LapTime: 955000},
+				{DriverID: 12345, Source: "garage61", SessionID: "abc", SessionStart: sessionStart, TrackID: 42, CarID: 7, LapNumber: 3, LapTime: -1},
+				{DriverID: 12345, Source: "garage61", SessionID: "def", SessionStart: otherStart, TrackID: 9, CarID: 7, LapNumber: 1, LapTime: 1020000},
+			},
+		},
+		{
+			name:          "empty",
+			export:        "",
+			expectedError: &ParseError{Code: "no_laps"},
+		},
+		{
+			name:          "header only",
+			export:        "Driven At,Session ID,Track ID,Car ID,Lap,Lap Time\n",
+			expectedError: &ParseError{Code: "no_laps"},
+		},
+		{
+			name:          "missing column",
+			export:        "Driven At,Session ID,Car ID,Lap,Lap Time\n2024-01-20T18:00:00Z,abc,7,1,95.5\n",
+			expectedError: &ParseError{Code: "missing_column", Params: map[string]string{"column": "track id"}},
+		},
+		{
+			name:          "invalid lap time",
+			export:        "Driven At,Session ID,Track ID,Car ID,Lap,Lap Time\n2024-01-20T18:00:00Z,abc,42,7,1,fast\n",
+			expectedError: &ParseError{Code: "invalid_value", Params: map[string]string{"line": "2", "column": "lap time"}},
+		},
+		{
+			name:          "invalid timestamp",
+			export:        "Driven At,Session ID,Track ID,Car ID,Lap,Lap Time\nyesterday,abc,42,7,1,95.5\n",
+			expectedError: &ParseError{Code: "invalid_value", Params: map[string]string{"line": "2", "column": "driven at"}},
+		},
+		{
+			name:          "missing session",
+			export:        "Driven At,Session ID,Track ID,Car ID,Lap,Lap Time\n2024-01-20T18:00:00Z,,42,7,1,95.5\n",
+			expectedError: &ParseError{Code: "invalid_value", Params: map[string]string{"line": "2", "column": "session id"}},
+		},
+		{
+			name:          "ragged row",
+			export:        "Driven At,Session ID,Track ID,Car ID,Lap,Lap Time\n2024-01-20T18:00:00Z,abc\n",
+			expectedError: &ParseError{Code: "invalid_csv", Params: map[string]string{"line": "2"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			adapter := NewGarage61Adapter()
+			laps, err := adapter.Parse(12345, strings.NewReader(tc.export))
+			if tc.expectedError != nil {
+				var parseErr *ParseError
+				require.True(t, errors.As(err, &parseErr), "expected a *ParseError, got %v", err)
+				assert.Equal(t, tc.expectedError, parseErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, laps)
+		})
+	}
+}
+
+func TestParseLapTime(t *testing.T) {
+	testCases := []struct {
+		raw      string
+		expected int
+		wantErr  bool
+	}{
+		{raw: "95.1234", expected: 951234},
+		{raw: "1:35.1234", expected: 951234},
+		{raw: "12:00", expected: 7200000},
+		{raw: "", expected: -1},
+		{raw: "-", expected: -1},
+		{raw: "x:35", wantErr: true},
+		{raw: "-3", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.raw, func(t *testing.T) {
+			lapTime, err := parseLapTime(tc.raw)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, lapTime)
+		})
+	}
+}
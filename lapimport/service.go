@@ -0,0 +1,65 @@
+package lapimport
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// ErrUnknownSource is returned when importing from a source there's no adapter for.
+var ErrUnknownSource = errors.New("unknown lap import source")
+
+// Store defines the data access methods needed by the lap import service.
+type Store interface {
+	SaveExternalLaps(ctx context.Context, laps []store.ExternalLap) error
+}
+
+// Service imports laps drivers recorded with third party lap time services, so practice done elsewhere counts
+// alongside their races.
+type Service struct {
+	store    Store
+	adapters map[string]Adapter
+}
+
+func NewService(store Store, adapters ...Adapter) *Service {
+	bySource := make(map[string]Adapter, len(adapters))
+	for _, adapter := range adapters {
+		bySource[adapter.Source()] = adapter
+	}
+	return &Service{
+		store:    store,
+		adapters: bySource,
+	}
+}
+
+// ImportResult counts what an import brought in.
+type ImportResult struct {
+	Sessions int
+	Laps     int
+}
+
+// Import parses an export from source and saves its laps against the driver. Laps imported before are overwritten, so
+// the same export can be imported again safely. Returns ErrUnknownSource if there's no adapter for source, or a
+// *ParseError if the export can't be read.
+func (s *Service) Import(ctx context.Context, driverID int64, source string, export io.Reader) (*ImportResult, error) {
+	adapter, ok := s.adapters[source]
+	if !ok {
+		return nil, ErrUnknownSource
+	}
+
+	laps, err := adapter.Parse(driverID, export)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.SaveExternalLaps(ctx, laps); err != nil {
+		return nil, err
+	}
+
+	sessions := make(map[string]struct{})
+	for _, lap := range laps {
+		sessions[lap.SessionID] = struct{}{}
+	}
+	return &ImportResult{Sessions: len(sessions), Laps: len(laps)}, nil
+}
@@ -0,0 +1,91 @@
+package lapimport
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Import(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	laps := []store.ExternalLap{
+		{DriverID: driverID, Source: "garage61", SessionID: "abc", LapNumber: 1},
+		{DriverID: driverID, Source: "garage61", SessionID: "abc", LapNumber: 2},
+		{DriverID: driverID, Source: "garage61", SessionID: "def", LapNumber: 1},
+	}
+
+	testCases := []struct {
+		name          string
+		source        string
+		setupMocks    func(*MockStore, *MockAdapter)
+		expected      *ImportResult
+		expectedErr   error
+		expectedParse bool
+		expectErr     bool
+	}{
+		{
+			name:   "success",
+			source: "garage61",
+			setupMocks: func(s *MockStore, a *MockAdapter) {
+				a.EXPECT().Parse(driverID, mock.Anything).Return(laps, nil)
+				s.EXPECT().SaveExternalLaps(mock.Anything, laps).Return(nil)
+			},
+			expected: &ImportResult{Sessions: 2, Laps: 3},
+		},
+		{
+			name:        "unknown source",
+			source:      "somewhere-else",
+			setupMocks:  func(*MockStore, *MockAdapter) {},
+			expectedErr: ErrUnknownSource,
+		},
+		{
+			name:   "unreadable export",
+			source: "garage61",
+			setupMocks: func(s *MockStore, a *MockAdapter) {
+				a.EXPECT().Parse(driverID, mock.Anything).Return(nil, &ParseError{Code: "no_laps"})
+			},
+			expectedParse: true,
+		},
+		{
+			name:   "save error",
+			source: "garage61",
+			setupMocks: func(s *MockStore, a *MockAdapter) {
+				a.EXPECT().Parse(driverID, mock.Anything).Return(laps, nil)
+				s.EXPECT().SaveExternalLaps(mock.Anything, laps).Return(errors.New("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockAdapter := NewMockAdapter(t)
+			mockAdapter.EXPECT().Source().Return("garage61")
+			tc.setupMocks(mockStore, mockAdapter)
+
+			svc := NewService(mockStore, mockAdapter)
+
+			result, err := svc.Import(ctx, driverID, tc.source, strings.NewReader("export"))
+			switch {
+			case tc.expectedErr != nil:
+				assert.ErrorIs(t, err, tc.expectedErr)
+			case tc.expectedParse:
+				var parseErr *ParseError
+				assert.ErrorAs(t, err, &parseErr)
+			case tc.expectErr:
+				assert.Error(t, err)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
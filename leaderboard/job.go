@@ -0,0 +1,251 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/datachange"
+	"github.com/jonsabados/saturdaysspinout/standings"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const raceWeek = 7 * 24 * time.Hour
+
+// MinRegionDrivers is the fewest drivers a club needs in a week before its totals are saved, so a region's aggregate
+// can't be traced back to one or two people.
+const MinRegionDrivers = 5
+
+// Store defines the data access methods needed by the leaderboard job.
+type Store interface {
+	GetDriverSettingsWithLeaderboardOptIn(ctx context.Context) ([]store.DriverSettings, error)
+	GetWeeklyLeaderboard(ctx context.Context, weekStart time.Time) ([]store.WeeklyLeaderboardEntry, error)
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	SaveRankedLeaderboard(ctx context.Context, board store.RankedLeaderboard, rankings []store.LeaderboardRanking) error
+	SaveRegionWeeklyAggregate(ctx context.Context, aggregate store.RegionWeeklyAggregate) error
+}
+
+type ChangeNotifier interface {
+	Notify(ctx context.Context, driverID int64, resources ...datachange.Resource)
+}
+
+type JobOption func(*Job)
+
+// WithChangeNotifier hints to the connections of every driver on the recomputed boards that the leaderboards changed.
+// Drivers not on a board don't hear about it, there is no way to reach every connected driver.
+func WithChangeNotifier(notifier ChangeNotifier) JobOption {
+	return func(j *Job) {
+		j.changeNotifier = notifier
+	}
+}
+
+// Job computes the weekly leaderboards from the per week rollups, ranking only the drivers that have opted in. It also
+// totals every driver's week by club, for comparing a driver against their region.
+type Job struct {
+	store          Store
+	changeNotifier ChangeNotifier
+	now            func() time.Time
+}
+
+func NewJob(store Store, opts ...JobOption) *Job {
+	j := &Job{
+		store: store,
+		now:   time.Now,
+	}
+	for _, opt := range opts {
+		opt(j)
+	}
+	return j
+}
+
+// standing is an opted in driver's week, before being placed on each board.
+type standing struct {
+	driverID    int64
+	driverName  string
+	clubID      int
+	races       int
+	iRating     int
+	subLevel    int
+	cleanStreak int
+}
+
+// Run recomputes the leaderboards for the current race week and the one before it, so races ingested after a week has
+// rolled over still make it into that week's final standings. Drivers that have opted out since the last run are
+// dropped from both weeks.
+func (j *Job) Run(ctx context.Context) error {
+	logger := zerolog.Ctx(ctx)
+
+	settings, err := j.store.GetDriverSettingsWithLeaderboardOptIn(ctx)
+	if err != nil {
+		return fmt.Errorf("getting opted in drivers: %w", err)
+	}
+	optedIn := make(map[int64]bool, len(settings))
+	for _, s := range settings {
+		optedIn[s.DriverID] = true
+	}
+
+	now := j.now()
+	currentWeek := standings.WeekStart(now)
+	var errs []error
+	var ranked []int64
+	for _, weekStart := range []time.Time{currentWeek.Add(-raceWeek), currentWeek} {
+		driverIDs, err := j.computeWeek(ctx, weekStart, optedIn, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("computing leaderboards for week of %s: %w", weekStart.Format(time.DateOnly), err))
+			continue
+		}
+		ranked = append(ranked, driverIDs...)
+	}
+	j.notifyRanked(ctx, ranked)
+
+	logger.Info().Int("optedInCount", len(optedIn)).Int("failureCount", len(errs)).Msg("weekly leaderboards computed")
+	return errors.Join(errs...)
+}
+
+// computeWeek saves a week's boards, returning the drivers ranked on them.
+func (j *Job) computeWeek(ctx context.Context, weekStart time.Time, optedIn map[int64]bool, computedAt time.Time) ([]int64, error) {
+	entries, err := j.store.GetWeeklyLeaderboard(ctx, weekStart)
+	if err != nil {
+		return nil, fmt.Errorf("getting weekly rollups: %w", err)
+	}
+
+	var week []standing
+	regions := make(map[int]*store.RegionWeeklyAggregate)
+	for _, entry := range entries {
+		driver, err := j.store.GetDriver(ctx, entry.DriverID)
+		if err != nil {
+			return nil, fmt.Errorf("getting driver %d: %w", entry.DriverID, err)
+		}
+		if driver == nil {
+			continue
+		}
+
+		if driver.ClubID != 0 {
+			region, ok := regions[driver.ClubID]
+			if !ok {
+				region = &store.RegionWeeklyAggregate{ClubID: driver.ClubID, WeekStart: weekStart}
+				regions[driver.ClubID] = region
+			}
+			region.Drivers++
+			region.SessionTotals = region.SessionTotals.Add(entry.SessionTotals)
+		}
+
+		if !optedIn[entry.DriverID] {
+			continue
+		}
+		sessions, err := j.store.GetDriverSessionsByTimeRange(ctx, entry.DriverID, weekStart, weekStart.Add(raceWeek-time.Second))
+		if err != nil {
+			return nil, fmt.Errorf("getting sessions for driver %d: %w", entry.DriverID, err)
+		}
+		week = append(week, standing{
+			driverID:    entry.DriverID,
+			driverName:  driver.DriverName,
+			clubID:      driver.ClubID,
+			races:       entry.Races,
+			iRating:     entry.IRatingChange,
+			subLevel:    entry.SubLevelChange,
+			cleanStreak: LongestCleanStreak(sessions),
+		})
+	}
+
+	for _, region := range regions {
+		if region.Drivers < MinRegionDrivers {
+			continue
+		}
+		if err := j.store.SaveRegionWeeklyAggregate(ctx, *region); err != nil {
+			return nil, fmt.Errorf("saving aggregate for club %d: %w", region.ClubID, err)
+		}
+	}
+
+	boards := []struct {
+		name  string
+		value func(s standing) int
+	}{
+		{store.LeaderboardIRatingGain, func(s standing) int { return s.iRating }},
+		{store.LeaderboardSafetyRatingGain, func(s standing) int { return s.subLevel }},
+		{store.LeaderboardCleanRaceStreak, func(s standing) int { return s.cleanStreak }},
+	}
+	for _, board := range boards {
+		leaderboard := store.RankedLeaderboard{
+			Board:      board.name,
+			WeekStart:  weekStart,
+			ComputedAt: computedAt,
+		}
+		if err := j.store.SaveRankedLeaderboard(ctx, leaderboard, rank(week, board.value)); err != nil {
+			return nil, fmt.Errorf("saving %s leaderboard: %w", board.name, err)
+		}
+	}
+
+	driverIDs := make([]int64, len(week))
+	for i, s := range week {
+		driverIDs[i] = s.driverID
+	}
+	return driverIDs, nil
+}
+
+// notifyRanked hints to each ranked driver that the boards changed, once no matter how many weeks they are on.
+func (j *Job) notifyRanked(ctx context.Context, driverIDs []int64) {
+	if j.changeNotifier == nil {
+		return
+	}
+	driverIDs = slices.Clone(driverIDs)
+	slices.Sort(driverIDs)
+	for _, driverID := range slices.Compact(driverIDs) {
+		j.changeNotifier.Notify(ctx, driverID, datachange.ResourceLeaderboards)
+	}
+}
+
+// rank orders drivers by value, highest first. Ties go to the driver with fewer races, then the lower driver ID so
+// recomputing a week doesn't shuffle drivers that are level.
+func rank(week []standing, value func(s standing) int) []store.LeaderboardRanking {
+	sorted := make([]standing, len(week))
+	copy(sorted, week)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if value(a) != value(b) {
+			return value(a) > value(b)
+		}
+		if a.races != b.races {
+			return a.races < b.races
+		}
+		return a.driverID < b.driverID
+	})
+
+	rankings := make([]store.LeaderboardRanking, len(sorted))
+	for i, s := range sorted {
+		rankings[i] = store.LeaderboardRanking{
+			Rank:       i + 1,
+			DriverID:   s.driverID,
+			DriverName: s.driverName,
+			ClubID:     s.clubID,
+			Races:      s.races,
+			Value:      value(s),
+		}
+	}
+	return rankings
+}
+
+// LongestCleanStreak is the most incident free races in a row among sessions, which can be given in any order.
+func LongestCleanStreak(sessions []store.DriverSession) int {
+	sorted := make([]store.DriverSession, len(sessions))
+	copy(sorted, sessions)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].StartTime.Before(sorted[j].StartTime)
+	})
+
+	longest, current := 0, 0
+	for _, session := range sorted {
+		if session.Incidents > 0 {
+			current = 0
+			continue
+		}
+		current++
+		longest = max(longest, current)
+	}
+	return longest
+}
@@ -0,0 +1,148 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/datachange"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestJob_Run(t *testing.T) {
+	// Thursday 2026-01-08, in the race week starting Tuesday 2026-01-06
+	now := time.Date(2026, 1, 8, 12, 0, 0, 0, time.UTC)
+	currentWeek := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+	previousWeek := time.Date(2025, 12, 30, 0, 0, 0, 0, time.UTC)
+	ctx := zerolog.Nop().WithContext(context.Background())
+
+	t.Run("ranks opted in drivers on every board", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriverSettingsWithLeaderboardOptIn(mock.Anything).
+			Return([]store.DriverSettings{{DriverID: 1, LeaderboardOptIn: true}, {DriverID: 2, LeaderboardOptIn: true}}, nil)
+
+		mockStore.EXPECT().GetWeeklyLeaderboard(mock.Anything, previousWeek).Return(nil, nil)
+		for _, board := range []string{store.LeaderboardIRatingGain, store.LeaderboardSafetyRatingGain, store.LeaderboardCleanRaceStreak} {
+			mockStore.EXPECT().SaveRankedLeaderboard(mock.Anything, store.RankedLeaderboard{Board: board, WeekStart: previousWeek, ComputedAt: now}, []store.LeaderboardRanking{}).Return(nil)
+		}
+
+		mockStore.EXPECT().GetWeeklyLeaderboard(mock.Anything, currentWeek).Return([]store.WeeklyLeaderboardEntry{
+			{WeekStart: currentWeek, DriverID: 1, SessionTotals: store.SessionTotals{Races: 3, IRatingChange: 45, SubLevelChange: 60}},
+			{WeekStart: currentWeek, DriverID: 2, SessionTotals: store.SessionTotals{Races: 2, IRatingChange: 90, SubLevelChange: -12}},
+			{WeekStart: currentWeek, DriverID: 3, SessionTotals: store.SessionTotals{Races: 5, IRatingChange: 300, SubLevelChange: 150}},
+		}, nil)
+		// too few drivers in club 7 for its totals to be saved
+		mockStore.EXPECT().GetDriver(mock.Anything, int64(1)).Return(&store.Driver{DriverID: 1, DriverName: "Driver One", ClubID: 7}, nil)
+		mockStore.EXPECT().GetDriver(mock.Anything, int64(2)).Return(&store.Driver{DriverID: 2, DriverName: "Driver Two", ClubID: 7}, nil)
+		mockStore.EXPECT().GetDriver(mock.Anything, int64(3)).Return(&store.Driver{DriverID: 3, DriverName: "Driver Three"}, nil)
+		weekEnd := currentWeek.Add(raceWeek - time.Second)
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, int64(1), currentWeek, weekEnd).Return([]store.DriverSession{
+			{StartTime: currentWeek.Add(3 * time.Hour), Incidents: 0},
+			{StartTime: currentWeek.Add(2 * time.Hour), Incidents: 0},
+			{StartTime: currentWeek.Add(time.Hour), Incidents: 4},
+		}, nil)
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, int64(2), currentWeek, weekEnd).Return([]store.DriverSession{
+			{StartTime: currentWeek.Add(2 * time.Hour), Incidents: 0},
+			{StartTime: currentWeek.Add(time.Hour), Incidents: 0},
+		}, nil)
+
+		mockStore.EXPECT().SaveRankedLeaderboard(mock.Anything, store.RankedLeaderboard{Board: store.LeaderboardIRatingGain, WeekStart: currentWeek, ComputedAt: now}, []store.LeaderboardRanking{
+			{Rank: 1, DriverID: 2, DriverName: "Driver Two", ClubID: 7, Races: 2, Value: 90},
+			{Rank: 2, DriverID: 1, DriverName: "Driver One", ClubID: 7, Races: 3, Value: 45},
+		}).Return(nil)
+		mockStore.EXPECT().SaveRankedLeaderboard(mock.Anything, store.RankedLeaderboard{Board: store.LeaderboardSafetyRatingGain, WeekStart: currentWeek, ComputedAt: now}, []store.LeaderboardRanking{
+			{Rank: 1, DriverID: 1, DriverName: "Driver One", ClubID: 7, Races: 3, Value: 60},
+			{Rank: 2, DriverID: 2, DriverName: "Driver Two", ClubID: 7, Races: 2, Value: -12},
+		}).Return(nil)
+		// level on streak, driver 2 got there in fewer races
+		mockStore.EXPECT().SaveRankedLeaderboard(mock.Anything, store.RankedLeaderboard{Board: store.LeaderboardCleanRaceStreak, WeekStart: currentWeek, ComputedAt: now}, []store.LeaderboardRanking{
+			{Rank: 1, DriverID: 2, DriverName: "Driver Two", ClubID: 7, Races: 2, Value: 2},
+			{Rank: 2, DriverID: 1, DriverName: "Driver One", ClubID: 7, Races: 3, Value: 2},
+		}).Return(nil)
+		// only the drivers on the boards hear about it, once each
+		mockNotifier := NewMockChangeNotifier(t)
+		mockNotifier.EXPECT().Notify(mock.Anything, int64(1), []datachange.Resource{datachange.ResourceLeaderboards}).Return().Once()
+		mockNotifier.EXPECT().Notify(mock.Anything, int64(2), []datachange.Resource{datachange.ResourceLeaderboards}).Return().Once()
+
+		job := NewJob(mockStore, WithChangeNotifier(mockNotifier))
+		job.now = func() time.Time { return now }
+		assert.NoError(t, job.Run(ctx))
+	})
+
+	t.Run("totals clubs with enough drivers, opted in or not", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriverSettingsWithLeaderboardOptIn(mock.Anything).Return(nil, nil)
+		mockStore.EXPECT().GetWeeklyLeaderboard(mock.Anything, previousWeek).Return(nil, nil)
+
+		var entries []store.WeeklyLeaderboardEntry
+		for driverID := int64(1); driverID <= MinRegionDrivers; driverID++ {
+			entries = append(entries, store.WeeklyLeaderboardEntry{
+				WeekStart:     currentWeek,
+				DriverID:      driverID,
+				SessionTotals: store.SessionTotals{Races: 2, Wins: 1, Incidents: 4, IRatingChange: 10},
+			})
+			mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(&store.Driver{DriverID: driverID, ClubID: 7}, nil)
+		}
+		mockStore.EXPECT().GetWeeklyLeaderboard(mock.Anything, currentWeek).Return(entries, nil)
+		mockStore.EXPECT().SaveRegionWeeklyAggregate(mock.Anything, store.RegionWeeklyAggregate{
+			ClubID:        7,
+			WeekStart:     currentWeek,
+			Drivers:       MinRegionDrivers,
+			SessionTotals: store.SessionTotals{Races: 10, Wins: 5, Incidents: 20, IRatingChange: 50},
+		}).Return(nil)
+		mockStore.EXPECT().SaveRankedLeaderboard(mock.Anything, mock.Anything, []store.LeaderboardRanking{}).Return(nil).Times(6)
+
+		job := NewJob(mockStore)
+		job.now = func() time.Time { return now }
+		assert.NoError(t, job.Run(ctx))
+	})
+
+	t.Run("a failing week doesn't stop the other", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriverSettingsWithLeaderboardOptIn(mock.Anything).Return(nil, nil)
+		mockStore.EXPECT().GetWeeklyLeaderboard(mock.Anything, previousWeek).Return(nil, errors.New("boom"))
+		mockStore.EXPECT().GetWeeklyLeaderboard(mock.Anything, currentWeek).Return(nil, nil)
+		mockStore.EXPECT().SaveRankedLeaderboard(mock.Anything, mock.Anything, []store.LeaderboardRanking{}).Return(nil).Times(3)
+
+		job := NewJob(mockStore)
+		job.now = func() time.Time { return now }
+		assert.ErrorContains(t, job.Run(ctx), "week of 2025-12-30")
+	})
+
+	t.Run("opt in lookup error", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriverSettingsWithLeaderboardOptIn(mock.Anything).Return(nil, errors.New("boom"))
+
+		job := NewJob(mockStore)
+		job.now = func() time.Time { return now }
+		assert.Error(t, job.Run(ctx))
+	})
+}
+
+func TestLongestCleanStreak(t *testing.T) {
+	start := time.Unix(1767657600, 0)
+	race := func(hour, incidents int) store.DriverSession {
+		return store.DriverSession{StartTime: start.Add(time.Duration(hour) * time.Hour), Incidents: incidents}
+	}
+
+	testCases := []struct {
+		name     string
+		sessions []store.DriverSession
+		expected int
+	}{
+		{"no races", nil, 0},
+		{"no clean races", []store.DriverSession{race(1, 2), race(2, 4)}, 0},
+		{"streak broken by an incident", []store.DriverSession{race(1, 0), race(2, 0), race(3, 1), race(4, 0)}, 2},
+		{"newest first", []store.DriverSession{race(5, 0), race(4, 0), race(3, 0), race(2, 8), race(1, 0)}, 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, LongestCleanStreak(tc.sessions))
+		})
+	}
+}
@@ -3,6 +3,33 @@ package metrics
 // Metric names
 const (
 	IRacingRateLimitRemaining = "iracing_ratelimit_remaining"
+	IRacingLargePayloadBytes  = "iracing_large_payload_bytes"
 	DriverSessionsIngested    = "driver_sessions_ingested"
 	JournalEntriesCreated     = "journal_entries_created"
+	LapsCompacted             = "laps_compacted"
+	LapsBackfilled            = "laps_backfilled"
+	LapsIngested              = "laps_ingested"
+	LapSessionsIngested       = "lap_sessions_ingested"
+	IngestionRunDuration      = "ingestion_run_duration"
+	IngestionPhaseDuration    = "ingestion_phase_duration"
+	MilestonesRecorded        = "milestones_recorded"
+	LoginFailures             = "login_failures"
+	LoginAttemptsRefused      = "login_attempts_refused"
+	LoginLockouts             = "login_lockouts"
+	APILatency                = "api_latency"
+	WebSocketPushLatency      = "websocket_push_latency"
+	StoreReadItems            = "store_read_items"
+	StoreReadBytes            = "store_read_bytes"
+)
+
+// Dimension names
+const (
+	DimensionPhase       = "Phase"
+	DimensionDriverCount = "DriverCount"
+	DimensionRoute       = "Route"
+	DimensionStatusClass = "StatusClass"
+	DimensionAction      = "Action"
+	DimensionQuery       = "Query"
+	// DimensionTenant slices everything emitted on behalf of a tenant other than the default
+	DimensionTenant = "Tenant"
 )
@@ -0,0 +1,129 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+// rollupDimensions split a metric by endpoint, message type or store query. EMFEmitter also emits every metric without them, giving
+// a series across all endpoints that percentile alarms can watch, which they can't do through a search expression.
+var rollupDimensions = []string{DimensionRoute, DimensionAction, DimensionQuery}
+
+// EMFEmitter writes metrics as CloudWatch embedded metric format log lines rather than calling PutMetricData, so
+// metrics emitted while serving requests don't add a CloudWatch round trip to them. CloudWatch Logs turns the lines
+// into metrics, so out must be shipped there, as stdout is in Lambda.
+type EMFEmitter struct {
+	mu        sync.Mutex
+	out       io.Writer
+	namespace string
+	now       func() time.Time
+}
+
+func NewEMFEmitter(out io.Writer, namespace string) *EMFEmitter {
+	return &EMFEmitter{
+		out:       out,
+		namespace: namespace,
+		now:       time.Now,
+	}
+}
+
+type emfMetadata struct {
+	Timestamp         int64          `json:"Timestamp"`
+	CloudWatchMetrics []emfDirective `json:"CloudWatchMetrics"`
+}
+
+type emfDirective struct {
+	Namespace  string      `json:"Namespace"`
+	Dimensions [][]string  `json:"Dimensions"`
+	Metrics    []emfMetric `json:"Metrics"`
+}
+
+type emfMetric struct {
+	Name string `json:"Name"`
+	Unit string `json:"Unit"`
+}
+
+func (e *EMFEmitter) EmitGauge(ctx context.Context, name string, value float64) error {
+	return e.emit(ctx, name, value, "Count", nil)
+}
+
+func (e *EMFEmitter) EmitCount(ctx context.Context, name string, count int) error {
+	return e.emit(ctx, name, float64(count), "Count", nil)
+}
+
+// EmitDuration records a duration in fractional milliseconds, sliced by the given dimensions (which may be empty).
+func (e *EMFEmitter) EmitDuration(ctx context.Context, name string, duration time.Duration, dimensions map[string]string) error {
+	return e.emit(ctx, name, float64(duration)/float64(time.Millisecond), "Milliseconds", dimensions)
+}
+
+// EmitDimensionedCount records a count sliced by the given dimensions (which may be empty).
+func (e *EMFEmitter) EmitDimensionedCount(ctx context.Context, name string, count int, dimensions map[string]string) error {
+	return e.emit(ctx, name, float64(count), "Count", dimensions)
+}
+
+// EmitBytes records a size in bytes, sliced by the given dimensions (which may be empty).
+func (e *EMFEmitter) EmitBytes(ctx context.Context, name string, bytes int, dimensions map[string]string) error {
+	return e.emit(ctx, name, float64(bytes), "Bytes", dimensions)
+}
+
+func (e *EMFEmitter) emit(ctx context.Context, name string, value float64, unit string, dimensions map[string]string) error {
+	dimensions = withTenant(ctx, dimensions)
+
+	names := make([]string, 0, len(dimensions))
+	for dimension := range dimensions {
+		names = append(names, dimension)
+	}
+	slices.Sort(names)
+	dimensionSets := [][]string{names}
+	if rolledUp := slices.DeleteFunc(slices.Clone(names), func(dimension string) bool {
+		return slices.Contains(rollupDimensions, dimension)
+	}); len(rolledUp) < len(names) {
+		dimensionSets = append(dimensionSets, rolledUp)
+	}
+
+	line := make(map[string]any, len(dimensions)+2)
+	for dimension, dimensionValue := range dimensions {
+		line[dimension] = dimensionValue
+	}
+	line[name] = value
+	line["_aws"] = emfMetadata{
+		Timestamp: e.now().UnixMilli(),
+		CloudWatchMetrics: []emfDirective{
+			{
+				Namespace:  e.namespace,
+				Dimensions: dimensionSets,
+				Metrics:    []emfMetric{{Name: name, Unit: unit}},
+			},
+		},
+	}
+	data, err := json.Marshal(line)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, err = e.out.Write(append(data, '\n'))
+	return err
+}
+
+// withTenant adds the tenant to dimensions for work done on behalf of one other than the default, so each tenant's
+// metrics can be told apart.
+func withTenant(ctx context.Context, dimensions map[string]string) map[string]string {
+	id := tenant.FromContext(ctx)
+	if id == tenant.Default {
+		return dimensions
+	}
+	ret := make(map[string]string, len(dimensions)+1)
+	for dimension, value := range dimensions {
+		ret[dimension] = value
+	}
+	ret[DimensionTenant] = id
+	return ret
+}
@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+func TestEMFEmitter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tenantID string
+		emit     func(ctx context.Context, emitter *EMFEmitter) error
+
+		expectedLine string
+	}{
+		{
+			name: "count without dimensions",
+			emit: func(ctx context.Context, emitter *EMFEmitter) error {
+				return emitter.EmitCount(ctx, LapsIngested, 3)
+			},
+			expectedLine: `{"_aws":{"Timestamp":1700000000000,"CloudWatchMetrics":[{"Namespace":"test","Dimensions":[[]],"Metrics":[{"Name":"laps_ingested","Unit":"Count"}]}]},"laps_ingested":3}`,
+		},
+		{
+			name: "gauge",
+			emit: func(ctx context.Context, emitter *EMFEmitter) error {
+				return emitter.EmitGauge(ctx, IRacingRateLimitRemaining, 10)
+			},
+			expectedLine: `{"_aws":{"Timestamp":1700000000000,"CloudWatchMetrics":[{"Namespace":"test","Dimensions":[[]],"Metrics":[{"Name":"iracing_ratelimit_remaining","Unit":"Count"}]}]},"iracing_ratelimit_remaining":10}`,
+		},
+		{
+			name: "duration keeps fractional milliseconds",
+			emit: func(ctx context.Context, emitter *EMFEmitter) error {
+				return emitter.EmitDuration(ctx, IngestionPhaseDuration, 1500*time.Microsecond, map[string]string{DimensionPhase: "laps"})
+			},
+			expectedLine: `{"Phase":"laps","_aws":{"Timestamp":1700000000000,"CloudWatchMetrics":[{"Namespace":"test","Dimensions":[["Phase"]],"Metrics":[{"Name":"ingestion_phase_duration","Unit":"Milliseconds"}]}]},"ingestion_phase_duration":1.5}`,
+		},
+		{
+			name: "route rolled up",
+			emit: func(ctx context.Context, emitter *EMFEmitter) error {
+				return emitter.EmitDuration(ctx, APILatency, 20*time.Millisecond, map[string]string{
+					DimensionRoute:       "/driver/{driver_id}",
+					DimensionStatusClass: "2xx",
+				})
+			},
+			expectedLine: `{"Route":"/driver/{driver_id}","StatusClass":"2xx","_aws":{"Timestamp":1700000000000,"CloudWatchMetrics":[{"Namespace":"test","Dimensions":[["Route","StatusClass"],["StatusClass"]],"Metrics":[{"Name":"api_latency","Unit":"Milliseconds"}]}]},"api_latency":20}`,
+		},
+		{
+			name: "store read items rolled up by query",
+			emit: func(ctx context.Context, emitter *EMFEmitter) error {
+				return emitter.EmitDimensionedCount(ctx, StoreReadItems, 25, map[string]string{DimensionQuery: "GetDriverSessions"})
+			},
+			expectedLine: `{"Query":"GetDriverSessions","_aws":{"Timestamp":1700000000000,"CloudWatchMetrics":[{"Namespace":"test","Dimensions":[["Query"],[]],"Metrics":[{"Name":"store_read_items","Unit":"Count"}]}]},"store_read_items":25}`,
+		},
+		{
+			name: "bytes",
+			emit: func(ctx context.Context, emitter *EMFEmitter) error {
+				return emitter.EmitBytes(ctx, StoreReadBytes, 2048, map[string]string{DimensionQuery: "GetDriverSessions"})
+			},
+			expectedLine: `{"Query":"GetDriverSessions","_aws":{"Timestamp":1700000000000,"CloudWatchMetrics":[{"Namespace":"test","Dimensions":[["Query"],[]],"Metrics":[{"Name":"store_read_bytes","Unit":"Bytes"}]}]},"store_read_bytes":2048}`,
+		},
+		{
+			name:     "tenant kept in the roll up",
+			tenantID: "league-one",
+			emit: func(ctx context.Context, emitter *EMFEmitter) error {
+				return emitter.EmitDuration(ctx, WebSocketPushLatency, 5*time.Millisecond, map[string]string{DimensionAction: "raceIngested"})
+			},
+			expectedLine: `{"Action":"raceIngested","Tenant":"league-one","_aws":{"Timestamp":1700000000000,"CloudWatchMetrics":[{"Namespace":"test","Dimensions":[["Action","Tenant"],["Tenant"]],"Metrics":[{"Name":"websocket_push_latency","Unit":"Milliseconds"}]}]},"websocket_push_latency":5}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tenant.WithID(context.Background(), tc.tenantID)
+
+			out := &bytes.Buffer{}
+			emitter := NewEMFEmitter(out, "test")
+			emitter.now = func() time.Time { return time.UnixMilli(1700000000000) }
+
+			require.NoError(t, tc.emit(ctx, emitter))
+			assert.Equal(t, tc.expectedLine+"\n", out.String())
+		})
+	}
+}
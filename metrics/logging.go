@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// LoggingEmitter writes metrics to the context logger at debug level instead of CloudWatch, for running locally.
+type LoggingEmitter struct{}
+
+func NewLoggingEmitter() *LoggingEmitter {
+	return &LoggingEmitter{}
+}
+
+func (e *LoggingEmitter) EmitGauge(ctx context.Context, name string, value float64) error {
+	zerolog.Ctx(ctx).Debug().Str("metric", name).Float64("value", value).Msg("gauge")
+	return nil
+}
+
+func (e *LoggingEmitter) EmitCount(ctx context.Context, name string, count int) error {
+	zerolog.Ctx(ctx).Debug().Str("metric", name).Int("count", count).Msg("count")
+	return nil
+}
+
+func (e *LoggingEmitter) EmitDuration(ctx context.Context, name string, duration time.Duration, dimensions map[string]string) error {
+	zerolog.Ctx(ctx).Debug().Str("metric", name).Dur("duration", duration).Interface("dimensions", dimensions).Msg("duration")
+	return nil
+}
+
+func (e *LoggingEmitter) EmitDimensionedCount(ctx context.Context, name string, count int, dimensions map[string]string) error {
+	zerolog.Ctx(ctx).Debug().Str("metric", name).Int("count", count).Interface("dimensions", dimensions).Msg("count")
+	return nil
+}
+
+func (e *LoggingEmitter) EmitBytes(ctx context.Context, name string, bytes int, dimensions map[string]string) error {
+	zerolog.Ctx(ctx).Debug().Str("metric", name).Int("bytes", bytes).Interface("dimensions", dimensions).Msg("bytes")
+	return nil
+}
@@ -0,0 +1,112 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+func TestCloudWatchEmitter_Dimensions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tenantID string
+		emit     func(ctx context.Context, emitter *CloudWatchEmitter) error
+
+		expectedDimensions []types.Dimension
+	}{
+		{
+			name: "default tenant count has no dimensions",
+			emit: func(ctx context.Context, emitter *CloudWatchEmitter) error {
+				return emitter.EmitCount(ctx, LapsIngested, 3)
+			},
+		},
+		{
+			name:     "tenant count",
+			tenantID: "league-one",
+			emit: func(ctx context.Context, emitter *CloudWatchEmitter) error {
+				return emitter.EmitCount(ctx, LapsIngested, 3)
+			},
+			expectedDimensions: []types.Dimension{
+				{Name: aws.String(DimensionTenant), Value: aws.String("league-one")},
+			},
+		},
+		{
+			name:     "tenant gauge",
+			tenantID: "league-one",
+			emit: func(ctx context.Context, emitter *CloudWatchEmitter) error {
+				return emitter.EmitGauge(ctx, IRacingRateLimitRemaining, 10)
+			},
+			expectedDimensions: []types.Dimension{
+				{Name: aws.String(DimensionTenant), Value: aws.String("league-one")},
+			},
+		},
+		{
+			name: "default tenant duration keeps its dimensions",
+			emit: func(ctx context.Context, emitter *CloudWatchEmitter) error {
+				return emitter.EmitDuration(ctx, IngestionPhaseDuration, time.Second, map[string]string{DimensionPhase: "laps"})
+			},
+			expectedDimensions: []types.Dimension{
+				{Name: aws.String(DimensionPhase), Value: aws.String("laps")},
+			},
+		},
+		{
+			name:     "tenant duration",
+			tenantID: "league-one",
+			emit: func(ctx context.Context, emitter *CloudWatchEmitter) error {
+				return emitter.EmitDuration(ctx, IngestionPhaseDuration, time.Second, map[string]string{DimensionPhase: "laps"})
+			},
+			expectedDimensions: []types.Dimension{
+				{Name: aws.String(DimensionPhase), Value: aws.String("laps")},
+				{Name: aws.String(DimensionTenant), Value: aws.String("league-one")},
+			},
+		},
+		{
+			name:     "tenant dimensioned count",
+			tenantID: "league-one",
+			emit: func(ctx context.Context, emitter *CloudWatchEmitter) error {
+				return emitter.EmitDimensionedCount(ctx, StoreReadItems, 25, map[string]string{DimensionQuery: "GetDriverSessions"})
+			},
+			expectedDimensions: []types.Dimension{
+				{Name: aws.String(DimensionQuery), Value: aws.String("GetDriverSessions")},
+				{Name: aws.String(DimensionTenant), Value: aws.String("league-one")},
+			},
+		},
+		{
+			name: "bytes",
+			emit: func(ctx context.Context, emitter *CloudWatchEmitter) error {
+				return emitter.EmitBytes(ctx, StoreReadBytes, 2048, map[string]string{DimensionQuery: "GetDriverSessions"})
+			},
+			expectedDimensions: []types.Dimension{
+				{Name: aws.String(DimensionQuery), Value: aws.String("GetDriverSessions")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tenant.WithID(context.Background(), tc.tenantID)
+
+			client := NewMockCloudWatchClient(t)
+			var input *cloudwatch.PutMetricDataInput
+			client.EXPECT().PutMetricData(mock.Anything, mock.Anything).
+				Run(func(_ context.Context, params *cloudwatch.PutMetricDataInput, _ ...func(*cloudwatch.Options)) {
+					input = params
+				}).
+				Return(&cloudwatch.PutMetricDataOutput{}, nil)
+
+			require.NoError(t, tc.emit(ctx, NewCloudWatchEmitter(client, "test")))
+			require.NotNil(t, input)
+			require.Len(t, input.MetricData, 1)
+			assert.Equal(t, tc.expectedDimensions, input.MetricData[0].Dimensions)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package mobile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+const (
+	// ratingLookback is how far back races are read for the last race and ratings. Categories not raced in this long
+	// are left out of the ratings.
+	ratingLookback = 365 * 24 * time.Hour
+	// promptDays is how far back open journal prompts are counted, matching the prompts endpoint's default.
+	promptDays = 7
+	// MaxPrompts caps the open journal prompts included, the count covers all of them
+	MaxPrompts = 3
+)
+
+// Sync states of a driver's race ingestion
+const (
+	SyncStateNeverSynced = "never_synced"
+	SyncStateSyncing     = "syncing"
+	SyncStateIdle        = "idle"
+)
+
+type Store interface {
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+}
+
+type PromptLister interface {
+	Prompts(ctx context.Context, driverID int64, days int) ([]journal.Prompt, error)
+}
+
+// SyncStatus is where a driver's race ingestion stands.
+type SyncStatus struct {
+	State string
+	// RacesIngestedTo is how far races have been ingested, nil until the first sync completes
+	RacesIngestedTo *time.Time
+	// SyncingUntil is when the running sync's lock lapses, nil unless syncing
+	SyncingUntil *time.Time
+}
+
+// Summary is a compact picture of a driver for a mobile home screen.
+type Summary struct {
+	// LastRace is nil for drivers with no races in the last year
+	LastRace *store.DriverSession
+	// Ratings are ordered by category ID
+	Ratings []analytics.Rating
+	// OpenPrompts are the newest races still waiting on a journal entry, at most MaxPrompts of OpenPromptCount
+	OpenPrompts     []journal.Prompt
+	OpenPromptCount int
+	Sync            SyncStatus
+}
+
+// ErrDriverNotFound is returned when summarizing a driver with no driver record.
+var ErrDriverNotFound = errors.New("driver not found")
+
+// Service assembles mobile summaries from the driver record, their races and their journal prompts, fetched
+// concurrently so the summary takes about as long as the slowest of them.
+type Service struct {
+	store   Store
+	prompts PromptLister
+	now     func() time.Time
+}
+
+func NewService(store Store, prompts PromptLister) *Service {
+	return &Service{
+		store:   store,
+		prompts: prompts,
+		now:     time.Now,
+	}
+}
+
+// Summary builds the driver's mobile summary, failing if any part of it can't be fetched.
+func (s *Service) Summary(ctx context.Context, driverID int64) (*Summary, error) {
+	now := s.now()
+
+	var (
+		driver   *store.Driver
+		sessions []store.DriverSession
+		prompts  []journal.Prompt
+
+		driverErr, sessionsErr, promptsErr error
+	)
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		driver, driverErr = s.store.GetDriver(ctx, driverID)
+	}()
+	go func() {
+		defer wg.Done()
+		sessions, sessionsErr = s.store.GetDriverSessionsByTimeRange(ctx, driverID, now.Add(-ratingLookback), now)
+	}()
+	go func() {
+		defer wg.Done()
+		prompts, promptsErr = s.prompts.Prompts(ctx, driverID, promptDays)
+	}()
+	wg.Wait()
+
+	if driverErr != nil {
+		return nil, fmt.Errorf("getting driver: %w", driverErr)
+	}
+	if sessionsErr != nil {
+		return nil, fmt.Errorf("getting driver sessions: %w", sessionsErr)
+	}
+	if promptsErr != nil {
+		return nil, fmt.Errorf("getting journal prompts: %w", promptsErr)
+	}
+	if driver == nil {
+		return nil, ErrDriverNotFound
+	}
+
+	summary := &Summary{
+		Ratings:         analytics.LatestRatings(sessions),
+		OpenPrompts:     prompts[:min(len(prompts), MaxPrompts)],
+		OpenPromptCount: len(prompts),
+		Sync:            syncStatus(*driver, now),
+	}
+	if len(sessions) > 0 {
+		last := slices.MaxFunc(sessions, func(a, b store.DriverSession) int {
+			return a.StartTime.Compare(b.StartTime)
+		})
+		summary.LastRace = &last
+	}
+	return summary, nil
+}
+
+func syncStatus(driver store.Driver, now time.Time) SyncStatus {
+	status := SyncStatus{State: SyncStateIdle, RacesIngestedTo: driver.RacesIngestedTo}
+	switch {
+	case driver.IngestionBlockedUntil != nil && driver.IngestionBlockedUntil.After(now):
+		status.State = SyncStateSyncing
+		status.SyncingUntil = driver.IngestionBlockedUntil
+	case driver.RacesIngestedTo == nil:
+		status.State = SyncStateNeverSynced
+	}
+	return status
+}
@@ -0,0 +1,129 @@
+package mobile
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Summary(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	from := now.Add(-ratingLookback)
+	ingestedTo := now.Add(-time.Hour)
+	lockedUntil := now.Add(time.Minute)
+	lapsedLock := now.Add(-time.Minute)
+
+	oldRoad := store.DriverSession{DriverID: driverID, StartTime: now.Add(-72 * time.Hour), LicenseCategoryID: 5, NewIRating: 1500, NewLicenseLevel: 14, NewSubLevel: 250}
+	newRoad := store.DriverSession{DriverID: driverID, StartTime: now.Add(-24 * time.Hour), LicenseCategoryID: 5, NewIRating: 1550, NewLicenseLevel: 15, NewSubLevel: 301}
+	oval := store.DriverSession{DriverID: driverID, StartTime: now.Add(-48 * time.Hour), LicenseCategoryID: 1, NewIRating: 1200, NewLicenseLevel: 9, NewSubLevel: 420}
+	// ingested before categories were recorded, so it can't be placed
+	uncategorized := store.DriverSession{DriverID: driverID, StartTime: now.Add(-2 * time.Hour), NewIRating: 9999}
+
+	prompts := []journal.Prompt{
+		{RaceID: 4, Race: &uncategorized},
+		{RaceID: 3, Race: &newRoad},
+		{RaceID: 2, Race: &oval},
+		{RaceID: 1, Race: &oldRoad},
+	}
+
+	testCases := []struct {
+		name        string
+		driver      *store.Driver
+		sessions    []store.DriverSession
+		prompts     []journal.Prompt
+		expected    *Summary
+		expectedErr error
+	}{
+		{
+			name:     "active driver",
+			driver:   &store.Driver{DriverID: driverID, RacesIngestedTo: &ingestedTo, IngestionBlockedUntil: &lapsedLock},
+			sessions: []store.DriverSession{oldRoad, oval, uncategorized, newRoad},
+			prompts:  prompts,
+			expected: &Summary{
+				LastRace: &uncategorized,
+				Ratings: []analytics.Rating{
+					{CategoryID: 1, Category: "oval", IRating: 1200, LicenseLevel: 9, SubLevel: 420, AsOf: oval.StartTime},
+					{CategoryID: 5, Category: "sports_car", IRating: 1550, LicenseLevel: 15, SubLevel: 301, AsOf: newRoad.StartTime},
+				},
+				OpenPrompts:     prompts[:3],
+				OpenPromptCount: 4,
+				Sync:            SyncStatus{State: SyncStateIdle, RacesIngestedTo: &ingestedTo},
+			},
+		},
+		{
+			name:     "syncing",
+			driver:   &store.Driver{DriverID: driverID, RacesIngestedTo: &ingestedTo, IngestionBlockedUntil: &lockedUntil},
+			sessions: []store.DriverSession{oval},
+			prompts:  []journal.Prompt{},
+			expected: &Summary{
+				LastRace: &oval,
+				Ratings: []analytics.Rating{
+					{CategoryID: 1, Category: "oval", IRating: 1200, LicenseLevel: 9, SubLevel: 420, AsOf: oval.StartTime},
+				},
+				OpenPrompts: []journal.Prompt{},
+				Sync:        SyncStatus{State: SyncStateSyncing, RacesIngestedTo: &ingestedTo, SyncingUntil: &lockedUntil},
+			},
+		},
+		{
+			name:     "never synced",
+			driver:   &store.Driver{DriverID: driverID},
+			sessions: []store.DriverSession{},
+			prompts:  []journal.Prompt{},
+			expected: &Summary{
+				Ratings:     []analytics.Rating{},
+				OpenPrompts: []journal.Prompt{},
+				Sync:        SyncStatus{State: SyncStateNeverSynced},
+			},
+		},
+		{
+			name:        "driver not found",
+			sessions:    []store.DriverSession{},
+			prompts:     []journal.Prompt{},
+			expectedErr: ErrDriverNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(tc.driver, nil)
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, now).Return(tc.sessions, nil)
+			mockPrompts := NewMockPromptLister(t)
+			mockPrompts.EXPECT().Prompts(mock.Anything, driverID, promptDays).Return(tc.prompts, nil)
+
+			service := NewService(mockStore, mockPrompts)
+			service.now = func() time.Time { return now }
+
+			summary, err := service.Summary(context.Background(), driverID)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, summary)
+		})
+	}
+
+	t.Run("any part failing fails the summary", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(&store.Driver{DriverID: driverID}, nil)
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, now).Return([]store.DriverSession{}, nil)
+		mockPrompts := NewMockPromptLister(t)
+		mockPrompts.EXPECT().Prompts(mock.Anything, driverID, promptDays).Return(nil, errors.New("boom"))
+
+		service := NewService(mockStore, mockPrompts)
+		service.now = func() time.Time { return now }
+
+		_, err := service.Summary(context.Background(), driverID)
+		assert.Error(t, err)
+	})
+}
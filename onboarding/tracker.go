@@ -0,0 +1,97 @@
+package onboarding
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// Steps are the onboarding steps in the order drivers complete them. Each one can only happen after the one before,
+// a race can't be ingested without an ingestion and a journal entry needs a race to be written about.
+var Steps = []store.OnboardingStep{
+	store.OnboardingStepProfileCreated,
+	store.OnboardingStepIngestionStarted,
+	store.OnboardingStepRaceIngested,
+	store.OnboardingStepJournalEntryCreated,
+}
+
+// maxAdvanceAttempts bounds how many times Advance retries when the step changes underneath it. Each retry means
+// someone else advanced the driver, so running out takes more concurrent transitions than there are steps.
+const maxAdvanceAttempts = 5
+
+// Store defines the data access methods needed to track onboarding.
+type Store interface {
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	AdvanceOnboarding(ctx context.Context, driverID int64, from, to store.OnboardingStep) (bool, error)
+}
+
+// Tracker moves drivers through onboarding as the events behind each step happen.
+type Tracker struct {
+	store Store
+}
+
+func NewTracker(store Store) *Tracker {
+	return &Tracker{
+		store: store,
+	}
+}
+
+// Advance records that the driver has reached step. Steps only ever move forward, so reaching a step the driver is
+// already past does nothing, which lets callers report every occurrence of an event rather than just the first.
+func (t *Tracker) Advance(ctx context.Context, driverID int64, step store.OnboardingStep) error {
+	for range maxAdvanceAttempts {
+		driver, err := t.store.GetDriver(ctx, driverID, store.ConsistentRead())
+		if err != nil {
+			return fmt.Errorf("getting driver: %w", err)
+		}
+		if driver == nil {
+			return nil
+		}
+		if IsReached(CurrentStep(*driver), step) {
+			return nil
+		}
+
+		advanced, err := t.store.AdvanceOnboarding(ctx, driverID, driver.OnboardingStep, step)
+		if err != nil {
+			return fmt.Errorf("advancing onboarding to %s: %w", step, err)
+		}
+		if advanced {
+			return nil
+		}
+	}
+	return fmt.Errorf("onboarding for driver %d kept changing while advancing to %s", driverID, step)
+}
+
+// CurrentStep is the furthest step the driver has reached. Drivers created before onboarding was tracked have no step
+// recorded, and steps are only recorded as their events happen, so the driver record itself is used to fill in what
+// is known to have happened.
+func CurrentStep(driver store.Driver) store.OnboardingStep {
+	current := store.OnboardingStepProfileCreated
+	if driver.RacesIngestedTo != nil {
+		current = store.OnboardingStepIngestionStarted
+	}
+	if driver.SessionCount > 0 {
+		current = store.OnboardingStepRaceIngested
+	}
+	if stepIndex(driver.OnboardingStep) > stepIndex(current) {
+		current = driver.OnboardingStep
+	}
+	return current
+}
+
+// IsComplete reports whether step is the final onboarding step.
+func IsComplete(step store.OnboardingStep) bool {
+	return step == Steps[len(Steps)-1]
+}
+
+// IsReached reports whether a driver at current has completed step.
+func IsReached(current, step store.OnboardingStep) bool {
+	return stepIndex(current) >= stepIndex(step)
+}
+
+// stepIndex orders steps, unknown and empty steps come before all others.
+func stepIndex(step store.OnboardingStep) int {
+	return slices.Index(Steps, step)
+}
@@ -0,0 +1,174 @@
+package onboarding
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTracker_Advance(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	consistentRead := []store.ReadOption{store.ConsistentRead()}
+
+	testCases := []struct {
+		name      string
+		step      store.OnboardingStep
+		setupMock func(*MockStore)
+		expectErr bool
+	}{
+		{
+			name: "advances to the next step",
+			step: store.OnboardingStepIngestionStarted,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriver(mock.Anything, driverID, consistentRead).
+					Return(&store.Driver{DriverID: driverID, OnboardingStep: store.OnboardingStepProfileCreated}, nil)
+				m.EXPECT().AdvanceOnboarding(mock.Anything, driverID, store.OnboardingStepProfileCreated, store.OnboardingStepIngestionStarted).
+					Return(true, nil)
+			},
+		},
+		{
+			name: "skips ahead over steps whose events were missed",
+			step: store.OnboardingStepJournalEntryCreated,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriver(mock.Anything, driverID, consistentRead).
+					Return(&store.Driver{DriverID: driverID, OnboardingStep: store.OnboardingStepIngestionStarted}, nil)
+				m.EXPECT().AdvanceOnboarding(mock.Anything, driverID, store.OnboardingStepIngestionStarted, store.OnboardingStepJournalEntryCreated).
+					Return(true, nil)
+			},
+		},
+		{
+			name: "step already reached",
+			step: store.OnboardingStepRaceIngested,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriver(mock.Anything, driverID, consistentRead).
+					Return(&store.Driver{DriverID: driverID, OnboardingStep: store.OnboardingStepJournalEntryCreated}, nil)
+			},
+		},
+		{
+			name: "step implied by the driver record",
+			step: store.OnboardingStepRaceIngested,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriver(mock.Anything, driverID, consistentRead).
+					Return(&store.Driver{DriverID: driverID, SessionCount: 3}, nil)
+			},
+		},
+		{
+			name: "driver without a recorded step",
+			step: store.OnboardingStepIngestionStarted,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriver(mock.Anything, driverID, consistentRead).
+					Return(&store.Driver{DriverID: driverID}, nil)
+				m.EXPECT().AdvanceOnboarding(mock.Anything, driverID, store.OnboardingStep(""), store.OnboardingStepIngestionStarted).
+					Return(true, nil)
+			},
+		},
+		{
+			name: "retries when the step changes underneath it",
+			step: store.OnboardingStepJournalEntryCreated,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriver(mock.Anything, driverID, consistentRead).
+					Return(&store.Driver{DriverID: driverID, OnboardingStep: store.OnboardingStepIngestionStarted}, nil).Once()
+				m.EXPECT().AdvanceOnboarding(mock.Anything, driverID, store.OnboardingStepIngestionStarted, store.OnboardingStepJournalEntryCreated).
+					Return(false, nil)
+				m.EXPECT().GetDriver(mock.Anything, driverID, consistentRead).
+					Return(&store.Driver{DriverID: driverID, OnboardingStep: store.OnboardingStepRaceIngested}, nil).Once()
+				m.EXPECT().AdvanceOnboarding(mock.Anything, driverID, store.OnboardingStepRaceIngested, store.OnboardingStepJournalEntryCreated).
+					Return(true, nil)
+			},
+		},
+		{
+			name: "gives up when the step keeps changing",
+			step: store.OnboardingStepJournalEntryCreated,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriver(mock.Anything, driverID, consistentRead).
+					Return(&store.Driver{DriverID: driverID, OnboardingStep: store.OnboardingStepProfileCreated}, nil).Times(maxAdvanceAttempts)
+				m.EXPECT().AdvanceOnboarding(mock.Anything, driverID, store.OnboardingStepProfileCreated, store.OnboardingStepJournalEntryCreated).
+					Return(false, nil).Times(maxAdvanceAttempts)
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing driver",
+			step: store.OnboardingStepIngestionStarted,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriver(mock.Anything, driverID, consistentRead).Return(nil, nil)
+			},
+		},
+		{
+			name: "get driver error",
+			step: store.OnboardingStepIngestionStarted,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriver(mock.Anything, driverID, consistentRead).Return(nil, errors.New("db error"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "advance error",
+			step: store.OnboardingStepIngestionStarted,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriver(mock.Anything, driverID, consistentRead).
+					Return(&store.Driver{DriverID: driverID, OnboardingStep: store.OnboardingStepProfileCreated}, nil)
+				m.EXPECT().AdvanceOnboarding(mock.Anything, driverID, store.OnboardingStepProfileCreated, store.OnboardingStepIngestionStarted).
+					Return(false, errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			err := NewTracker(mockStore).Advance(ctx, driverID, tc.step)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCurrentStep(t *testing.T) {
+	ingestedTo := time.Unix(1700000000, 0)
+
+	testCases := []struct {
+		name     string
+		driver   store.Driver
+		expected store.OnboardingStep
+	}{
+		{
+			name:     "recorded step",
+			driver:   store.Driver{OnboardingStep: store.OnboardingStepJournalEntryCreated},
+			expected: store.OnboardingStepJournalEntryCreated,
+		},
+		{
+			name:     "no step recorded",
+			driver:   store.Driver{},
+			expected: store.OnboardingStepProfileCreated,
+		},
+		{
+			name:     "ingested without a recorded step",
+			driver:   store.Driver{RacesIngestedTo: &ingestedTo},
+			expected: store.OnboardingStepIngestionStarted,
+		},
+		{
+			name:     "races ahead of the recorded step",
+			driver:   store.Driver{OnboardingStep: store.OnboardingStepIngestionStarted, RacesIngestedTo: &ingestedTo, SessionCount: 2},
+			expected: store.OnboardingStepRaceIngested,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, CurrentStep(tc.driver))
+		})
+	}
+}
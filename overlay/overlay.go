@@ -0,0 +1,155 @@
+package overlay
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// lookback is how far back races are read for an overlay. Drivers who haven't raced in this long get an overlay with
+// no last race, streak or ratings.
+const lookback = 90 * 24 * time.Hour
+
+// Streak directions
+const (
+	StreakUp   = "up"
+	StreakDown = "down"
+	StreakNone = "none"
+)
+
+// licenseClasses are iRacing's license classes in order, each spanning four license levels
+var licenseClasses = []string{"R", "D", "C", "B", "A", "P"}
+
+// Overlay is what a stream overlay shows, kept flat and ready to display so browser sources can render it without
+// knowing much about iRacing. Its JSON form is what's served, so fields are only ever added to it.
+type Overlay struct {
+	DriverID   int64  `json:"driverId"`
+	DriverName string `json:"driverName"`
+	// Category, IRating, LicenseClass and SafetyRating are as of the driver's last race, in its license category.
+	// Category is empty for races ingested before it was recorded, and all of them are zero values until the driver
+	// has raced.
+	Category     string  `json:"category"`
+	IRating      int     `json:"iRating"`
+	LicenseClass string  `json:"licenseClass"`
+	SafetyRating float64 `json:"safetyRating"`
+	// LastRace is null for drivers with no races in the lookback
+	LastRace  *LastRace `json:"lastRace"`
+	Streak    Streak    `json:"streak"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// LastRace is how the driver's most recent race went. Positions are 1 based.
+type LastRace struct {
+	SubsessionID       int64     `json:"subsessionId"`
+	SeriesName         string    `json:"seriesName"`
+	StartTime          time.Time `json:"startTime"`
+	StartPosition      int       `json:"startPosition"`
+	FinishPosition     int       `json:"finishPosition"`
+	Incidents          int       `json:"incidents"`
+	IRatingChange      int       `json:"iRatingChange"`
+	SafetyRatingChange float64   `json:"safetyRatingChange"`
+}
+
+// Streak is the run of races, ending with the last one, that all moved the driver's iRating the same way. A last race
+// leaving iRating where it was ends any streak, with Direction StreakNone and no races.
+type Streak struct {
+	Direction string `json:"direction"`
+	Races     int    `json:"races"`
+}
+
+// BuildStore defines the data access methods needed to build an overlay.
+type BuildStore interface {
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+}
+
+// build snapshots the driver's overlay as of now, returning ErrDriverNotFound for drivers with no driver record.
+func build(ctx context.Context, buildStore BuildStore, driverID int64, now time.Time) (Overlay, error) {
+	driver, err := buildStore.GetDriver(ctx, driverID)
+	if err != nil {
+		return Overlay{}, fmt.Errorf("getting driver: %w", err)
+	}
+	if driver == nil {
+		return Overlay{}, ErrDriverNotFound
+	}
+	sessions, err := buildStore.GetDriverSessionsByTimeRange(ctx, driverID, now.Add(-lookback), now)
+	if err != nil {
+		return Overlay{}, fmt.Errorf("getting driver sessions: %w", err)
+	}
+
+	ret := Overlay{
+		DriverID:   driverID,
+		DriverName: driver.DriverName,
+		Streak:     Streak{Direction: StreakNone},
+		UpdatedAt:  now.UTC(),
+	}
+	if len(sessions) == 0 {
+		return ret, nil
+	}
+
+	sorted := slices.Clone(sessions)
+	slices.SortFunc(sorted, func(a, b store.DriverSession) int {
+		return b.StartTime.Compare(a.StartTime)
+	})
+	last := sorted[0]
+	ret.Category = analytics.LicenseCategories[last.LicenseCategoryID]
+	ret.IRating = last.NewIRating
+	ret.LicenseClass = licenseClass(last.NewLicenseLevel)
+	ret.SafetyRating = safetyRating(last.NewSubLevel)
+	ret.LastRace = &LastRace{
+		SubsessionID:       last.SubsessionID,
+		SeriesName:         last.SeriesName,
+		StartTime:          last.StartTime.UTC(),
+		StartPosition:      last.StartPosition + 1,
+		FinishPosition:     last.FinishPosition + 1,
+		Incidents:          last.Incidents,
+		IRatingChange:      last.NewIRating - last.OldIRating,
+		SafetyRatingChange: safetyRating(last.NewSubLevel - last.OldSubLevel),
+	}
+	ret.Streak = currentStreak(sorted)
+	return ret, nil
+}
+
+// currentStreak counts back from the newest of sessions, which are ordered newest first.
+func currentStreak(sessions []store.DriverSession) Streak {
+	direction := streakDirection(sessions[0])
+	if direction == StreakNone {
+		return Streak{Direction: StreakNone}
+	}
+	races := 0
+	for _, session := range sessions {
+		if streakDirection(session) != direction {
+			break
+		}
+		races++
+	}
+	return Streak{Direction: direction, Races: races}
+}
+
+func streakDirection(session store.DriverSession) string {
+	switch {
+	case session.NewIRating > session.OldIRating:
+		return StreakUp
+	case session.NewIRating < session.OldIRating:
+		return StreakDown
+	default:
+		return StreakNone
+	}
+}
+
+// licenseClass names the class of an iRacing license level, empty for levels that aren't known.
+func licenseClass(level int) string {
+	if level < 1 {
+		return ""
+	}
+	return licenseClasses[min((level-1)/4, len(licenseClasses)-1)]
+}
+
+// safetyRating is iRacing's sub level as it's displayed, e.g. 301 is 3.01.
+func safetyRating(subLevel int) float64 {
+	return float64(subLevel) / 100
+}
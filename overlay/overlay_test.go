@@ -0,0 +1,156 @@
+package overlay
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	from := now.Add(-lookback)
+
+	loss := store.DriverSession{StartTime: now.Add(-96 * time.Hour), LicenseCategoryID: 5, OldIRating: 1520, NewIRating: 1500}
+	gain := store.DriverSession{StartTime: now.Add(-72 * time.Hour), LicenseCategoryID: 5, OldIRating: 1500, NewIRating: 1530}
+	anotherGain := store.DriverSession{StartTime: now.Add(-48 * time.Hour), LicenseCategoryID: 1, OldIRating: 1180, NewIRating: 1200}
+	last := store.DriverSession{
+		SubsessionID:      98765,
+		SeriesName:        "Global Mazda MX-5 Cup",
+		StartTime:         now.Add(-24 * time.Hour),
+		StartPosition:     7,
+		FinishPosition:    2,
+		Incidents:         4,
+		LicenseCategoryID: 5,
+		OldIRating:        1530,
+		NewIRating:        1562,
+		OldLicenseLevel:   15,
+		NewLicenseLevel:   15,
+		OldSubLevel:       301,
+		NewSubLevel:       287,
+	}
+	// ingested before categories were recorded
+	uncategorized := store.DriverSession{StartTime: now.Add(-time.Hour), OldIRating: 1562, NewIRating: 1562, OldLicenseLevel: 18, NewLicenseLevel: 18, OldSubLevel: 410, NewSubLevel: 410}
+
+	testCases := []struct {
+		name        string
+		driver      *store.Driver
+		sessions    []store.DriverSession
+		expected    Overlay
+		expectedErr error
+	}{
+		{
+			name:     "on a streak",
+			driver:   &store.Driver{DriverID: driverID, DriverName: "Jon Sabados"},
+			sessions: []store.DriverSession{last, loss, anotherGain, gain},
+			expected: Overlay{
+				DriverID:     driverID,
+				DriverName:   "Jon Sabados",
+				Category:     "sports_car",
+				IRating:      1562,
+				LicenseClass: "B",
+				SafetyRating: 2.87,
+				LastRace: &LastRace{
+					SubsessionID:       98765,
+					SeriesName:         "Global Mazda MX-5 Cup",
+					StartTime:          last.StartTime,
+					StartPosition:      8,
+					FinishPosition:     3,
+					Incidents:          4,
+					IRatingChange:      32,
+					SafetyRatingChange: -0.14,
+				},
+				Streak:    Streak{Direction: StreakUp, Races: 3},
+				UpdatedAt: now,
+			},
+		},
+		{
+			name:     "last race left iRating alone",
+			driver:   &store.Driver{DriverID: driverID, DriverName: "Jon Sabados"},
+			sessions: []store.DriverSession{last, uncategorized},
+			expected: Overlay{
+				DriverID:     driverID,
+				DriverName:   "Jon Sabados",
+				IRating:      1562,
+				LicenseClass: "A",
+				SafetyRating: 4.1,
+				LastRace: &LastRace{
+					StartTime:      uncategorized.StartTime,
+					StartPosition:  1,
+					FinishPosition: 1,
+				},
+				Streak:    Streak{Direction: StreakNone},
+				UpdatedAt: now,
+			},
+		},
+		{
+			name:     "no races",
+			driver:   &store.Driver{DriverID: driverID, DriverName: "Jon Sabados"},
+			sessions: []store.DriverSession{},
+			expected: Overlay{
+				DriverID:   driverID,
+				DriverName: "Jon Sabados",
+				Streak:     Streak{Direction: StreakNone},
+				UpdatedAt:  now,
+			},
+		},
+		{
+			name:        "driver not found",
+			expectedErr: ErrDriverNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(tc.driver, nil)
+			if tc.driver != nil {
+				mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, now).Return(tc.sessions, nil)
+			}
+
+			overlay, err := build(context.Background(), mockStore, driverID, now)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, overlay)
+		})
+	}
+
+	t.Run("sessions failing fails the overlay", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(&store.Driver{DriverID: driverID}, nil)
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, now).Return(nil, errors.New("boom"))
+
+		_, err := build(context.Background(), mockStore, driverID, now)
+		assert.Error(t, err)
+	})
+}
+
+func TestLicenseClass(t *testing.T) {
+	testCases := []struct {
+		level    int
+		expected string
+	}{
+		{0, ""},
+		{1, "R"},
+		{4, "R"},
+		{5, "D"},
+		{12, "C"},
+		{13, "B"},
+		{20, "A"},
+		{21, "P"},
+		{30, "P"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, licenseClass(tc.level), "level %d", tc.level)
+	}
+}
@@ -0,0 +1,76 @@
+package overlay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// UpdateAction is the WebSocket action overlays are sent their latest snapshot under, both when subscribing and
+// whenever it is retaken.
+const UpdateAction = "overlayUpdate"
+
+// RefresherStore defines the data access methods needed to refresh a driver's overlay.
+type RefresherStore interface {
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	GetOverlay(ctx context.Context, driverID int64) (*store.Overlay, error)
+	RefreshOverlay(ctx context.Context, token, snapshot string, refreshedAt time.Time) (bool, error)
+}
+
+type Pusher interface {
+	BroadcastOverlay(ctx context.Context, driverID int64, token string, actionType string, payload any) error
+}
+
+// Refresher retakes the snapshot of a driver's overlay, run once an ingestion has caught up so the overlay reflects
+// every race from it, and pushes it to any overlays subscribed over the WebSocket API.
+type Refresher struct {
+	store  RefresherStore
+	pusher Pusher
+	now    func() time.Time
+}
+
+func NewRefresher(store RefresherStore, pusher Pusher) *Refresher {
+	return &Refresher{
+		store:  store,
+		pusher: pusher,
+		now:    time.Now,
+	}
+}
+
+// RefreshDriver retakes the snapshot of the driver's overlay, doing nothing for drivers who haven't set one up.
+func (r *Refresher) RefreshDriver(ctx context.Context, driverID int64) error {
+	existing, err := r.store.GetOverlay(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("getting overlay: %w", err)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	now := r.now()
+	overlay, err := build(ctx, r.store, driverID, now)
+	if err != nil {
+		return err
+	}
+	snapshot, err := json.Marshal(overlay)
+	if err != nil {
+		return fmt.Errorf("marshaling overlay: %w", err)
+	}
+	refreshed, err := r.store.RefreshOverlay(ctx, existing.Token, string(snapshot), now)
+	if err != nil {
+		return fmt.Errorf("refreshing overlay: %w", err)
+	}
+	if !refreshed {
+		zerolog.Ctx(ctx).Debug().Int64("driverId", driverID).Msg("overlay replaced or revoked while refreshing")
+		return nil
+	}
+	if err := r.pusher.BroadcastOverlay(ctx, driverID, existing.Token, UpdateAction, overlay); err != nil {
+		return fmt.Errorf("pushing overlay: %w", err)
+	}
+	return nil
+}
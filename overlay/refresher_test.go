@@ -0,0 +1,63 @@
+package overlay
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefresher_RefreshDriver(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	expectedOverlay := Overlay{DriverID: driverID, DriverName: "Jon Sabados", Streak: Streak{Direction: StreakNone}, UpdatedAt: now}
+	expectedSnapshot := `{"driverId":12345,"driverName":"Jon Sabados","category":"","iRating":0,"licenseClass":"","safetyRating":0,"lastRace":null,"streak":{"direction":"none","races":0},"updatedAt":"2025-06-15T12:00:00Z"}`
+
+	testCases := []struct {
+		name         string
+		refreshed    bool
+		expectedPush bool
+	}{
+		{name: "refreshed", refreshed: true, expectedPush: true},
+		// revoked between reading the overlay and refreshing it, so there is nobody to push to
+		{name: "revoked meanwhile", refreshed: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockRefresherStore(t)
+			mockStore.EXPECT().GetOverlay(mock.Anything, driverID).Return(&store.Overlay{DriverID: driverID, Token: "tok"}, nil)
+			mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(&store.Driver{DriverID: driverID, DriverName: "Jon Sabados"}, nil)
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return([]store.DriverSession{}, nil)
+			mockStore.EXPECT().RefreshOverlay(mock.Anything, "tok", expectedSnapshot, now).Return(tc.refreshed, nil)
+			mockPusher := NewMockPusher(t)
+			if tc.expectedPush {
+				mockPusher.EXPECT().BroadcastOverlay(mock.Anything, driverID, "tok", UpdateAction, expectedOverlay).Return(nil)
+			}
+
+			refresher := NewRefresher(mockStore, mockPusher)
+			refresher.now = func() time.Time { return now }
+
+			require.NoError(t, refresher.RefreshDriver(context.Background(), driverID))
+		})
+	}
+
+	t.Run("not set up", func(t *testing.T) {
+		mockStore := NewMockRefresherStore(t)
+		mockStore.EXPECT().GetOverlay(mock.Anything, driverID).Return(nil, nil)
+
+		require.NoError(t, NewRefresher(mockStore, NewMockPusher(t)).RefreshDriver(context.Background(), driverID))
+	})
+
+	t.Run("store failing", func(t *testing.T) {
+		mockStore := NewMockRefresherStore(t)
+		mockStore.EXPECT().GetOverlay(mock.Anything, driverID).Return(nil, errors.New("boom"))
+
+		assert.Error(t, NewRefresher(mockStore, NewMockPusher(t)).RefreshDriver(context.Background(), driverID))
+	})
+}
@@ -0,0 +1,159 @@
+package overlay
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// tokenBytes is how much randomness goes into a token, which is all that stands between an overlay and the public
+const tokenBytes = 32
+
+var (
+	// ErrDriverNotFound is returned when setting up an overlay for a driver with no driver record.
+	ErrDriverNotFound = errors.New("driver not found")
+	// ErrNotSetUp is returned when a driver has no overlay, or no overlay is served with a token.
+	ErrNotSetUp = errors.New("overlay not set up")
+)
+
+type Store interface {
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	GetOverlay(ctx context.Context, driverID int64) (*store.Overlay, error)
+	GetOverlayByToken(ctx context.Context, token string) (*store.Overlay, error)
+	SaveOverlay(ctx context.Context, overlay store.Overlay, replacing string) error
+	DeleteOverlay(ctx context.Context, driverID int64, token string) error
+}
+
+// Issued is a driver's overlay along with the token it is served with.
+type Issued struct {
+	Token       string
+	Overlay     Overlay
+	CreatedAt   time.Time
+	RefreshedAt time.Time
+}
+
+// Service manages drivers' stream overlays. Overlays are snapshots, taken when set up and retaken by the Refresher
+// after each ingestion, so serving one is a single read however often browser sources poll it.
+type Service struct {
+	store    Store
+	now      func() time.Time
+	newToken func() (string, error)
+}
+
+func NewService(store Store) *Service {
+	return &Service{
+		store:    store,
+		now:      time.Now,
+		newToken: randomToken,
+	}
+}
+
+// Get returns the driver's overlay, ErrNotSetUp if they haven't set one up.
+func (s *Service) Get(ctx context.Context, driverID int64) (*Issued, error) {
+	stored, err := s.store.GetOverlay(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting overlay: %w", err)
+	}
+	if stored == nil {
+		return nil, ErrNotSetUp
+	}
+	return issuedFromStore(*stored)
+}
+
+// Issue takes a fresh snapshot of the driver's overlay and serves it under a new token. Any overlay they already set up
+// is replaced, so issuing again is how a token that leaked on stream is revoked without taking the overlay down.
+func (s *Service) Issue(ctx context.Context, driverID int64) (*Issued, error) {
+	now := s.now()
+	overlay, err := build(ctx, s.store, driverID, now)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := json.Marshal(overlay)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling overlay: %w", err)
+	}
+	token, err := s.newToken()
+	if err != nil {
+		return nil, fmt.Errorf("generating token: %w", err)
+	}
+
+	existing, err := s.store.GetOverlay(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting overlay: %w", err)
+	}
+	var replacing string
+	if existing != nil {
+		replacing = existing.Token
+	}
+
+	stored := store.Overlay{
+		DriverID:    driverID,
+		Token:       token,
+		Snapshot:    string(snapshot),
+		CreatedAt:   now,
+		RefreshedAt: now,
+	}
+	if err := s.store.SaveOverlay(ctx, stored, replacing); err != nil {
+		return nil, fmt.Errorf("saving overlay: %w", err)
+	}
+	return &Issued{Token: token, Overlay: overlay, CreatedAt: now, RefreshedAt: now}, nil
+}
+
+// Revoke takes down the driver's overlay, its token no longer working. Revoking when there is no overlay does nothing.
+func (s *Service) Revoke(ctx context.Context, driverID int64) error {
+	existing, err := s.store.GetOverlay(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("getting overlay: %w", err)
+	}
+	if existing == nil {
+		return nil
+	}
+	if err := s.store.DeleteOverlay(ctx, driverID, existing.Token); err != nil {
+		return fmt.Errorf("deleting overlay: %w", err)
+	}
+	return nil
+}
+
+// Fetch returns the overlay served with token, ErrNotSetUp for tokens with no overlay.
+func (s *Service) Fetch(ctx context.Context, token string) (*Overlay, error) {
+	stored, err := s.store.GetOverlayByToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("getting overlay: %w", err)
+	}
+	if stored == nil {
+		return nil, ErrNotSetUp
+	}
+	issued, err := issuedFromStore(*stored)
+	if err != nil {
+		return nil, err
+	}
+	return &issued.Overlay, nil
+}
+
+func issuedFromStore(stored store.Overlay) (*Issued, error) {
+	var overlay Overlay
+	if err := json.Unmarshal([]byte(stored.Snapshot), &overlay); err != nil {
+		return nil, fmt.Errorf("unmarshaling overlay: %w", err)
+	}
+	return &Issued{
+		Token:       stored.Token,
+		Overlay:     overlay,
+		CreatedAt:   stored.CreatedAt,
+		RefreshedAt: stored.RefreshedAt,
+	}, nil
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
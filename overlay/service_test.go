@@ -0,0 +1,142 @@
+package overlay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSnapshot = `{"driverId":12345,"driverName":"Jon Sabados","category":"","iRating":0,"licenseClass":"","safetyRating":0,"lastRace":null,"streak":{"direction":"none","races":0},"updatedAt":"2025-06-15T12:00:00Z"}`
+
+func TestService_Get(t *testing.T) {
+	driverID := int64(12345)
+	createdAt := time.Unix(1000, 0)
+	refreshedAt := time.Unix(2000, 0)
+
+	t.Run("set up", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetOverlay(mock.Anything, driverID).Return(&store.Overlay{
+			DriverID:    driverID,
+			Token:       "tok",
+			Snapshot:    testSnapshot,
+			CreatedAt:   createdAt,
+			RefreshedAt: refreshedAt,
+		}, nil)
+
+		issued, err := NewService(mockStore).Get(context.Background(), driverID)
+		require.NoError(t, err)
+		assert.Equal(t, &Issued{
+			Token:       "tok",
+			Overlay:     Overlay{DriverID: driverID, DriverName: "Jon Sabados", Streak: Streak{Direction: StreakNone}, UpdatedAt: time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)},
+			CreatedAt:   createdAt,
+			RefreshedAt: refreshedAt,
+		}, issued)
+	})
+
+	t.Run("not set up", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetOverlay(mock.Anything, driverID).Return(nil, nil)
+
+		_, err := NewService(mockStore).Get(context.Background(), driverID)
+		assert.ErrorIs(t, err, ErrNotSetUp)
+	})
+}
+
+func TestService_Issue(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	expectedOverlay := Overlay{DriverID: driverID, DriverName: "Jon Sabados", Streak: Streak{Direction: StreakNone}, UpdatedAt: now}
+
+	testCases := []struct {
+		name              string
+		existing          *store.Overlay
+		expectedReplacing string
+	}{
+		{
+			name: "first overlay",
+		},
+		{
+			name:              "replacing an overlay",
+			existing:          &store.Overlay{DriverID: driverID, Token: "old"},
+			expectedReplacing: "old",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(&store.Driver{DriverID: driverID, DriverName: "Jon Sabados"}, nil)
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return([]store.DriverSession{}, nil)
+			mockStore.EXPECT().GetOverlay(mock.Anything, driverID).Return(tc.existing, nil)
+			mockStore.EXPECT().SaveOverlay(mock.Anything, store.Overlay{
+				DriverID:    driverID,
+				Token:       "new",
+				Snapshot:    testSnapshot,
+				CreatedAt:   now,
+				RefreshedAt: now,
+			}, tc.expectedReplacing).Return(nil)
+
+			service := NewService(mockStore)
+			service.now = func() time.Time { return now }
+			service.newToken = func() (string, error) { return "new", nil }
+
+			issued, err := service.Issue(context.Background(), driverID)
+			require.NoError(t, err)
+			assert.Equal(t, &Issued{Token: "new", Overlay: expectedOverlay, CreatedAt: now, RefreshedAt: now}, issued)
+		})
+	}
+
+	t.Run("driver not found", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(nil, nil)
+
+		service := NewService(mockStore)
+		service.now = func() time.Time { return now }
+
+		_, err := service.Issue(context.Background(), driverID)
+		assert.ErrorIs(t, err, ErrDriverNotFound)
+	})
+}
+
+func TestService_Revoke(t *testing.T) {
+	driverID := int64(12345)
+
+	t.Run("set up", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetOverlay(mock.Anything, driverID).Return(&store.Overlay{DriverID: driverID, Token: "tok"}, nil)
+		mockStore.EXPECT().DeleteOverlay(mock.Anything, driverID, "tok").Return(nil)
+
+		require.NoError(t, NewService(mockStore).Revoke(context.Background(), driverID))
+	})
+
+	t.Run("not set up", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetOverlay(mock.Anything, driverID).Return(nil, nil)
+
+		require.NoError(t, NewService(mockStore).Revoke(context.Background(), driverID))
+	})
+}
+
+func TestService_Fetch(t *testing.T) {
+	t.Run("set up", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetOverlayByToken(mock.Anything, "tok").Return(&store.Overlay{DriverID: 12345, Token: "tok", Snapshot: testSnapshot}, nil)
+
+		overlay, err := NewService(mockStore).Fetch(context.Background(), "tok")
+		require.NoError(t, err)
+		assert.Equal(t, &Overlay{DriverID: 12345, DriverName: "Jon Sabados", Streak: Streak{Direction: StreakNone}, UpdatedAt: time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)}, overlay)
+	})
+
+	t.Run("not set up", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetOverlayByToken(mock.Anything, "nope").Return(nil, nil)
+
+		_, err := NewService(mockStore).Fetch(context.Background(), "nope")
+		assert.ErrorIs(t, err, ErrNotSetUp)
+	})
+}
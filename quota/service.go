@@ -0,0 +1,178 @@
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/supporter"
+)
+
+// Operation is something expensive enough that drivers get a daily quota of it.
+type Operation string
+
+const (
+	// OperationIRacingProxy is a request answered by calling iRacing on the driver's behalf.
+	OperationIRacingProxy Operation = "iracing_proxy"
+	// OperationExport is an export pack of the driver's races.
+	OperationExport Operation = "export"
+	// OperationLapIngest is pulling a session's laps from iRacing again.
+	OperationLapIngest Operation = "lap_ingest"
+)
+
+// Operations lists every quota limited operation, in the order usage is reported.
+var Operations = []Operation{OperationIRacingProxy, OperationExport, OperationLapIngest}
+
+// DefaultTier is the tier every driver gets, whatever their entitlements.
+const DefaultTier = "default"
+
+// Limits are how many times per UTC day each operation may run. Operations left out are unlimited.
+type Limits map[Operation]int
+
+// DefaultTierLimits are the limits used unless others are configured, keyed by the entitlement that grants them or
+// DefaultTier.
+var DefaultTierLimits = map[string]Limits{
+	DefaultTier: {
+		OperationIRacingProxy: 200,
+		OperationExport:       5,
+		OperationLapIngest:    20,
+	},
+	supporter.Entitlement: {
+		OperationIRacingProxy: 1000,
+		OperationExport:       50,
+		OperationLapIngest:    200,
+	},
+}
+
+// ParseTierLimits reads tier limits from JSON like {"default":{"iracing_proxy":200},"supporter":{"iracing_proxy":1000}}.
+func ParseTierLimits(raw string) (map[string]Limits, error) {
+	var tiers map[string]Limits
+	if err := json.Unmarshal([]byte(raw), &tiers); err != nil {
+		return nil, fmt.Errorf("parsing quota tiers: %w", err)
+	}
+	for tier, limits := range tiers {
+		for operation, limit := range limits {
+			if !slices.Contains(Operations, operation) {
+				return nil, fmt.Errorf("quota tier %s has unknown operation %s", tier, operation)
+			}
+			if limit < 0 {
+				return nil, fmt.Errorf("quota tier %s has negative limit for %s", tier, operation)
+			}
+		}
+	}
+	return tiers, nil
+}
+
+type Store interface {
+	GetQuotaUsage(ctx context.Context, driverID int64, day time.Time) (*store.QuotaUsage, error)
+	ConsumeQuota(ctx context.Context, driverID int64, day time.Time, operation string, limit int) (bool, error)
+}
+
+// Decision is the outcome of trying to use an operation.
+type Decision struct {
+	Allowed bool
+	// Limit is the driver's daily limit for the operation, meaningless when Unlimited.
+	Limit     int
+	Unlimited bool
+	ResetsAt  time.Time
+}
+
+// Usage is how much of one operation's quota a driver has used today.
+type Usage struct {
+	Operation Operation
+	Used      int
+	Limit     int
+	Unlimited bool
+}
+
+type Service struct {
+	store Store
+	tiers map[string]Limits
+	now   func() time.Time
+}
+
+type Option func(*Service)
+
+// WithTierLimits replaces DefaultTierLimits.
+func WithTierLimits(tiers map[string]Limits) Option {
+	return func(s *Service) {
+		s.tiers = tiers
+	}
+}
+
+func NewService(store Store, opts ...Option) *Service {
+	s := &Service{
+		store: store,
+		tiers: DefaultTierLimits,
+		now:   time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// LimitsFor returns the limits for a driver holding the given entitlements, the most generous limit for each operation
+// across the default tier and every tier they're entitled to.
+func (s *Service) LimitsFor(entitlements []string) Limits {
+	limits := Limits{}
+	for tier, tierLimits := range s.tiers {
+		if tier != DefaultTier && !slices.Contains(entitlements, tier) {
+			continue
+		}
+		for operation, limit := range tierLimits {
+			limits[operation] = max(limits[operation], limit)
+		}
+	}
+	return limits
+}
+
+// Consume counts one use of operation against the driver's quota for today, refusing it once the quota is used up.
+func (s *Service) Consume(ctx context.Context, driverID int64, entitlements []string, operation Operation) (*Decision, error) {
+	day := s.today()
+	decision := &Decision{ResetsAt: day.AddDate(0, 0, 1)}
+
+	limit, limited := s.LimitsFor(entitlements)[operation]
+	if !limited {
+		decision.Allowed = true
+		decision.Unlimited = true
+		return decision, nil
+	}
+	decision.Limit = limit
+
+	allowed, err := s.store.ConsumeQuota(ctx, driverID, day, string(operation), limit)
+	if err != nil {
+		return nil, fmt.Errorf("consuming %s quota: %w", operation, err)
+	}
+	decision.Allowed = allowed
+	return decision, nil
+}
+
+// Usage reports what the driver has used of each quota today, along with when the quotas reset.
+func (s *Service) Usage(ctx context.Context, driverID int64, entitlements []string) ([]Usage, time.Time, error) {
+	day := s.today()
+	usage, err := s.store.GetQuotaUsage(ctx, driverID, day)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("getting quota usage: %w", err)
+	}
+
+	limits := s.LimitsFor(entitlements)
+	ret := make([]Usage, len(Operations))
+	for i, operation := range Operations {
+		limit, limited := limits[operation]
+		ret[i] = Usage{
+			Operation: operation,
+			Used:      usage.Counts[string(operation)],
+			Limit:     limit,
+			Unlimited: !limited,
+		}
+	}
+	return ret, day.AddDate(0, 0, 1), nil
+}
+
+func (s *Service) today() time.Time {
+	return s.now().UTC().Truncate(24 * time.Hour)
+}
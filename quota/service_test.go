@@ -0,0 +1,142 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testTiers = map[string]Limits{
+	DefaultTier: {OperationIRacingProxy: 10, OperationLapIngest: 2},
+	"supporter": {OperationIRacingProxy: 100},
+}
+
+func TestService_LimitsFor(t *testing.T) {
+	svc := NewService(NewMockStore(t), WithTierLimits(testTiers))
+
+	assert.Equal(t, Limits{OperationIRacingProxy: 10, OperationLapIngest: 2}, svc.LimitsFor(nil))
+	assert.Equal(t, Limits{OperationIRacingProxy: 100, OperationLapIngest: 2}, svc.LimitsFor([]string{"developer", "supporter"}))
+}
+
+func TestService_Consume(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Date(2024, 3, 10, 15, 30, 0, 0, time.UTC)
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	resetsAt := time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)
+
+	type consumeCall struct {
+		limit   int
+		allowed bool
+		err     error
+	}
+
+	testCases := []struct {
+		name         string
+		entitlements []string
+		operation    Operation
+		consumeCall  *consumeCall
+		expected     *Decision
+		expectedErr  bool
+	}{
+		{
+			name:        "within quota",
+			operation:   OperationIRacingProxy,
+			consumeCall: &consumeCall{limit: 10, allowed: true},
+			expected:    &Decision{Allowed: true, Limit: 10, ResetsAt: resetsAt},
+		},
+		{
+			name:         "entitlement raises the limit",
+			entitlements: []string{"supporter"},
+			operation:    OperationIRacingProxy,
+			consumeCall:  &consumeCall{limit: 100, allowed: true},
+			expected:     &Decision{Allowed: true, Limit: 100, ResetsAt: resetsAt},
+		},
+		{
+			name:        "quota used up",
+			operation:   OperationLapIngest,
+			consumeCall: &consumeCall{limit: 2, allowed: false},
+			expected:    &Decision{Allowed: false, Limit: 2, ResetsAt: resetsAt},
+		},
+		{
+			name:      "unlimited operation skips the store",
+			operation: OperationExport,
+			expected:  &Decision{Allowed: true, Unlimited: true, ResetsAt: resetsAt},
+		},
+		{
+			name:        "store error",
+			operation:   OperationIRacingProxy,
+			consumeCall: &consumeCall{limit: 10, err: errors.New("boom")},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			if tc.consumeCall != nil {
+				mockStore.EXPECT().ConsumeQuota(mock.Anything, driverID, day, string(tc.operation), tc.consumeCall.limit).
+					Return(tc.consumeCall.allowed, tc.consumeCall.err)
+			}
+
+			svc := NewService(mockStore, WithTierLimits(testTiers))
+			svc.now = func() time.Time { return now }
+
+			decision, err := svc.Consume(context.Background(), driverID, tc.entitlements, tc.operation)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, decision)
+		})
+	}
+}
+
+func TestService_Usage(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Date(2024, 3, 10, 15, 30, 0, 0, time.UTC)
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetQuotaUsage(mock.Anything, driverID, day).Return(&store.QuotaUsage{
+		DriverID: driverID,
+		Day:      day,
+		Counts:   map[string]int{"iracing_proxy": 7, "export": 1},
+	}, nil)
+
+	svc := NewService(mockStore, WithTierLimits(testTiers))
+	svc.now = func() time.Time { return now }
+
+	usage, resetsAt, err := svc.Usage(context.Background(), driverID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []Usage{
+		{Operation: OperationIRacingProxy, Used: 7, Limit: 10},
+		{Operation: OperationExport, Used: 1, Unlimited: true},
+		{Operation: OperationLapIngest, Used: 0, Limit: 2},
+	}, usage)
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), resetsAt)
+}
+
+func TestParseTierLimits(t *testing.T) {
+	tiers, err := ParseTierLimits(`{"default":{"iracing_proxy":5},"supporter":{"iracing_proxy":50,"export":10}}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Limits{
+		DefaultTier: {OperationIRacingProxy: 5},
+		"supporter": {OperationIRacingProxy: 50, OperationExport: 10},
+	}, tiers)
+
+	_, err = ParseTierLimits(`{"default":{"bogus":5}}`)
+	assert.Error(t, err)
+
+	_, err = ParseTierLimits(`{"default":{"export":-1}}`)
+	assert.Error(t, err)
+
+	_, err = ParseTierLimits(`nope`)
+	assert.Error(t, err)
+}
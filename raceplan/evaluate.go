@@ -0,0 +1,89 @@
+package raceplan
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+var (
+	topPattern       = regexp.MustCompile(`(?i)\btop\s*(\d{1,2})\b`)
+	podiumPattern    = regexp.MustCompile(`(?i)\bpodium\b`)
+	winPattern       = regexp.MustCompile(`(?i)\bwin\b`)
+	incidentsPattern = regexp.MustCompile(`(?i)\b(under|below|less than|fewer than|max|at most)\s+(\d{1,2})\s*(?:x|incs?|incidents?)\b`)
+	cleanPattern     = regexp.MustCompile(`(?i)\b(?:clean race|0x)\b`)
+)
+
+// Evaluate compares the targets in a plan's goals with how a race went, nil when the goals don't name anything it can
+// measure. Goals are free text, so only common phrasings are picked up: "top 10", "podium" or "win" for where the
+// driver finished in class, and "under 4 incidents", "max 4x" or "clean race" for incidents. When goals name several
+// targets for the same thing the most ambitious one counts.
+func Evaluate(goals string, session store.DriverSession, evaluatedAt time.Time) *store.PlanEvaluation {
+	var targets []store.PlanTarget
+	if position, ok := finishTarget(goals); ok {
+		actual := session.FinishPositionInClass + 1
+		targets = append(targets, store.PlanTarget{
+			Kind:   store.PlanTargetFinishPosition,
+			Target: position,
+			Actual: actual,
+			Met:    actual <= position,
+		})
+	}
+	if incidents, ok := incidentsTarget(goals); ok {
+		targets = append(targets, store.PlanTarget{
+			Kind:   store.PlanTargetIncidents,
+			Target: incidents,
+			Actual: session.Incidents,
+			Met:    session.Incidents <= incidents,
+		})
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	return &store.PlanEvaluation{
+		Targets:     targets,
+		EvaluatedAt: evaluatedAt,
+	}
+}
+
+// finishTarget returns the worst finishing position, 1-based, the goals allow for.
+func finishTarget(goals string) (int, bool) {
+	best := 0
+	for _, match := range topPattern.FindAllStringSubmatch(goals, -1) {
+		position, _ := strconv.Atoi(match[1])
+		if position > 0 && (best == 0 || position < best) {
+			best = position
+		}
+	}
+	if podiumPattern.MatchString(goals) && (best == 0 || best > 3) {
+		best = 3
+	}
+	if winPattern.MatchString(goals) {
+		best = 1
+	}
+	return best, best > 0
+}
+
+// incidentsTarget returns the most incidents the goals allow for.
+func incidentsTarget(goals string) (int, bool) {
+	best := -1
+	if cleanPattern.MatchString(goals) {
+		best = 0
+	}
+	for _, match := range incidentsPattern.FindAllStringSubmatch(goals, -1) {
+		limit, _ := strconv.Atoi(match[2])
+		switch strings.ToLower(match[1]) {
+		case "max", "at most":
+		default:
+			// under 4 means 3 at most
+			limit--
+		}
+		if limit >= 0 && (best < 0 || limit < best) {
+			best = limit
+		}
+	}
+	return best, best >= 0
+}
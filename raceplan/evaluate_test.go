@@ -0,0 +1,56 @@
+package raceplan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluate(t *testing.T) {
+	evaluatedAt := time.Date(2025, 6, 11, 13, 0, 0, 0, time.UTC)
+	// finished 4th in class with 2 incidents
+	session := store.DriverSession{FinishPosition: 9, FinishPositionInClass: 3, Incidents: 2}
+
+	finish := func(target int, met bool) store.PlanTarget {
+		return store.PlanTarget{Kind: store.PlanTargetFinishPosition, Target: target, Actual: 4, Met: met}
+	}
+	incidents := func(target int, met bool) store.PlanTarget {
+		return store.PlanTarget{Kind: store.PlanTargetIncidents, Target: target, Actual: 2, Met: met}
+	}
+
+	testCases := []struct {
+		name     string
+		goals    string
+		expected []store.PlanTarget
+	}{
+		{name: "nothing measurable", goals: "Stay patient in turn 1, learn the braking points"},
+		{name: "top n", goals: "Finish top 5", expected: []store.PlanTarget{finish(5, true)}},
+		{name: "top n without a space", goals: "top3 would be great", expected: []store.PlanTarget{finish(3, false)}},
+		{name: "podium", goals: "Podium!", expected: []store.PlanTarget{finish(3, false)}},
+		{name: "win", goals: "Win it", expected: []store.PlanTarget{finish(1, false)}},
+		{name: "most ambitious finish counts", goals: "Top 10 at least, top 5 if the start goes well", expected: []store.PlanTarget{finish(5, true)}},
+		{name: "under n incidents", goals: "under 4 incidents", expected: []store.PlanTarget{incidents(3, true)}},
+		{name: "fewer than n x", goals: "fewer than 2x", expected: []store.PlanTarget{incidents(1, false)}},
+		{name: "max n", goals: "Max 2 incs", expected: []store.PlanTarget{incidents(2, true)}},
+		{name: "clean race", goals: "Clean race", expected: []store.PlanTarget{incidents(0, false)}},
+		{name: "under zero is ignored", goals: "under 0 incidents"},
+		{
+			name:     "finish and incidents",
+			goals:    "Clean race, top 5",
+			expected: []store.PlanTarget{finish(5, true), incidents(0, false)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evaluation := Evaluate(tc.goals, session, evaluatedAt)
+			if tc.expected == nil {
+				assert.Nil(t, evaluation)
+				return
+			}
+			assert.Equal(t, &store.PlanEvaluation{Targets: tc.expected, EvaluatedAt: evaluatedAt}, evaluation)
+		})
+	}
+}
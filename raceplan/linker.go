@@ -0,0 +1,138 @@
+package raceplan
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/i18n"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const actionPlanEvaluated = "planEvaluated"
+
+// PlanEvaluatedMsg tells the driver's connected clients how a race they planned for went against the plan's goals.
+type PlanEvaluatedMsg struct {
+	RaceID   int64          `json:"raceId"`
+	RaceWeek string         `json:"raceWeek"`
+	SeriesID int64          `json:"seriesId"`
+	TrackID  int64          `json:"trackId"`
+	Targets  []TargetResult `json:"targets"`
+	// Message sums the evaluation up in the driver's locale, ready to be shown as is
+	Message string `json:"message"`
+}
+
+// TargetResult is how the race measured up to one of the targets in the plan's goals.
+type TargetResult struct {
+	Kind   store.PlanTargetKind `json:"kind"`
+	Target int                  `json:"target"`
+	Actual int                  `json:"actual"`
+	Met    bool                 `json:"met"`
+}
+
+// LinkerStore defines the data access methods needed to link plans to the races run for them.
+type LinkerStore interface {
+	GetRacePlan(ctx context.Context, driverID int64, weekStart time.Time, seriesID, trackID int64) (*store.RacePlan, error)
+	LinkRacePlan(ctx context.Context, driverID int64, weekStart time.Time, seriesID, trackID, raceID, subsessionID int64, linkedAt time.Time, evaluation *store.PlanEvaluation) (bool, error)
+	GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error)
+}
+
+// Pusher delivers plan evaluations to the driver's connected clients.
+type Pusher interface {
+	Broadcast(ctx context.Context, driverID int64, actionType string, payload any) error
+}
+
+// Linker links drivers' plans to the races run for them as the races are ingested.
+type Linker struct {
+	store  LinkerStore
+	pusher Pusher
+	now    func() time.Time
+}
+
+func NewLinker(store LinkerStore, pusher Pusher) *Linker {
+	return &Linker{
+		store:  store,
+		pusher: pusher,
+		now:    time.Now,
+	}
+}
+
+// LinkSession links a freshly ingested session to the driver's plan for its series, track and race week, evaluating
+// the race against the plan's goals and letting the driver know how it went. Returns the evaluation, nil when the
+// session wasn't linked or the goals had nothing measurable. Sessions nobody planned for are left alone, as are
+// sessions ingested before series and tracks were recorded.
+func (l *Linker) LinkSession(ctx context.Context, session store.DriverSession) (*store.PlanEvaluation, error) {
+	if session.SeriesID == 0 || session.TrackID == 0 {
+		return nil, nil
+	}
+	key := keyOf(session)
+	// plans are rare next to races, so checking for one is cheaper than a conditional write for every race
+	plan, err := l.store.GetRacePlan(ctx, session.DriverID, key.WeekStart, key.SeriesID, key.TrackID)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil {
+		return nil, nil
+	}
+
+	now := l.now()
+	raceID := store.DriverRaceIDFromTime(session.StartTime)
+	evaluation := Evaluate(plan.Goals, session, now)
+	linked, err := l.store.LinkRacePlan(ctx, session.DriverID, key.WeekStart, key.SeriesID, key.TrackID, raceID, session.SubsessionID, now, evaluation)
+	if err != nil {
+		return nil, err
+	}
+	if !linked {
+		return nil, nil
+	}
+	zerolog.Ctx(ctx).Debug().Int64("driverId", session.DriverID).Int64("subsessionId", session.SubsessionID).Msg("linked race plan")
+	if evaluation == nil {
+		return nil, nil
+	}
+
+	// the plan keeps its evaluation, so a driver who wasn't connected still sees it next time they look
+	if err := l.pusher.Broadcast(ctx, session.DriverID, actionPlanEvaluated, l.evaluatedMsg(ctx, session.DriverID, raceID, key, *evaluation)); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Int64("driverId", session.DriverID).Msg("failed to broadcast plan evaluation")
+	}
+	return evaluation, nil
+}
+
+func (l *Linker) evaluatedMsg(ctx context.Context, driverID, raceID int64, key Key, evaluation store.PlanEvaluation) PlanEvaluatedMsg {
+	met := 0
+	targets := make([]TargetResult, len(evaluation.Targets))
+	for i, target := range evaluation.Targets {
+		targets[i] = TargetResult{
+			Kind:   target.Kind,
+			Target: target.Target,
+			Actual: target.Actual,
+			Met:    target.Met,
+		}
+		if target.Met {
+			met++
+		}
+	}
+	localizer := l.localizer(ctx, driverID)
+	return PlanEvaluatedMsg{
+		RaceID:   raceID,
+		RaceWeek: key.WeekStart.UTC().Format(time.DateOnly),
+		SeriesID: key.SeriesID,
+		TrackID:  key.TrackID,
+		Targets:  targets,
+		Message: localizer.Text(i18n.KeyPlanEvaluated, map[string]string{
+			"met":   strconv.Itoa(met),
+			"total": strconv.Itoa(len(targets)),
+		}),
+	}
+}
+
+// localizer falls back on the default locale when the driver's settings can't be read, the evaluation is still worth
+// sending.
+func (l *Linker) localizer(ctx context.Context, driverID int64) i18n.Localizer {
+	settings, err := l.store.GetDriverSettings(ctx, driverID)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Int64("driverId", driverID).Msg("failed to get driver settings, evaluating in the default locale")
+		return i18n.For(i18n.DefaultLocale)
+	}
+	return i18n.For(settings.Locale)
+}
@@ -0,0 +1,139 @@
+package raceplan
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinker_LinkSession(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Date(2025, 6, 12, 9, 0, 0, 0, time.UTC)
+	weekStart := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	// finished 3rd in class with 4 incidents
+	session := store.DriverSession{DriverID: driverID, SubsessionID: 1001, SeriesID: 260, TrackID: 47, StartTime: weekStart.Add(30 * time.Hour), FinishPositionInClass: 2, Incidents: 4}
+	raceID := store.DriverRaceIDFromTime(session.StartTime)
+
+	evaluation := &store.PlanEvaluation{
+		Targets: []store.PlanTarget{
+			{Kind: store.PlanTargetFinishPosition, Target: 5, Actual: 3, Met: true},
+			{Kind: store.PlanTargetIncidents, Target: 0, Actual: 4, Met: false},
+		},
+		EvaluatedAt: now,
+	}
+	evaluatedMsg := PlanEvaluatedMsg{
+		RaceID:   raceID,
+		RaceWeek: "2025-06-10",
+		SeriesID: 260,
+		TrackID:  47,
+		Targets: []TargetResult{
+			{Kind: store.PlanTargetFinishPosition, Target: 5, Actual: 3, Met: true},
+			{Kind: store.PlanTargetIncidents, Target: 0, Actual: 4, Met: false},
+		},
+		Message: "You met 1 of the 2 goals you planned for this race.",
+	}
+
+	type linkCall struct {
+		evaluation *store.PlanEvaluation
+		linked     bool
+	}
+
+	testCases := []struct {
+		name    string
+		session store.DriverSession
+		plan    *store.RacePlan
+
+		linkCalls     []linkCall
+		settings      *store.DriverSettings
+		settingsErr   error
+		broadcastMsgs []PlanEvaluatedMsg
+
+		expected *store.PlanEvaluation
+	}{
+		{
+			name:          "planned",
+			session:       session,
+			plan:          &store.RacePlan{DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47, Goals: "Clean race, top 5"},
+			linkCalls:     []linkCall{{evaluation: evaluation, linked: true}},
+			settings:      &store.DriverSettings{DriverID: driverID},
+			broadcastMsgs: []PlanEvaluatedMsg{evaluatedMsg},
+			expected:      evaluation,
+		},
+		{
+			name:      "planned in german",
+			session:   session,
+			plan:      &store.RacePlan{DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47, Goals: "Clean race, top 5"},
+			linkCalls: []linkCall{{evaluation: evaluation, linked: true}},
+			settings:  &store.DriverSettings{DriverID: driverID, Locale: "de"},
+			broadcastMsgs: []PlanEvaluatedMsg{func() PlanEvaluatedMsg {
+				msg := evaluatedMsg
+				msg.Message = "Du hast 1 von 2 Zielen erreicht, die du dir für dieses Rennen vorgenommen hattest."
+				return msg
+			}()},
+			expected: evaluation,
+		},
+		{
+			name:          "settings unavailable",
+			session:       session,
+			plan:          &store.RacePlan{DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47, Goals: "Clean race, top 5"},
+			linkCalls:     []linkCall{{evaluation: evaluation, linked: true}},
+			settingsErr:   errors.New("boom"),
+			broadcastMsgs: []PlanEvaluatedMsg{evaluatedMsg},
+			expected:      evaluation,
+		},
+		{
+			name:      "nothing measurable",
+			session:   session,
+			plan:      &store.RacePlan{DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47, Goals: "Be patient"},
+			linkCalls: []linkCall{{linked: true}},
+		},
+		{
+			name:      "already linked to an earlier race",
+			session:   session,
+			plan:      &store.RacePlan{DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47, Goals: "Clean race, top 5"},
+			linkCalls: []linkCall{{evaluation: evaluation, linked: false}},
+		},
+		{name: "not planned", session: session},
+		{name: "ingested before series and tracks were recorded", session: store.DriverSession{DriverID: driverID, SubsessionID: 1001, StartTime: session.StartTime}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockLinkerStore(t)
+			mockPusher := NewMockPusher(t)
+			if tc.session.SeriesID != 0 {
+				mockStore.EXPECT().GetRacePlan(mock.Anything, driverID, weekStart, int64(260), int64(47)).Return(tc.plan, nil)
+			}
+			for _, call := range tc.linkCalls {
+				mockStore.EXPECT().LinkRacePlan(mock.Anything, driverID, weekStart, int64(260), int64(47), raceID, int64(1001), now, call.evaluation).Return(call.linked, nil)
+			}
+			if tc.settings != nil || tc.settingsErr != nil {
+				mockStore.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(tc.settings, tc.settingsErr)
+			}
+			for _, msg := range tc.broadcastMsgs {
+				mockPusher.EXPECT().Broadcast(mock.Anything, driverID, "planEvaluated", msg).Return(nil)
+			}
+
+			linker := NewLinker(mockStore, mockPusher)
+			linker.now = func() time.Time { return now }
+
+			evaluation, err := linker.LinkSession(context.Background(), tc.session)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, evaluation)
+		})
+	}
+
+	t.Run("store failing", func(t *testing.T) {
+		mockStore := NewMockLinkerStore(t)
+		mockStore.EXPECT().GetRacePlan(mock.Anything, driverID, weekStart, int64(260), int64(47)).Return(nil, errors.New("boom"))
+
+		_, err := NewLinker(mockStore, NewMockPusher(t)).LinkSession(context.Background(), session)
+		assert.Error(t, err)
+	})
+}
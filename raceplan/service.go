@@ -0,0 +1,173 @@
+package raceplan
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/standings"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// MaxTextLength caps a plan's goals and setup intentions. Plans are notes to self ahead of a race, reflecting on how
+// it went belongs in the race's journal entry.
+const MaxTextLength = 2000
+
+// weekLength is how long an iRacing race week runs
+const weekLength = 7 * 24 * time.Hour
+
+// ErrRaceNotFound is returned when looking up the plan of a race the driver doesn't have.
+var ErrRaceNotFound = errors.New("race not found")
+
+// Validate checks a plan's content. A plan needs goals, setup intentions or both, neither longer than MaxTextLength.
+func Validate(goals, setupIntentions string) []journal.FieldValidation {
+	var errs []journal.FieldValidation
+	if strings.TrimSpace(goals) == "" && strings.TrimSpace(setupIntentions) == "" {
+		errs = append(errs, journal.FieldValidation{Field: "goals", Code: "required"})
+	}
+	for field, value := range map[string]string{"goals": goals, "setupIntentions": setupIntentions} {
+		if len(value) > MaxTextLength {
+			errs = append(errs, journal.FieldValidation{
+				Field:  field,
+				Code:   "too_long",
+				Params: map[string]string{"max": strconv.Itoa(MaxTextLength)},
+			})
+		}
+	}
+	slices.SortFunc(errs, func(a, b journal.FieldValidation) int {
+		return cmp.Compare(a.Field, b.Field)
+	})
+	return errs
+}
+
+// Key identifies what a plan is for, a series at a track in the race week starting at WeekStart.
+type Key struct {
+	WeekStart time.Time
+	SeriesID  int64
+	TrackID   int64
+}
+
+// keyOf returns the key of the plan a session would have been run under.
+func keyOf(session store.DriverSession) Key {
+	return Key{
+		WeekStart: standings.WeekStart(session.StartTime),
+		SeriesID:  session.SeriesID,
+		TrackID:   session.TrackID,
+	}
+}
+
+// Store defines the data access methods needed by the race plan service.
+type Store interface {
+	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	SaveRacePlan(ctx context.Context, plan store.RacePlan) error
+	GetRacePlan(ctx context.Context, driverID int64, weekStart time.Time, seriesID, trackID int64) (*store.RacePlan, error)
+	GetRacePlans(ctx context.Context, driverID int64) ([]store.RacePlan, error)
+	DeleteRacePlan(ctx context.Context, driverID int64, weekStart time.Time, seriesID, trackID int64) error
+}
+
+// Service manages the plans drivers write ahead of their races.
+type Service struct {
+	store Store
+	now   func() time.Time
+}
+
+func NewService(store Store) *Service {
+	return &Service{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Save writes the driver's plan for key, replacing the goals and setup intentions of any plan they already had for it.
+// Plans saved after the driver has already run a matching race are linked to it and evaluated straight away, anything
+// else is linked as the race is ingested. Linked plans keep the evaluation they got when linked, rewriting the goals
+// after the race doesn't change how it went. Callers should validate the content with Validate before calling Save.
+func (s *Service) Save(ctx context.Context, driverID int64, key Key, goals, setupIntentions string) (*store.RacePlan, error) {
+	now := s.now()
+	plan, err := s.store.GetRacePlan(ctx, driverID, key.WeekStart, key.SeriesID, key.TrackID)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil {
+		plan = &store.RacePlan{
+			DriverID:  driverID,
+			WeekStart: key.WeekStart,
+			SeriesID:  key.SeriesID,
+			TrackID:   key.TrackID,
+			CreatedAt: now,
+		}
+	}
+	plan.Goals = goals
+	plan.SetupIntentions = setupIntentions
+	plan.UpdatedAt = now
+
+	if plan.RaceID == 0 && key.WeekStart.Before(now) {
+		sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, driverID, key.WeekStart, key.WeekStart.Add(weekLength))
+		if err != nil {
+			return nil, err
+		}
+		var first *store.DriverSession
+		for i, session := range sessions {
+			if keyOf(session) == key && (first == nil || session.StartTime.Before(first.StartTime)) {
+				first = &sessions[i]
+			}
+		}
+		if first != nil {
+			plan.RaceID = store.DriverRaceIDFromTime(first.StartTime)
+			plan.SubsessionID = first.SubsessionID
+			plan.LinkedAt = now
+			plan.Evaluation = Evaluate(goals, *first, now)
+		}
+	}
+
+	if err := s.store.SaveRacePlan(ctx, *plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// List returns the driver's plans, most recent race week first.
+func (s *Service) List(ctx context.Context, driverID int64) ([]store.RacePlan, error) {
+	plans, err := s.store.GetRacePlans(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(plans, func(a, b store.RacePlan) int {
+		return b.WeekStart.Compare(a.WeekStart)
+	})
+	return plans, nil
+}
+
+// Delete removes the driver's plan for key. Deleting a plan that doesn't exist is not an error.
+func (s *Service) Delete(ctx context.Context, driverID int64, key Key) error {
+	return s.store.DeleteRacePlan(ctx, driverID, key.WeekStart, key.SeriesID, key.TrackID)
+}
+
+// ForRace returns the plan linked to one of the driver's races, nil if the race wasn't planned. Returns
+// ErrRaceNotFound if raceID doesn't identify one of the driver's races.
+func (s *Service) ForRace(ctx context.Context, driverID, raceID int64) (*store.RacePlan, error) {
+	session, err := s.store.GetDriverSession(ctx, driverID, store.TimeFromDriverRaceID(raceID))
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, ErrRaceNotFound
+	}
+
+	key := keyOf(*session)
+	plan, err := s.store.GetRacePlan(ctx, driverID, key.WeekStart, key.SeriesID, key.TrackID)
+	if err != nil {
+		return nil, err
+	}
+	// plans are linked to the first matching race of the week, later attempts at it weren't what was planned for
+	if plan == nil || plan.RaceID != raceID {
+		return nil, nil
+	}
+	return plan, nil
+}
@@ -0,0 +1,179 @@
+package raceplan
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tooLong := strings.Repeat("x", MaxTextLength+1)
+	tooLongErr := func(field string) journal.FieldValidation {
+		return journal.FieldValidation{Field: field, Code: "too_long", Params: map[string]string{"max": "2000"}}
+	}
+
+	testCases := []struct {
+		name            string
+		goals           string
+		setupIntentions string
+		expected        []journal.FieldValidation
+	}{
+		{name: "goals and setup", goals: "Top 5", setupIntentions: "Less wing"},
+		{name: "goals only", goals: "Top 5"},
+		{name: "setup only", setupIntentions: "Less wing"},
+		{name: "blank", goals: "  ", setupIntentions: "\n", expected: []journal.FieldValidation{{Field: "goals", Code: "required"}}},
+		{name: "too long", goals: tooLong, setupIntentions: tooLong, expected: []journal.FieldValidation{tooLongErr("goals"), tooLongErr("setupIntentions")}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Validate(tc.goals, tc.setupIntentions))
+		})
+	}
+}
+
+func TestService_Save(t *testing.T) {
+	driverID := int64(12345)
+	weekStart := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	key := Key{WeekStart: weekStart, SeriesID: 260, TrackID: 47}
+	createdAt := time.Date(2025, 6, 8, 9, 0, 0, 0, time.UTC)
+
+	firstRace := store.DriverSession{SubsessionID: 1001, SeriesID: 260, TrackID: 47, StartTime: weekStart.Add(30 * time.Hour)}
+	secondRace := store.DriverSession{SubsessionID: 1002, SeriesID: 260, TrackID: 47, StartTime: weekStart.Add(50 * time.Hour)}
+	otherTrack := store.DriverSession{SubsessionID: 1003, SeriesID: 260, TrackID: 12, StartTime: weekStart.Add(20 * time.Hour)}
+
+	testCases := []struct {
+		name     string
+		now      time.Time
+		existing *store.RacePlan
+		sessions []store.DriverSession
+		expected store.RacePlan
+	}{
+		{
+			name: "planned ahead of the week",
+			now:  createdAt,
+			expected: store.RacePlan{
+				DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47,
+				Goals: "Top 5", SetupIntentions: "Less wing", CreatedAt: createdAt, UpdatedAt: createdAt,
+			},
+		},
+		{
+			name:     "planned during the week before racing",
+			now:      weekStart.Add(10 * time.Hour),
+			sessions: []store.DriverSession{otherTrack},
+			expected: store.RacePlan{
+				DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47,
+				Goals: "Top 5", SetupIntentions: "Less wing", CreatedAt: weekStart.Add(10 * time.Hour), UpdatedAt: weekStart.Add(10 * time.Hour),
+			},
+		},
+		{
+			name:     "planned after racing links the first race",
+			now:      weekStart.Add(60 * time.Hour),
+			sessions: []store.DriverSession{secondRace, otherTrack, firstRace},
+			expected: store.RacePlan{
+				DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47,
+				Goals: "Top 5", SetupIntentions: "Less wing", CreatedAt: weekStart.Add(60 * time.Hour), UpdatedAt: weekStart.Add(60 * time.Hour),
+				RaceID: store.DriverRaceIDFromTime(firstRace.StartTime), SubsessionID: 1001, LinkedAt: weekStart.Add(60 * time.Hour),
+				Evaluation: &store.PlanEvaluation{
+					Targets:     []store.PlanTarget{{Kind: store.PlanTargetFinishPosition, Target: 5, Actual: 1, Met: true}},
+					EvaluatedAt: weekStart.Add(60 * time.Hour),
+				},
+			},
+		},
+		{
+			name: "updating a linked plan keeps the link",
+			now:  weekStart.Add(60 * time.Hour),
+			existing: &store.RacePlan{
+				DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47,
+				Goals: "Top 10", CreatedAt: createdAt, UpdatedAt: createdAt,
+				RaceID: store.DriverRaceIDFromTime(secondRace.StartTime), SubsessionID: 1002, LinkedAt: weekStart.Add(55 * time.Hour),
+			},
+			expected: store.RacePlan{
+				DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47,
+				Goals: "Top 5", SetupIntentions: "Less wing", CreatedAt: createdAt, UpdatedAt: weekStart.Add(60 * time.Hour),
+				RaceID: store.DriverRaceIDFromTime(secondRace.StartTime), SubsessionID: 1002, LinkedAt: weekStart.Add(55 * time.Hour),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetRacePlan(mock.Anything, driverID, weekStart, int64(260), int64(47)).Return(tc.existing, nil)
+			if tc.existing == nil && tc.now.After(weekStart) {
+				mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, weekStart, weekStart.Add(weekLength)).Return(tc.sessions, nil)
+			}
+			mockStore.EXPECT().SaveRacePlan(mock.Anything, tc.expected).Return(nil)
+
+			service := NewService(mockStore)
+			service.now = func() time.Time { return tc.now }
+
+			plan, err := service.Save(context.Background(), driverID, key, "Top 5", "Less wing")
+			require.NoError(t, err)
+			assert.Equal(t, &tc.expected, plan)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	weekStart := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	older := store.RacePlan{DriverID: 12345, WeekStart: weekStart, SeriesID: 260, TrackID: 47}
+	newer := store.RacePlan{DriverID: 12345, WeekStart: weekStart.AddDate(0, 0, 7), SeriesID: 260, TrackID: 12}
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetRacePlans(mock.Anything, int64(12345)).Return([]store.RacePlan{older, newer}, nil)
+
+	plans, err := NewService(mockStore).List(context.Background(), 12345)
+	require.NoError(t, err)
+	assert.Equal(t, []store.RacePlan{newer, older}, plans)
+}
+
+func TestService_ForRace(t *testing.T) {
+	driverID := int64(12345)
+	weekStart := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	session := store.DriverSession{DriverID: driverID, SubsessionID: 1001, SeriesID: 260, TrackID: 47, StartTime: weekStart.Add(30 * time.Hour)}
+	raceID := store.DriverRaceIDFromTime(session.StartTime)
+	linked := &store.RacePlan{DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47, Goals: "Top 5", RaceID: raceID, SubsessionID: 1001}
+
+	testCases := []struct {
+		name        string
+		session     *store.DriverSession
+		plan        *store.RacePlan
+		expected    *store.RacePlan
+		expectedErr error
+	}{
+		{name: "planned", session: &session, plan: linked, expected: linked},
+		{name: "not planned", session: &session},
+		{
+			name:    "a later attempt at a planned race",
+			session: &session,
+			plan:    &store.RacePlan{DriverID: driverID, WeekStart: weekStart, SeriesID: 260, TrackID: 47, RaceID: raceID - 3600},
+		},
+		{name: "race not found", expectedErr: ErrRaceNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).Return(tc.session, nil)
+			if tc.session != nil {
+				mockStore.EXPECT().GetRacePlan(mock.Anything, driverID, weekStart, int64(260), int64(47)).Return(tc.plan, nil)
+			}
+
+			plan, err := NewService(mockStore).ForRace(context.Background(), driverID, raceID)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, plan)
+		})
+	}
+}
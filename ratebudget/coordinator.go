@@ -0,0 +1,98 @@
+package ratebudget
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// DefaultWindow is the window iRacing's rate limit is measured over.
+const DefaultWindow = time.Minute
+
+// contentionThreshold is the share of the budget that has to be in use before drivers are held to their fair share of
+// it. Below this there is room for everyone, and a lone driver catching up on years of history should get all of it.
+const contentionThreshold = 0.5
+
+// minRetryAfter keeps deferred work from coming straight back when a window is about to roll over.
+const minRetryAfter = time.Second
+
+// Store defines the data access methods needed to share the budget.
+type Store interface {
+	GetRateBudgetWindow(ctx context.Context, start time.Time) (*store.RateBudgetWindow, error)
+	ConsumeRateBudget(ctx context.Context, start time.Time, driverID int64, cost, limit int) (bool, error)
+}
+
+// Coordinator shares a platform wide budget of iRacing requests between every ingestion running at once. Spending is
+// counted in fixed windows, with the previous window weighted by how much of it still falls inside a sliding window
+// ending now, which smooths out the burst a fixed window would allow at each rollover.
+type Coordinator struct {
+	store  Store
+	limit  int
+	window time.Duration
+	now    func() time.Time
+}
+
+func NewCoordinator(store Store, limit int, window time.Duration) *Coordinator {
+	return &Coordinator{
+		store:  store,
+		limit:  limit,
+		window: window,
+		now:    time.Now,
+	}
+}
+
+// Reserve takes cost out of the budget on behalf of driverID. It returns zero when the cost was reserved, otherwise how
+// long to wait before trying again. Once the budget is contended no driver may take more than an even share of it, so
+// one driver's backfill can't starve everyone else's, although every driver can always reserve at least one cost.
+func (c *Coordinator) Reserve(ctx context.Context, driverID int64, cost int) (time.Duration, error) {
+	now := c.now()
+	start := now.Truncate(c.window)
+	retryAfter := max(start.Add(c.window).Sub(now), minRetryAfter)
+
+	current, err := c.store.GetRateBudgetWindow(ctx, start)
+	if err != nil {
+		return 0, fmt.Errorf("getting current window: %w", err)
+	}
+	previous, err := c.store.GetRateBudgetWindow(ctx, start.Add(-c.window))
+	if err != nil {
+		return 0, fmt.Errorf("getting previous window: %w", err)
+	}
+
+	overlap := 1 - float64(now.Sub(start))/float64(c.window)
+	carried := int(math.Ceil(float64(previous.Total) * overlap))
+	used := current.Total + carried
+	if used+cost > c.limit {
+		return retryAfter, nil
+	}
+
+	if float64(used) >= float64(c.limit)*contentionThreshold {
+		fairShare := float64(c.limit) / float64(activeDrivers(driverID, current, previous))
+		driverUsed := float64(current.Drivers[driverID]) + float64(previous.Drivers[driverID])*overlap
+		if driverUsed+float64(cost) > max(fairShare, float64(cost)) {
+			return retryAfter, nil
+		}
+	}
+
+	// the previous window's share is fixed now, so the current window can only take what it leaves
+	consumed, err := c.store.ConsumeRateBudget(ctx, start, driverID, cost, c.limit-carried)
+	if err != nil {
+		return 0, fmt.Errorf("consuming budget: %w", err)
+	}
+	if !consumed {
+		return retryAfter, nil
+	}
+	return 0, nil
+}
+
+func activeDrivers(driverID int64, windows ...*store.RateBudgetWindow) int {
+	drivers := map[int64]bool{driverID: true}
+	for _, window := range windows {
+		for id := range window.Drivers {
+			drivers[id] = true
+		}
+	}
+	return len(drivers)
+}
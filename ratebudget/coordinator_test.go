@@ -0,0 +1,169 @@
+package ratebudget
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCoordinator_Reserve(t *testing.T) {
+	driverID := int64(12345)
+	start := time.Unix(6000, 0)
+	previousStart := start.Add(-time.Minute)
+
+	window := func(start time.Time, drivers map[int64]int) *store.RateBudgetWindow {
+		w := &store.RateBudgetWindow{Start: start, Drivers: drivers}
+		for _, spent := range drivers {
+			w.Total += spent
+		}
+		return w
+	}
+	manyDrivers := map[int64]int{}
+	for id := range int64(12) {
+		manyDrivers[id+1] = 5
+	}
+
+	testCases := []struct {
+		name               string
+		elapsed            time.Duration
+		current            *store.RateBudgetWindow
+		currentErr         error
+		previous           *store.RateBudgetWindow
+		previousErr        error
+		expectConsume      bool
+		consumeLimit       int
+		consumed           bool
+		consumeErr         error
+		expectedRetryAfter time.Duration
+		expectErr          bool
+	}{
+		{
+			name:          "nothing spent",
+			elapsed:       15 * time.Second,
+			current:       window(start, map[int64]int{}),
+			previous:      window(previousStart, map[int64]int{}),
+			expectConsume: true,
+			consumeLimit:  100,
+			consumed:      true,
+		},
+		{
+			name:          "previous window still in the sliding window limits what can be consumed",
+			elapsed:       15 * time.Second,
+			current:       window(start, map[int64]int{2: 10}),
+			previous:      window(previousStart, map[int64]int{2: 40}),
+			expectConsume: true,
+			consumeLimit:  70,
+			consumed:      true,
+		},
+		{
+			name:               "over budget",
+			elapsed:            15 * time.Second,
+			current:            window(start, map[int64]int{2: 60}),
+			previous:           window(previousStart, map[int64]int{2: 60}),
+			expectedRetryAfter: 45 * time.Second,
+		},
+		{
+			name:               "over budget as the window ends",
+			elapsed:            59*time.Second + 500*time.Millisecond,
+			current:            window(start, map[int64]int{2: 95}),
+			previous:           window(previousStart, map[int64]int{}),
+			expectedRetryAfter: time.Second,
+		},
+		{
+			name:          "contended but within fair share",
+			elapsed:       15 * time.Second,
+			current:       window(start, map[int64]int{driverID: 30, 2: 20}),
+			previous:      window(previousStart, map[int64]int{}),
+			expectConsume: true,
+			consumeLimit:  100,
+			consumed:      true,
+		},
+		{
+			name:               "contended and over fair share",
+			elapsed:            15 * time.Second,
+			current:            window(start, map[int64]int{driverID: 45, 2: 5}),
+			previous:           window(previousStart, map[int64]int{}),
+			expectedRetryAfter: 45 * time.Second,
+		},
+		{
+			name:               "fair share counts spending carried over from the previous window",
+			elapsed:            15 * time.Second,
+			current:            window(start, map[int64]int{driverID: 20, 2: 5}),
+			previous:           window(previousStart, map[int64]int{driverID: 40}),
+			expectedRetryAfter: 45 * time.Second,
+		},
+		{
+			name:          "drivers can always reserve one cost",
+			elapsed:       15 * time.Second,
+			current:       window(start, manyDrivers),
+			previous:      window(previousStart, map[int64]int{}),
+			expectConsume: true,
+			consumeLimit:  100,
+			consumed:      true,
+		},
+		{
+			name:               "budget taken by someone else first",
+			elapsed:            15 * time.Second,
+			current:            window(start, map[int64]int{}),
+			previous:           window(previousStart, map[int64]int{}),
+			expectConsume:      true,
+			consumeLimit:       100,
+			expectedRetryAfter: 45 * time.Second,
+		},
+		{
+			name:       "current window error",
+			elapsed:    15 * time.Second,
+			currentErr: errors.New("boom"),
+			expectErr:  true,
+		},
+		{
+			name:        "previous window error",
+			elapsed:     15 * time.Second,
+			current:     window(start, map[int64]int{}),
+			previousErr: errors.New("boom"),
+			expectErr:   true,
+		},
+		{
+			name:          "consume error",
+			elapsed:       15 * time.Second,
+			current:       window(start, map[int64]int{}),
+			previous:      window(previousStart, map[int64]int{}),
+			expectConsume: true,
+			consumeLimit:  100,
+			consumeErr:    errors.New("boom"),
+			expectErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetRateBudgetWindow(mock.Anything, start).Return(tc.current, tc.currentErr)
+			if tc.currentErr == nil {
+				mockStore.EXPECT().GetRateBudgetWindow(mock.Anything, previousStart).Return(tc.previous, tc.previousErr)
+			}
+			if tc.expectConsume {
+				mockStore.EXPECT().ConsumeRateBudget(mock.Anything, start, driverID, 10, tc.consumeLimit).Return(tc.consumed, tc.consumeErr)
+			}
+
+			coordinator := NewCoordinator(mockStore, 100, time.Minute)
+			coordinator.now = func() time.Time {
+				return start.Add(tc.elapsed)
+			}
+
+			retryAfter, err := coordinator.Reserve(ctx, driverID, 10)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedRetryAfter, retryAfter)
+		})
+	}
+}
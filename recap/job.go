@@ -0,0 +1,149 @@
+package recap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/coaching"
+	"github.com/jonsabados/saturdaysspinout/datachange"
+	"github.com/jonsabados/saturdaysspinout/i18n"
+	"github.com/jonsabados/saturdaysspinout/standings"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// ActiveWindow is how recently a driver has to have logged in to be included in the weekly recap, there's no point
+// preparing anything for drivers that have stopped using the site.
+const ActiveWindow = 30 * 24 * time.Hour
+
+// Store defines the data access methods needed by the recap job.
+type Store interface {
+	GetDriversActiveSince(ctx context.Context, since time.Time) ([]store.Driver, error)
+	SaveWeeklyRecap(ctx context.Context, recap store.WeeklyRecap) error
+	GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error)
+}
+
+// PracticePlanner regenerates a driver's practice plan.
+type PracticePlanner interface {
+	GeneratePracticePlan(ctx context.Context, driverID int64) (*store.PracticePlan, error)
+}
+
+// FatigueDetector checks whether a driver's results tail off over days where they run several races.
+type FatigueDetector interface {
+	DetectFatigue(ctx context.Context, driverID int64) (*coaching.FatigueInsight, error)
+}
+
+type ChangeNotifier interface {
+	Notify(ctx context.Context, driverID int64, resources ...datachange.Resource)
+}
+
+type JobOption func(*Job)
+
+// WithChangeNotifier hints to each driver's connections that their recap and practice plan changed once they are saved.
+func WithChangeNotifier(notifier ChangeNotifier) JobOption {
+	return func(j *Job) {
+		j.changeNotifier = notifier
+	}
+}
+
+// Job prepares the weekly recap for every active driver.
+type Job struct {
+	store          Store
+	planner        PracticePlanner
+	fatigue        FatigueDetector
+	changeNotifier ChangeNotifier
+	now            func() time.Time
+}
+
+func NewJob(store Store, planner PracticePlanner, fatigue FatigueDetector, opts ...JobOption) *Job {
+	j := &Job{
+		store:   store,
+		planner: planner,
+		fatigue: fatigue,
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(j)
+	}
+	return j
+}
+
+// Run prepares the recap for every driver active within ActiveWindow. A failure for one driver doesn't stop the others
+// from being processed; all failures are returned together.
+func (j *Job) Run(ctx context.Context) error {
+	logger := zerolog.Ctx(ctx)
+
+	now := j.now()
+	drivers, err := j.store.GetDriversActiveSince(ctx, now.Add(-ActiveWindow))
+	if err != nil {
+		return fmt.Errorf("getting active drivers: %w", err)
+	}
+
+	var errs []error
+	for _, driver := range drivers {
+		if err := j.runDriver(ctx, driver.DriverID, now); err != nil {
+			errs = append(errs, fmt.Errorf("driver %d: %w", driver.DriverID, err))
+		}
+	}
+
+	logger.Info().Int("driverCount", len(drivers)).Int("failureCount", len(errs)).Msg("weekly recap complete")
+	return errors.Join(errs...)
+}
+
+func (j *Job) runDriver(ctx context.Context, driverID int64, now time.Time) error {
+	if _, err := j.planner.GeneratePracticePlan(ctx, driverID); err != nil {
+		return fmt.Errorf("generating practice plan: %w", err)
+	}
+
+	insight, err := j.fatigue.DetectFatigue(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("detecting fatigue: %w", err)
+	}
+
+	settings, err := j.store.GetDriverSettings(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("getting settings: %w", err)
+	}
+
+	fatigue := insight.Flag()
+	err = j.store.SaveWeeklyRecap(ctx, store.WeeklyRecap{
+		DriverID:    driverID,
+		WeekStart:   standings.WeekStart(now),
+		GeneratedAt: now,
+		Fatigue:     fatigue,
+		Highlights:  highlights(i18n.For(settings.Locale), fatigue),
+	})
+	if err != nil {
+		return fmt.Errorf("saving recap: %w", err)
+	}
+	if j.changeNotifier != nil {
+		j.changeNotifier.Notify(ctx, driverID, datachange.ResourceWeeklyRecap, datachange.ResourcePracticePlan)
+	}
+	return nil
+}
+
+var fatigueMessageKeys = map[store.FatigueMeasure]string{
+	store.FatigueMeasureFinishPosition: i18n.KeyFatigueFinishPosition,
+	store.FatigueMeasureIncidents:      i18n.KeyFatigueIncidents,
+	store.FatigueMeasureConsistency:    i18n.KeyFatigueConsistency,
+}
+
+// highlights writes out the recap's lines of text. The practice plan is always regenerated, the fatigue line only
+// shows up when there's a flag.
+func highlights(localizer i18n.Localizer, fatigue *store.FatigueFlag) []string {
+	ret := []string{localizer.Text(i18n.KeyRecapPracticePlan, nil)}
+	if fatigue != nil {
+		measures := make([]string, len(fatigue.Measures))
+		for i, measure := range fatigue.Measures {
+			measures[i] = localizer.Text(fatigueMessageKeys[measure], nil)
+		}
+		ret = append(ret, localizer.Text(i18n.KeyRecapFatigue, map[string]string{
+			"days":     strconv.Itoa(fatigue.MultiRaceDays),
+			"measures": localizer.List(measures),
+		}))
+	}
+	return ret
+}
@@ -0,0 +1,108 @@
+package recap
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/coaching"
+	"github.com/jonsabados/saturdaysspinout/datachange"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestJob_Run(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	// the race week containing now started Tuesday 2023-11-14
+	weekStart := time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC)
+	ctx := zerolog.Nop().WithContext(context.Background())
+
+	fatigueFlag := &store.FatigueFlag{
+		MultiRaceDays: 4,
+		Measures:      []store.FatigueMeasure{store.FatigueMeasureFinishPosition, store.FatigueMeasureIncidents},
+	}
+	detected := &coaching.FatigueInsight{MultiRaceDays: 4, Degrading: fatigueFlag.Measures, Detected: true}
+
+	t.Run("prepares a recap for every active driver", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriversActiveSince(mock.Anything, now.Add(-ActiveWindow)).
+			Return([]store.Driver{{DriverID: 1}, {DriverID: 2}}, nil)
+		mockStore.EXPECT().GetDriverSettings(mock.Anything, int64(1)).Return(&store.DriverSettings{DriverID: 1}, nil)
+		mockStore.EXPECT().GetDriverSettings(mock.Anything, int64(2)).Return(&store.DriverSettings{DriverID: 2, Locale: "fr"}, nil)
+		mockStore.EXPECT().SaveWeeklyRecap(mock.Anything, store.WeeklyRecap{
+			DriverID:    1,
+			WeekStart:   weekStart,
+			GeneratedAt: now,
+			Fatigue:     fatigueFlag,
+			Highlights: []string{
+				"Your practice plan for the week is ready.",
+				"Over your last 4 days of several races, your finishing position and incident count tended to get worse the more you raced.",
+			},
+		}).Return(nil)
+		mockStore.EXPECT().SaveWeeklyRecap(mock.Anything, store.WeeklyRecap{
+			DriverID:    2,
+			WeekStart:   weekStart,
+			GeneratedAt: now,
+			Highlights:  []string{"Ton plan d'entraînement de la semaine est prêt."},
+		}).Return(nil)
+		mockPlanner := NewMockPracticePlanner(t)
+		mockPlanner.EXPECT().GeneratePracticePlan(mock.Anything, int64(1)).Return(&store.PracticePlan{DriverID: 1}, nil)
+		mockPlanner.EXPECT().GeneratePracticePlan(mock.Anything, int64(2)).Return(&store.PracticePlan{DriverID: 2}, nil)
+		mockFatigue := NewMockFatigueDetector(t)
+		mockFatigue.EXPECT().DetectFatigue(mock.Anything, int64(1)).Return(detected, nil)
+		mockFatigue.EXPECT().DetectFatigue(mock.Anything, int64(2)).Return(&coaching.FatigueInsight{MultiRaceDays: 1}, nil)
+		mockNotifier := NewMockChangeNotifier(t)
+		changed := []datachange.Resource{datachange.ResourceWeeklyRecap, datachange.ResourcePracticePlan}
+		mockNotifier.EXPECT().Notify(mock.Anything, int64(1), changed).Return()
+		mockNotifier.EXPECT().Notify(mock.Anything, int64(2), changed).Return()
+
+		job := NewJob(mockStore, mockPlanner, mockFatigue, WithChangeNotifier(mockNotifier))
+		job.now = func() time.Time { return now }
+		assert.NoError(t, job.Run(ctx))
+	})
+
+	t.Run("a failing driver doesn't stop the rest", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriversActiveSince(mock.Anything, now.Add(-ActiveWindow)).
+			Return([]store.Driver{{DriverID: 1}, {DriverID: 2}, {DriverID: 3}, {DriverID: 4}}, nil)
+		mockStore.EXPECT().GetDriverSettings(mock.Anything, int64(3)).Return(&store.DriverSettings{DriverID: 3}, nil)
+		mockStore.EXPECT().GetDriverSettings(mock.Anything, int64(4)).Return(nil, errors.New("crash"))
+		mockStore.EXPECT().SaveWeeklyRecap(mock.Anything, store.WeeklyRecap{
+			DriverID:    3,
+			WeekStart:   weekStart,
+			GeneratedAt: now,
+			Highlights:  []string{"Your practice plan for the week is ready."},
+		}).Return(nil)
+		mockPlanner := NewMockPracticePlanner(t)
+		mockPlanner.EXPECT().GeneratePracticePlan(mock.Anything, int64(1)).Return(nil, errors.New("boom"))
+		mockPlanner.EXPECT().GeneratePracticePlan(mock.Anything, int64(2)).Return(&store.PracticePlan{DriverID: 2}, nil)
+		mockPlanner.EXPECT().GeneratePracticePlan(mock.Anything, int64(3)).Return(&store.PracticePlan{DriverID: 3}, nil)
+		mockPlanner.EXPECT().GeneratePracticePlan(mock.Anything, int64(4)).Return(&store.PracticePlan{DriverID: 4}, nil)
+		mockFatigue := NewMockFatigueDetector(t)
+		mockFatigue.EXPECT().DetectFatigue(mock.Anything, int64(2)).Return(nil, errors.New("bang"))
+		mockFatigue.EXPECT().DetectFatigue(mock.Anything, int64(3)).Return(&coaching.FatigueInsight{}, nil)
+		mockFatigue.EXPECT().DetectFatigue(mock.Anything, int64(4)).Return(&coaching.FatigueInsight{}, nil)
+		// only the driver whose recap was saved hears about it
+		mockNotifier := NewMockChangeNotifier(t)
+		mockNotifier.EXPECT().Notify(mock.Anything, int64(3), []datachange.Resource{datachange.ResourceWeeklyRecap, datachange.ResourcePracticePlan}).Return()
+
+		job := NewJob(mockStore, mockPlanner, mockFatigue, WithChangeNotifier(mockNotifier))
+		job.now = func() time.Time { return now }
+		err := job.Run(ctx)
+		assert.ErrorContains(t, err, "driver 1: generating practice plan: boom")
+		assert.ErrorContains(t, err, "driver 2: detecting fatigue: bang")
+		assert.ErrorContains(t, err, "driver 4: getting settings: crash")
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriversActiveSince(mock.Anything, now.Add(-ActiveWindow)).Return(nil, errors.New("boom"))
+
+		job := NewJob(mockStore, NewMockPracticePlanner(t), NewMockFatigueDetector(t))
+		job.now = func() time.Time { return now }
+		assert.Error(t, job.Run(ctx))
+	})
+}
@@ -0,0 +1,145 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Page layout in points. Pages are US Letter with an inch of margin all round.
+const (
+	pageWidth   = 612
+	pageHeight  = 792
+	margin      = 72
+	lineSpacing = 1.4
+)
+
+// style is how a line of text is set. Fonts are the resource names pages give the built in Helvetica faces.
+type style struct {
+	font string
+	size float64
+	// wrapAt is roughly how many characters fit between the margins at this size
+	wrapAt int
+}
+
+var (
+	headingStyle = style{font: "F2", size: 18, wrapAt: 45}
+	bodyStyle    = style{font: "F1", size: 11, wrapAt: 85}
+)
+
+type line struct {
+	text  string
+	style style
+}
+
+// renderPDF lays lines out down the page, starting new pages as they fill. Only the PDF standard Helvetica faces are
+// used so nothing needs embedding, which limits text to what their WinAnsi encoding covers; anything else is drawn as
+// '?'.
+func renderPDF(lines []line) []byte {
+	var pages []string
+	var content strings.Builder
+	y := float64(pageHeight - margin)
+	for _, l := range lines {
+		advance := l.style.size * lineSpacing
+		if y-advance < margin && content.Len() > 0 {
+			pages = append(pages, content.String())
+			content.Reset()
+			y = pageHeight - margin
+		}
+		y -= advance
+		if l.text != "" {
+			fmt.Fprintf(&content, "BT /%s %g Tf %d %.2f Td (%s) Tj ET\n", l.style.font, l.style.size, margin, y, pdfString(l.text))
+		}
+	}
+	pages = append(pages, content.String())
+
+	// objects are numbered from 1 in the order they're listed: the catalog, the page tree, the two fonts, then a page
+	// and its content stream for each page
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+i*2)
+	}
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	}
+	for i, page := range pages {
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", pageWidth, pageHeight, 6+i*2),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(page), page),
+		)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes()
+}
+
+// winAnsiExtras are the characters outside Latin-1 that WinAnsi has room for and translations use
+var winAnsiExtras = map[rune]byte{
+	'€': 0x80,
+	'…': 0x85,
+	'‘': 0x91,
+	'’': 0x92,
+	'“': 0x93,
+	'”': 0x94,
+	'–': 0x96,
+	'—': 0x97,
+}
+
+// pdfString escapes text for a PDF string literal in WinAnsi.
+func pdfString(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			if c, ok := winAnsiExtras[r]; ok {
+				fmt.Fprintf(&b, "\\%03o", c)
+			} else {
+				b.WriteByte('?')
+			}
+		}
+	}
+	return b.String()
+}
+
+// wrap breaks text at spaces into lines of at most width characters. Words longer than a line are left whole.
+func wrap(text string, width int) []string {
+	var lines []string
+	var current strings.Builder
+	for _, word := range strings.Fields(text) {
+		if current.Len() > 0 && utf8.RuneCountInString(current.String())+1+utf8.RuneCountInString(word) > width {
+			lines = append(lines, current.String())
+			current.Reset()
+		}
+		if current.Len() > 0 {
+			current.WriteByte(' ')
+		}
+		current.WriteString(word)
+	}
+	if current.Len() > 0 {
+		lines = append(lines, current.String())
+	}
+	return lines
+}
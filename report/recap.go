@@ -0,0 +1,69 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/i18n"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// recapTemplate writes a recap out a line at a time. Lines starting "# " are headings, lines starting "- " are list
+// items, and everything else is body text.
+var recapTemplate = template.Must(template.New("recap").Parse(`# {{.Title}}
+{{.WeekOf}}
+
+{{range .Highlights}}- {{.}}
+{{end}}`))
+
+type recapView struct {
+	Title      string
+	WeekOf     string
+	Highlights []string
+}
+
+// RenderWeeklyRecap renders a recap as a PDF. The headings are in the localizer's language, the highlights having
+// been written in the driver's language when the recap was prepared.
+func RenderWeeklyRecap(recap store.WeeklyRecap, localizer i18n.Localizer) ([]byte, error) {
+	var text bytes.Buffer
+	err := recapTemplate.Execute(&text, recapView{
+		Title:      localizer.Text(i18n.KeyRecapTitle, nil),
+		WeekOf:     localizer.Text(i18n.KeyRecapWeekOf, map[string]string{"week": recap.WeekStart.UTC().Format(time.DateOnly)}),
+		Highlights: recap.Highlights,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing recap template: %w", err)
+	}
+	return renderPDF(layout(text.String())), nil
+}
+
+// layout turns template output into styled, wrapped lines. List items wrap with their continuation lines indented.
+func layout(text string) []line {
+	var ret []line
+	for _, raw := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(raw, "# "):
+			for _, wrapped := range wrap(strings.TrimPrefix(raw, "# "), headingStyle.wrapAt) {
+				ret = append(ret, line{text: wrapped, style: headingStyle})
+			}
+		case strings.HasPrefix(raw, "- "):
+			for i, wrapped := range wrap(strings.TrimPrefix(raw, "- "), bodyStyle.wrapAt-2) {
+				prefix := "- "
+				if i > 0 {
+					prefix = "  "
+				}
+				ret = append(ret, line{text: prefix + wrapped, style: bodyStyle})
+			}
+		case raw == "":
+			ret = append(ret, line{style: bodyStyle})
+		default:
+			for _, wrapped := range wrap(raw, bodyStyle.wrapAt) {
+				ret = append(ret, line{text: wrapped, style: bodyStyle})
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,90 @@
+package report
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/i18n"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderWeeklyRecap(t *testing.T) {
+	recap := store.WeeklyRecap{
+		DriverID:    12345,
+		WeekStart:   time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC),
+		GeneratedAt: time.Date(2024, 3, 12, 6, 0, 0, 0, time.UTC),
+		Highlights:  []string{"Dein Trainingsplan für die Woche ist fertig."},
+	}
+
+	pdf, err := RenderWeeklyRecap(recap, i18n.For("de"))
+	require.NoError(t, err)
+
+	assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(pdf, []byte("%%EOF\n")))
+	assert.Contains(t, string(pdf), `(Wochenr\374ckblick) Tj`)
+	assert.Contains(t, string(pdf), `(Rennwoche ab 2024-03-12) Tj`)
+	assert.Contains(t, string(pdf), `(- Dein Trainingsplan f\374r die Woche ist fertig.) Tj`)
+	assertValidXref(t, pdf)
+}
+
+func TestRenderPDF_Pages(t *testing.T) {
+	lines := make([]line, 100)
+	for i := range lines {
+		lines[i] = line{text: "lap " + strconv.Itoa(i), style: bodyStyle}
+	}
+
+	pdf := renderPDF(lines)
+
+	assert.Contains(t, string(pdf), "/Count 3")
+	assert.Contains(t, string(pdf), "(lap 99) Tj")
+	assertValidXref(t, pdf)
+}
+
+// assertValidXref checks each xref entry points at the object it claims to
+func assertValidXref(t *testing.T, pdf []byte) {
+	t.Helper()
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(pdf)
+	require.NotNil(t, startxref)
+	xref, err := strconv.Atoi(string(startxref[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(pdf[xref:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n \n`).FindAllSubmatch(pdf[xref:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(pdf[offset:], []byte(strconv.Itoa(i+1)+" 0 obj\n")), "object %d", i+1)
+	}
+}
+
+func TestPDFString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "plain", text: "Lap 3", expected: "Lap 3"},
+		{name: "delimiters escaped", text: `(P1) \o/`, expected: `\(P1\) \\o/`},
+		{name: "latin-1", text: "posição", expected: `posi\347\343o`},
+		{name: "typographic quote", text: "l’arrivée", expected: `l\222arriv\351e`},
+		{name: "outside winansi", text: "日本", expected: "??"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, pdfString(tc.text))
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	assert.Equal(t, []string{"the quick", "brown fox", "jumps"}, wrap("the quick brown fox jumps", 10))
+	assert.Equal(t, []string{"a", "supercalifragilistic", "b"}, wrap("a supercalifragilistic b", 10))
+	assert.Nil(t, wrap("   ", 10))
+}
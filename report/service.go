@@ -0,0 +1,151 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/jonsabados/saturdaysspinout/i18n"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+	"github.com/rs/zerolog"
+)
+
+const downloadURLLifetime = 15 * time.Minute
+
+const recapKeyPrefix = "recaps/"
+
+// ErrRecapNotFound is returned for a week the driver has no recap for.
+var ErrRecapNotFound = errors.New("recap not found")
+
+// Store defines the data access methods needed to render reports.
+type Store interface {
+	GetWeeklyRecap(ctx context.Context, driverID int64) (*store.WeeklyRecap, error)
+	GetDriverSettings(ctx context.Context, driverID int64) (*store.DriverSettings, error)
+}
+
+// ObjectStore is satisfied by s3.Client.
+type ObjectStore interface {
+	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+}
+
+// Presigner is satisfied by s3.PresignClient.
+type Presigner interface {
+	PresignGetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error)
+}
+
+// Download is a presigned link to a report, good until ExpiresAt.
+type Download struct {
+	URL       string
+	ExpiresAt time.Time
+}
+
+// Service renders reports as PDFs, keeping them in S3 and handing out presigned links to download them.
+type Service struct {
+	store     Store
+	objects   ObjectStore
+	presigner Presigner
+	bucket    string
+	now       func() time.Time
+}
+
+func NewService(store Store, objects ObjectStore, presigner Presigner, bucket string) *Service {
+	return &Service{
+		store:     store,
+		objects:   objects,
+		presigner: presigner,
+		bucket:    bucket,
+		now:       time.Now,
+	}
+}
+
+// WeeklyRecapPDF returns a link to the driver's recap for the race week starting weekStart as a PDF. The PDF is
+// rendered from the driver's current recap the first time it's asked for and kept from then on, so it outlives the
+// recap itself, which the next week's replaces. Returns ErrRecapNotFound for a week with neither.
+func (s *Service) WeeklyRecapPDF(ctx context.Context, driverID int64, weekStart time.Time) (*Download, error) {
+	key := recapKey(ctx, driverID, weekStart)
+
+	exists, err := s.exists(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err := s.renderWeeklyRecap(ctx, driverID, weekStart, key); err != nil {
+			return nil, err
+		}
+	}
+
+	now := s.now()
+	presigned, err := s.presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket:                     aws.String(s.bucket),
+		Key:                        aws.String(key),
+		ResponseContentDisposition: aws.String(fmt.Sprintf(`attachment; filename="recap-%s.pdf"`, weekStart.UTC().Format(time.DateOnly))),
+	}, s3.WithPresignExpires(downloadURLLifetime))
+	if err != nil {
+		return nil, fmt.Errorf("presigning recap: %w", err)
+	}
+	return &Download{URL: presigned.URL, ExpiresAt: now.Add(downloadURLLifetime)}, nil
+}
+
+func (s *Service) renderWeeklyRecap(ctx context.Context, driverID int64, weekStart time.Time, key string) error {
+	recap, err := s.store.GetWeeklyRecap(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("getting recap: %w", err)
+	}
+	if recap == nil || !recap.WeekStart.Equal(weekStart) {
+		return ErrRecapNotFound
+	}
+
+	settings, err := s.store.GetDriverSettings(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("getting settings: %w", err)
+	}
+
+	pdf, err := RenderWeeklyRecap(*recap, i18n.For(settings.Locale))
+	if err != nil {
+		return err
+	}
+	_, err = s.objects.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(pdf),
+		ContentType: aws.String("application/pdf"),
+	})
+	if err != nil {
+		return fmt.Errorf("storing recap: %w", err)
+	}
+	zerolog.Ctx(ctx).Info().Int64("driverId", driverID).Time("weekStart", weekStart).Int("bytes", len(pdf)).Msg("rendered recap pdf")
+	return nil
+}
+
+func (s *Service) exists(ctx context.Context, key string) (bool, error) {
+	_, err := s.objects.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	var notFound *types.NotFound
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("checking for recap: %w", err)
+	}
+	return true, nil
+}
+
+// recapKey is where a recap's PDF is kept. Reports of tenants other than the default have their tenant ahead of the
+// driver.
+func recapKey(ctx context.Context, driverID int64, weekStart time.Time) string {
+	prefix := recapKeyPrefix
+	if tenantID := tenant.FromContext(ctx); tenantID != tenant.Default {
+		prefix += tenantID + "/"
+	}
+	return fmt.Sprintf("%s%d/%s.pdf", prefix, driverID, weekStart.UTC().Format(time.DateOnly))
+}
@@ -0,0 +1,122 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_WeeklyRecapPDF(t *testing.T) {
+	driverID := int64(12345)
+	weekStart := time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+	recap := &store.WeeklyRecap{DriverID: driverID, WeekStart: weekStart, Highlights: []string{"Your practice plan for the week is ready."}}
+
+	forKey := func(key string) func(in *s3.HeadObjectInput) bool {
+		return func(in *s3.HeadObjectInput) bool {
+			return *in.Bucket == "report-bucket" && *in.Key == key
+		}
+	}
+	expectPresign := func(p *MockPresigner, key string) {
+		p.EXPECT().PresignGetObject(mock.Anything, mock.MatchedBy(func(in *s3.GetObjectInput) bool {
+			return *in.Bucket == "report-bucket" && *in.Key == key && *in.ResponseContentDisposition == `attachment; filename="recap-2024-03-12.pdf"`
+		}), mock.Anything).Return(&v4.PresignedHTTPRequest{URL: "https://example.com/recap.pdf"}, nil)
+	}
+
+	testCases := []struct {
+		name        string
+		ctx         context.Context
+		setupMocks  func(*MockStore, *MockObjectStore, *MockPresigner)
+		expected    *Download
+		expectedErr error
+	}{
+		{
+			name: "already rendered",
+			ctx:  context.Background(),
+			setupMocks: func(s *MockStore, o *MockObjectStore, p *MockPresigner) {
+				o.EXPECT().HeadObject(mock.Anything, mock.MatchedBy(forKey("recaps/12345/2024-03-12.pdf"))).Return(&s3.HeadObjectOutput{}, nil)
+				expectPresign(p, "recaps/12345/2024-03-12.pdf")
+			},
+			expected: &Download{URL: "https://example.com/recap.pdf", ExpiresAt: now.Add(downloadURLLifetime)},
+		},
+		{
+			name: "rendered from the current recap",
+			ctx:  context.Background(),
+			setupMocks: func(s *MockStore, o *MockObjectStore, p *MockPresigner) {
+				o.EXPECT().HeadObject(mock.Anything, mock.Anything).Return(nil, &types.NotFound{})
+				s.EXPECT().GetWeeklyRecap(mock.Anything, driverID).Return(recap, nil)
+				s.EXPECT().GetDriverSettings(mock.Anything, driverID).Return(&store.DriverSettings{DriverID: driverID, Locale: "en"}, nil)
+				o.EXPECT().PutObject(mock.Anything, mock.MatchedBy(func(in *s3.PutObjectInput) bool {
+					return *in.Bucket == "report-bucket" && *in.Key == "recaps/12345/2024-03-12.pdf" && *in.ContentType == "application/pdf"
+				})).Return(&s3.PutObjectOutput{}, nil)
+				expectPresign(p, "recaps/12345/2024-03-12.pdf")
+			},
+			expected: &Download{URL: "https://example.com/recap.pdf", ExpiresAt: now.Add(downloadURLLifetime)},
+		},
+		{
+			name: "tenant ahead of the driver",
+			ctx:  tenant.WithID(context.Background(), "acme"),
+			setupMocks: func(s *MockStore, o *MockObjectStore, p *MockPresigner) {
+				o.EXPECT().HeadObject(mock.Anything, mock.MatchedBy(forKey("recaps/acme/12345/2024-03-12.pdf"))).Return(&s3.HeadObjectOutput{}, nil)
+				expectPresign(p, "recaps/acme/12345/2024-03-12.pdf")
+			},
+			expected: &Download{URL: "https://example.com/recap.pdf", ExpiresAt: now.Add(downloadURLLifetime)},
+		},
+		{
+			name: "recap has moved on to another week",
+			ctx:  context.Background(),
+			setupMocks: func(s *MockStore, o *MockObjectStore, p *MockPresigner) {
+				o.EXPECT().HeadObject(mock.Anything, mock.Anything).Return(nil, &types.NotFound{})
+				s.EXPECT().GetWeeklyRecap(mock.Anything, driverID).Return(&store.WeeklyRecap{DriverID: driverID, WeekStart: weekStart.AddDate(0, 0, 7)}, nil)
+			},
+			expectedErr: ErrRecapNotFound,
+		},
+		{
+			name: "no recap",
+			ctx:  context.Background(),
+			setupMocks: func(s *MockStore, o *MockObjectStore, p *MockPresigner) {
+				o.EXPECT().HeadObject(mock.Anything, mock.Anything).Return(nil, &types.NotFound{})
+				s.EXPECT().GetWeeklyRecap(mock.Anything, driverID).Return(nil, nil)
+			},
+			expectedErr: ErrRecapNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockObjects := NewMockObjectStore(t)
+			mockPresigner := NewMockPresigner(t)
+			tc.setupMocks(mockStore, mockObjects, mockPresigner)
+
+			svc := NewService(mockStore, mockObjects, mockPresigner, "report-bucket")
+			svc.now = func() time.Time { return now }
+
+			download, err := svc.WeeklyRecapPDF(tc.ctx, driverID, weekStart)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, download)
+		})
+	}
+
+	t.Run("head fails", func(t *testing.T) {
+		mockObjects := NewMockObjectStore(t)
+		mockObjects.EXPECT().HeadObject(mock.Anything, mock.Anything).Return(nil, errors.New("boom"))
+
+		_, err := NewService(NewMockStore(t), mockObjects, NewMockPresigner(t), "report-bucket").WeeklyRecapPDF(context.Background(), driverID, weekStart)
+		assert.Error(t, err)
+	})
+}
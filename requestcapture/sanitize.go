@@ -0,0 +1,70 @@
+package requestcapture
+
+import (
+	"fmt"
+	"mime"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// sensitiveNamePattern matches the names of fields and query parameters holding credentials, such as our own tokens,
+// iRacing's access and refresh tokens, and OAuth authorization codes.
+var sensitiveNamePattern = regexp.MustCompile(`(?i)^(?:.*(?:token|secret|password|authorization|cookie|credential).*|code|code_verifier)$`)
+
+// jsonStringFieldPattern matches a JSON field with a string value. Bodies are matched rather than decoded so those cut
+// short at MaxBodyBytes, which no longer parse, are redacted too.
+var jsonStringFieldPattern = regexp.MustCompile(`("((?:[^"\\]|\\.)*)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// sanitizeBody redacts the values of sensitive fields from a body and cuts it to MaxBodyBytes, returning it along with
+// whether it was cut short. Bodies that aren't text are replaced with a note of their size.
+func sanitizeBody(body []byte, contentType string, truncated bool) (string, bool) {
+	if len(body) == 0 {
+		return "", truncated
+	}
+	if len(body) > MaxBodyBytes {
+		body = body[:MaxBodyBytes]
+		truncated = true
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		return sanitizeQuery(string(body)), truncated
+	case mediaType == "" || mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") || strings.HasPrefix(mediaType, "text/"):
+		text := strings.ToValidUTF8(string(body), "")
+		return jsonStringFieldPattern.ReplaceAllStringFunc(text, redactJSONField), truncated
+	default:
+		return fmt.Sprintf("[%d bytes of %s]", len(body), mediaType), truncated
+	}
+}
+
+func redactJSONField(field string) string {
+	match := jsonStringFieldPattern.FindStringSubmatch(field)
+	if !sensitiveNamePattern.MatchString(match[2]) {
+		return field
+	}
+	return match[1] + `"` + redacted + `"`
+}
+
+// sanitizeQuery redacts the values of sensitive parameters from a query string. Anything that won't parse is dropped
+// rather than risk keeping a secret.
+func sanitizeQuery(query string) string {
+	if query == "" {
+		return ""
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return redacted
+	}
+	for name, vals := range values {
+		if sensitiveNamePattern.MatchString(name) {
+			for i := range vals {
+				vals[i] = redacted
+			}
+		}
+	}
+	return values.Encode()
+}
@@ -0,0 +1,118 @@
+package requestcapture
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeBody(t *testing.T) {
+	testCases := []struct {
+		name              string
+		body              string
+		contentType       string
+		truncated         bool
+		expected          string
+		expectedTruncated bool
+	}{
+		{
+			name:        "empty",
+			contentType: "application/json",
+			expected:    "",
+		},
+		{
+			name:        "json without secrets kept as is",
+			body:        `{"notes": "Held it together", "laps": [1, 2]}`,
+			contentType: "application/json",
+			expected:    `{"notes": "Held it together", "laps": [1, 2]}`,
+		},
+		{
+			name:        "json secrets redacted at any depth",
+			body:        `{"session":{"access_token":"abc","Refresh_Token" : "d\"ef"},"code":"xyz","encoded":"x"}`,
+			contentType: "application/json",
+			expected:    `{"session":{"access_token":"REDACTED","Refresh_Token" : "REDACTED"},"code":"REDACTED","encoded":"x"}`,
+		},
+		{
+			name:        "field names inside values aren't mistaken for fields",
+			body:        `{"notes":"\"token\": \"kept\""}`,
+			contentType: "application/json",
+			expected:    `{"notes":"\"token\": \"kept\""}`,
+		},
+		{
+			name:              "body cut short before capture still redacted",
+			body:              `{"token":"abc","notes":"long`,
+			contentType:       "application/json",
+			truncated:         true,
+			expected:          `{"token":"REDACTED","notes":"long`,
+			expectedTruncated: true,
+		},
+		{
+			name:        "missing content type treated as text",
+			body:        `{"password":"hunter2"}`,
+			contentType: "",
+			expected:    `{"password":"REDACTED"}`,
+		},
+		{
+			name:        "form bodies redacted by parameter",
+			body:        "grant_type=refresh_token&refresh_token=abc",
+			contentType: "application/x-www-form-urlencoded",
+			expected:    "grant_type=refresh_token&refresh_token=REDACTED",
+		},
+		{
+			name:        "binary bodies replaced",
+			body:        "\x00\x01\x02",
+			contentType: "audio/webm",
+			expected:    "[3 bytes of audio/webm]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, truncated := sanitizeBody([]byte(tc.body), tc.contentType, tc.truncated)
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, tc.expectedTruncated, truncated)
+		})
+	}
+}
+
+func TestSanitizeBody_CutsToMaxBodyBytes(t *testing.T) {
+	got, truncated := sanitizeBody([]byte(strings.Repeat("a", MaxBodyBytes+10)), "text/plain", false)
+	assert.Len(t, got, MaxBodyBytes)
+	assert.True(t, truncated)
+}
+
+func TestSanitizeQuery(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "empty",
+			query:    "",
+			expected: "",
+		},
+		{
+			name:     "nothing sensitive",
+			query:    "limit=5&from=2024-01-01",
+			expected: "from=2024-01-01&limit=5",
+		},
+		{
+			name:     "sensitive parameters redacted",
+			query:    "code=abc&state=xyz&accessToken=def",
+			expected: "accessToken=REDACTED&code=REDACTED&state=xyz",
+		},
+		{
+			name:     "unparseable query dropped",
+			query:    "a=%zz",
+			expected: "REDACTED",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, sanitizeQuery(tc.query))
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Package requestcapture keeps copies of a developer's own API requests while they have request capture turned on, so
+// a mismatch between what the frontend sent and what the API answered can be looked at without digging through logs.
+package requestcapture
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// DefaultDuration is how long request capture stays on once turned on. It runs out on its own so a forgotten capture
+// doesn't go on recording every request.
+const DefaultDuration = time.Hour
+
+// MaxBodyBytes is how much of each request and response body is kept, leaving captured requests well inside the size
+// DynamoDB allows an item.
+const MaxBodyBytes = 16 * 1024
+
+// ModeCheckInterval is how long a process goes on trusting what it last read of a developer's capture mode. Turning
+// capture on or off is seen straight away by the process handling it, and by the rest within this.
+const ModeCheckInterval = 30 * time.Second
+
+// Store defines the data access methods needed by the request capture service.
+type Store interface {
+	SaveRequestCaptureMode(ctx context.Context, mode store.RequestCaptureMode) error
+	GetRequestCaptureMode(ctx context.Context, driverID int64) (*store.RequestCaptureMode, error)
+	DeleteRequestCaptureMode(ctx context.Context, driverID int64) error
+	SaveCapturedRequest(ctx context.Context, request store.CapturedRequest) error
+	GetCapturedRequests(ctx context.Context, driverID int64, limit int) ([]store.CapturedRequest, error)
+}
+
+// Request is an API request as it was made, before anything sensitive has been redacted from it. Bodies should already
+// be cut to MaxBodyBytes.
+type Request struct {
+	ID          string
+	DriverID    int64
+	RequestedAt time.Time
+	Method      string
+	Path        string
+	Route       string
+	Query       string
+	Status      int
+	Duration    time.Duration
+
+	RequestContentType    string
+	RequestBody           []byte
+	RequestBodyTruncated  bool
+	ResponseContentType   string
+	ResponseBody          []byte
+	ResponseBodyTruncated bool
+}
+
+type cachedMode struct {
+	until     time.Time // zero when capture is off
+	checkedAt time.Time
+}
+
+// Service turns request capture on and off, and records and lists captured requests.
+type Service struct {
+	store Store
+	now   func() time.Time
+
+	mu    sync.Mutex
+	modes map[int64]cachedMode
+}
+
+func NewService(store Store) *Service {
+	return &Service{
+		store: store,
+		now:   time.Now,
+		modes: make(map[int64]cachedMode),
+	}
+}
+
+// Enable turns on request capture for the developer for DefaultDuration, restarting it if it was already on.
+func (s *Service) Enable(ctx context.Context, driverID int64) (*store.RequestCaptureMode, error) {
+	now := s.now()
+	mode := store.RequestCaptureMode{
+		DriverID:  driverID,
+		EnabledAt: now,
+		Until:     now.Add(DefaultDuration),
+	}
+	if err := s.store.SaveRequestCaptureMode(ctx, mode); err != nil {
+		return nil, err
+	}
+	s.remember(driverID, mode.Until, now)
+	return &mode, nil
+}
+
+// Disable turns off request capture for the developer. What was already captured is kept until it expires.
+func (s *Service) Disable(ctx context.Context, driverID int64) error {
+	if err := s.store.DeleteRequestCaptureMode(ctx, driverID); err != nil {
+		return err
+	}
+	s.remember(driverID, time.Time{}, s.now())
+	return nil
+}
+
+// Mode returns the developer's request capture mode, or nil if capture is off.
+func (s *Service) Mode(ctx context.Context, driverID int64) (*store.RequestCaptureMode, error) {
+	return s.store.GetRequestCaptureMode(ctx, driverID)
+}
+
+// Capturing reports whether the developer's requests are being captured, reading their capture mode at most once per
+// ModeCheckInterval. A failed read is logged and treated as capture being off, since capturing is never worth failing
+// a request over.
+func (s *Service) Capturing(ctx context.Context, driverID int64) bool {
+	now := s.now()
+
+	s.mu.Lock()
+	cached, ok := s.modes[driverID]
+	s.mu.Unlock()
+	if ok && now.Sub(cached.checkedAt) < ModeCheckInterval {
+		return now.Before(cached.until)
+	}
+
+	mode, err := s.store.GetRequestCaptureMode(ctx, driverID)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to read request capture mode")
+		return false
+	}
+	var until time.Time
+	if mode != nil {
+		until = mode.Until
+	}
+	s.remember(driverID, until, now)
+	return now.Before(until)
+}
+
+func (s *Service) remember(driverID int64, until, checkedAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.modes[driverID] = cachedMode{until: until, checkedAt: checkedAt}
+}
+
+// Capture records a request after redacting anything sensitive from it.
+func (s *Service) Capture(ctx context.Context, request Request) error {
+	requestBody, requestTruncated := sanitizeBody(request.RequestBody, request.RequestContentType, request.RequestBodyTruncated)
+	responseBody, responseTruncated := sanitizeBody(request.ResponseBody, request.ResponseContentType, request.ResponseBodyTruncated)
+	return s.store.SaveCapturedRequest(ctx, store.CapturedRequest{
+		ID:                    request.ID,
+		DriverID:              request.DriverID,
+		RequestedAt:           request.RequestedAt,
+		Method:                request.Method,
+		Path:                  request.Path,
+		Route:                 request.Route,
+		Query:                 sanitizeQuery(request.Query),
+		Status:                request.Status,
+		Duration:              request.Duration,
+		RequestContentType:    request.RequestContentType,
+		RequestBody:           requestBody,
+		RequestBodyTruncated:  requestTruncated,
+		ResponseContentType:   request.ResponseContentType,
+		ResponseBody:          responseBody,
+		ResponseBodyTruncated: responseTruncated,
+	})
+}
+
+// List returns the developer's most recently captured requests, newest first.
+func (s *Service) List(ctx context.Context, driverID int64, limit int) ([]store.CapturedRequest, error) {
+	return s.store.GetCapturedRequests(ctx, driverID, limit)
+}
@@ -0,0 +1,187 @@
+package requestcapture
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+func TestService_Enable(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	expected := store.RequestCaptureMode{DriverID: 12345, EnabledAt: now, Until: now.Add(DefaultDuration)}
+
+	testCases := []struct {
+		name      string
+		saveErr   error
+		expected  *store.RequestCaptureMode
+		expectErr bool
+	}{
+		{
+			name:     "success",
+			expected: &expected,
+		},
+		{
+			name:      "save error",
+			saveErr:   errors.New("database error"),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().SaveRequestCaptureMode(mock.Anything, expected).Return(tc.saveErr)
+
+			svc := NewService(mockStore)
+			svc.now = func() time.Time { return now }
+
+			got, err := svc.Enable(ctx, 12345)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+			// the process turning capture on sees it without reading it back
+			assert.True(t, svc.Capturing(ctx, 12345))
+		})
+	}
+}
+
+func TestService_Disable(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().SaveRequestCaptureMode(mock.Anything, mock.Anything).Return(nil)
+	mockStore.EXPECT().DeleteRequestCaptureMode(mock.Anything, int64(12345)).Return(nil)
+
+	svc := NewService(mockStore)
+	svc.now = func() time.Time { return now }
+
+	_, err := svc.Enable(ctx, 12345)
+	require.NoError(t, err)
+	require.NoError(t, svc.Disable(ctx, 12345))
+	assert.False(t, svc.Capturing(ctx, 12345))
+}
+
+func TestService_Capturing(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+
+	type getCall struct {
+		mode *store.RequestCaptureMode
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+		// checks are made at these offsets from now, with the expected result of each
+		checkOffsets []time.Duration
+		getCalls     []getCall
+		expected     []bool
+	}{
+		{
+			name:         "capture off",
+			checkOffsets: []time.Duration{0},
+			getCalls:     []getCall{{}},
+			expected:     []bool{false},
+		},
+		{
+			name:         "capture on",
+			checkOffsets: []time.Duration{0},
+			getCalls:     []getCall{{mode: &store.RequestCaptureMode{DriverID: 12345, Until: now.Add(time.Hour)}}},
+			expected:     []bool{true},
+		},
+		{
+			name:         "mode is trusted for the check interval",
+			checkOffsets: []time.Duration{0, ModeCheckInterval - time.Second},
+			getCalls:     []getCall{{mode: &store.RequestCaptureMode{DriverID: 12345, Until: now.Add(time.Hour)}}},
+			expected:     []bool{true, true},
+		},
+		{
+			name:         "mode is read again after the check interval",
+			checkOffsets: []time.Duration{0, ModeCheckInterval},
+			getCalls: []getCall{
+				{mode: &store.RequestCaptureMode{DriverID: 12345, Until: now.Add(time.Hour)}},
+				{},
+			},
+			expected: []bool{true, false},
+		},
+		{
+			name:         "capture runs out between reads",
+			checkOffsets: []time.Duration{0, 10 * time.Second},
+			getCalls:     []getCall{{mode: &store.RequestCaptureMode{DriverID: 12345, Until: now.Add(5 * time.Second)}}},
+			expected:     []bool{true, false},
+		},
+		{
+			name:         "read error treated as off",
+			checkOffsets: []time.Duration{0},
+			getCalls:     []getCall{{err: errors.New("database error")}},
+			expected:     []bool{false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			for _, call := range tc.getCalls {
+				mockStore.EXPECT().GetRequestCaptureMode(mock.Anything, int64(12345)).Return(call.mode, call.err).Once()
+			}
+
+			svc := NewService(mockStore)
+			for i, offset := range tc.checkOffsets {
+				svc.now = func() time.Time { return now.Add(offset) }
+				assert.Equal(t, tc.expected[i], svc.Capturing(ctx, 12345), "check %d", i)
+			}
+		})
+	}
+}
+
+func TestService_Capture(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().SaveCapturedRequest(mock.Anything, store.CapturedRequest{
+		ID:                  "correlation-id",
+		DriverID:            12345,
+		RequestedAt:         now,
+		Method:              "POST",
+		Path:                "/auth/refresh",
+		Route:               "/auth/refresh",
+		Query:               "code=REDACTED&state=abc",
+		Status:              200,
+		Duration:            15 * time.Millisecond,
+		RequestContentType:  "application/json",
+		RequestBody:         `{"refresh_token":"REDACTED"}`,
+		ResponseContentType: "application/json; charset=utf-8",
+		ResponseBody:        `{"token":"REDACTED","expires_at":1700003600}`,
+	}).Return(nil)
+
+	svc := NewService(mockStore)
+	err := svc.Capture(ctx, Request{
+		ID:                  "correlation-id",
+		DriverID:            12345,
+		RequestedAt:         now,
+		Method:              "POST",
+		Path:                "/auth/refresh",
+		Route:               "/auth/refresh",
+		Query:               "state=abc&code=secret-code",
+		Status:              200,
+		Duration:            15 * time.Millisecond,
+		RequestContentType:  "application/json",
+		RequestBody:         []byte(`{"refresh_token":"secret-refresh"}`),
+		ResponseContentType: "application/json; charset=utf-8",
+		ResponseBody:        []byte(`{"token":"secret-jwt","expires_at":1700003600}`),
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,145 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// Store defines the data access methods needed to compact lap data.
+type Store interface {
+	GetDriverSettingsWithLapRetention(ctx context.Context) ([]store.DriverSettings, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	GetSessionDriverLaps(ctx context.Context, subsessionID, driverID int64) ([]store.SessionDriverLap, error)
+	SaveSessionDriverLapSummary(ctx context.Context, summary store.SessionDriverLapSummary) error
+	DeleteSessionDriverLaps(ctx context.Context, subsessionID, driverID int64) error
+}
+
+type MetricsClient interface {
+	EmitCount(ctx context.Context, name string, count int) error
+}
+
+// Compactor applies drivers' lap retention settings, rolling laps older than the retention period into per-race
+// summaries and deleting the individual lap records.
+type Compactor struct {
+	store         Store
+	metricsClient MetricsClient
+	now           func() time.Time
+}
+
+// NewCompactor creates a new lap compactor.
+func NewCompactor(store Store, metricsClient MetricsClient) *Compactor {
+	return &Compactor{
+		store:         store,
+		metricsClient: metricsClient,
+		now:           time.Now,
+	}
+}
+
+// CompactAll compacts laps for every driver with a lap retention period. A failure for one driver doesn't stop the
+// others from being compacted; all failures are returned together.
+func (c *Compactor) CompactAll(ctx context.Context) error {
+	logger := zerolog.Ctx(ctx)
+
+	settings, err := c.store.GetDriverSettingsWithLapRetention(ctx)
+	if err != nil {
+		return fmt.Errorf("getting driver settings: %w", err)
+	}
+
+	var errs []error
+	totalLaps := 0
+	for _, s := range settings {
+		lapCount, err := c.CompactDriver(ctx, s)
+		totalLaps += lapCount
+		if err != nil {
+			errs = append(errs, fmt.Errorf("compacting laps for driver %d: %w", s.DriverID, err))
+		}
+	}
+
+	if err := c.metricsClient.EmitCount(ctx, metrics.LapsCompacted, totalLaps); err != nil {
+		logger.Warn().Err(err).Msg("failed to emit laps compacted metric")
+	}
+
+	logger.Info().Int("driverCount", len(settings)).Int("lapCount", totalLaps).Msg("lap compaction complete")
+	return errors.Join(errs...)
+}
+
+// CompactDriver compacts the driver's own laps from races older than their retention period, returning the number of
+// laps removed. Laps other participants stored in the same sessions are left alone. Drivers without a retention
+// period are skipped.
+func (c *Compactor) CompactDriver(ctx context.Context, settings store.DriverSettings) (int, error) {
+	if settings.LapRetentionMonths <= 0 {
+		return 0, nil
+	}
+
+	now := c.now()
+	cutoff := now.AddDate(0, -settings.LapRetentionMonths, 0)
+
+	sessions, err := c.store.GetDriverSessionsByTimeRange(ctx, settings.DriverID, time.Unix(0, 0), cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("getting sessions: %w", err)
+	}
+
+	lapCount := 0
+	for _, session := range sessions {
+		laps, err := c.store.GetSessionDriverLaps(ctx, session.SubsessionID, settings.DriverID)
+		if err != nil {
+			return lapCount, fmt.Errorf("getting laps for session %d: %w", session.SubsessionID, err)
+		}
+		if len(laps) == 0 {
+			continue
+		}
+
+		// summary goes first so a failed delete never loses data, the next run just tries the delete again
+		summary := SummarizeLaps(session.SubsessionID, settings.DriverID, laps)
+		summary.CompactedAt = now
+		if err := c.store.SaveSessionDriverLapSummary(ctx, summary); err != nil {
+			return lapCount, fmt.Errorf("saving lap summary for session %d: %w", session.SubsessionID, err)
+		}
+		if err := c.store.DeleteSessionDriverLaps(ctx, session.SubsessionID, settings.DriverID); err != nil {
+			return lapCount, fmt.Errorf("deleting laps for session %d: %w", session.SubsessionID, err)
+		}
+		lapCount += len(laps)
+	}
+
+	return lapCount, nil
+}
+
+// SummarizeLaps rolls a driver's laps in a session up into a summary. Lap 0 (grid to start line) isn't counted.
+func SummarizeLaps(subsessionID, driverID int64, laps []store.SessionDriverLap) store.SessionDriverLapSummary {
+	summary := store.SessionDriverLapSummary{
+		SubsessionID: subsessionID,
+		DriverID:     driverID,
+		BestLapTime:  -1,
+		AvgLapTime:   -1,
+	}
+
+	totalTime := 0
+	for _, lap := range laps {
+		if lap.LapNumber < 1 {
+			continue
+		}
+		summary.LapCount++
+		if lap.Incident {
+			summary.IncidentLapCount++
+		}
+		if lap.LapTime <= 0 {
+			continue
+		}
+		summary.ValidLapCount++
+		totalTime += lap.LapTime
+		if summary.BestLapTime < 0 || lap.LapTime < summary.BestLapTime {
+			summary.BestLapTime = lap.LapTime
+		}
+	}
+
+	if summary.ValidLapCount > 0 {
+		summary.AvgLapTime = totalTime / summary.ValidLapCount
+	}
+	return summary
+}
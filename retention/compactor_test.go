@@ -0,0 +1,185 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeLaps(t *testing.T) {
+	testCases := []struct {
+		name     string
+		laps     []store.SessionDriverLap
+		expected store.SessionDriverLapSummary
+	}{
+		{
+			name: "mixed laps",
+			laps: []store.SessionDriverLap{
+				{LapNumber: 0, LapTime: 50000},
+				{LapNumber: 1, LapTime: 980000, Incident: true},
+				{LapNumber: 2, LapTime: 950000},
+				{LapNumber: 3, LapTime: -1, Incident: true},
+				{LapNumber: 4, LapTime: 960000},
+			},
+			expected: store.SessionDriverLapSummary{
+				SubsessionID:     12345,
+				DriverID:         1001,
+				LapCount:         4,
+				ValidLapCount:    3,
+				IncidentLapCount: 2,
+				BestLapTime:      950000,
+				AvgLapTime:       963333,
+			},
+		},
+		{
+			name: "no valid lap times",
+			laps: []store.SessionDriverLap{
+				{LapNumber: 1, LapTime: -1},
+			},
+			expected: store.SessionDriverLapSummary{
+				SubsessionID: 12345,
+				DriverID:     1001,
+				LapCount:     1,
+				BestLapTime:  -1,
+				AvgLapTime:   -1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SummarizeLaps(12345, 1001, tc.laps))
+		})
+	}
+}
+
+func TestCompactor_CompactAll(t *testing.T) {
+	now := time.Date(2025, 6, 15, 3, 0, 0, 0, time.UTC)
+	driverCutoff := time.Date(2024, 6, 15, 3, 0, 0, 0, time.UTC) // 12 months
+	otherCutoff := time.Date(2025, 3, 15, 3, 0, 0, 0, time.UTC)  // 3 months
+
+	oldLaps := []store.SessionDriverLap{
+		{SubsessionID: 100, DriverID: 1001, LapNumber: 1, LapTime: 950000},
+		{SubsessionID: 100, DriverID: 1001, LapNumber: 2, LapTime: 970000},
+	}
+
+	type lapsCall struct {
+		subsessionID int64
+		driverID     int64
+		laps         []store.SessionDriverLap
+	}
+
+	testCases := []struct {
+		name string
+
+		settings      []store.DriverSettings
+		settingsErr   error
+		sessionsCalls map[int64][]store.DriverSession
+		sessionsErr   error
+		lapsCalls     []lapsCall
+		summaries     []store.SessionDriverLapSummary
+		deleteErr     error
+		metricCount   *int
+
+		expectedErr string
+	}{
+		{
+			name: "compacts old laps",
+			settings: []store.DriverSettings{
+				{DriverID: 1001, LapRetentionMonths: 12},
+				{DriverID: 2002, LapRetentionMonths: 3},
+			},
+			sessionsCalls: map[int64][]store.DriverSession{
+				1001: {{SubsessionID: 100}, {SubsessionID: 101}},
+				2002: {},
+			},
+			lapsCalls: []lapsCall{
+				{subsessionID: 100, driverID: 1001, laps: oldLaps},
+				{subsessionID: 101, driverID: 1001, laps: []store.SessionDriverLap{}}, // already compacted
+			},
+			summaries: []store.SessionDriverLapSummary{
+				{SubsessionID: 100, DriverID: 1001, LapCount: 2, ValidLapCount: 2, BestLapTime: 950000, AvgLapTime: 960000, CompactedAt: now},
+			},
+			metricCount: intPtr(2),
+		},
+		{
+			name:        "no drivers with retention",
+			settings:    []store.DriverSettings{},
+			metricCount: intPtr(0),
+		},
+		{
+			name:        "settings lookup error",
+			settingsErr: errors.New("database error"),
+			expectedErr: "getting driver settings: database error",
+		},
+		{
+			name: "delete error reported but other drivers still compacted",
+			settings: []store.DriverSettings{
+				{DriverID: 1001, LapRetentionMonths: 12},
+				{DriverID: 2002, LapRetentionMonths: 3},
+			},
+			sessionsCalls: map[int64][]store.DriverSession{
+				1001: {{SubsessionID: 100}},
+				2002: {},
+			},
+			lapsCalls: []lapsCall{
+				{subsessionID: 100, driverID: 1001, laps: oldLaps},
+			},
+			summaries: []store.SessionDriverLapSummary{
+				{SubsessionID: 100, DriverID: 1001, LapCount: 2, ValidLapCount: 2, BestLapTime: 950000, AvgLapTime: 960000, CompactedAt: now},
+			},
+			deleteErr:   errors.New("throttled"),
+			metricCount: intPtr(0),
+			expectedErr: "compacting laps for driver 1001: deleting laps for session 100: throttled",
+		},
+	}
+
+	cutoffs := map[int64]time.Time{1001: driverCutoff, 2002: otherCutoff}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			mockStore := NewMockStore(t)
+			mockMetrics := NewMockMetricsClient(t)
+
+			mockStore.EXPECT().GetDriverSettingsWithLapRetention(mock.Anything).Return(tc.settings, tc.settingsErr)
+			for driverID, sessions := range tc.sessionsCalls {
+				mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, time.Unix(0, 0), cutoffs[driverID]).
+					Return(sessions, tc.sessionsErr)
+			}
+			for _, call := range tc.lapsCalls {
+				mockStore.EXPECT().GetSessionDriverLaps(mock.Anything, call.subsessionID, call.driverID).Return(call.laps, nil)
+			}
+			for _, summary := range tc.summaries {
+				mockStore.EXPECT().SaveSessionDriverLapSummary(mock.Anything, summary).Return(nil)
+				mockStore.EXPECT().DeleteSessionDriverLaps(mock.Anything, summary.SubsessionID, summary.DriverID).Return(tc.deleteErr)
+			}
+			if tc.metricCount != nil {
+				mockMetrics.EXPECT().EmitCount(mock.Anything, metrics.LapsCompacted, *tc.metricCount).Return(nil)
+			}
+
+			compactor := NewCompactor(mockStore, mockMetrics)
+			compactor.now = func() time.Time { return now }
+
+			err := compactor.CompactAll(ctx)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,225 @@
+package rollup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/datachange"
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/standings"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// Milestone names
+const (
+	MilestoneFirstRace      = "first_race"
+	MilestoneFirstTop5      = "first_top5"
+	MilestoneFirstPodium    = "first_podium"
+	MilestoneFirstWin       = "first_win"
+	MilestoneFirstCleanRace = "first_clean_race"
+)
+
+// iRatingMilestones are the iRatings a driver is congratulated for reaching
+var iRatingMilestones = []int{1500, 2000, 2500, 3000, 4000, 5000}
+
+// IRatingMilestone names the milestone for reaching an iRating
+func IRatingMilestone(iRating int) string {
+	return fmt.Sprintf("irating_%d", iRating)
+}
+
+// Store defines the data access methods needed to maintain derived data.
+type Store interface {
+	AddToDriverRollup(ctx context.Context, driverID int64, scope string, subsessionID int64, totals store.SessionTotals) (bool, error)
+	AddToWeeklyLeaderboard(ctx context.Context, weekStart time.Time, driverID, subsessionID int64, totals store.SessionTotals) (bool, error)
+	RecordMilestone(ctx context.Context, milestone store.DriverMilestone) (bool, error)
+}
+
+type MetricsClient interface {
+	EmitCount(ctx context.Context, name string, count int) error
+}
+
+type OnboardingTracker interface {
+	Advance(ctx context.Context, driverID int64, step store.OnboardingStep) error
+}
+
+type ChangeNotifier interface {
+	Notify(ctx context.Context, driverID int64, resources ...datachange.Resource)
+}
+
+type ProcessorOption func(*Processor)
+
+// WithOnboardingTracker marks the driver's first race as ingested in their onboarding once its first_race milestone
+// is recorded.
+func WithOnboardingTracker(tracker OnboardingTracker) ProcessorOption {
+	return func(p *Processor) {
+		p.onboardingTracker = tracker
+	}
+}
+
+// WithChangeNotifier hints to the driver's connections that their rollups or milestones changed, when processing a
+// session changed either.
+func WithChangeNotifier(notifier ChangeNotifier) ProcessorOption {
+	return func(p *Processor) {
+		p.changeNotifier = notifier
+	}
+}
+
+// Processor maintains the data derived from driver sessions: rollups, weekly leaderboards and milestones. Every write
+// it makes is idempotent, so a session can be processed any number of times and sessions can arrive in any order.
+type Processor struct {
+	store             Store
+	metricsClient     MetricsClient
+	onboardingTracker OnboardingTracker
+	changeNotifier    ChangeNotifier
+}
+
+// NewProcessor creates a new rollup processor.
+func NewProcessor(store Store, metricsClient MetricsClient, opts ...ProcessorOption) *Processor {
+	p := &Processor{
+		store:         store,
+		metricsClient: metricsClient,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// ProcessSession counts a newly written session towards the driver's all time and season rollups and their weekly
+// leaderboard entry, and records any milestones it achieved.
+func (p *Processor) ProcessSession(ctx context.Context, session store.DriverSession) error {
+	logger := zerolog.Ctx(ctx).With().Int64("driverID", session.DriverID).Int64("subsessionID", session.SubsessionID).Logger()
+
+	totals := TotalsForSession(session)
+
+	scopes := []string{store.RollupScopeAllTime}
+	// sessions ingested before championship data was recorded don't know their season
+	if session.SeasonID != 0 {
+		scopes = append(scopes, store.RollupScopeSeason(session.SeasonID))
+	}
+	rollupsChanged := false
+	for _, scope := range scopes {
+		added, err := p.store.AddToDriverRollup(ctx, session.DriverID, scope, session.SubsessionID, totals)
+		if err != nil {
+			return fmt.Errorf("adding to %s rollup: %w", scope, err)
+		}
+		if added {
+			rollupsChanged = true
+		} else {
+			logger.Debug().Str("scope", scope).Msg("session already counted in rollup")
+		}
+	}
+
+	weekStart := standings.WeekStart(session.StartTime)
+	added, err := p.store.AddToWeeklyLeaderboard(ctx, weekStart, session.DriverID, session.SubsessionID, totals)
+	if err != nil {
+		return fmt.Errorf("adding to weekly leaderboard: %w", err)
+	}
+	if !added {
+		logger.Debug().Time("weekStart", weekStart).Msg("session already counted in weekly leaderboard")
+	}
+
+	achieved := 0
+	for _, milestone := range MilestonesForSession(session) {
+		recorded, err := p.store.RecordMilestone(ctx, store.DriverMilestone{
+			DriverID:     session.DriverID,
+			Milestone:    milestone,
+			AchievedAt:   session.StartTime,
+			SubsessionID: session.SubsessionID,
+		})
+		if err != nil {
+			return fmt.Errorf("recording milestone %s: %w", milestone, err)
+		}
+		if recorded {
+			achieved++
+			logger.Info().Str("milestone", milestone).Msg("milestone recorded")
+			if milestone == MilestoneFirstRace {
+				p.advanceOnboarding(ctx, session.DriverID)
+			}
+		}
+	}
+
+	if achieved > 0 {
+		if err := p.metricsClient.EmitCount(ctx, metrics.MilestonesRecorded, achieved); err != nil {
+			logger.Warn().Err(err).Msg("failed to emit milestones recorded metric")
+		}
+	}
+	p.notifyChanged(ctx, session.DriverID, rollupsChanged, achieved > 0)
+	return nil
+}
+
+// notifyChanged hints at what processing a session changed. A replayed session changes nothing, so the driver only
+// hears about a session the first time it's processed.
+func (p *Processor) notifyChanged(ctx context.Context, driverID int64, rollupsChanged, milestonesChanged bool) {
+	if p.changeNotifier == nil {
+		return
+	}
+	var changed []datachange.Resource
+	if rollupsChanged {
+		changed = append(changed, datachange.ResourceRollups)
+	}
+	if milestonesChanged {
+		changed = append(changed, datachange.ResourceMilestones)
+	}
+	if len(changed) > 0 {
+		p.changeNotifier.Notify(ctx, driverID, changed...)
+	}
+}
+
+// advanceOnboarding is best effort, a failure here would otherwise have the whole batch retried and the milestone
+// that triggered it is already recorded, so it would never be seen again anyway.
+func (p *Processor) advanceOnboarding(ctx context.Context, driverID int64) {
+	if p.onboardingTracker == nil {
+		return
+	}
+	if err := p.onboardingTracker.Advance(ctx, driverID, store.OnboardingStepRaceIngested); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Int64("driverID", driverID).Msg("failed to advance onboarding")
+	}
+}
+
+// TotalsForSession is a single session's contribution to SessionTotals. Finishing positions are 0 based.
+func TotalsForSession(session store.DriverSession) store.SessionTotals {
+	totals := store.SessionTotals{
+		Races:          1,
+		Incidents:      session.Incidents,
+		IRatingChange:  session.NewIRating - session.OldIRating,
+		SubLevelChange: session.NewSubLevel - session.OldSubLevel,
+		LapsComplete:   session.LapsComplete,
+		LapsLead:       session.LapsLead,
+	}
+	if session.FinishPosition == 0 {
+		totals.Wins = 1
+	}
+	if session.FinishPosition <= 2 {
+		totals.Podiums = 1
+	}
+	if session.FinishPosition <= 4 {
+		totals.Top5s = 1
+	}
+	return totals
+}
+
+// MilestonesForSession lists the milestones a session would achieve were it the driver's first to qualify for them.
+func MilestonesForSession(session store.DriverSession) []string {
+	milestones := []string{MilestoneFirstRace}
+	if session.FinishPosition <= 4 {
+		milestones = append(milestones, MilestoneFirstTop5)
+	}
+	if session.FinishPosition <= 2 {
+		milestones = append(milestones, MilestoneFirstPodium)
+	}
+	if session.FinishPosition == 0 {
+		milestones = append(milestones, MilestoneFirstWin)
+	}
+	if session.Incidents == 0 {
+		milestones = append(milestones, MilestoneFirstCleanRace)
+	}
+	for _, iRating := range iRatingMilestones {
+		if session.NewIRating >= iRating {
+			milestones = append(milestones, IRatingMilestone(iRating))
+		}
+	}
+	return milestones
+}
@@ -0,0 +1,235 @@
+package rollup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/datachange"
+	"github.com/jonsabados/saturdaysspinout/metrics"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotalsForSession(t *testing.T) {
+	testCases := []struct {
+		name     string
+		session  store.DriverSession
+		expected store.SessionTotals
+	}{
+		{
+			name:     "win",
+			session:  store.DriverSession{FinishPosition: 0, Incidents: 2, OldIRating: 2000, NewIRating: 2080, OldSubLevel: 312, NewSubLevel: 321, LapsComplete: 20, LapsLead: 15},
+			expected: store.SessionTotals{Races: 1, Wins: 1, Podiums: 1, Top5s: 1, Incidents: 2, IRatingChange: 80, SubLevelChange: 9, LapsComplete: 20, LapsLead: 15},
+		},
+		{
+			name:     "podium",
+			session:  store.DriverSession{FinishPosition: 2, OldIRating: 2000, NewIRating: 2030, LapsComplete: 20},
+			expected: store.SessionTotals{Races: 1, Podiums: 1, Top5s: 1, IRatingChange: 30, LapsComplete: 20},
+		},
+		{
+			name:     "top 5",
+			session:  store.DriverSession{FinishPosition: 4, Incidents: 4, OldIRating: 2000, NewIRating: 2005, LapsComplete: 20},
+			expected: store.SessionTotals{Races: 1, Top5s: 1, Incidents: 4, IRatingChange: 5, LapsComplete: 20},
+		},
+		{
+			name:     "midpack",
+			session:  store.DriverSession{FinishPosition: 11, Incidents: 12, OldIRating: 2000, NewIRating: 1940, OldSubLevel: 312, NewSubLevel: 270, LapsComplete: 18},
+			expected: store.SessionTotals{Races: 1, Incidents: 12, IRatingChange: -60, SubLevelChange: -42, LapsComplete: 18},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, TotalsForSession(tc.session))
+		})
+	}
+}
+
+func TestMilestonesForSession(t *testing.T) {
+	testCases := []struct {
+		name     string
+		session  store.DriverSession
+		expected []string
+	}{
+		{
+			name:     "clean win over 2000",
+			session:  store.DriverSession{FinishPosition: 0, Incidents: 0, NewIRating: 2100},
+			expected: []string{MilestoneFirstRace, MilestoneFirstTop5, MilestoneFirstPodium, MilestoneFirstWin, MilestoneFirstCleanRace, "irating_1500", "irating_2000"},
+		},
+		{
+			name:     "messy midpack",
+			session:  store.DriverSession{FinishPosition: 9, Incidents: 8, NewIRating: 1350},
+			expected: []string{MilestoneFirstRace},
+		},
+		{
+			name:     "podium",
+			session:  store.DriverSession{FinishPosition: 1, Incidents: 4, NewIRating: 1500},
+			expected: []string{MilestoneFirstRace, MilestoneFirstTop5, MilestoneFirstPodium, "irating_1500"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, MilestonesForSession(tc.session))
+		})
+	}
+}
+
+func TestProcessor_ProcessSession(t *testing.T) {
+	// a Thursday, so the race week started the Tuesday before
+	startTime := time.Date(2025, 6, 12, 18, 0, 0, 0, time.UTC)
+	weekStart := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	session := store.DriverSession{
+		DriverID:       1001,
+		SubsessionID:   5000,
+		SeasonID:       4500,
+		StartTime:      startTime,
+		FinishPosition: 3,
+		Incidents:      4,
+		OldIRating:     1480,
+		NewIRating:     1510,
+		LapsComplete:   20,
+	}
+	totals := store.SessionTotals{Races: 1, Top5s: 1, Incidents: 4, IRatingChange: 30, LapsComplete: 20}
+	milestone := func(name string) store.DriverMilestone {
+		return store.DriverMilestone{DriverID: 1001, Milestone: name, AchievedAt: startTime, SubsessionID: 5000}
+	}
+
+	testCases := []struct {
+		name string
+
+		session        store.DriverSession
+		alreadyCounted bool
+		expectSeason   bool
+		rollupErr      error
+		leaderboard    bool
+		milestoneErr   error
+		recorded       map[string]bool
+		metricCount    *int
+		onboarding     bool
+		onboardErr     error
+		changed        []datachange.Resource
+		expectedErr    string
+	}{
+		{
+			name:         "first of everything",
+			session:      session,
+			expectSeason: true,
+			leaderboard:  true,
+			recorded: map[string]bool{
+				MilestoneFirstRace: true,
+				MilestoneFirstTop5: true,
+				"irating_1500":     true,
+			},
+			metricCount: intPtr(3),
+			onboarding:  true,
+			changed:     []datachange.Resource{datachange.ResourceRollups, datachange.ResourceMilestones},
+		},
+		{
+			name:         "onboarding error doesn't fail processing",
+			session:      session,
+			expectSeason: true,
+			leaderboard:  true,
+			recorded: map[string]bool{
+				MilestoneFirstRace: true,
+				MilestoneFirstTop5: false,
+				"irating_1500":     false,
+			},
+			metricCount: intPtr(1),
+			onboarding:  true,
+			onboardErr:  errors.New("throttled"),
+			changed:     []datachange.Resource{datachange.ResourceRollups, datachange.ResourceMilestones},
+		},
+		{
+			name:           "nothing new",
+			session:        session,
+			alreadyCounted: true,
+			expectSeason:   true,
+			leaderboard:    true,
+			recorded: map[string]bool{
+				MilestoneFirstRace: false,
+				MilestoneFirstTop5: false,
+				"irating_1500":     false,
+			},
+		},
+		{
+			name: "no season",
+			session: func() store.DriverSession {
+				s := session
+				s.SeasonID = 0
+				return s
+			}(),
+			leaderboard: true,
+			recorded: map[string]bool{
+				MilestoneFirstRace: false,
+				MilestoneFirstTop5: true,
+				"irating_1500":     false,
+			},
+			metricCount: intPtr(1),
+			changed:     []datachange.Resource{datachange.ResourceRollups, datachange.ResourceMilestones},
+		},
+		{
+			name:        "rollup error",
+			session:     session,
+			rollupErr:   errors.New("throttled"),
+			expectedErr: "adding to all rollup: throttled",
+		},
+		{
+			name:         "milestone error",
+			session:      session,
+			expectSeason: true,
+			leaderboard:  true,
+			milestoneErr: errors.New("throttled"),
+			recorded:     map[string]bool{MilestoneFirstRace: false},
+			expectedErr:  "recording milestone first_race: throttled",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+			mockStore := NewMockStore(t)
+			mockMetrics := NewMockMetricsClient(t)
+			mockTracker := NewMockOnboardingTracker(t)
+			mockNotifier := NewMockChangeNotifier(t)
+
+			mockStore.EXPECT().AddToDriverRollup(mock.Anything, int64(1001), store.RollupScopeAllTime, int64(5000), totals).Return(!tc.alreadyCounted, tc.rollupErr)
+			if tc.expectSeason {
+				mockStore.EXPECT().AddToDriverRollup(mock.Anything, int64(1001), store.RollupScopeSeason(4500), int64(5000), totals).Return(false, nil)
+			}
+			if tc.leaderboard {
+				mockStore.EXPECT().AddToWeeklyLeaderboard(mock.Anything, weekStart, int64(1001), int64(5000), totals).Return(true, nil)
+			}
+			for name, recorded := range tc.recorded {
+				mockStore.EXPECT().RecordMilestone(mock.Anything, milestone(name)).Return(recorded, tc.milestoneErr)
+			}
+			if tc.metricCount != nil {
+				mockMetrics.EXPECT().EmitCount(mock.Anything, metrics.MilestonesRecorded, *tc.metricCount).Return(nil)
+			}
+			if tc.onboarding {
+				mockTracker.EXPECT().Advance(mock.Anything, int64(1001), store.OnboardingStepRaceIngested).Return(tc.onboardErr)
+			}
+			if tc.changed != nil {
+				mockNotifier.EXPECT().Notify(mock.Anything, int64(1001), tc.changed).Return()
+			}
+
+			err := NewProcessor(mockStore, mockMetrics, WithOnboardingTracker(mockTracker), WithChangeNotifier(mockNotifier)).ProcessSession(ctx, tc.session)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,270 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// LookbackDays is how far back a driver's races are considered when judging how they go at a track.
+const LookbackDays = 365
+
+const (
+	// minRaces is how many races a driver needs at a track in a car class before it can be recommended
+	minRaces = 2
+	// horizon is how far ahead race slots are looked for
+	horizon = 7 * 24 * time.Hour
+	// maxSlots caps the upcoming race slots listed for each recommendation
+	maxSlots = 5
+	raceWeek = 7 * 24 * time.Hour
+)
+
+// Store defines the data access methods needed by the schedule service.
+type Store interface {
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+}
+
+// IRacingClient supplies the season schedules.
+type IRacingClient interface {
+	GetSeriesSeasons(ctx context.Context, accessToken string) ([]iracing.SeriesSeason, error)
+}
+
+// Service cross-references the season schedules with a driver's race history.
+type Service struct {
+	store  Store
+	client IRacingClient
+	now    func() time.Time
+}
+
+func NewService(store Store, client IRacingClient) *Service {
+	return &Service{
+		store:  store,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// History is how a driver has gone at a track in a car class over the last LookbackDays.
+type History struct {
+	Races             int
+	AvgFinishPosition float64
+	AvgIncidents      float64
+	AvgIRatingChange  float64
+}
+
+// SOFWindow is the middle half of the strengths of field the driver has raced against at a track in a car class.
+type SOFWindow struct {
+	Low  int
+	High int
+}
+
+// Recommendation is a series racing at a track where the driver has historically gained iRating, along with when it
+// goes off.
+type Recommendation struct {
+	SeasonID    int64
+	SeriesID    int64
+	SeasonName  string
+	RaceWeekNum int
+	TrackID     int64
+	TrackName   string
+	ConfigName  string
+	CarClassID  int64
+	Slots       []time.Time
+	History     History
+	// ExpectedSOF is nil when none of the driver's races there were ingested with a strength of field
+	ExpectedSOF *SOFWindow
+}
+
+// Recommendations are the race slots over the coming week worth planning around, best history first.
+type Recommendations struct {
+	GeneratedAt time.Time
+	Items       []Recommendation
+}
+
+type historyKey struct {
+	trackID    int64
+	carClassID int64
+}
+
+// GetRecommended finds the races over the coming week at tracks and car classes where the driver has averaged an
+// iRating gain. Races ingested before car classes were recorded can't be matched to a season and are left out.
+func (s *Service) GetRecommended(ctx context.Context, driverID int64, accessToken string) (*Recommendations, error) {
+	now := s.now()
+
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, driverID, now.AddDate(0, 0, -LookbackDays), now)
+	if err != nil {
+		return nil, fmt.Errorf("getting sessions: %w", err)
+	}
+
+	grouped := make(map[historyKey][]store.DriverSession)
+	for _, session := range sessions {
+		if session.CarClassID == 0 {
+			continue
+		}
+		key := historyKey{trackID: session.TrackID, carClassID: session.CarClassID}
+		grouped[key] = append(grouped[key], session)
+	}
+
+	result := &Recommendations{GeneratedAt: now, Items: []Recommendation{}}
+	if len(grouped) == 0 {
+		return result, nil
+	}
+
+	seasons, err := s.client.GetSeriesSeasons(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("getting seasons: %w", err)
+	}
+
+	windowEnd := now.Add(horizon)
+	for _, season := range seasons {
+		if !season.Active {
+			continue
+		}
+		for _, week := range season.Schedules {
+			var slots []time.Time
+			for _, carClassID := range season.CarClassIDs {
+				races := grouped[historyKey{trackID: week.Track.TrackID, carClassID: carClassID}]
+				if len(races) < minRaces {
+					continue
+				}
+				history := summarize(races)
+				if history.AvgIRatingChange <= 0 {
+					continue
+				}
+				// the race week's slots are shared by every class in the season, so only work them out once needed
+				if slots == nil {
+					slots = upcomingSlots(week, now, windowEnd)
+				}
+				if len(slots) == 0 {
+					break
+				}
+				result.Items = append(result.Items, Recommendation{
+					SeasonID:    season.SeasonID,
+					SeriesID:    season.SeriesID,
+					SeasonName:  season.SeasonName,
+					RaceWeekNum: week.RaceWeekNum,
+					TrackID:     week.Track.TrackID,
+					TrackName:   week.Track.TrackName,
+					ConfigName:  week.Track.ConfigName,
+					CarClassID:  carClassID,
+					Slots:       slots[:min(len(slots), maxSlots)],
+					History:     history,
+					ExpectedSOF: sofWindow(races),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(result.Items, func(i, j int) bool {
+		a, b := result.Items[i], result.Items[j]
+		if a.History.AvgIRatingChange != b.History.AvgIRatingChange {
+			return a.History.AvgIRatingChange > b.History.AvgIRatingChange
+		}
+		return a.Slots[0].Before(b.Slots[0])
+	})
+	return result, nil
+}
+
+func summarize(sessions []store.DriverSession) History {
+	var totalFinish, totalIncidents, totalIRatingChange int
+	for _, session := range sessions {
+		totalFinish += session.FinishPosition
+		totalIncidents += session.Incidents
+		totalIRatingChange += session.NewIRating - session.OldIRating
+	}
+	count := float64(len(sessions))
+	return History{
+		Races:             len(sessions),
+		AvgFinishPosition: float64(totalFinish) / count,
+		AvgIncidents:      float64(totalIncidents) / count,
+		AvgIRatingChange:  float64(totalIRatingChange) / count,
+	}
+}
+
+func sofWindow(sessions []store.DriverSession) *SOFWindow {
+	var sofs []int
+	for _, session := range sessions {
+		// sessions ingested before SOF was recorded have nothing to contribute
+		if session.StrengthOfField > 0 {
+			sofs = append(sofs, session.StrengthOfField)
+		}
+	}
+	if len(sofs) == 0 {
+		return nil
+	}
+	sort.Ints(sofs)
+	return &SOFWindow{
+		Low:  percentile(sofs, 0.25),
+		High: percentile(sofs, 0.75),
+	}
+}
+
+// percentile returns the nearest-rank percentile of an already sorted slice.
+func percentile(sorted []int, p float64) int {
+	idx := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+// upcomingSlots lists the sessions of a race week starting within [from, to), in order. Weeks are placed by their
+// start date rather than the season's current race week, which goes stale while the seasons are cached.
+func upcomingSlots(week iracing.SeasonSchedule, from, to time.Time) []time.Time {
+	weekStart, err := time.Parse(time.DateOnly, week.StartDate)
+	if err != nil {
+		return nil
+	}
+	weekEnd := weekStart.Add(raceWeek)
+	if !weekStart.Before(to) || !weekEnd.After(from) {
+		return nil
+	}
+
+	inWindow := func(t time.Time) bool {
+		return !t.Before(from) && t.Before(to) && !t.Before(weekStart) && t.Before(weekEnd)
+	}
+
+	var slots []time.Time
+	for _, descriptor := range week.RaceTimeDescriptors {
+		if !descriptor.Repeating {
+			for _, sessionTime := range descriptor.SessionTimes {
+				t, err := time.Parse(time.RFC3339, sessionTime)
+				if err == nil && inWindow(t) {
+					slots = append(slots, t.UTC())
+				}
+			}
+			continue
+		}
+
+		first, err := time.Parse(time.TimeOnly, descriptor.FirstSessionTime)
+		if err != nil {
+			continue
+		}
+		firstOffset := time.Duration(first.Hour())*time.Hour + time.Duration(first.Minute())*time.Minute + time.Duration(first.Second())*time.Second
+		days := descriptor.DayOffset
+		if len(days) == 0 {
+			days = []int{0, 1, 2, 3, 4, 5, 6}
+		}
+		for _, day := range days {
+			dayStart := weekStart.AddDate(0, 0, day)
+			for t := dayStart.Add(firstOffset); t.Before(dayStart.Add(24 * time.Hour)); t = t.Add(time.Duration(descriptor.RepeatMinutes) * time.Minute) {
+				if inWindow(t) {
+					slots = append(slots, t)
+				}
+				if descriptor.RepeatMinutes <= 0 {
+					break
+				}
+			}
+		}
+	}
+
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i].Before(slots[j])
+	})
+	return slots
+}
@@ -0,0 +1,222 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetRecommended(t *testing.T) {
+	driverID := int64(12345)
+	accessToken := "test-access-token"
+	// a Friday, partway through the race week that started Tuesday 2026-03-17
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	lookbackStart := now.AddDate(0, 0, -LookbackDays)
+
+	okayama := iracing.ScheduleTrack{TrackID: 219, TrackName: "Okayama International Circuit", ConfigName: "Full Course"}
+	laguna := iracing.ScheduleTrack{TrackID: 47, TrackName: "WeatherTech Raceway at Laguna Seca", ConfigName: "Full Course"}
+
+	seasons := []iracing.SeriesSeason{
+		{
+			SeasonID:    5012,
+			SeriesID:    139,
+			SeasonName:  "Global Mazda MX-5 Cup - 2026 Season 2",
+			Active:      true,
+			CarClassIDs: []int64{74},
+			Schedules: []iracing.SeasonSchedule{
+				{
+					RaceWeekNum: 0,
+					StartDate:   "2026-03-17",
+					Track:       okayama,
+					RaceTimeDescriptors: []iracing.RaceTimeDescriptor{
+						{Repeating: true, FirstSessionTime: "00:15:00", RepeatMinutes: 120, DayOffset: []int{0, 1, 2, 3, 4, 5, 6}},
+					},
+				},
+				{
+					RaceWeekNum: 1,
+					StartDate:   "2026-03-24",
+					Track:       laguna,
+					RaceTimeDescriptors: []iracing.RaceTimeDescriptor{
+						// the second session is past the coming week
+						{SessionTimes: []string{"2026-03-25T18:00:00Z", "2026-03-29T18:00:00Z"}},
+					},
+				},
+				{
+					RaceWeekNum: 2,
+					StartDate:   "2026-03-31",
+					Track:       okayama,
+					RaceTimeDescriptors: []iracing.RaceTimeDescriptor{
+						{Repeating: true, FirstSessionTime: "00:15:00", RepeatMinutes: 120},
+					},
+				},
+			},
+		},
+		{
+			SeasonID:    4800,
+			SeriesID:    139,
+			SeasonName:  "Global Mazda MX-5 Cup - 2026 Season 1",
+			CarClassIDs: []int64{74},
+			Schedules: []iracing.SeasonSchedule{
+				{
+					RaceWeekNum: 11,
+					StartDate:   "2026-03-17",
+					Track:       okayama,
+					RaceTimeDescriptors: []iracing.RaceTimeDescriptor{
+						{Repeating: true, FirstSessionTime: "00:45:00", RepeatMinutes: 120},
+					},
+				},
+			},
+		},
+		{
+			SeasonID:    5020,
+			SeriesID:    200,
+			SeasonName:  "GT3 Challenge - 2026 Season 2",
+			Active:      true,
+			CarClassIDs: []int64{2708},
+			Schedules: []iracing.SeasonSchedule{
+				{
+					RaceWeekNum: 0,
+					StartDate:   "2026-03-17",
+					Track:       laguna,
+					RaceTimeDescriptors: []iracing.RaceTimeDescriptor{
+						{Repeating: true, FirstSessionTime: "18:00:00", DayOffset: []int{3, 5}},
+					},
+				},
+			},
+		},
+	}
+
+	race := func(trackID, carClassID int64, finish, incidents, iRatingChange, sof int) store.DriverSession {
+		return store.DriverSession{
+			DriverID:        driverID,
+			TrackID:         trackID,
+			CarClassID:      carClassID,
+			FinishPosition:  finish,
+			Incidents:       incidents,
+			OldIRating:      2000,
+			NewIRating:      2000 + iRatingChange,
+			StrengthOfField: sof,
+		}
+	}
+	sessions := []store.DriverSession{
+		race(219, 74, 2, 2, 30, 1500),
+		race(219, 74, 4, 4, 10, 1800),
+		race(219, 74, 6, 0, -10, 1600),
+		race(47, 74, 1, 0, 40, 0),
+		race(47, 74, 3, 2, 20, 0),
+		race(47, 2708, 10, 8, -20, 2500),
+		race(47, 2708, 12, 6, -10, 2400),
+		race(219, 2708, 0, 0, 100, 2500),
+		race(219, 0, 0, 0, 200, 0), // ingested before car classes were recorded
+	}
+
+	type sessionsCall struct {
+		result []store.DriverSession
+		err    error
+	}
+
+	type seasonsCall struct {
+		result []iracing.SeriesSeason
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		sessionsCall sessionsCall
+		seasonsCall  *seasonsCall
+
+		expected    *Recommendations
+		expectedErr error
+	}{
+		{
+			name:         "recommends tracks the driver gains iRating at",
+			sessionsCall: sessionsCall{result: sessions},
+			seasonsCall:  &seasonsCall{result: seasons},
+			expected: &Recommendations{
+				GeneratedAt: now,
+				Items: []Recommendation{
+					{
+						SeasonID:    5012,
+						SeriesID:    139,
+						SeasonName:  "Global Mazda MX-5 Cup - 2026 Season 2",
+						RaceWeekNum: 1,
+						TrackID:     47,
+						TrackName:   "WeatherTech Raceway at Laguna Seca",
+						ConfigName:  "Full Course",
+						CarClassID:  74,
+						Slots:       []time.Time{time.Date(2026, 3, 25, 18, 0, 0, 0, time.UTC)},
+						History:     History{Races: 2, AvgFinishPosition: 2, AvgIncidents: 1, AvgIRatingChange: 30},
+					},
+					{
+						SeasonID:    5012,
+						SeriesID:    139,
+						SeasonName:  "Global Mazda MX-5 Cup - 2026 Season 2",
+						RaceWeekNum: 0,
+						TrackID:     219,
+						TrackName:   "Okayama International Circuit",
+						ConfigName:  "Full Course",
+						CarClassID:  74,
+						Slots: []time.Time{
+							time.Date(2026, 3, 20, 12, 15, 0, 0, time.UTC),
+							time.Date(2026, 3, 20, 14, 15, 0, 0, time.UTC),
+							time.Date(2026, 3, 20, 16, 15, 0, 0, time.UTC),
+							time.Date(2026, 3, 20, 18, 15, 0, 0, time.UTC),
+							time.Date(2026, 3, 20, 20, 15, 0, 0, time.UTC),
+						},
+						History:     History{Races: 3, AvgFinishPosition: 4, AvgIncidents: 2, AvgIRatingChange: 10},
+						ExpectedSOF: &SOFWindow{Low: 1500, High: 1800},
+					},
+				},
+			},
+		},
+		{
+			name:         "no history",
+			sessionsCall: sessionsCall{result: []store.DriverSession{race(219, 0, 0, 0, 200, 0)}},
+			expected:     &Recommendations{GeneratedAt: now, Items: []Recommendation{}},
+		},
+		{
+			name:         "sessions error",
+			sessionsCall: sessionsCall{err: errors.New("database error")},
+			expectedErr:  errors.New("getting sessions: database error"),
+		},
+		{
+			name:         "seasons error",
+			sessionsCall: sessionsCall{result: sessions},
+			seasonsCall:  &seasonsCall{err: errors.New("upstream error")},
+			expectedErr:  errors.New("getting seasons: upstream error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, lookbackStart, now).
+				Return(tc.sessionsCall.result, tc.sessionsCall.err)
+
+			mockClient := NewMockIRacingClient(t)
+			if tc.seasonsCall != nil {
+				mockClient.EXPECT().GetSeriesSeasons(mock.Anything, accessToken).Return(tc.seasonsCall.result, tc.seasonsCall.err)
+			}
+
+			svc := NewService(mockStore, mockClient)
+			svc.now = func() time.Time { return now }
+
+			result, err := svc.GetRecommended(context.Background(), driverID, accessToken)
+
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
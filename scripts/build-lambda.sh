@@ -7,7 +7,12 @@ set -e
 
 work=$(mktemp -d)
 
-GOOS=linux CGO_ENABLED=0 GOARCH=arm64 go build -trimpath -buildvcs=false -ldflags="-buildid=" -o "$work"/bootstrap -tags lambda.norpc "$1"
+git_sha=${GIT_SHA:-$(git rev-parse HEAD 2>/dev/null || echo unknown)}
+# build time is the commit time rather than now so that rebuilding the same commit produces an identical zip
+build_time=${BUILD_TIME:-$(git log -1 --format=%cI 2>/dev/null || echo unknown)}
+buildinfo_pkg=github.com/jonsabados/saturdaysspinout/buildinfo
+
+GOOS=linux CGO_ENABLED=0 GOARCH=arm64 go build -trimpath -buildvcs=false -ldflags="-buildid= -X $buildinfo_pkg.GitSHA=$git_sha -X $buildinfo_pkg.BuildTime=$build_time" -o "$work"/bootstrap -tags lambda.norpc "$1"
 touch -t 202111030000 "$work"/bootstrap
 zip -Xj "$2" "$work"/bootstrap
 rm -r "$work"
\ No newline at end of file
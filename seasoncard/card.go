@@ -0,0 +1,134 @@
+package seasoncard
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/jonsabados/saturdaysspinout/rollup"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// lookback is how far back races are read for a card, covering a full iRacing season along with the weeks either side
+// of it. Categories not raced in this long are left out of the ratings.
+const lookback = 120 * 24 * time.Hour
+
+// Card is the snapshot of a driver's current season served to whoever holds the card's token. Its JSON form is what
+// gets signed, so fields are only ever added to it.
+type Card struct {
+	DriverID   int64  `json:"driverId"`
+	DriverName string `json:"driverName"`
+	// Season is null until the driver has a race with its season recorded in the lookback
+	Season *Season `json:"season"`
+	// Ratings are ordered by category ID
+	Ratings     []Rating  `json:"ratings"`
+	GeneratedAt time.Time `json:"generatedAt"`
+}
+
+// Season is how the driver has gone in the iRacing season (year and quarter) of their latest race, across every
+// series. Finishing positions are 1 based.
+type Season struct {
+	Year          int     `json:"year"`
+	Quarter       int     `json:"quarter"`
+	Races         int     `json:"races"`
+	Wins          int     `json:"wins"`
+	Podiums       int     `json:"podiums"`
+	Top5s         int     `json:"top5s"`
+	AvgFinish     float64 `json:"avgFinish"`
+	AvgIncidents  float64 `json:"avgIncidents"`
+	IRatingChange int     `json:"iRatingChange"`
+}
+
+// Rating is where the driver stands in a license category as of their last race in it.
+type Rating struct {
+	CategoryID   int       `json:"categoryId"`
+	Category     string    `json:"category"`
+	IRating      int       `json:"iRating"`
+	LicenseLevel int       `json:"licenseLevel"`
+	SubLevel     int       `json:"subLevel"`
+	AsOf         time.Time `json:"asOf"`
+}
+
+// CardStore defines the data access methods needed to build a card.
+type CardStore interface {
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+}
+
+// buildCard snapshots the driver's season as of now, returning ErrDriverNotFound for drivers with no driver record.
+func buildCard(ctx context.Context, cardStore CardStore, driverID int64, now time.Time) (Card, error) {
+	driver, err := cardStore.GetDriver(ctx, driverID)
+	if err != nil {
+		return Card{}, fmt.Errorf("getting driver: %w", err)
+	}
+	if driver == nil {
+		return Card{}, ErrDriverNotFound
+	}
+	sessions, err := cardStore.GetDriverSessionsByTimeRange(ctx, driverID, now.Add(-lookback), now)
+	if err != nil {
+		return Card{}, fmt.Errorf("getting driver sessions: %w", err)
+	}
+
+	card := Card{
+		DriverID:    driverID,
+		DriverName:  driver.DriverName,
+		Season:      currentSeason(sessions),
+		Ratings:     []Rating{},
+		GeneratedAt: now.UTC(),
+	}
+	for _, rating := range analytics.LatestRatings(sessions) {
+		card.Ratings = append(card.Ratings, Rating{
+			CategoryID:   rating.CategoryID,
+			Category:     rating.Category,
+			IRating:      rating.IRating,
+			LicenseLevel: rating.LicenseLevel,
+			SubLevel:     rating.SubLevel,
+			AsOf:         rating.AsOf.UTC(),
+		})
+	}
+	return card, nil
+}
+
+// currentSeason totals the races of the season the driver last raced in. Races ingested before seasons were recorded
+// are skipped.
+func currentSeason(sessions []store.DriverSession) *Season {
+	var latest *store.DriverSession
+	for i, session := range sessions {
+		if session.SeasonYear == 0 {
+			continue
+		}
+		if latest == nil || session.StartTime.After(latest.StartTime) {
+			latest = &sessions[i]
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+
+	var totals store.SessionTotals
+	finishes := 0
+	for _, session := range sessions {
+		if session.SeasonYear != latest.SeasonYear || session.SeasonQuarter != latest.SeasonQuarter {
+			continue
+		}
+		totals = totals.Add(rollup.TotalsForSession(session))
+		finishes += session.FinishPosition + 1
+	}
+	return &Season{
+		Year:          latest.SeasonYear,
+		Quarter:       latest.SeasonQuarter,
+		Races:         totals.Races,
+		Wins:          totals.Wins,
+		Podiums:       totals.Podiums,
+		Top5s:         totals.Top5s,
+		AvgFinish:     roundTo2(float64(finishes) / float64(totals.Races)),
+		AvgIncidents:  roundTo2(float64(totals.Incidents) / float64(totals.Races)),
+		IRatingChange: totals.IRatingChange,
+	}
+}
+
+func roundTo2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,102 @@
+package seasoncard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCard(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	from := now.Add(-lookback)
+
+	lastSeason := store.DriverSession{StartTime: now.Add(-40 * 24 * time.Hour), SeasonYear: 2025, SeasonQuarter: 1, LicenseCategoryID: 1, FinishPosition: 0, Incidents: 0, OldIRating: 1100, NewIRating: 1150, NewLicenseLevel: 9, NewSubLevel: 400}
+	win := store.DriverSession{StartTime: now.Add(-72 * time.Hour), SeasonYear: 2025, SeasonQuarter: 2, LicenseCategoryID: 5, FinishPosition: 0, Incidents: 2, OldIRating: 1500, NewIRating: 1560, NewLicenseLevel: 14, NewSubLevel: 250}
+	midfield := store.DriverSession{StartTime: now.Add(-24 * time.Hour), SeasonYear: 2025, SeasonQuarter: 2, LicenseCategoryID: 5, FinishPosition: 5, Incidents: 7, OldIRating: 1560, NewIRating: 1541, NewLicenseLevel: 14, NewSubLevel: 231}
+	// ingested before seasons were recorded, so it counts for ratings but no season
+	unrecorded := store.DriverSession{StartTime: now.Add(-2 * time.Hour), LicenseCategoryID: 2, FinishPosition: 2, NewIRating: 1300, NewLicenseLevel: 10, NewSubLevel: 300}
+
+	testCases := []struct {
+		name        string
+		driver      *store.Driver
+		sessions    []store.DriverSession
+		expected    Card
+		expectedErr error
+	}{
+		{
+			name:     "racing this season",
+			driver:   &store.Driver{DriverID: driverID, DriverName: "Jon Sabados"},
+			sessions: []store.DriverSession{lastSeason, win, unrecorded, midfield},
+			expected: Card{
+				DriverID:   driverID,
+				DriverName: "Jon Sabados",
+				Season: &Season{
+					Year:          2025,
+					Quarter:       2,
+					Races:         2,
+					Wins:          1,
+					Podiums:       1,
+					Top5s:         1,
+					AvgFinish:     3.5,
+					AvgIncidents:  4.5,
+					IRatingChange: 41,
+				},
+				Ratings: []Rating{
+					{CategoryID: 1, Category: "oval", IRating: 1150, LicenseLevel: 9, SubLevel: 400, AsOf: lastSeason.StartTime},
+					{CategoryID: 2, Category: "road", IRating: 1300, LicenseLevel: 10, SubLevel: 300, AsOf: unrecorded.StartTime},
+					{CategoryID: 5, Category: "sports_car", IRating: 1541, LicenseLevel: 14, SubLevel: 231, AsOf: midfield.StartTime},
+				},
+				GeneratedAt: now,
+			},
+		},
+		{
+			name:     "no races",
+			driver:   &store.Driver{DriverID: driverID, DriverName: "Jon Sabados"},
+			sessions: []store.DriverSession{},
+			expected: Card{
+				DriverID:    driverID,
+				DriverName:  "Jon Sabados",
+				Ratings:     []Rating{},
+				GeneratedAt: now,
+			},
+		},
+		{
+			name:        "driver not found",
+			expectedErr: ErrDriverNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(tc.driver, nil)
+			if tc.driver != nil {
+				mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, now).Return(tc.sessions, nil)
+			}
+
+			card, err := buildCard(context.Background(), mockStore, driverID, now)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, card)
+		})
+	}
+
+	t.Run("sessions failing fails the card", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(&store.Driver{DriverID: driverID}, nil)
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, from, now).Return(nil, errors.New("boom"))
+
+		_, err := buildCard(context.Background(), mockStore, driverID, now)
+		assert.Error(t, err)
+	})
+}
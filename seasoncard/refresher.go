@@ -0,0 +1,62 @@
+package seasoncard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// RefresherStore defines the data access methods needed to refresh a driver's card.
+type RefresherStore interface {
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	GetSeasonCard(ctx context.Context, driverID int64) (*store.SeasonCard, error)
+	RefreshSeasonCard(ctx context.Context, token, snapshot string, refreshedAt time.Time) (bool, error)
+}
+
+// Refresher retakes the snapshot of a driver's shared card, run once an ingestion has caught up so the card reflects
+// every race from it.
+type Refresher struct {
+	store RefresherStore
+	now   func() time.Time
+}
+
+func NewRefresher(store RefresherStore) *Refresher {
+	return &Refresher{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// RefreshDriver retakes the snapshot of the driver's card, doing nothing for drivers who haven't shared one.
+func (r *Refresher) RefreshDriver(ctx context.Context, driverID int64) error {
+	existing, err := r.store.GetSeasonCard(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("getting season card: %w", err)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	now := r.now()
+	card, err := buildCard(ctx, r.store, driverID, now)
+	if err != nil {
+		return err
+	}
+	snapshot, err := json.Marshal(card)
+	if err != nil {
+		return fmt.Errorf("marshaling season card: %w", err)
+	}
+	refreshed, err := r.store.RefreshSeasonCard(ctx, existing.Token, string(snapshot), now)
+	if err != nil {
+		return fmt.Errorf("refreshing season card: %w", err)
+	}
+	if !refreshed {
+		zerolog.Ctx(ctx).Debug().Int64("driverId", driverID).Msg("season card replaced or revoked while refreshing")
+	}
+	return nil
+}
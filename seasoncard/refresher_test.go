@@ -0,0 +1,57 @@
+package seasoncard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefresher_RefreshDriver(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	expectedSnapshot := `{"driverId":12345,"driverName":"Jon Sabados","season":null,"ratings":[],"generatedAt":"2025-06-15T12:00:00Z"}`
+
+	testCases := []struct {
+		name      string
+		refreshed bool
+	}{
+		{name: "refreshed", refreshed: true},
+		// revoked between reading the card and refreshing it, which isn't worth failing ingestion over
+		{name: "revoked meanwhile", refreshed: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockRefresherStore(t)
+			mockStore.EXPECT().GetSeasonCard(mock.Anything, driverID).Return(&store.SeasonCard{DriverID: driverID, Token: "tok"}, nil)
+			mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(&store.Driver{DriverID: driverID, DriverName: "Jon Sabados"}, nil)
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return([]store.DriverSession{}, nil)
+			mockStore.EXPECT().RefreshSeasonCard(mock.Anything, "tok", expectedSnapshot, now).Return(tc.refreshed, nil)
+
+			refresher := NewRefresher(mockStore)
+			refresher.now = func() time.Time { return now }
+
+			require.NoError(t, refresher.RefreshDriver(context.Background(), driverID))
+		})
+	}
+
+	t.Run("not shared", func(t *testing.T) {
+		mockStore := NewMockRefresherStore(t)
+		mockStore.EXPECT().GetSeasonCard(mock.Anything, driverID).Return(nil, nil)
+
+		require.NoError(t, NewRefresher(mockStore).RefreshDriver(context.Background(), driverID))
+	})
+
+	t.Run("store failing", func(t *testing.T) {
+		mockStore := NewMockRefresherStore(t)
+		mockStore.EXPECT().GetSeasonCard(mock.Anything, driverID).Return(nil, errors.New("boom"))
+
+		assert.Error(t, NewRefresher(mockStore).RefreshDriver(context.Background(), driverID))
+	})
+}
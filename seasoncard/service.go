@@ -0,0 +1,233 @@
+package seasoncard
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// FetchesPerMinute caps how often a card is served publicly. Overlays and widgets poll, but the CDN serves most of
+// their requests, so only a card being fetched around the cache reaches it.
+const FetchesPerMinute = 60
+
+// fetchWindow is the fixed window public fetches are counted over
+const fetchWindow = time.Minute
+
+// tokenBytes is how much randomness goes into a token, which is all that stands between a card and the public
+const tokenBytes = 32
+
+var (
+	// ErrDriverNotFound is returned when sharing a card for a driver with no driver record.
+	ErrDriverNotFound = errors.New("driver not found")
+	// ErrNotShared is returned when a driver has no card shared, or no card is shared with a token.
+	ErrNotShared = errors.New("season card not shared")
+	// ErrRateLimited is returned when a card has been fetched too often. The error is a *RateLimitedError.
+	ErrRateLimited = errors.New("season card fetched too often")
+)
+
+// RateLimitedError says when a card can next be fetched.
+type RateLimitedError struct {
+	RetryAt time.Time
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("%s, retry at %s", ErrRateLimited, e.RetryAt.UTC().Format(time.RFC3339))
+}
+
+func (e *RateLimitedError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+type Store interface {
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	GetSeasonCard(ctx context.Context, driverID int64) (*store.SeasonCard, error)
+	GetSeasonCardByToken(ctx context.Context, token string) (*store.SeasonCard, error)
+	SaveSeasonCard(ctx context.Context, card store.SeasonCard, replacing string) error
+	DeleteSeasonCard(ctx context.Context, driverID int64, token string) error
+	ConsumeSeasonCardFetch(ctx context.Context, token string, windowStart time.Time, limit int) (bool, error)
+}
+
+// Shared is a driver's card along with the token it is shared with.
+type Shared struct {
+	Token       string
+	Card        Card
+	CreatedAt   time.Time
+	RefreshedAt time.Time
+}
+
+// Signed is a card as served publicly. JWS is the card's JSON signed as a compact JWS, for widgets that want to show
+// the card came from us rather than whoever is embedding it.
+type Signed struct {
+	Card Card
+	JWS  string
+}
+
+// Service shares drivers' season cards. Cards are snapshots, taken when shared and retaken by the Refresher after each
+// ingestion, and signed as they are served.
+type Service struct {
+	store    Store
+	keys     KeySource
+	now      func() time.Time
+	newToken func() (string, error)
+}
+
+func NewService(store Store, keys KeySource) *Service {
+	return &Service{
+		store:    store,
+		keys:     keys,
+		now:      time.Now,
+		newToken: randomToken,
+	}
+}
+
+// Get returns the driver's shared card, ErrNotShared if they haven't shared one.
+func (s *Service) Get(ctx context.Context, driverID int64) (*Shared, error) {
+	stored, err := s.store.GetSeasonCard(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting season card: %w", err)
+	}
+	if stored == nil {
+		return nil, ErrNotShared
+	}
+	return sharedFromStore(*stored)
+}
+
+// Share takes a fresh snapshot of the driver's season and shares it under a new token. Any card they already shared
+// is replaced, so sharing again is how a leaked token is revoked without taking the card down.
+func (s *Service) Share(ctx context.Context, driverID int64) (*Shared, error) {
+	now := s.now()
+	card, err := buildCard(ctx, s.store, driverID, now)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := json.Marshal(card)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling season card: %w", err)
+	}
+	token, err := s.newToken()
+	if err != nil {
+		return nil, fmt.Errorf("generating token: %w", err)
+	}
+
+	existing, err := s.store.GetSeasonCard(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting season card: %w", err)
+	}
+	var replacing string
+	if existing != nil {
+		replacing = existing.Token
+	}
+
+	stored := store.SeasonCard{
+		DriverID:    driverID,
+		Token:       token,
+		Snapshot:    string(snapshot),
+		CreatedAt:   now,
+		RefreshedAt: now,
+	}
+	if err := s.store.SaveSeasonCard(ctx, stored, replacing); err != nil {
+		return nil, fmt.Errorf("saving season card: %w", err)
+	}
+	return &Shared{Token: token, Card: card, CreatedAt: now, RefreshedAt: now}, nil
+}
+
+// Revoke stops sharing the driver's card, its token no longer working. Revoking a card that isn't shared does nothing.
+func (s *Service) Revoke(ctx context.Context, driverID int64) error {
+	existing, err := s.store.GetSeasonCard(ctx, driverID)
+	if err != nil {
+		return fmt.Errorf("getting season card: %w", err)
+	}
+	if existing == nil {
+		return nil
+	}
+	if err := s.store.DeleteSeasonCard(ctx, driverID, existing.Token); err != nil {
+		return fmt.Errorf("deleting season card: %w", err)
+	}
+	return nil
+}
+
+// Fetch returns the card shared with token, signed, for serving publicly. Returns ErrNotShared for tokens with no card
+// and a *RateLimitedError once the card has been fetched FetchesPerMinute times in the current minute.
+func (s *Service) Fetch(ctx context.Context, token string) (*Signed, error) {
+	stored, err := s.store.GetSeasonCardByToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("getting season card: %w", err)
+	}
+	if stored == nil {
+		return nil, ErrNotShared
+	}
+
+	// counted only once the token is known to be good, so guessing at tokens doesn't write anything
+	windowStart := s.now().Truncate(fetchWindow)
+	allowed, err := s.store.ConsumeSeasonCardFetch(ctx, token, windowStart, FetchesPerMinute)
+	if err != nil {
+		return nil, fmt.Errorf("counting season card fetch: %w", err)
+	}
+	if !allowed {
+		return nil, &RateLimitedError{RetryAt: windowStart.Add(fetchWindow)}
+	}
+
+	var card Card
+	if err := json.Unmarshal([]byte(stored.Snapshot), &card); err != nil {
+		return nil, fmt.Errorf("unmarshaling season card: %w", err)
+	}
+	keys, err := s.keys.SigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, errNoSigningKeys
+	}
+	// the stored snapshot is signed as is, so the payload is exactly what was snapshotted
+	jws, err := sign(keys[0], []byte(stored.Snapshot))
+	if err != nil {
+		return nil, fmt.Errorf("signing season card: %w", err)
+	}
+	return &Signed{Card: card, JWS: jws}, nil
+}
+
+// PublicKeys are the keys served cards may be signed with, for widgets verifying them.
+func (s *Service) PublicKeys(ctx context.Context) ([]PublicKey, error) {
+	keys, err := s.keys.SigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]PublicKey, 0, len(keys))
+	for _, key := range keys {
+		keyID, err := auth.KeyID(&key.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, PublicKey{KeyID: keyID, Key: &key.PublicKey})
+	}
+	return ret, nil
+}
+
+func sharedFromStore(stored store.SeasonCard) (*Shared, error) {
+	var card Card
+	if err := json.Unmarshal([]byte(stored.Snapshot), &card); err != nil {
+		return nil, fmt.Errorf("unmarshaling season card: %w", err)
+	}
+	return &Shared{
+		Token:       stored.Token,
+		Card:        card,
+		CreatedAt:   stored.CreatedAt,
+		RefreshedAt: stored.RefreshedAt,
+	}, nil
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,245 @@
+package seasoncard
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func TestService_Get(t *testing.T) {
+	driverID := int64(12345)
+	createdAt := time.Unix(1000, 0)
+	refreshedAt := time.Unix(2000, 0)
+
+	t.Run("shared", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSeasonCard(mock.Anything, driverID).Return(&store.SeasonCard{
+			DriverID:    driverID,
+			Token:       "tok",
+			Snapshot:    `{"driverId":12345,"driverName":"Jon Sabados","season":null,"ratings":[],"generatedAt":"2025-06-15T12:00:00Z"}`,
+			CreatedAt:   createdAt,
+			RefreshedAt: refreshedAt,
+		}, nil)
+
+		shared, err := NewService(mockStore, StaticKeySource{}).Get(context.Background(), driverID)
+		require.NoError(t, err)
+		assert.Equal(t, &Shared{
+			Token:       "tok",
+			Card:        Card{DriverID: driverID, DriverName: "Jon Sabados", Ratings: []Rating{}, GeneratedAt: time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)},
+			CreatedAt:   createdAt,
+			RefreshedAt: refreshedAt,
+		}, shared)
+	})
+
+	t.Run("not shared", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSeasonCard(mock.Anything, driverID).Return(nil, nil)
+
+		_, err := NewService(mockStore, StaticKeySource{}).Get(context.Background(), driverID)
+		assert.ErrorIs(t, err, ErrNotShared)
+	})
+}
+
+func TestService_Share(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	expectedCard := Card{DriverID: driverID, DriverName: "Jon Sabados", Ratings: []Rating{}, GeneratedAt: now}
+	expectedSnapshot := `{"driverId":12345,"driverName":"Jon Sabados","season":null,"ratings":[],"generatedAt":"2025-06-15T12:00:00Z"}`
+
+	testCases := []struct {
+		name              string
+		existing          *store.SeasonCard
+		expectedReplacing string
+	}{
+		{
+			name: "first share",
+		},
+		{
+			name:              "replacing a shared card",
+			existing:          &store.SeasonCard{DriverID: driverID, Token: "old"},
+			expectedReplacing: "old",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(&store.Driver{DriverID: driverID, DriverName: "Jon Sabados"}, nil)
+			mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-lookback), now).Return([]store.DriverSession{}, nil)
+			mockStore.EXPECT().GetSeasonCard(mock.Anything, driverID).Return(tc.existing, nil)
+			mockStore.EXPECT().SaveSeasonCard(mock.Anything, store.SeasonCard{
+				DriverID:    driverID,
+				Token:       "new",
+				Snapshot:    expectedSnapshot,
+				CreatedAt:   now,
+				RefreshedAt: now,
+			}, tc.expectedReplacing).Return(nil)
+
+			service := NewService(mockStore, StaticKeySource{})
+			service.now = func() time.Time { return now }
+			service.newToken = func() (string, error) { return "new", nil }
+
+			shared, err := service.Share(context.Background(), driverID)
+			require.NoError(t, err)
+			assert.Equal(t, &Shared{Token: "new", Card: expectedCard, CreatedAt: now, RefreshedAt: now}, shared)
+		})
+	}
+
+	t.Run("driver not found", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetDriver(mock.Anything, driverID).Return(nil, nil)
+
+		service := NewService(mockStore, StaticKeySource{})
+		service.now = func() time.Time { return now }
+
+		_, err := service.Share(context.Background(), driverID)
+		assert.ErrorIs(t, err, ErrDriverNotFound)
+	})
+}
+
+func TestService_Revoke(t *testing.T) {
+	driverID := int64(12345)
+
+	t.Run("shared", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSeasonCard(mock.Anything, driverID).Return(&store.SeasonCard{DriverID: driverID, Token: "tok"}, nil)
+		mockStore.EXPECT().DeleteSeasonCard(mock.Anything, driverID, "tok").Return(nil)
+
+		require.NoError(t, NewService(mockStore, StaticKeySource{}).Revoke(context.Background(), driverID))
+	})
+
+	t.Run("not shared", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSeasonCard(mock.Anything, driverID).Return(nil, nil)
+
+		require.NoError(t, NewService(mockStore, StaticKeySource{}).Revoke(context.Background(), driverID))
+	})
+}
+
+func TestService_Fetch(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 30, 0, time.UTC)
+	window := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	snapshot := `{"driverId":12345,"driverName":"Jon Sabados","season":null,"ratings":[],"generatedAt":"2025-06-15T12:00:00Z"}`
+	key := testKey(t)
+	previousKey := testKey(t)
+
+	t.Run("signed with the current key", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSeasonCardByToken(mock.Anything, "tok").Return(&store.SeasonCard{DriverID: 12345, Token: "tok", Snapshot: snapshot}, nil)
+		mockStore.EXPECT().ConsumeSeasonCardFetch(mock.Anything, "tok", window, FetchesPerMinute).Return(true, nil)
+
+		service := NewService(mockStore, StaticKeySource{key, previousKey})
+		service.now = func() time.Time { return now }
+
+		signed, err := service.Fetch(context.Background(), "tok")
+		require.NoError(t, err)
+		assert.Equal(t, Card{DriverID: 12345, DriverName: "Jon Sabados", Ratings: []Rating{}, GeneratedAt: time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)}, signed.Card)
+
+		parts := strings.Split(signed.JWS, ".")
+		require.Len(t, parts, 3)
+
+		headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+		require.NoError(t, err)
+		var header map[string]string
+		require.NoError(t, json.Unmarshal(headerJSON, &header))
+		keyID, err := auth.KeyID(&key.PublicKey)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"alg": "ES256", "kid": keyID, "typ": "season-card+jws"}, header)
+
+		payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+		require.NoError(t, err)
+		assert.Equal(t, snapshot, string(payload))
+
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		require.NoError(t, err)
+		require.Len(t, signature, 64)
+		digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		assert.True(t, ecdsa.Verify(&key.PublicKey, digest[:], r, s))
+	})
+
+	t.Run("not shared", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSeasonCardByToken(mock.Anything, "nope").Return(nil, nil)
+
+		_, err := NewService(mockStore, StaticKeySource{key}).Fetch(context.Background(), "nope")
+		assert.ErrorIs(t, err, ErrNotShared)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSeasonCardByToken(mock.Anything, "tok").Return(&store.SeasonCard{DriverID: 12345, Token: "tok", Snapshot: snapshot}, nil)
+		mockStore.EXPECT().ConsumeSeasonCardFetch(mock.Anything, "tok", window, FetchesPerMinute).Return(false, nil)
+
+		service := NewService(mockStore, StaticKeySource{key})
+		service.now = func() time.Time { return now }
+
+		_, err := service.Fetch(context.Background(), "tok")
+		assert.ErrorIs(t, err, ErrRateLimited)
+		var limited *RateLimitedError
+		require.ErrorAs(t, err, &limited)
+		assert.Equal(t, window.Add(time.Minute), limited.RetryAt)
+	})
+
+	t.Run("counting failing fails the fetch", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSeasonCardByToken(mock.Anything, "tok").Return(&store.SeasonCard{DriverID: 12345, Token: "tok", Snapshot: snapshot}, nil)
+		mockStore.EXPECT().ConsumeSeasonCardFetch(mock.Anything, "tok", window, FetchesPerMinute).Return(false, errors.New("boom"))
+
+		service := NewService(mockStore, StaticKeySource{key})
+		service.now = func() time.Time { return now }
+
+		_, err := service.Fetch(context.Background(), "tok")
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrRateLimited)
+	})
+}
+
+func TestService_PublicKeys(t *testing.T) {
+	key := testKey(t)
+	previousKey := testKey(t)
+	keyID, err := auth.KeyID(&key.PublicKey)
+	require.NoError(t, err)
+	previousKeyID, err := auth.KeyID(&previousKey.PublicKey)
+	require.NoError(t, err)
+
+	keys, err := NewService(NewMockStore(t), StaticKeySource{key, previousKey}).PublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []PublicKey{
+		{KeyID: keyID, Key: &key.PublicKey},
+		{KeyID: previousKeyID, Key: &previousKey.PublicKey},
+	}, keys)
+}
+
+func TestRandomToken(t *testing.T) {
+	first, err := randomToken()
+	require.NoError(t, err)
+	second, err := randomToken()
+	require.NoError(t, err)
+
+	assert.Len(t, first, 43)
+	assert.NotEqual(t, first, second)
+	assert.NotContains(t, first, "#")
+}
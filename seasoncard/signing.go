@@ -0,0 +1,117 @@
+package seasoncard
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/auth"
+	"github.com/jonsabados/saturdaysspinout/secrets"
+)
+
+// KeySource provides the keys cards are signed with, current first. Cards are signed with the current key, the rest
+// are published alongside it so cards signed before a rotation still verify.
+type KeySource interface {
+	SigningKeys(ctx context.Context) ([]*ecdsa.PrivateKey, error)
+}
+
+// SecretSource defines the cached secret reads SecretKeySource needs.
+type SecretSource interface {
+	Get(ctx context.Context, secretID string) (secrets.Value, error)
+}
+
+// SecretKeySource reads the signing keys from a Secrets Manager secret in the same form as the JWT signing key, a
+// single PEM key or a key set, so it can be rotated with the rotate-jwt-keys command. Cards are signed with a key of
+// their own so a card can never pass for a session token.
+type SecretKeySource struct {
+	secrets  SecretSource
+	secretID string
+}
+
+func NewSecretKeySource(secrets SecretSource, secretID string) *SecretKeySource {
+	return &SecretKeySource{
+		secrets:  secrets,
+		secretID: secretID,
+	}
+}
+
+// SigningKeys fails on a current key that won't parse, but only warns about a previous one.
+func (s *SecretKeySource) SigningKeys(ctx context.Context) ([]*ecdsa.PrivateKey, error) {
+	value, err := s.secrets.Get(ctx, s.secretID)
+	if err != nil {
+		return nil, fmt.Errorf("getting season card signing key: %w", err)
+	}
+	keys, err := parseSigningKeys(value.Current)
+	if err != nil {
+		return nil, fmt.Errorf("parsing season card signing key: %w", err)
+	}
+	if value.Previous != "" {
+		previous, err := parseSigningKeys(value.Previous)
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Msg("ignoring unparseable previous season card signing key")
+		} else {
+			keys = append(keys, previous...)
+		}
+	}
+	return keys, nil
+}
+
+func parseSigningKeys(value string) ([]*ecdsa.PrivateKey, error) {
+	set, err := auth.ParseKeySet(value)
+	if err != nil {
+		return nil, err
+	}
+	return set.SigningKeys()
+}
+
+// StaticKeySource signs with fixed keys, for running outside AWS.
+type StaticKeySource []*ecdsa.PrivateKey
+
+func (s StaticKeySource) SigningKeys(_ context.Context) ([]*ecdsa.PrivateKey, error) {
+	return s, nil
+}
+
+// PublicKey is a key cards may be signed with, identified by the kid header of the cards it signs.
+type PublicKey struct {
+	KeyID string
+	Key   *ecdsa.PublicKey
+}
+
+// signatureType is the typ header of a signed card, telling it apart from anything else signed as a JWS.
+const signatureType = "season-card+jws"
+
+type signatureHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	Type      string `json:"typ"`
+}
+
+// sign signs payload as a compact JWS (RFC 7515) with ES256, naming the key in its kid header.
+func sign(key *ecdsa.PrivateKey, payload []byte) (string, error) {
+	keyID, err := auth.KeyID(&key.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	header, err := json.Marshal(signatureHeader{
+		Algorithm: jwt.SigningMethodES256.Alg(),
+		KeyID:     keyID,
+		Type:      signatureType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshaling header: %w", err)
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	signature, err := jwt.SigningMethodES256.Sign(signingInput, key)
+	if err != nil {
+		return "", fmt.Errorf("signing: %w", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+var errNoSigningKeys = errors.New("no season card signing keys")
@@ -0,0 +1,82 @@
+package seasoncard
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/secrets"
+)
+
+func signingKeyPEM(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+	derBytes, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: derBytes}))
+}
+
+func TestSecretKeySource_SigningKeys(t *testing.T) {
+	ctx := context.Background()
+	key := testKey(t)
+	previousKey := testKey(t)
+
+	testCases := []struct {
+		name        string
+		value       secrets.Value
+		getErr      error
+		expected    []*ecdsa.PrivateKey
+		expectedErr bool
+	}{
+		{
+			name:     "current and previous",
+			value:    secrets.Value{Current: signingKeyPEM(t, key), Previous: signingKeyPEM(t, previousKey)},
+			expected: []*ecdsa.PrivateKey{key, previousKey},
+		},
+		{
+			name:     "never rotated",
+			value:    secrets.Value{Current: signingKeyPEM(t, key)},
+			expected: []*ecdsa.PrivateKey{key},
+		},
+		{
+			// all an unparseable previous key costs is cards signed with it no longer verifying
+			name:     "unparseable previous",
+			value:    secrets.Value{Current: signingKeyPEM(t, key), Previous: "not a key"},
+			expected: []*ecdsa.PrivateKey{key},
+		},
+		{
+			name:        "unparseable current",
+			value:       secrets.Value{Current: "not a key"},
+			expectedErr: true,
+		},
+		{
+			name:        "secret unavailable",
+			getErr:      errors.New("access denied"),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			source := NewMockSecretSource(t)
+			source.EXPECT().Get(mock.Anything, "season-card-key").Return(tc.value, tc.getErr)
+
+			keys, err := NewSecretKeySource(source, "season-card-key").SigningKeys(ctx)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, keys, len(tc.expected))
+			for i, expected := range tc.expected {
+				assert.True(t, expected.Equal(keys[i]))
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Package secrets reads Secrets Manager secrets through an in-memory cache, so that processes pick up rotated secrets
+// without a cold start while costing a Secrets Manager call per secret per TTL rather than per use.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/rs/zerolog"
+)
+
+// DefaultTTL is how long a fetched secret is trusted before it is fetched again.
+const DefaultTTL = 5 * time.Minute
+
+// DefaultMinRefreshInterval is the least time between forced refreshes of a secret, keeping anything that triggers
+// them, such as a flood of tokens signed with unknown keys, from turning into a flood of Secrets Manager calls.
+const DefaultMinRefreshInterval = 30 * time.Second
+
+const (
+	stageCurrent  = "AWSCURRENT"
+	stagePrevious = "AWSPREVIOUS"
+)
+
+// Client defines the Secrets Manager calls the provider needs.
+type Client interface {
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+// Value is a secret along with the value it was rotated from. Previous stays set until the secret is rotated again,
+// letting anything issued under it keep working through the overlap.
+type Value struct {
+	Current  string
+	Previous string // empty when the secret has never been rotated
+}
+
+type entry struct {
+	value     Value
+	fetchedAt time.Time
+}
+
+// Provider caches secrets for the TTL. A secret that can't be refetched once cached keeps being served, logging a
+// warning, so a Secrets Manager blip doesn't take out a warm process.
+type Provider struct {
+	client             Client
+	ttl                time.Duration
+	minRefreshInterval time.Duration
+	now                func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+func NewProvider(client Client, ttl, minRefreshInterval time.Duration) *Provider {
+	return &Provider{
+		client:             client,
+		ttl:                ttl,
+		minRefreshInterval: minRefreshInterval,
+		now:                time.Now,
+		entries:            make(map[string]*entry),
+	}
+}
+
+// Get returns the secret, fetching it when it isn't cached or was fetched longer than the TTL ago.
+func (p *Provider) Get(ctx context.Context, secretID string) (Value, error) {
+	return p.get(ctx, secretID, p.ttl)
+}
+
+// Refresh returns the secret, fetching it unless it was fetched within the minimum refresh interval. It is for callers
+// that have seen evidence of a rotation the cache doesn't know about yet.
+func (p *Provider) Refresh(ctx context.Context, secretID string) (Value, error) {
+	return p.get(ctx, secretID, p.minRefreshInterval)
+}
+
+func (p *Provider) get(ctx context.Context, secretID string, maxAge time.Duration) (Value, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	cached, ok := p.entries[secretID]
+	if ok && now.Sub(cached.fetchedAt) < maxAge {
+		return cached.value, nil
+	}
+
+	value, err := p.fetch(ctx, secretID)
+	if err != nil {
+		if !ok {
+			return Value{}, err
+		}
+		zerolog.Ctx(ctx).Warn().Err(err).Str("secretId", secretID).Time("fetchedAt", cached.fetchedAt).Msg("failed to refresh secret, using cached value")
+		// hold off retrying for another TTL rather than calling on every use while Secrets Manager is struggling
+		cached.fetchedAt = now
+		return cached.value, nil
+	}
+
+	if ok && cached.value.Current != value.Current {
+		zerolog.Ctx(ctx).Info().Str("secretId", secretID).Msg("secret rotated")
+	}
+	p.entries[secretID] = &entry{value: value, fetchedAt: now}
+	return value, nil
+}
+
+func (p *Provider) fetch(ctx context.Context, secretID string) (Value, error) {
+	current, err := p.fetchStage(ctx, secretID, stageCurrent)
+	if err != nil {
+		return Value{}, fmt.Errorf("fetching current version of %s: %w", secretID, err)
+	}
+
+	previous, err := p.fetchStage(ctx, secretID, stagePrevious)
+	var notFound *types.ResourceNotFoundException
+	if errors.As(err, &notFound) {
+		// never rotated
+		previous, err = "", nil
+	}
+	if err != nil {
+		return Value{}, fmt.Errorf("fetching previous version of %s: %w", secretID, err)
+	}
+
+	return Value{Current: current, Previous: previous}, nil
+}
+
+func (p *Provider) fetchStage(ctx context.Context, secretID, stage string) (string, error) {
+	result, err := p.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId:     aws.String(secretID),
+		VersionStage: aws.String(stage),
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(result.SecretString), nil
+}
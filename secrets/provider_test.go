@@ -0,0 +1,117 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const secretID = "jwt-signing-key"
+
+func expectStage(client *MockClient, stage, value string, err error) {
+	var output *secretsmanager.GetSecretValueOutput
+	if err == nil {
+		output = &secretsmanager.GetSecretValueOutput{SecretString: aws.String(value)}
+	}
+	client.EXPECT().GetSecretValue(mock.Anything, &secretsmanager.GetSecretValueInput{
+		SecretId:     aws.String(secretID),
+		VersionStage: aws.String(stage),
+	}).Return(output, err).Once()
+}
+
+func TestProvider_Get(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(10000, 0)
+
+	client := NewMockClient(t)
+	expectStage(client, "AWSCURRENT", "key-1", nil)
+	expectStage(client, "AWSPREVIOUS", "", &types.ResourceNotFoundException{})
+
+	provider := NewProvider(client, DefaultTTL, DefaultMinRefreshInterval)
+	provider.now = func() time.Time { return now }
+
+	got, err := provider.Get(ctx, secretID)
+	require.NoError(t, err)
+	assert.Equal(t, Value{Current: "key-1"}, got)
+
+	// served from the cache until the TTL is up
+	now = now.Add(DefaultTTL - time.Second)
+	got, err = provider.Get(ctx, secretID)
+	require.NoError(t, err)
+	assert.Equal(t, Value{Current: "key-1"}, got)
+
+	now = now.Add(time.Second)
+	expectStage(client, "AWSCURRENT", "key-2", nil)
+	expectStage(client, "AWSPREVIOUS", "key-1", nil)
+	got, err = provider.Get(ctx, secretID)
+	require.NoError(t, err)
+	assert.Equal(t, Value{Current: "key-2", Previous: "key-1"}, got)
+}
+
+func TestProvider_Get_ServesCachedValueOnError(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(10000, 0)
+
+	client := NewMockClient(t)
+	expectStage(client, "AWSCURRENT", "", errors.New("throttled"))
+
+	provider := NewProvider(client, DefaultTTL, DefaultMinRefreshInterval)
+	provider.now = func() time.Time { return now }
+
+	// nothing to fall back on
+	_, err := provider.Get(ctx, secretID)
+	assert.ErrorContains(t, err, "throttled")
+
+	expectStage(client, "AWSCURRENT", "key-1", nil)
+	expectStage(client, "AWSPREVIOUS", "", &types.ResourceNotFoundException{})
+	_, err = provider.Get(ctx, secretID)
+	require.NoError(t, err)
+
+	now = now.Add(DefaultTTL)
+	expectStage(client, "AWSCURRENT", "", errors.New("throttled"))
+	got, err := provider.Get(ctx, secretID)
+	require.NoError(t, err)
+	assert.Equal(t, Value{Current: "key-1"}, got)
+
+	// the failure holds off the next attempt for another TTL
+	now = now.Add(DefaultTTL - time.Second)
+	got, err = provider.Get(ctx, secretID)
+	require.NoError(t, err)
+	assert.Equal(t, Value{Current: "key-1"}, got)
+}
+
+func TestProvider_Refresh(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(10000, 0)
+
+	client := NewMockClient(t)
+	expectStage(client, "AWSCURRENT", "key-1", nil)
+	expectStage(client, "AWSPREVIOUS", "", &types.ResourceNotFoundException{})
+
+	provider := NewProvider(client, DefaultTTL, DefaultMinRefreshInterval)
+	provider.now = func() time.Time { return now }
+
+	_, err := provider.Get(ctx, secretID)
+	require.NoError(t, err)
+
+	// too soon after the last fetch to go again
+	now = now.Add(DefaultMinRefreshInterval - time.Second)
+	got, err := provider.Refresh(ctx, secretID)
+	require.NoError(t, err)
+	assert.Equal(t, Value{Current: "key-1"}, got)
+
+	now = now.Add(time.Second)
+	expectStage(client, "AWSCURRENT", "key-2", nil)
+	expectStage(client, "AWSPREVIOUS", "key-1", nil)
+	got, err = provider.Refresh(ctx, secretID)
+	require.NoError(t, err)
+	assert.Equal(t, Value{Current: "key-2", Previous: "key-1"}, got)
+}
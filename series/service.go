@@ -11,13 +11,17 @@ type IRacingClient interface {
 }
 
 type Series struct {
-	ID        int
-	Name      string
-	ShortName string
-	Category  string
-	LogoURL   string
-	Active    bool
-	Official  bool
+	ID         int
+	Name       string
+	ShortName  string
+	Category   string
+	CategoryID int
+	LogoURL    string
+	Active     bool
+	Official   bool
+	// MinLicenseGroup is the lowest license group (1 rookie through 5 A, 6 pro) that can enter the series, zero when
+	// iRacing doesn't say
+	MinLicenseGroup int
 }
 
 type Service struct {
@@ -37,12 +41,18 @@ func (s *Service) GetAll(ctx context.Context, accessToken string) ([]Series, err
 	result := make([]Series, 0, len(seriesInfos))
 	for _, info := range seriesInfos {
 		series := Series{
-			ID:        info.SeriesID,
-			Name:      info.SeriesName,
-			ShortName: info.SeriesShortName,
-			Category:  info.Category,
-			Active:    info.Active,
-			Official:  info.Official,
+			ID:         info.SeriesID,
+			Name:       info.SeriesName,
+			ShortName:  info.SeriesShortName,
+			Category:   info.Category,
+			CategoryID: info.CategoryID,
+			Active:     info.Active,
+			Official:   info.Official,
+		}
+		for _, license := range info.AllowedLicenses {
+			if series.MinLicenseGroup == 0 || license.LicenseGroup < series.MinLicenseGroup {
+				series.MinLicenseGroup = license.LicenseGroup
+			}
 		}
 
 		if info.Logo != "" {
@@ -53,4 +63,4 @@ func (s *Service) GetAll(ctx context.Context, accessToken string) ([]Series, err
 	}
 
 	return result, nil
-}
\ No newline at end of file
+}
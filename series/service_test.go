@@ -39,6 +39,10 @@ func TestService_GetAll(t *testing.T) {
 						Official:        true,
 						FixedSetup:      false,
 						Logo:            "/img/logos/series/porsche-cup-logo.png",
+						AllowedLicenses: []iracing.AllowedLicense{
+							{GroupName: "Class B", LicenseGroup: 4, MinLicenseLevel: 13, MaxLicenseLevel: 16},
+							{GroupName: "Class C", LicenseGroup: 3, MinLicenseLevel: 9, MaxLicenseLevel: 12},
+						},
 					},
 					{
 						SeriesID:        236,
@@ -55,22 +59,25 @@ func TestService_GetAll(t *testing.T) {
 			},
 			expectedSeries: []Series{
 				{
-					ID:        159,
-					Name:      "Porsche 911 GT3 Cup",
-					ShortName: "Porsche Cup",
-					Category:  "Road",
-					LogoURL:   "https://images-static.iracing.com/img/logos/series/porsche-cup-logo.png",
-					Active:    true,
-					Official:  true,
+					ID:              159,
+					Name:            "Porsche 911 GT3 Cup",
+					ShortName:       "Porsche Cup",
+					Category:        "Road",
+					CategoryID:      2,
+					LogoURL:         "https://images-static.iracing.com/img/logos/series/porsche-cup-logo.png",
+					Active:          true,
+					Official:        true,
+					MinLicenseGroup: 3,
 				},
 				{
-					ID:        236,
-					Name:      "NASCAR Cup Series",
-					ShortName: "Cup Series",
-					Category:  "Oval",
-					LogoURL:   "",
-					Active:    true,
-					Official:  true,
+					ID:         236,
+					Name:       "NASCAR Cup Series",
+					ShortName:  "Cup Series",
+					Category:   "Oval",
+					CategoryID: 1,
+					LogoURL:    "",
+					Active:     true,
+					Official:   true,
 				},
 			},
 		},
@@ -112,4 +119,4 @@ func TestService_GetAll(t *testing.T) {
 			assert.Equal(t, tc.expectedSeries, series)
 		})
 	}
-}
\ No newline at end of file
+}
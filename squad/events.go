@@ -0,0 +1,171 @@
+package squad
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// EventLookback is how far back active members' races are searched for a session when it's tagged as a squad event,
+// covering events tagged after members' races were already ingested.
+const EventLookback = 14 * 24 * time.Hour
+
+// ErrEventNotFound is returned when the squad hasn't tagged the session as an event.
+var ErrEventNotFound = errors.New("squad event not found")
+
+// EventStore defines the data access methods needed to tag members' sessions as squad events.
+type EventStore interface {
+	GetSessionSquadEvents(ctx context.Context, subsessionID int64) ([]store.SquadEvent, error)
+	GetSquadMember(ctx context.Context, squadID string, driverID int64) (*store.SquadMember, error)
+	TagDriverSessionSquadEvents(ctx context.Context, driverID int64, startTime time.Time, squadIDs []string) error
+	SaveSquadEventResult(ctx context.Context, result store.SquadEventResult) error
+}
+
+// EventTagger tags drivers' sessions with the squads that marked them as squad events, recording each member's finish
+// for the squad's event results.
+type EventTagger struct {
+	store EventStore
+}
+
+func NewEventTagger(store EventStore) *EventTagger {
+	return &EventTagger{store: store}
+}
+
+// TagSession tags a freshly ingested session as an event of each squad that marked it, so long as the driver is an
+// active member. Sessions that aren't squad events are left alone.
+func (t *EventTagger) TagSession(ctx context.Context, session store.DriverSession) error {
+	events, err := t.store.GetSessionSquadEvents(ctx, session.SubsessionID)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		member, err := t.store.GetSquadMember(ctx, event.SquadID, session.DriverID)
+		if err != nil {
+			return err
+		}
+		if member == nil || member.Status != store.SquadMemberActive {
+			continue
+		}
+		if err := t.tag(ctx, *member, session); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *EventTagger) tag(ctx context.Context, member store.SquadMember, session store.DriverSession) error {
+	err := t.store.SaveSquadEventResult(ctx, store.SquadEventResult{
+		SquadID:               member.SquadID,
+		SubsessionID:          session.SubsessionID,
+		DriverID:              session.DriverID,
+		DriverName:            member.DriverName,
+		StartTime:             session.StartTime,
+		CarID:                 session.CarID,
+		CarClassID:            session.CarClassID,
+		StartPosition:         session.StartPosition,
+		FinishPosition:        session.FinishPosition,
+		FinishPositionInClass: session.FinishPositionInClass,
+		Incidents:             session.Incidents,
+		LapsComplete:          session.LapsComplete,
+		OldIRating:            session.OldIRating,
+		NewIRating:            session.NewIRating,
+		ReasonOutCode:         session.Outcome(),
+	})
+	if err != nil {
+		return err
+	}
+	return t.store.TagDriverSessionSquadEvents(ctx, session.DriverID, session.StartTime, []string{member.SquadID})
+}
+
+// EventResults are the finishes of a squad's members in one of its events.
+type EventResults struct {
+	Event   store.SquadEvent
+	Results []store.SquadEventResult
+}
+
+// TagEvent marks a session as a squad event. Active members who have already raced it within EventLookback are tagged
+// straight away, anyone else is tagged as their races are ingested. Returns ErrNotManager unless managerID is the
+// squad's manager.
+func (s *Service) TagEvent(ctx context.Context, managerID int64, squadID string, subsessionID int64, name string) (*store.SquadEvent, error) {
+	if _, err := s.managedSquad(ctx, managerID, squadID); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	event := store.SquadEvent{
+		SquadID:      squadID,
+		SubsessionID: subsessionID,
+		Name:         name,
+		TaggedAt:     now,
+	}
+	if err := s.store.SaveSquadEvent(ctx, event); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.GetSquadMembers(ctx, squadID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if m.Status != store.SquadMemberActive {
+			continue
+		}
+		sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, m.DriverID, now.Add(-EventLookback), now)
+		if err != nil {
+			return nil, err
+		}
+		for _, session := range sessions {
+			if session.SubsessionID != subsessionID {
+				continue
+			}
+			if err := s.tagger.tag(ctx, m, session); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &event, nil
+}
+
+// Events returns the squad's events, most recent session first. Returns ErrSquadNotFound unless the driver is in the
+// squad or invited to it.
+func (s *Service) Events(ctx context.Context, driverID int64, squadID string) ([]store.SquadEvent, error) {
+	if err := s.checkVisible(ctx, driverID, squadID); err != nil {
+		return nil, err
+	}
+	return s.store.GetSquadEvents(ctx, squadID)
+}
+
+// EventResults returns the members' finishes in a squad event, in finishing order. Returns ErrSquadNotFound unless the
+// driver is in the squad or invited to it, and ErrEventNotFound if the squad hasn't tagged the session.
+func (s *Service) EventResults(ctx context.Context, driverID int64, squadID string, subsessionID int64) (*EventResults, error) {
+	if err := s.checkVisible(ctx, driverID, squadID); err != nil {
+		return nil, err
+	}
+
+	event, err := s.store.GetSquadEvent(ctx, squadID, subsessionID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrEventNotFound
+	}
+	results, err := s.store.GetSquadEventResults(ctx, squadID, subsessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &EventResults{Event: *event, Results: results}, nil
+}
+
+// checkVisible returns ErrSquadNotFound unless the driver is in the squad or invited to it.
+func (s *Service) checkVisible(ctx context.Context, driverID int64, squadID string) error {
+	member, err := s.store.GetSquadMember(ctx, squadID, driverID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return ErrSquadNotFound
+	}
+	return nil
+}
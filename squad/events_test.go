@@ -0,0 +1,180 @@
+package squad
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSubsessionID = int64(555)
+
+var testEvent = store.SquadEvent{
+	SquadID:      testSquadID,
+	SubsessionID: testSubsessionID,
+	Name:         "Race Night",
+	TaggedAt:     time.Unix(1700000000, 0),
+}
+
+var testEventSession = store.DriverSession{
+	DriverID:              testDriverID,
+	SubsessionID:          testSubsessionID,
+	StartTime:             time.Unix(1699990000, 0),
+	CarID:                 10,
+	CarClassID:            20,
+	StartPosition:         4,
+	FinishPosition:        2,
+	FinishPositionInClass: 1,
+	Incidents:             3,
+	LapsComplete:          25,
+	OldIRating:            1800,
+	NewIRating:            1850,
+	ReasonOutCode:         store.ReasonOutFinished,
+}
+
+var testEventResult = store.SquadEventResult{
+	SquadID:               testSquadID,
+	SubsessionID:          testSubsessionID,
+	DriverID:              testDriverID,
+	DriverName:            "Other Driver",
+	StartTime:             time.Unix(1699990000, 0),
+	CarID:                 10,
+	CarClassID:            20,
+	StartPosition:         4,
+	FinishPosition:        2,
+	FinishPositionInClass: 1,
+	Incidents:             3,
+	LapsComplete:          25,
+	OldIRating:            1800,
+	NewIRating:            1850,
+	ReasonOutCode:         store.ReasonOutFinished,
+}
+
+func TestEventTagger_TagSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("tags squads the driver is active in", func(t *testing.T) {
+		mockStore := NewMockEventStore(t)
+		mockStore.EXPECT().GetSessionSquadEvents(mock.Anything, testSubsessionID).Return([]store.SquadEvent{
+			testEvent,
+			{SquadID: "squad-invited", SubsessionID: testSubsessionID},
+			{SquadID: "squad-stranger", SubsessionID: testSubsessionID},
+		}, nil)
+		mockStore.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).
+			Return(&store.SquadMember{SquadID: testSquadID, DriverID: testDriverID, DriverName: "Other Driver", Status: store.SquadMemberActive}, nil)
+		mockStore.EXPECT().GetSquadMember(mock.Anything, "squad-invited", testDriverID).
+			Return(&store.SquadMember{SquadID: "squad-invited", DriverID: testDriverID, Status: store.SquadMemberInvited}, nil)
+		mockStore.EXPECT().GetSquadMember(mock.Anything, "squad-stranger", testDriverID).Return(nil, nil)
+		mockStore.EXPECT().SaveSquadEventResult(mock.Anything, testEventResult).Return(nil)
+		mockStore.EXPECT().TagDriverSessionSquadEvents(mock.Anything, testDriverID, testEventSession.StartTime, []string{testSquadID}).Return(nil)
+
+		require.NoError(t, NewEventTagger(mockStore).TagSession(ctx, testEventSession))
+	})
+
+	t.Run("not a squad event", func(t *testing.T) {
+		mockStore := NewMockEventStore(t)
+		mockStore.EXPECT().GetSessionSquadEvents(mock.Anything, testSubsessionID).Return([]store.SquadEvent{}, nil)
+
+		require.NoError(t, NewEventTagger(mockStore).TagSession(ctx, testEventSession))
+	})
+}
+
+func TestService_TagEvent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	from := now.Add(-EventLookback)
+
+	t.Run("tags members who already raced it", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquad(mock.Anything, testSquadID).Return(&testSquad, nil)
+		mockStore.EXPECT().SaveSquadEvent(mock.Anything, testEvent).Return(nil)
+		mockStore.EXPECT().GetSquadMembers(mock.Anything, testSquadID).Return([]store.SquadMember{
+			{SquadID: testSquadID, DriverID: testManagerID, DriverName: "Jon Sabados", Status: store.SquadMemberActive},
+			{SquadID: testSquadID, DriverID: testDriverID, DriverName: "Other Driver", Status: store.SquadMemberActive},
+			{SquadID: testSquadID, DriverID: 3, Status: store.SquadMemberInvited},
+		}, nil)
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, testManagerID, from, now).
+			Return([]store.DriverSession{{DriverID: testManagerID, SubsessionID: 999}}, nil)
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, testDriverID, from, now).
+			Return([]store.DriverSession{{DriverID: testDriverID, SubsessionID: 999}, testEventSession}, nil)
+		mockStore.EXPECT().SaveSquadEventResult(mock.Anything, testEventResult).Return(nil)
+		mockStore.EXPECT().TagDriverSessionSquadEvents(mock.Anything, testDriverID, testEventSession.StartTime, []string{testSquadID}).Return(nil)
+
+		svc := NewService(mockStore, nil)
+		svc.now = func() time.Time { return now }
+
+		event, err := svc.TagEvent(ctx, testManagerID, testSquadID, testSubsessionID, "Race Night")
+		require.NoError(t, err)
+		assert.Equal(t, &testEvent, event)
+	})
+
+	t.Run("not the manager", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquad(mock.Anything, testSquadID).Return(&testSquad, nil)
+
+		_, err := NewService(mockStore, nil).TagEvent(ctx, testDriverID, testSquadID, testSubsessionID, "Race Night")
+		assert.ErrorIs(t, err, ErrNotManager)
+	})
+}
+
+func TestService_Events(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("member", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).
+			Return(&store.SquadMember{DriverID: testDriverID, Status: store.SquadMemberInvited}, nil)
+		mockStore.EXPECT().GetSquadEvents(mock.Anything, testSquadID).Return([]store.SquadEvent{testEvent}, nil)
+
+		events, err := NewService(mockStore, nil).Events(ctx, testDriverID, testSquadID)
+		require.NoError(t, err)
+		assert.Equal(t, []store.SquadEvent{testEvent}, events)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).Return(nil, nil)
+
+		_, err := NewService(mockStore, nil).Events(ctx, testDriverID, testSquadID)
+		assert.ErrorIs(t, err, ErrSquadNotFound)
+	})
+}
+
+func TestService_EventResults(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("member", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).
+			Return(&store.SquadMember{DriverID: testDriverID, Status: store.SquadMemberActive}, nil)
+		mockStore.EXPECT().GetSquadEvent(mock.Anything, testSquadID, testSubsessionID).Return(&testEvent, nil)
+		mockStore.EXPECT().GetSquadEventResults(mock.Anything, testSquadID, testSubsessionID).
+			Return([]store.SquadEventResult{testEventResult}, nil)
+
+		results, err := NewService(mockStore, nil).EventResults(ctx, testDriverID, testSquadID, testSubsessionID)
+		require.NoError(t, err)
+		assert.Equal(t, &EventResults{Event: testEvent, Results: []store.SquadEventResult{testEventResult}}, results)
+	})
+
+	t.Run("event not found", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).
+			Return(&store.SquadMember{DriverID: testDriverID, Status: store.SquadMemberActive}, nil)
+		mockStore.EXPECT().GetSquadEvent(mock.Anything, testSquadID, testSubsessionID).Return(nil, nil)
+
+		_, err := NewService(mockStore, nil).EventResults(ctx, testDriverID, testSquadID, testSubsessionID)
+		assert.ErrorIs(t, err, ErrEventNotFound)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).Return(nil, nil)
+
+		_, err := NewService(mockStore, nil).EventResults(ctx, testDriverID, testSquadID, testSubsessionID)
+		assert.ErrorIs(t, err, ErrSquadNotFound)
+	})
+}
@@ -0,0 +1,354 @@
+package squad
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/benchmark"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// MaxNameLength caps squad names.
+const MaxNameLength = 100
+
+// IRatingLookback is how far back a member's races are searched for their current iRating. Members who haven't raced
+// in that time are counted as unrated rather than placed by a stale number.
+const IRatingLookback = 90 * 24 * time.Hour
+
+var (
+	// ErrSquadNotFound is returned when the squad doesn't exist, or the driver isn't in it so can't see it.
+	ErrSquadNotFound = errors.New("squad not found")
+	// ErrNotManager is returned when a driver other than the squad's manager tries to manage it.
+	ErrNotManager = errors.New("not the squad manager")
+	// ErrDriverNotFound is returned when inviting a driver who has never logged in.
+	ErrDriverNotFound = errors.New("driver not found")
+	// ErrAlreadyMember is returned when inviting a driver who is already an active member.
+	ErrAlreadyMember = errors.New("driver is already a member")
+	// ErrNotInvited is returned when accepting an invite the driver doesn't have.
+	ErrNotInvited = errors.New("driver has not been invited")
+	// ErrMemberNotFound is returned when removing a driver who isn't in the squad.
+	ErrMemberNotFound = errors.New("member not found")
+	// ErrManagerCannotLeave is returned when removing the squad's manager, squads always have one.
+	ErrManagerCannotLeave = errors.New("manager cannot leave the squad")
+)
+
+// ValidateName checks a squad name. Returns validation errors for a blank or overly long name.
+func ValidateName(name string) []journal.FieldValidation {
+	if strings.TrimSpace(name) == "" {
+		return []journal.FieldValidation{{Field: "name", Code: "required"}}
+	}
+	if len(name) > MaxNameLength {
+		return []journal.FieldValidation{{
+			Field:  "name",
+			Code:   "too_long",
+			Params: map[string]string{"max": strconv.Itoa(MaxNameLength)},
+		}}
+	}
+	return nil
+}
+
+// Store defines the data access methods needed by the squad service.
+type Store interface {
+	GetDriver(ctx context.Context, driverID int64, opts ...store.ReadOption) (*store.Driver, error)
+	SaveSquad(ctx context.Context, squad store.Squad) error
+	GetSquad(ctx context.Context, squadID string) (*store.Squad, error)
+	SaveSquadMember(ctx context.Context, member store.SquadMember) error
+	GetSquadMember(ctx context.Context, squadID string, driverID int64) (*store.SquadMember, error)
+	GetSquadMembers(ctx context.Context, squadID string) ([]store.SquadMember, error)
+	GetDriverSquads(ctx context.Context, driverID int64) ([]store.SquadMember, error)
+	DeleteSquadMember(ctx context.Context, squadID string, driverID int64) error
+	GetDriverRollup(ctx context.Context, driverID int64, scope string) (*store.DriverRollup, error)
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	SaveSquadEvent(ctx context.Context, event store.SquadEvent) error
+	GetSquadEvent(ctx context.Context, squadID string, subsessionID int64) (*store.SquadEvent, error)
+	GetSquadEvents(ctx context.Context, squadID string) ([]store.SquadEvent, error)
+	GetSquadEventResults(ctx context.Context, squadID string, subsessionID int64) ([]store.SquadEventResult, error)
+	GetSessionSquadEvents(ctx context.Context, subsessionID int64) ([]store.SquadEvent, error)
+	TagDriverSessionSquadEvents(ctx context.Context, driverID int64, startTime time.Time, squadIDs []string) error
+	SaveSquadEventResult(ctx context.Context, result store.SquadEventResult) error
+}
+
+// Service manages squads, groups of drivers such as leagues or teams run by a manager. Drivers only count towards a
+// squad's analytics once they've accepted the manager's invite.
+type Service struct {
+	store  Store
+	tagger *EventTagger
+	newID  func() string
+	now    func() time.Time
+}
+
+func NewService(store Store, newID func() string) *Service {
+	return &Service{
+		store:  store,
+		tagger: NewEventTagger(store),
+		newID:  newID,
+		now:    time.Now,
+	}
+}
+
+// Details is a squad along with everyone in it or invited to it.
+type Details struct {
+	Squad   store.Squad
+	Members []store.SquadMember
+}
+
+// Create starts a squad managed by the driver, who joins it as its first active member.
+func (s *Service) Create(ctx context.Context, managerID int64, name string) (*store.Squad, error) {
+	manager, err := s.store.GetDriver(ctx, managerID)
+	if err != nil {
+		return nil, err
+	}
+	if manager == nil {
+		return nil, ErrDriverNotFound
+	}
+
+	now := s.now()
+	squad := store.Squad{
+		SquadID:     s.newID(),
+		Name:        name,
+		ManagerID:   managerID,
+		ManagerName: manager.DriverName,
+		CreatedAt:   now,
+	}
+	if err := s.store.SaveSquad(ctx, squad); err != nil {
+		return nil, err
+	}
+	err = s.store.SaveSquadMember(ctx, store.SquadMember{
+		SquadID:    squad.SquadID,
+		SquadName:  squad.Name,
+		DriverID:   managerID,
+		DriverName: manager.DriverName,
+		Status:     store.SquadMemberActive,
+		InvitedAt:  now,
+		JoinedAt:   &now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &squad, nil
+}
+
+// Get returns the squad and its members. Returns ErrSquadNotFound unless the driver is in the squad or invited to it.
+func (s *Service) Get(ctx context.Context, driverID int64, squadID string) (*Details, error) {
+	squad, err := s.store.GetSquad(ctx, squadID)
+	if err != nil {
+		return nil, err
+	}
+	if squad == nil {
+		return nil, ErrSquadNotFound
+	}
+
+	members, err := s.store.GetSquadMembers(ctx, squadID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if m.DriverID == driverID {
+			return &Details{Squad: *squad, Members: members}, nil
+		}
+	}
+	return nil, ErrSquadNotFound
+}
+
+// ListForDriver returns the squads the driver is in, along with any they have been invited to.
+func (s *Service) ListForDriver(ctx context.Context, driverID int64) ([]store.SquadMember, error) {
+	return s.store.GetDriverSquads(ctx, driverID)
+}
+
+// Invite asks a driver to join the squad. Inviting a driver who already has an invite refreshes it. Returns
+// ErrNotManager unless managerID is the squad's manager, ErrDriverNotFound if the driver has never logged in, and
+// ErrAlreadyMember if they've already accepted.
+func (s *Service) Invite(ctx context.Context, managerID int64, squadID string, driverID int64) (*store.SquadMember, error) {
+	squad, err := s.managedSquad(ctx, managerID, squadID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.store.GetSquadMember(ctx, squadID, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.Status == store.SquadMemberActive {
+		return nil, ErrAlreadyMember
+	}
+
+	driver, err := s.store.GetDriver(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if driver == nil {
+		return nil, ErrDriverNotFound
+	}
+
+	member := store.SquadMember{
+		SquadID:    squad.SquadID,
+		SquadName:  squad.Name,
+		DriverID:   driverID,
+		DriverName: driver.DriverName,
+		Status:     store.SquadMemberInvited,
+		InvitedAt:  s.now(),
+	}
+	if err := s.store.SaveSquadMember(ctx, member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// Accept joins the driver to a squad they've been invited to, consenting to their races counting towards its
+// analytics. Accepting again is a no-op. Returns ErrNotInvited if the driver has no invite.
+func (s *Service) Accept(ctx context.Context, driverID int64, squadID string) (*store.SquadMember, error) {
+	member, err := s.store.GetSquadMember(ctx, squadID, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, ErrNotInvited
+	}
+	if member.Status == store.SquadMemberActive {
+		return member, nil
+	}
+
+	now := s.now()
+	member.Status = store.SquadMemberActive
+	member.JoinedAt = &now
+	if err := s.store.SaveSquadMember(ctx, *member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// Remove takes a driver out of a squad, withdrawing their consent or invite. Drivers can remove themselves, and the
+// manager can remove anyone but themselves. Returns ErrMemberNotFound if the driver isn't in the squad.
+func (s *Service) Remove(ctx context.Context, callerID int64, squadID string, driverID int64) error {
+	squad, err := s.store.GetSquad(ctx, squadID)
+	if err != nil {
+		return err
+	}
+	if squad == nil {
+		return ErrSquadNotFound
+	}
+	if driverID == squad.ManagerID {
+		return ErrManagerCannotLeave
+	}
+	if callerID != driverID && callerID != squad.ManagerID {
+		return ErrNotManager
+	}
+
+	member, err := s.store.GetSquadMember(ctx, squadID, driverID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return ErrMemberNotFound
+	}
+	return s.store.DeleteSquadMember(ctx, squadID, driverID)
+}
+
+// IRatingBand is how many of a squad's members have an iRating in the band starting at Band.
+type IRatingBand struct {
+	Band    int
+	Drivers int
+}
+
+// Analytics are a squad's combined results, built only from members who have accepted their invite.
+type Analytics struct {
+	ActiveMembers  int
+	InvitedMembers int
+	store.SessionTotals
+	// IRatingDistribution is ordered by band, with bands nobody falls in left out
+	IRatingDistribution []IRatingBand
+	// Unrated members haven't raced within IRatingLookback
+	Unrated int
+}
+
+// AverageIncidents is the incidents per race across the squad, zero if nobody has raced.
+func (a Analytics) AverageIncidents() float64 {
+	if a.Races == 0 {
+		return 0
+	}
+	return float64(a.Incidents) / float64(a.Races)
+}
+
+// Analytics computes the squad's combined results. Returns ErrNotManager unless managerID is the squad's manager.
+func (s *Service) Analytics(ctx context.Context, managerID int64, squadID string) (*Analytics, error) {
+	if _, err := s.managedSquad(ctx, managerID, squadID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.GetSquadMembers(ctx, squadID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	result := &Analytics{}
+	bands := make(map[int]int)
+	for _, m := range members {
+		if m.Status != store.SquadMemberActive {
+			result.InvitedMembers++
+			continue
+		}
+		result.ActiveMembers++
+
+		rollup, err := s.store.GetDriverRollup(ctx, m.DriverID, store.RollupScopeAllTime)
+		if err != nil {
+			return nil, err
+		}
+		if rollup != nil {
+			result.SessionTotals = result.SessionTotals.Add(rollup.SessionTotals)
+		}
+
+		iRating, err := s.currentIRating(ctx, m.DriverID, now)
+		if err != nil {
+			return nil, err
+		}
+		if iRating == 0 {
+			result.Unrated++
+			continue
+		}
+		bands[benchmark.IRatingBand(iRating)]++
+	}
+
+	result.IRatingDistribution = make([]IRatingBand, 0, len(bands))
+	for band, drivers := range bands {
+		result.IRatingDistribution = append(result.IRatingDistribution, IRatingBand{Band: band, Drivers: drivers})
+	}
+	sort.Slice(result.IRatingDistribution, func(i, j int) bool {
+		return result.IRatingDistribution[i].Band < result.IRatingDistribution[j].Band
+	})
+	return result, nil
+}
+
+// currentIRating returns the iRating from the driver's most recent rated race within IRatingLookback, or zero if
+// there isn't one.
+func (s *Service) currentIRating(ctx context.Context, driverID int64, now time.Time) (int, error) {
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, driverID, now.Add(-IRatingLookback), now)
+	if err != nil {
+		return 0, err
+	}
+	for _, session := range sessions {
+		if session.NewIRating > 0 {
+			return session.NewIRating, nil
+		}
+	}
+	return 0, nil
+}
+
+func (s *Service) managedSquad(ctx context.Context, managerID int64, squadID string) (*store.Squad, error) {
+	squad, err := s.store.GetSquad(ctx, squadID)
+	if err != nil {
+		return nil, err
+	}
+	if squad == nil {
+		return nil, ErrSquadNotFound
+	}
+	if squad.ManagerID != managerID {
+		return nil, ErrNotManager
+	}
+	return squad, nil
+}
@@ -0,0 +1,384 @@
+package squad
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSquadID   = "squad-1"
+	testManagerID = int64(12345)
+	testDriverID  = int64(67890)
+)
+
+var testSquad = store.Squad{
+	SquadID:     testSquadID,
+	Name:        "Saturday League",
+	ManagerID:   testManagerID,
+	ManagerName: "Jon Sabados",
+	CreatedAt:   time.Unix(1700000000, 0),
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetDriver(mock.Anything, testManagerID).Return(&store.Driver{DriverID: testManagerID, DriverName: "Jon Sabados"}, nil)
+	mockStore.EXPECT().SaveSquad(mock.Anything, testSquad).Return(nil)
+	mockStore.EXPECT().SaveSquadMember(mock.Anything, store.SquadMember{
+		SquadID:    testSquadID,
+		SquadName:  "Saturday League",
+		DriverID:   testManagerID,
+		DriverName: "Jon Sabados",
+		Status:     store.SquadMemberActive,
+		InvitedAt:  now,
+		JoinedAt:   &now,
+	}).Return(nil)
+
+	svc := NewService(mockStore, func() string { return testSquadID })
+	svc.now = func() time.Time { return now }
+
+	result, err := svc.Create(ctx, testManagerID, "Saturday League")
+	require.NoError(t, err)
+	assert.Equal(t, &testSquad, result)
+}
+
+func TestService_Get(t *testing.T) {
+	ctx := context.Background()
+	members := []store.SquadMember{
+		{SquadID: testSquadID, DriverID: testManagerID, Status: store.SquadMemberActive},
+		{SquadID: testSquadID, DriverID: testDriverID, Status: store.SquadMemberInvited},
+	}
+
+	testCases := []struct {
+		name        string
+		driverID    int64
+		squad       *store.Squad
+		expected    *Details
+		expectedErr error
+	}{
+		{
+			name:     "manager",
+			driverID: testManagerID,
+			squad:    &testSquad,
+			expected: &Details{Squad: testSquad, Members: members},
+		},
+		{
+			name:     "invited driver",
+			driverID: testDriverID,
+			squad:    &testSquad,
+			expected: &Details{Squad: testSquad, Members: members},
+		},
+		{
+			name:        "outsider",
+			driverID:    555,
+			squad:       &testSquad,
+			expectedErr: ErrSquadNotFound,
+		},
+		{
+			name:        "no such squad",
+			driverID:    testManagerID,
+			expectedErr: ErrSquadNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetSquad(mock.Anything, testSquadID).Return(tc.squad, nil)
+			if tc.squad != nil {
+				mockStore.EXPECT().GetSquadMembers(mock.Anything, testSquadID).Return(members, nil)
+			}
+
+			result, err := NewService(mockStore, nil).Get(ctx, tc.driverID, testSquadID)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestService_Invite(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700100000, 0)
+	joinedAt := time.Unix(1700050000, 0)
+	invite := store.SquadMember{
+		SquadID:    testSquadID,
+		SquadName:  "Saturday League",
+		DriverID:   testDriverID,
+		DriverName: "Other Driver",
+		Status:     store.SquadMemberInvited,
+		InvitedAt:  now,
+	}
+
+	testCases := []struct {
+		name        string
+		managerID   int64
+		setupMock   func(*MockStore)
+		expected    *store.SquadMember
+		expectedErr error
+	}{
+		{
+			name:      "success",
+			managerID: testManagerID,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetSquad(mock.Anything, testSquadID).Return(&testSquad, nil)
+				m.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).Return(nil, nil)
+				m.EXPECT().GetDriver(mock.Anything, testDriverID).Return(&store.Driver{DriverID: testDriverID, DriverName: "Other Driver"}, nil)
+				m.EXPECT().SaveSquadMember(mock.Anything, invite).Return(nil)
+			},
+			expected: &invite,
+		},
+		{
+			name:      "re-invite refreshes the invite",
+			managerID: testManagerID,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetSquad(mock.Anything, testSquadID).Return(&testSquad, nil)
+				m.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).Return(&store.SquadMember{Status: store.SquadMemberInvited}, nil)
+				m.EXPECT().GetDriver(mock.Anything, testDriverID).Return(&store.Driver{DriverID: testDriverID, DriverName: "Other Driver"}, nil)
+				m.EXPECT().SaveSquadMember(mock.Anything, invite).Return(nil)
+			},
+			expected: &invite,
+		},
+		{
+			name:      "not the manager",
+			managerID: 555,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetSquad(mock.Anything, testSquadID).Return(&testSquad, nil)
+			},
+			expectedErr: ErrNotManager,
+		},
+		{
+			name:      "already a member",
+			managerID: testManagerID,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetSquad(mock.Anything, testSquadID).Return(&testSquad, nil)
+				m.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).
+					Return(&store.SquadMember{Status: store.SquadMemberActive, JoinedAt: &joinedAt}, nil)
+			},
+			expectedErr: ErrAlreadyMember,
+		},
+		{
+			name:      "driver never logged in",
+			managerID: testManagerID,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetSquad(mock.Anything, testSquadID).Return(&testSquad, nil)
+				m.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).Return(nil, nil)
+				m.EXPECT().GetDriver(mock.Anything, testDriverID).Return(nil, nil)
+			},
+			expectedErr: ErrDriverNotFound,
+		},
+		{
+			name:      "no such squad",
+			managerID: testManagerID,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetSquad(mock.Anything, testSquadID).Return(nil, nil)
+			},
+			expectedErr: ErrSquadNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			svc := NewService(mockStore, nil)
+			svc.now = func() time.Time { return now }
+
+			result, err := svc.Invite(ctx, tc.managerID, testSquadID, testDriverID)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestService_Accept(t *testing.T) {
+	ctx := context.Background()
+	invitedAt := time.Unix(1700000000, 0)
+	now := time.Unix(1700100000, 0)
+
+	t.Run("accepts invite", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).Return(&store.SquadMember{
+			SquadID: testSquadID, DriverID: testDriverID, Status: store.SquadMemberInvited, InvitedAt: invitedAt,
+		}, nil)
+		expected := store.SquadMember{
+			SquadID: testSquadID, DriverID: testDriverID, Status: store.SquadMemberActive, InvitedAt: invitedAt, JoinedAt: &now,
+		}
+		mockStore.EXPECT().SaveSquadMember(mock.Anything, expected).Return(nil)
+
+		svc := NewService(mockStore, nil)
+		svc.now = func() time.Time { return now }
+
+		result, err := svc.Accept(ctx, testDriverID, testSquadID)
+		require.NoError(t, err)
+		assert.Equal(t, &expected, result)
+	})
+
+	t.Run("already active", func(t *testing.T) {
+		active := &store.SquadMember{SquadID: testSquadID, DriverID: testDriverID, Status: store.SquadMemberActive, JoinedAt: &invitedAt}
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).Return(active, nil)
+
+		result, err := NewService(mockStore, nil).Accept(ctx, testDriverID, testSquadID)
+		require.NoError(t, err)
+		assert.Equal(t, active, result)
+	})
+
+	t.Run("not invited", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquadMember(mock.Anything, testSquadID, testDriverID).Return(nil, nil)
+
+		_, err := NewService(mockStore, nil).Accept(ctx, testDriverID, testSquadID)
+		assert.ErrorIs(t, err, ErrNotInvited)
+	})
+}
+
+func TestService_Remove(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name        string
+		callerID    int64
+		driverID    int64
+		member      *store.SquadMember
+		expectedErr error
+	}{
+		{
+			name:     "driver leaves",
+			callerID: testDriverID,
+			driverID: testDriverID,
+			member:   &store.SquadMember{Status: store.SquadMemberActive},
+		},
+		{
+			name:     "manager removes driver",
+			callerID: testManagerID,
+			driverID: testDriverID,
+			member:   &store.SquadMember{Status: store.SquadMemberInvited},
+		},
+		{
+			name:        "manager can't leave",
+			callerID:    testManagerID,
+			driverID:    testManagerID,
+			expectedErr: ErrManagerCannotLeave,
+		},
+		{
+			name:        "other member can't remove driver",
+			callerID:    555,
+			driverID:    testDriverID,
+			expectedErr: ErrNotManager,
+		},
+		{
+			name:        "not in squad",
+			callerID:    testManagerID,
+			driverID:    testDriverID,
+			expectedErr: ErrMemberNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockStore.EXPECT().GetSquad(mock.Anything, testSquadID).Return(&testSquad, nil)
+			if tc.expectedErr == nil || tc.expectedErr == ErrMemberNotFound {
+				mockStore.EXPECT().GetSquadMember(mock.Anything, testSquadID, tc.driverID).Return(tc.member, nil)
+			}
+			if tc.expectedErr == nil {
+				mockStore.EXPECT().DeleteSquadMember(mock.Anything, testSquadID, tc.driverID).Return(nil)
+			}
+
+			err := NewService(mockStore, nil).Remove(ctx, tc.callerID, testSquadID, tc.driverID)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_Analytics(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	from := now.Add(-IRatingLookback)
+
+	t.Run("combines active members only", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquad(mock.Anything, testSquadID).Return(&testSquad, nil)
+		mockStore.EXPECT().GetSquadMembers(mock.Anything, testSquadID).Return([]store.SquadMember{
+			{DriverID: 1, Status: store.SquadMemberActive},
+			{DriverID: 2, Status: store.SquadMemberActive},
+			{DriverID: 3, Status: store.SquadMemberActive},
+			{DriverID: 4, Status: store.SquadMemberActive},
+			{DriverID: 5, Status: store.SquadMemberInvited},
+		}, nil)
+
+		mockStore.EXPECT().GetDriverRollup(mock.Anything, int64(1), store.RollupScopeAllTime).
+			Return(&store.DriverRollup{SessionTotals: store.SessionTotals{Races: 10, Wins: 2, Podiums: 4, Incidents: 30}}, nil)
+		mockStore.EXPECT().GetDriverRollup(mock.Anything, int64(2), store.RollupScopeAllTime).
+			Return(&store.DriverRollup{SessionTotals: store.SessionTotals{Races: 6, Wins: 0, Podiums: 1, Incidents: 18}}, nil)
+		mockStore.EXPECT().GetDriverRollup(mock.Anything, int64(3), store.RollupScopeAllTime).
+			Return(&store.DriverRollup{SessionTotals: store.SessionTotals{Races: 4, Wins: 1, Podiums: 1, Incidents: 12}}, nil)
+		mockStore.EXPECT().GetDriverRollup(mock.Anything, int64(4), store.RollupScopeAllTime).Return(nil, nil)
+
+		// newest first, the latest rated race wins
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, int64(1), from, now).
+			Return([]store.DriverSession{{NewIRating: 2150}, {NewIRating: 1990}}, nil)
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, int64(2), from, now).
+			Return([]store.DriverSession{{NewIRating: 0}, {NewIRating: 2400}}, nil)
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, int64(3), from, now).
+			Return([]store.DriverSession{{NewIRating: 1450}}, nil)
+		mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, int64(4), from, now).
+			Return([]store.DriverSession{}, nil)
+
+		svc := NewService(mockStore, nil)
+		svc.now = func() time.Time { return now }
+
+		result, err := svc.Analytics(ctx, testManagerID, testSquadID)
+		require.NoError(t, err)
+		assert.Equal(t, &Analytics{
+			ActiveMembers:  4,
+			InvitedMembers: 1,
+			SessionTotals:  store.SessionTotals{Races: 20, Wins: 3, Podiums: 6, Incidents: 60},
+			IRatingDistribution: []IRatingBand{
+				{Band: 1000, Drivers: 1},
+				{Band: 2000, Drivers: 2},
+			},
+			Unrated: 1,
+		}, result)
+		assert.Equal(t, 3.0, result.AverageIncidents())
+	})
+
+	t.Run("not the manager", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetSquad(mock.Anything, testSquadID).Return(&testSquad, nil)
+
+		_, err := NewService(mockStore, nil).Analytics(ctx, testDriverID, testSquadID)
+		assert.ErrorIs(t, err, ErrNotManager)
+	})
+}
+
+func TestValidateName(t *testing.T) {
+	assert.Empty(t, ValidateName("Saturday League"))
+	assert.Equal(t, "required", ValidateName("  ")[0].Code)
+	tooLong := ValidateName(strings.Repeat("a", MaxNameLength+1))
+	require.Len(t, tooLong, 1)
+	assert.Equal(t, "too_long", tooLong[0].Code)
+	assert.Equal(t, map[string]string{"max": "100"}, tooLong[0].Params)
+}
@@ -0,0 +1,194 @@
+package standings
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// recentRaceWindow is how far back to look for a race to work out which season and car class the driver is competing in.
+const recentRaceWindow = time.Hour * 24 * 14
+
+const week = time.Hour * 24 * 7
+
+// Store defines the data access methods needed to snapshot standings.
+type Store interface {
+	GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...store.SessionFilter) ([]store.DriverSession, error)
+	GetDriverStanding(ctx context.Context, driverID int64, weekStart time.Time) (*store.DriverStanding, error)
+	GetDriverStandings(ctx context.Context, driverID int64, from, to time.Time) ([]store.DriverStanding, error)
+	SaveDriverStanding(ctx context.Context, standing store.DriverStanding) error
+}
+
+// IRacingClient defines the iRacing API methods needed to snapshot standings.
+type IRacingClient interface {
+	GetMemberDivision(ctx context.Context, accessToken string, seasonID int64, eventType iracing.EventType) (*iracing.MemberDivision, error)
+	GetSeasonDriverStandings(ctx context.Context, accessToken string, seasonID, carClassID int64, opts ...iracing.SeasonStandingsOption) (*iracing.SeasonDriverStandings, error)
+}
+
+// Snapshotter records a driver's division standing once per iRacing race week.
+type Snapshotter struct {
+	store         Store
+	iracingClient IRacingClient
+	now           func() time.Time
+}
+
+// NewSnapshotter creates a new standings snapshotter.
+func NewSnapshotter(store Store, iracingClient IRacingClient) *Snapshotter {
+	return &Snapshotter{
+		store:         store,
+		iracingClient: iracingClient,
+		now:           time.Now,
+	}
+}
+
+// WeekStart returns the start of the iRacing race week containing t. Race weeks roll over Tuesday 00:00 UTC.
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	daysSinceTuesday := (int(t.Weekday()) - int(time.Tuesday) + 7) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceTuesday, 0, 0, 0, 0, time.UTC)
+}
+
+// SnapshotDriverStanding records the driver's standing within their division for the season of their most recent
+// race. Nothing is recorded if a snapshot already exists for the current race week, the driver hasn't raced recently,
+// or iRacing hasn't published standings including the driver yet. Snapshots are only taken when the driver syncs, so
+// earlier weeks of the season missed since their last snapshot are filled in from iRacing's standings for those race
+// weeks first.
+func (s *Snapshotter) SnapshotDriverStanding(ctx context.Context, accessToken string, driverID int64) error {
+	logger := zerolog.Ctx(ctx)
+
+	now := s.now()
+	weekStart := WeekStart(now)
+
+	existing, err := s.store.GetDriverStanding(ctx, driverID, weekStart)
+	if err != nil {
+		return fmt.Errorf("getting existing standing: %w", err)
+	}
+	if existing != nil {
+		logger.Debug().Int64("driverID", driverID).Time("weekStart", weekStart).Msg("standing already snapshotted this week")
+		return nil
+	}
+
+	sessions, err := s.store.GetDriverSessionsByTimeRange(ctx, driverID, now.Add(-recentRaceWindow), now)
+	if err != nil {
+		return fmt.Errorf("getting recent sessions: %w", err)
+	}
+	var latest *store.DriverSession
+	for i := range sessions {
+		// sessions ingested before season and car class data was recorded can't be matched to standings
+		if sessions[i].SeasonID != 0 && sessions[i].CarClassID != 0 {
+			latest = &sessions[i]
+			break
+		}
+	}
+	if latest == nil {
+		logger.Debug().Int64("driverID", driverID).Msg("no recent races, skipping standing snapshot")
+		return nil
+	}
+
+	division, err := s.iracingClient.GetMemberDivision(ctx, accessToken, latest.SeasonID, iracing.EventTypeRace)
+	if err != nil {
+		return fmt.Errorf("getting member division: %w", err)
+	}
+
+	if err := s.backfillMissedWeeks(ctx, accessToken, driverID, *latest, division.Division, weekStart); err != nil {
+		return err
+	}
+
+	standing, err := s.divisionStanding(ctx, accessToken, driverID, *latest, division.Division, iracing.WithDivision(division.Division))
+	if err != nil {
+		return fmt.Errorf("getting season standings: %w", err)
+	}
+	if standing == nil {
+		logger.Info().Int64("driverID", driverID).Int64("seasonID", latest.SeasonID).Msg("driver not yet in season standings")
+		return nil
+	}
+	standing.WeekStart = weekStart
+	standing.SnapshotAt = now
+	standing.RaceWeekNum = latest.RaceWeekNum
+	return s.store.SaveDriverStanding(ctx, *standing)
+}
+
+// backfillMissedWeeks saves snapshots for the weeks of the latest race's season before weekStart that came after the
+// driver's last snapshot, or from the start of the season if there isn't one. Weeks the driver isn't in iRacing's
+// standings for are left out, as they would be by a snapshot taken at the time.
+func (s *Snapshotter) backfillMissedWeeks(ctx context.Context, accessToken string, driverID int64, latest store.DriverSession, division int, weekStart time.Time) error {
+	latestWeekStart := WeekStart(latest.StartTime)
+	seasonStart := latestWeekStart.Add(-time.Duration(latest.RaceWeekNum) * week)
+
+	existing, err := s.store.GetDriverStandings(ctx, driverID, seasonStart, weekStart.Add(-time.Second))
+	if err != nil {
+		return fmt.Errorf("getting previous standings: %w", err)
+	}
+	from := seasonStart
+	for _, standing := range existing {
+		// the range can reach back into the end of the previous season, those snapshots don't count towards this one
+		if standing.SeasonID == latest.SeasonID && !standing.WeekStart.Before(from) {
+			from = standing.WeekStart.Add(week)
+		}
+	}
+
+	for missed := from; missed.Before(weekStart); missed = missed.Add(week) {
+		raceWeekNum := latest.RaceWeekNum + int(missed.Sub(latestWeekStart)/week)
+		standing, err := s.divisionStanding(ctx, accessToken, driverID, latest, division, iracing.WithDivision(division), iracing.WithRaceWeekNum(raceWeekNum))
+		if err != nil {
+			return fmt.Errorf("getting season standings for race week %d: %w", raceWeekNum, err)
+		}
+		if standing == nil {
+			continue
+		}
+		standing.WeekStart = missed
+		standing.SnapshotAt = s.now()
+		standing.RaceWeekNum = raceWeekNum
+		if err := s.store.SaveDriverStanding(ctx, *standing); err != nil {
+			return fmt.Errorf("saving standing for race week %d: %w", raceWeekNum, err)
+		}
+	}
+	return nil
+}
+
+// divisionStanding looks the driver up in the standings of the session's season and car class, nil if they aren't in
+// them. The week and snapshot times are left for the caller.
+func (s *Snapshotter) divisionStanding(ctx context.Context, accessToken string, driverID int64, session store.DriverSession, division int, opts ...iracing.SeasonStandingsOption) (*store.DriverStanding, error) {
+	standings, err := s.iracingClient.GetSeasonDriverStandings(ctx, accessToken, session.SeasonID, session.CarClassID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var driverRow *iracing.SeasonDriverStanding
+	for i := range standings.Standings {
+		if standings.Standings[i].CustID == driverID {
+			driverRow = &standings.Standings[i]
+			break
+		}
+	}
+	if driverRow == nil {
+		return nil, nil
+	}
+
+	// rank in the standings response is the overall rank, so count who is ahead within the division
+	divisionRank := 1
+	for _, row := range standings.Standings {
+		if row.Rank < driverRow.Rank {
+			divisionRank++
+		}
+	}
+
+	return &store.DriverStanding{
+		DriverID:     driverID,
+		SeasonID:     session.SeasonID,
+		SeriesID:     session.SeriesID,
+		SeriesName:   session.SeriesName,
+		CarClassID:   session.CarClassID,
+		Division:     division,
+		DivisionRank: divisionRank,
+		DivisionSize: len(standings.Standings),
+		Points:       driverRow.Points,
+		WeeksCounted: driverRow.WeeksCounted,
+		Starts:       driverRow.Starts,
+		Wins:         driverRow.Wins,
+	}, nil
+}
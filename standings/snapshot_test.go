@@ -0,0 +1,301 @@
+package standings
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/iracing"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeekStart(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    time.Time
+		expected time.Time
+	}{
+		{
+			name:     "tuesday midnight",
+			input:    time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "monday night",
+			input:    time.Date(2024, 5, 6, 23, 59, 59, 0, time.UTC),
+			expected: time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "saturday",
+			input:    time.Date(2024, 5, 11, 14, 30, 0, 0, time.UTC),
+			expected: time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "non utc input",
+			input:    time.Date(2024, 5, 6, 20, 0, 0, 0, time.FixedZone("CDT", -5*60*60)),
+			expected: time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, WeekStart(tc.input))
+		})
+	}
+}
+
+func TestSnapshotter_SnapshotDriverStanding(t *testing.T) {
+	now := time.Date(2024, 5, 11, 14, 30, 0, 0, time.UTC)
+	weekStart := time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC)
+	// race week 7 of the season started on weekStart, so week 0 started seven weeks earlier
+	seasonStart := weekStart.Add(-7 * week)
+	driverID := int64(1100750)
+
+	recentSession := store.DriverSession{
+		DriverID:    driverID,
+		StartTime:   now.Add(-2 * time.Hour),
+		SeasonID:    4500,
+		SeriesID:    42,
+		SeriesName:  "Advanced Mazda MX-5 Cup Series",
+		CarClassID:  74,
+		RaceWeekNum: 7,
+	}
+	legacySession := store.DriverSession{DriverID: driverID, SeriesID: 43}
+	lastWeekStanding := store.DriverStanding{DriverID: driverID, WeekStart: weekStart.Add(-week), SeasonID: 4500, RaceWeekNum: 6}
+
+	standingsResponse := &iracing.SeasonDriverStandings{
+		Success:    true,
+		SeasonID:   4500,
+		CarClassID: 74,
+		Standings: []iracing.SeasonDriverStanding{
+			{Rank: 12, CustID: 2000, Points: 812},
+			{Rank: 30, CustID: driverID, Points: 640, WeeksCounted: 5, Starts: 9, Wins: 1},
+			{Rank: 41, CustID: 3000, Points: 500},
+		},
+	}
+	expectedStanding := func(weekStart time.Time, raceWeekNum int) store.DriverStanding {
+		return store.DriverStanding{
+			DriverID:     driverID,
+			WeekStart:    weekStart,
+			SnapshotAt:   now,
+			SeasonID:     4500,
+			SeriesID:     42,
+			SeriesName:   "Advanced Mazda MX-5 Cup Series",
+			CarClassID:   74,
+			RaceWeekNum:  raceWeekNum,
+			Division:     3,
+			DivisionRank: 2,
+			DivisionSize: 3,
+			Points:       640,
+			WeeksCounted: 5,
+			Starts:       9,
+			Wins:         1,
+		}
+	}
+	notInStandings := &iracing.SeasonDriverStandings{
+		Success:   true,
+		Standings: []iracing.SeasonDriverStanding{{Rank: 1, CustID: 2000}},
+	}
+
+	type getStandingCall struct {
+		existing *store.DriverStanding
+		err      error
+	}
+	type sessionsCall struct {
+		sessions []store.DriverSession
+		err      error
+	}
+	type divisionCall struct {
+		result *iracing.MemberDivision
+		err    error
+	}
+	type previousStandingsCall struct {
+		standings []store.DriverStanding
+		err       error
+	}
+	type standingsCall struct {
+		opts   []iracing.SeasonStandingsOption
+		result *iracing.SeasonDriverStandings
+		err    error
+	}
+
+	division := &iracing.MemberDivision{Division: 3, Success: true, SeasonID: 4500}
+
+	testCases := []struct {
+		name string
+
+		getStandingCall       getStandingCall
+		sessionsCall          *sessionsCall
+		divisionCall          *divisionCall
+		previousStandingsCall *previousStandingsCall
+		standingsCalls        []standingsCall
+		expectedSaves         []store.DriverStanding
+		saveErr               error
+
+		expectedErr string
+	}{
+		{
+			name:                  "snapshot saved",
+			getStandingCall:       getStandingCall{},
+			sessionsCall:          &sessionsCall{sessions: []store.DriverSession{legacySession, recentSession}},
+			divisionCall:          &divisionCall{result: division},
+			previousStandingsCall: &previousStandingsCall{standings: []store.DriverStanding{lastWeekStanding}},
+			standingsCalls: []standingsCall{
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3)}, result: standingsResponse},
+			},
+			expectedSaves: []store.DriverStanding{expectedStanding(weekStart, 7)},
+		},
+		{
+			name:            "skipped weeks are backfilled",
+			getStandingCall: getStandingCall{},
+			sessionsCall:    &sessionsCall{sessions: []store.DriverSession{recentSession}},
+			divisionCall:    &divisionCall{result: division},
+			previousStandingsCall: &previousStandingsCall{standings: []store.DriverStanding{
+				{DriverID: driverID, WeekStart: weekStart.Add(-3 * week), SeasonID: 4500, RaceWeekNum: 4},
+				{DriverID: driverID, WeekStart: weekStart.Add(-5 * week), SeasonID: 4500, RaceWeekNum: 2},
+			}},
+			standingsCalls: []standingsCall{
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3), iracing.WithRaceWeekNum(5)}, result: standingsResponse},
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3), iracing.WithRaceWeekNum(6)}, result: standingsResponse},
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3)}, result: standingsResponse},
+			},
+			expectedSaves: []store.DriverStanding{
+				expectedStanding(weekStart.Add(-2*week), 5),
+				expectedStanding(weekStart.Add(-week), 6),
+				expectedStanding(weekStart, 7),
+			},
+		},
+		{
+			name:            "first snapshot of the season backfills from its start",
+			getStandingCall: getStandingCall{},
+			sessionsCall:    &sessionsCall{sessions: []store.DriverSession{recentSession}},
+			divisionCall:    &divisionCall{result: division},
+			// the previous season's last snapshot falls in the range, but isn't part of this season
+			previousStandingsCall: &previousStandingsCall{standings: []store.DriverStanding{
+				{DriverID: driverID, WeekStart: seasonStart, SeasonID: 4400, RaceWeekNum: 12},
+			}},
+			standingsCalls: []standingsCall{
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3), iracing.WithRaceWeekNum(0)}, result: notInStandings},
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3), iracing.WithRaceWeekNum(1)}, result: notInStandings},
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3), iracing.WithRaceWeekNum(2)}, result: notInStandings},
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3), iracing.WithRaceWeekNum(3)}, result: notInStandings},
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3), iracing.WithRaceWeekNum(4)}, result: notInStandings},
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3), iracing.WithRaceWeekNum(5)}, result: notInStandings},
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3), iracing.WithRaceWeekNum(6)}, result: standingsResponse},
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3)}, result: standingsResponse},
+			},
+			expectedSaves: []store.DriverStanding{
+				expectedStanding(weekStart.Add(-week), 6),
+				expectedStanding(weekStart, 7),
+			},
+		},
+		{
+			name:            "already snapshotted this week",
+			getStandingCall: getStandingCall{existing: &store.DriverStanding{DriverID: driverID, WeekStart: weekStart}},
+		},
+		{
+			name:            "no recent races with season data",
+			getStandingCall: getStandingCall{},
+			sessionsCall:    &sessionsCall{sessions: []store.DriverSession{legacySession}},
+		},
+		{
+			name:                  "driver not in standings yet",
+			getStandingCall:       getStandingCall{},
+			sessionsCall:          &sessionsCall{sessions: []store.DriverSession{recentSession}},
+			divisionCall:          &divisionCall{result: division},
+			previousStandingsCall: &previousStandingsCall{standings: []store.DriverStanding{lastWeekStanding}},
+			standingsCalls: []standingsCall{
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3)}, result: notInStandings},
+			},
+		},
+		{
+			name:            "existing standing lookup error",
+			getStandingCall: getStandingCall{err: errors.New("database error")},
+			expectedErr:     "getting existing standing: database error",
+		},
+		{
+			name:            "division lookup error",
+			getStandingCall: getStandingCall{},
+			sessionsCall:    &sessionsCall{sessions: []store.DriverSession{recentSession}},
+			divisionCall:    &divisionCall{err: errors.New("upstream error")},
+			expectedErr:     "getting member division: upstream error",
+		},
+		{
+			name:                  "previous standings lookup error",
+			getStandingCall:       getStandingCall{},
+			sessionsCall:          &sessionsCall{sessions: []store.DriverSession{recentSession}},
+			divisionCall:          &divisionCall{result: division},
+			previousStandingsCall: &previousStandingsCall{err: errors.New("database error")},
+			expectedErr:           "getting previous standings: database error",
+		},
+		{
+			name:            "backfill standings lookup error",
+			getStandingCall: getStandingCall{},
+			sessionsCall:    &sessionsCall{sessions: []store.DriverSession{recentSession}},
+			divisionCall:    &divisionCall{result: division},
+			previousStandingsCall: &previousStandingsCall{standings: []store.DriverStanding{
+				{DriverID: driverID, WeekStart: weekStart.Add(-2 * week), SeasonID: 4500, RaceWeekNum: 5},
+			}},
+			standingsCalls: []standingsCall{
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3), iracing.WithRaceWeekNum(6)}, err: errors.New("upstream error")},
+			},
+			expectedErr: "getting season standings for race week 6: upstream error",
+		},
+		{
+			name:                  "standings lookup error",
+			getStandingCall:       getStandingCall{},
+			sessionsCall:          &sessionsCall{sessions: []store.DriverSession{recentSession}},
+			divisionCall:          &divisionCall{result: division},
+			previousStandingsCall: &previousStandingsCall{standings: []store.DriverStanding{lastWeekStanding}},
+			standingsCalls: []standingsCall{
+				{opts: []iracing.SeasonStandingsOption{iracing.WithDivision(3)}, err: errors.New("upstream error")},
+			},
+			expectedErr: "getting season standings: upstream error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockStore := NewMockStore(t)
+			mockClient := NewMockIRacingClient(t)
+
+			mockStore.EXPECT().GetDriverStanding(mock.Anything, driverID, weekStart).
+				Return(tc.getStandingCall.existing, tc.getStandingCall.err)
+			if tc.sessionsCall != nil {
+				mockStore.EXPECT().GetDriverSessionsByTimeRange(mock.Anything, driverID, now.Add(-recentRaceWindow), now).
+					Return(tc.sessionsCall.sessions, tc.sessionsCall.err)
+			}
+			if tc.divisionCall != nil {
+				mockClient.EXPECT().GetMemberDivision(mock.Anything, "test-token", int64(4500), iracing.EventTypeRace).
+					Return(tc.divisionCall.result, tc.divisionCall.err)
+			}
+			if tc.previousStandingsCall != nil {
+				mockStore.EXPECT().GetDriverStandings(mock.Anything, driverID, seasonStart, weekStart.Add(-time.Second)).
+					Return(tc.previousStandingsCall.standings, tc.previousStandingsCall.err)
+			}
+			for _, call := range tc.standingsCalls {
+				mockClient.EXPECT().GetSeasonDriverStandings(mock.Anything, "test-token", int64(4500), int64(74), call.opts).
+					Return(call.result, call.err).Once()
+			}
+			for _, save := range tc.expectedSaves {
+				mockStore.EXPECT().SaveDriverStanding(mock.Anything, save).Return(tc.saveErr).Once()
+			}
+
+			snapshotter := NewSnapshotter(mockStore, mockClient)
+			snapshotter.now = func() time.Time { return now }
+
+			err := snapshotter.SnapshotDriverStanding(ctx, "test-token", driverID)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,29 +1,34 @@
 package store
 
+//go:generate go run ../cmd/dynamo-modelgen
+
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/jonsabados/saturdaysspinout/store/keys"
 )
 
 const partitionKeyName = "partition_key"
 const sortKeyName = "sort_key"
 
-const defaultSortKey = "info"
+const quotaOperationAttributeFormat = "op_%s"
+const quotaOperationAttributePrefix = "op_"
 
-const driverPartitionFormat = "driver#%d"
-const wsConnectionSortKeyFormat = "ws#%s"
-const ingestionLockSortKey = "ingestion_lock"
+// raceOrderIndexName is a sparse global secondary index over the session partition, ranging lap items by
+// raceOrderAttributeName so they can be read back in the order they were completed. Nothing else carries the
+// attribute, so nothing else lands in the index.
+const raceOrderIndexName = "race_order_index"
+const raceOrderAttributeName = "race_order"
 
-const websocketPartitionFormat = "websocket#%s"
+const rateBudgetDriverAttributeFormat = "driver_%d"
+const rateBudgetDriverAttributePrefix = "driver_"
 
-const driverSessionSortKeyFormat = "session#%d" // timestamp for ordering
-const journalEntrySortKeyFormat = "journal#%d"  // race_id (timestamp) for ordering
-
-const globalCountersPartitionKey = "global"
-const globalCountersSortKey = "counters"
 const globalCountersAttributeDrivers = "drivers"
 
 func globalCountersFromAttributeMap(item map[string]types.AttributeValue) (*GlobalCounters, error) {
@@ -40,22 +45,133 @@ func globalCountersFromAttributeMap(item map[string]types.AttributeValue) (*Glob
 	return counters, nil
 }
 
+// loginAttemptsModel represents failed sign in attempts from one source (loginattempts#<key> / info)
+type loginAttemptsModel struct {
+	key          string
+	failures     int
+	firstFailure int64
+	lastFailure  int64
+	expiresAt    int64
+}
+
+func (m loginAttemptsModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.LoginAttempts(m.key)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		"attempt_key":    &types.AttributeValueMemberS{Value: m.key},
+		"failures":       &types.AttributeValueMemberN{Value: strconv.Itoa(m.failures)},
+		"first_failure":  &types.AttributeValueMemberN{Value: strconv.FormatInt(m.firstFailure, 10)},
+		"last_failure":   &types.AttributeValueMemberN{Value: strconv.FormatInt(m.lastFailure, 10)},
+		ttlAttributeName: ttlAttr(m.expiresAt),
+	}
+}
+
+func loginAttemptsFromAttributeMap(item map[string]types.AttributeValue) (*LoginAttempts, error) {
+	key, err := getStringAttr(item, "attempt_key")
+	if err != nil {
+		return nil, err
+	}
+	failures, err := getIntAttr(item, "failures")
+	if err != nil {
+		return nil, err
+	}
+	firstFailure, err := getInt64Attr(item, "first_failure")
+	if err != nil {
+		return nil, err
+	}
+	lastFailure, err := getInt64Attr(item, "last_failure")
+	if err != nil {
+		return nil, err
+	}
+	return &LoginAttempts{
+		Key:          key,
+		Failures:     failures,
+		FirstFailure: time.Unix(firstFailure, 0),
+		LastFailure:  time.Unix(lastFailure, 0),
+	}, nil
+}
+
+func rateBudgetWindowFromAttributeMap(item map[string]types.AttributeValue) (*RateBudgetWindow, error) {
+	start, err := getInt64Attr(item, "window_start")
+	if err != nil {
+		return nil, err
+	}
+	total, err := getIntAttr(item, "total")
+	if err != nil {
+		return nil, err
+	}
+	window := &RateBudgetWindow{
+		Start:   time.Unix(start, 0),
+		Total:   total,
+		Drivers: make(map[int64]int),
+	}
+	for name := range item {
+		idStr, ok := strings.CutPrefix(name, rateBudgetDriverAttributePrefix)
+		if !ok {
+			continue
+		}
+		driverID, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate budget driver attribute %q: %w", name, err)
+		}
+		spent, err := getIntAttr(item, name)
+		if err != nil {
+			return nil, err
+		}
+		window.Drivers[driverID] = spent
+	}
+	return window, nil
+}
+
+func quotaUsageFromAttributeMap(item map[string]types.AttributeValue) (*QuotaUsage, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	day, err := getInt64Attr(item, "day")
+	if err != nil {
+		return nil, err
+	}
+	usage := &QuotaUsage{
+		DriverID: driverID,
+		Day:      time.Unix(day, 0),
+		Counts:   make(map[string]int),
+	}
+	for name := range item {
+		operation, ok := strings.CutPrefix(name, quotaOperationAttributePrefix)
+		if !ok {
+			continue
+		}
+		count, err := getIntAttr(item, name)
+		if err != nil {
+			return nil, err
+		}
+		usage.Counts[operation] = count
+	}
+	return usage, nil
+}
+
 type driverModel struct {
-	driverID        int64
-	driverName      string
-	memberSince     int64
-	racesIngestedTo *int64
-	firstLogin      int64
-	lastLogin       int64
-	loginCount      int64
-	sessionCount    int64
-	entitlements    []string
+	driverID          int64
+	driverName        string
+	memberSince       int64
+	racesIngestedFrom *int64
+	racesIngestedTo   *int64
+	firstLogin        int64
+	lastLogin         int64
+	loginCount        int64
+	sessionCount      int64
+	entitlements      []string
+	onboardingStep    string
+	clubID            int
+	clubName          string
+	searchIndexed     bool
 }
 
 func (d driverModel) toAttributeMap() map[string]types.AttributeValue {
 	m := map[string]types.AttributeValue{
-		partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, d.driverID)},
-		sortKeyName:      &types.AttributeValueMemberS{Value: defaultSortKey},
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(d.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
 		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(d.driverID, 10)},
 		"driver_name":    &types.AttributeValueMemberS{Value: d.driverName},
 		"member_since":   &types.AttributeValueMemberN{Value: strconv.FormatInt(d.memberSince, 10)},
@@ -64,15 +180,24 @@ func (d driverModel) toAttributeMap() map[string]types.AttributeValue {
 		"login_count":    &types.AttributeValueMemberN{Value: strconv.FormatInt(d.loginCount, 10)},
 		"session_count":  &types.AttributeValueMemberN{Value: strconv.FormatInt(d.sessionCount, 10)},
 	}
+	if d.racesIngestedFrom != nil {
+		m["races_ingested_from"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(*d.racesIngestedFrom, 10)}
+	}
 	if d.racesIngestedTo != nil {
 		m["races_ingested_to"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(*d.racesIngestedTo, 10)}
 	}
 	if len(d.entitlements) > 0 {
-		entitlementValues := make([]types.AttributeValue, len(d.entitlements))
-		for i, e := range d.entitlements {
-			entitlementValues[i] = &types.AttributeValueMemberS{Value: e}
-		}
-		m["entitlements"] = &types.AttributeValueMemberL{Value: entitlementValues}
+		m["entitlements"] = stringListAttr(d.entitlements)
+	}
+	if d.onboardingStep != "" {
+		m["onboarding_step"] = &types.AttributeValueMemberS{Value: d.onboardingStep}
+	}
+	if d.clubID != 0 {
+		m["club_id"] = &types.AttributeValueMemberN{Value: strconv.Itoa(d.clubID)}
+		m["club_name"] = &types.AttributeValueMemberS{Value: d.clubName}
+	}
+	if d.searchIndexed {
+		m["search_indexed"] = &types.AttributeValueMemberBOOL{Value: true}
 	}
 	return m
 }
@@ -103,6 +228,12 @@ func driverFromAttributeMap(item map[string]types.AttributeValue) (*Driver, erro
 		return nil, err
 	}
 
+	var racesIngestedFrom *time.Time
+	if rif, ok := getOptionalInt64Attr(item, "races_ingested_from"); ok {
+		t := time.Unix(rif, 0)
+		racesIngestedFrom = &t
+	}
+
 	var racesIngestedTo *time.Time
 	if rit, ok := getOptionalInt64Attr(item, "races_ingested_to"); ok {
 		t := time.Unix(rit, 0)
@@ -116,40 +247,89 @@ func driverFromAttributeMap(item map[string]types.AttributeValue) (*Driver, erro
 		return nil, err
 	}
 
+	var onboardingStep OnboardingStep
+	if step, ok := item["onboarding_step"].(*types.AttributeValueMemberS); ok {
+		onboardingStep = OnboardingStep(step.Value)
+	}
+
+	// club came after the driver record, drivers that haven't logged in since won't have it
+	clubID, _ := getOptionalInt64Attr(item, "club_id")
+	clubName, _ := getStringAttr(item, "club_name")
+
+	// drivers are indexed for search when created, and when they log in for ones created before search existed
+	searchIndexed, _ := getBoolAttr(item, "search_indexed")
+
 	return &Driver{
-		DriverID:        driverID,
-		DriverName:      driverName,
-		MemberSince:     time.Unix(memberSince, 0),
-		RacesIngestedTo: racesIngestedTo,
-		FirstLogin:      time.Unix(firstLogin, 0),
-		LastLogin:       time.Unix(lastLogin, 0),
-		LoginCount:      loginCount,
-		SessionCount:    sessionCount,
-		Entitlements:    entitlements,
+		DriverID:          driverID,
+		DriverName:        driverName,
+		MemberSince:       time.Unix(memberSince, 0),
+		RacesIngestedFrom: racesIngestedFrom,
+		RacesIngestedTo:   racesIngestedTo,
+		FirstLogin:        time.Unix(firstLogin, 0),
+		LastLogin:         time.Unix(lastLogin, 0),
+		LoginCount:        loginCount,
+		SessionCount:      sessionCount,
+		Entitlements:      entitlements,
+		OnboardingStep:    onboardingStep,
+		ClubID:            int(clubID),
+		ClubName:          clubName,
+		SearchIndexed:     searchIndexed,
+	}, nil
+}
+
+// driverSearchModel indexes a driver under their name (driversearch#<start of name> / name#<name>#<driver id>)
+type driverSearchModel struct {
+	driverID   int64  `dynamo:"driver_id"`
+	driverName string `dynamo:"driver_name"`
+}
+
+func (m driverSearchModel) keys() (string, string) {
+	searchName := DriverSearchName(m.driverName)
+	return keys.DriverSearch(searchName), keys.DriverSearchName(searchName, m.driverID)
+}
+
+func driverSearchEntryFromAttributeMap(item map[string]types.AttributeValue) (*DriverSearchEntry, error) {
+	m, err := driverSearchModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &DriverSearchEntry{
+		DriverID:   m.driverID,
+		DriverName: m.driverName,
 	}, nil
 }
 
 type wsConnectionModel struct {
 	driverID     int64
 	connectionID string
+	tenantID     string
 	connectedAt  int64
 	ttl          int64
+	overlayToken string
 }
 
 func (c wsConnectionModel) toAttributeMaps() []map[string]types.AttributeValue {
+	connection := map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(c.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.WSConnection(c.connectionID)},
+		"connection_id":  &types.AttributeValueMemberS{Value: c.connectionID},
+		"connected_at":   &types.AttributeValueMemberN{Value: strconv.FormatInt(c.connectedAt, 10)},
+		ttlAttributeName: ttlAttr(c.ttl),
+	}
+	// left off for the default tenant
+	if c.tenantID != "" {
+		connection["tenant_id"] = &types.AttributeValueMemberS{Value: c.tenantID}
+	}
+	if c.overlayToken != "" {
+		connection["overlay_token"] = &types.AttributeValueMemberS{Value: c.overlayToken}
+	}
 	return []map[string]types.AttributeValue{
+		connection,
 		{
-			partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, c.driverID)},
-			sortKeyName:      &types.AttributeValueMemberS{Value: fmt.Sprintf(wsConnectionSortKeyFormat, c.connectionID)},
-			"connection_id":  &types.AttributeValueMemberS{Value: c.connectionID},
-			"connected_at":   &types.AttributeValueMemberN{Value: strconv.FormatInt(c.connectedAt, 10)},
-			"ttl":            &types.AttributeValueMemberN{Value: strconv.FormatInt(c.ttl, 10)},
-		},
-		{
-			partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(websocketPartitionFormat, c.connectionID)},
-			sortKeyName:      &types.AttributeValueMemberS{Value: defaultSortKey},
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.WebSocket(c.connectionID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
 			"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(c.driverID, 10)},
-			"ttl":            &types.AttributeValueMemberN{Value: strconv.FormatInt(c.ttl, 10)},
+			ttlAttributeName: ttlAttr(c.ttl),
 		},
 	}
 }
@@ -169,279 +349,3984 @@ func wsConnectionFromAttributeMap(item map[string]types.AttributeValue) (*WebSoc
 	if err != nil {
 		return nil, fmt.Errorf("missing or invalid partition key")
 	}
-	var driverID int64
-	_, err = fmt.Sscanf(pk, driverPartitionFormat, &driverID)
+	driverID, err := keys.ParseDriver(pk)
 	if err != nil {
 		return nil, fmt.Errorf("invalid partition key format: %w", err)
 	}
 
+	tenantID, _ := getStringAttr(item, "tenant_id")
+	overlayToken, _ := getStringAttr(item, "overlay_token")
+
 	return &WebSocketConnection{
 		DriverID:     driverID,
 		ConnectionID: connectionID,
+		TenantID:     tenantID,
 		ConnectedAt:  time.Unix(connectedAt, 0),
+		OverlayToken: overlayToken,
 	}, nil
 }
 
-// ingestionLockModel represents a lock preventing concurrent ingestion (driver#<id> / ingestion_lock)
-type ingestionLockModel struct {
-	driverID    int64
-	lockedUntil int64
+// driverPresenceModel represents a driver's latest racing heartbeat (driver#<id> / presence)
+type driverPresenceModel struct {
+	driverID   int64
+	trackID    int64
+	carID      int64
+	seriesName string
+	updatedAt  int64
+	ttl        int64
 }
 
-func (l ingestionLockModel) toAttributeMap() map[string]types.AttributeValue {
-	return map[string]types.AttributeValue{
-		partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, l.driverID)},
-		sortKeyName:      &types.AttributeValueMemberS{Value: ingestionLockSortKey},
-		"locked_until":   &types.AttributeValueMemberN{Value: strconv.FormatInt(l.lockedUntil, 10)},
-		"ttl":            &types.AttributeValueMemberN{Value: strconv.FormatInt(l.lockedUntil, 10)},
+func (p driverPresenceModel) toAttributeMap() map[string]types.AttributeValue {
+	m := map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(p.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverPresence},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(p.driverID, 10)},
+		"updated_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(p.updatedAt, 10)},
+		ttlAttributeName: ttlAttr(p.ttl),
 	}
+	if p.trackID != 0 {
+		m["track_id"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(p.trackID, 10)}
+	}
+	if p.carID != 0 {
+		m["car_id"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(p.carID, 10)}
+	}
+	if p.seriesName != "" {
+		m["series_name"] = &types.AttributeValueMemberS{Value: p.seriesName}
+	}
+	return m
 }
 
-// driverSessionModel represents a driver's participation in a session (driver#<id> / session#<timestamp>)
-type driverSessionModel struct {
-	driverID              int64
-	subsessionID          int64
-	trackID               int64
-	carID                 int64
-	seriesID              int64
-	seriesName            string
-	startTime             int64
-	startPosition         int
-	startPositionInClass  int
-	finishPosition        int
-	finishPositionInClass int
-	incidents             int
-	oldCPI                float64
-	newCPI                float64
-	oldIRating            int
-	newIRating            int
-	oldLicenseLevel       int
-	newLicenseLevel       int
-	oldSubLevel           int
-	newSubLevel           int
-	reasonOut             string
-}
-
-func (d driverSessionModel) toAttributeMap() map[string]types.AttributeValue {
-	return map[string]types.AttributeValue{
-		partitionKeyName:           &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, d.driverID)},
-		sortKeyName:                &types.AttributeValueMemberS{Value: fmt.Sprintf(driverSessionSortKeyFormat, d.startTime)},
-		"subsession_id":            &types.AttributeValueMemberN{Value: strconv.FormatInt(d.subsessionID, 10)},
-		"track_id":                 &types.AttributeValueMemberN{Value: strconv.FormatInt(d.trackID, 10)},
-		"car_id":                   &types.AttributeValueMemberN{Value: strconv.FormatInt(d.carID, 10)},
-		"series_id":                &types.AttributeValueMemberN{Value: strconv.FormatInt(d.seriesID, 10)},
-		"series_name":              &types.AttributeValueMemberS{Value: d.seriesName},
-		"start_time":               &types.AttributeValueMemberN{Value: strconv.FormatInt(d.startTime, 10)},
-		"start_position":           &types.AttributeValueMemberN{Value: strconv.Itoa(d.startPosition)},
-		"start_position_in_class":  &types.AttributeValueMemberN{Value: strconv.Itoa(d.startPositionInClass)},
-		"finish_position":          &types.AttributeValueMemberN{Value: strconv.Itoa(d.finishPosition)},
-		"finish_position_in_class": &types.AttributeValueMemberN{Value: strconv.Itoa(d.finishPositionInClass)},
-		"incidents":                &types.AttributeValueMemberN{Value: strconv.Itoa(d.incidents)},
-		"old_cpi":                  &types.AttributeValueMemberN{Value: strconv.FormatFloat(d.oldCPI, 'f', -1, 64)},
-		"new_cpi":                  &types.AttributeValueMemberN{Value: strconv.FormatFloat(d.newCPI, 'f', -1, 64)},
-		"old_irating":              &types.AttributeValueMemberN{Value: strconv.Itoa(d.oldIRating)},
-		"new_irating":              &types.AttributeValueMemberN{Value: strconv.Itoa(d.newIRating)},
-		"old_license_level":        &types.AttributeValueMemberN{Value: strconv.Itoa(d.oldLicenseLevel)},
-		"new_license_level":        &types.AttributeValueMemberN{Value: strconv.Itoa(d.newLicenseLevel)},
-		"old_sub_level":            &types.AttributeValueMemberN{Value: strconv.Itoa(d.oldSubLevel)},
-		"new_sub_level":            &types.AttributeValueMemberN{Value: strconv.Itoa(d.newSubLevel)},
-		"reason_out":               &types.AttributeValueMemberS{Value: d.reasonOut},
+func driverPresenceModelFromEntity(presence DriverPresence, now time.Time) driverPresenceModel {
+	return driverPresenceModel{
+		driverID:   presence.DriverID,
+		trackID:    presence.TrackID,
+		carID:      presence.CarID,
+		seriesName: presence.SeriesName,
+		updatedAt:  now.Unix(),
+		ttl:        now.Add(presenceTTLDuration).Unix(),
 	}
 }
 
-func driverSessionFromAttributeMap(driverID int64, item map[string]types.AttributeValue) (*DriverSession, error) {
-	subsessionID, err := getInt64Attr(item, "subsession_id")
+func driverPresenceFromAttributeMap(item map[string]types.AttributeValue) (*DriverPresence, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
 	if err != nil {
 		return nil, err
 	}
-	trackID, err := getInt64Attr(item, "track_id")
+	updatedAt, err := getInt64Attr(item, "updated_at")
 	if err != nil {
 		return nil, err
 	}
-	carID, err := getInt64Attr(item, "car_id")
-	if err != nil {
-		return nil, err
+	trackID, _ := getOptionalInt64Attr(item, "track_id")
+	carID, _ := getOptionalInt64Attr(item, "car_id")
+	seriesName, _ := getStringAttr(item, "series_name")
+
+	return &DriverPresence{
+		DriverID:   driverID,
+		TrackID:    trackID,
+		CarID:      carID,
+		SeriesName: seriesName,
+		UpdatedAt:  time.Unix(updatedAt, 0),
+	}, nil
+}
+
+// presenceGrantModel represents a driver letting a viewer see their presence. It is written twice so it can be listed
+// from either side, as driver#<driver_id> / presenceviewer#<viewer_id> and driver#<viewer_id> / presencegrant#<driver_id>.
+type presenceGrantModel struct {
+	driverID   int64
+	driverName string
+	viewerID   int64
+	viewerName string
+	grantedAt  int64
+}
+
+func (g presenceGrantModel) attributes() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"driver_id":   &types.AttributeValueMemberN{Value: strconv.FormatInt(g.driverID, 10)},
+		"driver_name": &types.AttributeValueMemberS{Value: g.driverName},
+		"viewer_id":   &types.AttributeValueMemberN{Value: strconv.FormatInt(g.viewerID, 10)},
+		"viewer_name": &types.AttributeValueMemberS{Value: g.viewerName},
+		"granted_at":  &types.AttributeValueMemberN{Value: strconv.FormatInt(g.grantedAt, 10)},
 	}
-	seriesID, err := getInt64Attr(item, "series_id")
+}
+
+func (g presenceGrantModel) toAttributeMaps() []map[string]types.AttributeValue {
+	viewerItem := g.attributes()
+	viewerItem[partitionKeyName] = &types.AttributeValueMemberS{Value: keys.Driver(g.driverID)}
+	viewerItem[sortKeyName] = &types.AttributeValueMemberS{Value: keys.PresenceViewer(g.viewerID)}
+
+	grantItem := g.attributes()
+	grantItem[partitionKeyName] = &types.AttributeValueMemberS{Value: keys.Driver(g.viewerID)}
+	grantItem[sortKeyName] = &types.AttributeValueMemberS{Value: keys.PresenceGrant(g.driverID)}
+
+	return []map[string]types.AttributeValue{viewerItem, grantItem}
+}
+
+func presenceGrantModelFromEntity(grant PresenceGrant, now time.Time) presenceGrantModel {
+	return presenceGrantModel{
+		driverID:   grant.DriverID,
+		driverName: grant.DriverName,
+		viewerID:   grant.ViewerID,
+		viewerName: grant.ViewerName,
+		grantedAt:  now.Unix(),
+	}
+}
+
+func presenceGrantFromAttributeMap(item map[string]types.AttributeValue) (*PresenceGrant, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
 	if err != nil {
 		return nil, err
 	}
-	seriesName, err := getStringAttr(item, "series_name")
+	driverName, err := getStringAttr(item, "driver_name")
 	if err != nil {
 		return nil, err
 	}
-	startTime, err := getInt64Attr(item, "start_time")
+	viewerID, err := getInt64Attr(item, "viewer_id")
 	if err != nil {
 		return nil, err
 	}
-	startPosition, err := getIntAttr(item, "start_position")
+	viewerName, err := getStringAttr(item, "viewer_name")
 	if err != nil {
 		return nil, err
 	}
-	startPositionInClass, err := getIntAttr(item, "start_position_in_class")
+	grantedAt, err := getInt64Attr(item, "granted_at")
 	if err != nil {
 		return nil, err
 	}
-	finishPosition, err := getIntAttr(item, "finish_position")
+
+	return &PresenceGrant{
+		DriverID:   driverID,
+		DriverName: driverName,
+		ViewerID:   viewerID,
+		ViewerName: viewerName,
+		GrantedAt:  time.Unix(grantedAt, 0),
+	}, nil
+}
+
+// authSessionModel represents a login on one device (driver#<id> / authsession#<session_id>)
+type authSessionModel struct {
+	driverID  int64
+	sessionID string
+	userAgent string
+	ipPrefix  string
+	createdAt int64
+	issuedAt  int64
+	expiresAt int64
+	revokedAt *int64
+}
+
+func (m authSessionModel) toAttributeMap() map[string]types.AttributeValue {
+	item := map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(m.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.AuthSession(m.sessionID)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(m.driverID, 10)},
+		"session_id":     &types.AttributeValueMemberS{Value: m.sessionID},
+		"user_agent":     &types.AttributeValueMemberS{Value: m.userAgent},
+		"ip_prefix":      &types.AttributeValueMemberS{Value: m.ipPrefix},
+		"created_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(m.createdAt, 10)},
+		"issued_at":      &types.AttributeValueMemberN{Value: strconv.FormatInt(m.issuedAt, 10)},
+		"expires_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(m.expiresAt, 10)},
+		// kept until the latest token expires, so a revocation holds for as long as the token it revoked could be used
+		ttlAttributeName: ttlAttr(m.expiresAt),
+	}
+	if m.revokedAt != nil {
+		item["revoked_at"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(*m.revokedAt, 10)}
+	}
+	return item
+}
+
+func authSessionModelFromEntity(session AuthSession) authSessionModel {
+	m := authSessionModel{
+		driverID:  session.DriverID,
+		sessionID: session.SessionID,
+		userAgent: session.UserAgent,
+		ipPrefix:  session.IPPrefix,
+		createdAt: toUnixSeconds(session.CreatedAt),
+		issuedAt:  toUnixSeconds(session.IssuedAt),
+		expiresAt: toUnixSeconds(session.ExpiresAt),
+	}
+	if session.RevokedAt != nil {
+		revokedAt := toUnixSeconds(*session.RevokedAt)
+		m.revokedAt = &revokedAt
+	}
+	return m
+}
+
+func authSessionFromAttributeMap(item map[string]types.AttributeValue) (*AuthSession, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
 	if err != nil {
 		return nil, err
 	}
-	finishPositionInClass, err := getIntAttr(item, "finish_position_in_class")
+	sessionID, err := getStringAttr(item, "session_id")
 	if err != nil {
 		return nil, err
 	}
-	incidents, err := getIntAttr(item, "incidents")
+	userAgent, err := getStringAttr(item, "user_agent")
 	if err != nil {
 		return nil, err
 	}
-	oldCPI, err := getFloatAttr(item, "old_cpi")
+	ipPrefix, err := getStringAttr(item, "ip_prefix")
 	if err != nil {
 		return nil, err
 	}
-	newCPI, err := getFloatAttr(item, "new_cpi")
+	createdAt, err := getInt64Attr(item, "created_at")
 	if err != nil {
 		return nil, err
 	}
-	oldIRating, err := getIntAttr(item, "old_irating")
+	issuedAt, err := getInt64Attr(item, "issued_at")
 	if err != nil {
 		return nil, err
 	}
-	newIRating, err := getIntAttr(item, "new_irating")
+	expiresAt, err := getInt64Attr(item, "expires_at")
 	if err != nil {
 		return nil, err
 	}
-	oldLicenseLevel, err := getIntAttr(item, "old_license_level")
+
+	result := &AuthSession{
+		DriverID:  driverID,
+		SessionID: sessionID,
+		UserAgent: userAgent,
+		IPPrefix:  ipPrefix,
+		CreatedAt: time.Unix(createdAt, 0),
+		IssuedAt:  time.Unix(issuedAt, 0),
+		ExpiresAt: time.Unix(expiresAt, 0),
+	}
+	if v, ok := getOptionalInt64Attr(item, "revoked_at"); ok {
+		revokedAt := time.Unix(v, 0)
+		result.RevokedAt = &revokedAt
+	}
+	return result, nil
+}
+
+// squadModel represents a squad (squad#<squad_id> / info)
+type squadModel struct {
+	squadID     string
+	name        string
+	managerID   int64
+	managerName string
+	createdAt   int64
+}
+
+func (m squadModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Squad(m.squadID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		"squad_id":       &types.AttributeValueMemberS{Value: m.squadID},
+		"name":           &types.AttributeValueMemberS{Value: m.name},
+		"manager_id":     &types.AttributeValueMemberN{Value: strconv.FormatInt(m.managerID, 10)},
+		"manager_name":   &types.AttributeValueMemberS{Value: m.managerName},
+		"created_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(m.createdAt, 10)},
+	}
+}
+
+func squadModelFromEntity(squad Squad) squadModel {
+	return squadModel{
+		squadID:     squad.SquadID,
+		name:        squad.Name,
+		managerID:   squad.ManagerID,
+		managerName: squad.ManagerName,
+		createdAt:   toUnixSeconds(squad.CreatedAt),
+	}
+}
+
+func squadFromAttributeMap(item map[string]types.AttributeValue) (*Squad, error) {
+	squadID, err := getStringAttr(item, "squad_id")
 	if err != nil {
 		return nil, err
 	}
-	newLicenseLevel, err := getIntAttr(item, "new_license_level")
+	name, err := getStringAttr(item, "name")
 	if err != nil {
 		return nil, err
 	}
-	oldSubLevel, err := getIntAttr(item, "old_sub_level")
+	managerID, err := getInt64Attr(item, "manager_id")
 	if err != nil {
 		return nil, err
 	}
-	newSubLevel, err := getIntAttr(item, "new_sub_level")
+	managerName, err := getStringAttr(item, "manager_name")
 	if err != nil {
 		return nil, err
 	}
-	reasonOut, err := getStringAttr(item, "reason_out")
+	createdAt, err := getInt64Attr(item, "created_at")
 	if err != nil {
 		return nil, err
 	}
 
-	return &DriverSession{
-		DriverID:              driverID,
-		SubsessionID:          subsessionID,
-		TrackID:               trackID,
-		CarID:                 carID,
-		SeriesID:              seriesID,
-		SeriesName:            seriesName,
-		StartTime:             time.Unix(startTime, 0),
-		StartPosition:         startPosition,
-		StartPositionInClass:  startPositionInClass,
-		FinishPosition:        finishPosition,
-		FinishPositionInClass: finishPositionInClass,
-		Incidents:             incidents,
-		OldCPI:                oldCPI,
-		NewCPI:                newCPI,
-		OldIRating:            oldIRating,
-		NewIRating:            newIRating,
-		OldLicenseLevel:       oldLicenseLevel,
-		NewLicenseLevel:       newLicenseLevel,
-		OldSubLevel:           oldSubLevel,
-		NewSubLevel:           newSubLevel,
-		ReasonOut:             reasonOut,
+	return &Squad{
+		SquadID:     squadID,
+		Name:        name,
+		ManagerID:   managerID,
+		ManagerName: managerName,
+		CreatedAt:   time.Unix(createdAt, 0),
 	}, nil
 }
 
-// journalEntryModel represents a journal entry for a race (driver#<id> / journal#<race_id>)
-type journalEntryModel struct {
-	driverID    int64
-	raceID      int64
-	createdAt   int64
-	updatedAt   int64
-	notes       string
-	tags        []string
-	replayVideo string
+// squadMemberModel represents a driver's place in a squad. It is written twice so it can be listed from either side,
+// as squad#<squad_id> / member#<driver_id> and driver#<driver_id> / squad#<squad_id>.
+type squadMemberModel struct {
+	squadID    string
+	squadName  string
+	driverID   int64
+	driverName string
+	status     string
+	invitedAt  int64
+	joinedAt   int64 // zero until the invite is accepted
 }
 
-func (j journalEntryModel) toAttributeMap() map[string]types.AttributeValue {
-	m := map[string]types.AttributeValue{
-		partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, j.driverID)},
-		sortKeyName:      &types.AttributeValueMemberS{Value: fmt.Sprintf(journalEntrySortKeyFormat, j.raceID)},
-		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(j.driverID, 10)},
-		"race_id":        &types.AttributeValueMemberN{Value: strconv.FormatInt(j.raceID, 10)},
-		"created_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(j.createdAt, 10)},
-		"updated_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(j.updatedAt, 10)},
-		"notes":          &types.AttributeValueMemberS{Value: j.notes},
+func (m squadMemberModel) attributes() map[string]types.AttributeValue {
+	attrs := map[string]types.AttributeValue{
+		"squad_id":    &types.AttributeValueMemberS{Value: m.squadID},
+		"squad_name":  &types.AttributeValueMemberS{Value: m.squadName},
+		"driver_id":   &types.AttributeValueMemberN{Value: strconv.FormatInt(m.driverID, 10)},
+		"driver_name": &types.AttributeValueMemberS{Value: m.driverName},
+		"status":      &types.AttributeValueMemberS{Value: m.status},
+		"invited_at":  &types.AttributeValueMemberN{Value: strconv.FormatInt(m.invitedAt, 10)},
 	}
-	if len(j.tags) > 0 {
-		tagValues := make([]types.AttributeValue, len(j.tags))
-		for i, t := range j.tags {
-			tagValues[i] = &types.AttributeValueMemberS{Value: t}
-		}
-		m["tags"] = &types.AttributeValueMemberL{Value: tagValues}
+	if m.joinedAt != 0 {
+		attrs["joined_at"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(m.joinedAt, 10)}
 	}
-	if j.replayVideo != "" {
-		m["replay_video"] = &types.AttributeValueMemberS{Value: j.replayVideo}
+	return attrs
+}
+
+func (m squadMemberModel) toAttributeMaps() []map[string]types.AttributeValue {
+	memberItem := m.attributes()
+	memberItem[partitionKeyName] = &types.AttributeValueMemberS{Value: keys.Squad(m.squadID)}
+	memberItem[sortKeyName] = &types.AttributeValueMemberS{Value: keys.SquadMember(m.driverID)}
+
+	membershipItem := m.attributes()
+	membershipItem[partitionKeyName] = &types.AttributeValueMemberS{Value: keys.Driver(m.driverID)}
+	membershipItem[sortKeyName] = &types.AttributeValueMemberS{Value: keys.SquadMembership(m.squadID)}
+
+	return []map[string]types.AttributeValue{memberItem, membershipItem}
+}
+
+func squadMemberModelFromEntity(member SquadMember) squadMemberModel {
+	model := squadMemberModel{
+		squadID:    member.SquadID,
+		squadName:  member.SquadName,
+		driverID:   member.DriverID,
+		driverName: member.DriverName,
+		status:     string(member.Status),
+		invitedAt:  toUnixSeconds(member.InvitedAt),
 	}
-	return m
+	if member.JoinedAt != nil {
+		model.joinedAt = toUnixSeconds(*member.JoinedAt)
+	}
+	return model
 }
 
-func journalEntryFromAttributeMap(item map[string]types.AttributeValue) (*RaceJournalEntry, error) {
-	driverID, err := getInt64Attr(item, "driver_id")
+func squadMemberFromAttributeMap(item map[string]types.AttributeValue) (*SquadMember, error) {
+	squadID, err := getStringAttr(item, "squad_id")
 	if err != nil {
 		return nil, err
 	}
-	raceID, err := getInt64Attr(item, "race_id")
+	squadName, err := getStringAttr(item, "squad_name")
 	if err != nil {
 		return nil, err
 	}
-	createdAt, err := getInt64Attr(item, "created_at")
+	driverID, err := getInt64Attr(item, "driver_id")
 	if err != nil {
 		return nil, err
 	}
-	updatedAt, err := getInt64Attr(item, "updated_at")
+	driverName, err := getStringAttr(item, "driver_name")
 	if err != nil {
 		return nil, err
 	}
-	notes, err := getStringAttr(item, "notes")
+	status, err := getStringAttr(item, "status")
 	if err != nil {
 		return nil, err
 	}
-	tags, err := getOptionalStringSliceAttr(item, "tags")
+	invitedAt, err := getInt64Attr(item, "invited_at")
 	if err != nil {
 		return nil, err
 	}
 
-	replayVideo := ""
-	if rv, ok := item["replay_video"].(*types.AttributeValueMemberS); ok {
-		replayVideo = rv.Value
+	member := &SquadMember{
+		SquadID:    squadID,
+		SquadName:  squadName,
+		DriverID:   driverID,
+		DriverName: driverName,
+		Status:     SquadMemberStatus(status),
+		InvitedAt:  time.Unix(invitedAt, 0),
+	}
+	if joinedAt, ok := getOptionalInt64Attr(item, "joined_at"); ok {
+		t := time.Unix(joinedAt, 0)
+		member.JoinedAt = &t
 	}
+	return member, nil
+}
 
-	return &RaceJournalEntry{
-		DriverID:    driverID,
-		RaceID:      raceID,
-		CreatedAt:   time.Unix(createdAt, 0),
-		UpdatedAt:   time.Unix(updatedAt, 0),
-		Notes:       notes,
-		Tags:        tags,
-		ReplayVideo: replayVideo,
-	}, nil
+// squadEventModel represents a session tagged as a squad event. It is written twice, as squad#<squad_id> /
+// event#<subsession_id> for the squad's event list and session#<subsession_id> / squadevent#<squad_id> so ingestion
+// can find the squads that tagged a session.
+type squadEventModel struct {
+	squadID      string
+	subsessionID int64
+	name         string
+	taggedAt     int64
 }
 
-func getInt64Attr(item map[string]types.AttributeValue, name string) (int64, error) {
-	attr, ok := item[name].(*types.AttributeValueMemberN)
-	if !ok {
-		return 0, fmt.Errorf("missing or invalid '%s' attribute", name)
-	}
-	val, err := strconv.ParseInt(attr.Value, 10, 64)
-	if err != nil {
-		return 0, fmt.Errorf("invalid '%s' value: %w", name, err)
+func (m squadEventModel) attributes() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"squad_id":      &types.AttributeValueMemberS{Value: m.squadID},
+		"subsession_id": &types.AttributeValueMemberN{Value: strconv.FormatInt(m.subsessionID, 10)},
+		"name":          &types.AttributeValueMemberS{Value: m.name},
+		"tagged_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(m.taggedAt, 10)},
 	}
-	return val, nil
+}
+
+func (m squadEventModel) toAttributeMaps() []map[string]types.AttributeValue {
+	squadItem := m.attributes()
+	squadItem[partitionKeyName] = &types.AttributeValueMemberS{Value: keys.Squad(m.squadID)}
+	squadItem[sortKeyName] = &types.AttributeValueMemberS{Value: keys.SquadEvent(m.subsessionID)}
+
+	sessionItem := m.attributes()
+	sessionItem[partitionKeyName] = &types.AttributeValueMemberS{Value: keys.Session(m.subsessionID)}
+	sessionItem[sortKeyName] = &types.AttributeValueMemberS{Value: keys.SessionSquadEvent(m.squadID)}
+
+	return []map[string]types.AttributeValue{squadItem, sessionItem}
+}
+
+func squadEventModelFromEntity(event SquadEvent) squadEventModel {
+	return squadEventModel{
+		squadID:      event.SquadID,
+		subsessionID: event.SubsessionID,
+		name:         event.Name,
+		taggedAt:     toUnixSeconds(event.TaggedAt),
+	}
+}
+
+func squadEventFromAttributeMap(item map[string]types.AttributeValue) (*SquadEvent, error) {
+	squadID, err := getStringAttr(item, "squad_id")
+	if err != nil {
+		return nil, err
+	}
+	subsessionID, err := getInt64Attr(item, "subsession_id")
+	if err != nil {
+		return nil, err
+	}
+	name, err := getStringAttr(item, "name")
+	if err != nil {
+		return nil, err
+	}
+	taggedAt, err := getInt64Attr(item, "tagged_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SquadEvent{
+		SquadID:      squadID,
+		SubsessionID: subsessionID,
+		Name:         name,
+		TaggedAt:     time.Unix(taggedAt, 0),
+	}, nil
+}
+
+// squadEventsFromItems converts squad event items, ordering them most recent session first since the sort keys don't
+// order subsession IDs numerically.
+func squadEventsFromItems(items []map[string]types.AttributeValue) ([]SquadEvent, error) {
+	events := make([]SquadEvent, 0, len(items))
+	for _, item := range items {
+		event, err := squadEventFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].SubsessionID > events[j].SubsessionID
+	})
+	return events, nil
+}
+
+// squadEventResultModel represents a member's finish in a squad event (squad#<squad_id> /
+// eventresult#<subsession_id>#<driver_id>)
+type squadEventResultModel struct {
+	squadID               string
+	subsessionID          int64
+	driverID              int64
+	driverName            string
+	startTime             int64
+	carID                 int64
+	carClassID            int64
+	startPosition         int
+	finishPosition        int
+	finishPositionInClass int
+	incidents             int
+	lapsComplete          int
+	oldIRating            int
+	newIRating            int
+	reasonOutCode         ReasonOutCode
+}
+
+func (m squadEventResultModel) toAttributeMap() map[string]types.AttributeValue {
+	ret := map[string]types.AttributeValue{
+		partitionKeyName:           &types.AttributeValueMemberS{Value: keys.Squad(m.squadID)},
+		sortKeyName:                &types.AttributeValueMemberS{Value: keys.SquadEventResult(m.subsessionID, m.driverID)},
+		"squad_id":                 &types.AttributeValueMemberS{Value: m.squadID},
+		"subsession_id":            &types.AttributeValueMemberN{Value: strconv.FormatInt(m.subsessionID, 10)},
+		"driver_id":                &types.AttributeValueMemberN{Value: strconv.FormatInt(m.driverID, 10)},
+		"driver_name":              &types.AttributeValueMemberS{Value: m.driverName},
+		"start_time":               &types.AttributeValueMemberN{Value: strconv.FormatInt(m.startTime, 10)},
+		"car_id":                   &types.AttributeValueMemberN{Value: strconv.FormatInt(m.carID, 10)},
+		"car_class_id":             &types.AttributeValueMemberN{Value: strconv.FormatInt(m.carClassID, 10)},
+		"start_position":           &types.AttributeValueMemberN{Value: strconv.Itoa(m.startPosition)},
+		"finish_position":          &types.AttributeValueMemberN{Value: strconv.Itoa(m.finishPosition)},
+		"finish_position_in_class": &types.AttributeValueMemberN{Value: strconv.Itoa(m.finishPositionInClass)},
+		"incidents":                &types.AttributeValueMemberN{Value: strconv.Itoa(m.incidents)},
+		"laps_complete":            &types.AttributeValueMemberN{Value: strconv.Itoa(m.lapsComplete)},
+		"old_irating":              &types.AttributeValueMemberN{Value: strconv.Itoa(m.oldIRating)},
+		"new_irating":              &types.AttributeValueMemberN{Value: strconv.Itoa(m.newIRating)},
+	}
+	if m.reasonOutCode != "" {
+		ret["reason_out_code"] = &types.AttributeValueMemberS{Value: string(m.reasonOutCode)}
+	}
+	return ret
+}
+
+func squadEventResultModelFromEntity(result SquadEventResult) squadEventResultModel {
+	return squadEventResultModel{
+		squadID:               result.SquadID,
+		subsessionID:          result.SubsessionID,
+		driverID:              result.DriverID,
+		driverName:            result.DriverName,
+		startTime:             toUnixSeconds(result.StartTime),
+		carID:                 result.CarID,
+		carClassID:            result.CarClassID,
+		startPosition:         result.StartPosition,
+		finishPosition:        result.FinishPosition,
+		finishPositionInClass: result.FinishPositionInClass,
+		incidents:             result.Incidents,
+		lapsComplete:          result.LapsComplete,
+		oldIRating:            result.OldIRating,
+		newIRating:            result.NewIRating,
+		reasonOutCode:         result.ReasonOutCode,
+	}
+}
+
+func squadEventResultFromAttributeMap(item map[string]types.AttributeValue) (*SquadEventResult, error) {
+	squadID, err := getStringAttr(item, "squad_id")
+	if err != nil {
+		return nil, err
+	}
+	driverName, err := getStringAttr(item, "driver_name")
+	if err != nil {
+		return nil, err
+	}
+	subsessionID, err := getInt64Attr(item, "subsession_id")
+	if err != nil {
+		return nil, err
+	}
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := getInt64Attr(item, "start_time")
+	if err != nil {
+		return nil, err
+	}
+	carID, err := getInt64Attr(item, "car_id")
+	if err != nil {
+		return nil, err
+	}
+	carClassID, err := getInt64Attr(item, "car_class_id")
+	if err != nil {
+		return nil, err
+	}
+	startPosition, err := getIntAttr(item, "start_position")
+	if err != nil {
+		return nil, err
+	}
+	finishPosition, err := getIntAttr(item, "finish_position")
+	if err != nil {
+		return nil, err
+	}
+	finishPositionInClass, err := getIntAttr(item, "finish_position_in_class")
+	if err != nil {
+		return nil, err
+	}
+	incidents, err := getIntAttr(item, "incidents")
+	if err != nil {
+		return nil, err
+	}
+	lapsComplete, err := getIntAttr(item, "laps_complete")
+	if err != nil {
+		return nil, err
+	}
+	oldIRating, err := getIntAttr(item, "old_irating")
+	if err != nil {
+		return nil, err
+	}
+	newIRating, err := getIntAttr(item, "new_irating")
+	if err != nil {
+		return nil, err
+	}
+	reasonOutCode, _ := getStringAttr(item, "reason_out_code")
+
+	return &SquadEventResult{
+		SquadID:               squadID,
+		SubsessionID:          subsessionID,
+		DriverID:              driverID,
+		DriverName:            driverName,
+		StartTime:             time.Unix(startTime, 0),
+		CarID:                 carID,
+		CarClassID:            carClassID,
+		StartPosition:         startPosition,
+		FinishPosition:        finishPosition,
+		FinishPositionInClass: finishPositionInClass,
+		Incidents:             incidents,
+		LapsComplete:          lapsComplete,
+		OldIRating:            oldIRating,
+		NewIRating:            newIRating,
+		ReasonOutCode:         ReasonOutCode(reasonOutCode),
+	}, nil
+}
+
+// squadEventResultsFromItems converts squad event result items, ordering them by finishing position.
+func squadEventResultsFromItems(items []map[string]types.AttributeValue) ([]SquadEventResult, error) {
+	results := make([]SquadEventResult, 0, len(items))
+	for _, item := range items {
+		result, err := squadEventResultFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *result)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].FinishPosition < results[j].FinishPosition
+	})
+	return results, nil
+}
+
+// ingestionLockModel represents a lock preventing concurrent ingestion (driver#<id> / ingestion_lock)
+type ingestionLockModel struct {
+	driverID    int64
+	lockedUntil int64
+}
+
+func (l ingestionLockModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(l.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.IngestionLock},
+		"locked_until":   &types.AttributeValueMemberN{Value: strconv.FormatInt(l.lockedUntil, 10)},
+		ttlAttributeName: ttlAttr(l.lockedUntil),
+	}
+}
+
+// ingestionCancelModel represents a request to stop a driver's ingestion (driver#<id> / ingestion_cancel)
+type ingestionCancelModel struct {
+	driverID    int64
+	requestedAt int64
+	expiresAt   int64
+}
+
+func (c ingestionCancelModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(c.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.IngestionCancel},
+		"requested_at":   &types.AttributeValueMemberN{Value: strconv.FormatInt(c.requestedAt, 10)},
+		ttlAttributeName: ttlAttr(c.expiresAt),
+	}
+}
+
+// upstreamStatusModel records iRacing being down (global / upstream_status), expiring once it is expected back
+type upstreamStatusModel struct {
+	downUntil  int64 // unix millis
+	reason     string
+	reportedAt int64 // unix millis
+}
+
+func (m upstreamStatusModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Global},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.UpstreamStatus},
+		"down_until":     &types.AttributeValueMemberN{Value: strconv.FormatInt(m.downUntil, 10)},
+		"reason":         &types.AttributeValueMemberS{Value: m.reason},
+		"reported_at":    &types.AttributeValueMemberN{Value: strconv.FormatInt(m.reportedAt, 10)},
+		ttlAttributeName: ttlAttr(time.UnixMilli(m.downUntil).Unix()),
+	}
+}
+
+func upstreamStatusModelFromEntity(status UpstreamStatus) upstreamStatusModel {
+	return upstreamStatusModel{
+		downUntil:  status.DownUntil.UnixMilli(),
+		reason:     status.Reason,
+		reportedAt: status.ReportedAt.UnixMilli(),
+	}
+}
+
+func upstreamStatusFromAttributeMap(item map[string]types.AttributeValue) (*UpstreamStatus, error) {
+	downUntil, err := getInt64Attr(item, "down_until")
+	if err != nil {
+		return nil, err
+	}
+	reason, err := getStringAttr(item, "reason")
+	if err != nil {
+		return nil, err
+	}
+	reportedAt, err := getInt64Attr(item, "reported_at")
+	if err != nil {
+		return nil, err
+	}
+	return &UpstreamStatus{
+		DownUntil:  time.UnixMilli(downUntil),
+		Reason:     reason,
+		ReportedAt: time.UnixMilli(reportedAt),
+	}, nil
+}
+
+// backfillProgressModel records how far a backfill from the session archives has got (global / backfill#<name>)
+type backfillProgressModel struct {
+	name      string
+	after     string
+	archives  int
+	sessions  int
+	failures  int
+	complete  bool
+	updatedAt int64 // unix millis
+}
+
+func (m backfillProgressModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Global},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.BackfillProgress(m.name)},
+		"name":           &types.AttributeValueMemberS{Value: m.name},
+		"after":          &types.AttributeValueMemberS{Value: m.after},
+		"archives":       &types.AttributeValueMemberN{Value: strconv.Itoa(m.archives)},
+		"sessions":       &types.AttributeValueMemberN{Value: strconv.Itoa(m.sessions)},
+		"failures":       &types.AttributeValueMemberN{Value: strconv.Itoa(m.failures)},
+		"complete":       &types.AttributeValueMemberBOOL{Value: m.complete},
+		"updated_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(m.updatedAt, 10)},
+	}
+}
+
+func backfillProgressModelFromEntity(progress BackfillProgress) backfillProgressModel {
+	return backfillProgressModel{
+		name:      progress.Name,
+		after:     progress.After,
+		archives:  progress.Archives,
+		sessions:  progress.Sessions,
+		failures:  progress.Failures,
+		complete:  progress.Complete,
+		updatedAt: progress.UpdatedAt.UnixMilli(),
+	}
+}
+
+func backfillProgressFromAttributeMap(item map[string]types.AttributeValue) (*BackfillProgress, error) {
+	name, err := getStringAttr(item, "name")
+	if err != nil {
+		return nil, err
+	}
+	after, err := getStringAttr(item, "after")
+	if err != nil {
+		return nil, err
+	}
+	archives, err := getIntAttr(item, "archives")
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := getIntAttr(item, "sessions")
+	if err != nil {
+		return nil, err
+	}
+	failures, err := getIntAttr(item, "failures")
+	if err != nil {
+		return nil, err
+	}
+	complete, err := getBoolAttr(item, "complete")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := getInt64Attr(item, "updated_at")
+	if err != nil {
+		return nil, err
+	}
+	return &BackfillProgress{
+		Name:      name,
+		After:     after,
+		Archives:  archives,
+		Sessions:  sessions,
+		Failures:  failures,
+		Complete:  complete,
+		UpdatedAt: time.UnixMilli(updatedAt),
+	}, nil
+}
+
+// ingestionTierModel represents ingestion settings for a tier of drivers (ingestion_tiers / tier#<name>)
+type ingestionTierModel struct {
+	name                       string
+	priority                   int
+	searchWindowDays           int
+	raceConsumptionConcurrency int
+	lapConsumptionConcurrency  int
+	lapsDisabled               bool
+	updatedAt                  int64
+}
+
+func (m ingestionTierModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName:               &types.AttributeValueMemberS{Value: keys.IngestionTiers},
+		sortKeyName:                    &types.AttributeValueMemberS{Value: keys.IngestionTier(m.name)},
+		"name":                         &types.AttributeValueMemberS{Value: m.name},
+		"priority":                     &types.AttributeValueMemberN{Value: strconv.Itoa(m.priority)},
+		"search_window_days":           &types.AttributeValueMemberN{Value: strconv.Itoa(m.searchWindowDays)},
+		"race_consumption_concurrency": &types.AttributeValueMemberN{Value: strconv.Itoa(m.raceConsumptionConcurrency)},
+		"lap_consumption_concurrency":  &types.AttributeValueMemberN{Value: strconv.Itoa(m.lapConsumptionConcurrency)},
+		"laps_disabled":                &types.AttributeValueMemberBOOL{Value: m.lapsDisabled},
+		"updated_at":                   &types.AttributeValueMemberN{Value: strconv.FormatInt(m.updatedAt, 10)},
+	}
+}
+
+func ingestionTierFromAttributeMap(item map[string]types.AttributeValue) (*IngestionTier, error) {
+	name, err := getStringAttr(item, "name")
+	if err != nil {
+		return nil, err
+	}
+	priority, err := getIntAttr(item, "priority")
+	if err != nil {
+		return nil, err
+	}
+	searchWindowDays, err := getIntAttr(item, "search_window_days")
+	if err != nil {
+		return nil, err
+	}
+	raceConcurrency, err := getIntAttr(item, "race_consumption_concurrency")
+	if err != nil {
+		return nil, err
+	}
+	lapConcurrency, err := getIntAttr(item, "lap_consumption_concurrency")
+	if err != nil {
+		return nil, err
+	}
+	lapsDisabled, err := getBoolAttr(item, "laps_disabled")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := getInt64Attr(item, "updated_at")
+	if err != nil {
+		return nil, err
+	}
+	return &IngestionTier{
+		Name:                       name,
+		Priority:                   priority,
+		SearchWindowDays:           searchWindowDays,
+		RaceConsumptionConcurrency: raceConcurrency,
+		LapConsumptionConcurrency:  lapConcurrency,
+		LapsDisabled:               lapsDisabled,
+		UpdatedAt:                  time.Unix(updatedAt, 0),
+	}, nil
+}
+
+func ingestionTierModelFromEntity(tier IngestionTier) ingestionTierModel {
+	return ingestionTierModel{
+		name:                       tier.Name,
+		priority:                   tier.Priority,
+		searchWindowDays:           tier.SearchWindowDays,
+		raceConsumptionConcurrency: tier.RaceConsumptionConcurrency,
+		lapConsumptionConcurrency:  tier.LapConsumptionConcurrency,
+		lapsDisabled:               tier.LapsDisabled,
+		updatedAt:                  tier.UpdatedAt.Unix(),
+	}
+}
+
+// waitlistEntryModel represents a driver waiting to be let in (waitlist / driver#<id>)
+type waitlistEntryModel struct {
+	driverID    int64  `dynamo:"driver_id"`
+	driverName  string `dynamo:"driver_name"`
+	requestedAt int64  `dynamo:"requested_at"`
+}
+
+func (m waitlistEntryModel) keys() (string, string) {
+	return keys.Waitlist, keys.WaitlistDriver(m.driverID)
+}
+
+func waitlistEntryFromAttributeMap(item map[string]types.AttributeValue) (*WaitlistEntry, error) {
+	m, err := waitlistEntryModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &WaitlistEntry{
+		DriverID:    m.driverID,
+		DriverName:  m.driverName,
+		RequestedAt: time.Unix(m.requestedAt, 0),
+	}, nil
+}
+
+func waitlistEntryModelFromEntity(entry WaitlistEntry) waitlistEntryModel {
+	return waitlistEntryModel{
+		driverID:    entry.DriverID,
+		driverName:  entry.DriverName,
+		requestedAt: entry.RequestedAt.Unix(),
+	}
+}
+
+// invitationModel represents a driver's invitation (invitations / driver#<id>)
+type invitationModel struct {
+	driverID     int64    `dynamo:"driver_id"`
+	entitlements []string `dynamo:"entitlements,omitempty"`
+	invitedAt    int64    `dynamo:"invited_at"`
+	invitedBy    int64    `dynamo:"invited_by"`
+}
+
+func (m invitationModel) keys() (string, string) {
+	return keys.Invitations, keys.InvitationDriver(m.driverID)
+}
+
+func invitationFromAttributeMap(item map[string]types.AttributeValue) (*Invitation, error) {
+	m, err := invitationModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &Invitation{
+		DriverID:     m.driverID,
+		Entitlements: m.entitlements,
+		InvitedAt:    time.Unix(m.invitedAt, 0),
+		InvitedBy:    m.invitedBy,
+	}, nil
+}
+
+func invitationModelFromEntity(invitation Invitation) invitationModel {
+	return invitationModel{
+		driverID:     invitation.DriverID,
+		entitlements: invitation.Entitlements,
+		invitedAt:    invitation.InvitedAt.Unix(),
+		invitedBy:    invitation.InvitedBy,
+	}
+}
+
+// termsAcceptanceModel represents a driver's acceptance of a document version (driver#<id> / terms#<document>#<version>)
+type termsAcceptanceModel struct {
+	driverID   int64  `dynamo:"driver_id"`
+	document   string `dynamo:"document"`
+	version    string `dynamo:"version"`
+	acceptedAt int64  `dynamo:"accepted_at"`
+}
+
+func (m termsAcceptanceModel) keys() (string, string) {
+	return keys.Driver(m.driverID), keys.TermsAcceptance(m.document, m.version)
+}
+
+func termsAcceptanceFromAttributeMap(item map[string]types.AttributeValue) (*TermsAcceptance, error) {
+	m, err := termsAcceptanceModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &TermsAcceptance{
+		DriverID:   m.driverID,
+		Document:   m.document,
+		Version:    m.version,
+		AcceptedAt: time.Unix(m.acceptedAt, 0),
+	}, nil
+}
+
+func termsAcceptanceModelFromEntity(acceptance TermsAcceptance) termsAcceptanceModel {
+	return termsAcceptanceModel{
+		driverID:   acceptance.DriverID,
+		document:   acceptance.Document,
+		version:    acceptance.Version,
+		acceptedAt: acceptance.AcceptedAt.Unix(),
+	}
+}
+
+// supporterModel represents a driver's supporter subscription (driver#<id> / supporter)
+type supporterModel struct {
+	driverID         int64  `dynamo:"driver_id"`
+	customerID       string `dynamo:"customer_id"`
+	subscriptionID   string `dynamo:"subscription_id"`
+	status           string `dynamo:"status"`
+	currentPeriodEnd int64  `dynamo:"current_period_end"`
+	updatedAt        int64  `dynamo:"updated_at"`
+}
+
+func (m supporterModel) keys() (string, string) {
+	return keys.Driver(m.driverID), keys.Supporter
+}
+
+func supporterFromAttributeMap(item map[string]types.AttributeValue) (*Supporter, error) {
+	m, err := supporterModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &Supporter{
+		DriverID:         m.driverID,
+		CustomerID:       m.customerID,
+		SubscriptionID:   m.subscriptionID,
+		Status:           m.status,
+		CurrentPeriodEnd: time.Unix(m.currentPeriodEnd, 0),
+		UpdatedAt:        time.Unix(m.updatedAt, 0),
+	}, nil
+}
+
+func supporterModelFromEntity(supporter Supporter) supporterModel {
+	return supporterModel{
+		driverID:         supporter.DriverID,
+		customerID:       supporter.CustomerID,
+		subscriptionID:   supporter.SubscriptionID,
+		status:           supporter.Status,
+		currentPeriodEnd: supporter.CurrentPeriodEnd.Unix(),
+		updatedAt:        supporter.UpdatedAt.Unix(),
+	}
+}
+
+// driverSessionModel represents a driver's participation in a session (driver#<id> / session#<timestamp>). The driver
+// ID comes from the partition the session is read from rather than an attribute of its own.
+type driverSessionModel struct {
+	driverID              int64
+	subsessionID          int64   `dynamo:"subsession_id"`
+	trackID               int64   `dynamo:"track_id"`
+	carID                 int64   `dynamo:"car_id"`
+	seriesID              int64   `dynamo:"series_id"`
+	seriesName            string  `dynamo:"series_name"`
+	startTime             int64   `dynamo:"start_time"`
+	startPosition         int     `dynamo:"start_position"`
+	startPositionInClass  int     `dynamo:"start_position_in_class"`
+	finishPosition        int     `dynamo:"finish_position"`
+	finishPositionInClass int     `dynamo:"finish_position_in_class"`
+	incidents             int     `dynamo:"incidents"`
+	oldCPI                float64 `dynamo:"old_cpi"`
+	newCPI                float64 `dynamo:"new_cpi"`
+	oldIRating            int     `dynamo:"old_irating"`
+	newIRating            int     `dynamo:"new_irating"`
+	oldLicenseLevel       int     `dynamo:"old_license_level"`
+	newLicenseLevel       int     `dynamo:"new_license_level"`
+	oldSubLevel           int     `dynamo:"old_sub_level"`
+	newSubLevel           int     `dynamo:"new_sub_level"`
+	reasonOut             string  `dynamo:"reason_out"`
+	// reason_out_code is newer still, see DriverSession.Outcome for records without it
+	reasonOutCode ReasonOutCode `dynamo:"reason_out_code,omitempty"`
+	// everything from here on was added after launch, older records won't have it
+	strengthOfField   int      `dynamo:"strength_of_field,optional"`
+	trafficCost       *int     `dynamo:"traffic_cost"`
+	cornersPerLap     int      `dynamo:"corners_per_lap,optional"`
+	licenseCategoryID int      `dynamo:"license_category_id,omitempty"`
+	lapsComplete      int      `dynamo:"laps_complete,optional"`
+	lapsLead          int      `dynamo:"laps_lead,optional"`
+	carClassID        int64    `dynamo:"car_class_id,optional"`
+	seasonID          int64    `dynamo:"season_id,optional"`
+	seasonYear        int      `dynamo:"season_year,optional"`
+	seasonQuarter     int      `dynamo:"season_quarter,optional"`
+	raceWeekNum       int      `dynamo:"race_week_num,optional"`
+	champPoints       int      `dynamo:"champ_points,optional"`
+	dropRace          bool     `dynamo:"drop_race,optional"`
+	lapsSkipped       bool     `dynamo:"laps_skipped,omitempty"`
+	lapGaps           int      `dynamo:"lap_gaps,omitempty"`
+	positions         []int    `dynamo:"positions,omitempty"`
+	squadEvents       []string `dynamo:"squad_events,set"`
+}
+
+func (d driverSessionModel) keys() (string, string) {
+	return keys.Driver(d.driverID), keys.DriverSession(d.startTime)
+}
+
+func driverSessionFromAttributeMap(driverID int64, item map[string]types.AttributeValue) (*DriverSession, error) {
+	d, err := driverSessionModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &DriverSession{
+		DriverID:              driverID,
+		SubsessionID:          d.subsessionID,
+		TrackID:               d.trackID,
+		CarID:                 d.carID,
+		SeriesID:              d.seriesID,
+		SeriesName:            d.seriesName,
+		StartTime:             time.Unix(d.startTime, 0),
+		StartPosition:         d.startPosition,
+		StartPositionInClass:  d.startPositionInClass,
+		FinishPosition:        d.finishPosition,
+		FinishPositionInClass: d.finishPositionInClass,
+		Incidents:             d.incidents,
+		OldCPI:                d.oldCPI,
+		NewCPI:                d.newCPI,
+		OldIRating:            d.oldIRating,
+		NewIRating:            d.newIRating,
+		OldLicenseLevel:       d.oldLicenseLevel,
+		NewLicenseLevel:       d.newLicenseLevel,
+		OldSubLevel:           d.oldSubLevel,
+		NewSubLevel:           d.newSubLevel,
+		ReasonOut:             d.reasonOut,
+		ReasonOutCode:         d.reasonOutCode,
+		StrengthOfField:       d.strengthOfField,
+		TrafficCost:           d.trafficCost,
+		CornersPerLap:         d.cornersPerLap,
+		LicenseCategoryID:     d.licenseCategoryID,
+		LapsComplete:          d.lapsComplete,
+		LapsLead:              d.lapsLead,
+		CarClassID:            d.carClassID,
+		SeasonID:              d.seasonID,
+		SeasonYear:            d.seasonYear,
+		SeasonQuarter:         d.seasonQuarter,
+		RaceWeekNum:           d.raceWeekNum,
+		ChampPoints:           d.champPoints,
+		DropRace:              d.dropRace,
+		LapsSkipped:           d.lapsSkipped,
+		LapGaps:               d.lapGaps,
+		Positions:             d.positions,
+		SquadEvents:           d.squadEvents,
+	}, nil
+}
+
+// ingestionRunModel represents a single ingestion round (ingestion_runs / run#<started_at>#<driver_id>)
+type ingestionRunModel struct {
+	driverID         int64
+	startedAt        int64 // unix millis
+	durationMs       int64
+	raceCount        int
+	newRaceCount     int
+	lapCount         int
+	upToDate         bool
+	errorMessage     string
+	captureID        string
+	phaseDurationsMs map[string]int64
+	ttl              int64
+}
+
+func (m ingestionRunModel) toAttributeMap() map[string]types.AttributeValue {
+	phases := make(map[string]types.AttributeValue, len(m.phaseDurationsMs))
+	for phase, ms := range m.phaseDurationsMs {
+		phases[phase] = &types.AttributeValueMemberN{Value: strconv.FormatInt(ms, 10)}
+	}
+	ret := map[string]types.AttributeValue{
+		partitionKeyName:     &types.AttributeValueMemberS{Value: keys.IngestionRuns},
+		sortKeyName:          &types.AttributeValueMemberS{Value: keys.IngestionRun(m.startedAt, m.driverID)},
+		"driver_id":          &types.AttributeValueMemberN{Value: strconv.FormatInt(m.driverID, 10)},
+		"started_at":         &types.AttributeValueMemberN{Value: strconv.FormatInt(m.startedAt, 10)},
+		"duration_ms":        &types.AttributeValueMemberN{Value: strconv.FormatInt(m.durationMs, 10)},
+		"race_count":         &types.AttributeValueMemberN{Value: strconv.Itoa(m.raceCount)},
+		"new_race_count":     &types.AttributeValueMemberN{Value: strconv.Itoa(m.newRaceCount)},
+		"lap_count":          &types.AttributeValueMemberN{Value: strconv.Itoa(m.lapCount)},
+		"up_to_date":         &types.AttributeValueMemberBOOL{Value: m.upToDate},
+		"phase_durations_ms": &types.AttributeValueMemberM{Value: phases},
+		ttlAttributeName:     ttlAttr(m.ttl),
+	}
+	if m.errorMessage != "" {
+		ret["error"] = &types.AttributeValueMemberS{Value: m.errorMessage}
+	}
+	if m.captureID != "" {
+		ret["capture_id"] = &types.AttributeValueMemberS{Value: m.captureID}
+	}
+	return ret
+}
+
+func ingestionRunFromAttributeMap(item map[string]types.AttributeValue) (*IngestionRun, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	startedAt, err := getInt64Attr(item, "started_at")
+	if err != nil {
+		return nil, err
+	}
+	durationMs, err := getInt64Attr(item, "duration_ms")
+	if err != nil {
+		return nil, err
+	}
+	raceCount, err := getIntAttr(item, "race_count")
+	if err != nil {
+		return nil, err
+	}
+	newRaceCount, err := getIntAttr(item, "new_race_count")
+	if err != nil {
+		return nil, err
+	}
+	lapCount, err := getIntAttr(item, "lap_count")
+	if err != nil {
+		return nil, err
+	}
+	upToDate, err := getBoolAttr(item, "up_to_date")
+	if err != nil {
+		return nil, err
+	}
+	var errorMessage string
+	if attr, ok := item["error"].(*types.AttributeValueMemberS); ok {
+		errorMessage = attr.Value
+	}
+	var captureID string
+	if attr, ok := item["capture_id"].(*types.AttributeValueMemberS); ok {
+		captureID = attr.Value
+	}
+	phasesAttr, ok := item["phase_durations_ms"].(*types.AttributeValueMemberM)
+	if !ok {
+		return nil, fmt.Errorf("missing or invalid 'phase_durations_ms' attribute")
+	}
+	phaseDurations := make(map[string]time.Duration, len(phasesAttr.Value))
+	for phase := range phasesAttr.Value {
+		ms, err := getInt64Attr(phasesAttr.Value, phase)
+		if err != nil {
+			return nil, err
+		}
+		phaseDurations[phase] = time.Duration(ms) * time.Millisecond
+	}
+
+	return &IngestionRun{
+		DriverID:       driverID,
+		StartedAt:      time.UnixMilli(startedAt),
+		Duration:       time.Duration(durationMs) * time.Millisecond,
+		RaceCount:      raceCount,
+		NewRaceCount:   newRaceCount,
+		LapCount:       lapCount,
+		UpToDate:       upToDate,
+		Error:          errorMessage,
+		CaptureID:      captureID,
+		PhaseDurations: phaseDurations,
+	}, nil
+}
+
+// iRacingProxyRequestModel represents a developer's request through the iRacing proxy (driver#<id> / proxyrequest#<requested_at>)
+type iRacingProxyRequestModel struct {
+	driverID    int64
+	requestedAt int64 // unix millis
+	path        string
+	params      map[string]string
+	status      int
+	durationMs  int64
+	ttl         int64
+}
+
+func (m iRacingProxyRequestModel) toAttributeMap() map[string]types.AttributeValue {
+	params := make(map[string]types.AttributeValue, len(m.params))
+	for name, value := range m.params {
+		params[name] = &types.AttributeValueMemberS{Value: value}
+	}
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(m.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.IRacingProxyRequest(m.requestedAt)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(m.driverID, 10)},
+		"requested_at":   &types.AttributeValueMemberN{Value: strconv.FormatInt(m.requestedAt, 10)},
+		"path":           &types.AttributeValueMemberS{Value: m.path},
+		"params":         &types.AttributeValueMemberM{Value: params},
+		"status":         &types.AttributeValueMemberN{Value: strconv.Itoa(m.status)},
+		"duration_ms":    &types.AttributeValueMemberN{Value: strconv.FormatInt(m.durationMs, 10)},
+		ttlAttributeName: ttlAttr(m.ttl),
+	}
+}
+
+func iRacingProxyRequestFromAttributeMap(item map[string]types.AttributeValue) (*IRacingProxyRequest, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	requestedAt, err := getInt64Attr(item, "requested_at")
+	if err != nil {
+		return nil, err
+	}
+	path, err := getStringAttr(item, "path")
+	if err != nil {
+		return nil, err
+	}
+	status, err := getIntAttr(item, "status")
+	if err != nil {
+		return nil, err
+	}
+	durationMs, err := getInt64Attr(item, "duration_ms")
+	if err != nil {
+		return nil, err
+	}
+	paramsAttr, ok := item["params"].(*types.AttributeValueMemberM)
+	if !ok {
+		return nil, fmt.Errorf("missing or invalid 'params' attribute")
+	}
+	params := make(map[string]string, len(paramsAttr.Value))
+	for name := range paramsAttr.Value {
+		value, err := getStringAttr(paramsAttr.Value, name)
+		if err != nil {
+			return nil, err
+		}
+		params[name] = value
+	}
+
+	return &IRacingProxyRequest{
+		DriverID:    driverID,
+		RequestedAt: time.UnixMilli(requestedAt),
+		Path:        path,
+		Params:      params,
+		Status:      status,
+		Duration:    time.Duration(durationMs) * time.Millisecond,
+	}, nil
+}
+
+// requestCaptureModeModel represents a developer's request capture mode, expiring when it ends (driver#<id> /
+// requestcapture)
+type requestCaptureModeModel struct {
+	driverID  int64
+	enabledAt int64 // unix seconds
+	until     int64 // unix seconds, doubling as the ttl
+}
+
+func (m requestCaptureModeModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(m.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.RequestCaptureMode},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(m.driverID, 10)},
+		"enabled_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(m.enabledAt, 10)},
+		ttlAttributeName: ttlAttr(m.until),
+	}
+}
+
+func requestCaptureModeFromAttributeMap(item map[string]types.AttributeValue) (*RequestCaptureMode, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	enabledAt, err := getInt64Attr(item, "enabled_at")
+	if err != nil {
+		return nil, err
+	}
+	until, err := getInt64Attr(item, ttlAttributeName)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestCaptureMode{
+		DriverID:  driverID,
+		EnabledAt: time.Unix(enabledAt, 0),
+		Until:     time.Unix(until, 0),
+	}, nil
+}
+
+// capturedRequestModel represents one of a developer's captured API requests (driver#<id> /
+// capturedrequest#<requested_at>#<id>)
+type capturedRequestModel struct {
+	id                    string
+	driverID              int64
+	requestedAt           int64 // unix millis
+	method                string
+	path                  string
+	route                 string
+	query                 string
+	status                int
+	durationMs            int64
+	requestContentType    string
+	requestBody           string
+	requestBodyTruncated  bool
+	responseContentType   string
+	responseBody          string
+	responseBodyTruncated bool
+	ttl                   int64
+}
+
+func (m capturedRequestModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName:          &types.AttributeValueMemberS{Value: keys.Driver(m.driverID)},
+		sortKeyName:               &types.AttributeValueMemberS{Value: keys.CapturedRequest(m.requestedAt, m.id)},
+		"request_id":              &types.AttributeValueMemberS{Value: m.id},
+		"driver_id":               &types.AttributeValueMemberN{Value: strconv.FormatInt(m.driverID, 10)},
+		"requested_at":            &types.AttributeValueMemberN{Value: strconv.FormatInt(m.requestedAt, 10)},
+		"method":                  &types.AttributeValueMemberS{Value: m.method},
+		"path":                    &types.AttributeValueMemberS{Value: m.path},
+		"route":                   &types.AttributeValueMemberS{Value: m.route},
+		"query":                   &types.AttributeValueMemberS{Value: m.query},
+		"status":                  &types.AttributeValueMemberN{Value: strconv.Itoa(m.status)},
+		"duration_ms":             &types.AttributeValueMemberN{Value: strconv.FormatInt(m.durationMs, 10)},
+		"request_content_type":    &types.AttributeValueMemberS{Value: m.requestContentType},
+		"request_body":            &types.AttributeValueMemberS{Value: m.requestBody},
+		"request_body_truncated":  &types.AttributeValueMemberBOOL{Value: m.requestBodyTruncated},
+		"response_content_type":   &types.AttributeValueMemberS{Value: m.responseContentType},
+		"response_body":           &types.AttributeValueMemberS{Value: m.responseBody},
+		"response_body_truncated": &types.AttributeValueMemberBOOL{Value: m.responseBodyTruncated},
+		ttlAttributeName:          ttlAttr(m.ttl),
+	}
+}
+
+func capturedRequestFromAttributeMap(item map[string]types.AttributeValue) (*CapturedRequest, error) {
+	id, err := getStringAttr(item, "request_id")
+	if err != nil {
+		return nil, err
+	}
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	requestedAt, err := getInt64Attr(item, "requested_at")
+	if err != nil {
+		return nil, err
+	}
+	method, err := getStringAttr(item, "method")
+	if err != nil {
+		return nil, err
+	}
+	path, err := getStringAttr(item, "path")
+	if err != nil {
+		return nil, err
+	}
+	route, err := getStringAttr(item, "route")
+	if err != nil {
+		return nil, err
+	}
+	query, err := getStringAttr(item, "query")
+	if err != nil {
+		return nil, err
+	}
+	status, err := getIntAttr(item, "status")
+	if err != nil {
+		return nil, err
+	}
+	durationMs, err := getInt64Attr(item, "duration_ms")
+	if err != nil {
+		return nil, err
+	}
+	requestContentType, err := getStringAttr(item, "request_content_type")
+	if err != nil {
+		return nil, err
+	}
+	requestBody, err := getStringAttr(item, "request_body")
+	if err != nil {
+		return nil, err
+	}
+	requestBodyTruncated, err := getBoolAttr(item, "request_body_truncated")
+	if err != nil {
+		return nil, err
+	}
+	responseContentType, err := getStringAttr(item, "response_content_type")
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := getStringAttr(item, "response_body")
+	if err != nil {
+		return nil, err
+	}
+	responseBodyTruncated, err := getBoolAttr(item, "response_body_truncated")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CapturedRequest{
+		ID:                    id,
+		DriverID:              driverID,
+		RequestedAt:           time.UnixMilli(requestedAt),
+		Method:                method,
+		Path:                  path,
+		Route:                 route,
+		Query:                 query,
+		Status:                status,
+		Duration:              time.Duration(durationMs) * time.Millisecond,
+		RequestContentType:    requestContentType,
+		RequestBody:           requestBody,
+		RequestBodyTruncated:  requestBodyTruncated,
+		ResponseContentType:   responseContentType,
+		ResponseBody:          responseBody,
+		ResponseBodyTruncated: responseBodyTruncated,
+	}, nil
+}
+
+// journalEntryModel represents a journal entry for a race (driver#<id> / journal#<race_id>)
+type journalEntryModel struct {
+	driverID        int64
+	raceID          int64
+	createdAt       int64
+	updatedAt       int64
+	notes           string
+	tags            []string
+	replayVideo     string
+	transcripts     []string
+	searchTerms     []string
+	transcriptTerms []string
+}
+
+func (j journalEntryModel) toAttributeMap() map[string]types.AttributeValue {
+	m := map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(j.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalEntry(j.raceID)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(j.driverID, 10)},
+		"race_id":        &types.AttributeValueMemberN{Value: strconv.FormatInt(j.raceID, 10)},
+		"created_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(j.createdAt, 10)},
+		"updated_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(j.updatedAt, 10)},
+		"notes":          &types.AttributeValueMemberS{Value: j.notes},
+	}
+	if len(j.tags) > 0 {
+		tagValues := make([]types.AttributeValue, len(j.tags))
+		for i, t := range j.tags {
+			tagValues[i] = &types.AttributeValueMemberS{Value: t}
+		}
+		m["tags"] = &types.AttributeValueMemberL{Value: tagValues}
+	}
+	if j.replayVideo != "" {
+		m["replay_video"] = &types.AttributeValueMemberS{Value: j.replayVideo}
+	}
+	if len(j.transcripts) > 0 {
+		transcriptValues := make([]types.AttributeValue, len(j.transcripts))
+		for i, t := range j.transcripts {
+			transcriptValues[i] = &types.AttributeValueMemberS{Value: t}
+		}
+		m["transcripts"] = &types.AttributeValueMemberL{Value: transcriptValues}
+	}
+	// string sets can't be empty, no terms means no attribute
+	if len(j.searchTerms) > 0 {
+		m["search_terms"] = &types.AttributeValueMemberSS{Value: j.searchTerms}
+	}
+	if len(j.transcriptTerms) > 0 {
+		m["transcript_terms"] = &types.AttributeValueMemberSS{Value: j.transcriptTerms}
+	}
+	return m
+}
+
+func journalEntryFromAttributeMap(item map[string]types.AttributeValue) (*RaceJournalEntry, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	raceID, err := getInt64Attr(item, "race_id")
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := getInt64Attr(item, "created_at")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := getInt64Attr(item, "updated_at")
+	if err != nil {
+		return nil, err
+	}
+	notes, err := getStringAttr(item, "notes")
+	if err != nil {
+		return nil, err
+	}
+	tags, err := getOptionalStringSliceAttr(item, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	transcripts, err := getOptionalStringSliceAttr(item, "transcripts")
+	if err != nil {
+		return nil, err
+	}
+	searchTerms, err := getOptionalStringSetAttr(item, "search_terms")
+	if err != nil {
+		return nil, err
+	}
+	transcriptTerms, err := getOptionalStringSetAttr(item, "transcript_terms")
+	if err != nil {
+		return nil, err
+	}
+
+	replayVideo := ""
+	if rv, ok := item["replay_video"].(*types.AttributeValueMemberS); ok {
+		replayVideo = rv.Value
+	}
+
+	return &RaceJournalEntry{
+		DriverID:        driverID,
+		RaceID:          raceID,
+		CreatedAt:       time.Unix(createdAt, 0),
+		UpdatedAt:       time.Unix(updatedAt, 0),
+		Notes:           notes,
+		Tags:            tags,
+		ReplayVideo:     replayVideo,
+		Transcripts:     transcripts,
+		SearchTerms:     searchTerms,
+		TranscriptTerms: transcriptTerms,
+	}, nil
+}
+
+// journalAttachmentModel represents an attachment on a journal entry (driver#<id> / journalattachment#<race_id>#<attachment_id>)
+type journalAttachmentModel struct {
+	driverID     int64
+	raceID       int64
+	attachmentID string
+	contentType  string
+	createdAt    int64
+	status       string
+	transcribe   bool
+}
+
+func journalAttachmentModelFromEntity(attachment JournalAttachment) journalAttachmentModel {
+	return journalAttachmentModel{
+		driverID:     attachment.DriverID,
+		raceID:       attachment.RaceID,
+		attachmentID: attachment.AttachmentID,
+		contentType:  attachment.ContentType,
+		createdAt:    toUnixSeconds(attachment.CreatedAt),
+		status:       string(attachment.Status),
+		transcribe:   attachment.Transcribe,
+	}
+}
+
+func (a journalAttachmentModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(a.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalAttachment(a.raceID, a.attachmentID)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(a.driverID, 10)},
+		"race_id":        &types.AttributeValueMemberN{Value: strconv.FormatInt(a.raceID, 10)},
+		"attachment_id":  &types.AttributeValueMemberS{Value: a.attachmentID},
+		"content_type":   &types.AttributeValueMemberS{Value: a.contentType},
+		"created_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(a.createdAt, 10)},
+		"status":         &types.AttributeValueMemberS{Value: a.status},
+		"transcribe":     &types.AttributeValueMemberBOOL{Value: a.transcribe},
+	}
+}
+
+func journalAttachmentFromAttributeMap(item map[string]types.AttributeValue) (*JournalAttachment, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	raceID, err := getInt64Attr(item, "race_id")
+	if err != nil {
+		return nil, err
+	}
+	attachmentID, err := getStringAttr(item, "attachment_id")
+	if err != nil {
+		return nil, err
+	}
+	contentType, err := getStringAttr(item, "content_type")
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := getInt64Attr(item, "created_at")
+	if err != nil {
+		return nil, err
+	}
+	status, err := getStringAttr(item, "status")
+	if err != nil {
+		return nil, err
+	}
+	transcribe, err := getBoolAttr(item, "transcribe")
+	if err != nil {
+		return nil, err
+	}
+
+	return &JournalAttachment{
+		DriverID:     driverID,
+		RaceID:       raceID,
+		AttachmentID: attachmentID,
+		ContentType:  contentType,
+		CreatedAt:    time.Unix(createdAt, 0),
+		Status:       JournalAttachmentStatus(status),
+		Transcribe:   transcribe,
+	}, nil
+}
+
+// actionItemModel represents a driver's action item (driver#<id> / actionitem#<item_id>)
+type actionItemModel struct {
+	driverID    int64
+	itemID      string
+	title       string
+	status      string
+	raceID      int64
+	trackID     int64
+	dueDate     *int64
+	createdAt   int64
+	updatedAt   int64
+	completedAt *int64
+}
+
+func actionItemModelFromEntity(item ActionItem) actionItemModel {
+	m := actionItemModel{
+		driverID:  item.DriverID,
+		itemID:    item.ItemID,
+		title:     item.Title,
+		status:    string(item.Status),
+		raceID:    item.RaceID,
+		trackID:   item.TrackID,
+		createdAt: toUnixSeconds(item.CreatedAt),
+		updatedAt: toUnixSeconds(item.UpdatedAt),
+	}
+	if item.DueDate != nil {
+		dueDate := toUnixSeconds(*item.DueDate)
+		m.dueDate = &dueDate
+	}
+	if item.CompletedAt != nil {
+		completedAt := toUnixSeconds(*item.CompletedAt)
+		m.completedAt = &completedAt
+	}
+	return m
+}
+
+func (a actionItemModel) toAttributeMap() map[string]types.AttributeValue {
+	m := map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(a.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.ActionItem(a.itemID)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(a.driverID, 10)},
+		"item_id":        &types.AttributeValueMemberS{Value: a.itemID},
+		"title":          &types.AttributeValueMemberS{Value: a.title},
+		"status":         &types.AttributeValueMemberS{Value: a.status},
+		"created_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(a.createdAt, 10)},
+		"updated_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(a.updatedAt, 10)},
+	}
+	if a.raceID != 0 {
+		m["race_id"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(a.raceID, 10)}
+	}
+	if a.trackID != 0 {
+		m["track_id"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(a.trackID, 10)}
+	}
+	if a.dueDate != nil {
+		m["due_date"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(*a.dueDate, 10)}
+	}
+	if a.completedAt != nil {
+		m["completed_at"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(*a.completedAt, 10)}
+	}
+	return m
+}
+
+func actionItemFromAttributeMap(item map[string]types.AttributeValue) (*ActionItem, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	itemID, err := getStringAttr(item, "item_id")
+	if err != nil {
+		return nil, err
+	}
+	title, err := getStringAttr(item, "title")
+	if err != nil {
+		return nil, err
+	}
+	status, err := getStringAttr(item, "status")
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := getInt64Attr(item, "created_at")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := getInt64Attr(item, "updated_at")
+	if err != nil {
+		return nil, err
+	}
+	raceID, _ := getOptionalInt64Attr(item, "race_id")
+	trackID, _ := getOptionalInt64Attr(item, "track_id")
+
+	result := &ActionItem{
+		DriverID:  driverID,
+		ItemID:    itemID,
+		Title:     title,
+		Status:    ActionItemStatus(status),
+		RaceID:    raceID,
+		TrackID:   trackID,
+		CreatedAt: time.Unix(createdAt, 0),
+		UpdatedAt: time.Unix(updatedAt, 0),
+	}
+	if v, ok := getOptionalInt64Attr(item, "due_date"); ok {
+		dueDate := time.Unix(v, 0)
+		result.DueDate = &dueDate
+	}
+	if v, ok := getOptionalInt64Attr(item, "completed_at"); ok {
+		completedAt := time.Unix(v, 0)
+		result.CompletedAt = &completedAt
+	}
+	return result, nil
+}
+
+// alertRuleModel represents a driver's trend alert rule (driver#<id> / alertrule#<rule_id>)
+type alertRuleModel struct {
+	driverID        int64
+	ruleID          string
+	name            string
+	metric          string
+	aggregate       string
+	windowRaces     int
+	windowDays      int
+	comparison      string
+	threshold       float64
+	enabled         bool
+	triggered       bool
+	lastTriggeredAt *int64
+	lastValue       float64
+	createdAt       int64
+	updatedAt       int64
+}
+
+func alertRuleModelFromEntity(rule AlertRule) alertRuleModel {
+	m := alertRuleModel{
+		driverID:    rule.DriverID,
+		ruleID:      rule.RuleID,
+		name:        rule.Name,
+		metric:      string(rule.Metric),
+		aggregate:   string(rule.Aggregate),
+		windowRaces: rule.WindowRaces,
+		windowDays:  rule.WindowDays,
+		comparison:  string(rule.Comparison),
+		threshold:   rule.Threshold,
+		enabled:     rule.Enabled,
+		triggered:   rule.Triggered,
+		lastValue:   rule.LastValue,
+		createdAt:   toUnixSeconds(rule.CreatedAt),
+		updatedAt:   toUnixSeconds(rule.UpdatedAt),
+	}
+	if rule.LastTriggeredAt != nil {
+		lastTriggeredAt := toUnixSeconds(*rule.LastTriggeredAt)
+		m.lastTriggeredAt = &lastTriggeredAt
+	}
+	return m
+}
+
+func (a alertRuleModel) toAttributeMap() map[string]types.AttributeValue {
+	m := map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(a.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.AlertRule(a.ruleID)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(a.driverID, 10)},
+		"rule_id":        &types.AttributeValueMemberS{Value: a.ruleID},
+		"name":           &types.AttributeValueMemberS{Value: a.name},
+		"metric":         &types.AttributeValueMemberS{Value: a.metric},
+		"aggregate":      &types.AttributeValueMemberS{Value: a.aggregate},
+		"window_races":   &types.AttributeValueMemberN{Value: strconv.Itoa(a.windowRaces)},
+		"window_days":    &types.AttributeValueMemberN{Value: strconv.Itoa(a.windowDays)},
+		"comparison":     &types.AttributeValueMemberS{Value: a.comparison},
+		"threshold":      &types.AttributeValueMemberN{Value: strconv.FormatFloat(a.threshold, 'f', -1, 64)},
+		"enabled":        &types.AttributeValueMemberBOOL{Value: a.enabled},
+		"triggered":      &types.AttributeValueMemberBOOL{Value: a.triggered},
+		"last_value":     &types.AttributeValueMemberN{Value: strconv.FormatFloat(a.lastValue, 'f', -1, 64)},
+		"created_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(a.createdAt, 10)},
+		"updated_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(a.updatedAt, 10)},
+	}
+	if a.lastTriggeredAt != nil {
+		m["last_triggered_at"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(*a.lastTriggeredAt, 10)}
+	}
+	return m
+}
+
+func alertRuleFromAttributeMap(item map[string]types.AttributeValue) (*AlertRule, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	ruleID, err := getStringAttr(item, "rule_id")
+	if err != nil {
+		return nil, err
+	}
+	name, err := getStringAttr(item, "name")
+	if err != nil {
+		return nil, err
+	}
+	metric, err := getStringAttr(item, "metric")
+	if err != nil {
+		return nil, err
+	}
+	aggregate, err := getStringAttr(item, "aggregate")
+	if err != nil {
+		return nil, err
+	}
+	windowRaces, err := getIntAttr(item, "window_races")
+	if err != nil {
+		return nil, err
+	}
+	windowDays, err := getIntAttr(item, "window_days")
+	if err != nil {
+		return nil, err
+	}
+	comparison, err := getStringAttr(item, "comparison")
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := getFloatAttr(item, "threshold")
+	if err != nil {
+		return nil, err
+	}
+	enabled, err := getBoolAttr(item, "enabled")
+	if err != nil {
+		return nil, err
+	}
+	triggered, err := getBoolAttr(item, "triggered")
+	if err != nil {
+		return nil, err
+	}
+	lastValue, err := getFloatAttr(item, "last_value")
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := getInt64Attr(item, "created_at")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := getInt64Attr(item, "updated_at")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AlertRule{
+		DriverID:    driverID,
+		RuleID:      ruleID,
+		Name:        name,
+		Metric:      AlertMetric(metric),
+		Aggregate:   AlertAggregate(aggregate),
+		WindowRaces: windowRaces,
+		WindowDays:  windowDays,
+		Comparison:  AlertComparison(comparison),
+		Threshold:   threshold,
+		Enabled:     enabled,
+		Triggered:   triggered,
+		LastValue:   lastValue,
+		CreatedAt:   time.Unix(createdAt, 0),
+		UpdatedAt:   time.Unix(updatedAt, 0),
+	}
+	if v, ok := getOptionalInt64Attr(item, "last_triggered_at"); ok {
+		lastTriggeredAt := time.Unix(v, 0)
+		result.LastTriggeredAt = &lastTriggeredAt
+	}
+	return result, nil
+}
+
+// raceBookmarkModel represents a bookmark in a race's replay (driver#<id> / bookmark#<race_id>#<bookmark_id>)
+type raceBookmarkModel struct {
+	driverID     int64
+	raceID       int64
+	bookmarkID   string
+	lap          int
+	note         string
+	replayTimeMs int64
+	createdAt    int64
+	updatedAt    int64
+}
+
+func raceBookmarkModelFromEntity(bookmark RaceBookmark) raceBookmarkModel {
+	return raceBookmarkModel{
+		driverID:     bookmark.DriverID,
+		raceID:       bookmark.RaceID,
+		bookmarkID:   bookmark.BookmarkID,
+		lap:          bookmark.Lap,
+		note:         bookmark.Note,
+		replayTimeMs: bookmark.ReplayTime.Milliseconds(),
+		createdAt:    toUnixSeconds(bookmark.CreatedAt),
+		updatedAt:    toUnixSeconds(bookmark.UpdatedAt),
+	}
+}
+
+func (b raceBookmarkModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(b.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.RaceBookmark(b.raceID, b.bookmarkID)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(b.driverID, 10)},
+		"race_id":        &types.AttributeValueMemberN{Value: strconv.FormatInt(b.raceID, 10)},
+		"bookmark_id":    &types.AttributeValueMemberS{Value: b.bookmarkID},
+		"lap":            &types.AttributeValueMemberN{Value: strconv.Itoa(b.lap)},
+		"note":           &types.AttributeValueMemberS{Value: b.note},
+		"replay_time_ms": &types.AttributeValueMemberN{Value: strconv.FormatInt(b.replayTimeMs, 10)},
+		"created_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(b.createdAt, 10)},
+		"updated_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(b.updatedAt, 10)},
+	}
+}
+
+func raceBookmarkFromAttributeMap(item map[string]types.AttributeValue) (*RaceBookmark, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	raceID, err := getInt64Attr(item, "race_id")
+	if err != nil {
+		return nil, err
+	}
+	bookmarkID, err := getStringAttr(item, "bookmark_id")
+	if err != nil {
+		return nil, err
+	}
+	lap, err := getIntAttr(item, "lap")
+	if err != nil {
+		return nil, err
+	}
+	note, err := getStringAttr(item, "note")
+	if err != nil {
+		return nil, err
+	}
+	replayTimeMs, err := getInt64Attr(item, "replay_time_ms")
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := getInt64Attr(item, "created_at")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := getInt64Attr(item, "updated_at")
+	if err != nil {
+		return nil, err
+	}
+	return &RaceBookmark{
+		DriverID:   driverID,
+		RaceID:     raceID,
+		BookmarkID: bookmarkID,
+		Lap:        lap,
+		Note:       note,
+		ReplayTime: time.Duration(replayTimeMs) * time.Millisecond,
+		CreatedAt:  time.Unix(createdAt, 0),
+		UpdatedAt:  time.Unix(updatedAt, 0),
+	}, nil
+}
+
+// videoLinkModel represents an external video attached to a race (driver#<id> / videolink#<race_id>#<link_id>)
+type videoLinkModel struct {
+	driverID     int64
+	raceID       int64
+	linkID       string
+	lap          *int
+	url          string
+	provider     string
+	title        string
+	authorName   string
+	thumbnailURL string
+	createdAt    int64
+}
+
+func videoLinkModelFromEntity(link VideoLink) videoLinkModel {
+	return videoLinkModel{
+		driverID:     link.DriverID,
+		raceID:       link.RaceID,
+		linkID:       link.LinkID,
+		lap:          link.Lap,
+		url:          link.URL,
+		provider:     link.Provider,
+		title:        link.Title,
+		authorName:   link.AuthorName,
+		thumbnailURL: link.ThumbnailURL,
+		createdAt:    toUnixSeconds(link.CreatedAt),
+	}
+}
+
+func (v videoLinkModel) toAttributeMap() map[string]types.AttributeValue {
+	item := map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(v.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.VideoLink(v.raceID, v.linkID)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(v.driverID, 10)},
+		"race_id":        &types.AttributeValueMemberN{Value: strconv.FormatInt(v.raceID, 10)},
+		"link_id":        &types.AttributeValueMemberS{Value: v.linkID},
+		"url":            &types.AttributeValueMemberS{Value: v.url},
+		"provider":       &types.AttributeValueMemberS{Value: v.provider},
+		"created_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(v.createdAt, 10)},
+	}
+	if v.lap != nil {
+		item["lap"] = &types.AttributeValueMemberN{Value: strconv.Itoa(*v.lap)}
+	}
+	if v.title != "" {
+		item["title"] = &types.AttributeValueMemberS{Value: v.title}
+	}
+	if v.authorName != "" {
+		item["author_name"] = &types.AttributeValueMemberS{Value: v.authorName}
+	}
+	if v.thumbnailURL != "" {
+		item["thumbnail_url"] = &types.AttributeValueMemberS{Value: v.thumbnailURL}
+	}
+	return item
+}
+
+func videoLinkFromAttributeMap(item map[string]types.AttributeValue) (*VideoLink, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	raceID, err := getInt64Attr(item, "race_id")
+	if err != nil {
+		return nil, err
+	}
+	linkID, err := getStringAttr(item, "link_id")
+	if err != nil {
+		return nil, err
+	}
+	url, err := getStringAttr(item, "url")
+	if err != nil {
+		return nil, err
+	}
+	provider, err := getStringAttr(item, "provider")
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := getInt64Attr(item, "created_at")
+	if err != nil {
+		return nil, err
+	}
+	link := &VideoLink{
+		DriverID:  driverID,
+		RaceID:    raceID,
+		LinkID:    linkID,
+		URL:       url,
+		Provider:  provider,
+		CreatedAt: time.Unix(createdAt, 0),
+	}
+	if _, ok := item["lap"]; ok {
+		lap, err := getIntAttr(item, "lap")
+		if err != nil {
+			return nil, err
+		}
+		link.Lap = &lap
+	}
+	if _, ok := item["title"]; ok {
+		if link.Title, err = getStringAttr(item, "title"); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := item["author_name"]; ok {
+		if link.AuthorName, err = getStringAttr(item, "author_name"); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := item["thumbnail_url"]; ok {
+		if link.ThumbnailURL, err = getStringAttr(item, "thumbnail_url"); err != nil {
+			return nil, err
+		}
+	}
+	return link, nil
+}
+
+// raceTelemetryModel represents the stint summary imported for a race (driver#<id> / telemetry#<race_id>)
+type raceTelemetryModel struct {
+	driverID   int64
+	raceID     int64
+	stints     []TelemetryStint
+	importedAt int64
+}
+
+func raceTelemetryModelFromEntity(telemetry RaceTelemetry) raceTelemetryModel {
+	return raceTelemetryModel{
+		driverID:   telemetry.DriverID,
+		raceID:     telemetry.RaceID,
+		stints:     telemetry.Stints,
+		importedAt: toUnixSeconds(telemetry.ImportedAt),
+	}
+}
+
+func (t raceTelemetryModel) toAttributeMap() map[string]types.AttributeValue {
+	stintValues := make([]types.AttributeValue, len(t.stints))
+	for i, stint := range t.stints {
+		tireValues := make([]types.AttributeValue, len(stint.Tires))
+		for j, tire := range stint.Tires {
+			tireValues[j] = &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+				"corner":         &types.AttributeValueMemberS{Value: string(tire.Corner)},
+				"avg_temp":       &types.AttributeValueMemberN{Value: strconv.FormatFloat(tire.AvgTemp, 'f', -1, 64)},
+				"start_pressure": &types.AttributeValueMemberN{Value: strconv.FormatFloat(tire.StartPressure, 'f', -1, 64)},
+				"end_pressure":   &types.AttributeValueMemberN{Value: strconv.FormatFloat(tire.EndPressure, 'f', -1, 64)},
+			}}
+		}
+		stintValues[i] = &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"stint":        &types.AttributeValueMemberN{Value: strconv.Itoa(stint.Stint)},
+			"start_lap":    &types.AttributeValueMemberN{Value: strconv.Itoa(stint.StartLap)},
+			"end_lap":      &types.AttributeValueMemberN{Value: strconv.Itoa(stint.EndLap)},
+			"laps":         &types.AttributeValueMemberN{Value: strconv.Itoa(stint.Laps)},
+			"fuel_per_lap": &types.AttributeValueMemberN{Value: strconv.FormatFloat(stint.FuelPerLap, 'f', -1, 64)},
+			"tires":        &types.AttributeValueMemberL{Value: tireValues},
+		}}
+	}
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(t.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.RaceTelemetry(t.raceID)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(t.driverID, 10)},
+		"race_id":        &types.AttributeValueMemberN{Value: strconv.FormatInt(t.raceID, 10)},
+		"imported_at":    &types.AttributeValueMemberN{Value: strconv.FormatInt(t.importedAt, 10)},
+		"stints":         &types.AttributeValueMemberL{Value: stintValues},
+	}
+}
+
+func raceTelemetryFromAttributeMap(item map[string]types.AttributeValue) (*RaceTelemetry, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	raceID, err := getInt64Attr(item, "race_id")
+	if err != nil {
+		return nil, err
+	}
+	importedAt, err := getInt64Attr(item, "imported_at")
+	if err != nil {
+		return nil, err
+	}
+	stintsAttr, ok := item["stints"].(*types.AttributeValueMemberL)
+	if !ok {
+		return nil, fmt.Errorf("missing or invalid 'stints' attribute")
+	}
+
+	stints := make([]TelemetryStint, len(stintsAttr.Value))
+	for i, v := range stintsAttr.Value {
+		stintAttr, ok := v.(*types.AttributeValueMemberM)
+		if !ok {
+			return nil, fmt.Errorf("invalid 'stints' element %d", i)
+		}
+		stint, err := telemetryStintFromAttributeMap(stintAttr.Value)
+		if err != nil {
+			return nil, err
+		}
+		stints[i] = *stint
+	}
+
+	return &RaceTelemetry{
+		DriverID:   driverID,
+		RaceID:     raceID,
+		Stints:     stints,
+		ImportedAt: time.Unix(importedAt, 0),
+	}, nil
+}
+
+func telemetryStintFromAttributeMap(item map[string]types.AttributeValue) (*TelemetryStint, error) {
+	stintNumber, err := getIntAttr(item, "stint")
+	if err != nil {
+		return nil, err
+	}
+	startLap, err := getIntAttr(item, "start_lap")
+	if err != nil {
+		return nil, err
+	}
+	endLap, err := getIntAttr(item, "end_lap")
+	if err != nil {
+		return nil, err
+	}
+	laps, err := getIntAttr(item, "laps")
+	if err != nil {
+		return nil, err
+	}
+	fuelPerLap, err := getFloatAttr(item, "fuel_per_lap")
+	if err != nil {
+		return nil, err
+	}
+	tiresAttr, ok := item["tires"].(*types.AttributeValueMemberL)
+	if !ok {
+		return nil, fmt.Errorf("missing or invalid 'tires' attribute")
+	}
+
+	tires := make([]TireSummary, len(tiresAttr.Value))
+	for i, v := range tiresAttr.Value {
+		tireAttr, ok := v.(*types.AttributeValueMemberM)
+		if !ok {
+			return nil, fmt.Errorf("invalid 'tires' element %d", i)
+		}
+		tire, err := tireSummaryFromAttributeMap(tireAttr.Value)
+		if err != nil {
+			return nil, err
+		}
+		tires[i] = *tire
+	}
+
+	return &TelemetryStint{
+		Stint:      stintNumber,
+		StartLap:   startLap,
+		EndLap:     endLap,
+		Laps:       laps,
+		FuelPerLap: fuelPerLap,
+		Tires:      tires,
+	}, nil
+}
+
+func tireSummaryFromAttributeMap(item map[string]types.AttributeValue) (*TireSummary, error) {
+	corner, err := getStringAttr(item, "corner")
+	if err != nil {
+		return nil, err
+	}
+	avgTemp, err := getFloatAttr(item, "avg_temp")
+	if err != nil {
+		return nil, err
+	}
+	startPressure, err := getFloatAttr(item, "start_pressure")
+	if err != nil {
+		return nil, err
+	}
+	endPressure, err := getFloatAttr(item, "end_pressure")
+	if err != nil {
+		return nil, err
+	}
+	return &TireSummary{
+		Corner:        TireCorner(corner),
+		AvgTemp:       avgTemp,
+		StartPressure: startPressure,
+		EndPressure:   endPressure,
+	}, nil
+}
+
+// externalLapModel represents a lap imported from a third party service
+// (driver#<id> / externallap#<session_start>#<source>#<session_id>#<lap_number>)
+type externalLapModel struct {
+	driverID     int64
+	source       string
+	sessionID    string
+	sessionStart int64
+	trackID      int64
+	carID        int64
+	lapNumber    int
+	incident     bool
+	lapTime      int
+}
+
+func externalLapModelFromEntity(lap ExternalLap) externalLapModel {
+	return externalLapModel{
+		driverID:     lap.DriverID,
+		source:       lap.Source,
+		sessionID:    lap.SessionID,
+		sessionStart: toUnixSeconds(lap.SessionStart),
+		trackID:      lap.TrackID,
+		carID:        lap.CarID,
+		lapNumber:    lap.LapNumber,
+		incident:     lap.Incident,
+		lapTime:      lap.LapTime,
+	}
+}
+
+func (l externalLapModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(l.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.ExternalLap(l.sessionStart, l.source, l.sessionID, l.lapNumber)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(l.driverID, 10)},
+		"source":         &types.AttributeValueMemberS{Value: l.source},
+		"session_id":     &types.AttributeValueMemberS{Value: l.sessionID},
+		"session_start":  &types.AttributeValueMemberN{Value: strconv.FormatInt(l.sessionStart, 10)},
+		"track_id":       &types.AttributeValueMemberN{Value: strconv.FormatInt(l.trackID, 10)},
+		"car_id":         &types.AttributeValueMemberN{Value: strconv.FormatInt(l.carID, 10)},
+		"lap_number":     &types.AttributeValueMemberN{Value: strconv.Itoa(l.lapNumber)},
+		"incident":       &types.AttributeValueMemberBOOL{Value: l.incident},
+		"lap_time":       &types.AttributeValueMemberN{Value: strconv.Itoa(l.lapTime)},
+	}
+}
+
+func externalLapFromAttributeMap(item map[string]types.AttributeValue) (*ExternalLap, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	source, err := getStringAttr(item, "source")
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := getStringAttr(item, "session_id")
+	if err != nil {
+		return nil, err
+	}
+	sessionStart, err := getInt64Attr(item, "session_start")
+	if err != nil {
+		return nil, err
+	}
+	trackID, err := getInt64Attr(item, "track_id")
+	if err != nil {
+		return nil, err
+	}
+	carID, err := getInt64Attr(item, "car_id")
+	if err != nil {
+		return nil, err
+	}
+	lapNumber, err := getIntAttr(item, "lap_number")
+	if err != nil {
+		return nil, err
+	}
+	incident, err := getBoolAttr(item, "incident")
+	if err != nil {
+		return nil, err
+	}
+	lapTime, err := getIntAttr(item, "lap_time")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExternalLap{
+		DriverID:     driverID,
+		Source:       source,
+		SessionID:    sessionID,
+		SessionStart: time.Unix(sessionStart, 0),
+		TrackID:      trackID,
+		CarID:        carID,
+		LapNumber:    lapNumber,
+		Incident:     incident,
+		LapTime:      lapTime,
+	}, nil
+}
+
+// practicePlanModel represents a driver's generated practice plan (driver#<id> / practiceplan)
+type practicePlanModel struct {
+	driverID    int64
+	generatedAt int64
+	windowStart int64
+	items       []PracticePlanItem
+}
+
+func practicePlanModelFromEntity(plan PracticePlan) practicePlanModel {
+	return practicePlanModel{
+		driverID:    plan.DriverID,
+		generatedAt: toUnixSeconds(plan.GeneratedAt),
+		windowStart: toUnixSeconds(plan.WindowStart),
+		items:       plan.Items,
+	}
+}
+
+func (p practicePlanModel) toAttributeMap() map[string]types.AttributeValue {
+	itemValues := make([]types.AttributeValue, len(p.items))
+	for i, item := range p.items {
+		itemValues[i] = &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"track_id":   &types.AttributeValueMemberN{Value: strconv.FormatInt(item.TrackID, 10)},
+			"car_id":     &types.AttributeValueMemberN{Value: strconv.FormatInt(item.CarID, 10)},
+			"focus":      &types.AttributeValueMemberS{Value: string(item.Focus)},
+			"race_count": &types.AttributeValueMemberN{Value: strconv.Itoa(item.RaceCount)},
+			"value":      &types.AttributeValueMemberN{Value: strconv.FormatFloat(item.Value, 'f', -1, 64)},
+			"baseline":   &types.AttributeValueMemberN{Value: strconv.FormatFloat(item.Baseline, 'f', -1, 64)},
+		}}
+	}
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(p.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.PracticePlan},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(p.driverID, 10)},
+		"generated_at":   &types.AttributeValueMemberN{Value: strconv.FormatInt(p.generatedAt, 10)},
+		"window_start":   &types.AttributeValueMemberN{Value: strconv.FormatInt(p.windowStart, 10)},
+		"items":          &types.AttributeValueMemberL{Value: itemValues},
+	}
+}
+
+func practicePlanFromAttributeMap(item map[string]types.AttributeValue) (*PracticePlan, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	generatedAt, err := getInt64Attr(item, "generated_at")
+	if err != nil {
+		return nil, err
+	}
+	windowStart, err := getInt64Attr(item, "window_start")
+	if err != nil {
+		return nil, err
+	}
+	itemsAttr, ok := item["items"].(*types.AttributeValueMemberL)
+	if !ok {
+		return nil, fmt.Errorf("missing or invalid 'items' attribute")
+	}
+
+	items := make([]PracticePlanItem, len(itemsAttr.Value))
+	for i, v := range itemsAttr.Value {
+		itemAttr, ok := v.(*types.AttributeValueMemberM)
+		if !ok {
+			return nil, fmt.Errorf("invalid 'items' element %d", i)
+		}
+		planItem, err := practicePlanItemFromAttributeMap(itemAttr.Value)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = *planItem
+	}
+
+	return &PracticePlan{
+		DriverID:    driverID,
+		GeneratedAt: time.Unix(generatedAt, 0),
+		WindowStart: time.Unix(windowStart, 0),
+		Items:       items,
+	}, nil
+}
+
+func practicePlanItemFromAttributeMap(item map[string]types.AttributeValue) (*PracticePlanItem, error) {
+	trackID, err := getInt64Attr(item, "track_id")
+	if err != nil {
+		return nil, err
+	}
+	carID, err := getInt64Attr(item, "car_id")
+	if err != nil {
+		return nil, err
+	}
+	focus, err := getStringAttr(item, "focus")
+	if err != nil {
+		return nil, err
+	}
+	raceCount, err := getIntAttr(item, "race_count")
+	if err != nil {
+		return nil, err
+	}
+	value, err := getFloatAttr(item, "value")
+	if err != nil {
+		return nil, err
+	}
+	baseline, err := getFloatAttr(item, "baseline")
+	if err != nil {
+		return nil, err
+	}
+	return &PracticePlanItem{
+		TrackID:   trackID,
+		CarID:     carID,
+		Focus:     PracticeFocus(focus),
+		RaceCount: raceCount,
+		Value:     value,
+		Baseline:  baseline,
+	}, nil
+}
+
+// weeklyRecapModel represents the recap prepared for a driver's latest race week (driver#<id> / weeklyrecap)
+type weeklyRecapModel struct {
+	driverID    int64
+	weekStart   int64
+	generatedAt int64
+	fatigue     *FatigueFlag
+	highlights  []string
+}
+
+func weeklyRecapModelFromEntity(recap WeeklyRecap) weeklyRecapModel {
+	return weeklyRecapModel{
+		driverID:    recap.DriverID,
+		weekStart:   toUnixSeconds(recap.WeekStart),
+		generatedAt: toUnixSeconds(recap.GeneratedAt),
+		fatigue:     recap.Fatigue,
+		highlights:  recap.Highlights,
+	}
+}
+
+func (r weeklyRecapModel) toAttributeMap() map[string]types.AttributeValue {
+	m := map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(r.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.WeeklyRecap},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(r.driverID, 10)},
+		"week_start":     &types.AttributeValueMemberN{Value: strconv.FormatInt(r.weekStart, 10)},
+		"generated_at":   &types.AttributeValueMemberN{Value: strconv.FormatInt(r.generatedAt, 10)},
+	}
+	if r.fatigue != nil {
+		measures := make([]string, len(r.fatigue.Measures))
+		for i, measure := range r.fatigue.Measures {
+			measures[i] = string(measure)
+		}
+		m["fatigue_days"] = &types.AttributeValueMemberN{Value: strconv.Itoa(r.fatigue.MultiRaceDays)}
+		m["fatigue_measures"] = stringListAttr(measures)
+	}
+	if len(r.highlights) > 0 {
+		m["highlights"] = stringListAttr(r.highlights)
+	}
+	return m
+}
+
+func weeklyRecapFromAttributeMap(item map[string]types.AttributeValue) (*WeeklyRecap, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	weekStart, err := getInt64Attr(item, "week_start")
+	if err != nil {
+		return nil, err
+	}
+	generatedAt, err := getInt64Attr(item, "generated_at")
+	if err != nil {
+		return nil, err
+	}
+	recap := &WeeklyRecap{
+		DriverID:    driverID,
+		WeekStart:   time.Unix(weekStart, 0),
+		GeneratedAt: time.Unix(generatedAt, 0),
+	}
+	if fatigueDays, ok := getOptionalInt64Attr(item, "fatigue_days"); ok {
+		measures, err := getOptionalStringSliceAttr(item, "fatigue_measures")
+		if err != nil {
+			return nil, err
+		}
+		recap.Fatigue = &FatigueFlag{MultiRaceDays: int(fatigueDays)}
+		for _, measure := range measures {
+			recap.Fatigue.Measures = append(recap.Fatigue.Measures, FatigueMeasure(measure))
+		}
+	}
+	highlights, err := getOptionalStringSliceAttr(item, "highlights")
+	if err != nil {
+		return nil, err
+	}
+	recap.Highlights = highlights
+	return recap, nil
+}
+
+// dashboardLayoutModel represents how a driver has arranged their dashboard (driver#<id> / dashboardlayout)
+type dashboardLayoutModel struct {
+	driverID      int64
+	schemaVersion int
+	updatedAt     int64
+	widgets       []DashboardWidget
+}
+
+func dashboardLayoutModelFromEntity(layout DashboardLayout) dashboardLayoutModel {
+	return dashboardLayoutModel{
+		driverID:      layout.DriverID,
+		schemaVersion: layout.SchemaVersion,
+		updatedAt:     toUnixSeconds(layout.UpdatedAt),
+		widgets:       layout.Widgets,
+	}
+}
+
+func (d dashboardLayoutModel) toAttributeMap() map[string]types.AttributeValue {
+	widgetValues := make([]types.AttributeValue, len(d.widgets))
+	for i, widget := range d.widgets {
+		m := map[string]types.AttributeValue{
+			"widget_id": &types.AttributeValueMemberS{Value: widget.WidgetID},
+			"card":      &types.AttributeValueMemberS{Value: widget.Card},
+			"column":    &types.AttributeValueMemberN{Value: strconv.Itoa(widget.Column)},
+			"row":       &types.AttributeValueMemberN{Value: strconv.Itoa(widget.Row)},
+			"width":     &types.AttributeValueMemberN{Value: strconv.Itoa(widget.Width)},
+			"height":    &types.AttributeValueMemberN{Value: strconv.Itoa(widget.Height)},
+			"days":      &types.AttributeValueMemberN{Value: strconv.Itoa(widget.Days)},
+		}
+		if widget.Granularity != "" {
+			m["granularity"] = &types.AttributeValueMemberS{Value: widget.Granularity}
+		}
+		if len(widget.GroupBy) > 0 {
+			m["group_by"] = stringListAttr(widget.GroupBy)
+		}
+		if len(widget.SeriesIDs) > 0 {
+			m["series_ids"] = int64ListAttr(widget.SeriesIDs)
+		}
+		if len(widget.CarIDs) > 0 {
+			m["car_ids"] = int64ListAttr(widget.CarIDs)
+		}
+		if len(widget.TrackIDs) > 0 {
+			m["track_ids"] = int64ListAttr(widget.TrackIDs)
+		}
+		widgetValues[i] = &types.AttributeValueMemberM{Value: m}
+	}
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(d.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.DashboardLayout},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(d.driverID, 10)},
+		"schema_version": &types.AttributeValueMemberN{Value: strconv.Itoa(d.schemaVersion)},
+		"updated_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(d.updatedAt, 10)},
+		"widgets":        &types.AttributeValueMemberL{Value: widgetValues},
+	}
+}
+
+func dashboardLayoutFromAttributeMap(item map[string]types.AttributeValue) (*DashboardLayout, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	schemaVersion, err := getIntAttr(item, "schema_version")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := getInt64Attr(item, "updated_at")
+	if err != nil {
+		return nil, err
+	}
+	widgetsAttr, ok := item["widgets"].(*types.AttributeValueMemberL)
+	if !ok {
+		return nil, fmt.Errorf("missing or invalid 'widgets' attribute")
+	}
+
+	widgets := make([]DashboardWidget, len(widgetsAttr.Value))
+	for i, v := range widgetsAttr.Value {
+		widgetAttr, ok := v.(*types.AttributeValueMemberM)
+		if !ok {
+			return nil, fmt.Errorf("invalid 'widgets' element %d", i)
+		}
+		widget, err := dashboardWidgetFromAttributeMap(widgetAttr.Value)
+		if err != nil {
+			return nil, err
+		}
+		widgets[i] = *widget
+	}
+
+	return &DashboardLayout{
+		DriverID:      driverID,
+		SchemaVersion: schemaVersion,
+		Widgets:       widgets,
+		UpdatedAt:     time.Unix(updatedAt, 0),
+	}, nil
+}
+
+// seasonCardModel represents a shared season card (seasoncard#<token> / info)
+type seasonCardModel struct {
+	driverID    int64  `dynamo:"driver_id"`
+	token       string `dynamo:"token"`
+	snapshot    string `dynamo:"snapshot"`
+	createdAt   int64  `dynamo:"created_at"`
+	refreshedAt int64  `dynamo:"refreshed_at"`
+}
+
+func (m seasonCardModel) keys() (string, string) {
+	return keys.SeasonCard(m.token), keys.Info
+}
+
+func seasonCardModelFromEntity(card SeasonCard) seasonCardModel {
+	return seasonCardModel{
+		driverID:    card.DriverID,
+		token:       card.Token,
+		snapshot:    card.Snapshot,
+		createdAt:   card.CreatedAt.Unix(),
+		refreshedAt: card.RefreshedAt.Unix(),
+	}
+}
+
+func seasonCardFromAttributeMap(item map[string]types.AttributeValue) (*SeasonCard, error) {
+	m, err := seasonCardModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &SeasonCard{
+		DriverID:    m.driverID,
+		Token:       m.token,
+		Snapshot:    m.snapshot,
+		CreatedAt:   time.Unix(m.createdAt, 0),
+		RefreshedAt: time.Unix(m.refreshedAt, 0),
+	}, nil
+}
+
+// seasonCardTokenModel points a driver at the token their season card is shared with (driver#<id> / seasoncard)
+type seasonCardTokenModel struct {
+	driverID int64  `dynamo:"driver_id"`
+	token    string `dynamo:"token"`
+}
+
+func (m seasonCardTokenModel) keys() (string, string) {
+	return keys.Driver(m.driverID), keys.SeasonCardToken
+}
+
+// overlayModel represents a driver's stream overlay (overlay#<token> / info)
+type overlayModel struct {
+	driverID    int64  `dynamo:"driver_id"`
+	token       string `dynamo:"token"`
+	snapshot    string `dynamo:"snapshot"`
+	createdAt   int64  `dynamo:"created_at"`
+	refreshedAt int64  `dynamo:"refreshed_at"`
+}
+
+func (m overlayModel) keys() (string, string) {
+	return keys.Overlay(m.token), keys.Info
+}
+
+func overlayModelFromEntity(overlay Overlay) overlayModel {
+	return overlayModel{
+		driverID:    overlay.DriverID,
+		token:       overlay.Token,
+		snapshot:    overlay.Snapshot,
+		createdAt:   overlay.CreatedAt.Unix(),
+		refreshedAt: overlay.RefreshedAt.Unix(),
+	}
+}
+
+func overlayFromAttributeMap(item map[string]types.AttributeValue) (*Overlay, error) {
+	m, err := overlayModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &Overlay{
+		DriverID:    m.driverID,
+		Token:       m.token,
+		Snapshot:    m.snapshot,
+		CreatedAt:   time.Unix(m.createdAt, 0),
+		RefreshedAt: time.Unix(m.refreshedAt, 0),
+	}, nil
+}
+
+// overlayTokenModel points a driver at the token their overlay is served with (driver#<id> / overlay)
+type overlayTokenModel struct {
+	driverID int64  `dynamo:"driver_id"`
+	token    string `dynamo:"token"`
+}
+
+func (m overlayTokenModel) keys() (string, string) {
+	return keys.Driver(m.driverID), keys.OverlayToken
+}
+
+func dashboardWidgetFromAttributeMap(item map[string]types.AttributeValue) (*DashboardWidget, error) {
+	widgetID, err := getStringAttr(item, "widget_id")
+	if err != nil {
+		return nil, err
+	}
+	card, err := getStringAttr(item, "card")
+	if err != nil {
+		return nil, err
+	}
+	column, err := getIntAttr(item, "column")
+	if err != nil {
+		return nil, err
+	}
+	row, err := getIntAttr(item, "row")
+	if err != nil {
+		return nil, err
+	}
+	width, err := getIntAttr(item, "width")
+	if err != nil {
+		return nil, err
+	}
+	height, err := getIntAttr(item, "height")
+	if err != nil {
+		return nil, err
+	}
+	days, err := getIntAttr(item, "days")
+	if err != nil {
+		return nil, err
+	}
+	groupBy, err := getOptionalStringSliceAttr(item, "group_by")
+	if err != nil {
+		return nil, err
+	}
+	seriesIDs, err := getOptionalInt64SliceAttr(item, "series_ids")
+	if err != nil {
+		return nil, err
+	}
+	carIDs, err := getOptionalInt64SliceAttr(item, "car_ids")
+	if err != nil {
+		return nil, err
+	}
+	trackIDs, err := getOptionalInt64SliceAttr(item, "track_ids")
+	if err != nil {
+		return nil, err
+	}
+	widget := &DashboardWidget{
+		WidgetID:  widgetID,
+		Card:      card,
+		Column:    column,
+		Row:       row,
+		Width:     width,
+		Height:    height,
+		Days:      days,
+		GroupBy:   groupBy,
+		SeriesIDs: seriesIDs,
+		CarIDs:    carIDs,
+		TrackIDs:  trackIDs,
+	}
+	if granularity, ok := item["granularity"].(*types.AttributeValueMemberS); ok {
+		widget.Granularity = granularity.Value
+	}
+	return widget, nil
+}
+
+// ingestionCoverageModel represents the time ranges a driver's races have been ingested for
+// (driver#<id> / ingestion_coverage). Each range is stored as a [from, to] pair of unix seconds, version guards against
+// concurrent read-modify-write updates.
+type ingestionCoverageModel struct {
+	driverID int64
+	version  int64
+	ranges   Coverage
+}
+
+func (c ingestionCoverageModel) toAttributeMap() map[string]types.AttributeValue {
+	rangeValues := make([]types.AttributeValue, len(c.ranges))
+	for i, r := range c.ranges {
+		rangeValues[i] = &types.AttributeValueMemberL{Value: []types.AttributeValue{
+			&types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(r.From), 10)},
+			&types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(r.To), 10)},
+		}}
+	}
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(c.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.IngestionCoverage},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(c.driverID, 10)},
+		"version":        &types.AttributeValueMemberN{Value: strconv.FormatInt(c.version, 10)},
+		"ranges":         &types.AttributeValueMemberL{Value: rangeValues},
+	}
+}
+
+func ingestionCoverageModelFromAttributeMap(item map[string]types.AttributeValue) (*ingestionCoverageModel, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	version, err := getInt64Attr(item, "version")
+	if err != nil {
+		return nil, err
+	}
+	rangesAttr, ok := item["ranges"].(*types.AttributeValueMemberL)
+	if !ok {
+		return nil, fmt.Errorf("missing or invalid 'ranges' attribute")
+	}
+
+	ranges := make(Coverage, len(rangesAttr.Value))
+	for i, v := range rangesAttr.Value {
+		pair, ok := v.(*types.AttributeValueMemberL)
+		if !ok || len(pair.Value) != 2 {
+			return nil, fmt.Errorf("invalid 'ranges' element %d", i)
+		}
+		var bounds [2]int64
+		for j, bound := range pair.Value {
+			n, ok := bound.(*types.AttributeValueMemberN)
+			if !ok {
+				return nil, fmt.Errorf("invalid 'ranges' element %d", i)
+			}
+			bounds[j], err = strconv.ParseInt(n.Value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid 'ranges' element %d: %w", i, err)
+			}
+		}
+		ranges[i] = TimeRange{From: time.Unix(bounds[0], 0), To: time.Unix(bounds[1], 0)}
+	}
+
+	return &ingestionCoverageModel{
+		driverID: driverID,
+		version:  version,
+		ranges:   ranges,
+	}, nil
+}
+
+// journalDraftModel represents an unpublished journal entry for a race (driver#<id> / journaldraft#<race_id>)
+type journalDraftModel struct {
+	driverID    int64
+	raceID      int64
+	savedAt     int64
+	notes       string
+	tags        []string
+	replayVideo string
+	ttl         int64
+}
+
+func (j journalDraftModel) toAttributeMap() map[string]types.AttributeValue {
+	m := map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(j.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalDraft(j.raceID)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(j.driverID, 10)},
+		"race_id":        &types.AttributeValueMemberN{Value: strconv.FormatInt(j.raceID, 10)},
+		"saved_at":       &types.AttributeValueMemberN{Value: strconv.FormatInt(j.savedAt, 10)},
+		"notes":          &types.AttributeValueMemberS{Value: j.notes},
+		ttlAttributeName: ttlAttr(j.ttl),
+	}
+	if len(j.tags) > 0 {
+		tagValues := make([]types.AttributeValue, len(j.tags))
+		for i, t := range j.tags {
+			tagValues[i] = &types.AttributeValueMemberS{Value: t}
+		}
+		m["tags"] = &types.AttributeValueMemberL{Value: tagValues}
+	}
+	if j.replayVideo != "" {
+		m["replay_video"] = &types.AttributeValueMemberS{Value: j.replayVideo}
+	}
+	return m
+}
+
+func journalDraftModelFromEntity(draft RaceJournalDraft, savedAt time.Time) journalDraftModel {
+	return journalDraftModel{
+		driverID:    draft.DriverID,
+		raceID:      draft.RaceID,
+		savedAt:     toUnixSeconds(savedAt),
+		notes:       draft.Notes,
+		tags:        draft.Tags,
+		replayVideo: draft.ReplayVideo,
+		ttl:         toUnixSeconds(draft.ExpiresAt),
+	}
+}
+
+// unexpiredJournalDraft parses a draft item, treating one past its expiry as absent.
+func unexpiredJournalDraft(item map[string]types.AttributeValue, now time.Time) (*RaceJournalDraft, error) {
+	draft, err := journalDraftFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	if !draft.ExpiresAt.After(now) {
+		return nil, nil
+	}
+	return draft, nil
+}
+
+func journalDraftFromAttributeMap(item map[string]types.AttributeValue) (*RaceJournalDraft, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	raceID, err := getInt64Attr(item, "race_id")
+	if err != nil {
+		return nil, err
+	}
+	savedAt, err := getInt64Attr(item, "saved_at")
+	if err != nil {
+		return nil, err
+	}
+	notes, err := getStringAttr(item, "notes")
+	if err != nil {
+		return nil, err
+	}
+	tags, err := getOptionalStringSliceAttr(item, "tags")
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := getInt64Attr(item, ttlAttributeName)
+	if err != nil {
+		return nil, err
+	}
+
+	replayVideo := ""
+	if rv, ok := item["replay_video"].(*types.AttributeValueMemberS); ok {
+		replayVideo = rv.Value
+	}
+
+	return &RaceJournalDraft{
+		DriverID:    driverID,
+		RaceID:      raceID,
+		SavedAt:     time.Unix(savedAt, 0),
+		ExpiresAt:   time.Unix(ttl, 0),
+		Notes:       notes,
+		Tags:        tags,
+		ReplayVideo: replayVideo,
+	}, nil
+}
+
+// journalPromptModel represents a pending prompt to journal a race (driver#<id> / journalprompt#<race_id>)
+type journalPromptModel struct {
+	driverID       int64
+	raceID         int64
+	createdAt      int64
+	ttl            int64
+	planEvaluation *PlanEvaluation
+}
+
+func (j journalPromptModel) toAttributeMap() map[string]types.AttributeValue {
+	m := map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(j.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalPrompt(j.raceID)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(j.driverID, 10)},
+		"race_id":        &types.AttributeValueMemberN{Value: strconv.FormatInt(j.raceID, 10)},
+		"created_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(j.createdAt, 10)},
+		ttlAttributeName: ttlAttr(j.ttl),
+	}
+	if j.planEvaluation != nil {
+		m["plan_evaluation"] = planEvaluationAttr(*j.planEvaluation)
+	}
+	return m
+}
+
+func journalPromptModelFromEntity(prompt JournalPrompt, now time.Time) journalPromptModel {
+	return journalPromptModel{
+		driverID:       prompt.DriverID,
+		raceID:         prompt.RaceID,
+		createdAt:      toUnixSeconds(now),
+		ttl:            now.Add(journalPromptTTLDuration).Unix(),
+		planEvaluation: prompt.PlanEvaluation,
+	}
+}
+
+func journalPromptFromAttributeMap(item map[string]types.AttributeValue) (*JournalPrompt, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	raceID, err := getInt64Attr(item, "race_id")
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := getInt64Attr(item, "created_at")
+	if err != nil {
+		return nil, err
+	}
+	planEvaluation, err := getOptionalPlanEvaluationAttr(item, "plan_evaluation")
+	if err != nil {
+		return nil, err
+	}
+	return &JournalPrompt{
+		DriverID:       driverID,
+		RaceID:         raceID,
+		CreatedAt:      time.Unix(createdAt, 0),
+		PlanEvaluation: planEvaluation,
+	}, nil
+}
+
+// journalStreakModel represents a driver's journaling streak (driver#<id> / journalstreak)
+type journalStreakModel struct {
+	driverID      int64 `dynamo:"driver_id"`
+	currentWeeks  int   `dynamo:"current_weeks"`
+	longestWeeks  int   `dynamo:"longest_weeks"`
+	lastWeekStart int64 `dynamo:"last_week_start"`
+	updatedAt     int64 `dynamo:"updated_at"`
+}
+
+func (j journalStreakModel) keys() (string, string) {
+	return keys.Driver(j.driverID), keys.JournalStreak
+}
+
+func journalStreakModelFromEntity(streak JournalStreak, now time.Time) journalStreakModel {
+	return journalStreakModel{
+		driverID:      streak.DriverID,
+		currentWeeks:  streak.CurrentWeeks,
+		longestWeeks:  streak.LongestWeeks,
+		lastWeekStart: toUnixSeconds(streak.LastWeekStart),
+		updatedAt:     toUnixSeconds(now),
+	}
+}
+
+func journalStreakFromAttributeMap(item map[string]types.AttributeValue) (*JournalStreak, error) {
+	j, err := journalStreakModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &JournalStreak{
+		DriverID:      j.driverID,
+		CurrentWeeks:  j.currentWeeks,
+		LongestWeeks:  j.longestWeeks,
+		LastWeekStart: time.Unix(j.lastWeekStart, 0),
+		UpdatedAt:     time.Unix(j.updatedAt, 0),
+	}, nil
+}
+
+// racePlanModel represents a plan for an upcoming race (driver#<id> / raceplan#<week_start>#<series_id>#<track_id>)
+type racePlanModel struct {
+	driverID        int64  `dynamo:"driver_id"`
+	weekStart       int64  `dynamo:"week_start"`
+	seriesID        int64  `dynamo:"series_id"`
+	trackID         int64  `dynamo:"track_id"`
+	goals           string `dynamo:"goals"`
+	setupIntentions string `dynamo:"setup_intentions"`
+	raceID          int64  `dynamo:"race_id,omitempty"`
+	subsessionID    int64  `dynamo:"subsession_id,omitempty"`
+	linkedAt        int64  `dynamo:"linked_at,omitempty"`
+	createdAt       int64  `dynamo:"created_at"`
+	updatedAt       int64  `dynamo:"updated_at"`
+	evaluation      *PlanEvaluation
+}
+
+func (m racePlanModel) keys() (string, string) {
+	return keys.Driver(m.driverID), keys.RacePlan(m.weekStart, m.seriesID, m.trackID)
+}
+
+func racePlanModelFromEntity(plan RacePlan) racePlanModel {
+	m := racePlanModel{
+		driverID:        plan.DriverID,
+		weekStart:       plan.WeekStart.Unix(),
+		seriesID:        plan.SeriesID,
+		trackID:         plan.TrackID,
+		goals:           plan.Goals,
+		setupIntentions: plan.SetupIntentions,
+		raceID:          plan.RaceID,
+		subsessionID:    plan.SubsessionID,
+		createdAt:       toUnixSeconds(plan.CreatedAt),
+		updatedAt:       toUnixSeconds(plan.UpdatedAt),
+	}
+	if plan.RaceID != 0 {
+		m.linkedAt = toUnixSeconds(plan.LinkedAt)
+		m.evaluation = plan.Evaluation
+	}
+	return m
+}
+
+func (m racePlanModel) addComputedAttributes(item map[string]types.AttributeValue) {
+	if m.evaluation != nil {
+		item["evaluation"] = planEvaluationAttr(*m.evaluation)
+	}
+}
+
+func racePlanFromAttributeMap(item map[string]types.AttributeValue) (*RacePlan, error) {
+	m, err := racePlanModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	plan := &RacePlan{
+		DriverID:        m.driverID,
+		WeekStart:       time.Unix(m.weekStart, 0),
+		SeriesID:        m.seriesID,
+		TrackID:         m.trackID,
+		Goals:           m.goals,
+		SetupIntentions: m.setupIntentions,
+		RaceID:          m.raceID,
+		SubsessionID:    m.subsessionID,
+		CreatedAt:       time.Unix(m.createdAt, 0),
+		UpdatedAt:       time.Unix(m.updatedAt, 0),
+	}
+	if m.raceID != 0 {
+		plan.LinkedAt = time.Unix(m.linkedAt, 0)
+	}
+	plan.Evaluation, err = getOptionalPlanEvaluationAttr(item, "evaluation")
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// planEvaluationAttr encodes a plan evaluation, kept on both the plan and the linked race's journal prompt.
+func planEvaluationAttr(evaluation PlanEvaluation) types.AttributeValue {
+	targetValues := make([]types.AttributeValue, len(evaluation.Targets))
+	for i, target := range evaluation.Targets {
+		targetValues[i] = &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"kind":   &types.AttributeValueMemberS{Value: string(target.Kind)},
+			"target": &types.AttributeValueMemberN{Value: strconv.Itoa(target.Target)},
+			"actual": &types.AttributeValueMemberN{Value: strconv.Itoa(target.Actual)},
+			"met":    &types.AttributeValueMemberBOOL{Value: target.Met},
+		}}
+	}
+	return &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+		"targets":      &types.AttributeValueMemberL{Value: targetValues},
+		"evaluated_at": &types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(evaluation.EvaluatedAt), 10)},
+	}}
+}
+
+func getOptionalPlanEvaluationAttr(item map[string]types.AttributeValue, name string) (*PlanEvaluation, error) {
+	attr, ok := item[name]
+	if !ok {
+		return nil, nil
+	}
+	evaluationAttr, ok := attr.(*types.AttributeValueMemberM)
+	if !ok {
+		return nil, fmt.Errorf("invalid '%s' attribute", name)
+	}
+	evaluatedAt, err := getInt64Attr(evaluationAttr.Value, "evaluated_at")
+	if err != nil {
+		return nil, err
+	}
+	targetsAttr, ok := evaluationAttr.Value["targets"].(*types.AttributeValueMemberL)
+	if !ok {
+		return nil, fmt.Errorf("missing or invalid '%s.targets' attribute", name)
+	}
+
+	targets := make([]PlanTarget, len(targetsAttr.Value))
+	for i, v := range targetsAttr.Value {
+		targetAttr, ok := v.(*types.AttributeValueMemberM)
+		if !ok {
+			return nil, fmt.Errorf("invalid '%s.targets' element %d", name, i)
+		}
+		kind, err := getStringAttr(targetAttr.Value, "kind")
+		if err != nil {
+			return nil, err
+		}
+		target, err := getIntAttr(targetAttr.Value, "target")
+		if err != nil {
+			return nil, err
+		}
+		actual, err := getIntAttr(targetAttr.Value, "actual")
+		if err != nil {
+			return nil, err
+		}
+		met, err := getBoolAttr(targetAttr.Value, "met")
+		if err != nil {
+			return nil, err
+		}
+		targets[i] = PlanTarget{Kind: PlanTargetKind(kind), Target: target, Actual: actual, Met: met}
+	}
+	return &PlanEvaluation{
+		Targets:     targets,
+		EvaluatedAt: time.Unix(evaluatedAt, 0),
+	}, nil
+}
+
+// driverStandingModel represents a weekly division standing snapshot (driver#<id> / standing#<week_start>)
+type driverStandingModel struct {
+	driverID     int64
+	weekStart    int64
+	snapshotAt   int64
+	seasonID     int64
+	seriesID     int64
+	seriesName   string
+	carClassID   int64
+	raceWeekNum  int
+	division     int
+	divisionRank int
+	divisionSize int
+	points       int
+	weeksCounted int
+	starts       int
+	wins         int
+}
+
+func (d driverStandingModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(d.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverStanding(d.weekStart)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(d.driverID, 10)},
+		"week_start":     &types.AttributeValueMemberN{Value: strconv.FormatInt(d.weekStart, 10)},
+		"snapshot_at":    &types.AttributeValueMemberN{Value: strconv.FormatInt(d.snapshotAt, 10)},
+		"season_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(d.seasonID, 10)},
+		"series_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(d.seriesID, 10)},
+		"series_name":    &types.AttributeValueMemberS{Value: d.seriesName},
+		"car_class_id":   &types.AttributeValueMemberN{Value: strconv.FormatInt(d.carClassID, 10)},
+		"race_week_num":  &types.AttributeValueMemberN{Value: strconv.Itoa(d.raceWeekNum)},
+		"division":       &types.AttributeValueMemberN{Value: strconv.Itoa(d.division)},
+		"division_rank":  &types.AttributeValueMemberN{Value: strconv.Itoa(d.divisionRank)},
+		"division_size":  &types.AttributeValueMemberN{Value: strconv.Itoa(d.divisionSize)},
+		"points":         &types.AttributeValueMemberN{Value: strconv.Itoa(d.points)},
+		"weeks_counted":  &types.AttributeValueMemberN{Value: strconv.Itoa(d.weeksCounted)},
+		"starts":         &types.AttributeValueMemberN{Value: strconv.Itoa(d.starts)},
+		"wins":           &types.AttributeValueMemberN{Value: strconv.Itoa(d.wins)},
+	}
+}
+
+func driverStandingFromAttributeMap(item map[string]types.AttributeValue) (*DriverStanding, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	weekStart, err := getInt64Attr(item, "week_start")
+	if err != nil {
+		return nil, err
+	}
+	snapshotAt, err := getInt64Attr(item, "snapshot_at")
+	if err != nil {
+		return nil, err
+	}
+	seasonID, err := getInt64Attr(item, "season_id")
+	if err != nil {
+		return nil, err
+	}
+	seriesID, err := getInt64Attr(item, "series_id")
+	if err != nil {
+		return nil, err
+	}
+	seriesName, err := getStringAttr(item, "series_name")
+	if err != nil {
+		return nil, err
+	}
+	carClassID, err := getInt64Attr(item, "car_class_id")
+	if err != nil {
+		return nil, err
+	}
+	raceWeekNum, err := getIntAttr(item, "race_week_num")
+	if err != nil {
+		return nil, err
+	}
+	division, err := getIntAttr(item, "division")
+	if err != nil {
+		return nil, err
+	}
+	divisionRank, err := getIntAttr(item, "division_rank")
+	if err != nil {
+		return nil, err
+	}
+	divisionSize, err := getIntAttr(item, "division_size")
+	if err != nil {
+		return nil, err
+	}
+	points, err := getIntAttr(item, "points")
+	if err != nil {
+		return nil, err
+	}
+	weeksCounted, err := getIntAttr(item, "weeks_counted")
+	if err != nil {
+		return nil, err
+	}
+	starts, err := getIntAttr(item, "starts")
+	if err != nil {
+		return nil, err
+	}
+	wins, err := getIntAttr(item, "wins")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DriverStanding{
+		DriverID:     driverID,
+		WeekStart:    time.Unix(weekStart, 0),
+		SnapshotAt:   time.Unix(snapshotAt, 0),
+		SeasonID:     seasonID,
+		SeriesID:     seriesID,
+		SeriesName:   seriesName,
+		CarClassID:   carClassID,
+		RaceWeekNum:  raceWeekNum,
+		Division:     division,
+		DivisionRank: divisionRank,
+		DivisionSize: divisionSize,
+		Points:       points,
+		WeeksCounted: weeksCounted,
+		Starts:       starts,
+		Wins:         wins,
+	}, nil
+}
+
+// driverSettingsModel represents a driver's preferences (driver#<id> / settings)
+type driverSettingsModel struct {
+	driverID           int64 `dynamo:"driver_id"`
+	lapRetentionMonths int   `dynamo:"lap_retention_months"`
+	// the rest came after lap retention, older settings won't have them
+	summaryOnlyIngestion bool   `dynamo:"summary_only_ingestion,optional"`
+	lapBackfillPending   bool   `dynamo:"lap_backfill_pending,optional"`
+	leaderboardOptIn     bool   `dynamo:"leaderboard_opt_in,optional"`
+	benchmarkOptIn       bool   `dynamo:"benchmark_opt_in,optional"`
+	timezone             string `dynamo:"timezone,omitempty"`
+	locale               string `dynamo:"locale,omitempty"`
+}
+
+func (d driverSettingsModel) keys() (string, string) {
+	return keys.Driver(d.driverID), keys.DriverSettings
+}
+
+func driverSettingsFromAttributeMap(item map[string]types.AttributeValue) (*DriverSettings, error) {
+	d, err := driverSettingsModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &DriverSettings{
+		DriverID:             d.driverID,
+		LapRetentionMonths:   d.lapRetentionMonths,
+		SummaryOnlyIngestion: d.summaryOnlyIngestion,
+		LapBackfillPending:   d.lapBackfillPending,
+		LeaderboardOptIn:     d.leaderboardOptIn,
+		BenchmarkOptIn:       d.benchmarkOptIn,
+		Timezone:             d.timezone,
+		Locale:               d.locale,
+	}, nil
+}
+
+// sessionDriverLapSummaryModel represents a driver's compacted laps in a session (session#<id> / lapsummary#driver#<id>)
+type sessionDriverLapSummaryModel struct {
+	subsessionID     int64 `dynamo:"subsession_id"`
+	driverID         int64 `dynamo:"driver_id"`
+	lapCount         int   `dynamo:"lap_count"`
+	validLapCount    int   `dynamo:"valid_lap_count"`
+	incidentLapCount int   `dynamo:"incident_lap_count"`
+	bestLapTime      int   `dynamo:"best_lap_time"`
+	avgLapTime       int   `dynamo:"avg_lap_time"`
+	compactedAt      int64 `dynamo:"compacted_at"`
+}
+
+func (m sessionDriverLapSummaryModel) keys() (string, string) {
+	return keys.Session(m.subsessionID), keys.SessionDriverLapSummary(m.driverID)
+}
+
+func sessionDriverLapSummaryFromAttributeMap(item map[string]types.AttributeValue) (*SessionDriverLapSummary, error) {
+	m, err := sessionDriverLapSummaryModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionDriverLapSummary{
+		SubsessionID:     m.subsessionID,
+		DriverID:         m.driverID,
+		LapCount:         m.lapCount,
+		ValidLapCount:    m.validLapCount,
+		IncidentLapCount: m.incidentLapCount,
+		BestLapTime:      m.bestLapTime,
+		AvgLapTime:       m.avgLapTime,
+		CompactedAt:      time.Unix(m.compactedAt, 0),
+	}, nil
+}
+
+// sessionDriverLapModel represents a single lap driven by a driver in a session (session#<id> / laps#driver#<id>#lap#<num>)
+type sessionDriverLapModel struct {
+	subsessionID    int64    `dynamo:"subsession_id"`
+	driverID        int64    `dynamo:"driver_id"`
+	lapNumber       int      `dynamo:"lap_number"`
+	flags           int      `dynamo:"flags"`
+	incident        bool     `dynamo:"incident"`
+	sessionTime     int      `dynamo:"session_time"`
+	lapTime         int      `dynamo:"lap_time"`
+	personalBestLap bool     `dynamo:"personal_best_lap"`
+	lapEvents       []string `dynamo:"lap_events,omitempty"`
+	synthetic       bool     `dynamo:"synthetic,omitempty"`
+}
+
+func (l sessionDriverLapModel) keys() (string, string) {
+	return keys.Session(l.subsessionID), keys.SessionDriverLap(l.driverID, l.lapNumber)
+}
+
+func (l sessionDriverLapModel) addComputedAttributes(m map[string]types.AttributeValue) {
+	// iRacing reports -1 when it doesn't know when a lap was completed, those laps can't be placed in race order
+	if l.sessionTime >= 0 {
+		m[raceOrderAttributeName] = &types.AttributeValueMemberS{Value: keys.RaceOrder(l.sessionTime, l.driverID, l.lapNumber)}
+	}
+}
+
+func sessionDriverLapFromAttributeMap(item map[string]types.AttributeValue) (*SessionDriverLap, error) {
+	l, err := sessionDriverLapModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionDriverLap{
+		SubsessionID:    l.subsessionID,
+		DriverID:        l.driverID,
+		LapNumber:       l.lapNumber,
+		Flags:           l.flags,
+		Incident:        l.incident,
+		SessionTime:     l.sessionTime,
+		LapTime:         l.lapTime,
+		PersonalBestLap: l.personalBestLap,
+		LapEvents:       l.lapEvents,
+		Synthetic:       l.synthetic,
+	}, nil
+}
+
+// sessionIngestMarkerModel tracks how far PersistSessionData got writing a session too big for a single transaction
+// (session#<subsession_id> / ingest#driver#<driver_id>). chunkCount is the number of transactions of laps written ahead
+// of the final one holding the session record, and complete is set by that final transaction.
+type sessionIngestMarkerModel struct {
+	subsessionID  int64 `dynamo:"subsession_id"`
+	driverID      int64 `dynamo:"driver_id"`
+	chunkCount    int   `dynamo:"chunk_count"`
+	chunksWritten int   `dynamo:"chunks_written"`
+	complete      bool  `dynamo:"complete"`
+}
+
+func (m sessionIngestMarkerModel) keys() (string, string) {
+	return keys.Session(m.subsessionID), keys.SessionIngestMarker(m.driverID)
+}
+
+// sessionArchiveModel points at archived iRacing responses for a session (session#<subsession_id> /
+// archive#results or archive#laps#driver#<driver_id>)
+type sessionArchiveModel struct {
+	subsessionID int64
+	kind         string
+	driverID     int64
+	key          string
+	archivedAt   int64 // unix millis
+}
+
+func (m sessionArchiveModel) toAttributeMap() map[string]types.AttributeValue {
+	sortKey := keys.SessionResultsArchive
+	if m.kind == string(SessionArchiveLaps) {
+		sortKey = keys.SessionLapsArchive(m.driverID)
+	}
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Session(m.subsessionID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: sortKey},
+		"subsession_id":  &types.AttributeValueMemberN{Value: strconv.FormatInt(m.subsessionID, 10)},
+		"kind":           &types.AttributeValueMemberS{Value: m.kind},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(m.driverID, 10)},
+		"archive_key":    &types.AttributeValueMemberS{Value: m.key},
+		"archived_at":    &types.AttributeValueMemberN{Value: strconv.FormatInt(m.archivedAt, 10)},
+	}
+}
+
+func sessionArchiveModelFromEntity(archive SessionArchive) sessionArchiveModel {
+	return sessionArchiveModel{
+		subsessionID: archive.SubsessionID,
+		kind:         string(archive.Kind),
+		driverID:     archive.DriverID,
+		key:          archive.Key,
+		archivedAt:   archive.ArchivedAt.UnixMilli(),
+	}
+}
+
+func sessionArchiveFromAttributeMap(item map[string]types.AttributeValue) (*SessionArchive, error) {
+	subsessionID, err := getInt64Attr(item, "subsession_id")
+	if err != nil {
+		return nil, err
+	}
+	kind, err := getStringAttr(item, "kind")
+	if err != nil {
+		return nil, err
+	}
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	key, err := getStringAttr(item, "archive_key")
+	if err != nil {
+		return nil, err
+	}
+	archivedAt, err := getInt64Attr(item, "archived_at")
+	if err != nil {
+		return nil, err
+	}
+	return &SessionArchive{
+		SubsessionID: subsessionID,
+		Kind:         SessionArchiveKind(kind),
+		DriverID:     driverID,
+		Key:          key,
+		ArchivedAt:   time.UnixMilli(archivedAt),
+	}, nil
+}
+
+// sessionTotalsAttributes maps SessionTotals fields to the attributes they are accumulated in. Items holding totals
+// also carry a "subsession_ids" number set of the races already counted, making additions idempotent.
+// Attributes marked optional were added after rollups were first written, older items are read as having zero.
+var sessionTotalsAttributes = []struct {
+	name     string
+	field    func(t *SessionTotals) *int
+	optional bool
+}{
+	{"races", func(t *SessionTotals) *int { return &t.Races }, false},
+	{"wins", func(t *SessionTotals) *int { return &t.Wins }, false},
+	{"podiums", func(t *SessionTotals) *int { return &t.Podiums }, false},
+	{"top5s", func(t *SessionTotals) *int { return &t.Top5s }, false},
+	{"incidents", func(t *SessionTotals) *int { return &t.Incidents }, false},
+	{"irating_change", func(t *SessionTotals) *int { return &t.IRatingChange }, false},
+	{"laps_complete", func(t *SessionTotals) *int { return &t.LapsComplete }, false},
+	{"laps_lead", func(t *SessionTotals) *int { return &t.LapsLead }, false},
+	{"sub_level_change", func(t *SessionTotals) *int { return &t.SubLevelChange }, true},
+}
+
+func sessionTotalsFromAttributeMap(item map[string]types.AttributeValue) (SessionTotals, error) {
+	var totals SessionTotals
+	for _, attr := range sessionTotalsAttributes {
+		if _, ok := item[attr.name]; !ok && attr.optional {
+			continue
+		}
+		val, err := getIntAttr(item, attr.name)
+		if err != nil {
+			return SessionTotals{}, err
+		}
+		*attr.field(&totals) = val
+	}
+	return totals, nil
+}
+
+func driverRollupFromAttributeMap(item map[string]types.AttributeValue) (*DriverRollup, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	scope, err := getStringAttr(item, "scope")
+	if err != nil {
+		return nil, err
+	}
+	totals, err := sessionTotalsFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &DriverRollup{
+		DriverID:      driverID,
+		Scope:         scope,
+		SessionTotals: totals,
+	}, nil
+}
+
+func weeklyLeaderboardEntryFromAttributeMap(item map[string]types.AttributeValue) (*WeeklyLeaderboardEntry, error) {
+	weekStart, err := getInt64Attr(item, "week_start")
+	if err != nil {
+		return nil, err
+	}
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	totals, err := sessionTotalsFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &WeeklyLeaderboardEntry{
+		WeekStart:     time.Unix(weekStart, 0),
+		DriverID:      driverID,
+		SessionTotals: totals,
+	}, nil
+}
+
+// rankedLeaderboardModel describes a computed leaderboard (leaderboard#<board>#week#<timestamp> / board)
+type rankedLeaderboardModel struct {
+	board        string
+	weekStart    int64
+	computedAt   int64
+	totalEntries int
+}
+
+func (m rankedLeaderboardModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.RankedLeaderboard(m.board, m.weekStart)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.RankedLeaderboardBoard},
+		"board":          &types.AttributeValueMemberS{Value: m.board},
+		"week_start":     &types.AttributeValueMemberN{Value: strconv.FormatInt(m.weekStart, 10)},
+		"computed_at":    &types.AttributeValueMemberN{Value: strconv.FormatInt(m.computedAt, 10)},
+		"total_entries":  &types.AttributeValueMemberN{Value: strconv.Itoa(m.totalEntries)},
+	}
+}
+
+func rankedLeaderboardModelFromEntity(board RankedLeaderboard) rankedLeaderboardModel {
+	return rankedLeaderboardModel{
+		board:        board.Board,
+		weekStart:    toUnixSeconds(board.WeekStart),
+		computedAt:   toUnixSeconds(board.ComputedAt),
+		totalEntries: board.TotalEntries,
+	}
+}
+
+func rankedLeaderboardFromAttributeMap(item map[string]types.AttributeValue) (*RankedLeaderboard, error) {
+	board, err := getStringAttr(item, "board")
+	if err != nil {
+		return nil, err
+	}
+	weekStart, err := getInt64Attr(item, "week_start")
+	if err != nil {
+		return nil, err
+	}
+	computedAt, err := getInt64Attr(item, "computed_at")
+	if err != nil {
+		return nil, err
+	}
+	totalEntries, err := getIntAttr(item, "total_entries")
+	if err != nil {
+		return nil, err
+	}
+	return &RankedLeaderboard{
+		Board:        board,
+		WeekStart:    time.Unix(weekStart, 0),
+		ComputedAt:   time.Unix(computedAt, 0),
+		TotalEntries: totalEntries,
+	}, nil
+}
+
+// leaderboardRankingModel is a place on a computed leaderboard (leaderboard#<board>#week#<timestamp> / rank#<rank>)
+type leaderboardRankingModel struct {
+	board      string
+	weekStart  int64
+	rank       int
+	driverID   int64
+	driverName string
+	clubID     int
+	races      int
+	value      int
+}
+
+func (m leaderboardRankingModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.RankedLeaderboard(m.board, m.weekStart)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.LeaderboardRank(m.rank)},
+		"rank":           &types.AttributeValueMemberN{Value: strconv.Itoa(m.rank)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(m.driverID, 10)},
+		"driver_name":    &types.AttributeValueMemberS{Value: m.driverName},
+		"club_id":        &types.AttributeValueMemberN{Value: strconv.Itoa(m.clubID)},
+		"races":          &types.AttributeValueMemberN{Value: strconv.Itoa(m.races)},
+		"value":          &types.AttributeValueMemberN{Value: strconv.Itoa(m.value)},
+	}
+}
+
+func leaderboardRankingModelFromEntity(board string, weekStart time.Time, ranking LeaderboardRanking) leaderboardRankingModel {
+	return leaderboardRankingModel{
+		board:      board,
+		weekStart:  toUnixSeconds(weekStart),
+		rank:       ranking.Rank,
+		driverID:   ranking.DriverID,
+		driverName: ranking.DriverName,
+		clubID:     ranking.ClubID,
+		races:      ranking.Races,
+		value:      ranking.Value,
+	}
+}
+
+func leaderboardRankingFromAttributeMap(item map[string]types.AttributeValue) (*LeaderboardRanking, error) {
+	rank, err := getIntAttr(item, "rank")
+	if err != nil {
+		return nil, err
+	}
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	driverName, err := getStringAttr(item, "driver_name")
+	if err != nil {
+		return nil, err
+	}
+	races, err := getIntAttr(item, "races")
+	if err != nil {
+		return nil, err
+	}
+	value, err := getIntAttr(item, "value")
+	if err != nil {
+		return nil, err
+	}
+	// club came after rankings, boards computed before it won't have one until they are next computed
+	clubID, _ := getOptionalInt64Attr(item, "club_id")
+	return &LeaderboardRanking{
+		Rank:       rank,
+		DriverID:   driverID,
+		DriverName: driverName,
+		ClubID:     int(clubID),
+		Races:      races,
+		Value:      value,
+	}, nil
+}
+
+// regionWeeklyAggregateModel is a club's combined totals for a race week (region#<club_id> / week#<timestamp>)
+type regionWeeklyAggregateModel struct {
+	clubID    int
+	weekStart int64
+	drivers   int
+	totals    SessionTotals
+}
+
+func (m regionWeeklyAggregateModel) toAttributeMap() map[string]types.AttributeValue {
+	item := map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Region(m.clubID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.RegionWeek(m.weekStart)},
+		"club_id":        &types.AttributeValueMemberN{Value: strconv.Itoa(m.clubID)},
+		"week_start":     &types.AttributeValueMemberN{Value: strconv.FormatInt(m.weekStart, 10)},
+		"drivers":        &types.AttributeValueMemberN{Value: strconv.Itoa(m.drivers)},
+	}
+	for _, attr := range sessionTotalsAttributes {
+		item[attr.name] = &types.AttributeValueMemberN{Value: strconv.Itoa(*attr.field(&m.totals))}
+	}
+	return item
+}
+
+func regionWeeklyAggregateModelFromEntity(aggregate RegionWeeklyAggregate) regionWeeklyAggregateModel {
+	return regionWeeklyAggregateModel{
+		clubID:    aggregate.ClubID,
+		weekStart: toUnixSeconds(aggregate.WeekStart),
+		drivers:   aggregate.Drivers,
+		totals:    aggregate.SessionTotals,
+	}
+}
+
+func regionWeeklyAggregateFromAttributeMap(item map[string]types.AttributeValue) (*RegionWeeklyAggregate, error) {
+	clubID, err := getIntAttr(item, "club_id")
+	if err != nil {
+		return nil, err
+	}
+	weekStart, err := getInt64Attr(item, "week_start")
+	if err != nil {
+		return nil, err
+	}
+	drivers, err := getIntAttr(item, "drivers")
+	if err != nil {
+		return nil, err
+	}
+	totals, err := sessionTotalsFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &RegionWeeklyAggregate{
+		ClubID:        clubID,
+		WeekStart:     time.Unix(weekStart, 0),
+		Drivers:       drivers,
+		SessionTotals: totals,
+	}, nil
+}
+
+// benchmarkTableModel is the distribution of a series and track for an iRating band
+// (benchmark#series#<series_id>#track#<track_id> / irating#<band>)
+type benchmarkTableModel struct {
+	seriesID           int64
+	trackID            int64
+	iRatingBand        int
+	computedAt         int64
+	drivers            int
+	incidents          BenchmarkPercentiles
+	offBest            *BenchmarkPercentiles
+	consistencyDrivers int
+	ttl                int64
+}
+
+// benchmarkPercentileAttributes are the attribute suffixes each set of percentiles is stored under
+var benchmarkPercentileAttributes = []struct {
+	suffix string
+	field  func(p *BenchmarkPercentiles) *float64
+}{
+	{"p10", func(p *BenchmarkPercentiles) *float64 { return &p.P10 }},
+	{"p25", func(p *BenchmarkPercentiles) *float64 { return &p.P25 }},
+	{"p50", func(p *BenchmarkPercentiles) *float64 { return &p.P50 }},
+	{"p75", func(p *BenchmarkPercentiles) *float64 { return &p.P75 }},
+	{"p90", func(p *BenchmarkPercentiles) *float64 { return &p.P90 }},
+}
+
+func (m benchmarkTableModel) toAttributeMap() map[string]types.AttributeValue {
+	item := map[string]types.AttributeValue{
+		partitionKeyName:      &types.AttributeValueMemberS{Value: keys.Benchmark(m.seriesID, m.trackID)},
+		sortKeyName:           &types.AttributeValueMemberS{Value: keys.BenchmarkBand(m.iRatingBand)},
+		"series_id":           &types.AttributeValueMemberN{Value: strconv.FormatInt(m.seriesID, 10)},
+		"track_id":            &types.AttributeValueMemberN{Value: strconv.FormatInt(m.trackID, 10)},
+		"irating_band":        &types.AttributeValueMemberN{Value: strconv.Itoa(m.iRatingBand)},
+		"computed_at":         &types.AttributeValueMemberN{Value: strconv.FormatInt(m.computedAt, 10)},
+		"drivers":             &types.AttributeValueMemberN{Value: strconv.Itoa(m.drivers)},
+		"consistency_drivers": &types.AttributeValueMemberN{Value: strconv.Itoa(m.consistencyDrivers)},
+		ttlAttributeName:      ttlAttr(m.ttl),
+	}
+	for _, attr := range benchmarkPercentileAttributes {
+		item["incidents_"+attr.suffix] = &types.AttributeValueMemberN{Value: strconv.FormatFloat(*attr.field(&m.incidents), 'f', -1, 64)}
+		if m.offBest != nil {
+			item["off_best_"+attr.suffix] = &types.AttributeValueMemberN{Value: strconv.FormatFloat(*attr.field(m.offBest), 'f', -1, 64)}
+		}
+	}
+	return item
+}
+
+func benchmarkTableModelFromEntity(table BenchmarkTable, expiresAt time.Time) benchmarkTableModel {
+	return benchmarkTableModel{
+		seriesID:           table.SeriesID,
+		trackID:            table.TrackID,
+		iRatingBand:        table.IRatingBand,
+		computedAt:         toUnixSeconds(table.ComputedAt),
+		drivers:            table.Drivers,
+		incidents:          table.IncidentsPerRace,
+		offBest:            table.OffBestPercent,
+		consistencyDrivers: table.ConsistencyDrivers,
+		ttl:                expiresAt.Unix(),
+	}
+}
+
+func benchmarkPercentilesFromAttributeMap(item map[string]types.AttributeValue, prefix string) (BenchmarkPercentiles, error) {
+	var percentiles BenchmarkPercentiles
+	for _, attr := range benchmarkPercentileAttributes {
+		value, err := getFloatAttr(item, prefix+attr.suffix)
+		if err != nil {
+			return BenchmarkPercentiles{}, err
+		}
+		*attr.field(&percentiles) = value
+	}
+	return percentiles, nil
+}
+
+func benchmarkTableFromAttributeMap(item map[string]types.AttributeValue) (*BenchmarkTable, error) {
+	seriesID, err := getInt64Attr(item, "series_id")
+	if err != nil {
+		return nil, err
+	}
+	trackID, err := getInt64Attr(item, "track_id")
+	if err != nil {
+		return nil, err
+	}
+	iRatingBand, err := getIntAttr(item, "irating_band")
+	if err != nil {
+		return nil, err
+	}
+	computedAt, err := getInt64Attr(item, "computed_at")
+	if err != nil {
+		return nil, err
+	}
+	drivers, err := getIntAttr(item, "drivers")
+	if err != nil {
+		return nil, err
+	}
+	consistencyDrivers, err := getIntAttr(item, "consistency_drivers")
+	if err != nil {
+		return nil, err
+	}
+	incidents, err := benchmarkPercentilesFromAttributeMap(item, "incidents_")
+	if err != nil {
+		return nil, err
+	}
+	table := &BenchmarkTable{
+		SeriesID:           seriesID,
+		TrackID:            trackID,
+		IRatingBand:        iRatingBand,
+		ComputedAt:         time.Unix(computedAt, 0),
+		Drivers:            drivers,
+		IncidentsPerRace:   incidents,
+		ConsistencyDrivers: consistencyDrivers,
+	}
+	if _, ok := item["off_best_p50"]; ok {
+		offBest, err := benchmarkPercentilesFromAttributeMap(item, "off_best_")
+		if err != nil {
+			return nil, err
+		}
+		table.OffBestPercent = &offBest
+	}
+	return table, nil
+}
+
+// driverMilestoneModel represents a milestone (driver#<id> / milestone#<milestone>)
+type driverMilestoneModel struct {
+	driverID     int64
+	milestone    string
+	achievedAt   int64
+	subsessionID int64
+}
+
+func (d driverMilestoneModel) toAttributeMap() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(d.driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverMilestone(d.milestone)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(d.driverID, 10)},
+		"milestone":      &types.AttributeValueMemberS{Value: d.milestone},
+		"achieved_at":    &types.AttributeValueMemberN{Value: strconv.FormatInt(d.achievedAt, 10)},
+		"subsession_id":  &types.AttributeValueMemberN{Value: strconv.FormatInt(d.subsessionID, 10)},
+	}
+}
+
+func driverMilestoneModelFromEntity(milestone DriverMilestone) driverMilestoneModel {
+	return driverMilestoneModel{
+		driverID:     milestone.DriverID,
+		milestone:    milestone.Milestone,
+		achievedAt:   toUnixSeconds(milestone.AchievedAt),
+		subsessionID: milestone.SubsessionID,
+	}
+}
+
+func driverMilestoneFromAttributeMap(item map[string]types.AttributeValue) (*DriverMilestone, error) {
+	driverID, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	milestone, err := getStringAttr(item, "milestone")
+	if err != nil {
+		return nil, err
+	}
+	achievedAt, err := getInt64Attr(item, "achieved_at")
+	if err != nil {
+		return nil, err
+	}
+	subsessionID, err := getInt64Attr(item, "subsession_id")
+	if err != nil {
+		return nil, err
+	}
+	return &DriverMilestone{
+		DriverID:     driverID,
+		Milestone:    milestone,
+		AchievedAt:   time.Unix(achievedAt, 0),
+		SubsessionID: subsessionID,
+	}, nil
+}
+
+// DriverSessionFromItem decodes a raw table item, such as a stream record image, into a DriverSession. ok is false when
+// the item is something other than a driver session.
+func DriverSessionFromItem(item map[string]types.AttributeValue) (session *DriverSession, ok bool, err error) {
+	pk, _ := getStringAttr(item, partitionKeyName)
+	sk, _ := getStringAttr(item, sortKeyName)
+	driverID, pkErr := keys.ParseDriver(pk)
+	if _, skErr := keys.ParseDriverSession(sk); pkErr != nil || skErr != nil {
+		return nil, false, nil
+	}
+	session, err = driverSessionFromAttributeMap(driverID, item)
+	if err != nil {
+		return nil, true, err
+	}
+	return session, true, nil
+}
+
+func stringListAttr(values []string) *types.AttributeValueMemberL {
+	list := make([]types.AttributeValue, len(values))
+	for i, v := range values {
+		list[i] = &types.AttributeValueMemberS{Value: v}
+	}
+	return &types.AttributeValueMemberL{Value: list}
+}
+
+func getInt64Attr(item map[string]types.AttributeValue, name string) (int64, error) {
+	attr, ok := item[name].(*types.AttributeValueMemberN)
+	if !ok {
+		return 0, fmt.Errorf("missing or invalid '%s' attribute", name)
+	}
+	val, err := strconv.ParseInt(attr.Value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid '%s' value: %w", name, err)
+	}
+	return val, nil
 }
 
 func getOptionalInt64Attr(item map[string]types.AttributeValue, name string) (int64, bool) {
@@ -516,6 +4401,82 @@ func getOptionalStringSliceAttr(item map[string]types.AttributeValue, name strin
 	return result, nil
 }
 
+func intListAttr(values []int) *types.AttributeValueMemberL {
+	list := make([]types.AttributeValue, len(values))
+	for i, v := range values {
+		list[i] = &types.AttributeValueMemberN{Value: strconv.Itoa(v)}
+	}
+	return &types.AttributeValueMemberL{Value: list}
+}
+
+func getOptionalIntSliceAttr(item map[string]types.AttributeValue, name string) ([]int, error) {
+	attr, ok := item[name]
+	if !ok || attr == nil {
+		return nil, nil
+	}
+	listAttr, ok := attr.(*types.AttributeValueMemberL)
+	if !ok {
+		return nil, fmt.Errorf("'%s' attribute is not a list", name)
+	}
+	result := make([]int, 0, len(listAttr.Value))
+	for i, elem := range listAttr.Value {
+		numElem, ok := elem.(*types.AttributeValueMemberN)
+		if !ok {
+			return nil, fmt.Errorf("'%s' element at index %d is not a number", name, i)
+		}
+		v, err := strconv.Atoi(numElem.Value)
+		if err != nil {
+			return nil, fmt.Errorf("parsing '%s' element at index %d: %w", name, i, err)
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func int64ListAttr(values []int64) *types.AttributeValueMemberL {
+	list := make([]types.AttributeValue, len(values))
+	for i, v := range values {
+		list[i] = &types.AttributeValueMemberN{Value: strconv.FormatInt(v, 10)}
+	}
+	return &types.AttributeValueMemberL{Value: list}
+}
+
+func getOptionalInt64SliceAttr(item map[string]types.AttributeValue, name string) ([]int64, error) {
+	attr, ok := item[name]
+	if !ok || attr == nil {
+		return nil, nil
+	}
+	listAttr, ok := attr.(*types.AttributeValueMemberL)
+	if !ok {
+		return nil, fmt.Errorf("'%s' attribute is not a list", name)
+	}
+	result := make([]int64, 0, len(listAttr.Value))
+	for i, elem := range listAttr.Value {
+		numElem, ok := elem.(*types.AttributeValueMemberN)
+		if !ok {
+			return nil, fmt.Errorf("'%s' element at index %d is not a number", name, i)
+		}
+		v, err := strconv.ParseInt(numElem.Value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing '%s' element at index %d: %w", name, i, err)
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func getOptionalStringSetAttr(item map[string]types.AttributeValue, name string) ([]string, error) {
+	attr, ok := item[name]
+	if !ok || attr == nil {
+		return nil, nil
+	}
+	setAttr, ok := attr.(*types.AttributeValueMemberSS)
+	if !ok {
+		return nil, fmt.Errorf("'%s' attribute is not a string set", name)
+	}
+	return setAttr.Value, nil
+}
+
 // toUnixSeconds truncates a time to second precision and returns the Unix timestamp.
 // This ensures consistent key generation regardless of sub-second precision in the input.
 func toUnixSeconds(t time.Time) int64 {
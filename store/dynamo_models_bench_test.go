@@ -0,0 +1,150 @@
+package store
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// benchSessionCount and benchLapCount size the datasets the model benchmarks marshal, a heavy user's several years of
+// races and the laps of everyone in them
+const (
+	benchSessionCount = 10_000
+	benchLapCount     = 500_000
+)
+
+// benchLapsPerSession is roughly a 25 car field running a 20 lap race
+const benchLapsPerSession = 500
+
+func generateBenchSessions(n int) []DriverSession {
+	rng := rand.New(rand.NewPCG(1, 2))
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	sessions := make([]DriverSession, n)
+	for i := range sessions {
+		positions := make([]int, 21)
+		for lap := range positions {
+			positions[lap] = rng.IntN(25)
+		}
+		sessions[i] = DriverSession{
+			DriverID:              1100750,
+			SubsessionID:          int64(50_000_000 + i),
+			TrackID:               int64(rng.IntN(120) + 1),
+			CarID:                 int64(rng.IntN(40) + 1),
+			SeriesID:              int64(rng.IntN(60) + 1),
+			SeriesName:            "Benchmark Series",
+			StartTime:             start.Add(time.Duration(i) * 3 * time.Hour),
+			StartPosition:         rng.IntN(25),
+			StartPositionInClass:  rng.IntN(25),
+			FinishPosition:        rng.IntN(25),
+			FinishPositionInClass: rng.IntN(25),
+			Incidents:             rng.IntN(17),
+			OldCPI:                rng.Float64() * 100,
+			NewCPI:                rng.Float64() * 100,
+			OldIRating:            1500 + rng.IntN(500),
+			NewIRating:            1500 + rng.IntN(500),
+			OldLicenseLevel:       16,
+			NewLicenseLevel:       16,
+			OldSubLevel:           300 + rng.IntN(100),
+			NewSubLevel:           300 + rng.IntN(100),
+			ReasonOut:             "Running",
+			ReasonOutCode:         ReasonOutFinished,
+			StrengthOfField:       1000 + rng.IntN(3000),
+			CornersPerLap:         10 + rng.IntN(10),
+			LicenseCategoryID:     5,
+			LapsComplete:          20,
+			LapsLead:              rng.IntN(5),
+			CarClassID:            int64(rng.IntN(10) + 1),
+			SeasonID:              int64(4000 + rng.IntN(100)),
+			SeasonYear:            2020 + i/2920,
+			SeasonQuarter:         1 + rng.IntN(4),
+			RaceWeekNum:           rng.IntN(12),
+			ChampPoints:           rng.IntN(150),
+			Positions:             positions,
+		}
+	}
+	return sessions
+}
+
+func generateBenchLaps(n int) []SessionDriverLap {
+	rng := rand.New(rand.NewPCG(3, 4))
+	laps := make([]SessionDriverLap, n)
+	for i := range laps {
+		lap := SessionDriverLap{
+			SubsessionID:    int64(50_000_000 + i/benchLapsPerSession),
+			DriverID:        int64(100_000 + i%benchLapsPerSession/20),
+			LapNumber:       i%20 + 1,
+			Flags:           rng.IntN(4),
+			Incident:        rng.IntN(10) == 0,
+			SessionTime:     (i%20 + 1) * 900_000,
+			LapTime:         850_000 + rng.IntN(100_000),
+			PersonalBestLap: rng.IntN(20) == 0,
+		}
+		if lap.Incident {
+			lap.LapEvents = []string{"off track"}
+		}
+		laps[i] = lap
+	}
+	return laps
+}
+
+func BenchmarkDriverSessionModel_ToAttributeMap(b *testing.B) {
+	sessions := generateBenchSessions(benchSessionCount)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		for _, session := range sessions {
+			driverSessionModelFromEntity(session).toAttributeMap()
+		}
+	}
+}
+
+func BenchmarkDriverSessionFromAttributeMap(b *testing.B) {
+	sessions := generateBenchSessions(benchSessionCount)
+	items := make([]map[string]types.AttributeValue, len(sessions))
+	for i, session := range sessions {
+		items[i] = driverSessionModelFromEntity(session).toAttributeMap()
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		for _, item := range items {
+			if _, err := driverSessionFromAttributeMap(1100750, item); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkSessionDriverLapModel_ToAttributeMap(b *testing.B) {
+	laps := generateBenchLaps(benchLapCount)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		for _, lap := range laps {
+			sessionDriverLapModelFromEntity(lap).toAttributeMap()
+		}
+	}
+}
+
+func BenchmarkSessionDriverLapFromAttributeMap(b *testing.B) {
+	laps := generateBenchLaps(benchLapCount)
+	items := make([]map[string]types.AttributeValue, len(laps))
+	for i, lap := range laps {
+		items[i] = sessionDriverLapModelFromEntity(lap).toAttributeMap()
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		for _, item := range items {
+			if _, err := sessionDriverLapFromAttributeMap(item); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
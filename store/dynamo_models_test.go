@@ -0,0 +1,650 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The models below have generated marshaling code (see dynamo_models_gen.go), these pin down the items they read and
+// write so the generated code stays equivalent to the handwritten code it replaced, and records already in the table
+// keep reading the same.
+
+func attrS(v string) types.AttributeValue {
+	return &types.AttributeValueMemberS{Value: v}
+}
+
+func attrN(v string) types.AttributeValue {
+	return &types.AttributeValueMemberN{Value: v}
+}
+
+func attrBool(v bool) types.AttributeValue {
+	return &types.AttributeValueMemberBOOL{Value: v}
+}
+
+func withoutAttrs(item map[string]types.AttributeValue, names ...string) map[string]types.AttributeValue {
+	ret := make(map[string]types.AttributeValue, len(item))
+	for k, v := range item {
+		ret[k] = v
+	}
+	for _, name := range names {
+		delete(ret, name)
+	}
+	return ret
+}
+
+func driverSessionTestItem() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName:           attrS("driver#1100750"),
+		sortKeyName:                attrS("session#1700000000"),
+		"subsession_id":            attrN("70000001"),
+		"track_id":                 attrN("18"),
+		"car_id":                   attrN("67"),
+		"series_id":                attrN("285"),
+		"series_name":              attrS("Global Mazda MX-5 Fanatec Cup"),
+		"start_time":               attrN("1700000000"),
+		"start_position":           attrN("4"),
+		"start_position_in_class":  attrN("3"),
+		"finish_position":          attrN("2"),
+		"finish_position_in_class": attrN("1"),
+		"incidents":                attrN("6"),
+		"old_cpi":                  attrN("42.5"),
+		"new_cpi":                  attrN("40.25"),
+		"old_irating":              attrN("1500"),
+		"new_irating":              attrN("1532"),
+		"old_license_level":        attrN("14"),
+		"new_license_level":        attrN("15"),
+		"old_sub_level":            attrN("350"),
+		"new_sub_level":            attrN("362"),
+		"reason_out":               attrS("Running"),
+		"reason_out_code":          attrS("finished"),
+		"strength_of_field":        attrN("1650"),
+		"traffic_cost":             attrN("3"),
+		"corners_per_lap":          attrN("12"),
+		"license_category_id":      attrN("5"),
+		"laps_complete":            attrN("20"),
+		"laps_lead":                attrN("4"),
+		"car_class_id":             attrN("74"),
+		"season_id":                attrN("4500"),
+		"season_year":              attrN("2023"),
+		"season_quarter":           attrN("4"),
+		"race_week_num":            attrN("9"),
+		"champ_points":             attrN("88"),
+		"drop_race":                attrBool(true),
+		"laps_skipped":             attrBool(true),
+		"lap_gaps":                 attrN("2"),
+		"positions":                &types.AttributeValueMemberL{Value: []types.AttributeValue{attrN("4"), attrN("3"), attrN("2")}},
+		"squad_events":             &types.AttributeValueMemberSS{Value: []string{"event-1", "event-2"}},
+	}
+}
+
+func driverSessionTestEntity() DriverSession {
+	trafficCost := 3
+	return DriverSession{
+		DriverID:              1100750,
+		SubsessionID:          70000001,
+		TrackID:               18,
+		CarID:                 67,
+		SeriesID:              285,
+		SeriesName:            "Global Mazda MX-5 Fanatec Cup",
+		StartTime:             time.Unix(1700000000, 0),
+		StartPosition:         4,
+		StartPositionInClass:  3,
+		FinishPosition:        2,
+		FinishPositionInClass: 1,
+		Incidents:             6,
+		OldCPI:                42.5,
+		NewCPI:                40.25,
+		OldIRating:            1500,
+		NewIRating:            1532,
+		OldLicenseLevel:       14,
+		NewLicenseLevel:       15,
+		OldSubLevel:           350,
+		NewSubLevel:           362,
+		ReasonOut:             "Running",
+		ReasonOutCode:         ReasonOutFinished,
+		StrengthOfField:       1650,
+		TrafficCost:           &trafficCost,
+		CornersPerLap:         12,
+		LicenseCategoryID:     5,
+		LapsComplete:          20,
+		LapsLead:              4,
+		CarClassID:            74,
+		SeasonID:              4500,
+		SeasonYear:            2023,
+		SeasonQuarter:         4,
+		RaceWeekNum:           9,
+		ChampPoints:           88,
+		DropRace:              true,
+		LapsSkipped:           true,
+		LapGaps:               2,
+		Positions:             []int{4, 3, 2},
+		SquadEvents:           []string{"event-1", "event-2"},
+	}
+}
+
+func TestDriverSessionModel_ToAttributeMap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		session  DriverSession
+		expected map[string]types.AttributeValue
+	}{
+		{
+			name:     "everything set",
+			session:  driverSessionTestEntity(),
+			expected: driverSessionTestItem(),
+		},
+		{
+			name: "optional attributes left off",
+			session: func() DriverSession {
+				s := driverSessionTestEntity()
+				s.ReasonOutCode = ""
+				s.TrafficCost = nil
+				s.LicenseCategoryID = 0
+				s.LapsSkipped = false
+				s.LapGaps = 0
+				s.Positions = nil
+				s.SquadEvents = nil
+				return s
+			}(),
+			expected: withoutAttrs(driverSessionTestItem(), "reason_out_code", "traffic_cost", "license_category_id",
+				"laps_skipped", "lap_gaps", "positions", "squad_events"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			model := driverSessionModelFromEntity(tc.session)
+			model.squadEvents = tc.session.SquadEvents
+			assert.Equal(t, tc.expected, model.toAttributeMap())
+		})
+	}
+}
+
+func TestDriverSessionFromAttributeMap(t *testing.T) {
+	testCases := []struct {
+		name string
+		item map[string]types.AttributeValue
+
+		expected    *DriverSession
+		expectedErr string
+	}{
+		{
+			name:     "everything set",
+			item:     driverSessionTestItem(),
+			expected: func() *DriverSession { s := driverSessionTestEntity(); return &s }(),
+		},
+		{
+			name: "record from before the later attributes",
+			item: withoutAttrs(driverSessionTestItem(), "reason_out_code", "strength_of_field", "traffic_cost",
+				"corners_per_lap", "license_category_id", "laps_complete", "laps_lead", "car_class_id", "season_id",
+				"season_year", "season_quarter", "race_week_num", "champ_points", "drop_race", "laps_skipped", "lap_gaps",
+				"positions", "squad_events"),
+			expected: &DriverSession{
+				DriverID:              1100750,
+				SubsessionID:          70000001,
+				TrackID:               18,
+				CarID:                 67,
+				SeriesID:              285,
+				SeriesName:            "Global Mazda MX-5 Fanatec Cup",
+				StartTime:             time.Unix(1700000000, 0),
+				StartPosition:         4,
+				StartPositionInClass:  3,
+				FinishPosition:        2,
+				FinishPositionInClass: 1,
+				Incidents:             6,
+				OldCPI:                42.5,
+				NewCPI:                40.25,
+				OldIRating:            1500,
+				NewIRating:            1532,
+				OldLicenseLevel:       14,
+				NewLicenseLevel:       15,
+				OldSubLevel:           350,
+				NewSubLevel:           362,
+				ReasonOut:             "Running",
+			},
+		},
+		{
+			name:        "missing required attribute",
+			item:        withoutAttrs(driverSessionTestItem(), "incidents"),
+			expectedErr: "missing or invalid 'incidents' attribute",
+		},
+		{
+			name: "malformed positions",
+			item: func() map[string]types.AttributeValue {
+				item := driverSessionTestItem()
+				item["positions"] = attrS("4,3,2")
+				return item
+			}(),
+			expectedErr: "'positions' attribute is not a list",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			session, err := driverSessionFromAttributeMap(1100750, tc.item)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, session)
+		})
+	}
+}
+
+func sessionDriverLapTestItem() map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		partitionKeyName:       attrS("session#70000001"),
+		sortKeyName:            attrS("laps#driver#1100750#lap#7"),
+		raceOrderAttributeName: attrS("0000630000#1100750#0007"),
+		"subsession_id":        attrN("70000001"),
+		"driver_id":            attrN("1100750"),
+		"lap_number":           attrN("7"),
+		"flags":                attrN("4"),
+		"incident":             attrBool(true),
+		"session_time":         attrN("630000"),
+		"lap_time":             attrN("905123"),
+		"personal_best_lap":    attrBool(false),
+		"lap_events":           &types.AttributeValueMemberL{Value: []types.AttributeValue{attrS("off track"), attrS("contact")}},
+		"synthetic":            attrBool(true),
+	}
+}
+
+func sessionDriverLapTestEntity() SessionDriverLap {
+	return SessionDriverLap{
+		SubsessionID: 70000001,
+		DriverID:     1100750,
+		LapNumber:    7,
+		Flags:        4,
+		Incident:     true,
+		SessionTime:  630000,
+		LapTime:      905123,
+		LapEvents:    []string{"off track", "contact"},
+		Synthetic:    true,
+	}
+}
+
+func TestSessionDriverLapModel_ToAttributeMap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lap      SessionDriverLap
+		expected map[string]types.AttributeValue
+	}{
+		{
+			name:     "everything set",
+			lap:      sessionDriverLapTestEntity(),
+			expected: sessionDriverLapTestItem(),
+		},
+		{
+			name: "no events, real lap",
+			lap: func() SessionDriverLap {
+				l := sessionDriverLapTestEntity()
+				l.LapEvents = nil
+				l.Synthetic = false
+				return l
+			}(),
+			expected: withoutAttrs(sessionDriverLapTestItem(), "lap_events", "synthetic"),
+		},
+		{
+			name: "unknown session time",
+			lap: func() SessionDriverLap {
+				l := sessionDriverLapTestEntity()
+				l.SessionTime = -1
+				return l
+			}(),
+			expected: func() map[string]types.AttributeValue {
+				item := withoutAttrs(sessionDriverLapTestItem(), raceOrderAttributeName)
+				item["session_time"] = attrN("-1")
+				return item
+			}(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, sessionDriverLapModelFromEntity(tc.lap).toAttributeMap())
+		})
+	}
+}
+
+func TestSessionDriverLapFromAttributeMap(t *testing.T) {
+	testCases := []struct {
+		name string
+		item map[string]types.AttributeValue
+
+		expected    *SessionDriverLap
+		expectedErr string
+	}{
+		{
+			name:     "everything set",
+			item:     sessionDriverLapTestItem(),
+			expected: func() *SessionDriverLap { l := sessionDriverLapTestEntity(); return &l }(),
+		},
+		{
+			name: "no events, real lap",
+			item: withoutAttrs(sessionDriverLapTestItem(), "lap_events", "synthetic"),
+			expected: func() *SessionDriverLap {
+				l := sessionDriverLapTestEntity()
+				l.LapEvents = nil
+				l.Synthetic = false
+				return &l
+			}(),
+		},
+		{
+			name:        "missing required attribute",
+			item:        withoutAttrs(sessionDriverLapTestItem(), "lap_time"),
+			expectedErr: "missing or invalid 'lap_time' attribute",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lap, err := sessionDriverLapFromAttributeMap(tc.item)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, lap)
+		})
+	}
+}
+
+func TestSessionDriverLapSummaryModel_RoundTrip(t *testing.T) {
+	summary := SessionDriverLapSummary{
+		SubsessionID:     70000001,
+		DriverID:         1100750,
+		LapCount:         20,
+		ValidLapCount:    18,
+		IncidentLapCount: 2,
+		BestLapTime:      899321,
+		AvgLapTime:       905000,
+		CompactedAt:      time.Unix(1700003600, 0),
+	}
+	expectedItem := map[string]types.AttributeValue{
+		partitionKeyName:     attrS("session#70000001"),
+		sortKeyName:          attrS("lapsummary#driver#1100750"),
+		"subsession_id":      attrN("70000001"),
+		"driver_id":          attrN("1100750"),
+		"lap_count":          attrN("20"),
+		"valid_lap_count":    attrN("18"),
+		"incident_lap_count": attrN("2"),
+		"best_lap_time":      attrN("899321"),
+		"avg_lap_time":       attrN("905000"),
+		"compacted_at":       attrN("1700003600"),
+	}
+
+	item := sessionDriverLapSummaryModelFromEntity(summary).toAttributeMap()
+	assert.Equal(t, expectedItem, item)
+
+	actual, err := sessionDriverLapSummaryFromAttributeMap(item)
+	require.NoError(t, err)
+	assert.Equal(t, &summary, actual)
+
+	_, err = sessionDriverLapSummaryFromAttributeMap(withoutAttrs(item, "compacted_at"))
+	assert.EqualError(t, err, "missing or invalid 'compacted_at' attribute")
+}
+
+func TestSessionIngestMarkerModel_RoundTrip(t *testing.T) {
+	marker := sessionIngestMarkerModel{subsessionID: 70000001, driverID: 1100750, chunkCount: 3, chunksWritten: 2}
+	expectedItem := map[string]types.AttributeValue{
+		partitionKeyName: attrS("session#70000001"),
+		sortKeyName:      attrS("ingest#driver#1100750"),
+		"subsession_id":  attrN("70000001"),
+		"driver_id":      attrN("1100750"),
+		"chunk_count":    attrN("3"),
+		"chunks_written": attrN("2"),
+		"complete":       attrBool(false),
+	}
+
+	item := marker.toAttributeMap()
+	assert.Equal(t, expectedItem, item)
+
+	actual, err := sessionIngestMarkerModelFromAttributeMap(item)
+	require.NoError(t, err)
+	assert.Equal(t, &marker, actual)
+}
+
+func TestSupporterModel_RoundTrip(t *testing.T) {
+	supporter := Supporter{
+		DriverID:         1100750,
+		CustomerID:       "cus_123",
+		SubscriptionID:   "sub_456",
+		Status:           "active",
+		CurrentPeriodEnd: time.Unix(1702592000, 0),
+		UpdatedAt:        time.Unix(1700000000, 0),
+	}
+	expectedItem := map[string]types.AttributeValue{
+		partitionKeyName:     attrS("driver#1100750"),
+		sortKeyName:          attrS("supporter"),
+		"driver_id":          attrN("1100750"),
+		"customer_id":        attrS("cus_123"),
+		"subscription_id":    attrS("sub_456"),
+		"status":             attrS("active"),
+		"current_period_end": attrN("1702592000"),
+		"updated_at":         attrN("1700000000"),
+	}
+
+	item := supporterModelFromEntity(supporter).toAttributeMap()
+	assert.Equal(t, expectedItem, item)
+
+	actual, err := supporterFromAttributeMap(item)
+	require.NoError(t, err)
+	assert.Equal(t, &supporter, actual)
+}
+
+func TestJournalStreakModel_RoundTrip(t *testing.T) {
+	streak := JournalStreak{
+		DriverID:      1100750,
+		CurrentWeeks:  3,
+		LongestWeeks:  7,
+		LastWeekStart: time.Unix(1699833600, 0),
+		UpdatedAt:     time.Unix(1700000000, 0),
+	}
+	expectedItem := map[string]types.AttributeValue{
+		partitionKeyName:  attrS("driver#1100750"),
+		sortKeyName:       attrS("journalstreak"),
+		"driver_id":       attrN("1100750"),
+		"current_weeks":   attrN("3"),
+		"longest_weeks":   attrN("7"),
+		"last_week_start": attrN("1699833600"),
+		"updated_at":      attrN("1700000000"),
+	}
+
+	item := journalStreakModelFromEntity(streak, streak.UpdatedAt).toAttributeMap()
+	assert.Equal(t, expectedItem, item)
+
+	actual, err := journalStreakFromAttributeMap(item)
+	require.NoError(t, err)
+	assert.Equal(t, &streak, actual)
+}
+
+func TestRacePlanModel_RoundTrip(t *testing.T) {
+	unlinked := RacePlan{
+		DriverID:        1100750,
+		WeekStart:       time.Unix(1699833600, 0),
+		SeriesID:        260,
+		TrackID:         47,
+		Goals:           "Clean race, top 5",
+		SetupIntentions: "Less rear wing",
+		CreatedAt:       time.Unix(1700000000, 0),
+		UpdatedAt:       time.Unix(1700000100, 0),
+	}
+	linked := unlinked
+	linked.RaceID = 1700100000
+	linked.SubsessionID = 98765
+	linked.LinkedAt = time.Unix(1700110000, 0)
+	evaluated := linked
+	evaluated.Evaluation = &PlanEvaluation{
+		Targets: []PlanTarget{
+			{Kind: PlanTargetFinishPosition, Target: 5, Actual: 3, Met: true},
+			{Kind: PlanTargetIncidents, Target: 0, Actual: 4, Met: false},
+		},
+		EvaluatedAt: time.Unix(1700110000, 0),
+	}
+
+	testCases := []struct {
+		name         string
+		plan         RacePlan
+		expectedItem map[string]types.AttributeValue
+	}{
+		{
+			name: "unlinked",
+			plan: unlinked,
+			expectedItem: map[string]types.AttributeValue{
+				partitionKeyName:   attrS("driver#1100750"),
+				sortKeyName:        attrS("raceplan#1699833600#260#47"),
+				"driver_id":        attrN("1100750"),
+				"week_start":       attrN("1699833600"),
+				"series_id":        attrN("260"),
+				"track_id":         attrN("47"),
+				"goals":            attrS("Clean race, top 5"),
+				"setup_intentions": attrS("Less rear wing"),
+				"created_at":       attrN("1700000000"),
+				"updated_at":       attrN("1700000100"),
+			},
+		},
+		{
+			name: "linked",
+			plan: linked,
+			expectedItem: map[string]types.AttributeValue{
+				partitionKeyName:   attrS("driver#1100750"),
+				sortKeyName:        attrS("raceplan#1699833600#260#47"),
+				"driver_id":        attrN("1100750"),
+				"week_start":       attrN("1699833600"),
+				"series_id":        attrN("260"),
+				"track_id":         attrN("47"),
+				"goals":            attrS("Clean race, top 5"),
+				"setup_intentions": attrS("Less rear wing"),
+				"race_id":          attrN("1700100000"),
+				"subsession_id":    attrN("98765"),
+				"linked_at":        attrN("1700110000"),
+				"created_at":       attrN("1700000000"),
+				"updated_at":       attrN("1700000100"),
+			},
+		},
+		{
+			name: "evaluated",
+			plan: evaluated,
+			expectedItem: map[string]types.AttributeValue{
+				partitionKeyName:   attrS("driver#1100750"),
+				sortKeyName:        attrS("raceplan#1699833600#260#47"),
+				"driver_id":        attrN("1100750"),
+				"week_start":       attrN("1699833600"),
+				"series_id":        attrN("260"),
+				"track_id":         attrN("47"),
+				"goals":            attrS("Clean race, top 5"),
+				"setup_intentions": attrS("Less rear wing"),
+				"race_id":          attrN("1700100000"),
+				"subsession_id":    attrN("98765"),
+				"linked_at":        attrN("1700110000"),
+				"created_at":       attrN("1700000000"),
+				"updated_at":       attrN("1700000100"),
+				"evaluation": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+					"targets": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+						&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+							"kind":   attrS("finish_position"),
+							"target": attrN("5"),
+							"actual": attrN("3"),
+							"met":    attrBool(true),
+						}},
+						&types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+							"kind":   attrS("incidents"),
+							"target": attrN("0"),
+							"actual": attrN("4"),
+							"met":    attrBool(false),
+						}},
+					}},
+					"evaluated_at": attrN("1700110000"),
+				}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			item := racePlanModelFromEntity(tc.plan).toAttributeMap()
+			assert.Equal(t, tc.expectedItem, item)
+
+			actual, err := racePlanFromAttributeMap(item)
+			require.NoError(t, err)
+			assert.Equal(t, &tc.plan, actual)
+		})
+	}
+}
+
+func TestDriverSettingsModel_RoundTrip(t *testing.T) {
+	testCases := []struct {
+		name         string
+		settings     DriverSettings
+		expectedItem map[string]types.AttributeValue
+	}{
+		{
+			name: "everything set",
+			settings: DriverSettings{
+				DriverID:             1100750,
+				LapRetentionMonths:   6,
+				SummaryOnlyIngestion: true,
+				LapBackfillPending:   true,
+				LeaderboardOptIn:     true,
+				BenchmarkOptIn:       true,
+				Timezone:             "America/Chicago",
+				Locale:               "en-US",
+			},
+			expectedItem: map[string]types.AttributeValue{
+				partitionKeyName:         attrS("driver#1100750"),
+				sortKeyName:              attrS("settings"),
+				"driver_id":              attrN("1100750"),
+				"lap_retention_months":   attrN("6"),
+				"summary_only_ingestion": attrBool(true),
+				"lap_backfill_pending":   attrBool(true),
+				"leaderboard_opt_in":     attrBool(true),
+				"benchmark_opt_in":       attrBool(true),
+				"timezone":               attrS("America/Chicago"),
+				"locale":                 attrS("en-US"),
+			},
+		},
+		{
+			name:     "defaults",
+			settings: DriverSettings{DriverID: 1100750},
+			expectedItem: map[string]types.AttributeValue{
+				partitionKeyName:         attrS("driver#1100750"),
+				sortKeyName:              attrS("settings"),
+				"driver_id":              attrN("1100750"),
+				"lap_retention_months":   attrN("0"),
+				"summary_only_ingestion": attrBool(false),
+				"lap_backfill_pending":   attrBool(false),
+				"leaderboard_opt_in":     attrBool(false),
+				"benchmark_opt_in":       attrBool(false),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			item := driverSettingsModelFromEntity(tc.settings).toAttributeMap()
+			assert.Equal(t, tc.expectedItem, item)
+
+			actual, err := driverSettingsFromAttributeMap(item)
+			require.NoError(t, err)
+			assert.Equal(t, &tc.settings, actual)
+		})
+	}
+}
+
+func TestDriverSettingsFromAttributeMap_LegacyRecord(t *testing.T) {
+	item := map[string]types.AttributeValue{
+		partitionKeyName:       attrS("driver#1100750"),
+		sortKeyName:            attrS("settings"),
+		"driver_id":            attrN("1100750"),
+		"lap_retention_months": attrN("12"),
+	}
+
+	actual, err := driverSettingsFromAttributeMap(item)
+	require.NoError(t, err)
+	assert.Equal(t, &DriverSettings{DriverID: 1100750, LapRetentionMonths: 12}, actual)
+}
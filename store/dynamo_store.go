@@ -4,37 +4,71 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/jonsabados/saturdaysspinout/tenant"
+
+	"github.com/jonsabados/saturdaysspinout/store/keys"
 )
 
-const wsConnectionTTLDuration = 24 * time.Hour
+const entitlementUpdateAttempts = 3
 const maxTransactWriteItems = 100
 const maxBatchWriteItems = 25
+const maxBatchGetItems = 100
 
+// DynamoStore keeps each tenant's data in its own copy of the table, named as tenant.TableName names it, picking the
+// copy by the tenant on the context of each call. Things shared by the whole deployment, such as websocket connections
+// and the state of iRacing, are kept in the table itself whoever they are for.
 type DynamoStore struct {
-	client *dynamodb.Client
-	table  string
-	now    func() time.Time
+	client      *dynamodb.Client
+	table       string
+	now         func() time.Time
+	encrypter   *FieldEncrypter
+	readMetrics ReadMetricsEmitter
+}
+
+// DynamoStoreOption configures optional behavior of a DynamoStore.
+type DynamoStoreOption func(*DynamoStore)
+
+// WithFieldEncryption has the store encrypt journal notes, transcripts and bookmark notes at rest. Plaintext written
+// before it was turned on can still be read, and is encrypted by EncryptFields.
+func WithFieldEncryption(encrypter *FieldEncrypter) DynamoStoreOption {
+	return func(s *DynamoStore) {
+		s.encrypter = encrypter
+	}
 }
 
-func NewDynamoStore(client *dynamodb.Client, table string) *DynamoStore {
-	return &DynamoStore{
+func NewDynamoStore(client *dynamodb.Client, table string, opts ...DynamoStoreOption) *DynamoStore {
+	s := &DynamoStore{
 		client: client,
 		table:  table,
 		now:    time.Now,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// tableName is the copy of the table for the tenant ctx is for.
+func (s *DynamoStore) tableName(ctx context.Context) string {
+	return tenant.TableName(s.table, tenant.FromContext(ctx))
 }
 
 func (s *DynamoStore) GetGlobalCounters(ctx context.Context) (*GlobalCounters, error) {
 	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
-		TableName: aws.String(s.table),
+		TableName: aws.String(s.tableName(ctx)),
 		Key: map[string]types.AttributeValue{
-			partitionKeyName: &types.AttributeValueMemberS{Value: globalCountersPartitionKey},
-			sortKeyName:      &types.AttributeValueMemberS{Value: globalCountersSortKey},
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Global},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.GlobalCounters},
 		},
 	})
 	if err != nil {
@@ -46,6 +80,69 @@ func (s *DynamoStore) GetGlobalCounters(ctx context.Context) (*GlobalCounters, e
 	return globalCountersFromAttributeMap(result.Item)
 }
 
+// SaveUpstreamStatus records iRacing as down until status.DownUntil, replacing any earlier report.
+func (s *DynamoStore) SaveUpstreamStatus(ctx context.Context, status UpstreamStatus) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.table),
+		Item:      upstreamStatusModelFromEntity(status).toAttributeMap(),
+	})
+	return err
+}
+
+// GetUpstreamStatus returns the report of iRacing being down, nil when there is none or it is already back.
+func (s *DynamoStore) GetUpstreamStatus(ctx context.Context) (*UpstreamStatus, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.table),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Global},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.UpstreamStatus},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	status, err := upstreamStatusFromAttributeMap(result.Item)
+	if err != nil {
+		return nil, err
+	}
+	// the TTL sweep lags, so a report that has run out may still be around
+	if !status.DownUntil.After(s.now()) {
+		return nil, nil
+	}
+	return status, nil
+}
+
+// SaveBackfillProgress records how far the named backfill has got, replacing what was recorded before.
+func (s *DynamoStore) SaveBackfillProgress(ctx context.Context, progress BackfillProgress) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      backfillProgressModelFromEntity(progress).toAttributeMap(),
+	})
+	return err
+}
+
+// GetBackfillProgress returns how far the named backfill has got, nil when it has never run.
+func (s *DynamoStore) GetBackfillProgress(ctx context.Context, name string) (*BackfillProgress, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Global},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.BackfillProgress(name)},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return backfillProgressFromAttributeMap(result.Item)
+}
+
 func mapTransactionError(err error) error {
 	if err == nil {
 		return nil
@@ -61,22 +158,23 @@ func mapTransactionError(err error) error {
 	return err
 }
 
-func (s *DynamoStore) GetDriver(ctx context.Context, driverID int64) (*Driver, error) {
-	pk := fmt.Sprintf(driverPartitionFormat, driverID)
+func (s *DynamoStore) GetDriver(ctx context.Context, driverID int64, opts ...ReadOption) (*Driver, error) {
+	pk := keys.Driver(driverID)
 
-	result, err := s.client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{
+	result, err := s.batchGetItem(ctx, "GetDriver", &dynamodb.BatchGetItemInput{
 		RequestItems: map[string]types.KeysAndAttributes{
-			s.table: {
+			s.tableName(ctx): {
 				Keys: []map[string]types.AttributeValue{
 					{
 						partitionKeyName: &types.AttributeValueMemberS{Value: pk},
-						sortKeyName:      &types.AttributeValueMemberS{Value: defaultSortKey},
+						sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
 					},
 					{
 						partitionKeyName: &types.AttributeValueMemberS{Value: pk},
-						sortKeyName:      &types.AttributeValueMemberS{Value: ingestionLockSortKey},
+						sortKeyName:      &types.AttributeValueMemberS{Value: keys.IngestionLock},
 					},
 				},
+				ConsistentRead: aws.Bool(applyReadOptions(opts).consistent),
 			},
 		},
 	})
@@ -87,18 +185,18 @@ func (s *DynamoStore) GetDriver(ctx context.Context, driverID int64) (*Driver, e
 	var driver *Driver
 	var lockedUntil *time.Time
 
-	for _, item := range result.Responses[s.table] {
+	for _, item := range result.Responses[s.tableName(ctx)] {
 		sk, err := getStringAttr(item, sortKeyName)
 		if err != nil {
 			return nil, fmt.Errorf("reading sort key from driver item: %w", err)
 		}
 		switch sk {
-		case defaultSortKey:
+		case keys.Info:
 			driver, err = driverFromAttributeMap(item)
 			if err != nil {
 				return nil, err
 			}
-		case ingestionLockSortKey:
+		case keys.IngestionLock:
 			if lu, ok := getOptionalInt64Attr(item, "locked_until"); ok {
 				t := time.Unix(lu, 0)
 				if t.After(s.now()) {
@@ -116,45 +214,63 @@ func (s *DynamoStore) GetDriver(ctx context.Context, driverID int64) (*Driver, e
 	return driver, nil
 }
 
-func (s *DynamoStore) InsertDriver(ctx context.Context, driver Driver) error {
+func driverModelFromEntity(driver Driver) driverModel {
 	model := driverModel{
-		driverID:     driver.DriverID,
-		driverName:   driver.DriverName,
-		memberSince:  toUnixSeconds(driver.MemberSince),
-		firstLogin:   toUnixSeconds(driver.FirstLogin),
-		lastLogin:    toUnixSeconds(driver.LastLogin),
-		loginCount:   driver.LoginCount,
-		entitlements: driver.Entitlements,
+		driverID:       driver.DriverID,
+		driverName:     driver.DriverName,
+		memberSince:    toUnixSeconds(driver.MemberSince),
+		firstLogin:     toUnixSeconds(driver.FirstLogin),
+		lastLogin:      toUnixSeconds(driver.LastLogin),
+		loginCount:     driver.LoginCount,
+		entitlements:   driver.Entitlements,
+		onboardingStep: string(driver.OnboardingStep),
+		clubID:         driver.ClubID,
+		clubName:       driver.ClubName,
+		searchIndexed:  driver.SearchIndexed,
+	}
+	if driver.RacesIngestedFrom != nil {
+		rif := toUnixSeconds(*driver.RacesIngestedFrom)
+		model.racesIngestedFrom = &rif
 	}
 	if driver.RacesIngestedTo != nil {
 		rit := toUnixSeconds(*driver.RacesIngestedTo)
 		model.racesIngestedTo = &rit
 	}
+	return model
+}
 
-	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
-		TransactItems: []types.TransactWriteItem{
-			{
-				Put: &types.Put{
-					Item:                model.toAttributeMap(),
-					TableName:           aws.String(s.table),
-					ConditionExpression: aws.String("attribute_not_exists(#pk)"),
-					ExpressionAttributeNames: map[string]string{
-						"#pk": partitionKeyName,
-					},
+// InsertDriver creates a driver, indexing them for search by name, failing with ErrEntityAlreadyExists for drivers that
+// already exist.
+func (s *DynamoStore) InsertDriver(ctx context.Context, driver Driver) error {
+	driver.SearchIndexed = DriverSearchName(driver.DriverName) != ""
+	model := driverModelFromEntity(driver)
+
+	items := []types.TransactWriteItem{
+		{
+			Put: &types.Put{
+				Item:                model.toAttributeMap(),
+				TableName:           aws.String(s.tableName(ctx)),
+				ConditionExpression: aws.String("attribute_not_exists(#pk)"),
+				ExpressionAttributeNames: map[string]string{
+					"#pk": partitionKeyName,
 				},
 			},
-			s.incrementCounter(globalCountersAttributeDrivers),
 		},
-	})
+		s.incrementCounter(ctx, globalCountersAttributeDrivers),
+	}
+	if driver.SearchIndexed {
+		items = append(items, s.put(ctx, driverSearchModel{driverID: driver.DriverID, driverName: driver.DriverName}.toAttributeMap()))
+	}
+	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: items})
 	return mapTransactionError(err)
 }
 
 func (s *DynamoStore) RecordLogin(ctx context.Context, driverID int64, loginTime time.Time) error {
 	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
-		TableName: aws.String(s.table),
+		TableName: aws.String(s.tableName(ctx)),
 		Key: map[string]types.AttributeValue{
-			partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-			sortKeyName:      &types.AttributeValueMemberS{Value: defaultSortKey},
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
 		},
 		UpdateExpression: aws.String("SET #last_login = :login_time ADD #login_count :inc"),
 		ExpressionAttributeNames: map[string]string{
@@ -171,12 +287,125 @@ func (s *DynamoStore) RecordLogin(ctx context.Context, driverID int64, loginTime
 	return err
 }
 
+// UpdateDriverClub records the iRacing club (region) a driver belongs to, which can change between logins.
+func (s *DynamoStore) UpdateDriverClub(ctx context.Context, driverID int64, clubID int, clubName string) error {
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+		UpdateExpression: aws.String("SET #club_id = :club_id, #club_name = :club_name"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":        partitionKeyName,
+			"#club_id":   "club_id",
+			"#club_name": "club_name",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":club_id":   &types.AttributeValueMemberN{Value: strconv.Itoa(clubID)},
+			":club_name": &types.AttributeValueMemberS{Value: clubName},
+		},
+		ConditionExpression: aws.String("attribute_exists(#pk)"),
+	})
+	return err
+}
+
+// UpdateDriverName records a driver's new name, moving their search entry from previousName to it. It's also how
+// drivers created before driver search get indexed, previousName being their name as recorded when they were created.
+func (s *DynamoStore) UpdateDriverName(ctx context.Context, driverID int64, previousName, name string) error {
+	items := []types.TransactWriteItem{
+		{
+			Update: &types.Update{
+				TableName: aws.String(s.tableName(ctx)),
+				Key: map[string]types.AttributeValue{
+					partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+					sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+				},
+				UpdateExpression: aws.String("SET #driver_name = :driver_name, #search_indexed = :true"),
+				ExpressionAttributeNames: map[string]string{
+					"#pk":             partitionKeyName,
+					"#driver_name":    "driver_name",
+					"#search_indexed": "search_indexed",
+				},
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":driver_name": &types.AttributeValueMemberS{Value: name},
+					":true":        &types.AttributeValueMemberBOOL{Value: true},
+				},
+				ConditionExpression: aws.String("attribute_exists(#pk)"),
+			},
+		},
+	}
+	previous := driverSearchModel{driverID: driverID, driverName: previousName}
+	current := driverSearchModel{driverID: driverID, driverName: name}
+	// a transaction can't touch an item twice, and there's nothing to move when the names index the same
+	if searchName := DriverSearchName(previousName); searchName != "" && searchName != DriverSearchName(name) {
+		pk, sk := previous.keys()
+		items = append(items, types.TransactWriteItem{
+			Delete: &types.Delete{
+				TableName: aws.String(s.tableName(ctx)),
+				Key: map[string]types.AttributeValue{
+					partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+					sortKeyName:      &types.AttributeValueMemberS{Value: sk},
+				},
+			},
+		})
+	}
+	if DriverSearchName(name) != "" {
+		items = append(items, s.put(ctx, current.toAttributeMap()))
+	}
+	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: items})
+	return err
+}
+
+// SearchDrivers returns up to limit drivers whose name starts with prefix, ordered by name. Case and spacing are
+// ignored, and prefixes of fewer than MinDriverSearchLength characters find nobody.
+func (s *DynamoStore) SearchDrivers(ctx context.Context, prefix string, limit int) ([]DriverSearchEntry, error) {
+	searchName := DriverSearchName(prefix)
+	entries := make([]DriverSearchEntry, 0)
+	if len([]rune(searchName)) < MinDriverSearchLength || limit <= 0 {
+		return entries, nil
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+			"#sk": sortKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":     &types.AttributeValueMemberS{Value: keys.DriverSearch(searchName)},
+			":prefix": &types.AttributeValueMemberS{Value: keys.DriverSearchNamePrefix + searchName},
+		},
+		Limit: aws.Int32(int32(limit)),
+	}
+	for {
+		result, err := s.query(ctx, "SearchDrivers", input)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			entry, err := driverSearchEntryFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, *entry)
+		}
+		if len(entries) >= limit || result.LastEvaluatedKey == nil {
+			break
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
+		input.Limit = aws.Int32(int32(limit - len(entries)))
+	}
+	return entries, nil
+}
+
 func (s *DynamoStore) UpdateDriverRacesIngestedTo(ctx context.Context, driverID int64, racesIngestedTo time.Time) error {
 	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
-		TableName: aws.String(s.table),
+		TableName: aws.String(s.tableName(ctx)),
 		Key: map[string]types.AttributeValue{
-			partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-			sortKeyName:      &types.AttributeValueMemberS{Value: defaultSortKey},
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
 		},
 		UpdateExpression: aws.String("SET #races_ingested_to = :val"),
 		ExpressionAttributeNames: map[string]string{
@@ -191,6 +420,64 @@ func (s *DynamoStore) UpdateDriverRacesIngestedTo(ctx context.Context, driverID
 	return err
 }
 
+// UpdateDriverRacesIngestedFrom records how far back a driver's race history has been ingested.
+func (s *DynamoStore) UpdateDriverRacesIngestedFrom(ctx context.Context, driverID int64, racesIngestedFrom time.Time) error {
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+		UpdateExpression: aws.String("SET #races_ingested_from = :val"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":                  partitionKeyName,
+			"#races_ingested_from": "races_ingested_from",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":val": &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", toUnixSeconds(racesIngestedFrom))},
+		},
+		ConditionExpression: aws.String("attribute_exists(#pk)"),
+	})
+	return err
+}
+
+// AdvanceOnboarding moves a driver's onboarding step from one step to another, with from being empty for drivers that
+// have no step recorded. Returns false, changing nothing, if the driver doesn't exist or their step is no longer from.
+func (s *DynamoStore) AdvanceOnboarding(ctx context.Context, driverID int64, from, to OnboardingStep) (bool, error) {
+	condition := "attribute_exists(#pk) AND #onboarding_step = :from"
+	values := map[string]types.AttributeValue{
+		":to":   &types.AttributeValueMemberS{Value: string(to)},
+		":from": &types.AttributeValueMemberS{Value: string(from)},
+	}
+	if from == "" {
+		condition = "attribute_exists(#pk) AND attribute_not_exists(#onboarding_step)"
+		delete(values, ":from")
+	}
+
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+		UpdateExpression:    aws.String("SET #onboarding_step = :to"),
+		ConditionExpression: aws.String(condition),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":              partitionKeyName,
+			"#onboarding_step": "onboarding_step",
+		},
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // AcquireIngestionLock attempts to acquire an ingestion lock for a driver.
 // Returns (true, nil) if lock acquired, (false, nil) if lock already held, (false, err) on error.
 func (s *DynamoStore) AcquireIngestionLock(ctx context.Context, driverID int64, lockDuration time.Duration) (bool, error) {
@@ -198,7 +485,7 @@ func (s *DynamoStore) AcquireIngestionLock(ctx context.Context, driverID int64,
 	lockedUntil := now.Add(lockDuration)
 
 	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
-		TableName: aws.String(s.table),
+		TableName: aws.String(s.tableName(ctx)),
 		Item: ingestionLockModel{
 			driverID:    driverID,
 			lockedUntil: lockedUntil.Unix(),
@@ -225,542 +512,4291 @@ func (s *DynamoStore) AcquireIngestionLock(ctx context.Context, driverID int64,
 // ReleaseIngestionLock removes the ingestion lock for a driver.
 func (s *DynamoStore) ReleaseIngestionLock(ctx context.Context, driverID int64) error {
 	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
-		TableName: aws.String(s.table),
+		TableName: aws.String(s.tableName(ctx)),
 		Key: map[string]types.AttributeValue{
-			partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-			sortKeyName:      &types.AttributeValueMemberS{Value: ingestionLockSortKey},
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.IngestionLock},
 		},
 	})
 	return err
 }
 
-func (s *DynamoStore) incrementCounter(name string) types.TransactWriteItem {
-	return types.TransactWriteItem{
-		Update: &types.Update{
-			TableName: aws.String(s.table),
-			Key: map[string]types.AttributeValue{
-				partitionKeyName: &types.AttributeValueMemberS{Value: globalCountersPartitionKey},
-				sortKeyName:      &types.AttributeValueMemberS{Value: globalCountersSortKey},
-			},
-			UpdateExpression: aws.String("ADD #counter :inc"),
-			ExpressionAttributeNames: map[string]string{
-				"#counter": name,
-			},
-			ExpressionAttributeValues: map[string]types.AttributeValue{
-				":inc": &types.AttributeValueMemberN{Value: "1"},
-			},
+// RequestIngestionCancel flags the driver's ingestion to stop at the next opportunity. Flags nobody picks up expire after
+// an hour.
+func (s *DynamoStore) RequestIngestionCancel(ctx context.Context, driverID int64) error {
+	now := s.now()
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item: ingestionCancelModel{
+			driverID:    driverID,
+			requestedAt: now.Unix(),
+			expiresAt:   now.Add(ingestionCancelTTLDuration).Unix(),
+		}.toAttributeMap(),
+	})
+	return err
+}
+
+// IngestionCancelRequested reports whether the driver's ingestion has been flagged to stop. The read is consistent so
+// a cancel is seen as soon as it is made.
+func (s *DynamoStore) IngestionCancelRequested(ctx context.Context, driverID int64) (bool, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.IngestionCancel},
 		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return false, err
+	}
+	if result.Item == nil {
+		return false, nil
 	}
+	return !ttlExpired(result.Item, s.now()), nil
 }
 
-func (s *DynamoStore) SaveConnection(ctx context.Context, conn WebSocketConnection) error {
-	now := s.now()
-
-	rowsToWrite := wsConnectionModel{
-		driverID:     conn.DriverID,
-		connectionID: conn.ConnectionID,
-		connectedAt:  toUnixSeconds(now),
-		ttl:          toUnixSeconds(now.Add(wsConnectionTTLDuration)),
-	}.toAttributeMaps()
+// ClearIngestionCancel removes the driver's cancel flag, if there is one.
+func (s *DynamoStore) ClearIngestionCancel(ctx context.Context, driverID int64) error {
+	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.IngestionCancel},
+		},
+	})
+	return err
+}
 
-	toWrite := make([]types.TransactWriteItem, len(rowsToWrite))
+// SaveDriverPresence records a racing heartbeat from the driver's client, replacing the previous one. UpdatedAt is set
+// to the current time and the heartbeat lapses after presenceTTLDuration unless another arrives.
+func (s *DynamoStore) SaveDriverPresence(ctx context.Context, presence DriverPresence) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      driverPresenceModelFromEntity(presence, s.now()).toAttributeMap(),
+	})
+	return err
+}
 
-	for i, row := range rowsToWrite {
-		toWrite[i] = types.TransactWriteItem{
-			Put: &types.Put{
-				TableName: aws.String(s.table),
-				Item:      row,
-			},
+// GetDriverPresences returns the current presence of each of the given drivers that is racing right now. Lapsed
+// heartbeats the TTL sweep hasn't removed yet are left out.
+func (s *DynamoStore) GetDriverPresences(ctx context.Context, driverIDs []int64) ([]DriverPresence, error) {
+	now := s.now()
+	presences := make([]DriverPresence, 0)
+	for chunk := range slices.Chunk(driverIDs, maxBatchGetItems) {
+		itemKeys := make([]map[string]types.AttributeValue, len(chunk))
+		for i, driverID := range chunk {
+			itemKeys[i] = map[string]types.AttributeValue{
+				partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverPresence},
+			}
+		}
+		requestItems := map[string]types.KeysAndAttributes{s.tableName(ctx): {Keys: itemKeys}}
+		for len(requestItems) > 0 {
+			result, err := s.batchGetItem(ctx, "GetDriverPresences", &dynamodb.BatchGetItemInput{RequestItems: requestItems})
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range result.Responses[s.tableName(ctx)] {
+				if ttlExpired(item, now) {
+					continue
+				}
+				presence, err := driverPresenceFromAttributeMap(item)
+				if err != nil {
+					return nil, err
+				}
+				presences = append(presences, *presence)
+			}
+			requestItems = result.UnprocessedKeys
 		}
 	}
+	sort.Slice(presences, func(i, j int) bool {
+		return presences[i].DriverID < presences[j].DriverID
+	})
+	return presences, nil
+}
 
+// SavePresenceGrant lets grant.ViewerID see when grant.DriverID is racing, replacing any earlier grant between them.
+// GrantedAt is set to the current time.
+func (s *DynamoStore) SavePresenceGrant(ctx context.Context, grant PresenceGrant) error {
+	items := presenceGrantModelFromEntity(grant, s.now()).toAttributeMaps()
 	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
-		TransactItems: toWrite,
+		TransactItems: []types.TransactWriteItem{s.put(ctx, items[0]), s.put(ctx, items[1])},
 	})
 	return err
 }
 
-func (s *DynamoStore) DeleteConnection(ctx context.Context, driverID int64, connectionID string) error {
+// DeletePresenceGrant stops viewerID seeing when driverID is racing.
+// Returns nil even if there was no grant (idempotent delete).
+func (s *DynamoStore) DeletePresenceGrant(ctx context.Context, driverID, viewerID int64) error {
 	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
 		TransactItems: []types.TransactWriteItem{
-			{
-				Delete: &types.Delete{
-					TableName: aws.String(s.table),
-					Key: map[string]types.AttributeValue{
-						partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-						sortKeyName:      &types.AttributeValueMemberS{Value: fmt.Sprintf(wsConnectionSortKeyFormat, connectionID)},
-					},
+			{Delete: &types.Delete{
+				TableName: aws.String(s.tableName(ctx)),
+				Key: map[string]types.AttributeValue{
+					partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+					sortKeyName:      &types.AttributeValueMemberS{Value: keys.PresenceViewer(viewerID)},
 				},
-			},
-			{
-				Delete: &types.Delete{
-					TableName: aws.String(s.table),
-					Key: map[string]types.AttributeValue{
-						partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(websocketPartitionFormat, connectionID)},
-						sortKeyName:      &types.AttributeValueMemberS{Value: defaultSortKey},
-					},
+			}},
+			{Delete: &types.Delete{
+				TableName: aws.String(s.tableName(ctx)),
+				Key: map[string]types.AttributeValue{
+					partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(viewerID)},
+					sortKeyName:      &types.AttributeValueMemberS{Value: keys.PresenceGrant(driverID)},
 				},
-			},
+			}},
 		},
 	})
 	return err
 }
 
-func (s *DynamoStore) GetConnectionsByDriver(ctx context.Context, driverID int64) ([]WebSocketConnection, error) {
-	result, err := s.client.Query(ctx, &dynamodb.QueryInput{
-		TableName:              aws.String(s.table),
-		KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :sk_prefix)"),
-		ExpressionAttributeNames: map[string]string{
-			"#pk": partitionKeyName,
-			"#sk": sortKeyName,
-		},
-		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":pk":        &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-			":sk_prefix": &types.AttributeValueMemberS{Value: "ws#"},
-		},
-	})
+// GetPresenceViewers returns the grants the driver has made, ordered by viewer ID.
+func (s *DynamoStore) GetPresenceViewers(ctx context.Context, driverID int64) ([]PresenceGrant, error) {
+	grants, err := s.queryPresenceGrants(ctx, "GetPresenceViewers", driverID, keys.PresenceViewerPrefix)
 	if err != nil {
 		return nil, err
 	}
-
-	connections := make([]WebSocketConnection, 0, len(result.Items))
-	for _, item := range result.Items {
-		conn, err := wsConnectionFromAttributeMap(item)
-		if err != nil {
-			return nil, err
-		}
-		connections = append(connections, *conn)
-	}
-	return connections, nil
-}
-
-func (s *DynamoStore) GetDriverIDByConnection(ctx context.Context, connectionID string) (*int64, error) {
-	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
-		TableName: aws.String(s.table),
-		Key: map[string]types.AttributeValue{
-			partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(websocketPartitionFormat, connectionID)},
-			sortKeyName:      &types.AttributeValueMemberS{Value: defaultSortKey},
-		},
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].ViewerID < grants[j].ViewerID
 	})
+	return grants, nil
+}
 
+// GetPresenceGrantsForViewer returns the grants made to the viewer, ordered by the sharing driver's ID.
+func (s *DynamoStore) GetPresenceGrantsForViewer(ctx context.Context, viewerID int64) ([]PresenceGrant, error) {
+	grants, err := s.queryPresenceGrants(ctx, "GetPresenceGrantsForViewer", viewerID, keys.PresenceGrantPrefix)
 	if err != nil {
 		return nil, err
 	}
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].DriverID < grants[j].DriverID
+	})
+	return grants, nil
+}
 
-	if result.Item == nil {
+// queryPresenceGrants returns the grant items under prefix in the driver's partition. Sort keys hold the IDs unpadded,
+// so callers put them in numeric order.
+func (s *DynamoStore) queryPresenceGrants(ctx context.Context, name string, driverID int64, prefix string) ([]PresenceGrant, error) {
+	grants := make([]PresenceGrant, 0)
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, name, &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				":prefix": &types.AttributeValueMemberS{Value: prefix},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			grant, err := presenceGrantFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			grants = append(grants, *grant)
+		}
+		if result.LastEvaluatedKey == nil {
+			return grants, nil
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// SaveAuthSession creates or replaces an auth session. Returns false without saving if the session has been revoked,
+// so a refresh racing a revocation can't undo it.
+func (s *DynamoStore) SaveAuthSession(ctx context.Context, session AuthSession) (bool, error) {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(s.tableName(ctx)),
+		Item:                authSessionModelFromEntity(session).toAttributeMap(),
+		ConditionExpression: aws.String("attribute_not_exists(#revoked_at)"),
+		ExpressionAttributeNames: map[string]string{
+			"#revoked_at": "revoked_at",
+		},
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// GetAuthSession retrieves an auth session. Returns nil if there is no such session, including when its latest token
+// has expired but the TTL sweep hasn't removed it yet.
+func (s *DynamoStore) GetAuthSession(ctx context.Context, driverID int64, sessionID string) (*AuthSession, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.AuthSession(sessionID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil || ttlExpired(result.Item, s.now()) {
+		return nil, nil
+	}
+	return authSessionFromAttributeMap(result.Item)
+}
+
+// GetAuthSessions returns the driver's unexpired auth sessions, revoked ones included, most recently issued first.
+func (s *DynamoStore) GetAuthSessions(ctx context.Context, driverID int64) ([]AuthSession, error) {
+	now := s.now()
+	sessions := make([]AuthSession, 0)
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetAuthSessions", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				":prefix": &types.AttributeValueMemberS{Value: keys.AuthSessionPrefix},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			if ttlExpired(item, now) {
+				continue
+			}
+			session, err := authSessionFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			sessions = append(sessions, *session)
+		}
+		if result.LastEvaluatedKey == nil {
+			break
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+	sortAuthSessions(sessions)
+	return sessions, nil
+}
+
+// RevokeAuthSession marks an auth session revoked, keeping the earlier time if it already was. Returns false if there
+// is no such session.
+func (s *DynamoStore) RevokeAuthSession(ctx context.Context, driverID int64, sessionID string, revokedAt time.Time) (bool, error) {
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.AuthSession(sessionID)},
+		},
+		UpdateExpression:    aws.String("SET #revoked_at = if_not_exists(#revoked_at, :revoked_at)"),
+		ConditionExpression: aws.String("attribute_exists(#pk)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":         partitionKeyName,
+			"#revoked_at": "revoked_at",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":revoked_at": &types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(revokedAt), 10)},
+		},
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// sortAuthSessions orders sessions most recently issued first. Session IDs are random, so the sort key order means
+// nothing.
+func sortAuthSessions(sessions []AuthSession) {
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].IssuedAt.After(sessions[j].IssuedAt)
+	})
+}
+
+// SaveSquad creates or replaces a squad. Its members are saved separately, with SaveSquadMember.
+func (s *DynamoStore) SaveSquad(ctx context.Context, squad Squad) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      squadModelFromEntity(squad).toAttributeMap(),
+	})
+	return err
+}
+
+// GetSquad retrieves a squad. Returns nil if the squad doesn't exist.
+func (s *DynamoStore) GetSquad(ctx context.Context, squadID string) (*Squad, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Squad(squadID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return squadFromAttributeMap(result.Item)
+}
+
+// SaveSquadMember creates or replaces a driver's place in a squad, on both the squad's and the driver's partitions.
+func (s *DynamoStore) SaveSquadMember(ctx context.Context, member SquadMember) error {
+	items := squadMemberModelFromEntity(member).toAttributeMaps()
+	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{s.put(ctx, items[0]), s.put(ctx, items[1])},
+	})
+	return err
+}
+
+// GetSquadMember retrieves a driver's place in a squad. Returns nil if the driver is neither a member nor invited.
+func (s *DynamoStore) GetSquadMember(ctx context.Context, squadID string, driverID int64) (*SquadMember, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Squad(squadID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.SquadMember(driverID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return squadMemberFromAttributeMap(result.Item)
+}
+
+// GetSquadMembers returns the squad's members, invited or active, ordered by driver ID.
+func (s *DynamoStore) GetSquadMembers(ctx context.Context, squadID string) ([]SquadMember, error) {
+	members, err := s.querySquadMembers(ctx, "GetSquadMembers", keys.Squad(squadID), keys.SquadMemberPrefix)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].DriverID < members[j].DriverID
+	})
+	return members, nil
+}
+
+// GetDriverSquads returns the driver's places in squads, invited or active, ordered by squad ID.
+func (s *DynamoStore) GetDriverSquads(ctx context.Context, driverID int64) ([]SquadMember, error) {
+	return s.querySquadMembers(ctx, "GetDriverSquads", keys.Driver(driverID), keys.SquadMembershipPrefix)
+}
+
+// DeleteSquadMember removes a driver from a squad, or withdraws their invite.
+// Returns nil even if the driver wasn't in the squad (idempotent delete).
+func (s *DynamoStore) DeleteSquadMember(ctx context.Context, squadID string, driverID int64) error {
+	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{Delete: &types.Delete{
+				TableName: aws.String(s.tableName(ctx)),
+				Key: map[string]types.AttributeValue{
+					partitionKeyName: &types.AttributeValueMemberS{Value: keys.Squad(squadID)},
+					sortKeyName:      &types.AttributeValueMemberS{Value: keys.SquadMember(driverID)},
+				},
+			}},
+			{Delete: &types.Delete{
+				TableName: aws.String(s.tableName(ctx)),
+				Key: map[string]types.AttributeValue{
+					partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+					sortKeyName:      &types.AttributeValueMemberS{Value: keys.SquadMembership(squadID)},
+				},
+			}},
+		},
+	})
+	return err
+}
+
+// querySquadMembers returns the squad member items under prefix in the partition, in sort key order.
+func (s *DynamoStore) querySquadMembers(ctx context.Context, name, pk, prefix string) ([]SquadMember, error) {
+	members := make([]SquadMember, 0)
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, name, &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: pk},
+				":prefix": &types.AttributeValueMemberS{Value: prefix},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			member, err := squadMemberFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			members = append(members, *member)
+		}
+		if result.LastEvaluatedKey == nil {
+			return members, nil
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// SaveSquadEvent tags a session as a squad event, on both the squad's partition and the session's so ingestion can find
+// the squads that tagged it.
+func (s *DynamoStore) SaveSquadEvent(ctx context.Context, event SquadEvent) error {
+	items := squadEventModelFromEntity(event).toAttributeMaps()
+	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{s.put(ctx, items[0]), s.put(ctx, items[1])},
+	})
+	return err
+}
+
+// GetSquadEvent retrieves a squad event. Returns nil if the squad hasn't tagged the session.
+func (s *DynamoStore) GetSquadEvent(ctx context.Context, squadID string, subsessionID int64) (*SquadEvent, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Squad(squadID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.SquadEvent(subsessionID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return squadEventFromAttributeMap(result.Item)
+}
+
+// GetSquadEvents returns the squad's events, most recent session first.
+func (s *DynamoStore) GetSquadEvents(ctx context.Context, squadID string) ([]SquadEvent, error) {
+	items, err := s.queryPrefix(ctx, "GetSquadEvents", keys.Squad(squadID), keys.SquadEventPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return squadEventsFromItems(items)
+}
+
+// GetSessionSquadEvents returns the squad events tagging the session, ordered by squad ID.
+func (s *DynamoStore) GetSessionSquadEvents(ctx context.Context, subsessionID int64) ([]SquadEvent, error) {
+	items, err := s.queryPrefix(ctx, "GetSessionSquadEvents", keys.Session(subsessionID), keys.SessionSquadEventPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return squadEventsFromItems(items)
+}
+
+// SaveSquadEventResult creates or replaces a member's finish in a squad event.
+func (s *DynamoStore) SaveSquadEventResult(ctx context.Context, result SquadEventResult) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      squadEventResultModelFromEntity(result).toAttributeMap(),
+	})
+	return err
+}
+
+// GetSquadEventResults returns the members' finishes in a squad event, ordered by finishing position.
+func (s *DynamoStore) GetSquadEventResults(ctx context.Context, squadID string, subsessionID int64) ([]SquadEventResult, error) {
+	items, err := s.queryPrefix(ctx, "GetSquadEventResults", keys.Squad(squadID), keys.SquadEventResultEventPrefix(subsessionID))
+	if err != nil {
+		return nil, err
+	}
+	return squadEventResultsFromItems(items)
+}
+
+// queryPrefix returns the items under prefix in the partition, in sort key order. name is the store method querying,
+// for read metrics.
+func (s *DynamoStore) queryPrefix(ctx context.Context, name, pk, prefix string) ([]map[string]types.AttributeValue, error) {
+	var items []map[string]types.AttributeValue
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, name, &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: pk},
+				":prefix": &types.AttributeValueMemberS{Value: prefix},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, result.Items...)
+		if result.LastEvaluatedKey == nil {
+			return items, nil
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// GetRateBudgetWindow returns what was spent in the rate budget window starting at start, which is an empty window if
+// nothing has been spent in it.
+func (s *DynamoStore) GetRateBudgetWindow(ctx context.Context, start time.Time) (*RateBudgetWindow, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.table),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.RateBudget},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RateBudgetWindow(start.Unix())},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return &RateBudgetWindow{Start: time.Unix(start.Unix(), 0), Drivers: map[int64]int{}}, nil
+	}
+	return rateBudgetWindowFromAttributeMap(result.Item)
+}
+
+// ConsumeRateBudget adds cost to the rate budget window starting at start, on behalf of driverID, as long as the
+// window's total stays within limit. Returns (true, nil) if the cost was taken, (false, nil) if it would have gone over
+// the limit, (false, err) on error. Windows expire an hour after they start.
+func (s *DynamoStore) ConsumeRateBudget(ctx context.Context, start time.Time, driverID int64, cost, limit int) (bool, error) {
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.table),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.RateBudget},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RateBudgetWindow(start.Unix())},
+		},
+		UpdateExpression:    aws.String("SET #window_start = :start, #ttl = :ttl ADD #total :cost, #driver :cost"),
+		ConditionExpression: aws.String("attribute_not_exists(#total) OR #total <= :max"),
+		ExpressionAttributeNames: map[string]string{
+			"#window_start": "window_start",
+			"#ttl":          ttlAttributeName,
+			"#total":        "total",
+			"#driver":       fmt.Sprintf(rateBudgetDriverAttributeFormat, driverID),
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":start": &types.AttributeValueMemberN{Value: strconv.FormatInt(start.Unix(), 10)},
+			":ttl":   ttlAttr(start.Add(rateBudgetWindowTTLDuration).Unix()),
+			":cost":  &types.AttributeValueMemberN{Value: strconv.Itoa(cost)},
+			":max":   &types.AttributeValueMemberN{Value: strconv.Itoa(limit - cost)},
+		},
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// GetQuotaUsage returns what a driver has used of their quotas on the day starting at day, which is empty if they
+// haven't used anything.
+func (s *DynamoStore) GetQuotaUsage(ctx context.Context, driverID int64, day time.Time) (*QuotaUsage, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Quota(day.Unix())},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return &QuotaUsage{DriverID: driverID, Day: time.Unix(day.Unix(), 0), Counts: map[string]int{}}, nil
+	}
+	return quotaUsageFromAttributeMap(result.Item)
+}
+
+// ConsumeQuota counts one use of operation against a driver's quota for the day starting at day, as long as they've
+// used it fewer than limit times. Returns (true, nil) if the use was counted, (false, nil) if the quota is used up,
+// (false, err) on error. Days expire two days after they start.
+func (s *DynamoStore) ConsumeQuota(ctx context.Context, driverID int64, day time.Time, operation string, limit int) (bool, error) {
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Quota(day.Unix())},
+		},
+		UpdateExpression:    aws.String("SET #driver_id = :driver_id, #day = :day, #ttl = :ttl ADD #operation :one"),
+		ConditionExpression: aws.String("attribute_not_exists(#operation) OR #operation < :limit"),
+		ExpressionAttributeNames: map[string]string{
+			"#driver_id": "driver_id",
+			"#day":       "day",
+			"#ttl":       ttlAttributeName,
+			"#operation": fmt.Sprintf(quotaOperationAttributeFormat, operation),
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":driver_id": &types.AttributeValueMemberN{Value: strconv.FormatInt(driverID, 10)},
+			":day":       &types.AttributeValueMemberN{Value: strconv.FormatInt(day.Unix(), 10)},
+			":ttl":       ttlAttr(day.Add(quotaTTLDuration).Unix()),
+			":one":       &types.AttributeValueMemberN{Value: "1"},
+			":limit":     &types.AttributeValueMemberN{Value: strconv.Itoa(limit)},
+		},
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// GetLoginAttempts returns the recent failed sign in attempts recorded against key, nil if there are none. Attempts are
+// tracked deployment wide, so a tenant can't be used to get around them.
+func (s *DynamoStore) GetLoginAttempts(ctx context.Context, key string) (*LoginAttempts, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.table),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.LoginAttempts(key)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil || ttlExpired(result.Item, s.now()) {
+		return nil, nil
+	}
+	return loginAttemptsFromAttributeMap(result.Item)
+}
+
+// RecordLoginFailure counts a failed sign in attempt against key at the given time, returning the attempts as they now
+// stand. Failures are counted from the first one after a gap of at least window without any, and the record expires
+// window after the latest.
+func (s *DynamoStore) RecordLoginFailure(ctx context.Context, key string, at time.Time, window time.Duration) (*LoginAttempts, error) {
+	pk := keys.LoginAttempts(key)
+	result, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.table),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+		UpdateExpression:    aws.String("SET #last_failure = :at, #ttl = :ttl ADD #failures :one"),
+		ConditionExpression: aws.String("#last_failure >= :window_start"),
+		ExpressionAttributeNames: map[string]string{
+			"#last_failure": "last_failure",
+			"#failures":     "failures",
+			"#ttl":          ttlAttributeName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":at":           &types.AttributeValueMemberN{Value: strconv.FormatInt(at.Unix(), 10)},
+			":ttl":          ttlAttr(at.Add(window).Unix()),
+			":one":          &types.AttributeValueMemberN{Value: "1"},
+			":window_start": &types.AttributeValueMemberN{Value: strconv.FormatInt(at.Add(-window).Unix(), 10)},
+		},
+		ReturnValues: types.ReturnValueAllNew,
+	})
+	if err == nil {
+		return loginAttemptsFromAttributeMap(result.Attributes)
+	}
+	var condErr *types.ConditionalCheckFailedException
+	if !errors.As(err, &condErr) {
+		return nil, err
+	}
+
+	// nothing recent to add to, start counting again. Two first failures racing each other can count as one, which
+	// errs on the side of the client and is made up for by the next failure.
+	model := loginAttemptsModel{
+		key:          key,
+		failures:     1,
+		firstFailure: at.Unix(),
+		lastFailure:  at.Unix(),
+		expiresAt:    at.Add(window).Unix(),
+	}
+	_, err = s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.table),
+		Item:      model.toAttributeMap(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &LoginAttempts{Key: key, Failures: 1, FirstFailure: time.Unix(at.Unix(), 0), LastFailure: time.Unix(at.Unix(), 0)}, nil
+}
+
+// ClearLoginAttempts forgets the failed sign in attempts recorded against key.
+func (s *DynamoStore) ClearLoginAttempts(ctx context.Context, key string) error {
+	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.table),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.LoginAttempts(key)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+	})
+	return err
+}
+
+func (s *DynamoStore) incrementCounter(ctx context.Context, name string) types.TransactWriteItem {
+	return types.TransactWriteItem{
+		Update: &types.Update{
+			TableName: aws.String(s.tableName(ctx)),
+			Key: map[string]types.AttributeValue{
+				partitionKeyName: &types.AttributeValueMemberS{Value: keys.Global},
+				sortKeyName:      &types.AttributeValueMemberS{Value: keys.GlobalCounters},
+			},
+			UpdateExpression: aws.String("ADD #counter :inc"),
+			ExpressionAttributeNames: map[string]string{
+				"#counter": name,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":inc": &types.AttributeValueMemberN{Value: "1"},
+			},
+		},
+	}
+}
+
+func (s *DynamoStore) SaveConnection(ctx context.Context, conn WebSocketConnection) error {
+	now := s.now()
+
+	rowsToWrite := wsConnectionModel{
+		driverID:     conn.DriverID,
+		connectionID: conn.ConnectionID,
+		tenantID:     conn.TenantID,
+		overlayToken: conn.OverlayToken,
+		connectedAt:  toUnixSeconds(now),
+		ttl:          toUnixSeconds(now.Add(wsConnectionTTLDuration)),
+	}.toAttributeMaps()
+
+	toWrite := make([]types.TransactWriteItem, len(rowsToWrite))
+
+	for i, row := range rowsToWrite {
+		toWrite[i] = types.TransactWriteItem{
+			Put: &types.Put{
+				TableName: aws.String(s.table),
+				Item:      row,
+			},
+		}
+	}
+
+	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: toWrite,
+	})
+	return err
+}
+
+func (s *DynamoStore) DeleteConnection(ctx context.Context, driverID int64, connectionID string) error {
+	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				Delete: &types.Delete{
+					TableName: aws.String(s.table),
+					Key: map[string]types.AttributeValue{
+						partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+						sortKeyName:      &types.AttributeValueMemberS{Value: keys.WSConnection(connectionID)},
+					},
+				},
+			},
+			{
+				Delete: &types.Delete{
+					TableName: aws.String(s.table),
+					Key: map[string]types.AttributeValue{
+						partitionKeyName: &types.AttributeValueMemberS{Value: keys.WebSocket(connectionID)},
+						sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+					},
+				},
+			},
+		},
+	})
+	return err
+}
+
+func (s *DynamoStore) GetConnectionsByDriver(ctx context.Context, driverID int64) ([]WebSocketConnection, error) {
+	result, err := s.query(ctx, "GetConnectionsByDriver", &dynamodb.QueryInput{
+		TableName:              aws.String(s.table),
+		KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :sk_prefix)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+			"#sk": sortKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":        &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			":sk_prefix": &types.AttributeValueMemberS{Value: "ws#"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	connections := make([]WebSocketConnection, 0, len(result.Items))
+	for _, item := range result.Items {
+		conn, err := wsConnectionFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		connections = append(connections, *conn)
+	}
+	return connections, nil
+}
+
+func (s *DynamoStore) GetDriverIDByConnection(ctx context.Context, connectionID string) (*int64, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.table),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.WebSocket(connectionID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	ret, err := getInt64Attr(result.Item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+func (s *DynamoStore) GetConnection(ctx context.Context, driverID int64, connectionID string) (*WebSocketConnection, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.table),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.WSConnection(connectionID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return wsConnectionFromAttributeMap(result.Item)
+}
+
+func (s *DynamoStore) GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...ReadOption) (*DriverSession, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverSession(toUnixSeconds(startTime))},
+		},
+		ConsistentRead: aws.Bool(applyReadOptions(opts).consistent),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return driverSessionFromAttributeMap(driverID, result.Item)
+}
+
+// GetDriverSessions retrieves specific driver sessions by their exact start times.
+// Uses BatchGetItem for efficient fetching. Returns sessions in the order they were found.
+func (s *DynamoStore) GetDriverSessions(ctx context.Context, driverID int64, startTimes []time.Time) ([]DriverSession, error) {
+	if len(startTimes) == 0 {
+		return []DriverSession{}, nil
+	}
+
+	pk := keys.Driver(driverID)
+	itemKeys := make([]map[string]types.AttributeValue, len(startTimes))
+	for i, startTime := range startTimes {
+		itemKeys[i] = map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverSession(toUnixSeconds(startTime))},
+		}
+	}
+
+	result, err := s.batchGetItem(ctx, "GetDriverSessions", &dynamodb.BatchGetItemInput{
+		RequestItems: map[string]types.KeysAndAttributes{
+			s.tableName(ctx): {Keys: itemKeys},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]DriverSession, 0, len(result.Responses[s.tableName(ctx)]))
+	for _, item := range result.Responses[s.tableName(ctx)] {
+		session, err := driverSessionFromAttributeMap(driverID, item)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+
+	return sessions, nil
+}
+
+func (s *DynamoStore) GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...SessionFilter) ([]DriverSession, error) {
+	result, err := s.query(ctx, "GetDriverSessionsByTimeRange", &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :from AND :to"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+			"#sk": sortKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":   &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			":from": &types.AttributeValueMemberS{Value: keys.DriverSession(toUnixSeconds(from))},
+			":to":   &types.AttributeValueMemberS{Value: keys.DriverSession(toUnixSeconds(to))},
+		},
+		ScanIndexForward: aws.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]DriverSession, 0, len(result.Items))
+	for _, item := range result.Items {
+		session, err := driverSessionFromAttributeMap(driverID, item)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+
+	for _, filter := range filters {
+		sessions = filter(sessions)
+	}
+	return sessions, nil
+}
+
+// SaveDriverSessions saves driver session records and increments session counts atomically.
+// Uses transactions to ensure duplicate prevention via key checks.
+func (s *DynamoStore) SaveDriverSessions(ctx context.Context, sessions []DriverSession) error {
+	_, err := s.SaveDriverSessionsWithMode(ctx, sessions, WriteModeStrict)
+	return err
+}
+
+// SaveDriverSessionsWithMode saves driver session records, handling ones that were already saved according to mode.
+// Outside of WriteModeStrict each session is written in its own transaction with its session count increment, so an
+// existing session only affects itself.
+func (s *DynamoStore) SaveDriverSessionsWithMode(ctx context.Context, sessions []DriverSession, mode WriteMode) (WriteResult, error) {
+	if len(sessions) == 0 {
+		return WriteResult{}, nil
+	}
+
+	if mode == WriteModeStrict {
+		var items []types.TransactWriteItem
+
+		// Track session counts per driver
+		driverSessionCounts := make(map[int64]int)
+
+		for _, ds := range sessions {
+			driverSessionCounts[ds.DriverID]++
+			items = append(items, s.putWithKeyCheck(ctx, driverSessionModelFromEntity(ds).toAttributeMap()))
+		}
+
+		// Increment session count for each driver
+		for driverID, count := range driverSessionCounts {
+			items = append(items, s.incrementDriverSessionCount(ctx, driverID, count))
+		}
+
+		return WriteResult{}, s.executeBatchedTransact(ctx, items)
+	}
+
+	var result WriteResult
+	for _, ds := range sessions {
+		item := driverSessionModelFromEntity(ds).toAttributeMap()
+		_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+			TransactItems: []types.TransactWriteItem{
+				s.putWithKeyCheck(ctx, item),
+				s.incrementDriverSessionCount(ctx, ds.DriverID, 1),
+			},
+		})
+		err = mapTransactionError(err)
+		if errors.Is(err, ErrEntityAlreadyExists) {
+			result.Existing = append(result.Existing, ds)
+			if mode == WriteModeUpsert {
+				_, err = s.client.PutItem(ctx, &dynamodb.PutItemInput{
+					TableName: aws.String(s.tableName(ctx)),
+					Item:      item,
+				})
+			} else {
+				err = nil
+			}
+		}
+		if err != nil {
+			return result, fmt.Errorf("saving session %d for driver %d: %w", ds.SubsessionID, ds.DriverID, err)
+		}
+	}
+	return result, nil
+}
+
+// GetDriverSessionsWithSkippedLaps returns the driver's sessions that were ingested without lap data, newest first.
+func (s *DynamoStore) GetDriverSessionsWithSkippedLaps(ctx context.Context, driverID int64) ([]DriverSession, error) {
+	var sessions []DriverSession
+	var startKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetDriverSessionsWithSkippedLaps", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			FilterExpression:       aws.String("#laps_skipped = :true"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk":           partitionKeyName,
+				"#sk":           sortKeyName,
+				"#laps_skipped": "laps_skipped",
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				":prefix": &types.AttributeValueMemberS{Value: keys.DriverSessionPrefix},
+				":true":   &types.AttributeValueMemberBOOL{Value: true},
+			},
+			ScanIndexForward:  aws.Bool(false),
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			session, err := driverSessionFromAttributeMap(driverID, item)
+			if err != nil {
+				return nil, err
+			}
+			sessions = append(sessions, *session)
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			return sessions, nil
+		}
+		startKey = result.LastEvaluatedKey
+	}
+}
+
+// CompleteDriverSessionLaps records that lap data has been backfilled for a session ingested without it, setting the
+// traffic cost derived from the laps (if any) and the number of gaps found in them, and clearing the skipped flag.
+func (s *DynamoStore) CompleteDriverSessionLaps(ctx context.Context, driverID int64, startTime time.Time, trafficCost *int, lapGaps int) error {
+	names := map[string]string{
+		"#pk":           partitionKeyName,
+		"#laps_skipped": "laps_skipped",
+	}
+	var sets []string
+	var values map[string]types.AttributeValue
+	if trafficCost != nil {
+		sets = append(sets, "#traffic_cost = :traffic_cost")
+		names["#traffic_cost"] = "traffic_cost"
+		values = map[string]types.AttributeValue{
+			":traffic_cost": &types.AttributeValueMemberN{Value: strconv.Itoa(*trafficCost)},
+		}
+	}
+	if lapGaps > 0 {
+		sets = append(sets, "#lap_gaps = :lap_gaps")
+		names["#lap_gaps"] = "lap_gaps"
+		if values == nil {
+			values = map[string]types.AttributeValue{}
+		}
+		values[":lap_gaps"] = &types.AttributeValueMemberN{Value: strconv.Itoa(lapGaps)}
+	}
+	updateExpression := "REMOVE #laps_skipped"
+	if len(sets) > 0 {
+		updateExpression = "SET " + strings.Join(sets, ", ") + " " + updateExpression
+	}
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverSession(toUnixSeconds(startTime))},
+		},
+		UpdateExpression:          aws.String(updateExpression),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+		ConditionExpression:       aws.String("attribute_exists(#pk)"),
+	})
+	return err
+}
+
+// TagDriverSessionSquadEvents adds squads to those that tagged the driver's session as a squad event. Returns a
+// conditional check failure if the session doesn't exist.
+func (s *DynamoStore) TagDriverSessionSquadEvents(ctx context.Context, driverID int64, startTime time.Time, squadIDs []string) error {
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverSession(toUnixSeconds(startTime))},
+		},
+		UpdateExpression: aws.String("ADD #squad_events :squad_ids"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":           partitionKeyName,
+			"#squad_events": "squad_events",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":squad_ids": &types.AttributeValueMemberSS{Value: squadIDs},
+		},
+		ConditionExpression: aws.String("attribute_exists(#pk)"),
+	})
+	return err
+}
+
+func driverSessionModelFromEntity(ds DriverSession) driverSessionModel {
+	return driverSessionModel{
+		driverID:              ds.DriverID,
+		subsessionID:          ds.SubsessionID,
+		trackID:               ds.TrackID,
+		carID:                 ds.CarID,
+		seriesID:              ds.SeriesID,
+		seriesName:            ds.SeriesName,
+		startTime:             toUnixSeconds(ds.StartTime),
+		startPosition:         ds.StartPosition,
+		startPositionInClass:  ds.StartPositionInClass,
+		finishPosition:        ds.FinishPosition,
+		finishPositionInClass: ds.FinishPositionInClass,
+		incidents:             ds.Incidents,
+		oldCPI:                ds.OldCPI,
+		newCPI:                ds.NewCPI,
+		oldIRating:            ds.OldIRating,
+		newIRating:            ds.NewIRating,
+		oldLicenseLevel:       ds.OldLicenseLevel,
+		newLicenseLevel:       ds.NewLicenseLevel,
+		oldSubLevel:           ds.OldSubLevel,
+		newSubLevel:           ds.NewSubLevel,
+		reasonOut:             ds.ReasonOut,
+		reasonOutCode:         ds.ReasonOutCode,
+		strengthOfField:       ds.StrengthOfField,
+		trafficCost:           ds.TrafficCost,
+		cornersPerLap:         ds.CornersPerLap,
+		licenseCategoryID:     ds.LicenseCategoryID,
+		lapsComplete:          ds.LapsComplete,
+		lapsLead:              ds.LapsLead,
+		carClassID:            ds.CarClassID,
+		seasonID:              ds.SeasonID,
+		seasonYear:            ds.SeasonYear,
+		seasonQuarter:         ds.SeasonQuarter,
+		raceWeekNum:           ds.RaceWeekNum,
+		champPoints:           ds.ChampPoints,
+		dropRace:              ds.DropRace,
+		lapsSkipped:           ds.LapsSkipped,
+		lapGaps:               ds.LapGaps,
+		positions:             ds.Positions,
+	}
+}
+
+// PersistSessionData saves a newly ingested driver session together with the driver's laps for it. Everything lands in
+// a single transaction when it fits. Otherwise the laps are split into deterministic chunks, each written in its own
+// transaction along with a session ingest marker recording progress, and the session record goes in the final
+// transaction, so a session is never visible without its laps. That final transaction also marks the ingest complete,
+// and when an earlier attempt failed part way the chunks it already wrote are skipped. Returns ErrEntityAlreadyExists
+// if the session was already saved.
+func (s *DynamoStore) PersistSessionData(ctx context.Context, session DriverSession, laps []SessionDriverLap) error {
+	_, err := s.PersistSessionDataWithMode(ctx, session, laps, WriteModeStrict)
+	return err
+}
+
+// PersistSessionDataWithMode is PersistSessionData, handling a session that was already saved according to mode.
+// Upserting rewrites the given laps ahead of the session record, but not in a single transaction.
+func (s *DynamoStore) PersistSessionDataWithMode(ctx context.Context, session DriverSession, laps []SessionDriverLap, mode WriteMode) (WriteResult, error) {
+	err := s.persistNewSessionData(ctx, session, laps)
+	if mode == WriteModeStrict || !errors.Is(err, ErrEntityAlreadyExists) {
+		return WriteResult{}, err
+	}
+
+	result := WriteResult{Existing: []DriverSession{session}}
+	if mode == WriteModeSkipExisting {
+		return result, nil
+	}
+
+	items := make([]types.TransactWriteItem, 0, len(laps)+1)
+	for _, lap := range laps {
+		items = append(items, s.put(ctx, sessionDriverLapModelFromEntity(lap).toAttributeMap()))
+	}
+	items = append(items, s.put(ctx, driverSessionModelFromEntity(session).toAttributeMap()))
+	if err := s.executeBatchedTransact(ctx, items); err != nil {
+		return result, fmt.Errorf("overwriting session data: %w", err)
+	}
+	return result, nil
+}
+
+func (s *DynamoStore) persistNewSessionData(ctx context.Context, session DriverSession, laps []SessionDriverLap) error {
+	lapItems := make([]types.TransactWriteItem, len(laps))
+	for i, lap := range laps {
+		lapItems[i] = s.put(ctx, sessionDriverLapModelFromEntity(lap).toAttributeMap())
+	}
+
+	marker := sessionIngestMarkerModel{
+		subsessionID: session.SubsessionID,
+		driverID:     session.DriverID,
+	}
+	// sized with the largest counts the marker could hold so the chunking doesn't depend on them
+	sizingMarker := marker
+	sizingMarker.chunkCount, sizingMarker.chunksWritten = len(laps), len(laps)
+	sessionItems := []types.TransactWriteItem{
+		s.putWithKeyCheck(ctx, driverSessionModelFromEntity(session).toAttributeMap()),
+		s.incrementDriverSessionCount(ctx, session.DriverID, 1),
+		s.put(ctx, sizingMarker.toAttributeMap()),
+	}
+
+	chunks, err := chunkTransactItems(lapItems, []types.TransactWriteItem{s.put(ctx, sizingMarker.toAttributeMap())}, sessionItems)
+	if err != nil {
+		return err
+	}
+	lapChunks, finalLaps := chunks[:len(chunks)-1], chunks[len(chunks)-1]
+	marker.chunkCount = len(lapChunks)
+
+	if len(lapChunks) > 0 {
+		resumeFrom, err := s.sessionIngestResumePoint(ctx, marker)
+		if err != nil {
+			return fmt.Errorf("reading session ingest marker: %w", err)
+		}
+		for i := resumeFrom; i < len(lapChunks); i++ {
+			progress := marker
+			progress.chunksWritten = i + 1
+			_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+				TransactItems: append(slices.Clone(lapChunks[i]), s.put(ctx, progress.toAttributeMap())),
+			})
+			if err != nil {
+				return fmt.Errorf("writing lap chunk %d/%d: %w", i+1, len(lapChunks), mapTransactionError(err))
+			}
+		}
+	}
+
+	marker.chunksWritten = marker.chunkCount
+	marker.complete = true
+	sessionItems[len(sessionItems)-1] = s.put(ctx, marker.toAttributeMap())
+	_, err = s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: append(slices.Clone(finalLaps), sessionItems...),
+	})
+	return mapTransactionError(err)
+}
+
+// sessionIngestResumePoint returns the first lap chunk still needing to be written for a session. Work is only skipped
+// when the marker was left by an attempt that chunked the laps the same way; otherwise writing starts over, which is
+// safe since lap writes simply overwrite.
+func (s *DynamoStore) sessionIngestResumePoint(ctx context.Context, marker sessionIngestMarkerModel) (int, error) {
+	key := marker.toAttributeMap()
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: key[partitionKeyName],
+			sortKeyName:      key[sortKeyName],
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if result.Item == nil {
+		return 0, nil
+	}
+	existing, err := sessionIngestMarkerModelFromAttributeMap(result.Item)
+	if err != nil {
+		return 0, err
+	}
+	if existing.chunkCount != marker.chunkCount {
+		return 0, nil
+	}
+	return existing.chunksWritten, nil
+}
+
+// executeBatchedTransact writes items in as many transactions as the DynamoDB limits require. Each transaction is
+// atomic but the batch as a whole is not.
+func (s *DynamoStore) executeBatchedTransact(ctx context.Context, items []types.TransactWriteItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	batches, err := chunkTransactItems(items, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	for i, batch := range batches {
+		_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+			TransactItems: batch,
+		})
+		if err != nil {
+			return fmt.Errorf("batch %d/%d failed: %w", i+1, len(batches), mapTransactionError(err))
+		}
+	}
+
+	return nil
+}
+
+func (s *DynamoStore) put(ctx context.Context, item map[string]types.AttributeValue) types.TransactWriteItem {
+	return types.TransactWriteItem{
+		Put: &types.Put{
+			TableName: aws.String(s.tableName(ctx)),
+			Item:      item,
+		},
+	}
+}
+
+func (s *DynamoStore) putWithKeyCheck(ctx context.Context, item map[string]types.AttributeValue) types.TransactWriteItem {
+	return types.TransactWriteItem{
+		Put: &types.Put{
+			TableName:           aws.String(s.tableName(ctx)),
+			Item:                item,
+			ConditionExpression: aws.String("attribute_not_exists(#pk)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+			},
+		},
+	}
+}
+
+func (s *DynamoStore) incrementDriverSessionCount(ctx context.Context, driverID int64, count int) types.TransactWriteItem {
+	return types.TransactWriteItem{
+		Update: &types.Update{
+			TableName: aws.String(s.tableName(ctx)),
+			Key: map[string]types.AttributeValue{
+				partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+			},
+			UpdateExpression: aws.String("ADD #session_count :count"),
+			ExpressionAttributeNames: map[string]string{
+				"#session_count": "session_count",
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":count": &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", count)},
+			},
+		},
+	}
+}
+
+// DeleteDriverRaces removes all records under a driver's partition except their info record,
+// and resets their sync state to appear as if they've never synced (useful for testing initial sync flows).
+func (s *DynamoStore) DeleteDriverRaces(ctx context.Context, driverID int64) error {
+	pk := keys.Driver(driverID)
+
+	// Query all items under driver partition, fetching only keys for efficiency
+	result, err := s.query(ctx, "DeleteDriverRaces", &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk"),
+		ProjectionExpression:   aws.String("#pk, #sk"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+			"#sk": sortKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: pk},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("querying driver partition: %w", err)
+	}
+
+	// Collect keys to delete (everything except info and settings)
+	var keysToDelete []map[string]types.AttributeValue
+	for _, item := range result.Items {
+		sk, err := getStringAttr(item, sortKeyName)
+		if err != nil {
+			return fmt.Errorf("reading sort key from driver item: %w", err)
+		}
+		if sk != keys.Info && sk != keys.DriverSettings {
+			keysToDelete = append(keysToDelete, map[string]types.AttributeValue{
+				partitionKeyName: item[partitionKeyName],
+				sortKeyName:      item[sortKeyName],
+			})
+		}
+	}
+
+	if err := s.batchDeleteKeys(ctx, keysToDelete); err != nil {
+		return err
+	}
+
+	// Reset races_ingested_from and races_ingested_to to nil and session_count to 0
+	_, err = s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+		UpdateExpression: aws.String("REMOVE #races_ingested_from, #races_ingested_to SET #session_count = :zero"),
+		ExpressionAttributeNames: map[string]string{
+			"#races_ingested_from": "races_ingested_from",
+			"#races_ingested_to":   "races_ingested_to",
+			"#session_count":       "session_count",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":zero": &types.AttributeValueMemberN{Value: "0"},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("resetting driver info: %w", err)
+	}
+
+	return nil
+}
+
+// batchDeleteKeys deletes items in chunks of the BatchWriteItem limit.
+func (s *DynamoStore) batchDeleteKeys(ctx context.Context, itemKeys []map[string]types.AttributeValue) error {
+	for i := 0; i < len(itemKeys); i += maxBatchWriteItems {
+		end := i + maxBatchWriteItems
+		if end > len(itemKeys) {
+			end = len(itemKeys)
+		}
+		batch := itemKeys[i:end]
+
+		writeRequests := make([]types.WriteRequest, len(batch))
+		for j, key := range batch {
+			writeRequests[j] = types.WriteRequest{
+				DeleteRequest: &types.DeleteRequest{Key: key},
+			}
+		}
+
+		_, err := s.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]types.WriteRequest{
+				s.tableName(ctx): writeRequests,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("batch delete failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func sessionDriverLapModelFromEntity(lap SessionDriverLap) sessionDriverLapModel {
+	return sessionDriverLapModel{
+		subsessionID:    lap.SubsessionID,
+		driverID:        lap.DriverID,
+		lapNumber:       lap.LapNumber,
+		flags:           lap.Flags,
+		incident:        lap.Incident,
+		sessionTime:     lap.SessionTime,
+		lapTime:         lap.LapTime,
+		personalBestLap: lap.PersonalBestLap,
+		lapEvents:       lap.LapEvents,
+		synthetic:       lap.Synthetic,
+	}
+}
+
+// SaveSessionDriverLaps writes lap records, overwriting any previously stored copies of the same laps so that
+// re-ingesting a driver's laps is safe.
+func (s *DynamoStore) SaveSessionDriverLaps(ctx context.Context, laps []SessionDriverLap) error {
+	for i := 0; i < len(laps); i += maxBatchWriteItems {
+		end := i + maxBatchWriteItems
+		if end > len(laps) {
+			end = len(laps)
+		}
+		batch := laps[i:end]
+
+		writeRequests := make([]types.WriteRequest, len(batch))
+		for j, lap := range batch {
+			writeRequests[j] = types.WriteRequest{
+				PutRequest: &types.PutRequest{Item: sessionDriverLapModelFromEntity(lap).toAttributeMap()},
+			}
+		}
+
+		requestItems := map[string][]types.WriteRequest{s.tableName(ctx): writeRequests}
+		for len(requestItems) > 0 {
+			result, err := s.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: requestItems,
+			})
+			if err != nil {
+				return fmt.Errorf("batch write of laps failed: %w", err)
+			}
+			requestItems = result.UnprocessedItems
+		}
+	}
+	return nil
+}
+
+// GetSessionDriverLaps returns the stored laps for a driver in a session, ordered by lap number. An empty slice is
+// returned if no laps have been stored.
+func (s *DynamoStore) GetSessionDriverLaps(ctx context.Context, subsessionID, driverID int64) ([]SessionDriverLap, error) {
+	laps := make([]SessionDriverLap, 0)
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetSessionDriverLaps", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: keys.Session(subsessionID)},
+				":prefix": &types.AttributeValueMemberS{Value: keys.SessionDriverLapDriverPrefix(driverID)},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			lap, err := sessionDriverLapFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			laps = append(laps, *lap)
+		}
+		if result.LastEvaluatedKey == nil {
+			break
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+
+	// sort keys aren't zero padded, so lap 10 sorts before lap 2
+	sort.Slice(laps, func(i, j int) bool {
+		return laps[i].LapNumber < laps[j].LapNumber
+	})
+	return laps, nil
+}
+
+// GetSessionLapsInRaceOrder returns up to limit of the stored laps in a session, across every driver, in the order
+// they were completed. after is the RaceOrderCursor of the last lap already read, empty to start from the beginning.
+// The returned bool reports whether there are more laps after the page. Laps without a completion time are left out.
+func (s *DynamoStore) GetSessionLapsInRaceOrder(ctx context.Context, subsessionID int64, after string, limit int) ([]SessionDriverLap, bool, error) {
+	keyCondition := "#pk = :pk"
+	names := map[string]string{"#pk": partitionKeyName}
+	values := map[string]types.AttributeValue{
+		":pk": &types.AttributeValueMemberS{Value: keys.Session(subsessionID)},
+	}
+	if after != "" {
+		keyCondition += " AND #ro > :after"
+		names["#ro"] = raceOrderAttributeName
+		values[":after"] = &types.AttributeValueMemberS{Value: after}
+	}
+
+	// one more than asked for tells whether there is another page
+	laps := make([]SessionDriverLap, 0, limit+1)
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetSessionLapsInRaceOrder", &dynamodb.QueryInput{
+			TableName:                 aws.String(s.tableName(ctx)),
+			IndexName:                 aws.String(raceOrderIndexName),
+			KeyConditionExpression:    aws.String(keyCondition),
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+			Limit:                     aws.Int32(int32(limit + 1 - len(laps))),
+			ExclusiveStartKey:         exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		for _, item := range result.Items {
+			lap, err := sessionDriverLapFromAttributeMap(item)
+			if err != nil {
+				return nil, false, err
+			}
+			laps = append(laps, *lap)
+		}
+		if len(laps) > limit {
+			return laps[:limit], true, nil
+		}
+		if result.LastEvaluatedKey == nil {
+			return laps, false, nil
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// DeleteSessionDriverLaps removes the stored laps for a driver in a session. Returns nil if there are none.
+func (s *DynamoStore) DeleteSessionDriverLaps(ctx context.Context, subsessionID, driverID int64) error {
+	result, err := s.query(ctx, "DeleteSessionDriverLaps", &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+		ProjectionExpression:   aws.String("#pk, #sk"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+			"#sk": sortKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":     &types.AttributeValueMemberS{Value: keys.Session(subsessionID)},
+			":prefix": &types.AttributeValueMemberS{Value: keys.SessionDriverLapDriverPrefix(driverID)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("querying session laps: %w", err)
+	}
+
+	itemKeys := make([]map[string]types.AttributeValue, len(result.Items))
+	for i, item := range result.Items {
+		itemKeys[i] = map[string]types.AttributeValue{
+			partitionKeyName: item[partitionKeyName],
+			sortKeyName:      item[sortKeyName],
+		}
+	}
+	return s.batchDeleteKeys(ctx, itemKeys)
+}
+
+// SaveSessionDriverLapSummary stores the compacted summary of a driver's laps in a session, replacing any existing one.
+func (s *DynamoStore) SaveSessionDriverLapSummary(ctx context.Context, summary SessionDriverLapSummary) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      sessionDriverLapSummaryModelFromEntity(summary).toAttributeMap(),
+	})
+	return err
+}
+
+func sessionDriverLapSummaryModelFromEntity(summary SessionDriverLapSummary) sessionDriverLapSummaryModel {
+	return sessionDriverLapSummaryModel{
+		subsessionID:     summary.SubsessionID,
+		driverID:         summary.DriverID,
+		lapCount:         summary.LapCount,
+		validLapCount:    summary.ValidLapCount,
+		incidentLapCount: summary.IncidentLapCount,
+		bestLapTime:      summary.BestLapTime,
+		avgLapTime:       summary.AvgLapTime,
+		compactedAt:      toUnixSeconds(summary.CompactedAt),
+	}
+}
+
+// GetSessionDriverLapSummary retrieves the compacted lap summary for a driver in a session.
+// Returns nil if the driver's laps have not been compacted.
+func (s *DynamoStore) GetSessionDriverLapSummary(ctx context.Context, subsessionID, driverID int64) (*SessionDriverLapSummary, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Session(subsessionID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.SessionDriverLapSummary(driverID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return sessionDriverLapSummaryFromAttributeMap(result.Item)
+}
+
+// SaveJournalEntry creates or updates a journal entry for a race (upsert semantics).
+// CreatedAt is set on first save; UpdatedAt is always updated.
+func (s *DynamoStore) SaveJournalEntry(ctx context.Context, entry RaceJournalEntry) error {
+	now := s.now()
+
+	// For upsert: set created_at only if it doesn't exist, always update updated_at. Transcripts and their terms are
+	// left alone, they only change through AppendJournalTranscript.
+	updateExpression := "SET #driver_id = :driver_id, #race_id = :race_id, #notes = :notes, #updated_at = :updated_at, #created_at = if_not_exists(#created_at, :created_at), #tags = :tags, #replay_video = :replay_video"
+	values := s.journalEntryUpdateValues(entry, now)
+	pk := keys.Driver(entry.DriverID)
+	sk := keys.JournalEntry(entry.RaceID)
+	notes, err := s.sealValue(ctx, pk, sk, "notes", values[":notes"])
+	if err != nil {
+		return fmt.Errorf("encrypting notes: %w", err)
+	}
+	values[":notes"] = notes
+	if len(entry.SearchTerms) > 0 {
+		updateExpression += ", #search_terms = :search_terms"
+		values[":search_terms"] = &types.AttributeValueMemberSS{Value: entry.SearchTerms}
+	} else {
+		// string sets can't be empty
+		updateExpression += " REMOVE #search_terms"
+	}
+
+	_, err = s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+			sortKeyName:      &types.AttributeValueMemberS{Value: sk},
+		},
+		UpdateExpression: aws.String(updateExpression),
+		ExpressionAttributeNames: map[string]string{
+			"#driver_id":    "driver_id",
+			"#race_id":      "race_id",
+			"#notes":        "notes",
+			"#updated_at":   "updated_at",
+			"#created_at":   "created_at",
+			"#tags":         "tags",
+			"#replay_video": "replay_video",
+			"#search_terms": "search_terms",
+		},
+		ExpressionAttributeValues: values,
+	})
+	return err
+}
+
+// AppendJournalTranscript adds a voice memo transcript to a journal entry, along with its search terms, without
+// touching the entry's UpdatedAt. Returns false if the entry doesn't exist, as it may have been deleted while the memo
+// was being transcribed.
+func (s *DynamoStore) AppendJournalTranscript(ctx context.Context, driverID, raceID int64, transcript string, terms []string) (bool, error) {
+	pk := keys.Driver(driverID)
+	sk := keys.JournalEntry(raceID)
+	transcripts, err := s.sealValue(ctx, pk, sk, "transcripts", &types.AttributeValueMemberL{Value: []types.AttributeValue{&types.AttributeValueMemberS{Value: transcript}}})
+	if err != nil {
+		return false, fmt.Errorf("encrypting transcript: %w", err)
+	}
+
+	updateExpression := "SET #transcripts = list_append(if_not_exists(#transcripts, :empty), :transcript)"
+	names := map[string]string{
+		"#pk":          partitionKeyName,
+		"#transcripts": "transcripts",
+	}
+	values := map[string]types.AttributeValue{
+		":empty":      &types.AttributeValueMemberL{Value: []types.AttributeValue{}},
+		":transcript": transcripts,
+	}
+	if len(terms) > 0 {
+		updateExpression += " ADD #transcript_terms :terms"
+		names["#transcript_terms"] = "transcript_terms"
+		values[":terms"] = &types.AttributeValueMemberSS{Value: terms}
+	}
+
+	_, err = s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+			sortKeyName:      &types.AttributeValueMemberS{Value: sk},
+		},
+		UpdateExpression:          aws.String(updateExpression),
+		ConditionExpression:       aws.String("attribute_exists(#pk)"),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// SaveJournalAttachment stores an attachment record, replacing any existing record with the same ID.
+func (s *DynamoStore) SaveJournalAttachment(ctx context.Context, attachment JournalAttachment) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      journalAttachmentModelFromEntity(attachment).toAttributeMap(),
+	})
+	return err
+}
+
+// GetJournalAttachment retrieves a single attachment. Returns nil if it doesn't exist.
+func (s *DynamoStore) GetJournalAttachment(ctx context.Context, driverID, raceID int64, attachmentID string) (*JournalAttachment, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalAttachment(raceID, attachmentID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return journalAttachmentFromAttributeMap(result.Item)
+}
+
+// GetJournalAttachments retrieves all attachments for a race, ordered by attachment ID.
+func (s *DynamoStore) GetJournalAttachments(ctx context.Context, driverID, raceID int64) ([]JournalAttachment, error) {
+	var attachments []JournalAttachment
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetJournalAttachments", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				":prefix": &types.AttributeValueMemberS{Value: keys.JournalAttachmentRacePrefix(raceID)},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			attachment, err := journalAttachmentFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			attachments = append(attachments, *attachment)
+		}
+		if result.LastEvaluatedKey == nil {
+			return attachments, nil
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// SetJournalAttachmentStatus moves an existing attachment to a new status. Returns false if the attachment doesn't
+// exist.
+func (s *DynamoStore) SetJournalAttachmentStatus(ctx context.Context, driverID, raceID int64, attachmentID string, status JournalAttachmentStatus) (bool, error) {
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalAttachment(raceID, attachmentID)},
+		},
+		UpdateExpression:    aws.String("SET #status = :status"),
+		ConditionExpression: aws.String("attribute_exists(#pk)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":     partitionKeyName,
+			"#status": "status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":status": &types.AttributeValueMemberS{Value: string(status)},
+		},
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *DynamoStore) journalEntryUpdateValues(entry RaceJournalEntry, now time.Time) map[string]types.AttributeValue {
+	nowUnix := toUnixSeconds(now)
+	values := map[string]types.AttributeValue{
+		":driver_id":    &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", entry.DriverID)},
+		":race_id":      &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", entry.RaceID)},
+		":notes":        &types.AttributeValueMemberS{Value: entry.Notes},
+		":updated_at":   &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", nowUnix)},
+		":created_at":   &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", nowUnix)},
+		":replay_video": &types.AttributeValueMemberS{Value: entry.ReplayVideo},
+	}
+	if len(entry.Tags) > 0 {
+		tagValues := make([]types.AttributeValue, len(entry.Tags))
+		for i, t := range entry.Tags {
+			tagValues[i] = &types.AttributeValueMemberS{Value: t}
+		}
+		values[":tags"] = &types.AttributeValueMemberL{Value: tagValues}
+	} else {
+		values[":tags"] = &types.AttributeValueMemberL{Value: []types.AttributeValue{}}
+	}
+	return values
+}
+
+// GetJournalEntry retrieves a single journal entry for a specific race.
+// Returns nil if no entry exists.
+func (s *DynamoStore) GetJournalEntry(ctx context.Context, driverID, raceID int64, opts ...ReadOption) (*RaceJournalEntry, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalEntry(raceID)},
+		},
+		ConsistentRead: aws.Bool(applyReadOptions(opts).consistent),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	if err := s.openItem(ctx, result.Item); err != nil {
+		return nil, err
+	}
+	return journalEntryFromAttributeMap(result.Item)
+}
+
+// GetJournalEntries retrieves journal entries for a driver within a time range.
+// Returns entries in reverse chronological order (newest first).
+func (s *DynamoStore) GetJournalEntries(ctx context.Context, driverID int64, from, to time.Time) ([]RaceJournalEntry, error) {
+	result, err := s.query(ctx, "GetJournalEntries", &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :from AND :to"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+			"#sk": sortKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":   &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			":from": &types.AttributeValueMemberS{Value: keys.JournalEntry(toUnixSeconds(from))},
+			":to":   &types.AttributeValueMemberS{Value: keys.JournalEntry(toUnixSeconds(to))},
+		},
+		ScanIndexForward: aws.Bool(false), // newest first
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]RaceJournalEntry, 0, len(result.Items))
+	for _, item := range result.Items {
+		if err := s.openItem(ctx, item); err != nil {
+			return nil, err
+		}
+		entry, err := journalEntryFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+// DeleteJournalEntry removes a journal entry for a specific race.
+// Returns nil even if the entry doesn't exist (idempotent delete).
+func (s *DynamoStore) DeleteJournalEntry(ctx context.Context, driverID, raceID int64) error {
+	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalEntry(raceID)},
+		},
+	})
+	return err
+}
+
+// SaveJournalDraft stores the draft for a race, replacing any earlier draft. SavedAt is set to the current time and the
+// draft is removed by the table TTL once ExpiresAt has passed.
+func (s *DynamoStore) SaveJournalDraft(ctx context.Context, draft RaceJournalDraft) error {
+	item := journalDraftModelFromEntity(draft, s.now()).toAttributeMap()
+	if err := s.sealItem(ctx, item); err != nil {
+		return err
+	}
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      item,
+	})
+	return err
+}
+
+// GetJournalDraft retrieves the draft for a race. Returns nil if there is no draft, including when it has expired but
+// not yet been removed by the TTL sweep, which can lag by days.
+func (s *DynamoStore) GetJournalDraft(ctx context.Context, driverID, raceID int64, opts ...ReadOption) (*RaceJournalDraft, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalDraft(raceID)},
+		},
+		ConsistentRead: aws.Bool(applyReadOptions(opts).consistent),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	if err := s.openItem(ctx, result.Item); err != nil {
+		return nil, err
+	}
+	return unexpiredJournalDraft(result.Item, s.now())
+}
+
+// DeleteJournalDraft removes the draft for a race.
+// Returns nil even if there is no draft (idempotent delete).
+func (s *DynamoStore) DeleteJournalDraft(ctx context.Context, driverID, raceID int64) error {
+	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalDraft(raceID)},
+		},
+	})
+	return err
+}
+
+// SaveJournalPrompt raises a prompt to journal a race, replacing any earlier prompt for it. CreatedAt is set to the
+// current time.
+func (s *DynamoStore) SaveJournalPrompt(ctx context.Context, prompt JournalPrompt) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      journalPromptModelFromEntity(prompt, s.now()).toAttributeMap(),
+	})
+	return err
+}
+
+// GetJournalPrompts retrieves the driver's prompts for races run within a time range.
+// Returns prompts in reverse chronological order (newest race first).
+func (s *DynamoStore) GetJournalPrompts(ctx context.Context, driverID int64, from, to time.Time) ([]JournalPrompt, error) {
+	result, err := s.query(ctx, "GetJournalPrompts", &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :from AND :to"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+			"#sk": sortKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":   &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			":from": &types.AttributeValueMemberS{Value: keys.JournalPrompt(toUnixSeconds(from))},
+			":to":   &types.AttributeValueMemberS{Value: keys.JournalPrompt(toUnixSeconds(to))},
+		},
+		ScanIndexForward: aws.Bool(false), // newest first
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	prompts := make([]JournalPrompt, 0, len(result.Items))
+	for _, item := range result.Items {
+		if ttlExpired(item, now) {
+			continue
+		}
+		prompt, err := journalPromptFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		prompts = append(prompts, *prompt)
+	}
+	return prompts, nil
+}
+
+// DeleteJournalPrompt removes the prompt for a race.
+// Returns nil even if there is no prompt (idempotent delete).
+func (s *DynamoStore) DeleteJournalPrompt(ctx context.Context, driverID, raceID int64) error {
+	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalPrompt(raceID)},
+		},
+	})
+	return err
+}
+
+// SaveJournalStreak stores a driver's journaling streak, replacing the previous one. UpdatedAt is set to the current
+// time.
+func (s *DynamoStore) SaveJournalStreak(ctx context.Context, streak JournalStreak) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      journalStreakModelFromEntity(streak, s.now()).toAttributeMap(),
+	})
+	return err
+}
+
+// GetJournalStreak retrieves a driver's journaling streak. Returns nil if the driver has never journaled a race.
+func (s *DynamoStore) GetJournalStreak(ctx context.Context, driverID int64) (*JournalStreak, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.JournalStreak},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return journalStreakFromAttributeMap(result.Item)
+}
+
+// SaveActionItem stores an action item, replacing any existing item with the same ID.
+func (s *DynamoStore) SaveActionItem(ctx context.Context, item ActionItem) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      actionItemModelFromEntity(item).toAttributeMap(),
+	})
+	return err
+}
+
+// GetActionItem retrieves a single action item. Returns nil if it doesn't exist.
+func (s *DynamoStore) GetActionItem(ctx context.Context, driverID int64, itemID string) (*ActionItem, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.ActionItem(itemID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return actionItemFromAttributeMap(result.Item)
+}
+
+// GetActionItems retrieves all of a driver's action items, open and done, ordered by item ID.
+func (s *DynamoStore) GetActionItems(ctx context.Context, driverID int64) ([]ActionItem, error) {
+	var items []ActionItem
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetActionItems", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				":prefix": &types.AttributeValueMemberS{Value: keys.ActionItemPrefix},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range result.Items {
+			item, err := actionItemFromAttributeMap(i)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, *item)
+		}
+		if result.LastEvaluatedKey == nil {
+			return items, nil
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// DeleteActionItem removes an action item.
+// Returns nil even if the item doesn't exist (idempotent delete).
+func (s *DynamoStore) DeleteActionItem(ctx context.Context, driverID int64, itemID string) error {
+	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.ActionItem(itemID)},
+		},
+	})
+	return err
+}
+
+// SaveAlertRule stores a trend alert rule, replacing any existing rule with the same ID.
+func (s *DynamoStore) SaveAlertRule(ctx context.Context, rule AlertRule) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      alertRuleModelFromEntity(rule).toAttributeMap(),
+	})
+	return err
+}
+
+// GetAlertRule retrieves a single trend alert rule. Returns nil if it doesn't exist.
+func (s *DynamoStore) GetAlertRule(ctx context.Context, driverID int64, ruleID string) (*AlertRule, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.AlertRule(ruleID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return alertRuleFromAttributeMap(result.Item)
+}
+
+// GetAlertRules retrieves all of a driver's trend alert rules, enabled or not, ordered by rule ID.
+func (s *DynamoStore) GetAlertRules(ctx context.Context, driverID int64) ([]AlertRule, error) {
+	var rules []AlertRule
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetAlertRules", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				":prefix": &types.AttributeValueMemberS{Value: keys.AlertRulePrefix},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			rule, err := alertRuleFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, *rule)
+		}
+		if result.LastEvaluatedKey == nil {
+			return rules, nil
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// DeleteAlertRule removes a trend alert rule.
+// Returns nil even if the rule doesn't exist (idempotent delete).
+func (s *DynamoStore) DeleteAlertRule(ctx context.Context, driverID int64, ruleID string) error {
+	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.AlertRule(ruleID)},
+		},
+	})
+	return err
+}
+
+// SaveRacePlan stores a race plan, replacing any existing plan for the same race week, series and track.
+func (s *DynamoStore) SaveRacePlan(ctx context.Context, plan RacePlan) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      racePlanModelFromEntity(plan).toAttributeMap(),
+	})
+	return err
+}
+
+// GetRacePlan retrieves the driver's plan for a series and track in the race week starting at weekStart. Returns nil
+// if they haven't planned it.
+func (s *DynamoStore) GetRacePlan(ctx context.Context, driverID int64, weekStart time.Time, seriesID, trackID int64) (*RacePlan, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RacePlan(weekStart.Unix(), seriesID, trackID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return racePlanFromAttributeMap(result.Item)
+}
+
+// GetRacePlans retrieves all of a driver's race plans, ordered by race week.
+func (s *DynamoStore) GetRacePlans(ctx context.Context, driverID int64) ([]RacePlan, error) {
+	var plans []RacePlan
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetRacePlans", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				":prefix": &types.AttributeValueMemberS{Value: keys.RacePlanPrefix},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			plan, err := racePlanFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			plans = append(plans, *plan)
+		}
+		if result.LastEvaluatedKey == nil {
+			return plans, nil
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// LinkRacePlan links a race plan to a race run for it. A plan already linked to an earlier race is left alone, so a
+// driver running the same combination several times in a week has the plan linked to their first attempt whatever
+// order the races are ingested in. The plan's evaluation is replaced with evaluation, removed when it is nil. Returns
+// false, changing nothing, if the plan doesn't exist or was left alone.
+func (s *DynamoStore) LinkRacePlan(ctx context.Context, driverID int64, weekStart time.Time, seriesID, trackID, raceID, subsessionID int64, linkedAt time.Time, evaluation *PlanEvaluation) (bool, error) {
+	updateExpression := "SET #race_id = :race_id, #subsession_id = :subsession_id, #linked_at = :linked_at"
+	values := map[string]types.AttributeValue{
+		":race_id":       &types.AttributeValueMemberN{Value: strconv.FormatInt(raceID, 10)},
+		":subsession_id": &types.AttributeValueMemberN{Value: strconv.FormatInt(subsessionID, 10)},
+		":linked_at":     &types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(linkedAt), 10)},
+	}
+	// an evaluation left over from a later race the plan was linked to first doesn't belong to this one
+	if evaluation != nil {
+		updateExpression += ", #evaluation = :evaluation"
+		values[":evaluation"] = planEvaluationAttr(*evaluation)
+	} else {
+		updateExpression += " REMOVE #evaluation"
+	}
+
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RacePlan(weekStart.Unix(), seriesID, trackID)},
+		},
+		UpdateExpression:    aws.String(updateExpression),
+		ConditionExpression: aws.String("attribute_exists(#pk) AND (attribute_not_exists(#race_id) OR #race_id >= :race_id)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":            partitionKeyName,
+			"#race_id":       "race_id",
+			"#subsession_id": "subsession_id",
+			"#linked_at":     "linked_at",
+			"#evaluation":    "evaluation",
+		},
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteRacePlan removes a race plan.
+// Returns nil even if the plan doesn't exist (idempotent delete).
+func (s *DynamoStore) DeleteRacePlan(ctx context.Context, driverID int64, weekStart time.Time, seriesID, trackID int64) error {
+	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RacePlan(weekStart.Unix(), seriesID, trackID)},
+		},
+	})
+	return err
+}
+
+// SaveRaceBookmark stores a replay bookmark, replacing any existing bookmark with the same ID.
+func (s *DynamoStore) SaveRaceBookmark(ctx context.Context, bookmark RaceBookmark) error {
+	item := raceBookmarkModelFromEntity(bookmark).toAttributeMap()
+	if err := s.sealItem(ctx, item); err != nil {
+		return err
+	}
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      item,
+	})
+	return err
+}
+
+// GetRaceBookmark retrieves a single replay bookmark. Returns nil if it doesn't exist.
+func (s *DynamoStore) GetRaceBookmark(ctx context.Context, driverID, raceID int64, bookmarkID string) (*RaceBookmark, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RaceBookmark(raceID, bookmarkID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	if err := s.openItem(ctx, result.Item); err != nil {
+		return nil, err
+	}
+	return raceBookmarkFromAttributeMap(result.Item)
+}
+
+// GetRaceBookmarks retrieves all of the replay bookmarks for one of a driver's races, ordered by bookmark ID.
+func (s *DynamoStore) GetRaceBookmarks(ctx context.Context, driverID, raceID int64) ([]RaceBookmark, error) {
+	var bookmarks []RaceBookmark
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetRaceBookmarks", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				":prefix": &types.AttributeValueMemberS{Value: keys.RaceBookmarkRacePrefix(raceID)},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			if err := s.openItem(ctx, item); err != nil {
+				return nil, err
+			}
+			bookmark, err := raceBookmarkFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			bookmarks = append(bookmarks, *bookmark)
+		}
+		if result.LastEvaluatedKey == nil {
+			return bookmarks, nil
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// DeleteRaceBookmark removes a replay bookmark.
+// Returns nil even if the bookmark doesn't exist (idempotent delete).
+func (s *DynamoStore) DeleteRaceBookmark(ctx context.Context, driverID, raceID int64, bookmarkID string) error {
+	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RaceBookmark(raceID, bookmarkID)},
+		},
+	})
+	return err
+}
+
+// SaveVideoLink stores a video link attached to one of a driver's races.
+func (s *DynamoStore) SaveVideoLink(ctx context.Context, link VideoLink) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      videoLinkModelFromEntity(link).toAttributeMap(),
+	})
+	return err
+}
+
+// GetVideoLinks retrieves all of the video links attached to one of a driver's races, ordered by link ID.
+func (s *DynamoStore) GetVideoLinks(ctx context.Context, driverID, raceID int64) ([]VideoLink, error) {
+	var links []VideoLink
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetVideoLinks", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":     &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				":prefix": &types.AttributeValueMemberS{Value: keys.VideoLinkRacePrefix(raceID)},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			link, err := videoLinkFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			links = append(links, *link)
+		}
+		if result.LastEvaluatedKey == nil {
+			return links, nil
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// DeleteVideoLink removes a video link from a race.
+// Returns nil even if the link doesn't exist (idempotent delete).
+func (s *DynamoStore) DeleteVideoLink(ctx context.Context, driverID, raceID int64, linkID string) error {
+	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.VideoLink(raceID, linkID)},
+		},
+	})
+	return err
+}
+
+// SaveRaceTelemetry stores the telemetry summary imported for a race, replacing any earlier import.
+func (s *DynamoStore) SaveRaceTelemetry(ctx context.Context, telemetry RaceTelemetry) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      raceTelemetryModelFromEntity(telemetry).toAttributeMap(),
+	})
+	return err
+}
+
+// GetRaceTelemetry retrieves the telemetry summary imported for a race. Returns nil if none has been imported.
+func (s *DynamoStore) GetRaceTelemetry(ctx context.Context, driverID, raceID int64) (*RaceTelemetry, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RaceTelemetry(raceID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return raceTelemetryFromAttributeMap(result.Item)
+}
+
+// SaveExternalLaps stores laps imported from a third party service. Importing a lap again overwrites it.
+func (s *DynamoStore) SaveExternalLaps(ctx context.Context, laps []ExternalLap) error {
+	for i := 0; i < len(laps); i += maxBatchWriteItems {
+		end := i + maxBatchWriteItems
+		if end > len(laps) {
+			end = len(laps)
+		}
+		batch := laps[i:end]
+
+		writeRequests := make([]types.WriteRequest, len(batch))
+		for j, lap := range batch {
+			writeRequests[j] = types.WriteRequest{
+				PutRequest: &types.PutRequest{Item: externalLapModelFromEntity(lap).toAttributeMap()},
+			}
+		}
+
+		requestItems := map[string][]types.WriteRequest{s.tableName(ctx): writeRequests}
+		for len(requestItems) > 0 {
+			result, err := s.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: requestItems,
+			})
+			if err != nil {
+				return fmt.Errorf("batch write of external laps failed: %w", err)
+			}
+			requestItems = result.UnprocessedItems
+		}
+	}
+	return nil
+}
+
+// GetExternalLapsByTimeRange returns a driver's imported laps from sessions starting between from and to inclusive,
+// oldest session first and in lap order within a session.
+func (s *DynamoStore) GetExternalLapsByTimeRange(ctx context.Context, driverID int64, from, to time.Time) ([]ExternalLap, error) {
+	var laps []ExternalLap
+	var exclusiveStartKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetExternalLapsByTimeRange", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :from AND :to"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":   &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				":from": &types.AttributeValueMemberS{Value: keys.ExternalLapsFrom(toUnixSeconds(from))},
+				// a second past the end, sort keys carry more after the timestamp so anything starting at to sorts before this
+				":to": &types.AttributeValueMemberS{Value: keys.ExternalLapsFrom(toUnixSeconds(to) + 1)},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			lap, err := externalLapFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			laps = append(laps, *lap)
+		}
+		if result.LastEvaluatedKey == nil {
+			return laps, nil
+		}
+		exclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// SavePracticePlan stores a driver's practice plan, replacing the previous one.
+func (s *DynamoStore) SavePracticePlan(ctx context.Context, plan PracticePlan) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      practicePlanModelFromEntity(plan).toAttributeMap(),
+	})
+	return err
+}
+
+// GetPracticePlan retrieves a driver's practice plan. Returns nil if one has never been generated.
+func (s *DynamoStore) GetPracticePlan(ctx context.Context, driverID int64) (*PracticePlan, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.PracticePlan},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return practicePlanFromAttributeMap(result.Item)
+}
+
+// SaveDashboardLayout stores how a driver has arranged their dashboard, replacing the previous layout.
+func (s *DynamoStore) SaveDashboardLayout(ctx context.Context, layout DashboardLayout) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      dashboardLayoutModelFromEntity(layout).toAttributeMap(),
+	})
+	return err
+}
+
+// GetDashboardLayout retrieves how a driver has arranged their dashboard. Returns nil if they have never saved a layout.
+func (s *DynamoStore) GetDashboardLayout(ctx context.Context, driverID int64) (*DashboardLayout, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.DashboardLayout},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return dashboardLayoutFromAttributeMap(result.Item)
+}
+
+// SaveSeasonCard stores a driver's season card along with the driver's pointer to its token. replacing is the token of
+// the card it replaces, which is deleted in the same transaction so the old token stops working, empty if there is
+// none.
+func (s *DynamoStore) SaveSeasonCard(ctx context.Context, card SeasonCard, replacing string) error {
+	items := []types.TransactWriteItem{
+		{
+			Put: &types.Put{
+				TableName: aws.String(s.tableName(ctx)),
+				Item:      seasonCardModelFromEntity(card).toAttributeMap(),
+			},
+		},
+		{
+			Put: &types.Put{
+				TableName: aws.String(s.tableName(ctx)),
+				Item:      seasonCardTokenModel{driverID: card.DriverID, token: card.Token}.toAttributeMap(),
+			},
+		},
+	}
+	if replacing != "" && replacing != card.Token {
+		items = append(items, types.TransactWriteItem{
+			Delete: &types.Delete{
+				TableName: aws.String(s.tableName(ctx)),
+				Key: map[string]types.AttributeValue{
+					partitionKeyName: &types.AttributeValueMemberS{Value: keys.SeasonCard(replacing)},
+					sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+				},
+			},
+		})
+	}
+	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: items})
+	return err
+}
+
+// RefreshSeasonCard replaces the snapshot of the season card shared with token. Returns false, changing nothing, if
+// the card has since been replaced or deleted, so a refresh racing a driver revoking their card can't bring it back.
+func (s *DynamoStore) RefreshSeasonCard(ctx context.Context, token, snapshot string, refreshedAt time.Time) (bool, error) {
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.SeasonCard(token)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+		UpdateExpression:    aws.String("SET #snapshot = :snapshot, #refreshed_at = :refreshed_at"),
+		ConditionExpression: aws.String("attribute_exists(#pk)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":           partitionKeyName,
+			"#snapshot":     "snapshot",
+			"#refreshed_at": "refreshed_at",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":snapshot":     &types.AttributeValueMemberS{Value: snapshot},
+			":refreshed_at": &types.AttributeValueMemberN{Value: strconv.FormatInt(refreshedAt.Unix(), 10)},
+		},
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// GetSeasonCard retrieves a driver's season card. Returns nil if they haven't shared one.
+func (s *DynamoStore) GetSeasonCard(ctx context.Context, driverID int64) (*SeasonCard, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.SeasonCardToken},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	pointer, err := seasonCardTokenModelFromAttributeMap(result.Item)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetSeasonCardByToken(ctx, pointer.token)
+}
+
+// GetSeasonCardByToken retrieves the season card shared with token. Returns nil if no card is shared with it.
+func (s *DynamoStore) GetSeasonCardByToken(ctx context.Context, token string) (*SeasonCard, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.SeasonCard(token)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return seasonCardFromAttributeMap(result.Item)
+}
+
+// DeleteSeasonCard stops sharing a driver's season card, deleting both the card and the driver's pointer to it.
+func (s *DynamoStore) DeleteSeasonCard(ctx context.Context, driverID int64, token string) error {
+	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				Delete: &types.Delete{
+					TableName: aws.String(s.tableName(ctx)),
+					Key: map[string]types.AttributeValue{
+						partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+						sortKeyName:      &types.AttributeValueMemberS{Value: keys.SeasonCardToken},
+					},
+				},
+			},
+			{
+				Delete: &types.Delete{
+					TableName: aws.String(s.tableName(ctx)),
+					Key: map[string]types.AttributeValue{
+						partitionKeyName: &types.AttributeValueMemberS{Value: keys.SeasonCard(token)},
+						sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+					},
+				},
+			},
+		},
+	})
+	return err
+}
+
+// ConsumeSeasonCardFetch counts one public fetch of the season card shared with token against the minute starting at
+// windowStart, as long as there have been fewer than limit. Returns (true, nil) if the fetch was counted, (false, nil)
+// if the limit has been reached, (false, err) on error. Windows expire an hour after they start.
+func (s *DynamoStore) ConsumeSeasonCardFetch(ctx context.Context, token string, windowStart time.Time, limit int) (bool, error) {
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.SeasonCard(token)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.SeasonCardFetches(windowStart.Unix())},
+		},
+		UpdateExpression:    aws.String("SET #ttl = :ttl ADD #fetches :one"),
+		ConditionExpression: aws.String("attribute_not_exists(#fetches) OR #fetches < :limit"),
+		ExpressionAttributeNames: map[string]string{
+			"#ttl":     ttlAttributeName,
+			"#fetches": "fetches",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":ttl":   ttlAttr(windowStart.Add(seasonCardFetchesTTLDuration).Unix()),
+			":one":   &types.AttributeValueMemberN{Value: "1"},
+			":limit": &types.AttributeValueMemberN{Value: strconv.Itoa(limit)},
+		},
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// SaveOverlay stores a driver's stream overlay along with the driver's pointer to its token. replacing is the token of
+// the overlay it replaces, which is deleted in the same transaction so the old token stops working, empty if there is
+// none.
+func (s *DynamoStore) SaveOverlay(ctx context.Context, overlay Overlay, replacing string) error {
+	items := []types.TransactWriteItem{
+		{
+			Put: &types.Put{
+				TableName: aws.String(s.tableName(ctx)),
+				Item:      overlayModelFromEntity(overlay).toAttributeMap(),
+			},
+		},
+		{
+			Put: &types.Put{
+				TableName: aws.String(s.tableName(ctx)),
+				Item:      overlayTokenModel{driverID: overlay.DriverID, token: overlay.Token}.toAttributeMap(),
+			},
+		},
+	}
+	if replacing != "" && replacing != overlay.Token {
+		items = append(items, types.TransactWriteItem{
+			Delete: &types.Delete{
+				TableName: aws.String(s.tableName(ctx)),
+				Key: map[string]types.AttributeValue{
+					partitionKeyName: &types.AttributeValueMemberS{Value: keys.Overlay(replacing)},
+					sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+				},
+			},
+		})
+	}
+	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: items})
+	return err
+}
+
+// RefreshOverlay replaces the snapshot of the overlay served with token. Returns false, changing nothing, if the
+// overlay has since been replaced or deleted, so a refresh racing a driver revoking their overlay can't bring it back.
+func (s *DynamoStore) RefreshOverlay(ctx context.Context, token, snapshot string, refreshedAt time.Time) (bool, error) {
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Overlay(token)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+		UpdateExpression:    aws.String("SET #snapshot = :snapshot, #refreshed_at = :refreshed_at"),
+		ConditionExpression: aws.String("attribute_exists(#pk)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":           partitionKeyName,
+			"#snapshot":     "snapshot",
+			"#refreshed_at": "refreshed_at",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":snapshot":     &types.AttributeValueMemberS{Value: snapshot},
+			":refreshed_at": &types.AttributeValueMemberN{Value: strconv.FormatInt(refreshedAt.Unix(), 10)},
+		},
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// GetOverlay retrieves a driver's stream overlay. Returns nil if they haven't set one up.
+func (s *DynamoStore) GetOverlay(ctx context.Context, driverID int64) (*Overlay, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.OverlayToken},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	pointer, err := overlayTokenModelFromAttributeMap(result.Item)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetOverlayByToken(ctx, pointer.token)
+}
+
+// GetOverlayByToken retrieves the overlay served with token. Returns nil if no overlay is served with it.
+func (s *DynamoStore) GetOverlayByToken(ctx context.Context, token string) (*Overlay, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Overlay(token)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return overlayFromAttributeMap(result.Item)
+}
+
+// DeleteOverlay takes down a driver's stream overlay, deleting both the overlay and the driver's pointer to it.
+func (s *DynamoStore) DeleteOverlay(ctx context.Context, driverID int64, token string) error {
+	_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				Delete: &types.Delete{
+					TableName: aws.String(s.tableName(ctx)),
+					Key: map[string]types.AttributeValue{
+						partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+						sortKeyName:      &types.AttributeValueMemberS{Value: keys.OverlayToken},
+					},
+				},
+			},
+			{
+				Delete: &types.Delete{
+					TableName: aws.String(s.tableName(ctx)),
+					Key: map[string]types.AttributeValue{
+						partitionKeyName: &types.AttributeValueMemberS{Value: keys.Overlay(token)},
+						sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+					},
+				},
+			},
+		},
+	})
+	return err
+}
+
+// SaveWeeklyRecap stores the recap prepared for a driver, replacing the previous week's.
+func (s *DynamoStore) SaveWeeklyRecap(ctx context.Context, recap WeeklyRecap) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      weeklyRecapModelFromEntity(recap).toAttributeMap(),
+	})
+	return err
+}
+
+// GetWeeklyRecap retrieves the latest recap prepared for a driver. Returns nil if the recap job hasn't reached them yet.
+func (s *DynamoStore) GetWeeklyRecap(ctx context.Context, driverID int64) (*WeeklyRecap, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.WeeklyRecap},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return weeklyRecapFromAttributeMap(result.Item)
+}
+
+// GetIngestionCoverage retrieves the time ranges a driver's races have been ingested for. Returns nil if none have been
+// recorded.
+func (s *DynamoStore) GetIngestionCoverage(ctx context.Context, driverID int64, opts ...ReadOption) (Coverage, error) {
+	model, err := s.getIngestionCoverageModel(ctx, driverID, applyReadOptions(opts).consistent)
+	if err != nil || model == nil {
+		return nil, err
+	}
+	return model.ranges, nil
+}
+
+// AddIngestionCoverage merges ranges into the time ranges a driver's races have been ingested for. The update fails
+// rather than losing ranges if the coverage changes between being read and written back.
+func (s *DynamoStore) AddIngestionCoverage(ctx context.Context, driverID int64, ranges ...TimeRange) error {
+	model, err := s.getIngestionCoverageModel(ctx, driverID, true)
+	if err != nil {
+		return err
+	}
+
+	condition := "attribute_not_exists(#pk)"
+	names := map[string]string{"#pk": partitionKeyName}
+	var values map[string]types.AttributeValue
+	updated := ingestionCoverageModel{driverID: driverID, version: 1}
+	if model != nil {
+		condition = "#version = :version"
+		names = map[string]string{"#version": "version"}
+		values = map[string]types.AttributeValue{
+			":version": &types.AttributeValueMemberN{Value: strconv.FormatInt(model.version, 10)},
+		}
+		updated.version = model.version + 1
+		updated.ranges = model.ranges
+	}
+	updated.ranges = updated.ranges.Add(ranges...)
+
+	_, err = s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:                 aws.String(s.tableName(ctx)),
+		Item:                      updated.toAttributeMap(),
+		ConditionExpression:       aws.String(condition),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+	})
+	return err
+}
+
+func (s *DynamoStore) getIngestionCoverageModel(ctx context.Context, driverID int64, consistent bool) (*ingestionCoverageModel, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.IngestionCoverage},
+		},
+		ConsistentRead: aws.Bool(consistent),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return ingestionCoverageModelFromAttributeMap(result.Item)
+}
+
+// SaveDriverStanding stores a weekly standing snapshot, replacing any snapshot already taken for the same week.
+func (s *DynamoStore) SaveDriverStanding(ctx context.Context, standing DriverStanding) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      driverStandingModelFromEntity(standing).toAttributeMap(),
+	})
+	return err
+}
+
+func driverStandingModelFromEntity(standing DriverStanding) driverStandingModel {
+	return driverStandingModel{
+		driverID:     standing.DriverID,
+		weekStart:    toUnixSeconds(standing.WeekStart),
+		snapshotAt:   toUnixSeconds(standing.SnapshotAt),
+		seasonID:     standing.SeasonID,
+		seriesID:     standing.SeriesID,
+		seriesName:   standing.SeriesName,
+		carClassID:   standing.CarClassID,
+		raceWeekNum:  standing.RaceWeekNum,
+		division:     standing.Division,
+		divisionRank: standing.DivisionRank,
+		divisionSize: standing.DivisionSize,
+		points:       standing.Points,
+		weeksCounted: standing.WeeksCounted,
+		starts:       standing.Starts,
+		wins:         standing.Wins,
+	}
+}
+
+// GetDriverStanding retrieves the standing snapshot for the race week starting at weekStart.
+// Returns nil if no snapshot exists.
+func (s *DynamoStore) GetDriverStanding(ctx context.Context, driverID int64, weekStart time.Time) (*DriverStanding, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverStanding(toUnixSeconds(weekStart))},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
 		return nil, nil
 	}
+	return driverStandingFromAttributeMap(result.Item)
+}
+
+// GetDriverStandings retrieves standing snapshots for weeks starting within a time range.
+// Returns snapshots in reverse chronological order (newest first).
+func (s *DynamoStore) GetDriverStandings(ctx context.Context, driverID int64, from, to time.Time) ([]DriverStanding, error) {
+	result, err := s.query(ctx, "GetDriverStandings", &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :from AND :to"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+			"#sk": sortKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":   &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			":from": &types.AttributeValueMemberS{Value: keys.DriverStanding(toUnixSeconds(from))},
+			":to":   &types.AttributeValueMemberS{Value: keys.DriverStanding(toUnixSeconds(to))},
+		},
+		ScanIndexForward: aws.Bool(false), // newest first
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]DriverStanding, 0, len(result.Items))
+	for _, item := range result.Items {
+		standing, err := driverStandingFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		standings = append(standings, *standing)
+	}
+	return standings, nil
+}
+
+// GetDriverSettings retrieves a driver's settings. Drivers that have never saved settings get the defaults.
+func (s *DynamoStore) GetDriverSettings(ctx context.Context, driverID int64) (*DriverSettings, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverSettings},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return &DriverSettings{DriverID: driverID}, nil
+	}
+	return driverSettingsFromAttributeMap(result.Item)
+}
+
+// GetDriverSettingsForDrivers returns the settings of each of the given drivers, in the order given. Drivers that have
+// never saved settings get the defaults.
+func (s *DynamoStore) GetDriverSettingsForDrivers(ctx context.Context, driverIDs []int64) ([]DriverSettings, error) {
+	byDriver := make(map[int64]DriverSettings, len(driverIDs))
+	for chunk := range slices.Chunk(driverIDs, maxBatchGetItems) {
+		itemKeys := make([]map[string]types.AttributeValue, 0, len(chunk))
+		for _, driverID := range chunk {
+			if _, ok := byDriver[driverID]; ok {
+				continue
+			}
+			byDriver[driverID] = DriverSettings{DriverID: driverID}
+			itemKeys = append(itemKeys, map[string]types.AttributeValue{
+				partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverSettings},
+			})
+		}
+		if len(itemKeys) == 0 {
+			continue
+		}
+		requestItems := map[string]types.KeysAndAttributes{s.tableName(ctx): {Keys: itemKeys}}
+		for len(requestItems) > 0 {
+			result, err := s.batchGetItem(ctx, "GetDriverSettingsForDrivers", &dynamodb.BatchGetItemInput{RequestItems: requestItems})
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range result.Responses[s.tableName(ctx)] {
+				settings, err := driverSettingsFromAttributeMap(item)
+				if err != nil {
+					return nil, err
+				}
+				byDriver[settings.DriverID] = *settings
+			}
+			requestItems = result.UnprocessedKeys
+		}
+	}
+	ret := make([]DriverSettings, len(driverIDs))
+	for i, driverID := range driverIDs {
+		ret[i] = byDriver[driverID]
+	}
+	return ret, nil
+}
+
+// SaveDriverSettings creates or replaces a driver's settings.
+func (s *DynamoStore) SaveDriverSettings(ctx context.Context, settings DriverSettings) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      driverSettingsModelFromEntity(settings).toAttributeMap(),
+	})
+	return err
+}
+
+func driverSettingsModelFromEntity(settings DriverSettings) driverSettingsModel {
+	return driverSettingsModel{
+		driverID:             settings.DriverID,
+		lapRetentionMonths:   settings.LapRetentionMonths,
+		summaryOnlyIngestion: settings.SummaryOnlyIngestion,
+		lapBackfillPending:   settings.LapBackfillPending,
+		leaderboardOptIn:     settings.LeaderboardOptIn,
+		benchmarkOptIn:       settings.BenchmarkOptIn,
+		timezone:             settings.Timezone,
+		locale:               settings.Locale,
+	}
+}
+
+// ClearLapBackfillPending marks a driver's lap backfill as done without touching the rest of their settings.
+func (s *DynamoStore) ClearLapBackfillPending(ctx context.Context, driverID int64) error {
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverSettings},
+		},
+		UpdateExpression: aws.String("SET #lap_backfill_pending = :false"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":                   partitionKeyName,
+			"#lap_backfill_pending": "lap_backfill_pending",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":false": &types.AttributeValueMemberBOOL{Value: false},
+		},
+		ConditionExpression: aws.String("attribute_exists(#pk)"),
+	})
+	return err
+}
+
+// GetDriverSettingsWithLapRetention returns the settings of every driver that limits how long laps are kept. This
+// scans the table, so it's only meant for background jobs.
+func (s *DynamoStore) GetDriverSettingsWithLapRetention(ctx context.Context) ([]DriverSettings, error) {
+	var settings []DriverSettings
+	var startKey map[string]types.AttributeValue
+	for {
+		result, err := s.scan(ctx, "GetDriverSettingsWithLapRetention", &dynamodb.ScanInput{
+			TableName:        aws.String(s.tableName(ctx)),
+			FilterExpression: aws.String("#sk = :sk AND #retention > :zero"),
+			ExpressionAttributeNames: map[string]string{
+				"#sk":        sortKeyName,
+				"#retention": "lap_retention_months",
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":sk":   &types.AttributeValueMemberS{Value: keys.DriverSettings},
+				":zero": &types.AttributeValueMemberN{Value: "0"},
+			},
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			setting, err := driverSettingsFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			settings = append(settings, *setting)
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			return settings, nil
+		}
+		startKey = result.LastEvaluatedKey
+	}
+}
+
+// GetDriverSettingsWithLeaderboardOptIn returns the settings of every driver that has opted in to the weekly
+// leaderboards. This scans the table, so it's only meant for background jobs.
+func (s *DynamoStore) GetDriverSettingsWithLeaderboardOptIn(ctx context.Context) ([]DriverSettings, error) {
+	return s.scanDriverSettingsOptedIn(ctx, "leaderboard_opt_in")
+}
+
+// GetDriverSettingsWithBenchmarkOptIn returns the settings of every driver that has opted in to benchmarking. This
+// scans the table, so it's only meant for background jobs.
+func (s *DynamoStore) GetDriverSettingsWithBenchmarkOptIn(ctx context.Context) ([]DriverSettings, error) {
+	return s.scanDriverSettingsOptedIn(ctx, "benchmark_opt_in")
+}
+
+func (s *DynamoStore) scanDriverSettingsOptedIn(ctx context.Context, optInAttribute string) ([]DriverSettings, error) {
+	var settings []DriverSettings
+	var startKey map[string]types.AttributeValue
+	for {
+		result, err := s.scan(ctx, "scanDriverSettingsOptedIn", &dynamodb.ScanInput{
+			TableName:        aws.String(s.tableName(ctx)),
+			FilterExpression: aws.String("#sk = :sk AND #opt_in = :true"),
+			ExpressionAttributeNames: map[string]string{
+				"#sk":     sortKeyName,
+				"#opt_in": optInAttribute,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":sk":   &types.AttributeValueMemberS{Value: keys.DriverSettings},
+				":true": &types.AttributeValueMemberBOOL{Value: true},
+			},
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			setting, err := driverSettingsFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			settings = append(settings, *setting)
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			return settings, nil
+		}
+		startKey = result.LastEvaluatedKey
+	}
+}
+
+// GetDriversActiveSince returns every driver that has logged in since the given time. This scans the table, so it's
+// only meant for background jobs.
+func (s *DynamoStore) GetDriversActiveSince(ctx context.Context, since time.Time) ([]Driver, error) {
+	var drivers []Driver
+	var startKey map[string]types.AttributeValue
+	for {
+		result, err := s.scan(ctx, "GetDriversActiveSince", &dynamodb.ScanInput{
+			TableName:        aws.String(s.tableName(ctx)),
+			FilterExpression: aws.String("#sk = :sk AND #lastLogin >= :since"),
+			ExpressionAttributeNames: map[string]string{
+				"#sk":        sortKeyName,
+				"#lastLogin": "last_login",
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":sk":    &types.AttributeValueMemberS{Value: keys.Info},
+				":since": &types.AttributeValueMemberN{Value: strconv.FormatInt(since.Unix(), 10)},
+			},
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			driver, err := driverFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			drivers = append(drivers, *driver)
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			return drivers, nil
+		}
+		startKey = result.LastEvaluatedKey
+	}
+}
+
+// SaveIngestionRun records an ingestion round. Runs expire after a week.
+func (s *DynamoStore) SaveIngestionRun(ctx context.Context, run IngestionRun) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      ingestionRunModelFromEntity(run, s.now().Add(ingestionRunTTLDuration)).toAttributeMap(),
+	})
+	return err
+}
+
+func ingestionRunModelFromEntity(run IngestionRun, expiresAt time.Time) ingestionRunModel {
+	phaseDurationsMs := make(map[string]int64, len(run.PhaseDurations))
+	for phase, d := range run.PhaseDurations {
+		phaseDurationsMs[phase] = d.Milliseconds()
+	}
+	return ingestionRunModel{
+		driverID:         run.DriverID,
+		startedAt:        run.StartedAt.UnixMilli(),
+		durationMs:       run.Duration.Milliseconds(),
+		raceCount:        run.RaceCount,
+		newRaceCount:     run.NewRaceCount,
+		lapCount:         run.LapCount,
+		upToDate:         run.UpToDate,
+		errorMessage:     run.Error,
+		captureID:        run.CaptureID,
+		phaseDurationsMs: phaseDurationsMs,
+		ttl:              expiresAt.Unix(),
+	}
+}
 
-	ret, err := getInt64Attr(result.Item, "driver_id")
+// GetRecentIngestionRuns returns the most recent ingestion runs across all drivers, newest first.
+func (s *DynamoStore) GetRecentIngestionRuns(ctx context.Context, limit int) ([]IngestionRun, error) {
+	result, err := s.query(ctx, "GetRecentIngestionRuns", &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.IngestionRuns},
+		},
+		ScanIndexForward: aws.Bool(false),
+		Limit:            aws.Int32(int32(limit)),
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &ret, nil
+
+	runs := make([]IngestionRun, 0, len(result.Items))
+	for _, item := range result.Items {
+		run, err := ingestionRunFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, *run)
+	}
+	return runs, nil
 }
 
-func (s *DynamoStore) GetConnection(ctx context.Context, driverID int64, connectionID string) (*WebSocketConnection, error) {
-	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
-		TableName: aws.String(s.table),
-		Key: map[string]types.AttributeValue{
-			partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-			sortKeyName:      &types.AttributeValueMemberS{Value: fmt.Sprintf(wsConnectionSortKeyFormat, connectionID)},
+// SaveIRacingProxyRequest records a request a developer made through the iRacing proxy. Requests expire after 30 days.
+func (s *DynamoStore) SaveIRacingProxyRequest(ctx context.Context, request IRacingProxyRequest) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      iRacingProxyRequestModelFromEntity(request, s.now().Add(iRacingProxyRequestTTLDuration)).toAttributeMap(),
+	})
+	return err
+}
+
+func iRacingProxyRequestModelFromEntity(request IRacingProxyRequest, expiresAt time.Time) iRacingProxyRequestModel {
+	return iRacingProxyRequestModel{
+		driverID:    request.DriverID,
+		requestedAt: request.RequestedAt.UnixMilli(),
+		path:        request.Path,
+		params:      request.Params,
+		status:      request.Status,
+		durationMs:  request.Duration.Milliseconds(),
+		ttl:         expiresAt.Unix(),
+	}
+}
+
+// SaveSessionArchive records where raw iRacing responses for part of a session were archived, replacing any earlier
+// record for the same part.
+func (s *DynamoStore) SaveSessionArchive(ctx context.Context, archive SessionArchive) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      sessionArchiveModelFromEntity(archive).toAttributeMap(),
+	})
+	return err
+}
+
+// GetSessionArchives returns everything archived for a session.
+func (s *DynamoStore) GetSessionArchives(ctx context.Context, subsessionID int64) ([]SessionArchive, error) {
+	result, err := s.query(ctx, "GetSessionArchives", &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+			"#sk": sortKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":     &types.AttributeValueMemberS{Value: keys.Session(subsessionID)},
+			":prefix": &types.AttributeValueMemberS{Value: keys.SessionArchivePrefix},
 		},
 	})
 	if err != nil {
 		return nil, err
 	}
-	if result.Item == nil {
-		return nil, nil
+
+	archives := make([]SessionArchive, 0, len(result.Items))
+	for _, item := range result.Items {
+		archive, err := sessionArchiveFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		archives = append(archives, *archive)
+	}
+	return archives, nil
+}
+
+// GetIRacingProxyRequests returns a developer's most recent iRacing proxy requests, newest first.
+func (s *DynamoStore) GetIRacingProxyRequests(ctx context.Context, driverID int64, limit int) ([]IRacingProxyRequest, error) {
+	result, err := s.query(ctx, "GetIRacingProxyRequests", &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+			"#sk": sortKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":     &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			":prefix": &types.AttributeValueMemberS{Value: keys.IRacingProxyRequestPrefix},
+		},
+		ScanIndexForward: aws.Bool(false), // newest first
+		Limit:            aws.Int32(int32(limit)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]IRacingProxyRequest, 0, len(result.Items))
+	for _, item := range result.Items {
+		request, err := iRacingProxyRequestFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *request)
+	}
+	return requests, nil
+}
+
+// SaveRequestCaptureMode turns on a developer's request capture mode, replacing any they already had on.
+func (s *DynamoStore) SaveRequestCaptureMode(ctx context.Context, mode RequestCaptureMode) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      requestCaptureModeModelFromEntity(mode).toAttributeMap(),
+	})
+	return err
+}
+
+func requestCaptureModeModelFromEntity(mode RequestCaptureMode) requestCaptureModeModel {
+	return requestCaptureModeModel{
+		driverID:  mode.DriverID,
+		enabledAt: mode.EnabledAt.Unix(),
+		until:     mode.Until.Unix(),
 	}
-	return wsConnectionFromAttributeMap(result.Item)
 }
 
-func (s *DynamoStore) GetDriverSession(ctx context.Context, driverID int64, startTime time.Time) (*DriverSession, error) {
+// GetRequestCaptureMode returns a developer's request capture mode, or nil if it is off or has run out.
+func (s *DynamoStore) GetRequestCaptureMode(ctx context.Context, driverID int64) (*RequestCaptureMode, error) {
 	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
-		TableName: aws.String(s.table),
+		TableName: aws.String(s.tableName(ctx)),
 		Key: map[string]types.AttributeValue{
-			partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-			sortKeyName:      &types.AttributeValueMemberS{Value: fmt.Sprintf(driverSessionSortKeyFormat, toUnixSeconds(startTime))},
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RequestCaptureMode},
 		},
 	})
 	if err != nil {
 		return nil, err
 	}
-	if result.Item == nil {
+	if result.Item == nil || ttlExpired(result.Item, s.now()) {
 		return nil, nil
 	}
-	return driverSessionFromAttributeMap(driverID, result.Item)
+	return requestCaptureModeFromAttributeMap(result.Item)
 }
 
-// GetDriverSessions retrieves specific driver sessions by their exact start times.
-// Uses BatchGetItem for efficient fetching. Returns sessions in the order they were found.
-func (s *DynamoStore) GetDriverSessions(ctx context.Context, driverID int64, startTimes []time.Time) ([]DriverSession, error) {
-	if len(startTimes) == 0 {
-		return []DriverSession{}, nil
-	}
+// DeleteRequestCaptureMode turns off a developer's request capture mode, if it is on. Requests already captured are
+// kept until they expire.
+func (s *DynamoStore) DeleteRequestCaptureMode(ctx context.Context, driverID int64) error {
+	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RequestCaptureMode},
+		},
+	})
+	return err
+}
 
-	pk := fmt.Sprintf(driverPartitionFormat, driverID)
-	keys := make([]map[string]types.AttributeValue, len(startTimes))
-	for i, startTime := range startTimes {
-		keys[i] = map[string]types.AttributeValue{
-			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
-			sortKeyName:      &types.AttributeValueMemberS{Value: fmt.Sprintf(driverSessionSortKeyFormat, toUnixSeconds(startTime))},
-		}
+// SaveCapturedRequest records one of a developer's captured API requests. Captured requests expire after a day.
+func (s *DynamoStore) SaveCapturedRequest(ctx context.Context, request CapturedRequest) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      capturedRequestModelFromEntity(request, s.now().Add(capturedRequestTTLDuration)).toAttributeMap(),
+	})
+	return err
+}
+
+func capturedRequestModelFromEntity(request CapturedRequest, expiresAt time.Time) capturedRequestModel {
+	return capturedRequestModel{
+		id:                    request.ID,
+		driverID:              request.DriverID,
+		requestedAt:           request.RequestedAt.UnixMilli(),
+		method:                request.Method,
+		path:                  request.Path,
+		route:                 request.Route,
+		query:                 request.Query,
+		status:                request.Status,
+		durationMs:            request.Duration.Milliseconds(),
+		requestContentType:    request.RequestContentType,
+		requestBody:           request.RequestBody,
+		requestBodyTruncated:  request.RequestBodyTruncated,
+		responseContentType:   request.ResponseContentType,
+		responseBody:          request.ResponseBody,
+		responseBodyTruncated: request.ResponseBodyTruncated,
+		ttl:                   expiresAt.Unix(),
 	}
+}
 
-	result, err := s.client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{
-		RequestItems: map[string]types.KeysAndAttributes{
-			s.table: {Keys: keys},
+// GetCapturedRequests returns a developer's most recent captured API requests, newest first. Requests past their TTL
+// are left out even if DynamoDB has yet to delete them.
+func (s *DynamoStore) GetCapturedRequests(ctx context.Context, driverID int64, limit int) ([]CapturedRequest, error) {
+	// captured requests are keyed by when they were made, so the ones still live are a range of the sort key
+	from := s.now().Add(-capturedRequestTTLDuration).UnixMilli()
+	result, err := s.query(ctx, "GetCapturedRequests", &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :from AND :to"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+			"#sk": sortKeyName,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":   &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			":from": &types.AttributeValueMemberS{Value: keys.CapturedRequestsFrom(from)},
+			":to":   &types.AttributeValueMemberS{Value: keys.CapturedRequestPrefix + "~"},
 		},
+		ScanIndexForward: aws.Bool(false), // newest first
+		Limit:            aws.Int32(int32(limit)),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	sessions := make([]DriverSession, 0, len(result.Responses[s.table]))
-	for _, item := range result.Responses[s.table] {
-		session, err := driverSessionFromAttributeMap(driverID, item)
+	requests := make([]CapturedRequest, 0, len(result.Items))
+	for _, item := range result.Items {
+		request, err := capturedRequestFromAttributeMap(item)
 		if err != nil {
 			return nil, err
 		}
-		sessions = append(sessions, *session)
+		requests = append(requests, *request)
 	}
-
-	return sessions, nil
+	return requests, nil
 }
 
-func (s *DynamoStore) GetDriverSessionsByTimeRange(ctx context.Context, driverID int64, from, to time.Time, filters ...SessionFilter) ([]DriverSession, error) {
-	result, err := s.client.Query(ctx, &dynamodb.QueryInput{
+// GetIngestionTiers returns every ingestion tier ordered by name.
+func (s *DynamoStore) GetIngestionTiers(ctx context.Context) ([]IngestionTier, error) {
+	result, err := s.query(ctx, "GetIngestionTiers", &dynamodb.QueryInput{
 		TableName:              aws.String(s.table),
-		KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :from AND :to"),
+		KeyConditionExpression: aws.String("#pk = :pk"),
 		ExpressionAttributeNames: map[string]string{
 			"#pk": partitionKeyName,
-			"#sk": sortKeyName,
 		},
 		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":pk":   &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-			":from": &types.AttributeValueMemberS{Value: fmt.Sprintf(driverSessionSortKeyFormat, toUnixSeconds(from))},
-			":to":   &types.AttributeValueMemberS{Value: fmt.Sprintf(driverSessionSortKeyFormat, toUnixSeconds(to))},
+			":pk": &types.AttributeValueMemberS{Value: keys.IngestionTiers},
 		},
-		ScanIndexForward: aws.Bool(false),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	sessions := make([]DriverSession, 0, len(result.Items))
+	tiers := make([]IngestionTier, 0, len(result.Items))
 	for _, item := range result.Items {
-		session, err := driverSessionFromAttributeMap(driverID, item)
+		tier, err := ingestionTierFromAttributeMap(item)
 		if err != nil {
 			return nil, err
 		}
-		sessions = append(sessions, *session)
+		tiers = append(tiers, *tier)
 	}
+	return tiers, nil
+}
 
-	for _, filter := range filters {
-		sessions = filter(sessions)
+// SaveIngestionTier creates or replaces an ingestion tier.
+func (s *DynamoStore) SaveIngestionTier(ctx context.Context, tier IngestionTier) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.table),
+		Item:      ingestionTierModelFromEntity(tier).toAttributeMap(),
+	})
+	return err
+}
+
+// AddToWaitlist puts a driver on the waitlist. Returns false, changing nothing, if they are already on it so they keep
+// their place.
+func (s *DynamoStore) AddToWaitlist(ctx context.Context, entry WaitlistEntry) (bool, error) {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(s.tableName(ctx)),
+		Item:                waitlistEntryModelFromEntity(entry).toAttributeMap(),
+		ConditionExpression: aws.String("attribute_not_exists(#pk)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+		},
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
 	}
-	return sessions, nil
+	return true, nil
 }
 
-// SaveDriverSessions saves driver session records and increments session counts atomically.
-// Uses transactions to ensure duplicate prevention via key checks.
-func (s *DynamoStore) SaveDriverSessions(ctx context.Context, sessions []DriverSession) error {
-	if len(sessions) == 0 {
-		return nil
+// GetWaitlist returns every driver on the waitlist.
+func (s *DynamoStore) GetWaitlist(ctx context.Context) ([]WaitlistEntry, error) {
+	items, err := s.queryPrefix(ctx, "GetWaitlist", keys.Waitlist, keys.WaitlistDriverPrefix)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]WaitlistEntry, 0, len(items))
+	for _, item := range items {
+		entry, err := waitlistEntryFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
 	}
+	return entries, nil
+}
 
-	var items []types.TransactWriteItem
+// GetInvitation returns a driver's invitation, nil if they haven't been invited.
+func (s *DynamoStore) GetInvitation(ctx context.Context, driverID int64) (*Invitation, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Invitations},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.InvitationDriver(driverID)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return invitationFromAttributeMap(result.Item)
+}
 
-	// Track session counts per driver
-	driverSessionCounts := make(map[int64]int)
+// SaveInvitations creates or replaces invitations, taking each invited driver off the waitlist. Each driver's
+// invitation is written together with their leaving the waitlist, but a failure part way through leaves the drivers
+// before it invited.
+func (s *DynamoStore) SaveInvitations(ctx context.Context, invitations []Invitation) error {
+	items := make([]types.TransactWriteItem, 0, len(invitations)*2)
+	for _, invitation := range invitations {
+		items = append(items,
+			s.put(ctx, invitationModelFromEntity(invitation).toAttributeMap()),
+			types.TransactWriteItem{Delete: &types.Delete{
+				TableName: aws.String(s.tableName(ctx)),
+				Key: map[string]types.AttributeValue{
+					partitionKeyName: &types.AttributeValueMemberS{Value: keys.Waitlist},
+					sortKeyName:      &types.AttributeValueMemberS{Value: keys.WaitlistDriver(invitation.DriverID)},
+				},
+			}},
+		)
+	}
+	return s.executeBatchedTransact(ctx, items)
+}
 
-	for _, ds := range sessions {
-		driverSessionCounts[ds.DriverID]++
-		items = append(items, s.putWithKeyCheck(driverSessionModel{
-			driverID:              ds.DriverID,
-			subsessionID:          ds.SubsessionID,
-			trackID:               ds.TrackID,
-			carID:                 ds.CarID,
-			seriesID:              ds.SeriesID,
-			seriesName:            ds.SeriesName,
-			startTime:             toUnixSeconds(ds.StartTime),
-			startPosition:         ds.StartPosition,
-			startPositionInClass:  ds.StartPositionInClass,
-			finishPosition:        ds.FinishPosition,
-			finishPositionInClass: ds.FinishPositionInClass,
-			incidents:             ds.Incidents,
-			oldCPI:                ds.OldCPI,
-			newCPI:                ds.NewCPI,
-			oldIRating:            ds.OldIRating,
-			newIRating:            ds.NewIRating,
-			oldLicenseLevel:       ds.OldLicenseLevel,
-			newLicenseLevel:       ds.NewLicenseLevel,
-			oldSubLevel:           ds.OldSubLevel,
-			newSubLevel:           ds.NewSubLevel,
-			reasonOut:             ds.ReasonOut,
-		}.toAttributeMap()))
-	}
-
-	// Increment session count for each driver
-	for driverID, count := range driverSessionCounts {
-		items = append(items, s.incrementDriverSessionCount(driverID, count))
+// GetTermsAcceptances returns every document version a driver has accepted.
+func (s *DynamoStore) GetTermsAcceptances(ctx context.Context, driverID int64) ([]TermsAcceptance, error) {
+	items, err := s.queryPrefix(ctx, "GetTermsAcceptances", keys.Driver(driverID), keys.TermsAcceptancePrefix)
+	if err != nil {
+		return nil, err
 	}
+	acceptances := make([]TermsAcceptance, 0, len(items))
+	for _, item := range items {
+		acceptance, err := termsAcceptanceFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		acceptances = append(acceptances, *acceptance)
+	}
+	return acceptances, nil
+}
 
-	return s.executeBatchedTransact(ctx, items)
+// SaveTermsAcceptance records a driver accepting a document version. Returns false, changing nothing, if they had
+// already accepted it so the original acceptance time is kept.
+func (s *DynamoStore) SaveTermsAcceptance(ctx context.Context, acceptance TermsAcceptance) (bool, error) {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(s.tableName(ctx)),
+		Item:                termsAcceptanceModelFromEntity(acceptance).toAttributeMap(),
+		ConditionExpression: aws.String("attribute_not_exists(#pk)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+		},
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
 }
 
-func (s *DynamoStore) executeBatchedTransact(ctx context.Context, items []types.TransactWriteItem) error {
-	if len(items) == 0 {
-		return nil
+// GetSupporter returns a driver's supporter subscription, nil if they have never subscribed.
+func (s *DynamoStore) GetSupporter(ctx context.Context, driverID int64) (*Supporter, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.Supporter},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return supporterFromAttributeMap(result.Item)
+}
+
+// SaveSupporter creates or replaces a driver's supporter subscription. Returns false, changing nothing, if the stored
+// record came from a newer event than supporter.
+func (s *DynamoStore) SaveSupporter(ctx context.Context, supporter Supporter) (bool, error) {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(s.tableName(ctx)),
+		Item:                supporterModelFromEntity(supporter).toAttributeMap(),
+		ConditionExpression: aws.String("attribute_not_exists(#pk) OR #updated_at <= :updated_at"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":         partitionKeyName,
+			"#updated_at": "updated_at",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":updated_at": &types.AttributeValueMemberN{Value: strconv.FormatInt(supporter.UpdatedAt.Unix(), 10)},
+		},
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// GrantEntitlement adds an entitlement to a driver, doing nothing if they already have it.
+func (s *DynamoStore) GrantEntitlement(ctx context.Context, driverID int64, entitlement string) error {
+	return s.updateEntitlements(ctx, driverID, func(current []string) []string {
+		if slices.Contains(current, entitlement) {
+			return current
+		}
+		return append(current, entitlement)
+	})
+}
+
+// RevokeEntitlement removes an entitlement from a driver, doing nothing if they don't have it.
+func (s *DynamoStore) RevokeEntitlement(ctx context.Context, driverID int64, entitlement string) error {
+	return s.updateEntitlements(ctx, driverID, func(current []string) []string {
+		return slices.DeleteFunc(current, func(e string) bool { return e == entitlement })
+	})
+}
+
+// updateEntitlements rewrites a driver's entitlements, conditioned on them being unchanged since they were read so
+// concurrent grants and revokes don't clobber each other.
+func (s *DynamoStore) updateEntitlements(ctx context.Context, driverID int64, change func(current []string) []string) error {
+	for range entitlementUpdateAttempts {
+		driver, err := s.GetDriver(ctx, driverID, ConsistentRead())
+		if err != nil {
+			return err
+		}
+		if driver == nil {
+			return fmt.Errorf("driver %d not found", driverID)
+		}
+		updated := change(slices.Clone(driver.Entitlements))
+		if slices.Equal(updated, driver.Entitlements) {
+			return nil
+		}
+
+		names := map[string]string{"#entitlements": "entitlements"}
+		values := map[string]types.AttributeValue{}
+		condition := "attribute_not_exists(#entitlements)"
+		if len(driver.Entitlements) > 0 {
+			condition = "#entitlements = :current"
+			values[":current"] = stringListAttr(driver.Entitlements)
+		}
+		update := "REMOVE #entitlements"
+		if len(updated) > 0 {
+			update = "SET #entitlements = :updated"
+			values[":updated"] = stringListAttr(updated)
+		}
+		if len(values) == 0 {
+			values = nil
+		}
+
+		_, err = s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+			TableName: aws.String(s.tableName(ctx)),
+			Key: map[string]types.AttributeValue{
+				partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+				sortKeyName:      &types.AttributeValueMemberS{Value: keys.Info},
+			},
+			UpdateExpression:          aws.String(update),
+			ConditionExpression:       aws.String(condition),
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+		})
+		if err == nil {
+			return nil
+		}
+		var condErr *types.ConditionalCheckFailedException
+		if !errors.As(err, &condErr) {
+			return err
+		}
+	}
+	return fmt.Errorf("entitlements for driver %d kept changing, gave up after %d attempts", driverID, entitlementUpdateAttempts)
+}
+
+// AddToDriverRollup adds a race's totals to one of the driver's rollups, creating it if needed. Returns false without
+// changing anything when the race has already been counted in the rollup.
+func (s *DynamoStore) AddToDriverRollup(ctx context.Context, driverID int64, scope string, subsessionID int64, totals SessionTotals) (bool, error) {
+	return s.addSessionTotals(ctx, map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverRollup(scope)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(driverID, 10)},
+		"scope":          &types.AttributeValueMemberS{Value: scope},
+	}, subsessionID, totals)
+}
+
+// AddToWeeklyLeaderboard adds a race's totals to the driver's entry on the leaderboard for the race week starting at
+// weekStart. Returns false without changing anything when the race has already been counted.
+func (s *DynamoStore) AddToWeeklyLeaderboard(ctx context.Context, weekStart time.Time, driverID, subsessionID int64, totals SessionTotals) (bool, error) {
+	return s.addSessionTotals(ctx, map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.WeeklyLeaderboard(toUnixSeconds(weekStart))},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.LeaderboardDriver(driverID)},
+		"week_start":     &types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(weekStart), 10)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(driverID, 10)},
+	}, subsessionID, totals)
+}
+
+// addSessionTotals ADDs totals to the item identified by the key attributes in identity, SETting the rest of identity.
+// The subsession is recorded in the item's subsession_ids set, and conditioning on it not already being there makes
+// redelivered stream records and re-ingested races harmless.
+func (s *DynamoStore) addSessionTotals(ctx context.Context, identity map[string]types.AttributeValue, subsessionID int64, totals SessionTotals) (bool, error) {
+	key := map[string]types.AttributeValue{
+		partitionKeyName: identity[partitionKeyName],
+		sortKeyName:      identity[sortKeyName],
+	}
+	names := map[string]string{
+		"#subsession_ids": "subsession_ids",
+	}
+	values := map[string]types.AttributeValue{
+		":subsession_id":  &types.AttributeValueMemberN{Value: strconv.FormatInt(subsessionID, 10)},
+		":subsession_ids": &types.AttributeValueMemberNS{Value: []string{strconv.FormatInt(subsessionID, 10)}},
 	}
 
-	for i := 0; i < len(items); i += maxTransactWriteItems {
-		end := i + maxTransactWriteItems
-		if end > len(items) {
-			end = len(items)
+	identityNames := make([]string, 0, len(identity))
+	for name := range identity {
+		if name != partitionKeyName && name != sortKeyName {
+			identityNames = append(identityNames, name)
 		}
-		batch := items[i:end]
+	}
+	sort.Strings(identityNames)
+	sets := make([]string, len(identityNames))
+	for i, name := range identityNames {
+		sets[i] = fmt.Sprintf("#%s = :%s", name, name)
+		names["#"+name] = name
+		values[":"+name] = identity[name]
+	}
 
-		_, err := s.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
-			TransactItems: batch,
-		})
-		if err != nil {
-			batchNum := (i / maxTransactWriteItems) + 1
-			totalBatches := (len(items) + maxTransactWriteItems - 1) / maxTransactWriteItems
-			return fmt.Errorf("batch %d/%d failed: %w", batchNum, totalBatches, mapTransactionError(err))
-		}
+	adds := []string{"#subsession_ids :subsession_ids"}
+	for _, attr := range sessionTotalsAttributes {
+		adds = append(adds, fmt.Sprintf("#%s :%s", attr.name, attr.name))
+		names["#"+attr.name] = attr.name
+		values[":"+attr.name] = &types.AttributeValueMemberN{Value: strconv.Itoa(*attr.field(&totals))}
 	}
 
-	return nil
+	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(s.tableName(ctx)),
+		Key:                       key,
+		UpdateExpression:          aws.String(fmt.Sprintf("SET %s ADD %s", strings.Join(sets, ", "), strings.Join(adds, ", "))),
+		ConditionExpression:       aws.String("NOT contains(#subsession_ids, :subsession_id)"),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
 }
 
-func (s *DynamoStore) putWithKeyCheck(item map[string]types.AttributeValue) types.TransactWriteItem {
-	return types.TransactWriteItem{
-		Put: &types.Put{
-			TableName:           aws.String(s.table),
-			Item:                item,
-			ConditionExpression: aws.String("attribute_not_exists(#pk)"),
-			ExpressionAttributeNames: map[string]string{
-				"#pk": partitionKeyName,
-			},
+// GetDriverRollup retrieves one of a driver's rollups. Returns nil if the driver has no races in the scope.
+func (s *DynamoStore) GetDriverRollup(ctx context.Context, driverID int64, scope string) (*DriverRollup, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverRollup(scope)},
 		},
+	})
+	if err != nil {
+		return nil, err
 	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return driverRollupFromAttributeMap(result.Item)
 }
 
-func (s *DynamoStore) incrementDriverSessionCount(driverID int64, count int) types.TransactWriteItem {
-	return types.TransactWriteItem{
-		Update: &types.Update{
-			TableName: aws.String(s.table),
-			Key: map[string]types.AttributeValue{
-				partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-				sortKeyName:      &types.AttributeValueMemberS{Value: defaultSortKey},
-			},
-			UpdateExpression: aws.String("ADD #session_count :count"),
+// GetWeeklyLeaderboard retrieves every driver's entry on the leaderboard for the race week starting at weekStart, in
+// no particular order.
+func (s *DynamoStore) GetWeeklyLeaderboard(ctx context.Context, weekStart time.Time) ([]WeeklyLeaderboardEntry, error) {
+	var entries []WeeklyLeaderboardEntry
+	var startKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetWeeklyLeaderboard", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk"),
 			ExpressionAttributeNames: map[string]string{
-				"#session_count": "session_count",
+				"#pk": partitionKeyName,
 			},
 			ExpressionAttributeValues: map[string]types.AttributeValue{
-				":count": &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", count)},
+				":pk": &types.AttributeValueMemberS{Value: keys.WeeklyLeaderboard(toUnixSeconds(weekStart))},
 			},
-		},
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			entry, err := weeklyLeaderboardEntryFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, *entry)
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			return entries, nil
+		}
+		startKey = result.LastEvaluatedKey
 	}
 }
 
-// DeleteDriverRaces removes all records under a driver's partition except their info record,
-// and resets their sync state to appear as if they've never synced (useful for testing initial sync flows).
-func (s *DynamoStore) DeleteDriverRaces(ctx context.Context, driverID int64) error {
-	pk := fmt.Sprintf(driverPartitionFormat, driverID)
-
-	// Query all items under driver partition, fetching only keys for efficiency
-	result, err := s.client.Query(ctx, &dynamodb.QueryInput{
-		TableName:              aws.String(s.table),
-		KeyConditionExpression: aws.String("#pk = :pk"),
-		ProjectionExpression:   aws.String("#pk, #sk"),
-		ExpressionAttributeNames: map[string]string{
-			"#pk": partitionKeyName,
-			"#sk": sortKeyName,
-		},
-		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":pk": &types.AttributeValueMemberS{Value: pk},
+// SaveRankedLeaderboard replaces a computed leaderboard with the given rankings, which must be numbered 1 through
+// len(rankings). Places left over from an earlier, longer computation are removed.
+func (s *DynamoStore) SaveRankedLeaderboard(ctx context.Context, board RankedLeaderboard, rankings []LeaderboardRanking) error {
+	pk := keys.RankedLeaderboard(board.Board, toUnixSeconds(board.WeekStart))
+	previous, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RankedLeaderboardBoard},
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("querying driver partition: %w", err)
+		return err
 	}
-
-	// Collect keys to delete (everything except info)
-	var keysToDelete []map[string]types.AttributeValue
-	for _, item := range result.Items {
-		sk, err := getStringAttr(item, sortKeyName)
+	previousEntries := 0
+	if previous.Item != nil {
+		prev, err := rankedLeaderboardFromAttributeMap(previous.Item)
 		if err != nil {
-			return fmt.Errorf("reading sort key from driver item: %w", err)
-		}
-		if sk != defaultSortKey {
-			keysToDelete = append(keysToDelete, map[string]types.AttributeValue{
-				partitionKeyName: item[partitionKeyName],
-				sortKeyName:      item[sortKeyName],
-			})
+			return err
 		}
+		previousEntries = prev.TotalEntries
 	}
 
-	// Batch delete in chunks of 25
-	for i := 0; i < len(keysToDelete); i += maxBatchWriteItems {
-		end := i + maxBatchWriteItems
-		if end > len(keysToDelete) {
-			end = len(keysToDelete)
+	for i := 0; i < len(rankings); i += maxBatchWriteItems {
+		end := min(i+maxBatchWriteItems, len(rankings))
+		writeRequests := make([]types.WriteRequest, 0, end-i)
+		for _, ranking := range rankings[i:end] {
+			writeRequests = append(writeRequests, types.WriteRequest{
+				PutRequest: &types.PutRequest{Item: leaderboardRankingModelFromEntity(board.Board, board.WeekStart, ranking).toAttributeMap()},
+			})
 		}
-		batch := keysToDelete[i:end]
 
-		writeRequests := make([]types.WriteRequest, len(batch))
-		for j, key := range batch {
-			writeRequests[j] = types.WriteRequest{
-				DeleteRequest: &types.DeleteRequest{Key: key},
+		requestItems := map[string][]types.WriteRequest{s.tableName(ctx): writeRequests}
+		for len(requestItems) > 0 {
+			result, err := s.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: requestItems,
+			})
+			if err != nil {
+				return fmt.Errorf("batch write of leaderboard rankings failed: %w", err)
 			}
+			requestItems = result.UnprocessedItems
 		}
+	}
 
-		_, err := s.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
-			RequestItems: map[string][]types.WriteRequest{
-				s.table: writeRequests,
+	var stale []map[string]types.AttributeValue
+	for rank := len(rankings) + 1; rank <= previousEntries; rank++ {
+		stale = append(stale, map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.LeaderboardRank(rank)},
+		})
+	}
+	if err := s.batchDeleteKeys(ctx, stale); err != nil {
+		return err
+	}
+
+	board.TotalEntries = len(rankings)
+	_, err = s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      rankedLeaderboardModelFromEntity(board).toAttributeMap(),
+	})
+	return err
+}
+
+// GetRankedLeaderboard retrieves a computed leaderboard along with up to limit of its places, starting after offset
+// places. Returns nil if the leaderboard hasn't been computed.
+func (s *DynamoStore) GetRankedLeaderboard(ctx context.Context, board string, weekStart time.Time, offset, limit int) (*RankedLeaderboard, []LeaderboardRanking, error) {
+	pk := keys.RankedLeaderboard(board, toUnixSeconds(weekStart))
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RankedLeaderboardBoard},
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if result.Item == nil {
+		return nil, nil, nil
+	}
+	leaderboard, err := rankedLeaderboardFromAttributeMap(result.Item)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rankings := make([]LeaderboardRanking, 0, limit)
+	var startKey map[string]types.AttributeValue
+	for {
+		page, err := s.query(ctx, "GetRankedLeaderboard", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :from AND :to"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
 			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":   &types.AttributeValueMemberS{Value: pk},
+				":from": &types.AttributeValueMemberS{Value: keys.LeaderboardRank(offset + 1)},
+				":to":   &types.AttributeValueMemberS{Value: keys.LeaderboardRank(offset + limit)},
+			},
+			ExclusiveStartKey: startKey,
 		})
 		if err != nil {
-			return fmt.Errorf("batch delete failed: %w", err)
+			return nil, nil, err
+		}
+
+		for _, item := range page.Items {
+			ranking, err := leaderboardRankingFromAttributeMap(item)
+			if err != nil {
+				return nil, nil, err
+			}
+			rankings = append(rankings, *ranking)
+		}
+
+		if len(page.LastEvaluatedKey) == 0 {
+			return leaderboard, rankings, nil
 		}
+		startKey = page.LastEvaluatedKey
 	}
+}
 
-	// Reset races_ingested_to to nil and session_count to 0
-	_, err = s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
-		TableName: aws.String(s.table),
+// GetRankedLeaderboardByClub retrieves a computed leaderboard along with every place on it held by a driver in the
+// given iRacing club, in rank order. Returns nil if the leaderboard hasn't been computed.
+func (s *DynamoStore) GetRankedLeaderboardByClub(ctx context.Context, board string, weekStart time.Time, clubID int) (*RankedLeaderboard, []LeaderboardRanking, error) {
+	pk := keys.RankedLeaderboard(board, toUnixSeconds(weekStart))
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
 		Key: map[string]types.AttributeValue{
 			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
-			sortKeyName:      &types.AttributeValueMemberS{Value: defaultSortKey},
-		},
-		UpdateExpression: aws.String("REMOVE #races_ingested_to SET #session_count = :zero"),
-		ExpressionAttributeNames: map[string]string{
-			"#races_ingested_to": "races_ingested_to",
-			"#session_count":     "session_count",
-		},
-		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":zero": &types.AttributeValueMemberN{Value: "0"},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.RankedLeaderboardBoard},
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("resetting driver info: %w", err)
+		return nil, nil, err
+	}
+	if result.Item == nil {
+		return nil, nil, nil
+	}
+	leaderboard, err := rankedLeaderboardFromAttributeMap(result.Item)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return nil
-}
+	var rankings []LeaderboardRanking
+	var startKey map[string]types.AttributeValue
+	for {
+		page, err := s.query(ctx, "GetRankedLeaderboardByClub", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+			FilterExpression:       aws.String("#club_id = :club_id"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk":      partitionKeyName,
+				"#sk":      sortKeyName,
+				"#club_id": "club_id",
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":      &types.AttributeValueMemberS{Value: pk},
+				":prefix":  &types.AttributeValueMemberS{Value: "rank#"},
+				":club_id": &types.AttributeValueMemberN{Value: strconv.Itoa(clubID)},
+			},
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
 
-// SaveJournalEntry creates or updates a journal entry for a race (upsert semantics).
-// CreatedAt is set on first save; UpdatedAt is always updated.
-func (s *DynamoStore) SaveJournalEntry(ctx context.Context, entry RaceJournalEntry) error {
-	now := s.now()
+		for _, item := range page.Items {
+			ranking, err := leaderboardRankingFromAttributeMap(item)
+			if err != nil {
+				return nil, nil, err
+			}
+			rankings = append(rankings, *ranking)
+		}
 
-	// For upsert: set created_at only if it doesn't exist, always update updated_at
-	_, err := s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
-		TableName: aws.String(s.table),
-		Key: map[string]types.AttributeValue{
-			partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, entry.DriverID)},
-			sortKeyName:      &types.AttributeValueMemberS{Value: fmt.Sprintf(journalEntrySortKeyFormat, entry.RaceID)},
-		},
-		UpdateExpression: aws.String("SET #driver_id = :driver_id, #race_id = :race_id, #notes = :notes, #updated_at = :updated_at, #created_at = if_not_exists(#created_at, :created_at), #tags = :tags, #replay_video = :replay_video"),
-		ExpressionAttributeNames: map[string]string{
-			"#driver_id":    "driver_id",
-			"#race_id":      "race_id",
-			"#notes":        "notes",
-			"#updated_at":   "updated_at",
-			"#created_at":   "created_at",
-			"#tags":         "tags",
-			"#replay_video": "replay_video",
-		},
-		ExpressionAttributeValues: s.journalEntryUpdateValues(entry, now),
+		if len(page.LastEvaluatedKey) == 0 {
+			return leaderboard, rankings, nil
+		}
+		startKey = page.LastEvaluatedKey
+	}
+}
+
+// SaveRegionWeeklyAggregate creates or replaces a club's totals for a race week.
+func (s *DynamoStore) SaveRegionWeeklyAggregate(ctx context.Context, aggregate RegionWeeklyAggregate) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      regionWeeklyAggregateModelFromEntity(aggregate).toAttributeMap(),
 	})
 	return err
 }
 
-func (s *DynamoStore) journalEntryUpdateValues(entry RaceJournalEntry, now time.Time) map[string]types.AttributeValue {
-	nowUnix := toUnixSeconds(now)
-	values := map[string]types.AttributeValue{
-		":driver_id":    &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", entry.DriverID)},
-		":race_id":      &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", entry.RaceID)},
-		":notes":        &types.AttributeValueMemberS{Value: entry.Notes},
-		":updated_at":   &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", nowUnix)},
-		":created_at":   &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", nowUnix)},
-		":replay_video": &types.AttributeValueMemberS{Value: entry.ReplayVideo},
-	}
-	if len(entry.Tags) > 0 {
-		tagValues := make([]types.AttributeValue, len(entry.Tags))
-		for i, t := range entry.Tags {
-			tagValues[i] = &types.AttributeValueMemberS{Value: t}
+// GetRegionWeeklyAggregates retrieves a club's totals for the race weeks starting between from and to, inclusive,
+// oldest first.
+func (s *DynamoStore) GetRegionWeeklyAggregates(ctx context.Context, clubID int, from, to time.Time) ([]RegionWeeklyAggregate, error) {
+	var aggregates []RegionWeeklyAggregate
+	var startKey map[string]types.AttributeValue
+	for {
+		result, err := s.query(ctx, "GetRegionWeeklyAggregates", &dynamodb.QueryInput{
+			TableName:              aws.String(s.tableName(ctx)),
+			KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :from AND :to"),
+			ExpressionAttributeNames: map[string]string{
+				"#pk": partitionKeyName,
+				"#sk": sortKeyName,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk":   &types.AttributeValueMemberS{Value: keys.Region(clubID)},
+				":from": &types.AttributeValueMemberS{Value: keys.RegionWeek(toUnixSeconds(from))},
+				":to":   &types.AttributeValueMemberS{Value: keys.RegionWeek(toUnixSeconds(to))},
+			},
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, err
 		}
-		values[":tags"] = &types.AttributeValueMemberL{Value: tagValues}
-	} else {
-		values[":tags"] = &types.AttributeValueMemberL{Value: []types.AttributeValue{}}
+
+		for _, item := range result.Items {
+			aggregate, err := regionWeeklyAggregateFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			aggregates = append(aggregates, *aggregate)
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			return aggregates, nil
+		}
+		startKey = result.LastEvaluatedKey
 	}
-	return values
 }
 
-// GetJournalEntry retrieves a single journal entry for a specific race.
-// Returns nil if no entry exists.
-func (s *DynamoStore) GetJournalEntry(ctx context.Context, driverID, raceID int64) (*RaceJournalEntry, error) {
-	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
-		TableName: aws.String(s.table),
-		Key: map[string]types.AttributeValue{
-			partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-			sortKeyName:      &types.AttributeValueMemberS{Value: fmt.Sprintf(journalEntrySortKeyFormat, raceID)},
+// RecordMilestone saves a milestone unless the driver already achieved it in an earlier race, so races can be
+// processed in any order. Returns true when the milestone was saved.
+func (s *DynamoStore) RecordMilestone(ctx context.Context, milestone DriverMilestone) (bool, error) {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(s.tableName(ctx)),
+		Item:                driverMilestoneModelFromEntity(milestone).toAttributeMap(),
+		ConditionExpression: aws.String("attribute_not_exists(#pk) OR #achieved_at > :achieved_at"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk":          partitionKeyName,
+			"#achieved_at": "achieved_at",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":achieved_at": &types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(milestone.AchievedAt), 10)},
 		},
 	})
 	if err != nil {
-		return nil, err
-	}
-	if result.Item == nil {
-		return nil, nil
+		var condErr *types.ConditionalCheckFailedException
+		if errors.As(err, &condErr) {
+			return false, nil
+		}
+		return false, err
 	}
-	return journalEntryFromAttributeMap(result.Item)
+	return true, nil
 }
 
-// GetJournalEntries retrieves journal entries for a driver within a time range.
-// Returns entries in reverse chronological order (newest first).
-func (s *DynamoStore) GetJournalEntries(ctx context.Context, driverID int64, from, to time.Time) ([]RaceJournalEntry, error) {
-	result, err := s.client.Query(ctx, &dynamodb.QueryInput{
-		TableName:              aws.String(s.table),
-		KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :from AND :to"),
+// GetDriverMilestones retrieves all of a driver's milestones, ordered by name.
+func (s *DynamoStore) GetDriverMilestones(ctx context.Context, driverID int64) ([]DriverMilestone, error) {
+	result, err := s.query(ctx, "GetDriverMilestones", &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName(ctx)),
+		KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
 		ExpressionAttributeNames: map[string]string{
 			"#pk": partitionKeyName,
 			"#sk": sortKeyName,
 		},
 		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":pk":   &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-			":from": &types.AttributeValueMemberS{Value: fmt.Sprintf(journalEntrySortKeyFormat, toUnixSeconds(from))},
-			":to":   &types.AttributeValueMemberS{Value: fmt.Sprintf(journalEntrySortKeyFormat, toUnixSeconds(to))},
+			":pk":     &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+			":prefix": &types.AttributeValueMemberS{Value: keys.DriverMilestonePrefix},
 		},
-		ScanIndexForward: aws.Bool(false), // newest first
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	entries := make([]RaceJournalEntry, 0, len(result.Items))
+	milestones := make([]DriverMilestone, 0, len(result.Items))
 	for _, item := range result.Items {
-		entry, err := journalEntryFromAttributeMap(item)
+		milestone, err := driverMilestoneFromAttributeMap(item)
 		if err != nil {
 			return nil, err
 		}
-		entries = append(entries, *entry)
+		milestones = append(milestones, *milestone)
 	}
-	return entries, nil
+	return milestones, nil
 }
 
-// DeleteJournalEntry removes a journal entry for a specific race.
-// Returns nil even if the entry doesn't exist (idempotent delete).
-func (s *DynamoStore) DeleteJournalEntry(ctx context.Context, driverID, raceID int64) error {
-	_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
-		TableName: aws.String(s.table),
+// SaveBenchmarkTable saves a benchmark table over whatever was there for its series, track and iRating band.
+func (s *DynamoStore) SaveBenchmarkTable(ctx context.Context, table BenchmarkTable) error {
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Item:      benchmarkTableModelFromEntity(table, table.ComputedAt.Add(benchmarkTTLDuration)).toAttributeMap(),
+	})
+	return err
+}
+
+// GetBenchmarkTable retrieves the benchmark table for a series, track and iRating band, returning nil if there isn't
+// one.
+func (s *DynamoStore) GetBenchmarkTable(ctx context.Context, seriesID, trackID int64, iRatingBand int) (*BenchmarkTable, error) {
+	result, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.tableName(ctx)),
 		Key: map[string]types.AttributeValue{
-			partitionKeyName: &types.AttributeValueMemberS{Value: fmt.Sprintf(driverPartitionFormat, driverID)},
-			sortKeyName:      &types.AttributeValueMemberS{Value: fmt.Sprintf(journalEntrySortKeyFormat, raceID)},
+			partitionKeyName: &types.AttributeValueMemberS{Value: keys.Benchmark(seriesID, trackID)},
+			sortKeyName:      &types.AttributeValueMemberS{Value: keys.BenchmarkBand(iRatingBand)},
 		},
 	})
-	return err
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+	return benchmarkTableFromAttributeMap(result.Item)
 }
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +14,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/tenant"
 )
 
 const localDynamoEndpoint = "http://localhost:8000"
@@ -53,7 +56,9 @@ func TestInsertDriver_Success(t *testing.T) {
 
 	got, err := s.GetDriver(ctx, 12345)
 	require.NoError(t, err)
-	assert.Equal(t, &driver, got)
+	expected := driver
+	expected.SearchIndexed = true
+	assert.Equal(t, &expected, got)
 }
 
 func TestInsertDriver_DuplicateReturnsError(t *testing.T) {
@@ -121,7 +126,9 @@ func TestInsertDriver_WithEntitlements(t *testing.T) {
 
 	got, err := s.GetDriver(ctx, 12345)
 	require.NoError(t, err)
-	assert.Equal(t, &driver, got)
+	expected := driver
+	expected.SearchIndexed = true
+	assert.Equal(t, &expected, got)
 }
 
 func TestInsertDriver_WithoutEntitlements(t *testing.T) {
@@ -202,6 +209,187 @@ func TestRecordLogin_DriverNotFound(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestUpdateDriverClub(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	assert.Error(t, s.UpdateDriverClub(ctx, 12345, 7, "Great Plains"))
+
+	driver := Driver{
+		DriverID:    12345,
+		DriverName:  "Jon Sabados",
+		MemberSince: time.Unix(500, 0),
+		FirstLogin:  time.Unix(1000, 0),
+		LastLogin:   time.Unix(1000, 0),
+		LoginCount:  1,
+		ClubID:      3,
+		ClubName:    "Midwest",
+	}
+	require.NoError(t, s.InsertDriver(ctx, driver))
+
+	got, err := s.GetDriver(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, 3, got.ClubID)
+	assert.Equal(t, "Midwest", got.ClubName)
+
+	require.NoError(t, s.UpdateDriverClub(ctx, 12345, 7, "Great Plains"))
+
+	got, err = s.GetDriver(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, 7, got.ClubID)
+	assert.Equal(t, "Great Plains", got.ClubName)
+}
+
+func TestSearchDrivers(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	for _, d := range []Driver{
+		{DriverID: 1, DriverName: "Jon Sabados"},
+		{DriverID: 2, DriverName: "Jonathan  Doe"},
+		{DriverID: 3, DriverName: "Jane Smith"},
+		{DriverID: 4, DriverName: "Joe Racer"},
+	} {
+		d.MemberSince = time.Unix(500, 0)
+		d.FirstLogin = time.Unix(1000, 0)
+		d.LastLogin = time.Unix(1000, 0)
+		require.NoError(t, s.InsertDriver(ctx, d))
+	}
+
+	got, err := s.SearchDrivers(ctx, "jon", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSearchEntry{
+		{DriverID: 1, DriverName: "Jon Sabados"},
+		{DriverID: 2, DriverName: "Jonathan  Doe"},
+	}, got)
+
+	got, err = s.SearchDrivers(ctx, "JONATHAN d", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSearchEntry{{DriverID: 2, DriverName: "Jonathan  Doe"}}, got)
+
+	got, err = s.SearchDrivers(ctx, "jo", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSearchEntry{
+		{DriverID: 4, DriverName: "Joe Racer"},
+		{DriverID: 1, DriverName: "Jon Sabados"},
+	}, got)
+
+	got, err = s.SearchDrivers(ctx, "j", 10)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestUpdateDriverName(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	assert.Error(t, s.UpdateDriverName(ctx, 12345, "Jon Sabados", "Jonathan Sabados"))
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{
+		DriverID:    12345,
+		DriverName:  "Jon Sabados",
+		MemberSince: time.Unix(500, 0),
+		FirstLogin:  time.Unix(1000, 0),
+		LastLogin:   time.Unix(1000, 0),
+		LoginCount:  1,
+	}))
+
+	require.NoError(t, s.UpdateDriverName(ctx, 12345, "Jon Sabados", "Nojon Sabados"))
+
+	got, err := s.GetDriver(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, "Nojon Sabados", got.DriverName)
+
+	found, err := s.SearchDrivers(ctx, "jon", 10)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+	found, err = s.SearchDrivers(ctx, "nojon", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSearchEntry{{DriverID: 12345, DriverName: "Nojon Sabados"}}, found)
+
+	// a change of case is still indexed under the same search name
+	require.NoError(t, s.UpdateDriverName(ctx, 12345, "Nojon Sabados", "NoJon Sabados"))
+	found, err = s.SearchDrivers(ctx, "nojon", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSearchEntry{{DriverID: 12345, DriverName: "NoJon Sabados"}}, found)
+}
+
+func TestGetDriverSettingsForDrivers(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 2, LeaderboardOptIn: true}))
+
+	got, err := s.GetDriverSettingsForDrivers(ctx, []int64{2, 1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSettings{
+		{DriverID: 2, LeaderboardOptIn: true},
+		{DriverID: 1},
+		{DriverID: 2, LeaderboardOptIn: true},
+	}, got)
+}
+
+func TestInsertDriver_WithOnboardingStep(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	driver := Driver{
+		DriverID:       12345,
+		DriverName:     "Jon Sabados",
+		MemberSince:    time.Unix(500, 0),
+		FirstLogin:     time.Unix(1000, 0),
+		LastLogin:      time.Unix(1000, 0),
+		LoginCount:     1,
+		OnboardingStep: OnboardingStepProfileCreated,
+	}
+
+	require.NoError(t, s.InsertDriver(ctx, driver))
+
+	got, err := s.GetDriver(ctx, 12345)
+	require.NoError(t, err)
+	expected := driver
+	expected.SearchIndexed = true
+	assert.Equal(t, &expected, got)
+}
+
+func TestAdvanceOnboarding(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	advanced, err := s.AdvanceOnboarding(ctx, 12345, "", OnboardingStepIngestionStarted)
+	require.NoError(t, err)
+	assert.False(t, advanced, "missing driver")
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{
+		DriverID:    12345,
+		DriverName:  "Jon Sabados",
+		MemberSince: time.Unix(500, 0),
+		FirstLogin:  time.Unix(1000, 0),
+		LastLogin:   time.Unix(1000, 0),
+		LoginCount:  1,
+	}))
+
+	advanced, err = s.AdvanceOnboarding(ctx, 12345, OnboardingStepProfileCreated, OnboardingStepIngestionStarted)
+	require.NoError(t, err)
+	assert.False(t, advanced, "stale from step")
+
+	advanced, err = s.AdvanceOnboarding(ctx, 12345, "", OnboardingStepIngestionStarted)
+	require.NoError(t, err)
+	assert.True(t, advanced)
+
+	advanced, err = s.AdvanceOnboarding(ctx, 12345, "", OnboardingStepRaceIngested)
+	require.NoError(t, err)
+	assert.False(t, advanced, "step already recorded")
+
+	advanced, err = s.AdvanceOnboarding(ctx, 12345, OnboardingStepIngestionStarted, OnboardingStepRaceIngested)
+	require.NoError(t, err)
+	assert.True(t, advanced)
+
+	got, err := s.GetDriver(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, OnboardingStepRaceIngested, got.OnboardingStep)
+}
+
 func TestSaveConnection_Success(t *testing.T) {
 	s := setupTestStore(t)
 	ctx := context.Background()
@@ -458,6 +646,42 @@ func TestUpdateDriverRacesIngestedTo_UpdatesExistingValue(t *testing.T) {
 	assert.Equal(t, newIngestedTo, *got.RacesIngestedTo)
 }
 
+func TestUpdateDriverRacesIngestedFrom_Success(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	ingestedTo := time.Unix(8000, 0)
+	driver := Driver{
+		DriverID:        12345,
+		DriverName:      "Jon Sabados",
+		MemberSince:     time.Unix(500, 0),
+		FirstLogin:      time.Unix(1000, 0),
+		LastLogin:       time.Unix(1000, 0),
+		LoginCount:      1,
+		RacesIngestedTo: &ingestedTo,
+	}
+	require.NoError(t, s.InsertDriver(ctx, driver))
+
+	ingestedFrom := time.Unix(5000, 0)
+	err := s.UpdateDriverRacesIngestedFrom(ctx, 12345, ingestedFrom)
+	require.NoError(t, err)
+
+	got, err := s.GetDriver(ctx, 12345)
+	require.NoError(t, err)
+	require.NotNil(t, got.RacesIngestedFrom)
+	assert.Equal(t, ingestedFrom, *got.RacesIngestedFrom)
+	require.NotNil(t, got.RacesIngestedTo)
+	assert.Equal(t, ingestedTo, *got.RacesIngestedTo)
+}
+
+func TestUpdateDriverRacesIngestedFrom_DriverNotFound(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	err := s.UpdateDriverRacesIngestedFrom(ctx, 999, time.Unix(1000, 0))
+	assert.Error(t, err)
+}
+
 func TestAcquireIngestionLock_Success(t *testing.T) {
 	s := setupTestStore(t)
 	ctx := context.Background()
@@ -573,6 +797,40 @@ func TestReleaseIngestionLock_NotFoundNoError(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestIngestionCancel(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	currentTime := time.Unix(1000, 0)
+	s.now = func() time.Time { return currentTime }
+
+	requested, err := s.IngestionCancelRequested(ctx, 12345)
+	require.NoError(t, err)
+	assert.False(t, requested)
+
+	require.NoError(t, s.RequestIngestionCancel(ctx, 12345))
+	requested, err = s.IngestionCancelRequested(ctx, 12345)
+	require.NoError(t, err)
+	assert.True(t, requested)
+
+	// other drivers are unaffected
+	requested, err = s.IngestionCancelRequested(ctx, 67890)
+	require.NoError(t, err)
+	assert.False(t, requested)
+
+	require.NoError(t, s.ClearIngestionCancel(ctx, 12345))
+	requested, err = s.IngestionCancelRequested(ctx, 12345)
+	require.NoError(t, err)
+	assert.False(t, requested)
+
+	// cancels the TTL sweep hasn't got to yet are ignored
+	require.NoError(t, s.RequestIngestionCancel(ctx, 12345))
+	currentTime = currentTime.Add(2 * time.Hour)
+	requested, err = s.IngestionCancelRequested(ctx, 12345)
+	require.NoError(t, err)
+	assert.False(t, requested)
+}
+
 func TestGetDriver_IngestionBlockedUntilFromLock(t *testing.T) {
 	s := setupTestStore(t)
 	ctx := context.Background()
@@ -670,6 +928,21 @@ func TestSaveDriverSessions_HappyPath(t *testing.T) {
 			OldSubLevel:           381,
 			NewSubLevel:           399,
 			ReasonOut:             "Running",
+			ReasonOutCode:         ReasonOutFinished,
+			StrengthOfField:       1850,
+			TrafficCost:           aws.Int(23000),
+			CornersPerLap:         12,
+			LicenseCategoryID:     2,
+			LapsComplete:          15,
+			LapsLead:              3,
+			CarClassID:            74,
+			SeasonID:              4500,
+			SeasonYear:            2024,
+			SeasonQuarter:         2,
+			RaceWeekNum:           5,
+			ChampPoints:           87,
+			DropRace:              true,
+			Positions:             []int{1, 1, 0, 1},
 		},
 		{
 			DriverID:              1002,
@@ -1002,17 +1275,19 @@ func TestDeleteDriverRaces_DeletesAllExceptInfo(t *testing.T) {
 	s := setupTestStore(t)
 	ctx := context.Background()
 
-	// Insert a driver with RacesIngestedTo set
+	// Insert a driver with RacesIngestedFrom and RacesIngestedTo set
+	ingestedFrom := time.Unix(3000, 0)
 	ingestedTo := time.Unix(5000, 0)
 	driver := Driver{
-		DriverID:        12345,
-		DriverName:      "Jon Sabados",
-		MemberSince:     time.Unix(500, 0),
-		FirstLogin:      time.Unix(1000, 0),
-		LastLogin:       time.Unix(1000, 0),
-		LoginCount:      1,
-		RacesIngestedTo: &ingestedTo,
-		Entitlements:    []string{"developer"},
+		DriverID:          12345,
+		DriverName:        "Jon Sabados",
+		MemberSince:       time.Unix(500, 0),
+		FirstLogin:        time.Unix(1000, 0),
+		LastLogin:         time.Unix(1000, 0),
+		LoginCount:        1,
+		RacesIngestedFrom: &ingestedFrom,
+		RacesIngestedTo:   &ingestedTo,
+		Entitlements:      []string{"developer"},
 	}
 	require.NoError(t, s.InsertDriver(ctx, driver))
 
@@ -1039,7 +1314,8 @@ func TestDeleteDriverRaces_DeletesAllExceptInfo(t *testing.T) {
 	assert.Equal(t, "Jon Sabados", got.DriverName)
 	assert.Equal(t, []string{"developer"}, got.Entitlements)
 
-	// Verify RacesIngestedTo is nil'd
+	// Verify RacesIngestedFrom and RacesIngestedTo are nil'd
+	assert.Nil(t, got.RacesIngestedFrom)
 	assert.Nil(t, got.RacesIngestedTo)
 
 	// Verify SessionCount is reset to 0
@@ -1411,41 +1687,2376 @@ func TestDeleteJournalEntry_NotFoundNoError(t *testing.T) {
 	require.NoError(t, err)
 }
 
-func setupTestStore(t *testing.T) *DynamoStore {
-	t.Helper()
-	t.Parallel()
+func TestSaveJournalDraft_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
 
-	tableName := fmt.Sprintf("test-%s-%d", t.Name(), time.Now().UnixNano())
+	fixedTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	s.now = func() time.Time { return fixedTime }
 
-	cfg, err := config.LoadDefaultConfig(context.Background(),
-		config.WithRegion("us-east-1"),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("dummy", "dummy", "dummy")),
-	)
+	draft := RaceJournalDraft{
+		DriverID:    12345,
+		RaceID:      1700000000,
+		ExpiresAt:   fixedTime.Add(30 * 24 * time.Hour),
+		Notes:       "Half written",
+		Tags:        []string{"sentiment:good"},
+		ReplayVideo: "https://youtube.com/watch?v=abc",
+	}
+	require.NoError(t, s.SaveJournalDraft(ctx, draft))
+
+	got, err := s.GetJournalDraft(ctx, 12345, 1700000000, ConsistentRead())
 	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, fixedTime.Unix(), got.SavedAt.Unix())
+	assert.Equal(t, draft.ExpiresAt.Unix(), got.ExpiresAt.Unix())
+	assert.Equal(t, "Half written", got.Notes)
+	assert.Equal(t, []string{"sentiment:good"}, got.Tags)
+	assert.Equal(t, "https://youtube.com/watch?v=abc", got.ReplayVideo)
 
-	client := dynamodb.NewFromConfig(cfg, func(o *dynamodb.Options) {
-		o.BaseEndpoint = aws.String(localDynamoEndpoint)
-	})
+	// the draft must not show up as, or alter, the published entry
+	entry, err := s.GetJournalEntry(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+	entries, err := s.GetJournalEntries(ctx, 12345, time.Unix(0, 0), time.Unix(9999999999, 0))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
 
-	_, err = client.CreateTable(context.Background(), &dynamodb.CreateTableInput{
-		TableName: aws.String(tableName),
-		KeySchema: []types.KeySchemaElement{
-			{AttributeName: aws.String("partition_key"), KeyType: types.KeyTypeHash},
-			{AttributeName: aws.String("sort_key"), KeyType: types.KeyTypeRange},
-		},
-		AttributeDefinitions: []types.AttributeDefinition{
-			{AttributeName: aws.String("partition_key"), AttributeType: types.ScalarAttributeTypeS},
-			{AttributeName: aws.String("sort_key"), AttributeType: types.ScalarAttributeTypeS},
-		},
-		BillingMode: types.BillingModePayPerRequest,
-	})
+func TestGetJournalDraft_ExpiredTreatedAsAbsent(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	fixedTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	s.now = func() time.Time { return fixedTime }
+
+	require.NoError(t, s.SaveJournalDraft(ctx, RaceJournalDraft{DriverID: 12345, RaceID: 1700000000, ExpiresAt: fixedTime.Add(time.Hour)}))
+
+	s.now = func() time.Time { return fixedTime.Add(2 * time.Hour) }
+	got, err := s.GetJournalDraft(ctx, 12345, 1700000000)
 	require.NoError(t, err)
+	assert.Nil(t, got)
+}
 
-	t.Cleanup(func() {
-		_, _ = client.DeleteTable(context.Background(), &dynamodb.DeleteTableInput{
-			TableName: aws.String(tableName),
-		})
-	})
+func TestDeleteJournalDraft_Success(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
 
-	return NewDynamoStore(client, tableName)
+	require.NoError(t, s.SaveJournalDraft(ctx, RaceJournalDraft{DriverID: 12345, RaceID: 1700000000, ExpiresAt: time.Now().Add(time.Hour)}))
+	require.NoError(t, s.DeleteJournalDraft(ctx, 12345, 1700000000))
+
+	got, err := s.GetJournalDraft(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	// idempotent
+	require.NoError(t, s.DeleteJournalDraft(ctx, 12345, 1700000000))
+}
+
+func TestJournalPrompts_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	fixedTime := time.Unix(1705314600, 0)
+	s.now = func() time.Time { return fixedTime }
+
+	require.NoError(t, s.SaveJournalPrompt(ctx, JournalPrompt{DriverID: 12345, RaceID: 1700000000}))
+	require.NoError(t, s.SaveJournalPrompt(ctx, JournalPrompt{DriverID: 12345, RaceID: 1700100000}))
+	require.NoError(t, s.SaveJournalPrompt(ctx, JournalPrompt{DriverID: 12345, RaceID: 1700200000}))
+	require.NoError(t, s.SaveJournalPrompt(ctx, JournalPrompt{DriverID: 67890, RaceID: 1700100000}))
+
+	prompts, err := s.GetJournalPrompts(ctx, 12345, time.Unix(1700050000, 0), time.Unix(1800000000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []JournalPrompt{
+		{DriverID: 12345, RaceID: 1700200000, CreatedAt: fixedTime},
+		{DriverID: 12345, RaceID: 1700100000, CreatedAt: fixedTime},
+	}, prompts)
+
+	require.NoError(t, s.DeleteJournalPrompt(ctx, 12345, 1700200000))
+	// idempotent
+	require.NoError(t, s.DeleteJournalPrompt(ctx, 12345, 1700200000))
+
+	prompts, err = s.GetJournalPrompts(ctx, 12345, time.Unix(1700050000, 0), time.Unix(1800000000, 0))
+	require.NoError(t, err)
+	require.Len(t, prompts, 1)
+	assert.Equal(t, int64(1700100000), prompts[0].RaceID)
+
+	// prompts the TTL sweep hasn't got to yet are ignored
+	s.now = func() time.Time { return fixedTime.Add(journalPromptTTLDuration) }
+	prompts, err = s.GetJournalPrompts(ctx, 12345, time.Unix(0, 0), time.Unix(1800000000, 0))
+	require.NoError(t, err)
+	assert.Empty(t, prompts)
+}
+
+func TestJournalStreak_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	fixedTime := time.Unix(1705314600, 0)
+	s.now = func() time.Time { return fixedTime }
+
+	missing, err := s.GetJournalStreak(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	require.NoError(t, s.SaveJournalStreak(ctx, JournalStreak{DriverID: 12345, CurrentWeeks: 1, LongestWeeks: 1, LastWeekStart: time.Unix(1704672000, 0)}))
+	require.NoError(t, s.SaveJournalStreak(ctx, JournalStreak{DriverID: 12345, CurrentWeeks: 2, LongestWeeks: 4, LastWeekStart: time.Unix(1705276800, 0)}))
+
+	got, err := s.GetJournalStreak(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &JournalStreak{
+		DriverID:      12345,
+		CurrentWeeks:  2,
+		LongestWeeks:  4,
+		LastWeekStart: time.Unix(1705276800, 0),
+		UpdatedAt:     fixedTime,
+	}, got)
+}
+
+func TestSaveJournalEntry_SearchTerms(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 12345, RaceID: 1700000000, Notes: "spun at turn one", SearchTerms: []string{"spun", "turn", "one"}}))
+	got, err := s.GetJournalEntry(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"spun", "turn", "one"}, got.SearchTerms)
+
+	// no terms removes the attribute rather than writing an empty set
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 12345, RaceID: 1700000000}))
+	got, err = s.GetJournalEntry(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	assert.Nil(t, got.SearchTerms)
+}
+
+func TestAppendJournalTranscript(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	appended, err := s.AppendJournalTranscript(ctx, 12345, 1700000000, "no entry yet", []string{"entry"})
+	require.NoError(t, err)
+	assert.False(t, appended)
+
+	fixedTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	s.now = func() time.Time { return fixedTime }
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 12345, RaceID: 1700000000, Notes: "notes"}))
+
+	s.now = func() time.Time { return fixedTime.Add(time.Hour) }
+	appended, err = s.AppendJournalTranscript(ctx, 12345, 1700000000, "braked too late", []string{"braked", "too", "late"})
+	require.NoError(t, err)
+	assert.True(t, appended)
+	appended, err = s.AppendJournalTranscript(ctx, 12345, 1700000000, "", nil)
+	require.NoError(t, err)
+	assert.True(t, appended)
+
+	// saving the entry keeps its transcripts
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 12345, RaceID: 1700000000, Notes: "edited"}))
+
+	got, err := s.GetJournalEntry(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	assert.Equal(t, "edited", got.Notes)
+	assert.Equal(t, []string{"braked too late", ""}, got.Transcripts)
+	assert.ElementsMatch(t, []string{"braked", "too", "late"}, got.TranscriptTerms)
+}
+
+func TestJournalAttachments_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	attachment := JournalAttachment{
+		DriverID:     12345,
+		RaceID:       1700000000,
+		AttachmentID: "8f14e45f",
+		ContentType:  "audio/webm",
+		CreatedAt:    createdAt,
+		Status:       JournalAttachmentPendingUpload,
+		Transcribe:   true,
+	}
+	require.NoError(t, s.SaveJournalAttachment(ctx, attachment))
+	require.NoError(t, s.SaveJournalAttachment(ctx, JournalAttachment{DriverID: 12345, RaceID: 1700000001, AttachmentID: "other", ContentType: "audio/mpeg", CreatedAt: createdAt, Status: JournalAttachmentUploaded}))
+
+	updated, err := s.SetJournalAttachmentStatus(ctx, 12345, 1700000000, "8f14e45f", JournalAttachmentUploaded)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	updated, err = s.SetJournalAttachmentStatus(ctx, 12345, 1700000000, "missing", JournalAttachmentUploaded)
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	got, err := s.GetJournalAttachment(ctx, 12345, 1700000000, "8f14e45f")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, JournalAttachmentUploaded, got.Status)
+	assert.Equal(t, createdAt.Unix(), got.CreatedAt.Unix())
+	assert.True(t, got.Transcribe)
+
+	missing, err := s.GetJournalAttachment(ctx, 12345, 1700000000, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	all, err := s.GetJournalAttachments(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, "8f14e45f", all[0].AttachmentID)
+
+	// attachments are not journal entries
+	entries, err := s.GetJournalEntries(ctx, 12345, time.Unix(0, 0), time.Unix(9999999999, 0))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestActionItems_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	dueDate := time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC)
+	item := ActionItem{
+		DriverID:  12345,
+		ItemID:    "8f14e45f",
+		Title:     "Brake earlier into turn one",
+		Status:    ActionItemOpen,
+		RaceID:    1700000000,
+		TrackID:   42,
+		DueDate:   &dueDate,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	require.NoError(t, s.SaveActionItem(ctx, item))
+	require.NoError(t, s.SaveActionItem(ctx, ActionItem{DriverID: 12345, ItemID: "free", Title: "Work on consistency", Status: ActionItemDone, CreatedAt: createdAt, UpdatedAt: createdAt, CompletedAt: &createdAt}))
+
+	got, err := s.GetActionItem(ctx, 12345, "8f14e45f")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "Brake earlier into turn one", got.Title)
+	assert.Equal(t, ActionItemOpen, got.Status)
+	assert.Equal(t, int64(1700000000), got.RaceID)
+	assert.Equal(t, int64(42), got.TrackID)
+	require.NotNil(t, got.DueDate)
+	assert.Equal(t, dueDate.Unix(), got.DueDate.Unix())
+	assert.Nil(t, got.CompletedAt)
+
+	all, err := s.GetActionItems(ctx, 12345)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "8f14e45f", all[0].ItemID)
+	assert.Equal(t, "free", all[1].ItemID)
+	assert.Zero(t, all[1].RaceID)
+	assert.Nil(t, all[1].DueDate)
+	require.NotNil(t, all[1].CompletedAt)
+
+	require.NoError(t, s.DeleteActionItem(ctx, 12345, "8f14e45f"))
+	missing, err := s.GetActionItem(ctx, 12345, "8f14e45f")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestAlertRules_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	createdAt := time.Unix(1705314600, 0)
+	triggeredAt := time.Unix(1705401000, 0)
+	rule := AlertRule{
+		DriverID:    12345,
+		RuleID:      "8f14e45f",
+		Name:        "Incident creep",
+		Metric:      AlertMetricIncidents,
+		Aggregate:   AlertAggregateAverage,
+		WindowRaces: 10,
+		Comparison:  AlertComparisonAbove,
+		Threshold:   5.5,
+		Enabled:     true,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+	require.NoError(t, s.SaveAlertRule(ctx, rule))
+	require.NoError(t, s.SaveAlertRule(ctx, AlertRule{
+		DriverID:        12345,
+		RuleID:          "slide",
+		Name:            "iRating slide",
+		Metric:          AlertMetricIRating,
+		Aggregate:       AlertAggregateChange,
+		WindowDays:      7,
+		Comparison:      AlertComparisonBelow,
+		Threshold:       -200,
+		Enabled:         true,
+		Triggered:       true,
+		LastTriggeredAt: &triggeredAt,
+		LastValue:       -231,
+		CreatedAt:       createdAt,
+		UpdatedAt:       triggeredAt,
+	}))
+
+	got, err := s.GetAlertRule(ctx, 12345, "8f14e45f")
+	require.NoError(t, err)
+	assert.Equal(t, &rule, got)
+
+	all, err := s.GetAlertRules(ctx, 12345)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "8f14e45f", all[0].RuleID)
+	assert.Equal(t, "slide", all[1].RuleID)
+	assert.True(t, all[1].Triggered)
+	assert.Equal(t, &triggeredAt, all[1].LastTriggeredAt)
+	assert.Equal(t, -231.0, all[1].LastValue)
+
+	require.NoError(t, s.DeleteAlertRule(ctx, 12345, "8f14e45f"))
+	// idempotent
+	require.NoError(t, s.DeleteAlertRule(ctx, 12345, "8f14e45f"))
+	missing, err := s.GetAlertRule(ctx, 12345, "8f14e45f")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestRacePlans_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	weekStart := time.Unix(1699920000, 0)
+	plan := RacePlan{
+		DriverID:        12345,
+		WeekStart:       weekStart,
+		SeriesID:        260,
+		TrackID:         47,
+		Goals:           "Clean race, top 5",
+		SetupIntentions: "Less rear wing",
+		CreatedAt:       time.Unix(1700000000, 0),
+		UpdatedAt:       time.Unix(1700000000, 0),
+	}
+	require.NoError(t, s.SaveRacePlan(ctx, plan))
+	require.NoError(t, s.SaveRacePlan(ctx, RacePlan{DriverID: 12345, WeekStart: weekStart.AddDate(0, 0, 7), SeriesID: 260, TrackID: 12, Goals: "Next week", CreatedAt: plan.CreatedAt, UpdatedAt: plan.UpdatedAt}))
+	require.NoError(t, s.SaveRacePlan(ctx, RacePlan{DriverID: 999, WeekStart: weekStart, SeriesID: 260, TrackID: 47, Goals: "Someone else's", CreatedAt: plan.CreatedAt, UpdatedAt: plan.UpdatedAt}))
+
+	got, err := s.GetRacePlan(ctx, 12345, weekStart, 260, 47)
+	require.NoError(t, err)
+	assert.Equal(t, &plan, got)
+
+	all, err := s.GetRacePlans(ctx, 12345)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, int64(47), all[0].TrackID)
+	assert.Equal(t, int64(12), all[1].TrackID)
+
+	evaluation := &PlanEvaluation{
+		Targets: []PlanTarget{
+			{Kind: PlanTargetFinishPosition, Target: 5, Actual: 3, Met: true},
+			{Kind: PlanTargetIncidents, Target: 0, Actual: 4, Met: false},
+		},
+		EvaluatedAt: time.Unix(1700220000, 0),
+	}
+	linked, err := s.LinkRacePlan(ctx, 12345, weekStart, 260, 47, 1700200000, 2002, time.Unix(1700210000, 0), &PlanEvaluation{EvaluatedAt: time.Unix(1700210000, 0)})
+	require.NoError(t, err)
+	assert.True(t, linked)
+	// an earlier race takes the link over
+	linked, err = s.LinkRacePlan(ctx, 12345, weekStart, 260, 47, 1700100000, 1001, time.Unix(1700220000, 0), evaluation)
+	require.NoError(t, err)
+	assert.True(t, linked)
+	// a later one doesn't
+	linked, err = s.LinkRacePlan(ctx, 12345, weekStart, 260, 47, 1700300000, 3003, time.Unix(1700230000, 0), nil)
+	require.NoError(t, err)
+	assert.False(t, linked)
+	// nor do races nobody planned
+	linked, err = s.LinkRacePlan(ctx, 12345, weekStart, 260, 99, 1700100000, 1001, time.Unix(1700220000, 0), nil)
+	require.NoError(t, err)
+	assert.False(t, linked)
+
+	got, err = s.GetRacePlan(ctx, 12345, weekStart, 260, 47)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1700100000), got.RaceID)
+	assert.Equal(t, int64(1001), got.SubsessionID)
+	assert.Equal(t, time.Unix(1700220000, 0), got.LinkedAt)
+	assert.Equal(t, "Clean race, top 5", got.Goals)
+	assert.Equal(t, evaluation, got.Evaluation)
+
+	require.NoError(t, s.DeleteRacePlan(ctx, 12345, weekStart, 260, 47))
+	// idempotent
+	require.NoError(t, s.DeleteRacePlan(ctx, 12345, weekStart, 260, 47))
+	missing, err := s.GetRacePlan(ctx, 12345, weekStart, 260, 47)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestRaceBookmarks_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	bookmark := RaceBookmark{
+		DriverID:   12345,
+		RaceID:     1700000000,
+		BookmarkID: "8f14e45f",
+		Lap:        7,
+		Note:       "Lost the rear on exit",
+		ReplayTime: 12*time.Minute + 34567*time.Millisecond,
+		CreatedAt:  createdAt,
+		UpdatedAt:  createdAt,
+	}
+	require.NoError(t, s.SaveRaceBookmark(ctx, bookmark))
+	require.NoError(t, s.SaveRaceBookmark(ctx, RaceBookmark{DriverID: 12345, RaceID: 1700000000, BookmarkID: "a1", Lap: 1, Note: "Start", CreatedAt: createdAt, UpdatedAt: createdAt}))
+	require.NoError(t, s.SaveRaceBookmark(ctx, RaceBookmark{DriverID: 12345, RaceID: 1700000001, BookmarkID: "other", Lap: 2, Note: "Different race", CreatedAt: createdAt, UpdatedAt: createdAt}))
+
+	got, err := s.GetRaceBookmark(ctx, 12345, 1700000000, "8f14e45f")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, 7, got.Lap)
+	assert.Equal(t, "Lost the rear on exit", got.Note)
+	assert.Equal(t, bookmark.ReplayTime, got.ReplayTime)
+	assert.Equal(t, createdAt.Unix(), got.CreatedAt.Unix())
+
+	all, err := s.GetRaceBookmarks(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "8f14e45f", all[0].BookmarkID)
+	assert.Equal(t, "a1", all[1].BookmarkID)
+
+	require.NoError(t, s.DeleteRaceBookmark(ctx, 12345, 1700000000, "8f14e45f"))
+	missing, err := s.GetRaceBookmark(ctx, 12345, 1700000000, "8f14e45f")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestVideoLinks_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	lap := 0
+	link := VideoLink{
+		DriverID:     12345,
+		RaceID:       1700000000,
+		LinkID:       "8f14e45f",
+		Lap:          &lap,
+		URL:          "https://www.youtube.com/watch?v=abc123&t=95s",
+		Provider:     "youtube",
+		Title:        "Turn one chaos",
+		AuthorName:   "Some Streamer",
+		ThumbnailURL: "https://i.ytimg.com/vi/abc123/hqdefault.jpg",
+		CreatedAt:    createdAt,
+	}
+	require.NoError(t, s.SaveVideoLink(ctx, link))
+	require.NoError(t, s.SaveVideoLink(ctx, VideoLink{DriverID: 12345, RaceID: 1700000000, LinkID: "a1", URL: "https://example.com/clip", Provider: "other", CreatedAt: createdAt}))
+	require.NoError(t, s.SaveVideoLink(ctx, VideoLink{DriverID: 12345, RaceID: 1700000001, LinkID: "other", URL: "https://example.com/other", Provider: "other", CreatedAt: createdAt}))
+
+	all, err := s.GetVideoLinks(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "8f14e45f", all[0].LinkID)
+	require.NotNil(t, all[0].Lap)
+	assert.Equal(t, 0, *all[0].Lap)
+	assert.Equal(t, link.URL, all[0].URL)
+	assert.Equal(t, "Turn one chaos", all[0].Title)
+	assert.Equal(t, "Some Streamer", all[0].AuthorName)
+	assert.Equal(t, link.ThumbnailURL, all[0].ThumbnailURL)
+	assert.Equal(t, "a1", all[1].LinkID)
+	assert.Nil(t, all[1].Lap)
+	assert.Empty(t, all[1].Title)
+
+	require.NoError(t, s.DeleteVideoLink(ctx, 12345, 1700000000, "8f14e45f"))
+	all, err = s.GetVideoLinks(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+}
+
+func TestRaceTelemetry_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	missing, err := s.GetRaceTelemetry(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	telemetry := RaceTelemetry{
+		DriverID: 12345,
+		RaceID:   1700000000,
+		Stints: []TelemetryStint{
+			{Stint: 1, StartLap: 1, EndLap: 14, Laps: 14, FuelPerLap: 2.41, Tires: []TireSummary{
+				{Corner: TireCornerLF, AvgTemp: 84.5, StartPressure: 162.1, EndPressure: 176.4},
+				{Corner: TireCornerRR, AvgTemp: 91.25, StartPressure: 160.8, EndPressure: 179},
+			}},
+			{Stint: 2, StartLap: 15, EndLap: 22, Laps: 8, Tires: []TireSummary{}},
+		},
+		ImportedAt: time.Date(2024, 1, 22, 6, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, s.SaveRaceTelemetry(ctx, telemetry))
+
+	got, err := s.GetRaceTelemetry(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, telemetry.ImportedAt.Unix(), got.ImportedAt.Unix())
+	assert.Equal(t, telemetry.Stints, got.Stints)
+}
+
+func TestExternalLaps_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	sessionStart := time.Date(2024, 1, 20, 18, 0, 0, 0, time.UTC)
+	laps := []ExternalLap{
+		{DriverID: 12345, Source: "garage61", SessionID: "abc", SessionStart: sessionStart, TrackID: 42, CarID: 7, LapNumber: 1, LapTime: 951234},
+		{DriverID: 12345, Source: "garage61", SessionID: "abc", SessionStart: sessionStart, TrackID: 42, CarID: 7, LapNumber: 2, Incident: true, LapTime: -1},
+	}
+	require.NoError(t, s.SaveExternalLaps(ctx, laps))
+
+	got, err := s.GetExternalLapsByTimeRange(ctx, 12345, sessionStart, sessionStart)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	for i := range laps {
+		assert.Equal(t, laps[i].SessionStart.Unix(), got[i].SessionStart.Unix())
+		got[i].SessionStart = laps[i].SessionStart
+	}
+	assert.Equal(t, laps, got)
+
+	none, err := s.GetExternalLapsByTimeRange(ctx, 12345, sessionStart.Add(time.Second), sessionStart.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, none)
+}
+
+func TestPracticePlan_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	missing, err := s.GetPracticePlan(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	plan := PracticePlan{
+		DriverID:    12345,
+		GeneratedAt: time.Date(2024, 1, 22, 6, 0, 0, 0, time.UTC),
+		WindowStart: time.Date(2023, 12, 25, 6, 0, 0, 0, time.UTC),
+		Items: []PracticePlanItem{
+			{TrackID: 42, CarID: 7, Focus: PracticeFocusIncidents, RaceCount: 4, Value: 3.75, Baseline: 1.5},
+			{TrackID: 9, CarID: 7, Focus: PracticeFocusRacecraft, RaceCount: 2, Value: 4, Baseline: -0.5},
+		},
+	}
+	require.NoError(t, s.SavePracticePlan(ctx, plan))
+
+	got, err := s.GetPracticePlan(ctx, 12345)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, plan.GeneratedAt.Unix(), got.GeneratedAt.Unix())
+	assert.Equal(t, plan.WindowStart.Unix(), got.WindowStart.Unix())
+	assert.Equal(t, plan.Items, got.Items)
+}
+
+func TestWeeklyRecap_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	missing, err := s.GetWeeklyRecap(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	recap := WeeklyRecap{
+		DriverID:    12345,
+		WeekStart:   time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC),
+		GeneratedAt: time.Date(2024, 1, 22, 6, 0, 0, 0, time.UTC),
+		Fatigue:     &FatigueFlag{MultiRaceDays: 4, Measures: []FatigueMeasure{FatigueMeasureIncidents, FatigueMeasureConsistency}},
+		Highlights:  []string{"Your practice plan for the week is ready."},
+	}
+	require.NoError(t, s.SaveWeeklyRecap(ctx, recap))
+
+	got, err := s.GetWeeklyRecap(ctx, 12345)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, recap.WeekStart.Unix(), got.WeekStart.Unix())
+	assert.Equal(t, recap.GeneratedAt.Unix(), got.GeneratedAt.Unix())
+	assert.Equal(t, recap.Fatigue, got.Fatigue)
+	assert.Equal(t, recap.Highlights, got.Highlights)
+
+	// the next week's recap replaces it, flag and all
+	recap.WeekStart = time.Date(2024, 1, 23, 0, 0, 0, 0, time.UTC)
+	recap.Fatigue = nil
+	require.NoError(t, s.SaveWeeklyRecap(ctx, recap))
+
+	got, err = s.GetWeeklyRecap(ctx, 12345)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, recap.WeekStart.Unix(), got.WeekStart.Unix())
+	assert.Nil(t, got.Fatigue)
+}
+
+func TestDashboardLayout_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	missing, err := s.GetDashboardLayout(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	layout := DashboardLayout{
+		DriverID:      12345,
+		SchemaVersion: 1,
+		Widgets: []DashboardWidget{
+			{WidgetID: "w1", Card: "irating", Column: 0, Row: 0, Width: 6, Height: 4, Days: 90, Granularity: "week", SeriesIDs: []int64{285}},
+			{WidgetID: "w2", Card: "incidents", Column: 6, Row: 0, Width: 6, Height: 4, GroupBy: []string{"car", "track"}, CarIDs: []int64{7, 9}, TrackIDs: []int64{42}},
+		},
+		UpdatedAt: time.Date(2024, 1, 22, 6, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, s.SaveDashboardLayout(ctx, layout))
+
+	got, err := s.GetDashboardLayout(ctx, 12345)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, layout.SchemaVersion, got.SchemaVersion)
+	assert.Equal(t, layout.UpdatedAt.Unix(), got.UpdatedAt.Unix())
+	assert.Equal(t, layout.Widgets, got.Widgets)
+
+	// saving again replaces the whole layout, an empty dashboard included
+	layout.Widgets = []DashboardWidget{}
+	require.NoError(t, s.SaveDashboardLayout(ctx, layout))
+
+	got, err = s.GetDashboardLayout(ctx, 12345)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Empty(t, got.Widgets)
+}
+
+func TestIngestionCoverage_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	missing, err := s.GetIngestionCoverage(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	require.NoError(t, s.AddIngestionCoverage(ctx, 12345, TimeRange{From: time.Unix(3000, 0), To: time.Unix(4000, 0)}))
+	require.NoError(t, s.AddIngestionCoverage(ctx, 12345,
+		TimeRange{From: time.Unix(1000, 0), To: time.Unix(2000, 0)},
+		TimeRange{From: time.Unix(3500, 0), To: time.Unix(5000, 0)},
+	))
+
+	got, err := s.GetIngestionCoverage(ctx, 12345, ConsistentRead())
+	require.NoError(t, err)
+	assert.Equal(t, Coverage{
+		{From: time.Unix(1000, 0), To: time.Unix(2000, 0)},
+		{From: time.Unix(3000, 0), To: time.Unix(5000, 0)},
+	}, got)
+}
+
+func TestDeleteDriverRaces_DeletesIngestionCoverage(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 12345, DriverName: "Jon Sabados"}))
+	require.NoError(t, s.AddIngestionCoverage(ctx, 12345, TimeRange{From: time.Unix(1000, 0), To: time.Unix(2000, 0)}))
+
+	require.NoError(t, s.DeleteDriverRaces(ctx, 12345))
+
+	got, err := s.GetIngestionCoverage(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestLoginAttempts(t *testing.T) {
+	s := setupTestStore(t)
+	s.now = func() time.Time { return time.Unix(10000, 0) }
+	ctx := context.Background()
+	window := time.Hour
+
+	attempts, err := s.GetLoginAttempts(ctx, "ip#203.0.113.7")
+	require.NoError(t, err)
+	assert.Nil(t, attempts)
+
+	for i, at := range []int64{10000, 10100, 10200} {
+		attempts, err = s.RecordLoginFailure(ctx, "ip#203.0.113.7", time.Unix(at, 0), window)
+		require.NoError(t, err)
+		assert.Equal(t, &LoginAttempts{Key: "ip#203.0.113.7", Failures: i + 1, FirstFailure: time.Unix(10000, 0), LastFailure: time.Unix(at, 0)}, attempts)
+	}
+	_, err = s.RecordLoginFailure(ctx, "customer#12345", time.Unix(10200, 0), window)
+	require.NoError(t, err)
+
+	attempts, err = s.GetLoginAttempts(ctx, "ip#203.0.113.7")
+	require.NoError(t, err)
+	assert.Equal(t, &LoginAttempts{Key: "ip#203.0.113.7", Failures: 3, FirstFailure: time.Unix(10000, 0), LastFailure: time.Unix(10200, 0)}, attempts)
+
+	// a failure after a quiet window starts the count again
+	attempts, err = s.RecordLoginFailure(ctx, "ip#203.0.113.7", time.Unix(10200+3601, 0), window)
+	require.NoError(t, err)
+	assert.Equal(t, &LoginAttempts{Key: "ip#203.0.113.7", Failures: 1, FirstFailure: time.Unix(13801, 0), LastFailure: time.Unix(13801, 0)}, attempts)
+
+	require.NoError(t, s.ClearLoginAttempts(ctx, "ip#203.0.113.7"))
+	attempts, err = s.GetLoginAttempts(ctx, "ip#203.0.113.7")
+	require.NoError(t, err)
+	assert.Nil(t, attempts)
+
+	attempts, err = s.GetLoginAttempts(ctx, "customer#12345")
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts.Failures, "clearing one key should leave the others")
+}
+
+func TestConsumeRateBudget(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+	start := time.Unix(6000, 0)
+
+	empty, err := s.GetRateBudgetWindow(ctx, start)
+	require.NoError(t, err)
+	assert.Equal(t, &RateBudgetWindow{Start: start, Drivers: map[int64]int{}}, empty)
+
+	consumed, err := s.ConsumeRateBudget(ctx, start, 1, 40, 100)
+	require.NoError(t, err)
+	assert.True(t, consumed)
+	consumed, err = s.ConsumeRateBudget(ctx, start, 2, 40, 100)
+	require.NoError(t, err)
+	assert.True(t, consumed)
+	consumed, err = s.ConsumeRateBudget(ctx, start, 1, 30, 100)
+	require.NoError(t, err)
+	assert.False(t, consumed)
+
+	window, err := s.GetRateBudgetWindow(ctx, start)
+	require.NoError(t, err)
+	assert.Equal(t, &RateBudgetWindow{Start: start, Total: 80, Drivers: map[int64]int{1: 40, 2: 40}}, window)
+}
+
+func TestGetDriversActiveSince(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 1, DriverName: "Idle", LastLogin: time.Unix(5000, 0)}))
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 2, DriverName: "Active", LastLogin: time.Unix(9000, 0)}))
+
+	drivers, err := s.GetDriversActiveSince(ctx, time.Unix(8000, 0))
+	require.NoError(t, err)
+	require.Len(t, drivers, 1)
+	assert.Equal(t, int64(2), drivers[0].DriverID)
+}
+
+func TestSaveSessionDriverLaps_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	// more laps than a single batch write, inserted out of order
+	var laps []SessionDriverLap
+	for i := 30; i >= 0; i-- {
+		laps = append(laps, SessionDriverLap{
+			SubsessionID: 12345,
+			DriverID:     1001,
+			LapNumber:    i,
+			SessionTime:  60000 + i*95000,
+			LapTime:      95000 + i,
+		})
+	}
+	laps[0].Incident = true
+	laps[0].LapEvents = []string{"off track", "pitted"}
+	laps[1].PersonalBestLap = true
+	laps[1].Flags = 4
+	laps[2].LapTime = -1
+	laps[2].Synthetic = true
+
+	require.NoError(t, s.SaveSessionDriverLaps(ctx, laps))
+
+	got, err := s.GetSessionDriverLaps(ctx, 12345, 1001)
+	require.NoError(t, err)
+	require.Len(t, got, 31)
+	for i, lap := range got {
+		assert.Equal(t, i, lap.LapNumber, "laps should be ordered by lap number")
+	}
+	assert.Equal(t, laps[0], got[30])
+	assert.Equal(t, laps[1], got[29])
+	assert.Equal(t, laps[2], got[28])
+	assert.Nil(t, got[0].LapEvents)
+}
+
+func TestSaveSessionDriverLaps_OverwritesExisting(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveSessionDriverLaps(ctx, []SessionDriverLap{
+		{SubsessionID: 12345, DriverID: 1001, LapNumber: 1, LapTime: -1},
+	}))
+	require.NoError(t, s.SaveSessionDriverLaps(ctx, []SessionDriverLap{
+		{SubsessionID: 12345, DriverID: 1001, LapNumber: 1, LapTime: 95000},
+	}))
+
+	got, err := s.GetSessionDriverLaps(ctx, 12345, 1001)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, 95000, got[0].LapTime)
+}
+
+func TestGetSessionDriverLaps_IsolatedByDriver(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	// driver 1 is a prefix of driver 10, make sure the sort key prefix doesn't bleed between them
+	require.NoError(t, s.SaveSessionDriverLaps(ctx, []SessionDriverLap{
+		{SubsessionID: 12345, DriverID: 1, LapNumber: 1, LapTime: 95000},
+		{SubsessionID: 12345, DriverID: 10, LapNumber: 1, LapTime: 96000},
+		{SubsessionID: 99999, DriverID: 1, LapNumber: 1, LapTime: 97000},
+	}))
+
+	got, err := s.GetSessionDriverLaps(ctx, 12345, 1)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, 95000, got[0].LapTime)
+
+	got, err = s.GetSessionDriverLaps(ctx, 12345, 2)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestGetSessionDriverLaps_SpansPages(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	// a long race with chatty lap events runs past a single 1 MB query page
+	laps := make([]SessionDriverLap, 300)
+	for i := range laps {
+		laps[i] = SessionDriverLap{
+			SubsessionID: 12345,
+			DriverID:     1001,
+			LapNumber:    i,
+			LapTime:      95000,
+			LapEvents:    []string{strings.Repeat("x", 5000)},
+		}
+	}
+	require.NoError(t, s.SaveSessionDriverLaps(ctx, laps))
+
+	got, err := s.GetSessionDriverLaps(ctx, 12345, 1001)
+	require.NoError(t, err)
+	require.Len(t, got, 300)
+	for i, lap := range got {
+		assert.Equal(t, i, lap.LapNumber, "laps should be ordered by lap number")
+	}
+}
+
+func TestGetSessionLapsInRaceOrder(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	lap := func(driverID int64, lapNumber, sessionTime int) SessionDriverLap {
+		return SessionDriverLap{SubsessionID: 12345, DriverID: driverID, LapNumber: lapNumber, SessionTime: sessionTime, LapTime: 900000 + lapNumber}
+	}
+	require.NoError(t, s.SaveSessionDriverLaps(ctx, []SessionDriverLap{
+		lap(2, 1, 1950000),
+		lap(1, 1, 1900000),
+		lap(1, 2, 2850000),
+		lap(2, 2, 2850000),
+		lap(10, 1, -1),
+		{SubsessionID: 99999, DriverID: 1, LapNumber: 1, SessionTime: 100},
+	}))
+
+	laps, more, err := s.GetSessionLapsInRaceOrder(ctx, 12345, "", 2)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.Equal(t, []SessionDriverLap{lap(1, 1, 1900000), lap(2, 1, 1950000)}, laps)
+
+	// a lap completed earlier showing up later doesn't shift the next page
+	require.NoError(t, s.SaveSessionDriverLaps(ctx, []SessionDriverLap{lap(3, 1, 1800000)}))
+
+	laps, more, err = s.GetSessionLapsInRaceOrder(ctx, 12345, RaceOrderCursor(laps[1]), 2)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Equal(t, []SessionDriverLap{lap(1, 2, 2850000), lap(2, 2, 2850000)}, laps)
+
+	laps, more, err = s.GetSessionLapsInRaceOrder(ctx, 12345, RaceOrderCursor(laps[1]), 2)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Empty(t, laps)
+}
+
+func TestSaveDriverStanding_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	standing := DriverStanding{
+		DriverID:     12345,
+		WeekStart:    time.Unix(1715040000, 0),
+		SnapshotAt:   time.Unix(1715100000, 0),
+		SeasonID:     4500,
+		SeriesID:     42,
+		SeriesName:   "Advanced Mazda MX-5 Cup Series",
+		CarClassID:   74,
+		RaceWeekNum:  7,
+		Division:     3,
+		DivisionRank: 2,
+		DivisionSize: 180,
+		Points:       640,
+		WeeksCounted: 5,
+		Starts:       9,
+		Wins:         1,
+	}
+	require.NoError(t, s.SaveDriverStanding(ctx, standing))
+
+	got, err := s.GetDriverStanding(ctx, 12345, time.Unix(1715040000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, &standing, got)
+}
+
+func TestSaveDriverStanding_ReplacesSameWeek(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	weekStart := time.Unix(1715040000, 0)
+	require.NoError(t, s.SaveDriverStanding(ctx, DriverStanding{DriverID: 1, WeekStart: weekStart, SnapshotAt: weekStart, Points: 100}))
+	require.NoError(t, s.SaveDriverStanding(ctx, DriverStanding{DriverID: 1, WeekStart: weekStart, SnapshotAt: weekStart, Points: 200}))
+
+	got, err := s.GetDriverStandings(ctx, 1, time.Unix(0, 0), time.Unix(9999999999, 0))
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, 200, got[0].Points)
+}
+
+func TestGetDriverStanding_NotFound(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	got, err := s.GetDriverStanding(ctx, 99999, time.Unix(1715040000, 0))
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestGetDriverStandings_TimeRangeFiltering(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	for _, weekStart := range []int64{1000, 2000, 3000, 4000} {
+		require.NoError(t, s.SaveDriverStanding(ctx, DriverStanding{DriverID: 1, WeekStart: time.Unix(weekStart, 0), SnapshotAt: time.Unix(weekStart, 0)}))
+	}
+	// other drivers and entity types in the partition must not leak into results
+	require.NoError(t, s.SaveDriverStanding(ctx, DriverStanding{DriverID: 2, WeekStart: time.Unix(2500, 0), SnapshotAt: time.Unix(2500, 0)}))
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 1, RaceID: 2500, Notes: "not a standing"}))
+
+	got, err := s.GetDriverStandings(ctx, 1, time.Unix(2000, 0), time.Unix(3000, 0))
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, time.Unix(3000, 0), got[0].WeekStart)
+	assert.Equal(t, time.Unix(2000, 0), got[1].WeekStart)
+}
+
+func TestPersistSessionData_SavesSessionAndLaps(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 12345, DriverName: "Jon Sabados", MemberSince: time.Unix(500, 0), FirstLogin: time.Unix(1000, 0), LastLogin: time.Unix(1000, 0), LoginCount: 1}))
+
+	session := DriverSession{DriverID: 12345, SubsessionID: 777, StartTime: time.Unix(2000, 0), TrafficCost: aws.Int(15000)}
+	laps := []SessionDriverLap{
+		{SubsessionID: 777, DriverID: 12345, LapNumber: 0, LapTime: -1},
+		{SubsessionID: 777, DriverID: 12345, LapNumber: 1, LapTime: 951234},
+	}
+	require.NoError(t, s.PersistSessionData(ctx, session, laps))
+
+	gotSession, err := s.GetDriverSession(ctx, 12345, time.Unix(2000, 0))
+	require.NoError(t, err)
+	require.NotNil(t, gotSession)
+	assert.Equal(t, aws.Int(15000), gotSession.TrafficCost)
+
+	gotLaps, err := s.GetSessionDriverLaps(ctx, 777, 12345)
+	require.NoError(t, err)
+	assert.Len(t, gotLaps, 2)
+
+	driver, err := s.GetDriver(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), driver.SessionCount)
+}
+
+func TestPersistSessionData_MoreLapsThanATransaction(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	var laps []SessionDriverLap
+	for i := 0; i <= 150; i++ {
+		laps = append(laps, SessionDriverLap{SubsessionID: 777, DriverID: 12345, LapNumber: i, LapTime: 951234})
+	}
+	require.NoError(t, s.PersistSessionData(ctx, DriverSession{DriverID: 12345, SubsessionID: 777, StartTime: time.Unix(2000, 0)}, laps))
+
+	gotLaps, err := s.GetSessionDriverLaps(ctx, 777, 12345)
+	require.NoError(t, err)
+	assert.Len(t, gotLaps, 151)
+
+	gotSession, err := s.GetDriverSession(ctx, 12345, time.Unix(2000, 0))
+	require.NoError(t, err)
+	assert.NotNil(t, gotSession)
+}
+
+func TestPersistSessionData_ResumesPartialIngest(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	// 250 laps go out as two chunks of 99 ahead of the final transaction, pretend an earlier attempt wrote both chunks
+	// but failed before the session record
+	_, err := s.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.table),
+		Item:      sessionIngestMarkerModel{subsessionID: 777, driverID: 12345, chunkCount: 2, chunksWritten: 2}.toAttributeMap(),
+	})
+	require.NoError(t, err)
+
+	var laps []SessionDriverLap
+	for i := 0; i < 250; i++ {
+		laps = append(laps, SessionDriverLap{SubsessionID: 777, DriverID: 12345, LapNumber: i, LapTime: 951234})
+	}
+	require.NoError(t, s.PersistSessionData(ctx, DriverSession{DriverID: 12345, SubsessionID: 777, StartTime: time.Unix(2000, 0)}, laps))
+
+	// only the final transaction's laps were written this time around
+	gotLaps, err := s.GetSessionDriverLaps(ctx, 777, 12345)
+	require.NoError(t, err)
+	assert.Len(t, gotLaps, 52)
+
+	gotSession, err := s.GetDriverSession(ctx, 12345, time.Unix(2000, 0))
+	require.NoError(t, err)
+	assert.NotNil(t, gotSession)
+
+	err = s.PersistSessionData(ctx, DriverSession{DriverID: 12345, SubsessionID: 777, StartTime: time.Unix(2000, 0)}, laps)
+	assert.ErrorIs(t, err, ErrEntityAlreadyExists)
+}
+
+func TestPersistSessionData_AlreadyExists(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	session := DriverSession{DriverID: 12345, SubsessionID: 777, StartTime: time.Unix(2000, 0)}
+	require.NoError(t, s.PersistSessionData(ctx, session, nil))
+
+	err := s.PersistSessionData(ctx, session, []SessionDriverLap{{SubsessionID: 777, DriverID: 12345, LapNumber: 1, LapTime: 951234}})
+	assert.ErrorIs(t, err, ErrEntityAlreadyExists)
+
+	// the lap was part of the failed transaction so shouldn't have been written
+	gotLaps, err := s.GetSessionDriverLaps(ctx, 777, 12345)
+	require.NoError(t, err)
+	assert.Empty(t, gotLaps)
+}
+
+func TestPersistSessionDataWithMode(t *testing.T) {
+	testCases := []struct {
+		name              string
+		mode              WriteMode
+		expectedIncidents int
+		expectedLaps      int
+	}{
+		{name: "skip existing", mode: WriteModeSkipExisting, expectedIncidents: 4, expectedLaps: 0},
+		{name: "upsert", mode: WriteModeUpsert, expectedIncidents: 0, expectedLaps: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := setupTestStore(t)
+			ctx := context.Background()
+
+			require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 12345, DriverName: "Jon Sabados", MemberSince: time.Unix(500, 0), FirstLogin: time.Unix(1000, 0), LastLogin: time.Unix(1000, 0), LoginCount: 1}))
+			session := DriverSession{DriverID: 12345, SubsessionID: 777, StartTime: time.Unix(2000, 0), Incidents: 4}
+			require.NoError(t, s.PersistSessionData(ctx, session, nil))
+
+			changed := session
+			changed.Incidents = 0
+			result, err := s.PersistSessionDataWithMode(ctx, changed, []SessionDriverLap{{SubsessionID: 777, DriverID: 12345, LapNumber: 1, LapTime: 951234}}, tc.mode)
+			require.NoError(t, err)
+			assert.Equal(t, WriteResult{Existing: []DriverSession{changed}}, result)
+
+			got, err := s.GetDriverSession(ctx, 12345, time.Unix(2000, 0))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedIncidents, got.Incidents)
+
+			gotLaps, err := s.GetSessionDriverLaps(ctx, 777, 12345)
+			require.NoError(t, err)
+			assert.Len(t, gotLaps, tc.expectedLaps)
+
+			driver, err := s.GetDriver(ctx, 12345)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), driver.SessionCount)
+		})
+	}
+}
+
+func TestSaveDriverSessionsWithMode_SkipsExisting(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 12345, DriverName: "Jon Sabados", MemberSince: time.Unix(500, 0), FirstLogin: time.Unix(1000, 0), LastLogin: time.Unix(1000, 0), LoginCount: 1}))
+	saved := DriverSession{DriverID: 12345, SubsessionID: 777, StartTime: time.Unix(2000, 0)}
+	fresh := DriverSession{DriverID: 12345, SubsessionID: 778, StartTime: time.Unix(3000, 0)}
+	require.NoError(t, s.SaveDriverSessions(ctx, []DriverSession{saved}))
+	assert.ErrorIs(t, s.SaveDriverSessions(ctx, []DriverSession{saved, fresh}), ErrEntityAlreadyExists)
+
+	result, err := s.SaveDriverSessionsWithMode(ctx, []DriverSession{saved, fresh}, WriteModeSkipExisting)
+	require.NoError(t, err)
+	assert.Equal(t, WriteResult{Existing: []DriverSession{saved}}, result)
+
+	got, err := s.GetDriverSession(ctx, 12345, time.Unix(3000, 0))
+	require.NoError(t, err)
+	assert.NotNil(t, got)
+
+	driver, err := s.GetDriver(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), driver.SessionCount)
+}
+
+func TestDeleteDriverRaces_PreservesSettings(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 12345, DriverName: "Jon Sabados", MemberSince: time.Unix(500, 0), FirstLogin: time.Unix(1000, 0), LastLogin: time.Unix(1000, 0), LoginCount: 1}))
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 12345, LapRetentionMonths: 12}))
+
+	require.NoError(t, s.DeleteDriverRaces(ctx, 12345))
+
+	got, err := s.GetDriverSettings(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &DriverSettings{DriverID: 12345, LapRetentionMonths: 12}, got)
+}
+
+func TestDeleteSessionDriverLaps_OnlyDeletesDriversLaps(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	// more laps than a single batch write
+	var laps []SessionDriverLap
+	for i := 0; i <= 30; i++ {
+		laps = append(laps, SessionDriverLap{SubsessionID: 12345, DriverID: 1001, LapNumber: i, LapTime: 95000})
+	}
+	laps = append(laps, SessionDriverLap{SubsessionID: 12345, DriverID: 1002, LapNumber: 1, LapTime: 96000})
+	require.NoError(t, s.SaveSessionDriverLaps(ctx, laps))
+	require.NoError(t, s.SaveSessionDriverLapSummary(ctx, SessionDriverLapSummary{SubsessionID: 12345, DriverID: 1001, CompactedAt: time.Unix(1000, 0)}))
+
+	require.NoError(t, s.DeleteSessionDriverLaps(ctx, 12345, 1001))
+
+	got, err := s.GetSessionDriverLaps(ctx, 12345, 1001)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	other, err := s.GetSessionDriverLaps(ctx, 12345, 1002)
+	require.NoError(t, err)
+	assert.Len(t, other, 1)
+
+	summary, err := s.GetSessionDriverLapSummary(ctx, 12345, 1001)
+	require.NoError(t, err)
+	assert.NotNil(t, summary, "summary must survive lap deletion")
+}
+
+func TestDeleteSessionDriverLaps_NoLapsNoError(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.DeleteSessionDriverLaps(ctx, 99999, 1001))
+}
+
+func TestSaveSessionDriverLapSummary_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	summary := SessionDriverLapSummary{
+		SubsessionID:     12345,
+		DriverID:         1001,
+		LapCount:         20,
+		ValidLapCount:    19,
+		IncidentLapCount: 2,
+		BestLapTime:      951234,
+		AvgLapTime:       962000,
+		CompactedAt:      time.Unix(1700000000, 0),
+	}
+	require.NoError(t, s.SaveSessionDriverLapSummary(ctx, summary))
+
+	got, err := s.GetSessionDriverLapSummary(ctx, 12345, 1001)
+	require.NoError(t, err)
+	assert.Equal(t, &summary, got)
+}
+
+func TestGetSessionDriverLapSummary_NotFound(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	got, err := s.GetSessionDriverLapSummary(ctx, 99999, 1001)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestGetDriverSettings_DefaultsWhenNotSaved(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	got, err := s.GetDriverSettings(ctx, 99999)
+	require.NoError(t, err)
+	assert.Equal(t, &DriverSettings{DriverID: 99999}, got)
+}
+
+func TestSaveDriverSettings_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 12345, LapRetentionMonths: 12}))
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 12345, LapRetentionMonths: 6, SummaryOnlyIngestion: true, Timezone: "America/Chicago", Locale: "de"}))
+
+	got, err := s.GetDriverSettings(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &DriverSettings{DriverID: 12345, LapRetentionMonths: 6, SummaryOnlyIngestion: true, Timezone: "America/Chicago", Locale: "de"}, got)
+}
+
+func TestClearLapBackfillPending(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 12345, LapRetentionMonths: 12, LapBackfillPending: true}))
+
+	require.NoError(t, s.ClearLapBackfillPending(ctx, 12345))
+
+	got, err := s.GetDriverSettings(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &DriverSettings{DriverID: 12345, LapRetentionMonths: 12}, got)
+}
+
+func TestGetDriverSettingsWithLapRetention(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 1, LapRetentionMonths: 12}))
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 2}))
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 3, LapRetentionMonths: 3}))
+
+	got, err := s.GetDriverSettingsWithLapRetention(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []DriverSettings{
+		{DriverID: 1, LapRetentionMonths: 12},
+		{DriverID: 3, LapRetentionMonths: 3},
+	}, got)
+}
+
+func TestGetDriverSettingsWithLeaderboardOptIn(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 1, LeaderboardOptIn: true}))
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 2, LapRetentionMonths: 6}))
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 3, LapRetentionMonths: 3, LeaderboardOptIn: true}))
+
+	got, err := s.GetDriverSettingsWithLeaderboardOptIn(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []DriverSettings{
+		{DriverID: 1, LeaderboardOptIn: true},
+		{DriverID: 3, LapRetentionMonths: 3, LeaderboardOptIn: true},
+	}, got)
+}
+
+func TestGetDriverSettingsWithBenchmarkOptIn(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 1, BenchmarkOptIn: true}))
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 2, LeaderboardOptIn: true}))
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 3, LapRetentionMonths: 3, BenchmarkOptIn: true}))
+
+	got, err := s.GetDriverSettingsWithBenchmarkOptIn(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []DriverSettings{
+		{DriverID: 1, BenchmarkOptIn: true},
+		{DriverID: 3, LapRetentionMonths: 3, BenchmarkOptIn: true},
+	}, got)
+}
+
+func TestBenchmarkTable_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	got, err := s.GetBenchmarkTable(ctx, 139, 219, 1500)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	table := BenchmarkTable{
+		SeriesID:           139,
+		TrackID:            219,
+		IRatingBand:        1500,
+		ComputedAt:         time.Unix(1767657600, 0),
+		Drivers:            12,
+		IncidentsPerRace:   BenchmarkPercentiles{P10: 0.5, P25: 1, P50: 2.25, P75: 4, P90: 6.5},
+		OffBestPercent:     &BenchmarkPercentiles{P10: 0.4, P25: 0.7, P50: 1.1, P75: 1.6, P90: 2.3},
+		ConsistencyDrivers: 10,
+	}
+	require.NoError(t, s.SaveBenchmarkTable(ctx, table))
+
+	got, err = s.GetBenchmarkTable(ctx, 139, 219, 1500)
+	require.NoError(t, err)
+	assert.Equal(t, &table, got)
+
+	// recomputing without enough lap data drops the consistency distribution
+	table.OffBestPercent = nil
+	table.ConsistencyDrivers = 3
+	require.NoError(t, s.SaveBenchmarkTable(ctx, table))
+
+	got, err = s.GetBenchmarkTable(ctx, 139, 219, 1500)
+	require.NoError(t, err)
+	assert.Equal(t, &table, got)
+}
+
+func TestGetDriverSessionsWithSkippedLaps(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 12345, DriverName: "Jon Sabados", MemberSince: time.Unix(500, 0), FirstLogin: time.Unix(1000, 0), LastLogin: time.Unix(1000, 0), LoginCount: 1}))
+	require.NoError(t, s.SaveDriverSessions(ctx, []DriverSession{
+		{DriverID: 12345, SubsessionID: 1, StartTime: time.Unix(1000, 0), LapsSkipped: true},
+		{DriverID: 12345, SubsessionID: 2, StartTime: time.Unix(2000, 0)},
+		{DriverID: 12345, SubsessionID: 3, StartTime: time.Unix(3000, 0), LapsSkipped: true},
+		{DriverID: 54321, SubsessionID: 3, StartTime: time.Unix(3000, 0), LapsSkipped: true},
+	}))
+
+	got, err := s.GetDriverSessionsWithSkippedLaps(ctx, 12345)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, int64(3), got[0].SubsessionID)
+	assert.Equal(t, int64(1), got[1].SubsessionID)
+	assert.True(t, got[0].LapsSkipped)
+}
+
+func TestCompleteDriverSessionLaps(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveDriverSessions(ctx, []DriverSession{
+		{DriverID: 12345, SubsessionID: 1, StartTime: time.Unix(1000, 0), LapsSkipped: true},
+		{DriverID: 12345, SubsessionID: 2, StartTime: time.Unix(2000, 0), LapsSkipped: true},
+	}))
+
+	require.NoError(t, s.CompleteDriverSessionLaps(ctx, 12345, time.Unix(1000, 0), aws.Int(23000), 2))
+	require.NoError(t, s.CompleteDriverSessionLaps(ctx, 12345, time.Unix(2000, 0), nil, 0))
+
+	withTraffic, err := s.GetDriverSession(ctx, 12345, time.Unix(1000, 0))
+	require.NoError(t, err)
+	assert.False(t, withTraffic.LapsSkipped)
+	assert.Equal(t, aws.Int(23000), withTraffic.TrafficCost)
+	assert.Equal(t, 2, withTraffic.LapGaps)
+
+	withoutTraffic, err := s.GetDriverSession(ctx, 12345, time.Unix(2000, 0))
+	require.NoError(t, err)
+	assert.False(t, withoutTraffic.LapsSkipped)
+	assert.Nil(t, withoutTraffic.TrafficCost)
+	assert.Zero(t, withoutTraffic.LapGaps)
+
+	remaining, err := s.GetDriverSessionsWithSkippedLaps(ctx, 12345)
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+}
+
+func TestCompleteDriverSessionLaps_MissingSession(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	err := s.CompleteDriverSessionLaps(ctx, 12345, time.Unix(1000, 0), nil, 0)
+	assert.Error(t, err)
+}
+
+func TestSaveIngestionRun_RoundTrip(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	run := IngestionRun{
+		DriverID:     12345,
+		StartedAt:    time.UnixMilli(1700000000123),
+		Duration:     4500 * time.Millisecond,
+		RaceCount:    10,
+		NewRaceCount: 3,
+		LapCount:     120,
+		UpToDate:     true,
+		Error:        "pulling lap data: boom",
+		CaptureID:    "capture-1",
+		PhaseDurations: map[string]time.Duration{
+			"search":        800 * time.Millisecond,
+			"session_fetch": 2100 * time.Millisecond,
+		},
+	}
+	require.NoError(t, s.SaveIngestionRun(ctx, run))
+
+	got, err := s.GetRecentIngestionRuns(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, run, got[0])
+}
+
+func TestGetRecentIngestionRuns_NewestFirstAndLimited(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	for i, startedAt := range []int64{1000, 3000, 2000} {
+		require.NoError(t, s.SaveIngestionRun(ctx, IngestionRun{DriverID: int64(i + 1), StartedAt: time.UnixMilli(startedAt)}))
+	}
+
+	got, err := s.GetRecentIngestionRuns(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, int64(2), got[0].DriverID)
+	assert.Equal(t, int64(3), got[1].DriverID)
+}
+
+func TestIRacingProxyRequests_NewestFirstAndLimited(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	for _, requestedAt := range []int64{1000, 3000, 2000} {
+		require.NoError(t, s.SaveIRacingProxyRequest(ctx, IRacingProxyRequest{
+			DriverID:    12345,
+			RequestedAt: time.UnixMilli(requestedAt),
+			Path:        "/data/results/get",
+			Params:      map[string]string{"subsession_id": "99999", "cust_id": "{custId}"},
+			Status:      200,
+			Duration:    150 * time.Millisecond,
+		}))
+	}
+	require.NoError(t, s.SaveIRacingProxyRequest(ctx, IRacingProxyRequest{DriverID: 54321, RequestedAt: time.UnixMilli(4000), Path: "/data/member/info", Params: map[string]string{}}))
+
+	got, err := s.GetIRacingProxyRequests(ctx, 12345, 2)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, time.UnixMilli(3000), got[0].RequestedAt)
+	assert.Equal(t, time.UnixMilli(2000), got[1].RequestedAt)
+	assert.Equal(t, map[string]string{"subsession_id": "99999", "cust_id": "{custId}"}, got[0].Params)
+	assert.Equal(t, 150*time.Millisecond, got[0].Duration)
+}
+
+func TestRequestCapture(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+	fixedTime := time.Unix(1700000000, 0)
+	s.now = func() time.Time { return fixedTime }
+
+	mode, err := s.GetRequestCaptureMode(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, mode)
+
+	require.NoError(t, s.SaveRequestCaptureMode(ctx, RequestCaptureMode{DriverID: 12345, EnabledAt: fixedTime, Until: fixedTime.Add(time.Hour)}))
+	mode, err = s.GetRequestCaptureMode(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &RequestCaptureMode{DriverID: 12345, EnabledAt: fixedTime, Until: fixedTime.Add(time.Hour)}, mode)
+
+	for i, requestedAt := range []time.Time{fixedTime.Add(-25 * time.Hour), fixedTime.Add(-time.Minute), fixedTime} {
+		require.NoError(t, s.SaveCapturedRequest(ctx, CapturedRequest{
+			ID:                   fmt.Sprintf("request-%d", i),
+			DriverID:             12345,
+			RequestedAt:          requestedAt,
+			Method:               "POST",
+			Path:                 "/driver/12345/journal",
+			Route:                "/driver/{driver_id}/journal",
+			Status:               400,
+			Duration:             12 * time.Millisecond,
+			RequestContentType:   "application/json",
+			RequestBody:          `{"notes":"x"}`,
+			RequestBodyTruncated: true,
+		}))
+	}
+	require.NoError(t, s.SaveCapturedRequest(ctx, CapturedRequest{ID: "other", DriverID: 54321, RequestedAt: fixedTime}))
+
+	// the request from over a day ago is past its TTL
+	got, err := s.GetCapturedRequests(ctx, 12345, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "request-2", got[0].ID)
+	assert.Equal(t, "request-1", got[1].ID)
+	assert.Equal(t, "/driver/{driver_id}/journal", got[0].Route)
+	assert.Equal(t, `{"notes":"x"}`, got[0].RequestBody)
+	assert.True(t, got[0].RequestBodyTruncated)
+	assert.Equal(t, 12*time.Millisecond, got[0].Duration)
+
+	require.NoError(t, s.DeleteRequestCaptureMode(ctx, 12345))
+	mode, err = s.GetRequestCaptureMode(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, mode)
+}
+
+func TestIngestionTiers_SavedAndReplaced(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	tiers, err := s.GetIngestionTiers(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, tiers)
+
+	supporter := IngestionTier{
+		Name:                       "supporter",
+		Priority:                   10,
+		SearchWindowDays:           30,
+		RaceConsumptionConcurrency: 4,
+		LapConsumptionConcurrency:  8,
+		UpdatedAt:                  time.Unix(5000, 0),
+	}
+	standard := IngestionTier{
+		Name:         "default",
+		LapsDisabled: true,
+		UpdatedAt:    time.Unix(4000, 0),
+	}
+	require.NoError(t, s.SaveIngestionTier(ctx, supporter))
+	require.NoError(t, s.SaveIngestionTier(ctx, standard))
+
+	tiers, err = s.GetIngestionTiers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []IngestionTier{standard, supporter}, tiers)
+
+	supporter.SearchWindowDays = 60
+	require.NoError(t, s.SaveIngestionTier(ctx, supporter))
+	tiers, err = s.GetIngestionTiers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []IngestionTier{standard, supporter}, tiers)
+}
+
+func TestWaitlistAndInvitations(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	entries, err := s.GetWaitlist(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	first := WaitlistEntry{DriverID: 1, DriverName: "Jon Sabados", RequestedAt: time.Unix(1000, 0)}
+	second := WaitlistEntry{DriverID: 2, DriverName: "Jane Smith", RequestedAt: time.Unix(2000, 0)}
+	for _, entry := range []WaitlistEntry{first, second} {
+		added, err := s.AddToWaitlist(ctx, entry)
+		require.NoError(t, err)
+		assert.True(t, added)
+	}
+	// signing in again keeps the driver's place
+	added, err := s.AddToWaitlist(ctx, WaitlistEntry{DriverID: 1, DriverName: "Jon Sabados", RequestedAt: time.Unix(3000, 0)})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	entries, err = s.GetWaitlist(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WaitlistEntry{first, second}, entries)
+
+	invitation, err := s.GetInvitation(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, invitation)
+
+	invited := Invitation{DriverID: 1, Entitlements: []string{"beta"}, InvitedAt: time.Unix(4000, 0), InvitedBy: 99}
+	require.NoError(t, s.SaveInvitations(ctx, []Invitation{invited}))
+
+	invitation, err = s.GetInvitation(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &invited, invitation)
+
+	entries, err = s.GetWaitlist(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WaitlistEntry{second}, entries)
+}
+
+func TestTermsAcceptances(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	acceptances, err := s.GetTermsAcceptances(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, acceptances)
+
+	tos := TermsAcceptance{DriverID: 1, Document: "tos", Version: "2025-01-01", AcceptedAt: time.Unix(1000, 0)}
+	privacy := TermsAcceptance{DriverID: 1, Document: "privacy", Version: "2025-01-01", AcceptedAt: time.Unix(1000, 0)}
+	for _, acceptance := range []TermsAcceptance{tos, privacy} {
+		saved, err := s.SaveTermsAcceptance(ctx, acceptance)
+		require.NoError(t, err)
+		assert.True(t, saved)
+	}
+	// accepting again keeps the original acceptance time
+	saved, err := s.SaveTermsAcceptance(ctx, TermsAcceptance{DriverID: 1, Document: "tos", Version: "2025-01-01", AcceptedAt: time.Unix(2000, 0)})
+	require.NoError(t, err)
+	assert.False(t, saved)
+
+	acceptances, err = s.GetTermsAcceptances(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []TermsAcceptance{privacy, tos}, acceptances)
+
+	acceptances, err = s.GetTermsAcceptances(ctx, 2)
+	require.NoError(t, err)
+	assert.Empty(t, acceptances)
+}
+
+func TestDriverPresence(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	currentTime := time.Unix(1000, 0)
+	s.now = func() time.Time { return currentTime }
+
+	require.NoError(t, s.SaveDriverPresence(ctx, DriverPresence{DriverID: 67890, TrackID: 10, CarID: 20, SeriesName: "Formula Vee"}))
+	require.NoError(t, s.SaveDriverPresence(ctx, DriverPresence{DriverID: 12345}))
+
+	presences, err := s.GetDriverPresences(ctx, []int64{67890, 12345, 11111})
+	require.NoError(t, err)
+	assert.Equal(t, []DriverPresence{
+		{DriverID: 12345, UpdatedAt: currentTime},
+		{DriverID: 67890, TrackID: 10, CarID: 20, SeriesName: "Formula Vee", UpdatedAt: currentTime},
+	}, presences)
+
+	// presence the TTL sweep hasn't got to yet is ignored
+	currentTime = currentTime.Add(presenceTTLDuration)
+	presences, err = s.GetDriverPresences(ctx, []int64{12345, 67890})
+	require.NoError(t, err)
+	assert.Empty(t, presences)
+}
+
+func TestPresenceGrants_GrantAndRevoke(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	currentTime := time.Unix(1000, 0)
+	s.now = func() time.Time { return currentTime }
+
+	require.NoError(t, s.SavePresenceGrant(ctx, PresenceGrant{DriverID: 12345, DriverName: "Driver One", ViewerID: 300, ViewerName: "Viewer Three Hundred"}))
+	require.NoError(t, s.SavePresenceGrant(ctx, PresenceGrant{DriverID: 12345, DriverName: "Driver One", ViewerID: 40, ViewerName: "Viewer Forty"}))
+	require.NoError(t, s.SavePresenceGrant(ctx, PresenceGrant{DriverID: 67890, DriverName: "Driver Two", ViewerID: 40, ViewerName: "Viewer Forty"}))
+
+	viewers, err := s.GetPresenceViewers(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, []PresenceGrant{
+		{DriverID: 12345, DriverName: "Driver One", ViewerID: 40, ViewerName: "Viewer Forty", GrantedAt: currentTime},
+		{DriverID: 12345, DriverName: "Driver One", ViewerID: 300, ViewerName: "Viewer Three Hundred", GrantedAt: currentTime},
+	}, viewers)
+
+	grants, err := s.GetPresenceGrantsForViewer(ctx, 40)
+	require.NoError(t, err)
+	assert.Equal(t, []PresenceGrant{
+		{DriverID: 12345, DriverName: "Driver One", ViewerID: 40, ViewerName: "Viewer Forty", GrantedAt: currentTime},
+		{DriverID: 67890, DriverName: "Driver Two", ViewerID: 40, ViewerName: "Viewer Forty", GrantedAt: currentTime},
+	}, grants)
+
+	require.NoError(t, s.DeletePresenceGrant(ctx, 12345, 40))
+
+	viewers, err = s.GetPresenceViewers(ctx, 12345)
+	require.NoError(t, err)
+	assert.Len(t, viewers, 1)
+	assert.Equal(t, int64(300), viewers[0].ViewerID)
+
+	grants, err = s.GetPresenceGrantsForViewer(ctx, 40)
+	require.NoError(t, err)
+	assert.Len(t, grants, 1)
+	assert.Equal(t, int64(67890), grants[0].DriverID)
+}
+
+func TestAuthSessions(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+	s.now = func() time.Time { return time.Unix(1000, 0) }
+
+	older := AuthSession{DriverID: 12345, SessionID: "session-a", UserAgent: "Firefox", IPPrefix: "203.0.113.0/24", CreatedAt: time.Unix(1000, 0), IssuedAt: time.Unix(1000, 0), ExpiresAt: time.Unix(90000, 0)}
+	newer := AuthSession{DriverID: 12345, SessionID: "session-b", UserAgent: "Chrome", IPPrefix: "2001:db8:1::/48", CreatedAt: time.Unix(2000, 0), IssuedAt: time.Unix(3000, 0), ExpiresAt: time.Unix(90000, 0)}
+	expired := AuthSession{DriverID: 12345, SessionID: "session-c", CreatedAt: time.Unix(100, 0), IssuedAt: time.Unix(100, 0), ExpiresAt: time.Unix(500, 0)}
+	for _, session := range []AuthSession{older, newer, expired} {
+		saved, err := s.SaveAuthSession(ctx, session)
+		require.NoError(t, err)
+		assert.True(t, saved)
+	}
+
+	sessions, err := s.GetAuthSessions(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, []AuthSession{newer, older}, sessions)
+
+	got, err := s.GetAuthSession(ctx, 12345, "session-c")
+	require.NoError(t, err)
+	assert.Nil(t, got, "sessions whose latest token has expired should be ignored")
+
+	revoked, err := s.RevokeAuthSession(ctx, 12345, "session-a", time.Unix(4000, 0))
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	// revoking again keeps the first revocation time
+	revoked, err = s.RevokeAuthSession(ctx, 12345, "session-a", time.Unix(5000, 0))
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	got, err = s.GetAuthSession(ctx, 12345, "session-a")
+	require.NoError(t, err)
+	revokedAt := time.Unix(4000, 0)
+	older.RevokedAt = &revokedAt
+	assert.Equal(t, &older, got)
+
+	// a refresh can't bring a revoked session back
+	saved, err := s.SaveAuthSession(ctx, AuthSession{DriverID: 12345, SessionID: "session-a", CreatedAt: time.Unix(1000, 0), IssuedAt: time.Unix(6000, 0), ExpiresAt: time.Unix(95000, 0)})
+	require.NoError(t, err)
+	assert.False(t, saved)
+
+	revoked, err = s.RevokeAuthSession(ctx, 12345, "missing", time.Unix(4000, 0))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+	got, err = s.GetAuthSession(ctx, 12345, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestSquads_MembersAndInvites(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	got, err := s.GetSquad(ctx, "squad-1")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	squad := Squad{SquadID: "squad-1", Name: "Saturday League", ManagerID: 12345, ManagerName: "Driver One", CreatedAt: time.Unix(1000, 0)}
+	require.NoError(t, s.SaveSquad(ctx, squad))
+	got, err = s.GetSquad(ctx, "squad-1")
+	require.NoError(t, err)
+	assert.Equal(t, &squad, got)
+
+	joinedAt := time.Unix(1000, 0)
+	manager := SquadMember{SquadID: "squad-1", SquadName: "Saturday League", DriverID: 12345, DriverName: "Driver One", Status: SquadMemberActive, InvitedAt: time.Unix(1000, 0), JoinedAt: &joinedAt}
+	invitee := SquadMember{SquadID: "squad-1", SquadName: "Saturday League", DriverID: 678, DriverName: "Driver Two", Status: SquadMemberInvited, InvitedAt: time.Unix(2000, 0)}
+	otherSquad := SquadMember{SquadID: "squad-2", SquadName: "Endurance Crew", DriverID: 678, DriverName: "Driver Two", Status: SquadMemberActive, InvitedAt: time.Unix(3000, 0), JoinedAt: &joinedAt}
+	require.NoError(t, s.SaveSquadMember(ctx, manager))
+	require.NoError(t, s.SaveSquadMember(ctx, invitee))
+	require.NoError(t, s.SaveSquadMember(ctx, otherSquad))
+
+	members, err := s.GetSquadMembers(ctx, "squad-1")
+	require.NoError(t, err)
+	assert.Equal(t, []SquadMember{invitee, manager}, members)
+
+	member, err := s.GetSquadMember(ctx, "squad-1", 678)
+	require.NoError(t, err)
+	assert.Equal(t, &invitee, member)
+
+	squads, err := s.GetDriverSquads(ctx, 678)
+	require.NoError(t, err)
+	assert.Equal(t, []SquadMember{invitee, otherSquad}, squads)
+
+	require.NoError(t, s.DeleteSquadMember(ctx, "squad-1", 678))
+
+	members, err = s.GetSquadMembers(ctx, "squad-1")
+	require.NoError(t, err)
+	assert.Equal(t, []SquadMember{manager}, members)
+
+	member, err = s.GetSquadMember(ctx, "squad-1", 678)
+	require.NoError(t, err)
+	assert.Nil(t, member)
+
+	squads, err = s.GetDriverSquads(ctx, 678)
+	require.NoError(t, err)
+	assert.Equal(t, []SquadMember{otherSquad}, squads)
+}
+
+func TestSquadEvents_TaggingAndResults(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	event, err := s.GetSquadEvent(ctx, "squad-1", 100)
+	require.NoError(t, err)
+	assert.Nil(t, event)
+
+	raceNight := SquadEvent{SquadID: "squad-1", SubsessionID: 100, Name: "Race Night", TaggedAt: time.Unix(1000, 0)}
+	finale := SquadEvent{SquadID: "squad-1", SubsessionID: 99999, Name: "Finale", TaggedAt: time.Unix(2000, 0)}
+	otherSquad := SquadEvent{SquadID: "squad-2", SubsessionID: 100, Name: "Open Practice", TaggedAt: time.Unix(3000, 0)}
+	require.NoError(t, s.SaveSquadEvent(ctx, raceNight))
+	require.NoError(t, s.SaveSquadEvent(ctx, finale))
+	require.NoError(t, s.SaveSquadEvent(ctx, otherSquad))
+
+	event, err = s.GetSquadEvent(ctx, "squad-1", 100)
+	require.NoError(t, err)
+	assert.Equal(t, &raceNight, event)
+
+	events, err := s.GetSquadEvents(ctx, "squad-1")
+	require.NoError(t, err)
+	assert.Equal(t, []SquadEvent{finale, raceNight}, events)
+
+	events, err = s.GetSessionSquadEvents(ctx, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []SquadEvent{raceNight, otherSquad}, events)
+
+	winner := SquadEventResult{SquadID: "squad-1", SubsessionID: 100, DriverID: 678, DriverName: "Driver Two", StartTime: time.Unix(1000, 0), CarID: 1, CarClassID: 2, StartPosition: 3, FinishPosition: 1, FinishPositionInClass: 1, Incidents: 2, LapsComplete: 20, OldIRating: 1500, NewIRating: 1550}
+	dnf := SquadEventResult{SquadID: "squad-1", SubsessionID: 100, DriverID: 12345, DriverName: "Driver One", StartTime: time.Unix(1000, 0), CarID: 1, CarClassID: 2, StartPosition: 1, FinishPosition: 9, FinishPositionInClass: 9, Incidents: 8, LapsComplete: 4, OldIRating: 2000, NewIRating: 1950, ReasonOutCode: ReasonOutDisconnected}
+	require.NoError(t, s.SaveSquadEventResult(ctx, dnf))
+	require.NoError(t, s.SaveSquadEventResult(ctx, winner))
+	require.NoError(t, s.SaveSquadEventResult(ctx, SquadEventResult{SquadID: "squad-1", SubsessionID: 99999, DriverID: 678, DriverName: "Driver Two", StartTime: time.Unix(2000, 0)}))
+
+	results, err := s.GetSquadEventResults(ctx, "squad-1", 100)
+	require.NoError(t, err)
+	assert.Equal(t, []SquadEventResult{winner, dnf}, results)
+}
+
+func TestTagDriverSessionSquadEvents(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveDriverSessions(ctx, []DriverSession{
+		{DriverID: 12345, SubsessionID: 1, StartTime: time.Unix(1000, 0)},
+	}))
+
+	require.NoError(t, s.TagDriverSessionSquadEvents(ctx, 12345, time.Unix(1000, 0), []string{"squad-1"}))
+	require.NoError(t, s.TagDriverSessionSquadEvents(ctx, 12345, time.Unix(1000, 0), []string{"squad-1", "squad-2"}))
+
+	session, err := s.GetDriverSession(ctx, 12345, time.Unix(1000, 0))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"squad-1", "squad-2"}, session.SquadEvents)
+
+	err = s.TagDriverSessionSquadEvents(ctx, 12345, time.Unix(2000, 0), []string{"squad-1"})
+	assert.Error(t, err)
+}
+
+func TestSupporter_SaveIgnoresOlderEvents(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	supporter, err := s.GetSupporter(ctx, 42)
+	require.NoError(t, err)
+	assert.Nil(t, supporter)
+
+	active := Supporter{
+		DriverID:         42,
+		CustomerID:       "cus_123",
+		SubscriptionID:   "sub_123",
+		Status:           "active",
+		CurrentPeriodEnd: time.Unix(90000, 0),
+		UpdatedAt:        time.Unix(5000, 0),
+	}
+	saved, err := s.SaveSupporter(ctx, active)
+	require.NoError(t, err)
+	assert.True(t, saved)
+
+	stale := active
+	stale.Status = "incomplete"
+	stale.UpdatedAt = time.Unix(4000, 0)
+	saved, err = s.SaveSupporter(ctx, stale)
+	require.NoError(t, err)
+	assert.False(t, saved)
+
+	supporter, err = s.GetSupporter(ctx, 42)
+	require.NoError(t, err)
+	assert.Equal(t, &active, supporter)
+
+	canceled := active
+	canceled.Status = "canceled"
+	canceled.UpdatedAt = time.Unix(6000, 0)
+	saved, err = s.SaveSupporter(ctx, canceled)
+	require.NoError(t, err)
+	assert.True(t, saved)
+
+	supporter, err = s.GetSupporter(ctx, 42)
+	require.NoError(t, err)
+	assert.Equal(t, &canceled, supporter)
+}
+
+func TestEntitlements_GrantAndRevoke(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	require.Error(t, s.GrantEntitlement(ctx, 42, "supporter"))
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{
+		DriverID:     42,
+		DriverName:   "Test Driver",
+		MemberSince:  time.Unix(1000, 0),
+		FirstLogin:   time.Unix(2000, 0),
+		LastLogin:    time.Unix(2000, 0),
+		LoginCount:   1,
+		Entitlements: []string{"developer"},
+	}))
+
+	require.NoError(t, s.GrantEntitlement(ctx, 42, "supporter"))
+	require.NoError(t, s.GrantEntitlement(ctx, 42, "supporter"))
+	driver, err := s.GetDriver(ctx, 42)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"developer", "supporter"}, driver.Entitlements)
+
+	require.NoError(t, s.RevokeEntitlement(ctx, 42, "developer"))
+	require.NoError(t, s.RevokeEntitlement(ctx, 42, "developer"))
+	driver, err = s.GetDriver(ctx, 42)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"supporter"}, driver.Entitlements)
+
+	require.NoError(t, s.RevokeEntitlement(ctx, 42, "supporter"))
+	driver, err = s.GetDriver(ctx, 42)
+	require.NoError(t, err)
+	assert.Empty(t, driver.Entitlements)
+}
+
+func TestQuota_ConsumedUpToLimit(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+	day := time.Unix(86400*20000, 0)
+
+	usage, err := s.GetQuotaUsage(ctx, 42, day)
+	require.NoError(t, err)
+	assert.Equal(t, &QuotaUsage{DriverID: 42, Day: day, Counts: map[string]int{}}, usage)
+
+	for _, use := range []struct {
+		operation string
+		expected  bool
+	}{
+		{operation: "iracing_proxy", expected: true},
+		{operation: "iracing_proxy", expected: true},
+		{operation: "iracing_proxy", expected: false},
+		{operation: "export", expected: true},
+	} {
+		consumed, err := s.ConsumeQuota(ctx, 42, day, use.operation, 2)
+		require.NoError(t, err)
+		assert.Equal(t, use.expected, consumed)
+	}
+
+	usage, err = s.GetQuotaUsage(ctx, 42, day)
+	require.NoError(t, err)
+	assert.Equal(t, &QuotaUsage{DriverID: 42, Day: day, Counts: map[string]int{"iracing_proxy": 2, "export": 1}}, usage)
+
+	// each day starts fresh
+	consumed, err := s.ConsumeQuota(ctx, 42, day.Add(24*time.Hour), "iracing_proxy", 2)
+	require.NoError(t, err)
+	assert.True(t, consumed)
+}
+
+func TestSeasonCards(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	card, err := s.GetSeasonCard(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, card)
+
+	first := SeasonCard{DriverID: 12345, Token: "first", Snapshot: `{"races":1}`, CreatedAt: time.Unix(1000, 0), RefreshedAt: time.Unix(1000, 0)}
+	require.NoError(t, s.SaveSeasonCard(ctx, first, ""))
+
+	refreshed, err := s.RefreshSeasonCard(ctx, "first", `{"races":2}`, time.Unix(2000, 0))
+	require.NoError(t, err)
+	assert.True(t, refreshed)
+	first.Snapshot = `{"races":2}`
+	first.RefreshedAt = time.Unix(2000, 0)
+
+	card, err = s.GetSeasonCard(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &first, card)
+
+	// a new token replaces the old one
+	second := SeasonCard{DriverID: 12345, Token: "second", Snapshot: `{"races":2}`, CreatedAt: time.Unix(3000, 0), RefreshedAt: time.Unix(3000, 0)}
+	require.NoError(t, s.SaveSeasonCard(ctx, second, "first"))
+
+	card, err = s.GetSeasonCardByToken(ctx, "first")
+	require.NoError(t, err)
+	assert.Nil(t, card)
+	card, err = s.GetSeasonCard(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &second, card)
+
+	// refreshing a replaced card doesn't bring it back
+	refreshed, err = s.RefreshSeasonCard(ctx, "first", `{"races":3}`, time.Unix(4000, 0))
+	require.NoError(t, err)
+	assert.False(t, refreshed)
+	card, err = s.GetSeasonCardByToken(ctx, "first")
+	require.NoError(t, err)
+	assert.Nil(t, card)
+
+	require.NoError(t, s.DeleteSeasonCard(ctx, 12345, "second"))
+	card, err = s.GetSeasonCard(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, card)
+	card, err = s.GetSeasonCardByToken(ctx, "second")
+	require.NoError(t, err)
+	assert.Nil(t, card)
+}
+
+func TestOverlays(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	overlay, err := s.GetOverlay(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, overlay)
+
+	first := Overlay{DriverID: 12345, Token: "first", Snapshot: `{"races":1}`, CreatedAt: time.Unix(1000, 0), RefreshedAt: time.Unix(1000, 0)}
+	require.NoError(t, s.SaveOverlay(ctx, first, ""))
+
+	refreshed, err := s.RefreshOverlay(ctx, "first", `{"races":2}`, time.Unix(2000, 0))
+	require.NoError(t, err)
+	assert.True(t, refreshed)
+	first.Snapshot = `{"races":2}`
+	first.RefreshedAt = time.Unix(2000, 0)
+
+	overlay, err = s.GetOverlay(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &first, overlay)
+
+	// a new token replaces the old one
+	second := Overlay{DriverID: 12345, Token: "second", Snapshot: `{"races":2}`, CreatedAt: time.Unix(3000, 0), RefreshedAt: time.Unix(3000, 0)}
+	require.NoError(t, s.SaveOverlay(ctx, second, "first"))
+
+	overlay, err = s.GetOverlayByToken(ctx, "first")
+	require.NoError(t, err)
+	assert.Nil(t, overlay)
+	overlay, err = s.GetOverlay(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &second, overlay)
+
+	// refreshing a replaced overlay doesn't bring it back
+	refreshed, err = s.RefreshOverlay(ctx, "first", `{"races":3}`, time.Unix(4000, 0))
+	require.NoError(t, err)
+	assert.False(t, refreshed)
+	overlay, err = s.GetOverlayByToken(ctx, "first")
+	require.NoError(t, err)
+	assert.Nil(t, overlay)
+
+	require.NoError(t, s.DeleteOverlay(ctx, 12345, "second"))
+	overlay, err = s.GetOverlay(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, overlay)
+	overlay, err = s.GetOverlayByToken(ctx, "second")
+	require.NoError(t, err)
+	assert.Nil(t, overlay)
+}
+
+func TestConsumeSeasonCardFetch_CountedUpToLimit(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+	window := time.Unix(1700000040, 0)
+
+	for _, expected := range []bool{true, true, false} {
+		consumed, err := s.ConsumeSeasonCardFetch(ctx, "tok", window, 2)
+		require.NoError(t, err)
+		assert.Equal(t, expected, consumed)
+	}
+
+	// each window starts fresh
+	consumed, err := s.ConsumeSeasonCardFetch(ctx, "tok", window.Add(time.Minute), 2)
+	require.NoError(t, err)
+	assert.True(t, consumed)
+}
+
+func setupTestStore(t *testing.T) *DynamoStore {
+	t.Helper()
+	t.Parallel()
+
+	tableName := fmt.Sprintf("test-%s-%d", t.Name(), time.Now().UnixNano())
+
+	cfg, err := config.LoadDefaultConfig(context.Background(),
+		config.WithRegion("us-east-1"),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("dummy", "dummy", "dummy")),
+	)
+	require.NoError(t, err)
+
+	client := dynamodb.NewFromConfig(cfg, func(o *dynamodb.Options) {
+		o.BaseEndpoint = aws.String(localDynamoEndpoint)
+	})
+	createTestTable(t, client, tableName)
+
+	return NewDynamoStore(client, tableName)
+}
+
+// createTestTable creates a table shaped like the one terraform creates, deleting it once the test is done.
+func createTestTable(t *testing.T, client *dynamodb.Client, tableName string) {
+	t.Helper()
+
+	_, err := client.CreateTable(context.Background(), &dynamodb.CreateTableInput{
+		TableName: aws.String(tableName),
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("partition_key"), KeyType: types.KeyTypeHash},
+			{AttributeName: aws.String("sort_key"), KeyType: types.KeyTypeRange},
+		},
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("partition_key"), AttributeType: types.ScalarAttributeTypeS},
+			{AttributeName: aws.String("sort_key"), AttributeType: types.ScalarAttributeTypeS},
+			{AttributeName: aws.String(raceOrderAttributeName), AttributeType: types.ScalarAttributeTypeS},
+		},
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{
+			{
+				IndexName: aws.String(raceOrderIndexName),
+				KeySchema: []types.KeySchemaElement{
+					{AttributeName: aws.String("partition_key"), KeyType: types.KeyTypeHash},
+					{AttributeName: aws.String(raceOrderAttributeName), KeyType: types.KeyTypeRange},
+				},
+				Projection: &types.Projection{ProjectionType: types.ProjectionTypeAll},
+			},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteTable(context.Background(), &dynamodb.DeleteTableInput{
+			TableName: aws.String(tableName),
+		})
+	})
+}
+
+func TestAddToDriverRollup_AccumulatesAndSkipsCountedRaces(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	added, err := s.AddToDriverRollup(ctx, 12345, RollupScopeSeason(4500), 1, SessionTotals{Races: 1, Wins: 1, Podiums: 1, Top5s: 1, LapsComplete: 20, LapsLead: 12})
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = s.AddToDriverRollup(ctx, 12345, RollupScopeSeason(4500), 2, SessionTotals{Races: 1, Incidents: 8, IRatingChange: -45, SubLevelChange: -32, LapsComplete: 18})
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = s.AddToDriverRollup(ctx, 12345, RollupScopeSeason(4500), 2, SessionTotals{Races: 1, Incidents: 8, IRatingChange: -45, SubLevelChange: -32, LapsComplete: 18})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	got, err := s.GetDriverRollup(ctx, 12345, RollupScopeSeason(4500))
+	require.NoError(t, err)
+	assert.Equal(t, &DriverRollup{
+		DriverID: 12345,
+		Scope:    RollupScopeSeason(4500),
+		SessionTotals: SessionTotals{
+			Races:          2,
+			Wins:           1,
+			Podiums:        1,
+			Top5s:          1,
+			Incidents:      8,
+			IRatingChange:  -45,
+			SubLevelChange: -32,
+			LapsComplete:   38,
+			LapsLead:       12,
+		},
+	}, got)
+
+	got, err = s.GetDriverRollup(ctx, 12345, RollupScopeAllTime)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestGetWeeklyLeaderboard(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	weekStart := time.Unix(1715040000, 0)
+	for _, add := range []struct {
+		weekStart    time.Time
+		driverID     int64
+		subsessionID int64
+	}{
+		{weekStart, 1, 100},
+		{weekStart, 1, 101},
+		{weekStart, 2, 100},
+		{weekStart.AddDate(0, 0, 7), 1, 102},
+	} {
+		added, err := s.AddToWeeklyLeaderboard(ctx, add.weekStart, add.driverID, add.subsessionID, SessionTotals{Races: 1})
+		require.NoError(t, err)
+		assert.True(t, added)
+	}
+
+	got, err := s.GetWeeklyLeaderboard(ctx, weekStart)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []WeeklyLeaderboardEntry{
+		{WeekStart: weekStart, DriverID: 1, SessionTotals: SessionTotals{Races: 2}},
+		{WeekStart: weekStart, DriverID: 2, SessionTotals: SessionTotals{Races: 1}},
+	}, got)
+}
+
+func TestSaveRankedLeaderboard_ReplacesEarlierComputation(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	weekStart := time.Unix(1715040000, 0)
+	board := RankedLeaderboard{Board: LeaderboardSafetyRatingGain, WeekStart: weekStart, ComputedAt: time.Unix(1715100000, 0)}
+	require.NoError(t, s.SaveRankedLeaderboard(ctx, board, []LeaderboardRanking{
+		{Rank: 1, DriverID: 1, DriverName: "Driver One", Races: 3, Value: 87},
+		{Rank: 2, DriverID: 2, DriverName: "Driver Two", Races: 5, Value: 41},
+		{Rank: 3, DriverID: 3, DriverName: "Driver Three", Races: 1, Value: 12},
+	}))
+	board.ComputedAt = time.Unix(1715200000, 0)
+	require.NoError(t, s.SaveRankedLeaderboard(ctx, board, []LeaderboardRanking{
+		{Rank: 1, DriverID: 2, DriverName: "Driver Two", Races: 7, Value: 95},
+		{Rank: 2, DriverID: 1, DriverName: "Driver One", Races: 3, Value: 87},
+	}))
+
+	got, rankings, err := s.GetRankedLeaderboard(ctx, LeaderboardSafetyRatingGain, weekStart, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, &RankedLeaderboard{
+		Board:        LeaderboardSafetyRatingGain,
+		WeekStart:    weekStart,
+		ComputedAt:   time.Unix(1715200000, 0),
+		TotalEntries: 2,
+	}, got)
+	assert.Equal(t, []LeaderboardRanking{
+		{Rank: 1, DriverID: 2, DriverName: "Driver Two", Races: 7, Value: 95},
+		{Rank: 2, DriverID: 1, DriverName: "Driver One", Races: 3, Value: 87},
+	}, rankings)
+
+	_, rankings, err = s.GetRankedLeaderboard(ctx, LeaderboardSafetyRatingGain, weekStart, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []LeaderboardRanking{{Rank: 2, DriverID: 1, DriverName: "Driver One", Races: 3, Value: 87}}, rankings)
+
+	got, _, err = s.GetRankedLeaderboard(ctx, LeaderboardCleanRaceStreak, weekStart, 0, 10)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestGetRankedLeaderboardByClub(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	weekStart := time.Unix(1715040000, 0)
+	board := RankedLeaderboard{Board: LeaderboardIRatingGain, WeekStart: weekStart, ComputedAt: time.Unix(1715100000, 0)}
+	require.NoError(t, s.SaveRankedLeaderboard(ctx, board, []LeaderboardRanking{
+		{Rank: 1, DriverID: 1, DriverName: "Driver One", ClubID: 7, Races: 3, Value: 87},
+		{Rank: 2, DriverID: 2, DriverName: "Driver Two", ClubID: 3, Races: 5, Value: 41},
+		{Rank: 3, DriverID: 3, DriverName: "Driver Three", ClubID: 7, Races: 1, Value: 12},
+	}))
+
+	got, rankings, err := s.GetRankedLeaderboardByClub(ctx, LeaderboardIRatingGain, weekStart, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 3, got.TotalEntries)
+	assert.Equal(t, []LeaderboardRanking{
+		{Rank: 1, DriverID: 1, DriverName: "Driver One", ClubID: 7, Races: 3, Value: 87},
+		{Rank: 3, DriverID: 3, DriverName: "Driver Three", ClubID: 7, Races: 1, Value: 12},
+	}, rankings)
+
+	got, _, err = s.GetRankedLeaderboardByClub(ctx, LeaderboardCleanRaceStreak, weekStart, 7)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestGetRegionWeeklyAggregates(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	week := time.Unix(1715040000, 0)
+	nextWeek := week.AddDate(0, 0, 7)
+	first := RegionWeeklyAggregate{ClubID: 7, WeekStart: week, Drivers: 5, SessionTotals: SessionTotals{Races: 12, Wins: 2, SubLevelChange: 40}}
+	second := RegionWeeklyAggregate{ClubID: 7, WeekStart: nextWeek, Drivers: 6, SessionTotals: SessionTotals{Races: 9, Incidents: 30}}
+	require.NoError(t, s.SaveRegionWeeklyAggregate(ctx, first))
+	require.NoError(t, s.SaveRegionWeeklyAggregate(ctx, second))
+	require.NoError(t, s.SaveRegionWeeklyAggregate(ctx, RegionWeeklyAggregate{ClubID: 3, WeekStart: week, Drivers: 8}))
+
+	got, err := s.GetRegionWeeklyAggregates(ctx, 7, week, nextWeek)
+	require.NoError(t, err)
+	assert.Equal(t, []RegionWeeklyAggregate{first, second}, got)
+
+	got, err = s.GetRegionWeeklyAggregates(ctx, 7, week, week)
+	require.NoError(t, err)
+	assert.Equal(t, []RegionWeeklyAggregate{first}, got)
+}
+
+func TestRecordMilestone_KeepsEarliest(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	later := DriverMilestone{DriverID: 12345, Milestone: "first_podium", AchievedAt: time.Unix(2000, 0), SubsessionID: 2}
+	earlier := DriverMilestone{DriverID: 12345, Milestone: "first_podium", AchievedAt: time.Unix(1000, 0), SubsessionID: 1}
+
+	recorded, err := s.RecordMilestone(ctx, later)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	recorded, err = s.RecordMilestone(ctx, earlier)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	recorded, err = s.RecordMilestone(ctx, later)
+	require.NoError(t, err)
+	assert.False(t, recorded)
+
+	got, err := s.GetDriverMilestones(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverMilestone{earlier}, got)
+}
+
+func TestConsistentRead_SeesJustSavedRecords(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	driver := Driver{DriverID: 12345, DriverName: "Jon Sabados", MemberSince: time.Unix(500, 0), FirstLogin: time.Unix(1000, 0), LastLogin: time.Unix(1000, 0), LoginCount: 1}
+	require.NoError(t, s.InsertDriver(ctx, driver))
+	session := DriverSession{DriverID: 12345, SubsessionID: 777, StartTime: time.Unix(2000, 0)}
+	require.NoError(t, s.PersistSessionData(ctx, session, nil))
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 12345, RaceID: 2000, Notes: "just saved"}))
+
+	gotDriver, err := s.GetDriver(ctx, 12345, ConsistentRead())
+	require.NoError(t, err)
+	require.NotNil(t, gotDriver)
+	assert.Equal(t, int64(1), gotDriver.SessionCount)
+
+	gotSession, err := s.GetDriverSession(ctx, 12345, time.Unix(2000, 0), ConsistentRead())
+	require.NoError(t, err)
+	assert.NotNil(t, gotSession)
+
+	gotEntry, err := s.GetJournalEntry(ctx, 12345, 2000, ConsistentRead())
+	require.NoError(t, err)
+	require.NotNil(t, gotEntry)
+	assert.Equal(t, "just saved", gotEntry.Notes)
+}
+
+func TestUpstreamStatus(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	currentTime := time.UnixMilli(1_000_000)
+	s.now = func() time.Time { return currentTime }
+
+	status, err := s.GetUpstreamStatus(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, status)
+
+	require.NoError(t, s.SaveUpstreamStatus(ctx, UpstreamStatus{
+		DownUntil:  currentTime.Add(15 * time.Minute),
+		Reason:     "Site Maintenance",
+		ReportedAt: currentTime,
+	}))
+	status, err = s.GetUpstreamStatus(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, status)
+	assert.Equal(t, currentTime.Add(15*time.Minute).UnixMilli(), status.DownUntil.UnixMilli())
+	assert.Equal(t, "Site Maintenance", status.Reason)
+	assert.Equal(t, currentTime.UnixMilli(), status.ReportedAt.UnixMilli())
+
+	// reports the TTL sweep hasn't got to yet are ignored
+	currentTime = currentTime.Add(15 * time.Minute)
+	status, err = s.GetUpstreamStatus(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, status)
+}
+
+func TestSessionArchives(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+	archivedAt := time.UnixMilli(1_700_000_000_000)
+
+	archives, err := s.GetSessionArchives(ctx, 12345)
+	require.NoError(t, err)
+	assert.Empty(t, archives)
+
+	results := SessionArchive{SubsessionID: 12345, Kind: SessionArchiveResults, Key: "sessions/12345/results.json", ArchivedAt: archivedAt}
+	laps := SessionArchive{SubsessionID: 12345, Kind: SessionArchiveLaps, DriverID: 1001, Key: "sessions/12345/laps/1001.json", ArchivedAt: archivedAt}
+	require.NoError(t, s.SaveSessionArchive(ctx, results))
+	require.NoError(t, s.SaveSessionArchive(ctx, laps))
+	require.NoError(t, s.SaveSessionArchive(ctx, results))
+
+	archives, err = s.GetSessionArchives(ctx, 12345)
+	require.NoError(t, err)
+	require.Len(t, archives, 2)
+	for _, archive := range archives {
+		assert.Equal(t, archivedAt.UnixMilli(), archive.ArchivedAt.UnixMilli())
+	}
+	assert.ElementsMatch(t, []SessionArchiveKind{SessionArchiveResults, SessionArchiveLaps}, []SessionArchiveKind{archives[0].Kind, archives[1].Kind})
+}
+
+func TestBackfillProgress(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	progress, err := s.GetBackfillProgress(ctx, "championship")
+	require.NoError(t, err)
+	assert.Nil(t, progress)
+
+	saved := BackfillProgress{
+		Name:      "championship",
+		After:     "sessions/12345/results.json",
+		Archives:  25,
+		Sessions:  3,
+		Failures:  1,
+		Complete:  true,
+		UpdatedAt: time.UnixMilli(1_700_000_000_000),
+	}
+	require.NoError(t, s.SaveBackfillProgress(ctx, saved))
+
+	progress, err = s.GetBackfillProgress(ctx, "championship")
+	require.NoError(t, err)
+	require.NotNil(t, progress)
+	assert.True(t, saved.UpdatedAt.Equal(progress.UpdatedAt))
+	progress.UpdatedAt = saved.UpdatedAt
+	assert.Equal(t, saved, *progress)
+}
+
+func TestTenantTables(t *testing.T) {
+	s := setupTestStore(t)
+	createTestTable(t, s.client, tenant.TableName(s.table, "league-one"))
+	ctx := context.Background()
+	tenantCtx := tenant.WithID(ctx, "league-one")
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 1001, DriverName: "Default Driver"}))
+	require.NoError(t, s.InsertDriver(tenantCtx, Driver{DriverID: 1001, DriverName: "League Driver"}))
+	require.NoError(t, s.InsertDriver(tenantCtx, Driver{DriverID: 1002, DriverName: "League Only"}))
+
+	driver, err := s.GetDriver(ctx, 1001)
+	require.NoError(t, err)
+	require.NotNil(t, driver)
+	assert.Equal(t, "Default Driver", driver.DriverName)
+
+	driver, err = s.GetDriver(tenantCtx, 1001)
+	require.NoError(t, err)
+	require.NotNil(t, driver)
+	assert.Equal(t, "League Driver", driver.DriverName)
+
+	driver, err = s.GetDriver(ctx, 1002)
+	require.NoError(t, err)
+	assert.Nil(t, driver)
+
+	// connections are kept for the whole deployment, remembering who they were authenticated for
+	require.NoError(t, s.SaveConnection(tenantCtx, WebSocketConnection{DriverID: 1002, ConnectionID: "conn-1", TenantID: "league-one"}))
+	conn, err := s.GetConnection(ctx, 1002, "conn-1")
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+	assert.Equal(t, "league-one", conn.TenantID)
 }
@@ -2,15 +2,37 @@ package store
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store/keys"
 )
 
 var ErrEntityAlreadyExists = errors.New("entity already exists")
 
+// WriteMode decides what writes of driver sessions do about sessions that have already been saved.
+type WriteMode int
+
+const (
+	// WriteModeStrict fails the write with ErrEntityAlreadyExists, writing nothing
+	WriteModeStrict WriteMode = iota
+	// WriteModeSkipExisting leaves saved sessions, and their laps, as they are and writes the rest
+	WriteModeSkipExisting
+	// WriteModeUpsert overwrites saved sessions and their laps, without counting them towards the driver's sessions again
+	WriteModeUpsert
+)
+
+// WriteResult reports the sessions a WriteModeSkipExisting or WriteModeUpsert write found already saved.
+type WriteResult struct {
+	Existing []DriverSession
+}
+
 type Driver struct {
 	DriverID              int64
 	DriverName            string
 	MemberSince           time.Time
+	RacesIngestedFrom     *time.Time // nil for drivers ingested forwards from MemberSince, whose history is complete
 	RacesIngestedTo       *time.Time
 	IngestionBlockedUntil *time.Time
 	FirstLogin            time.Time
@@ -18,8 +40,37 @@ type Driver struct {
 	LoginCount            int64
 	SessionCount          int64
 	Entitlements          []string
+	OnboardingStep        OnboardingStep // empty for drivers created before onboarding was tracked
+	ClubID                int            // iRacing club (region), zero until the driver next logs in for older drivers
+	ClubName              string
+	SearchIndexed         bool // false for drivers created before driver search, until they next log in
+}
+
+// DriverSearchEntry is a driver found by searching on the start of their name.
+type DriverSearchEntry struct {
+	DriverID   int64
+	DriverName string
+}
+
+// MinDriverSearchLength is the fewest characters of a search name drivers can be searched by.
+const MinDriverSearchLength = keys.DriverSearchBucketLength
+
+// DriverSearchName is the form driver names are indexed and searched in: lower cased, with runs of whitespace made a
+// single space and key separators dropped.
+func DriverSearchName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(name, keys.Separator, " "))), " ")
 }
 
+// OnboardingStep is the furthest a driver has made it through onboarding.
+type OnboardingStep string
+
+const (
+	OnboardingStepProfileCreated      OnboardingStep = "profile_created"
+	OnboardingStepIngestionStarted    OnboardingStep = "ingestion_started"
+	OnboardingStepRaceIngested        OnboardingStep = "race_ingested"
+	OnboardingStepJournalEntryCreated OnboardingStep = "journal_entry_created"
+)
+
 // DriverSession represents drivers records of sessions (for use in list views of races)
 type DriverSession struct {
 	DriverID              int64
@@ -43,6 +94,191 @@ type DriverSession struct {
 	OldSubLevel           int
 	NewSubLevel           int
 	ReasonOut             string
+	ReasonOutCode         ReasonOutCode // empty for sessions ingested before it was recorded, see Outcome
+	StrengthOfField       int           // zero for sessions ingested before SOF was recorded
+	TrafficCost           *int          // estimated time lost to faster-class traffic in 10ths of ms, nil unless the race was multiclass
+	CornersPerLap         int           // zero for sessions ingested before corners were recorded
+	LicenseCategoryID     int           // iRacing license category (oval, road, ...), zero for sessions ingested before it was recorded
+	LapsComplete          int
+	LapsLead              int
+	// Championship data, zero values for sessions ingested before it was recorded
+	CarClassID    int64
+	SeasonID      int64
+	SeasonYear    int
+	SeasonQuarter int
+	RaceWeekNum   int
+	ChampPoints   int
+	DropRace      bool
+	// LapsSkipped is set when lap data was left out because the driver had summary only ingestion turned on, so it can
+	// be backfilled if they turn it back off
+	LapsSkipped bool
+	// LapGaps is the number of laps missing from the lap data iRacing returned for the driver, zero when the sequence was
+	// complete or lap data wasn't pulled
+	LapGaps int
+	// Positions is the driver's overall running position (0-based) at the end of each lap, indexed by lap number with
+	// lap 0 being the starting grid. Nil when lap chart data wasn't available.
+	Positions []int
+	// SquadEvents holds the IDs of the driver's squads that tagged the session as a squad event
+	SquadEvents []string
+}
+
+// Outcome returns how the race ended for the driver, normalizing ReasonOut for sessions ingested before ReasonOutCode
+// was recorded.
+func (d DriverSession) Outcome() ReasonOutCode {
+	if d.ReasonOutCode != "" {
+		return d.ReasonOutCode
+	}
+	return NormalizeReasonOut(d.ReasonOut)
+}
+
+// SessionDriverLap represents a single lap driven by a driver in a session. Lap data is keyed by session rather than
+// driver, so laps for any participant can be stored (not just drivers using the site).
+type SessionDriverLap struct {
+	SubsessionID    int64
+	DriverID        int64
+	LapNumber       int
+	Flags           int
+	Incident        bool
+	SessionTime     int // iRacing 10ths of milliseconds since session start
+	LapTime         int // iRacing 10ths of milliseconds, -1 when the lap has no valid time
+	PersonalBestLap bool
+	LapEvents       []string
+	// Synthetic is set on placeholder laps filling a gap in the lap data iRacing returned. They have no valid LapTime
+	// and a SessionTime interpolated between the laps either side of the gap.
+	Synthetic bool
+}
+
+// RaceOrderCursor identifies a lap's place in its session's race order, for resuming GetSessionLapsInRaceOrder after it.
+// Laps are ordered by SessionTime, so one lap's place never moves as other drivers' laps are stored.
+func RaceOrderCursor(lap SessionDriverLap) string {
+	return keys.RaceOrder(lap.SessionTime, lap.DriverID, lap.LapNumber)
+}
+
+// ExternalLap is a lap driven outside of any race we ingest, typically in practice, imported from a third party lap
+// time service. It carries what's needed to measure it like a SessionDriverLap, with Source and SessionID standing in
+// for the subsession.
+type ExternalLap struct {
+	DriverID int64
+	// Source is the service the lap was imported from, such as "garage61"
+	Source string
+	// SessionID is the source's identifier for the session the lap was driven in
+	SessionID string
+	// SessionStart is when the first lap of the session was driven, external laps are ordered and windowed by it
+	SessionStart time.Time
+	TrackID      int64
+	CarID        int64
+	LapNumber    int
+	Incident     bool
+	LapTime      int // iRacing 10ths of milliseconds, -1 when the lap has no valid time
+}
+
+// SessionDriverLapSummary is what's kept of a driver's laps in a session once lap retention has compacted away the
+// individual lap records.
+type SessionDriverLapSummary struct {
+	SubsessionID     int64
+	DriverID         int64
+	LapCount         int
+	ValidLapCount    int // laps with a lap time
+	IncidentLapCount int
+	BestLapTime      int // iRacing 10ths of milliseconds, -1 when no lap had a valid time
+	AvgLapTime       int // iRacing 10ths of milliseconds across valid laps, -1 when no lap had a valid time
+	CompactedAt      time.Time
+}
+
+// SessionArchiveKind is which part of a session an archive holds.
+type SessionArchiveKind string
+
+const (
+	SessionArchiveResults SessionArchiveKind = "results"
+	SessionArchiveLaps    SessionArchiveKind = "laps"
+)
+
+// SessionArchive points at the raw iRacing responses archived for part of a session, so fields added to the models
+// later can be filled in for sessions already ingested without fetching them again.
+type SessionArchive struct {
+	SubsessionID int64
+	Kind         SessionArchiveKind
+	// DriverID is who lap data was archived for, zero for results, which are the same whoever fetched them
+	DriverID   int64
+	Key        string // object key in the session archive bucket
+	ArchivedAt time.Time
+}
+
+// DriverSettings holds a driver's preferences. Drivers that have never saved settings get the zero value.
+type DriverSettings struct {
+	DriverID int64
+	// LapRetentionMonths is how long individual laps are kept before being compacted into a per-race summary. Zero
+	// keeps laps forever.
+	LapRetentionMonths int
+	// SummaryOnlyIngestion skips pulling lap data during ingestion, only race results are kept.
+	SummaryOnlyIngestion bool
+	// LapBackfillPending is set when summary only ingestion is turned off, so the next ingestion run fetches the laps
+	// that were skipped.
+	LapBackfillPending bool
+	// LeaderboardOptIn lists the driver, by name, on the platform wide weekly leaderboards. Drivers are left off
+	// unless they opt in.
+	LeaderboardOptIn bool
+	// BenchmarkOptIn adds the driver's races, anonymously, to the platform benchmark tables, and in return lets them
+	// compare themselves against the tables.
+	BenchmarkOptIn bool
+	// Timezone is the IANA name of the driver's timezone, used when looking at races by local time. Empty means UTC.
+	Timezone string
+	// Locale is the language text generated for the driver, such as alerts and recaps, is written in. Empty means the
+	// default locale.
+	Locale string
+}
+
+// IngestionTier tunes how a driver's races are ingested. Tiers are named after entitlements, drivers get the highest
+// Priority tier matching one of their entitlements, falling back on the tier named "default" when it exists. Zero values
+// leave the processor's own settings in place.
+type IngestionTier struct {
+	Name     string
+	Priority int
+	// SearchWindowDays is how much time each ingestion round searches for races.
+	SearchWindowDays           int
+	RaceConsumptionConcurrency int
+	LapConsumptionConcurrency  int
+	// LapsDisabled skips pulling lap data the same as a driver turning on summary only ingestion.
+	LapsDisabled bool
+	UpdatedAt    time.Time
+}
+
+// WaitlistEntry is a driver who signed in without an invitation while the waitlist is on, kept out until they are
+// invited.
+type WaitlistEntry struct {
+	DriverID    int64
+	DriverName  string
+	RequestedAt time.Time
+}
+
+// Invitation lets a driver in while the waitlist is on, their driver record starting out with Entitlements.
+type Invitation struct {
+	DriverID     int64
+	Entitlements []string
+	InvitedAt    time.Time
+	// InvitedBy is the driver who sent the invitation
+	InvitedBy int64
+}
+
+// TermsAcceptance records a driver accepting a version of a legal document such as the terms of service or privacy
+// policy.
+type TermsAcceptance struct {
+	DriverID   int64
+	Document   string
+	Version    string
+	AcceptedAt time.Time
+}
+
+// Supporter is a driver's paid supporter subscription as last reported by the payment provider.
+type Supporter struct {
+	DriverID       int64
+	CustomerID     string
+	SubscriptionID string
+	// Status is the provider's subscription status, e.g. active, past_due or canceled.
+	Status           string
+	CurrentPeriodEnd time.Time
+	// UpdatedAt is when the provider generated the event the record came from, used to drop out of order events.
+	UpdatedAt time.Time
 }
 
 // RaceJournalEntry represents a user's journal entry for a specific race.
@@ -57,15 +293,758 @@ type RaceJournalEntry struct {
 	Notes       string
 	Tags        []string // Unified tags: plain ("podium") or key:value ("sentiment:good")
 	ReplayVideo string   // Optional link to a replay video
+
+	// Transcripts of voice memos attached to the entry, in the order they were transcribed. Saving the entry leaves
+	// them untouched, they are only added via AppendJournalTranscript.
+	Transcripts []string
+
+	// Search index terms. SearchTerms covers the user-provided content and is replaced on every save, TranscriptTerms
+	// accumulates the terms of each appended transcript.
+	SearchTerms     []string
+	TranscriptTerms []string
+}
+
+// ReasonOutCode is a normalized version of the free form reason out iRacing reports for a driver's result.
+type ReasonOutCode string
+
+const (
+	ReasonOutFinished     ReasonOutCode = "finished"
+	ReasonOutDNF          ReasonOutCode = "dnf" // retired for any reason other than a DQ or disconnect
+	ReasonOutDisqualified ReasonOutCode = "disqualified"
+	ReasonOutDisconnected ReasonOutCode = "disconnected"
+	ReasonOutUnknown      ReasonOutCode = "unknown" // no reason out was reported
+)
+
+// NormalizeReasonOut maps an iRacing reason out to a ReasonOutCode. iRacing reports "Running" for drivers that took
+// the checkered flag, anything that isn't a DQ or disconnect is lumped in as a DNF.
+func NormalizeReasonOut(reasonOut string) ReasonOutCode {
+	normalized := strings.ToLower(strings.TrimSpace(reasonOut))
+	switch {
+	case normalized == "":
+		return ReasonOutUnknown
+	case normalized == "running":
+		return ReasonOutFinished
+	case strings.HasPrefix(normalized, "disqualified"):
+		return ReasonOutDisqualified
+	case strings.HasPrefix(normalized, "disconnected"):
+		return ReasonOutDisconnected
+	default:
+		return ReasonOutDNF
+	}
+}
+
+// JournalAttachmentStatus tracks an attachment from upload through transcription.
+type JournalAttachmentStatus string
+
+const (
+	JournalAttachmentPendingUpload       JournalAttachmentStatus = "pending_upload"
+	JournalAttachmentUploaded            JournalAttachmentStatus = "uploaded"
+	JournalAttachmentTranscribing        JournalAttachmentStatus = "transcribing"
+	JournalAttachmentTranscribed         JournalAttachmentStatus = "transcribed"
+	JournalAttachmentTranscriptionFailed JournalAttachmentStatus = "transcription_failed"
+)
+
+// JournalAttachment is an audio file attached to a race's journal entry. The audio itself lives in S3, this is the
+// record of it.
+type JournalAttachment struct {
+	DriverID     int64
+	RaceID       int64
+	AttachmentID string
+	ContentType  string
+	CreatedAt    time.Time
+	Status       JournalAttachmentStatus
+	Transcribe   bool // whether a transcript was requested when the attachment was created
+}
+
+// ActionItemStatus is whether a driver has dealt with an action item yet.
+type ActionItemStatus string
+
+const (
+	ActionItemOpen ActionItemStatus = "open"
+	ActionItemDone ActionItemStatus = "done"
+)
+
+// ActionItem is something a driver wants to work on, either taken away from a race or free-standing.
+type ActionItem struct {
+	DriverID    int64
+	ItemID      string
+	Title       string
+	Status      ActionItemStatus
+	RaceID      int64 // zero for free-standing items
+	TrackID     int64 // zero when the item isn't tied to a track
+	DueDate     *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// AlertMetric is the per race value an alert rule watches.
+type AlertMetric string
+
+const (
+	AlertMetricIncidents      AlertMetric = "incidents"
+	AlertMetricIRating        AlertMetric = "irating"
+	AlertMetricFinishPosition AlertMetric = "finishPosition"
+)
+
+// AlertAggregate is how an alert rule combines its metric across the races in its window.
+type AlertAggregate string
+
+const (
+	AlertAggregateAverage AlertAggregate = "average"
+	AlertAggregateChange  AlertAggregate = "change" // from before the oldest race in the window to after the newest
+)
+
+// AlertComparison is which side of its threshold an alert rule's value has to be on for the alert to fire.
+type AlertComparison string
+
+const (
+	AlertComparisonAbove AlertComparison = "above"
+	AlertComparisonBelow AlertComparison = "below"
+)
+
+// AlertRule is a trend a driver wants to be alerted about, such as their 10 race incident average going above 5.
+// Exactly one of WindowRaces and WindowDays is set. Triggered is held while the rule's condition is met, so the driver
+// is alerted when the trend starts rather than after every race it continues through.
+type AlertRule struct {
+	DriverID        int64
+	RuleID          string
+	Name            string
+	Metric          AlertMetric
+	Aggregate       AlertAggregate
+	WindowRaces     int
+	WindowDays      int
+	Comparison      AlertComparison
+	Threshold       float64
+	Enabled         bool
+	Triggered       bool
+	LastTriggeredAt *time.Time
+	LastValue       float64 // the rule's value when it last triggered
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// RaceBookmark marks a moment in a race the driver wants to come back to while reviewing the replay.
+type RaceBookmark struct {
+	DriverID   int64
+	RaceID     int64
+	BookmarkID string
+	Lap        int
+	Note       string
+	// ReplayTime is how far into the replay the moment is
+	ReplayTime time.Duration
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// VideoLink is an external video, such as a YouTube timestamp or a clip, the driver attached to a race's journal entry
+// or to one of the race's laps.
+type VideoLink struct {
+	DriverID int64
+	RaceID   int64
+	LinkID   string
+	// Lap is the lap the video shows, nil when the video is attached to the journal entry as a whole
+	Lap      *int
+	URL      string
+	Provider string
+	// Title, AuthorName and ThumbnailURL are preview metadata captured from the provider when the link was added, empty
+	// when the provider didn't supply them
+	Title        string
+	AuthorName   string
+	ThumbnailURL string
+	CreatedAt    time.Time
+}
+
+// TireCorner identifies one of a car's four tires.
+type TireCorner string
+
+const (
+	TireCornerLF TireCorner = "lf"
+	TireCornerRF TireCorner = "rf"
+	TireCornerLR TireCorner = "lr"
+	TireCornerRR TireCorner = "rr"
+)
+
+// TireSummary is how one tire behaved over a stint. Temperatures (°C) are averaged over the stint's laps while
+// pressures (kPa) are the first and last readings, so the build up over the stint shows. Values the export didn't
+// include are zero.
+type TireSummary struct {
+	Corner        TireCorner
+	AvgTemp       float64
+	StartPressure float64
+	EndPressure   float64
+}
+
+// TelemetryStint summarizes the laps between two pit stops.
+type TelemetryStint struct {
+	Stint    int
+	StartLap int
+	EndLap   int
+	Laps     int
+	// FuelPerLap is the average fuel burned per lap in liters, zero when the export had no fuel data
+	FuelPerLap float64
+	// Tires holds the corners the export had data for, in LF, RF, LR, RR order
+	Tires []TireSummary
+}
+
+// RaceTelemetry is the stint summary parsed from a telemetry export the driver uploaded for a race. A race has at
+// most one, each import replaces the last.
+type RaceTelemetry struct {
+	DriverID   int64
+	RaceID     int64
+	Stints     []TelemetryStint
+	ImportedAt time.Time
+}
+
+// PracticeFocus is the aspect of driving a practice plan item targets.
+type PracticeFocus string
+
+const (
+	PracticeFocusIncidents   PracticeFocus = "incidents"   // incidents per corner well above the driver's norm
+	PracticeFocusConsistency PracticeFocus = "consistency" // lap times spread further from the best lap than normal
+	PracticeFocusRacecraft   PracticeFocus = "racecraft"   // losing more positions from the start than normal
+)
+
+// PracticePlanItem suggests practicing a track and car combination with a particular focus. Value is the combination's
+// measure for the focus and Baseline the same measure across all of the driver's recent races, so the two can be
+// compared directly.
+type PracticePlanItem struct {
+	TrackID   int64
+	CarID     int64
+	Focus     PracticeFocus
+	RaceCount int
+	Value     float64
+	Baseline  float64
+}
+
+// PracticePlan is the set of suggestions generated from a driver's races between WindowStart and GeneratedAt, most
+// pressing first. A driver has at most one plan, each generation replaces the last.
+type PracticePlan struct {
+	DriverID    int64
+	GeneratedAt time.Time
+	WindowStart time.Time
+	Items       []PracticePlanItem
+}
+
+// FatigueMeasure is an aspect of race performance checked for tailing off over the course of multi-race days.
+type FatigueMeasure string
+
+const (
+	FatigueMeasureFinishPosition FatigueMeasure = "finish_position" // finishing further down race over race
+	FatigueMeasureIncidents      FatigueMeasure = "incidents"       // picking up more incidents race over race
+	FatigueMeasureConsistency    FatigueMeasure = "consistency"     // lap times spreading further from the best lap race over race
+)
+
+// FatigueFlag notes that a driver's races tended to get worse the more of them they ran in a day.
+type FatigueFlag struct {
+	MultiRaceDays int
+	Measures      []FatigueMeasure
+}
+
+// WeeklyRecap is what the weekly recap job prepared for a driver in the race week starting WeekStart. A driver has at
+// most one recap, each week's replaces the last.
+type WeeklyRecap struct {
+	DriverID    int64
+	WeekStart   time.Time
+	GeneratedAt time.Time
+	// Fatigue is nil unless the driver's recent multi-race days show a pattern of tailing off
+	Fatigue *FatigueFlag
+	// Highlights are the recap's lines of text, written in the driver's locale at the time the recap was prepared
+	Highlights []string
+}
+
+// DashboardWidget is a card on a driver's dashboard, where it sits on the dashboard's grid, and what it shows. Empty
+// filters cover every series, car or track.
+type DashboardWidget struct {
+	WidgetID string
+	Card     string
+	Column   int
+	Row      int
+	Width    int
+	Height   int
+	// Days is how far back the card looks, 0 for all of the driver's races
+	Days        int
+	Granularity string
+	GroupBy     []string
+	SeriesIDs   []int64
+	CarIDs      []int64
+	TrackIDs    []int64
+}
+
+// DashboardLayout is how a driver has arranged their dashboard. The frontend owns the layout schema, SchemaVersion is
+// the version of it the layout was written in so layouts saved by older frontends can be brought forward.
+type DashboardLayout struct {
+	DriverID      int64
+	SchemaVersion int
+	Widgets       []DashboardWidget
+	UpdatedAt     time.Time
+}
+
+// SeasonCard is a driver's shareable season card, served publicly to whoever holds Token. Snapshot is the card's JSON
+// as of RefreshedAt, the seasoncard package owns its schema.
+type SeasonCard struct {
+	DriverID    int64
+	Token       string
+	Snapshot    string
+	CreatedAt   time.Time
+	RefreshedAt time.Time
+}
+
+// Overlay is a driver's stream overlay, served to whoever holds Token. Snapshot is the overlay's JSON as of RefreshedAt,
+// the overlay package owns its schema.
+type Overlay struct {
+	DriverID    int64
+	Token       string
+	Snapshot    string
+	CreatedAt   time.Time
+	RefreshedAt time.Time
+}
+
+// RaceJournalDraft is unpublished journal content for a race, autosaved while the driver is still writing. It is kept
+// apart from the RaceJournalEntry so saving it never changes the published entry.
+type RaceJournalDraft struct {
+	DriverID  int64
+	RaceID    int64
+	SavedAt   time.Time
+	ExpiresAt time.Time // drafts are removed by the table TTL some time after this
+
+	Notes       string
+	Tags        []string
+	ReplayVideo string
+}
+
+// JournalPrompt is a nudge to journal a race, raised when the race is ingested shortly after it was run. Prompts are
+// removed when dismissed, and by the table TTL a month after they were raised.
+type JournalPrompt struct {
+	DriverID  int64
+	RaceID    int64
+	CreatedAt time.Time
+
+	// PlanEvaluation is how the race went against the driver's plan for it, nil if the race wasn't planned or its
+	// plan had no measurable goals
+	PlanEvaluation *PlanEvaluation
+}
+
+// JournalStreak counts the consecutive weeks in which a driver journaled at least one race. It is maintained as entries
+// are saved rather than recomputed from the driver's history, so CurrentWeeks is as of LastWeekStart and a driver who
+// has since skipped a week no longer has a current streak.
+type JournalStreak struct {
+	DriverID      int64
+	CurrentWeeks  int
+	LongestWeeks  int
+	LastWeekStart time.Time // start of the most recent week counted towards the streak
+	UpdatedAt     time.Time
+}
+
+// RacePlan is a driver's plan for an upcoming race, written before the race rather than about it. Plans are keyed by the
+// series, track and race week being planned for, and once a race matching them is ingested the plan is linked to it so
+// the plan can be read alongside the result and the race's journal entry.
+type RacePlan struct {
+	DriverID  int64
+	WeekStart time.Time // Tuesday 00:00 UTC, the start of the iRacing race week
+	SeriesID  int64
+	TrackID   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Goals           string
+	SetupIntentions string
+
+	// The race the plan was linked to, the earliest matching race when the driver ran several. Zero until one is
+	// ingested.
+	RaceID       int64 // driver_race_id (unix timestamp of race start)
+	SubsessionID int64
+	LinkedAt     time.Time
+
+	// Evaluation compares the goals with how the linked race went. Nil until the plan is linked, and for plans whose
+	// goals don't name anything measurable.
+	Evaluation *PlanEvaluation
+}
+
+// PlanTargetKind is something measurable a driver can aim for in a race plan's goals.
+type PlanTargetKind string
+
+const (
+	PlanTargetFinishPosition PlanTargetKind = "finish_position" // finish in class no worse than the target (1-based)
+	PlanTargetIncidents      PlanTargetKind = "incidents"       // no more incidents than the target
+)
+
+// PlanTarget is a measurable target picked out of a plan's goals and how the race measured up to it.
+type PlanTarget struct {
+	Kind   PlanTargetKind
+	Target int
+	Actual int
+	Met    bool
+}
+
+// PlanEvaluation compares the targets in a plan's goals with the result of the race the plan was linked to.
+type PlanEvaluation struct {
+	Targets     []PlanTarget
+	EvaluatedAt time.Time
+}
+
+// DriverStanding is a weekly snapshot of a driver's position in their division's season standings.
+// Snapshots are keyed by the start of the iRacing race week they were taken in, so there is at most one per week.
+type DriverStanding struct {
+	DriverID   int64
+	WeekStart  time.Time // Tuesday 00:00 UTC, the start of the iRacing race week
+	SnapshotAt time.Time
+
+	SeasonID    int64
+	SeriesID    int64
+	SeriesName  string
+	CarClassID  int64
+	RaceWeekNum int // 0-based, the race week the standings were current through
+
+	Division     int // 0-based, 0 is division 1
+	DivisionRank int // 1-based position within the division
+	DivisionSize int
+	Points       int
+	WeeksCounted int
+	Starts       int
+	Wins         int
+}
+
+// IngestionRun records how a single race ingestion round went, kept for a week to help debug slow syncs.
+type IngestionRun struct {
+	DriverID     int64
+	StartedAt    time.Time
+	Duration     time.Duration
+	RaceCount    int
+	NewRaceCount int
+	LapCount     int
+	UpToDate     bool
+	Error        string // empty when the run succeeded
+	CaptureID    string // set when the run's iRacing responses were captured for replay
+	// PhaseDurations is the total time spent in each phase of the run (search, session_fetch, lap_fetch, persist,
+	// notify). Phases run concurrently so these can add up to more than Duration.
+	PhaseDurations map[string]time.Duration
+}
+
+// IRacingProxyRequest is a request a developer made to the iRacing data API through the developer proxy, kept so it can
+// be found and made again.
+type IRacingProxyRequest struct {
+	DriverID    int64
+	RequestedAt time.Time
+	Path        string
+	Params      map[string]string // as entered, templates left unexpanded
+	Status      int               // iRacing's response status, 0 when no response came back
+	Duration    time.Duration
+}
+
+// RequestCaptureMode is a developer's opt in to having their own API requests captured, lasting until Until.
+type RequestCaptureMode struct {
+	DriverID  int64
+	EnabledAt time.Time
+	Until     time.Time
+}
+
+// CapturedRequest is one of a developer's own API requests, captured while their request capture mode was on. Bodies
+// have already had anything sensitive redacted, and are cut short when large.
+type CapturedRequest struct {
+	ID          string // correlation ID of the request
+	DriverID    int64
+	RequestedAt time.Time
+	Method      string
+	Path        string
+	Route       string // the pattern of the route that served it, empty when nothing matched
+	Query       string
+	Status      int
+	Duration    time.Duration
+
+	RequestContentType    string
+	RequestBody           string
+	RequestBodyTruncated  bool
+	ResponseContentType   string
+	ResponseBody          string
+	ResponseBodyTruncated bool
+}
+
+// SessionTotals are stats accumulated across a set of a driver's races. Finishing positions are overall, matching
+// analytics.
+type SessionTotals struct {
+	Races         int
+	Wins          int
+	Podiums       int
+	Top5s         int
+	Incidents     int
+	IRatingChange int
+	// SubLevelChange is the safety rating change in hundredths, matching iRacing's sub level. License promotions and
+	// demotions aren't accounted for.
+	SubLevelChange int
+	LapsComplete   int
+	LapsLead       int
+}
+
+// Add returns the combined totals of t and other.
+func (t SessionTotals) Add(other SessionTotals) SessionTotals {
+	return SessionTotals{
+		Races:          t.Races + other.Races,
+		Wins:           t.Wins + other.Wins,
+		Podiums:        t.Podiums + other.Podiums,
+		Top5s:          t.Top5s + other.Top5s,
+		Incidents:      t.Incidents + other.Incidents,
+		IRatingChange:  t.IRatingChange + other.IRatingChange,
+		SubLevelChange: t.SubLevelChange + other.SubLevelChange,
+		LapsComplete:   t.LapsComplete + other.LapsComplete,
+		LapsLead:       t.LapsLead + other.LapsLead,
+	}
+}
+
+// Sub returns t with other's totals taken back out.
+func (t SessionTotals) Sub(other SessionTotals) SessionTotals {
+	return SessionTotals{
+		Races:          t.Races - other.Races,
+		Wins:           t.Wins - other.Wins,
+		Podiums:        t.Podiums - other.Podiums,
+		Top5s:          t.Top5s - other.Top5s,
+		Incidents:      t.Incidents - other.Incidents,
+		IRatingChange:  t.IRatingChange - other.IRatingChange,
+		SubLevelChange: t.SubLevelChange - other.SubLevelChange,
+		LapsComplete:   t.LapsComplete - other.LapsComplete,
+		LapsLead:       t.LapsLead - other.LapsLead,
+	}
+}
+
+// RollupScopeAllTime is the scope of a driver's career rollup
+const RollupScopeAllTime = "all"
+
+// RollupScopeSeason is the scope of a driver's rollup for a single iRacing season
+func RollupScopeSeason(seasonID int64) string {
+	return fmt.Sprintf("season#%d", seasonID)
+}
+
+// DriverRollup is a driver's SessionTotals over some scope, built up as their sessions are written.
+type DriverRollup struct {
+	DriverID int64
+	Scope    string
+	SessionTotals
+}
+
+// WeeklyLeaderboardEntry is a driver's SessionTotals for a single race week, kept alongside every other driver's.
+type WeeklyLeaderboardEntry struct {
+	WeekStart time.Time // Tuesday 00:00 UTC, the start of the iRacing race week
+	DriverID  int64
+	SessionTotals
+}
+
+// Computed weekly leaderboards, each ranking opted in drivers by a different measure of improvement over the week.
+const (
+	LeaderboardIRatingGain      = "irating_gain"
+	LeaderboardSafetyRatingGain = "sr_gain"
+	LeaderboardCleanRaceStreak  = "clean_streak"
+)
+
+// RankedLeaderboard describes one computed leaderboard for a race week, the places on it are LeaderboardRankings.
+type RankedLeaderboard struct {
+	Board        string
+	WeekStart    time.Time
+	ComputedAt   time.Time
+	TotalEntries int
+}
+
+// LeaderboardRanking is a driver's place on a computed leaderboard.
+type LeaderboardRanking struct {
+	Rank       int // 1 based
+	DriverID   int64
+	DriverName string
+	ClubID     int
+	Races      int
+	// Value is what the board ranks by: iRating points, safety rating hundredths, or clean races in a row.
+	Value int
+}
+
+// RegionWeeklyAggregate is the combined SessionTotals of every platform driver in an iRacing club (region) for a race
+// week. Only the driver count is kept, not who they are.
+type RegionWeeklyAggregate struct {
+	ClubID    int
+	WeekStart time.Time
+	Drivers   int
+	SessionTotals
+}
+
+// BenchmarkPercentiles are points on the distribution of a measure across drivers.
+type BenchmarkPercentiles struct {
+	P10 float64
+	P25 float64
+	P50 float64
+	P75 float64
+	P90 float64
+}
+
+// BenchmarkTable is how opted in platform drivers in an iRating band have gone in a series at a track. Each driver
+// counts once, no matter how many races they ran, and who they are isn't kept.
+type BenchmarkTable struct {
+	SeriesID int64
+	TrackID  int64
+	// IRatingBand is the bottom of the band drivers' iRatings fell in going into their races
+	IRatingBand      int
+	ComputedAt       time.Time
+	Drivers          int
+	IncidentsPerRace BenchmarkPercentiles
+	// OffBestPercent is how far drivers' average laps were off their best, nil when too few of the drivers had lap
+	// data for a distribution
+	OffBestPercent     *BenchmarkPercentiles
+	ConsistencyDrivers int
+}
+
+// DriverMilestone records the earliest race in which a driver achieved something.
+type DriverMilestone struct {
+	DriverID     int64
+	Milestone    string
+	AchievedAt   time.Time // start time of the race
+	SubsessionID int64
 }
 
 type GlobalCounters struct {
 	Drivers int64
 }
 
+// UpstreamStatus records iRacing being down, shared by everything that calls it so none of them keep trying until
+// DownUntil has passed.
+type UpstreamStatus struct {
+	DownUntil  time.Time
+	Reason     string
+	ReportedAt time.Time
+}
+
+// BackfillProgress is how far a backfill of new fields from the session archives has got, so a run that stops part way
+// carries on from the last batch it finished rather than starting over.
+type BackfillProgress struct {
+	Name      string
+	After     string // key of the last archive worked through, empty before the first batch
+	Archives  int    // archives worked through
+	Sessions  int    // driver sessions patched
+	Failures  int    // archives that couldn't be read or parsed, skipped over
+	Complete  bool
+	UpdatedAt time.Time
+}
+
+// RateBudgetWindow is how much of the shared iRacing rate limit ingestion spent during a fixed window of time, in total
+// and by driver.
+type RateBudgetWindow struct {
+	Start   time.Time
+	Total   int
+	Drivers map[int64]int
+}
+
+// QuotaUsage is how many times a driver has run each quota limited operation during a UTC day.
+type QuotaUsage struct {
+	DriverID int64
+	Day      time.Time
+	Counts   map[string]int
+}
+
+// LoginAttempts are the recent failed sign in attempts from one source, a client address or an iRacing customer. They
+// are forgotten once none have failed for a while.
+type LoginAttempts struct {
+	Key          string
+	Failures     int
+	FirstFailure time.Time
+	LastFailure  time.Time
+}
+
 type WebSocketConnection struct {
 	DriverID     int64
 	ConnectionID string
-	ConnectedAt  time.Time
+	// TenantID is who the connection was authenticated for. Connections are kept for the whole deployment, since later
+	// messages on them carry no token to tell which tenant they're for.
+	TenantID    string
+	ConnectedAt time.Time
+	// OverlayToken is set for connections opened by a stream overlay with its token rather than by the driver, which
+	// are only sent updates to that overlay
+	OverlayToken string
+}
+
+// DriverPresence is a driver's client reporting that they're in a sim session right now. Clients refresh it with a
+// heartbeat while the sim is running and it lapses shortly after they stop.
+type DriverPresence struct {
+	DriverID   int64
+	TrackID    int64  // zero when the client didn't say
+	CarID      int64  // zero when the client didn't say
+	SeriesName string // empty when the client didn't say
+	UpdatedAt  time.Time
 }
 
+// PresenceGrant lets a viewer see when a driver is racing. Names are captured when the grant is made so either side can
+// be listed without looking the other driver up.
+type PresenceGrant struct {
+	DriverID   int64
+	DriverName string
+	ViewerID   int64
+	ViewerName string
+	GrantedAt  time.Time
+}
+
+// AuthSession is a login on one device, continued by each token refresh until it is revoked or its latest token
+// expires. Its ID is the sid claim of the tokens issued for it.
+type AuthSession struct {
+	DriverID  int64
+	SessionID string
+	UserAgent string
+	IPPrefix  string // the network the latest token was issued to, never the full address
+	CreatedAt time.Time
+	IssuedAt  time.Time // when the latest token was issued
+	ExpiresAt time.Time // when the latest token expires
+	RevokedAt *time.Time
+}
+
+// Squad is a group of drivers, such as a league or team, run by the driver who created it.
+type Squad struct {
+	SquadID     string
+	Name        string
+	ManagerID   int64
+	ManagerName string
+	CreatedAt   time.Time
+}
+
+// SquadMemberStatus is how far a driver is through joining a squad.
+type SquadMemberStatus string
+
+const (
+	// SquadMemberInvited drivers have been invited by the squad's manager but haven't accepted, so their data isn't
+	// shared with the squad.
+	SquadMemberInvited SquadMemberStatus = "invited"
+	// SquadMemberActive drivers have accepted, consenting to their data counting towards the squad's analytics.
+	SquadMemberActive SquadMemberStatus = "active"
+)
+
+// SquadMember is a driver's place in a squad. Names are captured when the member is saved so a squad's members and a
+// driver's squads can each be listed without looking anything else up.
+type SquadMember struct {
+	SquadID    string
+	SquadName  string
+	DriverID   int64
+	DriverName string
+	Status     SquadMemberStatus
+	InvitedAt  time.Time
+	JoinedAt   *time.Time // nil until the invite is accepted
+}
+
+// SquadEvent is a session the squad's manager tagged as a squad event, such as a league's race night. Members' sessions
+// are tagged with the squad when their ingestion reaches it.
+type SquadEvent struct {
+	SquadID      string
+	SubsessionID int64
+	Name         string
+	TaggedAt     time.Time
+}
+
+// SquadEventResult is an active member's finish in a squad event, recorded when their session is tagged.
+type SquadEventResult struct {
+	SquadID               string
+	SubsessionID          int64
+	DriverID              int64
+	DriverName            string
+	StartTime             time.Time
+	CarID                 int64
+	CarClassID            int64
+	StartPosition         int
+	FinishPosition        int
+	FinishPositionInClass int
+	Incidents             int
+	LapsComplete          int
+	OldIRating            int
+	NewIRating            int
+	ReasonOutCode         ReasonOutCode
+}
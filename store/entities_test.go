@@ -0,0 +1,40 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeReasonOut(t *testing.T) {
+	testCases := []struct {
+		reasonOut string
+		expected  ReasonOutCode
+	}{
+		{reasonOut: "Running", expected: ReasonOutFinished},
+		{reasonOut: " running ", expected: ReasonOutFinished},
+		{reasonOut: "Disconnected", expected: ReasonOutDisconnected},
+		{reasonOut: "Disqualified", expected: ReasonOutDisqualified},
+		{reasonOut: "Retired", expected: ReasonOutDNF},
+		{reasonOut: "Mechanical", expected: ReasonOutDNF},
+		{reasonOut: "", expected: ReasonOutUnknown},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.reasonOut, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NormalizeReasonOut(tc.reasonOut))
+		})
+	}
+}
+
+func TestDriverSession_Outcome(t *testing.T) {
+	t.Run("recorded code wins", func(t *testing.T) {
+		session := DriverSession{ReasonOut: "Running", ReasonOutCode: ReasonOutDisqualified}
+		assert.Equal(t, ReasonOutDisqualified, session.Outcome())
+	})
+
+	t.Run("derived for older sessions", func(t *testing.T) {
+		session := DriverSession{ReasonOut: "Disconnected"}
+		assert.Equal(t, ReasonOutDisconnected, session.Outcome())
+	})
+}
@@ -0,0 +1,463 @@
+package store
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	"github.com/jonsabados/saturdaysspinout/store/keys"
+)
+
+// DefaultDataKeyMaxAge is how long a data key is used to encrypt new values before a fresh one is generated.
+const DefaultDataKeyMaxAge = time.Hour
+
+// maxCachedDataKeys caps how many decrypted data keys are kept around, past it the cache starts over.
+const maxCachedDataKeys = 1000
+
+// ErrEncryptionNotConfigured is returned when reading an encrypted value through a store without field encryption.
+var ErrEncryptionNotConfigured = errors.New("value is encrypted but field encryption is not configured")
+
+// KeyService is the part of the KMS client needed for envelope encryption.
+type KeyService interface {
+	GenerateDataKey(ctx context.Context, params *kms.GenerateDataKeyInput, optFns ...func(*kms.Options)) (*kms.GenerateDataKeyOutput, error)
+	Decrypt(ctx context.Context, params *kms.DecryptInput, optFns ...func(*kms.Options)) (*kms.DecryptOutput, error)
+}
+
+// encryptedAttribute is an attribute kept encrypted on the items whose sort key starts with sortKeyPrefix.
+type encryptedAttribute struct {
+	sortKeyPrefix string
+	name          string
+}
+
+// encryptedAttributes are the free text the drivers write about themselves. Lists, such as transcripts, have each
+// element encrypted on its own so they can still be appended to.
+var encryptedAttributes = []encryptedAttribute{
+	{sortKeyPrefix: keys.JournalEntryPrefix, name: "notes"},
+	{sortKeyPrefix: keys.JournalEntryPrefix, name: "transcripts"},
+	{sortKeyPrefix: keys.JournalDraftPrefix, name: "notes"},
+	{sortKeyPrefix: keys.RaceBookmarkPrefix, name: "note"},
+}
+
+// FieldEncrypter encrypts attribute values using envelope encryption. Each value is sealed with AES-GCM under a data
+// key, and the data key is stored next to it encrypted under a KMS key, so KMS is only called when a data key is
+// generated or seen for the first time. Data keys are replaced once they are dataKeyMaxAge old, and values encrypted under an
+// earlier KMS key can still be read, so the KMS key can be switched while old values are re-encrypted.
+type FieldEncrypter struct {
+	keys          KeyService
+	keyID         string
+	dataKeyMaxAge time.Duration
+	now           func() time.Time
+
+	mu        sync.Mutex
+	current   *dataKey
+	decrypted map[string][]byte
+}
+
+type dataKey struct {
+	keyID     string
+	plaintext []byte
+	encrypted []byte
+	createdAt time.Time
+}
+
+func NewFieldEncrypter(keys KeyService, keyID string, dataKeyMaxAge time.Duration) *FieldEncrypter {
+	return &FieldEncrypter{
+		keys:          keys,
+		keyID:         keyID,
+		dataKeyMaxAge: dataKeyMaxAge,
+		now:           time.Now,
+		decrypted:     make(map[string][]byte),
+	}
+}
+
+// encrypt seals a plaintext value, aad ties the value to where it's stored so it can't be copied onto another item.
+func (e *FieldEncrypter) encrypt(ctx context.Context, plaintext string, aad []byte) (types.AttributeValue, error) {
+	key, err := e.dataKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key.plaintext)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %w", err)
+	}
+	return &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+		"key_id":     &types.AttributeValueMemberS{Value: key.keyID},
+		"data_key":   &types.AttributeValueMemberB{Value: key.encrypted},
+		"ciphertext": &types.AttributeValueMemberB{Value: gcm.Seal(nonce, nonce, []byte(plaintext), aad)},
+	}}, nil
+}
+
+// decrypt opens a value sealed by encrypt.
+func (e *FieldEncrypter) decrypt(ctx context.Context, sealed *types.AttributeValueMemberM, aad []byte) (string, error) {
+	keyID, ok := sealed.Value["key_id"].(*types.AttributeValueMemberS)
+	if !ok {
+		return "", errors.New("encrypted value is missing its key id")
+	}
+	encryptedKey, ok := sealed.Value["data_key"].(*types.AttributeValueMemberB)
+	if !ok {
+		return "", errors.New("encrypted value is missing its data key")
+	}
+	ciphertext, ok := sealed.Value["ciphertext"].(*types.AttributeValueMemberB)
+	if !ok {
+		return "", errors.New("encrypted value is missing its ciphertext")
+	}
+
+	key, err := e.plaintextKey(ctx, keyID.Value, encryptedKey.Value)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	if len(ciphertext.Value) < gcm.NonceSize() {
+		return "", errors.New("encrypted value is too short")
+	}
+	nonce, sealedText := ciphertext.Value[:gcm.NonceSize()], ciphertext.Value[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, sealedText, aad)
+	if err != nil {
+		return "", fmt.Errorf("decrypting value: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// currentKeyID is the ARN of the KMS key new values are encrypted under. The configured key can be an alias, so it's
+// resolved through the data key KMS generated.
+func (e *FieldEncrypter) currentKeyID(ctx context.Context) (string, error) {
+	key, err := e.dataKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	return key.keyID, nil
+}
+
+func (e *FieldEncrypter) dataKey(ctx context.Context) (*dataKey, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now()
+	if e.current != nil && now.Sub(e.current.createdAt) < e.dataKeyMaxAge {
+		return e.current, nil
+	}
+	result, err := e.keys.GenerateDataKey(ctx, &kms.GenerateDataKeyInput{
+		KeyId:   aws.String(e.keyID),
+		KeySpec: kmstypes.DataKeySpecAes256,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generating data key: %w", err)
+	}
+	e.current = &dataKey{
+		keyID:     aws.ToString(result.KeyId),
+		plaintext: result.Plaintext,
+		encrypted: result.CiphertextBlob,
+		createdAt: now,
+	}
+	e.cacheKey(result.CiphertextBlob, result.Plaintext)
+	return e.current, nil
+}
+
+func (e *FieldEncrypter) plaintextKey(ctx context.Context, keyID string, encrypted []byte) ([]byte, error) {
+	e.mu.Lock()
+	key, ok := e.decrypted[string(encrypted)]
+	e.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	result, err := e.keys.Decrypt(ctx, &kms.DecryptInput{
+		KeyId:          aws.String(keyID),
+		CiphertextBlob: encrypted,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("decrypting data key: %w", err)
+	}
+
+	e.mu.Lock()
+	e.cacheKey(encrypted, result.Plaintext)
+	e.mu.Unlock()
+	return result.Plaintext, nil
+}
+
+// cacheKey must be called with mu held.
+func (e *FieldEncrypter) cacheKey(encrypted, plaintext []byte) {
+	if len(e.decrypted) >= maxCachedDataKeys {
+		e.decrypted = make(map[string][]byte)
+	}
+	e.decrypted[string(encrypted)] = plaintext
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// fieldAAD is the additional authenticated data for an attribute on the item with the given keys.
+func fieldAAD(pk, sk, attribute string) []byte {
+	return []byte(pk + "\x00" + sk + "\x00" + attribute)
+}
+
+// sealValue encrypts a value of an encrypted attribute. Strings are encrypted, as is each string in a list. Without
+// field encryption the value is returned as is.
+func (s *DynamoStore) sealValue(ctx context.Context, pk, sk, attribute string, value types.AttributeValue) (types.AttributeValue, error) {
+	if s.encrypter == nil {
+		return value, nil
+	}
+	aad := fieldAAD(pk, sk, attribute)
+	switch v := value.(type) {
+	case *types.AttributeValueMemberS:
+		return s.encrypter.encrypt(ctx, v.Value, aad)
+	case *types.AttributeValueMemberL:
+		sealed := make([]types.AttributeValue, len(v.Value))
+		for i, elem := range v.Value {
+			str, ok := elem.(*types.AttributeValueMemberS)
+			if !ok {
+				// already encrypted
+				sealed[i] = elem
+				continue
+			}
+			var err error
+			sealed[i], err = s.encrypter.encrypt(ctx, str.Value, aad)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &types.AttributeValueMemberL{Value: sealed}, nil
+	}
+	return value, nil
+}
+
+// openValue decrypts a value of an encrypted attribute, the reverse of sealValue. Plaintext written before field
+// encryption was turned on is returned as is.
+func (s *DynamoStore) openValue(ctx context.Context, pk, sk, attribute string, value types.AttributeValue) (types.AttributeValue, error) {
+	aad := fieldAAD(pk, sk, attribute)
+	switch v := value.(type) {
+	case *types.AttributeValueMemberM:
+		return s.openString(ctx, v, aad)
+	case *types.AttributeValueMemberL:
+		opened := make([]types.AttributeValue, len(v.Value))
+		for i, elem := range v.Value {
+			sealed, ok := elem.(*types.AttributeValueMemberM)
+			if !ok {
+				opened[i] = elem
+				continue
+			}
+			var err error
+			opened[i], err = s.openString(ctx, sealed, aad)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &types.AttributeValueMemberL{Value: opened}, nil
+	}
+	return value, nil
+}
+
+func (s *DynamoStore) openString(ctx context.Context, sealed *types.AttributeValueMemberM, aad []byte) (types.AttributeValue, error) {
+	if s.encrypter == nil {
+		return nil, ErrEncryptionNotConfigured
+	}
+	plaintext, err := s.encrypter.decrypt(ctx, sealed, aad)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AttributeValueMemberS{Value: plaintext}, nil
+}
+
+// sealItem encrypts the encrypted attributes of an item that's about to be written.
+func (s *DynamoStore) sealItem(ctx context.Context, item map[string]types.AttributeValue) error {
+	return s.transformItem(ctx, item, s.sealValue)
+}
+
+// openItem decrypts the encrypted attributes of an item that was just read.
+func (s *DynamoStore) openItem(ctx context.Context, item map[string]types.AttributeValue) error {
+	return s.transformItem(ctx, item, s.openValue)
+}
+
+func (s *DynamoStore) transformItem(ctx context.Context, item map[string]types.AttributeValue, transform func(ctx context.Context, pk, sk, attribute string, value types.AttributeValue) (types.AttributeValue, error)) error {
+	pk, _ := getStringAttr(item, partitionKeyName)
+	sk, _ := getStringAttr(item, sortKeyName)
+	for _, attr := range encryptedAttributes {
+		value, ok := item[attr.name]
+		if !ok || !strings.HasPrefix(sk, attr.sortKeyPrefix) {
+			continue
+		}
+		transformed, err := transform(ctx, pk, sk, attr.name, value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", attr.name, err)
+		}
+		item[attr.name] = transformed
+	}
+	return nil
+}
+
+// needsEncrypting reports whether a stored value of an encrypted attribute is plaintext, or was encrypted under a KMS
+// key other than currentKeyID.
+func needsEncrypting(value types.AttributeValue, currentKeyID string) bool {
+	switch v := value.(type) {
+	case *types.AttributeValueMemberS:
+		return true
+	case *types.AttributeValueMemberM:
+		keyID, ok := v.Value["key_id"].(*types.AttributeValueMemberS)
+		return !ok || keyID.Value != currentKeyID
+	case *types.AttributeValueMemberL:
+		for _, elem := range v.Value {
+			if needsEncrypting(elem, currentKeyID) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FieldEncryptionResult counts what EncryptFields did.
+type FieldEncryptionResult struct {
+	Scanned   int `json:"scanned"`
+	Encrypted int `json:"encrypted"`
+	// Skipped items changed while they were being encrypted, running again picks up any that still need it
+	Skipped int `json:"skipped"`
+}
+
+// EncryptFields encrypts encrypted attributes that are still plaintext, and re-encrypts those under a KMS key other
+// than the current one, so the store's data ends up entirely under the current key. Each item is only written if the
+// attributes are unchanged since they were read, so drivers editing while this runs don't lose their edits. This scans
+// the table, so it's only meant for background jobs.
+func (s *DynamoStore) EncryptFields(ctx context.Context) (*FieldEncryptionResult, error) {
+	if s.encrypter == nil {
+		return nil, ErrEncryptionNotConfigured
+	}
+	currentKeyID, err := s.encrypter.currentKeyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]string{"#sk": sortKeyName}
+	values := map[string]types.AttributeValue{}
+	var conditions []string
+	for i, prefix := range encryptedSortKeyPrefixes() {
+		placeholder := fmt.Sprintf(":prefix%d", i)
+		conditions = append(conditions, fmt.Sprintf("begins_with(#sk, %s)", placeholder))
+		values[placeholder] = &types.AttributeValueMemberS{Value: prefix}
+	}
+
+	result := &FieldEncryptionResult{}
+	var startKey map[string]types.AttributeValue
+	for {
+		page, err := s.scan(ctx, "EncryptFields", &dynamodb.ScanInput{
+			TableName:                 aws.String(s.tableName(ctx)),
+			FilterExpression:          aws.String(strings.Join(conditions, " OR ")),
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+			ExclusiveStartKey:         startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range page.Items {
+			result.Scanned++
+			encrypted, err := s.encryptItemFields(ctx, item, currentKeyID)
+			if err != nil {
+				var condErr *types.ConditionalCheckFailedException
+				if errors.As(err, &condErr) {
+					result.Skipped++
+					continue
+				}
+				return nil, err
+			}
+			if encrypted {
+				result.Encrypted++
+			}
+		}
+
+		if len(page.LastEvaluatedKey) == 0 {
+			return result, nil
+		}
+		startKey = page.LastEvaluatedKey
+	}
+}
+
+// encryptItemFields rewrites the attributes of an item that need encrypting, returning false if none did.
+func (s *DynamoStore) encryptItemFields(ctx context.Context, item map[string]types.AttributeValue, currentKeyID string) (bool, error) {
+	pk, err := getStringAttr(item, partitionKeyName)
+	if err != nil {
+		return false, err
+	}
+	sk, err := getStringAttr(item, sortKeyName)
+	if err != nil {
+		return false, err
+	}
+
+	var sets, conditions []string
+	names := map[string]string{}
+	values := map[string]types.AttributeValue{}
+	for i, attr := range encryptedAttributes {
+		value, ok := item[attr.name]
+		if !ok || !strings.HasPrefix(sk, attr.sortKeyPrefix) || !needsEncrypting(value, currentKeyID) {
+			continue
+		}
+		opened, err := s.openValue(ctx, pk, sk, attr.name, value)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", attr.name, err)
+		}
+		sealed, err := s.sealValue(ctx, pk, sk, attr.name, opened)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", attr.name, err)
+		}
+
+		name := fmt.Sprintf("#attr%d", i)
+		names[name] = attr.name
+		values[fmt.Sprintf(":sealed%d", i)] = sealed
+		values[fmt.Sprintf(":original%d", i)] = value
+		sets = append(sets, fmt.Sprintf("%s = :sealed%d", name, i))
+		conditions = append(conditions, fmt.Sprintf("%s = :original%d", name, i))
+	}
+	if len(sets) == 0 {
+		return false, nil
+	}
+
+	_, err = s.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.tableName(ctx)),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+			sortKeyName:      &types.AttributeValueMemberS{Value: sk},
+		},
+		UpdateExpression:          aws.String("SET " + strings.Join(sets, ", ")),
+		ConditionExpression:       aws.String(strings.Join(conditions, " AND ")),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func encryptedSortKeyPrefixes() []string {
+	var prefixes []string
+	for _, attr := range encryptedAttributes {
+		if !slices.Contains(prefixes, attr.sortKeyPrefix) {
+			prefixes = append(prefixes, attr.sortKeyPrefix)
+		}
+	}
+	return prefixes
+}
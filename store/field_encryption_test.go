@@ -0,0 +1,236 @@
+package store
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/store/keys"
+)
+
+// expectDataKey has KMS hand out a fresh data key under keyID, once.
+func expectDataKey(t *testing.T, keyService *MockKeyService, keyID string) *kms.GenerateDataKeyOutput {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	dataKey := &kms.GenerateDataKeyOutput{
+		KeyId:          aws.String("arn:aws:kms:us-east-1:123456789012:key/" + keyID),
+		Plaintext:      key,
+		CiphertextBlob: append([]byte(keyID+"|"), key...),
+	}
+	keyService.EXPECT().GenerateDataKey(mock.Anything, &kms.GenerateDataKeyInput{
+		KeyId:   aws.String(keyID),
+		KeySpec: kmstypes.DataKeySpecAes256,
+	}).Return(dataKey, nil).Once()
+	return dataKey
+}
+
+// expectDataKeyDecrypt has KMS decrypt a data key it handed out earlier, once.
+func expectDataKeyDecrypt(keyService *MockKeyService, dataKey *kms.GenerateDataKeyOutput) {
+	keyService.EXPECT().Decrypt(mock.Anything, &kms.DecryptInput{
+		KeyId:          dataKey.KeyId,
+		CiphertextBlob: dataKey.CiphertextBlob,
+	}).Return(&kms.DecryptOutput{KeyId: dataKey.KeyId, Plaintext: dataKey.Plaintext}, nil).Once()
+}
+
+func TestFieldEncryption_SealAndOpen(t *testing.T) {
+	ctx := context.Background()
+	keyService := NewMockKeyService(t)
+	// everything below is done with a single data key, and it's never sent back to KMS
+	expectDataKey(t, keyService, "current")
+	s := &DynamoStore{encrypter: NewFieldEncrypter(keyService, "current", DefaultDataKeyMaxAge)}
+
+	sealed, err := s.sealValue(ctx, "driver#1", "journal#100", "notes", &types.AttributeValueMemberS{Value: "braked too late into T1"})
+	require.NoError(t, err)
+	sealedMap, ok := sealed.(*types.AttributeValueMemberM)
+	require.True(t, ok, "expected sealed value to be a map")
+	assert.Equal(t, &types.AttributeValueMemberS{Value: "arn:aws:kms:us-east-1:123456789012:key/current"}, sealedMap.Value["key_id"])
+	assert.NotContains(t, string(sealedMap.Value["ciphertext"].(*types.AttributeValueMemberB).Value), "braked")
+
+	opened, err := s.openValue(ctx, "driver#1", "journal#100", "notes", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, &types.AttributeValueMemberS{Value: "braked too late into T1"}, opened)
+
+	// a value copied onto another item doesn't open
+	_, err = s.openValue(ctx, "driver#2", "journal#100", "notes", sealed)
+	assert.Error(t, err)
+
+	// lists have each element sealed
+	sealedList, err := s.sealValue(ctx, "driver#1", "journal#100", "transcripts", &types.AttributeValueMemberL{Value: []types.AttributeValue{
+		&types.AttributeValueMemberS{Value: "first memo"},
+		&types.AttributeValueMemberS{Value: "second memo"},
+	}})
+	require.NoError(t, err)
+	for _, elem := range sealedList.(*types.AttributeValueMemberL).Value {
+		assert.IsType(t, &types.AttributeValueMemberM{}, elem)
+	}
+	openedList, err := s.openValue(ctx, "driver#1", "journal#100", "transcripts", sealedList)
+	require.NoError(t, err)
+	assert.Equal(t, &types.AttributeValueMemberL{Value: []types.AttributeValue{
+		&types.AttributeValueMemberS{Value: "first memo"},
+		&types.AttributeValueMemberS{Value: "second memo"},
+	}}, openedList)
+
+	// plaintext written before encryption was turned on reads as is
+	opened, err = s.openValue(ctx, "driver#1", "journal#100", "notes", &types.AttributeValueMemberS{Value: "old notes"})
+	require.NoError(t, err)
+	assert.Equal(t, &types.AttributeValueMemberS{Value: "old notes"}, opened)
+
+	// without encryption, sealed values can't be read
+	plain := &DynamoStore{}
+	_, err = plain.openValue(ctx, "driver#1", "journal#100", "notes", sealed)
+	assert.ErrorIs(t, err, ErrEncryptionNotConfigured)
+	unsealed, err := plain.sealValue(ctx, "driver#1", "journal#100", "notes", &types.AttributeValueMemberS{Value: "notes"})
+	require.NoError(t, err)
+	assert.Equal(t, &types.AttributeValueMemberS{Value: "notes"}, unsealed)
+}
+
+func TestFieldEncryption_DataKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	keyService := NewMockKeyService(t)
+	firstKey := expectDataKey(t, keyService, "current")
+	secondKey := expectDataKey(t, keyService, "current")
+	encrypter := NewFieldEncrypter(keyService, "current", time.Hour)
+	encrypter.now = func() time.Time { return now }
+	s := &DynamoStore{encrypter: encrypter}
+
+	first, err := s.sealValue(ctx, "driver#1", "bookmark#100#a", "note", &types.AttributeValueMemberS{Value: "first"})
+	require.NoError(t, err)
+	now = now.Add(59 * time.Minute)
+	_, err = s.sealValue(ctx, "driver#1", "bookmark#100#b", "note", &types.AttributeValueMemberS{Value: "same key"})
+	require.NoError(t, err)
+
+	now = now.Add(time.Minute)
+	second, err := s.sealValue(ctx, "driver#1", "bookmark#100#c", "note", &types.AttributeValueMemberS{Value: "new key"})
+	require.NoError(t, err)
+	assert.Equal(t, &types.AttributeValueMemberB{Value: firstKey.CiphertextBlob}, first.(*types.AttributeValueMemberM).Value["data_key"])
+	assert.Equal(t, &types.AttributeValueMemberB{Value: secondKey.CiphertextBlob}, second.(*types.AttributeValueMemberM).Value["data_key"])
+
+	// a fresh encrypter, like another lambda instance, has to ask KMS for the data key once
+	expectDataKeyDecrypt(keyService, firstKey)
+	reader := &DynamoStore{encrypter: NewFieldEncrypter(keyService, "current", time.Hour)}
+	for i := 0; i < 2; i++ {
+		opened, err := reader.openValue(ctx, "driver#1", "bookmark#100#a", "note", first)
+		require.NoError(t, err)
+		assert.Equal(t, &types.AttributeValueMemberS{Value: "first"}, opened)
+	}
+}
+
+func TestNeedsEncrypting(t *testing.T) {
+	current := "arn:aws:kms:us-east-1:123456789012:key/current"
+	sealedUnder := func(keyID string) *types.AttributeValueMemberM {
+		return &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+			"key_id": &types.AttributeValueMemberS{Value: keyID},
+		}}
+	}
+
+	testCases := []struct {
+		name     string
+		value    types.AttributeValue
+		expected bool
+	}{
+		{name: "plaintext", value: &types.AttributeValueMemberS{Value: "notes"}, expected: true},
+		{name: "current key", value: sealedUnder(current), expected: false},
+		{name: "earlier key", value: sealedUnder("arn:aws:kms:us-east-1:123456789012:key/retired"), expected: true},
+		{name: "all elements current", value: &types.AttributeValueMemberL{Value: []types.AttributeValue{sealedUnder(current), sealedUnder(current)}}, expected: false},
+		{name: "plaintext element", value: &types.AttributeValueMemberL{Value: []types.AttributeValue{sealedUnder(current), &types.AttributeValueMemberS{Value: "memo"}}}, expected: true},
+		{name: "empty list", value: &types.AttributeValueMemberL{}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, needsEncrypting(tc.value, current))
+		})
+	}
+}
+
+func TestFieldEncryption_DynamoRoundTripAndMigration(t *testing.T) {
+	plain := setupTestStore(t)
+	ctx := context.Background()
+	keyService := NewMockKeyService(t)
+	firstKey := expectDataKey(t, keyService, "first")
+	encrypted := NewDynamoStore(plain.client, plain.table, WithFieldEncryption(NewFieldEncrypter(keyService, "first", DefaultDataKeyMaxAge)))
+
+	rawNotes := func(sk string, attribute string) types.AttributeValue {
+		result, err := plain.client.GetItem(ctx, &dynamodb.GetItemInput{
+			TableName: aws.String(plain.table),
+			Key: map[string]types.AttributeValue{
+				partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(1)},
+				sortKeyName:      &types.AttributeValueMemberS{Value: sk},
+			},
+		})
+		require.NoError(t, err)
+		return result.Item[attribute]
+	}
+
+	// written before encryption was turned on
+	require.NoError(t, plain.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 1, RaceID: 100, Notes: "plaintext notes"}))
+	_, err := plain.AppendJournalTranscript(ctx, 1, 100, "plaintext memo", nil)
+	require.NoError(t, err)
+	require.NoError(t, plain.SaveRaceBookmark(ctx, RaceBookmark{DriverID: 1, RaceID: 100, BookmarkID: "a", Note: "plaintext bookmark"}))
+
+	// written after, transcripts mix plaintext and encrypted elements until the migration runs
+	require.NoError(t, encrypted.SaveJournalDraft(ctx, RaceJournalDraft{DriverID: 1, RaceID: 100, Notes: "secret draft", ExpiresAt: time.Now().Add(time.Hour)}))
+	_, err = encrypted.AppendJournalTranscript(ctx, 1, 100, "secret memo", nil)
+	require.NoError(t, err)
+	assert.IsType(t, &types.AttributeValueMemberM{}, rawNotes(keys.JournalDraft(100), "notes"))
+
+	entry, err := encrypted.GetJournalEntry(ctx, 1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, "plaintext notes", entry.Notes)
+	assert.Equal(t, []string{"plaintext memo", "secret memo"}, entry.Transcripts)
+	draft, err := encrypted.GetJournalDraft(ctx, 1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, "secret draft", draft.Notes)
+
+	_, err = plain.GetJournalDraft(ctx, 1, 100)
+	assert.ErrorIs(t, err, ErrEncryptionNotConfigured)
+
+	result, err := encrypted.EncryptFields(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &FieldEncryptionResult{Scanned: 3, Encrypted: 2}, result)
+	assert.IsType(t, &types.AttributeValueMemberM{}, rawNotes(keys.JournalEntry(100), "notes"))
+	assert.IsType(t, &types.AttributeValueMemberM{}, rawNotes(keys.RaceBookmark(100, "a"), "note"))
+
+	bookmarks, err := encrypted.GetRaceBookmarks(ctx, 1, 100)
+	require.NoError(t, err)
+	require.Len(t, bookmarks, 1)
+	assert.Equal(t, "plaintext bookmark", bookmarks[0].Note)
+
+	// nothing left to do on a second run
+	result, err = encrypted.EncryptFields(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &FieldEncryptionResult{Scanned: 3}, result)
+
+	// switching KMS keys leaves everything readable, and the migration moves it all over to the new key. The new
+	// store has to have the first key's data key decrypted, but only the once.
+	expectDataKeyDecrypt(keyService, firstKey)
+	expectDataKey(t, keyService, "second")
+	rotated := NewDynamoStore(plain.client, plain.table, WithFieldEncryption(NewFieldEncrypter(keyService, "second", DefaultDataKeyMaxAge)))
+	entry, err = rotated.GetJournalEntry(ctx, 1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, "plaintext notes", entry.Notes)
+
+	result, err = rotated.EncryptFields(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &FieldEncryptionResult{Scanned: 3, Encrypted: 3}, result)
+	assert.Equal(t, &types.AttributeValueMemberS{Value: "arn:aws:kms:us-east-1:123456789012:key/second"},
+		rawNotes(keys.JournalDraft(100), "notes").(*types.AttributeValueMemberM).Value["key_id"])
+
+	entry, err = rotated.GetJournalEntry(ctx, 1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, "plaintext notes", entry.Notes)
+	assert.Equal(t, []string{"plaintext memo", "secret memo"}, entry.Transcripts)
+}
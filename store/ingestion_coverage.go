@@ -0,0 +1,75 @@
+package store
+
+import (
+	"slices"
+	"time"
+)
+
+// TimeRange is a span of time, From inclusive and To exclusive.
+type TimeRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// Coverage is the set of time ranges a driver's races have been ingested for. It is kept sorted with overlapping and
+// touching ranges merged, so it stays small no matter how many rounds went into it.
+type Coverage []TimeRange
+
+// Add returns the coverage with ranges merged in. Empty and inverted ranges are ignored.
+func (c Coverage) Add(ranges ...TimeRange) Coverage {
+	merged := make(Coverage, 0, len(c)+len(ranges))
+	merged = append(merged, c...)
+	for _, r := range ranges {
+		if r.To.After(r.From) {
+			merged = append(merged, r)
+		}
+	}
+	slices.SortFunc(merged, func(a, b TimeRange) int {
+		return a.From.Compare(b.From)
+	})
+
+	result := make(Coverage, 0, len(merged))
+	for _, r := range merged {
+		if last := len(result) - 1; last >= 0 && !r.From.After(result[last].To) {
+			if r.To.After(result[last].To) {
+				result[last].To = r.To
+			}
+			continue
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+// Gaps returns the parts of from to to that are not covered, oldest first.
+func (c Coverage) Gaps(from, to time.Time) []TimeRange {
+	var gaps []TimeRange
+	cursor := from
+	for _, r := range c {
+		if !r.To.After(cursor) {
+			continue
+		}
+		if !r.From.Before(to) {
+			break
+		}
+		if r.From.After(cursor) {
+			gaps = append(gaps, TimeRange{From: cursor, To: r.From})
+		}
+		cursor = r.To
+	}
+	if to.After(cursor) {
+		gaps = append(gaps, TimeRange{From: cursor, To: to})
+	}
+	return gaps
+}
+
+// CoveredFrom returns how far back coverage runs without a gap from t, which is t itself if t isn't covered. The end
+// of a range counts as covered so that the end of the most recent round can be passed as is.
+func (c Coverage) CoveredFrom(t time.Time) time.Time {
+	for _, r := range c {
+		if !r.From.After(t) && !r.To.Before(t) {
+			return r.From
+		}
+	}
+	return t
+}
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoverage_Add(t *testing.T) {
+	at := func(sec int64) time.Time { return time.Unix(sec, 0) }
+
+	testCases := []struct {
+		name     string
+		coverage Coverage
+		ranges   []TimeRange
+		expected Coverage
+	}{
+		{
+			name:     "first range",
+			ranges:   []TimeRange{{From: at(100), To: at(200)}},
+			expected: Coverage{{From: at(100), To: at(200)}},
+		},
+		{
+			name:     "disjoint ranges kept sorted",
+			coverage: Coverage{{From: at(300), To: at(400)}},
+			ranges:   []TimeRange{{From: at(100), To: at(200)}},
+			expected: Coverage{{From: at(100), To: at(200)}, {From: at(300), To: at(400)}},
+		},
+		{
+			name:     "touching ranges merged",
+			coverage: Coverage{{From: at(100), To: at(200)}},
+			ranges:   []TimeRange{{From: at(200), To: at(300)}},
+			expected: Coverage{{From: at(100), To: at(300)}},
+		},
+		{
+			name:     "overlapping ranges merged",
+			coverage: Coverage{{From: at(100), To: at(200)}, {From: at(300), To: at(400)}},
+			ranges:   []TimeRange{{From: at(150), To: at(350)}},
+			expected: Coverage{{From: at(100), To: at(400)}},
+		},
+		{
+			name:     "contained range changes nothing",
+			coverage: Coverage{{From: at(100), To: at(400)}},
+			ranges:   []TimeRange{{From: at(150), To: at(350)}},
+			expected: Coverage{{From: at(100), To: at(400)}},
+		},
+		{
+			name:     "empty and inverted ranges ignored",
+			coverage: Coverage{{From: at(100), To: at(200)}},
+			ranges:   []TimeRange{{From: at(300), To: at(300)}, {From: at(500), To: at(400)}},
+			expected: Coverage{{From: at(100), To: at(200)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.coverage.Add(tc.ranges...))
+		})
+	}
+}
+
+func TestCoverage_Gaps(t *testing.T) {
+	at := func(sec int64) time.Time { return time.Unix(sec, 0) }
+	coverage := Coverage{{From: at(100), To: at(200)}, {From: at(300), To: at(400)}}
+
+	t.Run("gaps before, between and after", func(t *testing.T) {
+		assert.Equal(t, []TimeRange{
+			{From: at(0), To: at(100)},
+			{From: at(200), To: at(300)},
+			{From: at(400), To: at(500)},
+		}, coverage.Gaps(at(0), at(500)))
+	})
+
+	t.Run("bounds inside ranges", func(t *testing.T) {
+		assert.Equal(t, []TimeRange{{From: at(200), To: at(300)}}, coverage.Gaps(at(150), at(350)))
+	})
+
+	t.Run("fully covered", func(t *testing.T) {
+		assert.Empty(t, coverage.Gaps(at(100), at(200)))
+	})
+
+	t.Run("no coverage", func(t *testing.T) {
+		assert.Equal(t, []TimeRange{{From: at(0), To: at(500)}}, Coverage(nil).Gaps(at(0), at(500)))
+	})
+}
+
+func TestCoverage_CoveredFrom(t *testing.T) {
+	at := func(sec int64) time.Time { return time.Unix(sec, 0) }
+	coverage := Coverage{{From: at(100), To: at(200)}, {From: at(300), To: at(400)}}
+
+	assert.Equal(t, at(300), coverage.CoveredFrom(at(350)))
+	assert.Equal(t, at(300), coverage.CoveredFrom(at(400)))
+	assert.Equal(t, at(250), coverage.CoveredFrom(at(250)))
+}
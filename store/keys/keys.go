@@ -0,0 +1,876 @@
+// Package keys builds and parses the partition and sort keys of the single DynamoDB table everything is stored in.
+// Every key the store writes comes from a builder here, so formats can't drift between the code writing a record and
+// the code querying for it, and anything walking the table (stream processors, backfills, repair tooling) can tell
+// what an item is with Classify rather than matching on prefixes of its own.
+//
+// Keys are # separated segments. Numbers are written unpadded unless noted, so range queries over them only work
+// between keys of the same length, which holds for the unix second timestamps most of them are.
+package keys
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Separator sits between the segments of a key
+const Separator = "#"
+
+// ErrMalformed is returned, wrapped, by the parsers when a key isn't of the form they parse
+var ErrMalformed = errors.New("malformed key")
+
+// Info is the sort key of a partition's own record: the driver, squad, WebSocket connection or login attempts the
+// partition is for.
+const Info = "info"
+
+// Partitions
+
+const (
+	DriverPrefix            = "driver#"
+	WebSocketPrefix         = "websocket#"
+	SessionPrefix           = "session#"
+	SquadPrefix             = "squad#"
+	WeeklyLeaderboardPrefix = "leaderboard#week#"
+	RankedLeaderboardPrefix = "leaderboard#"
+	RegionPrefix            = "region#"
+	BenchmarkPrefix         = "benchmark#series#"
+	LoginAttemptsPrefix     = "loginattempts#"
+	DriverSearchPrefix      = "driversearch#"
+	SeasonCardPrefix        = "seasoncard#"
+	OverlayPrefix           = "overlay#"
+
+	IngestionRuns  = "ingestion_runs"
+	RateBudget     = "rate_budget"
+	IngestionTiers = "ingestion_tiers"
+	Global         = "global"
+	Waitlist       = "waitlist"
+	Invitations    = "invitations"
+)
+
+// Driver is the partition holding everything belonging to a driver
+func Driver(driverID int64) string {
+	return DriverPrefix + formatInt(driverID)
+}
+
+func ParseDriver(pk string) (int64, error) {
+	return parseSingleInt(pk, DriverPrefix)
+}
+
+// WebSocket is the partition of a WebSocket connection, pointing back at the driver it belongs to
+func WebSocket(connectionID string) string {
+	return WebSocketPrefix + connectionID
+}
+
+func ParseWebSocket(pk string) (string, error) {
+	return parseSingleString(pk, WebSocketPrefix)
+}
+
+// Session is the partition holding the laps and archives of an iRacing subsession
+func Session(subsessionID int64) string {
+	return SessionPrefix + formatInt(subsessionID)
+}
+
+func ParseSession(pk string) (int64, error) {
+	return parseSingleInt(pk, SessionPrefix)
+}
+
+func Squad(squadID string) string {
+	return SquadPrefix + squadID
+}
+
+func ParseSquad(pk string) (string, error) {
+	return parseSingleString(pk, SquadPrefix)
+}
+
+// WeeklyLeaderboard is the partition of drivers' totals for the race week starting at weekStart (unix seconds)
+func WeeklyLeaderboard(weekStart int64) string {
+	return WeeklyLeaderboardPrefix + formatInt(weekStart)
+}
+
+func ParseWeeklyLeaderboard(pk string) (int64, error) {
+	return parseSingleInt(pk, WeeklyLeaderboardPrefix)
+}
+
+// RankedLeaderboard is the partition of a computed board for the race week starting at weekStart (unix seconds)
+func RankedLeaderboard(board string, weekStart int64) string {
+	return RankedLeaderboardPrefix + board + "#week#" + formatInt(weekStart)
+}
+
+func ParseRankedLeaderboard(pk string) (board string, weekStart int64, err error) {
+	rest, err := cut(pk, RankedLeaderboardPrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	i := strings.LastIndex(rest, "#week#")
+	if i <= 0 {
+		return "", 0, malformed(pk, "missing board or week")
+	}
+	weekStart, err = parseInt(pk, rest[i+len("#week#"):])
+	if err != nil {
+		return "", 0, err
+	}
+	return rest[:i], weekStart, nil
+}
+
+// Region is the partition of an iRacing club's weekly aggregates
+func Region(clubID int) string {
+	return RegionPrefix + strconv.Itoa(clubID)
+}
+
+func ParseRegion(pk string) (int, error) {
+	clubID, err := parseSingleInt(pk, RegionPrefix)
+	return int(clubID), err
+}
+
+// Benchmark is the partition of the benchmark tables of a series at a track
+func Benchmark(seriesID, trackID int64) string {
+	return BenchmarkPrefix + formatInt(seriesID) + "#track#" + formatInt(trackID)
+}
+
+func ParseBenchmark(pk string) (seriesID, trackID int64, err error) {
+	rest, err := cut(pk, BenchmarkPrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	series, track, ok := strings.Cut(rest, "#track#")
+	if !ok {
+		return 0, 0, malformed(pk, "missing track")
+	}
+	if seriesID, err = parseInt(pk, series); err != nil {
+		return 0, 0, err
+	}
+	if trackID, err = parseInt(pk, track); err != nil {
+		return 0, 0, err
+	}
+	return seriesID, trackID, nil
+}
+
+// LoginAttempts is the partition of failed sign in attempts held against attemptKey (ip#<address> or customer#<id>)
+func LoginAttempts(attemptKey string) string {
+	return LoginAttemptsPrefix + attemptKey
+}
+
+func ParseLoginAttempts(pk string) (string, error) {
+	return parseRemainder(pk, LoginAttemptsPrefix)
+}
+
+// DriverSearchBucketLength is how many leading characters of a driver's search name pick the driver search partition
+// it's indexed in, and so the shortest prefix drivers can be searched by
+const DriverSearchBucketLength = 2
+
+// DriverSearch is the partition indexing drivers whose search name starts as searchName does, searchName being a
+// driver name or a prefix of one, lower cased with runs of whitespace made single spaces and separators removed
+func DriverSearch(searchName string) string {
+	bucket := []rune(searchName)
+	if len(bucket) > DriverSearchBucketLength {
+		bucket = bucket[:DriverSearchBucketLength]
+	}
+	return DriverSearchPrefix + string(bucket)
+}
+
+func ParseDriverSearch(pk string) (string, error) {
+	return parseSingleString(pk, DriverSearchPrefix)
+}
+
+// SeasonCard is the partition of a shared season card, keyed by the token it is shared with
+func SeasonCard(token string) string {
+	return SeasonCardPrefix + token
+}
+
+func ParseSeasonCard(pk string) (string, error) {
+	return parseSingleString(pk, SeasonCardPrefix)
+}
+
+// Overlay is the partition of a driver's stream overlay, keyed by the token it is served with
+func Overlay(token string) string {
+	return OverlayPrefix + token
+}
+
+func ParseOverlay(pk string) (string, error) {
+	return parseSingleString(pk, OverlayPrefix)
+}
+
+// Sort keys in the driver partition
+
+const (
+	IngestionCoverage  = "ingestion_coverage"
+	IngestionLock      = "ingestion_lock"
+	IngestionCancel    = "ingestion_cancel"
+	DriverSettings     = "settings"
+	Supporter          = "supporter"
+	DriverPresence     = "presence"
+	PracticePlan       = "practiceplan"
+	JournalStreak      = "journalstreak"
+	WeeklyRecap        = "weeklyrecap"
+	DashboardLayout    = "dashboardlayout"
+	SeasonCardToken    = "seasoncard"
+	OverlayToken       = "overlay"
+	RequestCaptureMode = "requestcapture"
+
+	WSConnectionPrefix        = "ws#"
+	AuthSessionPrefix         = "authsession#"
+	PresenceViewerPrefix      = "presenceviewer#"
+	PresenceGrantPrefix       = "presencegrant#"
+	SquadMembershipPrefix     = "squad#"
+	DriverSessionPrefix       = "session#"
+	JournalEntryPrefix        = "journal#"
+	JournalAttachmentPrefix   = "journalattachment#"
+	JournalDraftPrefix        = "journaldraft#"
+	JournalPromptPrefix       = "journalprompt#"
+	ActionItemPrefix          = "actionitem#"
+	AlertRulePrefix           = "alertrule#"
+	RacePlanPrefix            = "raceplan#"
+	RaceBookmarkPrefix        = "bookmark#"
+	VideoLinkPrefix           = "videolink#"
+	RaceTelemetryPrefix       = "telemetry#"
+	ExternalLapPrefix         = "externallap#"
+	IRacingProxyRequestPrefix = "proxyrequest#"
+	CapturedRequestPrefix     = "capturedrequest#"
+	QuotaPrefix               = "quota#"
+	DriverStandingPrefix      = "standing#"
+	DriverRollupPrefix        = "rollup#"
+	DriverMilestonePrefix     = "milestone#"
+	TermsAcceptancePrefix     = "terms#"
+)
+
+// WSConnection is the driver's record of one of their WebSocket connections
+func WSConnection(connectionID string) string {
+	return WSConnectionPrefix + connectionID
+}
+
+func ParseWSConnection(sk string) (string, error) {
+	return parseSingleString(sk, WSConnectionPrefix)
+}
+
+func AuthSession(sessionID string) string {
+	return AuthSessionPrefix + sessionID
+}
+
+func ParseAuthSession(sk string) (string, error) {
+	return parseSingleString(sk, AuthSessionPrefix)
+}
+
+// PresenceViewer is a driver the partition's driver shares their presence with
+func PresenceViewer(viewerID int64) string {
+	return PresenceViewerPrefix + formatInt(viewerID)
+}
+
+func ParsePresenceViewer(sk string) (int64, error) {
+	return parseSingleInt(sk, PresenceViewerPrefix)
+}
+
+// PresenceGrant is a driver sharing their presence with the partition's driver
+func PresenceGrant(driverID int64) string {
+	return PresenceGrantPrefix + formatInt(driverID)
+}
+
+func ParsePresenceGrant(sk string) (int64, error) {
+	return parseSingleInt(sk, PresenceGrantPrefix)
+}
+
+// SquadMembership is the driver's side of their membership of a squad
+func SquadMembership(squadID string) string {
+	return SquadMembershipPrefix + squadID
+}
+
+func ParseSquadMembership(sk string) (string, error) {
+	return parseSingleString(sk, SquadMembershipPrefix)
+}
+
+// DriverSession is the driver's race starting at startTime (unix seconds)
+func DriverSession(startTime int64) string {
+	return DriverSessionPrefix + formatInt(startTime)
+}
+
+func ParseDriverSession(sk string) (int64, error) {
+	return parseSingleInt(sk, DriverSessionPrefix)
+}
+
+// JournalEntry is the journal entry of a race, races being identified by their start time
+func JournalEntry(raceID int64) string {
+	return JournalEntryPrefix + formatInt(raceID)
+}
+
+func ParseJournalEntry(sk string) (int64, error) {
+	return parseSingleInt(sk, JournalEntryPrefix)
+}
+
+func JournalAttachment(raceID int64, attachmentID string) string {
+	return JournalAttachmentRacePrefix(raceID) + attachmentID
+}
+
+// JournalAttachmentRacePrefix is the prefix of the attachments of a race's journal entry
+func JournalAttachmentRacePrefix(raceID int64) string {
+	return JournalAttachmentPrefix + formatInt(raceID) + Separator
+}
+
+func ParseJournalAttachment(sk string) (raceID int64, attachmentID string, err error) {
+	return parseIntString(sk, JournalAttachmentPrefix)
+}
+
+func JournalDraft(raceID int64) string {
+	return JournalDraftPrefix + formatInt(raceID)
+}
+
+func ParseJournalDraft(sk string) (int64, error) {
+	return parseSingleInt(sk, JournalDraftPrefix)
+}
+
+func JournalPrompt(raceID int64) string {
+	return JournalPromptPrefix + formatInt(raceID)
+}
+
+func ParseJournalPrompt(sk string) (int64, error) {
+	return parseSingleInt(sk, JournalPromptPrefix)
+}
+
+func ActionItem(itemID string) string {
+	return ActionItemPrefix + itemID
+}
+
+func ParseActionItem(sk string) (string, error) {
+	return parseSingleString(sk, ActionItemPrefix)
+}
+
+func AlertRule(ruleID string) string {
+	return AlertRulePrefix + ruleID
+}
+
+func ParseAlertRule(sk string) (string, error) {
+	return parseSingleString(sk, AlertRulePrefix)
+}
+
+// RacePlan is a driver's plan for racing a series at a track in the race week starting at weekStart
+func RacePlan(weekStart, seriesID, trackID int64) string {
+	return RacePlanPrefix + formatInt(weekStart) + Separator + formatInt(seriesID) + Separator + formatInt(trackID)
+}
+
+func ParseRacePlan(sk string) (weekStart, seriesID, trackID int64, err error) {
+	weekStart, rest, err := parseIntString(sk, RacePlanPrefix)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	series, track, ok := strings.Cut(rest, Separator)
+	if !ok {
+		return 0, 0, 0, malformed(sk, "missing track")
+	}
+	if seriesID, err = parseInt(sk, series); err != nil {
+		return 0, 0, 0, err
+	}
+	if trackID, err = parseInt(sk, track); err != nil {
+		return 0, 0, 0, err
+	}
+	return weekStart, seriesID, trackID, nil
+}
+
+func RaceBookmark(raceID int64, bookmarkID string) string {
+	return RaceBookmarkRacePrefix(raceID) + bookmarkID
+}
+
+// RaceBookmarkRacePrefix is the prefix of the bookmarks of a race
+func RaceBookmarkRacePrefix(raceID int64) string {
+	return RaceBookmarkPrefix + formatInt(raceID) + Separator
+}
+
+func ParseRaceBookmark(sk string) (raceID int64, bookmarkID string, err error) {
+	return parseIntString(sk, RaceBookmarkPrefix)
+}
+
+func VideoLink(raceID int64, linkID string) string {
+	return VideoLinkRacePrefix(raceID) + linkID
+}
+
+// VideoLinkRacePrefix is the prefix of the video links of a race
+func VideoLinkRacePrefix(raceID int64) string {
+	return VideoLinkPrefix + formatInt(raceID) + Separator
+}
+
+func ParseVideoLink(sk string) (raceID int64, linkID string, err error) {
+	return parseIntString(sk, VideoLinkPrefix)
+}
+
+func RaceTelemetry(raceID int64) string {
+	return RaceTelemetryPrefix + formatInt(raceID)
+}
+
+func ParseRaceTelemetry(sk string) (int64, error) {
+	return parseSingleInt(sk, RaceTelemetryPrefix)
+}
+
+// ExternalLap is a lap imported from a third party service, ordered by the start (unix seconds) of the session it was
+// driven in. Lap numbers are padded to four digits so a session's laps sort in order.
+func ExternalLap(sessionStart int64, source, sessionID string, lapNumber int) string {
+	return fmt.Sprintf("%s%d#%s#%s#%04d", ExternalLapPrefix, sessionStart, source, sessionID, lapNumber)
+}
+
+// ExternalLapsFrom is the lowest sort key of the external laps of sessions starting at or after sessionStart
+func ExternalLapsFrom(sessionStart int64) string {
+	return ExternalLapPrefix + formatInt(sessionStart)
+}
+
+func ParseExternalLap(sk string) (sessionStart int64, source, sessionID string, lapNumber int, err error) {
+	rest, err := cut(sk, ExternalLapPrefix)
+	if err != nil {
+		return 0, "", "", 0, err
+	}
+	start, rest, ok := strings.Cut(rest, Separator)
+	if !ok {
+		return 0, "", "", 0, malformed(sk, "missing source")
+	}
+	source, rest, ok = strings.Cut(rest, Separator)
+	if !ok || source == "" {
+		return 0, "", "", 0, malformed(sk, "missing session ID")
+	}
+	i := strings.LastIndex(rest, Separator)
+	if i <= 0 {
+		return 0, "", "", 0, malformed(sk, "missing lap number")
+	}
+	if sessionStart, err = parseInt(sk, start); err != nil {
+		return 0, "", "", 0, err
+	}
+	lap, err := parseInt(sk, rest[i+1:])
+	if err != nil {
+		return 0, "", "", 0, err
+	}
+	return sessionStart, source, rest[:i], int(lap), nil
+}
+
+// IRacingProxyRequest is a request a developer made through the iRacing proxy at requestedAt (unix millis)
+func IRacingProxyRequest(requestedAt int64) string {
+	return IRacingProxyRequestPrefix + formatInt(requestedAt)
+}
+
+func ParseIRacingProxyRequest(sk string) (int64, error) {
+	return parseSingleInt(sk, IRacingProxyRequestPrefix)
+}
+
+// CapturedRequest is a request captured at requestedAt (unix millis, padded to 13 digits so captures sort in order)
+func CapturedRequest(requestedAt int64, requestID string) string {
+	return CapturedRequestsFrom(requestedAt) + Separator + requestID
+}
+
+// CapturedRequestsFrom is the lowest sort key of the requests captured at or after requestedAt (unix millis)
+func CapturedRequestsFrom(requestedAt int64) string {
+	return fmt.Sprintf("%s%013d", CapturedRequestPrefix, requestedAt)
+}
+
+func ParseCapturedRequest(sk string) (requestedAt int64, requestID string, err error) {
+	return parseIntString(sk, CapturedRequestPrefix)
+}
+
+// Quota is the driver's use of quota limited operations during the UTC day starting at dayStart (unix seconds)
+func Quota(dayStart int64) string {
+	return QuotaPrefix + formatInt(dayStart)
+}
+
+func ParseQuota(sk string) (int64, error) {
+	return parseSingleInt(sk, QuotaPrefix)
+}
+
+// DriverStanding is the driver's division standing for the race week starting at weekStart (unix seconds)
+func DriverStanding(weekStart int64) string {
+	return DriverStandingPrefix + formatInt(weekStart)
+}
+
+func ParseDriverStanding(sk string) (int64, error) {
+	return parseSingleInt(sk, DriverStandingPrefix)
+}
+
+// DriverRollup is the driver's totals over a scope, which may itself hold separators (season#<season_id>)
+func DriverRollup(scope string) string {
+	return DriverRollupPrefix + scope
+}
+
+func ParseDriverRollup(sk string) (string, error) {
+	return parseRemainder(sk, DriverRollupPrefix)
+}
+
+func DriverMilestone(milestone string) string {
+	return DriverMilestonePrefix + milestone
+}
+
+func ParseDriverMilestone(sk string) (string, error) {
+	return parseSingleString(sk, DriverMilestonePrefix)
+}
+
+// TermsAcceptance is the driver's acceptance of a version of a legal document such as the terms of service. The
+// document can't hold separators, the version takes whatever follows it.
+func TermsAcceptance(document, version string) string {
+	return TermsAcceptancePrefix + document + Separator + version
+}
+
+func ParseTermsAcceptance(sk string) (document, version string, err error) {
+	rest, err := cut(sk, TermsAcceptancePrefix)
+	if err != nil {
+		return "", "", err
+	}
+	document, version, ok := strings.Cut(rest, Separator)
+	if !ok || document == "" || version == "" {
+		return "", "", malformed(sk, "expected a document and version")
+	}
+	return document, version, nil
+}
+
+// Sort keys in the session partition
+
+const (
+	SessionResultsArchive = "archive#results"
+
+	SessionDriverLapPrefix        = "laps#driver#"
+	SessionDriverLapSummaryPrefix = "lapsummary#driver#"
+	SessionIngestMarkerPrefix     = "ingest#driver#"
+	SessionArchivePrefix          = "archive#"
+	SessionLapsArchivePrefix      = "archive#laps#driver#"
+	SessionSquadEventPrefix       = "squadevent#"
+)
+
+// SessionDriverLap is a lap a driver drove in the session. Lap numbers aren't padded, so lap 10 sorts before lap 2.
+func SessionDriverLap(driverID int64, lapNumber int) string {
+	return SessionDriverLapDriverPrefix(driverID) + "lap#" + strconv.Itoa(lapNumber)
+}
+
+// SessionDriverLapDriverPrefix is the prefix of a driver's laps in the session
+func SessionDriverLapDriverPrefix(driverID int64) string {
+	return SessionDriverLapPrefix + formatInt(driverID) + Separator
+}
+
+func ParseSessionDriverLap(sk string) (driverID int64, lapNumber int, err error) {
+	rest, err := cut(sk, SessionDriverLapPrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	driver, lap, ok := strings.Cut(rest, "#lap#")
+	if !ok {
+		return 0, 0, malformed(sk, "missing lap number")
+	}
+	if driverID, err = parseInt(sk, driver); err != nil {
+		return 0, 0, err
+	}
+	n, err := parseInt(sk, lap)
+	if err != nil {
+		return 0, 0, err
+	}
+	return driverID, int(n), nil
+}
+
+// SessionDriverLapSummary is a driver's laps in the session rolled up once they were compacted
+func SessionDriverLapSummary(driverID int64) string {
+	return SessionDriverLapSummaryPrefix + formatInt(driverID)
+}
+
+func ParseSessionDriverLapSummary(sk string) (int64, error) {
+	return parseSingleInt(sk, SessionDriverLapSummaryPrefix)
+}
+
+// SessionIngestMarker is the progress of writing a driver's session and laps
+func SessionIngestMarker(driverID int64) string {
+	return SessionIngestMarkerPrefix + formatInt(driverID)
+}
+
+func ParseSessionIngestMarker(sk string) (int64, error) {
+	return parseSingleInt(sk, SessionIngestMarkerPrefix)
+}
+
+// SessionLapsArchive points at the archived lap data fetched for a driver
+func SessionLapsArchive(driverID int64) string {
+	return SessionLapsArchivePrefix + formatInt(driverID)
+}
+
+func ParseSessionLapsArchive(sk string) (int64, error) {
+	return parseSingleInt(sk, SessionLapsArchivePrefix)
+}
+
+// SessionSquadEvent is the session's side of a squad tagging it as an event
+func SessionSquadEvent(squadID string) string {
+	return SessionSquadEventPrefix + squadID
+}
+
+func ParseSessionSquadEvent(sk string) (string, error) {
+	return parseSingleString(sk, SessionSquadEventPrefix)
+}
+
+// RaceOrder is the race order index's sort key of a lap, ordering laps by when they were completed (session time
+// padded to ten digits), then driver and lap number to break ties.
+func RaceOrder(sessionTime int, driverID int64, lapNumber int) string {
+	return fmt.Sprintf("%010d#%d#%04d", sessionTime, driverID, lapNumber)
+}
+
+func ParseRaceOrder(key string) (sessionTime int, driverID int64, lapNumber int, err error) {
+	parts := strings.Split(key, Separator)
+	if len(parts) != 3 {
+		return 0, 0, 0, malformed(key, "expected session time, driver and lap")
+	}
+	values := make([]int64, len(parts))
+	for i, part := range parts {
+		if values[i], err = parseInt(key, part); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	return int(values[0]), values[1], int(values[2]), nil
+}
+
+// Sort keys in the squad partition
+
+const (
+	SquadMemberPrefix      = "member#"
+	SquadEventPrefix       = "event#"
+	SquadEventResultPrefix = "eventresult#"
+)
+
+func SquadMember(driverID int64) string {
+	return SquadMemberPrefix + formatInt(driverID)
+}
+
+func ParseSquadMember(sk string) (int64, error) {
+	return parseSingleInt(sk, SquadMemberPrefix)
+}
+
+func SquadEvent(subsessionID int64) string {
+	return SquadEventPrefix + formatInt(subsessionID)
+}
+
+func ParseSquadEvent(sk string) (int64, error) {
+	return parseSingleInt(sk, SquadEventPrefix)
+}
+
+// SquadEventResult is a member's finish in a squad event
+func SquadEventResult(subsessionID, driverID int64) string {
+	return SquadEventResultEventPrefix(subsessionID) + formatInt(driverID)
+}
+
+// SquadEventResultEventPrefix is the prefix of the results of a squad event
+func SquadEventResultEventPrefix(subsessionID int64) string {
+	return SquadEventResultPrefix + formatInt(subsessionID) + Separator
+}
+
+func ParseSquadEventResult(sk string) (subsessionID, driverID int64, err error) {
+	return parseIntInt(sk, SquadEventResultPrefix)
+}
+
+// Sort keys in the remaining partitions
+
+const (
+	RankedLeaderboardBoard = "board"
+	GlobalCounters         = "counters"
+	UpstreamStatus         = "upstream_status"
+
+	IngestionRunPrefix      = "run#"
+	LeaderboardDriverPrefix = "driver#"
+	LeaderboardRankPrefix   = "rank#"
+	RegionWeekPrefix        = "week#"
+	BenchmarkBandPrefix     = "irating#"
+	RateBudgetWindowPrefix  = "window#"
+	IngestionTierPrefix     = "tier#"
+	BackfillProgressPrefix  = "backfill#"
+	DriverSearchNamePrefix  = "name#"
+	WaitlistDriverPrefix    = "driver#"
+	InvitationDriverPrefix  = "driver#"
+	SeasonCardFetchesPrefix = "fetches#"
+)
+
+// IngestionRun is an ingestion round for a driver started at startedAt (unix millis), in the ingestion runs partition
+func IngestionRun(startedAt, driverID int64) string {
+	return IngestionRunPrefix + formatInt(startedAt) + Separator + formatInt(driverID)
+}
+
+func ParseIngestionRun(sk string) (startedAt, driverID int64, err error) {
+	return parseIntInt(sk, IngestionRunPrefix)
+}
+
+// LeaderboardDriver is a driver's totals in a weekly leaderboard partition
+func LeaderboardDriver(driverID int64) string {
+	return LeaderboardDriverPrefix + formatInt(driverID)
+}
+
+func ParseLeaderboardDriver(sk string) (int64, error) {
+	return parseSingleInt(sk, LeaderboardDriverPrefix)
+}
+
+// LeaderboardRank is a place on a ranked leaderboard, padded to six digits so places sort in order
+func LeaderboardRank(rank int) string {
+	return fmt.Sprintf("%s%06d", LeaderboardRankPrefix, rank)
+}
+
+func ParseLeaderboardRank(sk string) (int, error) {
+	rank, err := parseSingleInt(sk, LeaderboardRankPrefix)
+	return int(rank), err
+}
+
+// RegionWeek is a region's totals for the race week starting at weekStart (unix seconds)
+func RegionWeek(weekStart int64) string {
+	return RegionWeekPrefix + formatInt(weekStart)
+}
+
+func ParseRegionWeek(sk string) (int64, error) {
+	return parseSingleInt(sk, RegionWeekPrefix)
+}
+
+// BenchmarkBand is the benchmark table of an iRating band, padded to five digits so bands sort in order
+func BenchmarkBand(iRatingBand int) string {
+	return fmt.Sprintf("%s%05d", BenchmarkBandPrefix, iRatingBand)
+}
+
+func ParseBenchmarkBand(sk string) (int, error) {
+	band, err := parseSingleInt(sk, BenchmarkBandPrefix)
+	return int(band), err
+}
+
+// RateBudgetWindow is the iRacing requests reserved during the minute starting at windowStart (unix seconds)
+func RateBudgetWindow(windowStart int64) string {
+	return RateBudgetWindowPrefix + formatInt(windowStart)
+}
+
+func ParseRateBudgetWindow(sk string) (int64, error) {
+	return parseSingleInt(sk, RateBudgetWindowPrefix)
+}
+
+func IngestionTier(name string) string {
+	return IngestionTierPrefix + name
+}
+
+func ParseIngestionTier(sk string) (string, error) {
+	return parseSingleString(sk, IngestionTierPrefix)
+}
+
+func BackfillProgress(name string) string {
+	return BackfillProgressPrefix + name
+}
+
+func ParseBackfillProgress(sk string) (string, error) {
+	return parseRemainder(sk, BackfillProgressPrefix)
+}
+
+// DriverSearchName is a driver's entry in a driver search partition, ranged by their search name so a prefix of it
+// finds them
+func DriverSearchName(searchName string, driverID int64) string {
+	return DriverSearchNamePrefix + searchName + Separator + formatInt(driverID)
+}
+
+func ParseDriverSearchName(sk string) (searchName string, driverID int64, err error) {
+	rest, err := cut(sk, DriverSearchNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	i := strings.LastIndex(rest, Separator)
+	if i <= 0 {
+		return "", 0, malformed(sk, "missing name or driver")
+	}
+	driverID, err = parseInt(sk, rest[i+1:])
+	if err != nil {
+		return "", 0, err
+	}
+	return rest[:i], driverID, nil
+}
+
+func formatInt(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+func malformed(key, reason string) error {
+	return fmt.Errorf("%w %q: %s", ErrMalformed, key, reason)
+}
+
+func cut(key, prefix string) (string, error) {
+	rest, ok := strings.CutPrefix(key, prefix)
+	if !ok {
+		return "", malformed(key, "expected prefix "+prefix)
+	}
+	return rest, nil
+}
+
+func parseInt(key, value string) (int64, error) {
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, malformed(key, fmt.Sprintf("%q isn't a number", value))
+	}
+	return v, nil
+}
+
+func parseSingleInt(key, prefix string) (int64, error) {
+	rest, err := cut(key, prefix)
+	if err != nil {
+		return 0, err
+	}
+	return parseInt(key, rest)
+}
+
+// parseSingleString reads a key of prefix and an ID, which can't be empty or hold separators
+func parseSingleString(key, prefix string) (string, error) {
+	rest, err := parseRemainder(key, prefix)
+	if err != nil {
+		return "", err
+	}
+	if strings.Contains(rest, Separator) {
+		return "", malformed(key, "unexpected separator")
+	}
+	return rest, nil
+}
+
+// parseRemainder reads everything after prefix, separators and all
+func parseRemainder(key, prefix string) (string, error) {
+	rest, err := cut(key, prefix)
+	if err != nil {
+		return "", err
+	}
+	if rest == "" {
+		return "", malformed(key, "nothing after "+prefix)
+	}
+	return rest, nil
+}
+
+// parseIntString reads a key of prefix, a number and a string which takes whatever follows the number
+func parseIntString(key, prefix string) (int64, string, error) {
+	rest, err := cut(key, prefix)
+	if err != nil {
+		return 0, "", err
+	}
+	num, str, ok := strings.Cut(rest, Separator)
+	if !ok || str == "" {
+		return 0, "", malformed(key, "expected a number and ID")
+	}
+	n, err := parseInt(key, num)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, str, nil
+}
+
+func parseIntInt(key, prefix string) (int64, int64, error) {
+	first, second, err := parseIntString(key, prefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	n, err := parseInt(key, second)
+	if err != nil {
+		return 0, 0, err
+	}
+	return first, n, nil
+}
+
+// WaitlistDriver is a driver waiting to be let in, in the waitlist partition
+func WaitlistDriver(driverID int64) string {
+	return WaitlistDriverPrefix + formatInt(driverID)
+}
+
+func ParseWaitlistDriver(sk string) (int64, error) {
+	return parseSingleInt(sk, WaitlistDriverPrefix)
+}
+
+// InvitationDriver is a driver's invitation, in the invitations partition
+func InvitationDriver(driverID int64) string {
+	return InvitationDriverPrefix + formatInt(driverID)
+}
+
+func ParseInvitationDriver(sk string) (int64, error) {
+	return parseSingleInt(sk, InvitationDriverPrefix)
+}
+
+// SeasonCardFetches is the public fetches of a season card during the minute starting at windowStart (unix seconds)
+func SeasonCardFetches(windowStart int64) string {
+	return SeasonCardFetchesPrefix + formatInt(windowStart)
+}
+
+func ParseSeasonCardFetches(sk string) (int64, error) {
+	return parseSingleInt(sk, SeasonCardFetchesPrefix)
+}
@@ -0,0 +1,266 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name        string
+		key         string
+		expectedKey string
+		parse       func(key string) ([]any, error)
+		expected    []any
+	}{
+		{"driver", Driver(12345), "driver#12345", one(ParseDriver), []any{int64(12345)}},
+		{"websocket", WebSocket("abc="), "websocket#abc=", one(ParseWebSocket), []any{"abc="}},
+		{"session", Session(9001), "session#9001", one(ParseSession), []any{int64(9001)}},
+		{"squad", Squad("sq1"), "squad#sq1", one(ParseSquad), []any{"sq1"}},
+		{"weekly leaderboard", WeeklyLeaderboard(1700000000), "leaderboard#week#1700000000", one(ParseWeeklyLeaderboard), []any{int64(1700000000)}},
+		{"ranked leaderboard", RankedLeaderboard("irating_gain", 1700000000), "leaderboard#irating_gain#week#1700000000", two(ParseRankedLeaderboard), []any{"irating_gain", int64(1700000000)}},
+		{"ranked leaderboard of a board named week", RankedLeaderboard("week", 1700000000), "leaderboard#week#week#1700000000", two(ParseRankedLeaderboard), []any{"week", int64(1700000000)}},
+		{"region", Region(42), "region#42", one(ParseRegion), []any{42}},
+		{"benchmark", Benchmark(123, 456), "benchmark#series#123#track#456", two(ParseBenchmark), []any{int64(123), int64(456)}},
+		{"login attempts", LoginAttempts("ip#10.0.0.1"), "loginattempts#ip#10.0.0.1", one(ParseLoginAttempts), []any{"ip#10.0.0.1"}},
+		{"driver search", DriverSearch("jon sabados"), "driversearch#jo", one(ParseDriverSearch), []any{"jo"}},
+		{"driver search of a short name", DriverSearch("j"), "driversearch#j", one(ParseDriverSearch), []any{"j"}},
+		{"season card", SeasonCard("tok_1-2"), "seasoncard#tok_1-2", one(ParseSeasonCard), []any{"tok_1-2"}},
+		{"overlay", Overlay("tok_1-2"), "overlay#tok_1-2", one(ParseOverlay), []any{"tok_1-2"}},
+		{"driver search of a non ascii name", DriverSearch("émile"), "driversearch#ém", one(ParseDriverSearch), []any{"ém"}},
+		{"ws connection", WSConnection("conn1"), "ws#conn1", one(ParseWSConnection), []any{"conn1"}},
+		{"auth session", AuthSession("sid"), "authsession#sid", one(ParseAuthSession), []any{"sid"}},
+		{"presence viewer", PresenceViewer(7), "presenceviewer#7", one(ParsePresenceViewer), []any{int64(7)}},
+		{"presence grant", PresenceGrant(8), "presencegrant#8", one(ParsePresenceGrant), []any{int64(8)}},
+		{"squad membership", SquadMembership("sq1"), "squad#sq1", one(ParseSquadMembership), []any{"sq1"}},
+		{"driver session", DriverSession(1700000000), "session#1700000000", one(ParseDriverSession), []any{int64(1700000000)}},
+		{"journal entry", JournalEntry(1700000000), "journal#1700000000", one(ParseJournalEntry), []any{int64(1700000000)}},
+		{"journal attachment", JournalAttachment(1700000000, "att1"), "journalattachment#1700000000#att1", two(ParseJournalAttachment), []any{int64(1700000000), "att1"}},
+		{"journal draft", JournalDraft(1700000000), "journaldraft#1700000000", one(ParseJournalDraft), []any{int64(1700000000)}},
+		{"journal prompt", JournalPrompt(1700000000), "journalprompt#1700000000", one(ParseJournalPrompt), []any{int64(1700000000)}},
+		{"action item", ActionItem("item1"), "actionitem#item1", one(ParseActionItem), []any{"item1"}},
+		{"alert rule", AlertRule("rule1"), "alertrule#rule1", one(ParseAlertRule), []any{"rule1"}},
+		{"race plan", RacePlan(1700000000, 123, 45), "raceplan#1700000000#123#45", func(key string) ([]any, error) {
+			weekStart, seriesID, trackID, err := ParseRacePlan(key)
+			return []any{weekStart, seriesID, trackID}, err
+		}, []any{int64(1700000000), int64(123), int64(45)}},
+		{"race bookmark", RaceBookmark(1700000000, "bm1"), "bookmark#1700000000#bm1", two(ParseRaceBookmark), []any{int64(1700000000), "bm1"}},
+		{"video link", VideoLink(1700000000, "vl1"), "videolink#1700000000#vl1", two(ParseVideoLink), []any{int64(1700000000), "vl1"}},
+		{"race telemetry", RaceTelemetry(1700000000), "telemetry#1700000000", one(ParseRaceTelemetry), []any{int64(1700000000)}},
+		{"external lap", ExternalLap(1700000000, "garage61", "abc", 7), "externallap#1700000000#garage61#abc#0007", func(key string) ([]any, error) {
+			start, source, sessionID, lap, err := ParseExternalLap(key)
+			return []any{start, source, sessionID, lap}, err
+		}, []any{int64(1700000000), "garage61", "abc", 7}},
+		{"iracing proxy request", IRacingProxyRequest(1700000000123), "proxyrequest#1700000000123", one(ParseIRacingProxyRequest), []any{int64(1700000000123)}},
+		{"captured request", CapturedRequest(123, "req1"), "capturedrequest#0000000000123#req1", two(ParseCapturedRequest), []any{int64(123), "req1"}},
+		{"quota", Quota(1700000000), "quota#1700000000", one(ParseQuota), []any{int64(1700000000)}},
+		{"driver standing", DriverStanding(1700000000), "standing#1700000000", one(ParseDriverStanding), []any{int64(1700000000)}},
+		{"driver rollup", DriverRollup("season#5000"), "rollup#season#5000", one(ParseDriverRollup), []any{"season#5000"}},
+		{"driver milestone", DriverMilestone("first_win"), "milestone#first_win", one(ParseDriverMilestone), []any{"first_win"}},
+		{"terms acceptance", TermsAcceptance("tos", "2025-01-01"), "terms#tos#2025-01-01", two(ParseTermsAcceptance), []any{"tos", "2025-01-01"}},
+		{"session driver lap", SessionDriverLap(12345, 10), "laps#driver#12345#lap#10", two(ParseSessionDriverLap), []any{int64(12345), 10}},
+		{"session driver lap summary", SessionDriverLapSummary(12345), "lapsummary#driver#12345", one(ParseSessionDriverLapSummary), []any{int64(12345)}},
+		{"session ingest marker", SessionIngestMarker(12345), "ingest#driver#12345", one(ParseSessionIngestMarker), []any{int64(12345)}},
+		{"session laps archive", SessionLapsArchive(12345), "archive#laps#driver#12345", one(ParseSessionLapsArchive), []any{int64(12345)}},
+		{"session squad event", SessionSquadEvent("sq1"), "squadevent#sq1", one(ParseSessionSquadEvent), []any{"sq1"}},
+		{"race order", RaceOrder(3605, 12345, 12), "0000003605#12345#0012", func(key string) ([]any, error) {
+			sessionTime, driverID, lap, err := ParseRaceOrder(key)
+			return []any{sessionTime, driverID, lap}, err
+		}, []any{3605, int64(12345), 12}},
+		{"squad member", SquadMember(12345), "member#12345", one(ParseSquadMember), []any{int64(12345)}},
+		{"squad event", SquadEvent(9001), "event#9001", one(ParseSquadEvent), []any{int64(9001)}},
+		{"squad event result", SquadEventResult(9001, 12345), "eventresult#9001#12345", two(ParseSquadEventResult), []any{int64(9001), int64(12345)}},
+		{"ingestion run", IngestionRun(1700000000123, 12345), "run#1700000000123#12345", two(ParseIngestionRun), []any{int64(1700000000123), int64(12345)}},
+		{"leaderboard driver", LeaderboardDriver(12345), "driver#12345", one(ParseLeaderboardDriver), []any{int64(12345)}},
+		{"leaderboard rank", LeaderboardRank(3), "rank#000003", one(ParseLeaderboardRank), []any{3}},
+		{"region week", RegionWeek(1700000000), "week#1700000000", one(ParseRegionWeek), []any{int64(1700000000)}},
+		{"benchmark band", BenchmarkBand(2500), "irating#02500", one(ParseBenchmarkBand), []any{2500}},
+		{"rate budget window", RateBudgetWindow(1700000040), "window#1700000040", one(ParseRateBudgetWindow), []any{int64(1700000040)}},
+		{"ingestion tier", IngestionTier("default"), "tier#default", one(ParseIngestionTier), []any{"default"}},
+		{"backfill progress", BackfillProgress("race_order"), "backfill#race_order", one(ParseBackfillProgress), []any{"race_order"}},
+		{"driver search name", DriverSearchName("jon sabados", 12345), "name#jon sabados#12345", two(ParseDriverSearchName), []any{"jon sabados", int64(12345)}},
+		{"waitlist driver", WaitlistDriver(12345), "driver#12345", one(ParseWaitlistDriver), []any{int64(12345)}},
+		{"invitation driver", InvitationDriver(12345), "driver#12345", one(ParseInvitationDriver), []any{int64(12345)}},
+		{"season card fetches", SeasonCardFetches(1700000040), "fetches#1700000040", one(ParseSeasonCardFetches), []any{int64(1700000040)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedKey, tc.key)
+			actual, err := tc.parse(tc.key)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestPrefixes(t *testing.T) {
+	assert.Equal(t, "journalattachment#1700000000#", JournalAttachmentRacePrefix(1700000000))
+	assert.Equal(t, "bookmark#1700000000#", RaceBookmarkRacePrefix(1700000000))
+	assert.Equal(t, "videolink#1700000000#", VideoLinkRacePrefix(1700000000))
+	assert.Equal(t, "laps#driver#12345#", SessionDriverLapDriverPrefix(12345))
+	assert.Equal(t, "eventresult#9001#", SquadEventResultEventPrefix(9001))
+	assert.Equal(t, "externallap#1700000000", ExternalLapsFrom(1700000000))
+	assert.Equal(t, "capturedrequest#0000000000123", CapturedRequestsFrom(123))
+}
+
+func TestParse_Malformed(t *testing.T) {
+	testCases := []struct {
+		name  string
+		parse func() error
+	}{
+		{"wrong prefix", func() error { _, err := ParseDriver("session#123"); return err }},
+		{"not a number", func() error { _, err := ParseDriver("driver#abc"); return err }},
+		{"nothing after the prefix", func() error { _, err := ParseWSConnection("ws#"); return err }},
+		{"unexpected separator", func() error { _, err := ParseSquad("squad#a#b"); return err }},
+		{"missing second segment", func() error { _, _, err := ParseRaceBookmark("bookmark#1700000000"); return err }},
+		{"missing track", func() error { _, _, err := ParseBenchmark("benchmark#series#123"); return err }},
+		{"missing lap number", func() error { _, _, err := ParseSessionDriverLap("laps#driver#12345"); return err }},
+		{"external lap missing session", func() error { _, _, _, _, err := ParseExternalLap("externallap#1700000000#garage61"); return err }},
+		{"race plan missing track", func() error { _, _, _, err := ParseRacePlan("raceplan#1700000000#123"); return err }},
+		{"race order missing lap", func() error { _, _, _, err := ParseRaceOrder("0000003605#12345"); return err }},
+		{"driver search name missing driver", func() error { _, _, err := ParseDriverSearchName("name#jon sabados"); return err }},
+		{"ranked leaderboard missing week", func() error { _, _, err := ParseRankedLeaderboard("leaderboard#irating_gain"); return err }},
+		{"terms acceptance missing version", func() error { _, _, err := ParseTermsAcceptance("terms#tos"); return err }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.parse(), ErrMalformed)
+		})
+	}
+}
+
+func TestClassify(t *testing.T) {
+	testCases := []struct {
+		pk       string
+		sk       string
+		expected RecordType
+	}{
+		{Driver(1), Info, RecordDriver},
+		{Driver(1), WSConnection("c"), RecordWSConnection},
+		{Driver(1), IngestionLock, RecordIngestionLock},
+		{Driver(1), IngestionCancel, RecordIngestionCancel},
+		{Driver(1), IngestionCoverage, RecordIngestionCoverage},
+		{Driver(1), DriverSettings, RecordDriverSettings},
+		{Driver(1), Supporter, RecordSupporter},
+		{Driver(1), Quota(1700000000), RecordQuota},
+		{Driver(1), DriverSession(1700000000), RecordDriverSession},
+		{Driver(1), JournalEntry(1700000000), RecordJournalEntry},
+		{Driver(1), JournalDraft(1700000000), RecordJournalDraft},
+		{Driver(1), JournalPrompt(1700000000), RecordJournalPrompt},
+		{Driver(1), JournalAttachment(1700000000, "a"), RecordJournalAttachment},
+		{Driver(1), JournalStreak, RecordJournalStreak},
+		{Driver(1), DriverStanding(1700000000), RecordDriverStanding},
+		{Driver(1), DriverRollup("season#5000"), RecordDriverRollup},
+		{Driver(1), DriverMilestone("first_win"), RecordDriverMilestone},
+		{Driver(1), TermsAcceptance("tos", "2025-01-01"), RecordTermsAcceptance},
+		{Driver(1), ActionItem("i"), RecordActionItem},
+		{Driver(1), AlertRule("r"), RecordAlertRule},
+		{Driver(1), RacePlan(1700000000, 123, 45), RecordRacePlan},
+		{Driver(1), IRacingProxyRequest(1700000000123), RecordIRacingProxyRequest},
+		{Driver(1), RequestCaptureMode, RecordRequestCaptureMode},
+		{Driver(1), CapturedRequest(1700000000123, "r"), RecordCapturedRequest},
+		{Driver(1), RaceBookmark(1700000000, "b"), RecordRaceBookmark},
+		{Driver(1), VideoLink(1700000000, "v"), RecordVideoLink},
+		{Driver(1), RaceTelemetry(1700000000), RecordRaceTelemetry},
+		{Driver(1), ExternalLap(1700000000, "garage61", "s", 1), RecordExternalLap},
+		{Driver(1), DriverPresence, RecordDriverPresence},
+		{Driver(1), PresenceViewer(2), RecordPresenceViewer},
+		{Driver(1), PresenceGrant(2), RecordPresenceGrant},
+		{Driver(1), AuthSession("s"), RecordAuthSession},
+		{Driver(1), SquadMembership("sq"), RecordSquadMembership},
+		{Driver(1), PracticePlan, RecordPracticePlan},
+		{Driver(1), WeeklyRecap, RecordWeeklyRecap},
+		{Driver(1), DashboardLayout, RecordDashboardLayout},
+		{Driver(1), SeasonCardToken, RecordSeasonCardToken},
+		{Driver(1), OverlayToken, RecordOverlayToken},
+		{WebSocket("c"), Info, RecordWebSocket},
+		{Session(1), SessionDriverLap(1, 1), RecordSessionDriverLap},
+		{Session(1), SessionDriverLapSummary(1), RecordSessionDriverLapSummary},
+		{Session(1), SessionIngestMarker(1), RecordSessionIngestMarker},
+		{Session(1), SessionResultsArchive, RecordSessionResultsArchive},
+		{Session(1), SessionLapsArchive(1), RecordSessionLapsArchive},
+		{Session(1), SessionSquadEvent("sq"), RecordSessionSquadEvent},
+		{Squad("sq"), Info, RecordSquad},
+		{Squad("sq"), SquadMember(1), RecordSquadMember},
+		{Squad("sq"), SquadEvent(1), RecordSquadEvent},
+		{Squad("sq"), SquadEventResult(1, 2), RecordSquadEventResult},
+		{IngestionRuns, IngestionRun(1700000000123, 1), RecordIngestionRun},
+		{WeeklyLeaderboard(1700000000), LeaderboardDriver(1), RecordLeaderboardDriver},
+		{RankedLeaderboard("irating_gain", 1700000000), RankedLeaderboardBoard, RecordRankedLeaderboard},
+		{RankedLeaderboard("irating_gain", 1700000000), LeaderboardRank(1), RecordLeaderboardRank},
+		{Region(1), RegionWeek(1700000000), RecordRegionWeek},
+		{Benchmark(1, 2), BenchmarkBand(2500), RecordBenchmarkBand},
+		{RateBudget, RateBudgetWindow(1700000040), RecordRateBudgetWindow},
+		{LoginAttempts("customer#1"), Info, RecordLoginAttempts},
+		{IngestionTiers, IngestionTier("default"), RecordIngestionTier},
+		{Global, GlobalCounters, RecordGlobalCounters},
+		{Global, UpstreamStatus, RecordUpstreamStatus},
+		{Global, BackfillProgress("race_order"), RecordBackfillProgress},
+		{DriverSearch("jon sabados"), DriverSearchName("jon sabados", 1), RecordDriverSearch},
+		{Waitlist, WaitlistDriver(1), RecordWaitlistEntry},
+		{Invitations, InvitationDriver(1), RecordInvitation},
+		{SeasonCard("t"), Info, RecordSeasonCard},
+		{SeasonCard("t"), SeasonCardFetches(1700000040), RecordSeasonCardFetches},
+		{Overlay("t"), Info, RecordOverlay},
+	}
+
+	covered := map[RecordType]bool{}
+	for _, tc := range testCases {
+		t.Run(string(tc.expected), func(t *testing.T) {
+			actual, ok := Classify(tc.pk, tc.sk)
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, actual)
+
+			matches := 0
+			for _, r := range records {
+				if r.partition(tc.pk) && r.sort(tc.sk) {
+					matches++
+				}
+			}
+			assert.Equal(t, 1, matches, "keys should match exactly one record type")
+		})
+		covered[tc.expected] = true
+	}
+
+	for _, recordType := range RecordTypes() {
+		assert.True(t, covered[recordType], "no test case for %s", recordType)
+	}
+}
+
+func TestClassify_Unknown(t *testing.T) {
+	testCases := []struct {
+		name string
+		pk   string
+		sk   string
+	}{
+		{"unknown partition", "mystery#1", Info},
+		{"unknown sort key", Driver(1), "mystery#1"},
+		{"sort key of another partition", Session(1), DriverSettings},
+		{"malformed sort key", Driver(1), "session#abc"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ok := Classify(tc.pk, tc.sk)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func one[T any](parse func(string) (T, error)) func(string) ([]any, error) {
+	return func(key string) ([]any, error) {
+		v, err := parse(key)
+		return []any{v}, err
+	}
+}
+
+func two[A, B any](parse func(string) (A, B, error)) func(string) ([]any, error) {
+	return func(key string) ([]any, error) {
+		a, b, err := parse(key)
+		return []any{a, b}, err
+	}
+}
@@ -0,0 +1,206 @@
+package keys
+
+// RecordType names a kind of item stored in the table, as identified by its keys
+type RecordType string
+
+const (
+	RecordDriver              RecordType = "driver"
+	RecordWSConnection        RecordType = "ws_connection"
+	RecordIngestionLock       RecordType = "ingestion_lock"
+	RecordIngestionCancel     RecordType = "ingestion_cancel"
+	RecordIngestionCoverage   RecordType = "ingestion_coverage"
+	RecordDriverSettings      RecordType = "driver_settings"
+	RecordSupporter           RecordType = "supporter"
+	RecordQuota               RecordType = "quota"
+	RecordDriverSession       RecordType = "driver_session"
+	RecordJournalEntry        RecordType = "journal_entry"
+	RecordJournalDraft        RecordType = "journal_draft"
+	RecordJournalPrompt       RecordType = "journal_prompt"
+	RecordJournalAttachment   RecordType = "journal_attachment"
+	RecordJournalStreak       RecordType = "journal_streak"
+	RecordDriverStanding      RecordType = "driver_standing"
+	RecordDriverRollup        RecordType = "driver_rollup"
+	RecordDriverMilestone     RecordType = "driver_milestone"
+	RecordTermsAcceptance     RecordType = "terms_acceptance"
+	RecordActionItem          RecordType = "action_item"
+	RecordAlertRule           RecordType = "alert_rule"
+	RecordRacePlan            RecordType = "race_plan"
+	RecordIRacingProxyRequest RecordType = "iracing_proxy_request"
+	RecordRequestCaptureMode  RecordType = "request_capture_mode"
+	RecordCapturedRequest     RecordType = "captured_request"
+	RecordRaceBookmark        RecordType = "race_bookmark"
+	RecordVideoLink           RecordType = "video_link"
+	RecordRaceTelemetry       RecordType = "race_telemetry"
+	RecordExternalLap         RecordType = "external_lap"
+	RecordDriverPresence      RecordType = "driver_presence"
+	RecordPresenceViewer      RecordType = "presence_viewer"
+	RecordPresenceGrant       RecordType = "presence_grant"
+	RecordAuthSession         RecordType = "auth_session"
+	RecordSquadMembership     RecordType = "squad_membership"
+	RecordPracticePlan        RecordType = "practice_plan"
+	RecordWeeklyRecap         RecordType = "weekly_recap"
+	RecordDashboardLayout     RecordType = "dashboard_layout"
+	RecordSeasonCardToken     RecordType = "season_card_token"
+	RecordOverlayToken        RecordType = "overlay_token"
+
+	RecordWebSocket RecordType = "websocket"
+
+	RecordSessionDriverLap        RecordType = "session_driver_lap"
+	RecordSessionDriverLapSummary RecordType = "session_driver_lap_summary"
+	RecordSessionIngestMarker     RecordType = "session_ingest_marker"
+	RecordSessionResultsArchive   RecordType = "session_results_archive"
+	RecordSessionLapsArchive      RecordType = "session_laps_archive"
+	RecordSessionSquadEvent       RecordType = "session_squad_event"
+
+	RecordSquad            RecordType = "squad"
+	RecordSquadMember      RecordType = "squad_member"
+	RecordSquadEvent       RecordType = "squad_event"
+	RecordSquadEventResult RecordType = "squad_event_result"
+
+	RecordIngestionRun      RecordType = "ingestion_run"
+	RecordLeaderboardDriver RecordType = "leaderboard_driver"
+	RecordRankedLeaderboard RecordType = "ranked_leaderboard"
+	RecordLeaderboardRank   RecordType = "leaderboard_rank"
+	RecordRegionWeek        RecordType = "region_week"
+	RecordBenchmarkBand     RecordType = "benchmark_band"
+	RecordRateBudgetWindow  RecordType = "rate_budget_window"
+	RecordLoginAttempts     RecordType = "login_attempts"
+	RecordIngestionTier     RecordType = "ingestion_tier"
+	RecordGlobalCounters    RecordType = "global_counters"
+	RecordUpstreamStatus    RecordType = "upstream_status"
+	RecordBackfillProgress  RecordType = "backfill_progress"
+	RecordDriverSearch      RecordType = "driver_search"
+	RecordWaitlistEntry     RecordType = "waitlist_entry"
+	RecordInvitation        RecordType = "invitation"
+	RecordSeasonCard        RecordType = "season_card"
+	RecordSeasonCardFetches RecordType = "season_card_fetches"
+	RecordOverlay           RecordType = "overlay"
+)
+
+type recordMatcher struct {
+	recordType RecordType
+	partition  func(string) bool
+	sort       func(string) bool
+}
+
+// records is every record type with what its keys look like. A record's keys match exactly one entry, which
+// TestClassify holds to by building keys for each of them.
+var records = []recordMatcher{
+	{RecordDriver, parses(ParseDriver), exactly(Info)},
+	{RecordWSConnection, parses(ParseDriver), parses(ParseWSConnection)},
+	{RecordIngestionLock, parses(ParseDriver), exactly(IngestionLock)},
+	{RecordIngestionCancel, parses(ParseDriver), exactly(IngestionCancel)},
+	{RecordIngestionCoverage, parses(ParseDriver), exactly(IngestionCoverage)},
+	{RecordDriverSettings, parses(ParseDriver), exactly(DriverSettings)},
+	{RecordSupporter, parses(ParseDriver), exactly(Supporter)},
+	{RecordQuota, parses(ParseDriver), parses(ParseQuota)},
+	{RecordDriverSession, parses(ParseDriver), parses(ParseDriverSession)},
+	{RecordJournalEntry, parses(ParseDriver), parses(ParseJournalEntry)},
+	{RecordJournalDraft, parses(ParseDriver), parses(ParseJournalDraft)},
+	{RecordJournalPrompt, parses(ParseDriver), parses(ParseJournalPrompt)},
+	{RecordJournalAttachment, parses(ParseDriver), parsesPair(ParseJournalAttachment)},
+	{RecordJournalStreak, parses(ParseDriver), exactly(JournalStreak)},
+	{RecordDriverStanding, parses(ParseDriver), parses(ParseDriverStanding)},
+	{RecordDriverRollup, parses(ParseDriver), parses(ParseDriverRollup)},
+	{RecordDriverMilestone, parses(ParseDriver), parses(ParseDriverMilestone)},
+	{RecordTermsAcceptance, parses(ParseDriver), parsesPair(ParseTermsAcceptance)},
+	{RecordActionItem, parses(ParseDriver), parses(ParseActionItem)},
+	{RecordAlertRule, parses(ParseDriver), parses(ParseAlertRule)},
+	{RecordRacePlan, parses(ParseDriver), func(sk string) bool {
+		_, _, _, err := ParseRacePlan(sk)
+		return err == nil
+	}},
+	{RecordIRacingProxyRequest, parses(ParseDriver), parses(ParseIRacingProxyRequest)},
+	{RecordRequestCaptureMode, parses(ParseDriver), exactly(RequestCaptureMode)},
+	{RecordCapturedRequest, parses(ParseDriver), parsesPair(ParseCapturedRequest)},
+	{RecordRaceBookmark, parses(ParseDriver), parsesPair(ParseRaceBookmark)},
+	{RecordVideoLink, parses(ParseDriver), parsesPair(ParseVideoLink)},
+	{RecordRaceTelemetry, parses(ParseDriver), parses(ParseRaceTelemetry)},
+	{RecordExternalLap, parses(ParseDriver), func(sk string) bool {
+		_, _, _, _, err := ParseExternalLap(sk)
+		return err == nil
+	}},
+	{RecordDriverPresence, parses(ParseDriver), exactly(DriverPresence)},
+	{RecordPresenceViewer, parses(ParseDriver), parses(ParsePresenceViewer)},
+	{RecordPresenceGrant, parses(ParseDriver), parses(ParsePresenceGrant)},
+	{RecordAuthSession, parses(ParseDriver), parses(ParseAuthSession)},
+	{RecordSquadMembership, parses(ParseDriver), parses(ParseSquadMembership)},
+	{RecordPracticePlan, parses(ParseDriver), exactly(PracticePlan)},
+	{RecordWeeklyRecap, parses(ParseDriver), exactly(WeeklyRecap)},
+	{RecordDashboardLayout, parses(ParseDriver), exactly(DashboardLayout)},
+	{RecordSeasonCardToken, parses(ParseDriver), exactly(SeasonCardToken)},
+	{RecordOverlayToken, parses(ParseDriver), exactly(OverlayToken)},
+
+	{RecordWebSocket, parses(ParseWebSocket), exactly(Info)},
+
+	{RecordSessionDriverLap, parses(ParseSession), parsesPair(ParseSessionDriverLap)},
+	{RecordSessionDriverLapSummary, parses(ParseSession), parses(ParseSessionDriverLapSummary)},
+	{RecordSessionIngestMarker, parses(ParseSession), parses(ParseSessionIngestMarker)},
+	{RecordSessionResultsArchive, parses(ParseSession), exactly(SessionResultsArchive)},
+	{RecordSessionLapsArchive, parses(ParseSession), parses(ParseSessionLapsArchive)},
+	{RecordSessionSquadEvent, parses(ParseSession), parses(ParseSessionSquadEvent)},
+
+	{RecordSquad, parses(ParseSquad), exactly(Info)},
+	{RecordSquadMember, parses(ParseSquad), parses(ParseSquadMember)},
+	{RecordSquadEvent, parses(ParseSquad), parses(ParseSquadEvent)},
+	{RecordSquadEventResult, parses(ParseSquad), parsesPair(ParseSquadEventResult)},
+
+	{RecordIngestionRun, exactly(IngestionRuns), parsesPair(ParseIngestionRun)},
+	{RecordLeaderboardDriver, parses(ParseWeeklyLeaderboard), parses(ParseLeaderboardDriver)},
+	{RecordRankedLeaderboard, parsesPair(ParseRankedLeaderboard), exactly(RankedLeaderboardBoard)},
+	{RecordLeaderboardRank, parsesPair(ParseRankedLeaderboard), parses(ParseLeaderboardRank)},
+	{RecordRegionWeek, parses(ParseRegion), parses(ParseRegionWeek)},
+	{RecordBenchmarkBand, parsesPair(ParseBenchmark), parses(ParseBenchmarkBand)},
+	{RecordRateBudgetWindow, exactly(RateBudget), parses(ParseRateBudgetWindow)},
+	{RecordLoginAttempts, parses(ParseLoginAttempts), exactly(Info)},
+	{RecordIngestionTier, exactly(IngestionTiers), parses(ParseIngestionTier)},
+	{RecordGlobalCounters, exactly(Global), exactly(GlobalCounters)},
+	{RecordUpstreamStatus, exactly(Global), exactly(UpstreamStatus)},
+	{RecordBackfillProgress, exactly(Global), parses(ParseBackfillProgress)},
+	{RecordDriverSearch, parses(ParseDriverSearch), parsesPair(ParseDriverSearchName)},
+	{RecordWaitlistEntry, exactly(Waitlist), parses(ParseWaitlistDriver)},
+	{RecordInvitation, exactly(Invitations), parses(ParseInvitationDriver)},
+	{RecordSeasonCard, parses(ParseSeasonCard), exactly(Info)},
+	{RecordSeasonCardFetches, parses(ParseSeasonCard), parses(ParseSeasonCardFetches)},
+	{RecordOverlay, parses(ParseOverlay), exactly(Info)},
+}
+
+// RecordTypes returns every record type the table holds
+func RecordTypes() []RecordType {
+	ret := make([]RecordType, len(records))
+	for i, r := range records {
+		ret[i] = r.recordType
+	}
+	return ret
+}
+
+// Classify returns the type of the record with the given partition and sort key, ok being false for keys that match
+// no known record type.
+func Classify(pk, sk string) (RecordType, bool) {
+	for _, r := range records {
+		if r.partition(pk) && r.sort(sk) {
+			return r.recordType, true
+		}
+	}
+	return "", false
+}
+
+func exactly(key string) func(string) bool {
+	return func(s string) bool {
+		return s == key
+	}
+}
+
+func parses[T any](parse func(string) (T, error)) func(string) bool {
+	return func(s string) bool {
+		_, err := parse(s)
+		return err == nil
+	}
+}
+
+func parsesPair[A, B any](parse func(string) (A, B, error)) func(string) bool {
+	return func(s string) bool {
+		_, _, err := parse(s)
+		return err == nil
+	}
+}
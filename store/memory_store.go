@@ -0,0 +1,2588 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/jonsabados/saturdaysspinout/store/keys"
+)
+
+// MemoryStore is an in-process stand-in for DynamoStore for local development. It keeps the same items DynamoStore
+// writes, keyed the same way, so records round trip exactly as they would through DynamoDB. Nothing is persisted and
+// TTLs are not enforced. Tenants all share the one set of items, so it only stands in for a single tenant deployment.
+type MemoryStore struct {
+	mu sync.Mutex
+	// partition key -> sort key -> item
+	items map[string]map[string]map[string]types.AttributeValue
+	now   func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		items: make(map[string]map[string]map[string]types.AttributeValue),
+		now:   time.Now,
+	}
+}
+
+// conditionFailed mirrors the error DynamoDB returns when a condition expression doesn't hold
+func conditionFailed() error {
+	return &types.ConditionalCheckFailedException{Message: aws.String("The conditional request failed")}
+}
+
+func (s *MemoryStore) get(pk, sk string) map[string]types.AttributeValue {
+	return s.items[pk][sk]
+}
+
+func (s *MemoryStore) put(item map[string]types.AttributeValue) {
+	pk := item[partitionKeyName].(*types.AttributeValueMemberS).Value
+	sk := item[sortKeyName].(*types.AttributeValueMemberS).Value
+	if s.items[pk] == nil {
+		s.items[pk] = make(map[string]map[string]types.AttributeValue)
+	}
+	s.items[pk][sk] = item
+}
+
+func (s *MemoryStore) delete(pk, sk string) {
+	delete(s.items[pk], sk)
+	if len(s.items[pk]) == 0 {
+		delete(s.items, pk)
+	}
+}
+
+// update applies changes to a copy of an existing item, or to a new item holding just the key when there is none,
+// the same as an UpdateItem without a condition.
+func (s *MemoryStore) update(pk, sk string, apply func(item map[string]types.AttributeValue)) {
+	item := maps.Clone(s.get(pk, sk))
+	if item == nil {
+		item = map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+			sortKeyName:      &types.AttributeValueMemberS{Value: sk},
+		}
+	}
+	apply(item)
+	s.put(item)
+}
+
+// add increments a numeric attribute, treating a missing attribute as zero like an ADD update expression.
+func (s *MemoryStore) add(pk, sk, name string, delta int64) {
+	s.update(pk, sk, func(item map[string]types.AttributeValue) {
+		current, _ := getOptionalInt64Attr(item, name)
+		item[name] = &types.AttributeValueMemberN{Value: strconv.FormatInt(current+delta, 10)}
+	})
+}
+
+// query returns the items in a partition whose sort key matches, ordered by sort key the way DynamoDB orders them.
+func (s *MemoryStore) query(pk string, match func(sk string) bool, newestFirst bool) []map[string]types.AttributeValue {
+	sortKeys := make([]string, 0, len(s.items[pk]))
+	for sk := range s.items[pk] {
+		if match(sk) {
+			sortKeys = append(sortKeys, sk)
+		}
+	}
+	sort.Strings(sortKeys)
+	if newestFirst {
+		slices.Reverse(sortKeys)
+	}
+	result := make([]map[string]types.AttributeValue, len(sortKeys))
+	for i, sk := range sortKeys {
+		result[i] = s.items[pk][sk]
+	}
+	return result
+}
+
+func hasPrefix(prefix string) func(sk string) bool {
+	return func(sk string) bool {
+		return strings.HasPrefix(sk, prefix)
+	}
+}
+
+func between(from, to string) func(sk string) bool {
+	return func(sk string) bool {
+		return sk >= from && sk <= to
+	}
+}
+
+func (s *MemoryStore) GetGlobalCounters(_ context.Context) (*GlobalCounters, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Global, keys.GlobalCounters)
+	if item == nil {
+		return &GlobalCounters{}, nil
+	}
+	return globalCountersFromAttributeMap(item)
+}
+
+func (s *MemoryStore) SaveUpstreamStatus(_ context.Context, status UpstreamStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(upstreamStatusModelFromEntity(status).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetUpstreamStatus(_ context.Context) (*UpstreamStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Global, keys.UpstreamStatus)
+	if item == nil {
+		return nil, nil
+	}
+	status, err := upstreamStatusFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	if !status.DownUntil.After(s.now()) {
+		return nil, nil
+	}
+	return status, nil
+}
+
+func (s *MemoryStore) SaveBackfillProgress(_ context.Context, progress BackfillProgress) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(backfillProgressModelFromEntity(progress).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetBackfillProgress(_ context.Context, name string) (*BackfillProgress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Global, keys.BackfillProgress(name))
+	if item == nil {
+		return nil, nil
+	}
+	return backfillProgressFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetRateBudgetWindow(_ context.Context, start time.Time) (*RateBudgetWindow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.RateBudget, keys.RateBudgetWindow(start.Unix()))
+	if item == nil {
+		return &RateBudgetWindow{Start: time.Unix(start.Unix(), 0), Drivers: map[int64]int{}}, nil
+	}
+	return rateBudgetWindowFromAttributeMap(item)
+}
+
+func (s *MemoryStore) ConsumeRateBudget(_ context.Context, start time.Time, driverID int64, cost, limit int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk, sk := keys.RateBudget, keys.RateBudgetWindow(start.Unix())
+	if item := s.get(pk, sk); item != nil {
+		total, err := getInt64Attr(item, "total")
+		if err != nil {
+			return false, err
+		}
+		if total > int64(limit-cost) {
+			return false, nil
+		}
+	}
+	s.update(pk, sk, func(item map[string]types.AttributeValue) {
+		item["window_start"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(start.Unix(), 10)}
+		item[ttlAttributeName] = ttlAttr(start.Add(rateBudgetWindowTTLDuration).Unix())
+	})
+	s.add(pk, sk, "total", int64(cost))
+	s.add(pk, sk, fmt.Sprintf(rateBudgetDriverAttributeFormat, driverID), int64(cost))
+	return true, nil
+}
+
+func (s *MemoryStore) GetLoginAttempts(_ context.Context, key string) (*LoginAttempts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.LoginAttempts(key), keys.Info)
+	if item == nil || ttlExpired(item, s.now()) {
+		return nil, nil
+	}
+	return loginAttemptsFromAttributeMap(item)
+}
+
+func (s *MemoryStore) RecordLoginFailure(_ context.Context, key string, at time.Time, window time.Duration) (*LoginAttempts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	model := loginAttemptsModel{
+		key:          key,
+		failures:     1,
+		firstFailure: at.Unix(),
+		lastFailure:  at.Unix(),
+		expiresAt:    at.Add(window).Unix(),
+	}
+	if item := s.get(keys.LoginAttempts(key), keys.Info); item != nil {
+		existing, err := loginAttemptsFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		if !existing.LastFailure.Before(at.Add(-window)) {
+			model.failures = existing.Failures + 1
+			model.firstFailure = existing.FirstFailure.Unix()
+		}
+	}
+	item := model.toAttributeMap()
+	s.put(item)
+	return loginAttemptsFromAttributeMap(item)
+}
+
+func (s *MemoryStore) ClearLoginAttempts(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.LoginAttempts(key), keys.Info)
+	return nil
+}
+
+func (s *MemoryStore) GetQuotaUsage(_ context.Context, driverID int64, day time.Time) (*QuotaUsage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.Quota(day.Unix()))
+	if item == nil {
+		return &QuotaUsage{DriverID: driverID, Day: time.Unix(day.Unix(), 0), Counts: map[string]int{}}, nil
+	}
+	return quotaUsageFromAttributeMap(item)
+}
+
+func (s *MemoryStore) ConsumeQuota(_ context.Context, driverID int64, day time.Time, operation string, limit int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk, sk := keys.Driver(driverID), keys.Quota(day.Unix())
+	attr := fmt.Sprintf(quotaOperationAttributeFormat, operation)
+	if item := s.get(pk, sk); item != nil {
+		if used, ok := getOptionalInt64Attr(item, attr); ok && used >= int64(limit) {
+			return false, nil
+		}
+	}
+	s.update(pk, sk, func(item map[string]types.AttributeValue) {
+		item["driver_id"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(driverID, 10)}
+		item["day"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(day.Unix(), 10)}
+		item[ttlAttributeName] = ttlAttr(day.Add(quotaTTLDuration).Unix())
+	})
+	s.add(pk, sk, attr, 1)
+	return true, nil
+}
+
+// Reads are always consistent here, so ReadOptions are accepted for compatibility and ignored.
+func (s *MemoryStore) GetDriver(_ context.Context, driverID int64, _ ...ReadOption) (*Driver, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	item := s.get(pk, keys.Info)
+	if item == nil {
+		return nil, nil
+	}
+	driver, err := driverFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	if lock := s.get(pk, keys.IngestionLock); lock != nil {
+		if lu, ok := getOptionalInt64Attr(lock, "locked_until"); ok {
+			t := time.Unix(lu, 0)
+			if t.After(s.now()) {
+				driver.IngestionBlockedUntil = &t
+			}
+		}
+	}
+	return driver, nil
+}
+
+func (s *MemoryStore) InsertDriver(_ context.Context, driver Driver) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.get(keys.Driver(driver.DriverID), keys.Info) != nil {
+		return ErrEntityAlreadyExists
+	}
+	driver.SearchIndexed = DriverSearchName(driver.DriverName) != ""
+	s.put(driverModelFromEntity(driver).toAttributeMap())
+	s.add(keys.Global, keys.GlobalCounters, globalCountersAttributeDrivers, 1)
+	if driver.SearchIndexed {
+		s.put(driverSearchModel{driverID: driver.DriverID, driverName: driver.DriverName}.toAttributeMap())
+	}
+	return nil
+}
+
+func (s *MemoryStore) RecordLogin(_ context.Context, driverID int64, loginTime time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	if s.get(pk, keys.Info) == nil {
+		return conditionFailed()
+	}
+	s.update(pk, keys.Info, func(item map[string]types.AttributeValue) {
+		loginCount, _ := getOptionalInt64Attr(item, "login_count")
+		item["last_login"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(loginTime), 10)}
+		item["login_count"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(loginCount+1, 10)}
+	})
+	return nil
+}
+
+func (s *MemoryStore) UpdateDriverClub(_ context.Context, driverID int64, clubID int, clubName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	if s.get(pk, keys.Info) == nil {
+		return conditionFailed()
+	}
+	s.update(pk, keys.Info, func(item map[string]types.AttributeValue) {
+		item["club_id"] = &types.AttributeValueMemberN{Value: strconv.Itoa(clubID)}
+		item["club_name"] = &types.AttributeValueMemberS{Value: clubName}
+	})
+	return nil
+}
+
+func (s *MemoryStore) UpdateDriverName(_ context.Context, driverID int64, previousName, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	if s.get(pk, keys.Info) == nil {
+		return conditionFailed()
+	}
+	s.update(pk, keys.Info, func(item map[string]types.AttributeValue) {
+		item["driver_name"] = &types.AttributeValueMemberS{Value: name}
+		item["search_indexed"] = &types.AttributeValueMemberBOOL{Value: true}
+	})
+	if DriverSearchName(previousName) != "" {
+		s.delete(driverSearchModel{driverID: driverID, driverName: previousName}.keys())
+	}
+	if DriverSearchName(name) != "" {
+		s.put(driverSearchModel{driverID: driverID, driverName: name}.toAttributeMap())
+	}
+	return nil
+}
+
+func (s *MemoryStore) SearchDrivers(_ context.Context, prefix string, limit int) ([]DriverSearchEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	searchName := DriverSearchName(prefix)
+	entries := make([]DriverSearchEntry, 0)
+	if len([]rune(searchName)) < MinDriverSearchLength || limit <= 0 {
+		return entries, nil
+	}
+	for _, item := range s.query(keys.DriverSearch(searchName), hasPrefix(keys.DriverSearchNamePrefix+searchName), false) {
+		if len(entries) == limit {
+			break
+		}
+		entry, err := driverSearchEntryFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+func (s *MemoryStore) UpdateDriverRacesIngestedTo(_ context.Context, driverID int64, racesIngestedTo time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	if s.get(pk, keys.Info) == nil {
+		return conditionFailed()
+	}
+	s.update(pk, keys.Info, func(item map[string]types.AttributeValue) {
+		item["races_ingested_to"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(racesIngestedTo), 10)}
+	})
+	return nil
+}
+
+func (s *MemoryStore) UpdateDriverRacesIngestedFrom(_ context.Context, driverID int64, racesIngestedFrom time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	if s.get(pk, keys.Info) == nil {
+		return conditionFailed()
+	}
+	s.update(pk, keys.Info, func(item map[string]types.AttributeValue) {
+		item["races_ingested_from"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(racesIngestedFrom), 10)}
+	})
+	return nil
+}
+
+func (s *MemoryStore) AdvanceOnboarding(_ context.Context, driverID int64, from, to OnboardingStep) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.Info)
+	if item == nil {
+		return false, nil
+	}
+	var current OnboardingStep
+	if step, ok := item["onboarding_step"].(*types.AttributeValueMemberS); ok {
+		current = OnboardingStep(step.Value)
+	}
+	if current != from {
+		return false, nil
+	}
+	item["onboarding_step"] = &types.AttributeValueMemberS{Value: string(to)}
+	s.put(item)
+	return true, nil
+}
+
+func (s *MemoryStore) AcquireIngestionLock(_ context.Context, driverID int64, lockDuration time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if lock := s.get(keys.Driver(driverID), keys.IngestionLock); lock != nil {
+		if lu, ok := getOptionalInt64Attr(lock, "locked_until"); ok && lu >= now.Unix() {
+			return false, nil
+		}
+	}
+	s.put(ingestionLockModel{
+		driverID:    driverID,
+		lockedUntil: now.Add(lockDuration).Unix(),
+	}.toAttributeMap())
+	return true, nil
+}
+
+func (s *MemoryStore) ReleaseIngestionLock(_ context.Context, driverID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.IngestionLock)
+	return nil
+}
+
+func (s *MemoryStore) RequestIngestionCancel(_ context.Context, driverID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.put(ingestionCancelModel{
+		driverID:    driverID,
+		requestedAt: now.Unix(),
+		expiresAt:   now.Add(ingestionCancelTTLDuration).Unix(),
+	}.toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) IngestionCancelRequested(_ context.Context, driverID int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.IngestionCancel)
+	if item == nil {
+		return false, nil
+	}
+	return !ttlExpired(item, s.now()), nil
+}
+
+func (s *MemoryStore) ClearIngestionCancel(_ context.Context, driverID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.IngestionCancel)
+	return nil
+}
+
+func (s *MemoryStore) SaveDriverPresence(_ context.Context, presence DriverPresence) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(driverPresenceModelFromEntity(presence, s.now()).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetDriverPresences(_ context.Context, driverIDs []int64) ([]DriverPresence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	presences := make([]DriverPresence, 0)
+	for _, driverID := range driverIDs {
+		item := s.get(keys.Driver(driverID), keys.DriverPresence)
+		if item == nil || ttlExpired(item, now) {
+			continue
+		}
+		presence, err := driverPresenceFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		presences = append(presences, *presence)
+	}
+	sort.Slice(presences, func(i, j int) bool {
+		return presences[i].DriverID < presences[j].DriverID
+	})
+	return presences, nil
+}
+
+func (s *MemoryStore) SavePresenceGrant(_ context.Context, grant PresenceGrant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, item := range presenceGrantModelFromEntity(grant, s.now()).toAttributeMaps() {
+		s.put(item)
+	}
+	return nil
+}
+
+func (s *MemoryStore) DeletePresenceGrant(_ context.Context, driverID, viewerID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.PresenceViewer(viewerID))
+	s.delete(keys.Driver(viewerID), keys.PresenceGrant(driverID))
+	return nil
+}
+
+func (s *MemoryStore) GetPresenceViewers(_ context.Context, driverID int64) ([]PresenceGrant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	grants, err := s.presenceGrants(driverID, keys.PresenceViewerPrefix)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].ViewerID < grants[j].ViewerID
+	})
+	return grants, nil
+}
+
+func (s *MemoryStore) GetPresenceGrantsForViewer(_ context.Context, viewerID int64) ([]PresenceGrant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	grants, err := s.presenceGrants(viewerID, keys.PresenceGrantPrefix)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].DriverID < grants[j].DriverID
+	})
+	return grants, nil
+}
+
+func (s *MemoryStore) presenceGrants(driverID int64, prefix string) ([]PresenceGrant, error) {
+	grants := make([]PresenceGrant, 0)
+	for _, item := range s.query(keys.Driver(driverID), hasPrefix(prefix), false) {
+		grant, err := presenceGrantFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, *grant)
+	}
+	return grants, nil
+}
+
+func (s *MemoryStore) SaveAuthSession(_ context.Context, session AuthSession) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := authSessionModelFromEntity(session).toAttributeMap()
+	existing := s.get(item[partitionKeyName].(*types.AttributeValueMemberS).Value, item[sortKeyName].(*types.AttributeValueMemberS).Value)
+	if _, revoked := existing["revoked_at"]; revoked {
+		return false, nil
+	}
+	s.put(item)
+	return true, nil
+}
+
+func (s *MemoryStore) GetAuthSession(_ context.Context, driverID int64, sessionID string) (*AuthSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.AuthSession(sessionID))
+	if item == nil || ttlExpired(item, s.now()) {
+		return nil, nil
+	}
+	return authSessionFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetAuthSessions(_ context.Context, driverID int64) ([]AuthSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	sessions := make([]AuthSession, 0)
+	for _, item := range s.query(keys.Driver(driverID), hasPrefix(keys.AuthSessionPrefix), false) {
+		if ttlExpired(item, now) {
+			continue
+		}
+		session, err := authSessionFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+	sortAuthSessions(sessions)
+	return sessions, nil
+}
+
+func (s *MemoryStore) RevokeAuthSession(_ context.Context, driverID int64, sessionID string, revokedAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	sk := keys.AuthSession(sessionID)
+	if s.get(pk, sk) == nil {
+		return false, nil
+	}
+	s.update(pk, sk, func(item map[string]types.AttributeValue) {
+		if _, ok := item["revoked_at"]; !ok {
+			item["revoked_at"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(revokedAt), 10)}
+		}
+	})
+	return true, nil
+}
+
+func (s *MemoryStore) SaveSquad(_ context.Context, squad Squad) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(squadModelFromEntity(squad).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetSquad(_ context.Context, squadID string) (*Squad, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Squad(squadID), keys.Info)
+	if item == nil {
+		return nil, nil
+	}
+	return squadFromAttributeMap(item)
+}
+
+func (s *MemoryStore) SaveSquadMember(_ context.Context, member SquadMember) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, item := range squadMemberModelFromEntity(member).toAttributeMaps() {
+		s.put(item)
+	}
+	return nil
+}
+
+func (s *MemoryStore) GetSquadMember(_ context.Context, squadID string, driverID int64) (*SquadMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Squad(squadID), keys.SquadMember(driverID))
+	if item == nil {
+		return nil, nil
+	}
+	return squadMemberFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetSquadMembers(_ context.Context, squadID string) ([]SquadMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	members, err := s.squadMembers(keys.Squad(squadID), keys.SquadMemberPrefix)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].DriverID < members[j].DriverID
+	})
+	return members, nil
+}
+
+func (s *MemoryStore) GetDriverSquads(_ context.Context, driverID int64) ([]SquadMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.squadMembers(keys.Driver(driverID), keys.SquadMembershipPrefix)
+}
+
+func (s *MemoryStore) DeleteSquadMember(_ context.Context, squadID string, driverID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Squad(squadID), keys.SquadMember(driverID))
+	s.delete(keys.Driver(driverID), keys.SquadMembership(squadID))
+	return nil
+}
+
+func (s *MemoryStore) squadMembers(pk, prefix string) ([]SquadMember, error) {
+	members := make([]SquadMember, 0)
+	for _, item := range s.query(pk, hasPrefix(prefix), false) {
+		member, err := squadMemberFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, *member)
+	}
+	return members, nil
+}
+
+func (s *MemoryStore) SaveSquadEvent(_ context.Context, event SquadEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, item := range squadEventModelFromEntity(event).toAttributeMaps() {
+		s.put(item)
+	}
+	return nil
+}
+
+func (s *MemoryStore) GetSquadEvent(_ context.Context, squadID string, subsessionID int64) (*SquadEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Squad(squadID), keys.SquadEvent(subsessionID))
+	if item == nil {
+		return nil, nil
+	}
+	return squadEventFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetSquadEvents(_ context.Context, squadID string) ([]SquadEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return squadEventsFromItems(s.query(keys.Squad(squadID), hasPrefix(keys.SquadEventPrefix), false))
+}
+
+func (s *MemoryStore) GetSessionSquadEvents(_ context.Context, subsessionID int64) ([]SquadEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return squadEventsFromItems(s.query(keys.Session(subsessionID), hasPrefix(keys.SessionSquadEventPrefix), false))
+}
+
+func (s *MemoryStore) SaveSquadEventResult(_ context.Context, result SquadEventResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(squadEventResultModelFromEntity(result).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetSquadEventResults(_ context.Context, squadID string, subsessionID int64) ([]SquadEventResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := keys.SquadEventResultEventPrefix(subsessionID)
+	return squadEventResultsFromItems(s.query(keys.Squad(squadID), hasPrefix(prefix), false))
+}
+
+func (s *MemoryStore) SaveConnection(_ context.Context, conn WebSocketConnection) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for _, item := range (wsConnectionModel{
+		driverID:     conn.DriverID,
+		connectionID: conn.ConnectionID,
+		tenantID:     conn.TenantID,
+		overlayToken: conn.OverlayToken,
+		connectedAt:  toUnixSeconds(now),
+		ttl:          toUnixSeconds(now.Add(wsConnectionTTLDuration)),
+	}).toAttributeMaps() {
+		s.put(item)
+	}
+	return nil
+}
+
+func (s *MemoryStore) DeleteConnection(_ context.Context, driverID int64, connectionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.WSConnection(connectionID))
+	s.delete(keys.WebSocket(connectionID), keys.Info)
+	return nil
+}
+
+func (s *MemoryStore) GetConnectionsByDriver(_ context.Context, driverID int64) ([]WebSocketConnection, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Driver(driverID), hasPrefix("ws#"), false)
+	connections := make([]WebSocketConnection, 0, len(items))
+	for _, item := range items {
+		conn, err := wsConnectionFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		connections = append(connections, *conn)
+	}
+	return connections, nil
+}
+
+func (s *MemoryStore) GetDriverIDByConnection(_ context.Context, connectionID string) (*int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.WebSocket(connectionID), keys.Info)
+	if item == nil {
+		return nil, nil
+	}
+	ret, err := getInt64Attr(item, "driver_id")
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+func (s *MemoryStore) GetConnection(_ context.Context, driverID int64, connectionID string) (*WebSocketConnection, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.WSConnection(connectionID))
+	if item == nil {
+		return nil, nil
+	}
+	return wsConnectionFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetDriverSession(_ context.Context, driverID int64, startTime time.Time, _ ...ReadOption) (*DriverSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.DriverSession(toUnixSeconds(startTime)))
+	if item == nil {
+		return nil, nil
+	}
+	return driverSessionFromAttributeMap(driverID, item)
+}
+
+func (s *MemoryStore) GetDriverSessions(_ context.Context, driverID int64, startTimes []time.Time) ([]DriverSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	sessions := make([]DriverSession, 0, len(startTimes))
+	for _, startTime := range startTimes {
+		item := s.get(pk, keys.DriverSession(toUnixSeconds(startTime)))
+		if item == nil {
+			continue
+		}
+		session, err := driverSessionFromAttributeMap(driverID, item)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+	return sessions, nil
+}
+
+func (s *MemoryStore) GetDriverSessionsByTimeRange(_ context.Context, driverID int64, from, to time.Time, filters ...SessionFilter) ([]DriverSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Driver(driverID), between(
+		keys.DriverSession(toUnixSeconds(from)),
+		keys.DriverSession(toUnixSeconds(to)),
+	), true)
+	sessions := make([]DriverSession, 0, len(items))
+	for _, item := range items {
+		session, err := driverSessionFromAttributeMap(driverID, item)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+
+	for _, filter := range filters {
+		sessions = filter(sessions)
+	}
+	return sessions, nil
+}
+
+func (s *MemoryStore) SaveDriverSessions(ctx context.Context, sessions []DriverSession) error {
+	_, err := s.SaveDriverSessionsWithMode(ctx, sessions, WriteModeStrict)
+	return err
+}
+
+func (s *MemoryStore) SaveDriverSessionsWithMode(_ context.Context, sessions []DriverSession, mode WriteMode) (WriteResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result WriteResult
+	items := make([]map[string]types.AttributeValue, len(sessions))
+	existing := make([]bool, len(sessions))
+	for i, ds := range sessions {
+		items[i] = driverSessionModelFromEntity(ds).toAttributeMap()
+		if s.get(keys.Driver(ds.DriverID), keys.DriverSession(toUnixSeconds(ds.StartTime))) != nil {
+			if mode == WriteModeStrict {
+				return WriteResult{}, ErrEntityAlreadyExists
+			}
+			existing[i] = true
+			result.Existing = append(result.Existing, ds)
+		}
+	}
+	for i, ds := range sessions {
+		if existing[i] {
+			if mode == WriteModeUpsert {
+				s.put(items[i])
+			}
+			continue
+		}
+		s.put(items[i])
+		s.add(keys.Driver(ds.DriverID), keys.Info, "session_count", 1)
+	}
+	return result, nil
+}
+
+func (s *MemoryStore) GetDriverSessionsWithSkippedLaps(_ context.Context, driverID int64) ([]DriverSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var sessions []DriverSession
+	for _, item := range s.query(keys.Driver(driverID), hasPrefix(keys.DriverSessionPrefix), true) {
+		if skipped, _ := getBoolAttr(item, "laps_skipped"); !skipped {
+			continue
+		}
+		session, err := driverSessionFromAttributeMap(driverID, item)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+	return sessions, nil
+}
+
+func (s *MemoryStore) CompleteDriverSessionLaps(_ context.Context, driverID int64, startTime time.Time, trafficCost *int, lapGaps int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	sk := keys.DriverSession(toUnixSeconds(startTime))
+	if s.get(pk, sk) == nil {
+		return conditionFailed()
+	}
+	s.update(pk, sk, func(item map[string]types.AttributeValue) {
+		delete(item, "laps_skipped")
+		if trafficCost != nil {
+			item["traffic_cost"] = &types.AttributeValueMemberN{Value: strconv.Itoa(*trafficCost)}
+		}
+		if lapGaps > 0 {
+			item["lap_gaps"] = &types.AttributeValueMemberN{Value: strconv.Itoa(lapGaps)}
+		}
+	})
+	return nil
+}
+
+func (s *MemoryStore) TagDriverSessionSquadEvents(_ context.Context, driverID int64, startTime time.Time, squadIDs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	sk := keys.DriverSession(toUnixSeconds(startTime))
+	if s.get(pk, sk) == nil {
+		return conditionFailed()
+	}
+	var updateErr error
+	s.update(pk, sk, func(item map[string]types.AttributeValue) {
+		existing, err := getOptionalStringSetAttr(item, "squad_events")
+		if err != nil {
+			updateErr = err
+			return
+		}
+		for _, squadID := range squadIDs {
+			if !slices.Contains(existing, squadID) {
+				existing = append(existing, squadID)
+			}
+		}
+		item["squad_events"] = &types.AttributeValueMemberSS{Value: existing}
+	})
+	return updateErr
+}
+
+func (s *MemoryStore) PersistSessionData(ctx context.Context, session DriverSession, laps []SessionDriverLap) error {
+	_, err := s.PersistSessionDataWithMode(ctx, session, laps, WriteModeStrict)
+	return err
+}
+
+func (s *MemoryStore) PersistSessionDataWithMode(_ context.Context, session DriverSession, laps []SessionDriverLap, mode WriteMode) (WriteResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result WriteResult
+	pk := keys.Driver(session.DriverID)
+	if s.get(pk, keys.DriverSession(toUnixSeconds(session.StartTime))) != nil {
+		if mode == WriteModeStrict {
+			return WriteResult{}, ErrEntityAlreadyExists
+		}
+		result.Existing = []DriverSession{session}
+		if mode == WriteModeSkipExisting {
+			return result, nil
+		}
+	}
+	for _, lap := range laps {
+		s.put(sessionDriverLapModelFromEntity(lap).toAttributeMap())
+	}
+	s.put(driverSessionModelFromEntity(session).toAttributeMap())
+	if len(result.Existing) > 0 {
+		return result, nil
+	}
+	s.add(pk, keys.Info, "session_count", 1)
+	// everything lands at once here, so the marker only ever records a completed ingest
+	s.put(sessionIngestMarkerModel{
+		subsessionID: session.SubsessionID,
+		driverID:     session.DriverID,
+		complete:     true,
+	}.toAttributeMap())
+	return result, nil
+}
+
+func (s *MemoryStore) DeleteDriverRaces(_ context.Context, driverID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	for sk := range s.items[pk] {
+		if sk != keys.Info && sk != keys.DriverSettings {
+			s.delete(pk, sk)
+		}
+	}
+	s.update(pk, keys.Info, func(item map[string]types.AttributeValue) {
+		delete(item, "races_ingested_from")
+		delete(item, "races_ingested_to")
+		item["session_count"] = &types.AttributeValueMemberN{Value: "0"}
+	})
+	return nil
+}
+
+func (s *MemoryStore) SaveSessionDriverLaps(_ context.Context, laps []SessionDriverLap) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, lap := range laps {
+		s.put(sessionDriverLapModelFromEntity(lap).toAttributeMap())
+	}
+	return nil
+}
+
+func (s *MemoryStore) GetSessionDriverLaps(_ context.Context, subsessionID, driverID int64) ([]SessionDriverLap, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Session(subsessionID), hasPrefix(keys.SessionDriverLapDriverPrefix(driverID)), false)
+	laps := make([]SessionDriverLap, 0, len(items))
+	for _, item := range items {
+		lap, err := sessionDriverLapFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		laps = append(laps, *lap)
+	}
+	sort.Slice(laps, func(i, j int) bool {
+		return laps[i].LapNumber < laps[j].LapNumber
+	})
+	return laps, nil
+}
+
+func (s *MemoryStore) GetSessionLapsInRaceOrder(_ context.Context, subsessionID int64, after string, limit int) ([]SessionDriverLap, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Session(subsessionID), func(sk string) bool {
+		return true
+	}, false)
+	var ordered []map[string]types.AttributeValue
+	for _, item := range items {
+		raceOrder, ok := item[raceOrderAttributeName].(*types.AttributeValueMemberS)
+		if ok && raceOrder.Value > after {
+			ordered = append(ordered, item)
+		}
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i][raceOrderAttributeName].(*types.AttributeValueMemberS).Value < ordered[j][raceOrderAttributeName].(*types.AttributeValueMemberS).Value
+	})
+
+	more := len(ordered) > limit
+	if more {
+		ordered = ordered[:limit]
+	}
+	laps := make([]SessionDriverLap, 0, len(ordered))
+	for _, item := range ordered {
+		lap, err := sessionDriverLapFromAttributeMap(item)
+		if err != nil {
+			return nil, false, err
+		}
+		laps = append(laps, *lap)
+	}
+	return laps, more, nil
+}
+
+func (s *MemoryStore) DeleteSessionDriverLaps(_ context.Context, subsessionID, driverID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Session(subsessionID)
+	for _, item := range s.query(pk, hasPrefix(keys.SessionDriverLapDriverPrefix(driverID)), false) {
+		s.delete(pk, item[sortKeyName].(*types.AttributeValueMemberS).Value)
+	}
+	return nil
+}
+
+func (s *MemoryStore) SaveSessionDriverLapSummary(_ context.Context, summary SessionDriverLapSummary) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(sessionDriverLapSummaryModelFromEntity(summary).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetSessionDriverLapSummary(_ context.Context, subsessionID, driverID int64) (*SessionDriverLapSummary, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Session(subsessionID), keys.SessionDriverLapSummary(driverID))
+	if item == nil {
+		return nil, nil
+	}
+	return sessionDriverLapSummaryFromAttributeMap(item)
+}
+
+func (s *MemoryStore) SaveJournalEntry(_ context.Context, entry RaceJournalEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := toUnixSeconds(s.now())
+	createdAt := now
+	existing := s.get(keys.Driver(entry.DriverID), keys.JournalEntry(entry.RaceID))
+	if existing != nil {
+		createdAt, _ = getOptionalInt64Attr(existing, "created_at")
+	}
+	item := journalEntryModel{
+		driverID:    entry.DriverID,
+		raceID:      entry.RaceID,
+		createdAt:   createdAt,
+		updatedAt:   now,
+		notes:       entry.Notes,
+		tags:        entry.Tags,
+		replayVideo: entry.ReplayVideo,
+		searchTerms: entry.SearchTerms,
+	}.toAttributeMap()
+	// DynamoStore's upsert always writes tags and replay_video, even when empty, and leaves transcripts alone
+	if _, ok := item["tags"]; !ok {
+		item["tags"] = &types.AttributeValueMemberL{Value: []types.AttributeValue{}}
+	}
+	item["replay_video"] = &types.AttributeValueMemberS{Value: entry.ReplayVideo}
+	for _, name := range []string{"transcripts", "transcript_terms"} {
+		if attr, ok := existing[name]; ok {
+			item[name] = attr
+		}
+	}
+	s.put(item)
+	return nil
+}
+
+func (s *MemoryStore) AppendJournalTranscript(_ context.Context, driverID, raceID int64, transcript string, terms []string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.JournalEntry(raceID))
+	if item == nil {
+		return false, nil
+	}
+	transcripts, err := getOptionalStringSliceAttr(item, "transcripts")
+	if err != nil {
+		return false, err
+	}
+	transcriptTerms, err := getOptionalStringSetAttr(item, "transcript_terms")
+	if err != nil {
+		return false, err
+	}
+	transcriptValues := make([]types.AttributeValue, 0, len(transcripts)+1)
+	for _, t := range append(transcripts, transcript) {
+		transcriptValues = append(transcriptValues, &types.AttributeValueMemberS{Value: t})
+	}
+	item["transcripts"] = &types.AttributeValueMemberL{Value: transcriptValues}
+	for _, term := range terms {
+		if !slices.Contains(transcriptTerms, term) {
+			transcriptTerms = append(transcriptTerms, term)
+		}
+	}
+	if len(transcriptTerms) > 0 {
+		item["transcript_terms"] = &types.AttributeValueMemberSS{Value: transcriptTerms}
+	}
+	s.put(item)
+	return true, nil
+}
+
+func (s *MemoryStore) SaveJournalAttachment(_ context.Context, attachment JournalAttachment) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(journalAttachmentModelFromEntity(attachment).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetJournalAttachment(_ context.Context, driverID, raceID int64, attachmentID string) (*JournalAttachment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.JournalAttachment(raceID, attachmentID))
+	if item == nil {
+		return nil, nil
+	}
+	return journalAttachmentFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetJournalAttachments(_ context.Context, driverID, raceID int64) ([]JournalAttachment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var attachments []JournalAttachment
+	for _, item := range s.query(keys.Driver(driverID), hasPrefix(keys.JournalAttachmentRacePrefix(raceID)), false) {
+		attachment, err := journalAttachmentFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, *attachment)
+	}
+	return attachments, nil
+}
+
+func (s *MemoryStore) SetJournalAttachmentStatus(_ context.Context, driverID, raceID int64, attachmentID string, status JournalAttachmentStatus) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.JournalAttachment(raceID, attachmentID))
+	if item == nil {
+		return false, nil
+	}
+	item["status"] = &types.AttributeValueMemberS{Value: string(status)}
+	s.put(item)
+	return true, nil
+}
+
+func (s *MemoryStore) GetJournalEntry(_ context.Context, driverID, raceID int64, _ ...ReadOption) (*RaceJournalEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.JournalEntry(raceID))
+	if item == nil {
+		return nil, nil
+	}
+	return journalEntryFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetJournalEntries(_ context.Context, driverID int64, from, to time.Time) ([]RaceJournalEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Driver(driverID), between(
+		keys.JournalEntry(toUnixSeconds(from)),
+		keys.JournalEntry(toUnixSeconds(to)),
+	), true)
+	entries := make([]RaceJournalEntry, 0, len(items))
+	for _, item := range items {
+		entry, err := journalEntryFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+func (s *MemoryStore) DeleteJournalEntry(_ context.Context, driverID, raceID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.JournalEntry(raceID))
+	return nil
+}
+
+func (s *MemoryStore) SaveJournalDraft(_ context.Context, draft RaceJournalDraft) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(journalDraftModelFromEntity(draft, s.now()).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetJournalDraft(_ context.Context, driverID, raceID int64, _ ...ReadOption) (*RaceJournalDraft, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.JournalDraft(raceID))
+	if item == nil {
+		return nil, nil
+	}
+	return unexpiredJournalDraft(item, s.now())
+}
+
+func (s *MemoryStore) DeleteJournalDraft(_ context.Context, driverID, raceID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.JournalDraft(raceID))
+	return nil
+}
+
+func (s *MemoryStore) SaveJournalPrompt(_ context.Context, prompt JournalPrompt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(journalPromptModelFromEntity(prompt, s.now()).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetJournalPrompts(_ context.Context, driverID int64, from, to time.Time) ([]JournalPrompt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	items := s.query(keys.Driver(driverID), between(
+		keys.JournalPrompt(toUnixSeconds(from)),
+		keys.JournalPrompt(toUnixSeconds(to)),
+	), true)
+	prompts := make([]JournalPrompt, 0, len(items))
+	for _, item := range items {
+		if ttlExpired(item, now) {
+			continue
+		}
+		prompt, err := journalPromptFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		prompts = append(prompts, *prompt)
+	}
+	return prompts, nil
+}
+
+func (s *MemoryStore) DeleteJournalPrompt(_ context.Context, driverID, raceID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.JournalPrompt(raceID))
+	return nil
+}
+
+func (s *MemoryStore) SaveJournalStreak(_ context.Context, streak JournalStreak) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(journalStreakModelFromEntity(streak, s.now()).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetJournalStreak(_ context.Context, driverID int64) (*JournalStreak, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.JournalStreak)
+	if item == nil {
+		return nil, nil
+	}
+	return journalStreakFromAttributeMap(item)
+}
+
+func (s *MemoryStore) SaveActionItem(_ context.Context, item ActionItem) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(actionItemModelFromEntity(item).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetActionItem(_ context.Context, driverID int64, itemID string) (*ActionItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.ActionItem(itemID))
+	if item == nil {
+		return nil, nil
+	}
+	return actionItemFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetActionItems(_ context.Context, driverID int64) ([]ActionItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var items []ActionItem
+	for _, i := range s.query(keys.Driver(driverID), hasPrefix(keys.ActionItemPrefix), false) {
+		item, err := actionItemFromAttributeMap(i)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *item)
+	}
+	return items, nil
+}
+
+func (s *MemoryStore) DeleteActionItem(_ context.Context, driverID int64, itemID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.ActionItem(itemID))
+	return nil
+}
+
+func (s *MemoryStore) SaveAlertRule(_ context.Context, rule AlertRule) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(alertRuleModelFromEntity(rule).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetAlertRule(_ context.Context, driverID int64, ruleID string) (*AlertRule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.AlertRule(ruleID))
+	if item == nil {
+		return nil, nil
+	}
+	return alertRuleFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetAlertRules(_ context.Context, driverID int64) ([]AlertRule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rules []AlertRule
+	for _, item := range s.query(keys.Driver(driverID), hasPrefix(keys.AlertRulePrefix), false) {
+		rule, err := alertRuleFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, *rule)
+	}
+	return rules, nil
+}
+
+func (s *MemoryStore) DeleteAlertRule(_ context.Context, driverID int64, ruleID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.AlertRule(ruleID))
+	return nil
+}
+
+func (s *MemoryStore) SaveRacePlan(_ context.Context, plan RacePlan) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(racePlanModelFromEntity(plan).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetRacePlan(_ context.Context, driverID int64, weekStart time.Time, seriesID, trackID int64) (*RacePlan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.RacePlan(weekStart.Unix(), seriesID, trackID))
+	if item == nil {
+		return nil, nil
+	}
+	return racePlanFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetRacePlans(_ context.Context, driverID int64) ([]RacePlan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var plans []RacePlan
+	for _, item := range s.query(keys.Driver(driverID), hasPrefix(keys.RacePlanPrefix), false) {
+		plan, err := racePlanFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, *plan)
+	}
+	return plans, nil
+}
+
+func (s *MemoryStore) LinkRacePlan(_ context.Context, driverID int64, weekStart time.Time, seriesID, trackID, raceID, subsessionID int64, linkedAt time.Time, evaluation *PlanEvaluation) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.RacePlan(weekStart.Unix(), seriesID, trackID))
+	if item == nil {
+		return false, nil
+	}
+	plan, err := racePlanFromAttributeMap(item)
+	if err != nil {
+		return false, err
+	}
+	if plan.RaceID != 0 && plan.RaceID < raceID {
+		return false, nil
+	}
+	plan.RaceID = raceID
+	plan.SubsessionID = subsessionID
+	plan.LinkedAt = linkedAt
+	plan.Evaluation = evaluation
+	s.put(racePlanModelFromEntity(*plan).toAttributeMap())
+	return true, nil
+}
+
+func (s *MemoryStore) DeleteRacePlan(_ context.Context, driverID int64, weekStart time.Time, seriesID, trackID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.RacePlan(weekStart.Unix(), seriesID, trackID))
+	return nil
+}
+
+func (s *MemoryStore) SaveRaceBookmark(_ context.Context, bookmark RaceBookmark) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(raceBookmarkModelFromEntity(bookmark).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetRaceBookmark(_ context.Context, driverID, raceID int64, bookmarkID string) (*RaceBookmark, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.RaceBookmark(raceID, bookmarkID))
+	if item == nil {
+		return nil, nil
+	}
+	return raceBookmarkFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetRaceBookmarks(_ context.Context, driverID, raceID int64) ([]RaceBookmark, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var bookmarks []RaceBookmark
+	for _, item := range s.query(keys.Driver(driverID), hasPrefix(keys.RaceBookmarkRacePrefix(raceID)), false) {
+		bookmark, err := raceBookmarkFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		bookmarks = append(bookmarks, *bookmark)
+	}
+	return bookmarks, nil
+}
+
+func (s *MemoryStore) DeleteRaceBookmark(_ context.Context, driverID, raceID int64, bookmarkID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.RaceBookmark(raceID, bookmarkID))
+	return nil
+}
+
+func (s *MemoryStore) SaveVideoLink(_ context.Context, link VideoLink) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(videoLinkModelFromEntity(link).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetVideoLinks(_ context.Context, driverID, raceID int64) ([]VideoLink, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var links []VideoLink
+	for _, item := range s.query(keys.Driver(driverID), hasPrefix(keys.VideoLinkRacePrefix(raceID)), false) {
+		link, err := videoLinkFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, *link)
+	}
+	return links, nil
+}
+
+func (s *MemoryStore) DeleteVideoLink(_ context.Context, driverID, raceID int64, linkID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.VideoLink(raceID, linkID))
+	return nil
+}
+
+func (s *MemoryStore) SaveRaceTelemetry(_ context.Context, telemetry RaceTelemetry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(raceTelemetryModelFromEntity(telemetry).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetRaceTelemetry(_ context.Context, driverID, raceID int64) (*RaceTelemetry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.RaceTelemetry(raceID))
+	if item == nil {
+		return nil, nil
+	}
+	return raceTelemetryFromAttributeMap(item)
+}
+
+func (s *MemoryStore) SaveExternalLaps(_ context.Context, laps []ExternalLap) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, lap := range laps {
+		s.put(externalLapModelFromEntity(lap).toAttributeMap())
+	}
+	return nil
+}
+
+func (s *MemoryStore) GetExternalLapsByTimeRange(_ context.Context, driverID int64, from, to time.Time) ([]ExternalLap, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Driver(driverID), between(
+		keys.ExternalLapsFrom(toUnixSeconds(from)),
+		keys.ExternalLapsFrom(toUnixSeconds(to)+1),
+	), false)
+	laps := make([]ExternalLap, 0, len(items))
+	for _, item := range items {
+		lap, err := externalLapFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		laps = append(laps, *lap)
+	}
+	return laps, nil
+}
+
+func (s *MemoryStore) SavePracticePlan(_ context.Context, plan PracticePlan) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(practicePlanModelFromEntity(plan).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetPracticePlan(_ context.Context, driverID int64) (*PracticePlan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.PracticePlan)
+	if item == nil {
+		return nil, nil
+	}
+	return practicePlanFromAttributeMap(item)
+}
+
+func (s *MemoryStore) SaveDashboardLayout(_ context.Context, layout DashboardLayout) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(dashboardLayoutModelFromEntity(layout).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetDashboardLayout(_ context.Context, driverID int64) (*DashboardLayout, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.DashboardLayout)
+	if item == nil {
+		return nil, nil
+	}
+	return dashboardLayoutFromAttributeMap(item)
+}
+
+func (s *MemoryStore) SaveSeasonCard(_ context.Context, card SeasonCard, replacing string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(seasonCardModelFromEntity(card).toAttributeMap())
+	s.put(seasonCardTokenModel{driverID: card.DriverID, token: card.Token}.toAttributeMap())
+	if replacing != "" && replacing != card.Token {
+		s.delete(keys.SeasonCard(replacing), keys.Info)
+	}
+	return nil
+}
+
+func (s *MemoryStore) RefreshSeasonCard(_ context.Context, token, snapshot string, refreshedAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.SeasonCard(token)
+	if s.get(pk, keys.Info) == nil {
+		return false, nil
+	}
+	s.update(pk, keys.Info, func(item map[string]types.AttributeValue) {
+		item["snapshot"] = &types.AttributeValueMemberS{Value: snapshot}
+		item["refreshed_at"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(refreshedAt.Unix(), 10)}
+	})
+	return true, nil
+}
+
+func (s *MemoryStore) GetSeasonCard(_ context.Context, driverID int64) (*SeasonCard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.SeasonCardToken)
+	if item == nil {
+		return nil, nil
+	}
+	pointer, err := seasonCardTokenModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	item = s.get(keys.SeasonCard(pointer.token), keys.Info)
+	if item == nil {
+		return nil, nil
+	}
+	return seasonCardFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetSeasonCardByToken(_ context.Context, token string) (*SeasonCard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.SeasonCard(token), keys.Info)
+	if item == nil {
+		return nil, nil
+	}
+	return seasonCardFromAttributeMap(item)
+}
+
+func (s *MemoryStore) DeleteSeasonCard(_ context.Context, driverID int64, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.SeasonCardToken)
+	s.delete(keys.SeasonCard(token), keys.Info)
+	return nil
+}
+
+func (s *MemoryStore) ConsumeSeasonCardFetch(_ context.Context, token string, windowStart time.Time, limit int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk, sk := keys.SeasonCard(token), keys.SeasonCardFetches(windowStart.Unix())
+	if item := s.get(pk, sk); item != nil {
+		if fetches, ok := getOptionalInt64Attr(item, "fetches"); ok && fetches >= int64(limit) {
+			return false, nil
+		}
+	}
+	s.update(pk, sk, func(item map[string]types.AttributeValue) {
+		item[ttlAttributeName] = ttlAttr(windowStart.Add(seasonCardFetchesTTLDuration).Unix())
+	})
+	s.add(pk, sk, "fetches", 1)
+	return true, nil
+}
+
+func (s *MemoryStore) SaveOverlay(_ context.Context, overlay Overlay, replacing string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(overlayModelFromEntity(overlay).toAttributeMap())
+	s.put(overlayTokenModel{driverID: overlay.DriverID, token: overlay.Token}.toAttributeMap())
+	if replacing != "" && replacing != overlay.Token {
+		s.delete(keys.Overlay(replacing), keys.Info)
+	}
+	return nil
+}
+
+func (s *MemoryStore) RefreshOverlay(_ context.Context, token, snapshot string, refreshedAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Overlay(token)
+	if s.get(pk, keys.Info) == nil {
+		return false, nil
+	}
+	s.update(pk, keys.Info, func(item map[string]types.AttributeValue) {
+		item["snapshot"] = &types.AttributeValueMemberS{Value: snapshot}
+		item["refreshed_at"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(refreshedAt.Unix(), 10)}
+	})
+	return true, nil
+}
+
+func (s *MemoryStore) GetOverlay(_ context.Context, driverID int64) (*Overlay, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.OverlayToken)
+	if item == nil {
+		return nil, nil
+	}
+	pointer, err := overlayTokenModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	item = s.get(keys.Overlay(pointer.token), keys.Info)
+	if item == nil {
+		return nil, nil
+	}
+	return overlayFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetOverlayByToken(_ context.Context, token string) (*Overlay, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Overlay(token), keys.Info)
+	if item == nil {
+		return nil, nil
+	}
+	return overlayFromAttributeMap(item)
+}
+
+func (s *MemoryStore) DeleteOverlay(_ context.Context, driverID int64, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.OverlayToken)
+	s.delete(keys.Overlay(token), keys.Info)
+	return nil
+}
+
+func (s *MemoryStore) SaveWeeklyRecap(_ context.Context, recap WeeklyRecap) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(weeklyRecapModelFromEntity(recap).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetWeeklyRecap(_ context.Context, driverID int64) (*WeeklyRecap, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.WeeklyRecap)
+	if item == nil {
+		return nil, nil
+	}
+	return weeklyRecapFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetIngestionCoverage(_ context.Context, driverID int64, _ ...ReadOption) (Coverage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.IngestionCoverage)
+	if item == nil {
+		return nil, nil
+	}
+	model, err := ingestionCoverageModelFromAttributeMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return model.ranges, nil
+}
+
+func (s *MemoryStore) AddIngestionCoverage(_ context.Context, driverID int64, ranges ...TimeRange) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	model := &ingestionCoverageModel{driverID: driverID}
+	if item := s.get(keys.Driver(driverID), keys.IngestionCoverage); item != nil {
+		var err error
+		if model, err = ingestionCoverageModelFromAttributeMap(item); err != nil {
+			return err
+		}
+	}
+	model.version++
+	model.ranges = model.ranges.Add(ranges...)
+	s.put(model.toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) SaveDriverStanding(_ context.Context, standing DriverStanding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(driverStandingModelFromEntity(standing).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetDriverStanding(_ context.Context, driverID int64, weekStart time.Time) (*DriverStanding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.DriverStanding(toUnixSeconds(weekStart)))
+	if item == nil {
+		return nil, nil
+	}
+	return driverStandingFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetDriverStandings(_ context.Context, driverID int64, from, to time.Time) ([]DriverStanding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Driver(driverID), between(
+		keys.DriverStanding(toUnixSeconds(from)),
+		keys.DriverStanding(toUnixSeconds(to)),
+	), true)
+	standings := make([]DriverStanding, 0, len(items))
+	for _, item := range items {
+		standing, err := driverStandingFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		standings = append(standings, *standing)
+	}
+	return standings, nil
+}
+
+func (s *MemoryStore) GetDriverSettings(_ context.Context, driverID int64) (*DriverSettings, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.DriverSettings)
+	if item == nil {
+		return &DriverSettings{DriverID: driverID}, nil
+	}
+	return driverSettingsFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetDriverSettingsForDrivers(_ context.Context, driverIDs []int64) ([]DriverSettings, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ret := make([]DriverSettings, len(driverIDs))
+	for i, driverID := range driverIDs {
+		ret[i] = DriverSettings{DriverID: driverID}
+		if item := s.get(keys.Driver(driverID), keys.DriverSettings); item != nil {
+			settings, err := driverSettingsFromAttributeMap(item)
+			if err != nil {
+				return nil, err
+			}
+			ret[i] = *settings
+		}
+	}
+	return ret, nil
+}
+
+func (s *MemoryStore) SaveDriverSettings(_ context.Context, settings DriverSettings) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(driverSettingsModelFromEntity(settings).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) ClearLapBackfillPending(_ context.Context, driverID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	if s.get(pk, keys.DriverSettings) == nil {
+		return conditionFailed()
+	}
+	s.update(pk, keys.DriverSettings, func(item map[string]types.AttributeValue) {
+		item["lap_backfill_pending"] = &types.AttributeValueMemberBOOL{Value: false}
+	})
+	return nil
+}
+
+func (s *MemoryStore) GetDriversActiveSince(_ context.Context, since time.Time) ([]Driver, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var drivers []Driver
+	for pk, partition := range s.items {
+		if !strings.HasPrefix(pk, keys.DriverPrefix) {
+			continue
+		}
+		item, ok := partition[keys.Info]
+		if !ok {
+			continue
+		}
+		if lastLogin, _ := getOptionalInt64Attr(item, "last_login"); lastLogin < since.Unix() {
+			continue
+		}
+		driver, err := driverFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		drivers = append(drivers, *driver)
+	}
+	return drivers, nil
+}
+
+func (s *MemoryStore) GetDriverSettingsWithLapRetention(_ context.Context) ([]DriverSettings, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var settings []DriverSettings
+	for _, partition := range s.items {
+		item, ok := partition[keys.DriverSettings]
+		if !ok {
+			continue
+		}
+		if retention, _ := getOptionalInt64Attr(item, "lap_retention_months"); retention <= 0 {
+			continue
+		}
+		setting, err := driverSettingsFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, *setting)
+	}
+	return settings, nil
+}
+
+func (s *MemoryStore) GetDriverSettingsWithLeaderboardOptIn(_ context.Context) ([]DriverSettings, error) {
+	return s.driverSettingsOptedIn("leaderboard_opt_in")
+}
+
+func (s *MemoryStore) GetDriverSettingsWithBenchmarkOptIn(_ context.Context) ([]DriverSettings, error) {
+	return s.driverSettingsOptedIn("benchmark_opt_in")
+}
+
+func (s *MemoryStore) driverSettingsOptedIn(optInAttribute string) ([]DriverSettings, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var settings []DriverSettings
+	for _, partition := range s.items {
+		item, ok := partition[keys.DriverSettings]
+		if !ok {
+			continue
+		}
+		if optIn, _ := getBoolAttr(item, optInAttribute); !optIn {
+			continue
+		}
+		setting, err := driverSettingsFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, *setting)
+	}
+	return settings, nil
+}
+
+func (s *MemoryStore) SaveIngestionRun(_ context.Context, run IngestionRun) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(ingestionRunModelFromEntity(run, s.now().Add(ingestionRunTTLDuration)).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) SaveIRacingProxyRequest(_ context.Context, request IRacingProxyRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(iRacingProxyRequestModelFromEntity(request, s.now().Add(iRacingProxyRequestTTLDuration)).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) SaveSessionArchive(_ context.Context, archive SessionArchive) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(sessionArchiveModelFromEntity(archive).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetSessionArchives(_ context.Context, subsessionID int64) ([]SessionArchive, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Session(subsessionID), hasPrefix(keys.SessionArchivePrefix), false)
+	archives := make([]SessionArchive, 0, len(items))
+	for _, item := range items {
+		archive, err := sessionArchiveFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		archives = append(archives, *archive)
+	}
+	return archives, nil
+}
+
+func (s *MemoryStore) GetIRacingProxyRequests(_ context.Context, driverID int64, limit int) ([]IRacingProxyRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Driver(driverID), hasPrefix(keys.IRacingProxyRequestPrefix), true)
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	requests := make([]IRacingProxyRequest, 0, len(items))
+	for _, item := range items {
+		request, err := iRacingProxyRequestFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *request)
+	}
+	return requests, nil
+}
+
+func (s *MemoryStore) SaveRequestCaptureMode(_ context.Context, mode RequestCaptureMode) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(requestCaptureModeModelFromEntity(mode).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetRequestCaptureMode(_ context.Context, driverID int64) (*RequestCaptureMode, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.RequestCaptureMode)
+	if item == nil || ttlExpired(item, s.now()) {
+		return nil, nil
+	}
+	return requestCaptureModeFromAttributeMap(item)
+}
+
+func (s *MemoryStore) DeleteRequestCaptureMode(_ context.Context, driverID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(keys.Driver(driverID), keys.RequestCaptureMode)
+	return nil
+}
+
+func (s *MemoryStore) SaveCapturedRequest(_ context.Context, request CapturedRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(capturedRequestModelFromEntity(request, s.now().Add(capturedRequestTTLDuration)).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetCapturedRequests(_ context.Context, driverID int64, limit int) ([]CapturedRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	from := keys.CapturedRequestsFrom(s.now().Add(-capturedRequestTTLDuration).UnixMilli())
+	items := s.query(keys.Driver(driverID), func(sk string) bool {
+		return strings.HasPrefix(sk, keys.CapturedRequestPrefix) && sk >= from
+	}, true)
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	requests := make([]CapturedRequest, 0, len(items))
+	for _, item := range items {
+		request, err := capturedRequestFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *request)
+	}
+	return requests, nil
+}
+
+func (s *MemoryStore) GetRecentIngestionRuns(_ context.Context, limit int) ([]IngestionRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.IngestionRuns, func(string) bool { return true }, true)
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	runs := make([]IngestionRun, 0, len(items))
+	for _, item := range items {
+		run, err := ingestionRunFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, *run)
+	}
+	return runs, nil
+}
+
+func (s *MemoryStore) GetIngestionTiers(_ context.Context) ([]IngestionTier, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.IngestionTiers, func(string) bool { return true }, false)
+	tiers := make([]IngestionTier, 0, len(items))
+	for _, item := range items {
+		tier, err := ingestionTierFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, *tier)
+	}
+	return tiers, nil
+}
+
+func (s *MemoryStore) SaveIngestionTier(_ context.Context, tier IngestionTier) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(ingestionTierModelFromEntity(tier).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) AddToWaitlist(_ context.Context, entry WaitlistEntry) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.get(keys.Waitlist, keys.WaitlistDriver(entry.DriverID)) != nil {
+		return false, nil
+	}
+	s.put(waitlistEntryModelFromEntity(entry).toAttributeMap())
+	return true, nil
+}
+
+func (s *MemoryStore) GetWaitlist(_ context.Context) ([]WaitlistEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Waitlist, hasPrefix(keys.WaitlistDriverPrefix), false)
+	entries := make([]WaitlistEntry, 0, len(items))
+	for _, item := range items {
+		entry, err := waitlistEntryFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+func (s *MemoryStore) GetInvitation(_ context.Context, driverID int64) (*Invitation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Invitations, keys.InvitationDriver(driverID))
+	if item == nil {
+		return nil, nil
+	}
+	return invitationFromAttributeMap(item)
+}
+
+func (s *MemoryStore) SaveInvitations(_ context.Context, invitations []Invitation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, invitation := range invitations {
+		s.put(invitationModelFromEntity(invitation).toAttributeMap())
+		s.delete(keys.Waitlist, keys.WaitlistDriver(invitation.DriverID))
+	}
+	return nil
+}
+
+func (s *MemoryStore) GetTermsAcceptances(_ context.Context, driverID int64) ([]TermsAcceptance, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Driver(driverID), hasPrefix(keys.TermsAcceptancePrefix), false)
+	acceptances := make([]TermsAcceptance, 0, len(items))
+	for _, item := range items {
+		acceptance, err := termsAcceptanceFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		acceptances = append(acceptances, *acceptance)
+	}
+	return acceptances, nil
+}
+
+func (s *MemoryStore) SaveTermsAcceptance(_ context.Context, acceptance TermsAcceptance) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.get(keys.Driver(acceptance.DriverID), keys.TermsAcceptance(acceptance.Document, acceptance.Version)) != nil {
+		return false, nil
+	}
+	s.put(termsAcceptanceModelFromEntity(acceptance).toAttributeMap())
+	return true, nil
+}
+
+func (s *MemoryStore) GetSupporter(_ context.Context, driverID int64) (*Supporter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.Supporter)
+	if item == nil {
+		return nil, nil
+	}
+	return supporterFromAttributeMap(item)
+}
+
+func (s *MemoryStore) SaveSupporter(_ context.Context, supporter Supporter) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing := s.get(keys.Driver(supporter.DriverID), keys.Supporter)
+	if existing != nil {
+		updatedAt, _ := getOptionalInt64Attr(existing, "updated_at")
+		if updatedAt > supporter.UpdatedAt.Unix() {
+			return false, nil
+		}
+	}
+	s.put(supporterModelFromEntity(supporter).toAttributeMap())
+	return true, nil
+}
+
+func (s *MemoryStore) GrantEntitlement(_ context.Context, driverID int64, entitlement string) error {
+	return s.updateEntitlements(driverID, func(current []string) []string {
+		if slices.Contains(current, entitlement) {
+			return current
+		}
+		return append(current, entitlement)
+	})
+}
+
+func (s *MemoryStore) RevokeEntitlement(_ context.Context, driverID int64, entitlement string) error {
+	return s.updateEntitlements(driverID, func(current []string) []string {
+		return slices.DeleteFunc(current, func(e string) bool { return e == entitlement })
+	})
+}
+
+func (s *MemoryStore) updateEntitlements(driverID int64, change func(current []string) []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.Driver(driverID)
+	item := s.get(pk, keys.Info)
+	if item == nil {
+		return fmt.Errorf("driver %d not found", driverID)
+	}
+	current, err := getOptionalStringSliceAttr(item, "entitlements")
+	if err != nil {
+		return err
+	}
+	updated := change(slices.Clone(current))
+	s.update(pk, keys.Info, func(item map[string]types.AttributeValue) {
+		if len(updated) == 0 {
+			delete(item, "entitlements")
+			return
+		}
+		item["entitlements"] = stringListAttr(updated)
+	})
+	return nil
+}
+
+func (s *MemoryStore) AddToDriverRollup(_ context.Context, driverID int64, scope string, subsessionID int64, totals SessionTotals) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addSessionTotals(map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.Driver(driverID)},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.DriverRollup(scope)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(driverID, 10)},
+		"scope":          &types.AttributeValueMemberS{Value: scope},
+	}, subsessionID, totals), nil
+}
+
+func (s *MemoryStore) AddToWeeklyLeaderboard(_ context.Context, weekStart time.Time, driverID, subsessionID int64, totals SessionTotals) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addSessionTotals(map[string]types.AttributeValue{
+		partitionKeyName: &types.AttributeValueMemberS{Value: keys.WeeklyLeaderboard(toUnixSeconds(weekStart))},
+		sortKeyName:      &types.AttributeValueMemberS{Value: keys.LeaderboardDriver(driverID)},
+		"week_start":     &types.AttributeValueMemberN{Value: strconv.FormatInt(toUnixSeconds(weekStart), 10)},
+		"driver_id":      &types.AttributeValueMemberN{Value: strconv.FormatInt(driverID, 10)},
+	}, subsessionID, totals), nil
+}
+
+// addSessionTotals mirrors DynamoStore.addSessionTotals, returning false when the subsession has already been counted.
+func (s *MemoryStore) addSessionTotals(identity map[string]types.AttributeValue, subsessionID int64, totals SessionTotals) bool {
+	pk := identity[partitionKeyName].(*types.AttributeValueMemberS).Value
+	sk := identity[sortKeyName].(*types.AttributeValueMemberS).Value
+	id := strconv.FormatInt(subsessionID, 10)
+
+	var counted []string
+	if existing, ok := s.get(pk, sk)["subsession_ids"].(*types.AttributeValueMemberNS); ok {
+		counted = existing.Value
+	}
+	if slices.Contains(counted, id) {
+		return false
+	}
+
+	s.update(pk, sk, func(item map[string]types.AttributeValue) {
+		maps.Copy(item, identity)
+		item["subsession_ids"] = &types.AttributeValueMemberNS{Value: append(slices.Clone(counted), id)}
+		for _, attr := range sessionTotalsAttributes {
+			current, _ := getOptionalInt64Attr(item, attr.name)
+			item[attr.name] = &types.AttributeValueMemberN{Value: strconv.FormatInt(current+int64(*attr.field(&totals)), 10)}
+		}
+	})
+	return true
+}
+
+func (s *MemoryStore) GetDriverRollup(_ context.Context, driverID int64, scope string) (*DriverRollup, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Driver(driverID), keys.DriverRollup(scope))
+	if item == nil {
+		return nil, nil
+	}
+	return driverRollupFromAttributeMap(item)
+}
+
+func (s *MemoryStore) GetWeeklyLeaderboard(_ context.Context, weekStart time.Time) ([]WeeklyLeaderboardEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var entries []WeeklyLeaderboardEntry
+	for _, item := range s.query(keys.WeeklyLeaderboard(toUnixSeconds(weekStart)), hasPrefix(""), false) {
+		entry, err := weeklyLeaderboardEntryFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+func (s *MemoryStore) SaveRankedLeaderboard(_ context.Context, board RankedLeaderboard, rankings []LeaderboardRanking) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.RankedLeaderboard(board.Board, toUnixSeconds(board.WeekStart))
+	for _, item := range s.query(pk, hasPrefix("rank#"), false) {
+		s.delete(pk, item[sortKeyName].(*types.AttributeValueMemberS).Value)
+	}
+	for _, ranking := range rankings {
+		s.put(leaderboardRankingModelFromEntity(board.Board, board.WeekStart, ranking).toAttributeMap())
+	}
+	board.TotalEntries = len(rankings)
+	s.put(rankedLeaderboardModelFromEntity(board).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetRankedLeaderboard(_ context.Context, board string, weekStart time.Time, offset, limit int) (*RankedLeaderboard, []LeaderboardRanking, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.RankedLeaderboard(board, toUnixSeconds(weekStart))
+	item := s.get(pk, keys.RankedLeaderboardBoard)
+	if item == nil {
+		return nil, nil, nil
+	}
+	leaderboard, err := rankedLeaderboardFromAttributeMap(item)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rankings := make([]LeaderboardRanking, 0, limit)
+	from := keys.LeaderboardRank(offset + 1)
+	to := keys.LeaderboardRank(offset + limit)
+	for _, item := range s.query(pk, between(from, to), false) {
+		ranking, err := leaderboardRankingFromAttributeMap(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		rankings = append(rankings, *ranking)
+	}
+	return leaderboard, rankings, nil
+}
+
+func (s *MemoryStore) GetRankedLeaderboardByClub(_ context.Context, board string, weekStart time.Time, clubID int) (*RankedLeaderboard, []LeaderboardRanking, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := keys.RankedLeaderboard(board, toUnixSeconds(weekStart))
+	item := s.get(pk, keys.RankedLeaderboardBoard)
+	if item == nil {
+		return nil, nil, nil
+	}
+	leaderboard, err := rankedLeaderboardFromAttributeMap(item)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rankings []LeaderboardRanking
+	for _, item := range s.query(pk, hasPrefix("rank#"), false) {
+		ranking, err := leaderboardRankingFromAttributeMap(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ranking.ClubID == clubID {
+			rankings = append(rankings, *ranking)
+		}
+	}
+	return leaderboard, rankings, nil
+}
+
+func (s *MemoryStore) SaveRegionWeeklyAggregate(_ context.Context, aggregate RegionWeeklyAggregate) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(regionWeeklyAggregateModelFromEntity(aggregate).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetRegionWeeklyAggregates(_ context.Context, clubID int, from, to time.Time) ([]RegionWeeklyAggregate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var aggregates []RegionWeeklyAggregate
+	fromKey := keys.RegionWeek(toUnixSeconds(from))
+	toKey := keys.RegionWeek(toUnixSeconds(to))
+	for _, item := range s.query(keys.Region(clubID), between(fromKey, toKey), false) {
+		aggregate, err := regionWeeklyAggregateFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		aggregates = append(aggregates, *aggregate)
+	}
+	return aggregates, nil
+}
+
+func (s *MemoryStore) SaveBenchmarkTable(_ context.Context, table BenchmarkTable) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(benchmarkTableModelFromEntity(table, table.ComputedAt.Add(benchmarkTTLDuration)).toAttributeMap())
+	return nil
+}
+
+func (s *MemoryStore) GetBenchmarkTable(_ context.Context, seriesID, trackID int64, iRatingBand int) (*BenchmarkTable, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.get(keys.Benchmark(seriesID, trackID), keys.BenchmarkBand(iRatingBand))
+	if item == nil {
+		return nil, nil
+	}
+	return benchmarkTableFromAttributeMap(item)
+}
+
+func (s *MemoryStore) RecordMilestone(_ context.Context, milestone DriverMilestone) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := driverMilestoneModelFromEntity(milestone).toAttributeMap()
+	existing := s.get(item[partitionKeyName].(*types.AttributeValueMemberS).Value, item[sortKeyName].(*types.AttributeValueMemberS).Value)
+	if existing != nil {
+		achievedAt, err := getInt64Attr(existing, "achieved_at")
+		if err != nil {
+			return false, err
+		}
+		if achievedAt <= toUnixSeconds(milestone.AchievedAt) {
+			return false, nil
+		}
+	}
+	s.put(item)
+	return true, nil
+}
+
+func (s *MemoryStore) GetDriverMilestones(_ context.Context, driverID int64) ([]DriverMilestone, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.query(keys.Driver(driverID), hasPrefix(keys.DriverMilestonePrefix), false)
+	milestones := make([]DriverMilestone, 0, len(items))
+	for _, item := range items {
+		milestone, err := driverMilestoneFromAttributeMap(item)
+		if err != nil {
+			return nil, err
+		}
+		milestones = append(milestones, *milestone)
+	}
+	return milestones, nil
+}
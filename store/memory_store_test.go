@@ -0,0 +1,1879 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMemoryStore(now time.Time) *MemoryStore {
+	s := NewMemoryStore()
+	s.now = func() time.Time {
+		return now
+	}
+	return s
+}
+
+func TestMemoryStore_Drivers(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	got, err := s.GetDriver(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	var conditionErr *types.ConditionalCheckFailedException
+	assert.ErrorAs(t, s.RecordLogin(ctx, 12345, time.Unix(2000, 0)), &conditionErr)
+	assert.ErrorAs(t, s.UpdateDriverClub(ctx, 12345, 7, "Great Plains"), &conditionErr)
+
+	driver := Driver{
+		DriverID:     12345,
+		DriverName:   "Jon Sabados",
+		MemberSince:  time.Unix(500, 0),
+		FirstLogin:   time.Unix(1000, 0),
+		LastLogin:    time.Unix(1000, 0),
+		LoginCount:   1,
+		Entitlements: []string{"developer"},
+	}
+	require.NoError(t, s.InsertDriver(ctx, driver))
+	assert.ErrorIs(t, s.InsertDriver(ctx, driver), ErrEntityAlreadyExists)
+
+	require.NoError(t, s.RecordLogin(ctx, 12345, time.Unix(2000, 0)))
+	require.NoError(t, s.UpdateDriverClub(ctx, 12345, 7, "Great Plains"))
+	require.NoError(t, s.UpdateDriverRacesIngestedTo(ctx, 12345, time.Unix(3000, 0)))
+	require.NoError(t, s.UpdateDriverRacesIngestedFrom(ctx, 12345, time.Unix(1500, 0)))
+
+	racesIngestedFrom := time.Unix(1500, 0)
+	racesIngestedTo := time.Unix(3000, 0)
+	expected := driver
+	expected.LastLogin = time.Unix(2000, 0)
+	expected.LoginCount = 2
+	expected.ClubID = 7
+	expected.ClubName = "Great Plains"
+	expected.RacesIngestedFrom = &racesIngestedFrom
+	expected.RacesIngestedTo = &racesIngestedTo
+	expected.SearchIndexed = true
+
+	got, err = s.GetDriver(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &expected, got)
+
+	counters, err := s.GetGlobalCounters(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &GlobalCounters{Drivers: 1}, counters)
+}
+
+func TestMemoryStore_DriverSearch(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 1, DriverName: "Jon Sabados"}))
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 2, DriverName: "Joe Racer"}))
+	require.NoError(t, s.UpdateDriverName(ctx, 2, "Joe Racer", "Joseph Racer"))
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 1, LeaderboardOptIn: true}))
+
+	got, err := s.SearchDrivers(ctx, " JO ", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSearchEntry{
+		{DriverID: 1, DriverName: "Jon Sabados"},
+		{DriverID: 2, DriverName: "Joseph Racer"},
+	}, got)
+
+	got, err = s.SearchDrivers(ctx, "joe", 10)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	settings, err := s.GetDriverSettingsForDrivers(ctx, []int64{2, 1})
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSettings{{DriverID: 2}, {DriverID: 1, LeaderboardOptIn: true}}, settings)
+}
+
+func TestMemoryStore_Onboarding(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	advanced, err := s.AdvanceOnboarding(ctx, 12345, "", OnboardingStepIngestionStarted)
+	require.NoError(t, err)
+	assert.False(t, advanced)
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{
+		DriverID:    12345,
+		DriverName:  "Jon Sabados",
+		MemberSince: time.Unix(500, 0),
+		FirstLogin:  time.Unix(1000, 0),
+		LastLogin:   time.Unix(1000, 0),
+		LoginCount:  1,
+	}))
+
+	advanced, err = s.AdvanceOnboarding(ctx, 12345, OnboardingStepProfileCreated, OnboardingStepIngestionStarted)
+	require.NoError(t, err)
+	assert.False(t, advanced)
+
+	advanced, err = s.AdvanceOnboarding(ctx, 12345, "", OnboardingStepIngestionStarted)
+	require.NoError(t, err)
+	assert.True(t, advanced)
+
+	advanced, err = s.AdvanceOnboarding(ctx, 12345, OnboardingStepIngestionStarted, OnboardingStepRaceIngested)
+	require.NoError(t, err)
+	assert.True(t, advanced)
+
+	got, err := s.GetDriver(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, OnboardingStepRaceIngested, got.OnboardingStep)
+}
+
+func TestMemoryStore_IngestionLock(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 1}))
+
+	acquired, err := s.AcquireIngestionLock(ctx, 1, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = s.AcquireIngestionLock(ctx, 1, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	driver, err := s.GetDriver(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, driver.IngestionBlockedUntil)
+	assert.Equal(t, now.Add(time.Minute), *driver.IngestionBlockedUntil)
+
+	s.now = func() time.Time {
+		return now.Add(2 * time.Minute)
+	}
+	acquired, err = s.AcquireIngestionLock(ctx, 1, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired, "expired locks should be reacquirable")
+
+	require.NoError(t, s.ReleaseIngestionLock(ctx, 1))
+	driver, err = s.GetDriver(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, driver.IngestionBlockedUntil)
+}
+
+func TestMemoryStore_Connections(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveConnection(ctx, WebSocketConnection{DriverID: 1, ConnectionID: "b"}))
+	require.NoError(t, s.SaveConnection(ctx, WebSocketConnection{DriverID: 1, ConnectionID: "a", OverlayToken: "tok"}))
+	require.NoError(t, s.SaveConnection(ctx, WebSocketConnection{DriverID: 2, ConnectionID: "c"}))
+
+	connections, err := s.GetConnectionsByDriver(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []WebSocketConnection{
+		{DriverID: 1, ConnectionID: "a", ConnectedAt: now, OverlayToken: "tok"},
+		{DriverID: 1, ConnectionID: "b", ConnectedAt: now},
+	}, connections)
+
+	driverID, err := s.GetDriverIDByConnection(ctx, "c")
+	require.NoError(t, err)
+	require.NotNil(t, driverID)
+	assert.Equal(t, int64(2), *driverID)
+
+	require.NoError(t, s.DeleteConnection(ctx, 2, "c"))
+	driverID, err = s.GetDriverIDByConnection(ctx, "c")
+	require.NoError(t, err)
+	assert.Nil(t, driverID)
+	conn, err := s.GetConnection(ctx, 2, "c")
+	require.NoError(t, err)
+	assert.Nil(t, conn)
+}
+
+func TestMemoryStore_Sessions(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 1}))
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 1, LapRetentionMonths: 3}))
+
+	older := DriverSession{DriverID: 1, SubsessionID: 100, StartTime: time.Unix(1000, 0), LapsSkipped: true}
+	newer := DriverSession{DriverID: 1, SubsessionID: 200, StartTime: time.Unix(2000, 0), Positions: []int{3, 2, 2}}
+	require.NoError(t, s.SaveDriverSessions(ctx, []DriverSession{older}))
+	assert.ErrorIs(t, s.SaveDriverSessions(ctx, []DriverSession{older}), ErrEntityAlreadyExists)
+
+	laps := []SessionDriverLap{
+		{SubsessionID: 200, DriverID: 1, LapNumber: 10, LapTime: -1, Synthetic: true},
+		{SubsessionID: 200, DriverID: 1, LapNumber: 2, LapTime: 910000},
+		{SubsessionID: 200, DriverID: 2, LapNumber: 1, LapTime: 920000},
+	}
+	require.NoError(t, s.PersistSessionData(ctx, newer, laps))
+	assert.ErrorIs(t, s.PersistSessionData(ctx, newer, laps), ErrEntityAlreadyExists)
+
+	sessions, err := s.GetDriverSessionsByTimeRange(ctx, 1, time.Unix(0, 0), time.Unix(5000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSession{newer, older}, sessions)
+
+	sessions, err = s.GetDriverSessionsByTimeRange(ctx, 1, time.Unix(1500, 0), time.Unix(5000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSession{newer}, sessions)
+
+	gotLaps, err := s.GetSessionDriverLaps(ctx, 200, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []SessionDriverLap{laps[1], laps[0]}, gotLaps)
+
+	skipped, err := s.GetDriverSessionsWithSkippedLaps(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSession{older}, skipped)
+
+	trafficCost := 42
+	require.NoError(t, s.CompleteDriverSessionLaps(ctx, 1, older.StartTime, &trafficCost, 1))
+	completed, err := s.GetDriverSession(ctx, 1, older.StartTime)
+	require.NoError(t, err)
+	assert.False(t, completed.LapsSkipped)
+	assert.Equal(t, &trafficCost, completed.TrafficCost)
+	assert.Equal(t, 1, completed.LapGaps)
+
+	driver, err := s.GetDriver(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), driver.SessionCount)
+
+	require.NoError(t, s.DeleteDriverRaces(ctx, 1))
+	sessions, err = s.GetDriverSessionsByTimeRange(ctx, 1, time.Unix(0, 0), time.Unix(5000, 0))
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+	driver, err = s.GetDriver(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), driver.SessionCount)
+	settings, err := s.GetDriverSettings(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, settings.LapRetentionMonths, "settings survive race deletion")
+}
+
+func TestMemoryStore_SessionWriteModes(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 1}))
+
+	saved := DriverSession{DriverID: 1, SubsessionID: 100, StartTime: time.Unix(1000, 0), Incidents: 4}
+	fresh := DriverSession{DriverID: 1, SubsessionID: 200, StartTime: time.Unix(2000, 0)}
+	require.NoError(t, s.SaveDriverSessions(ctx, []DriverSession{saved}))
+
+	changed := saved
+	changed.Incidents = 0
+	result, err := s.SaveDriverSessionsWithMode(ctx, []DriverSession{changed, fresh}, WriteModeSkipExisting)
+	require.NoError(t, err)
+	assert.Equal(t, WriteResult{Existing: []DriverSession{changed}}, result)
+	got, err := s.GetDriverSession(ctx, 1, saved.StartTime)
+	require.NoError(t, err)
+	assert.Equal(t, &saved, got)
+
+	laps := []SessionDriverLap{{SubsessionID: 100, DriverID: 1, LapNumber: 1, LapTime: 900000}}
+	result, err = s.PersistSessionDataWithMode(ctx, changed, laps, WriteModeUpsert)
+	require.NoError(t, err)
+	assert.Equal(t, WriteResult{Existing: []DriverSession{changed}}, result)
+	got, err = s.GetDriverSession(ctx, 1, saved.StartTime)
+	require.NoError(t, err)
+	assert.Equal(t, &changed, got)
+	gotLaps, err := s.GetSessionDriverLaps(ctx, 100, 1)
+	require.NoError(t, err)
+	assert.Equal(t, laps, gotLaps)
+
+	driver, err := s.GetDriver(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), driver.SessionCount, "existing sessions aren't counted again")
+}
+
+func TestMemoryStore_JournalEntries(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 1, RaceID: 1000, Notes: "first", Tags: []string{"podium"}}))
+
+	later := now.Add(time.Hour)
+	s.now = func() time.Time {
+		return later
+	}
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 1, RaceID: 1000, Notes: "edited"}))
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 1, RaceID: 2000, Notes: "second"}))
+
+	entry, err := s.GetJournalEntry(ctx, 1, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, &RaceJournalEntry{
+		DriverID:  1,
+		RaceID:    1000,
+		CreatedAt: now,
+		UpdatedAt: later,
+		Notes:     "edited",
+		Tags:      []string{},
+	}, entry)
+
+	entries, err := s.GetJournalEntries(ctx, 1, time.Unix(0, 0), time.Unix(5000, 0))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, int64(2000), entries[0].RaceID)
+	assert.Equal(t, int64(1000), entries[1].RaceID)
+
+	require.NoError(t, s.DeleteJournalEntry(ctx, 1, 1000))
+	entry, err = s.GetJournalEntry(ctx, 1, 1000)
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+}
+
+func TestMemoryStore_JournalDrafts(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 1, RaceID: 1000, Notes: "published"}))
+	require.NoError(t, s.SaveJournalDraft(ctx, RaceJournalDraft{DriverID: 1, RaceID: 1000, ExpiresAt: now.Add(time.Hour), Notes: "work in progress", Tags: []string{"podium"}}))
+
+	draft, err := s.GetJournalDraft(ctx, 1, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, &RaceJournalDraft{
+		DriverID:  1,
+		RaceID:    1000,
+		SavedAt:   now,
+		ExpiresAt: now.Add(time.Hour),
+		Notes:     "work in progress",
+		Tags:      []string{"podium"},
+	}, draft)
+
+	// drafts are not journal entries
+	entries, err := s.GetJournalEntries(ctx, 1, time.Unix(0, 0), time.Unix(5000, 0))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "published", entries[0].Notes)
+
+	s.now = func() time.Time {
+		return now.Add(time.Hour)
+	}
+	draft, err = s.GetJournalDraft(ctx, 1, 1000)
+	require.NoError(t, err)
+	assert.Nil(t, draft, "expired drafts should be treated as absent")
+
+	require.NoError(t, s.SaveJournalDraft(ctx, RaceJournalDraft{DriverID: 1, RaceID: 2000, ExpiresAt: now.Add(2 * time.Hour)}))
+	require.NoError(t, s.DeleteJournalDraft(ctx, 1, 2000))
+	draft, err = s.GetJournalDraft(ctx, 1, 2000)
+	require.NoError(t, err)
+	assert.Nil(t, draft)
+}
+
+func TestMemoryStore_JournalPrompts(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	evaluation := &PlanEvaluation{
+		Targets:     []PlanTarget{{Kind: PlanTargetFinishPosition, Target: 10, Actual: 7, Met: true}},
+		EvaluatedAt: now,
+	}
+	require.NoError(t, s.SaveJournalPrompt(ctx, JournalPrompt{DriverID: 1, RaceID: 1000}))
+	require.NoError(t, s.SaveJournalPrompt(ctx, JournalPrompt{DriverID: 1, RaceID: 2000}))
+	require.NoError(t, s.SaveJournalPrompt(ctx, JournalPrompt{DriverID: 1, RaceID: 3000, PlanEvaluation: evaluation}))
+	require.NoError(t, s.SaveJournalPrompt(ctx, JournalPrompt{DriverID: 2, RaceID: 2000}))
+
+	prompts, err := s.GetJournalPrompts(ctx, 1, time.Unix(1500, 0), time.Unix(5000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []JournalPrompt{
+		{DriverID: 1, RaceID: 3000, CreatedAt: now, PlanEvaluation: evaluation},
+		{DriverID: 1, RaceID: 2000, CreatedAt: now},
+	}, prompts)
+
+	require.NoError(t, s.DeleteJournalPrompt(ctx, 1, 3000))
+	prompts, err = s.GetJournalPrompts(ctx, 1, time.Unix(0, 0), time.Unix(5000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []JournalPrompt{
+		{DriverID: 1, RaceID: 2000, CreatedAt: now},
+		{DriverID: 1, RaceID: 1000, CreatedAt: now},
+	}, prompts)
+
+	s.now = func() time.Time {
+		return now.Add(journalPromptTTLDuration)
+	}
+	prompts, err = s.GetJournalPrompts(ctx, 1, time.Unix(0, 0), time.Unix(5000, 0))
+	require.NoError(t, err)
+	assert.Empty(t, prompts, "expired prompts should be ignored")
+}
+
+func TestMemoryStore_JournalStreak(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	streak, err := s.GetJournalStreak(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, streak)
+
+	require.NoError(t, s.SaveJournalStreak(ctx, JournalStreak{DriverID: 1, CurrentWeeks: 3, LongestWeeks: 5, LastWeekStart: time.Unix(7200, 0)}))
+	streak, err = s.GetJournalStreak(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &JournalStreak{DriverID: 1, CurrentWeeks: 3, LongestWeeks: 5, LastWeekStart: time.Unix(7200, 0), UpdatedAt: now}, streak)
+
+	other, err := s.GetJournalStreak(ctx, 2)
+	require.NoError(t, err)
+	assert.Nil(t, other)
+}
+
+func TestMemoryStore_JournalTranscripts(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	appended, err := s.AppendJournalTranscript(ctx, 1, 1000, "no entry yet", []string{"entry"})
+	require.NoError(t, err)
+	assert.False(t, appended)
+
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 1, RaceID: 1000, Notes: "spun at turn one", SearchTerms: []string{"spun", "turn", "one"}}))
+	appended, err = s.AppendJournalTranscript(ctx, 1, 1000, "braked too late", []string{"braked", "too", "late"})
+	require.NoError(t, err)
+	assert.True(t, appended)
+	appended, err = s.AppendJournalTranscript(ctx, 1, 1000, "too much throttle", []string{"too", "much", "throttle"})
+	require.NoError(t, err)
+	assert.True(t, appended)
+
+	// saving the entry again replaces its own terms but keeps the transcripts
+	require.NoError(t, s.SaveJournalEntry(ctx, RaceJournalEntry{DriverID: 1, RaceID: 1000, Notes: "edited"}))
+
+	entry, err := s.GetJournalEntry(ctx, 1, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, "edited", entry.Notes)
+	assert.Nil(t, entry.SearchTerms)
+	assert.Equal(t, []string{"braked too late", "too much throttle"}, entry.Transcripts)
+	assert.ElementsMatch(t, []string{"braked", "too", "late", "much", "throttle"}, entry.TranscriptTerms)
+}
+
+func TestMemoryStore_JournalAttachments(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveJournalAttachment(ctx, JournalAttachment{DriverID: 1, RaceID: 1000, AttachmentID: "b", ContentType: "audio/webm", CreatedAt: now, Status: JournalAttachmentPendingUpload, Transcribe: true}))
+	require.NoError(t, s.SaveJournalAttachment(ctx, JournalAttachment{DriverID: 1, RaceID: 1000, AttachmentID: "a", ContentType: "audio/mpeg", CreatedAt: now, Status: JournalAttachmentUploaded}))
+	require.NoError(t, s.SaveJournalAttachment(ctx, JournalAttachment{DriverID: 1, RaceID: 2000, AttachmentID: "c", ContentType: "audio/mpeg", CreatedAt: now, Status: JournalAttachmentUploaded}))
+
+	updated, err := s.SetJournalAttachmentStatus(ctx, 1, 1000, "b", JournalAttachmentTranscribing)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	updated, err = s.SetJournalAttachmentStatus(ctx, 1, 1000, "missing", JournalAttachmentTranscribing)
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	attachment, err := s.GetJournalAttachment(ctx, 1, 1000, "b")
+	require.NoError(t, err)
+	assert.Equal(t, &JournalAttachment{DriverID: 1, RaceID: 1000, AttachmentID: "b", ContentType: "audio/webm", CreatedAt: now, Status: JournalAttachmentTranscribing, Transcribe: true}, attachment)
+
+	attachments, err := s.GetJournalAttachments(ctx, 1, 1000)
+	require.NoError(t, err)
+	require.Len(t, attachments, 2)
+	assert.Equal(t, "a", attachments[0].AttachmentID)
+	assert.Equal(t, "b", attachments[1].AttachmentID)
+}
+
+func TestMemoryStore_ActionItems(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	dueDate := time.Unix(20000, 0)
+	open := ActionItem{DriverID: 1, ItemID: "b", Title: "Brake earlier into T1", Status: ActionItemOpen, RaceID: 1000, TrackID: 42, DueDate: &dueDate, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, s.SaveActionItem(ctx, open))
+	require.NoError(t, s.SaveActionItem(ctx, ActionItem{DriverID: 1, ItemID: "a", Title: "Practice starts", Status: ActionItemDone, CreatedAt: now, UpdatedAt: now, CompletedAt: &now}))
+	require.NoError(t, s.SaveActionItem(ctx, ActionItem{DriverID: 2, ItemID: "c", Title: "Someone else's", Status: ActionItemOpen, CreatedAt: now, UpdatedAt: now}))
+
+	item, err := s.GetActionItem(ctx, 1, "b")
+	require.NoError(t, err)
+	assert.Equal(t, &open, item)
+
+	items, err := s.GetActionItems(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "a", items[0].ItemID)
+	assert.Equal(t, &now, items[0].CompletedAt)
+	assert.Equal(t, "b", items[1].ItemID)
+
+	require.NoError(t, s.DeleteActionItem(ctx, 1, "b"))
+	item, err = s.GetActionItem(ctx, 1, "b")
+	require.NoError(t, err)
+	assert.Nil(t, item)
+}
+
+func TestMemoryStore_AlertRules(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	incidents := AlertRule{DriverID: 1, RuleID: "b", Name: "Incident creep", Metric: AlertMetricIncidents, Aggregate: AlertAggregateAverage, WindowRaces: 10, Comparison: AlertComparisonAbove, Threshold: 5, Enabled: true, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, s.SaveAlertRule(ctx, incidents))
+	require.NoError(t, s.SaveAlertRule(ctx, AlertRule{DriverID: 1, RuleID: "a", Name: "iRating slide", Metric: AlertMetricIRating, Aggregate: AlertAggregateChange, WindowDays: 7, Comparison: AlertComparisonBelow, Threshold: -200, Triggered: true, LastTriggeredAt: &now, LastValue: -231, CreatedAt: now, UpdatedAt: now}))
+	require.NoError(t, s.SaveAlertRule(ctx, AlertRule{DriverID: 2, RuleID: "c", Name: "Someone else's", Metric: AlertMetricIncidents, Aggregate: AlertAggregateAverage, WindowRaces: 5, Comparison: AlertComparisonAbove, Threshold: 4, CreatedAt: now, UpdatedAt: now}))
+
+	rule, err := s.GetAlertRule(ctx, 1, "b")
+	require.NoError(t, err)
+	assert.Equal(t, &incidents, rule)
+
+	rules, err := s.GetAlertRules(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, "a", rules[0].RuleID)
+	assert.Equal(t, &now, rules[0].LastTriggeredAt)
+	assert.Equal(t, -231.0, rules[0].LastValue)
+	assert.Equal(t, "b", rules[1].RuleID)
+
+	require.NoError(t, s.DeleteAlertRule(ctx, 1, "b"))
+	rule, err = s.GetAlertRule(ctx, 1, "b")
+	require.NoError(t, err)
+	assert.Nil(t, rule)
+}
+
+func TestMemoryStore_RacePlans(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	weekStart := time.Unix(1699920000, 0)
+	plan := RacePlan{
+		DriverID:        12345,
+		WeekStart:       weekStart,
+		SeriesID:        260,
+		TrackID:         47,
+		Goals:           "Clean race, top 5",
+		SetupIntentions: "Less rear wing",
+		CreatedAt:       time.Unix(1700000000, 0),
+		UpdatedAt:       time.Unix(1700000000, 0),
+	}
+	require.NoError(t, s.SaveRacePlan(ctx, plan))
+	require.NoError(t, s.SaveRacePlan(ctx, RacePlan{DriverID: 12345, WeekStart: weekStart.AddDate(0, 0, 7), SeriesID: 260, TrackID: 12, Goals: "Next week", CreatedAt: plan.CreatedAt, UpdatedAt: plan.UpdatedAt}))
+	require.NoError(t, s.SaveRacePlan(ctx, RacePlan{DriverID: 999, WeekStart: weekStart, SeriesID: 260, TrackID: 47, Goals: "Someone else's", CreatedAt: plan.CreatedAt, UpdatedAt: plan.UpdatedAt}))
+
+	got, err := s.GetRacePlan(ctx, 12345, weekStart, 260, 47)
+	require.NoError(t, err)
+	assert.Equal(t, &plan, got)
+
+	all, err := s.GetRacePlans(ctx, 12345)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, int64(47), all[0].TrackID)
+	assert.Equal(t, int64(12), all[1].TrackID)
+
+	evaluation := &PlanEvaluation{
+		Targets: []PlanTarget{
+			{Kind: PlanTargetFinishPosition, Target: 5, Actual: 3, Met: true},
+			{Kind: PlanTargetIncidents, Target: 0, Actual: 4, Met: false},
+		},
+		EvaluatedAt: time.Unix(1700220000, 0),
+	}
+	linked, err := s.LinkRacePlan(ctx, 12345, weekStart, 260, 47, 1700200000, 2002, time.Unix(1700210000, 0), &PlanEvaluation{EvaluatedAt: time.Unix(1700210000, 0)})
+	require.NoError(t, err)
+	assert.True(t, linked)
+	// an earlier race takes the link over
+	linked, err = s.LinkRacePlan(ctx, 12345, weekStart, 260, 47, 1700100000, 1001, time.Unix(1700220000, 0), evaluation)
+	require.NoError(t, err)
+	assert.True(t, linked)
+	// a later one doesn't
+	linked, err = s.LinkRacePlan(ctx, 12345, weekStart, 260, 47, 1700300000, 3003, time.Unix(1700230000, 0), nil)
+	require.NoError(t, err)
+	assert.False(t, linked)
+	// nor do races nobody planned
+	linked, err = s.LinkRacePlan(ctx, 12345, weekStart, 260, 99, 1700100000, 1001, time.Unix(1700220000, 0), nil)
+	require.NoError(t, err)
+	assert.False(t, linked)
+
+	got, err = s.GetRacePlan(ctx, 12345, weekStart, 260, 47)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1700100000), got.RaceID)
+	assert.Equal(t, int64(1001), got.SubsessionID)
+	assert.Equal(t, time.Unix(1700220000, 0), got.LinkedAt)
+	assert.Equal(t, "Clean race, top 5", got.Goals)
+	assert.Equal(t, evaluation, got.Evaluation)
+
+	require.NoError(t, s.DeleteRacePlan(ctx, 12345, weekStart, 260, 47))
+	// idempotent
+	require.NoError(t, s.DeleteRacePlan(ctx, 12345, weekStart, 260, 47))
+	missing, err := s.GetRacePlan(ctx, 12345, weekStart, 260, 47)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestMemoryStore_RaceBookmarks(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	pass := RaceBookmark{DriverID: 1, RaceID: 1000, BookmarkID: "b", Lap: 3, Note: "Pass into T1", ReplayTime: 4*time.Minute + 1500*time.Millisecond, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, s.SaveRaceBookmark(ctx, pass))
+	require.NoError(t, s.SaveRaceBookmark(ctx, RaceBookmark{DriverID: 1, RaceID: 1000, BookmarkID: "a", Lap: 1, Note: "Start", CreatedAt: now, UpdatedAt: now}))
+	require.NoError(t, s.SaveRaceBookmark(ctx, RaceBookmark{DriverID: 1, RaceID: 2000, BookmarkID: "c", Lap: 1, Note: "Another race", CreatedAt: now, UpdatedAt: now}))
+	require.NoError(t, s.SaveRaceBookmark(ctx, RaceBookmark{DriverID: 2, RaceID: 1000, BookmarkID: "d", Lap: 1, Note: "Someone else's", CreatedAt: now, UpdatedAt: now}))
+
+	bookmark, err := s.GetRaceBookmark(ctx, 1, 1000, "b")
+	require.NoError(t, err)
+	assert.Equal(t, &pass, bookmark)
+
+	bookmarks, err := s.GetRaceBookmarks(ctx, 1, 1000)
+	require.NoError(t, err)
+	require.Len(t, bookmarks, 2)
+	assert.Equal(t, "a", bookmarks[0].BookmarkID)
+	assert.Equal(t, "b", bookmarks[1].BookmarkID)
+
+	require.NoError(t, s.DeleteRaceBookmark(ctx, 1, 1000, "b"))
+	bookmark, err = s.GetRaceBookmark(ctx, 1, 1000, "b")
+	require.NoError(t, err)
+	assert.Nil(t, bookmark)
+}
+
+func TestMemoryStore_VideoLinks(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	lap := 4
+	onLap := VideoLink{
+		DriverID: 1, RaceID: 1000, LinkID: "b", Lap: &lap, URL: "https://www.youtube.com/watch?v=abc123&t=95s", Provider: "youtube",
+		Title: "Turn one chaos", AuthorName: "Some Streamer", ThumbnailURL: "https://i.ytimg.com/vi/abc123/hqdefault.jpg", CreatedAt: now,
+	}
+	require.NoError(t, s.SaveVideoLink(ctx, onLap))
+	require.NoError(t, s.SaveVideoLink(ctx, VideoLink{DriverID: 1, RaceID: 1000, LinkID: "a", URL: "https://example.com/clip", Provider: "other", CreatedAt: now}))
+	require.NoError(t, s.SaveVideoLink(ctx, VideoLink{DriverID: 1, RaceID: 2000, LinkID: "c", URL: "https://example.com/other", Provider: "other", CreatedAt: now}))
+
+	links, err := s.GetVideoLinks(ctx, 1, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, []VideoLink{
+		{DriverID: 1, RaceID: 1000, LinkID: "a", URL: "https://example.com/clip", Provider: "other", CreatedAt: now},
+		onLap,
+	}, links)
+
+	require.NoError(t, s.DeleteVideoLink(ctx, 1, 1000, "b"))
+	links, err = s.GetVideoLinks(ctx, 1, 1000)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, "a", links[0].LinkID)
+}
+
+func TestMemoryStore_RaceTelemetry(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	telemetry, err := s.GetRaceTelemetry(ctx, 1, 1000)
+	require.NoError(t, err)
+	assert.Nil(t, telemetry)
+
+	saved := RaceTelemetry{
+		DriverID: 1,
+		RaceID:   1000,
+		Stints: []TelemetryStint{
+			{Stint: 1, StartLap: 1, EndLap: 12, Laps: 12, FuelPerLap: 2.35, Tires: []TireSummary{
+				{Corner: TireCornerLF, AvgTemp: 82.5, StartPressure: 165.2, EndPressure: 178.9},
+				{Corner: TireCornerRF, AvgTemp: 88.25, StartPressure: 165.4, EndPressure: 181.3},
+			}},
+			{Stint: 2, StartLap: 13, EndLap: 20, Laps: 8, Tires: []TireSummary{}},
+		},
+		ImportedAt: time.Unix(20000, 0),
+	}
+	require.NoError(t, s.SaveRaceTelemetry(ctx, saved))
+
+	telemetry, err = s.GetRaceTelemetry(ctx, 1, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, &saved, telemetry)
+
+	telemetry, err = s.GetRaceTelemetry(ctx, 1, 2000)
+	require.NoError(t, err)
+	assert.Nil(t, telemetry)
+}
+
+func TestMemoryStore_ExternalLaps(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	lap := func(sessionID string, start int64, lapNumber int) ExternalLap {
+		return ExternalLap{
+			DriverID: 1, Source: "garage61", SessionID: sessionID, SessionStart: time.Unix(start, 0),
+			TrackID: 42, CarID: 7, LapNumber: lapNumber, LapTime: 950000 + lapNumber,
+		}
+	}
+	require.NoError(t, s.SaveExternalLaps(ctx, []ExternalLap{
+		lap("late", 30000, 1),
+		lap("early", 20000, 2),
+		lap("early", 20000, 1),
+		lap("before", 19999, 1),
+	}))
+
+	laps, err := s.GetExternalLapsByTimeRange(ctx, 1, time.Unix(20000, 0), time.Unix(30000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []ExternalLap{lap("early", 20000, 1), lap("early", 20000, 2), lap("late", 30000, 1)}, laps)
+
+	laps, err = s.GetExternalLapsByTimeRange(ctx, 2, time.Unix(0, 0), time.Unix(40000, 0))
+	require.NoError(t, err)
+	assert.Empty(t, laps)
+}
+
+func TestMemoryStore_SessionLapsInRaceOrder(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	lap := func(driverID int64, lapNumber, sessionTime int) SessionDriverLap {
+		return SessionDriverLap{SubsessionID: 12345, DriverID: driverID, LapNumber: lapNumber, SessionTime: sessionTime, LapTime: 900000 + lapNumber}
+	}
+	require.NoError(t, s.SaveSessionDriverLaps(ctx, []SessionDriverLap{
+		lap(2, 1, 1950000),
+		lap(1, 1, 1900000),
+		lap(1, 2, 2850000),
+		lap(2, 2, 2850000),
+		lap(10, 1, -1),
+		{SubsessionID: 99999, DriverID: 1, LapNumber: 1, SessionTime: 100},
+	}))
+
+	laps, more, err := s.GetSessionLapsInRaceOrder(ctx, 12345, "", 2)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.Equal(t, []SessionDriverLap{lap(1, 1, 1900000), lap(2, 1, 1950000)}, laps)
+
+	// a lap completed earlier showing up later doesn't shift the next page
+	require.NoError(t, s.SaveSessionDriverLaps(ctx, []SessionDriverLap{lap(3, 1, 1800000)}))
+
+	laps, more, err = s.GetSessionLapsInRaceOrder(ctx, 12345, RaceOrderCursor(laps[1]), 2)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Equal(t, []SessionDriverLap{lap(1, 2, 2850000), lap(2, 2, 2850000)}, laps)
+
+	laps, more, err = s.GetSessionLapsInRaceOrder(ctx, 12345, RaceOrderCursor(laps[1]), 2)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Empty(t, laps)
+}
+
+func TestMemoryStore_PracticePlans(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	plan, err := s.GetPracticePlan(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, plan)
+
+	saved := PracticePlan{
+		DriverID:    1,
+		GeneratedAt: time.Unix(20000, 0),
+		WindowStart: time.Unix(10000, 0),
+		Items: []PracticePlanItem{
+			{TrackID: 42, CarID: 7, Focus: PracticeFocusIncidents, RaceCount: 3, Value: 2.5, Baseline: 1.25},
+		},
+	}
+	require.NoError(t, s.SavePracticePlan(ctx, saved))
+
+	plan, err = s.GetPracticePlan(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &saved, plan)
+}
+
+func TestMemoryStore_WeeklyRecaps(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	recap, err := s.GetWeeklyRecap(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, recap)
+
+	saved := WeeklyRecap{
+		DriverID:    1,
+		WeekStart:   time.Unix(10000, 0),
+		GeneratedAt: time.Unix(20000, 0),
+		Fatigue:     &FatigueFlag{MultiRaceDays: 3, Measures: []FatigueMeasure{FatigueMeasureFinishPosition}},
+		Highlights:  []string{"Seu plano de treino da semana está pronto."},
+	}
+	require.NoError(t, s.SaveWeeklyRecap(ctx, saved))
+
+	recap, err = s.GetWeeklyRecap(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &saved, recap)
+}
+
+func TestMemoryStore_DashboardLayouts(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	layout, err := s.GetDashboardLayout(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, layout)
+
+	saved := DashboardLayout{
+		DriverID:      1,
+		SchemaVersion: 1,
+		Widgets: []DashboardWidget{
+			{WidgetID: "w1", Card: "irating", Column: 0, Row: 0, Width: 12, Height: 4, Days: 30, Granularity: "day", GroupBy: []string{"series"}, SeriesIDs: []int64{285}, CarIDs: []int64{7}, TrackIDs: []int64{42}},
+			{WidgetID: "w2", Card: "finishPosition", Column: 0, Row: 4, Width: 6, Height: 3},
+		},
+		UpdatedAt: time.Unix(20000, 0),
+	}
+	require.NoError(t, s.SaveDashboardLayout(ctx, saved))
+
+	layout, err = s.GetDashboardLayout(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &saved, layout)
+}
+
+func TestMemoryStore_IngestionCoverage(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	coverage, err := s.GetIngestionCoverage(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, coverage)
+
+	require.NoError(t, s.AddIngestionCoverage(ctx, 1, TimeRange{From: time.Unix(300, 0), To: time.Unix(400, 0)}))
+	require.NoError(t, s.AddIngestionCoverage(ctx, 1,
+		TimeRange{From: time.Unix(100, 0), To: time.Unix(200, 0)},
+		TimeRange{From: time.Unix(350, 0), To: time.Unix(500, 0)},
+	))
+
+	coverage, err = s.GetIngestionCoverage(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, Coverage{
+		{From: time.Unix(100, 0), To: time.Unix(200, 0)},
+		{From: time.Unix(300, 0), To: time.Unix(500, 0)},
+	}, coverage)
+}
+
+func TestMemoryStore_IngestionCancel(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	requested, err := s.IngestionCancelRequested(ctx, 1)
+	require.NoError(t, err)
+	assert.False(t, requested)
+
+	require.NoError(t, s.RequestIngestionCancel(ctx, 1))
+	requested, err = s.IngestionCancelRequested(ctx, 1)
+	require.NoError(t, err)
+	assert.True(t, requested)
+
+	require.NoError(t, s.ClearIngestionCancel(ctx, 1))
+	requested, err = s.IngestionCancelRequested(ctx, 1)
+	require.NoError(t, err)
+	assert.False(t, requested)
+
+	require.NoError(t, s.RequestIngestionCancel(ctx, 1))
+	s.now = func() time.Time {
+		return now.Add(2 * time.Hour)
+	}
+	requested, err = s.IngestionCancelRequested(ctx, 1)
+	require.NoError(t, err)
+	assert.False(t, requested, "expired cancels should be ignored")
+}
+
+func TestMemoryStore_Presence(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	presences, err := s.GetDriverPresences(ctx, []int64{1, 2})
+	require.NoError(t, err)
+	assert.Empty(t, presences)
+
+	require.NoError(t, s.SaveDriverPresence(ctx, DriverPresence{DriverID: 2, TrackID: 10, CarID: 20, SeriesName: "Formula Vee"}))
+	require.NoError(t, s.SaveDriverPresence(ctx, DriverPresence{DriverID: 1}))
+
+	presences, err = s.GetDriverPresences(ctx, []int64{2, 1, 3})
+	require.NoError(t, err)
+	assert.Equal(t, []DriverPresence{
+		{DriverID: 1, UpdatedAt: now},
+		{DriverID: 2, TrackID: 10, CarID: 20, SeriesName: "Formula Vee", UpdatedAt: now},
+	}, presences)
+
+	s.now = func() time.Time {
+		return now.Add(presenceTTLDuration)
+	}
+	presences, err = s.GetDriverPresences(ctx, []int64{1, 2})
+	require.NoError(t, err)
+	assert.Empty(t, presences, "presence without a recent heartbeat should be ignored")
+}
+
+func TestMemoryStore_AuthSessions(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(1000, 0))
+	ctx := context.Background()
+
+	older := AuthSession{DriverID: 12345, SessionID: "session-a", UserAgent: "Firefox", IPPrefix: "203.0.113.0/24", CreatedAt: time.Unix(1000, 0), IssuedAt: time.Unix(1000, 0), ExpiresAt: time.Unix(90000, 0)}
+	newer := AuthSession{DriverID: 12345, SessionID: "session-b", UserAgent: "Chrome", IPPrefix: "2001:db8:1::/48", CreatedAt: time.Unix(2000, 0), IssuedAt: time.Unix(3000, 0), ExpiresAt: time.Unix(90000, 0)}
+	expired := AuthSession{DriverID: 12345, SessionID: "session-c", CreatedAt: time.Unix(100, 0), IssuedAt: time.Unix(100, 0), ExpiresAt: time.Unix(500, 0)}
+	for _, session := range []AuthSession{older, newer, expired} {
+		saved, err := s.SaveAuthSession(ctx, session)
+		require.NoError(t, err)
+		assert.True(t, saved)
+	}
+
+	sessions, err := s.GetAuthSessions(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, []AuthSession{newer, older}, sessions)
+
+	got, err := s.GetAuthSession(ctx, 12345, "session-c")
+	require.NoError(t, err)
+	assert.Nil(t, got, "sessions whose latest token has expired should be ignored")
+
+	revoked, err := s.RevokeAuthSession(ctx, 12345, "session-a", time.Unix(4000, 0))
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	// revoking again keeps the first revocation time
+	revoked, err = s.RevokeAuthSession(ctx, 12345, "session-a", time.Unix(5000, 0))
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	got, err = s.GetAuthSession(ctx, 12345, "session-a")
+	require.NoError(t, err)
+	revokedAt := time.Unix(4000, 0)
+	older.RevokedAt = &revokedAt
+	assert.Equal(t, &older, got)
+
+	// a refresh can't bring a revoked session back
+	saved, err := s.SaveAuthSession(ctx, AuthSession{DriverID: 12345, SessionID: "session-a", CreatedAt: time.Unix(1000, 0), IssuedAt: time.Unix(6000, 0), ExpiresAt: time.Unix(95000, 0)})
+	require.NoError(t, err)
+	assert.False(t, saved)
+
+	revoked, err = s.RevokeAuthSession(ctx, 12345, "missing", time.Unix(4000, 0))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+	got, err = s.GetAuthSession(ctx, 12345, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestMemoryStore_PresenceGrants(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	require.NoError(t, s.SavePresenceGrant(ctx, PresenceGrant{DriverID: 1, DriverName: "Driver One", ViewerID: 30, ViewerName: "Viewer Thirty"}))
+	require.NoError(t, s.SavePresenceGrant(ctx, PresenceGrant{DriverID: 1, DriverName: "Driver One", ViewerID: 4, ViewerName: "Viewer Four"}))
+	require.NoError(t, s.SavePresenceGrant(ctx, PresenceGrant{DriverID: 20, DriverName: "Driver Twenty", ViewerID: 4, ViewerName: "Viewer Four"}))
+
+	viewers, err := s.GetPresenceViewers(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []PresenceGrant{
+		{DriverID: 1, DriverName: "Driver One", ViewerID: 4, ViewerName: "Viewer Four", GrantedAt: now},
+		{DriverID: 1, DriverName: "Driver One", ViewerID: 30, ViewerName: "Viewer Thirty", GrantedAt: now},
+	}, viewers)
+
+	grants, err := s.GetPresenceGrantsForViewer(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []PresenceGrant{
+		{DriverID: 1, DriverName: "Driver One", ViewerID: 4, ViewerName: "Viewer Four", GrantedAt: now},
+		{DriverID: 20, DriverName: "Driver Twenty", ViewerID: 4, ViewerName: "Viewer Four", GrantedAt: now},
+	}, grants)
+
+	require.NoError(t, s.DeletePresenceGrant(ctx, 1, 4))
+
+	viewers, err = s.GetPresenceViewers(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []PresenceGrant{
+		{DriverID: 1, DriverName: "Driver One", ViewerID: 30, ViewerName: "Viewer Thirty", GrantedAt: now},
+	}, viewers)
+
+	grants, err = s.GetPresenceGrantsForViewer(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []PresenceGrant{
+		{DriverID: 20, DriverName: "Driver Twenty", ViewerID: 4, ViewerName: "Viewer Four", GrantedAt: now},
+	}, grants)
+}
+
+func TestMemoryStore_Squads(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	got, err := s.GetSquad(ctx, "squad-1")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	squad := Squad{SquadID: "squad-1", Name: "Saturday League", ManagerID: 1, ManagerName: "Driver One", CreatedAt: time.Unix(5000, 0)}
+	require.NoError(t, s.SaveSquad(ctx, squad))
+	got, err = s.GetSquad(ctx, "squad-1")
+	require.NoError(t, err)
+	assert.Equal(t, &squad, got)
+
+	joinedAt := time.Unix(5000, 0)
+	manager := SquadMember{SquadID: "squad-1", SquadName: "Saturday League", DriverID: 30, DriverName: "Driver Thirty", Status: SquadMemberActive, InvitedAt: time.Unix(5000, 0), JoinedAt: &joinedAt}
+	invitee := SquadMember{SquadID: "squad-1", SquadName: "Saturday League", DriverID: 4, DriverName: "Driver Four", Status: SquadMemberInvited, InvitedAt: time.Unix(6000, 0)}
+	otherSquad := SquadMember{SquadID: "squad-2", SquadName: "Endurance Crew", DriverID: 4, DriverName: "Driver Four", Status: SquadMemberActive, InvitedAt: time.Unix(7000, 0), JoinedAt: &joinedAt}
+	require.NoError(t, s.SaveSquadMember(ctx, manager))
+	require.NoError(t, s.SaveSquadMember(ctx, invitee))
+	require.NoError(t, s.SaveSquadMember(ctx, otherSquad))
+
+	members, err := s.GetSquadMembers(ctx, "squad-1")
+	require.NoError(t, err)
+	assert.Equal(t, []SquadMember{invitee, manager}, members)
+
+	member, err := s.GetSquadMember(ctx, "squad-1", 4)
+	require.NoError(t, err)
+	assert.Equal(t, &invitee, member)
+
+	squads, err := s.GetDriverSquads(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []SquadMember{invitee, otherSquad}, squads)
+
+	require.NoError(t, s.DeleteSquadMember(ctx, "squad-1", 4))
+
+	members, err = s.GetSquadMembers(ctx, "squad-1")
+	require.NoError(t, err)
+	assert.Equal(t, []SquadMember{manager}, members)
+
+	member, err = s.GetSquadMember(ctx, "squad-1", 4)
+	require.NoError(t, err)
+	assert.Nil(t, member)
+
+	squads, err = s.GetDriverSquads(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []SquadMember{otherSquad}, squads)
+}
+
+func TestMemoryStore_SquadEvents(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	raceNight := SquadEvent{SquadID: "squad-1", SubsessionID: 100, Name: "Race Night", TaggedAt: time.Unix(1000, 0)}
+	finale := SquadEvent{SquadID: "squad-1", SubsessionID: 99999, Name: "Finale", TaggedAt: time.Unix(2000, 0)}
+	otherSquad := SquadEvent{SquadID: "squad-2", SubsessionID: 100, Name: "Open Practice", TaggedAt: time.Unix(3000, 0)}
+	require.NoError(t, s.SaveSquadEvent(ctx, raceNight))
+	require.NoError(t, s.SaveSquadEvent(ctx, finale))
+	require.NoError(t, s.SaveSquadEvent(ctx, otherSquad))
+
+	event, err := s.GetSquadEvent(ctx, "squad-1", 100)
+	require.NoError(t, err)
+	assert.Equal(t, &raceNight, event)
+
+	events, err := s.GetSquadEvents(ctx, "squad-1")
+	require.NoError(t, err)
+	assert.Equal(t, []SquadEvent{finale, raceNight}, events)
+
+	events, err = s.GetSessionSquadEvents(ctx, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []SquadEvent{raceNight, otherSquad}, events)
+
+	winner := SquadEventResult{SquadID: "squad-1", SubsessionID: 100, DriverID: 4, DriverName: "Driver Four", StartTime: time.Unix(1000, 0), FinishPosition: 1, NewIRating: 1550}
+	dnf := SquadEventResult{SquadID: "squad-1", SubsessionID: 100, DriverID: 30, DriverName: "Driver Thirty", StartTime: time.Unix(1000, 0), FinishPosition: 9, ReasonOutCode: ReasonOutDisconnected}
+	require.NoError(t, s.SaveSquadEventResult(ctx, dnf))
+	require.NoError(t, s.SaveSquadEventResult(ctx, winner))
+
+	results, err := s.GetSquadEventResults(ctx, "squad-1", 100)
+	require.NoError(t, err)
+	assert.Equal(t, []SquadEventResult{winner, dnf}, results)
+
+	require.NoError(t, s.SaveDriverSessions(ctx, []DriverSession{{DriverID: 4, SubsessionID: 100, StartTime: time.Unix(1000, 0)}}))
+	require.NoError(t, s.TagDriverSessionSquadEvents(ctx, 4, time.Unix(1000, 0), []string{"squad-1"}))
+	require.NoError(t, s.TagDriverSessionSquadEvents(ctx, 4, time.Unix(1000, 0), []string{"squad-1", "squad-2"}))
+	session, err := s.GetDriverSession(ctx, 4, time.Unix(1000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"squad-1", "squad-2"}, session.SquadEvents)
+
+	assert.Error(t, s.TagDriverSessionSquadEvents(ctx, 4, time.Unix(2000, 0), []string{"squad-1"}))
+}
+
+func TestMemoryStore_IngestionTiers(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	tiers, err := s.GetIngestionTiers(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, tiers)
+
+	supporter := IngestionTier{
+		Name:                       "supporter",
+		Priority:                   10,
+		SearchWindowDays:           30,
+		RaceConsumptionConcurrency: 4,
+		LapConsumptionConcurrency:  8,
+		UpdatedAt:                  time.Unix(5000, 0),
+	}
+	standard := IngestionTier{
+		Name:         "default",
+		LapsDisabled: true,
+		UpdatedAt:    time.Unix(4000, 0),
+	}
+	require.NoError(t, s.SaveIngestionTier(ctx, supporter))
+	require.NoError(t, s.SaveIngestionTier(ctx, standard))
+
+	tiers, err = s.GetIngestionTiers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []IngestionTier{standard, supporter}, tiers)
+
+	supporter.SearchWindowDays = 60
+	require.NoError(t, s.SaveIngestionTier(ctx, supporter))
+	tiers, err = s.GetIngestionTiers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []IngestionTier{standard, supporter}, tiers)
+}
+
+func TestMemoryStore_WaitlistAndInvitations(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	entries, err := s.GetWaitlist(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	first := WaitlistEntry{DriverID: 1, DriverName: "Jon Sabados", RequestedAt: time.Unix(1000, 0)}
+	second := WaitlistEntry{DriverID: 2, DriverName: "Jane Smith", RequestedAt: time.Unix(2000, 0)}
+	for _, entry := range []WaitlistEntry{first, second} {
+		added, err := s.AddToWaitlist(ctx, entry)
+		require.NoError(t, err)
+		assert.True(t, added)
+	}
+	// signing in again keeps the driver's place
+	added, err := s.AddToWaitlist(ctx, WaitlistEntry{DriverID: 1, DriverName: "Jon Sabados", RequestedAt: time.Unix(3000, 0)})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	entries, err = s.GetWaitlist(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WaitlistEntry{first, second}, entries)
+
+	invitation, err := s.GetInvitation(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, invitation)
+
+	invited := Invitation{DriverID: 1, Entitlements: []string{"beta"}, InvitedAt: time.Unix(4000, 0), InvitedBy: 99}
+	require.NoError(t, s.SaveInvitations(ctx, []Invitation{invited}))
+
+	invitation, err = s.GetInvitation(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &invited, invitation)
+
+	entries, err = s.GetWaitlist(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WaitlistEntry{second}, entries)
+}
+
+func TestMemoryStore_TermsAcceptances(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	acceptances, err := s.GetTermsAcceptances(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, acceptances)
+
+	tos := TermsAcceptance{DriverID: 1, Document: "tos", Version: "2025-01-01", AcceptedAt: time.Unix(1000, 0)}
+	privacy := TermsAcceptance{DriverID: 1, Document: "privacy", Version: "2025-01-01", AcceptedAt: time.Unix(1000, 0)}
+	for _, acceptance := range []TermsAcceptance{tos, privacy} {
+		saved, err := s.SaveTermsAcceptance(ctx, acceptance)
+		require.NoError(t, err)
+		assert.True(t, saved)
+	}
+	// accepting again keeps the original acceptance time
+	saved, err := s.SaveTermsAcceptance(ctx, TermsAcceptance{DriverID: 1, Document: "tos", Version: "2025-01-01", AcceptedAt: time.Unix(2000, 0)})
+	require.NoError(t, err)
+	assert.False(t, saved)
+
+	acceptances, err = s.GetTermsAcceptances(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []TermsAcceptance{privacy, tos}, acceptances)
+
+	acceptances, err = s.GetTermsAcceptances(ctx, 2)
+	require.NoError(t, err)
+	assert.Empty(t, acceptances)
+}
+
+func TestMemoryStore_SupporterIgnoresOlderEvents(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	supporter, err := s.GetSupporter(ctx, 42)
+	require.NoError(t, err)
+	assert.Nil(t, supporter)
+
+	active := Supporter{
+		DriverID:         42,
+		CustomerID:       "cus_123",
+		SubscriptionID:   "sub_123",
+		Status:           "active",
+		CurrentPeriodEnd: time.Unix(90000, 0),
+		UpdatedAt:        time.Unix(5000, 0),
+	}
+	saved, err := s.SaveSupporter(ctx, active)
+	require.NoError(t, err)
+	assert.True(t, saved)
+
+	stale := active
+	stale.Status = "incomplete"
+	stale.UpdatedAt = time.Unix(4000, 0)
+	saved, err = s.SaveSupporter(ctx, stale)
+	require.NoError(t, err)
+	assert.False(t, saved)
+
+	supporter, err = s.GetSupporter(ctx, 42)
+	require.NoError(t, err)
+	assert.Equal(t, &active, supporter)
+
+	canceled := active
+	canceled.Status = "canceled"
+	canceled.UpdatedAt = time.Unix(6000, 0)
+	saved, err = s.SaveSupporter(ctx, canceled)
+	require.NoError(t, err)
+	assert.True(t, saved)
+
+	supporter, err = s.GetSupporter(ctx, 42)
+	require.NoError(t, err)
+	assert.Equal(t, &canceled, supporter)
+}
+
+func TestMemoryStore_EntitlementsGrantAndRevoke(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	require.Error(t, s.GrantEntitlement(ctx, 42, "supporter"))
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{
+		DriverID:     42,
+		DriverName:   "Test Driver",
+		MemberSince:  time.Unix(1000, 0),
+		FirstLogin:   time.Unix(2000, 0),
+		LastLogin:    time.Unix(2000, 0),
+		LoginCount:   1,
+		Entitlements: []string{"developer"},
+	}))
+
+	require.NoError(t, s.GrantEntitlement(ctx, 42, "supporter"))
+	require.NoError(t, s.GrantEntitlement(ctx, 42, "supporter"))
+	driver, err := s.GetDriver(ctx, 42)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"developer", "supporter"}, driver.Entitlements)
+
+	require.NoError(t, s.RevokeEntitlement(ctx, 42, "developer"))
+	require.NoError(t, s.RevokeEntitlement(ctx, 42, "developer"))
+	driver, err = s.GetDriver(ctx, 42)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"supporter"}, driver.Entitlements)
+
+	require.NoError(t, s.RevokeEntitlement(ctx, 42, "supporter"))
+	driver, err = s.GetDriver(ctx, 42)
+	require.NoError(t, err)
+	assert.Empty(t, driver.Entitlements)
+}
+
+func TestMemoryStore_RateBudget(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+	start := time.Unix(9960, 0)
+
+	window, err := s.GetRateBudgetWindow(ctx, start)
+	require.NoError(t, err)
+	assert.Equal(t, &RateBudgetWindow{Start: start, Drivers: map[int64]int{}}, window)
+
+	for _, spend := range []struct {
+		driverID int64
+		cost     int
+		expected bool
+	}{
+		{driverID: 1, cost: 40, expected: true},
+		{driverID: 2, cost: 40, expected: true},
+		{driverID: 1, cost: 30, expected: false},
+		{driverID: 1, cost: 20, expected: true},
+	} {
+		consumed, err := s.ConsumeRateBudget(ctx, start, spend.driverID, spend.cost, 100)
+		require.NoError(t, err)
+		assert.Equal(t, spend.expected, consumed)
+	}
+
+	window, err = s.GetRateBudgetWindow(ctx, start)
+	require.NoError(t, err)
+	assert.Equal(t, &RateBudgetWindow{Start: start, Total: 100, Drivers: map[int64]int{1: 60, 2: 40}}, window)
+}
+
+func TestMemoryStore_LoginAttempts(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+	window := time.Hour
+
+	attempts, err := s.GetLoginAttempts(ctx, "ip#203.0.113.7")
+	require.NoError(t, err)
+	assert.Nil(t, attempts)
+
+	for i, at := range []int64{10000, 10100, 10200} {
+		attempts, err = s.RecordLoginFailure(ctx, "ip#203.0.113.7", time.Unix(at, 0), window)
+		require.NoError(t, err)
+		assert.Equal(t, &LoginAttempts{Key: "ip#203.0.113.7", Failures: i + 1, FirstFailure: time.Unix(10000, 0), LastFailure: time.Unix(at, 0)}, attempts)
+	}
+	_, err = s.RecordLoginFailure(ctx, "customer#12345", time.Unix(10200, 0), window)
+	require.NoError(t, err)
+
+	attempts, err = s.GetLoginAttempts(ctx, "ip#203.0.113.7")
+	require.NoError(t, err)
+	assert.Equal(t, &LoginAttempts{Key: "ip#203.0.113.7", Failures: 3, FirstFailure: time.Unix(10000, 0), LastFailure: time.Unix(10200, 0)}, attempts)
+
+	// a failure after a quiet window starts the count again
+	attempts, err = s.RecordLoginFailure(ctx, "ip#203.0.113.7", time.Unix(10200+3601, 0), window)
+	require.NoError(t, err)
+	assert.Equal(t, &LoginAttempts{Key: "ip#203.0.113.7", Failures: 1, FirstFailure: time.Unix(13801, 0), LastFailure: time.Unix(13801, 0)}, attempts)
+
+	require.NoError(t, s.ClearLoginAttempts(ctx, "ip#203.0.113.7"))
+	attempts, err = s.GetLoginAttempts(ctx, "ip#203.0.113.7")
+	require.NoError(t, err)
+	assert.Nil(t, attempts)
+
+	attempts, err = s.GetLoginAttempts(ctx, "customer#12345")
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts.Failures, "clearing one key should leave the others")
+}
+
+func TestMemoryStore_Quota(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+	day := time.Unix(86400*20000, 0)
+
+	usage, err := s.GetQuotaUsage(ctx, 42, day)
+	require.NoError(t, err)
+	assert.Equal(t, &QuotaUsage{DriverID: 42, Day: day, Counts: map[string]int{}}, usage)
+
+	for _, use := range []struct {
+		operation string
+		expected  bool
+	}{
+		{operation: "iracing_proxy", expected: true},
+		{operation: "iracing_proxy", expected: true},
+		{operation: "iracing_proxy", expected: false},
+		{operation: "export", expected: true},
+	} {
+		consumed, err := s.ConsumeQuota(ctx, 42, day, use.operation, 2)
+		require.NoError(t, err)
+		assert.Equal(t, use.expected, consumed)
+	}
+
+	usage, err = s.GetQuotaUsage(ctx, 42, day)
+	require.NoError(t, err)
+	assert.Equal(t, &QuotaUsage{DriverID: 42, Day: day, Counts: map[string]int{"iracing_proxy": 2, "export": 1}}, usage)
+
+	// each day starts fresh
+	consumed, err := s.ConsumeQuota(ctx, 42, day.Add(24*time.Hour), "iracing_proxy", 2)
+	require.NoError(t, err)
+	assert.True(t, consumed)
+}
+
+func TestMemoryStore_GetDriversActiveSince(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 1, LastLogin: time.Unix(5000, 0)}))
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 2, LastLogin: time.Unix(9000, 0)}))
+	require.NoError(t, s.SaveConnection(ctx, WebSocketConnection{DriverID: 2, ConnectionID: "conn", ConnectedAt: time.Unix(9000, 0)}))
+
+	drivers, err := s.GetDriversActiveSince(ctx, time.Unix(8000, 0))
+	require.NoError(t, err)
+	require.Len(t, drivers, 1)
+	assert.Equal(t, int64(2), drivers[0].DriverID)
+}
+
+func TestMemoryStore_DriverSettings(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	settings, err := s.GetDriverSettings(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &DriverSettings{DriverID: 1}, settings)
+
+	var conditionErr *types.ConditionalCheckFailedException
+	assert.ErrorAs(t, s.ClearLapBackfillPending(ctx, 1), &conditionErr)
+
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 1, SummaryOnlyIngestion: true, LapBackfillPending: true}))
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 2, LapRetentionMonths: 6}))
+	require.NoError(t, s.ClearLapBackfillPending(ctx, 1))
+
+	settings, err = s.GetDriverSettings(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &DriverSettings{DriverID: 1, SummaryOnlyIngestion: true}, settings)
+
+	withRetention, err := s.GetDriverSettingsWithLapRetention(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSettings{{DriverID: 2, LapRetentionMonths: 6}}, withRetention)
+
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 3, LeaderboardOptIn: true}))
+	optedIn, err := s.GetDriverSettingsWithLeaderboardOptIn(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSettings{{DriverID: 3, LeaderboardOptIn: true}}, optedIn)
+
+	require.NoError(t, s.SaveDriverSettings(ctx, DriverSettings{DriverID: 4, BenchmarkOptIn: true}))
+	optedIn, err = s.GetDriverSettingsWithBenchmarkOptIn(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverSettings{{DriverID: 4, BenchmarkOptIn: true}}, optedIn)
+}
+
+func TestMemoryStore_IngestionRuns(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	for i := range 3 {
+		require.NoError(t, s.SaveIngestionRun(ctx, IngestionRun{
+			DriverID:  int64(i),
+			StartedAt: time.Unix(int64(1000*(i+1)), 0),
+			Duration:  time.Second,
+			RaceCount: i,
+		}))
+	}
+
+	runs, err := s.GetRecentIngestionRuns(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, int64(2), runs[0].DriverID)
+	assert.Equal(t, int64(1), runs[1].DriverID)
+}
+
+func TestMemoryStore_IRacingProxyRequests(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	for i := range 3 {
+		require.NoError(t, s.SaveIRacingProxyRequest(ctx, IRacingProxyRequest{
+			DriverID:    12345,
+			RequestedAt: time.UnixMilli(int64(1000 * (i + 1))),
+			Path:        "/data/results/get",
+			Params:      map[string]string{"subsession_id": "99999"},
+			Status:      200,
+			Duration:    time.Duration(i) * time.Millisecond,
+		}))
+	}
+	// another developer's requests stay out of the list
+	require.NoError(t, s.SaveIRacingProxyRequest(ctx, IRacingProxyRequest{DriverID: 54321, RequestedAt: time.UnixMilli(5000), Path: "/data/member/info", Params: map[string]string{}}))
+
+	requests, err := s.GetIRacingProxyRequests(ctx, 12345, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []IRacingProxyRequest{
+		{DriverID: 12345, RequestedAt: time.UnixMilli(3000), Path: "/data/results/get", Params: map[string]string{"subsession_id": "99999"}, Status: 200, Duration: 2 * time.Millisecond},
+		{DriverID: 12345, RequestedAt: time.UnixMilli(2000), Path: "/data/results/get", Params: map[string]string{"subsession_id": "99999"}, Status: 200, Duration: time.Millisecond},
+	}, requests)
+}
+
+func TestMemoryStore_RequestCapture(t *testing.T) {
+	now := time.Unix(100000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	mode, err := s.GetRequestCaptureMode(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, mode)
+
+	require.NoError(t, s.SaveRequestCaptureMode(ctx, RequestCaptureMode{DriverID: 12345, EnabledAt: now, Until: now.Add(time.Hour)}))
+	mode, err = s.GetRequestCaptureMode(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &RequestCaptureMode{DriverID: 12345, EnabledAt: now, Until: now.Add(time.Hour)}, mode)
+
+	captured := func(id string, requestedAt time.Time) CapturedRequest {
+		return CapturedRequest{
+			ID:                   id,
+			DriverID:             12345,
+			RequestedAt:          requestedAt,
+			Method:               "POST",
+			Path:                 "/driver/12345/journal",
+			Route:                "/driver/{driver_id}/journal",
+			Query:                "limit=5",
+			Status:               400,
+			Duration:             12 * time.Millisecond,
+			RequestContentType:   "application/json",
+			RequestBody:          `{"notes":"x"}`,
+			RequestBodyTruncated: true,
+			ResponseContentType:  "application/json",
+			ResponseBody:         `{"message":"bad"}`,
+		}
+	}
+	// a day old, past its TTL
+	require.NoError(t, s.SaveCapturedRequest(ctx, captured("stale", now.Add(-25*time.Hour))))
+	require.NoError(t, s.SaveCapturedRequest(ctx, captured("first", now.Add(-time.Minute))))
+	require.NoError(t, s.SaveCapturedRequest(ctx, captured("second", now.Add(-time.Minute))))
+	require.NoError(t, s.SaveCapturedRequest(ctx, captured("third", now)))
+	// another developer's requests stay out of the list
+	require.NoError(t, s.SaveCapturedRequest(ctx, CapturedRequest{ID: "other", DriverID: 54321, RequestedAt: now}))
+
+	requests, err := s.GetCapturedRequests(ctx, 12345, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []CapturedRequest{
+		captured("third", now),
+		captured("second", now.Add(-time.Minute)),
+		captured("first", now.Add(-time.Minute)),
+	}, requests)
+
+	requests, err = s.GetCapturedRequests(ctx, 12345, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []CapturedRequest{captured("third", now)}, requests)
+
+	require.NoError(t, s.DeleteRequestCaptureMode(ctx, 12345))
+	mode, err = s.GetRequestCaptureMode(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, mode)
+}
+
+func TestMemoryStore_RequestCaptureModeExpires(t *testing.T) {
+	now := time.Unix(100000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveRequestCaptureMode(ctx, RequestCaptureMode{DriverID: 12345, EnabledAt: now.Add(-2 * time.Hour), Until: now.Add(-time.Hour)}))
+	mode, err := s.GetRequestCaptureMode(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, mode)
+}
+
+func TestMemoryStore_Rollups(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	got, err := s.GetDriverRollup(ctx, 12345, RollupScopeAllTime)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	added, err := s.AddToDriverRollup(ctx, 12345, RollupScopeAllTime, 1, SessionTotals{Races: 1, Wins: 1, Incidents: 2, IRatingChange: 50})
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = s.AddToDriverRollup(ctx, 12345, RollupScopeAllTime, 2, SessionTotals{Races: 1, Incidents: 4, IRatingChange: -20})
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = s.AddToDriverRollup(ctx, 12345, RollupScopeAllTime, 1, SessionTotals{Races: 1, Wins: 1, Incidents: 2, IRatingChange: 50})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	got, err = s.GetDriverRollup(ctx, 12345, RollupScopeAllTime)
+	require.NoError(t, err)
+	assert.Equal(t, &DriverRollup{
+		DriverID:      12345,
+		Scope:         RollupScopeAllTime,
+		SessionTotals: SessionTotals{Races: 2, Wins: 1, Incidents: 6, IRatingChange: 30},
+	}, got)
+
+	weekStart := time.Unix(1767657600, 0)
+	added, err = s.AddToWeeklyLeaderboard(ctx, weekStart, 12345, 1, SessionTotals{Races: 1, Wins: 1})
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = s.AddToWeeklyLeaderboard(ctx, weekStart, 12345, 1, SessionTotals{Races: 1, Wins: 1})
+	require.NoError(t, err)
+	assert.False(t, added)
+	added, err = s.AddToWeeklyLeaderboard(ctx, weekStart, 67890, 3, SessionTotals{Races: 1, Podiums: 1})
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	leaderboard, err := s.GetWeeklyLeaderboard(ctx, weekStart)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []WeeklyLeaderboardEntry{
+		{WeekStart: weekStart, DriverID: 12345, SessionTotals: SessionTotals{Races: 1, Wins: 1}},
+		{WeekStart: weekStart, DriverID: 67890, SessionTotals: SessionTotals{Races: 1, Podiums: 1}},
+	}, leaderboard)
+}
+
+func TestMemoryStore_RankedLeaderboards(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	weekStart := time.Unix(1767657600, 0)
+	got, rankings, err := s.GetRankedLeaderboard(ctx, LeaderboardIRatingGain, weekStart, 0, 10)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+	assert.Nil(t, rankings)
+
+	board := RankedLeaderboard{Board: LeaderboardIRatingGain, WeekStart: weekStart, ComputedAt: time.Unix(1767700000, 0)}
+	require.NoError(t, s.SaveRankedLeaderboard(ctx, board, []LeaderboardRanking{
+		{Rank: 1, DriverID: 1, DriverName: "Driver One", Races: 4, Value: 120},
+		{Rank: 2, DriverID: 2, DriverName: "Driver Two", Races: 2, Value: 80},
+		{Rank: 3, DriverID: 3, DriverName: "Driver Three", Races: 6, Value: 15},
+	}))
+	require.NoError(t, s.SaveRankedLeaderboard(ctx, board, []LeaderboardRanking{
+		{Rank: 1, DriverID: 1, DriverName: "Driver One", Races: 5, Value: 140},
+		{Rank: 2, DriverID: 3, DriverName: "Driver Three", Races: 6, Value: 15},
+	}))
+
+	got, rankings, err = s.GetRankedLeaderboard(ctx, LeaderboardIRatingGain, weekStart, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, &RankedLeaderboard{Board: LeaderboardIRatingGain, WeekStart: weekStart, ComputedAt: time.Unix(1767700000, 0), TotalEntries: 2}, got)
+	assert.Equal(t, []LeaderboardRanking{{Rank: 2, DriverID: 3, DriverName: "Driver Three", Races: 6, Value: 15}}, rankings)
+}
+
+func TestMemoryStore_RankedLeaderboardByClub(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	weekStart := time.Unix(1767657600, 0)
+	got, rankings, err := s.GetRankedLeaderboardByClub(ctx, LeaderboardIRatingGain, weekStart, 7)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+	assert.Nil(t, rankings)
+
+	board := RankedLeaderboard{Board: LeaderboardIRatingGain, WeekStart: weekStart, ComputedAt: time.Unix(1767700000, 0)}
+	require.NoError(t, s.SaveRankedLeaderboard(ctx, board, []LeaderboardRanking{
+		{Rank: 1, DriverID: 1, DriverName: "Driver One", ClubID: 7, Races: 4, Value: 120},
+		{Rank: 2, DriverID: 2, DriverName: "Driver Two", ClubID: 3, Races: 2, Value: 80},
+		{Rank: 3, DriverID: 3, DriverName: "Driver Three", ClubID: 7, Races: 6, Value: 15},
+	}))
+
+	got, rankings, err = s.GetRankedLeaderboardByClub(ctx, LeaderboardIRatingGain, weekStart, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 3, got.TotalEntries)
+	assert.Equal(t, []LeaderboardRanking{
+		{Rank: 1, DriverID: 1, DriverName: "Driver One", ClubID: 7, Races: 4, Value: 120},
+		{Rank: 3, DriverID: 3, DriverName: "Driver Three", ClubID: 7, Races: 6, Value: 15},
+	}, rankings)
+}
+
+func TestMemoryStore_RegionWeeklyAggregates(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	week := time.Unix(1767657600, 0)
+	nextWeek := week.AddDate(0, 0, 7)
+	first := RegionWeeklyAggregate{ClubID: 7, WeekStart: week, Drivers: 5, SessionTotals: SessionTotals{Races: 12, Wins: 2}}
+	second := RegionWeeklyAggregate{ClubID: 7, WeekStart: nextWeek, Drivers: 6, SessionTotals: SessionTotals{Races: 9, Incidents: 30}}
+	require.NoError(t, s.SaveRegionWeeklyAggregate(ctx, first))
+	require.NoError(t, s.SaveRegionWeeklyAggregate(ctx, second))
+	require.NoError(t, s.SaveRegionWeeklyAggregate(ctx, RegionWeeklyAggregate{ClubID: 3, WeekStart: week, Drivers: 8}))
+
+	got, err := s.GetRegionWeeklyAggregates(ctx, 7, week, nextWeek)
+	require.NoError(t, err)
+	assert.Equal(t, []RegionWeeklyAggregate{first, second}, got)
+
+	got, err = s.GetRegionWeeklyAggregates(ctx, 7, nextWeek, nextWeek)
+	require.NoError(t, err)
+	assert.Equal(t, []RegionWeeklyAggregate{second}, got)
+}
+
+func TestMemoryStore_BenchmarkTables(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	got, err := s.GetBenchmarkTable(ctx, 139, 219, 1500)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	withLaps := BenchmarkTable{
+		SeriesID:           139,
+		TrackID:            219,
+		IRatingBand:        1500,
+		ComputedAt:         time.Unix(1767657600, 0),
+		Drivers:            12,
+		IncidentsPerRace:   BenchmarkPercentiles{P10: 0.5, P25: 1, P50: 2.25, P75: 4, P90: 6.5},
+		OffBestPercent:     &BenchmarkPercentiles{P10: 0.4, P25: 0.7, P50: 1.1, P75: 1.6, P90: 2.3},
+		ConsistencyDrivers: 10,
+	}
+	withoutLaps := BenchmarkTable{SeriesID: 139, TrackID: 219, IRatingBand: 2000, ComputedAt: time.Unix(1767657600, 0), Drivers: 10}
+	require.NoError(t, s.SaveBenchmarkTable(ctx, withLaps))
+	require.NoError(t, s.SaveBenchmarkTable(ctx, withoutLaps))
+
+	got, err = s.GetBenchmarkTable(ctx, 139, 219, 1500)
+	require.NoError(t, err)
+	assert.Equal(t, &withLaps, got)
+
+	got, err = s.GetBenchmarkTable(ctx, 139, 219, 2000)
+	require.NoError(t, err)
+	assert.Equal(t, &withoutLaps, got)
+}
+
+func TestMemoryStore_Milestones(t *testing.T) {
+	s := newTestMemoryStore(time.Unix(10000, 0))
+	ctx := context.Background()
+
+	later := DriverMilestone{DriverID: 12345, Milestone: "first_win", AchievedAt: time.Unix(2000, 0), SubsessionID: 2}
+	earlier := DriverMilestone{DriverID: 12345, Milestone: "first_win", AchievedAt: time.Unix(1000, 0), SubsessionID: 1}
+	other := DriverMilestone{DriverID: 12345, Milestone: "first_race", AchievedAt: time.Unix(1000, 0), SubsessionID: 1}
+
+	recorded, err := s.RecordMilestone(ctx, later)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	recorded, err = s.RecordMilestone(ctx, earlier)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	recorded, err = s.RecordMilestone(ctx, later)
+	require.NoError(t, err)
+	assert.False(t, recorded)
+	recorded, err = s.RecordMilestone(ctx, other)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	milestones, err := s.GetDriverMilestones(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, []DriverMilestone{other, earlier}, milestones)
+}
+
+func TestMemoryStore_UpstreamStatus(t *testing.T) {
+	now := time.Unix(10000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+
+	status, err := s.GetUpstreamStatus(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, status)
+
+	require.NoError(t, s.SaveUpstreamStatus(ctx, UpstreamStatus{
+		DownUntil:  now.Add(15 * time.Minute),
+		Reason:     "Site Maintenance",
+		ReportedAt: now,
+	}))
+	status, err = s.GetUpstreamStatus(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, status)
+	assert.True(t, now.Add(15*time.Minute).Equal(status.DownUntil))
+	assert.Equal(t, "Site Maintenance", status.Reason)
+
+	s.now = func() time.Time {
+		return now.Add(15 * time.Minute)
+	}
+	status, err = s.GetUpstreamStatus(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, status, "reports that have run out should be ignored")
+}
+
+func TestMemoryStore_SessionArchives(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+	archivedAt := time.UnixMilli(1_700_000_000_000)
+
+	archives, err := s.GetSessionArchives(ctx, 12345)
+	require.NoError(t, err)
+	assert.Empty(t, archives)
+
+	results := SessionArchive{SubsessionID: 12345, Kind: SessionArchiveResults, Key: "sessions/12345/results.json", ArchivedAt: archivedAt}
+	laps := SessionArchive{SubsessionID: 12345, Kind: SessionArchiveLaps, DriverID: 1001, Key: "sessions/12345/laps/1001.json", ArchivedAt: archivedAt}
+	require.NoError(t, s.SaveSessionArchive(ctx, results))
+	require.NoError(t, s.SaveSessionArchive(ctx, laps))
+	// archiving again replaces the earlier record
+	require.NoError(t, s.SaveSessionArchive(ctx, results))
+	require.NoError(t, s.SaveSessionArchive(ctx, SessionArchive{SubsessionID: 99999, Kind: SessionArchiveResults, Key: "sessions/99999/results.json", ArchivedAt: archivedAt}))
+
+	archives, err = s.GetSessionArchives(ctx, 12345)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []SessionArchive{results, laps}, archives)
+}
+
+func TestMemoryStore_BackfillProgress(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+
+	progress, err := s.GetBackfillProgress(ctx, "championship")
+	require.NoError(t, err)
+	assert.Nil(t, progress)
+
+	saved := BackfillProgress{
+		Name:      "championship",
+		After:     "sessions/12345/results.json",
+		Archives:  25,
+		Sessions:  3,
+		Failures:  1,
+		UpdatedAt: time.UnixMilli(1_700_000_000_000),
+	}
+	require.NoError(t, s.SaveBackfillProgress(ctx, saved))
+	saved.Archives = 30
+	saved.Complete = true
+	require.NoError(t, s.SaveBackfillProgress(ctx, saved))
+
+	progress, err = s.GetBackfillProgress(ctx, "championship")
+	require.NoError(t, err)
+	assert.Equal(t, &saved, progress)
+
+	progress, err = s.GetBackfillProgress(ctx, "other")
+	require.NoError(t, err)
+	assert.Nil(t, progress)
+}
+
+func TestMemoryStore_SeasonCards(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+
+	card, err := s.GetSeasonCard(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, card)
+
+	first := SeasonCard{DriverID: 12345, Token: "first", Snapshot: `{"races":1}`, CreatedAt: time.Unix(1000, 0), RefreshedAt: time.Unix(1000, 0)}
+	require.NoError(t, s.SaveSeasonCard(ctx, first, ""))
+
+	refreshed, err := s.RefreshSeasonCard(ctx, "first", `{"races":2}`, time.Unix(2000, 0))
+	require.NoError(t, err)
+	assert.True(t, refreshed)
+	first.Snapshot = `{"races":2}`
+	first.RefreshedAt = time.Unix(2000, 0)
+
+	card, err = s.GetSeasonCard(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &first, card)
+
+	// a new token replaces the old one
+	second := SeasonCard{DriverID: 12345, Token: "second", Snapshot: `{"races":2}`, CreatedAt: time.Unix(3000, 0), RefreshedAt: time.Unix(3000, 0)}
+	require.NoError(t, s.SaveSeasonCard(ctx, second, "first"))
+
+	card, err = s.GetSeasonCardByToken(ctx, "first")
+	require.NoError(t, err)
+	assert.Nil(t, card)
+	card, err = s.GetSeasonCardByToken(ctx, "second")
+	require.NoError(t, err)
+	assert.Equal(t, &second, card)
+
+	refreshed, err = s.RefreshSeasonCard(ctx, "first", `{"races":3}`, time.Unix(4000, 0))
+	require.NoError(t, err)
+	assert.False(t, refreshed)
+	card, err = s.GetSeasonCardByToken(ctx, "first")
+	require.NoError(t, err)
+	assert.Nil(t, card)
+
+	require.NoError(t, s.DeleteSeasonCard(ctx, 12345, "second"))
+	card, err = s.GetSeasonCard(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, card)
+	card, err = s.GetSeasonCardByToken(ctx, "second")
+	require.NoError(t, err)
+	assert.Nil(t, card)
+}
+
+func TestMemoryStore_Overlays(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+
+	overlay, err := s.GetOverlay(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, overlay)
+
+	first := Overlay{DriverID: 12345, Token: "first", Snapshot: `{"races":1}`, CreatedAt: time.Unix(1000, 0), RefreshedAt: time.Unix(1000, 0)}
+	require.NoError(t, s.SaveOverlay(ctx, first, ""))
+
+	refreshed, err := s.RefreshOverlay(ctx, "first", `{"races":2}`, time.Unix(2000, 0))
+	require.NoError(t, err)
+	assert.True(t, refreshed)
+	first.Snapshot = `{"races":2}`
+	first.RefreshedAt = time.Unix(2000, 0)
+
+	overlay, err = s.GetOverlay(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, &first, overlay)
+
+	// a new token replaces the old one
+	second := Overlay{DriverID: 12345, Token: "second", Snapshot: `{"races":2}`, CreatedAt: time.Unix(3000, 0), RefreshedAt: time.Unix(3000, 0)}
+	require.NoError(t, s.SaveOverlay(ctx, second, "first"))
+
+	overlay, err = s.GetOverlayByToken(ctx, "first")
+	require.NoError(t, err)
+	assert.Nil(t, overlay)
+	overlay, err = s.GetOverlayByToken(ctx, "second")
+	require.NoError(t, err)
+	assert.Equal(t, &second, overlay)
+
+	refreshed, err = s.RefreshOverlay(ctx, "first", `{"races":3}`, time.Unix(4000, 0))
+	require.NoError(t, err)
+	assert.False(t, refreshed)
+	overlay, err = s.GetOverlayByToken(ctx, "first")
+	require.NoError(t, err)
+	assert.Nil(t, overlay)
+
+	require.NoError(t, s.DeleteOverlay(ctx, 12345, "second"))
+	overlay, err = s.GetOverlay(ctx, 12345)
+	require.NoError(t, err)
+	assert.Nil(t, overlay)
+	overlay, err = s.GetOverlayByToken(ctx, "second")
+	require.NoError(t, err)
+	assert.Nil(t, overlay)
+}
+
+func TestMemoryStore_ConsumeSeasonCardFetch(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+	window := time.Unix(1700000040, 0)
+
+	for _, expected := range []bool{true, true, false} {
+		consumed, err := s.ConsumeSeasonCardFetch(ctx, "tok", window, 2)
+		require.NoError(t, err)
+		assert.Equal(t, expected, consumed)
+	}
+
+	// other tokens and windows are counted separately
+	consumed, err := s.ConsumeSeasonCardFetch(ctx, "other", window, 2)
+	require.NoError(t, err)
+	assert.True(t, consumed)
+	consumed, err = s.ConsumeSeasonCardFetch(ctx, "tok", window.Add(time.Minute), 2)
+	require.NoError(t, err)
+	assert.True(t, consumed)
+}
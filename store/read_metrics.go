@@ -0,0 +1,107 @@
+package store
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-xray-sdk-go/v2/xray"
+	"github.com/rs/zerolog"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+)
+
+type ReadMetricsEmitter interface {
+	EmitDimensionedCount(ctx context.Context, name string, count int, dimensions map[string]string) error
+	EmitBytes(ctx context.Context, name string, bytes int, dimensions map[string]string) error
+}
+
+// WithReadMetrics has the store record how many items each query, scan and batch get returned as store_read_items, and
+// roughly how big they were as store_read_bytes, by the store method reading. Reads growing towards needing an index or
+// pagination show up there well before they start timing out.
+func WithReadMetrics(emitter ReadMetricsEmitter) DynamoStoreOption {
+	return func(s *DynamoStore) {
+		s.readMetrics = emitter
+	}
+}
+
+func (s *DynamoStore) query(ctx context.Context, name string, input *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+	var result *dynamodb.QueryOutput
+	err := s.instrumentRead(ctx, name, func(ctx context.Context) ([]map[string]types.AttributeValue, bool, error) {
+		var err error
+		result, err = s.client.Query(ctx, input)
+		if err != nil {
+			return nil, false, err
+		}
+		return result.Items, result.LastEvaluatedKey != nil, nil
+	})
+	return result, err
+}
+
+func (s *DynamoStore) scan(ctx context.Context, name string, input *dynamodb.ScanInput) (*dynamodb.ScanOutput, error) {
+	var result *dynamodb.ScanOutput
+	err := s.instrumentRead(ctx, name, func(ctx context.Context) ([]map[string]types.AttributeValue, bool, error) {
+		var err error
+		result, err = s.client.Scan(ctx, input)
+		if err != nil {
+			return nil, false, err
+		}
+		return result.Items, result.LastEvaluatedKey != nil, nil
+	})
+	return result, err
+}
+
+func (s *DynamoStore) batchGetItem(ctx context.Context, name string, input *dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error) {
+	var result *dynamodb.BatchGetItemOutput
+	err := s.instrumentRead(ctx, name, func(ctx context.Context) ([]map[string]types.AttributeValue, bool, error) {
+		var err error
+		result, err = s.client.BatchGetItem(ctx, input)
+		if err != nil {
+			return nil, false, err
+		}
+		var items []map[string]types.AttributeValue
+		for _, tableItems := range result.Responses {
+			items = append(items, tableItems...)
+		}
+		return items, len(result.UnprocessedKeys) > 0, nil
+	})
+	return result, err
+}
+
+// instrumentRead runs read in a subsegment annotated with what it returned, when there's a trace to add one to, and
+// records the same as metrics. truncated is whether the read stopped short, leaving more to page through.
+func (s *DynamoStore) instrumentRead(ctx context.Context, name string, read func(ctx context.Context) (items []map[string]types.AttributeValue, truncated bool, err error)) error {
+	var segment *xray.Segment
+	if xray.GetSegment(ctx) != nil {
+		ctx, segment = xray.BeginSubsegment(ctx, "store."+name)
+	}
+
+	items, truncated, err := read(ctx)
+	if err != nil {
+		if segment != nil {
+			segment.Close(err)
+		}
+		return err
+	}
+
+	size := 0
+	for _, item := range items {
+		size += itemSize(item)
+	}
+	if segment != nil {
+		_ = segment.AddAnnotation("items", len(items))
+		_ = segment.AddAnnotation("bytes", size)
+		_ = segment.AddAnnotation("truncated", truncated)
+		segment.Close(nil)
+	}
+	if s.readMetrics != nil {
+		dimensions := map[string]string{metrics.DimensionQuery: name}
+		if err := s.readMetrics.EmitDimensionedCount(ctx, metrics.StoreReadItems, len(items), dimensions); err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Str("query", name).Msg("error emitting store read items")
+		}
+		if err := s.readMetrics.EmitBytes(ctx, metrics.StoreReadBytes, size, dimensions); err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Str("query", name).Msg("error emitting store read bytes")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/metrics"
+)
+
+func TestDynamoStore_InstrumentRead(t *testing.T) {
+	items := []map[string]types.AttributeValue{
+		{
+			"partition_key": &types.AttributeValueMemberS{Value: "driver#1"},
+			"sort_key":      &types.AttributeValueMemberS{Value: "info"},
+		},
+		{
+			"partition_key": &types.AttributeValueMemberS{Value: "driver#1"},
+			"sort_key":      &types.AttributeValueMemberS{Value: "settings"},
+			"lap_retention": &types.AttributeValueMemberN{Value: "30"},
+		},
+	}
+	// attribute names plus values: 13+8 + 8+4, then 13+8 + 8+8 + 13+2
+	expectedBytes := 33 + 52
+
+	type emitDimensionedCountCall struct {
+		name       string
+		count      int
+		dimensions map[string]string
+	}
+
+	type emitBytesCall struct {
+		name       string
+		bytes      int
+		dimensions map[string]string
+	}
+
+	testCases := []struct {
+		name        string
+		withMetrics bool
+		readErr     error
+
+		emitDimensionedCountCall *emitDimensionedCountCall
+		emitBytesCall            *emitBytesCall
+	}{
+		{
+			name:                     "records items and size by query",
+			withMetrics:              true,
+			emitDimensionedCountCall: &emitDimensionedCountCall{name: metrics.StoreReadItems, count: 2, dimensions: map[string]string{metrics.DimensionQuery: "GetDriver"}},
+			emitBytesCall:            &emitBytesCall{name: metrics.StoreReadBytes, bytes: expectedBytes, dimensions: map[string]string{metrics.DimensionQuery: "GetDriver"}},
+		},
+		{
+			name: "without metrics",
+		},
+		{
+			name:        "failed read",
+			withMetrics: true,
+			readErr:     errors.New("throttled"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			emitter := NewMockReadMetricsEmitter(t)
+			if tc.emitDimensionedCountCall != nil {
+				emitter.EXPECT().EmitDimensionedCount(mock.Anything, tc.emitDimensionedCountCall.name, tc.emitDimensionedCountCall.count, tc.emitDimensionedCountCall.dimensions).
+					Return(nil)
+			}
+			if tc.emitBytesCall != nil {
+				emitter.EXPECT().EmitBytes(mock.Anything, tc.emitBytesCall.name, tc.emitBytesCall.bytes, tc.emitBytesCall.dimensions).
+					Return(nil)
+			}
+			var opts []DynamoStoreOption
+			if tc.withMetrics {
+				opts = append(opts, WithReadMetrics(emitter))
+			}
+			s := NewDynamoStore(nil, "test", opts...)
+
+			err := s.instrumentRead(context.Background(), "GetDriver", func(ctx context.Context) ([]map[string]types.AttributeValue, bool, error) {
+				if tc.readErr != nil {
+					return nil, false, tc.readErr
+				}
+				return items, false, nil
+			})
+
+			if tc.readErr != nil {
+				require.ErrorIs(t, err, tc.readErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
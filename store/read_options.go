@@ -0,0 +1,30 @@
+package store
+
+// ReadOption adjusts how a single read is made.
+type ReadOption interface {
+	applyRead(options *readOptions)
+}
+
+type readOptions struct {
+	consistent bool
+}
+
+type consistentReadOption bool
+
+func (c consistentReadOption) applyRead(options *readOptions) {
+	options.consistent = bool(c)
+}
+
+// ConsistentRead makes a read strongly consistent, so it sees every write that completed before it was made. These
+// reads cost twice as much, so keep them for reading back something that was just written.
+func ConsistentRead() ReadOption {
+	return consistentReadOption(true)
+}
+
+func applyReadOptions(opts []ReadOption) readOptions {
+	var options readOptions
+	for _, opt := range opts {
+		opt.applyRead(&options)
+	}
+	return options
+}
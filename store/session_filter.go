@@ -58,6 +58,19 @@ func FilterByTrackIDs(trackIDs []int64) SessionFilter {
 	}
 }
 
+// FilterFinished returns a SessionFilter that keeps sessions the driver was still running at the end of.
+func FilterFinished() SessionFilter {
+	return func(sessions []DriverSession) []DriverSession {
+		filtered := make([]DriverSession, 0, len(sessions))
+		for _, session := range sessions {
+			if session.Outcome() == ReasonOutFinished {
+				filtered = append(filtered, session)
+			}
+		}
+		return filtered
+	}
+}
+
 func int64Set(ids []int64) map[int64]struct{} {
 	set := make(map[int64]struct{}, len(ids))
 	for _, id := range ids {
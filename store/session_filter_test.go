@@ -102,6 +102,18 @@ func TestFilterByTrackIDs(t *testing.T) {
 	})
 }
 
+func TestFilterFinished(t *testing.T) {
+	sessions := []DriverSession{
+		{SubsessionID: 1, ReasonOut: "Running", ReasonOutCode: ReasonOutFinished},
+		{SubsessionID: 2, ReasonOut: "Disconnected", ReasonOutCode: ReasonOutDisconnected},
+		{SubsessionID: 3, ReasonOut: "Running"}, // ingested before the code was recorded
+		{SubsessionID: 4, ReasonOut: "Retired"},
+	}
+
+	result := FilterFinished()(sessions)
+	assert.Equal(t, []DriverSession{sessions[0], sessions[2]}, result)
+}
+
 func TestSessionFilters_ANDAcross(t *testing.T) {
 	sessions := []DriverSession{
 		{SeriesID: 42, CarID: 10, TrackID: 100},
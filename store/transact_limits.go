@@ -0,0 +1,129 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// DynamoDB rejects transactions over 4MB in aggregate and items over 400KB.
+const maxTransactWriteBytes = 4 * 1024 * 1024
+const maxItemBytes = 400 * 1024
+
+// ErrItemTooLarge is returned when an item could never be written because it exceeds the DynamoDB item size limit.
+var ErrItemTooLarge = errors.New("item exceeds the DynamoDB item size limit")
+
+// chunkTransactItems splits items into transactions that stay within the DynamoDB item count and size limits, leaving
+// room in every chunk but the last for the perChunk writes and in the last for the final writes, which the caller adds
+// itself.
+// Items are never reordered, so the same input always produces the same chunks, which is what lets a partially
+// applied write be resumed chunk by chunk. At least one, possibly empty, chunk is always returned.
+func chunkTransactItems(items, perChunk, final []types.TransactWriteItem) ([][]types.TransactWriteItem, error) {
+	sizes := make([]int, len(items))
+	for i, item := range items {
+		sizes[i] = transactWriteItemSize(item)
+		if sizes[i] > maxItemBytes {
+			return nil, fmt.Errorf("transaction item %d is %d bytes: %w", i, sizes[i], ErrItemTooLarge)
+		}
+	}
+
+	perChunkCount, perChunkBytes := len(perChunk), transactWriteItemsSize(perChunk)
+	finalCount, finalBytes := len(final), transactWriteItemsSize(final)
+	if max(perChunkCount, finalCount) >= maxTransactWriteItems || max(perChunkBytes, finalBytes) >= maxTransactWriteBytes {
+		return nil, errors.New("bookkeeping writes leave no room for items in a transaction")
+	}
+
+	var chunks [][]types.TransactWriteItem
+	start, bytes := 0, 0
+	for i := range items {
+		if i > start && (i-start+1+perChunkCount > maxTransactWriteItems || bytes+sizes[i]+perChunkBytes > maxTransactWriteBytes) {
+			chunks = append(chunks, items[start:i])
+			start, bytes = i, 0
+		}
+		bytes += sizes[i]
+	}
+
+	// the tail may not have room left for the final writes, in which case they get a transaction of their own
+	tail := items[start:]
+	if len(tail)+finalCount > maxTransactWriteItems || bytes+finalBytes > maxTransactWriteBytes {
+		chunks = append(chunks, tail)
+		tail = nil
+	}
+	return append(chunks, tail), nil
+}
+
+func transactWriteItemsSize(items []types.TransactWriteItem) int {
+	size := 0
+	for _, item := range items {
+		size += transactWriteItemSize(item)
+	}
+	return size
+}
+
+// transactWriteItemSize estimates the size DynamoDB counts against the transaction limit for a write.
+func transactWriteItemSize(item types.TransactWriteItem) int {
+	switch {
+	case item.Put != nil:
+		return itemSize(item.Put.Item)
+	case item.Update != nil:
+		return itemSize(item.Update.Key) + itemSize(item.Update.ExpressionAttributeValues)
+	case item.Delete != nil:
+		return itemSize(item.Delete.Key)
+	case item.ConditionCheck != nil:
+		return itemSize(item.ConditionCheck.Key)
+	}
+	return 0
+}
+
+// itemSize estimates the stored size of an item. Numbers are counted as their string length, which never undercounts.
+func itemSize(item map[string]types.AttributeValue) int {
+	size := 0
+	for name, value := range item {
+		size += len(name) + attributeValueSize(value)
+	}
+	return size
+}
+
+func attributeValueSize(value types.AttributeValue) int {
+	switch v := value.(type) {
+	case *types.AttributeValueMemberS:
+		return len(v.Value)
+	case *types.AttributeValueMemberN:
+		return len(v.Value)
+	case *types.AttributeValueMemberB:
+		return len(v.Value)
+	case *types.AttributeValueMemberSS:
+		size := 0
+		for _, s := range v.Value {
+			size += len(s)
+		}
+		return size
+	case *types.AttributeValueMemberNS:
+		size := 0
+		for _, n := range v.Value {
+			size += len(n)
+		}
+		return size
+	case *types.AttributeValueMemberBS:
+		size := 0
+		for _, b := range v.Value {
+			size += len(b)
+		}
+		return size
+	case *types.AttributeValueMemberL:
+		size := 3
+		for _, element := range v.Value {
+			size += 1 + attributeValueSize(element)
+		}
+		return size
+	case *types.AttributeValueMemberM:
+		size := 3
+		for name, element := range v.Value {
+			size += 1 + len(name) + attributeValueSize(element)
+		}
+		return size
+	}
+	// BOOL and NULL
+	return 1
+}
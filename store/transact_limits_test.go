@@ -0,0 +1,88 @@
+package store
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkTransactItems(t *testing.T) {
+	put := func(size int) types.TransactWriteItem {
+		return types.TransactWriteItem{Put: &types.Put{Item: map[string]types.AttributeValue{
+			"v": &types.AttributeValueMemberS{Value: strings.Repeat("x", size-1)},
+		}}}
+	}
+	puts := func(count, size int) []types.TransactWriteItem {
+		items := make([]types.TransactWriteItem, count)
+		for i := range items {
+			items[i] = put(size)
+		}
+		return items
+	}
+
+	testCases := []struct {
+		name       string
+		items      []types.TransactWriteItem
+		perChunk   []types.TransactWriteItem
+		final      []types.TransactWriteItem
+		chunkSizes []int
+		expectErr  error
+	}{
+		{
+			name:       "nothing to chunk",
+			final:      puts(3, 10),
+			chunkSizes: []int{0},
+		},
+		{
+			name:       "everything fits with the final writes",
+			items:      puts(97, 10),
+			perChunk:   puts(1, 10),
+			final:      puts(3, 10),
+			chunkSizes: []int{97},
+		},
+		{
+			name:       "final writes don't fit alongside the tail",
+			items:      puts(98, 10),
+			perChunk:   puts(1, 10),
+			final:      puts(3, 10),
+			chunkSizes: []int{98, 0},
+		},
+		{
+			name:       "split by count",
+			items:      puts(250, 10),
+			perChunk:   puts(1, 10),
+			final:      puts(3, 10),
+			chunkSizes: []int{99, 99, 52},
+		},
+		{
+			name:       "split by size",
+			items:      puts(25, 300*1024),
+			chunkSizes: []int{13, 12},
+		},
+		{
+			name:      "item too large",
+			items:     []types.TransactWriteItem{put(10), put(maxItemBytes + 1)},
+			expectErr: ErrItemTooLarge,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chunks, err := chunkTransactItems(tc.items, tc.perChunk, tc.final)
+			if tc.expectErr != nil {
+				assert.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var chunkSizes []int
+			for _, chunk := range chunks {
+				chunkSizes = append(chunkSizes, len(chunk))
+			}
+			assert.Equal(t, tc.chunkSizes, chunkSizes)
+		})
+	}
+}
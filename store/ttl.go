@@ -0,0 +1,46 @@
+package store
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// ttlAttributeName is the attribute the table's TTL is configured on, holding the epoch seconds after which DynamoDB
+// may delete the item. The sweep can lag by days, so reads of ephemeral records that care check it themselves with
+// ttlExpired.
+const ttlAttributeName = "ttl"
+
+const wsConnectionTTLDuration = 24 * time.Hour
+const ingestionRunTTLDuration = 7 * 24 * time.Hour
+const rateBudgetWindowTTLDuration = time.Hour
+const ingestionCancelTTLDuration = time.Hour
+const quotaTTLDuration = 48 * time.Hour
+const iRacingProxyRequestTTLDuration = 30 * 24 * time.Hour
+const capturedRequestTTLDuration = 24 * time.Hour
+const seasonCardFetchesTTLDuration = time.Hour
+
+// journalPromptTTLDuration outlasts the longest window prompts can be listed for
+const journalPromptTTLDuration = 30 * 24 * time.Hour
+
+// presenceTTLDuration is how long a racing heartbeat counts for, long enough to ride out a missed heartbeat or two
+const presenceTTLDuration = 2 * time.Minute
+
+// benchmarkTTLDuration clears out benchmark tables that stop being recomputed, such as when drivers opt out and a band
+// falls below the minimum, well after the next aggregation run would have replaced them
+const benchmarkTTLDuration = 7 * 24 * time.Hour
+
+// ttlAttr is the TTL attribute value for an item expiring at expiresAt (epoch seconds).
+func ttlAttr(expiresAt int64) types.AttributeValue {
+	return &types.AttributeValueMemberN{Value: strconv.FormatInt(expiresAt, 10)}
+}
+
+// ttlExpired reports whether an item's TTL has passed as of now. Items without a TTL never expire.
+func ttlExpired(item map[string]types.AttributeValue, now time.Time) bool {
+	expiresAt, err := getInt64Attr(item, ttlAttributeName)
+	if err != nil {
+		return false
+	}
+	return expiresAt <= now.Unix()
+}
@@ -0,0 +1,171 @@
+package store
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jonsabados/saturdaysspinout/store/keys"
+)
+
+// sweepExpiredItems stands in for DynamoDB's TTL sweep, which local Dynamo never runs, deleting every item in the
+// table whose TTL has passed as of now.
+func sweepExpiredItems(t *testing.T, s *DynamoStore, now time.Time) {
+	t.Helper()
+	ctx := context.Background()
+
+	paginator := dynamodb.NewScanPaginator(s.client, &dynamodb.ScanInput{
+		TableName:                 aws.String(s.table),
+		FilterExpression:          aws.String("#ttl <= :now"),
+		ExpressionAttributeNames:  map[string]string{"#ttl": ttlAttributeName},
+		ExpressionAttributeValues: map[string]types.AttributeValue{":now": &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Unix(), 10)}},
+		ConsistentRead:            aws.Bool(true),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		require.NoError(t, err)
+		for _, item := range page.Items {
+			_, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+				TableName: aws.String(s.table),
+				Key: map[string]types.AttributeValue{
+					partitionKeyName: item[partitionKeyName],
+					sortKeyName:      item[sortKeyName],
+				},
+			})
+			require.NoError(t, err)
+		}
+	}
+}
+
+// sweepExpiredItems does for the memory store what the TTL sweep does for DynamoDB.
+func (s *MemoryStore) sweepExpiredItems(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for pk, partition := range s.items {
+		for sk, item := range partition {
+			if ttlExpired(item, now) {
+				s.delete(pk, sk)
+			}
+		}
+	}
+}
+
+func itemExists(t *testing.T, s *DynamoStore, pk, sk string) bool {
+	t.Helper()
+	result, err := s.client.GetItem(context.Background(), &dynamodb.GetItemInput{
+		TableName: aws.String(s.table),
+		Key: map[string]types.AttributeValue{
+			partitionKeyName: &types.AttributeValueMemberS{Value: pk},
+			sortKeyName:      &types.AttributeValueMemberS{Value: sk},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	require.NoError(t, err)
+	return result.Item != nil
+}
+
+func TestTTLExpired(t *testing.T) {
+	now := time.Unix(10000, 0)
+
+	testCases := []struct {
+		name     string
+		item     map[string]types.AttributeValue
+		expected bool
+	}{
+		{name: "no ttl", item: map[string]types.AttributeValue{}, expected: false},
+		{name: "future", item: map[string]types.AttributeValue{ttlAttributeName: ttlAttr(10001)}, expected: false},
+		{name: "now", item: map[string]types.AttributeValue{ttlAttributeName: ttlAttr(10000)}, expected: true},
+		{name: "past", item: map[string]types.AttributeValue{ttlAttributeName: ttlAttr(9999)}, expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ttlExpired(tc.item, now))
+		})
+	}
+}
+
+func TestTTLSweep_EphemeralRecords(t *testing.T) {
+	s := setupTestStore(t)
+	ctx := context.Background()
+
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	day := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	driverPK := keys.Driver(12345)
+
+	require.NoError(t, s.SaveJournalDraft(ctx, RaceJournalDraft{DriverID: 12345, RaceID: 1700000000, ExpiresAt: now.Add(time.Hour)}))
+	acquired, err := s.AcquireIngestionLock(ctx, 12345, 15*time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired)
+	consumed, err := s.ConsumeQuota(ctx, 12345, day, "export", 5)
+	require.NoError(t, err)
+	require.True(t, consumed)
+	require.NoError(t, s.RequestIngestionCancel(ctx, 12345))
+
+	// a sweep before anything expires leaves everything alone
+	sweepExpiredItems(t, s, now)
+	assert.True(t, itemExists(t, s, driverPK, keys.JournalDraft(1700000000)))
+	assert.True(t, itemExists(t, s, driverPK, keys.IngestionLock))
+	assert.True(t, itemExists(t, s, driverPK, keys.Quota(day.Unix())))
+	assert.True(t, itemExists(t, s, driverPK, keys.IngestionCancel))
+
+	// the lock is the first to go
+	sweepExpiredItems(t, s, now.Add(30*time.Minute))
+	assert.False(t, itemExists(t, s, driverPK, keys.IngestionLock))
+	assert.True(t, itemExists(t, s, driverPK, keys.JournalDraft(1700000000)))
+
+	// then the draft and cancel flag after an hour
+	sweepExpiredItems(t, s, now.Add(time.Hour))
+	assert.False(t, itemExists(t, s, driverPK, keys.JournalDraft(1700000000)))
+	assert.False(t, itemExists(t, s, driverPK, keys.IngestionCancel))
+	assert.True(t, itemExists(t, s, driverPK, keys.Quota(day.Unix())))
+
+	// and the quota window two days after the day started
+	sweepExpiredItems(t, s, day.Add(quotaTTLDuration))
+	usage, err := s.GetQuotaUsage(ctx, 12345, day)
+	require.NoError(t, err)
+	assert.Empty(t, usage.Counts)
+}
+
+func TestMemoryStore_TTLSweep(t *testing.T) {
+	now := time.Unix(100000, 0)
+	s := newTestMemoryStore(now)
+	ctx := context.Background()
+	day := time.Unix(86400, 0)
+
+	require.NoError(t, s.InsertDriver(ctx, Driver{DriverID: 1}))
+	require.NoError(t, s.SaveJournalDraft(ctx, RaceJournalDraft{DriverID: 1, RaceID: 1000, ExpiresAt: now.Add(time.Hour)}))
+	consumed, err := s.ConsumeQuota(ctx, 1, day, "export", 5)
+	require.NoError(t, err)
+	require.True(t, consumed)
+
+	draftSK := keys.JournalDraft(1000)
+	driverPK := keys.Driver(1)
+
+	s.sweepExpiredItems(now)
+	assert.NotNil(t, s.get(driverPK, draftSK))
+
+	s.sweepExpiredItems(now.Add(time.Hour))
+	assert.Nil(t, s.get(driverPK, draftSK))
+	usage, err := s.GetQuotaUsage(ctx, 1, day)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"export": 1}, usage.Counts)
+
+	s.sweepExpiredItems(day.Add(quotaTTLDuration))
+	usage, err = s.GetQuotaUsage(ctx, 1, day)
+	require.NoError(t, err)
+	assert.Empty(t, usage.Counts)
+
+	driver, err := s.GetDriver(ctx, 1)
+	require.NoError(t, err)
+	assert.NotNil(t, driver, "records without a TTL are never swept")
+}
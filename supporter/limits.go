@@ -0,0 +1,45 @@
+package supporter
+
+import (
+	"slices"
+
+	"github.com/jonsabados/saturdaysspinout/analytics"
+)
+
+// Entitlement is granted to drivers while they have a live supporter subscription.
+const Entitlement = "supporter"
+
+// Limits caps what a driver can pull out of the API.
+type Limits struct {
+	// HistoryDays is how far back race history and analytics reach, zero for no limit.
+	HistoryDays int
+	// AnalyticsGranularities are the time series bucket sizes the driver may request.
+	AnalyticsGranularities []analytics.Granularity
+	// ExportMaxRaces is the most races a single export may include, the most recent being kept when there are more.
+	ExportMaxRaces int
+}
+
+var freeLimits = Limits{
+	HistoryDays:            365,
+	AnalyticsGranularities: []analytics.Granularity{analytics.GranularityWeek, analytics.GranularityMonth, analytics.GranularityYear},
+	ExportMaxRaces:         100,
+}
+
+var supporterLimits = Limits{
+	HistoryDays:            0,
+	AnalyticsGranularities: []analytics.Granularity{analytics.GranularityDay, analytics.GranularityWeek, analytics.GranularityMonth, analytics.GranularityYear},
+	ExportMaxRaces:         10000,
+}
+
+// LimitsFor returns the limits for a driver holding the given entitlements.
+func LimitsFor(entitlements []string) Limits {
+	if slices.Contains(entitlements, Entitlement) {
+		return supporterLimits
+	}
+	return freeLimits
+}
+
+// AllowsGranularity reports whether the limits permit the analytics granularity.
+func (l Limits) AllowsGranularity(granularity analytics.Granularity) bool {
+	return slices.Contains(l.AnalyticsGranularities, granularity)
+}
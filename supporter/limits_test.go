@@ -0,0 +1,20 @@
+package supporter
+
+import (
+	"testing"
+
+	"github.com/jonsabados/saturdaysspinout/analytics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitsFor(t *testing.T) {
+	free := LimitsFor([]string{"developer"})
+	assert.Equal(t, 365, free.HistoryDays)
+	assert.False(t, free.AllowsGranularity(analytics.GranularityDay))
+	assert.True(t, free.AllowsGranularity(analytics.GranularityWeek))
+
+	supporter := LimitsFor([]string{"developer", Entitlement})
+	assert.Zero(t, supporter.HistoryDays)
+	assert.True(t, supporter.AllowsGranularity(analytics.GranularityDay))
+	assert.Greater(t, supporter.ExportMaxRaces, free.ExportMaxRaces)
+}
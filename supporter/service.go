@@ -0,0 +1,73 @@
+package supporter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// entitledStatuses are the subscription statuses that keep the supporter entitlement. past_due keeps it while Stripe
+// retries the payment, the subscription moves to unpaid or canceled if that fails.
+var entitledStatuses = []string{"active", "trialing", "past_due"}
+
+type Store interface {
+	GetSupporter(ctx context.Context, driverID int64) (*store.Supporter, error)
+	SaveSupporter(ctx context.Context, supporter store.Supporter) (bool, error)
+	GrantEntitlement(ctx context.Context, driverID int64, entitlement string) error
+	RevokeEntitlement(ctx context.Context, driverID int64, entitlement string) error
+}
+
+type Service struct {
+	store Store
+}
+
+func NewService(store Store) *Service {
+	return &Service{store: store}
+}
+
+// GetSupporter returns the driver's subscription, nil if they have never subscribed.
+func (s *Service) GetSupporter(ctx context.Context, driverID int64) (*store.Supporter, error) {
+	return s.store.GetSupporter(ctx, driverID)
+}
+
+// ApplySubscriptionUpdate records a subscription change and grants or revokes the supporter entitlement to match.
+// Changes older than the one already recorded are dropped, Stripe doesn't guarantee delivery order.
+func (s *Service) ApplySubscriptionUpdate(ctx context.Context, update SubscriptionUpdate) error {
+	logger := zerolog.Ctx(ctx).With().
+		Int64("driverId", update.DriverID).
+		Str("subscriptionId", update.SubscriptionID).
+		Str("status", update.Status).
+		Logger()
+
+	saved, err := s.store.SaveSupporter(ctx, store.Supporter{
+		DriverID:         update.DriverID,
+		CustomerID:       update.CustomerID,
+		SubscriptionID:   update.SubscriptionID,
+		Status:           update.Status,
+		CurrentPeriodEnd: update.CurrentPeriodEnd,
+		UpdatedAt:        update.OccurredAt,
+	})
+	if err != nil {
+		return fmt.Errorf("saving supporter: %w", err)
+	}
+	if !saved {
+		logger.Info().Msg("ignoring subscription update older than the recorded one")
+		return nil
+	}
+
+	if slices.Contains(entitledStatuses, update.Status) {
+		if err := s.store.GrantEntitlement(ctx, update.DriverID, Entitlement); err != nil {
+			return fmt.Errorf("granting supporter entitlement: %w", err)
+		}
+		logger.Info().Msg("supporter entitlement granted")
+		return nil
+	}
+	if err := s.store.RevokeEntitlement(ctx, update.DriverID, Entitlement); err != nil {
+		return fmt.Errorf("revoking supporter entitlement: %w", err)
+	}
+	logger.Info().Msg("supporter entitlement revoked")
+	return nil
+}
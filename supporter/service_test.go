@@ -0,0 +1,115 @@
+package supporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_ApplySubscriptionUpdate(t *testing.T) {
+	driverID := int64(12345)
+	update := SubscriptionUpdate{
+		DriverID:         driverID,
+		CustomerID:       "cus_123",
+		SubscriptionID:   "sub_123",
+		Status:           "active",
+		CurrentPeriodEnd: time.Unix(90000, 0),
+		OccurredAt:       time.Unix(5000, 0),
+	}
+	supporterFor := func(status string) store.Supporter {
+		return store.Supporter{
+			DriverID:         driverID,
+			CustomerID:       "cus_123",
+			SubscriptionID:   "sub_123",
+			Status:           status,
+			CurrentPeriodEnd: time.Unix(90000, 0),
+			UpdatedAt:        time.Unix(5000, 0),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		status      string
+		setupMock   func(*MockStore)
+		expectedErr bool
+	}{
+		{
+			name:   "active grants",
+			status: "active",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveSupporter(mock.Anything, supporterFor("active")).Return(true, nil)
+				m.EXPECT().GrantEntitlement(mock.Anything, driverID, Entitlement).Return(nil)
+			},
+		},
+		{
+			name:   "past due keeps the entitlement while payment is retried",
+			status: "past_due",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveSupporter(mock.Anything, supporterFor("past_due")).Return(true, nil)
+				m.EXPECT().GrantEntitlement(mock.Anything, driverID, Entitlement).Return(nil)
+			},
+		},
+		{
+			name:   "canceled revokes",
+			status: "canceled",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveSupporter(mock.Anything, supporterFor("canceled")).Return(true, nil)
+				m.EXPECT().RevokeEntitlement(mock.Anything, driverID, Entitlement).Return(nil)
+			},
+		},
+		{
+			name:   "stale update changes nothing",
+			status: "canceled",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveSupporter(mock.Anything, supporterFor("canceled")).Return(false, nil)
+			},
+		},
+		{
+			name:   "save error",
+			status: "active",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveSupporter(mock.Anything, supporterFor("active")).Return(false, errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+		{
+			name:   "grant error",
+			status: "active",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveSupporter(mock.Anything, supporterFor("active")).Return(true, nil)
+				m.EXPECT().GrantEntitlement(mock.Anything, driverID, Entitlement).Return(errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+		{
+			name:   "revoke error",
+			status: "unpaid",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveSupporter(mock.Anything, supporterFor("unpaid")).Return(true, nil)
+				m.EXPECT().RevokeEntitlement(mock.Anything, driverID, Entitlement).Return(errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			input := update
+			input.Status = tc.status
+			err := NewService(mockStore).ApplySubscriptionUpdate(context.Background(), input)
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
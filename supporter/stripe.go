@@ -0,0 +1,163 @@
+package supporter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+// StripeSignatureHeader carries the signature Stripe puts on webhook deliveries.
+const StripeSignatureHeader = "Stripe-Signature"
+
+// stripeSignatureTolerance bounds how old a signed delivery may be, guarding against replays.
+const stripeSignatureTolerance = 5 * time.Minute
+
+var ErrInvalidSignature = errors.New("invalid stripe signature")
+
+// VerifyStripeSignature checks a webhook payload against its Stripe-Signature header, which looks like
+// t=<unix time>,v1=<hex hmac>[,v1=...]. The signature is an HMAC-SHA256 of "<t>.<payload>" keyed with the endpoint's
+// signing secret, and more than one v1 shows up while the secret is being rolled. Nothing verifies without a secret.
+func VerifyStripeSignature(payload []byte, header, secret string, now time.Time) error {
+	if secret == "" {
+		return ErrInvalidSignature
+	}
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if age := now.Sub(time.Unix(signedAt, 0)); age > stripeSignatureTolerance || age < -stripeSignatureTolerance {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+	for _, signature := range signatures {
+		decoded, err := hex.DecodeString(signature)
+		if err != nil {
+			continue
+		}
+		if hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// Stripe event types that carry subscription changes, everything else is acknowledged and ignored.
+const (
+	StripeEventSubscriptionCreated = "customer.subscription.created"
+	StripeEventSubscriptionUpdated = "customer.subscription.updated"
+	StripeEventSubscriptionDeleted = "customer.subscription.deleted"
+)
+
+// driverIDMetadataKey is the subscription metadata key checkout sessions set (via subscription_data.metadata) to tie a
+// subscription to the iRacing customer that bought it.
+const driverIDMetadataKey = "driver_id"
+
+// tenantIDMetadataKey is the subscription metadata key naming the tenant the driver subscribed through, left off for
+// the default tenant.
+const tenantIDMetadataKey = "tenant_id"
+
+// StripeEvent is the part of a Stripe webhook event we use.
+type StripeEvent struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+type stripeSubscription struct {
+	ID               string            `json:"id"`
+	Customer         string            `json:"customer"`
+	Status           string            `json:"status"`
+	CurrentPeriodEnd int64             `json:"current_period_end"`
+	Metadata         map[string]string `json:"metadata"`
+	// newer API versions only report the billing period on the subscription items
+	Items struct {
+		Data []struct {
+			CurrentPeriodEnd int64 `json:"current_period_end"`
+		} `json:"data"`
+	} `json:"items"`
+}
+
+// SubscriptionUpdate is a subscription change pulled out of a Stripe event.
+type SubscriptionUpdate struct {
+	DriverID         int64
+	TenantID         string
+	CustomerID       string
+	SubscriptionID   string
+	Status           string
+	CurrentPeriodEnd time.Time
+	OccurredAt       time.Time
+}
+
+// ParseStripeEvent decodes a webhook payload, returning a nil update for events that aren't subscription changes.
+func ParseStripeEvent(payload []byte) (*StripeEvent, *SubscriptionUpdate, error) {
+	var event StripeEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, nil, fmt.Errorf("decoding stripe event: %w", err)
+	}
+	switch event.Type {
+	case StripeEventSubscriptionCreated, StripeEventSubscriptionUpdated, StripeEventSubscriptionDeleted:
+	default:
+		return &event, nil, nil
+	}
+
+	var subscription stripeSubscription
+	if err := json.Unmarshal(event.Data.Object, &subscription); err != nil {
+		return nil, nil, fmt.Errorf("decoding subscription in stripe event %s: %w", event.ID, err)
+	}
+	driverID, err := strconv.ParseInt(subscription.Metadata[driverIDMetadataKey], 10, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("subscription %s has no usable %s metadata: %w", subscription.ID, driverIDMetadataKey, err)
+	}
+	tenantID := subscription.Metadata[tenantIDMetadataKey]
+	if tenantID != tenant.Default && !tenant.Valid(tenantID) {
+		return nil, nil, fmt.Errorf("subscription %s has invalid %s metadata %q", subscription.ID, tenantIDMetadataKey, tenantID)
+	}
+	periodEnd := subscription.CurrentPeriodEnd
+	if periodEnd == 0 && len(subscription.Items.Data) > 0 {
+		periodEnd = subscription.Items.Data[0].CurrentPeriodEnd
+	}
+
+	return &event, &SubscriptionUpdate{
+		DriverID:         driverID,
+		TenantID:         tenantID,
+		CustomerID:       subscription.Customer,
+		SubscriptionID:   subscription.ID,
+		Status:           subscription.Status,
+		CurrentPeriodEnd: time.Unix(periodEnd, 0),
+		OccurredAt:       time.Unix(event.Created, 0),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package supporter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sign(payload, secret string, at time.Time) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%d.%s", at.Unix(), payload)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyStripeSignature(t *testing.T) {
+	payload := `{"id":"evt_123"}`
+	secret := "whsec_test"
+	now := time.Unix(1700000000, 0)
+	signedAt := now.Add(-time.Minute)
+
+	testCases := []struct {
+		name        string
+		header      string
+		noSecret    bool
+		expectedErr error
+	}{
+		{
+			name:   "valid",
+			header: fmt.Sprintf("t=%d,v1=%s", signedAt.Unix(), sign(payload, secret, signedAt)),
+		},
+		{
+			name:   "any matching signature while the secret rolls",
+			header: fmt.Sprintf("t=%d,v1=%s,v1=%s", signedAt.Unix(), sign(payload, "whsec_old", signedAt), sign(payload, secret, signedAt)),
+		},
+		{
+			name:        "wrong secret",
+			header:      fmt.Sprintf("t=%d,v1=%s", signedAt.Unix(), sign(payload, "whsec_other", signedAt)),
+			expectedErr: ErrInvalidSignature,
+		},
+		{
+			name:        "too old",
+			header:      fmt.Sprintf("t=%d,v1=%s", now.Add(-10*time.Minute).Unix(), sign(payload, secret, now.Add(-10*time.Minute))),
+			expectedErr: ErrInvalidSignature,
+		},
+		{
+			name:        "missing timestamp",
+			header:      "v1=" + sign(payload, secret, signedAt),
+			expectedErr: ErrInvalidSignature,
+		},
+		{
+			name:        "no secret configured",
+			header:      fmt.Sprintf("t=%d,v1=%s", signedAt.Unix(), sign(payload, "", signedAt)),
+			noSecret:    true,
+			expectedErr: ErrInvalidSignature,
+		},
+		{
+			name:        "missing header",
+			header:      "",
+			expectedErr: ErrInvalidSignature,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := secret
+			if tc.noSecret {
+				key = ""
+			}
+			err := VerifyStripeSignature([]byte(payload), tc.header, key, now)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestParseStripeEvent(t *testing.T) {
+	t.Run("subscription event", func(t *testing.T) {
+		payload := `{"id":"evt_123","type":"customer.subscription.updated","created":1700000000,"data":{"object":{"id":"sub_123","customer":"cus_123","status":"active","current_period_end":1702592000,"metadata":{"driver_id":"12345"}}}}`
+		event, update, err := ParseStripeEvent([]byte(payload))
+		require.NoError(t, err)
+		assert.Equal(t, "evt_123", event.ID)
+		assert.Equal(t, &SubscriptionUpdate{
+			DriverID:         12345,
+			CustomerID:       "cus_123",
+			SubscriptionID:   "sub_123",
+			Status:           "active",
+			CurrentPeriodEnd: time.Unix(1702592000, 0),
+			OccurredAt:       time.Unix(1700000000, 0),
+		}, update)
+	})
+
+	t.Run("period end from subscription items", func(t *testing.T) {
+		payload := `{"id":"evt_123","type":"customer.subscription.created","created":1700000000,"data":{"object":{"id":"sub_123","customer":"cus_123","status":"trialing","metadata":{"driver_id":"12345"},"items":{"data":[{"current_period_end":1702592000}]}}}}`
+		_, update, err := ParseStripeEvent([]byte(payload))
+		require.NoError(t, err)
+		assert.Equal(t, time.Unix(1702592000, 0), update.CurrentPeriodEnd)
+	})
+
+	t.Run("tenant metadata", func(t *testing.T) {
+		payload := `{"id":"evt_123","type":"customer.subscription.updated","created":1700000000,"data":{"object":{"id":"sub_123","customer":"cus_123","status":"active","current_period_end":1702592000,"metadata":{"driver_id":"12345","tenant_id":"league-one"}}}}`
+		_, update, err := ParseStripeEvent([]byte(payload))
+		require.NoError(t, err)
+		assert.Equal(t, "league-one", update.TenantID)
+	})
+
+	t.Run("invalid tenant metadata", func(t *testing.T) {
+		payload := `{"id":"evt_123","type":"customer.subscription.updated","created":1700000000,"data":{"object":{"id":"sub_123","customer":"cus_123","status":"active","current_period_end":1702592000,"metadata":{"driver_id":"12345","tenant_id":"League One"}}}}`
+		_, _, err := ParseStripeEvent([]byte(payload))
+		assert.Error(t, err)
+	})
+
+	t.Run("other events are ignored", func(t *testing.T) {
+		payload := `{"id":"evt_123","type":"invoice.paid","created":1700000000,"data":{"object":{"id":"in_123"}}}`
+		event, update, err := ParseStripeEvent([]byte(payload))
+		require.NoError(t, err)
+		assert.Equal(t, "invoice.paid", event.Type)
+		assert.Nil(t, update)
+	})
+
+	t.Run("missing driver metadata", func(t *testing.T) {
+		payload := `{"id":"evt_123","type":"customer.subscription.deleted","created":1700000000,"data":{"object":{"id":"sub_123","customer":"cus_123","status":"canceled","metadata":{}}}}`
+		_, _, err := ParseStripeEvent([]byte(payload))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, _, err := ParseStripeEvent([]byte("nope"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,255 @@
+package telemetry
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// ParseError describes why an export couldn't be read. Code and Params follow the conventions of field validation
+// errors so they can be reported back against the upload.
+type ParseError struct {
+	Code   string
+	Params map[string]string
+}
+
+func (e *ParseError) Error() string {
+	if len(e.Params) == 0 {
+		return e.Code
+	}
+	keys := make([]string, 0, len(e.Params))
+	for k := range e.Params {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + e.Params[k]
+	}
+	return fmt.Sprintf("%s (%s)", e.Code, strings.Join(parts, ", "))
+}
+
+// refuelThreshold is how much the fuel level has to rise between laps to count as a pit stop rather than sensor noise,
+// in liters.
+const refuelThreshold = 0.1
+
+var corners = []store.TireCorner{store.TireCornerLF, store.TireCornerRF, store.TireCornerLR, store.TireCornerRR}
+
+// columnAliases maps normalized header names to the measure they hold. Headers are normalized by lower casing them and
+// dropping anything that isn't a letter or digit, so "Fuel Level", "fuel_level" and iRacing's own FuelLevel all match.
+var columnAliases = func() map[string]string {
+	aliases := map[string]string{
+		"lap":       "lap",
+		"lapnumber": "lap",
+		"lapnum":    "lap",
+		"stint":     "stint",
+		"fuelused":  "fuel_used",
+		"fueluse":   "fuel_used",
+		"fuellevel": "fuel_level",
+		"fuel":      "fuel_level",
+	}
+	for _, c := range corners {
+		// iRacing's telemetry splits carcass temperatures into left, middle and right, the middle stands in for the tire
+		aliases[string(c)+"temp"] = string(c) + "_temp"
+		aliases[string(c)+"tempcm"] = string(c) + "_temp"
+		aliases[string(c)+"pressure"] = string(c) + "_pressure"
+		aliases[string(c)+"press"] = string(c) + "_pressure"
+	}
+	return aliases
+}()
+
+func normalizeHeader(header string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(header) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+type lapReading struct {
+	lap    int
+	stint  *int
+	values map[string]float64
+}
+
+// Parse reads a per lap telemetry summary export, as produced from iRacing's IBT files, and summarizes it by stint.
+// The export is a CSV with a header row; a lap column is required while fuel (used per lap or level at the start of
+// the lap), tire temperatures (°C) and tire pressures (kPa) are summarized when present and unrecognized columns are
+// ignored. Stints follow a stint column when there is one, otherwise a rise in fuel level marks a pit stop.
+func Parse(r io.Reader) ([]store.TelemetryStint, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, &ParseError{Code: "no_laps"}
+	}
+	if err != nil {
+		return nil, csvParseError(err)
+	}
+
+	columns := make(map[int]string)
+	hasLap := false
+	for i, h := range header {
+		if measure, ok := columnAliases[normalizeHeader(h)]; ok {
+			columns[i] = measure
+			hasLap = hasLap || measure == "lap"
+		}
+	}
+	if !hasLap {
+		return nil, &ParseError{Code: "missing_column", Params: map[string]string{"column": "lap"}}
+	}
+
+	var readings []lapReading
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, csvParseError(err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		reading := lapReading{lap: -1, values: make(map[string]float64)}
+		for i, measure := range columns {
+			cell := strings.TrimSpace(record[i])
+			if cell == "" {
+				continue
+			}
+			value, err := strconv.ParseFloat(cell, 64)
+			if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+				return nil, &ParseError{Code: "invalid_value", Params: map[string]string{"line": strconv.Itoa(line), "column": header[i]}}
+			}
+			switch measure {
+			case "lap":
+				reading.lap = int(value)
+			case "stint":
+				stint := int(value)
+				reading.stint = &stint
+			default:
+				reading.values[measure] = value
+			}
+		}
+		if reading.lap < 0 {
+			return nil, &ParseError{Code: "invalid_value", Params: map[string]string{"line": strconv.Itoa(line), "column": "lap"}}
+		}
+		readings = append(readings, reading)
+	}
+	if len(readings) == 0 {
+		return nil, &ParseError{Code: "no_laps"}
+	}
+
+	slices.SortStableFunc(readings, func(a, b lapReading) int {
+		return a.lap - b.lap
+	})
+
+	var stints []store.TelemetryStint
+	start := 0
+	for i := 1; i <= len(readings); i++ {
+		if i < len(readings) && !startsStint(readings[i-1], readings[i]) {
+			continue
+		}
+		stints = append(stints, summarizeStint(len(stints)+1, readings[start:i]))
+		start = i
+	}
+	return stints, nil
+}
+
+func csvParseError(err error) error {
+	var csvErr *csv.ParseError
+	if errors.As(err, &csvErr) {
+		return &ParseError{Code: "invalid_csv", Params: map[string]string{"line": strconv.Itoa(csvErr.Line)}}
+	}
+	return err
+}
+
+func startsStint(prev, cur lapReading) bool {
+	if prev.stint != nil || cur.stint != nil {
+		return prev.stint == nil || cur.stint == nil || *prev.stint != *cur.stint
+	}
+	prevFuel, prevOK := prev.values["fuel_level"]
+	curFuel, curOK := cur.values["fuel_level"]
+	return prevOK && curOK && curFuel-prevFuel > refuelThreshold
+}
+
+func summarizeStint(number int, laps []lapReading) store.TelemetryStint {
+	stint := store.TelemetryStint{
+		Stint:      number,
+		StartLap:   laps[0].lap,
+		EndLap:     laps[len(laps)-1].lap,
+		Laps:       len(laps),
+		FuelPerLap: round(fuelPerLap(laps)),
+		Tires:      []store.TireSummary{},
+	}
+
+	for _, c := range corners {
+		tempKey, pressureKey := string(c)+"_temp", string(c)+"_pressure"
+		var tempSum float64
+		var tempCount int
+		var pressures []float64
+		for _, lap := range laps {
+			if temp, ok := lap.values[tempKey]; ok {
+				tempSum += temp
+				tempCount++
+			}
+			if pressure, ok := lap.values[pressureKey]; ok {
+				pressures = append(pressures, pressure)
+			}
+		}
+		if tempCount == 0 && len(pressures) == 0 {
+			continue
+		}
+		tire := store.TireSummary{Corner: c}
+		if tempCount > 0 {
+			tire.AvgTemp = round(tempSum / float64(tempCount))
+		}
+		if len(pressures) > 0 {
+			tire.StartPressure = round(pressures[0])
+			tire.EndPressure = round(pressures[len(pressures)-1])
+		}
+		stint.Tires = append(stint.Tires, tire)
+	}
+	return stint
+}
+
+// fuelPerLap prefers the per lap fuel use the export recorded, falling back to the drop in fuel level across the
+// stint. Returns zero when the export had neither.
+func fuelPerLap(laps []lapReading) float64 {
+	var usedSum float64
+	var usedCount int
+	var first, last *lapReading
+	for i, lap := range laps {
+		if used, ok := lap.values["fuel_used"]; ok {
+			usedSum += used
+			usedCount++
+		}
+		if _, ok := lap.values["fuel_level"]; ok {
+			if first == nil {
+				first = &laps[i]
+			}
+			last = &laps[i]
+		}
+	}
+	if usedCount > 0 {
+		return usedSum / float64(usedCount)
+	}
+	if first == nil || last.lap == first.lap {
+		return 0
+	}
+	return (first.values["fuel_level"] - last.values["fuel_level"]) / float64(last.lap-first.lap)
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,123 @@
+package telemetry
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name          string
+		export        string
+		expected      []store.TelemetryStint
+		expectedError *ParseError
+	}{
+		{
+			name: "stint column with fuel used",
+			export: `Lap,Stint,Fuel Used,LF Temp,RF Temp,LF Pressure,RF Pressure,Lap Time
+1,1,2.5,80,84,165.5,166,95.2
+2,1,2.4,82,86,170.25,171,94.8
+3,1,2.3,84,88,174,175.5,94.6
+4,2,2.6,78,80,166,166.5,95.9
+5,2,2.4,80,82,169,170,95.1
+`,
+			expected: []store.TelemetryStint{
+				{Stint: 1, StartLap: 1, EndLap: 3, Laps: 3, FuelPerLap: 2.4, Tires: []store.TireSummary{
+					{Corner: store.TireCornerLF, AvgTemp: 82, StartPressure: 165.5, EndPressure: 174},
+					{Corner: store.TireCornerRF, AvgTemp: 86, StartPressure: 166, EndPressure: 175.5},
+				}},
+				{Stint: 2, StartLap: 4, EndLap: 5, Laps: 2, FuelPerLap: 2.5, Tires: []store.TireSummary{
+					{Corner: store.TireCornerLF, AvgTemp: 79, StartPressure: 166, EndPressure: 169},
+					{Corner: store.TireCornerRF, AvgTemp: 81, StartPressure: 166.5, EndPressure: 170},
+				}},
+			},
+		},
+		{
+			name: "iRacing variable names split on refueling",
+			export: `# exported from race.ibt
+LapNum,FuelLevel,LRtempCM,RRtempCM,LRpressure,RRpressure
+3,30,90,,160,
+1,35,,,,
+2,32.5,86,92,158,161
+4,40,84,88,158.5,159
+5,37.6,85,89,161,162
+6,35.2,86.5,90.5,163,164.5
+`,
+			expected: []store.TelemetryStint{
+				{Stint: 1, StartLap: 1, EndLap: 3, Laps: 3, FuelPerLap: 2.5, Tires: []store.TireSummary{
+					{Corner: store.TireCornerLR, AvgTemp: 88, StartPressure: 158, EndPressure: 160},
+					{Corner: store.TireCornerRR, AvgTemp: 92, StartPressure: 161, EndPressure: 161},
+				}},
+				{Stint: 2, StartLap: 4, EndLap: 6, Laps: 3, FuelPerLap: 2.4, Tires: []store.TireSummary{
+					{Corner: store.TireCornerLR, AvgTemp: 85.17, StartPressure: 158.5, EndPressure: 163},
+					{Corner: store.TireCornerRR, AvgTemp: 89.17, StartPressure: 159, EndPressure: 164.5},
+				}},
+			},
+		},
+		{
+			name: "laps only",
+			export: `lap
+1
+2
+`,
+			expected: []store.TelemetryStint{
+				{Stint: 1, StartLap: 1, EndLap: 2, Laps: 2, Tires: []store.TireSummary{}},
+			},
+		},
+		{
+			name:          "empty",
+			export:        "",
+			expectedError: &ParseError{Code: "no_laps"},
+		},
+		{
+			name:          "header only",
+			export:        "lap,fuel used\n",
+			expectedError: &ParseError{Code: "no_laps"},
+		},
+		{
+			name:          "no lap column",
+			export:        "fuel used,lf temp\n2.4,80\n",
+			expectedError: &ParseError{Code: "missing_column", Params: map[string]string{"column": "lap"}},
+		},
+		{
+			name:          "missing lap",
+			export:        "lap,fuel used\n1,2.4\n,2.3\n",
+			expectedError: &ParseError{Code: "invalid_value", Params: map[string]string{"line": "3", "column": "lap"}},
+		},
+		{
+			name:          "non-numeric value",
+			export:        "lap,LF Temp\n1,80\n2,hot\n",
+			expectedError: &ParseError{Code: "invalid_value", Params: map[string]string{"line": "3", "column": "LF Temp"}},
+		},
+		{
+			name:          "ragged row",
+			export:        "lap,fuel used\n1,2.4\n2\n",
+			expectedError: &ParseError{Code: "invalid_csv", Params: map[string]string{"line": "3"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stints, err := Parse(strings.NewReader(tc.export))
+			if tc.expectedError != nil {
+				var parseErr *ParseError
+				require.True(t, errors.As(err, &parseErr), "expected a *ParseError, got %v", err)
+				assert.Equal(t, tc.expectedError, parseErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, stints)
+		})
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	assert.Equal(t, "no_laps", (&ParseError{Code: "no_laps"}).Error())
+	assert.Equal(t, "invalid_value (column=lap, line=3)",
+		(&ParseError{Code: "invalid_value", Params: map[string]string{"line": "3", "column": "lap"}}).Error())
+}
@@ -0,0 +1,67 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+// ErrRaceNotFound is returned when importing telemetry for a race the driver doesn't have.
+var ErrRaceNotFound = errors.New("race not found")
+
+// Store defines the data access methods needed by the telemetry service.
+type Store interface {
+	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)
+	SaveRaceTelemetry(ctx context.Context, telemetry store.RaceTelemetry) error
+	GetRaceTelemetry(ctx context.Context, driverID, raceID int64) (*store.RaceTelemetry, error)
+}
+
+// Service imports the telemetry summaries drivers export from their races.
+type Service struct {
+	store Store
+	now   func() time.Time
+}
+
+func NewService(store Store) *Service {
+	return &Service{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Import parses a telemetry summary export and attaches it to one of the driver's races, replacing anything imported
+// before. Returns ErrRaceNotFound if raceID doesn't identify one of the driver's races, or a *ParseError if the export
+// can't be read.
+func (s *Service) Import(ctx context.Context, driverID, raceID int64, export io.Reader) (*store.RaceTelemetry, error) {
+	session, err := s.store.GetDriverSession(ctx, driverID, store.TimeFromDriverRaceID(raceID))
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, ErrRaceNotFound
+	}
+
+	stints, err := Parse(export)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry := store.RaceTelemetry{
+		DriverID:   driverID,
+		RaceID:     raceID,
+		Stints:     stints,
+		ImportedAt: s.now(),
+	}
+	if err := s.store.SaveRaceTelemetry(ctx, telemetry); err != nil {
+		return nil, err
+	}
+	return &telemetry, nil
+}
+
+// Get returns the telemetry imported for a race, nil if there hasn't been any.
+func (s *Service) Get(ctx context.Context, driverID, raceID int64) (*store.RaceTelemetry, error) {
+	return s.store.GetRaceTelemetry(ctx, driverID, raceID)
+}
@@ -0,0 +1,123 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Import(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	now := time.Unix(1700100000, 0)
+	export := "lap,fuel used\n1,2.5\n2,2.3\n"
+	expected := store.RaceTelemetry{
+		DriverID: driverID,
+		RaceID:   raceID,
+		Stints: []store.TelemetryStint{
+			{Stint: 1, StartLap: 1, EndLap: 2, Laps: 2, FuelPerLap: 2.4, Tires: []store.TireSummary{}},
+		},
+		ImportedAt: now,
+	}
+
+	testCases := []struct {
+		name          string
+		export        string
+		setupMock     func(*MockStore)
+		expected      *store.RaceTelemetry
+		expectedErr   error
+		expectedParse bool
+		expectErr     bool
+	}{
+		{
+			name:   "success",
+			export: export,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).
+					Return(&store.DriverSession{DriverID: driverID}, nil)
+				m.EXPECT().SaveRaceTelemetry(mock.Anything, expected).Return(nil)
+			},
+			expected: &expected,
+		},
+		{
+			name:   "race not found",
+			export: export,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).Return(nil, nil)
+			},
+			expectedErr: ErrRaceNotFound,
+		},
+		{
+			name:   "unreadable export",
+			export: "fuel used\n2.5\n",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).
+					Return(&store.DriverSession{DriverID: driverID}, nil)
+			},
+			expectedParse: true,
+		},
+		{
+			name:   "session error",
+			export: export,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).
+					Return(nil, errors.New("database error"))
+			},
+			expectErr: true,
+		},
+		{
+			name:   "save error",
+			export: export,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).
+					Return(&store.DriverSession{DriverID: driverID}, nil)
+				m.EXPECT().SaveRaceTelemetry(mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			svc := NewService(mockStore)
+			svc.now = func() time.Time { return now }
+
+			telemetry, err := svc.Import(ctx, driverID, raceID, strings.NewReader(tc.export))
+			switch {
+			case tc.expectedErr != nil:
+				assert.ErrorIs(t, err, tc.expectedErr)
+			case tc.expectedParse:
+				var parseErr *ParseError
+				assert.ErrorAs(t, err, &parseErr)
+			case tc.expectErr:
+				assert.Error(t, err)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, telemetry)
+			}
+		})
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	ctx := context.Background()
+	telemetry := &store.RaceTelemetry{DriverID: 12345, RaceID: 1700000000}
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetRaceTelemetry(mock.Anything, int64(12345), int64(1700000000)).Return(telemetry, nil)
+
+	got, err := NewService(mockStore).Get(ctx, 12345, 1700000000)
+	require.NoError(t, err)
+	assert.Equal(t, telemetry, got)
+}
@@ -0,0 +1,125 @@
+// Package tenant lets a single deployment host several communities, each with data of its own. Every community other
+// than the default one has its own copy of the store, and requests, tokens, queued work and metrics carry which one
+// they belong to.
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// Default is the tenant of a deployment hosting a single community, and of everything from before tenants existed. Its
+// data stays where it always has been.
+const Default = ""
+
+// HeaderName is the request header naming the tenant a request is for, the default when left off.
+const HeaderName = "X-Tenant-ID"
+
+// IDs go into table names, object keys and transcription job names, so are kept to what all of those allow.
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// ErrUnknown is returned for a tenant the deployment doesn't host.
+var ErrUnknown = errors.New("unknown tenant")
+
+// Valid reports whether id may be used as a tenant ID. The default tenant's empty ID is not.
+func Valid(id string) bool {
+	return idPattern.MatchString(id)
+}
+
+type contextKey struct{}
+
+// WithID returns a context for work done on behalf of the given tenant.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the tenant work is being done for, the default when none was set.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// TableName is the name of the tenant's copy of the table named base.
+func TableName(base, id string) string {
+	if id == Default {
+		return base
+	}
+	return base + "-" + id
+}
+
+// FromTableName returns the tenant whose copy of the table named base the table is, false when it is neither the
+// table nor a tenant's copy of it.
+func FromTableName(base, table string) (string, bool) {
+	if table == base {
+		return Default, true
+	}
+	id, ok := strings.CutPrefix(table, base+"-")
+	if !ok || !Valid(id) {
+		return "", false
+	}
+	return id, true
+}
+
+// Registry is the tenants a deployment hosts.
+type Registry struct {
+	ids []string
+}
+
+// NewRegistry returns a registry of the default tenant and the ones given, normally from the TENANTS setting.
+func NewRegistry(ids []string) (*Registry, error) {
+	registry := &Registry{ids: []string{Default}}
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if !Valid(id) {
+			return nil, fmt.Errorf("tenant ID %q must be lowercase letters, digits and hyphens, at most 32 long", id)
+		}
+		if registry.Hosts(id) {
+			return nil, fmt.Errorf("tenant ID %q is listed more than once", id)
+		}
+		registry.ids = append(registry.ids, id)
+	}
+	return registry, nil
+}
+
+// Hosts reports whether the deployment hosts the tenant. The default tenant is always hosted.
+func (r *Registry) Hosts(id string) bool {
+	for _, hosted := range r.ids {
+		if hosted == id {
+			return true
+		}
+	}
+	return false
+}
+
+// IDs returns every tenant hosted, the default first.
+func (r *Registry) IDs() []string {
+	return append([]string(nil), r.ids...)
+}
+
+// ForEach runs fn for every tenant hosted, with the tenant on the context it is given and on the context logger. A
+// failure for one tenant doesn't stop the others being run; all failures are returned together.
+func (r *Registry) ForEach(ctx context.Context, fn func(ctx context.Context) error) error {
+	var errs []error
+	for _, id := range r.ids {
+		tenantCtx := WithID(ctx, id)
+		if id != Default {
+			logger := zerolog.Ctx(ctx).With().Str("tenant", id).Logger()
+			tenantCtx = logger.WithContext(tenantCtx)
+		}
+		if err := fn(tenantCtx); err != nil {
+			if id != Default {
+				err = fmt.Errorf("tenant %s: %w", id, err)
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,72 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, Default, FromContext(ctx))
+	assert.Equal(t, "league-one", FromContext(WithID(ctx, "league-one")))
+}
+
+func TestTableName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		table    string
+		expectID string
+		expectOK bool
+	}{
+		{name: "default", table: "AppStore", expectID: Default, expectOK: true},
+		{name: "tenant", table: "AppStore-league-one", expectID: "league-one", expectOK: true},
+		{name: "other table", table: "OtherStore", expectOK: false},
+		{name: "not an ID", table: "AppStore-League", expectOK: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			id, ok := FromTableName("AppStore", tc.table)
+			assert.Equal(t, tc.expectOK, ok)
+			assert.Equal(t, tc.expectID, id)
+			if ok {
+				assert.Equal(t, tc.table, TableName("AppStore", id))
+			}
+		})
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	registry, err := NewRegistry([]string{"league-one", " team2 ", ""})
+	require.NoError(t, err)
+	assert.Equal(t, []string{Default, "league-one", "team2"}, registry.IDs())
+	assert.True(t, registry.Hosts(Default))
+	assert.True(t, registry.Hosts("team2"))
+	assert.False(t, registry.Hosts("league-two"))
+
+	_, err = NewRegistry([]string{"League_One"})
+	assert.Error(t, err)
+
+	_, err = NewRegistry([]string{"league-one", "league-one"})
+	assert.Error(t, err)
+}
+
+func TestRegistry_ForEach(t *testing.T) {
+	registry, err := NewRegistry([]string{"league-one", "team2"})
+	require.NoError(t, err)
+
+	var ran []string
+	err = registry.ForEach(context.Background(), func(ctx context.Context) error {
+		ran = append(ran, FromContext(ctx))
+		if FromContext(ctx) == "league-one" {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	assert.EqualError(t, err, "tenant league-one: boom")
+	assert.Equal(t, []string{Default, "league-one", "team2"}, ran)
+}
@@ -0,0 +1,152 @@
+package terms
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+const (
+	DocumentTermsOfService = "tos"
+	DocumentPrivacyPolicy  = "privacy"
+)
+
+// Documents are the legal documents drivers can be asked to accept
+var Documents = []string{DocumentTermsOfService, DocumentPrivacyPolicy}
+
+var (
+	// ErrUnknownDocument is returned when accepting a document drivers aren't currently asked to accept
+	ErrUnknownDocument = errors.New("unknown document")
+	// ErrNotCurrentVersion is returned when accepting a version of a document other than the current one
+	ErrNotCurrentVersion = errors.New("not the current version")
+)
+
+// Versions are the current version of each document drivers must accept, keyed by document.
+type Versions map[string]string
+
+// ParseVersions reads current document versions from JSON like {"tos":"2025-01-01","privacy":"2025-01-01"}.
+func ParseVersions(raw string) (Versions, error) {
+	var versions Versions
+	if err := json.Unmarshal([]byte(raw), &versions); err != nil {
+		return nil, fmt.Errorf("parsing terms versions: %w", err)
+	}
+	for document, version := range versions {
+		if !slices.Contains(Documents, document) {
+			return nil, fmt.Errorf("terms versions have unknown document %s", document)
+		}
+		if version == "" {
+			return nil, fmt.Errorf("terms versions have a blank version for %s", document)
+		}
+	}
+	return versions, nil
+}
+
+type Store interface {
+	GetTermsAcceptances(ctx context.Context, driverID int64) ([]store.TermsAcceptance, error)
+	SaveTermsAcceptance(ctx context.Context, acceptance store.TermsAcceptance) (bool, error)
+}
+
+// Requirement is the current version of a document a driver has yet to accept.
+type Requirement struct {
+	Document string
+	Version  string
+}
+
+// Status is where a driver stands with the current version of a document.
+type Status struct {
+	Document       string
+	CurrentVersion string
+	// AcceptedAt is when the driver accepted the current version, nil if they haven't
+	AcceptedAt *time.Time
+}
+
+// Service tracks drivers accepting the terms of service and privacy policy. Accepting an older version counts for
+// nothing, so publishing a new version asks every driver to accept again.
+type Service struct {
+	store   Store
+	current Versions
+	now     func() time.Time
+}
+
+func NewService(store Store, current Versions) *Service {
+	return &Service{
+		store:   store,
+		current: current,
+		now:     time.Now,
+	}
+}
+
+// Status returns where a driver stands with each document they are asked to accept, ordered by document.
+func (s *Service) Status(ctx context.Context, driverID int64) ([]Status, error) {
+	if len(s.current) == 0 {
+		return []Status{}, nil
+	}
+	acceptances, err := s.store.GetTermsAcceptances(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("getting terms acceptances: %w", err)
+	}
+
+	ret := make([]Status, 0, len(s.current))
+	for document, version := range s.current {
+		status := Status{Document: document, CurrentVersion: version}
+		for _, acceptance := range acceptances {
+			if acceptance.Document == document && acceptance.Version == version {
+				acceptedAt := acceptance.AcceptedAt
+				status.AcceptedAt = &acceptedAt
+			}
+		}
+		ret = append(ret, status)
+	}
+	slices.SortFunc(ret, func(a, b Status) int {
+		return cmp.Compare(a.Document, b.Document)
+	})
+	return ret, nil
+}
+
+// Outstanding returns the current document versions a driver has yet to accept, ordered by document.
+func (s *Service) Outstanding(ctx context.Context, driverID int64) ([]Requirement, error) {
+	statuses, err := s.Status(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	var ret []Requirement
+	for _, status := range statuses {
+		if status.AcceptedAt == nil {
+			ret = append(ret, Requirement{Document: status.Document, Version: status.CurrentVersion})
+		}
+	}
+	return ret, nil
+}
+
+// Accept records a driver accepting a version of a document, which must be the current one. Accepting a version
+// already accepted keeps the original acceptance time.
+func (s *Service) Accept(ctx context.Context, driverID int64, document, version string) error {
+	current, ok := s.current[document]
+	if !ok {
+		return ErrUnknownDocument
+	}
+	if version != current {
+		return ErrNotCurrentVersion
+	}
+
+	saved, err := s.store.SaveTermsAcceptance(ctx, store.TermsAcceptance{
+		DriverID:   driverID,
+		Document:   document,
+		Version:    version,
+		AcceptedAt: s.now(),
+	})
+	if err != nil {
+		return fmt.Errorf("saving terms acceptance: %w", err)
+	}
+	if saved {
+		zerolog.Ctx(ctx).Info().Int64("driverId", driverID).Str("document", document).Str("version", version).Msg("terms accepted")
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package terms
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		raw         string
+		expected    Versions
+		expectedErr bool
+	}{
+		{
+			name:     "both documents",
+			raw:      `{"tos":"2025-01-01","privacy":"2025-02-01"}`,
+			expected: Versions{DocumentTermsOfService: "2025-01-01", DocumentPrivacyPolicy: "2025-02-01"},
+		},
+		{
+			name:        "not json",
+			raw:         `tos=2025-01-01`,
+			expectedErr: true,
+		},
+		{
+			name:        "unknown document",
+			raw:         `{"eula":"2025-01-01"}`,
+			expectedErr: true,
+		},
+		{
+			name:        "blank version",
+			raw:         `{"tos":""}`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			versions, err := ParseVersions(tc.raw)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, versions)
+		})
+	}
+}
+
+func TestService_Status(t *testing.T) {
+	driverID := int64(12345)
+	acceptedAt := time.Unix(1000, 0)
+	current := Versions{DocumentTermsOfService: "2025-02-01", DocumentPrivacyPolicy: "2025-01-01"}
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetTermsAcceptances(mock.Anything, driverID).Return([]store.TermsAcceptance{
+		{DriverID: driverID, Document: DocumentPrivacyPolicy, Version: "2025-01-01", AcceptedAt: acceptedAt},
+		// an older version counts for nothing
+		{DriverID: driverID, Document: DocumentTermsOfService, Version: "2025-01-01", AcceptedAt: acceptedAt},
+	}, nil)
+
+	statuses, err := NewService(mockStore, current).Status(context.Background(), driverID)
+	require.NoError(t, err)
+	assert.Equal(t, []Status{
+		{Document: DocumentPrivacyPolicy, CurrentVersion: "2025-01-01", AcceptedAt: &acceptedAt},
+		{Document: DocumentTermsOfService, CurrentVersion: "2025-02-01"},
+	}, statuses)
+}
+
+func TestService_Outstanding(t *testing.T) {
+	driverID := int64(12345)
+	current := Versions{DocumentTermsOfService: "2025-02-01", DocumentPrivacyPolicy: "2025-01-01"}
+
+	testCases := []struct {
+		name        string
+		current     Versions
+		setupMock   func(*MockStore)
+		expected    []Requirement
+		expectedErr bool
+	}{
+		{
+			name:    "nothing accepted",
+			current: current,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetTermsAcceptances(mock.Anything, driverID).Return([]store.TermsAcceptance{}, nil)
+			},
+			expected: []Requirement{
+				{Document: DocumentPrivacyPolicy, Version: "2025-01-01"},
+				{Document: DocumentTermsOfService, Version: "2025-02-01"},
+			},
+		},
+		{
+			name:    "everything accepted",
+			current: current,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetTermsAcceptances(mock.Anything, driverID).Return([]store.TermsAcceptance{
+					{DriverID: driverID, Document: DocumentPrivacyPolicy, Version: "2025-01-01", AcceptedAt: time.Unix(1000, 0)},
+					{DriverID: driverID, Document: DocumentTermsOfService, Version: "2025-02-01", AcceptedAt: time.Unix(1000, 0)},
+				}, nil)
+			},
+		},
+		{
+			name:      "no documents configured",
+			setupMock: func(m *MockStore) {},
+		},
+		{
+			name:    "store error",
+			current: current,
+			setupMock: func(m *MockStore) {
+				m.EXPECT().GetTermsAcceptances(mock.Anything, driverID).Return(nil, errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			outstanding, err := NewService(mockStore, tc.current).Outstanding(context.Background(), driverID)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, outstanding)
+		})
+	}
+}
+
+func TestService_Accept(t *testing.T) {
+	driverID := int64(12345)
+	now := time.Unix(5000, 0)
+	current := Versions{DocumentTermsOfService: "2025-02-01"}
+	acceptance := store.TermsAcceptance{DriverID: driverID, Document: DocumentTermsOfService, Version: "2025-02-01", AcceptedAt: now}
+
+	testCases := []struct {
+		name        string
+		document    string
+		version     string
+		setupMock   func(*MockStore)
+		expectedErr error
+	}{
+		{
+			name:     "current version",
+			document: DocumentTermsOfService,
+			version:  "2025-02-01",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveTermsAcceptance(mock.Anything, acceptance).Return(true, nil)
+			},
+		},
+		{
+			name:     "already accepted",
+			document: DocumentTermsOfService,
+			version:  "2025-02-01",
+			setupMock: func(m *MockStore) {
+				m.EXPECT().SaveTermsAcceptance(mock.Anything, acceptance).Return(false, nil)
+			},
+		},
+		{
+			name:        "older version",
+			document:    DocumentTermsOfService,
+			version:     "2025-01-01",
+			setupMock:   func(m *MockStore) {},
+			expectedErr: ErrNotCurrentVersion,
+		},
+		{
+			name:        "document not asked for",
+			document:    DocumentPrivacyPolicy,
+			version:     "2025-01-01",
+			setupMock:   func(m *MockStore) {},
+			expectedErr: ErrUnknownDocument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			tc.setupMock(mockStore)
+
+			service := NewService(mockStore, current)
+			service.now = func() time.Time { return now }
+
+			err := service.Accept(context.Background(), driverID, tc.document, tc.version)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("save fails", func(t *testing.T) {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().SaveTermsAcceptance(mock.Anything, acceptance).Return(false, errors.New("boom"))
+
+		service := NewService(mockStore, current)
+		service.now = func() time.Time { return now }
+
+		assert.Error(t, service.Accept(context.Background(), driverID, DocumentTermsOfService, "2025-02-01"))
+	})
+}
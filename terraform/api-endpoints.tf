@@ -43,6 +43,27 @@ resource "aws_api_gateway_resource" "auth_refresh" {
   path_part   = "refresh"
 }
 
+# /auth/sessions
+resource "aws_api_gateway_resource" "auth_sessions" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.auth.id
+  path_part   = "sessions"
+}
+
+# /auth/sessions/{session_id}
+resource "aws_api_gateway_resource" "auth_session" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.auth_sessions.id
+  path_part   = "{session_id}"
+}
+
+# /auth/terms
+resource "aws_api_gateway_resource" "auth_terms" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.auth.id
+  path_part   = "terms"
+}
+
 # /ingestion
 resource "aws_api_gateway_resource" "ingestion" {
   rest_api_id = aws_api_gateway_rest_api.api.id
@@ -120,424 +141,2582 @@ resource "aws_api_gateway_resource" "driver_race_journal" {
   path_part   = "journal"
 }
 
-# /driver/{driver_id}/journal
-resource "aws_api_gateway_resource" "driver_journal" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_resource.driver_id.id
-  path_part   = "journal"
+# /driver/{driver_id}/journal
+resource "aws_api_gateway_resource" "driver_journal" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "journal"
+}
+
+# /driver/{driver_id}/analytics
+resource "aws_api_gateway_resource" "driver_analytics" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "analytics"
+}
+
+# /driver/{driver_id}/analytics/dimensions
+resource "aws_api_gateway_resource" "driver_analytics_dimensions" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_analytics.id
+  path_part   = "dimensions"
+}
+
+# /driver/{driver_id}/analytics/prediction
+resource "aws_api_gateway_resource" "driver_analytics_prediction" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_analytics.id
+  path_part   = "prediction"
+}
+
+# /tracks
+resource "aws_api_gateway_resource" "tracks" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "tracks"
+}
+
+# /cars
+resource "aws_api_gateway_resource" "cars" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "cars"
+}
+
+# /series
+resource "aws_api_gateway_resource" "series" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "series"
+}
+
+# /session
+resource "aws_api_gateway_resource" "session" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "session"
+}
+
+# /session/{subsession_id}
+resource "aws_api_gateway_resource" "session_id" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.session.id
+  path_part   = "{subsession_id}"
+}
+
+# /session/{subsession_id}/simsession
+resource "aws_api_gateway_resource" "session_simsession" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.session_id.id
+  path_part   = "simsession"
+}
+
+# /session/{subsession_id}/simsession/{simsession}
+resource "aws_api_gateway_resource" "session_simsession_id" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.session_simsession.id
+  path_part   = "{simsession}"
+}
+
+# /session/{subsession_id}/simsession/{simsession}/driver
+resource "aws_api_gateway_resource" "session_simsession_driver" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.session_simsession_id.id
+  path_part   = "driver"
+}
+
+# /session/{subsession_id}/simsession/{simsession}/driver/{driver_id}
+resource "aws_api_gateway_resource" "session_simsession_driver_id" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.session_simsession_driver.id
+  path_part   = "{driver_id}"
+}
+
+# /session/{subsession_id}/simsession/{simsession}/driver/{driver_id}/laps
+resource "aws_api_gateway_resource" "session_simsession_driver_laps" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.session_simsession_driver_id.id
+  path_part   = "laps"
+}
+
+# /session/{subsession_id}/pace-comparison
+resource "aws_api_gateway_resource" "session_pace_comparison" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.session_id.id
+  path_part   = "pace-comparison"
+}
+
+# /session/{subsession_id}/laps
+resource "aws_api_gateway_resource" "session_laps" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.session_id.id
+  path_part   = "laps"
+}
+
+# /session/{subsession_id}/laps/ingest
+resource "aws_api_gateway_resource" "session_laps_ingest" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.session_laps.id
+  path_part   = "ingest"
+}
+
+# /driver/{driver_id}/analytics/championship
+resource "aws_api_gateway_resource" "driver_analytics_championship" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_analytics.id
+  path_part   = "championship"
+}
+
+# /driver/{driver_id}/standings
+resource "aws_api_gateway_resource" "driver_standings" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "standings"
+}
+
+# /driver/{driver_id}/settings
+resource "aws_api_gateway_resource" "driver_settings" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "settings"
+}
+
+# /developer/ingestion-metrics
+resource "aws_api_gateway_resource" "developer_ingestion_metrics" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.developer.id
+  path_part   = "ingestion-metrics"
+}
+
+# /healthz
+resource "aws_api_gateway_resource" "healthz" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "healthz"
+}
+
+# /readyz
+resource "aws_api_gateway_resource" "readyz" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "readyz"
+}
+
+# /version
+resource "aws_api_gateway_resource" "version" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "version"
+}
+
+# /driver/{driver_id}/races/{driver_race_id}/journal/draft
+resource "aws_api_gateway_resource" "driver_race_journal_draft" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race_journal.id
+  path_part   = "draft"
+}
+
+# /driver/{driver_id}/races/{driver_race_id}/journal/draft/publish
+resource "aws_api_gateway_resource" "driver_race_journal_draft_publish" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race_journal_draft.id
+  path_part   = "publish"
+}
+
+# /driver/{driver_id}/races/{driver_race_id}/journal/attachments
+resource "aws_api_gateway_resource" "driver_race_journal_attachments" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race_journal.id
+  path_part   = "attachments"
+}
+
+# /driver/{driver_id}/action-items
+resource "aws_api_gateway_resource" "driver_action_items" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "action-items"
+}
+
+# /driver/{driver_id}/action-items/{action_item_id}
+resource "aws_api_gateway_resource" "driver_action_item" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_action_items.id
+  path_part   = "{action_item_id}"
+}
+
+# /coaching
+resource "aws_api_gateway_resource" "coaching" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "coaching"
+}
+
+# /coaching/practice-plan
+resource "aws_api_gateway_resource" "coaching_practice_plan" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.coaching.id
+  path_part   = "practice-plan"
+}
+
+# /driver/{driver_id}/onboarding
+resource "aws_api_gateway_resource" "driver_onboarding" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "onboarding"
+}
+
+# /developer/coverage
+resource "aws_api_gateway_resource" "developer_coverage" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.developer.id
+  path_part   = "coverage"
+}
+
+# /driver/{driver_id}/ingestion
+resource "aws_api_gateway_resource" "driver_ingestion" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "ingestion"
+}
+
+# /driver/{driver_id}/ingestion/cancel
+resource "aws_api_gateway_resource" "driver_ingestion_cancel" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_ingestion.id
+  path_part   = "cancel"
+}
+
+# /developer/ingestion-tiers
+resource "aws_api_gateway_resource" "developer_ingestion_tiers" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.developer.id
+  path_part   = "ingestion-tiers"
+}
+
+# /developer/ingestion-tiers/{tier_name}
+resource "aws_api_gateway_resource" "developer_ingestion_tier" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.developer_ingestion_tiers.id
+  path_part   = "{tier_name}"
+}
+
+# /developer/login-attempts
+resource "aws_api_gateway_resource" "developer_login_attempts" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.developer.id
+  path_part   = "login-attempts"
+}
+
+# /developer/waitlist
+resource "aws_api_gateway_resource" "developer_waitlist" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.developer.id
+  path_part   = "waitlist"
+}
+
+# /developer/invitations
+resource "aws_api_gateway_resource" "developer_invitations" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.developer.id
+  path_part   = "invitations"
+}
+
+# /developer/requests
+resource "aws_api_gateway_resource" "developer_requests" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.developer.id
+  path_part   = "requests"
+}
+
+# /developer/requests/capture
+resource "aws_api_gateway_resource" "developer_requests_capture" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.developer_requests.id
+  path_part   = "capture"
+}
+
+# /supporter
+resource "aws_api_gateway_resource" "supporter" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "supporter"
+}
+
+# /supporter/status
+resource "aws_api_gateway_resource" "supporter_status" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.supporter.id
+  path_part   = "status"
+}
+
+# /supporter/webhook
+resource "aws_api_gateway_resource" "supporter_webhook" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.supporter.id
+  path_part   = "webhook"
+}
+
+# /supporter/webhook/stripe
+resource "aws_api_gateway_resource" "supporter_webhook_stripe" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.supporter_webhook.id
+  path_part   = "stripe"
+}
+
+# /driver/{driver_id}/quota
+resource "aws_api_gateway_resource" "driver_quota" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "quota"
+}
+
+# /driver/{driver_id}/export
+resource "aws_api_gateway_resource" "driver_export" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "export"
+}
+
+# /drivers
+resource "aws_api_gateway_resource" "drivers" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "drivers"
+}
+
+# /drivers/search
+resource "aws_api_gateway_resource" "drivers_search" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.drivers.id
+  path_part   = "search"
+}
+
+# /leaderboards
+resource "aws_api_gateway_resource" "leaderboards" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "leaderboards"
+}
+
+# /leaderboards/weekly
+resource "aws_api_gateway_resource" "leaderboards_weekly" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.leaderboards.id
+  path_part   = "weekly"
+}
+
+# /driver/{driver_id}/analytics/region
+resource "aws_api_gateway_resource" "driver_analytics_region" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_analytics.id
+  path_part   = "region"
+}
+
+# /driver/{driver_id}/analytics/categories
+resource "aws_api_gateway_resource" "driver_analytics_categories" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_analytics.id
+  path_part   = "categories"
+}
+
+# /schedule
+resource "aws_api_gateway_resource" "schedule" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "schedule"
+}
+
+# /schedule/recommended
+resource "aws_api_gateway_resource" "schedule_recommended" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.schedule.id
+  path_part   = "recommended"
+}
+
+# /driver/{driver_id}/analytics/time-of-day
+resource "aws_api_gateway_resource" "driver_analytics_time_of_day" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_analytics.id
+  path_part   = "time-of-day"
+}
+
+# /coaching/weekly-recap
+resource "aws_api_gateway_resource" "coaching_weekly_recap" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.coaching.id
+  path_part   = "weekly-recap"
+}
+
+# /coaching/recaps
+resource "aws_api_gateway_resource" "coaching_recaps" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.coaching.id
+  path_part   = "recaps"
+}
+
+# /coaching/recaps/{recap_id}
+resource "aws_api_gateway_resource" "coaching_recap_id" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.coaching_recaps.id
+  path_part   = "{recap_id}"
+}
+
+# /coaching/recaps/{recap_id}/pdf
+resource "aws_api_gateway_resource" "coaching_recap_pdf" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.coaching_recap_id.id
+  path_part   = "pdf"
+}
+
+# /dashboard
+resource "aws_api_gateway_resource" "dashboard" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "dashboard"
+}
+
+# /dashboard/layout
+resource "aws_api_gateway_resource" "dashboard_layout" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.dashboard.id
+  path_part   = "layout"
+}
+
+# /mobile
+resource "aws_api_gateway_resource" "mobile" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "mobile"
+}
+
+# /mobile/v1
+resource "aws_api_gateway_resource" "mobile_v1" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.mobile.id
+  path_part   = "v1"
+}
+
+# /mobile/v1/summary
+resource "aws_api_gateway_resource" "mobile_v1_summary" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.mobile_v1.id
+  path_part   = "summary"
+}
+
+# /season-card
+resource "aws_api_gateway_resource" "season_card" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "season-card"
+}
+
+# /benchmarks
+resource "aws_api_gateway_resource" "benchmarks" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "benchmarks"
+}
+
+# /driver/{driver_id}/races/{driver_race_id}/bookmarks
+resource "aws_api_gateway_resource" "driver_race_bookmarks" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race.id
+  path_part   = "bookmarks"
+}
+
+# /driver/{driver_id}/races/{driver_race_id}/bookmarks/{bookmark_id}
+resource "aws_api_gateway_resource" "driver_race_bookmark" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race_bookmarks.id
+  path_part   = "{bookmark_id}"
+}
+
+# /driver/{driver_id}/races/{driver_race_id}/video-links
+resource "aws_api_gateway_resource" "driver_race_video_links" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race.id
+  path_part   = "video-links"
+}
+
+# /driver/{driver_id}/races/{driver_race_id}/video-links/{video_link_id}
+resource "aws_api_gateway_resource" "driver_race_video_link" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race_video_links.id
+  path_part   = "{video_link_id}"
+}
+
+# /driver/{driver_id}/races/{driver_race_id}/telemetry
+resource "aws_api_gateway_resource" "driver_race_telemetry" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race.id
+  path_part   = "telemetry"
+}
+
+# /driver/{driver_id}/external-laps
+resource "aws_api_gateway_resource" "driver_external_laps" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "external-laps"
+}
+
+# /driver/{driver_id}/external-laps/{source}
+resource "aws_api_gateway_resource" "driver_external_laps_source" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_external_laps.id
+  path_part   = "{source}"
+}
+
+# /public
+resource "aws_api_gateway_resource" "public" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "public"
+}
+
+# /public/leaderboards
+resource "aws_api_gateway_resource" "public_leaderboards" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.public.id
+  path_part   = "leaderboards"
+}
+
+# /public/leaderboards/weekly
+resource "aws_api_gateway_resource" "public_leaderboards_weekly" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.public_leaderboards.id
+  path_part   = "weekly"
+}
+
+# /public/benchmarks
+resource "aws_api_gateway_resource" "public_benchmarks" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.public.id
+  path_part   = "benchmarks"
+}
+
+# /public/season-cards
+resource "aws_api_gateway_resource" "public_season_cards" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.public.id
+  path_part   = "season-cards"
+}
+
+# /public/season-cards/keys
+resource "aws_api_gateway_resource" "public_season_cards_keys" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.public_season_cards.id
+  path_part   = "keys"
+}
+
+# /public/season-cards/{token}
+resource "aws_api_gateway_resource" "public_season_card" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.public_season_cards.id
+  path_part   = "{token}"
+}
+
+# /driver/{driver_id}/presence
+resource "aws_api_gateway_resource" "driver_presence" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "presence"
+}
+
+# /driver/{driver_id}/presence/viewers
+resource "aws_api_gateway_resource" "driver_presence_viewers" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_presence.id
+  path_part   = "viewers"
+}
+
+# /driver/{driver_id}/presence/viewers/{viewer_id}
+resource "aws_api_gateway_resource" "driver_presence_viewer_id" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_presence_viewers.id
+  path_part   = "{viewer_id}"
+}
+
+# /driver/{driver_id}/racing-now
+resource "aws_api_gateway_resource" "driver_racing_now" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "racing-now"
+}
+
+# /driver/{driver_id}/journal/prompts
+resource "aws_api_gateway_resource" "driver_journal_prompts" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_journal.id
+  path_part   = "prompts"
+}
+
+# /driver/{driver_id}/journal/prompts/{driver_race_id}
+resource "aws_api_gateway_resource" "driver_journal_prompt_race_id" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_journal_prompts.id
+  path_part   = "{driver_race_id}"
+}
+
+# /driver/{driver_id}/journal-stats
+resource "aws_api_gateway_resource" "driver_journal_stats" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "journal-stats"
+}
+
+# /driver/{driver_id}/alert-rules
+resource "aws_api_gateway_resource" "driver_alert_rules" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "alert-rules"
+}
+
+# /driver/{driver_id}/alert-rules/{alert_rule_id}
+resource "aws_api_gateway_resource" "driver_alert_rule" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_alert_rules.id
+  path_part   = "{alert_rule_id}"
+}
+
+# /developer/iracing-proxy
+resource "aws_api_gateway_resource" "developer_iracing_proxy" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.developer.id
+  path_part   = "iracing-proxy"
+}
+
+# /developer/iracing-proxy/history
+resource "aws_api_gateway_resource" "developer_iracing_proxy_history" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.developer_iracing_proxy.id
+  path_part   = "history"
+}
+
+# /squads
+resource "aws_api_gateway_resource" "squads" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "squads"
+}
+
+# /squads/{squad_id}
+resource "aws_api_gateway_resource" "squad" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.squads.id
+  path_part   = "{squad_id}"
+}
+
+# /squads/{squad_id}/members
+resource "aws_api_gateway_resource" "squad_members" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.squad.id
+  path_part   = "members"
+}
+
+# /squads/{squad_id}/members/{driver_id}
+resource "aws_api_gateway_resource" "squad_member" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.squad_members.id
+  path_part   = "{driver_id}"
+}
+
+# /squads/{squad_id}/accept
+resource "aws_api_gateway_resource" "squad_accept" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.squad.id
+  path_part   = "accept"
+}
+
+# /squads/{squad_id}/analytics
+resource "aws_api_gateway_resource" "squad_analytics" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.squad.id
+  path_part   = "analytics"
+}
+
+# /squads/{squad_id}/events
+resource "aws_api_gateway_resource" "squad_events" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.squad.id
+  path_part   = "events"
+}
+
+# /squads/{squad_id}/events/{subsession_id}
+resource "aws_api_gateway_resource" "squad_event" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.squad_events.id
+  path_part   = "{subsession_id}"
+}
+
+# /driver/{driver_id}/races/{driver_race_id}/official
+resource "aws_api_gateway_resource" "driver_race_official" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race.id
+  path_part   = "official"
+}
+
+# /overlay
+resource "aws_api_gateway_resource" "overlay" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
+  path_part   = "overlay"
+}
+
+# /public/overlays
+resource "aws_api_gateway_resource" "public_overlays" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.public.id
+  path_part   = "overlays"
+}
+
+# /public/overlays/{token}
+resource "aws_api_gateway_resource" "public_overlay" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.public_overlays.id
+  path_part   = "{token}"
+}
+
+# /driver/{driver_id}/race-plans
+resource "aws_api_gateway_resource" "driver_race_plans" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_id.id
+  path_part   = "race-plans"
+}
+
+# /driver/{driver_id}/race-plans/{race_week}
+resource "aws_api_gateway_resource" "driver_race_plans_week" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race_plans.id
+  path_part   = "{race_week}"
+}
+
+# /driver/{driver_id}/race-plans/{race_week}/{series_id}
+resource "aws_api_gateway_resource" "driver_race_plans_series" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race_plans_week.id
+  path_part   = "{series_id}"
+}
+
+# /driver/{driver_id}/race-plans/{race_week}/{series_id}/{track_id}
+resource "aws_api_gateway_resource" "driver_race_plan" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race_plans_series.id
+  path_part   = "{track_id}"
+}
+
+# /driver/{driver_id}/races/{driver_race_id}/plan
+resource "aws_api_gateway_resource" "driver_race_race_plan" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_race.id
+  path_part   = "plan"
+}
+
+# /driver/{driver_id}/analytics/sr-recovery
+resource "aws_api_gateway_resource" "driver_analytics_sr_recovery" {
+  rest_api_id = aws_api_gateway_rest_api.api.id
+  parent_id   = aws_api_gateway_resource.driver_analytics.id
+  path_part   = "sr-recovery"
+}
+
+# API Gateway Endpoints
+# =====================
+
+module "health_ping_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.health_ping.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "health_ping_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.health_ping.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_ir_callback_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_ir_callback.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_ir_callback_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_ir_callback.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_refresh_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_refresh.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_refresh_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_refresh.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_sessions_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_sessions.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_sessions_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_sessions.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_sessions_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_sessions.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_session_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_session.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_session_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_session.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_terms_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_terms.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_terms_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_terms.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "auth_terms_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.auth_terms.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "ingestion_race_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.ingestion_race.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "ingestion_race_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.ingestion_race.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_iracing_api_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_iracing_api.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_iracing_api_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_iracing_api.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_iracing_api_proxy_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_iracing_api_proxy.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_iracing_api_proxy_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_iracing_api_proxy.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_iracing_token_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_iracing_token.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_iracing_token_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_iracing_token.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_id.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_id.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_races_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_races.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_races_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_races.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_races_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_races.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_put" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal.id
+  http_method       = "PUT"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_journal_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_journal.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_journal_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_journal.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_dimensions_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_dimensions.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_dimensions_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_dimensions.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_prediction_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_prediction.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_prediction_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_prediction.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "tracks_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.tracks.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "tracks_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.tracks.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "cars_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.cars.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "cars_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.cars.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "series_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.series.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "series_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.series.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "session_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.session_id.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "session_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.session_id.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "session_driver_laps_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.session_simsession_driver_laps.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "session_driver_laps_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.session_simsession_driver_laps.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "session_pace_comparison_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.session_pace_comparison.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "session_pace_comparison_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.session_pace_comparison.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "session_laps_ingest_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.session_laps_ingest.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "session_laps_ingest_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.session_laps_ingest.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_championship_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_championship.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_championship_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_championship.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_standings_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_standings.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_standings_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_standings.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_settings_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_settings.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_settings_put" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_settings.id
+  http_method       = "PUT"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_settings_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_settings.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_ingestion_metrics_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_ingestion_metrics.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_ingestion_metrics_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_ingestion_metrics.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "healthz_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.healthz.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "healthz_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.healthz.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "readyz_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.readyz.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "readyz_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.readyz.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "version_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.version.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "version_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.version.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_draft_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal_draft.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_draft_patch" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal_draft.id
+  http_method       = "PATCH"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_draft_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal_draft.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_draft_publish_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal_draft_publish.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_draft_publish_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal_draft_publish.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_attachments_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal_attachments.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_attachments_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal_attachments.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_journal_attachments_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_journal_attachments.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_action_items_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_action_items.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_action_items_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_action_items.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_action_items_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_action_items.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_action_item_patch" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_action_item.id
+  http_method       = "PATCH"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_action_item_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_action_item.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_action_item_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_action_item.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "coaching_practice_plan_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.coaching_practice_plan.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "coaching_practice_plan_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.coaching_practice_plan.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_onboarding_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_onboarding.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_onboarding_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_onboarding.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_coverage_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_coverage.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_coverage_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_coverage.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_ingestion_cancel_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_ingestion_cancel.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_ingestion_cancel_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_ingestion_cancel.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_ingestion_tiers_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_ingestion_tiers.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_ingestion_tiers_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_ingestion_tiers.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_ingestion_tier_put" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_ingestion_tier.id
+  http_method       = "PUT"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_ingestion_tier_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_ingestion_tier.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_login_attempts_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_login_attempts.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_login_attempts_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_login_attempts.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_waitlist_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_waitlist.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_waitlist_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_waitlist.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_invitations_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_invitations.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_invitations_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_invitations.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_requests_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_requests.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_requests_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_requests.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_requests_capture_put" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_requests_capture.id
+  http_method       = "PUT"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_requests_capture_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_requests_capture.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "developer_requests_capture_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.developer_requests_capture.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "supporter_status_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.supporter_status.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "supporter_status_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.supporter_status.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "supporter_webhook_stripe_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.supporter_webhook_stripe.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "supporter_webhook_stripe_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.supporter_webhook_stripe.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_quota_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_quota.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_quota_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_quota.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_export_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_export.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_export_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_export.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "drivers_search_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.drivers_search.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "drivers_search_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.drivers_search.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "leaderboards_weekly_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.leaderboards_weekly.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "leaderboards_weekly_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.leaderboards_weekly.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_region_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_region.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_region_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_region.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_categories_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_categories.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_categories_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_categories.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "schedule_recommended_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.schedule_recommended.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "schedule_recommended_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.schedule_recommended.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_time_of_day_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_time_of_day.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_analytics_time_of_day_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_time_of_day.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "coaching_weekly_recap_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.coaching_weekly_recap.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "coaching_weekly_recap_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.coaching_weekly_recap.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "coaching_recap_pdf_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.coaching_recap_pdf.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "coaching_recap_pdf_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.coaching_recap_pdf.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "dashboard_layout_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.dashboard_layout.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "dashboard_layout_put" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.dashboard_layout.id
+  http_method       = "PUT"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "dashboard_layout_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.dashboard_layout.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "mobile_v1_summary_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.mobile_v1_summary.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "mobile_v1_summary_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.mobile_v1_summary.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "season_card_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.season_card.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "season_card_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.season_card.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "season_card_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.season_card.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "season_card_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.season_card.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "benchmarks_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.benchmarks.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "benchmarks_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.benchmarks.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_bookmarks_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_bookmarks.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_bookmarks_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_bookmarks.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_bookmarks_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_bookmarks.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_bookmark_patch" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_bookmark.id
+  http_method       = "PATCH"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_bookmark_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_bookmark.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_bookmark_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_bookmark.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_video_links_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_video_links.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_video_links_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_video_links.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_video_links_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_video_links.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_video_link_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_video_link.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_video_link_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_video_link.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_telemetry_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_telemetry.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_telemetry_put" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_telemetry.id
+  http_method       = "PUT"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_telemetry_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_telemetry.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_external_laps_source_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_external_laps_source.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_external_laps_source_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_external_laps_source.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "public_leaderboards_weekly_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.public_leaderboards_weekly.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "public_leaderboards_weekly_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.public_leaderboards_weekly.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "public_benchmarks_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.public_benchmarks.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "public_benchmarks_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.public_benchmarks.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "public_season_cards_keys_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.public_season_cards_keys.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "public_season_cards_keys_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.public_season_cards_keys.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "public_season_card_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.public_season_card.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "public_season_card_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.public_season_card.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /driver/{driver_id}/analytics
-resource "aws_api_gateway_resource" "driver_analytics" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_resource.driver_id.id
-  path_part   = "analytics"
+module "session_laps_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.session_laps.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /driver/{driver_id}/analytics/dimensions
-resource "aws_api_gateway_resource" "driver_analytics_dimensions" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_resource.driver_analytics.id
-  path_part   = "dimensions"
+module "session_laps_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.session_laps.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /tracks
-resource "aws_api_gateway_resource" "tracks" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
-  path_part   = "tracks"
+module "driver_presence_viewers_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_presence_viewers.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /cars
-resource "aws_api_gateway_resource" "cars" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
-  path_part   = "cars"
+module "driver_presence_viewers_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_presence_viewers.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /series
-resource "aws_api_gateway_resource" "series" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
-  path_part   = "series"
+module "driver_presence_viewer_put" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_presence_viewer_id.id
+  http_method       = "PUT"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /session
-resource "aws_api_gateway_resource" "session" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_rest_api.api.root_resource_id
-  path_part   = "session"
+module "driver_presence_viewer_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_presence_viewer_id.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /session/{subsession_id}
-resource "aws_api_gateway_resource" "session_id" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_resource.session.id
-  path_part   = "{subsession_id}"
+module "driver_presence_viewer_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_presence_viewer_id.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /session/{subsession_id}/simsession
-resource "aws_api_gateway_resource" "session_simsession" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_resource.session_id.id
-  path_part   = "simsession"
+module "driver_racing_now_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_racing_now.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /session/{subsession_id}/simsession/{simsession}
-resource "aws_api_gateway_resource" "session_simsession_id" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_resource.session_simsession.id
-  path_part   = "{simsession}"
+module "driver_racing_now_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_racing_now.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /session/{subsession_id}/simsession/{simsession}/driver
-resource "aws_api_gateway_resource" "session_simsession_driver" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_resource.session_simsession_id.id
-  path_part   = "driver"
+module "driver_journal_prompts_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_journal_prompts.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /session/{subsession_id}/simsession/{simsession}/driver/{driver_id}
-resource "aws_api_gateway_resource" "session_simsession_driver_id" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_resource.session_simsession_driver.id
-  path_part   = "{driver_id}"
+module "driver_journal_prompts_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_journal_prompts.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# /session/{subsession_id}/simsession/{simsession}/driver/{driver_id}/laps
-resource "aws_api_gateway_resource" "session_simsession_driver_laps" {
-  rest_api_id = aws_api_gateway_rest_api.api.id
-  parent_id   = aws_api_gateway_resource.session_simsession_driver_id.id
-  path_part   = "laps"
+module "driver_journal_prompt_delete" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_journal_prompt_race_id.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-# API Gateway Endpoints
-# =====================
+module "driver_journal_prompt_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_journal_prompt_race_id.id
+  http_method       = "OPTIONS"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
 
-module "health_ping_get" {
+module "driver_journal_stats_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.health_ping.id
+  resource_id       = aws_api_gateway_resource.driver_journal_stats.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "health_ping_options" {
+module "driver_journal_stats_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.health_ping.id
+  resource_id       = aws_api_gateway_resource.driver_journal_stats.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "auth_ir_callback_post" {
+module "driver_alert_rules_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.auth_ir_callback.id
+  resource_id       = aws_api_gateway_resource.driver_alert_rules.id
+  http_method       = "GET"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_alert_rules_post" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_alert_rules.id
   http_method       = "POST"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "auth_ir_callback_options" {
+module "driver_alert_rules_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.auth_ir_callback.id
+  resource_id       = aws_api_gateway_resource.driver_alert_rules.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "auth_refresh_post" {
+module "driver_alert_rule_put" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.auth_refresh.id
-  http_method       = "POST"
+  resource_id       = aws_api_gateway_resource.driver_alert_rule.id
+  http_method       = "PUT"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "auth_refresh_options" {
+module "driver_alert_rule_delete" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.auth_refresh.id
+  resource_id       = aws_api_gateway_resource.driver_alert_rule.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_alert_rule_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_alert_rule.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "ingestion_race_post" {
+module "developer_iracing_proxy_post" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.ingestion_race.id
+  resource_id       = aws_api_gateway_resource.developer_iracing_proxy.id
   http_method       = "POST"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "ingestion_race_options" {
+module "developer_iracing_proxy_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.ingestion_race.id
+  resource_id       = aws_api_gateway_resource.developer_iracing_proxy.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "developer_iracing_api_get" {
+module "developer_iracing_proxy_history_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.developer_iracing_api.id
+  resource_id       = aws_api_gateway_resource.developer_iracing_proxy_history.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "developer_iracing_api_options" {
+module "developer_iracing_proxy_history_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.developer_iracing_api.id
+  resource_id       = aws_api_gateway_resource.developer_iracing_proxy_history.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "developer_iracing_api_proxy_get" {
+module "squads_post" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.developer_iracing_api_proxy.id
+  resource_id       = aws_api_gateway_resource.squads.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "squads_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.squads.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "developer_iracing_api_proxy_options" {
+module "squads_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.developer_iracing_api_proxy.id
+  resource_id       = aws_api_gateway_resource.squads.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "developer_iracing_token_get" {
+module "squad_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.developer_iracing_token.id
+  resource_id       = aws_api_gateway_resource.squad.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "developer_iracing_token_options" {
+module "squad_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.developer_iracing_token.id
+  resource_id       = aws_api_gateway_resource.squad.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_get" {
+module "squad_member_put" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_id.id
-  http_method       = "GET"
+  resource_id       = aws_api_gateway_resource.squad_member.id
+  http_method       = "PUT"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_options" {
+module "squad_member_delete" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_id.id
-  http_method       = "OPTIONS"
+  resource_id       = aws_api_gateway_resource.squad_member.id
+  http_method       = "DELETE"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_races_get" {
+module "squad_member_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_races.id
-  http_method       = "GET"
+  resource_id       = aws_api_gateway_resource.squad_member.id
+  http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_races_delete" {
+module "squad_accept_post" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_races.id
-  http_method       = "DELETE"
+  resource_id       = aws_api_gateway_resource.squad_accept.id
+  http_method       = "POST"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_races_options" {
+module "squad_accept_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_races.id
+  resource_id       = aws_api_gateway_resource.squad_accept.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_race_get" {
+module "squad_analytics_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_race.id
+  resource_id       = aws_api_gateway_resource.squad_analytics.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_race_options" {
+module "squad_analytics_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_race.id
+  resource_id       = aws_api_gateway_resource.squad_analytics.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_race_journal_get" {
+module "squad_events_post" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_race_journal.id
+  resource_id       = aws_api_gateway_resource.squad_events.id
+  http_method       = "POST"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "squad_events_get" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.squad_events.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_race_journal_put" {
+module "squad_events_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_race_journal.id
-  http_method       = "PUT"
+  resource_id       = aws_api_gateway_resource.squad_events.id
+  http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_race_journal_delete" {
+module "squad_event_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_race_journal.id
-  http_method       = "DELETE"
+  resource_id       = aws_api_gateway_resource.squad_event.id
+  http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_race_journal_options" {
+module "squad_event_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_race_journal.id
+  resource_id       = aws_api_gateway_resource.squad_event.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_journal_get" {
+module "driver_race_official_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_journal.id
+  resource_id       = aws_api_gateway_resource.driver_race_official.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_journal_options" {
+module "driver_race_official_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_journal.id
+  resource_id       = aws_api_gateway_resource.driver_race_official.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_analytics_get" {
+module "overlay_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_analytics.id
+  resource_id       = aws_api_gateway_resource.overlay.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_analytics_options" {
+module "overlay_post" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_analytics.id
-  http_method       = "OPTIONS"
+  resource_id       = aws_api_gateway_resource.overlay.id
+  http_method       = "POST"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_analytics_dimensions_get" {
+module "overlay_delete" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_analytics_dimensions.id
-  http_method       = "GET"
+  resource_id       = aws_api_gateway_resource.overlay.id
+  http_method       = "DELETE"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "driver_analytics_dimensions_options" {
+module "overlay_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.driver_analytics_dimensions.id
+  resource_id       = aws_api_gateway_resource.overlay.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "tracks_get" {
+module "public_overlay_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.tracks.id
+  resource_id       = aws_api_gateway_resource.public_overlay.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "tracks_options" {
+module "public_overlay_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.tracks.id
+  resource_id       = aws_api_gateway_resource.public_overlay.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "cars_get" {
+module "driver_race_plans_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.cars.id
+  resource_id       = aws_api_gateway_resource.driver_race_plans.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "cars_options" {
+module "driver_race_plans_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.cars.id
+  resource_id       = aws_api_gateway_resource.driver_race_plans.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "series_get" {
+module "driver_race_plan_put" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.series.id
-  http_method       = "GET"
+  resource_id       = aws_api_gateway_resource.driver_race_plan.id
+  http_method       = "PUT"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "series_options" {
+module "driver_race_plan_delete" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.series.id
+  resource_id       = aws_api_gateway_resource.driver_race_plan.id
+  http_method       = "DELETE"
+  lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
+}
+
+module "driver_race_plan_options" {
+  source            = "./api_endpoint"
+  rest_api_id       = aws_api_gateway_rest_api.api.id
+  resource_id       = aws_api_gateway_resource.driver_race_plan.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "session_get" {
+module "driver_race_race_plan_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.session_id.id
+  resource_id       = aws_api_gateway_resource.driver_race_race_plan.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "session_options" {
+module "driver_race_race_plan_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.session_id.id
+  resource_id       = aws_api_gateway_resource.driver_race_race_plan.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "session_driver_laps_get" {
+module "driver_analytics_sr_recovery_get" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.session_simsession_driver_laps.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_sr_recovery.id
   http_method       = "GET"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
 
-module "session_driver_laps_options" {
+module "driver_analytics_sr_recovery_options" {
   source            = "./api_endpoint"
   rest_api_id       = aws_api_gateway_rest_api.api.id
-  resource_id       = aws_api_gateway_resource.session_simsession_driver_laps.id
+  resource_id       = aws_api_gateway_resource.driver_analytics_sr_recovery.id
   http_method       = "OPTIONS"
   lambda_invoke_arn = aws_lambda_function.api_lambda.invoke_arn
 }
\ No newline at end of file
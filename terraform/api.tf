@@ -3,15 +3,26 @@ locals {
   api_domain_name = "${local.api_host_name}.${data.aws_route53_zone.route53_zone.name}"
 
   app_env_vars = {
-    LOG_LEVEL                  = "info"
-    CORS_ALLOWED_ORIGINS       = "https://${local.frontend_domain_name},http://127.0.0.1:5173"
-    IRACING_CREDENTIALS_SECRET = data.aws_secretsmanager_secret.iracing_credentials.arn
-    JWT_SIGNING_KEY_SECRET     = aws_secretsmanager_secret.jwt_signing_key.arn
-    JWT_ENCRYPTION_KEY_SECRET  = aws_secretsmanager_secret.jwt_encryption_key.arn
-    DYNAMODB_TABLE             = aws_dynamodb_table.application_store.name
-    RACE_INGESTION_QUEUE_URL   = aws_sqs_queue.race_ingestion_requests.url
-    IRACING_CACHE_BUCKET       = aws_s3_bucket.iracing_cache.bucket
-    METRICS_NAMESPACE          = "${local.workspace_prefix}SaturdaysSpinout"
+    LOG_LEVEL                    = "info"
+    CORS_ALLOWED_ORIGINS         = "https://${local.frontend_domain_name},http://127.0.0.1:5173"
+    IRACING_CREDENTIALS_SECRET   = data.aws_secretsmanager_secret.iracing_credentials.arn
+    JWT_SIGNING_KEY_SECRET       = aws_secretsmanager_secret.jwt_signing_key.arn
+    JWT_ENCRYPTION_KEY_SECRET    = aws_secretsmanager_secret.jwt_encryption_key.arn
+    SEASON_CARD_KEY_SECRET       = aws_secretsmanager_secret.season_card_signing_key.arn
+    DYNAMODB_TABLE               = aws_dynamodb_table.application_store.name
+    RACE_INGESTION_QUEUE_URL     = aws_sqs_queue.race_ingestion_requests.url
+    RACE_INGESTION_TOPIC_ARN     = aws_sns_topic.race_ingestion_events.arn
+    EVENT_BACKEND                = "sqs"
+    IRACING_CACHE_BUCKET         = aws_s3_bucket.iracing_cache.bucket
+    METRICS_NAMESPACE            = "${local.workspace_prefix}SaturdaysSpinout"
+    JOURNAL_DRAFT_RETENTION_DAYS = "30"
+    VOICE_MEMO_BUCKET            = aws_s3_bucket.voice_memos.bucket
+    REPORT_BUCKET                = aws_s3_bucket.reports.bucket
+    STRIPE_WEBHOOK_SECRET        = data.aws_secretsmanager_secret.stripe_webhook_secret.arn
+    TENANTS                      = local.tenants
+    FIELD_ENCRYPTION_KEY_ID      = aws_kms_alias.field_encryption.arn
+    WAITLIST_ENABLED             = tostring(var.waitlist_enabled)
+    TERMS_VERSIONS               = jsonencode(var.terms_versions)
   }
 }
 
@@ -51,6 +62,7 @@ data "aws_iam_policy_document" "api_lambda" {
     sid    = "AllowDynamoDB"
     effect = "Allow"
     actions = [
+      "dynamodb:DescribeTable",
       "dynamodb:GetItem",
       "dynamodb:BatchGetItem",
       "dynamodb:PutItem",
@@ -62,9 +74,17 @@ data "aws_iam_policy_document" "api_lambda" {
       "dynamodb:TransactGetItems",
       "dynamodb:BatchWriteItem"
     ]
-    resources = [
-      aws_dynamodb_table.application_store.arn
+    resources = local.application_store_arns
+  }
+
+  statement {
+    sid    = "AllowFieldEncryption"
+    effect = "Allow"
+    actions = [
+      "kms:GenerateDataKey",
+      "kms:Decrypt"
     ]
+    resources = [aws_kms_key.field_encryption.arn]
   }
 
   statement {
@@ -77,6 +97,8 @@ data "aws_iam_policy_document" "api_lambda" {
       data.aws_secretsmanager_secret.iracing_credentials.arn,
       aws_secretsmanager_secret.jwt_signing_key.arn,
       aws_secretsmanager_secret.jwt_encryption_key.arn,
+      aws_secretsmanager_secret.season_card_signing_key.arn,
+      data.aws_secretsmanager_secret.stripe_webhook_secret.arn,
     ]
   }
 
@@ -84,13 +106,26 @@ data "aws_iam_policy_document" "api_lambda" {
     sid    = "AllowSQSSendMessage"
     effect = "Allow"
     actions = [
-      "sqs:SendMessage"
+      "sqs:SendMessage",
+      "sqs:GetQueueAttributes"
     ]
     resources = [
       aws_sqs_queue.race_ingestion_requests.arn
     ]
   }
 
+  statement {
+    sid    = "AllowSNSPublish"
+    effect = "Allow"
+    actions = [
+      "sns:Publish",
+      "sns:GetTopicAttributes"
+    ]
+    resources = [
+      aws_sns_topic.race_ingestion_events.arn
+    ]
+  }
+
   statement {
     sid    = "AllowCloudWatchMetrics"
     effect = "Allow"
@@ -113,6 +148,35 @@ data "aws_iam_policy_document" "api_lambda" {
       "${aws_s3_bucket.iracing_cache.arn}/*"
     ]
   }
+
+  // presigned memo URLs carry the API's permissions
+  statement {
+    sid    = "AllowVoiceMemoS3"
+    effect = "Allow"
+    actions = [
+      "s3:GetObject",
+      "s3:PutObject"
+    ]
+    resources = [
+      "${aws_s3_bucket.voice_memos.arn}/*"
+    ]
+  }
+
+  // listing lets a missing report come back as a 404 rather than a 403, and presigned report URLs carry the API's
+  // permissions
+  statement {
+    sid    = "AllowReportS3"
+    effect = "Allow"
+    actions = [
+      "s3:GetObject",
+      "s3:PutObject",
+      "s3:ListBucket"
+    ]
+    resources = [
+      aws_s3_bucket.reports.arn,
+      "${aws_s3_bucket.reports.arn}/*"
+    ]
+  }
 }
 
 resource "aws_iam_role_policy" "api_lambda" {
@@ -199,10 +263,24 @@ resource "aws_api_gateway_deployment" "api" {
   depends_on = [
     module.health_ping_get,
     module.health_ping_options,
+    module.healthz_get,
+    module.healthz_options,
+    module.readyz_get,
+    module.readyz_options,
+    module.version_get,
+    module.version_options,
     module.auth_ir_callback_post,
     module.auth_ir_callback_options,
     module.auth_refresh_post,
     module.auth_refresh_options,
+    module.auth_sessions_get,
+    module.auth_sessions_delete,
+    module.auth_sessions_options,
+    module.auth_session_delete,
+    module.auth_session_options,
+    module.auth_terms_get,
+    module.auth_terms_post,
+    module.auth_terms_options,
     module.ingestion_race_post,
     module.ingestion_race_options,
     module.developer_iracing_api_get,
@@ -211,6 +289,8 @@ resource "aws_api_gateway_deployment" "api" {
     module.developer_iracing_api_proxy_options,
     module.developer_iracing_token_get,
     module.developer_iracing_token_options,
+    module.developer_ingestion_metrics_get,
+    module.developer_ingestion_metrics_options,
     module.driver_get,
     module.driver_options,
     module.driver_races_get,
@@ -222,6 +302,55 @@ resource "aws_api_gateway_deployment" "api" {
     module.driver_race_journal_put,
     module.driver_race_journal_delete,
     module.driver_race_journal_options,
+    module.driver_race_journal_draft_get,
+    module.driver_race_journal_draft_patch,
+    module.driver_race_journal_draft_options,
+    module.driver_race_journal_draft_publish_post,
+    module.driver_race_journal_draft_publish_options,
+    module.driver_race_journal_attachments_get,
+    module.driver_race_journal_attachments_post,
+    module.driver_race_journal_attachments_options,
+    module.driver_action_items_get,
+    module.driver_action_items_post,
+    module.driver_action_items_options,
+    module.driver_action_item_patch,
+    module.driver_action_item_delete,
+    module.driver_action_item_options,
+    module.coaching_practice_plan_get,
+    module.coaching_practice_plan_options,
+    module.driver_onboarding_get,
+    module.driver_onboarding_options,
+    module.developer_coverage_get,
+    module.developer_coverage_options,
+    module.developer_ingestion_tiers_get,
+    module.developer_ingestion_tiers_options,
+    module.developer_ingestion_tier_put,
+    module.developer_ingestion_tier_options,
+    module.developer_login_attempts_delete,
+    module.developer_login_attempts_options,
+    module.developer_waitlist_get,
+    module.developer_waitlist_options,
+    module.developer_invitations_post,
+    module.developer_invitations_options,
+    module.developer_requests_get,
+    module.developer_requests_options,
+    module.developer_requests_capture_put,
+    module.developer_requests_capture_delete,
+    module.developer_requests_capture_options,
+    module.developer_iracing_proxy_post,
+    module.developer_iracing_proxy_options,
+    module.developer_iracing_proxy_history_get,
+    module.developer_iracing_proxy_history_options,
+    module.driver_ingestion_cancel_post,
+    module.driver_ingestion_cancel_options,
+    module.driver_quota_get,
+    module.driver_quota_options,
+    module.driver_export_get,
+    module.driver_export_options,
+    module.supporter_status_get,
+    module.supporter_status_options,
+    module.supporter_webhook_stripe_post,
+    module.supporter_webhook_stripe_options,
     module.driver_journal_get,
     module.driver_journal_options,
     module.driver_analytics_get,
@@ -238,6 +367,112 @@ resource "aws_api_gateway_deployment" "api" {
     module.session_options,
     module.session_driver_laps_get,
     module.session_driver_laps_options,
+    module.drivers_search_get,
+    module.drivers_search_options,
+    module.leaderboards_weekly_get,
+    module.leaderboards_weekly_options,
+    module.driver_analytics_region_get,
+    module.driver_analytics_region_options,
+    module.driver_analytics_categories_get,
+    module.driver_analytics_categories_options,
+    module.schedule_recommended_get,
+    module.schedule_recommended_options,
+    module.driver_analytics_time_of_day_get,
+    module.driver_analytics_time_of_day_options,
+    module.coaching_weekly_recap_get,
+    module.coaching_weekly_recap_options,
+    module.coaching_recap_pdf_get,
+    module.coaching_recap_pdf_options,
+    module.dashboard_layout_get,
+    module.dashboard_layout_put,
+    module.dashboard_layout_options,
+    module.mobile_v1_summary_get,
+    module.mobile_v1_summary_options,
+    module.season_card_get,
+    module.season_card_post,
+    module.season_card_delete,
+    module.season_card_options,
+    module.benchmarks_get,
+    module.benchmarks_options,
+    module.driver_race_bookmarks_get,
+    module.driver_race_bookmarks_post,
+    module.driver_race_bookmarks_options,
+    module.driver_race_bookmark_patch,
+    module.driver_race_bookmark_delete,
+    module.driver_race_bookmark_options,
+    module.driver_race_video_links_get,
+    module.driver_race_video_links_post,
+    module.driver_race_video_links_options,
+    module.driver_race_video_link_delete,
+    module.driver_race_video_link_options,
+    module.driver_race_telemetry_get,
+    module.driver_race_telemetry_put,
+    module.driver_race_telemetry_options,
+    module.driver_external_laps_source_post,
+    module.driver_external_laps_source_options,
+    module.public_leaderboards_weekly_get,
+    module.public_leaderboards_weekly_options,
+    module.public_benchmarks_get,
+    module.public_benchmarks_options,
+    module.public_season_cards_keys_get,
+    module.public_season_cards_keys_options,
+    module.public_season_card_get,
+    module.public_season_card_options,
+    module.session_laps_get,
+    module.session_laps_options,
+    module.driver_presence_viewers_get,
+    module.driver_presence_viewers_options,
+    module.driver_presence_viewer_put,
+    module.driver_presence_viewer_delete,
+    module.driver_presence_viewer_options,
+    module.driver_racing_now_get,
+    module.driver_racing_now_options,
+    module.driver_journal_prompts_get,
+    module.driver_journal_prompts_options,
+    module.driver_journal_prompt_delete,
+    module.driver_journal_prompt_options,
+    module.driver_journal_stats_get,
+    module.driver_journal_stats_options,
+    module.driver_alert_rules_get,
+    module.driver_alert_rules_post,
+    module.driver_alert_rules_options,
+    module.driver_alert_rule_put,
+    module.driver_alert_rule_delete,
+    module.driver_alert_rule_options,
+    module.squads_post,
+    module.squads_get,
+    module.squads_options,
+    module.squad_get,
+    module.squad_options,
+    module.squad_member_put,
+    module.squad_member_delete,
+    module.squad_member_options,
+    module.squad_accept_post,
+    module.squad_accept_options,
+    module.squad_analytics_get,
+    module.squad_analytics_options,
+    module.squad_events_post,
+    module.squad_events_get,
+    module.squad_events_options,
+    module.squad_event_get,
+    module.squad_event_options,
+    module.driver_race_official_get,
+    module.driver_race_official_options,
+    module.overlay_get,
+    module.overlay_post,
+    module.overlay_delete,
+    module.overlay_options,
+    module.public_overlay_get,
+    module.public_overlay_options,
+    module.driver_race_plans_get,
+    module.driver_race_plans_options,
+    module.driver_race_plan_put,
+    module.driver_race_plan_delete,
+    module.driver_race_plan_options,
+    module.driver_race_race_plan_get,
+    module.driver_race_race_plan_options,
+    module.driver_analytics_sr_recovery_get,
+    module.driver_analytics_sr_recovery_options,
   ]
   rest_api_id = aws_api_gateway_rest_api.api.id
 
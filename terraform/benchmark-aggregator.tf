@@ -0,0 +1,100 @@
+resource "aws_iam_role" "benchmark_aggregator_lambda" {
+  name               = "${local.workspace_prefix}SaturdaysSpinoutBenchmarkAggregator"
+  assume_role_policy = data.aws_iam_policy_document.assume_lambda_role_policy.json
+}
+
+data "aws_iam_policy_document" "benchmark_aggregator_lambda" {
+  statement {
+    sid    = "AllowLogging"
+    effect = "Allow"
+    actions = [
+      "logs:CreateLogStream",
+      "logs:PutLogEvents"
+    ]
+    resources = [
+      "${aws_cloudwatch_log_group.benchmark_aggregator_lambda_logs.arn}:*"
+    ]
+  }
+
+  statement {
+    sid    = "AllowXRayWrite"
+    effect = "Allow"
+    actions = [
+      "xray:PutTraceSegments",
+      "xray:PutTelemetryRecords",
+      "xray:GetSamplingRules",
+      "xray:GetSamplingTargets",
+      "xray:GetSamplingStatisticSummaries"
+    ]
+    resources = ["*"]
+  }
+
+  statement {
+    sid    = "AllowDynamoDB"
+    effect = "Allow"
+    actions = [
+      "dynamodb:DescribeTable",
+      "dynamodb:Scan",
+      "dynamodb:Query",
+      "dynamodb:GetItem",
+      "dynamodb:PutItem"
+    ]
+    resources = local.application_store_arns
+  }
+}
+
+resource "aws_iam_role_policy" "benchmark_aggregator_lambda" {
+  role   = aws_iam_role.benchmark_aggregator_lambda.name
+  policy = data.aws_iam_policy_document.benchmark_aggregator_lambda.json
+}
+
+resource "aws_lambda_function" "benchmark_aggregator_lambda" {
+  filename         = "../dist/benchmarkAggregatorLambda.zip"
+  source_code_hash = filebase64sha256("../dist/benchmarkAggregatorLambda.zip")
+  timeout          = 900
+
+  reserved_concurrent_executions = 1 // runs never overlap
+  memory_size                    = 256
+
+  runtime       = "provided.al2"
+  handler       = "bootstrap"
+  architectures = ["arm64"]
+  function_name = "${local.workspace_prefix}SaturdaysSpinoutBenchmarkAggregator"
+  role          = aws_iam_role.benchmark_aggregator_lambda.arn
+
+  tracing_config {
+    mode = "Active"
+  }
+
+  environment {
+    variables = {
+      LOG_LEVEL      = "info"
+      DYNAMODB_TABLE = aws_dynamodb_table.application_store.name
+      TENANTS        = local.tenants
+    }
+  }
+}
+
+resource "aws_cloudwatch_log_group" "benchmark_aggregator_lambda_logs" {
+  name              = "/aws/lambda/${local.workspace_prefix}SaturdaysSpinoutBenchmarkAggregator"
+  retention_in_days = 7
+}
+
+resource "aws_cloudwatch_event_rule" "benchmark_aggregator_schedule" {
+  name                = "${local.workspace_prefix}SaturdaysSpinoutBenchmarkAggregator"
+  description         = "Rebuilds the anonymized benchmark percentile tables from opted in drivers"
+  schedule_expression = "rate(1 day)" # a day of new races barely moves a 90 day distribution
+}
+
+resource "aws_cloudwatch_event_target" "benchmark_aggregator_schedule" {
+  rule = aws_cloudwatch_event_rule.benchmark_aggregator_schedule.name
+  arn  = aws_lambda_function.benchmark_aggregator_lambda.arn
+}
+
+resource "aws_lambda_permission" "benchmark_aggregator_schedule" {
+  statement_id  = "AllowExecutionFromEventBridge"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.benchmark_aggregator_lambda.function_name
+  principal     = "events.amazonaws.com"
+  source_arn    = aws_cloudwatch_event_rule.benchmark_aggregator_schedule.arn
+}
@@ -0,0 +1,25 @@
+resource "aws_s3_bucket" "ingestion_captures" {
+  bucket = "${local.workspace_prefix}ingestion-captures-${data.aws_caller_identity.current.account_id}"
+}
+
+resource "aws_s3_bucket_public_access_block" "ingestion_captures" {
+  bucket = aws_s3_bucket.ingestion_captures.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "ingestion_captures" {
+  bucket = aws_s3_bucket.ingestion_captures.id
+
+  rule {
+    id     = "expire-old-captures"
+    status = "Enabled"
+
+    expiration {
+      days = 14
+    }
+  }
+}
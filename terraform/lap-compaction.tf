@@ -0,0 +1,110 @@
+resource "aws_iam_role" "lap_compaction_lambda" {
+  name               = "${local.workspace_prefix}SaturdaysSpinoutLapCompaction"
+  assume_role_policy = data.aws_iam_policy_document.assume_lambda_role_policy.json
+}
+
+data "aws_iam_policy_document" "lap_compaction_lambda" {
+  statement {
+    sid    = "AllowLogging"
+    effect = "Allow"
+    actions = [
+      "logs:CreateLogStream",
+      "logs:PutLogEvents"
+    ]
+    resources = [
+      "${aws_cloudwatch_log_group.lap_compaction_lambda_logs.arn}:*"
+    ]
+  }
+
+  statement {
+    sid    = "AllowXRayWrite"
+    effect = "Allow"
+    actions = [
+      "xray:PutTraceSegments",
+      "xray:PutTelemetryRecords",
+      "xray:GetSamplingRules",
+      "xray:GetSamplingTargets",
+      "xray:GetSamplingStatisticSummaries"
+    ]
+    resources = ["*"]
+  }
+
+  statement {
+    sid    = "AllowDynamoDB"
+    effect = "Allow"
+    actions = [
+      "dynamodb:DescribeTable",
+      "dynamodb:Scan",
+      "dynamodb:Query",
+      "dynamodb:PutItem",
+      "dynamodb:BatchWriteItem"
+    ]
+    resources = local.application_store_arns
+  }
+
+  statement {
+    sid    = "AllowCloudWatchMetrics"
+    effect = "Allow"
+    actions = [
+      "cloudwatch:PutMetricData"
+    ]
+    resources = ["*"]
+  }
+}
+
+resource "aws_iam_role_policy" "lap_compaction_lambda" {
+  role   = aws_iam_role.lap_compaction_lambda.name
+  policy = data.aws_iam_policy_document.lap_compaction_lambda.json
+}
+
+resource "aws_lambda_function" "lap_compaction_lambda" {
+  filename         = "../dist/lapCompactorLambda.zip"
+  source_code_hash = filebase64sha256("../dist/lapCompactorLambda.zip")
+  timeout          = 900
+
+  reserved_concurrent_executions = 1 // runs never overlap
+  memory_size                    = 256
+
+  runtime       = "provided.al2"
+  handler       = "bootstrap"
+  architectures = ["arm64"]
+  function_name = "${local.workspace_prefix}SaturdaysSpinoutLapCompaction"
+  role          = aws_iam_role.lap_compaction_lambda.arn
+
+  tracing_config {
+    mode = "Active"
+  }
+
+  environment {
+    variables = {
+      LOG_LEVEL         = "info"
+      DYNAMODB_TABLE    = aws_dynamodb_table.application_store.name
+      METRICS_NAMESPACE = "${local.workspace_prefix}SaturdaysSpinout"
+      TENANTS           = local.tenants
+    }
+  }
+}
+
+resource "aws_cloudwatch_log_group" "lap_compaction_lambda_logs" {
+  name              = "/aws/lambda/${local.workspace_prefix}SaturdaysSpinoutLapCompaction"
+  retention_in_days = 7
+}
+
+resource "aws_cloudwatch_event_rule" "lap_compaction_schedule" {
+  name                = "${local.workspace_prefix}SaturdaysSpinoutLapCompaction"
+  description         = "Compacts lap data past each driver's retention period"
+  schedule_expression = "cron(0 8 * * ? *)" # daily, off peak for US racers
+}
+
+resource "aws_cloudwatch_event_target" "lap_compaction_schedule" {
+  rule = aws_cloudwatch_event_rule.lap_compaction_schedule.name
+  arn  = aws_lambda_function.lap_compaction_lambda.arn
+}
+
+resource "aws_lambda_permission" "lap_compaction_schedule" {
+  statement_id  = "AllowExecutionFromEventBridge"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.lap_compaction_lambda.function_name
+  principal     = "events.amazonaws.com"
+  source_arn    = aws_cloudwatch_event_rule.lap_compaction_schedule.arn
+}
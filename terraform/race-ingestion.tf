@@ -8,6 +8,18 @@ resource "aws_sqs_queue" "race_ingestion_requests" {
   sqs_managed_sse_enabled = true
 }
 
+# Rounds nobody is waiting on, such as filling in older history, queue here so they never hold up a driver who just
+# logged in. They get their own slice of the processor's concurrency.
+resource "aws_sqs_queue" "race_ingestion_background_requests" {
+  name = "${local.workspace_prefix}SaturdaysSpinoutRaceIngestionBackgroundRequests"
+
+  visibility_timeout_seconds = 300
+  message_retention_seconds  = 900 # 15 minutes - best effort, tokens are short-lived
+  receive_wait_time_seconds  = 20  # Long polling
+
+  sqs_managed_sse_enabled = true
+}
+
 resource "aws_sqs_queue" "race_ingestion_requests_dlq" {
   name = "${local.workspace_prefix}SaturdaysSpinoutRaceIngestionRequestsDLQ"
 
@@ -25,6 +37,85 @@ resource "aws_sqs_queue_redrive_policy" "race_ingestion_requests" {
   })
 }
 
+resource "aws_sqs_queue_redrive_policy" "race_ingestion_background_requests" {
+  queue_url = aws_sqs_queue.race_ingestion_background_requests.id
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.race_ingestion_requests_dlq.arn
+    maxReceiveCount     = 3
+  })
+}
+
+# Ingestion events go straight to the queue unless EVENT_BACKEND is set to sns, in which case they are published here
+# and fanned out to the queue along with any other subscribers.
+resource "aws_sns_topic" "race_ingestion_events" {
+  name = "${local.workspace_prefix}SaturdaysSpinoutRaceIngestionEvents"
+}
+
+resource "aws_sns_topic_subscription" "race_ingestion_requests" {
+  topic_arn            = aws_sns_topic.race_ingestion_events.arn
+  protocol             = "sqs"
+  endpoint             = aws_sqs_queue.race_ingestion_requests.arn
+  raw_message_delivery = true # consumers see the same body as when sent to the queue directly
+}
+
+resource "aws_sns_topic" "race_ingestion_background_events" {
+  name = "${local.workspace_prefix}SaturdaysSpinoutRaceIngestionBackgroundEvents"
+}
+
+resource "aws_sns_topic_subscription" "race_ingestion_background_requests" {
+  topic_arn            = aws_sns_topic.race_ingestion_background_events.arn
+  protocol             = "sqs"
+  endpoint             = aws_sqs_queue.race_ingestion_background_requests.arn
+  raw_message_delivery = true
+}
+
+data "aws_iam_policy_document" "race_ingestion_requests_queue" {
+  statement {
+    sid     = "AllowRaceIngestionEventsTopic"
+    effect  = "Allow"
+    actions = ["sqs:SendMessage"]
+    principals {
+      type        = "Service"
+      identifiers = ["sns.amazonaws.com"]
+    }
+    resources = [aws_sqs_queue.race_ingestion_requests.arn]
+    condition {
+      test     = "ArnEquals"
+      variable = "aws:SourceArn"
+      values   = [aws_sns_topic.race_ingestion_events.arn]
+    }
+  }
+}
+
+resource "aws_sqs_queue_policy" "race_ingestion_requests" {
+  queue_url = aws_sqs_queue.race_ingestion_requests.id
+  policy    = data.aws_iam_policy_document.race_ingestion_requests_queue.json
+}
+
+data "aws_iam_policy_document" "race_ingestion_background_requests_queue" {
+  statement {
+    sid     = "AllowRaceIngestionBackgroundEventsTopic"
+    effect  = "Allow"
+    actions = ["sqs:SendMessage"]
+    principals {
+      type        = "Service"
+      identifiers = ["sns.amazonaws.com"]
+    }
+    resources = [aws_sqs_queue.race_ingestion_background_requests.arn]
+    condition {
+      test     = "ArnEquals"
+      variable = "aws:SourceArn"
+      values   = [aws_sns_topic.race_ingestion_background_events.arn]
+    }
+  }
+}
+
+resource "aws_sqs_queue_policy" "race_ingestion_background_requests" {
+  queue_url = aws_sqs_queue.race_ingestion_background_requests.id
+  policy    = data.aws_iam_policy_document.race_ingestion_background_requests_queue.json
+}
+
 resource "aws_iam_role" "race_ingestion_lambda" {
   name               = "${local.workspace_prefix}SaturdaysSpinoutRaceIngestion"
   assume_role_policy = data.aws_iam_policy_document.assume_lambda_role_policy.json
@@ -68,7 +159,21 @@ data "aws_iam_policy_document" "race_ingestion_lambda" {
       "sqs:SendMessage"
     ]
     resources = [
-      aws_sqs_queue.race_ingestion_requests.arn
+      aws_sqs_queue.race_ingestion_requests.arn,
+      aws_sqs_queue.race_ingestion_background_requests.arn
+    ]
+  }
+
+  statement {
+    sid    = "AllowSNSPublish"
+    effect = "Allow"
+    actions = [
+      "sns:Publish",
+      "sns:GetTopicAttributes"
+    ]
+    resources = [
+      aws_sns_topic.race_ingestion_events.arn,
+      aws_sns_topic.race_ingestion_background_events.arn
     ]
   }
 
@@ -76,6 +181,7 @@ data "aws_iam_policy_document" "race_ingestion_lambda" {
     sid    = "AllowDynamoDB"
     effect = "Allow"
     actions = [
+      "dynamodb:DescribeTable",
       "dynamodb:GetItem",
       "dynamodb:BatchGetItem",
       "dynamodb:BatchWriteItem",
@@ -84,9 +190,7 @@ data "aws_iam_policy_document" "race_ingestion_lambda" {
       "dynamodb:DeleteItem",
       "dynamodb:Query"
     ]
-    resources = [
-      aws_dynamodb_table.application_store.arn
-    ]
+    resources = local.application_store_arns
   }
 
   statement {
@@ -122,6 +226,28 @@ data "aws_iam_policy_document" "race_ingestion_lambda" {
       "${aws_s3_bucket.iracing_cache.arn}/*"
     ]
   }
+
+  statement {
+    sid    = "AllowIngestionCaptureS3"
+    effect = "Allow"
+    actions = [
+      "s3:PutObject"
+    ]
+    resources = [
+      "${aws_s3_bucket.ingestion_captures.arn}/*"
+    ]
+  }
+
+  statement {
+    sid    = "AllowSessionArchiveS3"
+    effect = "Allow"
+    actions = [
+      "s3:PutObject"
+    ]
+    resources = [
+      "${aws_s3_bucket.session_archives.arn}/*"
+    ]
+  }
 }
 
 resource "aws_iam_role_policy" "race_ingestion_lambda" {
@@ -134,7 +260,7 @@ resource "aws_lambda_function" "race_ingestion_lambda" {
   source_code_hash = filebase64sha256("../dist/raceIngestionProcessorLambda.zip")
   timeout          = 300
 
-  reserved_concurrent_executions = var.race_ingestion_processor_concurrency
+  reserved_concurrent_executions = var.race_ingestion_processor_concurrency + var.race_ingestion_background_concurrency
   memory_size                    = 1024 // memory is less an issue, but we want the greater CPU allocation and bigger pipes
 
   runtime       = "provided.al2"
@@ -149,14 +275,25 @@ resource "aws_lambda_function" "race_ingestion_lambda" {
 
   environment {
     variables = {
-      LOG_LEVEL                       = "info"
-      DYNAMODB_TABLE                  = aws_dynamodb_table.application_store.name
-      WS_MANAGEMENT_ENDPOINT          = "https://${aws_apigatewayv2_api.websockets.id}.execute-api.us-east-1.amazonaws.com/${aws_apigatewayv2_stage.ws.name}"
-      RACE_CONSUMPTION_CONCURRENCY    = "12"
-      INGESTION_QUEUE_URL             = aws_sqs_queue.race_ingestion_requests.url
-      INGESTION_LOCK_DURATION_SECONDS = "900"
-      IRACING_CACHE_BUCKET            = aws_s3_bucket.iracing_cache.bucket
-      METRICS_NAMESPACE               = "${local.workspace_prefix}SaturdaysSpinout"
+      LOG_LEVEL                               = "info"
+      DYNAMODB_TABLE                          = aws_dynamodb_table.application_store.name
+      WS_MANAGEMENT_ENDPOINT                  = "https://${aws_apigatewayv2_api.websockets.id}.execute-api.us-east-1.amazonaws.com/${aws_apigatewayv2_stage.ws.name}"
+      RACE_CONSUMPTION_CONCURRENCY            = "12"
+      LAP_CONSUMPTION_CONCURRENCY             = "6"
+      BACKGROUND_RACE_CONSUMPTION_CONCURRENCY = "4"
+      BACKGROUND_LAP_CONSUMPTION_CONCURRENCY  = "2"
+      INGESTION_QUEUE_URL                     = aws_sqs_queue.race_ingestion_requests.url
+      INGESTION_TOPIC_ARN                     = aws_sns_topic.race_ingestion_events.arn
+      BACKGROUND_INGESTION_QUEUE_URL          = aws_sqs_queue.race_ingestion_background_requests.url
+      BACKGROUND_INGESTION_TOPIC_ARN          = aws_sns_topic.race_ingestion_background_events.arn
+      EVENT_BACKEND                           = "sqs"
+      INGESTION_LOCK_DURATION_SECONDS         = "900"
+      INGESTION_RATE_BUDGET                   = "240"
+      INTERPOLATE_MISSING_LAPS                = "true"
+      IRACING_CACHE_BUCKET                    = aws_s3_bucket.iracing_cache.bucket
+      INGESTION_CAPTURE_BUCKET                = aws_s3_bucket.ingestion_captures.bucket
+      SESSION_ARCHIVE_BUCKET                  = aws_s3_bucket.session_archives.bucket
+      METRICS_NAMESPACE                       = "${local.workspace_prefix}SaturdaysSpinout"
     }
   }
 }
@@ -176,6 +313,16 @@ resource "aws_lambda_event_source_mapping" "race_ingestion_sqs" {
   }
 }
 
+resource "aws_lambda_event_source_mapping" "race_ingestion_background_sqs" {
+  event_source_arn                   = aws_sqs_queue.race_ingestion_background_requests.arn
+  function_name                      = aws_lambda_function.race_ingestion_lambda.arn
+  batch_size                         = 1
+  maximum_batching_window_in_seconds = 0
+  scaling_config {
+    maximum_concurrency = var.race_ingestion_background_concurrency
+  }
+}
+
 output "race_ingestion_queue_url" {
   value = aws_sqs_queue.race_ingestion_requests.url
 }
@@ -183,3 +330,11 @@ output "race_ingestion_queue_url" {
 output "race_ingestion_queue_arn" {
   value = aws_sqs_queue.race_ingestion_requests.arn
 }
+
+output "race_ingestion_background_queue_url" {
+  value = aws_sqs_queue.race_ingestion_background_requests.url
+}
+
+output "race_ingestion_topic_arn" {
+  value = aws_sns_topic.race_ingestion_events.arn
+}
@@ -0,0 +1,13 @@
+# PDF reports rendered for drivers, kept indefinitely so they can come back for past weeks' recaps
+resource "aws_s3_bucket" "reports" {
+  bucket = "${local.workspace_prefix}reports-${data.aws_caller_identity.current.account_id}"
+}
+
+resource "aws_s3_bucket_public_access_block" "reports" {
+  bucket = aws_s3_bucket.reports.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
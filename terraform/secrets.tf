@@ -2,6 +2,11 @@ data "aws_secretsmanager_secret" "iracing_credentials" {
   name = "iracing_credentials"
 }
 
+# Stripe webhook signing secret (whsec_...), created by hand from the webhook endpoint in the Stripe dashboard
+data "aws_secretsmanager_secret" "stripe_webhook_secret" {
+  name = "${local.workspace_prefix}stripe-webhook-secret"
+}
+
 # JWT Signing Key (ECDSA P-256)
 resource "tls_private_key" "jwt_signing" {
   algorithm   = "ECDSA"
@@ -13,9 +18,35 @@ resource "aws_secretsmanager_secret" "jwt_signing_key" {
   recovery_window_in_days = 0
 }
 
+# Only seeds the secret, rotations by cmd/rotate-jwt-keys replace it with a key set that must not be reverted on apply
 resource "aws_secretsmanager_secret_version" "jwt_signing_key" {
   secret_id     = aws_secretsmanager_secret.jwt_signing_key.id
   secret_string = tls_private_key.jwt_signing.private_key_pem
+
+  lifecycle {
+    ignore_changes = [secret_string]
+  }
+}
+
+# Season card signing key (ECDSA P-256), kept apart from the JWT signing key so a card can never pass for a session
+# token. Rotated the same way, with cmd/rotate-jwt-keys -secret pointed at it.
+resource "tls_private_key" "season_card_signing" {
+  algorithm   = "ECDSA"
+  ecdsa_curve = "P256"
+}
+
+resource "aws_secretsmanager_secret" "season_card_signing_key" {
+  name                    = "${local.workspace_prefix}season-card-signing-key"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "season_card_signing_key" {
+  secret_id     = aws_secretsmanager_secret.season_card_signing_key.id
+  secret_string = tls_private_key.season_card_signing.private_key_pem
+
+  lifecycle {
+    ignore_changes = [secret_string]
+  }
 }
 
 # JWT Encryption Key (AES-256, 32 bytes)
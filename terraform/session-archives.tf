@@ -0,0 +1,13 @@
+# Raw iRacing responses behind each ingested session, kept indefinitely so new fields can be backfilled from them
+resource "aws_s3_bucket" "session_archives" {
+  bucket = "${local.workspace_prefix}session-archives-${data.aws_caller_identity.current.account_id}"
+}
+
+resource "aws_s3_bucket_public_access_block" "session_archives" {
+  bucket = aws_s3_bucket.session_archives.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
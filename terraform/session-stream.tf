@@ -0,0 +1,146 @@
+resource "aws_iam_role" "session_stream_lambda" {
+  name               = "${local.workspace_prefix}SaturdaysSpinoutSessionStream"
+  assume_role_policy = data.aws_iam_policy_document.assume_lambda_role_policy.json
+}
+
+data "aws_iam_policy_document" "session_stream_lambda" {
+  statement {
+    sid    = "AllowLogging"
+    effect = "Allow"
+    actions = [
+      "logs:CreateLogStream",
+      "logs:PutLogEvents"
+    ]
+    resources = [
+      "${aws_cloudwatch_log_group.session_stream_lambda_logs.arn}:*"
+    ]
+  }
+
+  statement {
+    sid    = "AllowXRayWrite"
+    effect = "Allow"
+    actions = [
+      "xray:PutTraceSegments",
+      "xray:PutTelemetryRecords",
+      "xray:GetSamplingRules",
+      "xray:GetSamplingTargets",
+      "xray:GetSamplingStatisticSummaries"
+    ]
+    resources = ["*"]
+  }
+
+  statement {
+    sid    = "AllowStreamRead"
+    effect = "Allow"
+    actions = [
+      "dynamodb:DescribeStream",
+      "dynamodb:GetRecords",
+      "dynamodb:GetShardIterator",
+      "dynamodb:ListStreams"
+    ]
+    resources = local.application_store_stream_arns
+  }
+
+  statement {
+    sid    = "AllowDynamoDB"
+    effect = "Allow"
+    actions = [
+      "dynamodb:DescribeTable",
+      "dynamodb:BatchGetItem",
+      "dynamodb:PutItem",
+      "dynamodb:UpdateItem",
+      "dynamodb:Query"
+    ]
+    resources = local.application_store_arns
+  }
+
+  statement {
+    sid    = "AllowAPIGatewayManagement"
+    effect = "Allow"
+    actions = [
+      "execute-api:ManageConnections"
+    ]
+    resources = [
+      "arn:aws:execute-api:us-east-1:${data.aws_caller_identity.current.account_id}:${aws_apigatewayv2_api.websockets.id}/*"
+    ]
+  }
+
+  statement {
+    sid    = "AllowCloudWatchMetrics"
+    effect = "Allow"
+    actions = [
+      "cloudwatch:PutMetricData"
+    ]
+    resources = ["*"]
+  }
+}
+
+resource "aws_iam_role_policy" "session_stream_lambda" {
+  role   = aws_iam_role.session_stream_lambda.name
+  policy = data.aws_iam_policy_document.session_stream_lambda.json
+}
+
+resource "aws_lambda_function" "session_stream_lambda" {
+  filename         = "../dist/sessionStreamProcessorLambda.zip"
+  source_code_hash = filebase64sha256("../dist/sessionStreamProcessorLambda.zip")
+  timeout          = 60
+  memory_size      = 128
+
+  runtime       = "provided.al2"
+  handler       = "bootstrap"
+  architectures = ["arm64"]
+  function_name = "${local.workspace_prefix}SaturdaysSpinoutSessionStream"
+  role          = aws_iam_role.session_stream_lambda.arn
+
+  tracing_config {
+    mode = "Active"
+  }
+
+  environment {
+    variables = {
+      LOG_LEVEL              = "info"
+      DYNAMODB_TABLE         = aws_dynamodb_table.application_store.name
+      METRICS_NAMESPACE      = "${local.workspace_prefix}SaturdaysSpinout"
+      WS_MANAGEMENT_ENDPOINT = "https://${aws_apigatewayv2_api.websockets.id}.execute-api.us-east-1.amazonaws.com/${aws_apigatewayv2_stage.ws.name}"
+    }
+  }
+}
+
+resource "aws_cloudwatch_log_group" "session_stream_lambda_logs" {
+  name              = "/aws/lambda/${local.workspace_prefix}SaturdaysSpinoutSessionStream"
+  retention_in_days = 7
+}
+
+// one mapping per tenant table, the processor works out the tenant from the stream a record came from
+resource "aws_lambda_event_source_mapping" "session_stream" {
+  for_each = local.application_store_streams
+
+  event_source_arn  = each.value
+  function_name     = aws_lambda_function.session_stream_lambda.arn
+  starting_position = "LATEST"
+  batch_size        = 100
+
+  // processing is idempotent so retries are safe, but a poison record shouldn't hold up the shard forever
+  bisect_batch_on_function_error = true
+  maximum_retry_attempts         = 10
+
+  // only newly written driver sessions, everything else in the table is noise
+  filter_criteria {
+    filter {
+      pattern = jsonencode({
+        eventName = ["INSERT"]
+        dynamodb = {
+          Keys = {
+            partition_key = { S = [{ prefix = "driver#" }] }
+            sort_key      = { S = [{ prefix = "session#" }] }
+          }
+        }
+      })
+    }
+  }
+}
+
+moved {
+  from = aws_lambda_event_source_mapping.session_stream
+  to   = aws_lambda_event_source_mapping.session_stream["default"]
+}
@@ -5,6 +5,10 @@ resource "aws_dynamodb_table" "application_store" {
   hash_key  = "partition_key"
   range_key = "sort_key"
 
+  // consumed by the session stream processor to maintain data derived from driver sessions
+  stream_enabled   = true
+  stream_view_type = "NEW_IMAGE"
+
   attribute {
     name = "partition_key"
     type = "S"
@@ -15,8 +19,84 @@ resource "aws_dynamodb_table" "application_store" {
     type = "S"
   }
 
+  attribute {
+    name = "race_order"
+    type = "S"
+  }
+
+  // sparse, only lap items carry race_order, so a session's laps can be paged through in the order they were completed
+  global_secondary_index {
+    name            = "race_order_index"
+    hash_key        = "partition_key"
+    range_key       = "race_order"
+    projection_type = "ALL"
+  }
+
   ttl {
     attribute_name = "ttl"
     enabled        = true
   }
-}
\ No newline at end of file
+}
+// every tenant other than the default gets a table of its own, with the same layout as the default's
+resource "aws_dynamodb_table" "tenant_store" {
+  for_each = toset(var.tenants)
+
+  name         = "${aws_dynamodb_table.application_store.name}-${each.key}"
+  billing_mode = "PAY_PER_REQUEST"
+
+  hash_key  = "partition_key"
+  range_key = "sort_key"
+
+  stream_enabled   = true
+  stream_view_type = "NEW_IMAGE"
+
+  attribute {
+    name = "partition_key"
+    type = "S"
+  }
+
+  attribute {
+    name = "sort_key"
+    type = "S"
+  }
+
+  attribute {
+    name = "race_order"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "race_order_index"
+    hash_key        = "partition_key"
+    range_key       = "race_order"
+    projection_type = "ALL"
+  }
+
+  ttl {
+    attribute_name = "ttl"
+    enabled        = true
+  }
+}
+
+locals {
+  application_store_arns        = concat([aws_dynamodb_table.application_store.arn], [for t in aws_dynamodb_table.tenant_store : t.arn])
+  application_store_stream_arns = concat([aws_dynamodb_table.application_store.stream_arn], [for t in aws_dynamodb_table.tenant_store : t.stream_arn])
+  // keyed by tenant, since stream ARNs aren't known until the tables exist
+  application_store_streams = merge(
+    { default = aws_dynamodb_table.application_store.stream_arn },
+    { for tenant, t in aws_dynamodb_table.tenant_store : tenant => t.stream_arn }
+  )
+  tenants                       = join(",", var.tenants)
+}
+
+// envelope encryption of journal notes, transcripts and bookmark notes. KMS rotates the key material yearly, values
+// encrypted under older material stay readable
+resource "aws_kms_key" "field_encryption" {
+  description         = "${local.workspace_prefix}SaturdaysSpinout field encryption"
+  enable_key_rotation = true
+}
+
+resource "aws_kms_alias" "field_encryption" {
+  name          = "alias/${local.workspace_prefix}saturdaysspinout-field-encryption"
+  target_key_id = aws_kms_key.field_encryption.key_id
+}
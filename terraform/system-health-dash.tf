@@ -245,6 +245,115 @@ resource "aws_cloudwatch_dashboard" "system_health" {
             }
           }
         }
+      },
+      {
+        type   = "metric"
+        x      = 0
+        y      = 30
+        width  = 12
+        height = 6
+        properties = {
+          title  = "API Handler Latency, Successful Requests (ms)"
+          region = "us-east-1"
+          metrics = [
+            ["${local.workspace_prefix}SaturdaysSpinout", "api_latency", "StatusClass", "2xx", { stat = "p50", period = 300, label = "p50" }],
+            ["${local.workspace_prefix}SaturdaysSpinout", "api_latency", "StatusClass", "2xx", { stat = "p90", period = 300, label = "p90" }],
+            ["${local.workspace_prefix}SaturdaysSpinout", "api_latency", "StatusClass", "2xx", { stat = "p99", period = 300, label = "p99" }]
+          ]
+          view    = "timeSeries"
+          stacked = false
+          yAxis = {
+            left = {
+              min = 0
+            }
+          }
+        }
+      },
+      {
+        type   = "metric"
+        x      = 12
+        y      = 30
+        width  = 12
+        height = 6
+        properties = {
+          title  = "WebSocket Push Latency (ms)"
+          region = "us-east-1"
+          metrics = [
+            ["${local.workspace_prefix}SaturdaysSpinout", "websocket_push_latency", { stat = "p50", period = 300, label = "p50" }],
+            ["${local.workspace_prefix}SaturdaysSpinout", "websocket_push_latency", { stat = "p90", period = 300, label = "p90" }],
+            ["${local.workspace_prefix}SaturdaysSpinout", "websocket_push_latency", { stat = "p99", period = 300, label = "p99" }]
+          ]
+          view    = "timeSeries"
+          stacked = false
+          yAxis = {
+            left = {
+              min = 0
+            }
+          }
+        }
+      },
+      {
+        type   = "metric"
+        x      = 0
+        y      = 36
+        width  = 24
+        height = 6
+        properties = {
+          title  = "API Handler p99 Latency by Route, Successful Requests (ms)"
+          region = "us-east-1"
+          metrics = [
+            [{ expression = "SEARCH('{${local.workspace_prefix}SaturdaysSpinout,Route,StatusClass} MetricName=\"api_latency\" StatusClass=\"2xx\"', 'p99', 300)", id = "routes", label = "" }]
+          ]
+          view    = "timeSeries"
+          stacked = false
+          yAxis = {
+            left = {
+              min = 0
+            }
+          }
+        }
+      },
+      {
+        type   = "metric"
+        x      = 0
+        y      = 42
+        width  = 12
+        height = 6
+        properties = {
+          title  = "Store Read Max Items by Query"
+          region = "us-east-1"
+          metrics = [
+            [{ expression = "SEARCH('{${local.workspace_prefix}SaturdaysSpinout,Query} MetricName=\"store_read_items\"', 'Maximum', 300)", id = "items", label = "" }]
+          ]
+          view    = "timeSeries"
+          stacked = false
+          yAxis = {
+            left = {
+              min = 0
+            }
+          }
+        }
+      },
+      {
+        type   = "metric"
+        x      = 12
+        y      = 42
+        width  = 12
+        height = 6
+        properties = {
+          title  = "Store Read p99 Bytes by Query"
+          region = "us-east-1"
+          metrics = [
+            [{ expression = "SEARCH('{${local.workspace_prefix}SaturdaysSpinout,Query} MetricName=\"store_read_bytes\"', 'p99', 300)", id = "bytes", label = "" }]
+          ]
+          view    = "timeSeries"
+          stacked = false
+          yAxis = {
+            left = {
+              min = 0
+            }
+          }
+        }
       }
     ]
   })
@@ -10,7 +10,30 @@ variable "route53_domain" {
 }
 
 variable "race_ingestion_processor_concurrency" {
-  description = "Reserved concurrent executions for the race ingestion processor Lambda"
+  description = "Concurrent executions of the race ingestion processor Lambda for rounds a driver is waiting on"
   type        = number
   default     = 15
-}
\ No newline at end of file
+}
+
+variable "race_ingestion_background_concurrency" {
+  description = "Concurrent executions of the race ingestion processor Lambda for background rounds, on top of the interactive ones"
+  type        = number
+  default     = 5
+}
+variable "tenants" {
+  description = "Communities hosted alongside the default one, each getting its own copy of the application store"
+  type        = list(string)
+  default     = []
+}
+
+variable "waitlist_enabled" {
+  description = "Whether drivers signing in for the first time are held on the soft launch waitlist until invited"
+  type        = bool
+  default     = false
+}
+
+variable "terms_versions" {
+  description = "Current version of each document drivers must accept before changing anything, keyed by document (tos, privacy)"
+  type        = map(string)
+  default     = {}
+}
@@ -0,0 +1,190 @@
+resource "aws_s3_bucket" "voice_memos" {
+  bucket = "${local.workspace_prefix}voice-memos-${data.aws_caller_identity.current.account_id}"
+}
+
+resource "aws_s3_bucket_public_access_block" "voice_memos" {
+  bucket = aws_s3_bucket.voice_memos.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+// memos are uploaded and played back by the browser through presigned URLs
+resource "aws_s3_bucket_cors_configuration" "voice_memos" {
+  bucket = aws_s3_bucket.voice_memos.id
+
+  cors_rule {
+    allowed_methods = ["PUT", "GET"]
+    allowed_origins = ["https://${local.frontend_domain_name}", "http://127.0.0.1:5173"]
+    allowed_headers = ["*"]
+    max_age_seconds = 3000
+  }
+}
+
+resource "aws_iam_role" "voice_memo_lambda" {
+  name               = "${local.workspace_prefix}SaturdaysSpinoutVoiceMemo"
+  assume_role_policy = data.aws_iam_policy_document.assume_lambda_role_policy.json
+}
+
+data "aws_iam_policy_document" "voice_memo_lambda" {
+  statement {
+    sid    = "AllowLogging"
+    effect = "Allow"
+    actions = [
+      "logs:CreateLogStream",
+      "logs:PutLogEvents"
+    ]
+    resources = [
+      "${aws_cloudwatch_log_group.voice_memo_lambda_logs.arn}:*"
+    ]
+  }
+
+  statement {
+    sid    = "AllowXRayWrite"
+    effect = "Allow"
+    actions = [
+      "xray:PutTraceSegments",
+      "xray:PutTelemetryRecords",
+      "xray:GetSamplingRules",
+      "xray:GetSamplingTargets",
+      "xray:GetSamplingStatisticSummaries"
+    ]
+    resources = ["*"]
+  }
+
+  statement {
+    sid    = "AllowDynamoDB"
+    effect = "Allow"
+    actions = [
+      "dynamodb:DescribeTable",
+      "dynamodb:GetItem",
+      "dynamodb:UpdateItem"
+    ]
+    resources = local.application_store_arns
+  }
+
+  // transcription jobs run with the permissions of whoever started them, so the memo read and transcript write are
+  // granted here as well
+  statement {
+    sid    = "AllowVoiceMemoS3"
+    effect = "Allow"
+    actions = [
+      "s3:GetObject",
+      "s3:PutObject"
+    ]
+    resources = [
+      "${aws_s3_bucket.voice_memos.arn}/*"
+    ]
+  }
+
+  statement {
+    sid    = "AllowFieldEncryption"
+    effect = "Allow"
+    actions = [
+      "kms:GenerateDataKey",
+      "kms:Decrypt"
+    ]
+    resources = [aws_kms_key.field_encryption.arn]
+  }
+
+  statement {
+    sid    = "AllowTranscribe"
+    effect = "Allow"
+    actions = [
+      "transcribe:StartTranscriptionJob"
+    ]
+    resources = ["*"]
+  }
+}
+
+resource "aws_iam_role_policy" "voice_memo_lambda" {
+  role   = aws_iam_role.voice_memo_lambda.name
+  policy = data.aws_iam_policy_document.voice_memo_lambda.json
+}
+
+resource "aws_lambda_function" "voice_memo_lambda" {
+  filename         = "../dist/voiceMemoProcessorLambda.zip"
+  source_code_hash = filebase64sha256("../dist/voiceMemoProcessorLambda.zip")
+  timeout          = 30
+  memory_size      = 128
+
+  runtime       = "provided.al2"
+  handler       = "bootstrap"
+  architectures = ["arm64"]
+  function_name = "${local.workspace_prefix}SaturdaysSpinoutVoiceMemo"
+  role          = aws_iam_role.voice_memo_lambda.arn
+
+  tracing_config {
+    mode = "Active"
+  }
+
+  environment {
+    variables = {
+      LOG_LEVEL               = "info"
+      DYNAMODB_TABLE          = aws_dynamodb_table.application_store.name
+      VOICE_MEMO_BUCKET       = aws_s3_bucket.voice_memos.bucket
+      FIELD_ENCRYPTION_KEY_ID = aws_kms_alias.field_encryption.arn
+    }
+  }
+}
+
+resource "aws_cloudwatch_log_group" "voice_memo_lambda_logs" {
+  name              = "/aws/lambda/${local.workspace_prefix}SaturdaysSpinoutVoiceMemo"
+  retention_in_days = 7
+}
+
+resource "aws_lambda_permission" "voice_memo_s3" {
+  statement_id  = "AllowS3Invoke"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.voice_memo_lambda.function_name
+  principal     = "s3.amazonaws.com"
+  source_arn    = aws_s3_bucket.voice_memos.arn
+}
+
+resource "aws_s3_bucket_notification" "voice_memos" {
+  bucket = aws_s3_bucket.voice_memos.id
+
+  lambda_function {
+    lambda_function_arn = aws_lambda_function.voice_memo_lambda.arn
+    events              = ["s3:ObjectCreated:*"]
+    filter_prefix       = "memos/"
+  }
+
+  lambda_function {
+    lambda_function_arn = aws_lambda_function.voice_memo_lambda.arn
+    events              = ["s3:ObjectCreated:*"]
+    filter_prefix       = "transcripts/"
+    filter_suffix       = ".json"
+  }
+
+  depends_on = [aws_lambda_permission.voice_memo_s3]
+}
+
+// failed jobs never write a transcript, so they are picked up from the job state change instead
+resource "aws_cloudwatch_event_rule" "voice_memo_transcription_failed" {
+  name = "${local.workspace_prefix}SaturdaysSpinoutVoiceMemoTranscriptionFailed"
+  event_pattern = jsonencode({
+    source        = ["aws.transcribe"]
+    "detail-type" = ["Transcribe Job State Change"]
+    detail = {
+      TranscriptionJobStatus = ["FAILED"]
+      // tenants other than the default have theirs appended to the prefix, memo-<tenant>.
+      TranscriptionJobName = [{ prefix = "memo." }, { prefix = "memo-" }]
+    }
+  })
+}
+
+resource "aws_cloudwatch_event_target" "voice_memo_transcription_failed" {
+  rule = aws_cloudwatch_event_rule.voice_memo_transcription_failed.name
+  arn  = aws_lambda_function.voice_memo_lambda.arn
+}
+
+resource "aws_lambda_permission" "voice_memo_events" {
+  statement_id  = "AllowEventBridgeInvoke"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.voice_memo_lambda.function_name
+  principal     = "events.amazonaws.com"
+  source_arn    = aws_cloudwatch_event_rule.voice_memo_transcription_failed.arn
+}
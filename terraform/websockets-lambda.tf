@@ -44,14 +44,13 @@ data "aws_iam_policy_document" "ws_lambda" {
     sid    = "AllowDynamoDB"
     effect = "Allow"
     actions = [
+      "dynamodb:DescribeTable",
       "dynamodb:GetItem",
       "dynamodb:PutItem",
       "dynamodb:DeleteItem",
       "dynamodb:Query"
     ]
-    resources = [
-      aws_dynamodb_table.application_store.arn
-    ]
+    resources = local.application_store_arns
   }
 
   statement {
@@ -50,6 +50,24 @@ resource "aws_apigatewayv2_route" "ws_ping" {
   target    = "integrations/${aws_apigatewayv2_integration.ws_lambda.id}"
 }
 
+resource "aws_apigatewayv2_route" "ws_cancel_ingestion" {
+  api_id    = aws_apigatewayv2_api.websockets.id
+  route_key = "cancelIngestion"
+  target    = "integrations/${aws_apigatewayv2_integration.ws_lambda.id}"
+}
+
+resource "aws_apigatewayv2_route" "ws_racing_heartbeat" {
+  api_id    = aws_apigatewayv2_api.websockets.id
+  route_key = "racingHeartbeat"
+  target    = "integrations/${aws_apigatewayv2_integration.ws_lambda.id}"
+}
+
+resource "aws_apigatewayv2_route" "ws_overlay_subscribe" {
+  api_id    = aws_apigatewayv2_api.websockets.id
+  route_key = "overlaySubscribe"
+  target    = "integrations/${aws_apigatewayv2_integration.ws_lambda.id}"
+}
+
 resource "aws_apigatewayv2_stage" "ws" {
   api_id      = aws_apigatewayv2_api.websockets.id
   name        = "${local.workspace_prefix}saturdaysspinout-ws"
@@ -0,0 +1,113 @@
+resource "aws_iam_role" "weekly_leaderboard_lambda" {
+  name               = "${local.workspace_prefix}SaturdaysSpinoutWeeklyLeaderboard"
+  assume_role_policy = data.aws_iam_policy_document.assume_lambda_role_policy.json
+}
+
+data "aws_iam_policy_document" "weekly_leaderboard_lambda" {
+  statement {
+    sid    = "AllowLogging"
+    effect = "Allow"
+    actions = [
+      "logs:CreateLogStream",
+      "logs:PutLogEvents"
+    ]
+    resources = [
+      "${aws_cloudwatch_log_group.weekly_leaderboard_lambda_logs.arn}:*"
+    ]
+  }
+
+  statement {
+    sid    = "AllowXRayWrite"
+    effect = "Allow"
+    actions = [
+      "xray:PutTraceSegments",
+      "xray:PutTelemetryRecords",
+      "xray:GetSamplingRules",
+      "xray:GetSamplingTargets",
+      "xray:GetSamplingStatisticSummaries"
+    ]
+    resources = ["*"]
+  }
+
+  statement {
+    sid    = "AllowDynamoDB"
+    effect = "Allow"
+    actions = [
+      "dynamodb:DescribeTable",
+      "dynamodb:Scan",
+      "dynamodb:Query",
+      "dynamodb:GetItem",
+      "dynamodb:PutItem",
+      "dynamodb:BatchWriteItem"
+    ]
+    resources = local.application_store_arns
+  }
+
+  statement {
+    sid    = "AllowAPIGatewayManagement"
+    effect = "Allow"
+    actions = [
+      "execute-api:ManageConnections"
+    ]
+    resources = [
+      "arn:aws:execute-api:us-east-1:${data.aws_caller_identity.current.account_id}:${aws_apigatewayv2_api.websockets.id}/*"
+    ]
+  }
+}
+
+resource "aws_iam_role_policy" "weekly_leaderboard_lambda" {
+  role   = aws_iam_role.weekly_leaderboard_lambda.name
+  policy = data.aws_iam_policy_document.weekly_leaderboard_lambda.json
+}
+
+resource "aws_lambda_function" "weekly_leaderboard_lambda" {
+  filename         = "../dist/weeklyLeaderboardLambda.zip"
+  source_code_hash = filebase64sha256("../dist/weeklyLeaderboardLambda.zip")
+  timeout          = 900
+
+  reserved_concurrent_executions = 1 // runs never overlap
+  memory_size                    = 256
+
+  runtime       = "provided.al2"
+  handler       = "bootstrap"
+  architectures = ["arm64"]
+  function_name = "${local.workspace_prefix}SaturdaysSpinoutWeeklyLeaderboard"
+  role          = aws_iam_role.weekly_leaderboard_lambda.arn
+
+  tracing_config {
+    mode = "Active"
+  }
+
+  environment {
+    variables = {
+      LOG_LEVEL              = "info"
+      DYNAMODB_TABLE         = aws_dynamodb_table.application_store.name
+      TENANTS                = local.tenants
+      WS_MANAGEMENT_ENDPOINT = "https://${aws_apigatewayv2_api.websockets.id}.execute-api.us-east-1.amazonaws.com/${aws_apigatewayv2_stage.ws.name}"
+    }
+  }
+}
+
+resource "aws_cloudwatch_log_group" "weekly_leaderboard_lambda_logs" {
+  name              = "/aws/lambda/${local.workspace_prefix}SaturdaysSpinoutWeeklyLeaderboard"
+  retention_in_days = 7
+}
+
+resource "aws_cloudwatch_event_rule" "weekly_leaderboard_schedule" {
+  name                = "${local.workspace_prefix}SaturdaysSpinoutWeeklyLeaderboard"
+  description         = "Ranks opted in drivers on the weekly improvement leaderboards"
+  schedule_expression = "rate(6 hours)" # the boards are a weekly thing, a few refreshes a day keeps them current enough
+}
+
+resource "aws_cloudwatch_event_target" "weekly_leaderboard_schedule" {
+  rule = aws_cloudwatch_event_rule.weekly_leaderboard_schedule.name
+  arn  = aws_lambda_function.weekly_leaderboard_lambda.arn
+}
+
+resource "aws_lambda_permission" "weekly_leaderboard_schedule" {
+  statement_id  = "AllowExecutionFromEventBridge"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.weekly_leaderboard_lambda.function_name
+  principal     = "events.amazonaws.com"
+  source_arn    = aws_cloudwatch_event_rule.weekly_leaderboard_schedule.arn
+}
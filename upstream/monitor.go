@@ -0,0 +1,95 @@
+// Package upstream tracks whether iRacing is down for maintenance, so that once anything sees it go down the API and
+// ingestion all back off until it is expected back rather than each finding out for themselves.
+package upstream
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// DefaultPause is how long iRacing is assumed to be down for once it reports maintenance. Maintenance usually runs well
+// past this, the first request after the pause finding it still down starts another.
+const DefaultPause = 15 * time.Minute
+
+// DefaultRefreshInterval is how long a read of the shared status is trusted, keeping the status check from costing a
+// store read on every request.
+const DefaultRefreshInterval = 30 * time.Second
+
+// Store defines the data access methods needed to share the status.
+type Store interface {
+	GetUpstreamStatus(ctx context.Context) (*store.UpstreamStatus, error)
+	SaveUpstreamStatus(ctx context.Context, status store.UpstreamStatus) error
+}
+
+// Monitor shares iRacing being down between every process calling it. Reads of the shared status are cached for the
+// refresh interval, and a process that reports maintenance sees it straight away.
+type Monitor struct {
+	store           Store
+	pause           time.Duration
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mu        sync.Mutex
+	downUntil time.Time
+	checkedAt time.Time
+}
+
+func NewMonitor(store Store, pause, refreshInterval time.Duration) *Monitor {
+	return &Monitor{
+		store:           store,
+		pause:           pause,
+		refreshInterval: refreshInterval,
+		now:             time.Now,
+	}
+}
+
+// DownUntil returns when iRacing is expected back, the zero time when it isn't known to be down.
+func (m *Monitor) DownUntil(ctx context.Context) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	if m.checkedAt.IsZero() || now.Sub(m.checkedAt) >= m.refreshInterval {
+		status, err := m.store.GetUpstreamStatus(ctx)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("getting upstream status: %w", err)
+		}
+		m.downUntil = time.Time{}
+		if status != nil {
+			m.downUntil = status.DownUntil
+		}
+		m.checkedAt = now
+	}
+
+	if !m.downUntil.After(now) {
+		return time.Time{}, nil
+	}
+	return m.downUntil, nil
+}
+
+// ReportMaintenance records iRacing as down for the pause from now, returning when it is expected back.
+func (m *Monitor) ReportMaintenance(ctx context.Context, reason string) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	downUntil := now.Add(m.pause)
+	err := m.store.SaveUpstreamStatus(ctx, store.UpstreamStatus{
+		DownUntil:  downUntil,
+		Reason:     reason,
+		ReportedAt: now,
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("saving upstream status: %w", err)
+	}
+	m.downUntil = downUntil
+	m.checkedAt = now
+
+	zerolog.Ctx(ctx).Warn().Str("reason", reason).Time("downUntil", downUntil).Msg("iRacing down for maintenance, pausing calls to it")
+	return downUntil, nil
+}
@@ -0,0 +1,101 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitor_DownUntil(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(10000, 0)
+	downUntil := now.Add(10 * time.Minute)
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetUpstreamStatus(mock.Anything).Return(&store.UpstreamStatus{DownUntil: downUntil}, nil).Once()
+
+	monitor := NewMonitor(mockStore, DefaultPause, DefaultRefreshInterval)
+	monitor.now = func() time.Time { return now }
+
+	got, err := monitor.DownUntil(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, downUntil, got)
+
+	// served from the last read until the refresh interval is up
+	now = now.Add(DefaultRefreshInterval - time.Second)
+	got, err = monitor.DownUntil(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, downUntil, got)
+
+	now = now.Add(time.Second)
+	mockStore.EXPECT().GetUpstreamStatus(mock.Anything).Return(nil, nil).Once()
+	got, err = monitor.DownUntil(ctx)
+	require.NoError(t, err)
+	assert.True(t, got.IsZero())
+
+	now = now.Add(DefaultRefreshInterval)
+	mockStore.EXPECT().GetUpstreamStatus(mock.Anything).Return(nil, errors.New("db error")).Once()
+	_, err = monitor.DownUntil(ctx)
+	assert.Error(t, err)
+}
+
+func TestMonitor_DownUntil_PassesOnceBack(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(10000, 0)
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().GetUpstreamStatus(mock.Anything).Return(&store.UpstreamStatus{DownUntil: now.Add(10 * time.Second)}, nil).Once()
+
+	monitor := NewMonitor(mockStore, DefaultPause, DefaultRefreshInterval)
+	monitor.now = func() time.Time { return now }
+
+	got, err := monitor.DownUntil(ctx)
+	require.NoError(t, err)
+	assert.False(t, got.IsZero())
+
+	// back before the cached read is refreshed
+	now = now.Add(10 * time.Second)
+	got, err = monitor.DownUntil(ctx)
+	require.NoError(t, err)
+	assert.True(t, got.IsZero())
+}
+
+func TestMonitor_ReportMaintenance(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(10000, 0)
+
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().SaveUpstreamStatus(mock.Anything, store.UpstreamStatus{
+		DownUntil:  now.Add(DefaultPause),
+		Reason:     "Site Maintenance",
+		ReportedAt: now,
+	}).Return(nil)
+
+	monitor := NewMonitor(mockStore, DefaultPause, DefaultRefreshInterval)
+	monitor.now = func() time.Time { return now }
+
+	downUntil, err := monitor.ReportMaintenance(ctx, "Site Maintenance")
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(DefaultPause), downUntil)
+
+	// seen straight away without reading the store back
+	got, err := monitor.DownUntil(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, downUntil, got)
+}
+
+func TestMonitor_ReportMaintenance_StoreError(t *testing.T) {
+	mockStore := NewMockStore(t)
+	mockStore.EXPECT().SaveUpstreamStatus(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	monitor := NewMonitor(mockStore, DefaultPause, DefaultRefreshInterval)
+
+	_, err := monitor.ReportMaintenance(context.Background(), "Site Maintenance")
+	assert.Error(t, err)
+}
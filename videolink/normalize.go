@@ -0,0 +1,153 @@
+package videolink
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jonsabados/saturdaysspinout/journal"
+)
+
+// Provider identifies where a linked video is hosted.
+type Provider string
+
+const (
+	ProviderYouTube    Provider = "youtube"
+	ProviderVimeo      Provider = "vimeo"
+	ProviderStreamable Provider = "streamable"
+	ProviderTwitch     Provider = "twitch"
+	ProviderOther      Provider = "other"
+)
+
+// ErrInvalidURL is returned when a video link isn't an absolute http or https URL.
+var ErrInvalidURL = errors.New("invalid video URL")
+
+// youtubeTimestamp matches the forms YouTube accepts for a start time: plain seconds, or hours, minutes and seconds
+// such as 1h2m3s.
+var youtubeTimestamp = regexp.MustCompile(`^(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s?)?$`)
+
+// ValidateURL checks that a video link can be normalized.
+func ValidateURL(raw string) []journal.FieldValidation {
+	if strings.TrimSpace(raw) == "" {
+		return []journal.FieldValidation{{Field: "url", Code: "required"}}
+	}
+	if _, _, err := Normalize(raw); err != nil {
+		return []journal.FieldValidation{{Field: "url", Code: "invalid_url"}}
+	}
+	return nil
+}
+
+// Normalize turns the many shapes of link a provider hands out (share links, embeds, shorts, timestamps in different
+// parameters) into one canonical URL so the same moment always links the same way. Links to providers that aren't
+// recognized only have their scheme and host lower cased.
+func Normalize(raw string) (string, Provider, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", "", ErrInvalidURL
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return "", "", ErrInvalidURL
+	}
+	host := strings.ToLower(u.Hostname())
+	if port := u.Port(); port != "" && !(u.Scheme == "http" && port == "80") && !(u.Scheme == "https" && port == "443") {
+		u.Host = host + ":" + port
+	} else {
+		u.Host = host
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	switch strings.TrimPrefix(strings.TrimPrefix(host, "www."), "m.") {
+	case "youtube.com", "youtu.be":
+		if normalized, ok := normalizeYouTube(host, segments, u.Query()); ok {
+			return normalized, ProviderYouTube, nil
+		}
+	case "vimeo.com":
+		if len(segments) == 1 && isDigits(segments[0]) {
+			normalized := "https://vimeo.com/" + segments[0]
+			if strings.HasPrefix(u.Fragment, "t=") {
+				normalized += "#" + u.Fragment
+			}
+			return normalized, ProviderVimeo, nil
+		}
+	case "streamable.com":
+		if len(segments) == 1 && segments[0] != "" {
+			return "https://streamable.com/" + segments[0], ProviderStreamable, nil
+		}
+	case "twitch.tv", "clips.twitch.tv":
+		if normalized, ok := normalizeTwitch(host, segments, u.Query()); ok {
+			return normalized, ProviderTwitch, nil
+		}
+	}
+	return u.String(), ProviderOther, nil
+}
+
+func normalizeYouTube(host string, segments []string, query url.Values) (string, bool) {
+	var videoID string
+	switch {
+	case strings.HasSuffix(host, "youtu.be") && len(segments) == 1:
+		videoID = segments[0]
+	case len(segments) == 1 && segments[0] == "watch":
+		videoID = query.Get("v")
+	case len(segments) == 2 && (segments[0] == "shorts" || segments[0] == "embed" || segments[0] == "live"):
+		videoID = segments[1]
+	}
+	if videoID == "" {
+		return "", false
+	}
+
+	normalized := "https://www.youtube.com/watch?v=" + url.QueryEscape(videoID)
+	start := query.Get("t")
+	if start == "" {
+		start = query.Get("start")
+	}
+	if seconds, ok := parseYouTubeTimestamp(start); ok && seconds > 0 {
+		normalized += "&t=" + strconv.Itoa(seconds) + "s"
+	}
+	return normalized, true
+}
+
+func parseYouTubeTimestamp(t string) (int, bool) {
+	match := youtubeTimestamp.FindStringSubmatch(t)
+	if t == "" || match == nil {
+		return 0, false
+	}
+	seconds := 0
+	for i, multiplier := range []int{3600, 60, 1} {
+		if match[i+1] != "" {
+			v, _ := strconv.Atoi(match[i+1])
+			seconds += v * multiplier
+		}
+	}
+	return seconds, true
+}
+
+func normalizeTwitch(host string, segments []string, query url.Values) (string, bool) {
+	switch {
+	case host == "clips.twitch.tv" && len(segments) == 1 && segments[0] != "":
+		return "https://clips.twitch.tv/" + segments[0], true
+	case len(segments) == 3 && segments[1] == "clip":
+		return "https://clips.twitch.tv/" + segments[2], true
+	case len(segments) == 2 && segments[0] == "videos" && isDigits(segments[1]):
+		normalized := "https://www.twitch.tv/videos/" + segments[1]
+		if t := query.Get("t"); t != "" {
+			normalized += "?t=" + url.QueryEscape(t)
+		}
+		return normalized, true
+	}
+	return "", false
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,111 @@
+package videolink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		name             string
+		input            string
+		expectedURL      string
+		expectedProvider Provider
+		expectErr        bool
+	}{
+		{
+			name:             "youtube watch link with extra parameters",
+			input:            "https://www.youtube.com/watch?v=dQw4w9WgXcQ&list=PL123&t=95",
+			expectedURL:      "https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=95s",
+			expectedProvider: ProviderYouTube,
+		},
+		{
+			name:             "youtube share link with minutes and seconds",
+			input:            "https://youtu.be/dQw4w9WgXcQ?t=1m35s",
+			expectedURL:      "https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=95s",
+			expectedProvider: ProviderYouTube,
+		},
+		{
+			name:             "youtube mobile short without timestamp",
+			input:            "HTTPS://M.YouTube.com/shorts/abc123",
+			expectedURL:      "https://www.youtube.com/watch?v=abc123",
+			expectedProvider: ProviderYouTube,
+		},
+		{
+			name:             "youtube embed with start",
+			input:            "https://www.youtube.com/embed/abc123?start=3723",
+			expectedURL:      "https://www.youtube.com/watch?v=abc123&t=3723s",
+			expectedProvider: ProviderYouTube,
+		},
+		{
+			name:             "youtube link that isn't a video",
+			input:            "https://www.youtube.com/@somechannel",
+			expectedURL:      "https://www.youtube.com/@somechannel",
+			expectedProvider: ProviderOther,
+		},
+		{
+			name:             "vimeo keeps its timestamp",
+			input:            "http://www.vimeo.com/123456?share=copy#t=90s",
+			expectedURL:      "https://vimeo.com/123456#t=90s",
+			expectedProvider: ProviderVimeo,
+		},
+		{
+			name:             "streamable",
+			input:            "https://streamable.com/abc12/",
+			expectedURL:      "https://streamable.com/abc12",
+			expectedProvider: ProviderStreamable,
+		},
+		{
+			name:             "twitch clip on a channel",
+			input:            "https://www.twitch.tv/somestreamer/clip/FunnyClipSlug?filter=clips",
+			expectedURL:      "https://clips.twitch.tv/FunnyClipSlug",
+			expectedProvider: ProviderTwitch,
+		},
+		{
+			name:             "twitch vod with timestamp",
+			input:            "https://m.twitch.tv/videos/987654?t=1h2m3s&utm_source=share",
+			expectedURL:      "https://www.twitch.tv/videos/987654?t=1h2m3s",
+			expectedProvider: ProviderTwitch,
+		},
+		{
+			name:             "other providers keep everything but case and default port",
+			input:            "HTTPS://Clips.Example.COM:443/Race/Clip?id=7#replay",
+			expectedURL:      "https://clips.example.com/Race/Clip?id=7#replay",
+			expectedProvider: ProviderOther,
+		},
+		{
+			name:      "not http",
+			input:     "ftp://example.com/clip.mp4",
+			expectErr: true,
+		},
+		{
+			name:      "relative",
+			input:     "youtube.com/watch?v=abc123",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			normalized, provider, err := Normalize(tc.input)
+			if tc.expectErr {
+				assert.ErrorIs(t, err, ErrInvalidURL)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedURL, normalized)
+			assert.Equal(t, tc.expectedProvider, provider)
+		})
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	assert.Empty(t, ValidateURL("https://youtu.be/abc123"))
+	assert.Equal(t, "required", ValidateURL(" ")[0].Code)
+	invalid := ValidateURL("not a url")
+	require.Len(t, invalid, 1)
+	assert.Equal(t, "url", invalid[0].Field)
+	assert.Equal(t, "invalid_url", invalid[0].Code)
+}
@@ -0,0 +1,87 @@
+package videolink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// maxOEmbedResponseBytes caps how much of an oEmbed response is read, real responses are a few hundred bytes.
+const maxOEmbedResponseBytes = 64 * 1024
+
+// oEmbedEndpoints are the oEmbed endpoints of the providers that have one. Twitch retired theirs.
+var oEmbedEndpoints = map[Provider]string{
+	ProviderYouTube:    "https://www.youtube.com/oembed?format=json",
+	ProviderVimeo:      "https://vimeo.com/api/oembed.json",
+	ProviderStreamable: "https://api.streamable.com/oembed.json",
+}
+
+// HTTPClient is the subset of http.Client used to call oEmbed endpoints.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Metadata is the preview information a provider supplies for a video.
+type Metadata struct {
+	Title        string
+	AuthorName   string
+	ThumbnailURL string
+}
+
+// OEmbedClient fetches preview metadata for linked videos from the hosting provider's oEmbed endpoint.
+type OEmbedClient struct {
+	httpClient HTTPClient
+}
+
+func NewOEmbedClient(httpClient HTTPClient) *OEmbedClient {
+	return &OEmbedClient{httpClient: httpClient}
+}
+
+type oEmbedResponse struct {
+	Title        string `json:"title"`
+	AuthorName   string `json:"author_name"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+// Fetch returns the provider's metadata for a normalized video URL. Returns nil for providers without an oEmbed
+// endpoint.
+func (c *OEmbedClient) Fetch(ctx context.Context, videoURL string, provider Provider) (*Metadata, error) {
+	endpoint, ok := oEmbedEndpoints[provider]
+	if !ok {
+		return nil, nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing endpoint: %w", err)
+	}
+	query := u.Query()
+	query.Set("url", videoURL)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from %s oEmbed", resp.StatusCode, provider)
+	}
+
+	var body oEmbedResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxOEmbedResponseBytes)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+	return &Metadata{
+		Title:        body.Title,
+		AuthorName:   body.AuthorName,
+		ThumbnailURL: body.ThumbnailURL,
+	}, nil
+}
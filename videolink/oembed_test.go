@@ -0,0 +1,84 @@
+package videolink
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOEmbedClient_Fetch(t *testing.T) {
+	videoURL := "https://www.youtube.com/watch?v=abc123&t=95s"
+	expectedRequest := "https://www.youtube.com/oembed?format=json&url=https%3A%2F%2Fwww.youtube.com%2Fwatch%3Fv%3Dabc123%26t%3D95s"
+
+	testCases := []struct {
+		name          string
+		provider      Provider
+		setupMock     func(*MockHTTPClient)
+		expected      *Metadata
+		expectedError string
+	}{
+		{
+			name:     "success",
+			provider: ProviderYouTube,
+			setupMock: func(m *MockHTTPClient) {
+				m.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+					return req.URL.String() == expectedRequest
+				})).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"title":"Turn one chaos","author_name":"Some Streamer","thumbnail_url":"https://i.ytimg.com/vi/abc123/hqdefault.jpg","html":"<iframe></iframe>"}`)),
+				}, nil)
+			},
+			expected: &Metadata{
+				Title:        "Turn one chaos",
+				AuthorName:   "Some Streamer",
+				ThumbnailURL: "https://i.ytimg.com/vi/abc123/hqdefault.jpg",
+			},
+		},
+		{
+			name:      "provider without oEmbed",
+			provider:  ProviderTwitch,
+			setupMock: func(m *MockHTTPClient) {},
+		},
+		{
+			name:     "not found upstream",
+			provider: ProviderYouTube,
+			setupMock: func(m *MockHTTPClient) {
+				m.EXPECT().Do(mock.Anything).Return(&http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       io.NopCloser(strings.NewReader("Not Found")),
+				}, nil)
+			},
+			expectedError: "unexpected status 404 from youtube oEmbed",
+		},
+		{
+			name:     "request error",
+			provider: ProviderYouTube,
+			setupMock: func(m *MockHTTPClient) {
+				m.EXPECT().Do(mock.Anything).Return(nil, errors.New("connection refused"))
+			},
+			expectedError: "executing request: connection refused",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := NewMockHTTPClient(t)
+			tc.setupMock(httpClient)
+
+			metadata, err := NewOEmbedClient(httpClient).Fetch(context.Background(), videoURL, tc.provider)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, metadata)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package videolink
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// metadataTimeout bounds how long adding a link waits on the provider for preview metadata.
+const metadataTimeout = 3 * time.Second
+
+var (
+	// ErrRaceNotFound is returned when attaching a video to a race the driver doesn't have.
+	ErrRaceNotFound = errors.New("race not found")
+	// ErrJournalEntryNotFound is returned when attaching a video to a race's journal entry before it has been written.
+	ErrJournalEntryNotFound = errors.New("journal entry not found")
+)
+
+// ValidateLap checks the lap a video is attached to.
+func ValidateLap(lap *int) []journal.FieldValidation {
+	if lap != nil && *lap < 0 {
+		return []journal.FieldValidation{{Field: "lap", Code: "out_of_range", Params: map[string]string{"min": "0"}}}
+	}
+	return nil
+}
+
+// Store defines the data access methods needed by the video link service.
+type Store interface {
+	GetDriverSession(ctx context.Context, driverID int64, startTime time.Time, opts ...store.ReadOption) (*store.DriverSession, error)
+	GetJournalEntry(ctx context.Context, driverID, raceID int64, opts ...store.ReadOption) (*store.RaceJournalEntry, error)
+	SaveVideoLink(ctx context.Context, link store.VideoLink) error
+	GetVideoLinks(ctx context.Context, driverID, raceID int64) ([]store.VideoLink, error)
+	DeleteVideoLink(ctx context.Context, driverID, raceID int64, linkID string) error
+}
+
+// MetadataFetcher looks up preview metadata for a video.
+type MetadataFetcher interface {
+	Fetch(ctx context.Context, videoURL string, provider Provider) (*Metadata, error)
+}
+
+// Service manages the external videos drivers attach to their races.
+type Service struct {
+	store    Store
+	metadata MetadataFetcher
+	newID    func() string
+	now      func() time.Time
+}
+
+// NewService creates a video link service. metadata may be nil, in which case links are saved without previews.
+func NewService(store Store, metadata MetadataFetcher, newID func() string) *Service {
+	return &Service{
+		store:    store,
+		metadata: metadata,
+		newID:    newID,
+		now:      time.Now,
+	}
+}
+
+// CreateInput describes a video to attach. Lap is nil to attach the video to the race's journal entry.
+type CreateInput struct {
+	DriverID int64
+	RaceID   int64
+	Lap      *int
+	URL      string
+}
+
+// Create normalizes and attaches a video link, capturing preview metadata from the provider when it has any. A
+// provider that can't be reached doesn't stop the link being saved, it's just saved without a preview. Returns
+// ErrRaceNotFound if the race isn't one of the driver's, or ErrJournalEntryNotFound when attaching to a journal entry
+// that doesn't exist.
+func (s *Service) Create(ctx context.Context, input CreateInput) (*store.VideoLink, error) {
+	normalized, provider, err := Normalize(input.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.store.GetDriverSession(ctx, input.DriverID, store.TimeFromDriverRaceID(input.RaceID))
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, ErrRaceNotFound
+	}
+	if input.Lap == nil {
+		entry, err := s.store.GetJournalEntry(ctx, input.DriverID, input.RaceID)
+		if err != nil {
+			return nil, err
+		}
+		if entry == nil {
+			return nil, ErrJournalEntryNotFound
+		}
+	}
+
+	link := store.VideoLink{
+		DriverID:  input.DriverID,
+		RaceID:    input.RaceID,
+		LinkID:    s.newID(),
+		Lap:       input.Lap,
+		URL:       normalized,
+		Provider:  string(provider),
+		CreatedAt: s.now(),
+	}
+	if s.metadata != nil {
+		fetchCtx, cancel := context.WithTimeout(ctx, metadataTimeout)
+		metadata, err := s.metadata.Fetch(fetchCtx, normalized, provider)
+		cancel()
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Str("url", normalized).Msg("failed to fetch video metadata, saving link without preview")
+		} else if metadata != nil {
+			link.Title = metadata.Title
+			link.AuthorName = metadata.AuthorName
+			link.ThumbnailURL = metadata.ThumbnailURL
+		}
+	}
+
+	if err := s.store.SaveVideoLink(ctx, link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// Delete removes a video link. Deleting a link that doesn't exist is not an error.
+func (s *Service) Delete(ctx context.Context, driverID, raceID int64, linkID string) error {
+	return s.store.DeleteVideoLink(ctx, driverID, raceID, linkID)
+}
+
+// List returns every video attached to a race, those on the journal entry first and then by lap, oldest first within
+// each.
+func (s *Service) List(ctx context.Context, driverID, raceID int64) ([]store.VideoLink, error) {
+	links, err := s.store.GetVideoLinks(ctx, driverID, raceID)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(links, func(a, b store.VideoLink) int {
+		return cmp.Or(
+			cmp.Compare(lapOrder(a.Lap), lapOrder(b.Lap)),
+			a.CreatedAt.Compare(b.CreatedAt),
+		)
+	})
+	return links, nil
+}
+
+// ForJournal returns the videos attached to a race's journal entry rather than to one of its laps.
+func (s *Service) ForJournal(ctx context.Context, driverID, raceID int64) ([]store.VideoLink, error) {
+	links, err := s.List(ctx, driverID, raceID)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(links, func(link store.VideoLink) bool {
+		return link.Lap != nil
+	}), nil
+}
+
+// ForLaps returns the videos attached to a race's laps, keyed by lap number.
+func (s *Service) ForLaps(ctx context.Context, driverID, raceID int64) (map[int][]store.VideoLink, error) {
+	links, err := s.List(ctx, driverID, raceID)
+	if err != nil {
+		return nil, err
+	}
+	byLap := make(map[int][]store.VideoLink)
+	for _, link := range links {
+		if link.Lap != nil {
+			byLap[*link.Lap] = append(byLap[*link.Lap], link)
+		}
+	}
+	return byLap, nil
+}
+
+// lapOrder sorts links on the journal entry ahead of those on a lap.
+func lapOrder(lap *int) int {
+	if lap == nil {
+		return -1
+	}
+	return *lap
+}
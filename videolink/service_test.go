@@ -0,0 +1,168 @@
+package videolink
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	now := time.Unix(1700100000, 0)
+	lap := 4
+	normalizedURL := "https://www.youtube.com/watch?v=abc123&t=95s"
+
+	expectRace := func(m *MockStore) {
+		m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).
+			Return(&store.DriverSession{DriverID: driverID}, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		input         CreateInput
+		setupMocks    func(*MockStore, *MockMetadataFetcher)
+		expected      *store.VideoLink
+		expectedErr   error
+		expectedError string
+	}{
+		{
+			name:  "on a lap with preview",
+			input: CreateInput{DriverID: driverID, RaceID: raceID, Lap: &lap, URL: "https://youtu.be/abc123?t=1m35s"},
+			setupMocks: func(m *MockStore, f *MockMetadataFetcher) {
+				expectRace(m)
+				f.EXPECT().Fetch(mock.Anything, normalizedURL, ProviderYouTube).
+					Return(&Metadata{Title: "Turn one chaos", AuthorName: "Some Streamer", ThumbnailURL: "https://i.ytimg.com/vi/abc123/hqdefault.jpg"}, nil)
+				m.EXPECT().SaveVideoLink(mock.Anything, mock.Anything).Return(nil)
+			},
+			expected: &store.VideoLink{
+				DriverID: driverID, RaceID: raceID, LinkID: "link-1", Lap: &lap, URL: normalizedURL, Provider: "youtube",
+				Title: "Turn one chaos", AuthorName: "Some Streamer", ThumbnailURL: "https://i.ytimg.com/vi/abc123/hqdefault.jpg", CreatedAt: now,
+			},
+		},
+		{
+			name:  "on the journal entry without preview when the provider fails",
+			input: CreateInput{DriverID: driverID, RaceID: raceID, URL: "https://youtu.be/abc123?t=95"},
+			setupMocks: func(m *MockStore, f *MockMetadataFetcher) {
+				expectRace(m)
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID).Return(&store.RaceJournalEntry{DriverID: driverID, RaceID: raceID}, nil)
+				f.EXPECT().Fetch(mock.Anything, normalizedURL, ProviderYouTube).Return(nil, errors.New("timeout"))
+				m.EXPECT().SaveVideoLink(mock.Anything, mock.Anything).Return(nil)
+			},
+			expected: &store.VideoLink{
+				DriverID: driverID, RaceID: raceID, LinkID: "link-1", URL: normalizedURL, Provider: "youtube", CreatedAt: now,
+			},
+		},
+		{
+			name:  "race not found",
+			input: CreateInput{DriverID: driverID, RaceID: raceID, Lap: &lap, URL: "https://youtu.be/abc123"},
+			setupMocks: func(m *MockStore, f *MockMetadataFetcher) {
+				m.EXPECT().GetDriverSession(mock.Anything, driverID, store.TimeFromDriverRaceID(raceID)).Return(nil, nil)
+			},
+			expectedErr: ErrRaceNotFound,
+		},
+		{
+			name:  "journal entry not found",
+			input: CreateInput{DriverID: driverID, RaceID: raceID, URL: "https://youtu.be/abc123"},
+			setupMocks: func(m *MockStore, f *MockMetadataFetcher) {
+				expectRace(m)
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID).Return(nil, nil)
+			},
+			expectedErr: ErrJournalEntryNotFound,
+		},
+		{
+			name:        "invalid url",
+			input:       CreateInput{DriverID: driverID, RaceID: raceID, URL: "mailto:someone@example.com"},
+			setupMocks:  func(m *MockStore, f *MockMetadataFetcher) {},
+			expectedErr: ErrInvalidURL,
+		},
+		{
+			name:  "save error",
+			input: CreateInput{DriverID: driverID, RaceID: raceID, Lap: &lap, URL: "https://example.com/clip"},
+			setupMocks: func(m *MockStore, f *MockMetadataFetcher) {
+				expectRace(m)
+				f.EXPECT().Fetch(mock.Anything, "https://example.com/clip", ProviderOther).Return(nil, nil)
+				m.EXPECT().SaveVideoLink(mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			expectedError: "database error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockFetcher := NewMockMetadataFetcher(t)
+			tc.setupMocks(mockStore, mockFetcher)
+
+			svc := NewService(mockStore, mockFetcher, func() string { return "link-1" })
+			svc.now = func() time.Time { return now }
+
+			result, err := svc.Create(ctx, tc.input)
+			switch {
+			case tc.expectedErr != nil:
+				assert.ErrorIs(t, err, tc.expectedErr)
+			case tc.expectedError != "":
+				assert.EqualError(t, err, tc.expectedError)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	early := time.Unix(1700000000, 0)
+	late := time.Unix(1700500000, 0)
+	lap := func(l int) *int { return &l }
+
+	links := []store.VideoLink{
+		{LinkID: "lap-5", Lap: lap(5), CreatedAt: early},
+		{LinkID: "journal-late", CreatedAt: late},
+		{LinkID: "lap-2-late", Lap: lap(2), CreatedAt: late},
+		{LinkID: "journal-early", CreatedAt: early},
+		{LinkID: "lap-2-early", Lap: lap(2), CreatedAt: early},
+	}
+	linkIDs := func(links []store.VideoLink) []string {
+		ids := make([]string, len(links))
+		for i, link := range links {
+			ids[i] = link.LinkID
+		}
+		return ids
+	}
+	newService := func(t *testing.T) *Service {
+		mockStore := NewMockStore(t)
+		mockStore.EXPECT().GetVideoLinks(mock.Anything, driverID, raceID).Return(append([]store.VideoLink(nil), links...), nil)
+		return NewService(mockStore, nil, nil)
+	}
+
+	t.Run("all", func(t *testing.T) {
+		result, err := newService(t).List(ctx, driverID, raceID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"journal-early", "journal-late", "lap-2-early", "lap-2-late", "lap-5"}, linkIDs(result))
+	})
+
+	t.Run("journal", func(t *testing.T) {
+		result, err := newService(t).ForJournal(ctx, driverID, raceID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"journal-early", "journal-late"}, linkIDs(result))
+	})
+
+	t.Run("laps", func(t *testing.T) {
+		result, err := newService(t).ForLaps(ctx, driverID, raceID)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, []string{"lap-2-early", "lap-2-late"}, linkIDs(result[2]))
+		assert.Equal(t, []string{"lap-5"}, linkIDs(result[5]))
+	})
+}
@@ -0,0 +1,68 @@
+package voicememo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	"github.com/aws/aws-sdk-go-v2/service/transcribe/types"
+)
+
+type TranscribeClient interface {
+	StartTranscriptionJob(ctx context.Context, params *transcribe.StartTranscriptionJobInput, optFns ...func(*transcribe.Options)) (*transcribe.StartTranscriptionJobOutput, error)
+}
+
+// AWSTranscriber transcribes memos with Amazon Transcribe, letting it identify the spoken language.
+type AWSTranscriber struct {
+	client TranscribeClient
+}
+
+func NewAWSTranscriber(client TranscribeClient) *AWSTranscriber {
+	return &AWSTranscriber{client: client}
+}
+
+func (t *AWSTranscriber) Start(ctx context.Context, job Job) error {
+	_, err := t.client.StartTranscriptionJob(ctx, &transcribe.StartTranscriptionJobInput{
+		TranscriptionJobName: aws.String(job.Name),
+		Media: &types.Media{
+			MediaFileUri: aws.String(fmt.Sprintf("s3://%s/%s", job.Bucket, job.MediaKey)),
+		},
+		MediaFormat:      types.MediaFormat(job.MediaFormat),
+		IdentifyLanguage: aws.Bool(true),
+		OutputBucketName: aws.String(job.Bucket),
+		OutputKey:        aws.String(job.OutputKey),
+	})
+	if err != nil {
+		// job names are unique per memo, so a conflict means this memo is already being transcribed
+		var conflictErr *types.ConflictException
+		if errors.As(err, &conflictErr) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+type awsTranscriptOutput struct {
+	Results struct {
+		Transcripts []struct {
+			Transcript string `json:"transcript"`
+		} `json:"transcripts"`
+	} `json:"results"`
+}
+
+func (t *AWSTranscriber) ParseTranscript(output []byte) (string, error) {
+	var parsed awsTranscriptOutput
+	if err := json.Unmarshal(output, &parsed); err != nil {
+		return "", fmt.Errorf("parsing transcript: %w", err)
+	}
+	texts := make([]string, 0, len(parsed.Results.Transcripts))
+	for _, t := range parsed.Results.Transcripts {
+		texts = append(texts, t.Transcript)
+	}
+	return strings.Join(texts, " "), nil
+}
@@ -0,0 +1,75 @@
+package voicememo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	"github.com/aws/aws-sdk-go-v2/service/transcribe/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAWSTranscriber_Start(t *testing.T) {
+	job := Job{
+		Name:        "memo.12345.1700000000.abc",
+		Bucket:      "memo-bucket",
+		MediaKey:    "memos/12345/1700000000/abc",
+		MediaFormat: "webm",
+		OutputKey:   "transcripts/12345/1700000000/abc.json",
+	}
+	expectedInput := &transcribe.StartTranscriptionJobInput{
+		TranscriptionJobName: aws.String("memo.12345.1700000000.abc"),
+		Media:                &types.Media{MediaFileUri: aws.String("s3://memo-bucket/memos/12345/1700000000/abc")},
+		MediaFormat:          types.MediaFormatWebm,
+		IdentifyLanguage:     aws.Bool(true),
+		OutputBucketName:     aws.String("memo-bucket"),
+		OutputKey:            aws.String("transcripts/12345/1700000000/abc.json"),
+	}
+
+	testCases := []struct {
+		name        string
+		clientErr   error
+		expectedErr bool
+	}{
+		{name: "started"},
+		{name: "already started", clientErr: &types.ConflictException{Message: aws.String("job exists")}},
+		{name: "client error", clientErr: errors.New("throttled"), expectedErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := NewMockTranscribeClient(t)
+			client.EXPECT().StartTranscriptionJob(mock.Anything, expectedInput).
+				Return(&transcribe.StartTranscriptionJobOutput{}, tc.clientErr)
+
+			err := NewAWSTranscriber(client).Start(context.Background(), job)
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAWSTranscriber_ParseTranscript(t *testing.T) {
+	transcriber := NewAWSTranscriber(nil)
+
+	text, err := transcriber.ParseTranscript([]byte(`{
+		"jobName": "memo.12345.1700000000.abc",
+		"status": "COMPLETED",
+		"results": {
+			"transcripts": [{"transcript": "Locked the rears into turn one."}, {"transcript": "Brake earlier."}],
+			"items": []
+		}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "Locked the rears into turn one. Brake earlier.", text)
+
+	_, err = transcriber.ParseTranscript([]byte("not json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,152 @@
+package voicememo
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/rs/zerolog"
+)
+
+// ProcessorStore defines the data access methods needed to track memos through transcription.
+type ProcessorStore interface {
+	GetJournalAttachment(ctx context.Context, driverID, raceID int64, attachmentID string) (*store.JournalAttachment, error)
+	SetJournalAttachmentStatus(ctx context.Context, driverID, raceID int64, attachmentID string, status store.JournalAttachmentStatus) (bool, error)
+	AppendJournalTranscript(ctx context.Context, driverID, raceID int64, transcript string, terms []string) (bool, error)
+}
+
+type ObjectReader interface {
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+}
+
+// Processor reacts to objects landing in the memo bucket: uploaded memos are sent for transcription if one was asked
+// for, and finished transcripts are appended to the memo's journal entry.
+type Processor struct {
+	store       ProcessorStore
+	objects     ObjectReader
+	transcriber Transcriber
+	bucket      string
+}
+
+func NewProcessor(store ProcessorStore, objects ObjectReader, transcriber Transcriber, bucket string) *Processor {
+	return &Processor{
+		store:       store,
+		objects:     objects,
+		transcriber: transcriber,
+		bucket:      bucket,
+	}
+}
+
+// HandleObjectCreated processes a newly written object in the memo bucket. Objects that aren't memos or transcripts,
+// such as the access check files transcribers leave behind, are ignored.
+func (p *Processor) HandleObjectCreated(ctx context.Context, key string) error {
+	if ref, ok := parseKeyRef(key, memoKeyPrefix, ""); ok {
+		return p.memoUploaded(ref.withTenant(ctx), ref)
+	}
+	if ref, ok := parseKeyRef(key, transcriptKeyPrefix, transcriptKeySuffix); ok {
+		return p.transcriptWritten(ref.withTenant(ctx), ref, key)
+	}
+	zerolog.Ctx(ctx).Debug().Str("key", key).Msg("ignoring object that is neither a memo nor a transcript")
+	return nil
+}
+
+// HandleTranscriptionFailed marks the memo behind a failed transcription job, since a failed job never writes a
+// transcript for HandleObjectCreated to pick up.
+func (p *Processor) HandleTranscriptionFailed(ctx context.Context, jobName, reason string) error {
+	ref, ok := parseJobName(jobName)
+	if !ok {
+		zerolog.Ctx(ctx).Debug().Str("jobName", jobName).Msg("ignoring transcription job that isn't for a memo")
+		return nil
+	}
+	zerolog.Ctx(ctx).Warn().Str("jobName", jobName).Str("reason", reason).Msg("memo transcription failed")
+	_, err := p.store.SetJournalAttachmentStatus(ref.withTenant(ctx), ref.driverID, ref.raceID, ref.attachmentID, store.JournalAttachmentTranscriptionFailed)
+	return err
+}
+
+func (p *Processor) memoUploaded(ctx context.Context, ref memoRef) error {
+	logger := zerolog.Ctx(ctx).With().Int64("driverId", ref.driverID).Int64("raceId", ref.raceID).Str("attachmentId", ref.attachmentID).Logger()
+
+	attachment, err := p.store.GetJournalAttachment(ctx, ref.driverID, ref.raceID, ref.attachmentID)
+	if err != nil {
+		return err
+	}
+	if attachment == nil {
+		logger.Warn().Msg("memo uploaded without an attachment record")
+		return nil
+	}
+	if attachment.Status != store.JournalAttachmentPendingUpload {
+		// a repeat delivery of the upload notification
+		return nil
+	}
+
+	if !attachment.Transcribe {
+		_, err := p.store.SetJournalAttachmentStatus(ctx, ref.driverID, ref.raceID, ref.attachmentID, store.JournalAttachmentUploaded)
+		return err
+	}
+
+	format, ok := mediaFormat(attachment.ContentType)
+	if !ok {
+		// content types are validated before upload URLs are handed out
+		return fmt.Errorf("attachment %s has unsupported content type %q", ref.attachmentID, attachment.ContentType)
+	}
+	err = p.transcriber.Start(ctx, Job{
+		Name:        ref.jobName(),
+		Bucket:      p.bucket,
+		MediaKey:    ref.memoKey(),
+		MediaFormat: format,
+		OutputKey:   ref.transcriptKey(),
+	})
+	if err != nil {
+		return fmt.Errorf("starting transcription: %w", err)
+	}
+	_, err = p.store.SetJournalAttachmentStatus(ctx, ref.driverID, ref.raceID, ref.attachmentID, store.JournalAttachmentTranscribing)
+	return err
+}
+
+func (p *Processor) transcriptWritten(ctx context.Context, ref memoRef, key string) error {
+	logger := zerolog.Ctx(ctx).With().Int64("driverId", ref.driverID).Int64("raceId", ref.raceID).Str("attachmentId", ref.attachmentID).Logger()
+
+	attachment, err := p.store.GetJournalAttachment(ctx, ref.driverID, ref.raceID, ref.attachmentID)
+	if err != nil {
+		return err
+	}
+	if attachment == nil {
+		logger.Warn().Msg("transcript written for a memo without an attachment record")
+		return nil
+	}
+	if attachment.Status == store.JournalAttachmentTranscribed {
+		// a repeat delivery, appending again would duplicate the transcript
+		return nil
+	}
+
+	obj, err := p.objects.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(p.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return err
+	}
+	defer obj.Body.Close()
+	output, err := io.ReadAll(obj.Body)
+	if err != nil {
+		return err
+	}
+	transcript, err := p.transcriber.ParseTranscript(output)
+	if err != nil {
+		return err
+	}
+
+	appended, err := p.store.AppendJournalTranscript(ctx, ref.driverID, ref.raceID, transcript, journal.SearchTerms(transcript))
+	if err != nil {
+		return err
+	}
+	if !appended {
+		logger.Info().Msg("journal entry deleted before its memo was transcribed, dropping transcript")
+	}
+	_, err = p.store.SetJournalAttachmentStatus(ctx, ref.driverID, ref.raceID, ref.attachmentID, store.JournalAttachmentTranscribed)
+	return err
+}
@@ -0,0 +1,203 @@
+package voicememo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProcessor_HandleObjectCreated(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	attachmentID := "abc"
+	memoKey := "memos/12345/1700000000/abc"
+	transcriptKey := "transcripts/12345/1700000000/abc.json"
+	attachment := func(status store.JournalAttachmentStatus, transcribe bool) *store.JournalAttachment {
+		return &store.JournalAttachment{
+			DriverID:     driverID,
+			RaceID:       raceID,
+			AttachmentID: attachmentID,
+			ContentType:  "audio/webm;codecs=opus",
+			Status:       status,
+			Transcribe:   transcribe,
+		}
+	}
+	transcriptObject := func() *s3.GetObjectOutput {
+		return &s3.GetObjectOutput{Body: io.NopCloser(strings.NewReader("transcript output"))}
+	}
+
+	testCases := []struct {
+		name             string
+		key              string
+		setupStore       func(*MockProcessorStore)
+		setupObjects     func(*MockObjectReader)
+		setupTranscriber func(*MockTranscriber)
+		expectErr        bool
+	}{
+		{
+			name: "memo uploaded without transcription",
+			key:  memoKey,
+			setupStore: func(m *MockProcessorStore) {
+				m.EXPECT().GetJournalAttachment(mock.Anything, driverID, raceID, attachmentID).
+					Return(attachment(store.JournalAttachmentPendingUpload, false), nil)
+				m.EXPECT().SetJournalAttachmentStatus(mock.Anything, driverID, raceID, attachmentID, store.JournalAttachmentUploaded).
+					Return(true, nil)
+			},
+		},
+		{
+			name: "memo uploaded with transcription",
+			key:  memoKey,
+			setupStore: func(m *MockProcessorStore) {
+				m.EXPECT().GetJournalAttachment(mock.Anything, driverID, raceID, attachmentID).
+					Return(attachment(store.JournalAttachmentPendingUpload, true), nil)
+				m.EXPECT().SetJournalAttachmentStatus(mock.Anything, driverID, raceID, attachmentID, store.JournalAttachmentTranscribing).
+					Return(true, nil)
+			},
+			setupTranscriber: func(m *MockTranscriber) {
+				m.EXPECT().Start(mock.Anything, Job{
+					Name:        "memo.12345.1700000000.abc",
+					Bucket:      "memo-bucket",
+					MediaKey:    memoKey,
+					MediaFormat: "webm",
+					OutputKey:   transcriptKey,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "transcription fails to start",
+			key:  memoKey,
+			setupStore: func(m *MockProcessorStore) {
+				m.EXPECT().GetJournalAttachment(mock.Anything, driverID, raceID, attachmentID).
+					Return(attachment(store.JournalAttachmentPendingUpload, true), nil)
+			},
+			setupTranscriber: func(m *MockTranscriber) {
+				m.EXPECT().Start(mock.Anything, mock.Anything).Return(errors.New("throttled"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "repeat upload notification ignored",
+			key:  memoKey,
+			setupStore: func(m *MockProcessorStore) {
+				m.EXPECT().GetJournalAttachment(mock.Anything, driverID, raceID, attachmentID).
+					Return(attachment(store.JournalAttachmentTranscribing, true), nil)
+			},
+		},
+		{
+			name: "memo without attachment record ignored",
+			key:  memoKey,
+			setupStore: func(m *MockProcessorStore) {
+				m.EXPECT().GetJournalAttachment(mock.Anything, driverID, raceID, attachmentID).Return(nil, nil)
+			},
+		},
+		{
+			name: "transcript appended",
+			key:  transcriptKey,
+			setupStore: func(m *MockProcessorStore) {
+				m.EXPECT().GetJournalAttachment(mock.Anything, driverID, raceID, attachmentID).
+					Return(attachment(store.JournalAttachmentTranscribing, true), nil)
+				m.EXPECT().AppendJournalTranscript(mock.Anything, driverID, raceID, "Brake earlier into turn one",
+					[]string{"brake", "earlier", "into", "one", "turn"}).Return(true, nil)
+				m.EXPECT().SetJournalAttachmentStatus(mock.Anything, driverID, raceID, attachmentID, store.JournalAttachmentTranscribed).
+					Return(true, nil)
+			},
+			setupObjects: func(m *MockObjectReader) {
+				m.EXPECT().GetObject(mock.Anything, mock.MatchedBy(func(in *s3.GetObjectInput) bool {
+					return *in.Bucket == "memo-bucket" && *in.Key == transcriptKey
+				})).Return(transcriptObject(), nil)
+			},
+			setupTranscriber: func(m *MockTranscriber) {
+				m.EXPECT().ParseTranscript([]byte("transcript output")).Return("Brake earlier into turn one", nil)
+			},
+		},
+		{
+			name: "transcript for deleted entry dropped",
+			key:  transcriptKey,
+			setupStore: func(m *MockProcessorStore) {
+				m.EXPECT().GetJournalAttachment(mock.Anything, driverID, raceID, attachmentID).
+					Return(attachment(store.JournalAttachmentTranscribing, true), nil)
+				m.EXPECT().AppendJournalTranscript(mock.Anything, driverID, raceID, "hi", []string{"hi"}).Return(false, nil)
+				m.EXPECT().SetJournalAttachmentStatus(mock.Anything, driverID, raceID, attachmentID, store.JournalAttachmentTranscribed).
+					Return(false, nil)
+			},
+			setupObjects: func(m *MockObjectReader) {
+				m.EXPECT().GetObject(mock.Anything, mock.Anything).Return(transcriptObject(), nil)
+			},
+			setupTranscriber: func(m *MockTranscriber) {
+				m.EXPECT().ParseTranscript(mock.Anything).Return("hi", nil)
+			},
+		},
+		{
+			name: "repeat transcript notification ignored",
+			key:  transcriptKey,
+			setupStore: func(m *MockProcessorStore) {
+				m.EXPECT().GetJournalAttachment(mock.Anything, driverID, raceID, attachmentID).
+					Return(attachment(store.JournalAttachmentTranscribed, true), nil)
+			},
+		},
+		{
+			name: "unparseable transcript",
+			key:  transcriptKey,
+			setupStore: func(m *MockProcessorStore) {
+				m.EXPECT().GetJournalAttachment(mock.Anything, driverID, raceID, attachmentID).
+					Return(attachment(store.JournalAttachmentTranscribing, true), nil)
+			},
+			setupObjects: func(m *MockObjectReader) {
+				m.EXPECT().GetObject(mock.Anything, mock.Anything).Return(transcriptObject(), nil)
+			},
+			setupTranscriber: func(m *MockTranscriber) {
+				m.EXPECT().ParseTranscript(mock.Anything).Return("", errors.New("bad transcript"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "access check file ignored",
+			key:  "transcripts/.write_access_check_file.temp",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockProcessorStore(t)
+			mockObjects := NewMockObjectReader(t)
+			mockTranscriber := NewMockTranscriber(t)
+			if tc.setupStore != nil {
+				tc.setupStore(mockStore)
+			}
+			if tc.setupObjects != nil {
+				tc.setupObjects(mockObjects)
+			}
+			if tc.setupTranscriber != nil {
+				tc.setupTranscriber(mockTranscriber)
+			}
+
+			err := NewProcessor(mockStore, mockObjects, mockTranscriber, "memo-bucket").HandleObjectCreated(ctx, tc.key)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProcessor_HandleTranscriptionFailed(t *testing.T) {
+	ctx := context.Background()
+
+	mockStore := NewMockProcessorStore(t)
+	mockStore.EXPECT().SetJournalAttachmentStatus(mock.Anything, int64(12345), int64(1700000000), "abc", store.JournalAttachmentTranscriptionFailed).
+		Return(true, nil)
+
+	processor := NewProcessor(mockStore, nil, nil, "memo-bucket")
+	assert.NoError(t, processor.HandleTranscriptionFailed(ctx, "memo.12345.1700000000.abc", "unsupported audio"))
+	// jobs that aren't ours are left alone
+	assert.NoError(t, processor.HandleTranscriptionFailed(ctx, "someone-elses-job", "unsupported audio"))
+}
@@ -0,0 +1,169 @@
+package voicememo
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jonsabados/saturdaysspinout/store"
+)
+
+const uploadURLLifetime = 15 * time.Minute
+const downloadURLLifetime = time.Hour
+
+// Store defines the data access methods needed to attach memos to journal entries.
+type Store interface {
+	GetJournalEntry(ctx context.Context, driverID, raceID int64, opts ...store.ReadOption) (*store.RaceJournalEntry, error)
+	SaveJournalAttachment(ctx context.Context, attachment store.JournalAttachment) error
+	GetJournalAttachments(ctx context.Context, driverID, raceID int64) ([]store.JournalAttachment, error)
+}
+
+// Presigner is satisfied by s3.PresignClient.
+type Presigner interface {
+	PresignPutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error)
+	PresignGetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error)
+}
+
+// Attachment is a memo attached to a journal entry. DownloadURL is only set once the memo has been uploaded.
+type Attachment struct {
+	AttachmentID string
+	ContentType  string
+	CreatedAt    time.Time
+	Status       store.JournalAttachmentStatus
+	Transcribe   bool
+	DownloadURL  string
+}
+
+// Upload is a memo attachment waiting on the client to PUT the audio to URL, sending Headers along with it.
+type Upload struct {
+	Attachment Attachment
+	URL        string
+	Headers    map[string]string
+	ExpiresAt  time.Time
+}
+
+// CreateUploadInput describes a memo about to be uploaded.
+type CreateUploadInput struct {
+	DriverID    int64
+	RaceID      int64
+	ContentType string
+	SizeBytes   int64
+	Transcribe  bool
+}
+
+// Service hands out presigned URLs for memo audio, which goes straight between the browser and S3.
+type Service struct {
+	store     Store
+	presigner Presigner
+	bucket    string
+	newID     func() string
+	now       func() time.Time
+}
+
+func NewService(store Store, presigner Presigner, bucket string, newID func() string) *Service {
+	return &Service{
+		store:     store,
+		presigner: presigner,
+		bucket:    bucket,
+		newID:     newID,
+		now:       time.Now,
+	}
+}
+
+// CreateUpload records a new memo attachment and returns where to upload it. Returns ErrJournalEntryNotFound if the
+// race has no journal entry. Callers should validate the input with ValidateUpload before calling CreateUpload.
+func (s *Service) CreateUpload(ctx context.Context, input CreateUploadInput) (*Upload, error) {
+	entry, err := s.store.GetJournalEntry(ctx, input.DriverID, input.RaceID)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, ErrJournalEntryNotFound
+	}
+
+	attachment := store.JournalAttachment{
+		DriverID:     input.DriverID,
+		RaceID:       input.RaceID,
+		AttachmentID: s.newID(),
+		ContentType:  input.ContentType,
+		CreatedAt:    s.now(),
+		Status:       store.JournalAttachmentPendingUpload,
+		Transcribe:   input.Transcribe,
+	}
+	ref := newMemoRef(ctx, attachment)
+
+	// the content type and length are signed, so the upload can't be swapped for something else or something bigger
+	presigned, err := s.presigner.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(ref.memoKey()),
+		ContentType:   aws.String(input.ContentType),
+		ContentLength: aws.Int64(input.SizeBytes),
+	}, s3.WithPresignExpires(uploadURLLifetime))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.SaveJournalAttachment(ctx, attachment); err != nil {
+		return nil, err
+	}
+
+	return &Upload{
+		Attachment: attachmentFromStore(attachment, ""),
+		URL:        presigned.URL,
+		Headers:    uploadHeaders(presigned.SignedHeader),
+		ExpiresAt:  attachment.CreatedAt.Add(uploadURLLifetime),
+	}, nil
+}
+
+// List returns the memos attached to a race's journal entry, with links to download those that have been uploaded.
+func (s *Service) List(ctx context.Context, driverID, raceID int64) ([]Attachment, error) {
+	attachments, err := s.store.GetJournalAttachments(ctx, driverID, raceID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		var downloadURL string
+		if attachment.Status != store.JournalAttachmentPendingUpload {
+			ref := newMemoRef(ctx, attachment)
+			presigned, err := s.presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+				Bucket: aws.String(s.bucket),
+				Key:    aws.String(ref.memoKey()),
+			}, s3.WithPresignExpires(downloadURLLifetime))
+			if err != nil {
+				return nil, err
+			}
+			downloadURL = presigned.URL
+		}
+		results = append(results, attachmentFromStore(attachment, downloadURL))
+	}
+	return results, nil
+}
+
+func attachmentFromStore(attachment store.JournalAttachment, downloadURL string) Attachment {
+	return Attachment{
+		AttachmentID: attachment.AttachmentID,
+		ContentType:  attachment.ContentType,
+		CreatedAt:    attachment.CreatedAt,
+		Status:       attachment.Status,
+		Transcribe:   attachment.Transcribe,
+		DownloadURL:  downloadURL,
+	}
+}
+
+// uploadHeaders are the signed headers the client has to send itself. Browsers set Host on their own and refuse to
+// let scripts set it.
+func uploadHeaders(signed http.Header) map[string]string {
+	headers := make(map[string]string, len(signed))
+	for name := range signed {
+		if http.CanonicalHeaderKey(name) == "Host" {
+			continue
+		}
+		headers[http.CanonicalHeaderKey(name)] = signed.Get(name)
+	}
+	return headers
+}
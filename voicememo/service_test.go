@@ -0,0 +1,171 @@
+package voicememo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_CreateUpload(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	now := time.Unix(1700003600, 0)
+	attachmentID := "3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a"
+	input := CreateUploadInput{
+		DriverID:    driverID,
+		RaceID:      raceID,
+		ContentType: "audio/webm",
+		SizeBytes:   4096,
+		Transcribe:  true,
+	}
+	expectedAttachment := store.JournalAttachment{
+		DriverID:     driverID,
+		RaceID:       raceID,
+		AttachmentID: attachmentID,
+		ContentType:  "audio/webm",
+		CreatedAt:    now,
+		Status:       store.JournalAttachmentPendingUpload,
+		Transcribe:   true,
+	}
+	matchesPut := mock.MatchedBy(func(in *s3.PutObjectInput) bool {
+		return *in.Bucket == "memo-bucket" &&
+			*in.Key == "memos/12345/1700000000/"+attachmentID &&
+			*in.ContentType == "audio/webm" &&
+			*in.ContentLength == 4096
+	})
+
+	testCases := []struct {
+		name           string
+		setupStore     func(*MockStore)
+		setupPresigner func(*MockPresigner)
+		expected       *Upload
+		expectedErr    error
+		expectErr      bool
+	}{
+		{
+			name: "upload created",
+			setupStore: func(m *MockStore) {
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID).
+					Return(&store.RaceJournalEntry{DriverID: driverID, RaceID: raceID}, nil)
+				m.EXPECT().SaveJournalAttachment(mock.Anything, expectedAttachment).Return(nil)
+			},
+			setupPresigner: func(m *MockPresigner) {
+				m.EXPECT().PresignPutObject(mock.Anything, matchesPut, mock.Anything).
+					Return(&v4.PresignedHTTPRequest{
+						URL:    "https://memo-bucket.s3.amazonaws.com/memos/12345/1700000000/" + attachmentID + "?X-Amz-Signature=abc",
+						Method: http.MethodPut,
+						SignedHeader: http.Header{
+							"Host":           []string{"memo-bucket.s3.amazonaws.com"},
+							"Content-Type":   []string{"audio/webm"},
+							"Content-Length": []string{"4096"},
+						},
+					}, nil)
+			},
+			expected: &Upload{
+				Attachment: Attachment{
+					AttachmentID: attachmentID,
+					ContentType:  "audio/webm",
+					CreatedAt:    now,
+					Status:       store.JournalAttachmentPendingUpload,
+					Transcribe:   true,
+				},
+				URL: "https://memo-bucket.s3.amazonaws.com/memos/12345/1700000000/" + attachmentID + "?X-Amz-Signature=abc",
+				Headers: map[string]string{
+					"Content-Type":   "audio/webm",
+					"Content-Length": "4096",
+				},
+				ExpiresAt: now.Add(15 * time.Minute),
+			},
+		},
+		{
+			name: "no journal entry",
+			setupStore: func(m *MockStore) {
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID).Return(nil, nil)
+			},
+			setupPresigner: func(m *MockPresigner) {},
+			expectedErr:    ErrJournalEntryNotFound,
+		},
+		{
+			name: "presign error",
+			setupStore: func(m *MockStore) {
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID).
+					Return(&store.RaceJournalEntry{DriverID: driverID, RaceID: raceID}, nil)
+			},
+			setupPresigner: func(m *MockPresigner) {
+				m.EXPECT().PresignPutObject(mock.Anything, matchesPut, mock.Anything).Return(nil, errors.New("no credentials"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "save error",
+			setupStore: func(m *MockStore) {
+				m.EXPECT().GetJournalEntry(mock.Anything, driverID, raceID).
+					Return(&store.RaceJournalEntry{DriverID: driverID, RaceID: raceID}, nil)
+				m.EXPECT().SaveJournalAttachment(mock.Anything, expectedAttachment).Return(errors.New("database error"))
+			},
+			setupPresigner: func(m *MockPresigner) {
+				m.EXPECT().PresignPutObject(mock.Anything, matchesPut, mock.Anything).
+					Return(&v4.PresignedHTTPRequest{URL: "https://example.com"}, nil)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockStore(t)
+			mockPresigner := NewMockPresigner(t)
+			tc.setupStore(mockStore)
+			tc.setupPresigner(mockPresigner)
+
+			svc := NewService(mockStore, mockPresigner, "memo-bucket", func() string { return attachmentID })
+			svc.now = func() time.Time { return now }
+
+			result, err := svc.CreateUpload(ctx, input)
+			switch {
+			case tc.expectedErr != nil:
+				assert.ErrorIs(t, err, tc.expectedErr)
+			case tc.expectErr:
+				assert.Error(t, err)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	ctx := context.Background()
+	driverID := int64(12345)
+	raceID := int64(1700000000)
+	createdAt := time.Unix(1700003600, 0)
+
+	mockStore := NewMockStore(t)
+	mockPresigner := NewMockPresigner(t)
+	mockStore.EXPECT().GetJournalAttachments(mock.Anything, driverID, raceID).Return([]store.JournalAttachment{
+		{DriverID: driverID, RaceID: raceID, AttachmentID: "a", ContentType: "audio/webm", CreatedAt: createdAt, Status: store.JournalAttachmentTranscribed, Transcribe: true},
+		{DriverID: driverID, RaceID: raceID, AttachmentID: "b", ContentType: "audio/mpeg", CreatedAt: createdAt, Status: store.JournalAttachmentPendingUpload},
+	}, nil)
+	mockPresigner.EXPECT().PresignGetObject(mock.Anything, mock.MatchedBy(func(in *s3.GetObjectInput) bool {
+		return *in.Bucket == "memo-bucket" && *in.Key == "memos/12345/1700000000/a"
+	}), mock.Anything).Return(&v4.PresignedHTTPRequest{URL: "https://example.com/a"}, nil)
+
+	svc := NewService(mockStore, mockPresigner, "memo-bucket", nil)
+	result, err := svc.List(ctx, driverID, raceID)
+	require.NoError(t, err)
+	assert.Equal(t, []Attachment{
+		{AttachmentID: "a", ContentType: "audio/webm", CreatedAt: createdAt, Status: store.JournalAttachmentTranscribed, Transcribe: true, DownloadURL: "https://example.com/a"},
+		{AttachmentID: "b", ContentType: "audio/mpeg", CreatedAt: createdAt, Status: store.JournalAttachmentPendingUpload},
+	}, result)
+}
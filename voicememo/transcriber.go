@@ -0,0 +1,20 @@
+package voicememo
+
+import "context"
+
+// Job describes a memo to transcribe. Both the memo and the transcript live in the memo bucket.
+type Job struct {
+	Name        string
+	Bucket      string
+	MediaKey    string
+	MediaFormat string // mp3, mp4, m4a, wav, flac, ogg, amr or webm
+	OutputKey   string
+}
+
+// Transcriber turns memos into text. Transcription is asynchronous: Start kicks it off, and the transcriber writes its
+// output to the job's OutputKey, which is handed back to ParseTranscript once it lands. Starting a job that has
+// already been started must not fail, since memo uploads can be delivered more than once.
+type Transcriber interface {
+	Start(ctx context.Context, job Job) error
+	ParseTranscript(output []byte) (string, error)
+}
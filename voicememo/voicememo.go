@@ -0,0 +1,165 @@
+package voicememo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/jonsabados/saturdaysspinout/journal"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+)
+
+// MaxUploadBytes caps the size of a single memo, which is signed into the upload URL so S3 enforces it.
+const MaxUploadBytes = 25 * 1024 * 1024
+
+// ErrJournalEntryNotFound is returned when attaching a memo to a race that has no journal entry.
+var ErrJournalEntryNotFound = errors.New("journal entry not found")
+
+// mediaFormats maps the audio types we accept to the media format passed to transcribers.
+var mediaFormats = map[string]string{
+	"audio/webm":  "webm",
+	"audio/ogg":   "ogg",
+	"audio/mpeg":  "mp3",
+	"audio/mp4":   "mp4",
+	"audio/x-m4a": "m4a",
+	"audio/wav":   "wav",
+	"audio/x-wav": "wav",
+	"audio/flac":  "flac",
+	"audio/amr":   "amr",
+}
+
+// mediaFormat returns the transcriber media format for a content type, ignoring parameters such as the codec browsers
+// add when recording ("audio/webm;codecs=opus").
+func mediaFormat(contentType string) (string, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", false
+	}
+	format, ok := mediaFormats[mediaType]
+	return format, ok
+}
+
+// ValidateUpload checks a requested memo upload. Returns validation errors for an unsupported content type or size.
+func ValidateUpload(contentType string, sizeBytes int64) []journal.FieldValidation {
+	var errs []journal.FieldValidation
+	if _, ok := mediaFormat(contentType); !ok {
+		errs = append(errs, journal.FieldValidation{
+			Field: "contentType",
+			Code:  "unsupported_content_type",
+		})
+	}
+	if sizeBytes < 1 || sizeBytes > MaxUploadBytes {
+		errs = append(errs, journal.FieldValidation{
+			Field:  "sizeBytes",
+			Code:   "out_of_range",
+			Params: map[string]string{"max": strconv.Itoa(MaxUploadBytes)},
+		})
+	}
+	return errs
+}
+
+const memoKeyPrefix = "memos/"
+const transcriptKeyPrefix = "transcripts/"
+const transcriptKeySuffix = ".json"
+
+const jobNamePrefix = "memo"
+
+// memoRef identifies an attachment by the IDs that make up its object keys and transcription job name. Attachments of
+// tenants other than the default have their tenant ahead of the driver in keys, and appended to the job name prefix.
+type memoRef struct {
+	tenantID     string
+	driverID     int64
+	raceID       int64
+	attachmentID string
+}
+
+func newMemoRef(ctx context.Context, attachment store.JournalAttachment) memoRef {
+	return memoRef{
+		tenantID:     tenant.FromContext(ctx),
+		driverID:     attachment.DriverID,
+		raceID:       attachment.RaceID,
+		attachmentID: attachment.AttachmentID,
+	}
+}
+
+func (r memoRef) keyPrefix(prefix string) string {
+	if r.tenantID == tenant.Default {
+		return prefix
+	}
+	return prefix + r.tenantID + "/"
+}
+
+func (r memoRef) memoKey() string {
+	return fmt.Sprintf("%s%d/%d/%s", r.keyPrefix(memoKeyPrefix), r.driverID, r.raceID, r.attachmentID)
+}
+
+func (r memoRef) transcriptKey() string {
+	return fmt.Sprintf("%s%d/%d/%s%s", r.keyPrefix(transcriptKeyPrefix), r.driverID, r.raceID, r.attachmentID, transcriptKeySuffix)
+}
+
+// jobName is unique per attachment, which makes starting a transcription idempotent. Transcription job names may
+// only contain letters, digits, dots, underscores and hyphens.
+func (r memoRef) jobName() string {
+	prefix := jobNamePrefix
+	if r.tenantID != tenant.Default {
+		prefix += "-" + r.tenantID
+	}
+	return fmt.Sprintf("%s.%d.%d.%s", prefix, r.driverID, r.raceID, r.attachmentID)
+}
+
+// withTenant puts the attachment's tenant on the context, for working with it from outside a request
+func (r memoRef) withTenant(ctx context.Context) context.Context {
+	return tenant.WithID(ctx, r.tenantID)
+}
+
+func parseMemoRef(tenantID, driverID, raceID, attachmentID string) (memoRef, bool) {
+	if tenantID != tenant.Default && !tenant.Valid(tenantID) {
+		return memoRef{}, false
+	}
+	d, err := strconv.ParseInt(driverID, 10, 64)
+	if err != nil {
+		return memoRef{}, false
+	}
+	r, err := strconv.ParseInt(raceID, 10, 64)
+	if err != nil {
+		return memoRef{}, false
+	}
+	if attachmentID == "" {
+		return memoRef{}, false
+	}
+	return memoRef{tenantID: tenantID, driverID: d, raceID: r, attachmentID: attachmentID}, true
+}
+
+func parseKeyRef(key, prefix, suffix string) (memoRef, bool) {
+	if !strings.HasPrefix(key, prefix) || !strings.HasSuffix(key, suffix) {
+		return memoRef{}, false
+	}
+	parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(key, prefix), suffix), "/")
+	switch len(parts) {
+	case 3:
+		return parseMemoRef(tenant.Default, parts[0], parts[1], parts[2])
+	case 4:
+		return parseMemoRef(parts[0], parts[1], parts[2], parts[3])
+	default:
+		return memoRef{}, false
+	}
+}
+
+func parseJobName(name string) (memoRef, bool) {
+	parts := strings.SplitN(name, ".", 4)
+	if len(parts) != 4 {
+		return memoRef{}, false
+	}
+	if parts[0] == jobNamePrefix {
+		return parseMemoRef(tenant.Default, parts[1], parts[2], parts[3])
+	}
+	tenantID, ok := strings.CutPrefix(parts[0], jobNamePrefix+"-")
+	if !ok || tenantID == tenant.Default {
+		return memoRef{}, false
+	}
+	return parseMemoRef(tenantID, parts[1], parts[2], parts[3])
+}
@@ -0,0 +1,101 @@
+package voicememo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUpload(t *testing.T) {
+	testCases := []struct {
+		name          string
+		contentType   string
+		sizeBytes     int64
+		expectedCodes []string
+	}{
+		{name: "webm", contentType: "audio/webm", sizeBytes: 1024},
+		{name: "content type parameters ignored", contentType: "audio/webm;codecs=opus", sizeBytes: 1024},
+		{name: "at max size", contentType: "audio/mpeg", sizeBytes: MaxUploadBytes},
+		{name: "unsupported content type", contentType: "video/mp4", sizeBytes: 1024, expectedCodes: []string{"unsupported_content_type"}},
+		{name: "malformed content type", contentType: "audio/", sizeBytes: 1024, expectedCodes: []string{"unsupported_content_type"}},
+		{name: "empty", contentType: "audio/ogg", sizeBytes: 0, expectedCodes: []string{"out_of_range"}},
+		{name: "too big", contentType: "audio/ogg", sizeBytes: MaxUploadBytes + 1, expectedCodes: []string{"out_of_range"}},
+		{name: "everything wrong", contentType: "text/plain", sizeBytes: -1, expectedCodes: []string{"unsupported_content_type", "out_of_range"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateUpload(tc.contentType, tc.sizeBytes)
+			var codes []string
+			for _, e := range errs {
+				codes = append(codes, e.Code)
+			}
+			assert.Equal(t, tc.expectedCodes, codes)
+		})
+	}
+}
+
+func TestMemoRef_RoundTrip(t *testing.T) {
+	ref := memoRef{driverID: 12345, raceID: 1700000000, attachmentID: "3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a"}
+
+	assert.Equal(t, "memos/12345/1700000000/3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a", ref.memoKey())
+	assert.Equal(t, "transcripts/12345/1700000000/3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a.json", ref.transcriptKey())
+	assert.Equal(t, "memo.12345.1700000000.3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a", ref.jobName())
+
+	parsed, ok := parseKeyRef(ref.memoKey(), memoKeyPrefix, "")
+	assert.True(t, ok)
+	assert.Equal(t, ref, parsed)
+
+	parsed, ok = parseKeyRef(ref.transcriptKey(), transcriptKeyPrefix, transcriptKeySuffix)
+	assert.True(t, ok)
+	assert.Equal(t, ref, parsed)
+
+	parsed, ok = parseJobName(ref.jobName())
+	assert.True(t, ok)
+	assert.Equal(t, ref, parsed)
+}
+
+func TestMemoRef_RoundTripTenant(t *testing.T) {
+	ref := memoRef{tenantID: "league-one", driverID: 12345, raceID: 1700000000, attachmentID: "3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a"}
+
+	assert.Equal(t, "memos/league-one/12345/1700000000/3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a", ref.memoKey())
+	assert.Equal(t, "transcripts/league-one/12345/1700000000/3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a.json", ref.transcriptKey())
+	assert.Equal(t, "memo-league-one.12345.1700000000.3f1c2a9e-8d4b-4c6f-9a2e-1b7d5e0c4f8a", ref.jobName())
+
+	parsed, ok := parseKeyRef(ref.memoKey(), memoKeyPrefix, "")
+	assert.True(t, ok)
+	assert.Equal(t, ref, parsed)
+
+	parsed, ok = parseKeyRef(ref.transcriptKey(), transcriptKeyPrefix, transcriptKeySuffix)
+	assert.True(t, ok)
+	assert.Equal(t, ref, parsed)
+
+	parsed, ok = parseJobName(ref.jobName())
+	assert.True(t, ok)
+	assert.Equal(t, ref, parsed)
+}
+
+func TestParseKeyRef_Invalid(t *testing.T) {
+	testCases := []struct {
+		name   string
+		key    string
+		prefix string
+		suffix string
+	}{
+		{name: "wrong prefix", key: "other/12345/1700000000/abc", prefix: memoKeyPrefix},
+		{name: "too few parts", key: "memos/12345/abc", prefix: memoKeyPrefix},
+		{name: "too many parts", key: "memos/league-one/12345/1700000000/abc/def", prefix: memoKeyPrefix},
+		{name: "invalid tenant", key: "memos/League_One/12345/1700000000/abc", prefix: memoKeyPrefix},
+		{name: "non-numeric driver", key: "memos/bob/1700000000/abc", prefix: memoKeyPrefix},
+		{name: "empty attachment", key: "memos/12345/1700000000/", prefix: memoKeyPrefix},
+		{name: "transcribe access check file", key: "transcripts/.write_access_check_file.temp", prefix: transcriptKeyPrefix, suffix: transcriptKeySuffix},
+		{name: "transcript missing suffix", key: "transcripts/12345/1700000000/abc", prefix: transcriptKeyPrefix, suffix: transcriptKeySuffix},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ok := parseKeyRef(tc.key, tc.prefix, tc.suffix)
+			assert.False(t, ok)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package ws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewaymanagementapi"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewaymanagementapi/types"
+)
+
+type APIGatewayManagementClient interface {
+	PostToConnection(ctx context.Context, params *apigatewaymanagementapi.PostToConnectionInput, optFns ...func(*apigatewaymanagementapi.Options)) (*apigatewaymanagementapi.PostToConnectionOutput, error)
+	DeleteConnection(ctx context.Context, params *apigatewaymanagementapi.DeleteConnectionInput, optFns ...func(*apigatewaymanagementapi.Options)) (*apigatewaymanagementapi.DeleteConnectionOutput, error)
+}
+
+// APIGatewayTransport sends to connections held by an API Gateway WebSocket API.
+type APIGatewayTransport struct {
+	client APIGatewayManagementClient
+}
+
+func NewAPIGatewayTransport(client APIGatewayManagementClient) *APIGatewayTransport {
+	return &APIGatewayTransport{
+		client: client,
+	}
+}
+
+func (t *APIGatewayTransport) Send(ctx context.Context, connectionID string, data []byte) error {
+	_, err := t.client.PostToConnection(ctx, &apigatewaymanagementapi.PostToConnectionInput{
+		ConnectionId: aws.String(connectionID),
+		Data:         data,
+	})
+	return mapGoneError(err)
+}
+
+func (t *APIGatewayTransport) Close(ctx context.Context, connectionID string) error {
+	_, err := t.client.DeleteConnection(ctx, &apigatewaymanagementapi.DeleteConnectionInput{
+		ConnectionId: aws.String(connectionID),
+	})
+	return mapGoneError(err)
+}
+
+func mapGoneError(err error) error {
+	var goneErr *types.GoneException
+	if errors.As(err, &goneErr) {
+		return ErrConnectionGone
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewaymanagementapi"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewaymanagementapi/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAPIGatewayTransport_Send(t *testing.T) {
+	testCases := []struct {
+		name        string
+		clientErr   error
+		expectedErr error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:        "gone",
+			clientErr:   &types.GoneException{Message: aws.String("connection gone")},
+			expectedErr: ErrConnectionGone,
+		},
+		{
+			name:        "other error",
+			clientErr:   errors.New("network error"),
+			expectedErr: errors.New("network error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := NewMockAPIGatewayManagementClient(t)
+			mockClient.EXPECT().PostToConnection(mock.Anything, &apigatewaymanagementapi.PostToConnectionInput{
+				ConnectionId: aws.String("conn-123"),
+				Data:         []byte("data"),
+			}).Return(&apigatewaymanagementapi.PostToConnectionOutput{}, tc.clientErr)
+
+			err := NewAPIGatewayTransport(mockClient).Send(context.Background(), "conn-123", []byte("data"))
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAPIGatewayTransport_Close(t *testing.T) {
+	testCases := []struct {
+		name        string
+		clientErr   error
+		expectedErr error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:        "gone",
+			clientErr:   &types.GoneException{Message: aws.String("connection gone")},
+			expectedErr: ErrConnectionGone,
+		},
+		{
+			name:        "other error",
+			clientErr:   errors.New("network error"),
+			expectedErr: errors.New("network error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := NewMockAPIGatewayManagementClient(t)
+			mockClient.EXPECT().DeleteConnection(mock.Anything, &apigatewaymanagementapi.DeleteConnectionInput{
+				ConnectionId: aws.String("conn-123"),
+			}).Return(&apigatewaymanagementapi.DeleteConnectionOutput{}, tc.clientErr)
+
+			err := NewAPIGatewayTransport(mockClient).Close(context.Background(), "conn-123")
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -81,6 +81,7 @@ func NewHandler(validator JWTValidator, pusher Pusher, connStore ConnectionStore
 		err = connStore.SaveConnection(ctx, store.WebSocketConnection{
 			DriverID:     sessionClaims.IRacingUserID,
 			ConnectionID: connectionID,
+			TenantID:     sessionClaims.TenantID,
 		})
 		if err != nil {
 			logger.Error().Err(err).Msg("failed to save connection")
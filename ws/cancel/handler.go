@@ -0,0 +1,91 @@
+package cancel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+	"github.com/jonsabados/saturdaysspinout/ws"
+	"github.com/rs/zerolog"
+)
+
+const responseAction = "cancelIngestionResponse"
+
+type Response struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+type Pusher interface {
+	Push(ctx context.Context, connectionID string, actionType string, payload any) (bool, error)
+	Disconnect(ctx context.Context, connectionID string)
+}
+
+type ConnectionStore interface {
+	GetConnection(ctx context.Context, driverID int64, connectionID string) (*store.WebSocketConnection, error)
+	RequestIngestionCancel(ctx context.Context, driverID int64) error
+}
+
+// NewHandler flags the authenticated driver's in-flight ingestion for cancellation. The race processor picks the flag up
+// between races and announces the stop with an ingestionCancelled message, so the response here only confirms the
+// request was recorded.
+func NewHandler(pusher Pusher, connectionStore ConnectionStore) ws.RouteHandler {
+	return ws.RouteHandlerFunc(func(ctx context.Context, request events.APIGatewayWebsocketProxyRequest) (events.APIGatewayProxyResponse, error) {
+		logger := zerolog.Ctx(ctx)
+		connectionID := request.RequestContext.ConnectionID
+
+		var msg ws.AuthenticatedMessage
+		if err := json.Unmarshal([]byte(request.Body), &msg); err != nil {
+			logger.Warn().Err(err).Msg("failed to parse cancel ingestion request")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "invalid payload"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusBadRequest}, nil
+		}
+
+		if msg.DriverID == 0 {
+			logger.Warn().Msg("missing driverId in cancel ingestion request")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "missing driverId"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusBadRequest}, nil
+		}
+
+		// Verify connection is authenticated for this driver
+		conn, err := connectionStore.GetConnection(ctx, msg.DriverID, connectionID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get connection")
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}, err
+		}
+		// overlay connections were opened with an overlay's token, which only lets them listen
+		if conn == nil || conn.OverlayToken != "" {
+			logger.Warn().Int64("driverId", msg.DriverID).Msg("connection not found for driver, disconnecting")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "not authenticated"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			pusher.Disconnect(ctx, connectionID)
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusForbidden}, nil
+		}
+		// the rest is done in the tenant the connection was authenticated for
+		ctx = tenant.WithID(ctx, conn.TenantID)
+
+		if err := connectionStore.RequestIngestionCancel(ctx, msg.DriverID); err != nil {
+			logger.Error().Err(err).Int64("driverId", msg.DriverID).Msg("failed to request ingestion cancel")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "internal error"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}, err
+		}
+
+		logger.Info().Int64("driverId", msg.DriverID).Msg("ingestion cancel requested")
+		if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: true, Message: "cancel requested"}); err != nil {
+			logger.Error().Err(err).Msg("error pushing message")
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}, err
+		}
+
+		return events.APIGatewayProxyResponse{StatusCode: http.StatusOK}, nil
+	})
+}
@@ -28,13 +28,19 @@ type Handler struct {
 	disconnectHandler RouteHandler
 	authHandler       RouteHandler
 	pingHandler       RouteHandler
+	cancelHandler     RouteHandler
+	presenceHandler   RouteHandler
+	overlayHandler    RouteHandler
 }
 
-func NewHandler(disconnectHandler, authHandler, pingHandler RouteHandler) *Handler {
+func NewHandler(disconnectHandler, authHandler, pingHandler, cancelHandler, presenceHandler, overlayHandler RouteHandler) *Handler {
 	return &Handler{
 		disconnectHandler: disconnectHandler,
 		authHandler:       authHandler,
 		pingHandler:       pingHandler,
+		cancelHandler:     cancelHandler,
+		presenceHandler:   presenceHandler,
+		overlayHandler:    overlayHandler,
 	}
 }
 
@@ -59,6 +65,12 @@ func (h *Handler) Handle(ctx context.Context, request events.APIGatewayWebsocket
 		return h.authHandler.HandleRequest(ctx, request)
 	case "pingRequest":
 		return h.pingHandler.HandleRequest(ctx, request)
+	case "cancelIngestion":
+		return h.cancelHandler.HandleRequest(ctx, request)
+	case "racingHeartbeat":
+		return h.presenceHandler.HandleRequest(ctx, request)
+	case "overlaySubscribe":
+		return h.overlayHandler.HandleRequest(ctx, request)
 	case "$default":
 		return h.handleDefault(ctx, request)
 	default:
@@ -0,0 +1,162 @@
+// Package native serves WebSocket connections directly rather than through API Gateway, for running outside AWS. It
+// turns connects, disconnects and messages into the same proxy events API Gateway would send the websocket lambda, so
+// the ws route handlers work unchanged, and is the ws.Transport used to push messages back to its clients.
+package native
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/rs/zerolog"
+	"golang.org/x/net/websocket"
+
+	"github.com/jonsabados/saturdaysspinout/ws"
+)
+
+// EventHandler is satisfied by ws.Handler
+type EventHandler interface {
+	Handle(ctx context.Context, request events.APIGatewayWebsocketProxyRequest) (events.APIGatewayProxyResponse, error)
+}
+
+type IDGenerator func() string
+
+type connection struct {
+	conn *websocket.Conn
+	// x/net/websocket doesn't support concurrent writers
+	writeMu sync.Mutex
+}
+
+type Server struct {
+	logger      zerolog.Logger
+	idGenerator IDGenerator
+	routeKeys   map[string]bool
+
+	mu          sync.Mutex
+	connections map[string]*connection
+}
+
+// NewServer creates a server routing messages whose action is one of routeKeys to that route, and everything else to
+// $default, mirroring the "$request.body.action" route selection expression in terraform/websockets.tf.
+func NewServer(logger zerolog.Logger, idGenerator IDGenerator, routeKeys ...string) *Server {
+	routes := make(map[string]bool, len(routeKeys))
+	for _, key := range routeKeys {
+		routes[key] = true
+	}
+	return &Server{
+		logger:      logger,
+		idGenerator: idGenerator,
+		routeKeys:   routes,
+		connections: make(map[string]*connection),
+	}
+}
+
+// Handler returns the http.Handler clients connect to. The event handler is supplied here rather than to NewServer
+// since it will normally push messages through this same Server.
+func (s *Server) Handler(eventHandler EventHandler) http.Handler {
+	return websocket.Server{
+		// API Gateway doesn't restrict origins either, auth happens via the auth action after connecting
+		Handshake: func(*websocket.Config, *http.Request) error {
+			return nil
+		},
+		Handler: func(conn *websocket.Conn) {
+			s.serve(conn, eventHandler)
+		},
+	}
+}
+
+func (s *Server) serve(conn *websocket.Conn, eventHandler EventHandler) {
+	connectionID := s.idGenerator()
+	logger := s.logger.With().Str("connectionID", connectionID).Logger()
+	ctx := logger.WithContext(context.Background())
+
+	resp, err := eventHandler.Handle(ctx, s.proxyRequest(connectionID, "$connect", "CONNECT", ""))
+	if err != nil || resp.StatusCode != http.StatusOK {
+		logger.Warn().Err(err).Int("statusCode", resp.StatusCode).Msg("connect rejected")
+		return
+	}
+
+	s.mu.Lock()
+	s.connections[connectionID] = &connection{conn: conn}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.connections, connectionID)
+		s.mu.Unlock()
+
+		if _, err := eventHandler.Handle(ctx, s.proxyRequest(connectionID, "$disconnect", "DISCONNECT", "")); err != nil {
+			logger.Error().Err(err).Msg("error handling disconnect")
+		}
+	}()
+
+	for {
+		var body string
+		if err := websocket.Message.Receive(conn, &body); err != nil {
+			logger.Debug().Err(err).Msg("connection closed")
+			return
+		}
+
+		resp, err := eventHandler.Handle(ctx, s.proxyRequest(connectionID, s.routeKey(body), "MESSAGE", body))
+		if err != nil {
+			logger.Error().Err(err).Msg("error handling message")
+			continue
+		}
+		// API Gateway only relays route responses when a route response is configured, which none are
+		logger.Debug().Int("statusCode", resp.StatusCode).Msg("message handled")
+	}
+}
+
+func (s *Server) routeKey(body string) string {
+	var msg struct {
+		Action string `json:"action"`
+	}
+	if err := json.Unmarshal([]byte(body), &msg); err != nil || !s.routeKeys[msg.Action] {
+		return "$default"
+	}
+	return msg.Action
+}
+
+func (s *Server) proxyRequest(connectionID, routeKey, eventType, body string) events.APIGatewayWebsocketProxyRequest {
+	return events.APIGatewayWebsocketProxyRequest{
+		Body: body,
+		RequestContext: events.APIGatewayWebsocketProxyRequestContext{
+			ConnectionID: connectionID,
+			RouteKey:     routeKey,
+			EventType:    eventType,
+		},
+	}
+}
+
+func (s *Server) lookup(connectionID string) (*connection, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conn, ok := s.connections[connectionID]
+	if !ok {
+		return nil, ws.ErrConnectionGone
+	}
+	return conn, nil
+}
+
+func (s *Server) Send(_ context.Context, connectionID string, data []byte) error {
+	conn, err := s.lookup(connectionID)
+	if err != nil {
+		return err
+	}
+
+	conn.writeMu.Lock()
+	defer conn.writeMu.Unlock()
+	return websocket.Message.Send(conn.conn, string(data))
+}
+
+// Close closes a client connection, which in turn triggers the $disconnect route.
+func (s *Server) Close(_ context.Context, connectionID string) error {
+	conn, err := s.lookup(connectionID)
+	if err != nil {
+		return err
+	}
+	return conn.conn.Close()
+}
@@ -0,0 +1,109 @@
+package native
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+
+	"github.com/jonsabados/saturdaysspinout/ws"
+)
+
+type recordingHandler struct {
+	received chan events.APIGatewayWebsocketProxyRequest
+	status   int
+}
+
+func newRecordingHandler(status int) *recordingHandler {
+	return &recordingHandler{
+		received: make(chan events.APIGatewayWebsocketProxyRequest, 10),
+		status:   status,
+	}
+}
+
+func (h *recordingHandler) Handle(_ context.Context, request events.APIGatewayWebsocketProxyRequest) (events.APIGatewayProxyResponse, error) {
+	h.received <- request
+	return events.APIGatewayProxyResponse{StatusCode: h.status}, nil
+}
+
+func (h *recordingHandler) next(t *testing.T) events.APIGatewayWebsocketProxyRequest {
+	t.Helper()
+	select {
+	case req := <-h.received:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+		return events.APIGatewayWebsocketProxyRequest{}
+	}
+}
+
+func dial(t *testing.T, server *httptest.Server) *websocket.Conn {
+	t.Helper()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	conn, err := websocket.Dial(url, "", server.URL)
+	require.NoError(t, err)
+	return conn
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer(zerolog.Nop(), func() string { return "test-connection" }, "auth", "pingRequest")
+	handler := newRecordingHandler(http.StatusOK)
+	httpServer := httptest.NewServer(server.Handler(handler))
+	defer httpServer.Close()
+
+	conn := dial(t, httpServer)
+
+	connect := handler.next(t)
+	assert.Equal(t, "$connect", connect.RequestContext.RouteKey)
+	assert.Equal(t, "CONNECT", connect.RequestContext.EventType)
+	assert.Equal(t, "test-connection", connect.RequestContext.ConnectionID)
+
+	require.NoError(t, websocket.Message.Send(conn, `{"action":"auth","token":"abc"}`))
+	auth := handler.next(t)
+	assert.Equal(t, "auth", auth.RequestContext.RouteKey)
+	assert.Equal(t, "MESSAGE", auth.RequestContext.EventType)
+	assert.Equal(t, `{"action":"auth","token":"abc"}`, auth.Body)
+
+	require.NoError(t, websocket.Message.Send(conn, `{"action":"somethingElse"}`))
+	assert.Equal(t, "$default", handler.next(t).RequestContext.RouteKey)
+
+	require.NoError(t, websocket.Message.Send(conn, `not json`))
+	assert.Equal(t, "$default", handler.next(t).RequestContext.RouteKey)
+
+	require.NoError(t, server.Send(ctx, "test-connection", []byte(`{"action":"pong"}`)))
+	var pushed string
+	require.NoError(t, websocket.Message.Receive(conn, &pushed))
+	assert.Equal(t, `{"action":"pong"}`, pushed)
+
+	require.NoError(t, server.Close(ctx, "test-connection"))
+	disconnect := handler.next(t)
+	assert.Equal(t, "$disconnect", disconnect.RequestContext.RouteKey)
+	assert.Equal(t, "DISCONNECT", disconnect.RequestContext.EventType)
+
+	assert.ErrorIs(t, server.Send(ctx, "test-connection", []byte(`{}`)), ws.ErrConnectionGone)
+	assert.ErrorIs(t, server.Close(ctx, "test-connection"), ws.ErrConnectionGone)
+}
+
+func TestServer_ConnectRejected(t *testing.T) {
+	server := NewServer(zerolog.Nop(), func() string { return "test-connection" })
+	handler := newRecordingHandler(http.StatusUnauthorized)
+	httpServer := httptest.NewServer(server.Handler(handler))
+	defer httpServer.Close()
+
+	conn := dial(t, httpServer)
+	assert.Equal(t, "$connect", handler.next(t).RequestContext.RouteKey)
+
+	var msg string
+	assert.Error(t, websocket.Message.Receive(conn, &msg))
+
+	assert.ErrorIs(t, server.Send(context.Background(), "test-connection", []byte(`{}`)), ws.ErrConnectionGone)
+}
@@ -0,0 +1,110 @@
+package overlay
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/jonsabados/saturdaysspinout/overlay"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+	"github.com/jonsabados/saturdaysspinout/ws"
+	"github.com/rs/zerolog"
+)
+
+const responseAction = "overlaySubscribeResponse"
+
+// Message subscribes a connection to the overlay served with Token. Overlays have no driver token, so the tenant the
+// overlay belongs to comes along with it, left off for the default tenant.
+type Message struct {
+	Action   string `json:"action"`
+	Token    string `json:"token"`
+	TenantID string `json:"tenantId"`
+}
+
+type Response struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+type Pusher interface {
+	Push(ctx context.Context, connectionID string, actionType string, payload any) (bool, error)
+	Disconnect(ctx context.Context, connectionID string)
+}
+
+type ConnectionStore interface {
+	GetOverlayByToken(ctx context.Context, token string) (*store.Overlay, error)
+	SaveConnection(ctx context.Context, conn store.WebSocketConnection) error
+}
+
+// NewHandler subscribes a stream overlay to updates, in place of authenticating. The connection is sent the overlay's
+// current snapshot straight away and again each time it is retaken, and nothing else.
+func NewHandler(pusher Pusher, connectionStore ConnectionStore) ws.RouteHandler {
+	return ws.RouteHandlerFunc(func(ctx context.Context, request events.APIGatewayWebsocketProxyRequest) (events.APIGatewayProxyResponse, error) {
+		logger := zerolog.Ctx(ctx)
+		connectionID := request.RequestContext.ConnectionID
+
+		var msg Message
+		if err := json.Unmarshal([]byte(request.Body), &msg); err != nil {
+			logger.Warn().Err(err).Msg("failed to parse overlay subscription")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "invalid payload"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusBadRequest}, nil
+		}
+
+		if msg.Token == "" || (msg.TenantID != tenant.Default && !tenant.Valid(msg.TenantID)) {
+			logger.Warn().Msg("missing token or invalid tenant in overlay subscription")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "missing token or invalid tenant"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusBadRequest}, nil
+		}
+		ctx = tenant.WithID(ctx, msg.TenantID)
+
+		stored, err := connectionStore.GetOverlayByToken(ctx, msg.Token)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get overlay")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "internal error"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}, err
+		}
+		if stored == nil {
+			logger.Warn().Msg("overlay not found, disconnecting")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "overlay not found"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			pusher.Disconnect(ctx, connectionID)
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusForbidden}, nil
+		}
+
+		err = connectionStore.SaveConnection(ctx, store.WebSocketConnection{
+			DriverID:     stored.DriverID,
+			ConnectionID: connectionID,
+			TenantID:     msg.TenantID,
+			OverlayToken: stored.Token,
+		})
+		if err != nil {
+			logger.Error().Err(err).Int64("driverId", stored.DriverID).Msg("failed to save overlay connection")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "internal error"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}, err
+		}
+
+		logger.Info().Int64("driverId", stored.DriverID).Msg("overlay subscribed")
+		if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: true, Message: "subscribed"}); err != nil {
+			logger.Error().Err(err).Msg("error pushing message")
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}, err
+		}
+		// the snapshot is the overlay's JSON already, so it goes out as is
+		if _, err := pusher.Push(ctx, connectionID, overlay.UpdateAction, json.RawMessage(stored.Snapshot)); err != nil {
+			logger.Error().Err(err).Msg("error pushing message")
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}, err
+		}
+
+		return events.APIGatewayProxyResponse{StatusCode: http.StatusOK}, nil
+	})
+}
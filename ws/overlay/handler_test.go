@@ -0,0 +1,128 @@
+package overlay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHandler(t *testing.T) {
+	connectionID := "conn-1"
+	snapshot := `{"driverId":12345,"driverName":"Jon Sabados"}`
+	stored := &store.Overlay{DriverID: 12345, Token: "tok", Snapshot: snapshot}
+
+	type getOverlayCall struct {
+		overlay *store.Overlay
+		err     error
+	}
+
+	testCases := []struct {
+		name string
+		body string
+
+		getOverlayCall *getOverlayCall
+		saveErr        error
+
+		expectedTenant     string
+		expectedResponse   Response
+		expectedUpdate     bool
+		expectedDisconnect bool
+		expectedStatus     int
+	}{
+		{
+			name:             "invalid payload",
+			body:             `{`,
+			expectedResponse: Response{Success: false, Message: "invalid payload"},
+			expectedStatus:   http.StatusBadRequest,
+		},
+		{
+			name:             "missing token",
+			body:             `{"action":"overlaySubscribe"}`,
+			expectedResponse: Response{Success: false, Message: "missing token or invalid tenant"},
+			expectedStatus:   http.StatusBadRequest,
+		},
+		{
+			name:             "invalid tenant",
+			body:             `{"action":"overlaySubscribe","token":"tok","tenantId":"Not A Tenant"}`,
+			expectedResponse: Response{Success: false, Message: "missing token or invalid tenant"},
+			expectedStatus:   http.StatusBadRequest,
+		},
+		{
+			name:               "unknown token",
+			body:               `{"action":"overlaySubscribe","token":"nope"}`,
+			getOverlayCall:     &getOverlayCall{},
+			expectedResponse:   Response{Success: false, Message: "overlay not found"},
+			expectedDisconnect: true,
+			expectedStatus:     http.StatusForbidden,
+		},
+		{
+			name:             "store error",
+			body:             `{"action":"overlaySubscribe","token":"tok"}`,
+			getOverlayCall:   &getOverlayCall{err: errors.New("boom")},
+			expectedResponse: Response{Success: false, Message: "internal error"},
+			expectedStatus:   http.StatusInternalServerError,
+		},
+		{
+			name:             "subscribed",
+			body:             `{"action":"overlaySubscribe","token":"tok"}`,
+			getOverlayCall:   &getOverlayCall{overlay: stored},
+			expectedResponse: Response{Success: true, Message: "subscribed"},
+			expectedUpdate:   true,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "subscribed for a tenant",
+			body:             `{"action":"overlaySubscribe","token":"tok","tenantId":"league-one"}`,
+			getOverlayCall:   &getOverlayCall{overlay: stored},
+			expectedTenant:   "league-one",
+			expectedResponse: Response{Success: true, Message: "subscribed"},
+			expectedUpdate:   true,
+			expectedStatus:   http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := NewMockConnectionStore(t)
+			mockPusher := NewMockPusher(t)
+
+			var token string
+			if tc.getOverlayCall != nil {
+				var msg Message
+				require.NoError(t, json.Unmarshal([]byte(tc.body), &msg))
+				token = msg.Token
+				mockStore.EXPECT().GetOverlayByToken(mock.MatchedBy(func(ctx context.Context) bool {
+					return tenant.FromContext(ctx) == tc.expectedTenant
+				}), token).Return(tc.getOverlayCall.overlay, tc.getOverlayCall.err)
+			}
+			if tc.expectedUpdate {
+				mockStore.EXPECT().SaveConnection(mock.Anything, store.WebSocketConnection{
+					DriverID:     12345,
+					ConnectionID: connectionID,
+					TenantID:     tc.expectedTenant,
+					OverlayToken: "tok",
+				}).Return(nil)
+				mockPusher.EXPECT().Push(mock.Anything, connectionID, "overlayUpdate", json.RawMessage(snapshot)).Return(true, nil)
+			}
+			mockPusher.EXPECT().Push(mock.Anything, connectionID, responseAction, tc.expectedResponse).Return(true, nil)
+			if tc.expectedDisconnect {
+				mockPusher.EXPECT().Disconnect(mock.Anything, connectionID).Return()
+			}
+
+			res, _ := NewHandler(mockPusher, mockStore).HandleRequest(context.Background(), events.APIGatewayWebsocketProxyRequest{
+				Body:           tc.body,
+				RequestContext: events.APIGatewayWebsocketProxyRequestContext{ConnectionID: connectionID, RouteKey: "overlaySubscribe"},
+			})
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+		})
+	}
+}
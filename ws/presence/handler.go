@@ -0,0 +1,106 @@
+package presence
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
+	"github.com/jonsabados/saturdaysspinout/ws"
+	"github.com/rs/zerolog"
+)
+
+const responseAction = "racingHeartbeatResponse"
+
+// Message is a racing heartbeat. What the driver is racing is optional since the client may only know that a sim
+// session is running.
+type Message struct {
+	ws.AuthenticatedMessage
+	TrackID    int64  `json:"trackId"`
+	CarID      int64  `json:"carId"`
+	SeriesName string `json:"seriesName"`
+}
+
+type Response struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+type Pusher interface {
+	Push(ctx context.Context, connectionID string, actionType string, payload any) (bool, error)
+	Disconnect(ctx context.Context, connectionID string)
+}
+
+type ConnectionStore interface {
+	GetConnection(ctx context.Context, driverID int64, connectionID string) (*store.WebSocketConnection, error)
+	SaveDriverPresence(ctx context.Context, presence store.DriverPresence) error
+}
+
+// NewHandler records that the authenticated driver is currently in a sim session. Clients send a heartbeat every
+// minute or so while racing; presence lapses on its own a couple of minutes after the last one, so there is no
+// message for leaving.
+func NewHandler(pusher Pusher, connectionStore ConnectionStore) ws.RouteHandler {
+	return ws.RouteHandlerFunc(func(ctx context.Context, request events.APIGatewayWebsocketProxyRequest) (events.APIGatewayProxyResponse, error) {
+		logger := zerolog.Ctx(ctx)
+		connectionID := request.RequestContext.ConnectionID
+
+		var msg Message
+		if err := json.Unmarshal([]byte(request.Body), &msg); err != nil {
+			logger.Warn().Err(err).Msg("failed to parse racing heartbeat")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "invalid payload"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusBadRequest}, nil
+		}
+
+		if msg.DriverID == 0 {
+			logger.Warn().Msg("missing driverId in racing heartbeat")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "missing driverId"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusBadRequest}, nil
+		}
+
+		// Verify connection is authenticated for this driver
+		conn, err := connectionStore.GetConnection(ctx, msg.DriverID, connectionID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get connection")
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}, err
+		}
+		// overlay connections were opened with an overlay's token, which only lets them listen
+		if conn == nil || conn.OverlayToken != "" {
+			logger.Warn().Int64("driverId", msg.DriverID).Msg("connection not found for driver, disconnecting")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "not authenticated"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			pusher.Disconnect(ctx, connectionID)
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusForbidden}, nil
+		}
+		// the rest is done in the tenant the connection was authenticated for
+		ctx = tenant.WithID(ctx, conn.TenantID)
+
+		err = connectionStore.SaveDriverPresence(ctx, store.DriverPresence{
+			DriverID:   msg.DriverID,
+			TrackID:    msg.TrackID,
+			CarID:      msg.CarID,
+			SeriesName: msg.SeriesName,
+		})
+		if err != nil {
+			logger.Error().Err(err).Int64("driverId", msg.DriverID).Msg("failed to save driver presence")
+			if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: false, Message: "internal error"}); err != nil {
+				logger.Error().Err(err).Msg("error pushing message")
+			}
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}, err
+		}
+
+		logger.Debug().Int64("driverId", msg.DriverID).Msg("racing heartbeat recorded")
+		if _, err := pusher.Push(ctx, connectionID, responseAction, Response{Success: true, Message: "presence recorded"}); err != nil {
+			logger.Error().Err(err).Msg("error pushing message")
+			return events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}, err
+		}
+
+		return events.APIGatewayProxyResponse{StatusCode: http.StatusOK}, nil
+	})
+}
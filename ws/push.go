@@ -4,11 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/apigatewaymanagementapi"
-	"github.com/aws/aws-sdk-go-v2/service/apigatewaymanagementapi/types"
+	"github.com/jonsabados/saturdaysspinout/metrics"
 	"github.com/jonsabados/saturdaysspinout/store"
+	"github.com/jonsabados/saturdaysspinout/tenant"
 	"github.com/rs/zerolog"
 )
 
@@ -17,25 +17,39 @@ type Message struct {
 	Payload any    `json:"payload,omitempty"`
 }
 
-type APIGatewayManagementClient interface {
-	PostToConnection(ctx context.Context, params *apigatewaymanagementapi.PostToConnectionInput, optFns ...func(*apigatewaymanagementapi.Options)) (*apigatewaymanagementapi.PostToConnectionOutput, error)
-	DeleteConnection(ctx context.Context, params *apigatewaymanagementapi.DeleteConnectionInput, optFns ...func(*apigatewaymanagementapi.Options)) (*apigatewaymanagementapi.DeleteConnectionOutput, error)
-}
-
 type ConnectionLookup interface {
 	GetConnectionsByDriver(ctx context.Context, driverID int64) ([]store.WebSocketConnection, error)
 }
 
+type MetricsEmitter interface {
+	EmitDuration(ctx context.Context, name string, duration time.Duration, dimensions map[string]string) error
+}
+
 type Pusher struct {
-	client           APIGatewayManagementClient
+	transport        Transport
 	connectionLookup ConnectionLookup
+	metrics          MetricsEmitter
+}
+
+type PusherOption func(*Pusher)
+
+// WithPushMetrics has the pusher record how long each message took to hand to the transport as
+// websocket_push_latency, by action.
+func WithPushMetrics(emitter MetricsEmitter) PusherOption {
+	return func(p *Pusher) {
+		p.metrics = emitter
+	}
 }
 
-func NewPusher(client APIGatewayManagementClient, connectionLookup ConnectionLookup) *Pusher {
-	return &Pusher{
-		client:           client,
+func NewPusher(transport Transport, connectionLookup ConnectionLookup, opts ...PusherOption) *Pusher {
+	p := &Pusher{
+		transport:        transport,
 		connectionLookup: connectionLookup,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // Push dispatches messages in a consistent format. When the connection is valid true, nil will be returned, but if the
@@ -51,13 +65,11 @@ func (p *Pusher) Push(ctx context.Context, connectionID string, actionType strin
 		return false, err
 	}
 
-	_, err = p.client.PostToConnection(ctx, &apigatewaymanagementapi.PostToConnectionInput{
-		ConnectionId: aws.String(connectionID),
-		Data:         data,
-	})
+	t1 := time.Now()
+	err = p.transport.Send(ctx, connectionID, data)
+	p.recordLatency(ctx, actionType, time.Since(t1))
 	if err != nil {
-		var goneErr *types.GoneException
-		if errors.As(err, &goneErr) {
+		if errors.Is(err, ErrConnectionGone) {
 			return false, nil
 		}
 		return false, err
@@ -65,26 +77,49 @@ func (p *Pusher) Push(ctx context.Context, connectionID string, actionType strin
 	return true, nil
 }
 
+func (p *Pusher) recordLatency(ctx context.Context, actionType string, latency time.Duration) {
+	if p.metrics == nil {
+		return
+	}
+	err := p.metrics.EmitDuration(ctx, metrics.WebSocketPushLatency, latency, map[string]string{metrics.DimensionAction: actionType})
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to emit push latency")
+	}
+}
+
 // Disconnect closes a WebSocket connection.
 func (p *Pusher) Disconnect(ctx context.Context, connectionID string) {
 	logger := zerolog.Ctx(ctx)
 
-	_, err := p.client.DeleteConnection(ctx, &apigatewaymanagementapi.DeleteConnectionInput{
-		ConnectionId: aws.String(connectionID),
-	})
+	err := p.transport.Close(ctx, connectionID)
 	if err != nil {
 		logger.Error().Err(err).Msg("failed to disconnect client")
 	}
 }
 
-// Broadcast sends a message to all active connections for a given driver.
+// Broadcast sends a message to all active connections for a given driver, among those authenticated for the tenant
+// on the context. Overlay connections are left out, they only get what BroadcastOverlay sends.
 func (p *Pusher) Broadcast(ctx context.Context, driverID int64, actionType string, payload any) error {
+	return p.broadcast(ctx, driverID, "", actionType, payload)
+}
+
+// BroadcastOverlay sends a message to the driver's connections opened by the overlay served with token, for the tenant
+// on the context. Overlays opened with a token since replaced or revoked get nothing.
+func (p *Pusher) BroadcastOverlay(ctx context.Context, driverID int64, token string, actionType string, payload any) error {
+	return p.broadcast(ctx, driverID, token, actionType, payload)
+}
+
+func (p *Pusher) broadcast(ctx context.Context, driverID int64, overlayToken string, actionType string, payload any) error {
 	connections, err := p.connectionLookup.GetConnectionsByDriver(ctx, driverID)
 	if err != nil {
 		return err
 	}
 
 	for _, conn := range connections {
+		// the same iRacing driver may be signed in to more than one tenant
+		if conn.TenantID != tenant.FromContext(ctx) || conn.OverlayToken != overlayToken {
+			continue
+		}
 		if _, err := p.Push(ctx, conn.ConnectionID, actionType, payload); err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigatewaymanagementapi"
 	"github.com/aws/aws-sdk-go-v2/service/apigatewaymanagementapi/types"
+	"github.com/jonsabados/saturdaysspinout/metrics"
 	"github.com/jonsabados/saturdaysspinout/store"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
@@ -93,7 +94,7 @@ func TestPusher_Push(t *testing.T) {
 			}).Return(&apigatewaymanagementapi.PostToConnectionOutput{}, tc.postToConnectionCall.err)
 
 			mockConnLookup := NewMockConnectionLookup(t)
-			pusher := NewPusher(mockClient, mockConnLookup)
+			pusher := NewPusher(NewAPIGatewayTransport(mockClient), mockConnLookup)
 			ok, err := pusher.Push(context.Background(), tc.connectionID, tc.actionType, tc.payload)
 
 			assert.Equal(t, tc.expectedOK, ok)
@@ -110,7 +111,7 @@ func TestPusher_Push(t *testing.T) {
 func TestPusher_Push_MarshalError(t *testing.T) {
 	mockClient := NewMockAPIGatewayManagementClient(t)
 	mockConnLookup := NewMockConnectionLookup(t)
-	pusher := NewPusher(mockClient, mockConnLookup)
+	pusher := NewPusher(NewAPIGatewayTransport(mockClient), mockConnLookup)
 
 	// channels cannot be marshaled to JSON
 	unmarshalable := make(chan int)
@@ -122,6 +123,40 @@ func TestPusher_Push_MarshalError(t *testing.T) {
 	assert.Contains(t, err.Error(), "json")
 }
 
+func TestPusher_Push_RecordsLatency(t *testing.T) {
+	testCases := []struct {
+		name    string
+		sendErr error
+		emitErr error
+	}{
+		{
+			name: "delivered",
+		},
+		{
+			name:    "connection gone",
+			sendErr: &types.GoneException{Message: aws.String("connection gone")},
+		},
+		{
+			name:    "emit failure ignored",
+			emitErr: errors.New("write failed"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := NewMockAPIGatewayManagementClient(t)
+			mockClient.EXPECT().PostToConnection(mock.Anything, mock.Anything).Return(&apigatewaymanagementapi.PostToConnectionOutput{}, tc.sendErr)
+
+			emitter := NewMockMetricsEmitter(t)
+			emitter.EXPECT().EmitDuration(mock.Anything, metrics.WebSocketPushLatency, mock.Anything, map[string]string{metrics.DimensionAction: "raceIngested"}).Return(tc.emitErr)
+
+			pusher := NewPusher(NewAPIGatewayTransport(mockClient), NewMockConnectionLookup(t), WithPushMetrics(emitter))
+			_, err := pusher.Push(context.Background(), "conn-123", "raceIngested", nil)
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestPusher_Disconnect(t *testing.T) {
 	type deleteConnectionCall struct {
 		connectionID string
@@ -155,7 +190,7 @@ func TestPusher_Disconnect(t *testing.T) {
 			}).Return(&apigatewaymanagementapi.DeleteConnectionOutput{}, tc.deleteConnectionCall.err)
 
 			mockConnLookup := NewMockConnectionLookup(t)
-			pusher := NewPusher(mockClient, mockConnLookup)
+			pusher := NewPusher(NewAPIGatewayTransport(mockClient), mockConnLookup)
 
 			logger := zerolog.Nop()
 			ctx := logger.WithContext(context.Background())
@@ -231,6 +266,38 @@ func TestPusher_Broadcast(t *testing.T) {
 				{connectionID: "conn-3"},
 			},
 		},
+		{
+			name:       "connections for another tenant are skipped",
+			driverID:   driverID,
+			actionType: "test-action",
+			payload:    "payload",
+			getConnectionsByDriverCall: getConnectionsByDriverCall{
+				driverID: driverID,
+				result: []store.WebSocketConnection{
+					{DriverID: driverID, ConnectionID: "conn-1"},
+					{DriverID: driverID, ConnectionID: "conn-2", TenantID: "league-one"},
+				},
+			},
+			postToConnectionCalls: []postToConnectionCall{
+				{connectionID: "conn-1"},
+			},
+		},
+		{
+			name:       "overlay connections are skipped",
+			driverID:   driverID,
+			actionType: "test-action",
+			payload:    "payload",
+			getConnectionsByDriverCall: getConnectionsByDriverCall{
+				driverID: driverID,
+				result: []store.WebSocketConnection{
+					{DriverID: driverID, ConnectionID: "conn-1"},
+					{DriverID: driverID, ConnectionID: "conn-2", OverlayToken: "tok"},
+				},
+			},
+			postToConnectionCalls: []postToConnectionCall{
+				{connectionID: "conn-1"},
+			},
+		},
 		{
 			name:       "error on first push fails fast",
 			driverID:   driverID,
@@ -267,7 +334,7 @@ func TestPusher_Broadcast(t *testing.T) {
 				}).Return(&apigatewaymanagementapi.PostToConnectionOutput{}, call.err)
 			}
 
-			pusher := NewPusher(mockClient, mockConnLookup)
+			pusher := NewPusher(NewAPIGatewayTransport(mockClient), mockConnLookup)
 			err := pusher.Broadcast(context.Background(), tc.driverID, tc.actionType, tc.payload)
 
 			if tc.expectedErrMsg != "" {
@@ -280,6 +347,27 @@ func TestPusher_Broadcast(t *testing.T) {
 	}
 }
 
+func TestPusher_BroadcastOverlay(t *testing.T) {
+	driverID := int64(12345)
+
+	mockClient := NewMockAPIGatewayManagementClient(t)
+	mockConnLookup := NewMockConnectionLookup(t)
+	mockConnLookup.EXPECT().GetConnectionsByDriver(mock.Anything, driverID).Return([]store.WebSocketConnection{
+		{DriverID: driverID, ConnectionID: "conn-1"},
+		{DriverID: driverID, ConnectionID: "conn-2", OverlayToken: "tok"},
+		{DriverID: driverID, ConnectionID: "conn-3", OverlayToken: "replaced"},
+		{DriverID: driverID, ConnectionID: "conn-4", OverlayToken: "tok", TenantID: "league-one"},
+	}, nil)
+	mockClient.EXPECT().PostToConnection(mock.Anything, &apigatewaymanagementapi.PostToConnectionInput{
+		ConnectionId: aws.String("conn-2"),
+		Data:         mustMarshal(t, Message{Action: "overlayUpdate", Payload: "payload"}),
+	}).Return(&apigatewaymanagementapi.PostToConnectionOutput{}, nil)
+
+	pusher := NewPusher(NewAPIGatewayTransport(mockClient), mockConnLookup)
+	err := pusher.BroadcastOverlay(context.Background(), driverID, "tok", "overlayUpdate", "payload")
+	assert.NoError(t, err)
+}
+
 func mustMarshal(t *testing.T, v any) []byte {
 	t.Helper()
 	data, err := json.Marshal(v)
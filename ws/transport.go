@@ -0,0 +1,16 @@
+package ws
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrConnectionGone is returned by a Transport when the client is no longer connected.
+var ErrConnectionGone = errors.New("connection gone")
+
+// Transport delivers data to connected clients. In AWS this is API Gateway's management API, outside of it
+// connections are served directly by native.Server.
+type Transport interface {
+	Send(ctx context.Context, connectionID string, data []byte) error
+	Close(ctx context.Context, connectionID string) error
+}